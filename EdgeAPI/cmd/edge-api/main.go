@@ -18,6 +18,7 @@ import (
 	_ "github.com/iwind/TeaGo/bootstrap"
 	"github.com/iwind/TeaGo/maps"
 	"github.com/iwind/TeaGo/types"
+	timeutil "github.com/iwind/TeaGo/utils/time"
 	"github.com/iwind/gosock/pkg/gosock"
 )
 
@@ -28,7 +29,7 @@ func main() {
 	var app = apps.NewAppCmd()
 	app.Version(teaconst.Version)
 	app.Product(teaconst.ProductName)
-	app.Usage(teaconst.ProcessName + " [-h|-v|start|stop|restart|setup|upgrade|service|daemon|issues]")
+	app.Usage(teaconst.ProcessName + " [-h|-v|start|stop|restart|setup|upgrade|migrations|service|daemon|issues]")
 
 	// 短版本号
 	app.On("-V", func() {
@@ -101,6 +102,67 @@ func main() {
 			}
 		}
 	})
+	app.On("migrations", func() {
+		migrator, err := setup.NewSQLMigratorFromCmd()
+		if err != nil {
+			fmt.Println("[ERROR]" + err.Error())
+			return
+		}
+		defer func() {
+			_ = migrator.Close()
+		}()
+
+		// edge-api migrations [repair|down] VERSION
+		if len(os.Args) > 3 {
+			var version = os.Args[3]
+			switch os.Args[2] {
+			case "repair":
+				err = migrator.Repair(version)
+			case "down":
+				err = migrator.Down(version)
+			default:
+				fmt.Println("usage: " + teaconst.ProcessName + " migrations [repair|down VERSION]")
+				return
+			}
+			if err != nil {
+				fmt.Println("[ERROR]" + err.Error())
+				return
+			}
+			fmt.Println("ok")
+			return
+		}
+
+		records, err := migrator.FindRecords()
+		if err != nil {
+			fmt.Println("[ERROR]" + err.Error())
+			return
+		}
+		var recordMap = map[string]*setup.SQLMigrationRecord{}
+		for _, record := range records {
+			recordMap[record.Version] = record
+		}
+		fmt.Printf("%-12s | %-10s | %-19s | %s\n", "version", "status", "applied at", "description")
+		fmt.Println(strings.Repeat("-", 70))
+		for _, migration := range migrator.Migrations() {
+			var status = "pending"
+			var appliedAt = ""
+			record, ok := recordMap[migration.Version]
+			if ok {
+				switch {
+				case !record.IsApplied:
+					status = "failed"
+				case record.IsBaseline:
+					status = "baseline"
+				default:
+					status = "applied"
+				}
+				if record.AppliedAt > 0 {
+					appliedAt = timeutil.FormatTime("Y-m-d H:i:s", record.AppliedAt)
+				}
+			}
+			fmt.Printf("%-12s | %-10s | %-19s | %s\n", migration.Version, status, appliedAt, migration.Description)
+		}
+	})
 	app.On("debug", func() {
 		var sock = gosock.NewTmpSock(teaconst.ProcessName)
 		reply, err := sock.Send(&gosock.Command{Code: "debug"})
@@ -139,6 +201,21 @@ func main() {
 			fmt.Println("prepared statements count: " + types.String(count))
 		}
 	})
+	app.On("db.pool", func() {
+		var sock = gosock.NewTmpSock(teaconst.ProcessName)
+		reply, err := sock.Send(&gosock.Command{Code: "db.pool"})
+		if err != nil {
+			fmt.Println("[ERROR]" + err.Error())
+		} else {
+			var stats = maps.NewMap(reply.Params)
+			fmt.Println("max open: " + stats.GetString("maxOpen"))
+			fmt.Println("open: " + stats.GetString("open"))
+			fmt.Println("in use: " + stats.GetString("inUse"))
+			fmt.Println("idle: " + stats.GetString("idle"))
+			fmt.Println("wait count: " + stats.GetString("waitCount"))
+			fmt.Println("wait duration: " + stats.GetString("waitDurationMs") + "ms")
+		}
+	})
 	app.On("issues", func() {
 		var flagSet = flag.NewFlagSet("issues", flag.ExitOnError)
 		var formatJSON = false
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/datasets"
+	_ "github.com/iwind/TeaGo/bootstrap"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+// 生成和导入性能测试数据
+// 数据写入到 configs/db.yaml 中配置的数据库，请不要在生产环境中执行
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		return
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "seed":
+		err = runSeed(os.Args[2:])
+	case "import":
+		err = runImport(os.Args[2:])
+	default:
+		printUsage()
+		return
+	}
+	if err != nil {
+		fmt.Println("[ERROR]" + err.Error())
+		os.Exit(1)
+	}
+}
+
+func printUsage() {
+	fmt.Println("usage: edge-data [seed|import] [options]")
+	fmt.Println("  seed    generate users, servers and stats: edge-data seed -users=100 -servers=10 -days=30 -seed=1")
+	fmt.Println("  import  import anonymized data from production database: edge-data import -dsn=\"user:pass@tcp(host:3306)/db\" -salt=SALT")
+}
+
+func runSeed(args []string) error {
+	var options = datasets.DefaultSeedOptions()
+	var endDay string
+
+	var flagSet = flag.NewFlagSet("seed", flag.ExitOnError)
+	flagSet.Int64Var(&options.Seed, "seed", options.Seed, "random seed")
+	flagSet.IntVar(&options.Users, "users", options.Users, "count of users")
+	flagSet.IntVar(&options.ServersPerUser, "servers", options.ServersPerUser, "count of servers per user")
+	flagSet.IntVar(&options.Nodes, "nodes", options.Nodes, "count of nodes in cluster")
+	flagSet.IntVar(&options.StatDays, "days", options.StatDays, "days of stats history")
+	flagSet.StringVar(&endDay, "end-day", "", "last day of stats history, YYYYMMDD, default is yesterday")
+	_ = flagSet.Parse(args)
+
+	if len(endDay) > 0 {
+		day, err := time.ParseInLocation("20060102", endDay, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid 'end-day': %w", err)
+		}
+		options.EndDay = day
+	}
+
+	dbs.NotifyReady()
+
+	var seeder = datasets.NewSeeder(options)
+	seeder.SetLogWriter(os.Stdout)
+	result, err := seeder.Run()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("done, cluster: " + types.String(result.ClusterId) + ", users: " + types.String(result.CountUsers) + ", servers: " + types.String(result.CountServers) + ", stats: " + types.String(result.CountStats))
+	return nil
+}
+
+func runImport(args []string) error {
+	var options = &datasets.ImportOptions{}
+	var dsn string
+	var salt string
+	var tables string
+
+	var flagSet = flag.NewFlagSet("import", flag.ExitOnError)
+	flagSet.StringVar(&dsn, "dsn", "", "source database dsn")
+	flagSet.StringVar(&salt, "salt", "", "salt used to anonymize data")
+	flagSet.StringVar(&tables, "tables", "", "only import these tables, separated by comma")
+	flagSet.BoolVar(&options.WithLogs, "with-logs", false, "import log tables")
+	flagSet.BoolVar(&options.Truncate, "truncate", false, "truncate target tables before importing")
+	flagSet.IntVar(&options.BatchSize, "batch", 500, "rows per batch")
+	_ = flagSet.Parse(args)
+
+	if len(dsn) == 0 {
+		return fmt.Errorf("'dsn' should not be empty")
+	}
+	if len(salt) == 0 {
+		return fmt.Errorf("'salt' should not be empty")
+	}
+	for _, table := range strings.Split(tables, ",") {
+		table = strings.TrimSpace(table)
+		if len(table) > 0 {
+			options.Tables = append(options.Tables, table)
+		}
+	}
+
+	sourceDB, err := dbs.NewInstanceFromConfig(&dbs.DBConfig{
+		Driver: "mysql",
+		Dsn:    dsn,
+		Prefix: "edge",
+	})
+	if err != nil {
+		return fmt.Errorf("connect source database failed: %w", err)
+	}
+	defer func() {
+		_ = sourceDB.Close()
+	}()
+
+	targetDB, err := dbs.Default()
+	if err != nil {
+		return fmt.Errorf("connect target database failed: %w", err)
+	}
+
+	var importer = datasets.NewSnapshotImporter(sourceDB, targetDB, datasets.NewAnonymizer(salt), options)
+	importer.SetLogWriter(os.Stdout)
+	result, err := importer.Import()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("done, tables: " + types.String(result.CountTables) + ", rows: " + types.String(result.CountRows))
+	return nil
+}
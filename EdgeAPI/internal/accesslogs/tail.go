@@ -0,0 +1,135 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package accesslogs
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+)
+
+// TailFilter 实时日志过滤条件
+type TailFilter struct {
+	ServerId int64   // 网站ID，0表示所有网站
+	Statuses []int32 // 状态码，比如 404，也可以使用 4、5 这样的一位数表示 4xx、5xx，为空表示所有状态码
+	IP       string  // 客户端IP，为空表示所有IP
+}
+
+// Match 检查日志是否匹配
+func (this *TailFilter) Match(accessLog *pb.HTTPAccessLog) bool {
+	if accessLog == nil {
+		return false
+	}
+	if this.ServerId > 0 && accessLog.ServerId != this.ServerId {
+		return false
+	}
+	if len(this.Statuses) > 0 {
+		var found = false
+		for _, status := range this.Statuses {
+			if status == accessLog.Status || (status > 0 && status < 10 && accessLog.Status/100 == status) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(this.IP) > 0 && accessLog.RemoteAddr != this.IP && accessLog.RawRemoteAddr != this.IP {
+		return false
+	}
+	return true
+}
+
+// TailEvent 推送给订阅者的一批日志
+type TailEvent struct {
+	AccessLogs   []*pb.HTTPAccessLog
+	DroppedCount int64 // 此前因为订阅者处理不及时而丢弃的日志数量
+}
+
+type tailSubscriber struct {
+	filter  *TailFilter
+	ch      chan *TailEvent
+	dropped int64
+}
+
+// SharedTailHub 实时日志的订阅中心
+var SharedTailHub = NewTailHub()
+
+// TailHub 实时日志的订阅中心
+// 只能收到当前API节点接收到的日志；订阅者处理不及时的日志会被丢弃，并在下一次推送时告知丢弃的数量
+type TailHub struct {
+	subscribers map[*tailSubscriber]bool
+	count       int32
+	locker      sync.Mutex
+}
+
+func NewTailHub() *TailHub {
+	return &TailHub{
+		subscribers: map[*tailSubscriber]bool{},
+	}
+}
+
+// Subscribe 订阅日志，使用完后需要调用返回的取消函数
+func (this *TailHub) Subscribe(filter *TailFilter) (events <-chan *TailEvent, cancel func()) {
+	if filter == nil {
+		filter = &TailFilter{}
+	}
+	var subscriber = &tailSubscriber{
+		filter: filter,
+		ch:     make(chan *TailEvent, 128),
+	}
+
+	this.locker.Lock()
+	this.subscribers[subscriber] = true
+	atomic.StoreInt32(&this.count, int32(len(this.subscribers)))
+	this.locker.Unlock()
+
+	var once = sync.Once{}
+	return subscriber.ch, func() {
+		once.Do(func() {
+			this.locker.Lock()
+			delete(this.subscribers, subscriber)
+			atomic.StoreInt32(&this.count, int32(len(this.subscribers)))
+			this.locker.Unlock()
+		})
+	}
+}
+
+// Publish 发布新接收到的日志
+func (this *TailHub) Publish(accessLogs []*pb.HTTPAccessLog) {
+	// 没有订阅者时不需要加锁，以免影响写入日志的性能
+	if len(accessLogs) == 0 || atomic.LoadInt32(&this.count) == 0 {
+		return
+	}
+
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	for subscriber := range this.subscribers {
+		var matchedLogs []*pb.HTTPAccessLog
+		for _, accessLog := range accessLogs {
+			if subscriber.filter.Match(accessLog) {
+				matchedLogs = append(matchedLogs, accessLog)
+			}
+		}
+		if len(matchedLogs) == 0 {
+			continue
+		}
+
+		select {
+		case subscriber.ch <- &TailEvent{
+			AccessLogs:   matchedLogs,
+			DroppedCount: subscriber.dropped,
+		}:
+			subscriber.dropped = 0
+		default:
+			subscriber.dropped += int64(len(matchedLogs))
+		}
+	}
+}
+
+// CountSubscribers 订阅者数量
+func (this *TailHub) CountSubscribers() int {
+	return int(atomic.LoadInt32(&this.count))
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package accesslogs_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/accesslogs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestTailFilter_Match(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var accessLog = &pb.HTTPAccessLog{ServerId: 1, Status: 502, RemoteAddr: "1.2.3.4", RawRemoteAddr: "10.0.0.1"}
+
+	a.IsTrue((&accesslogs.TailFilter{}).Match(accessLog))
+	a.IsTrue((&accesslogs.TailFilter{ServerId: 1}).Match(accessLog))
+	a.IsFalse((&accesslogs.TailFilter{ServerId: 2}).Match(accessLog))
+	a.IsTrue((&accesslogs.TailFilter{Statuses: []int32{502}}).Match(accessLog))
+	a.IsTrue((&accesslogs.TailFilter{Statuses: []int32{404, 5}}).Match(accessLog))
+	a.IsFalse((&accesslogs.TailFilter{Statuses: []int32{4, 200}}).Match(accessLog))
+	a.IsTrue((&accesslogs.TailFilter{IP: "1.2.3.4"}).Match(accessLog))
+	a.IsTrue((&accesslogs.TailFilter{IP: "10.0.0.1"}).Match(accessLog))
+	a.IsFalse((&accesslogs.TailFilter{IP: "1.2.3.5"}).Match(accessLog))
+	a.IsFalse((&accesslogs.TailFilter{}).Match(nil))
+}
+
+func TestTailHub(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var hub = accesslogs.NewTailHub()
+
+	// 没有订阅者时不阻塞
+	hub.Publish([]*pb.HTTPAccessLog{{ServerId: 1}})
+
+	events1, cancel1 := hub.Subscribe(&accesslogs.TailFilter{ServerId: 1})
+	events2, cancel2 := hub.Subscribe(nil)
+	a.IsTrue(hub.CountSubscribers() == 2)
+
+	hub.Publish([]*pb.HTTPAccessLog{{ServerId: 1}, {ServerId: 2}})
+
+	var event = <-events1
+	a.IsTrue(len(event.AccessLogs) == 1 && event.AccessLogs[0].ServerId == 1)
+	event = <-events2
+	a.IsTrue(len(event.AccessLogs) == 2)
+
+	cancel1()
+	cancel1() // 重复取消
+	a.IsTrue(hub.CountSubscribers() == 1)
+
+	// 订阅者处理不及时时丢弃日志
+	for i := 0; i < 200; i++ {
+		hub.Publish([]*pb.HTTPAccessLog{{ServerId: 3}})
+	}
+	var count = 0
+	for len(events2) > 0 {
+		<-events2
+		count++
+	}
+	a.IsTrue(count == 128)
+	hub.Publish([]*pb.HTTPAccessLog{{ServerId: 3}})
+	event = <-events2
+	a.IsTrue(event.DroppedCount == 72)
+
+	cancel2()
+	a.IsTrue(hub.CountSubscribers() == 0)
+}
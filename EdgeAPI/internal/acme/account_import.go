@@ -0,0 +1,123 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"net/url"
+	"strings"
+
+	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
+	"github.com/go-acme/lego/v4/lego"
+	"github.com/go-acme/lego/v4/registration"
+)
+
+// ParsePrivateKeyFromPEM 解析从certbot、acme.sh等工具中导出的ACME账号私钥
+// 支持 PRIVATE KEY（PKCS#8）、EC PRIVATE KEY 和 RSA PRIVATE KEY 格式
+func ParsePrivateKeyFromPEM(pemData []byte) (crypto.PrivateKey, error) {
+	for {
+		var block *pem.Block
+		block, pemData = pem.Decode(pemData)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "PRIVATE KEY":
+			key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, errors.New("parse private key failed: " + err.Error())
+			}
+			switch key.(type) {
+			case *rsa.PrivateKey, *ecdsa.PrivateKey:
+				return key, nil
+			}
+			return nil, errors.New("unsupported ACME account key type, only RSA and ECDSA keys are supported")
+		case "EC PRIVATE KEY":
+			key, err := x509.ParseECPrivateKey(block.Bytes)
+			if err != nil {
+				return nil, errors.New("parse private key failed: " + err.Error())
+			}
+			return key, nil
+		case "RSA PRIVATE KEY":
+			key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, errors.New("parse private key failed: " + err.Error())
+			}
+			return key, nil
+		}
+	}
+	return nil, errors.New("can not find private key in PEM data")
+}
+
+// EncodePrivateKeyToBase64 将私钥编码为保存在数据库中的格式（PKCS#8 + Base64）
+func EncodePrivateKeyToBase64(key crypto.PrivateKey) (string, error) {
+	data, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// ValidateAccountURL 校验导入的账号URL，必须和服务商正式目录在同一个域名下
+func ValidateAccountURL(provider *Provider, accountURL string) (string, error) {
+	accountURL = strings.TrimSpace(accountURL)
+	if len(accountURL) == 0 {
+		return "", nil
+	}
+	if provider == nil {
+		return "", errors.New("'provider' must not be nil")
+	}
+
+	u, err := url.Parse(accountURL)
+	if err != nil {
+		return "", errors.New("invalid account url '" + accountURL + "': " + err.Error())
+	}
+	if u.Scheme != "https" || len(u.Host) == 0 || u.User != nil {
+		return "", errors.New("invalid account url '" + accountURL + "': should be a 'https://' url")
+	}
+
+	apiURL, err := url.Parse(provider.APIURL)
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(u.Host, apiURL.Host) {
+		return "", errors.New("invalid account url '" + accountURL + "': the host should be '" + apiURL.Host + "'")
+	}
+	return accountURL, nil
+}
+
+// ResolveAccount 使用私钥从服务商正式目录中查找已有的账号
+// 如果指定了accountURL，则查找到的账号必须和accountURL一致
+func ResolveAccount(provider *Provider, email string, key crypto.PrivateKey, accountURL string) (*registration.Resource, error) {
+	if provider == nil {
+		return nil, errors.New("'provider' must not be nil")
+	}
+
+	var user = NewUser(email, key, func(resource *registration.Resource) error {
+		return nil
+	})
+	var config = lego.NewConfig(user)
+	config.CADirURL = provider.APIURL
+	config.UserAgent = teaconst.ProductName + "/" + teaconst.Version
+
+	client, err := lego.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := client.Registration.ResolveAccountByKey()
+	if err != nil {
+		return nil, errors.New("resolve account failed: " + err.Error())
+	}
+	if len(accountURL) > 0 && resource.URI != accountURL {
+		return nil, errors.New("the account url '" + accountURL + "' does not match the private key, the account of the key is '" + resource.URI + "'")
+	}
+	return resource, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestParsePrivateKeyFromPEM(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecData, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8Data, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, block := range []*pem.Block{
+		{Type: "EC PRIVATE KEY", Bytes: ecData},
+		{Type: "PRIVATE KEY", Bytes: pkcs8Data},
+		{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)},
+	} {
+		key, err := acmeutils.ParsePrivateKeyFromPEM(pem.EncodeToMemory(block))
+		a.IsNil(err)
+
+		// 编码后可以被任务重新解析
+		keyString, err := acmeutils.EncodePrivateKeyToBase64(key)
+		a.IsNil(err)
+		_, err = acmeutils.ParsePrivateKeyFromBase64(keyString)
+		a.IsNil(err)
+	}
+
+	{
+		_, err := acmeutils.ParsePrivateKeyFromPEM([]byte("abc"))
+		a.IsNotNil(err)
+	}
+}
+
+func TestValidateAccountURL(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var provider = acmeutils.FindProviderWithCode(acmeutils.DefaultProviderCode)
+	a.IsNotNil(provider)
+
+	{
+		u, err := acmeutils.ValidateAccountURL(provider, " ")
+		a.IsNil(err)
+		a.IsTrue(len(u) == 0)
+	}
+	{
+		u, err := acmeutils.ValidateAccountURL(provider, " https://acme-v02.api.letsencrypt.org/acme/acct/123456 ")
+		a.IsNil(err)
+		a.IsTrue(u == "https://acme-v02.api.letsencrypt.org/acme/acct/123456")
+	}
+	{
+		_, err := acmeutils.ValidateAccountURL(provider, "http://acme-v02.api.letsencrypt.org/acme/acct/123456")
+		a.IsNotNil(err)
+	}
+	{
+		_, err := acmeutils.ValidateAccountURL(provider, "https://acme.zerossl.com/v2/DV90/account/abc")
+		a.IsNotNil(err)
+	}
+}
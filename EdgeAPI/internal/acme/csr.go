@@ -0,0 +1,87 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// ParseCSR 分析证书签名请求（CSR），支持PEM和DER格式，并校验CSR签名
+// 使用CSR申请证书时，私钥由用户自行保管，不需要保存在系统中
+func ParseCSR(csrData []byte) (*x509.CertificateRequest, error) {
+	if len(csrData) == 0 {
+		return nil, errors.New("csr should not be empty")
+	}
+
+	block, _ := pem.Decode(csrData)
+	if block != nil {
+		if block.Type != "CERTIFICATE REQUEST" && block.Type != "NEW CERTIFICATE REQUEST" {
+			return nil, errors.New("invalid PEM block type '" + block.Type + "', expected 'CERTIFICATE REQUEST'")
+		}
+		csrData = block.Bytes
+	}
+
+	csr, err := x509.ParseCertificateRequest(csrData)
+	if err != nil {
+		return nil, errors.New("parse csr failed: " + err.Error())
+	}
+	err = csr.CheckSignature()
+	if err != nil {
+		return nil, errors.New("invalid csr signature: " + err.Error())
+	}
+	if len(csr.IPAddresses) > 0 {
+		return nil, errors.New("IP addresses in csr are not supported")
+	}
+	return csr, nil
+}
+
+// EncodeCSR 将CSR转换为PEM格式，用于保存
+func EncodeCSR(csr *x509.CertificateRequest) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE REQUEST",
+		Bytes: csr.Raw,
+	})
+}
+
+// CSRDomains 读取CSR中的域名，通用名称（CN）排在第一个
+func CSRDomains(csr *x509.CertificateRequest) ([]string, error) {
+	var domains = []string{}
+	if len(csr.Subject.CommonName) > 0 {
+		domains = append(domains, csr.Subject.CommonName)
+	}
+	domains = append(domains, csr.DNSNames...)
+
+	domains, err := NormalizeDomains(domains)
+	if err != nil {
+		return nil, err
+	}
+	if len(domains) == 0 {
+		return nil, errors.New("no domains found in csr")
+	}
+	return domains, nil
+}
+
+// CheckCSRDomains 检查域名列表是否和CSR中的域名一致（不区分顺序）
+func CheckCSRDomains(csr *x509.CertificateRequest, domains []string) error {
+	csrDomains, err := CSRDomains(csr)
+	if err != nil {
+		return err
+	}
+	domains, err = NormalizeDomains(domains)
+	if err != nil {
+		return err
+	}
+
+	var sortedCSRDomains = append([]string{}, csrDomains...)
+	var sortedDomains = append([]string{}, domains...)
+	sort.Strings(sortedCSRDomains)
+	sort.Strings(sortedDomains)
+	if strings.Join(sortedCSRDomains, ",") != strings.Join(sortedDomains, ",") {
+		return errors.New("domains should be same as the domains in csr: " + strings.Join(csrDomains, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func testCreateCSR(t *testing.T, commonName string, dnsNames []string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: commonName},
+		DNSNames: dnsNames,
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+}
+
+func TestParseCSR(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var csrPEM = testCreateCSR(t, "Example.com", []string{"example.com", "*.example.com"})
+	csr, err := acme.ParseCSR(csrPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	domains, err := acme.CSRDomains(csr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(strings.Join(domains, ",") == "example.com,*.example.com")
+
+	// DER
+	block, _ := pem.Decode(csrPEM)
+	_, err = acme.ParseCSR(block.Bytes)
+	a.IsNil(err)
+
+	// 重新编码
+	csr2, err := acme.ParseCSR(acme.EncodeCSR(csr))
+	a.IsNil(err)
+	a.IsTrue(csr2 != nil && csr2.Subject.CommonName == "Example.com")
+
+	// 错误的数据
+	_, err = acme.ParseCSR([]byte("-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----\n"))
+	a.IsNotNil(err)
+	_, err = acme.ParseCSR(nil)
+	a.IsNotNil(err)
+}
+
+func TestCheckCSRDomains(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	csr, err := acme.ParseCSR(testCreateCSR(t, "", []string{"a.example.com", "b.example.com"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a.IsNil(acme.CheckCSRDomains(csr, []string{"b.example.com", "A.example.com"}))
+	a.IsNotNil(acme.CheckCSRDomains(csr, []string{"a.example.com"}))
+	a.IsNotNil(acme.CheckCSRDomains(csr, []string{"a.example.com", "b.example.com", "c.example.com"}))
+}
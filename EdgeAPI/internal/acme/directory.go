@@ -0,0 +1,44 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// DirectoryURLMaxLength 自定义目录URL最大长度
+const DirectoryURLMaxLength = 255
+
+// NormalizeDirectoryURL 规范化并校验ACME任务自定义的目录URL，为空表示使用服务商的正式目录
+// allowCustom 为false时只能使用服务商的正式或测试目录，避免普通用户让API节点请求任意URL
+func NormalizeDirectoryURL(provider *Provider, directoryURL string, allowCustom bool) (string, error) {
+	directoryURL = strings.TrimSpace(directoryURL)
+	if len(directoryURL) == 0 {
+		return "", nil
+	}
+	if provider != nil {
+		if directoryURL == provider.APIURL {
+			return "", nil
+		}
+		if len(provider.TestAPIURL) > 0 && directoryURL == provider.TestAPIURL {
+			return directoryURL, nil
+		}
+	}
+	if !allowCustom {
+		return "", errors.New("only the test directory of the provider can be used")
+	}
+
+	if len(directoryURL) > DirectoryURLMaxLength {
+		return "", errors.New("directory url is too long")
+	}
+	u, err := url.Parse(directoryURL)
+	if err != nil {
+		return "", errors.New("invalid directory url '" + directoryURL + "': " + err.Error())
+	}
+	if u.Scheme != "https" || len(u.Host) == 0 || u.User != nil {
+		return "", errors.New("invalid directory url '" + directoryURL + "': should be a 'https://' url")
+	}
+	return directoryURL, nil
+}
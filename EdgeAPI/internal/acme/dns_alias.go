@@ -0,0 +1,53 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients/dnstypes"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+)
+
+const dnsChallengeLabel = "_acme-challenge"
+
+// DNSAliasFQDN 别名域名中用来存放TXT记录的完整域名
+func DNSAliasFQDN(aliasDomain string) string {
+	return dns01.ToFqdn(dnsChallengeLabel + "." + strings.Trim(aliasDomain, "."))
+}
+
+// DNSAliasCNAMERecords 使用DNS别名时需要用户在主域名中添加的CNAME记录
+func DNSAliasCNAMERecords(domains []string, aliasDomain string) []*dnstypes.Record {
+	var result = []*dnstypes.Record{}
+	var existNames = map[string]bool{}
+	for _, domain := range domains {
+		var name = dnsChallengeLabel + "." + strings.TrimPrefix(domain, "*.")
+		if existNames[name] {
+			continue
+		}
+		existNames[name] = true
+		result = append(result, &dnstypes.Record{
+			Name:  name,
+			Type:  dnstypes.RecordTypeCNAME,
+			Value: DNSAliasFQDN(aliasDomain),
+		})
+	}
+	return result
+}
+
+// CheckDNSAliasCNAMERecords 检查域名是否已经正确设置了CNAME委托
+func CheckDNSAliasCNAMERecords(domains []string, aliasDomain string) error {
+	var target = strings.ToLower(DNSAliasFQDN(aliasDomain))
+	for _, record := range DNSAliasCNAMERecords(domains, aliasDomain) {
+		cname, err := net.LookupCNAME(record.Name)
+		if err != nil {
+			return fmt.Errorf("lookup CNAME of '%s' failed: %w, please add CNAME record '%s' -> '%s'", record.Name, err, record.Name, record.Value)
+		}
+		if strings.ToLower(dns01.ToFqdn(cname)) != target {
+			return fmt.Errorf("CNAME of '%s' should be '%s', but got '%s'", record.Name, record.Value, cname)
+		}
+	}
+	return nil
+}
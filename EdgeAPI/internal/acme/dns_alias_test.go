@@ -0,0 +1,24 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"testing"
+
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestDNSAliasFQDN(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(DNSAliasFQDN("validation.example.net") == "_acme-challenge.validation.example.net.")
+	a.IsTrue(DNSAliasFQDN("validation.example.net.") == "_acme-challenge.validation.example.net.")
+}
+
+func TestDNSAliasCNAMERecords(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	var records = DNSAliasCNAMERecords([]string{"example.com", "*.example.com", "www.example.org"}, "validation.example.net")
+	a.IsTrue(len(records) == 2)
+	a.IsTrue(records[0].Name == "_acme-challenge.example.com")
+	a.IsTrue(records[0].Value == "_acme-challenge.validation.example.net.")
+	a.IsTrue(records[1].Name == "_acme-challenge.www.example.org")
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/miekg/dns"
+)
+
+const (
+	DNSPropagationTimeout  = 5 * time.Minute // 等待TXT记录生效的最长时间
+	DNSPropagationInterval = 5 * time.Second // 检查间隔
+)
+
+// DefaultPublicDNSResolvers 默认检查的公共DNS服务器
+var DefaultPublicDNSResolvers = []string{
+	"223.5.5.5:53",    // AliDNS
+	"119.29.29.29:53", // DNSPod
+	"8.8.8.8:53",      // Google
+	"1.1.1.1:53",      // Cloudflare
+}
+
+// DNSPropagationChecker 检查TXT记录是否已经在权威DNS服务器和公共DNS服务器上生效
+// 在请求CA验证之前确认记录已经生效，可以减少因为DNS服务商同步较慢导致的验证失败
+type DNSPropagationChecker struct {
+	client          *dns.Client
+	publicResolvers []string
+
+	// 查找权威DNS服务器地址，返回 ip:port 列表
+	lookupAuthoritativeServers func(fqdn string) ([]string, error)
+}
+
+func NewDNSPropagationChecker(publicResolvers []string) *DNSPropagationChecker {
+	return &DNSPropagationChecker{
+		client: &dns.Client{
+			Timeout: 5 * time.Second,
+		},
+		publicResolvers:            publicResolvers,
+		lookupAuthoritativeServers: lookupAuthoritativeServers,
+	}
+}
+
+// Check 检查记录是否已经生效
+// 权威DNS服务器必须全部返回正确的记录；公共DNS服务器中无法连接的会被忽略，能连接的必须返回正确的记录
+// 没有生效时返回的错误中包含原因，方便在超时后排查问题
+func (this *DNSPropagationChecker) Check(fqdn string, value string) (ok bool, err error) {
+	fqdn = dns01.ToFqdn(fqdn)
+
+	// 权威DNS服务器
+	servers, err := this.lookupAuthoritativeServers(fqdn)
+	if err != nil {
+		return false, fmt.Errorf("lookup authoritative nameservers of '%s' failed: %w", fqdn, err)
+	}
+	if len(servers) == 0 {
+		return false, errors.New("could not find authoritative nameservers of '" + fqdn + "'")
+	}
+	for _, server := range servers {
+		found, lookupErr := this.lookupTXT(fqdn, value, server, false)
+		if lookupErr != nil {
+			return false, fmt.Errorf("query authoritative nameserver '%s' failed: %w", server, lookupErr)
+		}
+		if !found {
+			return false, fmt.Errorf("TXT record of '%s' has not been propagated to authoritative nameserver '%s'", fqdn, server)
+		}
+	}
+
+	// 公共DNS服务器
+	var wg = &sync.WaitGroup{}
+	var locker = &sync.Mutex{}
+	var missingResolvers = []string{}
+	for _, resolver := range this.publicResolvers {
+		wg.Add(1)
+		go func(resolver string) {
+			defer wg.Done()
+
+			found, lookupErr := this.lookupTXT(fqdn, value, resolver, true)
+			if lookupErr != nil {
+				// 有些网络环境下无法访问部分公共DNS，这里直接忽略
+				return
+			}
+			if !found {
+				locker.Lock()
+				missingResolvers = append(missingResolvers, resolver)
+				locker.Unlock()
+			}
+		}(resolver)
+	}
+	wg.Wait()
+
+	if len(missingResolvers) > 0 {
+		return false, fmt.Errorf("TXT record of '%s' has not been propagated to public resolvers '%s'", fqdn, strings.Join(missingResolvers, ", "))
+	}
+
+	return true, nil
+}
+
+// PreCheck 用于设置 dns01.WrapPreCheck()
+// 如果设置了 checkFQDN，则检查 checkFQDN 而不是CA要求的域名，比如使用DNS别名时
+func (this *DNSPropagationChecker) PreCheck(checkFQDN string) dns01.ChallengeOption {
+	return dns01.WrapPreCheck(func(domain, fqdn, value string, check dns01.PreCheckFunc) (bool, error) {
+		if len(checkFQDN) > 0 {
+			fqdn = checkFQDN
+		}
+		return this.Check(fqdn, value)
+	})
+}
+
+// 查询TXT记录中是否包含某个值
+func (this *DNSPropagationChecker) lookupTXT(fqdn string, value string, server string, recursive bool) (bool, error) {
+	var m = new(dns.Msg)
+	m.SetQuestion(fqdn, dns.TypeTXT)
+	m.RecursionDesired = recursive
+
+	r, _, err := this.client.Exchange(m, server)
+	if err != nil {
+		return false, err
+	}
+	if r.Rcode != dns.RcodeSuccess && r.Rcode != dns.RcodeNameError {
+		return false, errors.New("unexpected response code '" + dns.RcodeToString[r.Rcode] + "'")
+	}
+
+	for _, answer := range r.Answer {
+		txt, ok := answer.(*dns.TXT)
+		if !ok {
+			continue
+		}
+		if strings.Join(txt.Txt, "") == value {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// 查找域名所在区域的权威DNS服务器地址
+func lookupAuthoritativeServers(fqdn string) ([]string, error) {
+	zone, err := dns01.FindZoneByFqdn(fqdn)
+	if err != nil {
+		return nil, err
+	}
+
+	nsRecords, err := net.LookupNS(dns01.UnFqdn(zone))
+	if err != nil {
+		return nil, err
+	}
+
+	var result = []string{}
+	for _, ns := range nsRecords {
+		ips, lookupErr := net.LookupHost(ns.Host)
+		if lookupErr != nil || len(ips) == 0 {
+			continue
+		}
+
+		// 每个NS只检查一个地址即可
+		result = append(result, net.JoinHostPort(ips[0], "53"))
+	}
+	return result, nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iwind/TeaGo/assert"
+	"github.com/miekg/dns"
+)
+
+// 本地测试用的DNS服务器
+type testTXTServer struct {
+	server *dns.Server
+	addr   string
+
+	locker sync.Mutex
+	values map[string]string // fqdn => value
+}
+
+func startTestTXTServer(t *testing.T) *testTXTServer {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var s = &testTXTServer{
+		addr:   conn.LocalAddr().String(),
+		values: map[string]string{},
+	}
+	s.server = &dns.Server{
+		PacketConn: conn,
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			var m = new(dns.Msg)
+			m.SetReply(r)
+
+			s.locker.Lock()
+			value, ok := s.values[r.Question[0].Name]
+			s.locker.Unlock()
+			if ok {
+				m.Answer = append(m.Answer, &dns.TXT{
+					Hdr: dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: 60},
+					Txt: []string{value},
+				})
+			} else {
+				m.Rcode = dns.RcodeNameError
+			}
+			_ = w.WriteMsg(m)
+		}),
+	}
+
+	var started = make(chan struct{})
+	s.server.NotifyStartedFunc = func() {
+		close(started)
+	}
+	go func() {
+		_ = s.server.ActivateAndServe()
+	}()
+	<-started
+
+	t.Cleanup(func() {
+		_ = s.server.Shutdown()
+	})
+	return s
+}
+
+func (this *testTXTServer) Set(fqdn string, value string) {
+	this.locker.Lock()
+	this.values[fqdn] = value
+	this.locker.Unlock()
+}
+
+func newTestPropagationChecker(authoritativeServers []string, publicResolvers []string) *DNSPropagationChecker {
+	var checker = NewDNSPropagationChecker(publicResolvers)
+	checker.lookupAuthoritativeServers = func(fqdn string) ([]string, error) {
+		return authoritativeServers, nil
+	}
+	return checker
+}
+
+func TestDNSPropagationChecker_Check(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var authoritative = startTestTXTServer(t)
+	var public = startTestTXTServer(t)
+	var checker = newTestPropagationChecker([]string{authoritative.addr}, []string{public.addr})
+
+	const fqdn = "_acme-challenge.example.com."
+
+	// 还没有设置
+	ok, err := checker.Check(fqdn, "abc")
+	a.IsFalse(ok)
+	a.IsNotNil(err)
+	t.Log(err)
+
+	// 只在权威DNS上生效
+	authoritative.Set(fqdn, "abc")
+	ok, err = checker.Check(fqdn, "abc")
+	a.IsFalse(ok)
+	a.IsNotNil(err)
+	t.Log(err)
+
+	// 公共DNS上的值不一致
+	public.Set(fqdn, "old")
+	ok, _ = checker.Check(fqdn, "abc")
+	a.IsFalse(ok)
+
+	// 全部生效
+	public.Set(fqdn, "abc")
+	ok, err = checker.Check(fqdn, "abc")
+	a.IsTrue(ok)
+	a.IsNil(err)
+
+	// 不带最后的点
+	ok, _ = checker.Check("_acme-challenge.example.com", "abc")
+	a.IsTrue(ok)
+}
+
+func TestDNSPropagationChecker_UnreachablePublicResolver(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var authoritative = startTestTXTServer(t)
+	authoritative.Set("_acme-challenge.example.com.", "abc")
+
+	// 找一个没有监听的端口
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var unreachableAddr = conn.LocalAddr().String()
+	_ = conn.Close()
+
+	var checker = newTestPropagationChecker([]string{authoritative.addr}, []string{unreachableAddr})
+	checker.client.Timeout = 200 * time.Millisecond
+
+	ok, err := checker.Check("_acme-challenge.example.com.", "abc")
+	a.IsTrue(ok)
+	a.IsNil(err)
+}
+
+func TestDNSPropagationChecker_NoAuthoritativeServers(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var checker = newTestPropagationChecker(nil, nil)
+	ok, err := checker.Check("_acme-challenge.example.com.", "abc")
+	a.IsFalse(ok)
+	a.IsNotNil(err)
+}
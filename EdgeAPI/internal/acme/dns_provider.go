@@ -17,6 +17,7 @@ import (
 type DNSProvider struct {
 	raw       dnsclients.ProviderInterface
 	dnsDomain string
+	isAlias   bool
 
 	locker             sync.Mutex
 	deletedRecordNames []string
@@ -29,12 +30,24 @@ func NewDNSProvider(raw dnsclients.ProviderInterface, dnsDomain string) *DNSProv
 	}
 }
 
+// NewDNSAliasProvider 使用DNS别名（CNAME委托）模式，TXT记录统一添加到别名域名中
+func NewDNSAliasProvider(raw dnsclients.ProviderInterface, aliasDomain string) *DNSProvider {
+	return &DNSProvider{
+		raw:       raw,
+		dnsDomain: aliasDomain,
+		isAlias:   true,
+	}
+}
+
 func (this *DNSProvider) Present(domain, token, keyAuth string) error {
 	_ = os.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
 	var info = dns01.GetChallengeInfo(domain, keyAuth)
 
 	var fqdn = info.EffectiveFQDN
 	var value = info.Value
+	if this.isAlias {
+		fqdn = DNSAliasFQDN(this.dnsDomain)
+	}
 
 	// 设置记录
 	var index = strings.Index(fqdn, "."+this.dnsDomain)
@@ -81,7 +94,7 @@ func (this *DNSProvider) Present(domain, token, keyAuth string) error {
 }
 
 func (this *DNSProvider) Timeout() (timeout, interval time.Duration) {
-	return 2 * time.Minute, 2 * time.Second
+	return DNSPropagationTimeout, DNSPropagationInterval
 }
 
 func (this *DNSProvider) CleanUp(domain, token, keyAuth string) error {
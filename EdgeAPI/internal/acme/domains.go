@@ -0,0 +1,52 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"strings"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
+)
+
+// NormalizeDomains 规范化证书域名列表：转换为小写、去除末尾的点（.）、国际化域名转换为Punycode格式，并去除重复的域名
+// 保持域名原来的顺序，第一个域名会作为证书的通用名称（CN）
+func NormalizeDomains(domains []string) ([]string, error) {
+	var result = []string{}
+	var domainMap = map[string]bool{}
+	for _, domain := range domains {
+		domain, err := configutils.NormalizeDomain(domain)
+		if err != nil {
+			return nil, err
+		}
+		if len(domain) == 0 || domainMap[domain] {
+			continue
+		}
+		domainMap[domain] = true
+		result = append(result, domain)
+	}
+	return result, nil
+}
+
+// IncludeWildcardApex 为通配符域名补充对应的主域名，比如 *.example.com 补充 example.com
+// 补充的主域名紧跟在通配符域名之后，已经存在的不会重复添加
+func IncludeWildcardApex(domains []string) []string {
+	var domainMap = map[string]bool{}
+	for _, domain := range domains {
+		domainMap[domain] = true
+	}
+
+	var result = []string{}
+	for _, domain := range domains {
+		result = append(result, domain)
+		if !strings.HasPrefix(domain, "*.") {
+			continue
+		}
+		var apex = domain[2:]
+		if len(apex) == 0 || domainMap[apex] {
+			continue
+		}
+		domainMap[apex] = true
+		result = append(result, apex)
+	}
+	return result
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme_test
+
+import (
+	"strings"
+	"testing"
+
+	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestIncludeWildcardApex(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	for _, testCase := range []struct {
+		domains []string
+		expect  string
+	}{
+		{[]string{"*.example.com"}, "*.example.com,example.com"},
+		{[]string{"*.example.com", "example.com"}, "*.example.com,example.com"},
+		{[]string{"example.com", "*.example.com"}, "example.com,*.example.com"},
+		{[]string{"*.a.example.com", "www.example.com", "*.example.com"}, "*.a.example.com,a.example.com,www.example.com,*.example.com,example.com"},
+		{[]string{"www.example.com"}, "www.example.com"},
+		{[]string{}, ""},
+	} {
+		var result = strings.Join(acmeutils.IncludeWildcardApex(testCase.domains), ",")
+		a.IsTrue(result == testCase.expect)
+		if result != testCase.expect {
+			t.Log(result)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+)
+
+// DryRunReport 试运行诊断报告
+type DryRunReport struct {
+	IsOk         bool           `json:"isOk"`         // 是否所有步骤都成功
+	Error        string         `json:"error"`        // 失败原因
+	DirectoryURL string         `json:"directoryURL"` // 实际使用的测试目录
+	AuthType     AuthType       `json:"authType"`     // 认证方式
+	Domains      []string       `json:"domains"`      // 域名
+	Steps        []*RequestStep `json:"steps"`        // 执行的步骤
+
+	// 测试环境签发的证书信息，证书本身不会被保存
+	CertDNSNames  []string `json:"certDNSNames"`
+	CertIssuer    string   `json:"certIssuer"`
+	CertExpiresAt int64    `json:"certExpiresAt"`
+
+	locker sync.Mutex
+}
+
+func (this *DryRunReport) addStep(step *RequestStep) {
+	// 验证时可能有多个域名同时执行
+	this.locker.Lock()
+	this.Steps = append(this.Steps, step)
+	this.locker.Unlock()
+}
+
+func (this *DryRunReport) fail(err error) *DryRunReport {
+	this.IsOk = false
+	this.Error = err.Error()
+	return this
+}
+
+// DryRun 在CA的测试环境中执行一次完整的验证和下单流程，但不保存证书
+// 用于在不影响正式环境频率限制的情况下排查DNS/HTTP验证问题
+func (this *Request) DryRun() *DryRunReport {
+	var report = &DryRunReport{
+		AuthType: this.task.AuthType,
+		Domains:  this.task.Domains,
+		Steps:    []*RequestStep{},
+	}
+
+	if this.task.Provider == nil {
+		return report.fail(errors.New("provider should not be nil"))
+	}
+
+	// 只能使用测试目录
+	var directoryURL = this.task.Provider.TestAPIURL
+	if len(directoryURL) == 0 && this.task.IsCustomDirectory() {
+		directoryURL = this.task.DirectoryURL
+	}
+	if len(directoryURL) == 0 {
+		return report.fail(errors.New("ACME provider '" + this.task.Provider.Name + "' does not have a staging directory"))
+	}
+	this.task.DirectoryURL = directoryURL
+	report.DirectoryURL = directoryURL
+
+	this.report = report
+	defer func() {
+		this.report = nil
+	}()
+
+	var startedAt = time.Now()
+	certData, keyData, err := this.Run()
+	if err != nil {
+		return report.fail(err)
+	}
+
+	// 分析测试证书
+	var sslConfig = &sslconfigs.SSLCertConfig{
+		CertData: certData,
+		KeyData:  keyData,
+	}
+	err = sslConfig.Init(context.Background())
+	if err != nil {
+		report.addStep(NewRequestStep("parse certificate", startedAt, "", err))
+		return report.fail(err)
+	}
+	report.CertDNSNames = sslConfig.DNSNames
+	report.CertExpiresAt = sslConfig.TimeEndAt
+	if len(sslConfig.CommonNames) > 0 {
+		report.CertIssuer = sslConfig.CommonNames[0] // 叶子证书的签发者
+	}
+
+	report.IsOk = true
+	return report
+}
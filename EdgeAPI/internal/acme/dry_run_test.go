@@ -0,0 +1,35 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme_test
+
+import (
+	"testing"
+
+	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestRequest_DryRun_NoStagingDirectory(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	{
+		var report = acmeutils.NewRequest(&acmeutils.Task{
+			AuthType: acmeutils.AuthTypeHTTP,
+			Domains:  []string{"example.com"},
+		}).DryRun()
+		a.IsFalse(report.IsOk)
+		t.Log(report.Error)
+	}
+
+	// ZeroSSL没有测试目录
+	{
+		var report = acmeutils.NewRequest(&acmeutils.Task{
+			Provider: acmeutils.FindProviderWithCode("zerossl"),
+			AuthType: acmeutils.AuthTypeHTTP,
+			Domains:  []string{"example.com"},
+		}).DryRun()
+		a.IsFalse(report.IsOk)
+		a.IsTrue(len(report.Steps) == 0)
+		t.Log(report.Error)
+	}
+}
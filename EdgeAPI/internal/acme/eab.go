@@ -0,0 +1,121 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+)
+
+const zeroSSLEABCredentialsURL = "https://api.zerossl.com/acme/eab-credentials"
+
+// GenerateEAB 使用服务商API Key自动生成EAB
+func GenerateEAB(providerCode string, apiKey string) (kid string, hmacKey string, err error) {
+	var provider = FindProviderWithCode(providerCode)
+	if provider == nil {
+		return "", "", errors.New("can not find provider '" + providerCode + "'")
+	}
+	if !provider.CanGenerateEAB {
+		return "", "", errors.New("provider '" + provider.Name + "' does not support generating EAB")
+	}
+
+	apiKey = strings.TrimSpace(apiKey)
+	if len(apiKey) == 0 {
+		return "", "", errors.New("'apiKey' should not be empty")
+	}
+
+	switch providerCode {
+	case "zerossl":
+		return generateZeroSSLEAB(apiKey)
+	}
+	return "", "", errors.New("provider '" + provider.Name + "' does not support generating EAB")
+}
+
+// ValidateEAB 校验EAB格式
+// 不会调用服务商接口，因为部分服务商（比如Google Trust Services）的EAB只能使用一次
+func ValidateEAB(kid string, hmacKey string) error {
+	if len(kid) == 0 {
+		return errors.New("'eabKid' should not be empty")
+	}
+	if strings.ContainsAny(kid, " \t\r\n") {
+		return errors.New("'eabKid' should not contain spaces")
+	}
+	if len(hmacKey) == 0 {
+		return errors.New("'eabKey' should not be empty")
+	}
+
+	// 和lego保持一致，使用无填充的Base64URL解码
+	key, err := base64.RawURLEncoding.DecodeString(hmacKey)
+	if err != nil {
+		return errors.New("'eabKey' should be a base64url encoded string: " + err.Error())
+	}
+	if len(key) < 16 {
+		return errors.New("'eabKey' is too short")
+	}
+	return nil
+}
+
+// 调用ZeroSSL接口生成EAB
+// 文档：https://zerossl.com/documentation/acme/generate-eab-credentials/
+func generateZeroSSLEAB(apiKey string) (kid string, hmacKey string, err error) {
+	req, err := http.NewRequest(http.MethodPost, zeroSSLEABCredentialsURL+"?access_key="+url.QueryEscape(apiKey), nil)
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("User-Agent", teaconst.ProductName+"/"+teaconst.Version)
+
+	resp, err := utils.SharedHttpClient(30 * time.Second).Do(req)
+	if err != nil {
+		return "", "", fmt.Errorf("request ZeroSSL API failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return "", "", fmt.Errorf("read ZeroSSL API response failed: %w", err)
+	}
+
+	var result = &struct {
+		Success    bool   `json:"success"`
+		EABKid     string `json:"eab_kid"`
+		EABHmacKey string `json:"eab_hmac_key"`
+		Error      *struct {
+			Code int    `json:"code"`
+			Type string `json:"type"`
+			Info string `json:"info"`
+		} `json:"error"`
+	}{}
+	err = json.Unmarshal(data, result)
+	if err != nil {
+		return "", "", errors.New("decode ZeroSSL API response failed: " + err.Error() + ", status: " + resp.Status)
+	}
+
+	if !result.Success {
+		if result.Error != nil {
+			var message = result.Error.Type
+			if len(result.Error.Info) > 0 {
+				message += ": " + result.Error.Info
+			}
+			return "", "", fmt.Errorf("ZeroSSL API returns error (%d): %s", result.Error.Code, message)
+		}
+		return "", "", errors.New("ZeroSSL API returns error, status: " + resp.Status)
+	}
+
+	if len(result.EABKid) == 0 || len(result.EABHmacKey) == 0 {
+		return "", "", errors.New("ZeroSSL API returns empty EAB credentials")
+	}
+
+	return result.EABKid, result.EABHmacKey, nil
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"testing"
+
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestValidateEAB(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsNil(ValidateEAB("f8f1b1b6a3c1", "c2VjcmV0LWtleS1mb3ItdGVzdGluZy1vbmx5LTEyMzQ1Njc4OTA"))
+	a.IsNotNil(ValidateEAB("", "c2VjcmV0LWtleS1mb3ItdGVzdGluZy1vbmx5LTEyMzQ1Njc4OTA"))
+	a.IsNotNil(ValidateEAB("f8f1 b1b6", "c2VjcmV0LWtleS1mb3ItdGVzdGluZy1vbmx5LTEyMzQ1Njc4OTA"))
+	a.IsNotNil(ValidateEAB("f8f1b1b6a3c1", ""))
+	a.IsNotNil(ValidateEAB("f8f1b1b6a3c1", "not base64!"))
+	a.IsNotNil(ValidateEAB("f8f1b1b6a3c1", "c2hvcnQ"))
+}
+
+func TestFindProviderWithCode_GoogleTrust(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	var provider = FindProviderWithCode("googletrust")
+	a.IsNotNil(provider)
+	a.IsTrue(provider.RequireEAB)
+	a.IsFalse(provider.CanGenerateEAB)
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import "time"
+
+// 签发优先级，数值越大越先签发
+const (
+	IssuePriorityRenewal  = 1 // 常规续期
+	IssuePriorityNew      = 2 // 新申请
+	IssuePriorityExpiring = 3 // 即将过期的证书续期
+)
+
+// IssueExpiringDays 证书在多少天内过期时使用最高优先级
+const IssueExpiringDays = 3
+
+// IssuePriority 计算任务的签发优先级
+// certExpiresAt 为当前证书的过期时间，新申请时为0
+func IssuePriority(isRenewal bool, certExpiresAt int64) int {
+	if !isRenewal {
+		return IssuePriorityNew
+	}
+	if certExpiresAt > 0 && certExpiresAt-time.Now().Unix() <= IssueExpiringDays*86400 {
+		return IssuePriorityExpiring
+	}
+	return IssuePriorityRenewal
+}
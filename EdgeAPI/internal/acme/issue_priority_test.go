@@ -0,0 +1,22 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme_test
+
+import (
+	"testing"
+	"time"
+
+	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestIssuePriority(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	var now = time.Now().Unix()
+
+	a.IsTrue(acmeutils.IssuePriority(false, 0) == acmeutils.IssuePriorityNew)
+	a.IsTrue(acmeutils.IssuePriority(true, now+30*86400) == acmeutils.IssuePriorityRenewal)
+	a.IsTrue(acmeutils.IssuePriority(true, now+2*86400) == acmeutils.IssuePriorityExpiring)
+	a.IsTrue(acmeutils.IssuePriority(true, now-3600) == acmeutils.IssuePriorityExpiring)
+	a.IsTrue(acmeutils.IssuePriorityExpiring > acmeutils.IssuePriorityNew && acmeutils.IssuePriorityNew > acmeutils.IssuePriorityRenewal)
+}
@@ -0,0 +1,25 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import "time"
+
+// IssueRetryDelays 签发失败后的重试间隔，超出部分使用最后一个间隔
+var IssueRetryDelays = []time.Duration{
+	1 * time.Hour,
+	6 * time.Hour,
+	24 * time.Hour,
+}
+
+// NextIssueRetryDelay 计算下次重试的间隔
+// failures 为已经失败的次数，如果超出 maxRetries 则返回0，表示不再重试
+func NextIssueRetryDelay(failures int, maxRetries int) time.Duration {
+	if failures <= 0 || failures > maxRetries || len(IssueRetryDelays) == 0 {
+		return 0
+	}
+	var index = failures - 1
+	if index >= len(IssueRetryDelays) {
+		index = len(IssueRetryDelays) - 1
+	}
+	return IssueRetryDelays[index]
+}
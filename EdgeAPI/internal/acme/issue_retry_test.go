@@ -0,0 +1,22 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme_test
+
+import (
+	"testing"
+	"time"
+
+	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestNextIssueRetryDelay(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(acmeutils.NextIssueRetryDelay(0, 5) == 0)
+	a.IsTrue(acmeutils.NextIssueRetryDelay(1, 5) == 1*time.Hour)
+	a.IsTrue(acmeutils.NextIssueRetryDelay(2, 5) == 6*time.Hour)
+	a.IsTrue(acmeutils.NextIssueRetryDelay(3, 5) == 24*time.Hour)
+	a.IsTrue(acmeutils.NextIssueRetryDelay(5, 5) == 24*time.Hour)
+	a.IsTrue(acmeutils.NextIssueRetryDelay(6, 5) == 0)
+	a.IsTrue(acmeutils.NextIssueRetryDelay(1, 0) == 0)
+}
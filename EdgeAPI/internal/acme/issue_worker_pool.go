@@ -0,0 +1,189 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"sync"
+)
+
+// IssueJob 签发任务
+type IssueJob struct {
+	TaskId        int64    // ACME任务ID
+	Domains       []string // 签发的域名
+	ProviderCode  string   // ACME服务商代号
+	AccountId     int64    // ACME账号（用户）ID
+	DNSProviderId int64    // DNS服务商ID，HTTP认证时为0
+	Priority      int      // 优先级，数值越大越先执行
+}
+
+// IssueLimits 并发限制，0表示不限制
+type IssueLimits struct {
+	Concurrency       int // 总并发数
+	MaxPerProvider    int // 每个ACME服务商的并发数
+	MaxPerAccount     int // 每个ACME账号的并发数
+	MaxPerDNSProvider int // 每个DNS服务商的并发数
+}
+
+// IssueWorkerPool 证书签发队列
+// 任务按优先级从高到低执行，同一优先级的按加入顺序执行，某个服务商或账号达到并发上限时，跳过其任务继续执行后面的任务，避免批量续期时同时请求CA或DNS服务商
+type IssueWorkerPool struct {
+	limits  IssueLimits
+	runFunc func(job *IssueJob)
+
+	pendingJobs []*IssueJob
+	taskIdMap   map[int64]bool // 排队和执行中的任务ID
+
+	countRunning      int
+	providerCounts    map[string]int
+	accountCounts     map[int64]int
+	dnsProviderCounts map[int64]int
+
+	locker sync.Mutex
+}
+
+func NewIssueWorkerPool(limits IssueLimits, runFunc func(job *IssueJob)) *IssueWorkerPool {
+	return &IssueWorkerPool{
+		limits:            limits,
+		runFunc:           runFunc,
+		taskIdMap:         map[int64]bool{},
+		providerCounts:    map[string]int{},
+		accountCounts:     map[int64]int{},
+		dnsProviderCounts: map[int64]int{},
+	}
+}
+
+// UpdateLimits 修改并发限制
+// 已经在执行的任务不受影响
+func (this *IssueWorkerPool) UpdateLimits(limits IssueLimits) {
+	this.locker.Lock()
+	this.limits = limits
+	this.dispatch()
+	this.locker.Unlock()
+}
+
+// Push 加入任务
+// 如果任务已经在排队或者执行中，则返回false
+func (this *IssueWorkerPool) Push(job *IssueJob) bool {
+	if job == nil {
+		return false
+	}
+
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	if this.taskIdMap[job.TaskId] {
+		return false
+	}
+	this.taskIdMap[job.TaskId] = true
+
+	// 插入到第一个优先级比它低的任务前面
+	var index = len(this.pendingJobs)
+	for i, pendingJob := range this.pendingJobs {
+		if pendingJob.Priority < job.Priority {
+			index = i
+			break
+		}
+	}
+	this.pendingJobs = append(this.pendingJobs, nil)
+	copy(this.pendingJobs[index+1:], this.pendingJobs[index:])
+	this.pendingJobs[index] = job
+
+	this.dispatch()
+	return true
+}
+
+// TaskIds 排队和执行中的任务ID
+func (this *IssueWorkerPool) TaskIds() []int64 {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	var result = make([]int64, 0, len(this.taskIdMap))
+	for taskId := range this.taskIdMap {
+		result = append(result, taskId)
+	}
+	return result
+}
+
+// CountPending 排队中的任务数
+func (this *IssueWorkerPool) CountPending() int {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return len(this.pendingJobs)
+}
+
+// CountRunning 执行中的任务数
+func (this *IssueWorkerPool) CountRunning() int {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return this.countRunning
+}
+
+// 启动可以执行的任务，调用前需要加锁
+func (this *IssueWorkerPool) dispatch() {
+	var remainingJobs = this.pendingJobs[:0]
+	for _, job := range this.pendingJobs {
+		if !this.canRun(job) {
+			remainingJobs = append(remainingJobs, job)
+			continue
+		}
+
+		this.countRunning++
+		this.providerCounts[job.ProviderCode]++
+		this.accountCounts[job.AccountId]++
+		if job.DNSProviderId > 0 {
+			this.dnsProviderCounts[job.DNSProviderId]++
+		}
+
+		go this.run(job)
+	}
+
+	// 释放引用
+	for i := len(remainingJobs); i < len(this.pendingJobs); i++ {
+		this.pendingJobs[i] = nil
+	}
+	this.pendingJobs = remainingJobs
+}
+
+// 检查是否可以执行任务，调用前需要加锁
+func (this *IssueWorkerPool) canRun(job *IssueJob) bool {
+	if this.limits.Concurrency > 0 && this.countRunning >= this.limits.Concurrency {
+		return false
+	}
+	if this.limits.MaxPerProvider > 0 && this.providerCounts[job.ProviderCode] >= this.limits.MaxPerProvider {
+		return false
+	}
+	if this.limits.MaxPerAccount > 0 && this.accountCounts[job.AccountId] >= this.limits.MaxPerAccount {
+		return false
+	}
+	if job.DNSProviderId > 0 && this.limits.MaxPerDNSProvider > 0 && this.dnsProviderCounts[job.DNSProviderId] >= this.limits.MaxPerDNSProvider {
+		return false
+	}
+	return true
+}
+
+func (this *IssueWorkerPool) run(job *IssueJob) {
+	defer func() {
+		this.locker.Lock()
+		defer this.locker.Unlock()
+
+		this.countRunning--
+		decreaseCount(this.providerCounts, job.ProviderCode)
+		decreaseCount(this.accountCounts, job.AccountId)
+		if job.DNSProviderId > 0 {
+			decreaseCount(this.dnsProviderCounts, job.DNSProviderId)
+		}
+		delete(this.taskIdMap, job.TaskId)
+
+		this.dispatch()
+	}()
+
+	this.runFunc(job)
+}
+
+func decreaseCount[K comparable](m map[K]int, key K) {
+	if m[key] <= 1 {
+		delete(m, key)
+	} else {
+		m[key]--
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/types"
+)
+
+// 记录执行中任务的最大并发数
+type testConcurrencyRecorder struct {
+	locker  sync.Mutex
+	current map[string]int
+	max     map[string]int
+}
+
+func newTestConcurrencyRecorder() *testConcurrencyRecorder {
+	return &testConcurrencyRecorder{
+		current: map[string]int{},
+		max:     map[string]int{},
+	}
+}
+
+func (this *testConcurrencyRecorder) Begin(keys ...string) {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	for _, key := range keys {
+		this.current[key]++
+		if this.current[key] > this.max[key] {
+			this.max[key] = this.current[key]
+		}
+	}
+}
+
+func (this *testConcurrencyRecorder) End(keys ...string) {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	for _, key := range keys {
+		this.current[key]--
+	}
+}
+
+func (this *testConcurrencyRecorder) Max(key string) int {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return this.max[key]
+}
+
+func TestIssueWorkerPool_Limits(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var recorder = newTestConcurrencyRecorder()
+	var wg = &sync.WaitGroup{}
+	var pool = acme.NewIssueWorkerPool(acme.IssueLimits{
+		Concurrency:       4,
+		MaxPerProvider:    3,
+		MaxPerAccount:     1,
+		MaxPerDNSProvider: 2,
+	}, func(job *acme.IssueJob) {
+		defer wg.Done()
+
+		var keys = []string{"all", "provider:" + job.ProviderCode, "account:" + types.String(job.AccountId)}
+		if job.DNSProviderId > 0 {
+			keys = append(keys, "dns:"+types.String(job.DNSProviderId))
+		}
+		recorder.Begin(keys...)
+		time.Sleep(10 * time.Millisecond)
+		recorder.End(keys...)
+	})
+
+	var providers = []string{"letsencrypt", "zerossl"}
+	for i := 1; i <= 40; i++ {
+		wg.Add(1)
+		a.IsTrue(pool.Push(&acme.IssueJob{
+			TaskId:        int64(i),
+			ProviderCode:  providers[i%2],
+			AccountId:     int64(i % 5),
+			DNSProviderId: int64(i % 3),
+		}))
+	}
+	wg.Wait()
+
+	a.IsTrue(recorder.Max("all") <= 4)
+	a.IsTrue(recorder.Max("provider:letsencrypt") <= 3)
+	a.IsTrue(recorder.Max("provider:zerossl") <= 3)
+	for _, accountKey := range []string{"account:0", "account:1", "account:2", "account:3", "account:4"} {
+		a.IsTrue(recorder.Max(accountKey) == 1)
+	}
+	a.IsTrue(recorder.Max("dns:1") <= 2)
+	a.IsTrue(recorder.Max("dns:2") <= 2)
+	a.IsTrue(pool.CountPending() == 0)
+
+	// 任务结束后计数需要释放
+	for i := 0; i < 100 && pool.CountRunning() > 0; i++ {
+		time.Sleep(5 * time.Millisecond)
+	}
+	a.IsTrue(pool.CountRunning() == 0)
+	a.IsTrue(len(pool.TaskIds()) == 0)
+}
+
+func TestIssueWorkerPool_Duplicate(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var release = make(chan struct{})
+	var countRuns int32
+	var pool = acme.NewIssueWorkerPool(acme.IssueLimits{Concurrency: 1}, func(job *acme.IssueJob) {
+		atomic.AddInt32(&countRuns, 1)
+		<-release
+	})
+
+	a.IsTrue(pool.Push(&acme.IssueJob{TaskId: 1}))
+	a.IsTrue(pool.Push(&acme.IssueJob{TaskId: 2}))
+	a.IsFalse(pool.Push(&acme.IssueJob{TaskId: 1})) // 执行中
+	a.IsFalse(pool.Push(&acme.IssueJob{TaskId: 2})) // 排队中
+	a.IsTrue(pool.CountRunning() == 1)
+	a.IsTrue(pool.CountPending() == 1)
+	a.IsTrue(len(pool.TaskIds()) == 2)
+
+	close(release)
+	for i := 0; i < 100 && len(pool.TaskIds()) > 0; i++ {
+		time.Sleep(5 * time.Millisecond)
+	}
+	a.IsTrue(atomic.LoadInt32(&countRuns) == 2)
+	a.IsTrue(len(pool.TaskIds()) == 0)
+}
+
+func TestIssueWorkerPool_SkipBlockedJobs(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var release = make(chan struct{})
+	var started = make(chan int64, 10)
+	var pool = acme.NewIssueWorkerPool(acme.IssueLimits{Concurrency: 10, MaxPerAccount: 1}, func(job *acme.IssueJob) {
+		started <- job.TaskId
+		<-release
+	})
+
+	pool.Push(&acme.IssueJob{TaskId: 1, AccountId: 1})
+	pool.Push(&acme.IssueJob{TaskId: 2, AccountId: 1}) // 被同一个账号阻塞
+	pool.Push(&acme.IssueJob{TaskId: 3, AccountId: 2}) // 不受影响
+
+	var startedIds = map[int64]bool{}
+	for i := 0; i < 2; i++ {
+		select {
+		case taskId := <-started:
+			startedIds[taskId] = true
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+	}
+	a.IsTrue(startedIds[1] && startedIds[3])
+	a.IsTrue(pool.CountPending() == 1)
+
+	// 放宽限制后，排队中的任务立即执行
+	pool.UpdateLimits(acme.IssueLimits{Concurrency: 10})
+	select {
+	case taskId := <-started:
+		a.IsTrue(taskId == 2)
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+	close(release)
+}
+
+func TestIssueWorkerPool_Priority(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var release = make(chan struct{})
+	var started = make(chan int64, 10)
+	var pool = acme.NewIssueWorkerPool(acme.IssueLimits{Concurrency: 1}, func(job *acme.IssueJob) {
+		started <- job.TaskId
+		<-release
+	})
+
+	// 第一个任务占用唯一的并发，其余任务排队
+	pool.Push(&acme.IssueJob{TaskId: 1, Priority: acme.IssuePriorityRenewal})
+	pool.Push(&acme.IssueJob{TaskId: 2, Priority: acme.IssuePriorityRenewal})
+	pool.Push(&acme.IssueJob{TaskId: 3, Priority: acme.IssuePriorityNew})
+	pool.Push(&acme.IssueJob{TaskId: 4, Priority: acme.IssuePriorityExpiring})
+	pool.Push(&acme.IssueJob{TaskId: 5, Priority: acme.IssuePriorityNew})
+
+	var startedIds = []int64{}
+	for i := 0; i < 5; i++ {
+		select {
+		case taskId := <-started:
+			startedIds = append(startedIds, taskId)
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+		release <- struct{}{}
+	}
+	var expectedIds = []int64{1, 4, 3, 5, 2}
+	for index, taskId := range expectedIds {
+		a.IsTrue(startedIds[index] == taskId)
+	}
+}
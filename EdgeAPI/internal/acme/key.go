@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 )
 
+// TODO 支持将ACME账号私钥保存在HSM/KMS中，目前无法实现：lego（v4.17.3）签名时只支持 *rsa.PrivateKey 和 *ecdsa.PrivateKey，不能委托给 crypto.Signer
 func ParsePrivateKeyFromBase64(base64String string) (interface{}, error) {
 	data, err := base64.StdEncoding.DecodeString(base64String)
 	if err != nil {
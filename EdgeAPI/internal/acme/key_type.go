@@ -0,0 +1,75 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/iwind/TeaGo/maps"
+)
+
+type KeyType = string
+
+const (
+	KeyTypeEC256   KeyType = "ec256"
+	KeyTypeEC384   KeyType = "ec384"
+	KeyTypeRSA2048 KeyType = "rsa2048"
+	KeyTypeRSA3072 KeyType = "rsa3072"
+	KeyTypeRSA4096 KeyType = "rsa4096"
+
+	DefaultKeyType = KeyTypeRSA2048 // 和以前的版本保持一致
+)
+
+// FindAllKeyTypes 所有支持的私钥类型
+func FindAllKeyTypes() []maps.Map {
+	return []maps.Map{
+		{
+			"name":        "RSA 2048",
+			"code":        KeyTypeRSA2048,
+			"description": "兼容性最好，适合需要支持旧客户端的场景。",
+		},
+		{
+			"name":        "RSA 3072",
+			"code":        KeyTypeRSA3072,
+			"description": "",
+		},
+		{
+			"name":        "RSA 4096",
+			"code":        KeyTypeRSA4096,
+			"description": "安全性更高，但握手速度较慢。",
+		},
+		{
+			"name":        "ECDSA P-256",
+			"code":        KeyTypeEC256,
+			"description": "证书更小、握手更快，推荐现代客户端使用。",
+		},
+		{
+			"name":        "ECDSA P-384",
+			"code":        KeyTypeEC384,
+			"description": "",
+		},
+	}
+}
+
+// IsValidKeyType 检查私钥类型是否有效
+func IsValidKeyType(keyType KeyType) bool {
+	switch keyType {
+	case KeyTypeEC256, KeyTypeEC384, KeyTypeRSA2048, KeyTypeRSA3072, KeyTypeRSA4096:
+		return true
+	}
+	return false
+}
+
+// 转换为lego使用的私钥类型
+func legoKeyType(keyType KeyType) certcrypto.KeyType {
+	switch keyType {
+	case KeyTypeEC256:
+		return certcrypto.EC256
+	case KeyTypeEC384:
+		return certcrypto.EC384
+	case KeyTypeRSA3072:
+		return certcrypto.RSA3072
+	case KeyTypeRSA4096:
+		return certcrypto.RSA4096
+	}
+	return certcrypto.RSA2048
+}
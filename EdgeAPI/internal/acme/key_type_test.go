@@ -0,0 +1,29 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"testing"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestLegoKeyType(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(legoKeyType("") == certcrypto.RSA2048)
+	a.IsTrue(legoKeyType(KeyTypeRSA2048) == certcrypto.RSA2048)
+	a.IsTrue(legoKeyType(KeyTypeRSA3072) == certcrypto.RSA3072)
+	a.IsTrue(legoKeyType(KeyTypeRSA4096) == certcrypto.RSA4096)
+	a.IsTrue(legoKeyType(KeyTypeEC256) == certcrypto.EC256)
+	a.IsTrue(legoKeyType(KeyTypeEC384) == certcrypto.EC384)
+}
+
+func TestIsValidKeyType(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	for _, keyType := range FindAllKeyTypes() {
+		a.IsTrue(IsValidKeyType(keyType.GetString("code")))
+	}
+	a.IsFalse(IsValidKeyType(""))
+	a.IsFalse(IsValidKeyType("rsa1024"))
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/iwind/TeaGo/maps"
+)
+
+// PreferredChainMaxLength 首选证书链名称最大长度
+const PreferredChainMaxLength = 200
+
+// FindAllPreferredChains 常用的首选证书链
+// 值为证书链中顶级证书的签发者通用名称（Issuer Common Name），CA没有提供对应的证书链时使用默认证书链
+func FindAllPreferredChains() []maps.Map {
+	return []maps.Map{
+		{
+			"name":        "ISRG Root X1",
+			"code":        "ISRG Root X1",
+			"description": "Let's Encrypt的短证书链，证书链更小，但不兼容Android 7.1.1以下的旧设备。",
+		},
+		{
+			"name":        "ISRG Root X2",
+			"code":        "ISRG Root X2",
+			"description": "Let's Encrypt的ECDSA根证书，需要配合ECDSA私钥使用。",
+		},
+		{
+			"name":        "DST Root CA X3",
+			"code":        "DST Root CA X3",
+			"description": "Let's Encrypt的长证书链，兼容旧的Android设备，但证书链较大；CA停止提供后将自动使用默认证书链。",
+		},
+	}
+}
+
+// NormalizePreferredChain 规范化并校验首选证书链名称，为空表示使用CA的默认证书链
+func NormalizePreferredChain(preferredChain string) (string, error) {
+	preferredChain = strings.TrimSpace(preferredChain)
+	if len(preferredChain) > PreferredChainMaxLength {
+		return "", errors.New("preferred chain is too long")
+	}
+	for _, r := range preferredChain {
+		if unicode.IsControl(r) {
+			return "", errors.New("invalid preferred chain '" + preferredChain + "'")
+		}
+	}
+	return preferredChain, nil
+}
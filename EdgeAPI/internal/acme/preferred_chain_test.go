@@ -0,0 +1,42 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestNormalizePreferredChain(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	for _, chain := range FindAllPreferredChains() {
+		result, err := NormalizePreferredChain(chain.GetString("code"))
+		a.IsNil(err)
+		a.IsTrue(result == chain.GetString("code"))
+	}
+
+	{
+		result, err := NormalizePreferredChain("  ISRG Root X1 ")
+		a.IsNil(err)
+		a.IsTrue(result == "ISRG Root X1")
+	}
+
+	{
+		result, err := NormalizePreferredChain("")
+		a.IsNil(err)
+		a.IsTrue(result == "")
+	}
+
+	{
+		_, err := NormalizePreferredChain("ISRG\nRoot X1")
+		a.IsNotNil(err)
+	}
+
+	{
+		_, err := NormalizePreferredChain(strings.Repeat("a", PreferredChainMaxLength+1))
+		a.IsNotNil(err)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+)
+
+// RequestStep 申请证书过程中的一个步骤
+type RequestStep struct {
+	Name    string `json:"name"`    // 步骤名称
+	IsOk    bool   `json:"isOk"`    // 是否成功
+	Message string `json:"message"` // 详细信息或错误信息
+	CostMs  int64  `json:"costMs"`  // 耗时（毫秒）
+}
+
+// NewRequestStep 根据执行结果构造步骤
+func NewRequestStep(name string, startedAt time.Time, message string, err error) *RequestStep {
+	var step = &RequestStep{
+		Name:    name,
+		IsOk:    err == nil,
+		Message: message,
+		CostMs:  time.Since(startedAt).Milliseconds(),
+	}
+	if err != nil {
+		step.Message = err.Error()
+	}
+	return step
+}
+
+// OnStep 设置每个步骤完成后的回调
+// 验证多个域名时，回调可能在多个协程中同时调用
+func (this *Request) OnStep(onStep func(step *RequestStep)) {
+	this.onStep = onStep
+}
+
+// 记录步骤
+func (this *Request) recordStep(name string, startedAt time.Time, message string, err error) {
+	if this.report == nil && this.onStep == nil {
+		return
+	}
+	var step = NewRequestStep(name, startedAt, message, err)
+	if this.report != nil {
+		this.report.addStep(step)
+	}
+	if this.onStep != nil {
+		this.onStep(step)
+	}
+}
+
+// 需要记录步骤时，记录验证信息的设置和清理
+func (this *Request) wrapChallengeProvider(provider challenge.Provider) challenge.Provider {
+	if this.report == nil && this.onStep == nil {
+		return provider
+	}
+	return &recordingChallengeProvider{
+		raw:     provider,
+		request: this,
+	}
+}
+
+type recordingChallengeProvider struct {
+	raw     challenge.Provider
+	request *Request
+}
+
+func (this *recordingChallengeProvider) Present(domain, token, keyAuth string) error {
+	var startedAt = time.Now()
+	err := this.raw.Present(domain, token, keyAuth)
+	var message = "token: " + token
+	if dnsProvider, ok := this.raw.(*DNSProvider); ok {
+		var info = dns01.GetChallengeInfo(domain, keyAuth)
+		var fqdn = info.EffectiveFQDN
+		if dnsProvider.isAlias {
+			fqdn = DNSAliasFQDN(dnsProvider.dnsDomain)
+		}
+		message = "TXT " + fqdn + " = " + info.Value
+	}
+	this.request.recordStep("present challenge for '"+domain+"'", startedAt, message, err)
+	return err
+}
+
+func (this *recordingChallengeProvider) CleanUp(domain, token, keyAuth string) error {
+	var startedAt = time.Now()
+	err := this.raw.CleanUp(domain, token, keyAuth)
+	this.request.recordStep("clean up challenge for '"+domain+"'", startedAt, "", err)
+	return err
+}
+
+func (this *recordingChallengeProvider) Timeout() (timeout, interval time.Duration) {
+	providerTimeout, ok := this.raw.(challenge.ProviderTimeout)
+	if ok {
+		return providerTimeout.Timeout()
+	}
+	return dns01.DefaultPropagationTimeout, dns01.DefaultPollingInterval
+}
+
+// TaskProgressEvent 任务执行进度
+type TaskProgressEvent struct {
+	Step       *RequestStep // 完成的步骤
+	IsFinished bool         // 任务是否已结束
+	IsOk       bool         // 任务结束时是否成功
+	Error      string       // 任务结束时的错误信息
+	CertId     int64        // 任务成功时生成的证书ID
+	CreatedAt  int64        // 事件时间
+}
+
+// SharedTaskProgressHub 任务执行进度的订阅中心
+var SharedTaskProgressHub = NewTaskProgressHub()
+
+// TaskProgressHub 任务执行进度的订阅中心
+// 只分发订阅之后的事件，订阅者处理不及时的事件会被丢弃
+type TaskProgressHub struct {
+	subscribers map[int64]map[chan *TaskProgressEvent]bool // taskId => { ch => true }
+	locker      sync.Mutex
+}
+
+func NewTaskProgressHub() *TaskProgressHub {
+	return &TaskProgressHub{
+		subscribers: map[int64]map[chan *TaskProgressEvent]bool{},
+	}
+}
+
+// Subscribe 订阅某个任务的进度，使用完后需要调用返回的取消函数
+func (this *TaskProgressHub) Subscribe(taskId int64) (events <-chan *TaskProgressEvent, cancel func()) {
+	var ch = make(chan *TaskProgressEvent, 64)
+
+	this.locker.Lock()
+	chMap, ok := this.subscribers[taskId]
+	if !ok {
+		chMap = map[chan *TaskProgressEvent]bool{}
+		this.subscribers[taskId] = chMap
+	}
+	chMap[ch] = true
+	this.locker.Unlock()
+
+	var once = sync.Once{}
+	return ch, func() {
+		once.Do(func() {
+			this.locker.Lock()
+			var currentChMap = this.subscribers[taskId]
+			delete(currentChMap, ch)
+			if len(currentChMap) == 0 {
+				delete(this.subscribers, taskId)
+			}
+			this.locker.Unlock()
+		})
+	}
+}
+
+// Publish 发布任务进度
+func (this *TaskProgressHub) Publish(taskId int64, event *TaskProgressEvent) {
+	if event.CreatedAt <= 0 {
+		event.CreatedAt = time.Now().Unix()
+	}
+
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	for ch := range this.subscribers[taskId] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// CountSubscribers 某个任务的订阅者数量
+func (this *TaskProgressHub) CountSubscribers(taskId int64) int {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return len(this.subscribers[taskId])
+}
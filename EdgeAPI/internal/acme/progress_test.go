@@ -0,0 +1,43 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme_test
+
+import (
+	"testing"
+
+	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestTaskProgressHub(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var hub = acmeutils.NewTaskProgressHub()
+
+	// 没有订阅者时不阻塞
+	hub.Publish(1, &acmeutils.TaskProgressEvent{Step: &acmeutils.RequestStep{Name: "fetch directory"}})
+
+	events1, cancel1 := hub.Subscribe(1)
+	events2, cancel2 := hub.Subscribe(1)
+	otherEvents, cancelOther := hub.Subscribe(2)
+	defer cancelOther()
+	a.IsTrue(hub.CountSubscribers(1) == 2)
+
+	hub.Publish(1, &acmeutils.TaskProgressEvent{Step: &acmeutils.RequestStep{Name: "register account", IsOk: true}})
+	hub.Publish(1, &acmeutils.TaskProgressEvent{IsFinished: true, IsOk: true, CertId: 100})
+
+	for _, events := range []<-chan *acmeutils.TaskProgressEvent{events1, events2} {
+		var event = <-events
+		a.IsTrue(event.Step != nil && event.Step.Name == "register account")
+		a.IsTrue(event.CreatedAt > 0)
+		event = <-events
+		a.IsTrue(event.IsFinished && event.CertId == 100)
+	}
+	a.IsTrue(len(otherEvents) == 0)
+
+	cancel1()
+	cancel1() // 重复取消
+	a.IsTrue(hub.CountSubscribers(1) == 1)
+	cancel2()
+	a.IsTrue(hub.CountSubscribers(1) == 0)
+}
@@ -12,6 +12,7 @@ type Provider struct {
 	TestAPIURL     string `json:"testAPIURL"`
 	RequireEAB     bool   `json:"requireEAB"`
 	EABDescription string `json:"eabDescription"`
+	CanGenerateEAB bool   `json:"canGenerateEAB"` // 是否支持使用API Key自动生成EAB
 }
 
 func FindProviderWithCode(code string) *Provider {
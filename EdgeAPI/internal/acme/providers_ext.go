@@ -10,6 +10,7 @@ func FindAllProviders() []*Provider {
 			Code:        DefaultProviderCode,
 			Description: "非盈利组织Let's Encrypt提供的免费证书。",
 			APIURL:      "https://acme-v02.api.letsencrypt.org/directory",
+			TestAPIURL:  "https://acme-staging-v02.api.letsencrypt.org/directory",
 			RequireEAB:  false,
 		},
 		{
@@ -18,7 +19,17 @@ func FindAllProviders() []*Provider {
 			Description:    "相关文档 <a href=\"https://zerossl.com/documentation/acme/\" target=\"_blank\">https://zerossl.com/documentation/acme/</a>。",
 			APIURL:         "https://acme.zerossl.com/v2/DV90",
 			RequireEAB:     true,
-			EABDescription: "在官网<a href=\"https://app.zerossl.com/developer\" target=\"_blank\">[Developer]</a>页面底部点击\"Generate\"按钮生成。",
+			EABDescription: "在官网<a href=\"https://app.zerossl.com/developer\" target=\"_blank\">[Developer]</a>页面底部点击\"Generate\"按钮生成；也可以只填写同一页面中的API Key，由系统自动生成。",
+			CanGenerateEAB: true,
+		},
+		{
+			Name:           "Google Trust Services",
+			Code:           "googletrust",
+			Description:    "Google提供的免费证书，相关文档 <a href=\"https://cloud.google.com/certificate-manager/docs/public-ca-tutorial\" target=\"_blank\">https://cloud.google.com/certificate-manager/docs/public-ca-tutorial</a>。",
+			APIURL:         "https://dv.acme-v02.api.pki.goog/directory",
+			TestAPIURL:     "https://dv.acme-v02.test-api.pki.goog/directory",
+			RequireEAB:     true,
+			EABDescription: "在Google Cloud中启用Public CA API后，使用命令 <code-label>gcloud publicca external-account-keys create</code-label> 生成，其中keyId为EAB Kid，b64MacKey为EAB HMAC Key；EAB只能使用一次，且需要在生成后7天内使用。",
 		},
 	}
 }
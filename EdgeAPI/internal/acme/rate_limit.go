@@ -0,0 +1,221 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// RateLimits CA的签发频率限制，数量为0表示不限制
+type RateLimits struct {
+	CertsPerDomain       int           // 每个注册域名在窗口期内可以签发的证书数
+	CertsPerDomainWindow time.Duration // 窗口期
+
+	DuplicateCerts       int           // 相同域名组合在窗口期内可以签发的证书数
+	DuplicateCertsWindow time.Duration // 窗口期
+
+	OrdersPerAccount       int           // 每个账号在窗口期内可以创建的订单数
+	OrdersPerAccountWindow time.Duration // 窗口期
+
+	FailuresPerAccountDomain       int           // 每个账号每个域名在窗口期内可以失败的次数
+	FailuresPerAccountDomainWindow time.Duration // 窗口期
+}
+
+// MaxWindow 最长的窗口期，用来确定需要查询的签发记录范围
+func (this *RateLimits) MaxWindow() time.Duration {
+	var result time.Duration
+	for _, window := range []time.Duration{this.CertsPerDomainWindow, this.DuplicateCertsWindow, this.OrdersPerAccountWindow, this.FailuresPerAccountDomainWindow} {
+		if window > result {
+			result = window
+		}
+	}
+	return result
+}
+
+// 参考 https://letsencrypt.org/docs/rate-limits/
+var providerRateLimits = map[string]*RateLimits{
+	DefaultProviderCode: {
+		CertsPerDomain:                 50,
+		CertsPerDomainWindow:           7 * 24 * time.Hour,
+		DuplicateCerts:                 5,
+		DuplicateCertsWindow:           7 * 24 * time.Hour,
+		OrdersPerAccount:               300,
+		OrdersPerAccountWindow:         3 * time.Hour,
+		FailuresPerAccountDomain:       5,
+		FailuresPerAccountDomainWindow: 1 * time.Hour,
+	},
+}
+
+// FindProviderRateLimits 查找服务商的签发频率限制，如果没有已知的限制则返回nil
+func FindProviderRateLimits(providerCode string) *RateLimits {
+	return providerRateLimits[providerCode]
+}
+
+// IssueRecord 签发记录
+type IssueRecord struct {
+	AccountId int64    // ACME账号（用户）ID
+	Domains   []string // 签发的域名
+	IsOk      bool     // 是否签发成功
+	CreatedAt int64    // 签发时间
+}
+
+// CheckRateLimits 检查签发是否会超出频率限制
+// 如果会超出限制，返回可以再次签发的时间和原因，否则返回零值
+func CheckRateLimits(limits *RateLimits, records []*IssueRecord, accountId int64, domains []string, now time.Time) (throttledUntil time.Time, reason string) {
+	if limits == nil || len(domains) == 0 {
+		return
+	}
+
+	// 窗口期内第limit个记录（从新到旧）过期时才可以再次签发
+	var check = func(limit int, window time.Duration, match func(record *IssueRecord) bool, limitReason string) {
+		if limit <= 0 || window <= 0 {
+			return
+		}
+		var since = now.Add(-window).Unix()
+		var times []int64
+		for _, record := range records {
+			if record.CreatedAt > since && match(record) {
+				times = append(times, record.CreatedAt)
+			}
+		}
+		if len(times) < limit {
+			return
+		}
+		sort.Slice(times, func(i, j int) bool {
+			return times[i] > times[j]
+		})
+		var until = time.Unix(times[limit-1], 0).Add(window)
+		if until.After(throttledUntil) {
+			throttledUntil = until
+			reason = limitReason
+		}
+	}
+
+	var nameSet = NormalizeDomainSet(domains)
+	var nameSetKey = strings.Join(nameSet, ",")
+
+	// 注册域名
+	for _, registeredDomain := range RegisteredDomains(nameSet) {
+		check(limits.CertsPerDomain, limits.CertsPerDomainWindow, func(record *IssueRecord) bool {
+			if !record.IsOk {
+				return false
+			}
+			for _, recordDomain := range RegisteredDomains(record.Domains) {
+				if recordDomain == registeredDomain {
+					return true
+				}
+			}
+			return false
+		}, "域名 "+registeredDomain+" 签发的证书数量超出限制（"+formatRateLimit(limits.CertsPerDomain, limits.CertsPerDomainWindow)+"）")
+	}
+
+	// 重复证书
+	check(limits.DuplicateCerts, limits.DuplicateCertsWindow, func(record *IssueRecord) bool {
+		return record.IsOk && strings.Join(NormalizeDomainSet(record.Domains), ",") == nameSetKey
+	}, "相同域名组合的证书数量超出限制（"+formatRateLimit(limits.DuplicateCerts, limits.DuplicateCertsWindow)+"）")
+
+	// 账号订单
+	check(limits.OrdersPerAccount, limits.OrdersPerAccountWindow, func(record *IssueRecord) bool {
+		return record.AccountId == accountId
+	}, "ACME账号创建的订单数量超出限制（"+formatRateLimit(limits.OrdersPerAccount, limits.OrdersPerAccountWindow)+"）")
+
+	// 校验失败
+	for _, domain := range nameSet {
+		check(limits.FailuresPerAccountDomain, limits.FailuresPerAccountDomainWindow, func(record *IssueRecord) bool {
+			if record.IsOk || record.AccountId != accountId {
+				return false
+			}
+			for _, recordDomain := range NormalizeDomainSet(record.Domains) {
+				if recordDomain == domain {
+					return true
+				}
+			}
+			return false
+		}, "域名 "+domain+" 校验失败次数超出限制（"+formatRateLimit(limits.FailuresPerAccountDomain, limits.FailuresPerAccountDomainWindow)+"）")
+	}
+
+	return
+}
+
+// NormalizeDomainSet 规范化（参考 NormalizeDomains）、去重并排序后的域名列表
+func NormalizeDomainSet(domains []string) []string {
+	var result = []string{}
+	var domainMap = map[string]bool{}
+	for _, domain := range domains {
+		normalizedDomain, err := configutils.NormalizeDomain(domain)
+		if err != nil {
+			normalizedDomain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+		}
+		domain = normalizedDomain
+		if len(domain) == 0 || domainMap[domain] {
+			continue
+		}
+		domainMap[domain] = true
+		result = append(result, domain)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// RegisteredDomains 取得域名列表对应的注册域名（去重）
+// 和Let's Encrypt一样使用完整的公共后缀列表，无法识别的域名会被忽略
+func RegisteredDomains(domains []string) []string {
+	var result = []string{}
+	var domainMap = map[string]bool{}
+	for _, domain := range domains {
+		domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "*.")
+		domain = strings.TrimSuffix(domain, ".")
+		asciiDomain, err := idna.Lookup.ToASCII(domain)
+		if err != nil {
+			continue
+		}
+		registeredDomain, err := publicsuffix.EffectiveTLDPlusOne(asciiDomain)
+		if err != nil || domainMap[registeredDomain] {
+			continue
+		}
+		domainMap[registeredDomain] = true
+		result = append(result, registeredDomain)
+	}
+	return result
+}
+
+var rateLimitedRetryAfterReg = regexp.MustCompile(`(?i)retry after (\d{4}-\d{2}-\d{2}[ T]\d{2}:\d{2}:\d{2}) ?UTC`)
+
+// DefaultRateLimitedDelay CA返回的频率限制错误中没有重试时间时使用的等待时间
+const DefaultRateLimitedDelay = 1 * time.Hour
+
+// ParseRateLimitedError 分析CA返回的错误是否为频率限制错误，如果是则返回可以重试的时间
+func ParseRateLimitedError(err error, now time.Time) (retryAt time.Time, ok bool) {
+	if err == nil || !strings.Contains(err.Error(), "urn:ietf:params:acme:error:rateLimited") {
+		return
+	}
+
+	var matches = rateLimitedRetryAfterReg.FindStringSubmatch(err.Error())
+	if len(matches) == 2 {
+		t, parseErr := time.ParseInLocation("2006-01-02 15:04:05", strings.Replace(matches[1], "T", " ", 1), time.UTC)
+		if parseErr == nil && t.After(now) {
+			return t, true
+		}
+	}
+	return now.Add(DefaultRateLimitedDelay), true
+}
+
+func formatRateLimit(limit int, window time.Duration) string {
+	var windowString string
+	if window%(24*time.Hour) == 0 {
+		windowString = strconv.Itoa(int(window/(24*time.Hour))) + "天"
+	} else if window%time.Hour == 0 {
+		windowString = strconv.Itoa(int(window/time.Hour)) + "小时"
+	} else {
+		windowString = window.String()
+	}
+	return windowString + strconv.Itoa(limit) + "个"
+}
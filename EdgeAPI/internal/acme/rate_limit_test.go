@@ -0,0 +1,129 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestCheckRateLimits_Duplicate(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var limits = acmeutils.FindProviderRateLimits(acmeutils.DefaultProviderCode)
+	a.IsNotNil(limits)
+
+	var now = time.Unix(1_700_000_000, 0)
+	var records []*acmeutils.IssueRecord
+	for i := 0; i < 5; i++ {
+		records = append(records, &acmeutils.IssueRecord{
+			AccountId: 1,
+			Domains:   []string{"www.example.com", "Example.com"},
+			IsOk:      true,
+			CreatedAt: now.Add(-time.Duration(5-i) * time.Hour).Unix(),
+		})
+	}
+
+	// 相同域名组合，顺序和大小写不同
+	until, reason := acmeutils.CheckRateLimits(limits, records, 2, []string{"example.com", "www.example.com"}, now)
+	a.IsTrue(until.Equal(now.Add(-5 * time.Hour).Add(7 * 24 * time.Hour)))
+	a.IsTrue(len(reason) > 0)
+	t.Log(until, reason)
+
+	// 不同域名组合
+	until, _ = acmeutils.CheckRateLimits(limits, records, 2, []string{"example.com"}, now)
+	a.IsTrue(until.IsZero())
+
+	// 失败的记录不计入
+	for _, record := range records {
+		record.IsOk = false
+	}
+	until, _ = acmeutils.CheckRateLimits(limits, records, 2, []string{"example.com", "www.example.com"}, now)
+	a.IsTrue(until.IsZero())
+}
+
+func TestCheckRateLimits_RegisteredDomain(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var limits = &acmeutils.RateLimits{
+		CertsPerDomain:       2,
+		CertsPerDomainWindow: 24 * time.Hour,
+	}
+	var now = time.Unix(1_700_000_000, 0)
+	var records = []*acmeutils.IssueRecord{
+		{Domains: []string{"a.example.com"}, IsOk: true, CreatedAt: now.Add(-3 * time.Hour).Unix()},
+		{Domains: []string{"b.example.com"}, IsOk: true, CreatedAt: now.Add(-1 * time.Hour).Unix()},
+		{Domains: []string{"a.example.org"}, IsOk: true, CreatedAt: now.Add(-1 * time.Hour).Unix()},
+		{Domains: []string{"c.example.com"}, IsOk: true, CreatedAt: now.Add(-25 * time.Hour).Unix()},
+	}
+
+	until, _ := acmeutils.CheckRateLimits(limits, records, 1, []string{"*.example.com"}, now)
+	a.IsTrue(until.Equal(now.Add(-3 * time.Hour).Add(24 * time.Hour)))
+
+	until, _ = acmeutils.CheckRateLimits(limits, records, 1, []string{"example.org"}, now)
+	a.IsTrue(until.IsZero())
+}
+
+func TestCheckRateLimits_Account(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var limits = &acmeutils.RateLimits{
+		OrdersPerAccount:               2,
+		OrdersPerAccountWindow:         3 * time.Hour,
+		FailuresPerAccountDomain:       1,
+		FailuresPerAccountDomainWindow: time.Hour,
+	}
+	var now = time.Unix(1_700_000_000, 0)
+	var records = []*acmeutils.IssueRecord{
+		{AccountId: 1, Domains: []string{"a.example.com"}, IsOk: true, CreatedAt: now.Add(-2 * time.Hour).Unix()},
+		{AccountId: 1, Domains: []string{"b.example.com"}, IsOk: false, CreatedAt: now.Add(-30 * time.Minute).Unix()},
+	}
+
+	// 订单数量
+	until, _ := acmeutils.CheckRateLimits(limits, records, 1, []string{"c.example.com"}, now)
+	a.IsTrue(until.Equal(now.Add(-2 * time.Hour).Add(3 * time.Hour)))
+
+	// 其他账号
+	until, _ = acmeutils.CheckRateLimits(limits, records, 2, []string{"b.example.com"}, now)
+	a.IsTrue(until.IsZero())
+
+	// 校验失败，取最晚的时间
+	limits.OrdersPerAccount = 0
+	until, _ = acmeutils.CheckRateLimits(limits, records, 1, []string{"b.example.com"}, now)
+	a.IsTrue(until.Equal(now.Add(-30 * time.Minute).Add(time.Hour)))
+}
+
+func TestRegisteredDomains(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	var domains = acmeutils.RegisteredDomains([]string{"*.a.example.com", "b.example.com", "www.example.co.uk", "user.github.io", "com", "中文.com"})
+	t.Log(domains)
+	a.IsTrue(len(domains) == 4)
+	a.IsTrue(domains[0] == "example.com")
+	a.IsTrue(domains[1] == "example.co.uk")
+	a.IsTrue(domains[2] == "user.github.io")
+	a.IsTrue(domains[3] == "xn--fiq228c.com")
+}
+
+func TestParseRateLimitedError(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	var now = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	{
+		_, ok := acmeutils.ParseRateLimitedError(errors.New("acme: error: 400 :: urn:ietf:params:acme:error:connection"), now)
+		a.IsFalse(ok)
+	}
+	{
+		retryAt, ok := acmeutils.ParseRateLimitedError(errors.New(`acme: error: 429 :: POST :: https://acme-v02.api.letsencrypt.org/acme/new-order :: urn:ietf:params:acme:error:rateLimited :: too many certificates (5) already issued for this exact set of domains in the last 168 hours: example.com, retry after 2024-03-02 12:30:00 UTC`), now)
+		a.IsTrue(ok)
+		a.IsTrue(retryAt.Equal(time.Date(2024, 3, 2, 12, 30, 0, 0, time.UTC)))
+	}
+	{
+		retryAt, ok := acmeutils.ParseRateLimitedError(errors.New("acme: error: 429 :: urn:ietf:params:acme:error:rateLimited :: too many new orders"), now)
+		a.IsTrue(ok)
+		a.IsTrue(retryAt.Equal(now.Add(acmeutils.DefaultRateLimitedDelay)))
+	}
+}
@@ -4,10 +4,10 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"time"
 
 	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
-	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/certificate"
 	"github.com/go-acme/lego/v4/lego"
 	acmelog "github.com/go-acme/lego/v4/log"
@@ -20,6 +20,9 @@ type Request struct {
 
 	task   *Task
 	onAuth AuthCallback
+
+	report *DryRunReport      // 试运行时的诊断报告
+	onStep func(*RequestStep) // 每个步骤完成后的回调
 }
 
 func NewRequest(task *Task) *Request {
@@ -46,6 +49,20 @@ func (this *Request) Run() (certData []byte, keyData []byte, err error) {
 		return
 	}
 
+	// 规范化域名，兼容以前保存的国际化域名
+	this.task.Domains, err = NormalizeDomains(this.task.Domains)
+	if err != nil {
+		return
+	}
+
+	// CA根据CSR签发证书，域名必须和CSR一致
+	if this.task.CSR != nil {
+		err = CheckCSRDomains(this.task.CSR, this.task.Domains)
+		if err != nil {
+			return
+		}
+	}
+
 	switch this.task.AuthType {
 	case AuthTypeDNS:
 		return this.runDNS()
@@ -68,73 +85,72 @@ func (this *Request) runDNS() (certData []byte, keyData []byte, err error) {
 		err = errors.New("'user' must not be nil")
 		return
 	}
-	if this.task.DNSProvider == nil {
-		err = errors.New("'dnsProvider' must not be nil")
-		return
-	}
-	if len(this.task.DNSDomain) == 0 {
-		err = errors.New("'dnsDomain' must not be empty")
-		return
+	if !this.task.IsDNSAlias() {
+		if this.task.DNSProvider == nil {
+			err = errors.New("'dnsProvider' must not be nil")
+			return
+		}
+		if len(this.task.DNSDomain) == 0 {
+			err = errors.New("'dnsDomain' must not be empty")
+			return
+		}
 	}
 	if len(this.task.Domains) == 0 {
 		err = errors.New("'domains' must not be empty")
 		return
 	}
 
+	// 保存的注册信息只属于服务商的正式目录
+	if this.task.IsCustomDirectory() {
+		this.task.User.SetTemporaryRegistration(nil)
+	}
+
 	var config = lego.NewConfig(this.task.User)
-	config.Certificate.KeyType = certcrypto.RSA2048
-	config.CADirURL = this.task.Provider.APIURL
+	config.Certificate.KeyType = legoKeyType(this.task.KeyType)
+	config.CADirURL = this.task.DirectoryURLOrDefault()
 	config.UserAgent = teaconst.ProductName + "/" + teaconst.Version
 
+	var startedAt = time.Now()
 	client, err := lego.NewClient(config)
+	this.recordStep("fetch directory", startedAt, config.CADirURL, err)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// 注册用户
-	var resource = this.task.User.GetRegistration()
-	if resource != nil {
-		_, err = client.Registration.QueryRegistration()
+	startedAt = time.Now()
+	err = this.register(client)
+	this.recordStep("register account", startedAt, "", err)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 在请求CA验证之前，等待TXT记录在权威DNS和公共DNS上生效
+	var propagationChecker = NewDNSPropagationChecker(DefaultPublicDNSResolvers)
+
+	if this.task.IsDNSAlias() {
+		// 检查CNAME委托
+		startedAt = time.Now()
+		err = CheckDNSAliasCNAMERecords(this.task.Domains, this.task.DNSAliasDomain)
+		this.recordStep("check CNAME delegation", startedAt, DNSAliasFQDN(this.task.DNSAliasDomain), err)
 		if err != nil {
 			return nil, nil, err
 		}
+
+		// 直接检查别名域名中的TXT记录
+		var aliasFQDN = DNSAliasFQDN(this.task.DNSAliasDomain)
+		err = client.Challenge.SetDNS01Provider(this.wrapChallengeProvider(NewDNSAliasProvider(this.task.DNSAliasProvider, this.task.DNSAliasDomain)), propagationChecker.PreCheck(aliasFQDN))
 	} else {
-		if this.task.Provider.RequireEAB {
-			resource, err = client.Registration.RegisterWithExternalAccountBinding(registration.RegisterEABOptions{
-				TermsOfServiceAgreed: true,
-				Kid:                  this.task.Account.EABKid,
-				HmacEncoded:          this.task.Account.EABKey,
-			})
-			if err != nil {
-				return nil, nil, fmt.Errorf("register user failed: %w", err)
-			}
-			err = this.task.User.Register(resource)
-			if err != nil {
-				return nil, nil, err
-			}
-		} else {
-			resource, err = client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
-			if err != nil {
-				return nil, nil, err
-			}
-			err = this.task.User.Register(resource)
-			if err != nil {
-				return nil, nil, err
-			}
-		}
+		err = client.Challenge.SetDNS01Provider(this.wrapChallengeProvider(NewDNSProvider(this.task.DNSProvider, this.task.DNSDomain)), propagationChecker.PreCheck(""))
 	}
-
-	err = client.Challenge.SetDNS01Provider(NewDNSProvider(this.task.DNSProvider, this.task.DNSDomain))
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// 申请证书
-	var request = certificate.ObtainRequest{
-		Domains: this.task.Domains,
-		Bundle:  true,
-	}
-	certResource, err := client.Certificate.Obtain(request)
+	startedAt = time.Now()
+	certResource, err := this.obtain(client)
+	this.recordStep("create order and validate", startedAt, "", err)
 	if err != nil {
 		return nil, nil, fmt.Errorf("obtain cert failed: %w", err)
 	}
@@ -154,63 +170,93 @@ func (this *Request) runHTTP() (certData []byte, keyData []byte, err error) {
 		return
 	}
 
+	// 保存的注册信息只属于服务商的正式目录
+	if this.task.IsCustomDirectory() {
+		this.task.User.SetTemporaryRegistration(nil)
+	}
+
 	var config = lego.NewConfig(this.task.User)
-	config.Certificate.KeyType = certcrypto.RSA2048
-	config.CADirURL = this.task.Provider.APIURL
+	config.Certificate.KeyType = legoKeyType(this.task.KeyType)
+	config.CADirURL = this.task.DirectoryURLOrDefault()
 	config.UserAgent = teaconst.ProductName + "/" + teaconst.Version
 
+	var startedAt = time.Now()
 	client, err := lego.NewClient(config)
+	this.recordStep("fetch directory", startedAt, config.CADirURL, err)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// 注册用户
-	var resource = this.task.User.GetRegistration()
-	if resource != nil {
-		_, err = client.Registration.QueryRegistration()
-		if err != nil {
-			return nil, nil, err
-		}
-	} else {
-		if this.task.Provider.RequireEAB {
-			resource, err = client.Registration.RegisterWithExternalAccountBinding(registration.RegisterEABOptions{
-				TermsOfServiceAgreed: true,
-				Kid:                  this.task.Account.EABKid,
-				HmacEncoded:          this.task.Account.EABKey,
-			})
-			if err != nil {
-				return nil, nil, fmt.Errorf("register user failed: %w", err)
-			}
-			err = this.task.User.Register(resource)
-			if err != nil {
-				return nil, nil, err
-			}
-		} else {
-			resource, err = client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
-			if err != nil {
-				return nil, nil, err
-			}
-			err = this.task.User.Register(resource)
-			if err != nil {
-				return nil, nil, err
-			}
-		}
+	startedAt = time.Now()
+	err = this.register(client)
+	this.recordStep("register account", startedAt, "", err)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	err = client.Challenge.SetHTTP01Provider(NewHTTPProvider(this.onAuth))
+	err = client.Challenge.SetHTTP01Provider(this.wrapChallengeProvider(NewHTTPProvider(this.onAuth)))
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// 申请证书
-	var request = certificate.ObtainRequest{
-		Domains: this.task.Domains,
-		Bundle:  true,
-	}
-	certResource, err := client.Certificate.Obtain(request)
+	startedAt = time.Now()
+	certResource, err := this.obtain(client)
+	this.recordStep("create order and validate", startedAt, "", err)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	return certResource.Certificate, certResource.PrivateKey, nil
 }
+
+// 申请证书
+// 设置了CSR时使用CSR申请，此时CA只返回证书，私钥为空
+func (this *Request) obtain(client *lego.Client) (*certificate.Resource, error) {
+	if this.task.CSR != nil {
+		return client.Certificate.ObtainForCSR(certificate.ObtainForCSRRequest{
+			CSR:            this.task.CSR,
+			Bundle:         true,
+			PreferredChain: this.task.PreferredChain,
+		})
+	}
+	return client.Certificate.Obtain(certificate.ObtainRequest{
+		Domains:        this.task.Domains,
+		Bundle:         true,
+		PreferredChain: this.task.PreferredChain,
+	})
+}
+
+// 注册用户
+func (this *Request) register(client *lego.Client) error {
+	var resource = this.task.User.GetRegistration()
+	if resource != nil {
+		_, err := client.Registration.QueryRegistration()
+		return err
+	}
+
+	var err error
+	if this.task.Provider.RequireEAB {
+		resource, err = client.Registration.RegisterWithExternalAccountBinding(registration.RegisterEABOptions{
+			TermsOfServiceAgreed: true,
+			Kid:                  this.task.Account.EABKid,
+			HmacEncoded:          this.task.Account.EABKey,
+		})
+		if err != nil {
+			return fmt.Errorf("register user failed: %w", err)
+		}
+	} else {
+		resource, err = client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
+		if err != nil {
+			return err
+		}
+	}
+
+	// 在自定义目录中使用同一个私钥临时注册，不保存注册信息，以免覆盖正式目录的注册信息
+	if this.task.IsCustomDirectory() {
+		this.task.User.SetTemporaryRegistration(resource)
+		return nil
+	}
+	return this.task.User.Register(resource)
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"fmt"
+
+	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	legoacme "github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/lego"
+	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
+)
+
+type RevokeReason = uint
+
+// 证书吊销原因，和 RFC 5280 中的 CRLReason 保持一致
+const (
+	RevokeReasonUnspecified          RevokeReason = legoacme.CRLReasonUnspecified
+	RevokeReasonKeyCompromise        RevokeReason = legoacme.CRLReasonKeyCompromise
+	RevokeReasonAffiliationChanged   RevokeReason = legoacme.CRLReasonAffiliationChanged
+	RevokeReasonSuperseded           RevokeReason = legoacme.CRLReasonSuperseded
+	RevokeReasonCessationOfOperation RevokeReason = legoacme.CRLReasonCessationOfOperation
+)
+
+// FindAllRevokeReasons 所有可以使用的吊销原因
+func FindAllRevokeReasons() []maps.Map {
+	return []maps.Map{
+		{
+			"name":        "未指定",
+			"code":        RevokeReasonUnspecified,
+			"description": "",
+		},
+		{
+			"name":        "私钥泄露",
+			"code":        RevokeReasonKeyCompromise,
+			"description": "证书私钥已经泄露或者可能已经泄露。",
+		},
+		{
+			"name":        "信息变更",
+			"code":        RevokeReasonAffiliationChanged,
+			"description": "证书中的域名或者组织信息已经发生变化。",
+		},
+		{
+			"name":        "已被替代",
+			"code":        RevokeReasonSuperseded,
+			"description": "已经申请了新的证书来代替此证书。",
+		},
+		{
+			"name":        "停止使用",
+			"code":        RevokeReasonCessationOfOperation,
+			"description": "证书中的域名已经不再使用，比如服务已下线。",
+		},
+	}
+}
+
+// IsValidRevokeReason 检查吊销原因是否有效
+func IsValidRevokeReason(reason RevokeReason) bool {
+	switch reason {
+	case RevokeReasonUnspecified, RevokeReasonKeyCompromise, RevokeReasonAffiliationChanged, RevokeReasonSuperseded, RevokeReasonCessationOfOperation:
+		return true
+	}
+	return false
+}
+
+// Revoke 在证书颁发机构吊销证书
+// 需要使用申请证书时的ACME用户
+func (this *Request) Revoke(certData []byte, reason RevokeReason) error {
+	if this.task.Provider == nil {
+		return errors.New("provider should not be nil")
+	}
+	if this.task.User == nil {
+		return errors.New("'user' must not be nil")
+	}
+	if this.task.User.GetRegistration() == nil {
+		return errors.New("the acme user has not been registered")
+	}
+	if len(certData) == 0 {
+		return errors.New("'certData' must not be empty")
+	}
+	if !IsValidRevokeReason(reason) {
+		return errors.New("invalid revoke reason '" + types.String(reason) + "'")
+	}
+
+	var config = lego.NewConfig(this.task.User)
+	config.CADirURL = this.task.Provider.APIURL
+	config.UserAgent = teaconst.ProductName + "/" + teaconst.Version
+
+	client, err := lego.NewClient(config)
+	if err != nil {
+		return err
+	}
+
+	err = client.Certificate.RevokeWithReason(certData, &reason)
+	if err != nil {
+		return fmt.Errorf("revoke cert failed: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package acme
 
-import "github.com/TeaOSLab/EdgeAPI/internal/dnsclients"
+import (
+	"crypto/x509"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients"
+)
 
 type AuthType = string
 
@@ -15,8 +19,41 @@ type Task struct {
 	User     *User
 	AuthType AuthType
 	Domains  []string
+	KeyType  KeyType // 私钥类型，为空表示使用默认类型
+
+	PreferredChain string // 首选证书链顶级证书的签发者通用名称，为空表示使用CA的默认证书链
+	DirectoryURL   string // 自定义的ACME目录URL，比如服务商的测试目录，为空表示使用服务商的正式目录
+
+	// 证书签名请求，设置后使用CSR申请证书，不生成私钥，Domains需要和CSR中的域名一致
+	CSR *x509.CertificateRequest
 
 	// DNS相关
 	DNSProvider dnsclients.ProviderInterface
 	DNSDomain   string
+
+	// DNS别名（CNAME委托）相关
+	// 设置后，_acme-challenge.域名 需要CNAME到 _acme-challenge.DNSAliasDomain，TXT记录将添加到DNSAliasDomain中
+	DNSAliasProvider dnsclients.ProviderInterface
+	DNSAliasDomain   string
+}
+
+// IsDNSAlias 是否使用DNS别名（CNAME委托）模式
+func (this *Task) IsDNSAlias() bool {
+	return this.DNSAliasProvider != nil && len(this.DNSAliasDomain) > 0
+}
+
+// IsCustomDirectory 是否使用自定义的ACME目录
+func (this *Task) IsCustomDirectory() bool {
+	return len(this.DirectoryURL) > 0 && (this.Provider == nil || this.DirectoryURL != this.Provider.APIURL)
+}
+
+// DirectoryURLOrDefault 实际使用的ACME目录URL
+func (this *Task) DirectoryURLOrDefault() string {
+	if len(this.DirectoryURL) > 0 {
+		return this.DirectoryURL
+	}
+	if this.Provider != nil {
+		return this.Provider.APIURL
+	}
+	return ""
 }
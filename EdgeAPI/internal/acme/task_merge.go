@@ -0,0 +1,133 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"sort"
+)
+
+// MaxMergedDomains 合并后单个证书最多包含的域名数量（Let's Encrypt等CA的限制为100）
+const MaxMergedDomains = 100
+
+// MergeCandidate 可以合并的待签发任务
+type MergeCandidate struct {
+	TaskId  int64
+	Key     string // 签发参数，只有相同Key的任务才能合并，比如所属用户、ACME用户、认证方式等
+	Domains []string
+}
+
+// TaskMerge 任务合并方案
+type TaskMerge struct {
+	TaskId        int64    // 保留的任务
+	MergedTaskIds []int64  // 被合并的任务
+	Domains       []string // 合并后的域名
+}
+
+type taskMergeGroup struct {
+	taskIds   []int64
+	domains   []string
+	domainMap map[string]bool
+}
+
+func (this *taskMergeGroup) overlaps(domains []string) bool {
+	for _, domain := range domains {
+		if this.domainMap[domain] {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *taskMergeGroup) add(taskIds []int64, domains []string) {
+	this.taskIds = append(this.taskIds, taskIds...)
+	for _, domain := range domains {
+		if this.domainMap[domain] {
+			continue
+		}
+		this.domainMap[domain] = true
+		this.domains = append(this.domains, domain)
+	}
+}
+
+// PlanTaskMerges 计算待签发任务的合并方案
+// 签发参数相同、并且域名有重叠（包括完全相同）的任务会合并到ID最小的任务中，合并后的域名数量不能超过maxDomains
+func PlanTaskMerges(candidates []*MergeCandidate, maxDomains int) []*TaskMerge {
+	if maxDomains <= 0 {
+		maxDomains = MaxMergedDomains
+	}
+
+	var sortedCandidates = append([]*MergeCandidate{}, candidates...)
+	sort.Slice(sortedCandidates, func(i, j int) bool {
+		return sortedCandidates[i].TaskId < sortedCandidates[j].TaskId
+	})
+
+	var keys = []string{}
+	var groupsMap = map[string][]*taskMergeGroup{} // key => groups
+	for _, candidate := range sortedCandidates {
+		if len(candidate.Domains) == 0 {
+			continue
+		}
+
+		groups, ok := groupsMap[candidate.Key]
+		if !ok {
+			keys = append(keys, candidate.Key)
+		}
+
+		// 找出所有和当前任务有重叠的分组
+		var overlappingGroups = []*taskMergeGroup{}
+		var restGroups = []*taskMergeGroup{}
+		for _, group := range groups {
+			if group.overlaps(candidate.Domains) {
+				overlappingGroups = append(overlappingGroups, group)
+			} else {
+				restGroups = append(restGroups, group)
+			}
+		}
+
+		// 保持ID最小的任务的域名排在最前面
+		sort.Slice(overlappingGroups, func(i, j int) bool {
+			return overlappingGroups[i].taskIds[0] < overlappingGroups[j].taskIds[0]
+		})
+
+		var newGroup = &taskMergeGroup{
+			domainMap: map[string]bool{},
+		}
+		for _, group := range overlappingGroups {
+			newGroup.add(group.taskIds, group.domains)
+		}
+		newGroup.add([]int64{candidate.TaskId}, candidate.Domains)
+
+		if len(overlappingGroups) > 0 && len(newGroup.domains) > maxDomains {
+			// 超出域名数量限制，单独签发
+			var singleGroup = &taskMergeGroup{
+				domainMap: map[string]bool{},
+			}
+			singleGroup.add([]int64{candidate.TaskId}, candidate.Domains)
+			groupsMap[candidate.Key] = append(groups, singleGroup)
+			continue
+		}
+		groupsMap[candidate.Key] = append(restGroups, newGroup)
+	}
+
+	var result = []*TaskMerge{}
+	for _, key := range keys {
+		for _, group := range groupsMap[key] {
+			if len(group.taskIds) <= 1 {
+				continue
+			}
+			var taskIds = group.taskIds
+			sort.Slice(taskIds, func(i, j int) bool {
+				return taskIds[i] < taskIds[j]
+			})
+			result = append(result, &TaskMerge{
+				TaskId:        taskIds[0],
+				MergedTaskIds: taskIds[1:],
+				Domains:       group.domains,
+			})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].TaskId < result[j].TaskId
+	})
+	return result
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme_test
+
+import (
+	"testing"
+
+	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestPlanTaskMerges(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var merges = acmeutils.PlanTaskMerges([]*acmeutils.MergeCandidate{
+		{TaskId: 3, Key: "user1", Domains: []string{"b.example.com", "c.example.com"}},
+		{TaskId: 1, Key: "user1", Domains: []string{"a.example.com", "b.example.com"}},
+		{TaskId: 2, Key: "user1", Domains: []string{"x.example.com"}},
+		{TaskId: 4, Key: "user1", Domains: []string{"c.example.com", "d.example.com"}},
+		{TaskId: 5, Key: "user2", Domains: []string{"a.example.com", "b.example.com"}},
+		{TaskId: 6, Key: "user2", Domains: []string{"b.example.com", "a.example.com"}}, // 相同域名
+		{TaskId: 7, Key: "user3", Domains: []string{"a.example.com"}},
+	}, 0)
+	for _, merge := range merges {
+		t.Logf("%+v", merge)
+	}
+
+	a.IsTrue(len(merges) == 2)
+	a.IsTrue(merges[0].TaskId == 1)
+	a.IsTrue(len(merges[0].MergedTaskIds) == 2 && merges[0].MergedTaskIds[0] == 3 && merges[0].MergedTaskIds[1] == 4)
+	a.IsTrue(len(merges[0].Domains) == 4 && merges[0].Domains[0] == "a.example.com")
+	a.IsTrue(merges[1].TaskId == 5)
+	a.IsTrue(len(merges[1].MergedTaskIds) == 1 && merges[1].MergedTaskIds[0] == 6)
+	a.IsTrue(len(merges[1].Domains) == 2)
+}
+
+func TestPlanTaskMerges_MaxDomains(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var merges = acmeutils.PlanTaskMerges([]*acmeutils.MergeCandidate{
+		{TaskId: 1, Key: "user1", Domains: []string{"a.example.com", "b.example.com"}},
+		{TaskId: 2, Key: "user1", Domains: []string{"b.example.com", "c.example.com"}},
+		{TaskId: 3, Key: "user1", Domains: []string{"a.example.com"}},
+	}, 2)
+	for _, merge := range merges {
+		t.Logf("%+v", merge)
+	}
+
+	// 任务2合并后超出域名数量，单独签发；任务3和任务1合并
+	a.IsTrue(len(merges) == 1)
+	a.IsTrue(merges[0].TaskId == 1)
+	a.IsTrue(len(merges[0].MergedTaskIds) == 1 && merges[0].MergedTaskIds[0] == 3)
+}
@@ -40,6 +40,11 @@ func (this *User) SetRegistration(resourceData []byte) error {
 	return nil
 }
 
+// SetTemporaryRegistration 设置只在本次请求中使用的注册信息，不会保存
+func (this *User) SetTemporaryRegistration(resource *registration.Resource) {
+	this.resource = resource
+}
+
 func (this *User) GetPrivateKey() crypto.PrivateKey {
 	return this.key
 }
@@ -211,6 +211,16 @@ func (this *AppCmd) runStop() {
 
 	_, _ = this.sock.Send(&gosock.Command{Code: "stop"})
 
+	// 等待正在执行的后台任务结束后进程退出
+	var before = time.Now()
+	for this.getPID() > 0 {
+		if time.Since(before) > 90*time.Second {
+			fmt.Println(this.product+" is still running, pid:", types.String(pid))
+			return
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+
 	fmt.Println(this.product+" stopped ok, pid:", types.String(pid))
 }
 
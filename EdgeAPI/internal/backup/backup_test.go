@@ -0,0 +1,133 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package backup_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/backup"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func openTestDB(t *testing.T, name string) *dbs.DB {
+	db, err := dbs.NewInstanceFromConfig(&dbs.DBConfig{
+		Driver: sqlite.DriverName,
+		Dsn:    sqlite.ComposeDSN(filepath.Join(t.TempDir(), name)),
+		Prefix: "edge",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	return db
+}
+
+func TestDumpAndRestore(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var sourceDB = openTestDB(t, "source.db")
+	for _, query := range []string{
+		"CREATE TABLE edgeServers (id INTEGER PRIMARY KEY AUTOINCREMENT, name VARCHAR(255), config TEXT, data BLOB, isOn TINYINT)",
+		"CREATE INDEX name ON edgeServers (name)",
+		"CREATE TABLE edgeHTTPAccessLogs_20240101 (id INTEGER PRIMARY KEY AUTOINCREMENT, content TEXT)",
+		"INSERT INTO edgeHTTPAccessLogs_20240101 (content) VALUES ('log')",
+	} {
+		_, err := sourceDB.Exec(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 150; i++ {
+		_, err := sourceDB.Exec("INSERT INTO edgeServers (name, config, data, isOn) VALUES (?, ?, ?, ?)", "server", "{\"a\":\"b\nc\"}", []byte{0xff, 0x00, byte(i)}, i%2)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buf = &bytes.Buffer{}
+	tables, rows, err := backup.NewDumper(sourceDB).Dump(context.Background(), buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(tables == 1)
+	a.IsTrue(rows == 150)
+
+	// 只导出指定的表格
+	{
+		var dumper = backup.NewDumper(sourceDB)
+		dumper.Tables = []string{"edgeHTTPAccessLogs_20240101"}
+		logTables, logRows, err := dumper.Dump(context.Background(), &bytes.Buffer{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.IsTrue(logTables == 1 && logRows == 1)
+	}
+
+	var targetDB = openTestDB(t, "target.db")
+	_, err = targetDB.Exec("CREATE TABLE edgeServers (id INTEGER PRIMARY KEY, old TEXT)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var restorer = backup.NewRestorer(targetDB)
+	verifiedTables, verifiedRows, err := restorer.Verify(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(verifiedTables == 1 && verifiedRows == 150)
+
+	err = restorer.Restore(context.Background(), bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := targetDB.FindCol(0, "SELECT COUNT(*) FROM edgeServers WHERE name='server' AND isOn=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(count == int64(75))
+
+	one, err := targetDB.FindOne("SELECT config, data FROM edgeServers WHERE id=10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(one.GetString("config") == "{\"a\":\"b\nc\"}")
+	a.IsTrue(one.GetString("data") == string([]byte{0xff, 0x00, 9}))
+
+	index, err := targetDB.FindCol(0, "SELECT name FROM sqlite_master WHERE type='index' AND tbl_name='edgeServers'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(index == "name")
+}
+
+func TestRestorer_Verify(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var db = openTestDB(t, "edge.db")
+	_, err := db.Exec("CREATE TABLE edgeNodes (id INTEGER PRIMARY KEY, name TEXT)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf = &bytes.Buffer{}
+	_, _, err = backup.NewDumper(db).Dump(context.Background(), buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 被截断的文件
+	_, _, err = backup.NewRestorer(db).Verify(bytes.NewReader(buf.Bytes()[:buf.Len()-20]))
+	a.IsTrue(err != nil)
+
+	// 不是备份文件
+	_, _, err = backup.NewRestorer(db).Verify(bytes.NewReader([]byte("hello")))
+	a.IsTrue(err != nil)
+}
@@ -0,0 +1,265 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// Dumper 逻辑备份
+// 所有表格在同一个只读事务中导出，保证数据的一致性
+type Dumper struct {
+	db *dbs.DB
+
+	IncludeConfigs    bool     // 是否包含API节点配置文件
+	IncludeAccessLogs bool     // 是否包含访问日志表
+	Tables            []string // 只导出这些表格，为空表示导出所有表格
+}
+
+func NewDumper(db *dbs.DB) *Dumper {
+	return &Dumper{
+		db: db,
+	}
+}
+
+// Dump 导出数据到writer
+func (this *Dumper) Dump(ctx context.Context, writer io.Writer) (tables int, rows int64, err error) {
+	var gzipWriter = gzip.NewWriter(writer)
+	var bufWriter = bufio.NewWriterSize(gzipWriter, 64*1024)
+	var encoder = json.NewEncoder(bufWriter)
+
+	var driver = this.db.Driver()
+	err = encoder.Encode(&record{
+		Type:      recordTypeManifest,
+		Version:   Version,
+		Driver:    driverFamily(driver),
+		CreatedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return
+	}
+
+	// 使用单独的连接开启一致性快照
+	conn, err := this.db.Raw().Conn(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	if driver == sqlite.DriverName {
+		_, err = conn.ExecContext(ctx, "BEGIN DEFERRED")
+	} else {
+		_, err = conn.ExecContext(ctx, "SET SESSION TRANSACTION ISOLATION LEVEL REPEATABLE READ")
+		if err == nil {
+			_, err = conn.ExecContext(ctx, "START TRANSACTION WITH CONSISTENT SNAPSHOT, READ ONLY")
+		}
+	}
+	if err != nil {
+		return 0, 0, errors.New("start transaction failed: " + err.Error())
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), "ROLLBACK")
+	}()
+
+	var tableNames = this.Tables
+	if len(tableNames) == 0 {
+		tableNames, err = this.findTableNames(ctx, conn)
+		if err != nil {
+			return
+		}
+	}
+	for _, tableName := range tableNames {
+		if len(this.Tables) == 0 && !this.IncludeAccessLogs && isAccessLogTable(tableName) {
+			continue
+		}
+		tableRows, dumpErr := this.dumpTable(ctx, conn, driver, tableName, encoder)
+		if dumpErr != nil {
+			return 0, 0, errors.New("dump table '" + tableName + "' failed: " + dumpErr.Error())
+		}
+		tables++
+		rows += tableRows
+	}
+
+	// 配置文件
+	if this.IncludeConfigs {
+		err = this.dumpConfigs(encoder)
+		if err != nil {
+			return
+		}
+	}
+
+	err = encoder.Encode(&record{
+		Type:   recordTypeEnd,
+		Tables: tables,
+		Rows:   rows,
+	})
+	if err != nil {
+		return
+	}
+	err = bufWriter.Flush()
+	if err != nil {
+		return
+	}
+	err = gzipWriter.Close()
+	return
+}
+
+func (this *Dumper) findTableNames(ctx context.Context, conn *sql.Conn) ([]string, error) {
+	rows, err := conn.QueryContext(ctx, "SHOW TABLES")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var result = []string{}
+	for rows.Next() {
+		var tableName string
+		err = rows.Scan(&tableName)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, tableName)
+	}
+	sort.Strings(result)
+	return result, rows.Err()
+}
+
+func (this *Dumper) dumpTable(ctx context.Context, conn *sql.Conn, driver string, tableName string, encoder *json.Encoder) (count int64, err error) {
+	// 建表语句
+	var createSQL string
+	var indexSQLs []string
+	if driver == sqlite.DriverName {
+		err = conn.QueryRowContext(ctx, "SELECT sql FROM sqlite_master WHERE type='table' AND name=?", tableName).Scan(&createSQL)
+		if err == nil {
+			indexSQLs, err = this.findSQLiteIndexes(ctx, conn, tableName)
+		}
+	} else {
+		var name string
+		err = conn.QueryRowContext(ctx, "SHOW CREATE TABLE "+quoteName(tableName)).Scan(&name, &createSQL)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := conn.QueryContext(ctx, "SELECT * FROM "+quoteName(tableName))
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	err = encoder.Encode(&record{
+		Type:      recordTypeTable,
+		Name:      tableName,
+		CreateSQL: createSQL,
+		IndexSQLs: indexSQLs,
+		Columns:   columns,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var values = make([]any, len(columns))
+	var valuePointers = make([]any, len(columns))
+	for i := range values {
+		valuePointers[i] = &values[i]
+	}
+	for rows.Next() {
+		err = rows.Scan(valuePointers...)
+		if err != nil {
+			return 0, err
+		}
+		var encodedValues = make([]any, len(values))
+		for i, value := range values {
+			encodedValues[i] = encodeValue(value)
+		}
+		err = encoder.Encode(&record{
+			Type:   recordTypeRow,
+			Values: encodedValues,
+		})
+		if err != nil {
+			return 0, err
+		}
+		count++
+	}
+	return count, rows.Err()
+}
+
+// 查找SQLite表格上的索引，自动创建的主键和唯一索引没有SQL
+func (this *Dumper) findSQLiteIndexes(ctx context.Context, conn *sql.Conn, tableName string) ([]string, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT sql FROM sqlite_master WHERE type='index' AND tbl_name=? AND sql IS NOT NULL ORDER BY name", tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var result = []string{}
+	for rows.Next() {
+		var indexSQL string
+		err = rows.Scan(&indexSQL)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, indexSQL)
+	}
+	return result, rows.Err()
+}
+
+// 导出配置目录中的YAML文件
+func (this *Dumper) dumpConfigs(encoder *json.Encoder) error {
+	files, err := filepath.Glob(Tea.ConfigFile("*.yaml"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		var name = filepath.Base(file)
+
+		// .db.yaml 是启动时自动生成的
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		err = encoder.Encode(&record{
+			Type: recordTypeConfig,
+			Name: name,
+			Data: data,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func quoteName(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
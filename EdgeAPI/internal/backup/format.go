@@ -0,0 +1,117 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package backup
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Version 备份文件格式版本
+const Version = 1
+
+// FileExt 备份文件扩展名
+const FileExt = ".jsonl.gz"
+
+// 备份文件中的记录类型
+const (
+	recordTypeManifest = "manifest"
+	recordTypeTable    = "table"
+	recordTypeRow      = "row"
+	recordTypeConfig   = "config"
+	recordTypeEnd      = "end"
+)
+
+// 备份文件中的一行记录
+// 备份文件为gzip压缩的JSON Lines，依次为：manifest、每个表格的table和row、config、end
+type record struct {
+	Type string `json:"type"`
+
+	// manifest
+	Version   int    `json:"version,omitempty"`
+	Driver    string `json:"driver,omitempty"`
+	CreatedAt int64  `json:"createdAt,omitempty"`
+
+	// table和config
+	Name      string   `json:"name,omitempty"`
+	CreateSQL string   `json:"createSQL,omitempty"`
+	IndexSQLs []string `json:"indexSQLs,omitempty"` // SQLite的索引需要单独创建
+	Columns   []string `json:"columns,omitempty"`
+
+	// row
+	Values []any `json:"values,omitempty"`
+
+	// config
+	Data []byte `json:"data,omitempty"`
+
+	// end
+	Tables int   `json:"tables,omitempty"`
+	Rows   int64 `json:"rows,omitempty"`
+}
+
+// 编码字段值
+// 不是UTF-8的二进制数据使用 {"b": base64} 表示
+func encodeValue(value any) any {
+	switch v := value.(type) {
+	case []byte:
+		if utf8.Valid(v) {
+			return string(v)
+		}
+		return map[string]string{"b": base64.StdEncoding.EncodeToString(v)}
+	case time.Time:
+		return v.Format("2006-01-02 15:04:05")
+	}
+	return value
+}
+
+// 解码字段值
+func decodeValue(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		intValue, err := v.Int64()
+		if err == nil {
+			return intValue
+		}
+		floatValue, err := v.Float64()
+		if err == nil {
+			return floatValue
+		}
+		return v.String()
+	case map[string]any:
+		encoded, ok := v["b"].(string)
+		if ok {
+			data, err := base64.StdEncoding.DecodeString(encoded)
+			if err == nil {
+				return data
+			}
+		}
+		return nil
+	}
+	return value
+}
+
+// 数据库驱动类型，只能恢复到同一类型的数据库
+func driverFamily(driver string) string {
+	if strings.HasPrefix(driver, "mysql") {
+		return "mysql"
+	}
+	return driver
+}
+
+// 是否为访问日志表
+func isAccessLogTable(tableName string) bool {
+	return strings.HasPrefix(tableName, "edgeHTTPAccessLogs_") || strings.HasPrefix(tableName, "edgeNSAccessLogs_")
+}
+
+// 组合备份文件名
+func composeFilename(t time.Time) string {
+	return "goedge-backup-" + t.Format("20060102-150405") + FileExt
+}
+
+// 检查是否为合法的备份文件名
+func isValidFilename(name string) bool {
+	return strings.HasPrefix(name, "goedge-backup-") && strings.HasSuffix(name, FileExt) && !strings.ContainsAny(name, `/\`)
+}
@@ -0,0 +1,207 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+var SharedManager = NewManager()
+
+var ErrBusy = errors.New("another backup or restore is running")
+
+// Manager 备份管理
+// 同一个API节点上同时只能运行一个备份或恢复任务
+type Manager struct {
+	isRunning bool
+	locker    sync.Mutex
+}
+
+func NewManager() *Manager {
+	return &Manager{}
+}
+
+// Backup 立即备份并上传到存储中
+// 备份完成后按照设置删除多余的旧备份
+func (this *Manager) Backup(config *systemconfigs.BackupConfig) (*FileInfo, error) {
+	if !this.begin() {
+		return nil, ErrBusy
+	}
+	defer this.end()
+
+	storage, err := NewStorage(config)
+	if err != nil {
+		return nil, err
+	}
+	db, err := dbs.Default()
+	if err != nil {
+		return nil, err
+	}
+
+	// 先写入临时文件，上传时需要知道文件尺寸
+	tmpFile, err := os.CreateTemp("", "goedge-backup-*"+FileExt)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	var now = time.Now()
+	var dumper = NewDumper(db)
+	dumper.IncludeConfigs = config.IncludeConfigs
+	dumper.IncludeAccessLogs = config.IncludeAccessLogs
+	_, _, err = dumper.Dump(context.Background(), tmpFile)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := tmpFile.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tmpFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	var name = composeFilename(now)
+	err = storage.Put(name, tmpFile, size)
+	if err != nil {
+		return nil, errors.New("save backup file failed: " + err.Error())
+	}
+
+	// 删除多余的备份
+	if config.Keeps > 0 {
+		files, err := storage.List()
+		if err != nil {
+			return nil, err
+		}
+		for len(files) > config.Keeps {
+			err = storage.Delete(files[0].Name)
+			if err != nil {
+				return nil, err
+			}
+			files = files[1:]
+		}
+	}
+
+	return &FileInfo{
+		Name:      name,
+		Size:      size,
+		CreatedAt: now.Unix(),
+	}, nil
+}
+
+// Restore 从某个备份中恢复
+// 恢复之前会先完整检查备份文件，检查失败时不会修改任何数据
+func (this *Manager) Restore(config *systemconfigs.BackupConfig, name string, restoreConfigs bool) error {
+	if !this.begin() {
+		return ErrBusy
+	}
+	defer this.end()
+
+	storage, err := NewStorage(config)
+	if err != nil {
+		return err
+	}
+	db, err := dbs.Default()
+	if err != nil {
+		return err
+	}
+
+	// 需要读取两遍，所以先下载到临时文件
+	reader, err := storage.Get(name)
+	if err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp("", "goedge-restore-*"+FileExt)
+	if err != nil {
+		_ = reader.Close()
+		return err
+	}
+	defer func() {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}()
+	_, err = io.Copy(tmpFile, reader)
+	_ = reader.Close()
+	if err != nil {
+		return err
+	}
+
+	var restorer = NewRestorer(db)
+	restorer.RestoreConfigs = restoreConfigs
+
+	_, err = tmpFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	_, _, err = restorer.Verify(tmpFile)
+	if err != nil {
+		return errors.New("verify backup file failed: " + err.Error())
+	}
+
+	_, err = tmpFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	err = restorer.Restore(context.Background(), tmpFile)
+	if err != nil {
+		return err
+	}
+
+	dbutils.SharedDAOCache.Clear()
+	return nil
+}
+
+// List 列出所有备份
+func (this *Manager) List(config *systemconfigs.BackupConfig) ([]*FileInfo, error) {
+	storage, err := NewStorage(config)
+	if err != nil {
+		return nil, err
+	}
+	return storage.List()
+}
+
+// Delete 删除某个备份
+func (this *Manager) Delete(config *systemconfigs.BackupConfig, name string) error {
+	storage, err := NewStorage(config)
+	if err != nil {
+		return err
+	}
+	return storage.Delete(name)
+}
+
+// IsRunning 是否有正在运行的备份或恢复任务
+func (this *Manager) IsRunning() bool {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return this.isRunning
+}
+
+func (this *Manager) begin() bool {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	if this.isRunning {
+		return false
+	}
+	this.isRunning = true
+	return true
+}
+
+func (this *Manager) end() {
+	this.locker.Lock()
+	this.isRunning = false
+	this.locker.Unlock()
+}
@@ -0,0 +1,272 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// 每条插入语句中的行数
+const restoreBatchRows = 100
+
+var ErrIncompleteBackup = errors.New("backup file is incomplete")
+
+// Restorer 从备份文件中恢复数据
+// 备份文件中的表格会被删除后重建，备份文件中没有的表格保持不变
+type Restorer struct {
+	db *dbs.DB
+
+	RestoreConfigs bool // 是否恢复API节点配置文件
+}
+
+func NewRestorer(db *dbs.DB) *Restorer {
+	return &Restorer{
+		db: db,
+	}
+}
+
+// Verify 检查备份文件是否完整，不修改数据
+func (this *Restorer) Verify(reader io.Reader) (tables int, rows int64, err error) {
+	err = this.read(reader, func(r *record) error {
+		switch r.Type {
+		case recordTypeManifest:
+			return this.checkManifest(r)
+		case recordTypeTable:
+			tables++
+		case recordTypeRow:
+			rows++
+		case recordTypeEnd:
+			if r.Tables != tables || r.Rows != rows {
+				return ErrIncompleteBackup
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// Restore 恢复数据
+// 调用之前应该先调用 Verify() 检查备份文件
+func (this *Restorer) Restore(ctx context.Context, reader io.Reader) error {
+	var table *tableRestorer
+	var flushTable = func() error {
+		if table == nil {
+			return nil
+		}
+		err := table.finish(ctx)
+		table = nil
+		return err
+	}
+
+	err := this.read(reader, func(r *record) error {
+		switch r.Type {
+		case recordTypeManifest:
+			return this.checkManifest(r)
+		case recordTypeTable:
+			err := flushTable()
+			if err != nil {
+				return err
+			}
+			table, err = this.newTableRestorer(ctx, r)
+			return err
+		case recordTypeRow:
+			if table == nil {
+				return errors.New("row found before table")
+			}
+			return table.add(ctx, r.Values)
+		case recordTypeConfig:
+			err := flushTable()
+			if err != nil {
+				return err
+			}
+			if this.RestoreConfigs {
+				return this.restoreConfig(r)
+			}
+		case recordTypeEnd:
+			return flushTable()
+		}
+		return nil
+	})
+	if err != nil {
+		if table != nil {
+			_ = table.tx.Rollback()
+		}
+		return err
+	}
+	return nil
+}
+
+// 依次读取记录
+func (this *Restorer) read(reader io.Reader, callback func(r *record) error) error {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = gzipReader.Close()
+	}()
+
+	var bufReader = bufio.NewReaderSize(gzipReader, 64*1024)
+	var isFirst = true
+	var hasEnd = false
+	for {
+		line, readErr := bufReader.ReadBytes('\n')
+		if len(line) > 0 {
+			if hasEnd {
+				return errors.New("unexpected data after end of backup")
+			}
+
+			var decoder = json.NewDecoder(bytes.NewReader(line))
+			decoder.UseNumber()
+			var r = &record{}
+			err = decoder.Decode(r)
+			if err != nil {
+				return errors.New("decode backup record failed: " + err.Error())
+			}
+			if isFirst && r.Type != recordTypeManifest {
+				return errors.New("invalid backup file: manifest not found")
+			}
+			isFirst = false
+			if r.Type == recordTypeEnd {
+				hasEnd = true
+			}
+
+			err = callback(r)
+			if err != nil {
+				return err
+			}
+		}
+		if readErr != nil {
+			if readErr == io.EOF {
+				break
+			}
+			return readErr
+		}
+	}
+	if !hasEnd {
+		return ErrIncompleteBackup
+	}
+	return nil
+}
+
+func (this *Restorer) checkManifest(r *record) error {
+	if r.Version <= 0 || r.Version > Version {
+		return errors.New("unsupported backup version")
+	}
+	if r.Driver != driverFamily(this.db.Driver()) {
+		return errors.New("can not restore '" + r.Driver + "' backup to '" + driverFamily(this.db.Driver()) + "' database")
+	}
+	return nil
+}
+
+func (this *Restorer) restoreConfig(r *record) error {
+	var name = filepath.Base(r.Name)
+	if name != r.Name || !strings.HasSuffix(name, ".yaml") || strings.HasPrefix(name, ".") {
+		return errors.New("invalid config file name '" + r.Name + "'")
+	}
+	return os.WriteFile(Tea.ConfigFile(name), r.Data, 0666)
+}
+
+func (this *Restorer) newTableRestorer(ctx context.Context, r *record) (*tableRestorer, error) {
+	if len(r.Name) == 0 || len(r.CreateSQL) == 0 || len(r.Columns) == 0 {
+		return nil, errors.New("invalid table record")
+	}
+
+	// 重建表格
+	_, err := this.db.Raw().ExecContext(ctx, "DROP TABLE IF EXISTS "+quoteName(r.Name))
+	if err != nil {
+		return nil, errors.New("drop table '" + r.Name + "' failed: " + err.Error())
+	}
+	_, err = this.db.Raw().ExecContext(ctx, r.CreateSQL)
+	if err != nil {
+		return nil, errors.New("create table '" + r.Name + "' failed: " + err.Error())
+	}
+	for _, indexSQL := range r.IndexSQLs {
+		_, err = this.db.Raw().ExecContext(ctx, indexSQL)
+		if err != nil {
+			return nil, errors.New("create index on table '" + r.Name + "' failed: " + err.Error())
+		}
+	}
+
+	tx, err := this.db.Raw().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var quotedColumns = []string{}
+	for _, column := range r.Columns {
+		quotedColumns = append(quotedColumns, quoteName(column))
+	}
+	return &tableRestorer{
+		tx:        tx,
+		name:      r.Name,
+		columns:   r.Columns,
+		insertSQL: "INSERT INTO " + quoteName(r.Name) + " (" + strings.Join(quotedColumns, ", ") + ") VALUES ",
+	}, nil
+}
+
+// 单个表格的数据恢复
+type tableRestorer struct {
+	tx        *sql.Tx
+	name      string
+	columns   []string
+	insertSQL string
+
+	args []any
+	rows int
+}
+
+func (this *tableRestorer) add(ctx context.Context, values []any) error {
+	if len(values) != len(this.columns) {
+		return errors.New("invalid row in table '" + this.name + "': column count mismatch")
+	}
+	for _, value := range values {
+		this.args = append(this.args, decodeValue(value))
+	}
+	this.rows++
+	if this.rows >= restoreBatchRows {
+		return this.flush(ctx)
+	}
+	return nil
+}
+
+func (this *tableRestorer) flush(ctx context.Context) error {
+	if this.rows == 0 {
+		return nil
+	}
+
+	var placeholder = "(" + strings.TrimSuffix(strings.Repeat("?, ", len(this.columns)), ", ") + ")"
+	var placeholders = make([]string, this.rows)
+	for i := range placeholders {
+		placeholders[i] = placeholder
+	}
+	_, err := this.tx.ExecContext(ctx, this.insertSQL+strings.Join(placeholders, ", "), this.args...)
+	if err != nil {
+		return errors.New("insert into table '" + this.name + "' failed: " + err.Error())
+	}
+	this.args = nil
+	this.rows = 0
+	return nil
+}
+
+func (this *tableRestorer) finish(ctx context.Context) error {
+	err := this.flush(ctx)
+	if err != nil {
+		_ = this.tx.Rollback()
+		return err
+	}
+	return this.tx.Commit()
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package backup
+
+import (
+	"errors"
+	"io"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+)
+
+// FileInfo 备份文件信息
+type FileInfo struct {
+	Name      string
+	Size      int64
+	CreatedAt int64
+}
+
+// StorageInterface 备份文件存储
+type StorageInterface interface {
+	// Put 保存文件
+	Put(name string, reader io.ReadSeeker, size int64) error
+
+	// Get 读取文件，调用者需要关闭返回的Reader
+	Get(name string) (io.ReadCloser, error)
+
+	// List 列出所有的备份文件，按创建时间从早到晚排列
+	List() ([]*FileInfo, error)
+
+	// Delete 删除文件
+	Delete(name string) error
+}
+
+// NewStorage 根据设置创建存储
+func NewStorage(config *systemconfigs.BackupConfig) (StorageInterface, error) {
+	switch config.StorageType {
+	case "", systemconfigs.BackupStorageLocal:
+		return NewLocalStorage(config.LocalDir), nil
+	case systemconfigs.BackupStorageS3:
+		if config.S3 == nil {
+			return nil, errors.New("s3 storage has not been configured")
+		}
+		return NewS3Storage(config.S3), nil
+	}
+	return nil, errors.New("invalid storage type '" + config.StorageType + "'")
+}
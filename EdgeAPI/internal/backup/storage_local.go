@@ -0,0 +1,115 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package backup
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/iwind/TeaGo/Tea"
+)
+
+// DefaultLocalDir 默认的本地备份目录，相对于程序根目录
+const DefaultLocalDir = "backups"
+
+// LocalStorage 本地目录存储
+type LocalStorage struct {
+	dir string
+}
+
+func NewLocalStorage(dir string) *LocalStorage {
+	if len(dir) == 0 {
+		dir = DefaultLocalDir
+	}
+	if !filepath.IsAbs(dir) {
+		dir = Tea.Root + Tea.DS + dir
+	}
+	return &LocalStorage{
+		dir: dir,
+	}
+}
+
+func (this *LocalStorage) Put(name string, reader io.ReadSeeker, size int64) error {
+	if !isValidFilename(name) {
+		return errors.New("invalid backup file name '" + name + "'")
+	}
+	err := os.MkdirAll(this.dir, 0777)
+	if err != nil {
+		return err
+	}
+
+	// 先写入临时文件，避免列出未完成的备份
+	var path = filepath.Join(this.dir, name)
+	fp, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fp, reader)
+	if err != nil {
+		_ = fp.Close()
+		_ = os.Remove(fp.Name())
+		return err
+	}
+	err = fp.Close()
+	if err != nil {
+		_ = os.Remove(fp.Name())
+		return err
+	}
+	return os.Rename(fp.Name(), path)
+}
+
+func (this *LocalStorage) Get(name string) (io.ReadCloser, error) {
+	if !isValidFilename(name) {
+		return nil, errors.New("invalid backup file name '" + name + "'")
+	}
+	return os.Open(filepath.Join(this.dir, name))
+}
+
+func (this *LocalStorage) List() ([]*FileInfo, error) {
+	entries, err := os.ReadDir(this.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var result = []*FileInfo{}
+	for _, entry := range entries {
+		if entry.IsDir() || !isValidFilename(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		result = append(result, &FileInfo{
+			Name:      entry.Name(),
+			Size:      info.Size(),
+			CreatedAt: info.ModTime().Unix(),
+		})
+	}
+	sortFiles(result)
+	return result, nil
+}
+
+func (this *LocalStorage) Delete(name string) error {
+	if !isValidFilename(name) {
+		return errors.New("invalid backup file name '" + name + "'")
+	}
+	err := os.Remove(filepath.Join(this.dir, name))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// 文件名中包含时间，按文件名排序即可
+func sortFiles(files []*FileInfo) {
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package backup
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/s3utils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+)
+
+// S3Storage S3兼容的对象存储
+type S3Storage struct {
+	client *s3utils.Client
+	prefix string
+}
+
+func NewS3Storage(config *systemconfigs.S3StorageConfig) *S3Storage {
+	return &S3Storage{
+		client: s3utils.NewClient(config),
+		prefix: config.Prefix,
+	}
+}
+
+func (this *S3Storage) Put(name string, reader io.ReadSeeker, size int64) error {
+	if !isValidFilename(name) {
+		return errors.New("invalid backup file name '" + name + "'")
+	}
+	return this.client.PutObject(this.prefix+name, reader, size)
+}
+
+func (this *S3Storage) Get(name string) (io.ReadCloser, error) {
+	if !isValidFilename(name) {
+		return nil, errors.New("invalid backup file name '" + name + "'")
+	}
+	return this.client.GetObject(this.prefix + name)
+}
+
+func (this *S3Storage) List() ([]*FileInfo, error) {
+	objects, err := this.client.ListObjects(this.prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var result = []*FileInfo{}
+	for _, object := range objects {
+		var name = strings.TrimPrefix(object.Key, this.prefix)
+		if !isValidFilename(name) {
+			continue
+		}
+		result = append(result, &FileInfo{
+			Name:      name,
+			Size:      object.Size,
+			CreatedAt: object.LastModified.Unix(),
+		})
+	}
+	sortFiles(result)
+	return result, nil
+}
+
+func (this *S3Storage) Delete(name string) error {
+	if !isValidFilename(name) {
+		return errors.New("invalid backup file name '" + name + "'")
+	}
+	return this.client.DeleteObject(this.prefix + name)
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package bgpclients
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/iwind/TeaGo/types"
+)
+
+var exaBGPHTTPClient = &http.Client{
+	Timeout: 10 * time.Second,
+}
+
+// ExaBGPDriver 通过ExaBGP的HTTP API宣告
+// HTTP API接收表单字段command，并将其原样写入ExaBGP的API管道
+type ExaBGPDriver struct {
+	config *nodeconfigs.NodeBGPConfig
+}
+
+func NewExaBGPDriver(config *nodeconfigs.NodeBGPConfig) *ExaBGPDriver {
+	return &ExaBGPDriver{
+		config: config,
+	}
+}
+
+// Announce 宣告配置中的所有前缀
+func (this *ExaBGPDriver) Announce() error {
+	for _, prefix := range this.config.Prefixes {
+		err := this.send(BuildExaBGPCommand(true, prefix, this.config))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Withdraw 撤回配置中的所有前缀
+func (this *ExaBGPDriver) Withdraw() error {
+	for _, prefix := range this.config.Prefixes {
+		err := this.send(BuildExaBGPCommand(false, prefix, this.config))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (this *ExaBGPDriver) send(command string) error {
+	resp, err := exaBGPHTTPClient.PostForm(this.config.Endpoint, url.Values{
+		"command": []string{command},
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.New("exabgp api returns invalid status code '" + types.String(resp.StatusCode) + "': " + strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// BuildExaBGPCommand 构造ExaBGP API命令
+// 比如：announce route 192.0.2.0/24 next-hop self as-path [ 65000 65000 ] community [ 65000:100 ]
+func BuildExaBGPCommand(isAnnounce bool, prefix string, config *nodeconfigs.NodeBGPConfig) string {
+	var nextHop = config.NextHop
+	if len(nextHop) == 0 {
+		nextHop = "self"
+	}
+
+	var pieces = []string{}
+	if isAnnounce {
+		pieces = append(pieces, "announce")
+	} else {
+		pieces = append(pieces, "withdraw")
+	}
+	pieces = append(pieces, "route", prefix, "next-hop", nextHop)
+
+	if isAnnounce {
+		if len(config.ASPath) > 0 {
+			pieces = append(pieces, "as-path", "[")
+			for _, as := range config.ASPath {
+				pieces = append(pieces, types.String(as))
+			}
+			pieces = append(pieces, "]")
+		}
+		if len(config.Communities) > 0 {
+			pieces = append(pieces, "community", "[")
+			pieces = append(pieces, config.Communities...)
+			pieces = append(pieces, "]")
+		}
+	}
+
+	return strings.Join(pieces, " ")
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package bgpclients
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/iwind/TeaGo/types"
+)
+
+const goBGPDefaultPort = "50051"
+
+// GoBGPDriver 通过gobgp命令操作gobgpd的全局路由表
+// 需要在API节点所在服务器上安装gobgp命令
+type GoBGPDriver struct {
+	config *nodeconfigs.NodeBGPConfig
+}
+
+func NewGoBGPDriver(config *nodeconfigs.NodeBGPConfig) *GoBGPDriver {
+	return &GoBGPDriver{
+		config: config,
+	}
+}
+
+// Announce 宣告配置中的所有前缀
+func (this *GoBGPDriver) Announce() error {
+	for _, prefix := range this.config.Prefixes {
+		args, err := BuildGoBGPArgs(true, prefix, this.config)
+		if err != nil {
+			return err
+		}
+		err = this.exec(args)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Withdraw 撤回配置中的所有前缀
+func (this *GoBGPDriver) Withdraw() error {
+	for _, prefix := range this.config.Prefixes {
+		args, err := BuildGoBGPArgs(false, prefix, this.config)
+		if err != nil {
+			return err
+		}
+		err = this.exec(args)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (this *GoBGPDriver) exec(args []string) error {
+	exe, err := exec.LookPath("gobgp")
+	if err != nil {
+		return errors.New("can not find 'gobgp' command: " + err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var stderr = &bytes.Buffer{}
+	var cmd = exec.CommandContext(ctx, exe, args...)
+	cmd.Stderr = stderr
+	err = cmd.Run()
+	if err != nil {
+		var errString = strings.TrimSpace(stderr.String())
+		if len(errString) > 0 {
+			return errors.New("run gobgp failed: " + errString)
+		}
+		return errors.New("run gobgp failed: " + err.Error())
+	}
+	return nil
+}
+
+// BuildGoBGPArgs 构造gobgp命令参数
+// 比如：-u 127.0.0.1 -p 50051 global rib -a ipv4 add 192.0.2.0/24 nexthop 10.0.0.1 aspath "65000 65000" community 65000:100
+func BuildGoBGPArgs(isAnnounce bool, prefix string, config *nodeconfigs.NodeBGPConfig) ([]string, error) {
+	var host = config.Endpoint
+	var port = goBGPDefaultPort
+	if strings.Contains(host, ":") {
+		h, p, err := net.SplitHostPort(host)
+		if err == nil {
+			host = h
+			port = p
+		} else if net.ParseIP(strings.Trim(host, "[]")) == nil {
+			return nil, errors.New("invalid gobgp endpoint '" + config.Endpoint + "'")
+		} else {
+			host = strings.Trim(host, "[]")
+		}
+	}
+
+	ip, _, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return nil, errors.New("invalid prefix '" + prefix + "'")
+	}
+	var family = "ipv4"
+	if ip.To4() == nil {
+		family = "ipv6"
+	}
+
+	var args = []string{"-u", host, "-p", port, "global", "rib", "-a", family}
+	if !isAnnounce {
+		return append(args, "del", prefix), nil
+	}
+
+	args = append(args, "add", prefix)
+	if len(config.NextHop) > 0 && config.NextHop != "self" {
+		args = append(args, "nexthop", config.NextHop)
+	}
+	if len(config.ASPath) > 0 {
+		var asList = []string{}
+		for _, as := range config.ASPath {
+			asList = append(asList, types.String(as))
+		}
+		args = append(args, "aspath", strings.Join(asList, " "))
+	}
+	if len(config.Communities) > 0 {
+		args = append(args, "community", strings.Join(config.Communities, ","))
+	}
+	return args, nil
+}
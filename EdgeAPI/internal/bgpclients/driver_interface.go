@@ -0,0 +1,32 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package bgpclients
+
+import (
+	"errors"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+)
+
+// DriverInterface BGP宣告驱动接口
+type DriverInterface interface {
+	// Announce 宣告配置中的所有前缀
+	Announce() error
+
+	// Withdraw 撤回配置中的所有前缀
+	Withdraw() error
+}
+
+// NewDriver 根据配置获取驱动
+func NewDriver(config *nodeconfigs.NodeBGPConfig) (DriverInterface, error) {
+	if config == nil {
+		return nil, errors.New("'config' should not be nil")
+	}
+	switch config.Driver {
+	case nodeconfigs.BGPDriverExaBGP:
+		return NewExaBGPDriver(config), nil
+	case nodeconfigs.BGPDriverGoBGP:
+		return NewGoBGPDriver(config), nil
+	}
+	return nil, errors.New("unsupported bgp driver '" + config.Driver + "'")
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package bgpclients_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/bgpclients"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestBuildExaBGPCommand(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var config = &nodeconfigs.NodeBGPConfig{
+		ASPath:      []uint32{65000, 65000},
+		Communities: []string{"65000:100", "65000:200"},
+	}
+	a.IsTrue(bgpclients.BuildExaBGPCommand(true, "192.0.2.0/24", config) == "announce route 192.0.2.0/24 next-hop self as-path [ 65000 65000 ] community [ 65000:100 65000:200 ]")
+	a.IsTrue(bgpclients.BuildExaBGPCommand(false, "192.0.2.0/24", config) == "withdraw route 192.0.2.0/24 next-hop self")
+
+	config.NextHop = "198.51.100.1"
+	config.ASPath = nil
+	config.Communities = nil
+	a.IsTrue(bgpclients.BuildExaBGPCommand(true, "192.0.2.0/24", config) == "announce route 192.0.2.0/24 next-hop 198.51.100.1")
+}
+
+func TestBuildGoBGPArgs(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var config = &nodeconfigs.NodeBGPConfig{
+		Endpoint:    "127.0.0.1",
+		NextHop:     "198.51.100.1",
+		ASPath:      []uint32{65000},
+		Communities: []string{"65000:100", "65000:200"},
+	}
+
+	{
+		args, err := bgpclients.BuildGoBGPArgs(true, "192.0.2.0/24", config)
+		a.IsNil(err)
+		t.Log(args)
+		a.IsTrue(strings.Join(args, "|") == "-u|127.0.0.1|-p|50051|global|rib|-a|ipv4|add|192.0.2.0/24|nexthop|198.51.100.1|aspath|65000|community|65000:100,65000:200")
+	}
+	{
+		config.Endpoint = "[::1]:50052"
+		args, err := bgpclients.BuildGoBGPArgs(false, "2001:db8::/32", config)
+		a.IsNil(err)
+		a.IsTrue(strings.Join(args, "|") == "-u|::1|-p|50052|global|rib|-a|ipv6|del|2001:db8::/32")
+	}
+	{
+		config.Endpoint = "::1"
+		args, err := bgpclients.BuildGoBGPArgs(false, "2001:db8::/32", config)
+		a.IsNil(err)
+		a.IsTrue(args[1] == "::1" && args[3] == "50051")
+	}
+	{
+		_, err := bgpclients.BuildGoBGPArgs(true, "192.0.2.1", config)
+		a.IsNotNil(err)
+	}
+}
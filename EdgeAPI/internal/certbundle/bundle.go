@@ -0,0 +1,105 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certbundle
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"strings"
+)
+
+type Format = string
+
+const (
+	FormatPKCS12 Format = "pkcs12" // PKCS#12，后缀通常为.p12或.pfx
+	FormatJKS    Format = "jks"    // Java KeyStore
+)
+
+// MinPasswordLength 最小密码长度，和Java keytool的要求保持一致
+const MinPasswordLength = 6
+
+// Bundle 证书和私钥包
+type Bundle struct {
+	Alias        string              // 别名
+	Certs        []*x509.Certificate // 证书链，第一个为证书本身
+	PKCS8KeyData []byte              // PKCS#8格式的私钥
+}
+
+// ParseBundle 从PEM格式的证书链和私钥中分析证书包
+func ParseBundle(certData []byte, keyData []byte, alias string) (*Bundle, error) {
+	if len(certData) == 0 {
+		return nil, errors.New("cert data should not be empty")
+	}
+	if len(keyData) == 0 {
+		return nil, errors.New("key data should not be empty")
+	}
+
+	// 检查证书和私钥是否匹配
+	pair, err := tls.X509KeyPair(certData, keyData)
+	if err != nil {
+		return nil, err
+	}
+
+	var bundle = &Bundle{
+		Alias: strings.TrimSpace(alias),
+	}
+	for _, certDER := range pair.Certificate {
+		cert, err := x509.ParseCertificate(certDER)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Certs = append(bundle.Certs, cert)
+	}
+	if len(bundle.Certs) == 0 {
+		return nil, errors.New("no certificates found")
+	}
+
+	bundle.PKCS8KeyData, err = x509.MarshalPKCS8PrivateKey(pair.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bundle.Alias) == 0 {
+		bundle.Alias = bundle.Certs[0].Subject.CommonName
+		if len(bundle.Alias) == 0 && len(bundle.Certs[0].DNSNames) > 0 {
+			bundle.Alias = bundle.Certs[0].DNSNames[0]
+		}
+		if len(bundle.Alias) == 0 {
+			bundle.Alias = "goedge"
+		}
+	}
+
+	return bundle, nil
+}
+
+// Encode 生成指定格式的证书包
+func Encode(format Format, certData []byte, keyData []byte, alias string, password string) ([]byte, error) {
+	if len(password) < MinPasswordLength {
+		return nil, errors.New("password should be at least 6 characters")
+	}
+
+	bundle, err := ParseBundle(certData, keyData, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case FormatPKCS12:
+		return EncodePKCS12(bundle, password)
+	case FormatJKS:
+		return EncodeJKS(bundle, password)
+	}
+	return nil, errors.New("invalid format '" + format + "'")
+}
+
+// FindFormatExt 获取格式对应的文件扩展名
+func FindFormatExt(format Format) string {
+	switch format {
+	case FormatPKCS12:
+		return ".p12"
+	case FormatJKS:
+		return ".jks"
+	}
+	return ""
+}
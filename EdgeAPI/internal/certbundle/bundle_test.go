@@ -0,0 +1,296 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certbundle_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/certbundle"
+	"github.com/iwind/TeaGo/assert"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// 生成CA证书和由CA签发的证书
+func testGenerateChain(t *testing.T, privateKey crypto.Signer) (certData []byte, keyData []byte) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var caTemplate = &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-1 * time.Minute),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var template = &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now().Add(-1 * time.Minute),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, privateKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkcs8Data, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certData = append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})...)
+	keyData = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8Data})
+	return
+}
+
+func testKeys(t *testing.T) map[string]crypto.Signer {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]crypto.Signer{
+		"ecdsa": ecdsaKey,
+		"rsa":   rsaKey,
+	}
+}
+
+func TestEncode_PKCS12(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	for keyType, privateKey := range testKeys(t) {
+		certData, keyData := testGenerateChain(t, privateKey)
+
+		data, err := certbundle.Encode(certbundle.FormatPKCS12, certData, keyData, "My Cert", "123456")
+		if err != nil {
+			t.Fatal(keyType, err)
+		}
+
+		// 使用第三方实现解码
+		blocks, err := pkcs12.ToPEM(data, "123456")
+		if err != nil {
+			t.Fatal(keyType, err)
+		}
+
+		var countCerts = 0
+		var hasKey = false
+		for _, block := range blocks {
+			switch block.Type {
+			case "CERTIFICATE":
+				countCerts++
+			case "PRIVATE KEY":
+				hasKey = true
+				a.IsTrue(block.Headers["friendlyName"] == "My Cert")
+				a.IsTrue(len(block.Headers["localKeyId"]) > 0)
+			}
+		}
+		a.IsTrue(countCerts == 2)
+		a.IsTrue(hasKey)
+
+		// 私钥和证书匹配
+		decodedKey, decodedCert, err := pkcs12.Decode(testPKCS12WithoutChain(t, privateKey), "123456")
+		if err != nil {
+			t.Fatal(keyType, err)
+		}
+		a.IsTrue(decodedCert.Subject.CommonName == "example.com")
+		a.IsNotNil(decodedKey)
+
+		// 错误的密码
+		_, err = pkcs12.ToPEM(data, "654321")
+		a.IsNotNil(err)
+	}
+}
+
+// x/crypto/pkcs12.Decode只支持一个证书
+func testPKCS12WithoutChain(t *testing.T, privateKey crypto.Signer) []byte {
+	certData, keyData := testGenerateChain(t, privateKey)
+	block, _ := pem.Decode(certData)
+	data, err := certbundle.Encode(certbundle.FormatPKCS12, pem.EncodeToMemory(block), keyData, "", "123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestEncode_JKS(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	for keyType, privateKey := range testKeys(t) {
+		certData, keyData := testGenerateChain(t, privateKey)
+
+		data, err := certbundle.Encode(certbundle.FormatJKS, certData, keyData, "My Cert", "123456")
+		if err != nil {
+			t.Fatal(keyType, err)
+		}
+
+		alias, plainKey, certs := testDecodeJKS(t, data, "123456")
+		a.IsTrue(alias == "my cert")
+		a.IsTrue(len(certs) == 2)
+
+		parsedKey, err := x509.ParsePKCS8PrivateKey(plainKey)
+		if err != nil {
+			t.Fatal(keyType, err)
+		}
+		a.IsTrue(parsedKey.(interface{ Equal(crypto.PrivateKey) bool }).Equal(privateKey))
+
+		leaf, err := x509.ParseCertificate(certs[0])
+		if err != nil {
+			t.Fatal(keyType, err)
+		}
+		a.IsTrue(leaf.Subject.CommonName == "example.com")
+	}
+}
+
+func TestEncode_Invalid(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var keys = testKeys(t)
+	certData, keyData := testGenerateChain(t, keys["ecdsa"])
+	_, otherKeyData := testGenerateChain(t, keys["rsa"])
+
+	// 密码太短
+	_, err := certbundle.Encode(certbundle.FormatPKCS12, certData, keyData, "", "123")
+	a.IsNotNil(err)
+
+	// 私钥不匹配
+	_, err = certbundle.Encode(certbundle.FormatPKCS12, certData, otherKeyData, "", "123456")
+	a.IsNotNil(err)
+
+	// 格式错误
+	_, err = certbundle.Encode("pem", certData, keyData, "", "123456")
+	a.IsNotNil(err)
+
+	// 缺少私钥
+	_, err = certbundle.Encode(certbundle.FormatJKS, certData, nil, "", "123456")
+	a.IsNotNil(err)
+}
+
+// 按照JKS格式解析，并校验完整性
+func testDecodeJKS(t *testing.T, data []byte, password string) (alias string, plainKey []byte, certs [][]byte) {
+	var passwordBytes = []byte{}
+	for _, c := range utf16.Encode([]rune(password)) {
+		passwordBytes = append(passwordBytes, byte(c>>8), byte(c))
+	}
+
+	// 校验
+	if len(data) < sha1.Size {
+		t.Fatal("invalid data")
+	}
+	var body = data[:len(data)-sha1.Size]
+	var h = sha1.New()
+	h.Write(passwordBytes)
+	h.Write([]byte("Mighty Aphrodite"))
+	h.Write(body)
+	if !bytes.Equal(h.Sum(nil), data[len(data)-sha1.Size:]) {
+		t.Fatal("invalid keystore digest")
+	}
+
+	var reader = bytes.NewReader(body)
+	var readUint32 = func() uint32 {
+		var v uint32
+		err := binary.Read(reader, binary.BigEndian, &v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	var readUTF = func() string {
+		var l uint16
+		err := binary.Read(reader, binary.BigEndian, &l)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b = make([]byte, l)
+		_, err = reader.Read(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+	var readBytes = func() []byte {
+		var b = make([]byte, readUint32())
+		_, err := reader.Read(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	if readUint32() != 0xFEEDFEED || readUint32() != 2 || readUint32() != 1 || readUint32() != 1 {
+		t.Fatal("invalid keystore header")
+	}
+	alias = readUTF()
+	var timestamp int64
+	_ = binary.Read(reader, binary.BigEndian, &timestamp)
+
+	// 私钥
+	var keyInfo = struct {
+		Algorithm     pkix.AlgorithmIdentifier
+		EncryptedData []byte
+	}{}
+	_, err := asn1.Unmarshal(readBytes(), &keyInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var protectedKey = keyInfo.EncryptedData
+	var salt = protectedKey[:sha1.Size]
+	var encryptedKey = protectedKey[sha1.Size : len(protectedKey)-sha1.Size]
+	plainKey = make([]byte, len(encryptedKey))
+	var digest = salt
+	for offset := 0; offset < len(encryptedKey); offset += sha1.Size {
+		var dh = sha1.New()
+		dh.Write(passwordBytes)
+		dh.Write(digest)
+		digest = dh.Sum(nil)
+		for i := 0; i < sha1.Size && offset+i < len(encryptedKey); i++ {
+			plainKey[offset+i] = encryptedKey[offset+i] ^ digest[i]
+		}
+	}
+	var checkHash = sha1.New()
+	checkHash.Write(passwordBytes)
+	checkHash.Write(plainKey)
+	if !bytes.Equal(checkHash.Sum(nil), protectedKey[len(protectedKey)-sha1.Size:]) {
+		t.Fatal("invalid key digest")
+	}
+
+	// 证书
+	var countCerts = readUint32()
+	for i := uint32(0); i < countCerts; i++ {
+		if readUTF() != "X.509" {
+			t.Fatal("invalid cert type")
+		}
+		certs = append(certs, readBytes())
+	}
+	return
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certbundle
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+const (
+	jksMagic                uint32 = 0xFEEDFEED
+	jksVersion              uint32 = 2
+	jksTagPrivateKey        uint32 = 1
+	jksDigestWhitener              = "Mighty Aphrodite"
+	jksKeyProtectorSaltSize        = sha1.Size
+)
+
+// Sun的私有私钥保护算法
+var oidJKSKeyProtector = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 42, 2, 17, 1, 1}
+
+// EncodeJKS 生成使用密码保护的Java KeyStore文件
+// 私钥使用和KeyStore相同的密码保护
+func EncodeJKS(bundle *Bundle, password string) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, errors.New("password should not be empty")
+	}
+	var passwordBytes = jksPasswordBytes(password)
+
+	protectedKey, err := jksProtectKey(bundle.PKCS8KeyData, passwordBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf = &bytes.Buffer{}
+	var writeUint32 = func(v uint32) {
+		_ = binary.Write(buf, binary.BigEndian, v)
+	}
+	var writeUTF = func(s string) error {
+		if len(s) > 0xFFFF {
+			return errors.New("string too long")
+		}
+		_ = binary.Write(buf, binary.BigEndian, uint16(len(s)))
+		buf.WriteString(s)
+		return nil
+	}
+
+	writeUint32(jksMagic)
+	writeUint32(jksVersion)
+	writeUint32(1) // 条目数量
+
+	// 私钥条目
+	writeUint32(jksTagPrivateKey)
+	err = writeUTF(strings.ToLower(bundle.Alias)) // JKS中的别名不区分大小写
+	if err != nil {
+		return nil, err
+	}
+	_ = binary.Write(buf, binary.BigEndian, time.Now().UnixMilli())
+	writeUint32(uint32(len(protectedKey)))
+	buf.Write(protectedKey)
+
+	// 证书链
+	writeUint32(uint32(len(bundle.Certs)))
+	for _, cert := range bundle.Certs {
+		err = writeUTF("X.509")
+		if err != nil {
+			return nil, err
+		}
+		writeUint32(uint32(len(cert.Raw)))
+		buf.Write(cert.Raw)
+	}
+
+	// 完整性校验
+	var h = sha1.New()
+	h.Write(passwordBytes)
+	h.Write([]byte(jksDigestWhitener))
+	h.Write(buf.Bytes())
+	buf.Write(h.Sum(nil))
+
+	return buf.Bytes(), nil
+}
+
+// 使用sun.security.provider.KeyProtector算法保护私钥
+func jksProtectKey(plainKey []byte, passwordBytes []byte) ([]byte, error) {
+	var salt = make([]byte, jksKeyProtectorSaltSize)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	var encryptedKey = jksXORKey(plainKey, passwordBytes, salt)
+
+	var h = sha1.New()
+	h.Write(passwordBytes)
+	h.Write(plainKey)
+
+	var protectedKey = make([]byte, 0, len(salt)+len(encryptedKey)+sha1.Size)
+	protectedKey = append(protectedKey, salt...)
+	protectedKey = append(protectedKey, encryptedKey...)
+	protectedKey = append(protectedKey, h.Sum(nil)...)
+
+	return asn1.Marshal(encryptedPrivateKeyInfo{
+		AlgorithmIdentifier: pkix.AlgorithmIdentifier{
+			Algorithm:  oidJKSKeyProtector,
+			Parameters: asn1.NullRawValue,
+		},
+		EncryptedData: protectedKey,
+	})
+}
+
+// 使用密码和盐值生成的密钥流对数据进行异或，加密和解密使用同样的方法
+func jksXORKey(data []byte, passwordBytes []byte, salt []byte) []byte {
+	var result = make([]byte, len(data))
+	var digest = salt
+	for offset := 0; offset < len(data); offset += sha1.Size {
+		var h = sha1.New()
+		h.Write(passwordBytes)
+		h.Write(digest)
+		digest = h.Sum(nil)
+
+		for i := 0; i < sha1.Size && offset+i < len(data); i++ {
+			result[offset+i] = data[offset+i] ^ digest[i]
+		}
+	}
+	return result
+}
+
+// 和Java中char[]的转换方式一致，使用UTF-16BE编码
+func jksPasswordBytes(password string) []byte {
+	var result = []byte{}
+	for _, c := range utf16.Encode([]rune(password)) {
+		result = append(result, byte(c>>8), byte(c))
+	}
+	return result
+}
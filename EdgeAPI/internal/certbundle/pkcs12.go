@@ -0,0 +1,356 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certbundle
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"unicode/utf16"
+)
+
+// PKCS#12使用兼容性最好的传统算法：pbeWithSHAAnd3-KeyTripleDES-CBC加密，HMAC-SHA1校验
+// Java 8、Windows和OpenSSL都可以直接导入
+const (
+	pkcs12Iterations = 2048
+	pkcs12SaltSize   = 8
+)
+
+var (
+	oidDataContentType               = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidEncryptedDataContentType      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 6}
+	oidFriendlyName                  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 20}
+	oidLocalKeyID                    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 21}
+	oidCertTypeX509Certificate       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+	oidPKCS8ShroudedKeyBag           = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertBag                       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidPBEWithSHAAnd3KeyTripleDESCBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+	oidSHA1                          = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+)
+
+type pfxPdu struct {
+	Version  int
+	AuthSafe contentInfo
+	MacData  macData
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"tag:0,explicit,optional"`
+}
+
+type encryptedData struct {
+	Version              int
+	EncryptedContentInfo encryptedContentInfo
+}
+
+type encryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedContent           []byte `asn1:"tag:0,optional"`
+}
+
+type safeBag struct {
+	Id         asn1.ObjectIdentifier
+	Value      asn1.RawValue     `asn1:"tag:0,explicit"`
+	Attributes []pkcs12Attribute `asn1:"set,optional"`
+}
+
+type pkcs12Attribute struct {
+	Id    asn1.ObjectIdentifier
+	Value asn1.RawValue `asn1:"set"`
+}
+
+type certBag struct {
+	Id   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+type encryptedPrivateKeyInfo struct {
+	AlgorithmIdentifier pkix.AlgorithmIdentifier
+	EncryptedData       []byte
+}
+
+type pbeParams struct {
+	Salt       []byte
+	Iterations int
+}
+
+type macData struct {
+	Mac        digestInfo
+	MacSalt    []byte
+	Iterations int
+}
+
+type digestInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Digest    []byte
+}
+
+// EncodePKCS12 生成使用密码保护的PKCS#12文件
+func EncodePKCS12(bundle *Bundle, password string) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, errors.New("password should not be empty")
+	}
+	encodedPassword, err := bmpString(password)
+	if err != nil {
+		return nil, err
+	}
+
+	// 证书和私钥关联ID
+	var localKeyId = sha1.Sum(bundle.Certs[0].Raw)
+	localKeyIdAttr, err := newPKCS12Attribute(oidLocalKeyID, localKeyId[:])
+	if err != nil {
+		return nil, err
+	}
+	var attrs = []pkcs12Attribute{localKeyIdAttr}
+	if len(bundle.Alias) > 0 {
+		encodedAlias, err := bmpString(bundle.Alias)
+		if err != nil {
+			return nil, err
+		}
+		// 去掉结尾的0
+		friendlyNameAttr, err := newPKCS12Attribute(oidFriendlyName, asn1.RawValue{Tag: asn1.TagBMPString, Bytes: encodedAlias[:len(encodedAlias)-2]})
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, friendlyNameAttr)
+	}
+
+	// 证书
+	var certBags = []safeBag{}
+	for index, cert := range bundle.Certs {
+		bagData, err := asn1.Marshal(certBag{
+			Id:   oidCertTypeX509Certificate,
+			Data: cert.Raw,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var bag = safeBag{
+			Id:    oidCertBag,
+			Value: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: bagData},
+		}
+		if index == 0 {
+			bag.Attributes = attrs
+		}
+		certBags = append(certBags, bag)
+	}
+	certsContentInfo, err := newEncryptedContentInfo(certBags, encodedPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	// 私钥
+	keyInfo, err := encryptPBE(bundle.PKCS8KeyData, encodedPassword)
+	if err != nil {
+		return nil, err
+	}
+	keyInfoData, err := asn1.Marshal(*keyInfo)
+	if err != nil {
+		return nil, err
+	}
+	keyContentInfo, err := newDataContentInfo([]safeBag{
+		{
+			Id:         oidPKCS8ShroudedKeyBag,
+			Value:      asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: keyInfoData},
+			Attributes: attrs,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	authSafeData, err := asn1.Marshal([]contentInfo{certsContentInfo, keyContentInfo})
+	if err != nil {
+		return nil, err
+	}
+	authSafeContent, err := asn1.Marshal(authSafeData)
+	if err != nil {
+		return nil, err
+	}
+
+	// MAC
+	var salt = make([]byte, pkcs12SaltSize)
+	_, err = rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	var macKey = pkcs12KDF(salt, encodedPassword, pkcs12Iterations, 3, 20)
+	var mac = hmac.New(sha1.New, macKey)
+	mac.Write(authSafeData)
+
+	return asn1.Marshal(pfxPdu{
+		Version: 3,
+		AuthSafe: contentInfo{
+			ContentType: oidDataContentType,
+			Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: authSafeContent},
+		},
+		MacData: macData{
+			Mac: digestInfo{
+				Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+				Digest:    mac.Sum(nil),
+			},
+			MacSalt:    salt,
+			Iterations: pkcs12Iterations,
+		},
+	})
+}
+
+func newPKCS12Attribute(oid asn1.ObjectIdentifier, value any) (pkcs12Attribute, error) {
+	valueData, err := asn1.Marshal(value)
+	if err != nil {
+		return pkcs12Attribute{}, err
+	}
+	return pkcs12Attribute{
+		Id:    oid,
+		Value: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: valueData},
+	}, nil
+}
+
+// 未加密的内容
+func newDataContentInfo(bags []safeBag) (contentInfo, error) {
+	bagsData, err := asn1.Marshal(bags)
+	if err != nil {
+		return contentInfo{}, err
+	}
+	content, err := asn1.Marshal(bagsData)
+	if err != nil {
+		return contentInfo{}, err
+	}
+	return contentInfo{
+		ContentType: oidDataContentType,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: content},
+	}, nil
+}
+
+// 加密的内容
+func newEncryptedContentInfo(bags []safeBag, encodedPassword []byte) (contentInfo, error) {
+	bagsData, err := asn1.Marshal(bags)
+	if err != nil {
+		return contentInfo{}, err
+	}
+	info, err := encryptPBE(bagsData, encodedPassword)
+	if err != nil {
+		return contentInfo{}, err
+	}
+	content, err := asn1.Marshal(encryptedData{
+		Version: 0,
+		EncryptedContentInfo: encryptedContentInfo{
+			ContentType:                oidDataContentType,
+			ContentEncryptionAlgorithm: info.AlgorithmIdentifier,
+			EncryptedContent:           info.EncryptedData,
+		},
+	})
+	if err != nil {
+		return contentInfo{}, err
+	}
+	return contentInfo{
+		ContentType: oidEncryptedDataContentType,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: content},
+	}, nil
+}
+
+// 使用pbeWithSHAAnd3-KeyTripleDES-CBC加密
+func encryptPBE(data []byte, encodedPassword []byte) (*encryptedPrivateKeyInfo, error) {
+	var salt = make([]byte, pkcs12SaltSize)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	paramsData, err := asn1.Marshal(pbeParams{
+		Salt:       salt,
+		Iterations: pkcs12Iterations,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var key = pkcs12KDF(salt, encodedPassword, pkcs12Iterations, 1, 24)
+	var iv = pkcs12KDF(salt, encodedPassword, pkcs12Iterations, 2, 8)
+	block, err := des.NewTripleDESCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// PKCS#7 padding
+	var padding = block.BlockSize() - len(data)%block.BlockSize()
+	var encrypted = append(append([]byte{}, data...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	return &encryptedPrivateKeyInfo{
+		AlgorithmIdentifier: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPBEWithSHAAnd3KeyTripleDESCBC,
+			Parameters: asn1.RawValue{FullBytes: paramsData},
+		},
+		EncryptedData: encrypted,
+	}, nil
+}
+
+// pkcs12KDF PKCS#12密钥生成算法，参考 RFC 7292 附录B.2
+// id: 1表示加密密钥，2表示IV，3表示MAC密钥
+func pkcs12KDF(salt []byte, encodedPassword []byte, iterations int, id byte, size int) []byte {
+	const u = sha1.Size
+	const v = 64
+
+	var fillBlocks = func(data []byte) []byte {
+		if len(data) == 0 {
+			return nil
+		}
+		var l = (len(data) + v - 1) / v * v
+		var result = make([]byte, l)
+		for i := 0; i < l; i++ {
+			result[i] = data[i%len(data)]
+		}
+		return result
+	}
+
+	var d = bytes.Repeat([]byte{id}, v)
+	var i = append(fillBlocks(salt), fillBlocks(encodedPassword)...)
+
+	var result = []byte{}
+	for len(result) < size {
+		var h = sha1.New()
+		h.Write(d)
+		h.Write(i)
+		var a = h.Sum(nil)
+		for j := 1; j < iterations; j++ {
+			var sum = sha1.Sum(a)
+			a = sum[:]
+		}
+		result = append(result, a...)
+
+		// I_j = (I_j + B + 1) mod 2^(v*8)
+		var b = make([]byte, v)
+		for j := 0; j < v; j++ {
+			b[j] = a[j%u]
+		}
+		for j := 0; j < len(i); j += v {
+			var carry = 1
+			for k := v - 1; k >= 0; k-- {
+				var sum = int(i[j+k]) + int(b[k]) + carry
+				i[j+k] = byte(sum)
+				carry = sum >> 8
+			}
+		}
+	}
+	return result[:size]
+}
+
+// 转换为以0结尾的BMPString
+func bmpString(s string) ([]byte, error) {
+	var result = make([]byte, 0, 2*len(s)+2)
+	for _, r := range s {
+		if t, _ := utf16.EncodeRune(r); t != 0xfffd {
+			return nil, errors.New("string contains characters that cannot be encoded in UCS-2")
+		}
+		result = append(result, byte(r/256), byte(r%256))
+	}
+	return append(result, 0, 0), nil
+}
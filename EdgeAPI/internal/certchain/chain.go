@@ -0,0 +1,172 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certchain
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"sync"
+	"time"
+)
+
+// 最大证书链长度，防止恶意证书导致无限循环
+const maxChainDepth = 6
+
+// Result 证书链补全结果
+type Result struct {
+	CertData     []byte // 补全后的证书链，PEM格式
+	CountFetched int    // 通过AIA获取的中间证书数量
+	IsComplete   bool   // 是否已经连接到根证书
+	FetchError   error  // 获取中间证书时的错误，只在证书链不完整时有值
+}
+
+var systemRoots *x509.CertPool
+var systemRootsOnce = sync.Once{}
+
+// Complete 校验并补全证书链
+// 证书链按照 证书 -> 中间证书 的顺序重新排列，缺失的中间证书通过证书中的AIA地址获取，根证书不会被加入证书链
+func Complete(certData []byte) (*Result, error) {
+	var certs = []*x509.Certificate{}
+	var data = certData
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.New("parse cert failed: " + err.Error())
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificates found")
+	}
+
+	var result = &Result{}
+	var chain = []*x509.Certificate{certs[0]}
+	var pool = certs[1:]
+	var isReordered = false
+
+	for len(chain) < maxChainDepth {
+		var current = chain[len(chain)-1]
+		if isSelfSigned(current) {
+			result.IsComplete = true
+			break
+		}
+
+		// 从上传的证书中查找
+		var issuerIndex = -1
+		for index, cert := range pool {
+			if isIssuedBy(current, cert) {
+				issuerIndex = index
+				break
+			}
+		}
+		if issuerIndex >= 0 {
+			if issuerIndex > 0 {
+				isReordered = true
+			}
+			chain = append(chain, pool[issuerIndex])
+			pool = append(pool[:issuerIndex:issuerIndex], pool[issuerIndex+1:]...)
+			continue
+		}
+
+		// 已经可以连接到系统根证书
+		if isTrustedBySystem(current) {
+			result.IsComplete = true
+			break
+		}
+
+		// 通过AIA获取
+		issuer, err := fetchIssuer(current)
+		if err != nil {
+			result.FetchError = err
+			break
+		}
+		if issuer == nil {
+			break
+		}
+		if isSelfSigned(issuer) {
+			result.IsComplete = true
+			break
+		}
+		chain = append(chain, issuer)
+		result.CountFetched++
+	}
+
+	if len(pool) > 0 {
+		return nil, errors.New("the cert '" + certName(pool[0]) + "' is not a part of the cert chain")
+	}
+
+	if result.IsComplete {
+		result.FetchError = nil
+	}
+
+	// 没有变化时保留原始数据
+	if result.CountFetched == 0 && !isReordered {
+		result.CertData = certData
+		return result, nil
+	}
+
+	var buf = &bytes.Buffer{}
+	for _, cert := range chain {
+		err := pem.Encode(buf, &pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: cert.Raw,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	result.CertData = buf.Bytes()
+	return result, nil
+}
+
+// 判断是否为自签名证书
+func isSelfSigned(cert *x509.Certificate) bool {
+	return isIssuedBy(cert, cert)
+}
+
+// 判断证书是否由某个证书签发
+func isIssuedBy(cert *x509.Certificate, issuer *x509.Certificate) bool {
+	if !bytes.Equal(cert.RawIssuer, issuer.RawSubject) {
+		return false
+	}
+	return cert.CheckSignatureFrom(issuer) == nil
+}
+
+// 判断证书是否由系统根证书直接签发
+func isTrustedBySystem(cert *x509.Certificate) bool {
+	systemRootsOnce.Do(func() {
+		systemRoots, _ = x509.SystemCertPool()
+	})
+	if systemRoots == nil {
+		return false
+	}
+
+	// 只检查证书链关系，不检查有效期
+	var currentTime = time.Now()
+	if currentTime.After(cert.NotAfter) || currentTime.Before(cert.NotBefore) {
+		currentTime = cert.NotBefore.Add(1 * time.Second)
+	}
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:       systemRoots,
+		CurrentTime: currentTime,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err == nil
+}
+
+func certName(cert *x509.Certificate) string {
+	if len(cert.Subject.CommonName) > 0 {
+		return cert.Subject.CommonName
+	}
+	return cert.Subject.String()
+}
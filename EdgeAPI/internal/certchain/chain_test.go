@@ -0,0 +1,227 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certchain
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iwind/TeaGo/assert"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func (this *testCert) PEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: this.cert.Raw})
+}
+
+func testIssue(t *testing.T, name string, isCA bool, parent *testCert, aiaURL string) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	var template = &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-1 * time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	if isCA {
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		template.DNSNames = []string{name}
+	}
+	if len(aiaURL) > 0 {
+		template.IssuingCertificateURL = []string{aiaURL}
+	}
+
+	var parentCert = template
+	var parentKey = key
+	if parent != nil {
+		parentCert = parent.cert
+		parentKey = parent.key
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key}
+}
+
+// 只包含证书的PKCS#7文件
+func testPKCS7(t *testing.T, certs ...*x509.Certificate) []byte {
+	var rawCerts = []byte{}
+	for _, cert := range certs {
+		rawCerts = append(rawCerts, cert.Raw...)
+	}
+	type signedData struct {
+		Version          int
+		DigestAlgorithms asn1.RawValue
+		ContentInfo      struct{ ContentType asn1.ObjectIdentifier }
+		Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+		SignerInfos      asn1.RawValue
+	}
+	signedDataDER, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true},
+		ContentInfo:      struct{ ContentType asn1.ObjectIdentifier }{ContentType: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: rawCerts},
+		SignerInfos:      asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := asn1.Marshal(struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue `asn1:"explicit,tag:0"`
+	}{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: signedDataDER},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func testServer(t *testing.T, files map[string][]byte) *httptest.Server {
+	var server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		data, ok := files[req.URL.Path]
+		if !ok {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = writer.Write(data)
+	}))
+
+	// 测试时允许访问本地地址
+	var oldClient = httpClient
+	httpClient = server.Client()
+	t.Cleanup(func() {
+		httpClient = oldClient
+		server.Close()
+	})
+	return server
+}
+
+func TestComplete_FetchMissing(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var files = map[string][]byte{}
+	var server = testServer(t, files)
+
+	var root = testIssue(t, "Test Root", true, nil, "")
+	var intermediate1 = testIssue(t, "Test Intermediate 1", true, root, server.URL+"/root.crt")
+	var intermediate2 = testIssue(t, "Test Intermediate 2", true, intermediate1, server.URL+"/intermediate1.p7c")
+	var leaf = testIssue(t, "example.com", false, intermediate2, server.URL+"/intermediate2.pem")
+
+	files["/root.crt"] = root.cert.Raw
+	files["/intermediate1.p7c"] = testPKCS7(t, intermediate1.cert)
+	files["/intermediate2.pem"] = intermediate2.PEM()
+
+	result, err := Complete(leaf.PEM())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(result.IsComplete)
+	a.IsNil(result.FetchError)
+	a.IsTrue(result.CountFetched == 2)
+
+	// 根证书不加入证书链
+	var expected = append(append(leaf.PEM(), intermediate2.PEM()...), intermediate1.PEM()...)
+	a.IsTrue(bytes.Equal(result.CertData, expected))
+}
+
+func TestComplete_Reorder(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var root = testIssue(t, "Test Root", true, nil, "")
+	var intermediate1 = testIssue(t, "Test Intermediate 1", true, root, "")
+	var intermediate2 = testIssue(t, "Test Intermediate 2", true, intermediate1, "")
+	var leaf = testIssue(t, "example.com", false, intermediate2, "")
+
+	// 顺序正确时保持不变
+	var certData = append(append(leaf.PEM(), intermediate2.PEM()...), intermediate1.PEM()...)
+	result, err := Complete(certData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsFalse(result.IsComplete)
+	a.IsTrue(result.CountFetched == 0)
+	a.IsTrue(bytes.Equal(result.CertData, certData))
+
+	// 顺序错误时重新排列
+	result, err = Complete(append(append(append(leaf.PEM(), root.PEM()...), intermediate1.PEM()...), intermediate2.PEM()...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(result.IsComplete)
+	a.IsTrue(bytes.Equal(result.CertData, append(append(append(leaf.PEM(), intermediate2.PEM()...), intermediate1.PEM()...), root.PEM()...)))
+}
+
+func TestComplete_Invalid(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var root = testIssue(t, "Test Root", true, nil, "")
+	var otherRoot = testIssue(t, "Other Root", true, nil, "")
+	var leaf = testIssue(t, "example.com", false, root, "")
+
+	// 和证书无关的证书
+	_, err := Complete(append(leaf.PEM(), otherRoot.PEM()...))
+	a.IsNotNil(err)
+
+	// 没有证书
+	_, err = Complete([]byte("hello"))
+	a.IsNotNil(err)
+}
+
+func TestComplete_FetchError(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var server = testServer(t, map[string][]byte{})
+	var root = testIssue(t, "Test Root", true, nil, "")
+	var leaf = testIssue(t, "example.com", false, root, server.URL+"/not-found.crt")
+
+	result, err := Complete(leaf.PEM())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsFalse(result.IsComplete)
+	a.IsNotNil(result.FetchError)
+	a.IsTrue(bytes.Equal(result.CertData, leaf.PEM()))
+}
+
+func TestFetchClient_DenyPrivate(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {}))
+	defer server.Close()
+
+	_, err := fetchCerts(server.URL + "/a.crt")
+	a.IsNotNil(err)
+	t.Log(err)
+
+	_, err = fetchCerts("file:///etc/passwd")
+	a.IsNotNil(err)
+}
@@ -0,0 +1,172 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certchain
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+const (
+	fetchTimeout = 5 * time.Second
+	maxFetchSize = 64 << 10 // 中间证书通常只有几KB
+)
+
+// AIA地址来自用户上传的证书，不允许访问内网地址
+var httpClient = newFetchClient()
+
+var oidSignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+
+func newFetchClient() *http.Client {
+	var dialer = &net.Dialer{
+		Timeout: fetchTimeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			var ip = net.ParseIP(host)
+			if ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() || ip.IsLoopback() {
+				return errors.New("address '" + host + "' is not allowed")
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: fetchTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			MaxIdleConnsPerHost: 4,
+			IdleConnTimeout:     1 * time.Minute,
+		},
+	}
+}
+
+// FindIssuer 查找证书的签发者证书
+// 先从已有的证书中查找，找不到时再通过AIA获取
+func FindIssuer(cert *x509.Certificate, candidates []*x509.Certificate) (*x509.Certificate, error) {
+	for _, candidate := range candidates {
+		if isIssuedBy(cert, candidate) {
+			return candidate, nil
+		}
+	}
+	issuer, err := fetchIssuer(cert)
+	if err != nil {
+		return nil, err
+	}
+	if issuer == nil {
+		return nil, errors.New("can not find the issuer of '" + certName(cert) + "'")
+	}
+	return issuer, nil
+}
+
+// 通过AIA获取证书的签发者证书
+func fetchIssuer(cert *x509.Certificate) (*x509.Certificate, error) {
+	var lastErr error
+	for _, issuerURL := range cert.IssuingCertificateURL {
+		issuers, err := fetchCerts(issuerURL)
+		if err != nil {
+			lastErr = errors.New("fetch '" + issuerURL + "' failed: " + err.Error())
+			continue
+		}
+		for _, issuer := range issuers {
+			if isIssuedBy(cert, issuer) {
+				return issuer, nil
+			}
+		}
+		lastErr = errors.New("the cert from '" + issuerURL + "' is not the issuer of '" + certName(cert) + "'")
+	}
+	return nil, lastErr
+}
+
+// 下载证书，支持DER、PEM和PKCS#7格式
+func fetchCerts(issuerURL string) ([]*x509.Certificate, error) {
+	u, err := url.Parse(issuerURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.New("unsupported scheme '" + u.Scheme + "'")
+	}
+
+	resp, err := httpClient.Get(issuerURL)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("invalid response status: " + resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxFetchSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxFetchSize {
+		return nil, errors.New("response is too large")
+	}
+
+	return parseCerts(data)
+}
+
+func parseCerts(data []byte) ([]*x509.Certificate, error) {
+	// PEM
+	block, _ := pem.Decode(data)
+	if block != nil {
+		data = block.Bytes
+	}
+
+	// DER
+	cert, err := x509.ParseCertificate(data)
+	if err == nil {
+		return []*x509.Certificate{cert}, nil
+	}
+
+	// PKCS#7
+	certs, pkcs7Err := parsePKCS7Certs(data)
+	if pkcs7Err == nil {
+		return certs, nil
+	}
+
+	return nil, err
+}
+
+// 从只包含证书的PKCS#7 SignedData中读取证书
+func parsePKCS7Certs(data []byte) ([]*x509.Certificate, error) {
+	var contentInfo struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue `asn1:"explicit,tag:0"`
+	}
+	_, err := asn1.Unmarshal(data, &contentInfo)
+	if err != nil {
+		return nil, err
+	}
+	if !contentInfo.ContentType.Equal(oidSignedData) {
+		return nil, errors.New("not a signed data")
+	}
+
+	var signedData struct {
+		Version          int
+		DigestAlgorithms asn1.RawValue
+		ContentInfo      asn1.RawValue
+		Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	}
+	_, err = asn1.Unmarshal(contentInfo.Content.Bytes, &signedData)
+	if err != nil {
+		return nil, err
+	}
+	if len(signedData.Certificates.Bytes) == 0 {
+		return nil, errors.New("no certificates found")
+	}
+	return x509.ParseCertificates(signedData.Certificates.Bytes)
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certchain
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+// OCSPResult OCSP查询结果
+type OCSPResult struct {
+	Status           int   // ocsp.Good、ocsp.Revoked、ocsp.Unknown
+	RevokedAt        int64 // 吊销时间
+	RevocationReason int   // 吊销原因
+}
+
+// QueryOCSP 向证书中的OCSP服务器查询证书状态
+// 如果证书中没有OCSP服务器地址，则返回nil
+func QueryOCSP(cert *x509.Certificate, issuer *x509.Certificate) (*OCSPResult, error) {
+	if len(cert.OCSPServer) == 0 {
+		return nil, nil
+	}
+
+	reqData, err := ocsp.CreateRequest(cert, issuer, &ocsp.RequestOptions{
+		Hash: crypto.SHA1,
+	})
+	if err != nil {
+		return nil, errors.New("create ocsp request failed: " + err.Error())
+	}
+
+	var lastErr error
+	for _, serverURL := range cert.OCSPServer {
+		respData, err := postOCSP(serverURL, reqData)
+		if err != nil {
+			lastErr = errors.New("request '" + serverURL + "' failed: " + err.Error())
+			continue
+		}
+
+		resp, err := ocsp.ParseResponseForCert(respData, cert, issuer)
+		if err != nil {
+			lastErr = errors.New("parse response from '" + serverURL + "' failed: " + err.Error())
+			continue
+		}
+
+		var result = &OCSPResult{
+			Status: resp.Status,
+		}
+		if resp.Status == ocsp.Revoked {
+			result.RevokedAt = resp.RevokedAt.Unix()
+			result.RevocationReason = resp.RevocationReason
+		}
+		return result, nil
+	}
+	return nil, lastErr
+}
+
+func postOCSP(serverURL string, reqData []byte) ([]byte, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.New("unsupported scheme '" + u.Scheme + "'")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, serverURL, bytes.NewReader(reqData))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/ocsp-request")
+	req.Header.Set("Accept", "application/ocsp-response")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("invalid response status: " + resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxFetchSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxFetchSize {
+		return nil, errors.New("response is too large")
+	}
+	return data, nil
+}
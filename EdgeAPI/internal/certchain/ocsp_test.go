@@ -0,0 +1,85 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certchain
+
+import (
+	"crypto/x509"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iwind/TeaGo/assert"
+	"golang.org/x/crypto/ocsp"
+)
+
+func TestQueryOCSP(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var root = testIssue(t, "Test Root", true, nil, "")
+	var status = ocsp.Good
+	var revokedAt = time.Now().Add(-1 * time.Hour).Truncate(time.Second)
+
+	var server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ocspReq, err := ocsp.ParseRequest(data)
+		if err != nil {
+			writer.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var template = ocsp.Response{
+			Status:       status,
+			SerialNumber: ocspReq.SerialNumber,
+			ThisUpdate:   time.Now().Add(-1 * time.Minute),
+			NextUpdate:   time.Now().Add(1 * time.Hour),
+		}
+		if status == ocsp.Revoked {
+			template.RevokedAt = revokedAt
+			template.RevocationReason = ocsp.KeyCompromise
+		}
+		respData, err := ocsp.CreateResponse(root.cert, root.cert, template, root.key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = writer.Write(respData)
+	}))
+	var oldClient = httpClient
+	httpClient = server.Client()
+	defer func() {
+		httpClient = oldClient
+		server.Close()
+	}()
+
+	var leaf = testIssue(t, "example.com", false, root, "")
+	leaf.cert.OCSPServer = []string{server.URL}
+
+	issuer, err := FindIssuer(leaf.cert, []*x509.Certificate{root.cert})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := QueryOCSP(leaf.cert, issuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(result.Status == ocsp.Good)
+
+	status = ocsp.Revoked
+	result, err = QueryOCSP(leaf.cert, issuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(result.Status == ocsp.Revoked)
+	a.IsTrue(result.RevokedAt == revokedAt.Unix())
+	a.IsTrue(result.RevocationReason == ocsp.KeyCompromise)
+
+	// 没有OCSP服务器
+	leaf.cert.OCSPServer = nil
+	result, err = QueryOCSP(leaf.cert, issuer)
+	a.IsNil(err)
+	a.IsNil(result)
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certdeploy
+
+import (
+	"errors"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cdn"
+	"github.com/iwind/TeaGo/maps"
+)
+
+// AliyunCDNTarget 阿里云CDN
+type AliyunCDNTarget struct {
+	domains []string
+
+	client *cdn.Client
+}
+
+// Init 使用参数初始化
+func (this *AliyunCDNTarget) Init(params maps.Map) error {
+	var accessKeyId = params.GetString("accessKeyId")
+	var accessKeySecret = params.GetString("accessKeySecret")
+	if len(accessKeyId) == 0 {
+		return errors.New("'accessKeyId' required")
+	}
+	if len(accessKeySecret) == 0 {
+		return errors.New("'accessKeySecret' required")
+	}
+
+	this.domains = parseDomains(params, "domains")
+	if len(this.domains) == 0 {
+		return errors.New("'domains' required")
+	}
+
+	client, err := cdn.NewClientWithAccessKey("cn-hangzhou", accessKeyId, accessKeySecret)
+	if err != nil {
+		return err
+	}
+	this.client = client
+	return nil
+}
+
+// MaskParams 对参数进行掩码
+func (this *AliyunCDNTarget) MaskParams(params maps.Map) {
+	if params == nil {
+		return
+	}
+	params["accessKeySecret"] = MaskString(params.GetString("accessKeySecret"))
+}
+
+// Deploy 部署证书
+func (this *AliyunCDNTarget) Deploy(cert *Cert) error {
+	var name = certName(cert)
+	for _, domain := range this.domains {
+		var req = cdn.CreateSetCdnDomainSSLCertificateRequest()
+		req.DomainName = domain
+		req.SSLProtocol = "on"
+		req.CertType = "upload"
+		req.CertName = name
+		req.SSLPub = string(cert.CertData)
+		req.SSLPri = string(cert.KeyData)
+		_, err := this.client.SetCdnDomainSSLCertificate(req)
+		if err != nil {
+			return errors.New("set certificate for '" + domain + "' failed: " + err.Error())
+		}
+	}
+	return nil
+}
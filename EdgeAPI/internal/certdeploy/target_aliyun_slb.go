@@ -0,0 +1,115 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certdeploy
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
+)
+
+// AliyunSLBTarget 阿里云负载均衡
+// 先上传服务器证书，再修改HTTPS监听使用的证书
+type AliyunSLBTarget struct {
+	regionId       string
+	loadBalancerId string
+	listenerPort   int
+
+	client *sdk.Client
+}
+
+// Init 使用参数初始化
+func (this *AliyunSLBTarget) Init(params maps.Map) error {
+	var accessKeyId = params.GetString("accessKeyId")
+	var accessKeySecret = params.GetString("accessKeySecret")
+	if len(accessKeyId) == 0 {
+		return errors.New("'accessKeyId' required")
+	}
+	if len(accessKeySecret) == 0 {
+		return errors.New("'accessKeySecret' required")
+	}
+
+	this.regionId = params.GetString("regionId")
+	if len(this.regionId) == 0 {
+		return errors.New("'regionId' required")
+	}
+	this.loadBalancerId = params.GetString("loadBalancerId")
+	if len(this.loadBalancerId) == 0 {
+		return errors.New("'loadBalancerId' required")
+	}
+	this.listenerPort = params.GetInt("listenerPort")
+	if this.listenerPort <= 0 {
+		this.listenerPort = 443
+	}
+
+	client, err := sdk.NewClientWithAccessKey(this.regionId, accessKeyId, accessKeySecret)
+	if err != nil {
+		return err
+	}
+	this.client = client
+	return nil
+}
+
+// MaskParams 对参数进行掩码
+func (this *AliyunSLBTarget) MaskParams(params maps.Map) {
+	if params == nil {
+		return
+	}
+	params["accessKeySecret"] = MaskString(params.GetString("accessKeySecret"))
+}
+
+// Deploy 部署证书
+func (this *AliyunSLBTarget) Deploy(cert *Cert) error {
+	// 上传证书
+	var uploadResp = struct {
+		ServerCertificateId string `json:"ServerCertificateId"`
+	}{}
+	err := this.doAPI("UploadServerCertificate", map[string]string{
+		"ServerCertificate":     string(cert.CertData),
+		"PrivateKey":            string(cert.KeyData),
+		"ServerCertificateName": certName(cert),
+	}, &uploadResp)
+	if err != nil {
+		return errors.New("upload certificate failed: " + err.Error())
+	}
+	if len(uploadResp.ServerCertificateId) == 0 {
+		return errors.New("upload certificate failed: empty 'ServerCertificateId'")
+	}
+
+	// 修改监听
+	err = this.doAPI("SetLoadBalancerHTTPSListenerAttribute", map[string]string{
+		"LoadBalancerId":      this.loadBalancerId,
+		"ListenerPort":        types.String(this.listenerPort),
+		"ServerCertificateId": uploadResp.ServerCertificateId,
+	}, nil)
+	if err != nil {
+		return errors.New("set listener certificate failed: " + err.Error())
+	}
+	return nil
+}
+
+func (this *AliyunSLBTarget) doAPI(action string, params map[string]string, respPtr any) error {
+	var req = requests.NewCommonRequest()
+	req.Method = "POST"
+	req.Scheme = "https"
+	req.Domain = "slb.aliyuncs.com"
+	req.Version = "2014-05-15"
+	req.ApiName = action
+	req.QueryParams["RegionId"] = this.regionId
+	for k, v := range params {
+		req.FormParams[k] = v
+	}
+
+	resp, err := this.client.ProcessCommonRequest(req)
+	if err != nil {
+		return err
+	}
+	if respPtr != nil {
+		return json.Unmarshal(resp.GetHttpContentBytes(), respPtr)
+	}
+	return nil
+}
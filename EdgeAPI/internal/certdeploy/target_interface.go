@@ -0,0 +1,30 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certdeploy
+
+import (
+	"github.com/iwind/TeaGo/maps"
+)
+
+// Cert 需要部署的证书
+type Cert struct {
+	Id          int64    `json:"id"`
+	Name        string   `json:"name"`
+	CertData    []byte   `json:"certData"`
+	KeyData     []byte   `json:"keyData"`
+	DNSNames    []string `json:"dnsNames"`
+	TimeBeginAt int64    `json:"timeBeginAt"`
+	TimeEndAt   int64    `json:"timeEndAt"`
+}
+
+// TargetInterface 证书部署目标接口
+type TargetInterface interface {
+	// Init 使用参数初始化
+	Init(params maps.Map) error
+
+	// MaskParams 对参数进行掩码
+	MaskParams(params maps.Map)
+
+	// Deploy 部署证书
+	Deploy(cert *Cert) error
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certdeploy
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/iwind/TeaGo/maps"
+)
+
+const qiniuAPIEndpoint = "https://api.qiniu.com"
+
+var qiniuHTTPClient = &http.Client{
+	Timeout: 30 * time.Second,
+}
+
+// QiniuCDNTarget 七牛云CDN
+// 先上传证书，再修改域名的HTTPS配置
+type QiniuCDNTarget struct {
+	accessKey string
+	secretKey string
+	domains   []string
+
+	endpoint string
+}
+
+// Init 使用参数初始化
+func (this *QiniuCDNTarget) Init(params maps.Map) error {
+	this.accessKey = params.GetString("accessKey")
+	this.secretKey = params.GetString("secretKey")
+	if len(this.accessKey) == 0 {
+		return errors.New("'accessKey' required")
+	}
+	if len(this.secretKey) == 0 {
+		return errors.New("'secretKey' required")
+	}
+
+	this.domains = parseDomains(params, "domains")
+	if len(this.domains) == 0 {
+		return errors.New("'domains' required")
+	}
+
+	this.endpoint = qiniuAPIEndpoint
+	return nil
+}
+
+// MaskParams 对参数进行掩码
+func (this *QiniuCDNTarget) MaskParams(params maps.Map) {
+	if params == nil {
+		return
+	}
+	params["secretKey"] = MaskString(params.GetString("secretKey"))
+}
+
+// Deploy 部署证书
+func (this *QiniuCDNTarget) Deploy(cert *Cert) error {
+	// 上传证书
+	var uploadResp = struct {
+		CertId string `json:"certID"`
+	}{}
+	err := this.doAPI(http.MethodPost, "/sslcert", maps.Map{
+		"name":        certName(cert),
+		"common_name": firstDNSName(cert),
+		"pri":         string(cert.KeyData),
+		"ca":          string(cert.CertData),
+	}, &uploadResp)
+	if err != nil {
+		return errors.New("upload certificate failed: " + err.Error())
+	}
+	if len(uploadResp.CertId) == 0 {
+		return errors.New("upload certificate failed: empty 'certID'")
+	}
+
+	// 修改域名证书
+	for _, domain := range this.domains {
+		err = this.doAPI(http.MethodPut, "/domain/"+url.PathEscape(domain)+"/httpsconf", maps.Map{
+			"certId":      uploadResp.CertId,
+			"forceHttps":  false,
+			"http2Enable": true,
+		}, nil)
+		if err != nil {
+			return errors.New("set certificate for '" + domain + "' failed: " + err.Error())
+		}
+	}
+	return nil
+}
+
+func (this *QiniuCDNTarget) doAPI(method string, path string, params maps.Map, respPtr any) error {
+	bodyJSON, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(method, this.endpoint+path, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "QBox "+QiniuSign(this.accessKey, this.secretKey, req.URL))
+
+	resp, err := qiniuHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("invalid response status '" + resp.Status + "': " + string(respData))
+	}
+	if respPtr != nil {
+		return json.Unmarshal(respData, respPtr)
+	}
+	return nil
+}
+
+// QiniuSign 计算七牛管理凭证
+// 请求内容为JSON时，签名只包含请求路径和查询参数
+func QiniuSign(accessKey string, secretKey string, u *url.URL) string {
+	var data = u.EscapedPath()
+	if len(u.RawQuery) > 0 {
+		data += "?" + u.RawQuery
+	}
+	data += "\n"
+
+	var h = hmac.New(sha1.New, []byte(secretKey))
+	h.Write([]byte(data))
+	return accessKey + ":" + base64.URLEncoding.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certdeploy_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/certdeploy"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestQiniuSign(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	u, err := url.Parse("https://api.qiniu.com/domain/example.com/httpsconf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sign1 = certdeploy.QiniuSign("ak", "sk", u)
+	var sign2 = certdeploy.QiniuSign("ak", "sk", u)
+	a.IsTrue(sign1 == sign2)
+	a.IsTrue(len(sign1) > len("ak:"))
+	a.IsTrue(sign1[:3] == "ak:")
+
+	// 查询参数参与签名
+	u.RawQuery = "a=b"
+	a.IsTrue(certdeploy.QiniuSign("ak", "sk", u) != sign1)
+	t.Log(sign1)
+}
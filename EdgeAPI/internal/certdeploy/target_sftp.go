@@ -0,0 +1,183 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certdeploy
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
+	"github.com/iwind/TeaGo/maps"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// SFTPTarget 通过SFTP上传证书和私钥文件
+type SFTPTarget struct {
+	host          string
+	port          int
+	username      string
+	password      string
+	privateKey    string
+	passphrase    string
+	certPath      string
+	keyPath       string
+	reloadCommand string
+}
+
+// Init 使用参数初始化
+func (this *SFTPTarget) Init(params maps.Map) error {
+	this.host = params.GetString("host")
+	if len(this.host) == 0 {
+		return errors.New("'host' required")
+	}
+	this.port = params.GetInt("port")
+	if this.port <= 0 {
+		this.port = 22
+	}
+	this.username = params.GetString("username")
+	if len(this.username) == 0 {
+		this.username = "root"
+	}
+	this.password = params.GetString("password")
+	this.privateKey = params.GetString("privateKey")
+	this.passphrase = params.GetString("passphrase")
+	if len(this.password) == 0 && len(this.privateKey) == 0 {
+		return errors.New("require 'password' or 'privateKey'")
+	}
+
+	this.certPath = params.GetString("certPath")
+	if len(this.certPath) == 0 {
+		return errors.New("'certPath' required")
+	}
+	this.keyPath = params.GetString("keyPath")
+	if len(this.keyPath) == 0 {
+		return errors.New("'keyPath' required")
+	}
+	this.reloadCommand = params.GetString("reloadCommand")
+	return nil
+}
+
+// MaskParams 对参数进行掩码
+func (this *SFTPTarget) MaskParams(params maps.Map) {
+	if params == nil {
+		return
+	}
+	params["password"] = MaskString(params.GetString("password"))
+	params["privateKey"] = MaskString(params.GetString("privateKey"))
+	params["passphrase"] = MaskString(params.GetString("passphrase"))
+}
+
+// Deploy 部署证书
+func (this *SFTPTarget) Deploy(cert *Cert) error {
+	sshClient, err := this.dial()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = sshClient.Close()
+	}()
+
+	sftpClient, err := sftp.NewClient(sshClient)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = sftpClient.Close()
+	}()
+
+	err = this.writeFile(sftpClient, this.certPath, cert.CertData, 0644)
+	if err != nil {
+		return errors.New("write certificate file failed: " + err.Error())
+	}
+	err = this.writeFile(sftpClient, this.keyPath, cert.KeyData, 0600)
+	if err != nil {
+		return errors.New("write key file failed: " + err.Error())
+	}
+
+	// 重载服务
+	if len(this.reloadCommand) > 0 {
+		session, err := sshClient.NewSession()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = session.Close()
+		}()
+
+		var stderr = &bytes.Buffer{}
+		session.Stderr = stderr
+		err = session.Run(this.reloadCommand)
+		if err != nil {
+			return errors.New("run reload command failed: " + err.Error() + ": " + stderr.String())
+		}
+	}
+
+	return nil
+}
+
+func (this *SFTPTarget) dial() (*ssh.Client, error) {
+	var methods = []ssh.AuthMethod{}
+	if len(this.privateKey) > 0 {
+		var signer ssh.Signer
+		var err error
+		if len(this.passphrase) > 0 {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(this.privateKey), []byte(this.passphrase))
+		} else {
+			signer, err = ssh.ParsePrivateKey([]byte(this.privateKey))
+		}
+		if err != nil {
+			return nil, errors.New("parse private key failed: " + err.Error())
+		}
+		methods = append(methods, ssh.PublicKeys(signer))
+	}
+	if len(this.password) > 0 {
+		methods = append(methods, ssh.Password(this.password))
+	}
+
+	var config = &ssh.ClientConfig{
+		User: this.username,
+		Auth: methods,
+		// 不使用known_hosts
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			return nil
+		},
+		Timeout: 10 * time.Second,
+	}
+	return ssh.Dial("tcp", configutils.QuoteIP(this.host)+":"+strconv.Itoa(this.port), config)
+}
+
+// 先写入临时文件再重命名，避免服务读取到不完整的文件
+func (this *SFTPTarget) writeFile(client *sftp.Client, filePath string, data []byte, mode os.FileMode) error {
+	err := client.MkdirAll(path.Dir(filePath))
+	if err != nil {
+		return err
+	}
+
+	var tmpPath = filePath + ".tmp"
+	fp, err := client.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	err = fp.Chmod(mode)
+	if err != nil {
+		_ = fp.Close()
+		return err
+	}
+	_, err = fp.Write(data)
+	if err != nil {
+		_ = fp.Close()
+		return err
+	}
+	err = fp.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.PosixRename(tmpPath, filePath)
+}
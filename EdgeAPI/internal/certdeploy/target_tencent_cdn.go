@@ -0,0 +1,77 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certdeploy
+
+import (
+	"errors"
+
+	"github.com/iwind/TeaGo/maps"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	tchttp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/http"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
+)
+
+// TencentCDNTarget 腾讯云CDN
+type TencentCDNTarget struct {
+	domains []string
+
+	client *common.Client
+}
+
+// Init 使用参数初始化
+func (this *TencentCDNTarget) Init(params maps.Map) error {
+	var accessKeyId = params.GetString("accessKeyId")
+	var accessKeySecret = params.GetString("accessKeySecret")
+	if len(accessKeyId) == 0 {
+		return errors.New("'accessKeyId' required")
+	}
+	if len(accessKeySecret) == 0 {
+		return errors.New("'accessKeySecret' required")
+	}
+
+	this.domains = parseDomains(params, "domains")
+	if len(this.domains) == 0 {
+		return errors.New("'domains' required")
+	}
+
+	var clientProfile = profile.NewClientProfile()
+	clientProfile.HttpProfile.Endpoint = "cdn.tencentcloudapi.com"
+	this.client = common.NewCommonClient(common.NewCredential(accessKeyId, accessKeySecret), "", clientProfile)
+	return nil
+}
+
+// MaskParams 对参数进行掩码
+func (this *TencentCDNTarget) MaskParams(params maps.Map) {
+	if params == nil {
+		return
+	}
+	params["accessKeySecret"] = MaskString(params.GetString("accessKeySecret"))
+}
+
+// Deploy 部署证书
+func (this *TencentCDNTarget) Deploy(cert *Cert) error {
+	for _, domain := range this.domains {
+		var req = tchttp.NewCommonRequest("cdn", "2018-06-06", "UpdateDomainConfig")
+		err := req.SetActionParameters(map[string]any{
+			"Domain": domain,
+			"Https": map[string]any{
+				"Switch": "on",
+				"CertInfo": map[string]any{
+					"Certificate": string(cert.CertData),
+					"PrivateKey":  string(cert.KeyData),
+					"Message":     certName(cert),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		var resp = tchttp.NewCommonResponse()
+		err = this.client.Send(req, resp)
+		if err != nil {
+			return errors.New("set certificate for '" + domain + "' failed: " + err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certdeploy
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
+	"github.com/iwind/TeaGo/maps"
+)
+
+const WebhookSignatureHeader = "X-Edge-Signature"
+
+var webhookHTTPClient = &http.Client{
+	Timeout: 30 * time.Second,
+}
+
+// WebhookTarget 通过自定义HTTP接口部署证书
+// 接口需要返回2xx状态码，如果设置了密钥，则请求中会包含使用HMAC-SHA256计算的请求内容签名
+type WebhookTarget struct {
+	url    string
+	secret string
+}
+
+// Init 使用参数初始化
+func (this *WebhookTarget) Init(params maps.Map) error {
+	this.url = params.GetString("url")
+	if len(this.url) == 0 {
+		return errors.New("'url' required")
+	}
+	u, err := url.Parse(this.url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return errors.New("invalid 'url'")
+	}
+
+	this.secret = params.GetString("secret")
+	return nil
+}
+
+// MaskParams 对参数进行掩码
+func (this *WebhookTarget) MaskParams(params maps.Map) {
+	if params == nil {
+		return
+	}
+	params["secret"] = MaskString(params.GetString("secret"))
+}
+
+// Deploy 部署证书
+func (this *WebhookTarget) Deploy(cert *Cert) error {
+	bodyJSON, err := json.Marshal(maps.Map{
+		"certId":      cert.Id,
+		"name":        cert.Name,
+		"dnsNames":    cert.DNSNames,
+		"timeBeginAt": cert.TimeBeginAt,
+		"timeEndAt":   cert.TimeEndAt,
+		"certData":    string(cert.CertData),
+		"keyData":     string(cert.KeyData),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, this.url, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", teaconst.GlobalProductName+"/"+teaconst.Version)
+	if len(this.secret) > 0 {
+		req.Header.Set(WebhookSignatureHeader, WebhookSign(this.secret, bodyJSON))
+	}
+
+	resp, err := webhookHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respData, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return errors.New("invalid response status '" + resp.Status + "': " + string(respData))
+	}
+	return nil
+}
+
+// WebhookSign 计算请求内容签名
+func WebhookSign(secret string, body []byte) string {
+	var h = hmac.New(sha256.New, []byte(secret))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certdeploy_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/certdeploy"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/maps"
+)
+
+func TestWebhookTarget_Deploy(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var received maps.Map
+	var signature string
+	var expectedSignature string
+	var server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		signature = req.Header.Get(certdeploy.WebhookSignatureHeader)
+		expectedSignature = certdeploy.WebhookSign("123456", body)
+		_ = json.Unmarshal(body, &received)
+	}))
+	defer server.Close()
+
+	var target = certdeploy.FindTarget(certdeploy.TargetTypeWebhook)
+	err := target.Init(maps.Map{
+		"url":    server.URL,
+		"secret": "123456",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = target.Deploy(&certdeploy.Cert{
+		Id:       1,
+		CertData: []byte("CERT"),
+		KeyData:  []byte("KEY"),
+		DNSNames: []string{"example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(len(signature) > 0)
+	a.IsTrue(signature == expectedSignature)
+	a.IsTrue(received.GetInt64("certId") == 1)
+	a.IsTrue(received.GetString("certData") == "CERT")
+	a.IsTrue(received.GetString("keyData") == "KEY")
+}
+
+func TestWebhookTarget_Deploy_Failed(t *testing.T) {
+	var server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		writer.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	var target = certdeploy.FindTarget(certdeploy.TargetTypeWebhook)
+	err := target.Init(maps.Map{
+		"url": server.URL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = target.Deploy(&certdeploy.Cert{Id: 1})
+	if err == nil {
+		t.Fatal("should fail")
+	}
+	t.Log("expected error:", err)
+}
+
+func TestWebhookTarget_Init(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var target = certdeploy.FindTarget(certdeploy.TargetTypeWebhook)
+	a.IsNotNil(target.Init(maps.Map{}))
+	a.IsNotNil(target.Init(maps.Map{"url": "ftp://example.com/"}))
+	a.IsNil(target.Init(maps.Map{"url": "https://example.com/hook"}))
+
+	var params = maps.Map{"url": "https://example.com/hook", "secret": "1234567890"}
+	target.MaskParams(params)
+	a.IsTrue(params.GetString("secret") == "1234******")
+	a.IsTrue(certdeploy.IsMasked(params.GetString("secret")))
+}
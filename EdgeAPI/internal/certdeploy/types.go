@@ -0,0 +1,84 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certdeploy
+
+import (
+	"github.com/iwind/TeaGo/maps"
+)
+
+type TargetType = string
+
+// 部署目标代号
+const (
+	TargetTypeAliyunCDN  TargetType = "aliyunCDN"  // 阿里云CDN
+	TargetTypeAliyunSLB  TargetType = "aliyunSLB"  // 阿里云负载均衡
+	TargetTypeTencentCDN TargetType = "tencentCDN" // 腾讯云CDN
+	TargetTypeQiniuCDN   TargetType = "qiniuCDN"   // 七牛云CDN
+	TargetTypeSFTP       TargetType = "sftp"       // SFTP上传
+	TargetTypeWebhook    TargetType = "webhook"    // 自定义HTTP接口
+)
+
+// FindAllTargetTypes 所有的部署目标类型
+func FindAllTargetTypes() []maps.Map {
+	return []maps.Map{
+		{
+			"name":        "阿里云CDN",
+			"code":        TargetTypeAliyunCDN,
+			"description": "将证书设置到阿里云CDN加速域名上。",
+		},
+		{
+			"name":        "阿里云负载均衡SLB",
+			"code":        TargetTypeAliyunSLB,
+			"description": "上传证书到阿里云负载均衡，并设置到HTTPS监听上。",
+		},
+		{
+			"name":        "腾讯云CDN",
+			"code":        TargetTypeTencentCDN,
+			"description": "将证书设置到腾讯云CDN加速域名上。",
+		},
+		{
+			"name":        "七牛云CDN",
+			"code":        TargetTypeQiniuCDN,
+			"description": "上传证书到七牛云，并设置到CDN加速域名上。",
+		},
+		{
+			"name":        "SFTP",
+			"code":        TargetTypeSFTP,
+			"description": "通过SFTP上传证书和私钥文件到服务器，并可以执行重载命令。",
+		},
+		{
+			"name":        "自定义HTTP接口",
+			"code":        TargetTypeWebhook,
+			"description": "使用POST方法将证书信息以JSON格式发送到自定义的HTTP接口。",
+		},
+	}
+}
+
+// FindTargetTypeName 查找部署目标类型名称
+func FindTargetTypeName(targetType TargetType) string {
+	for _, t := range FindAllTargetTypes() {
+		if t.GetString("code") == targetType {
+			return t.GetString("name")
+		}
+	}
+	return ""
+}
+
+// FindTarget 查找部署目标实例
+func FindTarget(targetType TargetType) TargetInterface {
+	switch targetType {
+	case TargetTypeAliyunCDN:
+		return &AliyunCDNTarget{}
+	case TargetTypeAliyunSLB:
+		return &AliyunSLBTarget{}
+	case TargetTypeTencentCDN:
+		return &TencentCDNTarget{}
+	case TargetTypeQiniuCDN:
+		return &QiniuCDNTarget{}
+	case TargetTypeSFTP:
+		return &SFTPTarget{}
+	case TargetTypeWebhook:
+		return &WebhookTarget{}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certdeploy
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
+)
+
+// MaskString 对字符串进行掩码
+func MaskString(s string) string {
+	var l = len(s)
+	if l == 0 {
+		return ""
+	}
+	if l < 8 {
+		return strings.Repeat("*", l)
+	}
+	return s[:4] + strings.Repeat("*", l-4)
+}
+
+// IsMasked 判断字符串是否被掩码
+func IsMasked(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	return s == strings.Repeat("*", len(s)) || strings.HasSuffix(s, "**")
+}
+
+// UnmaskParams 使用旧的参数恢复新参数中被掩码的值
+func UnmaskParams(oldParamsJSON []byte, newParamsJSON []byte) (resultJSON []byte, err error) {
+	var oldParams maps.Map
+	var newParams maps.Map
+
+	if len(oldParamsJSON) == 0 || len(newParamsJSON) == 0 {
+		return newParamsJSON, nil
+	}
+	err = json.Unmarshal(oldParamsJSON, &oldParams)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(newParamsJSON, &newParams)
+	if err != nil {
+		return nil, err
+	}
+	if oldParams == nil || newParams == nil {
+		return newParamsJSON, nil
+	}
+
+	for k, v := range newParams {
+		s, ok := v.(string)
+		if ok && IsMasked(s) {
+			var oldV = oldParams.GetString(k)
+			if len(oldV) > 0 {
+				newParams[k] = oldV
+			}
+		}
+	}
+
+	return json.Marshal(newParams)
+}
+
+// 从参数中读取域名列表，支持数组或者用逗号、换行分隔的字符串
+func parseDomains(params maps.Map, key string) []string {
+	var result = []string{}
+	var v = params.Get(key)
+	switch values := v.(type) {
+	case []any:
+		for _, value := range values {
+			s, ok := value.(string)
+			if ok {
+				result = appendDomain(result, s)
+			}
+		}
+	case []string:
+		for _, value := range values {
+			result = appendDomain(result, value)
+		}
+	case string:
+		for _, value := range strings.FieldsFunc(values, func(r rune) bool {
+			return r == ',' || r == '\n' || r == ' '
+		}) {
+			result = appendDomain(result, value)
+		}
+	}
+	return result
+}
+
+func appendDomain(domains []string, domain string) []string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if len(domain) == 0 {
+		return domains
+	}
+	for _, d := range domains {
+		if d == domain {
+			return domains
+		}
+	}
+	return append(domains, domain)
+}
+
+// 证书名称，部分平台对名称有长度和唯一性要求
+func certName(cert *Cert) string {
+	var name = "goedge-" + strings.ReplaceAll(strings.TrimPrefix(firstDNSName(cert), "*."), ".", "-")
+	if len(name) > 48 {
+		name = name[:48]
+	}
+	if cert.TimeEndAt > 0 {
+		name += "-" + types.String(cert.TimeEndAt)
+	}
+	return name
+}
+
+func firstDNSName(cert *Cert) string {
+	if len(cert.DNSNames) > 0 {
+		return cert.DNSNames[0]
+	}
+	return "cert-" + types.String(cert.Id)
+}
@@ -0,0 +1,303 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certgen
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type KeyType = string
+
+const (
+	KeyTypeECDSA KeyType = "ecdsa" // ECDSA P-256
+	KeyTypeRSA   KeyType = "rsa"   // RSA 2048
+)
+
+const (
+	DefaultDays   = 365
+	DefaultCADays = 3650
+	MaxDays       = 3650
+	MaxCADays     = 7300
+	MaxNames      = 100
+)
+
+// Options 生成证书的选项
+type Options struct {
+	CommonName   string   // 通用名称，为空时使用第一个域名
+	Names        []string // 域名或IP
+	Organization string   // 组织名称，可选
+	Days         int      // 有效天数
+	KeyType      KeyType  // 私钥类型
+	IsCA         bool     // 是否生成CA证书
+	IsClient     bool     // 是否生成客户端证书，客户端证书可以不设置域名
+
+	OrganizationalUnit string // 部门名称，可选
+
+	// 签发证书用的CA证书和私钥，为空时生成自签名证书
+	CACertData []byte
+	CAKeyData  []byte
+}
+
+// Generate 生成证书，返回PEM格式的证书链和私钥
+// 使用CA签发时，返回的证书链中包含CA证书
+func Generate(options *Options) (certData []byte, keyData []byte, err error) {
+	template, err := buildTemplate(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privateKey, err := generateKey(options.KeyType)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !options.IsCA && options.KeyType == KeyTypeRSA {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
+	certData, err = sign(template, privateKey, privateKey.Public(), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyData = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+
+	return certData, keyData, nil
+}
+
+// SignCSR 使用CA签发证书请求，返回PEM格式的证书链
+// 证书的名称和用途以选项为准，忽略证书请求中的主题和扩展
+func SignCSR(csrData []byte, options *Options) (certData []byte, err error) {
+	if options == nil {
+		return nil, errors.New("options should not be nil")
+	}
+	if options.IsCA {
+		return nil, errors.New("can not sign a CA cert from CSR")
+	}
+	if len(options.CACertData) == 0 || len(options.CAKeyData) == 0 {
+		return nil, errors.New("'caCertData' and 'caKeyData' should not be empty")
+	}
+
+	var block, _ = pem.Decode(csrData)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, errors.New("invalid CSR data")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, errors.New("invalid CSR data: " + err.Error())
+	}
+	err = csr.CheckSignature()
+	if err != nil {
+		return nil, errors.New("invalid CSR signature: " + err.Error())
+	}
+
+	template, err := buildTemplate(options)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := csr.PublicKey.(*rsa.PublicKey); ok {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
+	return sign(template, nil, csr.PublicKey, options)
+}
+
+// 根据选项构造证书模板
+func buildTemplate(options *Options) (*x509.Certificate, error) {
+	if options == nil {
+		return nil, errors.New("options should not be nil")
+	}
+
+	var dnsNames = []string{}
+	var ipAddresses = []net.IP{}
+	var nameMap = map[string]bool{}
+	for _, name := range options.Names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if len(name) == 0 || nameMap[name] {
+			continue
+		}
+		nameMap[name] = true
+
+		var ip = net.ParseIP(name)
+		if ip != nil {
+			ipAddresses = append(ipAddresses, ip)
+		} else {
+			if !isValidDNSName(name) {
+				return nil, errors.New("invalid name '" + name + "'")
+			}
+			dnsNames = append(dnsNames, name)
+		}
+	}
+	if len(nameMap) > MaxNames {
+		return nil, errors.New("too many names")
+	}
+
+	var commonName = strings.TrimSpace(options.CommonName)
+	if len(commonName) == 0 && len(options.Names) > 0 {
+		commonName = strings.TrimSpace(options.Names[0])
+	}
+	if len(commonName) == 0 {
+		return nil, errors.New("'commonName' or 'names' should not be empty")
+	}
+	if !options.IsCA && !options.IsClient && len(nameMap) == 0 {
+		return nil, errors.New("'names' should not be empty")
+	}
+
+	var days = options.Days
+	var maxDays = MaxDays
+	if options.IsCA {
+		maxDays = MaxCADays
+	}
+	if days <= 0 {
+		days = DefaultDays
+		if options.IsCA {
+			days = DefaultCADays
+		}
+	}
+	if days > maxDays {
+		return nil, errors.New("'days' should not be greater than " + strconv.Itoa(maxDays))
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+	if err != nil {
+		return nil, err
+	}
+
+	var now = time.Now()
+	var template = &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName: commonName,
+		},
+		NotBefore:             now.Add(-1 * time.Hour), // 往前一点，避免时钟误差导致证书暂时不可用
+		NotAfter:              now.AddDate(0, 0, days),
+		DNSNames:              dnsNames,
+		IPAddresses:           ipAddresses,
+		BasicConstraintsValid: true,
+	}
+	if len(options.Organization) > 0 {
+		template.Subject.Organization = []string{options.Organization}
+	}
+	if len(options.OrganizationalUnit) > 0 {
+		template.Subject.OrganizationalUnit = []string{options.OrganizationalUnit}
+	}
+	if options.IsCA {
+		template.IsCA = true
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+	} else {
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		if options.IsClient {
+			template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+		} else {
+			template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		}
+	}
+	return template, nil
+}
+
+// 签发证书，没有设置CA时使用私钥自签名
+func sign(template *x509.Certificate, privateKey crypto.Signer, publicKey crypto.PublicKey, options *Options) (certData []byte, err error) {
+	var parentCert = template
+	var parentKey = privateKey
+	var chainData []byte
+	if len(options.CACertData) > 0 || len(options.CAKeyData) > 0 {
+		caCert, caSigner, caChain, err := loadCA(options.CACertData, options.CAKeyData)
+		if err != nil {
+			return nil, err
+		}
+
+		// 证书有效期不能超过CA证书的有效期
+		if template.NotAfter.After(caCert.NotAfter) {
+			template.NotAfter = caCert.NotAfter
+		}
+
+		parentCert = caCert
+		parentKey = caSigner
+		for _, der := range caChain {
+			chainData = append(chainData, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+		}
+	}
+	if parentKey == nil {
+		return nil, errors.New("no key to sign the cert")
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, parentCert, publicKey, parentKey)
+	if err != nil {
+		return nil, err
+	}
+	certData = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	certData = append(certData, chainData...)
+	return certData, nil
+}
+
+// 加载CA证书和私钥
+func loadCA(caCertData []byte, caKeyData []byte) (caCert *x509.Certificate, signer crypto.Signer, chain [][]byte, err error) {
+	caPair, err := tls.X509KeyPair(caCertData, caKeyData)
+	if err != nil {
+		return nil, nil, nil, errors.New("load CA failed: " + err.Error())
+	}
+	caCert, err = x509.ParseCertificate(caPair.Certificate[0])
+	if err != nil {
+		return nil, nil, nil, errors.New("load CA failed: " + err.Error())
+	}
+	if !caCert.IsCA || (caCert.KeyUsage != 0 && caCert.KeyUsage&x509.KeyUsageCertSign == 0) {
+		return nil, nil, nil, errors.New("the CA cert can not be used to sign certs")
+	}
+	if time.Now().After(caCert.NotAfter) {
+		return nil, nil, nil, errors.New("the CA cert has been expired")
+	}
+	signer, ok := caPair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, nil, nil, errors.New("unsupported CA private key")
+	}
+	return caCert, signer, caPair.Certificate, nil
+}
+
+func generateKey(keyType KeyType) (crypto.Signer, error) {
+	switch keyType {
+	case "", KeyTypeECDSA:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyTypeRSA:
+		return rsa.GenerateKey(rand.Reader, 2048)
+	}
+	return nil, errors.New("invalid key type '" + keyType + "'")
+}
+
+// 检查域名格式，支持通配符
+func isValidDNSName(name string) bool {
+	if len(name) > 253 {
+		return false
+	}
+	name = strings.TrimPrefix(name, "*.")
+	if len(name) == 0 {
+		return false
+	}
+	for _, label := range strings.Split(name, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9') && c != '-' && c != '_' {
+				return false
+			}
+		}
+	}
+	return true
+}
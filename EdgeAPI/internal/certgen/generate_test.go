@@ -0,0 +1,176 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certgen_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/certgen"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestGenerate_SelfSigned(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	for _, keyType := range []certgen.KeyType{certgen.KeyTypeECDSA, certgen.KeyTypeRSA} {
+		certData, keyData, err := certgen.Generate(&certgen.Options{
+			Names:   []string{"staging.example.internal", "*.staging.example.internal", "10.0.0.1"},
+			Days:    30,
+			KeyType: keyType,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pair, err := tls.X509KeyPair(certData, keyData)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.IsTrue(len(pair.Certificate) == 1)
+
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.IsTrue(cert.Subject.CommonName == "staging.example.internal")
+		a.IsTrue(len(cert.DNSNames) == 2)
+		a.IsTrue(len(cert.IPAddresses) == 1)
+		a.IsTrue(cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil)
+		a.IsTrue(cert.VerifyHostname("a.staging.example.internal") == nil)
+	}
+}
+
+func TestGenerate_SignedByCA(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	caCertData, caKeyData, err := certgen.Generate(&certgen.Options{
+		CommonName: "GoEdge Internal CA",
+		IsCA:       true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certData, keyData, err := certgen.Generate(&certgen.Options{
+		Names:      []string{"api.internal"},
+		CACertData: caCertData,
+		CAKeyData:  caKeyData,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pair, err := tls.X509KeyPair(certData, keyData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(len(pair.Certificate) == 2)
+
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err := x509.ParseCertificate(pair.Certificate[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var roots = x509.NewCertPool()
+	roots.AddCert(ca)
+	_, err = leaf.Verify(x509.VerifyOptions{
+		DNSName: "api.internal",
+		Roots:   roots,
+	})
+	a.IsTrue(err == nil)
+
+	// 普通证书不能用来签发证书
+	_, _, err = certgen.Generate(&certgen.Options{
+		Names:      []string{"api2.internal"},
+		CACertData: certData,
+		CAKeyData:  keyData,
+	})
+	a.IsNotNil(err)
+}
+
+func TestSignCSR(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	caCertData, caKeyData, err := certgen.Generate(&certgen.Options{
+		CommonName: "GoEdge Internal CA",
+		IsCA:       true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: "ignored"},
+	}, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var csrData = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	certData, err := certgen.SignCSR(csrData, &certgen.Options{
+		CommonName:         "node-unique-id",
+		OrganizationalUnit: "node",
+		Days:               30,
+		IsClient:           true,
+		CACertData:         caCertData,
+		CAKeyData:          caKeyData,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var block, _ = pem.Decode(certData)
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(leaf.Subject.CommonName == "node-unique-id")
+	a.IsTrue(len(leaf.Subject.OrganizationalUnit) == 1 && leaf.Subject.OrganizationalUnit[0] == "node")
+
+	var roots = x509.NewCertPool()
+	roots.AppendCertsFromPEM(caCertData)
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	a.IsTrue(err == nil)
+
+	// 没有CA时不能签发
+	_, err = certgen.SignCSR(csrData, &certgen.Options{CommonName: "node-unique-id", IsClient: true})
+	a.IsNotNil(err)
+
+	// 错误的证书请求
+	_, err = certgen.SignCSR([]byte("bad csr"), &certgen.Options{CommonName: "node-unique-id", IsClient: true, CACertData: caCertData, CAKeyData: caKeyData})
+	a.IsNotNil(err)
+}
+
+func TestGenerate_Invalid(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	_, _, err := certgen.Generate(&certgen.Options{})
+	a.IsNotNil(err)
+
+	_, _, err = certgen.Generate(&certgen.Options{Names: []string{"bad name"}})
+	a.IsNotNil(err)
+
+	_, _, err = certgen.Generate(&certgen.Options{Names: []string{"a.internal"}, Days: certgen.MaxDays + 1})
+	a.IsNotNil(err)
+
+	_, _, err = certgen.Generate(&certgen.Options{Names: []string{"a.internal"}, KeyType: "dsa"})
+	a.IsNotNil(err)
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package configbundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/firewallconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"gopkg.in/yaml.v3"
+)
+
+// Version 当前配置包格式版本
+// 格式有不兼容的修改时需要增加此版本号
+const Version = 1
+
+type Format = string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// 对象类型，用于导入时的ID映射
+const (
+	ItemTypeSSLCert            = "sslCert"
+	ItemTypeDNSProvider        = "dnsProvider"
+	ItemTypeHTTPCachePolicy    = "httpCachePolicy"
+	ItemTypeHTTPFirewallPolicy = "httpFirewallPolicy"
+	ItemTypeServer             = "server"
+)
+
+// Bundle 配置包
+// 其中的ID都是导出时所在系统中的ID，导入时会重新分配并映射
+type Bundle struct {
+	Version    int   `json:"version"`
+	ExportedAt int64 `json:"exportedAt"`
+
+	SSLCerts             []*SSLCert            `json:"sslCerts,omitempty"`
+	DNSProviders         []*DNSProvider        `json:"dnsProviders,omitempty"`
+	HTTPCachePolicies    []*HTTPCachePolicy    `json:"httpCachePolicies,omitempty"`
+	HTTPFirewallPolicies []*HTTPFirewallPolicy `json:"httpFirewallPolicies,omitempty"`
+	Servers              []*Server             `json:"servers,omitempty"`
+}
+
+func NewBundle() *Bundle {
+	return &Bundle{
+		Version:    Version,
+		ExportedAt: time.Now().Unix(),
+	}
+}
+
+// SSLCert 证书
+type SSLCert struct {
+	Id          int64    `json:"id"`
+	IsOn        bool     `json:"isOn"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	ServerName  string   `json:"serverName"`
+	IsCA        bool     `json:"isCA"`
+	CertData    string   `json:"certData"`
+	KeyData     string   `json:"keyData,omitempty"` // 不包含敏感信息时为空
+	TimeBeginAt int64    `json:"timeBeginAt"`
+	TimeEndAt   int64    `json:"timeEndAt"`
+	DNSNames    []string `json:"dnsNames"`
+	CommonNames []string `json:"commonNames"`
+}
+
+// DNSProvider DNS服务商
+type DNSProvider struct {
+	Id        int64          `json:"id"`
+	Type      string         `json:"type"`
+	Name      string         `json:"name"`
+	MinTTL    int32          `json:"minTTL"`
+	APIParams map[string]any `json:"apiParams,omitempty"` // 不包含敏感信息时为空
+}
+
+// HTTPCachePolicy 缓存策略
+type HTTPCachePolicy struct {
+	Id     int64                          `json:"id"`
+	Config *serverconfigs.HTTPCachePolicy `json:"config"`
+}
+
+// HTTPFirewallPolicy WAF策略
+type HTTPFirewallPolicy struct {
+	Id     int64                               `json:"id"`
+	Config *firewallconfigs.HTTPFirewallPolicy `json:"config"`
+}
+
+// Server 网站
+type Server struct {
+	Id          int64                             `json:"id"`
+	Type        string                            `json:"type"`
+	IsOn        bool                              `json:"isOn"`
+	Name        string                            `json:"name"`
+	Description string                            `json:"description"`
+	ServerNames []*serverconfigs.ServerNameConfig `json:"serverNames"`
+
+	HTTP  *serverconfigs.HTTPProtocolConfig  `json:"http,omitempty"`
+	HTTPS *serverconfigs.HTTPSProtocolConfig `json:"https,omitempty"` // 其中的SSL策略直接包含在sslPolicy中
+	TCP   *serverconfigs.TCPProtocolConfig   `json:"tcp,omitempty"`
+	TLS   *serverconfigs.TLSProtocolConfig   `json:"tls,omitempty"` // 其中的SSL策略直接包含在sslPolicy中
+	UDP   *serverconfigs.UDPProtocolConfig   `json:"udp,omitempty"`
+
+	ReverseProxyRef *serverconfigs.ReverseProxyRef    `json:"reverseProxyRef,omitempty"`
+	ReverseProxy    *serverconfigs.ReverseProxyConfig `json:"reverseProxy,omitempty"`
+
+	Web *Web `json:"web,omitempty"`
+}
+
+// Web 网站的Web设置
+// 目前只包含常用的设置，路由规则、重写规则等其他设置需要在导入后手动设置
+type Web struct {
+	Root     *serverconfigs.HTTPRootConfig    `json:"root,omitempty"`
+	Cache    *serverconfigs.HTTPCacheConfig   `json:"cache,omitempty"`
+	Firewall *firewallconfigs.HTTPFirewallRef `json:"firewall,omitempty"`
+}
+
+// Encode 编码配置包
+func Encode(bundle *Bundle, format Format) ([]byte, error) {
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case "", FormatJSON:
+		var buf = &bytes.Buffer{}
+		err = json.Indent(buf, data, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case FormatYAML:
+		// 先转换为通用的数据结构，以便使用和JSON相同的字段名
+		var m = map[string]any{}
+		err = json.Unmarshal(data, &m)
+		if err != nil {
+			return nil, err
+		}
+		return yaml.Marshal(m)
+	}
+	return nil, errors.New("invalid format '" + format + "'")
+}
+
+// Decode 解析配置包，自动识别JSON和YAML格式
+func Decode(data []byte) (*Bundle, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, errors.New("bundle data should not be empty")
+	}
+
+	// YAML
+	if data[0] != '{' {
+		var m = map[string]any{}
+		err := yaml.Unmarshal(data, &m)
+		if err != nil {
+			return nil, errors.New("decode yaml failed: " + err.Error())
+		}
+		data, err = json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var bundle = &Bundle{}
+	err := json.Unmarshal(data, bundle)
+	if err != nil {
+		return nil, errors.New("decode bundle failed: " + err.Error())
+	}
+	if bundle.Version <= 0 {
+		return nil, errors.New("invalid bundle: missing 'version'")
+	}
+	if bundle.Version > Version {
+		return nil, errors.New("unsupported bundle version '" + strconv.Itoa(bundle.Version) + "', please upgrade the system first")
+	}
+	return bundle, nil
+}
+
+// IdMapping 单个对象的新旧ID
+type IdMapping struct {
+	ItemType string
+	OldId    int64
+	NewId    int64
+}
+
+// IdMap 导入时新旧ID的映射
+type IdMap struct {
+	m        map[string]map[int64]int64 // itemType => { oldId => newId }
+	mappings []*IdMapping
+}
+
+func NewIdMap() *IdMap {
+	return &IdMap{
+		m: map[string]map[int64]int64{},
+	}
+}
+
+// Put 添加映射
+func (this *IdMap) Put(itemType string, oldId int64, newId int64) {
+	idMap, ok := this.m[itemType]
+	if !ok {
+		idMap = map[int64]int64{}
+		this.m[itemType] = idMap
+	}
+	idMap[oldId] = newId
+
+	this.mappings = append(this.mappings, &IdMapping{
+		ItemType: itemType,
+		OldId:    oldId,
+		NewId:    newId,
+	})
+}
+
+// Get 查找新的ID
+func (this *IdMap) Get(itemType string, oldId int64) (newId int64, ok bool) {
+	newId, ok = this.m[itemType][oldId]
+	return
+}
+
+// Mappings 按添加顺序列出所有映射
+func (this *IdMap) Mappings() []*IdMapping {
+	return this.mappings
+}
+
+// RemapCertRefs 映射证书引用，找不到的引用会被删除
+func (this *IdMap) RemapCertRefs(refs []*sslconfigs.SSLCertRef) (result []*sslconfigs.SSLCertRef, missingIds []int64) {
+	result = []*sslconfigs.SSLCertRef{}
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		newId, ok := this.Get(ItemTypeSSLCert, ref.CertId)
+		if !ok {
+			missingIds = append(missingIds, ref.CertId)
+			continue
+		}
+		result = append(result, &sslconfigs.SSLCertRef{
+			IsOn:   ref.IsOn,
+			CertId: newId,
+		})
+	}
+	return
+}
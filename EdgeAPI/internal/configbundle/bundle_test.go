@@ -0,0 +1,96 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package configbundle_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configbundle"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestEncodeAndDecode(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var bundle = configbundle.NewBundle()
+	bundle.SSLCerts = append(bundle.SSLCerts, &configbundle.SSLCert{
+		Id:       1,
+		Name:     "example.com",
+		CertData: "CERT",
+		DNSNames: []string{"example.com"},
+	})
+	bundle.Servers = append(bundle.Servers, &configbundle.Server{
+		Id:   2,
+		Type: serverconfigs.ServerTypeHTTPProxy,
+		Name: "example",
+		ServerNames: []*serverconfigs.ServerNameConfig{
+			{Name: "example.com"},
+		},
+	})
+
+	for _, format := range []configbundle.Format{configbundle.FormatJSON, configbundle.FormatYAML} {
+		data, err := configbundle.Encode(bundle, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		newBundle, err := configbundle.Decode(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.IsTrue(newBundle.Version == configbundle.Version)
+		a.IsTrue(len(newBundle.SSLCerts) == 1)
+		a.IsTrue(newBundle.SSLCerts[0].Id == 1)
+		a.IsTrue(newBundle.SSLCerts[0].CertData == "CERT")
+		a.IsTrue(len(newBundle.Servers) == 1)
+		a.IsTrue(newBundle.Servers[0].Name == "example")
+		a.IsTrue(newBundle.Servers[0].ServerNames[0].Name == "example.com")
+	}
+
+	_, err := configbundle.Encode(bundle, "xml")
+	a.IsNotNil(err)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	{
+		_, err := configbundle.Decode(nil)
+		a.IsNotNil(err)
+	}
+	{
+		_, err := configbundle.Decode([]byte(`{"servers":[]}`))
+		a.IsNotNil(err)
+	}
+	{
+		_, err := configbundle.Decode([]byte(`{"version":1000}`))
+		a.IsNotNil(err)
+	}
+	{
+		_, err := configbundle.Decode([]byte("version: 1"))
+		a.IsNil(err)
+	}
+}
+
+func TestIdMap_RemapCertRefs(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var idMap = configbundle.NewIdMap()
+	idMap.Put(configbundle.ItemTypeSSLCert, 1, 101)
+	idMap.Put(configbundle.ItemTypeServer, 1, 201)
+
+	refs, missingIds := idMap.RemapCertRefs([]*sslconfigs.SSLCertRef{
+		{IsOn: true, CertId: 1},
+		{IsOn: true, CertId: 2},
+		nil,
+	})
+	a.IsTrue(len(refs) == 1)
+	a.IsTrue(refs[0].CertId == 101)
+	a.IsTrue(len(missingIds) == 1 && missingIds[0] == 2)
+
+	a.IsTrue(len(idMap.Mappings()) == 2)
+	a.IsTrue(idMap.Mappings()[1].ItemType == configbundle.ItemTypeServer)
+	a.IsTrue(idMap.Mappings()[1].NewId == 201)
+}
@@ -0,0 +1,357 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package configbundle
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models/dns"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/firewallconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+// Exporter 配置导出器
+// 导出网站时会自动导出其引用的证书和WAF策略
+type Exporter struct {
+	tx             *dbs.Tx
+	includeSecrets bool
+	bundle         *Bundle
+
+	addedIds map[string]map[int64]bool // itemType => { id => true }
+}
+
+func NewExporter(tx *dbs.Tx, includeSecrets bool) *Exporter {
+	return &Exporter{
+		tx:             tx,
+		includeSecrets: includeSecrets,
+		bundle:         NewBundle(),
+		addedIds:       map[string]map[int64]bool{},
+	}
+}
+
+// Bundle 取得导出的配置包
+func (this *Exporter) Bundle() *Bundle {
+	return this.bundle
+}
+
+// AddSSLCert 导出证书
+func (this *Exporter) AddSSLCert(certId int64) error {
+	if certId <= 0 || !this.markAdded(ItemTypeSSLCert, certId) {
+		return nil
+	}
+
+	cert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(this.tx, certId)
+	if err != nil {
+		return err
+	}
+	if cert == nil {
+		return errors.New("can not find cert with id '" + types.String(certId) + "'")
+	}
+
+	var certItem = &SSLCert{
+		Id:          int64(cert.Id),
+		IsOn:        cert.IsOn,
+		Name:        cert.Name,
+		Description: cert.Description,
+		ServerName:  cert.ServerName,
+		IsCA:        cert.IsCA,
+		CertData:    string(cert.CertData),
+		TimeBeginAt: int64(cert.TimeBeginAt),
+		TimeEndAt:   int64(cert.TimeEndAt),
+		DNSNames:    cert.DecodeDNSNames(),
+		CommonNames: cert.DecodeCommonNames(),
+	}
+	if this.includeSecrets && len(cert.KeyData) > 0 {
+		keyData, err := cert.DecodeKeyData()
+		if err != nil {
+			return err
+		}
+		certItem.KeyData = string(keyData)
+	}
+	this.bundle.SSLCerts = append(this.bundle.SSLCerts, certItem)
+	return nil
+}
+
+// AddDNSProvider 导出DNS服务商
+func (this *Exporter) AddDNSProvider(providerId int64) error {
+	if providerId <= 0 || !this.markAdded(ItemTypeDNSProvider, providerId) {
+		return nil
+	}
+
+	provider, err := dns.SharedDNSProviderDAO.FindEnabledDNSProvider(this.tx, providerId)
+	if err != nil {
+		return err
+	}
+	if provider == nil {
+		return errors.New("can not find dns provider with id '" + types.String(providerId) + "'")
+	}
+
+	var providerItem = &DNSProvider{
+		Id:     int64(provider.Id),
+		Type:   provider.Type,
+		Name:   provider.Name,
+		MinTTL: int32(provider.MinTTL),
+	}
+	if this.includeSecrets {
+		apiParams, err := provider.DecodeAPIParams()
+		if err != nil {
+			return err
+		}
+		providerItem.APIParams = apiParams
+	}
+	this.bundle.DNSProviders = append(this.bundle.DNSProviders, providerItem)
+	return nil
+}
+
+// AddHTTPCachePolicy 导出缓存策略
+func (this *Exporter) AddHTTPCachePolicy(policyId int64) error {
+	if policyId <= 0 || !this.markAdded(ItemTypeHTTPCachePolicy, policyId) {
+		return nil
+	}
+
+	config, err := models.SharedHTTPCachePolicyDAO.ComposeCachePolicy(this.tx, policyId, nil)
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		return errors.New("can not find cache policy with id '" + types.String(policyId) + "'")
+	}
+
+	this.bundle.HTTPCachePolicies = append(this.bundle.HTTPCachePolicies, &HTTPCachePolicy{
+		Id:     policyId,
+		Config: config,
+	})
+	return nil
+}
+
+// AddHTTPFirewallPolicy 导出WAF策略
+// IP名单中的数据不会被导出
+func (this *Exporter) AddHTTPFirewallPolicy(policyId int64) error {
+	if policyId <= 0 || !this.markAdded(ItemTypeHTTPFirewallPolicy, policyId) {
+		return nil
+	}
+
+	config, err := models.SharedHTTPFirewallPolicyDAO.ComposeFirewallPolicy(this.tx, policyId, false, nil)
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		return errors.New("can not find firewall policy with id '" + types.String(policyId) + "'")
+	}
+
+	// 去除当前系统中的关联ID
+	if config.Inbound != nil {
+		config.Inbound.GroupRefs = nil
+		config.Inbound.AllowListRef = nil
+		config.Inbound.DenyListRef = nil
+		config.Inbound.GreyListRef = nil
+		config.Inbound.PublicAllowListRefs = nil
+		config.Inbound.PublicDenyListRefs = nil
+		config.Inbound.PublicGreyListRefs = nil
+	}
+	if config.Outbound != nil {
+		config.Outbound.GroupRefs = nil
+	}
+
+	this.bundle.HTTPFirewallPolicies = append(this.bundle.HTTPFirewallPolicies, &HTTPFirewallPolicy{
+		Id:     policyId,
+		Config: config,
+	})
+	return nil
+}
+
+// AddServer 导出网站
+func (this *Exporter) AddServer(serverId int64) error {
+	if serverId <= 0 || !this.markAdded(ItemTypeServer, serverId) {
+		return nil
+	}
+
+	server, err := models.SharedServerDAO.FindEnabledServer(this.tx, serverId)
+	if err != nil {
+		return err
+	}
+	if server == nil {
+		return errors.New("can not find server with id '" + types.String(serverId) + "'")
+	}
+
+	serverNames, _ := server.DecodeServerNames()
+	var serverItem = &Server{
+		Id:          int64(server.Id),
+		Type:        server.Type,
+		IsOn:        server.IsOn,
+		Name:        server.Name,
+		Description: server.Description,
+		ServerNames: serverNames,
+	}
+
+	// 协议
+	if models.IsNotNull(server.Http) {
+		var httpConfig = &serverconfigs.HTTPProtocolConfig{}
+		err = json.Unmarshal(server.Http, httpConfig)
+		if err != nil {
+			return err
+		}
+		serverItem.HTTP = httpConfig
+	}
+	if models.IsNotNull(server.Https) {
+		var httpsConfig = &serverconfigs.HTTPSProtocolConfig{}
+		err = json.Unmarshal(server.Https, httpsConfig)
+		if err != nil {
+			return err
+		}
+		httpsConfig.SSLPolicy, err = this.composeSSLPolicy(httpsConfig.SSLPolicyRef)
+		if err != nil {
+			return err
+		}
+		serverItem.HTTPS = httpsConfig
+	}
+	if models.IsNotNull(server.Tcp) {
+		var tcpConfig = &serverconfigs.TCPProtocolConfig{}
+		err = json.Unmarshal(server.Tcp, tcpConfig)
+		if err != nil {
+			return err
+		}
+		serverItem.TCP = tcpConfig
+	}
+	if models.IsNotNull(server.Tls) {
+		var tlsConfig = &serverconfigs.TLSProtocolConfig{}
+		err = json.Unmarshal(server.Tls, tlsConfig)
+		if err != nil {
+			return err
+		}
+		tlsConfig.SSLPolicy, err = this.composeSSLPolicy(tlsConfig.SSLPolicyRef)
+		if err != nil {
+			return err
+		}
+		serverItem.TLS = tlsConfig
+	}
+	if models.IsNotNull(server.Udp) {
+		var udpConfig = &serverconfigs.UDPProtocolConfig{}
+		err = json.Unmarshal(server.Udp, udpConfig)
+		if err != nil {
+			return err
+		}
+		serverItem.UDP = udpConfig
+	}
+
+	// 反向代理
+	if models.IsNotNull(server.ReverseProxy) {
+		var reverseProxyRef = &serverconfigs.ReverseProxyRef{}
+		err = json.Unmarshal(server.ReverseProxy, reverseProxyRef)
+		if err != nil {
+			return err
+		}
+		if reverseProxyRef.ReverseProxyId > 0 {
+			reverseProxyConfig, err := models.SharedReverseProxyDAO.ComposeReverseProxyConfig(this.tx, reverseProxyRef.ReverseProxyId, nil, nil)
+			if err != nil {
+				return err
+			}
+			if reverseProxyConfig != nil {
+				reverseProxyConfig.PrimaryOriginRefs = nil
+				reverseProxyConfig.BackupOriginRefs = nil
+				for _, origins := range [][]*serverconfigs.OriginConfig{reverseProxyConfig.PrimaryOrigins, reverseProxyConfig.BackupOrigins} {
+					for _, origin := range origins {
+						origin.Cert = nil
+						if origin.CertRef != nil {
+							err = this.AddSSLCert(origin.CertRef.CertId)
+							if err != nil {
+								return err
+							}
+						}
+					}
+				}
+				serverItem.ReverseProxyRef = reverseProxyRef
+				serverItem.ReverseProxy = reverseProxyConfig
+			}
+		}
+	}
+
+	// Web设置
+	if server.WebId > 0 {
+		web, err := models.SharedHTTPWebDAO.FindEnabledHTTPWeb(this.tx, int64(server.WebId))
+		if err != nil {
+			return err
+		}
+		if web != nil {
+			var webItem = &Web{}
+			if models.IsNotNull(web.Root) {
+				var rootConfig = &serverconfigs.HTTPRootConfig{}
+				err = json.Unmarshal(web.Root, rootConfig)
+				if err != nil {
+					return err
+				}
+				webItem.Root = rootConfig
+			}
+			if models.IsNotNull(web.Cache) {
+				var cacheConfig = &serverconfigs.HTTPCacheConfig{}
+				err = json.Unmarshal(web.Cache, cacheConfig)
+				if err != nil {
+					return err
+				}
+				webItem.Cache = cacheConfig
+			}
+			if models.IsNotNull(web.Firewall) {
+				var firewallRef = &firewallconfigs.HTTPFirewallRef{}
+				err = json.Unmarshal(web.Firewall, firewallRef)
+				if err != nil {
+					return err
+				}
+				err = this.AddHTTPFirewallPolicy(firewallRef.FirewallPolicyId)
+				if err != nil {
+					return err
+				}
+				webItem.Firewall = firewallRef
+			}
+			serverItem.Web = webItem
+		}
+	}
+
+	this.bundle.Servers = append(this.bundle.Servers, serverItem)
+	return nil
+}
+
+// 组合SSL策略，并导出其中引用的证书
+func (this *Exporter) composeSSLPolicy(ref *sslconfigs.SSLPolicyRef) (*sslconfigs.SSLPolicy, error) {
+	if ref == nil || ref.SSLPolicyId <= 0 {
+		return nil, nil
+	}
+	policy, err := models.SharedSSLPolicyDAO.ComposePolicyConfig(this.tx, ref.SSLPolicyId, true, nil, nil)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+
+	// 证书内容单独导出
+	policy.Certs = nil
+	policy.ClientCACerts = nil
+	for _, certRefs := range [][]*sslconfigs.SSLCertRef{policy.CertRefs, policy.ClientCARefs} {
+		for _, certRef := range certRefs {
+			if certRef == nil {
+				continue
+			}
+			err = this.AddSSLCert(certRef.CertId)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return policy, nil
+}
+
+// 标记对象已导出，返回是否为第一次标记
+func (this *Exporter) markAdded(itemType string, id int64) bool {
+	idMap, ok := this.addedIds[itemType]
+	if !ok {
+		idMap = map[int64]bool{}
+		this.addedIds[itemType] = idMap
+	}
+	if idMap[id] {
+		return false
+	}
+	idMap[id] = true
+	return true
+}
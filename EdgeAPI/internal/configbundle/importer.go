@@ -0,0 +1,404 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package configbundle
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models/dns"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+// Importer 配置导入器
+// 所有对象都会重新创建，其中的引用关系使用新的ID
+type Importer struct {
+	tx                   *dbs.Tx
+	adminId              int64
+	userId               int64
+	clusterId            int64
+	skipExistServerNames bool
+
+	idMap    *IdMap
+	warnings []string
+}
+
+func NewImporter(tx *dbs.Tx, adminId int64, userId int64, clusterId int64, skipExistServerNames bool) *Importer {
+	return &Importer{
+		tx:                   tx,
+		adminId:              adminId,
+		userId:               userId,
+		clusterId:            clusterId,
+		skipExistServerNames: skipExistServerNames,
+		idMap:                NewIdMap(),
+	}
+}
+
+// IdMap 取得新旧ID映射
+func (this *Importer) IdMap() *IdMap {
+	return this.idMap
+}
+
+// Warnings 取得导入过程中产生的警告
+func (this *Importer) Warnings() []string {
+	return this.warnings
+}
+
+// Import 导入配置包
+// 按照依赖关系依次导入证书、DNS服务商、缓存策略、WAF策略和网站
+func (this *Importer) Import(bundle *Bundle) error {
+	if bundle == nil {
+		return errors.New("'bundle' should not be nil")
+	}
+	if len(bundle.Servers) > 0 && this.clusterId <= 0 {
+		return errors.New("'nodeClusterId' is required to import servers")
+	}
+
+	for _, cert := range bundle.SSLCerts {
+		err := this.importSSLCert(cert)
+		if err != nil {
+			return errors.New("import cert '" + cert.Name + "' failed: " + err.Error())
+		}
+	}
+	for _, provider := range bundle.DNSProviders {
+		err := this.importDNSProvider(provider)
+		if err != nil {
+			return errors.New("import dns provider '" + provider.Name + "' failed: " + err.Error())
+		}
+	}
+	for _, policy := range bundle.HTTPCachePolicies {
+		err := this.importHTTPCachePolicy(policy)
+		if err != nil {
+			return errors.New("import cache policy '" + types.String(policy.Id) + "' failed: " + err.Error())
+		}
+	}
+	for _, policy := range bundle.HTTPFirewallPolicies {
+		err := this.importHTTPFirewallPolicy(policy)
+		if err != nil {
+			return errors.New("import firewall policy '" + types.String(policy.Id) + "' failed: " + err.Error())
+		}
+	}
+	for _, server := range bundle.Servers {
+		err := this.importServer(server)
+		if err != nil {
+			return errors.New("import server '" + server.Name + "' failed: " + err.Error())
+		}
+	}
+
+	// 网站专属的WAF策略
+	for _, policy := range bundle.HTTPFirewallPolicies {
+		if policy.Config == nil || policy.Config.ServerId <= 0 {
+			continue
+		}
+		newPolicyId, _ := this.idMap.Get(ItemTypeHTTPFirewallPolicy, policy.Id)
+		newServerId, _ := this.idMap.Get(ItemTypeServer, policy.Config.ServerId)
+		if newPolicyId > 0 && newServerId > 0 {
+			err := models.SharedHTTPFirewallPolicyDAO.UpdateFirewallPolicyServerId(this.tx, newPolicyId, newServerId)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (this *Importer) importSSLCert(cert *SSLCert) error {
+	if cert == nil {
+		return nil
+	}
+	if len(cert.CertData) == 0 {
+		return errors.New("'certData' should not be empty")
+	}
+
+	// 已经有相同证书时直接使用
+	certId, err := models.SharedSSLCertDAO.FindDuplicatedCertId(this.tx, this.userId, cert.IsCA, sslconfigs.SSLCertKeyStorageDB, []byte(cert.CertData))
+	if err != nil {
+		return err
+	}
+	if certId <= 0 {
+		if !cert.IsCA && len(cert.KeyData) == 0 {
+			this.addWarning("证书'" + cert.Name + "'中没有私钥，导入后需要重新上传私钥")
+		}
+		certId, err = models.SharedSSLCertDAO.CreateCert(this.tx, this.adminId, this.userId, cert.IsOn, cert.Name, cert.Description, cert.ServerName, cert.IsCA, []byte(cert.CertData), []byte(cert.KeyData), cert.TimeBeginAt, cert.TimeEndAt, cert.DNSNames, cert.CommonNames)
+		if err != nil {
+			return err
+		}
+	}
+	this.idMap.Put(ItemTypeSSLCert, cert.Id, certId)
+	return nil
+}
+
+func (this *Importer) importDNSProvider(provider *DNSProvider) error {
+	if provider == nil {
+		return nil
+	}
+
+	var apiParamsJSON = []byte("{}")
+	if len(provider.APIParams) > 0 {
+		var err error
+		apiParamsJSON, err = json.Marshal(provider.APIParams)
+		if err != nil {
+			return err
+		}
+	} else {
+		this.addWarning("DNS服务商'" + provider.Name + "'中没有API参数，导入后需要重新设置")
+	}
+
+	providerId, err := dns.SharedDNSProviderDAO.CreateDNSProvider(this.tx, this.adminId, this.userId, provider.Type, provider.Name, apiParamsJSON, provider.MinTTL)
+	if err != nil {
+		return err
+	}
+	this.idMap.Put(ItemTypeDNSProvider, provider.Id, providerId)
+	return nil
+}
+
+func (this *Importer) importHTTPCachePolicy(policy *HTTPCachePolicy) error {
+	if policy == nil || policy.Config == nil {
+		return nil
+	}
+	policyId, err := models.SharedHTTPCachePolicyDAO.CreateCachePolicyFromConfig(this.tx, policy.Config)
+	if err != nil {
+		return err
+	}
+	this.idMap.Put(ItemTypeHTTPCachePolicy, policy.Id, policyId)
+	return nil
+}
+
+func (this *Importer) importHTTPFirewallPolicy(policy *HTTPFirewallPolicy) error {
+	if policy == nil || policy.Config == nil {
+		return nil
+	}
+	policyId, err := models.SharedHTTPFirewallPolicyDAO.CreateFirewallPolicyFromConfig(this.tx, this.userId, policy.Config)
+	if err != nil {
+		return err
+	}
+	this.idMap.Put(ItemTypeHTTPFirewallPolicy, policy.Id, policyId)
+	return nil
+}
+
+func (this *Importer) importServer(server *Server) error {
+	if server == nil {
+		return nil
+	}
+
+	// 检查域名
+	for _, serverName := range serverconfigs.PlainServerNames(server.ServerNames) {
+		exists, err := models.SharedServerDAO.ExistServerNameInCluster(this.tx, this.clusterId, serverName, 0, true)
+		if err != nil {
+			return err
+		}
+		if exists {
+			if this.skipExistServerNames {
+				this.addWarning("网站'" + server.Name + "'的域名'" + serverName + "'已经存在，已跳过")
+				this.idMap.Put(ItemTypeServer, server.Id, 0)
+				return nil
+			}
+			return errors.New("domain '" + serverName + "' already created by other server")
+		}
+	}
+
+	serverNamesJSON, err := json.Marshal(server.ServerNames)
+	if err != nil {
+		return err
+	}
+
+	// 协议
+	var httpJSON []byte
+	if server.HTTP != nil {
+		httpJSON, err = json.Marshal(server.HTTP)
+		if err != nil {
+			return err
+		}
+	}
+	var httpsJSON []byte
+	if server.HTTPS != nil {
+		server.HTTPS.SSLPolicyRef, err = this.createSSLPolicy(server.Name, server.HTTPS.SSLPolicy)
+		if err != nil {
+			return err
+		}
+		server.HTTPS.SSLPolicy = nil
+		httpsJSON, err = json.Marshal(server.HTTPS)
+		if err != nil {
+			return err
+		}
+	}
+	var tcpJSON []byte
+	if server.TCP != nil {
+		tcpJSON, err = json.Marshal(server.TCP)
+		if err != nil {
+			return err
+		}
+	}
+	var tlsJSON []byte
+	if server.TLS != nil {
+		server.TLS.SSLPolicyRef, err = this.createSSLPolicy(server.Name, server.TLS.SSLPolicy)
+		if err != nil {
+			return err
+		}
+		server.TLS.SSLPolicy = nil
+		tlsJSON, err = json.Marshal(server.TLS)
+		if err != nil {
+			return err
+		}
+	}
+	var udpJSON []byte
+	if server.UDP != nil {
+		udpJSON, err = json.Marshal(server.UDP)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 反向代理
+	var reverseProxyJSON []byte
+	if server.ReverseProxy != nil {
+		for _, origins := range [][]*serverconfigs.OriginConfig{server.ReverseProxy.PrimaryOrigins, server.ReverseProxy.BackupOrigins} {
+			for _, origin := range origins {
+				if origin == nil || origin.CertRef == nil {
+					continue
+				}
+				newCertId, ok := this.idMap.Get(ItemTypeSSLCert, origin.CertRef.CertId)
+				if ok {
+					origin.CertRef.CertId = newCertId
+				} else {
+					this.addWarning("网站'" + server.Name + "'的源站'" + origin.Name + "'引用的证书'" + types.String(origin.CertRef.CertId) + "'不在配置包中，已忽略")
+					origin.CertRef = nil
+				}
+			}
+		}
+
+		reverseProxyId, err := models.SharedReverseProxyDAO.CreateReverseProxyFromConfig(this.tx, this.adminId, this.userId, server.ReverseProxy)
+		if err != nil {
+			return err
+		}
+		var reverseProxyRef = &serverconfigs.ReverseProxyRef{
+			IsOn:           true,
+			ReverseProxyId: reverseProxyId,
+		}
+		if server.ReverseProxyRef != nil {
+			reverseProxyRef.IsPrior = server.ReverseProxyRef.IsPrior
+			reverseProxyRef.IsOn = server.ReverseProxyRef.IsOn
+		}
+		reverseProxyJSON, err = json.Marshal(reverseProxyRef)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Web设置
+	var webId int64
+	if server.Web != nil {
+		webId, err = this.createWeb(server.Name, server.Web)
+		if err != nil {
+			return err
+		}
+	}
+
+	serverId, err := models.SharedServerDAO.CreateServer(this.tx, this.adminId, this.userId, server.Type, server.Name, server.Description, serverNamesJSON, false, nil, httpJSON, httpsJSON, tcpJSON, tlsJSON, udpJSON, webId, reverseProxyJSON, this.clusterId, nil, nil, nil, 0)
+	if err != nil {
+		return err
+	}
+	if !server.IsOn {
+		err = models.SharedServerDAO.UpdateServerIsOn(this.tx, serverId, false)
+		if err != nil {
+			return err
+		}
+	}
+	this.idMap.Put(ItemTypeServer, server.Id, serverId)
+
+	return models.SharedServerDAO.NotifyUpdate(this.tx, serverId)
+}
+
+// 根据配置创建SSL策略
+func (this *Importer) createSSLPolicy(serverName string, policy *sslconfigs.SSLPolicy) (*sslconfigs.SSLPolicyRef, error) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	certRefs, missingCertIds := this.idMap.RemapCertRefs(policy.CertRefs)
+	clientCARefs, missingClientCAIds := this.idMap.RemapCertRefs(policy.ClientCARefs)
+	for _, certId := range append(missingCertIds, missingClientCAIds...) {
+		this.addWarning("网站'" + serverName + "'引用的证书'" + types.String(certId) + "'不在配置包中，已忽略")
+	}
+
+	certsJSON, err := json.Marshal(certRefs)
+	if err != nil {
+		return nil, err
+	}
+	clientCACertsJSON, err := json.Marshal(clientCARefs)
+	if err != nil {
+		return nil, err
+	}
+	var hstsJSON []byte
+	if policy.HSTS != nil {
+		hstsJSON, err = json.Marshal(policy.HSTS)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	policyId, err := models.SharedSSLPolicyDAO.CreatePolicy(this.tx, this.adminId, this.userId, policy.HTTP2Enabled, policy.HTTP3Enabled, policy.MinVersion, certsJSON, hstsJSON, policy.OCSPIsOn, types.Int32(policy.ClientAuthType), clientCACertsJSON, policy.CipherSuitesIsOn, policy.CipherSuites)
+	if err != nil {
+		return nil, err
+	}
+	return &sslconfigs.SSLPolicyRef{
+		IsOn:        true,
+		SSLPolicyId: policyId,
+	}, nil
+}
+
+// 根据配置创建Web设置
+func (this *Importer) createWeb(serverName string, web *Web) (int64, error) {
+	var rootJSON []byte
+	var err error
+	if web.Root != nil {
+		rootJSON, err = json.Marshal(web.Root)
+		if err != nil {
+			return 0, err
+		}
+	}
+	webId, err := models.SharedHTTPWebDAO.CreateWeb(this.tx, this.adminId, this.userId, rootJSON)
+	if err != nil {
+		return 0, err
+	}
+
+	if web.Cache != nil {
+		cacheJSON, err := json.Marshal(web.Cache)
+		if err != nil {
+			return 0, err
+		}
+		err = models.SharedHTTPWebDAO.UpdateWebCache(this.tx, webId, cacheJSON)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if web.Firewall != nil {
+		if web.Firewall.FirewallPolicyId > 0 {
+			newPolicyId, ok := this.idMap.Get(ItemTypeHTTPFirewallPolicy, web.Firewall.FirewallPolicyId)
+			if !ok {
+				this.addWarning("网站'" + serverName + "'引用的WAF策略'" + types.String(web.Firewall.FirewallPolicyId) + "'不在配置包中，已忽略")
+			}
+			web.Firewall.FirewallPolicyId = newPolicyId
+		}
+		firewallJSON, err := json.Marshal(web.Firewall)
+		if err != nil {
+			return 0, err
+		}
+		err = models.SharedHTTPWebDAO.UpdateWebFirewall(this.tx, webId, firewallJSON)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return webId, nil
+}
+
+func (this *Importer) addWarning(warning string) {
+	this.warnings = append(this.warnings, warning)
+}
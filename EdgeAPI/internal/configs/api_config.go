@@ -16,6 +16,9 @@ type APIConfig struct {
 	NodeId string `yaml:"nodeId" json:"nodeId"`
 	Secret string `yaml:"secret" json:"secret"`
 
+	KeyEncryption *KeyEncryptionConfig `yaml:"keyEncryption,omitempty" json:"keyEncryption,omitempty"` // 证书私钥加密设置
+	SlowCall      *SlowCallConfig      `yaml:"slowCall,omitempty" json:"slowCall,omitempty"`           // 慢调用日志设置
+
 	numberId int64 // 数字ID
 }
 
@@ -101,6 +104,14 @@ func SharedAPIConfig() (*APIConfig, error) {
 	return config, nil
 }
 
+// SetSharedAPIConfig 直接设置共享配置
+// 用于不读取配置文件的场景，比如在集成测试中启动API服务
+func SetSharedAPIConfig(config *APIConfig) {
+	sharedLocker.Lock()
+	sharedAPIConfig = config
+	sharedLocker.Unlock()
+}
+
 // SetNumberId 设置数字ID
 func (this *APIConfig) SetNumberId(numberId int64) {
 	this.numberId = numberId
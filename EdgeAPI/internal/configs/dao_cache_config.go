@@ -0,0 +1,27 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package configs
+
+import (
+	"errors"
+	"time"
+)
+
+// DAOCacheConfig DAO缓存设置
+// 开启后集群配置、服务、策略等常用数据会在内存中缓存一段时间，修改时自动失效
+type DAOCacheConfig struct {
+	IsOn bool   `yaml:"isOn"` // 是否开启
+	TTL  string `yaml:"ttl"`  // 缓存时间，比如 30s、1m，默认60s
+}
+
+// TTLDuration 缓存时间，为空时返回0
+func (this *DAOCacheConfig) TTLDuration() (time.Duration, error) {
+	if len(this.TTL) == 0 {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(this.TTL)
+	if err != nil {
+		return 0, errors.New("invalid dao cache ttl '" + this.TTL + "'")
+	}
+	return duration, nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package configs
+
+import (
+	"errors"
+	"time"
+)
+
+// DBPoolConfig 数据库连接池设置
+// 为0或者为空时使用默认值
+type DBPoolConfig struct {
+	MaxOpen     int    `yaml:"maxOpen"`     // 最大连接数，默认128
+	MaxIdle     int    `yaml:"maxIdle"`     // 最大空闲连接数，默认64
+	MaxLifetime string `yaml:"maxLifetime"` // 连接最长使用时间，比如 30s、5m，默认30s
+	MaxIdleTime string `yaml:"maxIdleTime"` // 空闲连接最长保留时间，默认2m
+}
+
+// Validate 校验设置
+func (this *DBPoolConfig) Validate() error {
+	for _, duration := range []string{this.MaxLifetime, this.MaxIdleTime} {
+		if len(duration) == 0 {
+			continue
+		}
+		_, err := time.ParseDuration(duration)
+		if err != nil {
+			return errors.New("invalid duration '" + duration + "' in db pool config")
+		}
+	}
+	return nil
+}
+
+// MaxIdleTimeDuration 空闲连接最长保留时间
+func (this *DBPoolConfig) MaxIdleTimeDuration() time.Duration {
+	if len(this.MaxIdleTime) == 0 {
+		return 0
+	}
+	duration, _ := time.ParseDuration(this.MaxIdleTime)
+	return duration
+}
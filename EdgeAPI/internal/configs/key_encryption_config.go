@@ -0,0 +1,31 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package configs
+
+type KeyEncryptionType = string
+
+const (
+	KeyEncryptionTypeFile      KeyEncryptionType = "file"      // 本地主密钥文件
+	KeyEncryptionTypeAliyunKMS KeyEncryptionType = "aliyunKMS" // 阿里云KMS解密的数据密钥
+)
+
+// KeyEncryptionConfig 证书私钥加密设置
+// 同一个集群中的所有API节点必须使用相同的主密钥
+type KeyEncryptionConfig struct {
+	Type KeyEncryptionType `yaml:"type" json:"type"` // 主密钥来源
+
+	// 本地文件
+	KeyFile string `yaml:"keyFile" json:"keyFile"` // 主密钥文件，内容为32字节密钥的HEX或Base64编码
+
+	// 阿里云KMS
+	AliyunKMS *AliyunKMSConfig `yaml:"aliyunKMS,omitempty" json:"aliyunKMS,omitempty"`
+}
+
+// AliyunKMSConfig 阿里云KMS设置
+// 使用GenerateDataKey生成数据密钥后，将密文填入CiphertextBlob，启动时通过KMS解密得到主密钥
+type AliyunKMSConfig struct {
+	RegionId        string `yaml:"regionId" json:"regionId"`
+	AccessKeyId     string `yaml:"accessKeyId" json:"accessKeyId"`
+	AccessKeySecret string `yaml:"accessKeySecret" json:"accessKeySecret"`
+	CiphertextBlob  string `yaml:"ciphertextBlob" json:"ciphertextBlob"`
+}
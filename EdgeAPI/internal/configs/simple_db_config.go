@@ -3,21 +3,34 @@
 package configs
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
+	"time"
 
+	"github.com/TeaOSLab/EdgeAPI/internal/db/slowquery"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
 	"gopkg.in/yaml.v3"
 )
 
 type SimpleDBConfig struct {
-	User       string   `yaml:"user"`
-	Password   string   `yaml:"password"`
-	Database   string   `yaml:"database"`
-	Host       string   `yaml:"host"`
-	BoolFields []string `yaml:"boolFields,omitempty"`
+	Driver     string        `yaml:"driver,omitempty"` // 数据库驱动，默认为MySQL，单机部署时可以使用 sqlite
+	File       string        `yaml:"file,omitempty"`   // SQLite数据库文件，相对路径为相对于程序根目录
+	User       string        `yaml:"user"`
+	Password   string        `yaml:"password"`
+	Database   string        `yaml:"database"`
+	Host       string        `yaml:"host"`
+	BoolFields []string      `yaml:"boolFields,omitempty"`
+	Replicas   []string      `yaml:"replicas,omitempty"` // 只读副本DSN，列表和统计等耗时查询会优先使用只读副本
+	Pool       *DBPoolConfig `yaml:"pool,omitempty"`     // 连接池设置
+
+	FailoverHosts []string `yaml:"failoverHosts,omitempty"` // 备用主库地址，使用和主库相同的用户名、密码和数据库名，主库不可用时自动切换
+
+	SlowQueryThreshold string          `yaml:"slowQueryThreshold,omitempty"` // 慢查询阈值，比如 500ms、2s，为0时不记录慢查询
+	Cache              *DAOCacheConfig `yaml:"cache,omitempty"`              // DAO缓存设置
 }
 
 func ParseSimpleDBConfig(data []byte) (*SimpleDBConfig, error) {
@@ -26,14 +39,61 @@ func ParseSimpleDBConfig(data []byte) (*SimpleDBConfig, error) {
 	return config, err
 }
 
+// IsSQLite 是否使用SQLite
+func (this *SimpleDBConfig) IsSQLite() bool {
+	return this.Driver == sqlite.DriverName
+}
+
+// SlowQueryThresholdDuration 慢查询阈值，为空时使用默认值
+func (this *SimpleDBConfig) SlowQueryThresholdDuration() (time.Duration, error) {
+	if len(this.SlowQueryThreshold) == 0 {
+		return slowquery.DefaultThreshold, nil
+	}
+	duration, err := time.ParseDuration(this.SlowQueryThreshold)
+	if err != nil {
+		return 0, errors.New("invalid slow query threshold '" + this.SlowQueryThreshold + "'")
+	}
+	return duration, nil
+}
+
+// FailoverDSNList 备用主库的DSN列表
+func (this *SimpleDBConfig) FailoverDSNList() []string {
+	var result = []string{}
+	if this.IsSQLite() {
+		return result
+	}
+	for _, host := range this.FailoverHosts {
+		if len(host) == 0 || host == this.Host {
+			continue
+		}
+		result = append(result, this.composeMySQLDSN(host))
+	}
+	return result
+}
+
 func (this *SimpleDBConfig) GenerateOldConfig() error {
 	var dbConfig = &dbs.DBConfig{
 		Driver: "mysql",
-		Dsn:    url.QueryEscape(this.User) + ":" + this.Password + "@tcp(" + this.Host + ")/" + url.PathEscape(this.Database) + "?charset=utf8mb4&timeout=30s&multiStatements=true",
+		Dsn:    this.composeMySQLDSN(this.Host),
 		Prefix: "edge",
 	}
+	if this.IsSQLite() {
+		dbConfig.Driver = sqlite.DriverName
+		dbConfig.Dsn = sqlite.ComposeDSN(this.File)
+	}
 	dbConfig.Models.Package = "internal/db/models"
 
+	// 连接池
+	if this.Pool != nil {
+		err := this.Pool.Validate()
+		if err != nil {
+			return err
+		}
+		dbConfig.Connections.Max = this.Pool.MaxOpen
+		dbConfig.Connections.Pool = this.Pool.MaxIdle
+		dbConfig.Connections.Life = this.Pool.MaxLifetime
+	}
+
 	var config = &dbs.Config{
 		DBs: map[string]*dbs.DBConfig{
 			Tea.Env: dbConfig,
@@ -57,3 +117,8 @@ func (this *SimpleDBConfig) GenerateOldConfig() error {
 
 	return nil
 }
+
+// 根据主机地址组合MySQL DSN
+func (this *SimpleDBConfig) composeMySQLDSN(host string) string {
+	return url.QueryEscape(this.User) + ":" + this.Password + "@tcp(" + host + ")/" + url.PathEscape(this.Database) + "?charset=utf8mb4&timeout=30s&multiStatements=true"
+}
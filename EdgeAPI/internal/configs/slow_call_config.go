@@ -0,0 +1,28 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package configs
+
+import "time"
+
+// DefaultSlowCallThresholdMs 默认慢调用阈值，单位毫秒
+const DefaultSlowCallThresholdMs = 1000
+
+// SlowCallConfig 慢调用日志设置
+type SlowCallConfig struct {
+	IsDisabled  bool  `yaml:"isDisabled" json:"isDisabled"`   // 是否禁用慢调用日志
+	ThresholdMs int64 `yaml:"thresholdMs" json:"thresholdMs"` // 耗时超过此值的调用会被记录，单位毫秒，0表示使用默认值
+}
+
+// Threshold 取得慢调用阈值，返回0表示不记录
+func (this *SlowCallConfig) Threshold() time.Duration {
+	if this == nil {
+		return DefaultSlowCallThresholdMs * time.Millisecond
+	}
+	if this.IsDisabled {
+		return 0
+	}
+	if this.ThresholdMs <= 0 {
+		return DefaultSlowCallThresholdMs * time.Millisecond
+	}
+	return time.Duration(this.ThresholdMs) * time.Millisecond
+}
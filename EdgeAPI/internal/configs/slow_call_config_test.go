@@ -0,0 +1,32 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package configs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestSlowCallConfig_Threshold(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	{
+		var config *configs.SlowCallConfig
+		a.IsTrue(config.Threshold() == time.Second)
+	}
+	{
+		var config = &configs.SlowCallConfig{}
+		a.IsTrue(config.Threshold() == time.Second)
+	}
+	{
+		var config = &configs.SlowCallConfig{ThresholdMs: 200}
+		a.IsTrue(config.Threshold() == 200*time.Millisecond)
+	}
+	{
+		var config = &configs.SlowCallConfig{IsDisabled: true, ThresholdMs: 200}
+		a.IsTrue(config.Threshold() == 0)
+	}
+}
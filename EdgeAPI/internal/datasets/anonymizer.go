@@ -0,0 +1,186 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package datasets
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	stringutil "github.com/iwind/TeaGo/utils/string"
+)
+
+// AnonymizedPassword 导入或者生成的数据中所有用户的登录密码
+const AnonymizedPassword = "123456"
+
+// AnonymizeKind 脱敏方式
+type AnonymizeKind = string
+
+const (
+	AnonymizeKindUsername AnonymizeKind = "username" // 用户名
+	AnonymizeKindFullname AnonymizeKind = "fullname" // 姓名、联系人等
+	AnonymizeKindEmail    AnonymizeKind = "email"    // 邮箱
+	AnonymizeKindMobile   AnonymizeKind = "mobile"   // 手机号、电话
+	AnonymizeKindPassword AnonymizeKind = "password" // 登录密码
+	AnonymizeKindSecret   AnonymizeKind = "secret"   // 密钥、令牌等
+	AnonymizeKindDomain   AnonymizeKind = "domain"   // 包含域名的文本，比如域名列表JSON
+	AnonymizeKindEmpty    AnonymizeKind = "empty"    // 清空，比如私钥、备注等
+)
+
+// 所有表中同名字段的脱敏方式
+var columnAnonymizeKinds = map[string]AnonymizeKind{
+	"username":            AnonymizeKindUsername,
+	"fullname":            AnonymizeKindFullname,
+	"realname":            AnonymizeKindFullname,
+	"contactname":         AnonymizeKindFullname,
+	"email":               AnonymizeKindEmail,
+	"mobile":              AnonymizeKindMobile,
+	"tel":                 AnonymizeKindMobile,
+	"password":            AnonymizeKindPassword,
+	"secret":              AnonymizeKindSecret,
+	"accesskey":           AnonymizeKindSecret,
+	"accesskeyid":         AnonymizeKindSecret,
+	"uniqueid":            AnonymizeKindSecret,
+	"token":               AnonymizeKindSecret,
+	"apikey":              AnonymizeKindSecret,
+	"privatekey":          AnonymizeKindEmpty,
+	"keydata":             AnonymizeKindEmpty,
+	"remark":              AnonymizeKindEmpty,
+	"description":         AnonymizeKindEmpty,
+	"apiparams":           AnonymizeKindEmpty,
+	"servernames":         AnonymizeKindDomain,
+	"plainservernames":    AnonymizeKindDomain,
+	"auditingservernames": AnonymizeKindDomain,
+	"dnsnames":            AnonymizeKindDomain,
+	"commonnames":         AnonymizeKindDomain,
+	"domains":             AnonymizeKindDomain,
+	"dnsdomain":           AnonymizeKindDomain,
+	"dnsaliasdomain":      AnonymizeKindDomain,
+}
+
+// 某些表中特定字段的脱敏方式，优先级高于 columnAnonymizeKinds
+var tableColumnAnonymizeKinds = map[string]map[string]AnonymizeKind{
+	"edgeServers": {
+		"name": AnonymizeKindDomain,
+	},
+	"edgeSSLCerts": {
+		"name": AnonymizeKindDomain,
+	},
+	"edgeDNSDomains": {
+		"name": AnonymizeKindDomain,
+	},
+	"edgeUsers": {
+		"name": AnonymizeKindFullname,
+	},
+	"edgeNSDomains": {
+		"name": AnonymizeKindDomain,
+	},
+}
+
+var domainRegexp = regexp.MustCompile(`(?i)(\*\.)?([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}`)
+
+// Anonymizer 数据脱敏
+// 使用加盐的HMAC生成替换值，同样的原始值总是得到同样的结果，因此不同表之间的关联关系（比如同一个域名）仍然保持一致
+type Anonymizer struct {
+	salt []byte
+}
+
+func NewAnonymizer(salt string) *Anonymizer {
+	return &Anonymizer{
+		salt: []byte(salt),
+	}
+}
+
+// FindColumnKind 查找某个表字段的脱敏方式，如果不需要脱敏则返回空
+func (this *Anonymizer) FindColumnKind(tableName string, columnName string) AnonymizeKind {
+	var lowerColumnName = strings.ToLower(columnName)
+	columnKinds, ok := tableColumnAnonymizeKinds[tableName]
+	if ok {
+		kind, ok := columnKinds[lowerColumnName]
+		if ok {
+			return kind
+		}
+	}
+	return columnAnonymizeKinds[lowerColumnName]
+}
+
+// Anonymize 对字段值脱敏
+// 空值保持不变，以免改变数据的分布
+func (this *Anonymizer) Anonymize(kind AnonymizeKind, value []byte) []byte {
+	if len(value) == 0 {
+		return value
+	}
+
+	var s = string(value)
+	switch kind {
+	case AnonymizeKindUsername:
+		return []byte("user_" + this.hash(s, 10))
+	case AnonymizeKindFullname:
+		return []byte("User " + this.hash(s, 6))
+	case AnonymizeKindEmail:
+		return []byte(this.hash(s, 10) + "@example.com")
+	case AnonymizeKindMobile:
+		return []byte(this.digits(s, 11))
+	case AnonymizeKindPassword:
+		return []byte(stringutil.Md5(AnonymizedPassword))
+	case AnonymizeKindSecret:
+		return []byte(this.hash(s, len(s)))
+	case AnonymizeKindDomain:
+		return []byte(this.AnonymizeDomains(s))
+	case AnonymizeKindEmpty:
+		return []byte{}
+	}
+	return value
+}
+
+// AnonymizeDomains 替换文本中的所有域名
+// 保留顶级域名、层级数量和通配符，以便和原数据有相似的分布
+func (this *Anonymizer) AnonymizeDomains(s string) string {
+	return domainRegexp.ReplaceAllStringFunc(s, this.AnonymizeDomain)
+}
+
+// AnonymizeDomain 替换单个域名
+func (this *Anonymizer) AnonymizeDomain(domain string) string {
+	var lowerDomain = strings.ToLower(domain)
+	var prefix = ""
+	if strings.HasPrefix(lowerDomain, "*.") {
+		prefix = "*."
+		lowerDomain = lowerDomain[2:]
+	}
+
+	var pieces = strings.Split(lowerDomain, ".")
+	if len(pieces) < 2 {
+		return domain
+	}
+
+	// 每一级使用上级域名+本级名称生成，保证同一个主域名下的子域名仍然属于同一个主域名
+	var result = []string{pieces[len(pieces)-1]}
+	for i := len(pieces) - 2; i >= 0; i-- {
+		result = append([]string{this.hash(strings.Join(pieces[i:], "."), 8)}, result...)
+	}
+	return prefix + strings.Join(result, ".")
+}
+
+// 生成长度为 size 的十六进制字符串
+func (this *Anonymizer) hash(s string, size int) string {
+	var result = ""
+	for i := 0; len(result) < size; i++ {
+		var mac = hmac.New(sha256.New, this.salt)
+		mac.Write([]byte{byte(i)})
+		mac.Write([]byte(s))
+		result += hex.EncodeToString(mac.Sum(nil))
+	}
+	return result[:size]
+}
+
+// 生成长度为 size 的数字字符串，以1开头
+func (this *Anonymizer) digits(s string, size int) string {
+	var hexString = this.hash(s, size-1)
+	var result = []byte{'1'}
+	for _, c := range []byte(hexString) {
+		result = append(result, '0'+c%10)
+	}
+	return string(result)
+}
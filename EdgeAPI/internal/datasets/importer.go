@@ -0,0 +1,297 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package datasets
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/lists"
+	"github.com/iwind/TeaGo/types"
+)
+
+// 不导入的数据表，这些表中的数据和当前环境相关，或者包含运维人员的敏感信息
+var importSkippedTables = []string{
+	"edgeAdmins",
+	"edgeAPINodes",
+	"edgeAPITokens",
+	"edgeSysSettings",
+	"edgeSysEvents",
+	"edgeLogins",
+	"edgeLoginSessions",
+	"edgeLoginTickets",
+	"edgeVersions",
+}
+
+// 日志数据表，默认不导入
+var importLogTableRegexp = regexp.MustCompile(`^edge(HTTPAccessLogs|NSAccessLogs|Logs|NodeLogs|NodeLogins|ACMETaskLogs|MessageTaskLogs|NodeIPAddressLogs)(_\d+)*$`)
+
+// ImportOptions 导入选项
+type ImportOptions struct {
+	Tables    []string // 只导入这些表，为空表示所有表
+	WithLogs  bool     // 是否导入日志表
+	Truncate  bool     // 导入前是否清空目标表，如果为false，则跳过已有数据的表
+	BatchSize int      // 每批写入的记录数
+}
+
+// ImportResult 导入结果
+type ImportResult struct {
+	CountTables int   // 导入的表数量
+	CountRows   int64 // 导入的记录数
+}
+
+// SnapshotImporter 从生产环境数据库（通常是备份恢复出来的实例）中导入脱敏后的数据
+// 只导入源库和目标库中都存在的表和字段，目标库的表结构需要先通过 upgrade 升级到当前版本
+type SnapshotImporter struct {
+	sourceDB   *dbs.DB
+	targetDB   *dbs.DB
+	anonymizer *Anonymizer
+	options    *ImportOptions
+
+	logWriter io.Writer
+}
+
+func NewSnapshotImporter(sourceDB *dbs.DB, targetDB *dbs.DB, anonymizer *Anonymizer, options *ImportOptions) *SnapshotImporter {
+	if options == nil {
+		options = &ImportOptions{}
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = 500
+	}
+	return &SnapshotImporter{
+		sourceDB:   sourceDB,
+		targetDB:   targetDB,
+		anonymizer: anonymizer,
+		options:    options,
+	}
+}
+
+// SetLogWriter 设置日志输出
+func (this *SnapshotImporter) SetLogWriter(logWriter io.Writer) {
+	this.logWriter = logWriter
+}
+
+// Import 执行导入
+func (this *SnapshotImporter) Import() (*ImportResult, error) {
+	sourceTableNames, err := this.sourceDB.TableNames()
+	if err != nil {
+		return nil, fmt.Errorf("read source tables failed: %w", err)
+	}
+	targetTableNames, err := this.targetDB.TableNames()
+	if err != nil {
+		return nil, fmt.Errorf("read target tables failed: %w", err)
+	}
+
+	var result = &ImportResult{}
+	for _, tableName := range sourceTableNames {
+		if !this.shouldImport(tableName) {
+			continue
+		}
+		if !lists.ContainsString(targetTableNames, tableName) {
+			this.log("skip '" + tableName + "': not found in target database")
+			continue
+		}
+
+		countRows, err := this.importTable(tableName)
+		if err != nil {
+			return nil, fmt.Errorf("import table '%s' failed: %w", tableName, err)
+		}
+		if countRows < 0 {
+			continue
+		}
+
+		result.CountTables++
+		result.CountRows += countRows
+	}
+
+	return result, nil
+}
+
+// 检查是否需要导入某个表
+func (this *SnapshotImporter) shouldImport(tableName string) bool {
+	if len(this.options.Tables) > 0 {
+		return lists.ContainsString(this.options.Tables, tableName)
+	}
+	if lists.ContainsString(importSkippedTables, tableName) {
+		return false
+	}
+	if !this.options.WithLogs && importLogTableRegexp.MatchString(tableName) {
+		return false
+	}
+	return true
+}
+
+// 导入单个表，返回导入的记录数，跳过时返回-1
+func (this *SnapshotImporter) importTable(tableName string) (int64, error) {
+	sourceTable, err := this.sourceDB.FindFullTable(tableName)
+	if err != nil {
+		return 0, err
+	}
+	targetTable, err := this.targetDB.FindFullTable(tableName)
+	if err != nil {
+		return 0, err
+	}
+
+	// 共有的字段
+	var fields = []*dbs.Field{}
+	var idIndex = -1
+	for _, field := range sourceTable.Fields {
+		var targetField = targetTable.FindFieldWithName(field.Name)
+		if targetField == nil {
+			continue
+		}
+		if field.Name == "id" {
+			idIndex = len(fields)
+		}
+		fields = append(fields, targetField)
+	}
+	if len(fields) == 0 {
+		return -1, nil
+	}
+
+	// 检查目标表
+	if this.options.Truncate {
+		_, err = this.targetDB.Exec("TRUNCATE TABLE `" + tableName + "`")
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		one, err := this.targetDB.FindOne("SELECT 1 FROM `" + tableName + "` LIMIT 1")
+		if err != nil {
+			return 0, err
+		}
+		if one != nil {
+			this.log("skip '" + tableName + "': target table is not empty")
+			return -1, nil
+		}
+	}
+
+	var columnNames = []string{}
+	var anonymizeKinds = []AnonymizeKind{}
+	for _, field := range fields {
+		columnNames = append(columnNames, "`"+field.Name+"`")
+		anonymizeKinds = append(anonymizeKinds, this.anonymizer.FindColumnKind(tableName, field.Name))
+	}
+	var columnsSQL = strings.Join(columnNames, ", ")
+
+	// 分批读取，有自增ID的表按ID翻页，避免大表深度分页
+	var countRows int64
+	var lastId int64
+	var offset int64
+	for {
+		var querySQL string
+		var args []any
+		if idIndex >= 0 {
+			querySQL = "SELECT " + columnsSQL + " FROM `" + tableName + "` WHERE id>? ORDER BY id ASC LIMIT " + types.String(this.options.BatchSize)
+			args = []any{lastId}
+		} else {
+			querySQL = "SELECT " + columnsSQL + " FROM `" + tableName + "` LIMIT " + types.String(this.options.BatchSize) + " OFFSET " + types.String(offset)
+		}
+
+		rows, err := this.readRows(querySQL, args, fields, anonymizeKinds)
+		if err != nil {
+			return 0, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+
+		err = this.writeRows(tableName, columnsSQL, rows)
+		if err != nil {
+			return 0, err
+		}
+
+		countRows += int64(len(rows))
+		offset += int64(len(rows))
+		if idIndex >= 0 {
+			lastIdData, _ := rows[len(rows)-1][idIndex].([]byte)
+			lastId = types.Int64(string(lastIdData))
+		}
+
+		if len(rows) < this.options.BatchSize {
+			break
+		}
+	}
+
+	this.log("imported '" + tableName + "': " + types.String(countRows) + " rows")
+	return countRows, nil
+}
+
+// 读取一批数据并脱敏
+func (this *SnapshotImporter) readRows(querySQL string, args []any, fields []*dbs.Field, anonymizeKinds []AnonymizeKind) ([][]any, error) {
+	rows, err := this.sourceDB.Raw().Query(querySQL, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var result = [][]any{}
+	for rows.Next() {
+		var values = make([]sql.RawBytes, len(fields))
+		var pointers = make([]any, len(fields))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		err = rows.Scan(pointers...)
+		if err != nil {
+			return nil, err
+		}
+
+		var row = make([]any, len(fields))
+		for i, value := range values {
+			if value == nil {
+				row[i] = nil
+				continue
+			}
+
+			// RawBytes 会在下次Scan时被覆盖，这里需要复制
+			var data = append([]byte{}, value...)
+			if len(anonymizeKinds[i]) > 0 {
+				data = this.anonymizer.Anonymize(anonymizeKinds[i], data)
+
+				// JSON字段不能为空字符串
+				if len(data) == 0 && fields[i].Type == "json" {
+					data = []byte("null")
+				}
+			}
+			row[i] = data
+		}
+		result = append(result, row)
+	}
+
+	return result, rows.Err()
+}
+
+// 批量写入数据
+func (this *SnapshotImporter) writeRows(tableName string, columnsSQL string, rows [][]any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var placeholder = "(" + strings.TrimSuffix(strings.Repeat("?, ", len(rows[0])), ", ") + ")"
+	var placeholders = make([]string, 0, len(rows))
+	var args = make([]any, 0, len(rows)*len(rows[0]))
+	for _, row := range rows {
+		placeholders = append(placeholders, placeholder)
+		args = append(args, row...)
+	}
+
+	_, err := this.targetDB.Exec("INSERT INTO `"+tableName+"` ("+columnsSQL+") VALUES "+strings.Join(placeholders, ", "), args...)
+	if err != nil {
+		return fmt.Errorf("write rows failed: %w", err)
+	}
+	return nil
+}
+
+func (this *SnapshotImporter) log(message string) {
+	if this.logWriter == nil {
+		return
+	}
+	_, _ = this.logWriter.Write([]byte(message + "\n"))
+}
@@ -0,0 +1,255 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package datasets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/iwind/TeaGo/types"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+// SeedOptions 生成数据选项
+type SeedOptions struct {
+	Seed           int64     // 随机数种子，同样的种子和选项总是生成同样的数据
+	Users          int       // 用户数
+	ServersPerUser int       // 每个用户的网站数
+	Nodes          int       // 集群中的节点数
+	StatDays       int       // 统计数据天数
+	EndDay         time.Time // 统计数据的最后一天，为空表示昨天
+}
+
+func DefaultSeedOptions() *SeedOptions {
+	return &SeedOptions{
+		Seed:           1,
+		Users:          10,
+		ServersPerUser: 10,
+		Nodes:          3,
+		StatDays:       7,
+	}
+}
+
+// SeedResult 生成结果
+type SeedResult struct {
+	ClusterId    int64
+	CountUsers   int
+	CountServers int
+	CountNodes   int
+	CountStats   int64
+}
+
+// Seeder 生成用于性能测试的数据
+// 所有数据都通过DAO创建，以保证和正常使用时的数据一致
+type Seeder struct {
+	options *SeedOptions
+	rand    *rand.Rand
+
+	logWriter io.Writer
+}
+
+func NewSeeder(options *SeedOptions) *Seeder {
+	if options == nil {
+		options = DefaultSeedOptions()
+	}
+	return &Seeder{
+		options: options,
+		rand:    rand.New(rand.NewSource(options.Seed)),
+	}
+}
+
+// SetLogWriter 设置日志输出
+func (this *Seeder) SetLogWriter(logWriter io.Writer) {
+	this.logWriter = logWriter
+}
+
+// Run 生成数据
+func (this *Seeder) Run() (*SeedResult, error) {
+	if models.SharedUserDAO == nil {
+		return nil, errors.New("database is not ready")
+	}
+
+	var result = &SeedResult{}
+
+	// 集群
+	clusterId, err := models.SharedNodeClusterDAO.CreateCluster(nil, 0, this.prefix()+"cluster", 0, "/usr/local/goedge", 0, "", 0, 0, 0, nil, serverconfigs.NewGlobalServerConfig(), false, false, false, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("create cluster failed: %w", err)
+	}
+	result.ClusterId = clusterId
+
+	// 节点
+	for i := 1; i <= this.options.Nodes; i++ {
+		_, err = models.SharedNodeDAO.CreateNode(nil, 0, this.prefix()+"node-"+types.String(i), clusterId, 0, 0)
+		if err != nil {
+			return nil, fmt.Errorf("create node failed: %w", err)
+		}
+		result.CountNodes++
+	}
+	this.log("created cluster '" + types.String(clusterId) + "' with " + types.String(result.CountNodes) + " nodes")
+
+	// 用户和网站
+	for userIndex := 1; userIndex <= this.options.Users; userIndex++ {
+		var username = fmt.Sprintf("%suser%05d", this.prefix(), userIndex)
+		userId, err := models.SharedUserDAO.CreateUser(nil, username, AnonymizedPassword, username, "", "", "", "", "seed", clusterId, nil, "", true)
+		if err != nil {
+			return nil, fmt.Errorf("create user failed: %w", err)
+		}
+		result.CountUsers++
+
+		for serverIndex := 1; serverIndex <= this.options.ServersPerUser; serverIndex++ {
+			var serverNames = this.serverNames(userIndex, serverIndex)
+			serverId, err := this.createHTTPServer(userId, clusterId, serverNames)
+			if err != nil {
+				return nil, fmt.Errorf("create server failed: %w", err)
+			}
+			result.CountServers++
+
+			countStats, err := this.createServerStats(userId, serverId)
+			if err != nil {
+				return nil, fmt.Errorf("create server stats failed: %w", err)
+			}
+			result.CountStats += countStats
+		}
+
+		if userIndex%10 == 0 || userIndex == this.options.Users {
+			this.log("created " + types.String(userIndex) + "/" + types.String(this.options.Users) + " users")
+		}
+	}
+
+	return result, nil
+}
+
+// 名称前缀，不同的随机数种子生成的数据互不冲突
+func (this *Seeder) prefix() string {
+	return "seed" + types.String(this.options.Seed) + "-"
+}
+
+// 网站域名，部分网站会有多个域名
+func (this *Seeder) serverNames(userIndex int, serverIndex int) []string {
+	var domain = fmt.Sprintf("u%05d-s%04d.%sexample.com", userIndex, serverIndex, this.prefix())
+	var result = []string{domain}
+	var countAliases = this.rand.Intn(4) // 0-3个别名
+	for i := 0; i < countAliases; i++ {
+		result = append(result, fmt.Sprintf("alias%d.%s", i+1, domain))
+	}
+	return result
+}
+
+// 创建一个监听80端口的HTTP反向代理网站
+func (this *Seeder) createHTTPServer(userId int64, clusterId int64, serverNames []string) (serverId int64, err error) {
+	webId, err := models.SharedHTTPWebDAO.CreateWeb(nil, 0, userId, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var serverNameConfigs = []*serverconfigs.ServerNameConfig{}
+	for _, serverName := range serverNames {
+		serverNameConfigs = append(serverNameConfigs, &serverconfigs.ServerNameConfig{
+			Name: serverName,
+			Type: serverconfigs.ServerNameTypeFull,
+		})
+	}
+	serverNamesJSON, err := json.Marshal(serverNameConfigs)
+	if err != nil {
+		return 0, err
+	}
+
+	var httpConfig = &serverconfigs.HTTPProtocolConfig{}
+	httpConfig.IsOn = true
+	httpConfig.Listen = []*serverconfigs.NetworkAddressConfig{
+		{
+			Protocol:  serverconfigs.ProtocolHTTP,
+			PortRange: "80",
+		},
+	}
+	httpJSON, err := json.Marshal(httpConfig)
+	if err != nil {
+		return 0, err
+	}
+
+	reverseProxyId, err := models.SharedReverseProxyDAO.CreateReverseProxy(nil, 0, userId, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	reverseProxyRefJSON, err := json.Marshal(&serverconfigs.ReverseProxyRef{
+		IsOn:           true,
+		ReverseProxyId: reverseProxyId,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return models.SharedServerDAO.CreateServer(nil, 0, userId, serverconfigs.ServerTypeHTTPProxy, serverNames[0], "", serverNamesJSON, false, nil, httpJSON, nil, nil, nil, nil, webId, reverseProxyRefJSON, clusterId, nil, nil, nil, 0)
+}
+
+// 生成网站每小时的流量和带宽统计
+func (this *Seeder) createServerStats(userId int64, serverId int64) (count int64, err error) {
+	if this.options.StatDays <= 0 {
+		return 0, nil
+	}
+
+	var endDay = this.options.EndDay
+	if endDay.IsZero() {
+		endDay = time.Now().AddDate(0, 0, -1)
+	}
+	var endDayStart = time.Date(endDay.Year(), endDay.Month(), endDay.Day(), 0, 0, 0, 0, time.Local)
+
+	// 每个网站的流量规模不同
+	var scale = math.Pow(10, 3+this.rand.Float64()*4) // 1KB - 10MB 每秒
+
+	for dayOffset := this.options.StatDays - 1; dayOffset >= 0; dayOffset-- {
+		var dayStart = endDayStart.AddDate(0, 0, -dayOffset)
+		var stats = []*pb.ServerDailyStat{}
+		for hour := 0; hour < 24; hour++ {
+			var createdAt = dayStart.Add(time.Duration(hour) * time.Hour)
+
+			// 白天流量高，夜间流量低
+			var factor = 0.6 + 0.4*math.Sin(float64(hour-6)/24*2*math.Pi) + this.rand.Float64()*0.2
+			var bandwidthBytes = int64(scale * factor)
+			var totalBytes = bandwidthBytes * 3600
+			var cachedBytes = totalBytes * int64(50+this.rand.Intn(40)) / 100
+			var countRequests = totalBytes/(20*1024) + 1
+			var countCachedRequests = countRequests * cachedBytes / totalBytes
+
+			stats = append(stats, &pb.ServerDailyStat{
+				ServerId:            serverId,
+				UserId:              userId,
+				Bytes:               totalBytes,
+				CachedBytes:         cachedBytes,
+				CountRequests:       countRequests,
+				CountCachedRequests: countCachedRequests,
+				CreatedAt:           createdAt.Unix(),
+			})
+
+			err = models.SharedServerBandwidthStatDAO.UpdateServerBandwidth(nil, userId, serverId, 0, 0, timeutil.Format("Ymd", createdAt), timeutil.Format("Hi", createdAt), bandwidthBytes, bandwidthBytes*300, cachedBytes/12, 0, countRequests/12, countCachedRequests/12, 0, countRequests/100)
+			if err != nil {
+				return 0, err
+			}
+			count++
+		}
+
+		err = models.SharedServerDailyStatDAO.SaveStats(nil, stats)
+		if err != nil {
+			return 0, err
+		}
+		count += int64(len(stats))
+	}
+
+	return
+}
+
+func (this *Seeder) log(message string) {
+	if this.logWriter == nil {
+		return
+	}
+	_, _ = this.logWriter.Write([]byte(message + "\n"))
+}
@@ -0,0 +1,151 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package failover
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/slowquery"
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQLDriverName 支持多数据源自动切换的MySQL驱动名称
+// 只在打开数据库时使用，参考 Setup()
+const MySQLDriverName = "mysql-failover"
+
+// SharedMySQLDriver 支持多数据源的MySQL驱动，同时可以记录慢查询
+var SharedMySQLDriver = NewDriver(slowquery.NewDriver(&mysql.MySQLDriver{}))
+
+func init() {
+	sql.Register(MySQLDriverName, SharedMySQLDriver)
+}
+
+// Driver 支持多数据源自动切换的驱动
+// 打开数据库时使用主数据源的DSN，驱动根据DSN找到对应的数据源分组；没有分组的DSN直接使用原有驱动打开
+type Driver struct {
+	rawDriver driver.Driver
+
+	groupMap map[string]*Group // primary dsn => group
+	locker   sync.RWMutex
+}
+
+func NewDriver(rawDriver driver.Driver) *Driver {
+	return &Driver{
+		rawDriver: rawDriver,
+		groupMap:  map[string]*Group{},
+	}
+}
+
+// AddGroup 添加数据源分组，第一个DSN为主数据源
+func (this *Driver) AddGroup(dsnList []string) *Group {
+	var group = NewGroup(this.rawDriver, dsnList)
+	if len(group.sources) == 0 {
+		return group
+	}
+
+	this.locker.Lock()
+	this.groupMap[group.sources[0].dsn] = group
+	this.locker.Unlock()
+	return group
+}
+
+// FindGroup 根据主数据源DSN查找分组
+func (this *Driver) FindGroup(dsn string) *Group {
+	this.locker.RLock()
+	defer this.locker.RUnlock()
+	return this.groupMap[dsn]
+}
+
+func (this *Driver) Open(dsn string) (driver.Conn, error) {
+	var group = this.FindGroup(dsn)
+	if group == nil {
+		return this.rawDriver.Open(dsn)
+	}
+	return group.Open()
+}
+
+// Conn 数据库连接
+// 数据源切换之后，旧数据源上的连接不再放回连接池
+type Conn struct {
+	driver.Conn
+
+	group *Group
+	index int
+}
+
+func (this *Conn) isActive() bool {
+	return this.group.ActiveIndex() == this.index
+}
+
+func (this *Conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	preparer, ok := this.Conn.(driver.ConnPrepareContext)
+	if ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return this.Conn.Prepare(query)
+}
+
+func (this *Conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	beginner, ok := this.Conn.(driver.ConnBeginTx)
+	if ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return this.Conn.Begin()
+}
+
+func (this *Conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := this.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	return execer.ExecContext(ctx, query, args)
+}
+
+func (this *Conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := this.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	return queryer.QueryContext(ctx, query, args)
+}
+
+func (this *Conn) Ping(ctx context.Context) error {
+	pinger, ok := this.Conn.(driver.Pinger)
+	if ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (this *Conn) ResetSession(ctx context.Context) error {
+	if !this.isActive() {
+		return driver.ErrBadConn
+	}
+	resetter, ok := this.Conn.(driver.SessionResetter)
+	if ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (this *Conn) IsValid() bool {
+	if !this.isActive() {
+		return false
+	}
+	validator, ok := this.Conn.(driver.Validator)
+	if ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+func (this *Conn) CheckNamedValue(value *driver.NamedValue) error {
+	checker, ok := this.Conn.(driver.NamedValueChecker)
+	if ok {
+		return checker.CheckNamedValue(value)
+	}
+	return driver.ErrSkip
+}
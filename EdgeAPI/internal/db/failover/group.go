@@ -0,0 +1,175 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package failover
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/iwind/TeaGo/logs"
+	"github.com/iwind/TeaGo/types"
+)
+
+// DefaultCheckInterval 默认的健康检查间隔
+const DefaultCheckInterval = 5 * time.Second
+
+const checkTimeout = 3 * time.Second // 单次检查的超时时间
+
+// 单个数据源
+type source struct {
+	dsn       string
+	isHealthy atomic.Bool
+}
+
+// Group 同一个数据库的多个数据源
+// 第一个为主数据源，其余为备用数据源；当前数据源不可用时切换到下一个可用的数据源，并且不会自动切回，以免切回到已经降级为从库的原主库
+type Group struct {
+	rawDriver driver.Driver
+	sources   []*source
+
+	activeIndex atomic.Int32
+
+	locker sync.Mutex
+}
+
+// NewGroup 获取新的数据源分组
+func NewGroup(rawDriver driver.Driver, dsnList []string) *Group {
+	var group = &Group{
+		rawDriver: rawDriver,
+	}
+	for _, dsn := range dsnList {
+		if len(dsn) == 0 {
+			continue
+		}
+		var s = &source{dsn: dsn}
+		s.isHealthy.Store(true)
+		group.sources = append(group.sources, s)
+	}
+	return group
+}
+
+// Open 打开新的连接
+// 优先使用当前数据源，失败时依次尝试其他数据源
+func (this *Group) Open() (driver.Conn, error) {
+	var count = len(this.sources)
+	if count == 0 {
+		return nil, errors.New("no data source")
+	}
+
+	var activeIndex = int(this.activeIndex.Load())
+	var lastErr error
+
+	// 先尝试健康的数据源，再尝试其他的数据源，因为检查结果可能已经过时
+	for _, onlyHealthy := range []bool{true, false} {
+		for i := 0; i < count; i++ {
+			var index = (activeIndex + i) % count
+			var s = this.sources[index]
+			if s.isHealthy.Load() != onlyHealthy {
+				continue
+			}
+
+			conn, err := this.rawDriver.Open(s.dsn)
+			if err != nil {
+				lastErr = err
+				this.markUnhealthy(index, err)
+				continue
+			}
+			s.isHealthy.Store(true)
+			this.activate(index)
+			return &Conn{Conn: conn, group: this, index: index}, nil
+		}
+	}
+	return nil, lastErr
+}
+
+// Check 检查所有数据源是否可用
+// 当前数据源不可用时切换到第一个可用的数据源
+func (this *Group) Check() {
+	for index := range this.sources {
+		err := this.ping(this.sources[index].dsn)
+		if err != nil {
+			this.markUnhealthy(index, err)
+		} else if !this.sources[index].isHealthy.Swap(true) {
+			logs.Println("[DB_FAILOVER]data source #" + types.String(index) + " is available again")
+		}
+	}
+
+	var activeIndex = this.ActiveIndex()
+	if this.sources[activeIndex].isHealthy.Load() {
+		return
+	}
+	var count = len(this.sources)
+	for i := 1; i < count; i++ {
+		var index = (activeIndex + i) % count
+		if this.sources[index].isHealthy.Load() {
+			this.activate(index)
+			return
+		}
+	}
+}
+
+// Start 定时检查数据源
+func (this *Group) Start(interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultCheckInterval
+	}
+	var ticker = time.NewTicker(interval)
+	for range ticker.C {
+		this.Check()
+	}
+}
+
+// ActiveIndex 当前数据源的序号
+func (this *Group) ActiveIndex() int {
+	return int(this.activeIndex.Load())
+}
+
+// IsHealthy 检查某个数据源是否可用
+func (this *Group) IsHealthy(index int) bool {
+	if index < 0 || index >= len(this.sources) {
+		return false
+	}
+	return this.sources[index].isHealthy.Load()
+}
+
+// 切换当前数据源
+func (this *Group) activate(index int) {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	var oldIndex = int(this.activeIndex.Load())
+	if oldIndex == index {
+		return
+	}
+	this.activeIndex.Store(int32(index))
+	logs.Println("[DB_FAILOVER]switch data source from #" + types.String(oldIndex) + " to #" + types.String(index))
+}
+
+func (this *Group) markUnhealthy(index int, err error) {
+	if this.sources[index].isHealthy.Swap(false) {
+		logs.Println("[DB_FAILOVER]data source #" + types.String(index) + " is unavailable: " + err.Error())
+	}
+}
+
+// 使用一个新的连接检查数据源
+func (this *Group) ping(dsn string) error {
+	conn, err := this.rawDriver.Open(dsn)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	pinger, ok := conn.(driver.Pinger)
+	if !ok {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+	return pinger.Ping(ctx)
+}
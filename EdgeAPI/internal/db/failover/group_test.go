@@ -0,0 +1,114 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package failover_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/failover"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+	"github.com/iwind/TeaGo/assert"
+)
+
+// 可以模拟数据源不可用的驱动
+type testDriver struct {
+	sqlite.Driver
+
+	downMap map[string]bool
+	locker  sync.Mutex
+}
+
+func (this *testDriver) SetDown(dsn string, isDown bool) {
+	this.locker.Lock()
+	this.downMap[dsn] = isDown
+	this.locker.Unlock()
+}
+
+func (this *testDriver) Open(dsn string) (driver.Conn, error) {
+	this.locker.Lock()
+	var isDown = this.downMap[dsn]
+	this.locker.Unlock()
+	if isDown {
+		return nil, errors.New("connection refused")
+	}
+	return this.Driver.Open(dsn)
+}
+
+func TestGroup_Failover(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var primaryDSN = sqlite.ComposeDSN(filepath.Join(t.TempDir(), "primary.db"))
+	var backupDSN = sqlite.ComposeDSN(filepath.Join(t.TempDir(), "backup.db"))
+
+	var rawDriver = &testDriver{downMap: map[string]bool{}}
+	var failoverDriver = failover.NewDriver(rawDriver)
+	var group = failoverDriver.AddGroup([]string{primaryDSN, backupDSN})
+	a.IsTrue(failoverDriver.FindGroup(primaryDSN) == group)
+	a.IsTrue(failoverDriver.FindGroup(backupDSN) == nil)
+
+	sql.Register("sqlite-failover-test", failoverDriver)
+	db, err := sql.Open("sqlite-failover-test", primaryDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	var findName = func() string {
+		var name string
+		err := db.QueryRow("SELECT name FROM edgeTests LIMIT 1").Scan(&name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return name
+	}
+
+	for _, item := range []struct {
+		dsn  string
+		name string
+	}{{primaryDSN, "primary"}, {backupDSN, "backup"}} {
+		rawDB, err := sql.Open(sqlite.DriverName, item.dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = rawDB.Exec("CREATE TABLE edgeTests (name TEXT)")
+		if err == nil {
+			_, err = rawDB.Exec("INSERT INTO edgeTests (name) VALUES (?)", item.name)
+		}
+		_ = rawDB.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	a.IsTrue(findName() == "primary")
+	a.IsTrue(group.ActiveIndex() == 0)
+
+	// 主数据源不可用
+	rawDriver.SetDown(primaryDSN, true)
+	group.Check()
+	a.IsFalse(group.IsHealthy(0))
+	a.IsTrue(group.IsHealthy(1))
+	a.IsTrue(group.ActiveIndex() == 1)
+	a.IsTrue(findName() == "backup")
+
+	// 主数据源恢复之后不会自动切回
+	rawDriver.SetDown(primaryDSN, false)
+	group.Check()
+	a.IsTrue(group.IsHealthy(0))
+	a.IsTrue(group.ActiveIndex() == 1)
+	a.IsTrue(findName() == "backup")
+
+	// 备用数据源不可用时打开新连接会切换到主数据源
+	rawDriver.SetDown(backupDSN, true)
+	db.SetMaxIdleConns(0)
+	a.IsTrue(findName() == "primary")
+	a.IsTrue(group.ActiveIndex() == 0)
+	a.IsFalse(group.IsHealthy(1))
+}
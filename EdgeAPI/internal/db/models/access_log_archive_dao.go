@@ -0,0 +1,117 @@
+package models
+
+import (
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+type AccessLogArchiveDAO dbs.DAO
+
+func NewAccessLogArchiveDAO() *AccessLogArchiveDAO {
+	return dbs.NewDAO(&AccessLogArchiveDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeAccessLogArchives",
+			Model:  new(AccessLogArchive),
+			PkName: "id",
+		},
+	}).(*AccessLogArchiveDAO)
+}
+
+var SharedAccessLogArchiveDAO *AccessLogArchiveDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedAccessLogArchiveDAO = NewAccessLogArchiveDAO()
+	})
+}
+
+// CreateArchive 记录归档
+func (this *AccessLogArchiveDAO) CreateArchive(tx *dbs.Tx, dbNodeId int64, tableName string, logType string, day string, objectKey string, size int64, countRows int64) error {
+	var op = NewAccessLogArchiveOperator()
+	op.DbNodeId = dbNodeId
+	op.TableName = tableName
+	op.LogType = logType
+	op.Day = day
+	op.ObjectKey = objectKey
+	op.Size = size
+	op.CountRows = countRows
+	op.CreatedAt = time.Now().Unix()
+	return this.Save(tx, op)
+}
+
+// ExistArchive 检查某个表格是否已经归档
+func (this *AccessLogArchiveDAO) ExistArchive(tx *dbs.Tx, dbNodeId int64, tableName string) (bool, error) {
+	return this.Query(tx).
+		Attr("dbNodeId", dbNodeId).
+		Attr("tableName", tableName).
+		Exist()
+}
+
+// FindAllArchives 查找某个日期范围内的归档
+// logType 为空表示所有类型；dayFrom 和 dayTo 为空表示不限制
+func (this *AccessLogArchiveDAO) FindAllArchives(tx *dbs.Tx, logType string, dayFrom string, dayTo string) (result []*AccessLogArchive, err error) {
+	var query = this.Query(tx)
+	if len(logType) > 0 {
+		query.Attr("logType", logType)
+	}
+	if len(dayFrom) > 0 {
+		query.Gte("day", dayFrom)
+	}
+	if len(dayTo) > 0 {
+		query.Lte("day", dayTo)
+	}
+	_, err = query.
+		Asc("day").
+		AscPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// FindArchivedDayRanges 查找已经归档的连续日期范围
+func (this *AccessLogArchiveDAO) FindArchivedDayRanges(tx *dbs.Tx, logType string) ([]*AccessLogArchiveDayRange, error) {
+	archives, err := this.FindAllArchives(tx, logType, "", "")
+	if err != nil {
+		return nil, err
+	}
+	var days = []string{}
+	for _, archive := range archives {
+		days = append(days, archive.Day)
+	}
+	return ComposeAccessLogArchiveDayRanges(days), nil
+}
+
+// AccessLogArchiveDayRange 连续的归档日期范围
+type AccessLogArchiveDayRange struct {
+	DayFrom string
+	DayTo   string
+}
+
+// ComposeAccessLogArchiveDayRanges 将日期合并为连续的日期范围
+// days 需要按从早到晚排序，可以有重复的日期
+func ComposeAccessLogArchiveDayRanges(days []string) []*AccessLogArchiveDayRange {
+	var result = []*AccessLogArchiveDayRange{}
+	var lastRange *AccessLogArchiveDayRange
+	for _, day := range days {
+		if lastRange != nil {
+			if day == lastRange.DayTo {
+				continue
+			}
+			lastTime, err := time.ParseInLocation("20060102", lastRange.DayTo, time.Local)
+			if err == nil && lastTime.AddDate(0, 0, 1).Format("20060102") == day {
+				lastRange.DayTo = day
+				continue
+			}
+		}
+		lastRange = &AccessLogArchiveDayRange{
+			DayFrom: day,
+			DayTo:   day,
+		}
+		result = append(result, lastRange)
+	}
+	return result
+}
@@ -0,0 +1,19 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestComposeAccessLogArchiveDayRanges(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var ranges = models.ComposeAccessLogArchiveDayRanges([]string{"20240130", "20240131", "20240131", "20240201", "20240203"})
+	a.IsTrue(len(ranges) == 2)
+	a.IsTrue(ranges[0].DayFrom == "20240130" && ranges[0].DayTo == "20240201")
+	a.IsTrue(ranges[1].DayFrom == "20240203" && ranges[1].DayTo == "20240203")
+
+	a.IsTrue(len(models.ComposeAccessLogArchiveDayRanges(nil)) == 0)
+}
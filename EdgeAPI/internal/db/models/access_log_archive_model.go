@@ -0,0 +1,30 @@
+package models
+
+// AccessLogArchive 访问日志归档
+type AccessLogArchive struct {
+	Id        uint64 `field:"id"`        // ID
+	DbNodeId  uint32 `field:"dbNodeId"`  // 数据库节点ID，0表示默认数据库
+	TableName string `field:"tableName"` // 表名
+	LogType   string `field:"logType"`   // 日志类型：http、ns
+	Day       string `field:"day"`       // 日期
+	ObjectKey string `field:"objectKey"` // 对象存储中的Key
+	Size      uint64 `field:"size"`      // 压缩后的尺寸
+	CountRows uint64 `field:"countRows"` // 日志数量
+	CreatedAt uint64 `field:"createdAt"` // 创建时间
+}
+
+type AccessLogArchiveOperator struct {
+	Id        interface{} // ID
+	DbNodeId  interface{} // 数据库节点ID，0表示默认数据库
+	TableName interface{} // 表名
+	LogType   interface{} // 日志类型：http、ns
+	Day       interface{} // 日期
+	ObjectKey interface{} // 对象存储中的Key
+	Size      interface{} // 压缩后的尺寸
+	CountRows interface{} // 日志数量
+	CreatedAt interface{} // 创建时间
+}
+
+func NewAccessLogArchiveOperator() *AccessLogArchiveOperator {
+	return &AccessLogArchiveOperator{}
+}
@@ -1,11 +1,19 @@
 package acme
 
 import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
 )
 
+// ACMEAuthenticationLifeSeconds 认证信息分发到边缘节点后的有效期
+const ACMEAuthenticationLifeSeconds = 3600
+
 type ACMEAuthenticationDAO dbs.DAO
 
 func NewACMEAuthenticationDAO() *ACMEAuthenticationDAO {
@@ -34,8 +42,18 @@ func (this *ACMEAuthenticationDAO) CreateAuth(tx *dbs.Tx, taskId int64, domain s
 	op.Domain = domain
 	op.Token = token
 	op.Key = key
+	op.CreatedAt = time.Now().Unix()
 	err := this.Save(tx, op)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// 通知使用此域名的集群，以便边缘节点提前拿到认证信息
+	err = this.NotifyUpdate(tx, domain)
+	if err != nil {
+		remotelogs.Error("ACME", "notify authentication to clusters failed: "+err.Error())
+	}
+	return nil
 }
 
 // 根据令牌查找认证信息
@@ -52,3 +70,33 @@ func (this *ACMEAuthenticationDAO) FindAuthWithToken(tx *dbs.Tx, token string) (
 	}
 	return one.(*ACMEAuthentication), nil
 }
+
+// FindRecentAuths 查找最近创建的认证信息
+func (this *ACMEAuthenticationDAO) FindRecentAuths(tx *dbs.Tx, sinceTime int64, size int64) (result []*ACMEAuthentication, err error) {
+	var minTime = time.Now().Unix() - ACMEAuthenticationLifeSeconds
+	if sinceTime < minTime {
+		sinceTime = minTime
+	}
+	_, err = this.Query(tx).
+		Gte("createdAt", sinceTime).
+		AscPk().
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// NotifyUpdate 通知使用某个域名的集群更新认证信息
+func (this *ACMEAuthenticationDAO) NotifyUpdate(tx *dbs.Tx, domain string) error {
+	clusterIds, err := models.SharedServerDAO.FindAllEnabledClusterIdsWithDomain(tx, domain)
+	if err != nil {
+		return err
+	}
+	for _, clusterId := range clusterIds {
+		err = models.SharedNodeTaskDAO.CreateClusterTask(tx, nodeconfigs.NodeRoleNode, clusterId, 0, 0, models.NodeTaskTypeACMEAuthenticationsChanged)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
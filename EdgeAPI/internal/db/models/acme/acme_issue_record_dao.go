@@ -0,0 +1,137 @@
+package acme
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/rands"
+	"github.com/iwind/TeaGo/types"
+)
+
+type ACMEIssueRecordDAO dbs.DAO
+
+func NewACMEIssueRecordDAO() *ACMEIssueRecordDAO {
+	return dbs.NewDAO(&ACMEIssueRecordDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeACMEIssueRecords",
+			Model:  new(ACMEIssueRecord),
+			PkName: "id",
+		},
+	}).(*ACMEIssueRecordDAO)
+}
+
+var SharedACMEIssueRecordDAO *ACMEIssueRecordDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedACMEIssueRecordDAO = NewACMEIssueRecordDAO()
+	})
+
+	dbs.OnReadyDone(func() {
+		// 清理数据任务
+		var ticker = time.NewTicker(time.Duration(rands.Int(24, 48)) * time.Hour)
+		goman.New(func() {
+			for range ticker.C {
+				err := SharedACMEIssueRecordDAO.Clean(nil, 8) // 只保留N天，需要大于最长的频率限制窗口期
+				if err != nil {
+					remotelogs.Error("ACMEIssueRecordDAO", "clean expired data failed: "+err.Error())
+				}
+			}
+		})
+	})
+}
+
+// CreateRecord 记录一次向CA发起的签发
+func (this *ACMEIssueRecordDAO) CreateRecord(tx *dbs.Tx, providerCode string, acmeUserId int64, taskId int64, domains []string, isOk bool) error {
+	domains = acmeutils.NormalizeDomainSet(domains)
+	domainsJSON, err := json.Marshal(domains)
+	if err != nil {
+		return err
+	}
+	registeredDomainsJSON, err := json.Marshal(acmeutils.RegisteredDomains(domains))
+	if err != nil {
+		return err
+	}
+
+	var op = NewACMEIssueRecordOperator()
+	op.ProviderCode = providerCode
+	op.AcmeUserId = acmeUserId
+	op.TaskId = taskId
+	op.Domains = domainsJSON
+	op.RegisteredDomains = registeredDomainsJSON
+	op.IsOk = isOk
+	op.CreatedAt = time.Now().Unix()
+	return this.Save(tx, op)
+}
+
+// FindRelatedRecords 查找和账号或者域名相关的签发记录
+func (this *ACMEIssueRecordDAO) FindRelatedRecords(tx *dbs.Tx, providerCode string, acmeUserId int64, domains []string, sinceTime int64) (result []*ACMEIssueRecord, err error) {
+	var query = this.Query(tx).
+		Attr("providerCode", providerCode).
+		Gte("createdAt", sinceTime)
+
+	var conds = []string{"acmeUserId=:acmeUserId"}
+	query.Param("acmeUserId", acmeUserId)
+	for index, registeredDomain := range acmeutils.RegisteredDomains(domains) {
+		domainJSON, err := json.Marshal(registeredDomain)
+		if err != nil {
+			return nil, err
+		}
+		var paramName = "registeredDomain" + types.String(index)
+		conds = append(conds, "JSON_CONTAINS(registeredDomains, :"+paramName+")")
+		query.Param(paramName, string(domainJSON))
+	}
+
+	_, err = query.
+		Where("(" + strings.Join(conds, " OR ") + ")").
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// CheckRateLimits 检查签发是否会超出CA的频率限制
+// 如果会超出限制，返回可以再次签发的时间和原因
+func (this *ACMEIssueRecordDAO) CheckRateLimits(tx *dbs.Tx, providerCode string, acmeUserId int64, domains []string) (throttledUntil int64, reason string, err error) {
+	var limits = acmeutils.FindProviderRateLimits(providerCode)
+	if limits == nil {
+		return 0, "", nil
+	}
+
+	var now = time.Now()
+	records, err := this.FindRelatedRecords(tx, providerCode, acmeUserId, domains, now.Add(-limits.MaxWindow()).Unix())
+	if err != nil {
+		return 0, "", err
+	}
+
+	var issueRecords = []*acmeutils.IssueRecord{}
+	for _, record := range records {
+		issueRecords = append(issueRecords, &acmeutils.IssueRecord{
+			AccountId: int64(record.AcmeUserId),
+			Domains:   record.DecodeDomains(),
+			IsOk:      record.IsOk,
+			CreatedAt: int64(record.CreatedAt),
+		})
+	}
+
+	until, reason := acmeutils.CheckRateLimits(limits, issueRecords, acmeUserId, domains, now)
+	if until.IsZero() {
+		return 0, "", nil
+	}
+	return until.Unix(), reason, nil
+}
+
+// Clean 清理N天以前的记录
+func (this *ACMEIssueRecordDAO) Clean(tx *dbs.Tx, days int) error {
+	_, err := this.Query(tx).
+		Lt("createdAt", time.Now().Unix()-int64(days)*86400).
+		Delete()
+	return err
+}
@@ -0,0 +1,30 @@
+package acme
+
+import "github.com/iwind/TeaGo/dbs"
+
+// ACMEIssueRecord ACME签发记录
+type ACMEIssueRecord struct {
+	Id                uint64   `field:"id"`                // ID
+	ProviderCode      string   `field:"providerCode"`      // ACME服务商代号
+	AcmeUserId        uint32   `field:"acmeUserId"`        // ACME用户ID
+	TaskId            uint64   `field:"taskId"`            // 任务ID
+	Domains           dbs.JSON `field:"domains"`           // 签发的域名
+	RegisteredDomains dbs.JSON `field:"registeredDomains"` // 注册域名
+	IsOk              bool     `field:"isOk"`              // 是否成功
+	CreatedAt         uint64   `field:"createdAt"`         // 签发时间
+}
+
+type ACMEIssueRecordOperator struct {
+	Id                interface{} // ID
+	ProviderCode      interface{} // ACME服务商代号
+	AcmeUserId        interface{} // ACME用户ID
+	TaskId            interface{} // 任务ID
+	Domains           interface{} // 签发的域名
+	RegisteredDomains interface{} // 注册域名
+	IsOk              interface{} // 是否成功
+	CreatedAt         interface{} // 签发时间
+}
+
+func NewACMEIssueRecordOperator() *ACMEIssueRecordOperator {
+	return &ACMEIssueRecordOperator{}
+}
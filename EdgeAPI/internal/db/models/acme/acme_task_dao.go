@@ -6,21 +6,24 @@ import (
 	"encoding/json"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
 	"net/http"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/TeaOSLab/EdgeAPI/internal/certchain"
 	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models/dns"
 	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
 	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/webhooks"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
 	"github.com/go-acme/lego/v4/registration"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
@@ -28,6 +31,7 @@ import (
 	"github.com/iwind/TeaGo/logs"
 	"github.com/iwind/TeaGo/maps"
 	"github.com/iwind/TeaGo/types"
+	timeutil "github.com/iwind/TeaGo/utils/time"
 )
 
 const (
@@ -38,9 +42,9 @@ const (
 	ACMETaskStatusDone        = 1
 	ACMETaskStatusRunning     = 2
 	ACMETaskStatusIssueFailed = 3
+	ACMETaskStatusThrottled   = 4 // 因CA频率限制推迟执行
 )
 
-var runningTaskMap sync.Map
 var serverBindMutex = &sync.Mutex{}
 
 type ACMETaskDAO dbs.DAO
@@ -110,6 +114,20 @@ func (this *ACMETaskDAO) CountACMETasksWithDNSProviderId(tx *dbs.Tx, dnsProvider
 		Count()
 }
 
+// FindAllEnabledTasksWithDNSProviderId 查找使用某个DNS服务商的任务，包括DNS别名域名使用的服务商
+func (this *ACMETaskDAO) FindAllEnabledTasksWithDNSProviderId(tx *dbs.Tx, dnsProviderId int64, size int64) (result []*ACMETask, err error) {
+	_, err = this.Query(tx).
+		State(ACMETaskStateEnabled).
+		Where("(dnsProviderId=:dnsProviderId OR dnsAliasProviderId=:dnsProviderId)").
+		Param("dnsProviderId", dnsProviderId).
+		Result("id", "domains").
+		AscPk().
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
 // DisableAllTasksWithCertId 停止某个证书相关任务
 func (this *ACMETaskDAO) DisableAllTasksWithCertId(tx *dbs.Tx, certId int64) error {
 	_, err := this.Query(tx).
@@ -119,9 +137,31 @@ func (this *ACMETaskDAO) DisableAllTasksWithCertId(tx *dbs.Tx, certId int64) err
 	return err
 }
 
+// ReplaceTaskCert 将任务关联的证书替换为另外一个证书
+func (this *ACMETaskDAO) ReplaceTaskCert(tx *dbs.Tx, oldCertId int64, newCertId int64) error {
+	if oldCertId <= 0 || newCertId <= 0 || oldCertId == newCertId {
+		return nil
+	}
+	return this.Query(tx).
+		Attr("certId", oldCertId).
+		Set("certId", newCertId).
+		UpdateQuickly()
+}
+
+// DisableAutoRenewWithCertId 取消某个证书相关任务的自动续期
+func (this *ACMETaskDAO) DisableAutoRenewWithCertId(tx *dbs.Tx, certId int64) error {
+	if certId <= 0 {
+		return nil
+	}
+	return this.Query(tx).
+		Attr("certId", certId).
+		Set("autoRenew", false).
+		UpdateQuickly()
+}
+
 // CountAllEnabledACMETasks 计算所有任务数量
 func (this *ACMETaskDAO) CountAllEnabledACMETasks(tx *dbs.Tx, userId int64, isAvailable bool, isExpired bool, expiringDays int64, keyword string, userOnly bool) (int64, error) {
-	var query = this.Query(tx)
+	var query = dbutils.NewReadQuery(tx, this)
 	if userId > 0 {
 		query.Attr("userId", userId)
 	} else {
@@ -161,7 +201,7 @@ func (this *ACMETaskDAO) CountAllEnabledACMETasks(tx *dbs.Tx, userId int64, isAv
 
 // ListEnabledACMETasks 列出单页任务
 func (this *ACMETaskDAO) ListEnabledACMETasks(tx *dbs.Tx, userId int64, isAvailable bool, isExpired bool, expiringDays int64, keyword string, userOnly bool, offset int64, size int64) (result []*ACMETask, err error) {
-	var query = this.Query(tx)
+	var query = dbutils.NewReadQuery(tx, this)
 	if userId > 0 {
 		query.Attr("userId", userId)
 	} else {
@@ -201,6 +241,12 @@ func (this *ACMETaskDAO) ListEnabledACMETasks(tx *dbs.Tx, userId int64, isAvaila
 
 // CreateACMETask 创建任务
 func (this *ACMETaskDAO) CreateACMETask(tx *dbs.Tx, adminId int64, userId int64, authType acmeutils.AuthType, acmeUserId int64, dnsProviderId int64, dnsDomain string, domains []string, autoRenew bool, authURL string, async bool) (int64, error) {
+	// 用户配额
+	err := models.CheckUserQuota(tx, userId, userconfigs.UserQuotaItemACMETasks, 1)
+	if err != nil {
+		return 0, err
+	}
+
 	var op = NewACMETaskOperator()
 	op.AdminId = adminId
 	op.UserId = userId
@@ -224,7 +270,8 @@ func (this *ACMETaskDAO) CreateACMETask(tx *dbs.Tx, adminId int64, userId int64,
 	op.IsOn = true
 	op.State = ACMETaskStateEnabled
 	op.Async = async
-	err := this.Save(tx, op)
+	op.Priority = acmeutils.IssuePriorityNew
+	err = this.Save(tx, op)
 	if err != nil {
 		return 0, err
 	}
@@ -259,6 +306,110 @@ func (this *ACMETaskDAO) UpdateACMETask(tx *dbs.Tx, acmeTaskId int64, acmeUserId
 	return err
 }
 
+// UpdateACMETaskDNSAlias 设置任务的DNS别名（CNAME委托）
+func (this *ACMETaskDAO) UpdateACMETaskDNSAlias(tx *dbs.Tx, acmeTaskId int64, dnsAliasProviderId int64, dnsAliasDomain string) error {
+	if acmeTaskId <= 0 {
+		return errors.New("invalid acmeTaskId")
+	}
+
+	if dnsAliasProviderId <= 0 || len(dnsAliasDomain) == 0 {
+		dnsAliasProviderId = 0
+		dnsAliasDomain = ""
+	}
+
+	var op = NewACMETaskOperator()
+	op.Id = acmeTaskId
+	op.DnsAliasProviderId = dnsAliasProviderId
+	op.DnsAliasDomain = strings.Trim(strings.ToLower(dnsAliasDomain), ".")
+	return this.Save(tx, op)
+}
+
+// UpdateACMETaskKeyType 设置任务的证书私钥类型
+func (this *ACMETaskDAO) UpdateACMETaskKeyType(tx *dbs.Tx, acmeTaskId int64, keyType acmeutils.KeyType) error {
+	if acmeTaskId <= 0 {
+		return errors.New("invalid acmeTaskId")
+	}
+
+	if len(keyType) > 0 && !acmeutils.IsValidKeyType(keyType) {
+		return errors.New("invalid key type '" + keyType + "'")
+	}
+
+	var op = NewACMETaskOperator()
+	op.Id = acmeTaskId
+	op.KeyType = keyType
+	return this.Save(tx, op)
+}
+
+// UpdateACMETaskPreferredChain 设置任务的首选证书链
+func (this *ACMETaskDAO) UpdateACMETaskPreferredChain(tx *dbs.Tx, acmeTaskId int64, preferredChain string) error {
+	if acmeTaskId <= 0 {
+		return errors.New("invalid acmeTaskId")
+	}
+
+	preferredChain, err := acmeutils.NormalizePreferredChain(preferredChain)
+	if err != nil {
+		return err
+	}
+
+	var op = NewACMETaskOperator()
+	op.Id = acmeTaskId
+	op.PreferredChain = preferredChain
+	return this.Save(tx, op)
+}
+
+// UpdateACMETaskDirectoryURL 设置任务自定义的ACME目录URL，为空表示使用服务商的正式目录
+func (this *ACMETaskDAO) UpdateACMETaskDirectoryURL(tx *dbs.Tx, acmeTaskId int64, directoryURL string) error {
+	if acmeTaskId <= 0 {
+		return errors.New("invalid acmeTaskId")
+	}
+
+	var op = NewACMETaskOperator()
+	op.Id = acmeTaskId
+	op.DirectoryURL = directoryURL
+	return this.Save(tx, op)
+}
+
+// UpdateACMETaskCSR 设置任务的证书签名请求（CSR），为空表示由系统生成私钥
+func (this *ACMETaskDAO) UpdateACMETaskCSR(tx *dbs.Tx, acmeTaskId int64, csrData []byte) error {
+	if acmeTaskId <= 0 {
+		return errors.New("invalid acmeTaskId")
+	}
+
+	var csrPEM = ""
+	if len(csrData) > 0 {
+		csr, err := acmeutils.ParseCSR(csrData)
+		if err != nil {
+			return err
+		}
+		csrPEM = string(acmeutils.EncodeCSR(csr))
+	}
+
+	var op = NewACMETaskOperator()
+	op.Id = acmeTaskId
+	op.Csr = csrPEM
+	return this.Save(tx, op)
+}
+
+// FindACMETaskCSR 查找任务的证书签名请求（CSR）
+func (this *ACMETaskDAO) FindACMETaskCSR(tx *dbs.Tx, acmeTaskId int64) ([]byte, error) {
+	csr, err := this.Query(tx).
+		Pk(acmeTaskId).
+		Result("csr").
+		FindStringCol("")
+	if err != nil || len(csr) == 0 {
+		return nil, err
+	}
+	return []byte(csr), nil
+}
+
+// FindACMETaskDirectoryURL 查找任务自定义的ACME目录URL
+func (this *ACMETaskDAO) FindACMETaskDirectoryURL(tx *dbs.Tx, acmeTaskId int64) (string, error) {
+	return this.Query(tx).
+		Pk(acmeTaskId).
+		Result("directoryURL").
+		FindStringCol("")
+}
+
 // CheckUserACMETask 检查用户权限
 func (this *ACMETaskDAO) CheckUserACMETask(tx *dbs.Tx, userId int64, acmeTaskId int64) (bool, error) {
 	var query = this.Query(tx)
@@ -294,8 +445,21 @@ func (this *ACMETaskDAO) UpdateACMETaskCert(tx *dbs.Tx, taskId int64, certId int
 }
 
 // RunTask 执行任务并记录日志
-func (this *ACMETaskDAO) RunTask(tx *dbs.Tx, taskId int64) (isOk bool, errMsg string, resultCertId int64) {
-	isOk, errMsg, resultCertId = this.runTaskWithoutLog(tx, taskId, false)
+// 执行过程中需要和CA交互，不能在事务中调用
+func (this *ACMETaskDAO) RunTask(taskId int64) (isOk bool, errMsg string, resultCertId int64) {
+	var tx *dbs.Tx
+	isOk, errMsg, resultCertId, _ = this.runTaskWithoutLog(taskId, false)
+
+	// 手动执行成功后，不再需要在队列中续期
+	if isOk {
+		err := this.Query(tx).
+			Pk(taskId).
+			Set("isRenewing", false).
+			UpdateQuickly()
+		if err != nil {
+			logs.Error(err)
+		}
+	}
 
 	// 记录日志
 	err := SharedACMETaskLogDAO.CreateACMETaskLog(tx, taskId, isOk, errMsg)
@@ -306,8 +470,174 @@ func (this *ACMETaskDAO) RunTask(tx *dbs.Tx, taskId int64) (isOk bool, errMsg st
 	return
 }
 
+// DryRunTask 在CA的测试环境中试运行任务，不保存证书，也不修改任务状态
+// 执行过程中需要和CA交互，不能在事务中调用
+func (this *ACMETaskDAO) DryRunTask(taskId int64) (*acmeutils.DryRunReport, error) {
+	var tx *dbs.Tx
+
+	task, err := this.FindEnabledACMETask(tx, taskId)
+	if err != nil {
+		return nil, err
+	}
+	if task == nil {
+		return nil, errors.New("can not find task '" + types.String(taskId) + "'")
+	}
+
+	user, err := SharedACMEUserDAO.FindEnabledACMEUser(tx, int64(task.AcmeUserId))
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("can not find acme user '" + types.String(task.AcmeUserId) + "'")
+	}
+	if len(user.ProviderCode) == 0 {
+		user.ProviderCode = acmeutils.DefaultProviderCode
+	}
+
+	acmeRequest, _, errMsg := this.composeACMERequest(tx, task, user)
+	if len(errMsg) > 0 {
+		return &acmeutils.DryRunReport{
+			Domains: task.DecodeDomains(),
+			Error:   errMsg,
+		}, nil
+	}
+	return acmeRequest.DryRun(), nil
+}
+
+// 签发证书需要的信息
+type acmeIssue struct {
+	task              *ACMETask
+	user              *ACMEUser
+	providerCode      string
+	isCustomDirectory bool
+	request           *acmeutils.Request
+	acmeTask          *acmeutils.Task
+}
+
 // 执行任务但并不记录日志
-func (this *ACMETaskDAO) runTaskWithoutLog(tx *dbs.Tx, taskId int64, randomAcmeAccount bool) (isOk bool, errMsg string, resultCertId int64) {
+// 和CA的交互可能持续数分钟，所以不使用调用者的事务：准备阶段只执行简短的查询，CA交互完成后再使用单独的短事务保存证书
+// isThrottled 表示因为CA频率限制而推迟执行
+func (this *ACMETaskDAO) runTaskWithoutLog(taskId int64, randomAcmeAccount bool) (isOk bool, errMsg string, resultCertId int64, isThrottled bool) {
+	// 发布执行结果
+	defer func() {
+		acmeutils.SharedTaskProgressHub.Publish(taskId, &acmeutils.TaskProgressEvent{
+			IsFinished: true,
+			IsOk:       isOk,
+			Error:      errMsg,
+			CertId:     resultCertId,
+		})
+
+		// Webhook事件，因为频率限制推迟执行的不算失败
+		var err error
+		if isOk {
+			err = models.SharedWebhookDAO.Trigger(nil, webhooks.EventSSLCertIssued, maps.Map{
+				"acmeTaskId": taskId,
+				"sslCertId":  resultCertId,
+			})
+		} else if !isThrottled {
+			err = models.SharedWebhookDAO.Trigger(nil, webhooks.EventACMEFailed, maps.Map{
+				"acmeTaskId": taskId,
+				"error":      errMsg,
+			})
+		}
+		if err != nil {
+			remotelogs.Error("ACMETaskDAO", "trigger webhook event failed: "+err.Error())
+		}
+	}()
+
+	issue, errMsg, isThrottled := this.prepareIssue(taskId, randomAcmeAccount)
+	if len(errMsg) > 0 {
+		return
+	}
+
+	// 发布执行进度
+	issue.request.OnStep(func(step *acmeutils.RequestStep) {
+		acmeutils.SharedTaskProgressHub.Publish(taskId, &acmeutils.TaskProgressEvent{Step: step})
+	})
+
+	// 和CA交互，此时不占用任何数据库连接和事务
+	certData, keyData, err := issue.request.Run()
+	if err != nil {
+		retryAt, isRateLimited := acmeutils.ParseRateLimitedError(err, time.Now())
+		if isRateLimited {
+			isThrottled = true
+			errMsg = this.throttleTask(nil, taskId, retryAt.Unix(), "CA返回频率限制错误："+err.Error())
+			return
+		}
+	}
+
+	// 记录签发，用来计算CA频率限制
+	if !issue.isCustomDirectory {
+		recordErr := SharedACMEIssueRecordDAO.CreateRecord(nil, issue.providerCode, int64(issue.user.Id), taskId, issue.acmeTask.Domains, err == nil)
+		if recordErr != nil {
+			logs.Error(recordErr)
+		}
+	}
+
+	if err != nil {
+		errMsg = "证书生成失败：" + err.Error()
+		return
+	}
+
+	// 补全证书链，失败时仍然使用CA返回的证书链
+	chainResult, chainErr := certchain.Complete(certData)
+	if chainErr != nil {
+		remotelogs.Warn("ACME", "check cert chain failed: "+chainErr.Error())
+	} else {
+		if chainResult.FetchError != nil {
+			remotelogs.Warn("ACME", "complete cert chain failed: "+chainResult.FetchError.Error())
+		}
+		certData = chainResult.CertData
+	}
+
+	// 分析证书
+	var sslConfig = &sslconfigs.SSLCertConfig{
+		CertData: certData,
+		KeyData:  keyData,
+	}
+	if len(keyData) == 0 {
+		// 使用CSR申请时没有私钥，和CA证书一样只分析证书内容
+		sslConfig.IsCA = true
+	}
+	err = sslConfig.Init(context.Background())
+	if err != nil {
+		errMsg = "证书生成成功，但是分析证书信息时发生错误：" + err.Error()
+		return
+	}
+
+	// 保存证书
+	var saveStartedAt = time.Now()
+	defer func() {
+		var saveErr error
+		if !isOk {
+			saveErr = errors.New(errMsg)
+		}
+		acmeutils.SharedTaskProgressHub.Publish(taskId, &acmeutils.TaskProgressEvent{
+			Step: acmeutils.NewRequestStep("save certificate", saveStartedAt, "cert id: "+types.String(resultCertId), saveErr),
+		})
+	}()
+	resultCertId, errMsg = this.saveIssuedCert(issue, certData, keyData, sslConfig)
+	if len(errMsg) > 0 {
+		return
+	}
+
+	// 部署到外部平台
+	var deployCertId = resultCertId
+	goman.New(func() {
+		deployErr := models.SharedSSLCertDeployTargetDAO.DeployCert(deployCertId)
+		if deployErr != nil {
+			remotelogs.Error("ACME", "deploy cert '"+types.String(deployCertId)+"' failed: "+deployErr.Error())
+		}
+	})
+
+	isOk = true
+	return
+}
+
+// 准备签发需要的信息，只执行简短的查询和修改
+func (this *ACMETaskDAO) prepareIssue(taskId int64, randomAcmeAccount bool) (issue *acmeIssue, errMsg string, isThrottled bool) {
+	var tx *dbs.Tx
+
 	task, err := this.FindEnabledACMETask(tx, taskId)
 	if err != nil {
 		errMsg = "查询任务信息时出错：" + err.Error()
@@ -348,6 +678,7 @@ func (this *ACMETaskDAO) runTaskWithoutLog(tx *dbs.Tx, taskId int64, randomAcmeA
 	if len(user.ProviderCode) == 0 {
 		user.ProviderCode = acmeutils.DefaultProviderCode
 	}
+	var providerCode = user.ProviderCode
 
 	if randomAcmeAccount {
 		user, err = SharedACMEUserDAO.FindRandomACMEUserWithSameProvider(tx, user.ProviderCode)
@@ -357,87 +688,189 @@ func (this *ACMETaskDAO) runTaskWithoutLog(tx *dbs.Tx, taskId int64, randomAcmeA
 		}
 	}
 
-	var acmeProvider = acmeutils.FindProviderWithCode(user.ProviderCode)
-	if acmeProvider == nil {
-		errMsg = "服务商已不可用"
-		return
-	}
-
-	// 账号
-	var acmeAccount *acmeutils.Account
-	if user.AccountId > 0 {
-		account, err := SharedACMEProviderAccountDAO.FindEnabledACMEProviderAccount(tx, int64(user.AccountId))
+	// CA频率限制，自定义目录（比如测试目录）不计入正式目录的频率限制
+	var isCustomDirectory = len(task.DirectoryURL) > 0
+	if !isCustomDirectory {
+		throttledUntil, throttleReason, err := SharedACMEIssueRecordDAO.CheckRateLimits(tx, providerCode, int64(user.Id), task.DecodeDomains())
 		if err != nil {
-			errMsg = "查询ACME账号时出错：" + err.Error()
+			errMsg = "检查CA签发频率限制时出错：" + err.Error()
 			return
 		}
-		if account != nil {
-			acmeAccount = &acmeutils.Account{
-				EABKid: account.EabKid,
-				EABKey: account.EabKey,
-			}
+		if throttledUntil > 0 {
+			isThrottled = true
+			errMsg = this.throttleTask(tx, taskId, throttledUntil, throttleReason)
+			return
+		}
+	}
+	if task.ThrottledUntil > 0 {
+		err = this.ResetThrottled(tx, taskId)
+		if err != nil {
+			logs.Error(err)
 		}
 	}
 
-	privateKey, err := acmeutils.ParsePrivateKeyFromBase64(user.PrivateKey)
-	if err != nil {
-		errMsg = "解析私钥时出错：" + err.Error()
+	acmeRequest, acmeTask, errMsg := this.composeACMERequest(tx, task, user)
+	if len(errMsg) > 0 {
 		return
 	}
 
-	var remoteUser = acmeutils.NewUser(user.Email, privateKey, func(resource *registration.Resource) error {
-		resourceJSON, err := json.Marshal(resource)
+	issue = &acmeIssue{
+		task:              task,
+		user:              user,
+		providerCode:      providerCode,
+		isCustomDirectory: isCustomDirectory,
+		request:           acmeRequest,
+		acmeTask:          acmeTask,
+	}
+	return
+}
+
+// 在一个短事务中保存签发的证书
+func (this *ACMETaskDAO) saveIssuedCert(issue *acmeIssue, certData []byte, keyData []byte, sslConfig *sslconfigs.SSLCertConfig) (resultCertId int64, errMsg string) {
+	var task = issue.task
+	var taskId = int64(task.Id)
+
+	// 更新已有的证书
+	resultCertId = int64(task.CertId)
+	if resultCertId > 0 {
+		cert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(nil, resultCertId)
 		if err != nil {
-			return err
+			errMsg = "证书生成成功，但查询已绑定的证书时出错：" + err.Error()
+			return
 		}
+		if cert == nil {
+			errMsg = "证书已被管理员或用户删除"
 
-		err = SharedACMEUserDAO.UpdateACMEUserRegistration(tx, int64(user.Id), resourceJSON)
-		return err
-	})
+			// 禁用
+			err = SharedACMETaskDAO.DisableACMETask(nil, taskId)
+			if err != nil {
+				errMsg = "禁用失效的ACME任务出错：" + err.Error()
+			}
 
-	if len(user.Registration) > 0 {
-		err = remoteUser.SetRegistration(user.Registration)
-		if err != nil {
-			errMsg = "设置注册信息时出错：" + err.Error()
 			return
 		}
+
+		err = this.Instance.RunTx(func(tx *dbs.Tx) error {
+			// 私钥只保存在节点上
+			var certKeyData = keyData
+			if len(keyData) == 0 {
+				// 使用CSR申请时没有私钥，不能继续使用数据库中的旧私钥
+				if cert.KeyStorage != sslconfigs.SSLCertKeyStorageNode {
+					err := models.SharedSSLCertDAO.UpdateCertKeyStorage(tx, resultCertId, sslconfigs.SSLCertKeyStorageNode)
+					if err != nil {
+						errMsg = "证书生成成功，但是设置私钥存储位置时出错：" + err.Error()
+						return err
+					}
+				}
+			} else if cert.KeyStorage == sslconfigs.SSLCertKeyStorageNode {
+				err := models.SharedSSLCertDAO.SealCertKeyForNodes(tx, resultCertId, int64(cert.UserId), certData, keyData)
+				if err != nil {
+					errMsg = "证书生成成功，但是发送私钥到节点时出错：" + err.Error()
+					return err
+				}
+				certKeyData = nil
+			}
+
+			err := models.SharedSSLCertDAO.UpdateCert(tx, resultCertId, cert.IsOn, cert.Name, cert.Description, cert.ServerName, cert.IsCA, certData, certKeyData, sslConfig.TimeBeginAt, sslConfig.TimeEndAt, sslConfig.DNSNames, sslConfig.CommonNames)
+			if err != nil {
+				errMsg = "证书生成成功，但是修改数据库中的证书信息时出错：" + err.Error()
+				return err
+			}
+			return nil
+		})
+		if err != nil && len(errMsg) == 0 {
+			errMsg = "证书生成成功，但是保存证书时出错：" + err.Error()
+		}
+		return
 	}
 
-	var acmeTask *acmeutils.Task = nil
-	if task.AuthType == acmeutils.AuthTypeDNS {
-		// DNS服务商
-		dnsProvider, err := dns.SharedDNSProviderDAO.FindEnabledDNSProvider(tx, int64(task.DnsProviderId))
-		if err != nil {
-			errMsg = "查找DNS服务商账号信息时出错：" + err.Error()
-			return
+	// 创建新的证书
+	var certName = task.DnsDomain + "免费证书"
+	if issue.isCustomDirectory {
+		certName += "（测试）"
+	}
+	err := this.Instance.RunTx(func(tx *dbs.Tx) error {
+		// 已经保存过相同的证书时不再重复创建
+		// 使用CSR申请时私钥由用户自行保管，证书需要从节点本地读取私钥
+		var keyStorage = sslconfigs.SSLCertKeyStorageDB
+		if len(keyData) == 0 {
+			keyStorage = sslconfigs.SSLCertKeyStorageNode
 		}
-		if dnsProvider == nil {
-			errMsg = "找不到DNS服务商账号"
-			return
+
+		certId, err := models.SharedSSLCertDAO.FindDuplicatedCertId(tx, int64(task.UserId), false, keyStorage, certData)
+		if err != nil {
+			errMsg = "证书生成成功，但是查询重复证书时出错：" + err.Error()
+			return err
 		}
-		providerInterface := dnsclients.FindProvider(dnsProvider.Type, int64(dnsProvider.Id))
-		if providerInterface == nil {
-			errMsg = "暂不支持此类型的DNS服务商 '" + dnsProvider.Type + "'"
-			return
+		if certId <= 0 {
+			certId, err = models.SharedSSLCertDAO.CreateCert(tx, int64(task.AdminId), int64(task.UserId), true, certName, "免费申请的证书", "", false, certData, keyData, sslConfig.TimeBeginAt, sslConfig.TimeEndAt, sslConfig.DNSNames, sslConfig.CommonNames)
+			if err != nil {
+				errMsg = "证书生成成功，但是保存到数据库失败：" + err.Error()
+				return err
+			}
+			if keyStorage != sslconfigs.SSLCertKeyStorageDB {
+				err = models.SharedSSLCertDAO.UpdateCertKeyStorage(tx, certId, keyStorage)
+				if err != nil {
+					errMsg = "证书生成成功，但是设置私钥存储位置时出错：" + err.Error()
+					return err
+				}
+			}
 		}
-		providerInterface.SetMinTTL(int32(dnsProvider.MinTTL))
-		apiParams, err := dnsProvider.DecodeAPIParams()
+
+		err = models.SharedSSLCertDAO.UpdateCertACME(tx, certId, taskId)
 		if err != nil {
-			errMsg = "解析DNS服务商API参数时出错：" + err.Error()
-			return
+			errMsg = "证书生成成功，修改证书ACME信息时出错：" + err.Error()
+			return err
 		}
-		err = providerInterface.Auth(apiParams)
+
+		// 设置成功
+		err = SharedACMETaskDAO.UpdateACMETaskCert(tx, taskId, certId)
 		if err != nil {
-			errMsg = "校验DNS服务商API参数时出错：" + err.Error()
-			return
+			errMsg = "证书生成成功，设置任务关联的证书时出错：" + err.Error()
+			return err
+		}
+
+		resultCertId = certId
+		return nil
+	})
+	if err != nil {
+		resultCertId = 0
+		if len(errMsg) == 0 {
+			errMsg = "证书生成成功，但是保存证书时出错：" + err.Error()
 		}
+	}
+	return
+}
+
+// 根据任务信息构造ACME请求
+func (this *ACMETaskDAO) composeACMERequest(tx *dbs.Tx, task *ACMETask, user *ACMEUser) (acmeRequest *acmeutils.Request, acmeTask *acmeutils.Task, errMsg string) {
+	remoteUser, acmeProvider, acmeAccount, errMsg := this.composeACMEUser(tx, user)
+	if len(errMsg) > 0 {
+		return
+	}
 
+	if task.AuthType == acmeutils.AuthTypeDNS {
 		acmeTask = &acmeutils.Task{
-			User:        remoteUser,
-			AuthType:    acmeutils.AuthTypeDNS,
-			DNSProvider: providerInterface,
-			DNSDomain:   task.DnsDomain,
-			Domains:     task.DecodeDomains(),
+			User:      remoteUser,
+			AuthType:  acmeutils.AuthTypeDNS,
+			DNSDomain: task.DnsDomain,
+			Domains:   task.DecodeDomains(),
+		}
+
+		if task.DnsAliasProviderId > 0 && len(task.DnsAliasDomain) > 0 {
+			// DNS别名（CNAME委托）
+			acmeTask.DNSAliasProvider, errMsg = this.findDNSProviderInterface(tx, int64(task.DnsAliasProviderId))
+			if len(errMsg) > 0 {
+				errMsg = "DNS别名域名：" + errMsg
+				return
+			}
+			acmeTask.DNSAliasDomain = task.DnsAliasDomain
+		} else {
+			// DNS服务商
+			acmeTask.DNSProvider, errMsg = this.findDNSProviderInterface(tx, int64(task.DnsProviderId))
+			if len(errMsg) > 0 {
+				return
+			}
 		}
 	} else if task.AuthType == acmeutils.AuthTypeHTTP {
 		acmeTask = &acmeutils.Task{
@@ -445,13 +878,29 @@ func (this *ACMETaskDAO) runTaskWithoutLog(tx *dbs.Tx, taskId int64, randomAcmeA
 			AuthType: acmeutils.AuthTypeHTTP,
 			Domains:  task.DecodeDomains(),
 		}
+	} else {
+		errMsg = "不支持的认证方式：" + task.AuthType
+		return
 	}
 	acmeTask.Provider = acmeProvider
 	acmeTask.Account = acmeAccount
+	acmeTask.KeyType = task.KeyType
+	acmeTask.PreferredChain = task.PreferredChain
+	acmeTask.DirectoryURL = task.DirectoryURL
 
-	var acmeRequest = acmeutils.NewRequest(acmeTask)
+	// 使用CSR申请
+	if len(task.Csr) > 0 {
+		csr, err := acmeutils.ParseCSR([]byte(task.Csr))
+		if err != nil {
+			errMsg = "解析证书签名请求（CSR）失败：" + err.Error()
+			return
+		}
+		acmeTask.CSR = csr
+	}
+
+	acmeRequest = acmeutils.NewRequest(acmeTask)
 	acmeRequest.OnAuth(func(domain, token, keyAuth string) {
-		err := SharedACMEAuthenticationDAO.CreateAuth(tx, taskId, domain, token, keyAuth)
+		err := SharedACMEAuthenticationDAO.CreateAuth(tx, int64(task.Id), domain, token, keyAuth)
 		if err != nil {
 			remotelogs.Error("ACME", "write authentication to database error: "+err.Error())
 		} else {
@@ -483,96 +932,283 @@ func (this *ACMETaskDAO) runTaskWithoutLog(tx *dbs.Tx, taskId int64, randomAcmeA
 			}
 		}
 	})
-	certData, keyData, err := acmeRequest.Run()
+	return
+}
+
+// RevokeACMECert 在证书颁发机构吊销通过ACME申请的证书，并禁用证书
+func (this *ACMETaskDAO) RevokeACMECert(tx *dbs.Tx, certId int64, reason acmeutils.RevokeReason) error {
+	if !acmeutils.IsValidRevokeReason(reason) {
+		return errors.New("invalid revoke reason '" + types.String(reason) + "'")
+	}
+
+	cert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, certId)
 	if err != nil {
-		errMsg = "证书生成失败：" + err.Error()
-		return
+		return err
+	}
+	if cert == nil {
+		return errors.New("can not find cert '" + types.String(certId) + "'")
+	}
+	if cert.RevokedAt > 0 {
+		return errors.New("the cert has already been revoked")
+	}
+	if !cert.IsACME || cert.AcmeTaskId == 0 {
+		return errors.New("only certs issued by ACME tasks can be revoked")
 	}
 
-	// 分析证书
-	var sslConfig = &sslconfigs.SSLCertConfig{
-		CertData: certData,
-		KeyData:  keyData,
+	// 申请证书时使用的ACME用户
+	one, err := this.Query(tx).
+		Pk(cert.AcmeTaskId).
+		Find()
+	if err != nil {
+		return err
 	}
-	err = sslConfig.Init(context.Background())
+	if one == nil {
+		return errors.New("can not find the acme task of the cert")
+	}
+	var task = one.(*ACMETask)
+
+	user, err := SharedACMEUserDAO.FindEnabledACMEUser(tx, int64(task.AcmeUserId))
 	if err != nil {
-		errMsg = "证书生成成功，但是分析证书信息时发生错误：" + err.Error()
-		return
+		return err
+	}
+	if user == nil {
+		return errors.New("can not find the acme user of the cert")
 	}
 
-	// 保存证书
-	resultCertId = int64(task.CertId)
-	if resultCertId > 0 {
-		cert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, resultCertId)
-		if err != nil {
-			errMsg = "证书生成成功，但查询已绑定的证书时出错：" + err.Error()
-			return
-		}
-		if cert == nil {
-			errMsg = "证书已被管理员或用户删除"
+	remoteUser, acmeProvider, acmeAccount, errMsg := this.composeACMEUser(tx, user)
+	if len(errMsg) > 0 {
+		return errors.New(errMsg)
+	}
 
-			// 禁用
-			err = SharedACMETaskDAO.DisableACMETask(tx, taskId)
-			if err != nil {
-				errMsg = "禁用失效的ACME任务出错：" + err.Error()
-			}
+	var acmeRequest = acmeutils.NewRequest(&acmeutils.Task{
+		Provider: acmeProvider,
+		Account:  acmeAccount,
+		User:     remoteUser,
+	})
+	err = acmeRequest.Revoke(cert.CertData, reason)
+	if err != nil {
+		return err
+	}
 
-			return
-		}
+	// 禁用证书
+	err = models.SharedSSLCertDAO.UpdateCertRevoked(tx, certId, reason)
+	if err != nil {
+		return err
+	}
 
-		err = models.SharedSSLCertDAO.UpdateCert(tx, resultCertId, cert.IsOn, cert.Name, cert.Description, cert.ServerName, cert.IsCA, certData, keyData, sslConfig.TimeBeginAt, sslConfig.TimeEndAt, sslConfig.DNSNames, sslConfig.CommonNames)
+	// 证书已吊销，不再自动续期
+	return this.DisableAutoRenewWithCertId(tx, certId)
+}
+
+// 构造ACME用户、服务商和账号信息
+func (this *ACMETaskDAO) composeACMEUser(tx *dbs.Tx, user *ACMEUser) (remoteUser *acmeutils.User, acmeProvider *acmeutils.Provider, acmeAccount *acmeutils.Account, errMsg string) {
+	if len(user.ProviderCode) == 0 {
+		user.ProviderCode = acmeutils.DefaultProviderCode
+	}
+
+	acmeProvider = acmeutils.FindProviderWithCode(user.ProviderCode)
+	if acmeProvider == nil {
+		errMsg = "服务商已不可用"
+		return
+	}
+
+	// 账号
+	if user.AccountId > 0 {
+		account, err := SharedACMEProviderAccountDAO.FindEnabledACMEProviderAccount(tx, int64(user.AccountId))
 		if err != nil {
-			errMsg = "证书生成成功，但是修改数据库中的证书信息时出错：" + err.Error()
+			errMsg = "查询ACME账号时出错：" + err.Error()
 			return
 		}
-	} else {
-		resultCertId, err = models.SharedSSLCertDAO.CreateCert(tx, int64(task.AdminId), int64(task.UserId), true, task.DnsDomain+"免费证书", "免费申请的证书", "", false, certData, keyData, sslConfig.TimeBeginAt, sslConfig.TimeEndAt, sslConfig.DNSNames, sslConfig.CommonNames)
-		if err != nil {
-			errMsg = "证书生成成功，但是保存到数据库失败：" + err.Error()
-			return
+		if account != nil {
+			acmeAccount = &acmeutils.Account{
+				EABKid: account.EabKid,
+				EABKey: account.EabKey,
+			}
 		}
+	}
+
+	privateKey, err := acmeutils.ParsePrivateKeyFromBase64(user.PrivateKey)
+	if err != nil {
+		errMsg = "解析私钥时出错：" + err.Error()
+		return
+	}
 
-		err = models.SharedSSLCertDAO.UpdateCertACME(tx, resultCertId, int64(task.Id))
+	remoteUser = acmeutils.NewUser(user.Email, privateKey, func(resource *registration.Resource) error {
+		resourceJSON, err := json.Marshal(resource)
 		if err != nil {
-			errMsg = "证书生成成功，修改证书ACME信息时出错：" + err.Error()
-			return
+			return err
 		}
 
-		// 设置成功
-		err = SharedACMETaskDAO.UpdateACMETaskCert(tx, taskId, resultCertId)
+		err = SharedACMEUserDAO.UpdateACMEUserRegistration(tx, int64(user.Id), resourceJSON)
+		return err
+	})
+
+	if len(user.Registration) > 0 {
+		err = remoteUser.SetRegistration(user.Registration)
 		if err != nil {
-			errMsg = "证书生成成功，设置任务关联的证书时出错：" + err.Error()
+			errMsg = "设置注册信息时出错：" + err.Error()
 			return
 		}
 	}
 
-	isOk = true
 	return
 }
 
-// FindIssueACMETask 查找N小时内未执行的AcmeTask
-func (this *ACMETaskDAO) FindIssueACMETask(tx *dbs.Tx, hour int, limit int64, excludeTasks []int64) (result []*ACMETask, err error) {
-	if len(excludeTasks) == 0 {
-		excludeTasks = append(excludeTasks, 0)
+// 查找DNS服务商并完成认证
+func (this *ACMETaskDAO) findDNSProviderInterface(tx *dbs.Tx, dnsProviderId int64) (providerInterface dnsclients.ProviderInterface, errMsg string) {
+	dnsProvider, err := dns.SharedDNSProviderDAO.FindEnabledDNSProvider(tx, dnsProviderId)
+	if err != nil {
+		errMsg = "查找DNS服务商账号信息时出错：" + err.Error()
+		return
 	}
-	var strIDs []string
-	for _, id := range excludeTasks {
-		strIDs = append(strIDs, strconv.FormatInt(id, 10))
+	if dnsProvider == nil {
+		errMsg = "找不到DNS服务商账号"
+		return
 	}
-	_, err = this.Query(tx).
+	providerInterface = dnsclients.FindProvider(dnsProvider.Type, int64(dnsProvider.Id))
+	if providerInterface == nil {
+		errMsg = "暂不支持此类型的DNS服务商 '" + dnsProvider.Type + "'"
+		return
+	}
+	providerInterface.SetMinTTL(int32(dnsProvider.MinTTL))
+	apiParams, err := dnsProvider.DecodeAPIParams()
+	if err != nil {
+		errMsg = "解析DNS服务商API参数时出错：" + err.Error()
+		return nil, errMsg
+	}
+	err = providerInterface.Auth(apiParams)
+	if err != nil {
+		errMsg = "校验DNS服务商API参数时出错：" + err.Error()
+		return nil, errMsg
+	}
+	return
+}
+
+// FindIssueACMETask 查找N小时内未执行的AcmeTask，以及需要重试和续期的AcmeTask
+// 按优先级从高到低排列，同一优先级的按ID排列
+func (this *ACMETaskDAO) FindIssueACMETask(tx *dbs.Tx, hour int, limit int64, excludeTasks []int64) (result []*ACMETask, err error) {
+	var query = this.Query(tx).
 		Attr("isOn", true).
 		Attr("async", true).
 		State(ACMETaskStateEnabled).
-		Where("FROM_UNIXTIME(createdAt, '%Y-%m-%d %H:%i')>:hoursAgo AND certId=0 and id NOT IN ("+strings.Join(strIDs, ",")+")").
+		Where("(certId=0 OR isRenewing=1)")
+	_, err = dbutils.WhereNotIn(query, "id", "excludeTaskIds", excludeTasks).
+		// 新创建的任务，或者签发失败后到了重试时间的任务
+		// 因频率限制推迟的任务到了可以执行的时间
+		// 在队列中等待续期的任务
+		Where("((status NOT IN (:failedStatus, :throttledStatus) AND FROM_UNIXTIME(createdAt, '%Y-%m-%d %H:%i')>:hoursAgo) OR (status=:failedStatus AND nextRetryAt>0 AND nextRetryAt<=:now) OR (status=:throttledStatus AND throttledUntil<=:now) OR (isRenewing=1 AND status=:pendingStatus))").
+		Param("pendingStatus", ACMETaskStatusPending).
+		Param("failedStatus", ACMETaskStatusIssueFailed).
+		Param("throttledStatus", ACMETaskStatusThrottled).
 		Param("hoursAgo", time.Now().UTC().Add(-time.Duration(hour)*time.Hour).Format("2006-01-02 15:04")).
 		Param("now", time.Now().Unix()).
 		Slice(&result).
+		Desc("priority").
 		AscPk().
 		Limit(limit).
 		FindAll()
 	return
 }
 
+// QueueACMETaskRenewal 将任务加入签发队列续期
+// certExpiresAt 为当前证书的过期时间，用来计算优先级；正在执行的任务不会重复加入
+func (this *ACMETaskDAO) QueueACMETaskRenewal(tx *dbs.Tx, taskId int64, certExpiresAt int64) error {
+	return this.Query(tx).
+		Pk(taskId).
+		Neq("status", ACMETaskStatusRunning).
+		Set("isRenewing", true).
+		Set("status", ACMETaskStatusPending).
+		Set("priority", acmeutils.IssuePriority(true, certExpiresAt)).
+		UpdateQuickly()
+}
+
+// UpdateACMETaskPriority 修改任务的签发优先级
+func (this *ACMETaskDAO) UpdateACMETaskPriority(tx *dbs.Tx, taskId int64, priority int) error {
+	return this.Query(tx).
+		Pk(taskId).
+		Set("priority", priority).
+		UpdateQuickly()
+}
+
+// MergePendingTasks 合并待签发任务中域名重叠的任务，以减少签发次数和重复的证书
+// hour 和 FindIssueACMETask() 中的一致，excludeTasks 为正在签发队列中的任务
+func (this *ACMETaskDAO) MergePendingTasks(tx *dbs.Tx, hour int, excludeTasks []int64) (merges []*acmeutils.TaskMerge, err error) {
+	var query = this.Query(tx).
+		Attr("isOn", true).
+		Attr("async", true).
+		State(ACMETaskStateEnabled).
+		Attr("certId", 0).
+		Attr("status", ACMETaskStatusPending).
+		Where("(csr IS NULL OR csr='')"). // 使用CSR的任务域名不能改变
+		Gt("createdAt", time.Now().Unix()-int64(hour*3600))
+	dbutils.WhereNotIn(query, "id", "excludeTaskIds", excludeTasks)
+
+	var tasks = []*ACMETask{}
+	_, err = query.
+		AscPk().
+		Slice(&tasks).
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) <= 1 {
+		return nil, nil
+	}
+
+	var candidates = []*acmeutils.MergeCandidate{}
+	for _, task := range tasks {
+		candidates = append(candidates, &acmeutils.MergeCandidate{
+			TaskId:  int64(task.Id),
+			Key:     task.MergeKey(),
+			Domains: task.DecodeDomains(),
+		})
+	}
+
+	merges = acmeutils.PlanTaskMerges(candidates, acmeutils.MaxMergedDomains)
+	for _, merge := range merges {
+		err = this.applyTaskMerge(tx, merge)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return merges, nil
+}
+
+// 执行任务合并
+func (this *ACMETaskDAO) applyTaskMerge(tx *dbs.Tx, merge *acmeutils.TaskMerge) error {
+	domainsJSON, err := json.Marshal(merge.Domains)
+	if err != nil {
+		return err
+	}
+	err = this.Query(tx).
+		Pk(merge.TaskId).
+		Set("domains", domainsJSON).
+		UpdateQuickly()
+	if err != nil {
+		return err
+	}
+
+	var mergedTaskIdStrings = []string{}
+	for _, mergedTaskId := range merge.MergedTaskIds {
+		err = this.Query(tx).
+			Pk(mergedTaskId).
+			Set("mergedTaskId", merge.TaskId).
+			Set("state", ACMETaskStateDisabled).
+			UpdateQuickly()
+		if err != nil {
+			return err
+		}
+		mergedTaskIdStrings = append(mergedTaskIdStrings, types.String(mergedTaskId))
+
+		err = SharedACMETaskLogDAO.CreateACMETaskLog(tx, mergedTaskId, true, "域名和任务 #"+types.String(merge.TaskId)+" 重叠，已合并到该任务中签发")
+		if err != nil {
+			return err
+		}
+	}
+
+	return SharedACMETaskLogDAO.CreateACMETaskLog(tx, merge.TaskId, true, "合并了域名重叠的任务 #"+strings.Join(mergedTaskIdStrings, ", #")+"，合并后的域名："+strings.Join(merge.Domains, ", "))
+}
+
 // UpdateStatus 更新状态
 func (this *ACMETaskDAO) UpdateStatus(tx *dbs.Tx, id int64, status int64) error {
 	_, err := this.Query(tx).
@@ -582,34 +1218,146 @@ func (this *ACMETaskDAO) UpdateStatus(tx *dbs.Tx, id int64, status int64) error
 	return err
 }
 
-// RunTaskAndAutoBindServer 证书签发并绑定Server，记录日志
-func (this *ACMETaskDAO) RunTaskAndAutoBindServer(tx *dbs.Tx, taskId int64, domains []string) (isOk bool, errMsg string) {
-	_, ok := runningTaskMap.Load(taskId)
-	if ok {
-		return true, "" // 返回ok，异步任务无需继续执行
+// UpdateIssueFailed 设置签发失败，并根据失败次数计算下次重试时间
+func (this *ACMETaskDAO) UpdateIssueFailed(tx *dbs.Tx, taskId int64, maxRetries int) (nextRetryAt int64, err error) {
+	failures, err := this.Query(tx).
+		Pk(taskId).
+		Result("issueRetries").
+		FindIntCol(0)
+	if err != nil {
+		return 0, err
 	}
+	failures++
 
-	isOk, errMsg, resultCertId := this.runTaskWithoutLog(tx, taskId, true)
+	var delay = acmeutils.NextIssueRetryDelay(failures, maxRetries)
+	if delay > 0 {
+		nextRetryAt = time.Now().Add(delay).Unix()
+	}
 
-	// 记录日志
-	err := SharedACMETaskLogDAO.CreateACMETaskLog(tx, taskId, isOk, errMsg)
+	err = this.Query(tx).
+		Pk(taskId).
+		Set("status", ACMETaskStatusIssueFailed).
+		Set("issueRetries", failures).
+		Set("nextRetryAt", nextRetryAt).
+		UpdateQuickly()
+	return
+}
+
+// ResetIssueRetries 重置签发失败次数
+func (this *ACMETaskDAO) ResetIssueRetries(tx *dbs.Tx, taskId int64) error {
+	return this.Query(tx).
+		Pk(taskId).
+		Set("issueRetries", 0).
+		Set("nextRetryAt", 0).
+		UpdateQuickly()
+}
+
+// UpdateThrottled 设置任务因CA频率限制推迟执行
+func (this *ACMETaskDAO) UpdateThrottled(tx *dbs.Tx, taskId int64, throttledUntil int64, reason string) error {
+	return this.Query(tx).
+		Pk(taskId).
+		Set("status", ACMETaskStatusThrottled).
+		Set("throttledUntil", throttledUntil).
+		Set("throttleReason", utils.LimitString(reason, 255)).
+		UpdateQuickly()
+}
+
+// ResetThrottled 清除频率限制状态
+func (this *ACMETaskDAO) ResetThrottled(tx *dbs.Tx, taskId int64) error {
+	return this.Query(tx).
+		Pk(taskId).
+		Set("throttledUntil", 0).
+		Set("throttleReason", "").
+		UpdateQuickly()
+}
+
+// 推迟任务，并返回提示信息
+func (this *ACMETaskDAO) throttleTask(tx *dbs.Tx, taskId int64, throttledUntil int64, reason string) (errMsg string) {
+	err := this.UpdateThrottled(tx, taskId, throttledUntil, reason)
 	if err != nil {
 		logs.Error(err)
 	}
+	return "受CA签发频率限制，推迟到" + timeutil.FormatTime("Y-m-d H:i:s", throttledUntil) + "执行：" + reason
+}
+
+// RunTaskAndAutoBindServer 证书签发并绑定Server，记录日志
+// maxRetries 签发失败后自动重试的最多次数
+// 执行过程中需要和CA交互，不能在事务中调用
+func (this *ACMETaskDAO) RunTaskAndAutoBindServer(taskId int64, domains []string, maxRetries int) (isOk bool, errMsg string) {
+	var tx *dbs.Tx
+
+	// 续期时证书已经绑定过
+	isRenewing, err := this.Query(tx).
+		Pk(taskId).
+		Result("isRenewing").
+		FindBoolCol()
+	if err != nil {
+		return false, "查询任务信息时出错：" + err.Error()
+	}
+
+	isOk, errMsg, resultCertId, isThrottled := this.runTaskWithoutLog(taskId, true)
+
+	if isThrottled {
+		// 推迟执行，不计入签发失败次数
+		err := SharedACMETaskLogDAO.CreateACMETaskLog(tx, taskId, false, errMsg)
+		if err != nil {
+			logs.Error(err)
+		}
+		return
+	}
+
 	if !isOk {
 		// 设置签发失败
-		err = this.UpdateStatus(tx, taskId, ACMETaskStatusIssueFailed)
+		nextRetryAt, err := this.UpdateIssueFailed(tx, taskId, maxRetries)
+		if err != nil {
+			logs.Error(err)
+		} else if nextRetryAt > 0 {
+			errMsg += "（将在" + timeutil.FormatTime("Y-m-d H:i:s", nextRetryAt) + "自动重试）"
+		}
+
+		// 记录日志
+		err = SharedACMETaskLogDAO.CreateACMETaskLog(tx, taskId, isOk, errMsg)
 		if err != nil {
 			logs.Error(err)
 		}
 		return
 	}
 
+	// 记录日志
+	err = SharedACMETaskLogDAO.CreateACMETaskLog(tx, taskId, isOk, errMsg)
+	if err != nil {
+		logs.Error(err)
+	}
+
 	// 签发成功
 	err = this.UpdateStatus(tx, taskId, ACMETaskStatusDone)
 	if err != nil {
 		logs.Error(err)
 	}
+	err = this.ResetIssueRetries(tx, taskId)
+	if err != nil {
+		logs.Error(err)
+	}
+	if isRenewing {
+		err = this.Query(tx).
+			Pk(taskId).
+			Set("isRenewing", false).
+			UpdateQuickly()
+		if err != nil {
+			logs.Error(err)
+		}
+		return
+	}
+
+	// 使用自定义目录（比如测试目录）签发的证书不自动绑定到网站，避免替换正式证书
+	directoryURL, err := this.FindACMETaskDirectoryURL(tx, taskId)
+	if err != nil {
+		logs.Error(err)
+		return
+	}
+	if len(directoryURL) > 0 {
+		return
+	}
 
 	newCert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, resultCertId)
 	if err != nil {
@@ -661,7 +1409,7 @@ func (this *ACMETaskDAO) RunTaskAndAutoBindServer(tx *dbs.Tx, taskId int64, doma
 					var certIds []int64
 					for _, cert := range sslPolicyConfig.Certs {
 						// 新签发的证书如果包含所有旧证书的域名则不再绑定旧证书，并禁用旧证书避免触发续签
-						if utils.ListIsGreaterEqualThanOther(domains, cert.DNSNames) && cert.TimeEndAt < int64(newCert.TimeEndAt) {
+						if cert.IsSupersededBy(domains, int64(newCert.TimeEndAt)) {
 							err = models.SharedSSLCertDAO.DisableSSLCert(tx, cert.Id)
 							if err != nil {
 								logs.Error(err)
@@ -20,6 +20,20 @@ type ACMETask struct {
 	AuthURL       string   `field:"authURL"`       // 认证URL
 	Async         bool     `field:"async"`         // 是否异步
 	Status        uint32   `field:"status"`        // 任务状态
+
+	DnsAliasDomain     string `field:"dnsAliasDomain"`     // DNS别名域名
+	DnsAliasProviderId uint64 `field:"dnsAliasProviderId"` // DNS别名域名服务商
+	KeyType            string `field:"keyType"`            // 私钥类型
+	IssueRetries       uint32 `field:"issueRetries"`       // 签发失败次数
+	NextRetryAt        uint64 `field:"nextRetryAt"`        // 下次重试时间
+	PreferredChain     string `field:"preferredChain"`     // 首选证书链
+	ThrottledUntil     uint64 `field:"throttledUntil"`     // 因频率限制推迟到的时间
+	ThrottleReason     string `field:"throttleReason"`     // 频率限制原因
+	DirectoryURL       string `field:"directoryURL"`       // 自定义ACME目录URL
+	MergedTaskId       uint64 `field:"mergedTaskId"`       // 合并到的任务ID
+	Priority           uint8  `field:"priority"`           // 签发优先级
+	IsRenewing         bool   `field:"isRenewing"`         // 是否在队列中等待续期
+	Csr                string `field:"csr"`                // 证书签名请求（CSR）
 }
 
 type ACMETaskOperator struct {
@@ -39,6 +53,20 @@ type ACMETaskOperator struct {
 	AuthURL       interface{} // 认证URL
 	Async         interface{} //是否异步
 	Status        interface{} // 任务状态
+
+	DnsAliasDomain     interface{} // DNS别名域名
+	DnsAliasProviderId interface{} // DNS别名域名服务商
+	KeyType            interface{} // 私钥类型
+	IssueRetries       interface{} // 签发失败次数
+	NextRetryAt        interface{} // 下次重试时间
+	PreferredChain     interface{} // 首选证书链
+	ThrottledUntil     interface{} // 因频率限制推迟到的时间
+	ThrottleReason     interface{} // 频率限制原因
+	DirectoryURL       interface{} // 自定义ACME目录URL
+	MergedTaskId       interface{} // 合并到的任务ID
+	Priority           interface{} // 签发优先级
+	IsRenewing         interface{} // 是否在队列中等待续期
+	Csr                interface{} // 证书签名请求（CSR）
 }
 
 func NewACMETaskOperator() *ACMETaskOperator {
@@ -2,8 +2,10 @@ package acme
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/iwind/TeaGo/logs"
+	"github.com/iwind/TeaGo/types"
 )
 
 // DecodeDomains 将域名解析成字符串数组
@@ -19,3 +21,22 @@ func (this *ACMETask) DecodeDomains() []string {
 	}
 	return result
 }
+
+// MergeKey 任务的签发参数，签发参数相同的任务才能合并
+func (this *ACMETask) MergeKey() string {
+	return strings.Join([]string{
+		types.String(this.AdminId),
+		types.String(this.UserId),
+		types.String(this.AcmeUserId),
+		this.AuthType,
+		this.AuthURL,
+		types.String(this.DnsProviderId),
+		this.DnsDomain,
+		types.String(this.DnsAliasProviderId),
+		this.DnsAliasDomain,
+		this.KeyType,
+		this.PreferredChain,
+		this.DirectoryURL,
+		types.String(this.AutoRenew),
+	}, "|")
+}
@@ -100,6 +100,32 @@ func (this *ACMEUserDAO) CreateACMEUser(tx *dbs.Tx, adminId int64, userId int64,
 	return types.Int64(op.Id), nil
 }
 
+// ImportACMEUser 导入已有的ACME用户
+// privateKeyText 为PKCS#8格式私钥的Base64编码，registrationJSON 为服务商返回的注册信息
+func (this *ACMEUserDAO) ImportACMEUser(tx *dbs.Tx, adminId int64, userId int64, providerCode string, accountId int64, email string, description string, privateKeyText string, registrationJSON []byte) (int64, error) {
+	if len(privateKeyText) == 0 {
+		return 0, errors.New("'privateKey' must not be empty")
+	}
+
+	var op = NewACMEUserOperator()
+	op.AdminId = adminId
+	op.UserId = userId
+	op.ProviderCode = providerCode
+	op.AccountId = accountId
+	op.Email = email
+	op.Description = description
+	op.PrivateKey = privateKeyText
+	if len(registrationJSON) > 0 {
+		op.Registration = registrationJSON
+	}
+	op.State = ACMEUserStateEnabled
+	err := this.Save(tx, op)
+	if err != nil {
+		return 0, err
+	}
+	return types.Int64(op.Id), nil
+}
+
 // UpdateACMEUser 修改用户信息
 func (this *ACMEUserDAO) UpdateACMEUser(tx *dbs.Tx, acmeUserId int64, description string) error {
 	if acmeUserId <= 0 {
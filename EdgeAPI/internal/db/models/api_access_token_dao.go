@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
@@ -10,6 +11,9 @@ import (
 	"github.com/iwind/TeaGo/rands"
 )
 
+// MaxImpersonationTokenLife 模拟用户登录令牌的最长有效期
+const MaxImpersonationTokenLife = 1 * time.Hour
+
 type APIAccessTokenDAO dbs.DAO
 
 func NewAPIAccessTokenDAO() *APIAccessTokenDAO {
@@ -32,7 +36,8 @@ func init() {
 }
 
 // GenerateAccessToken 生成AccessToken
-func (this *APIAccessTokenDAO) GenerateAccessToken(tx *dbs.Tx, adminId int64, userId int64) (token string, expiresAt int64, err error) {
+// scopes 为已经整理过的权限范围，为空表示不限制；不同的权限范围使用不同的令牌
+func (this *APIAccessTokenDAO) GenerateAccessToken(tx *dbs.Tx, adminId int64, userId int64, scopes []string) (token string, expiresAt int64, err error) {
 	if adminId <= 0 && userId <= 0 {
 		err = errors.New("either 'adminId' or 'userId' should not be zero")
 		return
@@ -46,13 +51,21 @@ func (this *APIAccessTokenDAO) GenerateAccessToken(tx *dbs.Tx, adminId int64, us
 	}
 
 	// 查询以前的
-	accessToken, err := this.Query(tx).
+	ones, err := this.Query(tx).
 		Attr("adminId", adminId).
 		Attr("userId", userId).
-		Find()
+		Attr("impersonatorAdminId", 0).
+		FindAll()
 	if err != nil {
 		return "", 0, err
 	}
+	var accessToken *APIAccessToken
+	for _, one := range ones {
+		if this.equalScopes(one.(*APIAccessToken).DecodeScopes(), scopes) {
+			accessToken = one.(*APIAccessToken)
+			break
+		}
+	}
 
 	token = rands.String(128) // TODO 增强安全性，将来使用 base64_encode(encrypt(salt+random)) 算法来代替
 	expiresAt = time.Now().Unix() + 7200
@@ -60,7 +73,7 @@ func (this *APIAccessTokenDAO) GenerateAccessToken(tx *dbs.Tx, adminId int64, us
 	var op = NewAPIAccessTokenOperator()
 
 	if accessToken != nil {
-		op.Id = accessToken.(*APIAccessToken).Id
+		op.Id = accessToken.Id
 	}
 
 	op.AdminId = adminId
@@ -68,10 +81,67 @@ func (this *APIAccessTokenDAO) GenerateAccessToken(tx *dbs.Tx, adminId int64, us
 	op.Token = token
 	op.CreatedAt = time.Now().Unix()
 	op.ExpiredAt = expiresAt
+	if len(scopes) > 0 {
+		scopesJSON, jsonErr := json.Marshal(scopes)
+		if jsonErr != nil {
+			return "", 0, jsonErr
+		}
+		op.Scopes = scopesJSON
+	}
 	err = this.Save(tx, op)
 	return
 }
 
+// GenerateImpersonationToken 生成管理员模拟用户登录使用的AccessToken
+// 每次都生成新的令牌，不和普通令牌共用，方便单独撤销
+func (this *APIAccessTokenDAO) GenerateImpersonationToken(tx *dbs.Tx, impersonatorAdminId int64, userId int64, scopes []string, reason string, life time.Duration) (token string, expiresAt int64, err error) {
+	if impersonatorAdminId <= 0 {
+		err = errors.New("'impersonatorAdminId' should not be zero")
+		return
+	}
+	if userId <= 0 {
+		err = errors.New("'userId' should not be zero")
+		return
+	}
+	if life <= 0 || life > MaxImpersonationTokenLife {
+		life = MaxImpersonationTokenLife
+	}
+
+	token = rands.String(128)
+	expiresAt = time.Now().Add(life).Unix()
+
+	var op = NewAPIAccessTokenOperator()
+	op.UserId = userId
+	op.ImpersonatorAdminId = impersonatorAdminId
+	op.Reason = reason
+	op.Token = token
+	op.CreatedAt = time.Now().Unix()
+	op.ExpiredAt = expiresAt
+	if len(scopes) > 0 {
+		scopesJSON, jsonErr := json.Marshal(scopes)
+		if jsonErr != nil {
+			return "", 0, jsonErr
+		}
+		op.Scopes = scopesJSON
+	}
+	err = this.Save(tx, op)
+	return
+}
+
+// DeleteImpersonationTokens 删除管理员模拟用户登录的令牌
+// userId 为0表示删除此管理员模拟所有用户的令牌
+func (this *APIAccessTokenDAO) DeleteImpersonationTokens(tx *dbs.Tx, impersonatorAdminId int64, userId int64) error {
+	if impersonatorAdminId <= 0 {
+		return nil
+	}
+	var query = this.Query(tx).
+		Attr("impersonatorAdminId", impersonatorAdminId)
+	if userId > 0 {
+		query.Attr("userId", userId)
+	}
+	return query.DeleteQuickly()
+}
+
 // FindAccessToken 查找AccessToken
 func (this *APIAccessTokenDAO) FindAccessToken(tx *dbs.Tx, token string) (*APIAccessToken, error) {
 	one, err := this.Query(tx).
@@ -87,7 +157,9 @@ func (this *APIAccessTokenDAO) FindAccessToken(tx *dbs.Tx, token string) (*APIAc
 func (this *APIAccessTokenDAO) DeleteAccessTokens(tx *dbs.Tx, adminId int64, userId int64) error {
 	var query = this.Query(tx)
 	if adminId > 0 {
-		query.Attr("adminId", adminId)
+		// 同时删除此管理员模拟用户登录的令牌
+		query.Where("(adminId=:adminId OR impersonatorAdminId=:adminId)").
+			Param("adminId", adminId)
 	} else if userId > 0 {
 		query.Attr("userId", userId)
 	} else {
@@ -95,3 +167,16 @@ func (this *APIAccessTokenDAO) DeleteAccessTokens(tx *dbs.Tx, adminId int64, use
 	}
 	return query.DeleteQuickly()
 }
+
+// 判断两组权限范围是否相同
+func (this *APIAccessTokenDAO) equalScopes(scopes1 []string, scopes2 []string) bool {
+	if len(scopes1) != len(scopes2) {
+		return false
+	}
+	for index, scope := range scopes1 {
+		if scopes2[index] != scope {
+			return false
+		}
+	}
+	return true
+}
@@ -1 +1,21 @@
 package models
+
+import "encoding/json"
+
+// DecodeScopes 解析权限范围，为空表示不限制
+func (this *APIAccessToken) DecodeScopes() []string {
+	return decodeScopes(this.Scopes)
+}
+
+func decodeScopes(scopesJSON []byte) []string {
+	if len(scopesJSON) == 0 {
+		return nil
+	}
+
+	var result = []string{}
+	err := json.Unmarshal(scopesJSON, &result)
+	if err != nil {
+		return nil
+	}
+	return result
+}
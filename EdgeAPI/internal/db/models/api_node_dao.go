@@ -6,8 +6,10 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
@@ -282,6 +284,35 @@ func (this *APINodeDAO) CountAllEnabledAndOnOfflineAPINodes(tx *dbs.Tx) (int64,
 		Count()
 }
 
+// FindAllActiveAPINodeIds 查找所有在线的API节点ID
+// 用于在多个API节点之间分配后台任务
+func (this *APINodeDAO) FindAllActiveAPINodeIds(tx *dbs.Tx) ([]int64, error) {
+	ones, err := this.Query(tx).
+		State(APINodeStateEnabled).
+		Attr("isOn", true).
+		Result("id", "status").
+		AscPk().
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// 在程序中判断状态，以便兼容不支持JSON函数的数据库
+	var minUpdatedAt = time.Now().Unix() - 90
+	var result = []int64{}
+	for _, one := range ones {
+		var node = one.(*APINode)
+		status, err := node.DecodeStatus()
+		if err != nil || status == nil {
+			continue
+		}
+		if status.IsActive && status.UpdatedAt >= minUpdatedAt {
+			result = append(result, int64(node.Id))
+		}
+	}
+	return result, nil
+}
+
 // ListEnabledAPINodes 列出单页的API节点
 func (this *APINodeDAO) ListEnabledAPINodes(tx *dbs.Tx, offset int64, size int64) (result []*APINode, err error) {
 	_, err = this.Query(tx).
@@ -325,6 +356,10 @@ func (this *APINodeDAO) FindEnabledAPINodeIdWithAddr(tx *dbs.Tx, protocol string
 
 // UpdateAPINodeStatus 设置API节点状态
 func (this *APINodeDAO) UpdateAPINodeStatus(tx *dbs.Tx, apiNodeId int64, statusJSON []byte) error {
+	if tx == nil && dbutils.SharedUpdateQueue.Set(this.Instance, this.Table, this.PkName, apiNodeId, map[string]any{"status": statusJSON}) {
+		return nil
+	}
+
 	_, err := this.Query(tx).
 		Pk(apiNodeId).
 		Set("status", statusJSON).
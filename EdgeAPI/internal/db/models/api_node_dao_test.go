@@ -45,6 +45,11 @@ func TestAPINodeDAO_ResetPrimaryAPINode(t *testing.T) {
 	t.Log(dao.ResetPrimaryAPINode(nil))
 }
 
+func TestAPINodeDAO_FindAllActiveAPINodeIds(t *testing.T) {
+	var dao = NewAPINodeDAO()
+	t.Log(dao.FindAllActiveAPINodeIds(nil))
+}
+
 func BenchmarkAPINodeDAO_New(b *testing.B) {
 	runtime.GOMAXPROCS(1)
 	for i := 0; i < b.N; i++ {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
 	"github.com/iwind/TeaGo/dbs"
 )
@@ -162,3 +163,16 @@ func (this *APINode) DecodeRestHTTPS(tx *dbs.Tx, cacheMap *utils.CacheMap) (*ser
 
 	return config, nil
 }
+
+// DecodeStatus 解析运行状态
+func (this *APINode) DecodeStatus() (*nodeconfigs.NodeStatus, error) {
+	if len(this.Status) == 0 {
+		return nil, nil
+	}
+	var status = &nodeconfigs.NodeStatus{}
+	err := json.Unmarshal(this.Status, status)
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
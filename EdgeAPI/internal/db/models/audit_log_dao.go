@@ -0,0 +1,141 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+type AuditLogDAO dbs.DAO
+
+func NewAuditLogDAO() *AuditLogDAO {
+	return dbs.NewDAO(&AuditLogDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeAuditLogs",
+			Model:  new(AuditLog),
+			PkName: "id",
+		},
+	}).(*AuditLogDAO)
+}
+
+var SharedAuditLogDAO *AuditLogDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedAuditLogDAO = NewAuditLogDAO()
+	})
+}
+
+// CreateAuditLog 创建审计日志
+// impersonatorAdminId 为模拟用户登录的管理员ID，不是模拟登录时为0
+func (this *AuditLogDAO) CreateAuditLog(tx *dbs.Tx, adminId int64, userId int64, impersonatorAdminId int64, method string, request string, isOk bool, errString string, costMs int64, ip string, isRest bool) error {
+	if len(errString) > 1024 {
+		errString = errString[:1024]
+	}
+
+	var op = NewAuditLogOperator()
+	op.AdminId = adminId
+	op.UserId = userId
+	op.ImpersonatorAdminId = impersonatorAdminId
+	op.Method = method
+	op.Request = request
+	op.IsOk = isOk
+	op.Error = errString
+	op.CostMs = costMs
+	op.Ip = ip
+	op.IsRest = isRest
+	op.Day = timeutil.Format("Ymd")
+	op.CreatedAt = time.Now().Unix()
+	return this.Save(tx, op)
+}
+
+// FindAuditLog 查找单条审计日志
+func (this *AuditLogDAO) FindAuditLog(tx *dbs.Tx, auditLogId int64) (*AuditLog, error) {
+	one, err := this.Query(tx).
+		Pk(auditLogId).
+		Find()
+	if err != nil || one == nil {
+		return nil, err
+	}
+	return one.(*AuditLog), nil
+}
+
+// CountAuditLogs 计算审计日志数量
+// result 可选值：ok, error，为空表示不限制
+func (this *AuditLogDAO) CountAuditLogs(tx *dbs.Tx, adminId int64, userId int64, method string, keyword string, result string, dayFrom string, dayTo string) (int64, error) {
+	return this.filterQuery(tx, adminId, userId, method, keyword, result, dayFrom, dayTo).
+		Count()
+}
+
+// ListAuditLogs 列出单页审计日志
+func (this *AuditLogDAO) ListAuditLogs(tx *dbs.Tx, adminId int64, userId int64, method string, keyword string, result string, dayFrom string, dayTo string, offset int64, size int64) (logs []*AuditLog, err error) {
+	_, err = this.filterQuery(tx, adminId, userId, method, keyword, result, dayFrom, dayTo).
+		Offset(offset).
+		Limit(size).
+		DescPk().
+		Slice(&logs).
+		FindAll()
+	return
+}
+
+// DeleteAuditLogsBeforeDays 物理删除某些天之前的审计日志
+func (this *AuditLogDAO) DeleteAuditLogsBeforeDays(tx *dbs.Tx, days int) error {
+	if days <= 0 {
+		days = 0
+	}
+	var untilDay = timeutil.Format("Ymd", time.Now().AddDate(0, 0, -days))
+	_, err := this.Query(tx).
+		Lte("day", untilDay).
+		Delete()
+	return err
+}
+
+// 构造查询条件
+func (this *AuditLogDAO) filterQuery(tx *dbs.Tx, adminId int64, userId int64, method string, keyword string, result string, dayFrom string, dayTo string) *dbs.Query {
+	var query = this.Query(tx)
+	if adminId > 0 {
+		query.Attr("adminId", adminId)
+	}
+	if userId > 0 {
+		query.Attr("userId", userId)
+	}
+	if len(method) > 0 {
+		query.Where("method LIKE :method").
+			Param("method", dbutils.QuoteLike(method))
+	}
+	if len(keyword) > 0 {
+		query.Where("(request LIKE :keyword OR error LIKE :keyword OR ip LIKE :keyword)").
+			Param("keyword", dbutils.QuoteLike(keyword))
+	}
+	switch result {
+	case "ok":
+		query.Attr("isOk", true)
+	case "error":
+		query.Attr("isOk", false)
+	}
+
+	dayFrom = this.formatDay(dayFrom)
+	dayTo = this.formatDay(dayTo)
+	if len(dayFrom) > 0 {
+		query.Gte("day", dayFrom)
+	}
+	if len(dayTo) > 0 {
+		query.Lte("day", dayTo)
+	}
+	return query
+}
+
+// 格式化日期
+func (this *AuditLogDAO) formatDay(day string) string {
+	if !regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`).MatchString(day) {
+		return ""
+	}
+	return strings.ReplaceAll(day, "-", "")
+}
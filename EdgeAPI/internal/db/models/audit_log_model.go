@@ -0,0 +1,38 @@
+package models
+
+// AuditLog RPC操作审计日志
+type AuditLog struct {
+	Id                  uint64 `field:"id"`                  // ID
+	AdminId             uint32 `field:"adminId"`             // 管理员ID
+	UserId              uint32 `field:"userId"`              // 用户ID
+	Method              string `field:"method"`              // RPC方法
+	Request             string `field:"request"`             // 请求摘要
+	IsOk                bool   `field:"isOk"`                // 是否成功
+	Error               string `field:"error"`               // 错误信息
+	CostMs              uint32 `field:"costMs"`              // 耗时（毫秒）
+	Ip                  string `field:"ip"`                  // 调用方IP
+	IsRest              bool   `field:"isRest"`              // 是否为REST调用
+	Day                 string `field:"day"`                 // 日期
+	CreatedAt           uint64 `field:"createdAt"`           // 创建时间
+	ImpersonatorAdminId uint32 `field:"impersonatorAdminId"` // 模拟用户登录的管理员ID
+}
+
+type AuditLogOperator struct {
+	Id                  interface{} // ID
+	AdminId             interface{} // 管理员ID
+	UserId              interface{} // 用户ID
+	Method              interface{} // RPC方法
+	Request             interface{} // 请求摘要
+	IsOk                interface{} // 是否成功
+	Error               interface{} // 错误信息
+	CostMs              interface{} // 耗时（毫秒）
+	Ip                  interface{} // 调用方IP
+	IsRest              interface{} // 是否为REST调用
+	Day                 interface{} // 日期
+	CreatedAt           interface{} // 创建时间
+	ImpersonatorAdminId interface{} // 模拟用户登录的管理员ID
+}
+
+func NewAuditLogOperator() *AuditLogOperator {
+	return &AuditLogOperator{}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
@@ -200,6 +201,10 @@ func (this *AuthorityNodeDAO) UpdateNodeStatus(tx *dbs.Tx, nodeId int64, nodeSta
 		return err
 	}
 
+	if tx == nil && dbutils.SharedUpdateQueue.Set(this.Instance, this.Table, this.PkName, nodeId, map[string]any{"status": nodeStatusJSON}) {
+		return nil
+	}
+
 	_, err = this.Query(tx).
 		Pk(nodeId).
 		Set("status", nodeStatusJSON).
@@ -0,0 +1,11 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models
+
+// DAO缓存的命名空间，参考 dbutils.SharedDAOCache
+const (
+	DAOCacheServer               = "server"
+	DAOCacheSSLPolicyConfig      = "sslPolicyConfig"
+	DAOCacheHTTPAuthPolicyConfig = "httpAuthPolicyConfig"
+	DAOCacheNodeConfig           = "nodeConfig"
+)
@@ -0,0 +1,158 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models
+
+import (
+	"strings"
+	"time"
+
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/iwind/TeaGo/dbs"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+// DBIndexAdvice 常用查询的索引检查结果
+type DBIndexAdvice struct {
+	Name         string                 // 查询名称
+	Description  string                 // 查询说明
+	Table        string                 // 表名
+	SQL          string                 // 查询语句
+	Columns      []string               // 需要的索引字段
+	HasIndex     bool                   // 是否已经有以这些字段开头的索引
+	Explain      *dbutils.ExplainResult // 查询计划
+	SuggestedSQL string                 // 建议执行的建索引语句
+	Error        string                 // 检查时的错误
+}
+
+// 需要检查的查询
+type dbIndexAdviceQuery struct {
+	name        string
+	description string
+	table       string
+	sql         string
+	args        []any
+	columns     []string
+}
+
+// 比较耗时的常用查询，和DAO中的查询条件保持一致
+func composeDBIndexAdviceQueries() []*dbIndexAdviceQuery {
+	var now = time.Now()
+	var today = timeutil.Format("Ymd", now)
+	var monthAgo = timeutil.Format("Ymd", now.AddDate(0, 0, -30))
+	var hourAgoMinute = timeutil.Format("YmdHi", now.Add(-1*time.Hour))
+
+	return []*dbIndexAdviceQuery{
+		{
+			name:        "acmeTaskList",
+			description: "证书申请任务列表",
+			table:       "edgeACMETasks",
+			sql:         "SELECT id FROM edgeACMETasks WHERE userId=? AND state=1 ORDER BY id DESC LIMIT 20",
+			args:        []any{0},
+			columns:     []string{"userId"},
+		},
+		{
+			name:        "acmeIssueTasks",
+			description: "查找需要签发的证书申请任务",
+			table:       "edgeACMETasks",
+			sql:         "SELECT id FROM edgeACMETasks WHERE isOn=1 AND async=1 AND state=1 AND (certId=0 OR isRenewing=1) ORDER BY priority DESC, id ASC LIMIT 10",
+			columns:     []string{"async"},
+		},
+		{
+			name:        "acmeExpiringCerts",
+			description: "按证书过期时间筛选证书申请任务",
+			table:       "edgeSSLCerts",
+			sql:         "SELECT id FROM edgeACMETasks WHERE userId=? AND state=1 AND certId IN (SELECT id FROM edgeSSLCerts WHERE timeEndAt>? AND timeEndAt<?) ORDER BY id DESC LIMIT 20",
+			args:        []any{0, now.Unix(), now.Unix() + 30*86400},
+			columns:     []string{"timeEndAt"},
+		},
+		{
+			name:        "serverDailyStats",
+			description: "服务在一段时间内的流量统计",
+			table:       "edgeServerDailyStats",
+			sql:         "SELECT SUM(bytes) FROM edgeServerDailyStats WHERE serverId=? AND day BETWEEN ? AND ?",
+			args:        []any{1, monthAgo, today},
+			columns:     []string{"serverId", "day"},
+		},
+		{
+			name:        "userDailyStats",
+			description: "用户在一段时间内的流量统计",
+			table:       "edgeServerDailyStats",
+			sql:         "SELECT SUM(bytes) FROM edgeServerDailyStats WHERE userId=? AND day BETWEEN ? AND ?",
+			args:        []any{1, monthAgo, today},
+			columns:     []string{"userId", "day"},
+		},
+		{
+			name:        "serverBandwidthStats",
+			description: "服务带宽统计",
+			table:       "edgeServerBandwidthStats_0",
+			sql:         "SELECT day, timeAt, bytes FROM edgeServerBandwidthStats_0 WHERE serverId=? AND day=? AND timeAt BETWEEN ? AND ? ORDER BY timeAt",
+			args:        []any{0, today, "0000", "2359"},
+			columns:     []string{"serverId", "day", "timeAt"},
+		},
+		{
+			name:        "nodeValues",
+			description: "节点最近的监控数据",
+			table:       "edgeNodeValues",
+			sql:         "SELECT id FROM edgeNodeValues WHERE role=? AND nodeId=? AND item=? AND minute>=?",
+			args:        []any{"node", 1, "cpu", hourAgoMinute},
+			columns:     []string{"nodeId", "role", "item"},
+		},
+		{
+			name:        "clusterNodeValues",
+			description: "集群最近的监控数据",
+			table:       "edgeNodeValues",
+			sql:         "SELECT id FROM edgeNodeValues WHERE role=? AND clusterId=? AND item=? AND minute>=?",
+			args:        []any{"node", 1, "cpu", hourAgoMinute},
+			columns:     []string{"clusterId"},
+		},
+	}
+}
+
+// AnalyzeDBIndexes 分析常用查询的执行计划和索引
+// 单个查询检查失败时只记录错误，不影响其他查询
+func AnalyzeDBIndexes(db *dbs.DB) []*DBIndexAdvice {
+	var result = []*DBIndexAdvice{}
+	var tableIndexes = map[string]map[string][]string{} // table => indexes
+	for _, query := range composeDBIndexAdviceQueries() {
+		var advice = &DBIndexAdvice{
+			Name:        query.name,
+			Description: query.description,
+			Table:       query.table,
+			SQL:         query.sql,
+			Columns:     query.columns,
+		}
+		result = append(result, advice)
+
+		indexes, ok := tableIndexes[query.table]
+		if !ok {
+			var err error
+			indexes, err = dbutils.FindTableIndexes(db, query.table)
+			if err != nil {
+				advice.Error = err.Error()
+				continue
+			}
+			tableIndexes[query.table] = indexes
+		}
+		advice.HasIndex = dbutils.HasIndexWithPrefix(indexes, query.columns)
+		if !advice.HasIndex {
+			advice.SuggestedSQL = composeDBIndexSQL(db, query.table, query.columns)
+		}
+
+		explain, err := dbutils.ExplainQuery(db, query.sql, query.args...)
+		if err != nil {
+			advice.Error = err.Error()
+			continue
+		}
+		advice.Explain = explain
+	}
+	return result
+}
+
+// 组合建索引语句
+func composeDBIndexSQL(db *dbs.DB, table string, columns []string) string {
+	var indexName = strings.Join(columns, "_")
+	if dbutils.IsSQLite(db) {
+		return "CREATE INDEX `" + table + "_" + indexName + "` ON `" + table + "` (`" + strings.Join(columns, "`, `") + "`)"
+	}
+	return "ALTER TABLE `" + table + "` ADD INDEX `" + indexName + "` (`" + strings.Join(columns, "`, `") + "`)"
+}
@@ -20,10 +20,11 @@ var accessLogDBMapping = map[int64]*dbs.DB{} // dbNodeId => DB
 var accessLogLocker = &sync.RWMutex{}
 
 type httpAccessLogDefinition struct {
-	Name          string
-	HasRemoteAddr bool
-	HasDomain     bool
-	Exists        bool
+	Name            string
+	HasRemoteAddr   bool
+	HasDomain       bool
+	HasSearchFields bool // 是否有全文检索字段
+	Exists          bool
 }
 
 // HTTP服务访问
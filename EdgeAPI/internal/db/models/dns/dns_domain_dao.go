@@ -159,6 +159,19 @@ func (this *DNSDomainDAO) CountAllEnabledDomainsWithProviderId(tx *dbs.Tx, provi
 		Count()
 }
 
+// CountAllEnabledRecordsWithUserId 计算用户所有域名中的解析记录数量
+func (this *DNSDomainDAO) CountAllEnabledRecordsWithUserId(tx *dbs.Tx, userId int64) (int64, error) {
+	if userId <= 0 {
+		return 0, nil
+	}
+	return this.Query(tx).
+		State(DNSDomainStateEnabled).
+		Attr("userId", userId).
+		Attr("isDeleted", false).
+		Where("JSON_TYPE(records)='ARRAY'").
+		SumInt64("JSON_LENGTH(records)", 0)
+}
+
 // UpdateDomainData 更新域名数据
 func (this *DNSDomainDAO) UpdateDomainData(tx *dbs.Tx, domainId int64, data string) error {
 	if domainId <= 0 {
@@ -0,0 +1,120 @@
+package dns
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/rands"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+type DNSProviderCallStatDAO dbs.DAO
+
+func init() {
+	dbs.OnReadyDone(func() {
+		// 清理数据任务
+		var ticker = time.NewTicker(time.Duration(rands.Int(24, 48)) * time.Hour)
+		goman.New(func() {
+			for range ticker.C {
+				err := SharedDNSProviderCallStatDAO.Clean(nil, 30) // 只保留N天
+				if err != nil {
+					remotelogs.Error("DNSProviderCallStatDAO", "clean expired data failed: "+err.Error())
+				}
+			}
+		})
+	})
+}
+
+func NewDNSProviderCallStatDAO() *DNSProviderCallStatDAO {
+	return dbs.NewDAO(&DNSProviderCallStatDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeDNSProviderCallStats",
+			Model:  new(DNSProviderCallStat),
+			PkName: "id",
+		},
+	}).(*DNSProviderCallStatDAO)
+}
+
+var SharedDNSProviderCallStatDAO *DNSProviderCallStatDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedDNSProviderCallStatDAO = NewDNSProviderCallStatDAO()
+	})
+}
+
+// IncreaseStat 增加调用统计
+func (this *DNSProviderCallStatDAO) IncreaseStat(tx *dbs.Tx, providerId int64, costMs float64, isOk bool) error {
+	if providerId <= 0 {
+		return nil
+	}
+
+	var countFailedCalls = 0
+	if !isOk {
+		countFailedCalls = 1
+	}
+
+	var day = timeutil.Format("Ymd")
+	return this.Query(tx).
+		Param("costMs", costMs).
+		Param("countFailedCalls", countFailedCalls).
+		InsertOrUpdateQuickly(map[string]any{
+			"providerId":       providerId,
+			"day":              day,
+			"countCalls":       1,
+			"countFailedCalls": countFailedCalls,
+			"costMs":           costMs,
+			"peekMs":           costMs,
+		}, map[string]any{
+			"costMs":           dbs.SQL("(costMs*countCalls+:costMs)/(countCalls+1)"),
+			"peekMs":           dbs.SQL("IF(peekMs>:costMs, peekMs, :costMs)"),
+			"countCalls":       dbs.SQL("countCalls+1"),
+			"countFailedCalls": dbs.SQL("countFailedCalls+:countFailedCalls"),
+		})
+}
+
+// FindStatsWithProviderId 查找某个服务商最近N天的统计
+func (this *DNSProviderCallStatDAO) FindStatsWithProviderId(tx *dbs.Tx, providerId int64, days int) (result []*DNSProviderCallStat, err error) {
+	if days <= 0 {
+		days = 7
+	}
+	var dayFrom = timeutil.Format("Ymd", time.Now().AddDate(0, 0, -days+1))
+	_, err = this.Query(tx).
+		Attr("providerId", providerId).
+		Gte("day", dayFrom).
+		Asc("day").
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// FindAllStatsWithDay 查找某天所有服务商的统计
+func (this *DNSProviderCallStatDAO) FindAllStatsWithDay(tx *dbs.Tx, day string) (result []*DNSProviderCallStat, err error) {
+	_, err = this.Query(tx).
+		Attr("day", day).
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// SumCallsWithDay 计算某个服务商某天的调用次数
+func (this *DNSProviderCallStatDAO) SumCallsWithDay(tx *dbs.Tx, providerId int64, day string) (int64, error) {
+	return this.Query(tx).
+		Attr("providerId", providerId).
+		Attr("day", day).
+		SumInt64("countCalls", 0)
+}
+
+// Clean 清理N天以前的数据
+func (this *DNSProviderCallStatDAO) Clean(tx *dbs.Tx, days int) error {
+	var day = timeutil.Format("Ymd", time.Now().AddDate(0, 0, -days))
+	_, err := this.Query(tx).
+		Lt("day", day).
+		Delete()
+	return err
+}
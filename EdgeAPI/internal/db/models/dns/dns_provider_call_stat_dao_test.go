@@ -0,0 +1,26 @@
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models/dns"
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/iwind/TeaGo/bootstrap"
+	"github.com/iwind/TeaGo/dbs"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+func TestDNSProviderCallStatDAO_IncreaseStat(t *testing.T) {
+	var dao = dns.NewDNSProviderCallStatDAO()
+	var tx *dbs.Tx
+	err := dao.IncreaseStat(tx, 1, 12.3, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := dao.SumCallsWithDay(tx, 1, timeutil.Format("Ymd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("count:", count)
+}
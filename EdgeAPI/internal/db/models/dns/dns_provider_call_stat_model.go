@@ -0,0 +1,26 @@
+package dns
+
+// DNSProviderCallStat DNS服务商API调用统计
+type DNSProviderCallStat struct {
+	Id               uint64  `field:"id"`               // ID
+	ProviderId       uint64  `field:"providerId"`       // 服务商ID
+	Day              string  `field:"day"`              // 日期YYYYMMDD
+	CountCalls       uint64  `field:"countCalls"`       // 调用次数
+	CountFailedCalls uint64  `field:"countFailedCalls"` // 失败次数
+	CostMs           float64 `field:"costMs"`           // 平均耗时Ms
+	PeekMs           float64 `field:"peekMs"`           // 峰值耗时Ms
+}
+
+type DNSProviderCallStatOperator struct {
+	Id               any // ID
+	ProviderId       any // 服务商ID
+	Day              any // 日期YYYYMMDD
+	CountCalls       any // 调用次数
+	CountFailedCalls any // 失败次数
+	CostMs           any // 平均耗时Ms
+	PeekMs           any // 峰值耗时Ms
+}
+
+func NewDNSProviderCallStatOperator() *DNSProviderCallStatOperator {
+	return &DNSProviderCallStatOperator{}
+}
@@ -116,6 +116,75 @@ func (this *DNSProviderDAO) UpdateDNSProvider(tx *dbs.Tx, dnsProviderId int64, n
 	return nil
 }
 
+// UpdateDNSProviderExternalId 设置服务商的外部ID
+func (this *DNSProviderDAO) UpdateDNSProviderExternalId(tx *dbs.Tx, dnsProviderId int64, externalId string) error {
+	if dnsProviderId <= 0 {
+		return errors.New("invalid dnsProviderId")
+	}
+
+	err := dbutils.ValidateExternalId(externalId)
+	if err != nil {
+		return err
+	}
+
+	if len(externalId) > 0 {
+		existProviderId, err := this.FindEnabledDNSProviderIdWithExternalId(tx, 0, externalId)
+		if err != nil {
+			return err
+		}
+		if existProviderId > 0 && existProviderId != dnsProviderId {
+			return errors.New("the externalId '" + externalId + "' is used by another dns provider '" + types.String(existProviderId) + "'")
+		}
+	}
+
+	_, err = this.Query(tx).
+		Pk(dnsProviderId).
+		Set("externalId", externalId).
+		Update()
+	return err
+}
+
+// FindEnabledDNSProviderIdWithExternalId 根据外部ID查找服务商ID
+func (this *DNSProviderDAO) FindEnabledDNSProviderIdWithExternalId(tx *dbs.Tx, userId int64, externalId string) (int64, error) {
+	if len(externalId) == 0 {
+		return 0, nil
+	}
+	var query = this.Query(tx).
+		Attr("state", DNSProviderStateEnabled).
+		Attr("externalId", externalId)
+	if userId > 0 {
+		query.Attr("userId", userId)
+	}
+	return query.
+		ResultPk().
+		FindInt64Col(0)
+}
+
+// FindEnabledDNSProviderIdsWithName 根据名称查找服务商ID
+func (this *DNSProviderDAO) FindEnabledDNSProviderIdsWithName(tx *dbs.Tx, userId int64, name string, size int64) (providerIds []int64, err error) {
+	if len(name) == 0 {
+		return nil, nil
+	}
+	var query = this.Query(tx).
+		Attr("state", DNSProviderStateEnabled).
+		Attr("name", name)
+	if userId > 0 {
+		query.Attr("userId", userId)
+	}
+	ones, err := query.
+		ResultPk().
+		AscPk().
+		Limit(size).
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range ones {
+		providerIds = append(providerIds, int64(one.(*DNSProvider).Id))
+	}
+	return
+}
+
 // CountAllEnabledDNSProviders 计算服务商数量
 func (this *DNSProviderDAO) CountAllEnabledDNSProviders(tx *dbs.Tx, adminId int64, userId int64, keyword string, domain string, providerType string) (int64, error) {
 	var query = dbutils.NewQuery(tx, this, adminId, userId)
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
@@ -39,14 +40,15 @@ var SharedHTTPAccessLogDAO *HTTPAccessLogDAO
 
 // 队列
 var (
-	oldAccessLogQueue       = make(chan *pb.HTTPAccessLog)
-	accessLogQueue          = make(chan *pb.HTTPAccessLog, 10_000)
-	accessLogQueueMaxLength = 100_000 // 队列最大长度
-	accessLogQueuePercent   = 100     // 0-100
-	accessLogCountPerSecond = 10_000  // 每秒钟写入条数，0 表示不限制
-	accessLogPerTx          = 100     // 单事务写入条数
-	accessLogConfigJSON     = []byte{}
-	accessLogQueueChanged   = make(chan zero.Zero, 1)
+	oldAccessLogQueue         = make(chan *pb.HTTPAccessLog)
+	accessLogQueue            = make(chan *pb.HTTPAccessLog, 10_000)
+	accessLogQueueMaxLength   = 100_000         // 队列最大长度
+	accessLogQueuePercent     = 100             // 0-100
+	accessLogCountPerSecond   = 10_000          // 每秒钟写入条数，0 表示不限制
+	accessLogPerTx            = 100             // 单事务写入条数
+	accessLogQueueWaitTimeout = 1 * time.Second // 队列已满时最长等待时间
+	accessLogConfigJSON       = []byte{}
+	accessLogQueueChanged     = make(chan zero.Zero, 1)
 
 	accessLogEnableAutoPartial       = true    // 是否启用自动分表
 	accessLogRowsPerTable      int64 = 500_000 // 自动分表的单表最大值
@@ -61,6 +63,7 @@ type accessLogTableQuery struct {
 	name               string
 	hasRemoteAddrField bool
 	hasDomainField     bool
+	hasSearchFields    bool
 }
 
 func init() {
@@ -135,6 +138,8 @@ func NewHTTPAccessLogDAO() *HTTPAccessLogDAO {
 func (this *HTTPAccessLogDAO) CreateHTTPAccessLogs(tx *dbs.Tx, accessLogs []*pb.HTTPAccessLog) error {
 	// 写入队列
 	var queue = accessLogQueue // 这样写非常重要，防止在写入过程中队列有切换
+	var timer *time.Timer
+	var isTimeout = false
 	for _, accessLog := range accessLogs {
 		if accessLog.FirewallPolicyId == 0 { // 如果是非WAF记录，则采取采样率
 			// 采样率
@@ -149,7 +154,19 @@ func (this *HTTPAccessLogDAO) CreateHTTPAccessLogs(tx *dbs.Tx, accessLogs []*pb.
 		select {
 		case queue <- accessLog:
 		default:
-			// 超出的丢弃
+			// 队列已满时等待写入，从而减慢节点上传的速度；超时后超出的丢弃
+			if isTimeout {
+				continue
+			}
+			if timer == nil {
+				timer = time.NewTimer(accessLogQueueWaitTimeout)
+				defer timer.Stop()
+			}
+			select {
+			case queue <- accessLog:
+			case <-timer.C:
+				isTimeout = true
+			}
 		}
 	}
 
@@ -200,15 +217,13 @@ func (this *HTTPAccessLogDAO) DumpAccessLogsFromQueue(size int) (hasMore bool, e
 
 	hasMore = true
 
+	var accessLogs = make([]*pb.HTTPAccessLog, 0, size)
 Loop:
 	for i := 0; i < size; i++ {
 		// old
 		select {
 		case accessLog := <-oldQueue:
-			err := this.CreateHTTPAccessLog(tx, dao.DAO, accessLog)
-			if err != nil {
-				return false, err
-			}
+			accessLogs = append(accessLogs, accessLog)
 			continue Loop
 		default:
 
@@ -217,10 +232,7 @@ Loop:
 		// new
 		select {
 		case accessLog := <-newQueue:
-			err := this.CreateHTTPAccessLog(tx, dao.DAO, accessLog)
-			if err != nil {
-				return false, err
-			}
+			accessLogs = append(accessLogs, accessLog)
 			continue Loop
 		default:
 			hasMore = false
@@ -228,87 +240,151 @@ Loop:
 		}
 	}
 
+	if len(accessLogs) > 0 {
+		err = this.CreateHTTPAccessLogsBatch(tx, dao.DAO, accessLogs)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	return hasMore, nil
 }
 
 // CreateHTTPAccessLog 写入单条访问日志
 func (this *HTTPAccessLogDAO) CreateHTTPAccessLog(tx *dbs.Tx, dao *HTTPAccessLogDAO, accessLog *pb.HTTPAccessLog) error {
-	var day string
-	// 注意：如果你修改了 TimeISO8601 的逻辑，这里也需要同步修改
-	if len(accessLog.TimeISO8601) > 10 {
-		day = strings.ReplaceAll(accessLog.TimeISO8601[:10], "-", "")
-	} else {
-		day = timeutil.FormatTime("Ymd", accessLog.Timestamp)
-	}
+	return this.CreateHTTPAccessLogsBatch(tx, dao, []*pb.HTTPAccessLog{accessLog})
+}
 
-	tableDef, err := SharedHTTPAccessLogManager.FindLastTable(dao.Instance, day, true)
-	if err != nil {
-		return err
+// CreateHTTPAccessLogsBatch 批量写入访问日志
+// 同一个分表中的访问日志使用一条多行INSERT语句写入
+func (this *HTTPAccessLogDAO) CreateHTTPAccessLogsBatch(tx *dbs.Tx, dao *HTTPAccessLogDAO, accessLogs []*pb.HTTPAccessLog) error {
+	type tableBatch struct {
+		day      string
+		tableDef *httpAccessLogDefinition
+		rows     [][]any
 	}
 
-	var fields = map[string]any{}
-	fields["serverId"] = accessLog.ServerId
-	fields["nodeId"] = accessLog.NodeId
-	fields["status"] = accessLog.Status
-	fields["createdAt"] = accessLog.Timestamp
-	fields["requestId"] = accessLog.RequestId
-	fields["firewallPolicyId"] = accessLog.FirewallPolicyId
-	fields["firewallRuleGroupId"] = accessLog.FirewallRuleGroupId
-	fields["firewallRuleSetId"] = accessLog.FirewallRuleSetId
-	fields["firewallRuleId"] = accessLog.FirewallRuleId
+	var batches = []*tableBatch{}
+	var batchMap = map[string]*tableBatch{} // table name => batch
+	for _, accessLog := range accessLogs {
+		var day string
+		// 注意：如果你修改了 TimeISO8601 的逻辑，这里也需要同步修改
+		if len(accessLog.TimeISO8601) > 10 {
+			day = strings.ReplaceAll(accessLog.TimeISO8601[:10], "-", "")
+		} else {
+			day = timeutil.FormatTime("Ymd", accessLog.Timestamp)
+		}
 
-	if len(accessLog.RequestBody) > 0 {
-		fields["requestBody"] = accessLog.RequestBody
-		accessLog.RequestBody = nil
-	}
+		tableDef, err := SharedHTTPAccessLogManager.FindLastTable(dao.Instance, day, true)
+		if err != nil {
+			return err
+		}
 
-	if tableDef.HasRemoteAddr {
-		fields["remoteAddr"] = accessLog.RemoteAddr
-	}
-	if tableDef.HasDomain {
-		if len(accessLog.Host) > 128 {
-			fields["domain"] = accessLog.Host[:128]
-		} else {
-			fields["domain"] = accessLog.Host
+		values, err := this.composeAccessLogValues(tableDef, accessLog)
+		if err != nil {
+			return err
 		}
+
+		batch, ok := batchMap[tableDef.Name]
+		if !ok {
+			batch = &tableBatch{
+				day:      day,
+				tableDef: tableDef,
+			}
+			batchMap[tableDef.Name] = batch
+			batches = append(batches, batch)
+		}
+		batch.rows = append(batch.rows, values)
 	}
 
-	content, err := json.Marshal(accessLog)
-	if err != nil {
-		return err
+	var executor dbutils.SQLExecutor = dao.Instance
+	if tx != nil {
+		executor = tx
 	}
-	fields["content"] = content
 
-	var lastId int64
-	lastId, err = dao.Query(tx).
-		Table(tableDef.Name).
-		Sets(fields).
-		Insert()
-	if err != nil {
-		// 错误重试
-		if CheckSQLErrCode(err, 1146) { // Error 1146: Table 'xxx' doesn't exist
-			err = SharedHTTPAccessLogManager.CreateTable(dao.Instance, tableDef.Name)
+	for _, batch := range batches {
+		var columns = this.composeAccessLogColumns(batch.tableDef)
+		lastId, err := dbutils.BatchInsert(executor, batch.tableDef.Name, columns, batch.rows, "")
+		if err != nil {
+			// 错误重试
+			if CheckSQLErrCode(err, 1146) { // Error 1146: Table 'xxx' doesn't exist
+				err = SharedHTTPAccessLogManager.CreateTable(dao.Instance, batch.tableDef.Name)
+				if err != nil {
+					return err
+				}
+
+				// 重新尝试
+				lastId, err = dbutils.BatchInsert(executor, batch.tableDef.Name, columns, batch.rows, "")
+			}
+
 			if err != nil {
 				return err
 			}
-
-			// 重新尝试
-			lastId, err = dao.Query(tx).
-				Table(tableDef.Name).
-				Sets(fields).
-				Insert()
 		}
 
-		if err != nil {
-			return err
+		if accessLogEnableAutoPartial && accessLogRowsPerTable > 0 && lastId >= accessLogRowsPerTable {
+			SharedHTTPAccessLogManager.ResetTable(dao.Instance, batch.day)
 		}
 	}
 
-	if accessLogEnableAutoPartial && accessLogRowsPerTable > 0 && lastId >= accessLogRowsPerTable {
-		SharedHTTPAccessLogManager.ResetTable(dao.Instance, day)
+	return nil
+}
+
+// 访问日志表中需要写入的字段
+func (this *HTTPAccessLogDAO) composeAccessLogColumns(tableDef *httpAccessLogDefinition) []string {
+	var columns = []string{"serverId", "nodeId", "status", "createdAt", "requestId", "firewallPolicyId", "firewallRuleGroupId", "firewallRuleSetId", "firewallRuleId", "requestBody"}
+	if tableDef.HasRemoteAddr {
+		columns = append(columns, "remoteAddr")
 	}
+	if tableDef.HasDomain {
+		columns = append(columns, "domain")
+	}
+	if tableDef.HasSearchFields {
+		columns = append(columns, "requestURI", "userAgent")
+	}
+	return append(columns, "content")
+}
 
-	return nil
+// 访问日志字段值，和 composeAccessLogColumns() 中的字段一一对应
+func (this *HTTPAccessLogDAO) composeAccessLogValues(tableDef *httpAccessLogDefinition, accessLog *pb.HTTPAccessLog) ([]any, error) {
+	var requestBody []byte
+	if len(accessLog.RequestBody) > 0 {
+		requestBody = accessLog.RequestBody
+		accessLog.RequestBody = nil
+	}
+
+	var values = []any{
+		accessLog.ServerId,
+		accessLog.NodeId,
+		accessLog.Status,
+		accessLog.Timestamp,
+		accessLog.RequestId,
+		accessLog.FirewallPolicyId,
+		accessLog.FirewallRuleGroupId,
+		accessLog.FirewallRuleSetId,
+		accessLog.FirewallRuleId,
+		requestBody,
+	}
+
+	if tableDef.HasRemoteAddr {
+		values = append(values, accessLog.RemoteAddr)
+	}
+	if tableDef.HasDomain {
+		if len(accessLog.Host) > 128 {
+			values = append(values, accessLog.Host[:128])
+		} else {
+			values = append(values, accessLog.Host)
+		}
+	}
+	if tableDef.HasSearchFields {
+		values = append(values, limitAccessLogSearchField(accessLog.RequestURI, 1024), limitAccessLogSearchField(accessLog.UserAgent, 512))
+	}
+
+	content, err := json.Marshal(accessLog)
+	if err != nil {
+		return nil, err
+	}
+	return append(values, content), nil
 }
 
 // ListAccessLogs 读取往前的 单页访问日志
@@ -331,7 +407,10 @@ func (this *HTTPAccessLogDAO) ListAccessLogs(tx *dbs.Tx,
 	userId int64,
 	keyword string,
 	ip string,
-	domain string) (result []*HTTPAccessLog, nextLastRequestId string, hasMore bool, err error) {
+	domain string,
+	requestURIKeyword string,
+	userAgentKeyword string,
+	remoteAddrKeyword string) (result []*HTTPAccessLog, nextLastRequestId string, hasMore bool, err error) {
 	if len(day) != 8 {
 		return
 	}
@@ -341,12 +420,12 @@ func (this *HTTPAccessLogDAO) ListAccessLogs(tx *dbs.Tx,
 		size = 1000
 	}
 
-	result, nextLastRequestId, err = this.listAccessLogs(tx, partition, lastRequestId, size, day, hourFrom, hourTo, clusterId, nodeId, serverId, reverse, hasError, firewallPolicyId, firewallRuleGroupId, firewallRuleSetId, hasFirewallPolicy, userId, keyword, ip, domain)
+	result, nextLastRequestId, err = this.listAccessLogs(tx, partition, lastRequestId, size, day, hourFrom, hourTo, clusterId, nodeId, serverId, reverse, hasError, firewallPolicyId, firewallRuleGroupId, firewallRuleSetId, hasFirewallPolicy, userId, keyword, ip, domain, requestURIKeyword, userAgentKeyword, remoteAddrKeyword)
 	if err != nil || int64(len(result)) < size {
 		return
 	}
 
-	moreResult, _, _ := this.listAccessLogs(tx, partition, nextLastRequestId, 1, day, hourFrom, hourTo, clusterId, nodeId, serverId, reverse, hasError, firewallPolicyId, firewallRuleGroupId, firewallRuleSetId, hasFirewallPolicy, userId, keyword, ip, domain)
+	moreResult, _, _ := this.listAccessLogs(tx, partition, nextLastRequestId, 1, day, hourFrom, hourTo, clusterId, nodeId, serverId, reverse, hasError, firewallPolicyId, firewallRuleGroupId, firewallRuleSetId, hasFirewallPolicy, userId, keyword, ip, domain, requestURIKeyword, userAgentKeyword, remoteAddrKeyword)
 	hasMore = len(moreResult) > 0
 	return
 }
@@ -371,7 +450,10 @@ func (this *HTTPAccessLogDAO) listAccessLogs(tx *dbs.Tx,
 	userId int64,
 	keyword string,
 	ip string,
-	domain string) (result []*HTTPAccessLog, nextLastRequestId string, err error) {
+	domain string,
+	requestURIKeyword string,
+	userAgentKeyword string,
+	remoteAddrKeyword string) (result []*HTTPAccessLog, nextLastRequestId string, err error) {
 	if size <= 0 {
 		return nil, lastRequestId, nil
 	}
@@ -436,6 +518,7 @@ func (this *HTTPAccessLogDAO) listAccessLogs(tx *dbs.Tx,
 			name:               def.Name,
 			hasRemoteAddrField: def.HasRemoteAddr,
 			hasDomainField:     def.HasDomain,
+			hasSearchFields:    def.HasSearchFields,
 		})
 	}
 
@@ -476,20 +559,21 @@ func (this *HTTPAccessLogDAO) listAccessLogs(tx *dbs.Tx,
 			defer wg.Done()
 
 			var dao = tableQuery.daoWrapper.DAO
-			var query = dao.Query(tx)
+			var query = dbutils.NewReadQuery(tx, dao)
 			query.Result("id", "serverId", "nodeId", "status", "createdAt", "content", "requestId", "firewallPolicyId", "firewallRuleGroupId", "firewallRuleSetId", "firewallRuleId", "remoteAddr", "domain")
 
+			// 使用全文索引时不能指定其他索引
+			var fullTextQuery = ""
+			if tableQuery.hasSearchFields {
+				fullTextQuery = composeAccessLogFullTextQuery(requestURIKeyword, userAgentKeyword, remoteAddrKeyword)
+			}
+
 			// 条件
 			if nodeId > 0 {
 				query.Attr("nodeId", nodeId)
 			} else if clusterId > 0 {
 				if len(nodeIds) > 0 {
-					var nodeIdStrings = []string{}
-					for _, subNodeId := range nodeIds {
-						nodeIdStrings = append(nodeIdStrings, types.String(subNodeId))
-					}
-
-					query.Where("nodeId IN (" + strings.Join(nodeIdStrings, ",") + ")")
+					dbutils.WhereIn(query, "nodeId", "nodeIds", nodeIds)
 					query.Reuse(false)
 				} else {
 					// 如果没有节点，则直接返回空
@@ -516,7 +600,9 @@ func (this *HTTPAccessLogDAO) listAccessLogs(tx *dbs.Tx,
 			}
 			if hasFirewallPolicy {
 				query.Where("firewallPolicyId>0")
-				query.UseIndex("firewallPolicyId")
+				if len(fullTextQuery) == 0 {
+					query.UseIndex("firewallPolicyId")
+				}
 			}
 
 			// keyword
@@ -549,7 +635,9 @@ func (this *HTTPAccessLogDAO) listAccessLogs(tx *dbs.Tx,
 						ip = strings.Trim(ip, "[]")
 
 						query.Attr("remoteAddr", ip)
-						query.UseIndex("remoteAddr")
+						if len(fullTextQuery) == 0 {
+							query.UseIndex("remoteAddr")
+						}
 					}
 				} else {
 					query.Where("JSON_EXTRACT(content, '$.remoteAddr')=:ip1").
@@ -573,7 +661,9 @@ func (this *HTTPAccessLogDAO) listAccessLogs(tx *dbs.Tx,
 						}
 
 						query.Attr("domain", domain)
-						query.UseIndex("domain")
+						if len(fullTextQuery) == 0 {
+							query.UseIndex("domain")
+						}
 					}
 				} else {
 					query.Where("JSON_EXTRACT(content, '$.host')=:host1").
@@ -581,6 +671,42 @@ func (this *HTTPAccessLogDAO) listAccessLogs(tx *dbs.Tx,
 				}
 			}
 
+			// 分字段检索
+			if len(requestURIKeyword) > 0 || len(userAgentKeyword) > 0 || len(remoteAddrKeyword) > 0 {
+				if tableQuery.hasSearchFields {
+					// 先使用全文索引缩小范围，再用LIKE精确匹配字段
+					if len(fullTextQuery) > 0 {
+						query.Where("MATCH(requestURI, userAgent, remoteAddr) AGAINST(:fullTextQuery IN BOOLEAN MODE)").
+							Param("fullTextQuery", fullTextQuery)
+					}
+					if len(requestURIKeyword) > 0 {
+						query.Where("requestURI LIKE :requestURIKeyword").
+							Param("requestURIKeyword", dbutils.QuoteLike(requestURIKeyword))
+					}
+					if len(userAgentKeyword) > 0 {
+						query.Where("userAgent LIKE :userAgentKeyword").
+							Param("userAgentKeyword", dbutils.QuoteLike(userAgentKeyword))
+					}
+				} else {
+					if len(requestURIKeyword) > 0 {
+						query.Where("JSON_EXTRACT(content, '$.requestURI') LIKE :requestURIKeyword").
+							Param("requestURIKeyword", dbutils.QuoteLike(requestURIKeyword))
+					}
+					if len(userAgentKeyword) > 0 {
+						query.Where("JSON_EXTRACT(content, '$.userAgent') LIKE :userAgentKeyword").
+							Param("userAgentKeyword", dbutils.QuoteLike(userAgentKeyword))
+					}
+				}
+				if len(remoteAddrKeyword) > 0 {
+					if tableQuery.hasRemoteAddrField {
+						query.Where("remoteAddr LIKE :remoteAddrKeyword")
+					} else {
+						query.Where("JSON_EXTRACT(content, '$.remoteAddr') LIKE :remoteAddrKeyword")
+					}
+					query.Param("remoteAddrKeyword", dbutils.QuoteLike(remoteAddrKeyword))
+				}
+			}
+
 			if len(keyword) > 0 {
 				var isSpecialKeyword = false
 
@@ -773,6 +899,33 @@ func (this *HTTPAccessLogDAO) listAccessLogs(tx *dbs.Tx,
 	}
 }
 
+// 组合全文检索的查询语句，每个关键词都必须匹配
+// ngram解析器会把短语拆分成片段，短于片段长度（默认为2）的关键词无法使用全文索引
+func composeAccessLogFullTextQuery(keywords ...string) string {
+	var pieces = []string{}
+	for _, keyword := range keywords {
+		// 去除双引号，防止破坏短语语法
+		keyword = strings.TrimSpace(strings.ReplaceAll(keyword, "\"", ""))
+		if utf8.RuneCountInString(keyword) < 2 {
+			continue
+		}
+		pieces = append(pieces, "+\""+keyword+"\"")
+	}
+	return strings.Join(pieces, " ")
+}
+
+// 截取检索字段内容，防止超出字段长度
+func limitAccessLogSearchField(value string, maxLength int) string {
+	if len(value) <= maxLength {
+		return value
+	}
+	value = value[:maxLength]
+	for len(value) > 0 && !utf8.ValidString(value) {
+		value = value[:len(value)-1]
+	}
+	return value
+}
+
 // FindAccessLogWithRequestId 根据请求ID获取访问日志
 func (this *HTTPAccessLogDAO) FindAccessLogWithRequestId(tx *dbs.Tx, requestId string) (*HTTPAccessLog, error) {
 	if !regexp.MustCompile(`^\d{11,}`).MatchString(requestId) {
@@ -821,7 +974,7 @@ func (this *HTTPAccessLogDAO) FindAccessLogWithRequestId(tx *dbs.Tx, requestId s
 			defer wg.Done()
 
 			var dao = tableQuery.daoWrapper.DAO
-			one, err := dao.Query(tx).
+			one, err := dbutils.NewReadQuery(tx, dao).
 				Table(tableQuery.name).
 				Attr("requestId", requestId).
 				Find()
@@ -3,8 +3,11 @@
 package models
 
 import (
+	"context"
 	"fmt"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
 	"github.com/iwind/TeaGo/dbs"
 	"github.com/iwind/TeaGo/lists"
 	"github.com/iwind/TeaGo/types"
@@ -23,13 +26,16 @@ var SharedHTTPAccessLogManager = NewHTTPAccessLogManager()
 
 type HTTPAccessLogManager struct {
 	currentTableMapping map[string]*httpAccessLogDefinition // dsn => def
+	tableFieldsMapping  map[string]*httpAccessLogDefinition // dsn@tableName => def
 
-	locker sync.Mutex
+	locker       sync.Mutex
+	fieldsLocker sync.Mutex
 }
 
 func NewHTTPAccessLogManager() *HTTPAccessLogManager {
 	return &HTTPAccessLogManager{
 		currentTableMapping: map[string]*httpAccessLogDefinition{},
+		tableFieldsMapping:  map[string]*httpAccessLogDefinition{},
 	}
 }
 
@@ -78,12 +84,6 @@ func (this *HTTPAccessLogManager) FindTables(db *dbs.DB, day string) ([]*httpAcc
 	var results = []*httpAccessLogDefinition{}
 	var tableNames = []string{}
 
-	config, err := db.Config()
-	if err != nil {
-		return nil, err
-	}
-	var cachePrefix = config.Dsn
-
 	// 需要防止用户设置了表名自动小写
 	for _, prefix := range []string{"edgeHTTPAccessLogs_" + day + "%", "edgehttpaccesslogs_" + day + "%"} {
 		ones, columnNames, err := db.FindPreparedOnes(`SHOW TABLES LIKE '` + prefix + `'`)
@@ -100,44 +100,15 @@ func (this *HTTPAccessLogManager) FindTables(db *dbs.DB, day string) ([]*httpAcc
 				continue
 			}
 
-			if accessLogTableMainReg.MatchString(tableName) {
+			if accessLogTableMainReg.MatchString(tableName) || accessLogTablePartialReg.MatchString(tableName) {
 				tableNames = append(tableNames, tableName)
 
-				// 查找已有的表格信息，避免SHOW FIELDS
-				var tableDay = tableName[strings.LastIndex(tableName, "_")+1:]
-				var cacheKey = this.composeTableCacheKey(cachePrefix, tableDay)
-				this.locker.Lock()
-				currentTableDef, ok := this.currentTableMapping[cacheKey]
-				this.locker.Unlock()
-				if ok {
-					results = append(results, &httpAccessLogDefinition{
-						Name:          tableName,
-						HasRemoteAddr: currentTableDef.HasRemoteAddr,
-						HasDomain:     currentTableDef.HasDomain,
-						Exists:        true,
-					})
-				} else {
-					hasRemoteAddrField, hasDomainField, err := this.checkTableFields(db, tableName)
-					if err != nil {
-						return nil, err
-					}
-
-					results = append(results, &httpAccessLogDefinition{
-						Name:          tableName,
-						HasRemoteAddr: hasRemoteAddrField,
-						HasDomain:     hasDomainField,
-						Exists:        true,
-					})
+				// 字段检查结果有缓存，不会每次都执行SHOW FIELDS
+				def, err := this.checkTableFields(db, tableName)
+				if err != nil {
+					return nil, err
 				}
-			} else if accessLogTablePartialReg.MatchString(tableName) {
-				tableNames = append(tableNames, tableName)
-
-				results = append(results, &httpAccessLogDefinition{
-					Name:          tableName,
-					HasRemoteAddr: true,
-					HasDomain:     true,
-					Exists:        true,
-				})
+				results = append(results, def)
 			}
 		}
 	}
@@ -174,16 +145,11 @@ func (this *HTTPAccessLogManager) FindPartitionTable(db *dbs.DB, day string, par
 		tableNames = []string{"edgeHTTPAccessLogs_" + day + "_" + fmt.Sprintf("%04d", partition), "edgehttpaccesslogs_" + day + "_" + fmt.Sprintf("%04d", partition)}
 	}
 	for _, tableName := range tableNames {
-		hasRemoteField, hasDomainField, err := this.checkTableFields(db, tableName)
+		def, err := this.checkTableFields(db, tableName)
 		if err != nil {
 			continue
 		}
-		return &httpAccessLogDefinition{
-			Name:          tableName,
-			HasRemoteAddr: hasRemoteField,
-			HasDomain:     hasDomainField,
-			Exists:        true,
-		}, nil
+		return def, nil
 	}
 
 	return &httpAccessLogDefinition{
@@ -239,7 +205,22 @@ func (this *HTTPAccessLogManager) FindLastTable(db *dbs.DB, day string, force bo
 
 // CreateTable 创建访问日志表格
 func (this *HTTPAccessLogManager) CreateTable(db *dbs.DB, tableName string) error {
-	_, err := db.Exec("CREATE TABLE `" + tableName + "` (\n  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT 'ID',\n  `serverId` int(11) unsigned DEFAULT '0' COMMENT '服务ID',\n  `nodeId` int(11) unsigned DEFAULT '0' COMMENT '节点ID',\n  `status` int(3) unsigned DEFAULT '0' COMMENT '状态码',\n  `createdAt` bigint(11) unsigned DEFAULT '0' COMMENT '创建时间',\n  `content` json DEFAULT NULL COMMENT '日志内容',\n  `requestId` varchar(128) DEFAULT NULL COMMENT '请求ID',\n  `firewallPolicyId` int(11) unsigned DEFAULT '0' COMMENT 'WAF策略ID',\n  `firewallRuleGroupId` int(11) unsigned DEFAULT '0' COMMENT 'WAF分组ID',\n  `firewallRuleSetId` int(11) unsigned DEFAULT '0' COMMENT 'WAF集ID',\n  `firewallRuleId` int(11) unsigned DEFAULT '0' COMMENT 'WAF规则ID',\n  `remoteAddr` varchar(64) DEFAULT NULL COMMENT 'IP地址',\n  `domain` varchar(255) DEFAULT NULL COMMENT '域名',\n  `requestBody` mediumblob COMMENT '请求内容',\n  `responseBody` mediumblob COMMENT '响应内容',\n  PRIMARY KEY (`id`),\n  KEY `serverId` (`serverId`),\n  KEY `nodeId` (`nodeId`),\n  KEY `serverId_status` (`serverId`,`status`),\n  KEY `requestId` (`requestId`),\n  KEY `firewallPolicyId` (`firewallPolicyId`),\n  KEY `firewallRuleGroupId` (`firewallRuleGroupId`),\n  KEY `firewallRuleSetId` (`firewallRuleSetId`),\n  KEY `firewallRuleId` (`firewallRuleId`),\n  KEY `remoteAddr` (`remoteAddr`),\n  KEY `domain` (`domain`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='访问日志';")
+	if dbutils.IsSQLite(db) {
+		return this.createSQLiteTable(db, tableName)
+	}
+
+	// 带有全文索引的表格
+	if this.isFullTextSearchOn() {
+		err := this.createFullTextTable(db, tableName)
+		if err == nil || CheckSQLErrCode(err, 1050) { // Error 1050: Table 'xxx' already exists
+			return nil
+		}
+
+		// 比如MariaDB不支持ngram解析器
+		remotelogs.Warn("HTTP_ACCESS_LOG", "create table '"+tableName+"' with full-text index failed, create it without full-text index: "+err.Error())
+	}
+
+	_, err := db.Exec(this.composeMySQLTableSQL(tableName, false))
 	if err != nil {
 		if CheckSQLErrCode(err, 1050) { // Error 1050: Table 'xxx' already exists
 			return nil
@@ -251,6 +232,65 @@ func (this *HTTPAccessLogManager) CreateTable(db *dbs.DB, tableName string) erro
 	return nil
 }
 
+// 创建带有全文索引的表格
+// 使用ngram解析器以便支持URL、IP等没有分隔符的内容，同时需要在同一个连接中关闭停止词，否则包含停止词的片段不会被索引
+func (this *HTTPAccessLogManager) createFullTextTable(db *dbs.DB, tableName string) error {
+	var ctx = context.Background()
+	conn, err := db.Raw().Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	_, err = conn.ExecContext(ctx, "SET SESSION innodb_ft_enable_stopword=OFF")
+	if err != nil {
+		return err
+	}
+	_, err = conn.ExecContext(ctx, this.composeMySQLTableSQL(tableName, true))
+	return err
+}
+
+// 组合MySQL建表语句
+func (this *HTTPAccessLogManager) composeMySQLTableSQL(tableName string, withFullText bool) string {
+	var searchFields = ""
+	var searchIndexes = ""
+	if withFullText {
+		searchFields = "  `requestURI` varchar(1024) DEFAULT NULL COMMENT '请求URI',\n  `userAgent` varchar(512) DEFAULT NULL COMMENT '终端信息',\n"
+		searchIndexes = ",\n  FULLTEXT KEY `requestURI_userAgent_remoteAddr` (`requestURI`,`userAgent`,`remoteAddr`) WITH PARSER ngram"
+	}
+	return "CREATE TABLE `" + tableName + "` (\n  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT 'ID',\n  `serverId` int(11) unsigned DEFAULT '0' COMMENT '服务ID',\n  `nodeId` int(11) unsigned DEFAULT '0' COMMENT '节点ID',\n  `status` int(3) unsigned DEFAULT '0' COMMENT '状态码',\n  `createdAt` bigint(11) unsigned DEFAULT '0' COMMENT '创建时间',\n  `content` json DEFAULT NULL COMMENT '日志内容',\n  `requestId` varchar(128) DEFAULT NULL COMMENT '请求ID',\n  `firewallPolicyId` int(11) unsigned DEFAULT '0' COMMENT 'WAF策略ID',\n  `firewallRuleGroupId` int(11) unsigned DEFAULT '0' COMMENT 'WAF分组ID',\n  `firewallRuleSetId` int(11) unsigned DEFAULT '0' COMMENT 'WAF集ID',\n  `firewallRuleId` int(11) unsigned DEFAULT '0' COMMENT 'WAF规则ID',\n  `remoteAddr` varchar(64) DEFAULT NULL COMMENT 'IP地址',\n  `domain` varchar(255) DEFAULT NULL COMMENT '域名',\n" + searchFields + "  `requestBody` mediumblob COMMENT '请求内容',\n  `responseBody` mediumblob COMMENT '响应内容',\n  PRIMARY KEY (`id`),\n  KEY `serverId` (`serverId`),\n  KEY `nodeId` (`nodeId`),\n  KEY `serverId_status` (`serverId`,`status`),\n  KEY `requestId` (`requestId`),\n  KEY `firewallPolicyId` (`firewallPolicyId`),\n  KEY `firewallRuleGroupId` (`firewallRuleGroupId`),\n  KEY `firewallRuleSetId` (`firewallRuleSetId`),\n  KEY `firewallRuleId` (`firewallRuleId`),\n  KEY `remoteAddr` (`remoteAddr`),\n  KEY `domain` (`domain`)" + searchIndexes + "\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='访问日志';"
+}
+
+// 是否开启了全文检索
+func (this *HTTPAccessLogManager) isFullTextSearchOn() bool {
+	if SharedSysSettingDAO == nil {
+		return false
+	}
+	config, err := SharedSysSettingDAO.ReadDatabaseConfig(nil)
+	if err != nil {
+		remotelogs.Error("HTTP_ACCESS_LOG", "read database config failed: "+err.Error())
+		return false
+	}
+	return config.ServerAccessLog.FullTextSearch.IsOn
+}
+
+// 在SQLite中创建访问日志表格
+func (this *HTTPAccessLogManager) createSQLiteTable(db *dbs.DB, tableName string) error {
+	var sqlStrings = []string{"CREATE TABLE IF NOT EXISTS `" + tableName + "` (\n  `id` INTEGER PRIMARY KEY AUTOINCREMENT,\n  `serverId` INTEGER DEFAULT '0',\n  `nodeId` INTEGER DEFAULT '0',\n  `status` INTEGER DEFAULT '0',\n  `createdAt` INTEGER DEFAULT '0',\n  `content` TEXT,\n  `requestId` TEXT,\n  `firewallPolicyId` INTEGER DEFAULT '0',\n  `firewallRuleGroupId` INTEGER DEFAULT '0',\n  `firewallRuleSetId` INTEGER DEFAULT '0',\n  `firewallRuleId` INTEGER DEFAULT '0',\n  `remoteAddr` TEXT,\n  `domain` TEXT,\n  `requestBody` BLOB,\n  `responseBody` BLOB\n)"}
+	for _, index := range [][]string{{"serverId"}, {"nodeId"}, {"serverId", "status"}, {"requestId"}, {"firewallPolicyId"}, {"firewallRuleGroupId"}, {"firewallRuleSetId"}, {"firewallRuleId"}, {"remoteAddr"}, {"domain"}} {
+		sqlStrings = append(sqlStrings, "CREATE INDEX IF NOT EXISTS `"+tableName+"_"+strings.Join(index, "_")+"` ON `"+tableName+"` (`"+strings.Join(index, "`,`")+"`)")
+	}
+	for _, sqlString := range sqlStrings {
+		_, err := db.Exec(sqlString)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ResetTable 清除某个数据库表名缓存
 func (this *HTTPAccessLogManager) ResetTable(db *dbs.DB, day string) {
 	this.locker.Lock()
@@ -333,12 +373,8 @@ func (this *HTTPAccessLogManager) findTableWithoutCache(db *dbs.DB, day string,
 				return nil, err
 			}
 
-			return &httpAccessLogDefinition{
-				Name:          prefix,
-				HasRemoteAddr: true,
-				HasDomain:     true,
-				Exists:        true,
-			}, nil
+			// 是否有全文检索字段取决于创建时的设置
+			return this.checkTableFields(db, prefix)
 		}
 
 		return &httpAccessLogDefinition{
@@ -351,16 +387,7 @@ func (this *HTTPAccessLogManager) findTableWithoutCache(db *dbs.DB, day string,
 
 	var lastTableName = tableNames[len(tableNames)-1]
 	if !force || !accessLogEnableAutoPartial || accessLogRowsPerTable <= 0 {
-		hasRemoteAddrField, hasDomainField, err := this.checkTableFields(db, lastTableName)
-		if err != nil {
-			return nil, err
-		}
-		return &httpAccessLogDefinition{
-			Name:          lastTableName,
-			HasRemoteAddr: hasRemoteAddrField,
-			HasDomain:     hasDomainField,
-			Exists:        true,
-		}, nil
+		return this.checkTableFields(db, lastTableName)
 	}
 
 	// 检查是否生成下个分表
@@ -392,44 +419,57 @@ func (this *HTTPAccessLogManager) findTableWithoutCache(db *dbs.DB, day string,
 				return nil, err
 			}
 
-			return &httpAccessLogDefinition{
-				Name:          nextTableName,
-				HasRemoteAddr: true,
-				HasDomain:     true,
-				Exists:        true,
-			}, nil
+			return this.checkTableFields(db, nextTableName)
 		}
 	}
 
 	// 检查字段
-	hasRemoteAddrField, hasDomainField, err := this.checkTableFields(db, lastTableName)
+	return this.checkTableFields(db, lastTableName)
+}
+
+// 检查表格中的字段
+// 表格创建后字段不会再变化，所以检查结果会被缓存
+func (this *HTTPAccessLogManager) checkTableFields(db *dbs.DB, tableName string) (*httpAccessLogDefinition, error) {
+	config, err := db.Config()
 	if err != nil {
 		return nil, err
 	}
-	return &httpAccessLogDefinition{
-		Name:          lastTableName,
-		HasRemoteAddr: hasRemoteAddrField,
-		HasDomain:     hasDomainField,
-		Exists:        true,
-	}, nil
-}
+	var cacheKey = config.Dsn + "@" + tableName
+
+	this.fieldsLocker.Lock()
+	def, ok := this.tableFieldsMapping[cacheKey]
+	this.fieldsLocker.Unlock()
+	if ok {
+		return def, nil
+	}
 
-// TODO 考虑缓存检查结果
-func (this *HTTPAccessLogManager) checkTableFields(db *dbs.DB, tableName string) (hasRemoteAddrField bool, hasDomainField bool, err error) {
 	fields, _, err := db.FindPreparedOnes("SHOW FIELDS FROM " + tableName)
 	if err != nil {
-		return false, false, err
+		return nil, err
+	}
+
+	def = &httpAccessLogDefinition{
+		Name:   tableName,
+		Exists: true,
 	}
 	for _, field := range fields {
 		var fieldName = field.GetString("Field")
 		if strings.EqualFold(fieldName, "remoteAddr") {
-			hasRemoteAddrField = true
+			def.HasRemoteAddr = true
 		}
 		if strings.ToLower(fieldName) == "domain" {
-			hasDomainField = true
+			def.HasDomain = true
+		}
+		if strings.EqualFold(fieldName, "requestURI") {
+			def.HasSearchFields = true
 		}
 	}
-	return
+
+	this.fieldsLocker.Lock()
+	this.tableFieldsMapping[cacheKey] = def
+	this.fieldsLocker.Unlock()
+
+	return def, nil
 }
 
 // 组合表格的缓存Key
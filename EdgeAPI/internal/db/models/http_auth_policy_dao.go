@@ -3,6 +3,7 @@ package models
 import (
 	"encoding/json"
 
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
@@ -129,6 +130,12 @@ func (this *HTTPAuthPolicyDAO) ComposePolicyConfig(tx *dbs.Tx, policyId int64, c
 		return cache.(*serverconfigs.HTTPAuthPolicy), nil
 	}
 
+	var daoCacheConfig = &serverconfigs.HTTPAuthPolicy{}
+	if dbutils.SharedDAOCache.ReadJSON(tx, DAOCacheHTTPAuthPolicyConfig, types.String(policyId), daoCacheConfig) {
+		cacheMap.Put(cacheKey, daoCacheConfig)
+		return daoCacheConfig, nil
+	}
+
 	policy, err := this.FindEnabledHTTPAuthPolicy(tx, policyId)
 	if err != nil {
 		return nil, err
@@ -156,6 +163,7 @@ func (this *HTTPAuthPolicyDAO) ComposePolicyConfig(tx *dbs.Tx, policyId int64, c
 	if cacheMap != nil {
 		cacheMap.Put(cacheKey, config)
 	}
+	dbutils.SharedDAOCache.WriteJSON(tx, DAOCacheHTTPAuthPolicyConfig, types.String(policyId), config)
 
 	return config, nil
 }
@@ -176,6 +184,8 @@ func (this *HTTPAuthPolicyDAO) CheckUserPolicy(tx *dbs.Tx, userId int64, policyI
 
 // NotifyUpdate 通知更改
 func (this *HTTPAuthPolicyDAO) NotifyUpdate(tx *dbs.Tx, policyId int64) error {
+	dbutils.SharedDAOCache.Delete(DAOCacheHTTPAuthPolicyConfig, types.String(policyId))
+
 	webId, err := SharedHTTPWebDAO.FindEnabledWebIdWithHTTPAuthPolicyId(tx, policyId)
 	if err != nil {
 		return err
@@ -194,6 +194,62 @@ func (this *HTTPCachePolicyDAO) CreateDefaultCachePolicy(tx *dbs.Tx, name string
 	return policyId, nil
 }
 
+// CreateCachePolicyFromConfig 从配置中创建缓存策略
+func (this *HTTPCachePolicyDAO) CreateCachePolicyFromConfig(tx *dbs.Tx, config *serverconfigs.HTTPCachePolicy) (int64, error) {
+	if config == nil {
+		return 0, errors.New("'config' should not be nil")
+	}
+
+	var capacityJSON []byte
+	var maxSizeJSON []byte
+	var storageOptionsJSON []byte
+	var fetchTimeoutJSON []byte
+	var err error
+	if config.Capacity != nil {
+		capacityJSON, err = config.Capacity.AsJSON()
+		if err != nil {
+			return 0, err
+		}
+	}
+	if config.MaxSize != nil {
+		maxSizeJSON, err = config.MaxSize.AsJSON()
+		if err != nil {
+			return 0, err
+		}
+	}
+	if config.Options != nil {
+		storageOptionsJSON, err = json.Marshal(config.Options)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if config.FetchTimeout != nil {
+		fetchTimeoutJSON, err = config.FetchTimeout.AsJSON()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	policyId, err := this.CreateCachePolicy(tx, config.IsOn, config.Name, config.Description, capacityJSON, maxSizeJSON, config.Type, storageOptionsJSON, config.SyncCompressionCache, fetchTimeoutJSON)
+	if err != nil {
+		return 0, err
+	}
+
+	// 缓存条件
+	if config.CacheRefs != nil {
+		refsJSON, err := json.Marshal(config.CacheRefs)
+		if err != nil {
+			return 0, err
+		}
+		err = this.UpdatePolicyRefs(tx, policyId, refsJSON)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return policyId, nil
+}
+
 // UpdateCachePolicy 修改缓存策略
 func (this *HTTPCachePolicyDAO) UpdateCachePolicy(tx *dbs.Tx, policyId int64, isOn bool, name string, description string, capacityJSON []byte, maxSizeJSON []byte, storageType string, storageOptionsJSON []byte, syncCompressionCache bool, fetchTimeoutJSON []byte) error {
 	if policyId <= 0 {
@@ -386,6 +442,12 @@ func (this *HTTPCachePolicyDAO) UpdatePolicyRefs(tx *dbs.Tx, policyId int64, ref
 	return this.NotifyUpdate(tx, policyId)
 }
 
+// IncreasePolicyVersion 增加策略版本号，用于防止多人同时修改时互相覆盖
+// version>0 时只有当前版本号和它一致时才会增加，否则返回false；需要和修改操作在同一个事务中执行
+func (this *HTTPCachePolicyDAO) IncreasePolicyVersion(tx *dbs.Tx, policyId int64, version int64) (bool, error) {
+	return increaseVersion(this.Query(tx), policyId, version)
+}
+
 // NotifyUpdate 通知更新
 func (this *HTTPCachePolicyDAO) NotifyUpdate(tx *dbs.Tx, policyId int64) error {
 	webIds, err := SharedHTTPWebDAO.FindAllWebIdsWithCachePolicyId(tx, policyId)
@@ -20,6 +20,7 @@ const (
 	HTTPCachePolicyField_Refs                 dbs.FieldName = "refs"                 // 默认的缓存设置
 	HTTPCachePolicyField_SyncCompressionCache dbs.FieldName = "syncCompressionCache" // 是否同步写入压缩缓存
 	HTTPCachePolicyField_FetchTimeout         dbs.FieldName = "fetchTimeout"         // 预热超时时间
+	HTTPCachePolicyField_Version              dbs.FieldName = "version"              // 版本号
 )
 
 // HTTPCachePolicy HTTP缓存策略
@@ -41,6 +42,7 @@ type HTTPCachePolicy struct {
 	Refs                 dbs.JSON `field:"refs"`                 // 默认的缓存设置
 	SyncCompressionCache uint8    `field:"syncCompressionCache"` // 是否同步写入压缩缓存
 	FetchTimeout         dbs.JSON `field:"fetchTimeout"`         // 预热超时时间
+	Version              uint32   `field:"version"`              // 版本号
 }
 
 type HTTPCachePolicyOperator struct {
@@ -61,6 +63,7 @@ type HTTPCachePolicyOperator struct {
 	Refs                 any // 默认的缓存设置
 	SyncCompressionCache any // 是否同步写入压缩缓存
 	FetchTimeout         any // 预热超时时间
+	Version              any // 版本号
 }
 
 func NewHTTPCachePolicyOperator() *HTTPCachePolicyOperator {
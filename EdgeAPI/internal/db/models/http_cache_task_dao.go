@@ -6,6 +6,7 @@ import (
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/goman"
 	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
@@ -26,6 +27,10 @@ const (
 	HTTPCacheTaskTypeFetch HTTPCacheTaskType = "fetch"
 )
 
+const (
+	HTTPCacheTaskMaxDoingTasks = 10 // 同时下发给节点执行的任务数量
+)
+
 type HTTPCacheTaskDAO dbs.DAO
 
 func init() {
@@ -130,15 +135,25 @@ func (this *HTTPCacheTaskDAO) ResetTask(tx *dbs.Tx, taskId int64) error {
 	op.IsOk = false
 	op.IsDone = false
 	op.DoneAt = 0
-	return this.Save(tx, op)
+	err := this.Save(tx, op)
+	if err != nil {
+		return err
+	}
+
+	return this.NotifyChange(tx, taskId)
 }
 
 // UpdateTaskReady 设置任务为已准备
 func (this *HTTPCacheTaskDAO) UpdateTaskReady(tx *dbs.Tx, taskId int64) error {
-	return this.Query(tx).
+	err := this.Query(tx).
 		Pk(taskId).
 		Set("isReady", true).
 		UpdateQuickly()
+	if err != nil {
+		return err
+	}
+
+	return this.NotifyChange(tx, taskId)
 }
 
 // CountTasks 查询所有任务数量
@@ -182,6 +197,26 @@ func (this *HTTPCacheTaskDAO) ListTasks(tx *dbs.Tx, userId int64, offset int64,
 	return
 }
 
+// FindDoingTaskIds 查找正在执行的任务ID
+// 按照先创建先执行的原则，最多返回 size 个
+func (this *HTTPCacheTaskDAO) FindDoingTaskIds(tx *dbs.Tx, size int64) (taskIds []int64, err error) {
+	ones, err := this.Query(tx).
+		State(HTTPCacheTaskStateEnabled).
+		Attr("isDone", false).
+		Attr("isReady", true).
+		Result("id").
+		Limit(size).
+		AscPk().
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range ones {
+		taskIds = append(taskIds, int64(one.(*HTTPCacheTask).Id))
+	}
+	return
+}
+
 // ListDoingTasks 列出需要执行的任务
 func (this *HTTPCacheTaskDAO) ListDoingTasks(tx *dbs.Tx, size int64) (result []*HTTPCacheTask, err error) {
 	_, err = this.Query(tx).
@@ -267,7 +302,17 @@ func (this *HTTPCacheTaskDAO) CleanDefaultDays(tx *dbs.Tx, defaultDays int) erro
 }
 
 // NotifyChange 发送通知
+// 通知任务涉及的集群中的节点立即执行任务，而不用等待节点下一次轮询
 func (this *HTTPCacheTaskDAO) NotifyChange(tx *dbs.Tx, taskId int64) error {
-	// TODO
+	clusterIds, err := SharedHTTPCacheTaskKeyDAO.FindTaskClusterIds(tx, taskId)
+	if err != nil {
+		return err
+	}
+	for _, clusterId := range clusterIds {
+		err = SharedNodeTaskDAO.CreateClusterTask(tx, nodeconfigs.NodeRoleNode, clusterId, 0, 0, NodeTaskTypeHTTPCacheTaskChanged)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -148,6 +148,7 @@ func (this *HTTPCacheTaskKeyDAO) FindAllTaskKeys(tx *dbs.Tx, taskId int64) (resu
 }
 
 // FindDoingTaskKeys 查询要执行的任务
+// 为了控制并发，只从最早创建的 HTTPCacheTaskMaxDoingTasks 个任务中查找
 func (this *HTTPCacheTaskKeyDAO) FindDoingTaskKeys(tx *dbs.Tx, nodeId int64, size int64) (result []*HTTPCacheTaskKey, err error) {
 	// 集群ID
 	clusterIds, err := SharedNodeDAO.FindEnabledAndOnNodeClusterIds(tx, nodeId)
@@ -159,12 +160,21 @@ func (this *HTTPCacheTaskKeyDAO) FindDoingTaskKeys(tx *dbs.Tx, nodeId int64, siz
 		return nil, nil
 	}
 
+	// 任务ID
+	taskIds, err := SharedHTTPCacheTaskDAO.FindDoingTaskIds(tx, HTTPCacheTaskMaxDoingTasks)
+	if err != nil {
+		return nil, err
+	}
+	if len(taskIds) == 0 {
+		return nil, nil
+	}
+
 	_, err = this.Query(tx).
 		Attr("clusterId", clusterIds).
+		Attr("taskId", taskIds).
 		Attr("isDone", false).
 		Where("NOT JSON_CONTAINS_PATH(nodes, 'one', :jsonPath1)").
 		Param("jsonPath1", "$.\""+types.String(nodeId)+"\"").
-		Where("taskId IN (SELECT id FROM " + SharedHTTPCacheTaskDAO.Table + " WHERE state=1 AND isReady=1 AND isDone=0)").
 		Limit(size).
 		AscPk().
 		Reuse(false).
@@ -177,6 +187,63 @@ func (this *HTTPCacheTaskKeyDAO) FindDoingTaskKeys(tx *dbs.Tx, nodeId int64, siz
 	return
 }
 
+// FindTaskClusterIds 查找任务涉及的集群ID
+func (this *HTTPCacheTaskKeyDAO) FindTaskClusterIds(tx *dbs.Tx, taskId int64) (clusterIds []int64, err error) {
+	ones, err := this.Query(tx).
+		Attr("taskId", taskId).
+		Result("DISTINCT clusterId").
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range ones {
+		var clusterId = int64(one.(*HTTPCacheTaskKey).ClusterId)
+		if clusterId > 0 {
+			clusterIds = append(clusterIds, clusterId)
+		}
+	}
+	return
+}
+
+// CountTaskKeys 计算任务下的Key数量
+func (this *HTTPCacheTaskKeyDAO) CountTaskKeys(tx *dbs.Tx, taskId int64) (int64, error) {
+	return this.Query(tx).
+		Attr("taskId", taskId).
+		Count()
+}
+
+// CountDoneTaskKeys 计算任务下已完成的Key数量
+func (this *HTTPCacheTaskKeyDAO) CountDoneTaskKeys(tx *dbs.Tx, taskId int64) (int64, error) {
+	return this.Query(tx).
+		Attr("taskId", taskId).
+		Attr("isDone", true).
+		Count()
+}
+
+// CountFailedTaskKeys 计算任务下有错误的Key数量
+func (this *HTTPCacheTaskKeyDAO) CountFailedTaskKeys(tx *dbs.Tx, taskId int64) (int64, error) {
+	return this.Query(tx).
+		Attr("taskId", taskId).
+		Where("JSON_LENGTH(errors)>0").
+		Count()
+}
+
+// ListTaskKeys 列出任务下的单页Key
+func (this *HTTPCacheTaskKeyDAO) ListTaskKeys(tx *dbs.Tx, taskId int64, onlyFailed bool, offset int64, size int64) (result []*HTTPCacheTaskKey, err error) {
+	var query = this.Query(tx).
+		Attr("taskId", taskId)
+	if onlyFailed {
+		query.Where("JSON_LENGTH(errors)>0")
+	}
+	_, err = query.
+		Offset(offset).
+		Limit(size).
+		AscPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
 // ResetCacheKeysWithTaskId 重置任务下的Key状态
 func (this *HTTPCacheTaskKeyDAO) ResetCacheKeysWithTaskId(tx *dbs.Tx, taskId int64) error {
 	return this.Query(tx).
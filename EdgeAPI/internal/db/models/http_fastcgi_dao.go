@@ -200,3 +200,37 @@ func (this *HTTPFastcgiDAO) NotifyUpdate(tx *dbs.Tx, fastcgiId int64) error {
 	}
 	return nil
 }
+
+// CloneFastcgi 复制Fastcgi配置
+func (this *HTTPFastcgiDAO) CloneFastcgi(tx *dbs.Tx, fromFastcgiId int64) (newFastcgiId int64, err error) {
+	if fromFastcgiId <= 0 {
+		return
+	}
+	fastcgiOne, err := this.Query(tx).
+		Pk(fromFastcgiId).
+		State(HTTPFastcgiStateEnabled).
+		Find()
+	if err != nil || fastcgiOne == nil {
+		return 0, err
+	}
+	var fastcgi = fastcgiOne.(*HTTPFastcgi)
+
+	var op = NewHTTPFastcgiOperator()
+	op.AdminId = fastcgi.AdminId
+	op.UserId = fastcgi.UserId
+	op.IsOn = fastcgi.IsOn
+	op.Address = fastcgi.Address
+	if IsNotNull(fastcgi.Params) {
+		op.Params = fastcgi.Params
+	}
+	if IsNotNull(fastcgi.ReadTimeout) {
+		op.ReadTimeout = fastcgi.ReadTimeout
+	}
+	if IsNotNull(fastcgi.ConnTimeout) {
+		op.ConnTimeout = fastcgi.ConnTimeout
+	}
+	op.PoolSize = fastcgi.PoolSize
+	op.PathInfoPattern = fastcgi.PathInfoPattern
+	op.State = HTTPFastcgiStateEnabled
+	return this.SaveInt64(tx, op)
+}
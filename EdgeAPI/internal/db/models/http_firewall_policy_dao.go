@@ -248,6 +248,120 @@ func (this *HTTPFirewallPolicyDAO) CreateDefaultFirewallPolicy(tx *dbs.Tx, name
 	return policyId, nil
 }
 
+// CreateFirewallPolicyFromConfig 从完整的配置中创建策略
+// 规则分组会重新创建；IP名单不会复制，而是创建新的空名单
+func (this *HTTPFirewallPolicyDAO) CreateFirewallPolicyFromConfig(tx *dbs.Tx, userId int64, config *firewallconfigs.HTTPFirewallPolicy) (int64, error) {
+	if config == nil {
+		return 0, errors.New("'config' should not be nil")
+	}
+
+	policyId, err := this.CreateFirewallPolicy(tx, userId, 0, 0, config.IsOn, config.Name, config.Description, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var inboundConfig = &firewallconfigs.HTTPFirewallInboundConfig{IsOn: true}
+	if config.Inbound != nil {
+		inboundConfig.IsOn = config.Inbound.IsOn
+		inboundConfig.Region = config.Inbound.Region
+		for _, group := range config.Inbound.Groups {
+			groupId, err := SharedHTTPFirewallRuleGroupDAO.CreateGroupFromConfig(tx, group)
+			if err != nil {
+				return 0, err
+			}
+			inboundConfig.GroupRefs = append(inboundConfig.GroupRefs, &firewallconfigs.HTTPFirewallRuleGroupRef{
+				IsOn:    true,
+				GroupId: groupId,
+			})
+		}
+	}
+
+	var outboundConfig = &firewallconfigs.HTTPFirewallOutboundConfig{IsOn: true}
+	if config.Outbound != nil {
+		outboundConfig.IsOn = config.Outbound.IsOn
+		for _, group := range config.Outbound.Groups {
+			groupId, err := SharedHTTPFirewallRuleGroupDAO.CreateGroupFromConfig(tx, group)
+			if err != nil {
+				return 0, err
+			}
+			outboundConfig.GroupRefs = append(outboundConfig.GroupRefs, &firewallconfigs.HTTPFirewallRuleGroupRef{
+				IsOn:    true,
+				GroupId: groupId,
+			})
+		}
+	}
+
+	inboundConfigJSON, err := json.Marshal(inboundConfig)
+	if err != nil {
+		return 0, err
+	}
+	outboundConfigJSON, err := json.Marshal(outboundConfig)
+	if err != nil {
+		return 0, err
+	}
+	err = this.UpdateFirewallPolicyInboundAndOutbound(tx, policyId, userId, 0, inboundConfigJSON, outboundConfigJSON, false)
+	if err != nil {
+		return 0, err
+	}
+
+	// 其他选项
+	var op = NewHTTPFirewallPolicyOperator()
+	op.Id = policyId
+	op.Mode = config.Mode
+	op.UseLocalFirewall = config.UseLocalFirewall
+	op.MaxRequestBodySize = config.MaxRequestBodySize
+	op.DenyCountryHTML = config.DenyCountryHTML
+	op.DenyProvinceHTML = config.DenyProvinceHTML
+	if config.BlockOptions != nil {
+		blockOptionsJSON, err := json.Marshal(config.BlockOptions)
+		if err != nil {
+			return 0, err
+		}
+		op.BlockOptions = blockOptionsJSON
+	}
+	if config.PageOptions != nil {
+		pageOptionsJSON, err := json.Marshal(config.PageOptions)
+		if err != nil {
+			return 0, err
+		}
+		op.PageOptions = pageOptionsJSON
+	}
+	if config.CaptchaOptions != nil {
+		captchaOptionsJSON, err := json.Marshal(config.CaptchaOptions)
+		if err != nil {
+			return 0, err
+		}
+		op.CaptchaOptions = captchaOptionsJSON
+	}
+	if config.JSCookieOptions != nil {
+		jsCookieOptionsJSON, err := json.Marshal(config.JSCookieOptions)
+		if err != nil {
+			return 0, err
+		}
+		op.JsCookieOptions = jsCookieOptionsJSON
+	}
+	if config.SYNFlood != nil {
+		synFloodJSON, err := json.Marshal(config.SYNFlood)
+		if err != nil {
+			return 0, err
+		}
+		op.SynFlood = synFloodJSON
+	}
+	if config.Log != nil {
+		logJSON, err := json.Marshal(config.Log)
+		if err != nil {
+			return 0, err
+		}
+		op.Log = logJSON
+	}
+	err = this.Save(tx, op)
+	if err != nil {
+		return 0, err
+	}
+
+	return policyId, nil
+}
+
 // UpdateFirewallPolicyInboundAndOutbound 修改策略的Inbound和Outbound
 func (this *HTTPFirewallPolicyDAO) UpdateFirewallPolicyInboundAndOutbound(tx *dbs.Tx, policyId int64, userId int64, serverId int64, inboundJSON []byte, outboundJSON []byte, shouldNotify bool) error {
 	if policyId <= 0 {
@@ -320,6 +434,12 @@ func (this *HTTPFirewallPolicyDAO) UpdateFirewallPolicyInboundAndOutbound(tx *db
 		return err
 	}
 
+	// 分组变化后，之前读取的策略不能再直接保存
+	_, err = increaseVersion(this.Query(tx), policyId, 0)
+	if err != nil {
+		return err
+	}
+
 	if shouldNotify {
 		return this.NotifyUpdate(tx, policyId)
 	}
@@ -344,6 +464,12 @@ func (this *HTTPFirewallPolicyDAO) UpdateFirewallPolicyInbound(tx *dbs.Tx, polic
 		return err
 	}
 
+	// 分组变化后，之前读取的策略不能再直接保存
+	_, err = increaseVersion(this.Query(tx), policyId, 0)
+	if err != nil {
+		return err
+	}
+
 	return this.NotifyUpdate(tx, policyId)
 }
 
@@ -777,6 +903,12 @@ func (this *HTTPFirewallPolicyDAO) FindServerIdWithFirewallPolicyId(tx *dbs.Tx,
 	return
 }
 
+// IncreasePolicyVersion 增加策略版本号，用于防止多人同时修改时互相覆盖
+// version>0 时只有当前版本号和它一致时才会增加，否则返回false；需要和修改操作在同一个事务中执行
+func (this *HTTPFirewallPolicyDAO) IncreasePolicyVersion(tx *dbs.Tx, policyId int64, version int64) (bool, error) {
+	return increaseVersion(this.Query(tx), policyId, version)
+}
+
 // NotifyUpdate 通知更新
 func (this *HTTPFirewallPolicyDAO) NotifyUpdate(tx *dbs.Tx, policyId int64) error {
 	webIds, err := SharedHTTPWebDAO.FindAllWebIdsWithHTTPFirewallPolicyId(tx, policyId)
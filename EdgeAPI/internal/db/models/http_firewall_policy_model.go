@@ -27,6 +27,7 @@ const (
 	HTTPFirewallPolicyField_MaxRequestBodySize dbs.FieldName = "maxRequestBodySize" // 可以检查的最大请求内容尺寸
 	HTTPFirewallPolicyField_DenyCountryHTML    dbs.FieldName = "denyCountryHTML"    // 区域封禁提示
 	HTTPFirewallPolicyField_DenyProvinceHTML   dbs.FieldName = "denyProvinceHTML"   // 省份封禁提示
+	HTTPFirewallPolicyField_Version            dbs.FieldName = "version"            // 版本号
 )
 
 // HTTPFirewallPolicy HTTP防火墙
@@ -55,6 +56,7 @@ type HTTPFirewallPolicy struct {
 	MaxRequestBodySize uint32   `field:"maxRequestBodySize"` // 可以检查的最大请求内容尺寸
 	DenyCountryHTML    string   `field:"denyCountryHTML"`    // 区域封禁提示
 	DenyProvinceHTML   string   `field:"denyProvinceHTML"`   // 省份封禁提示
+	Version            uint32   `field:"version"`            // 版本号
 }
 
 type HTTPFirewallPolicyOperator struct {
@@ -82,6 +84,7 @@ type HTTPFirewallPolicyOperator struct {
 	MaxRequestBodySize any // 可以检查的最大请求内容尺寸
 	DenyCountryHTML    any // 区域封禁提示
 	DenyProvinceHTML   any // 省份封禁提示
+	Version            any // 版本号
 }
 
 func NewHTTPFirewallPolicyOperator() *HTTPFirewallPolicyOperator {
@@ -298,3 +298,44 @@ func (this *HTTPHeaderDAO) NotifyUpdate(tx *dbs.Tx, headerId int64) error {
 	}
 	return nil
 }
+
+// CloneHeader 复制Header
+func (this *HTTPHeaderDAO) CloneHeader(tx *dbs.Tx, fromHeaderId int64) (newHeaderId int64, err error) {
+	if fromHeaderId <= 0 {
+		return
+	}
+	headerOne, err := this.Query(tx).
+		Pk(fromHeaderId).
+		State(HTTPHeaderStateEnabled).
+		Find()
+	if err != nil || headerOne == nil {
+		return 0, err
+	}
+	var header = headerOne.(*HTTPHeader)
+
+	var op = NewHTTPHeaderOperator()
+	op.AdminId = header.AdminId
+	op.UserId = header.UserId
+	op.TemplateId = header.TemplateId
+	op.IsOn = header.IsOn
+	op.Name = header.Name
+	op.Value = header.Value
+	op.Order = header.Order
+	if IsNotNull(header.Status) {
+		op.Status = header.Status
+	}
+	op.DisableRedirect = header.DisableRedirect
+	op.ShouldAppend = header.ShouldAppend
+	op.ShouldReplace = header.ShouldReplace
+	if IsNotNull(header.ReplaceValues) {
+		op.ReplaceValues = header.ReplaceValues
+	}
+	if IsNotNull(header.Methods) {
+		op.Methods = header.Methods
+	}
+	if IsNotNull(header.Domains) {
+		op.Domains = header.Domains
+	}
+	op.State = HTTPHeaderStateEnabled
+	return this.SaveInt64(tx, op)
+}
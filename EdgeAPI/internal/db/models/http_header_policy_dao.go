@@ -333,3 +333,81 @@ func (this *HTTPHeaderPolicyDAO) NotifyUpdate(tx *dbs.Tx, policyId int64) error
 	}
 	return nil
 }
+
+// CloneHeaderPolicy 复制策略，策略中的Header也一并复制
+func (this *HTTPHeaderPolicyDAO) CloneHeaderPolicy(tx *dbs.Tx, fromPolicyId int64) (newPolicyId int64, err error) {
+	if fromPolicyId <= 0 {
+		return
+	}
+	policy, err := this.FindEnabledHTTPHeaderPolicy(tx, fromPolicyId)
+	if err != nil || policy == nil {
+		return 0, err
+	}
+
+	var op = NewHTTPHeaderPolicyOperator()
+	op.AdminId = policy.AdminId
+	op.UserId = policy.UserId
+	op.IsOn = policy.IsOn
+	op.State = HTTPHeaderPolicyStateEnabled
+
+	if IsNotNull(policy.AddHeaders) {
+		op.AddHeaders, err = this.cloneHeaderRefs(tx, policy.AddHeaders)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if IsNotNull(policy.AddTrailers) {
+		op.AddTrailers, err = this.cloneHeaderRefs(tx, policy.AddTrailers)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if IsNotNull(policy.SetHeaders) {
+		op.SetHeaders, err = this.cloneHeaderRefs(tx, policy.SetHeaders)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if IsNotNull(policy.ReplaceHeaders) {
+		op.ReplaceHeaders, err = this.cloneHeaderRefs(tx, policy.ReplaceHeaders)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if IsNotNull(policy.Expires) {
+		op.Expires = policy.Expires
+	}
+	if IsNotNull(policy.DeleteHeaders) {
+		op.DeleteHeaders = policy.DeleteHeaders
+	}
+	if IsNotNull(policy.NonStandardHeaders) {
+		op.NonStandardHeaders = policy.NonStandardHeaders
+	}
+	if IsNotNull(policy.Cors) {
+		op.Cors = policy.Cors
+	}
+	return this.SaveInt64(tx, op)
+}
+
+// 复制Header引用中的Header
+func (this *HTTPHeaderPolicyDAO) cloneHeaderRefs(tx *dbs.Tx, headerRefsJSON []byte) ([]byte, error) {
+	var refs = []*shared.HTTPHeaderRef{}
+	err := json.Unmarshal(headerRefsJSON, &refs)
+	if err != nil {
+		return nil, err
+	}
+	var newRefs = []*shared.HTTPHeaderRef{}
+	for _, ref := range refs {
+		newHeaderId, err := SharedHTTPHeaderDAO.CloneHeader(tx, ref.HeaderId)
+		if err != nil {
+			return nil, err
+		}
+		if newHeaderId > 0 {
+			newRefs = append(newRefs, &shared.HTTPHeaderRef{
+				IsOn:     ref.IsOn,
+				HeaderId: newHeaderId,
+			})
+		}
+	}
+	return json.Marshal(newRefs)
+}
@@ -342,3 +342,52 @@ func (this *HTTPLocationDAO) NotifyUpdate(tx *dbs.Tx, locationId int64) error {
 	}
 	return nil
 }
+
+// CloneLocation 复制路由规则，包括其中的Web配置和反向代理设置
+// fromServerId 为路由规则所属服务的ID，用来判断WAF策略是否为服务专属
+func (this *HTTPLocationDAO) CloneLocation(tx *dbs.Tx, fromLocationId int64, parentId int64, fromServerId int64) (newLocationId int64, err error) {
+	if fromLocationId <= 0 {
+		return
+	}
+	location, err := this.FindEnabledHTTPLocation(tx, fromLocationId)
+	if err != nil || location == nil {
+		return 0, err
+	}
+
+	var op = NewHTTPLocationOperator()
+	op.TemplateId = location.TemplateId
+	op.AdminId = location.AdminId
+	op.UserId = location.UserId
+	op.ParentId = parentId
+	op.Pattern = location.Pattern
+	op.IsOn = location.IsOn
+	op.Name = location.Name
+	op.Description = location.Description
+	op.UrlPrefix = location.UrlPrefix
+	op.IsBreak = location.IsBreak
+	if IsNotNull(location.Conds) {
+		op.Conds = location.Conds
+	}
+	if IsNotNull(location.Domains) {
+		op.Domains = location.Domains
+	}
+
+	if location.WebId > 0 {
+		newWebId, err := SharedHTTPWebDAO.CloneWeb(tx, int64(location.WebId), fromServerId)
+		if err != nil {
+			return 0, err
+		}
+		op.WebId = newWebId
+	}
+
+	if IsNotNull(location.ReverseProxy) {
+		newReverseProxyJSON, err := SharedReverseProxyDAO.CloneReverseProxyRef(tx, location.ReverseProxy)
+		if err != nil {
+			return 0, err
+		}
+		op.ReverseProxy = newReverseProxyJSON
+	}
+
+	op.State = HTTPLocationStateEnabled
+	return this.SaveInt64(tx, op)
+}
@@ -213,3 +213,36 @@ func (this *HTTPRewriteRuleDAO) NotifyUpdate(tx *dbs.Tx, rewriteRuleId int64) er
 	}
 	return nil
 }
+
+// CloneRewriteRule 复制重写规则
+func (this *HTTPRewriteRuleDAO) CloneRewriteRule(tx *dbs.Tx, fromRewriteRuleId int64) (newRewriteRuleId int64, err error) {
+	if fromRewriteRuleId <= 0 {
+		return
+	}
+	ruleOne, err := this.Query(tx).
+		Pk(fromRewriteRuleId).
+		State(HTTPRewriteRuleStateEnabled).
+		Find()
+	if err != nil || ruleOne == nil {
+		return 0, err
+	}
+	var rule = ruleOne.(*HTTPRewriteRule)
+
+	var op = NewHTTPRewriteRuleOperator()
+	op.AdminId = rule.AdminId
+	op.UserId = rule.UserId
+	op.TemplateId = rule.TemplateId
+	op.IsOn = rule.IsOn
+	op.Pattern = rule.Pattern
+	op.Replace = rule.Replace
+	op.Mode = rule.Mode
+	op.RedirectStatus = rule.RedirectStatus
+	op.ProxyHost = rule.ProxyHost
+	op.IsBreak = rule.IsBreak
+	op.WithQuery = rule.WithQuery
+	if IsNotNull(rule.Conds) {
+		op.Conds = rule.Conds
+	}
+	op.State = HTTPRewriteRuleStateEnabled
+	return this.SaveInt64(tx, op)
+}
@@ -1526,6 +1526,271 @@ func (this *HTTPWebDAO) FindWebUserAgent(tx *dbs.Tx, webId int64) ([]byte, error
 		FindJSONCol()
 }
 
+// CloneWeb 复制Web配置
+// 路由规则、请求和响应Header、自定义页面、Websocket、重写规则、Fastcgi和认证策略都复制一份新的；
+// 缓存策略和WAF策略仍然引用原有的策略，但属于 fromServerId 专属的WAF策略不会被引用
+func (this *HTTPWebDAO) CloneWeb(tx *dbs.Tx, fromWebId int64, fromServerId int64) (newWebId int64, err error) {
+	if fromWebId <= 0 {
+		return
+	}
+	web, err := this.FindEnabledHTTPWeb(tx, fromWebId)
+	if err != nil || web == nil {
+		return 0, err
+	}
+
+	var op = NewHTTPWebOperator()
+	op.IsOn = web.IsOn
+	op.TemplateId = web.TemplateId
+	op.AdminId = web.AdminId
+	op.UserId = web.UserId
+	op.State = HTTPWebStateEnabled
+	op.EnableGlobalPages = web.EnableGlobalPages
+	op.MergeSlashes = web.MergeSlashes
+
+	// 不包含ID引用的配置直接复制
+	for _, field := range []struct {
+		value  dbs.JSON
+		target *any
+	}{
+		{web.Root, &op.Root},
+		{web.Charset, &op.Charset},
+		{web.Shutdown, &op.Shutdown},
+		{web.RedirectToHttps, &op.RedirectToHttps},
+		{web.Indexes, &op.Indexes},
+		{web.MaxRequestBodySize, &op.MaxRequestBodySize},
+		{web.AccessLog, &op.AccessLog},
+		{web.Stat, &op.Stat},
+		{web.Compression, &op.Compression},
+		{web.Cache, &op.Cache},
+		{web.HostRedirects, &op.HostRedirects},
+		{web.Webp, &op.Webp},
+		{web.RemoteAddr, &op.RemoteAddr},
+		{web.RequestLimit, &op.RequestLimit},
+		{web.RequestScripts, &op.RequestScripts},
+		{web.Uam, &op.Uam},
+		{web.Cc, &op.Cc},
+		{web.Referers, &op.Referers},
+		{web.UserAgent, &op.UserAgent},
+		{web.Optimization, &op.Optimization},
+		{web.Hls, &op.Hls},
+	} {
+		if IsNotNull(field.value) {
+			*field.target = field.value
+		}
+	}
+
+	// 请求Header和响应Header
+	if IsNotNull(web.RequestHeader) {
+		op.RequestHeader, err = this.cloneHeaderPolicyRef(tx, web.RequestHeader)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if IsNotNull(web.ResponseHeader) {
+		op.ResponseHeader, err = this.cloneHeaderPolicyRef(tx, web.ResponseHeader)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// 自定义页面
+	if IsNotNull(web.Pages) {
+		var pageMaps = []maps.Map{}
+		err = json.Unmarshal(web.Pages, &pageMaps)
+		if err != nil {
+			return 0, err
+		}
+		var newPageMaps = []maps.Map{}
+		for _, pageMap := range pageMaps {
+			newPageId, err := SharedHTTPPageDAO.ClonePage(tx, pageMap.GetInt64("id"))
+			if err != nil {
+				return 0, err
+			}
+			if newPageId > 0 {
+				pageMap["id"] = newPageId
+				newPageMaps = append(newPageMaps, pageMap)
+			}
+		}
+		op.Pages, err = json.Marshal(newPageMaps)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// 路由规则
+	if IsNotNull(web.Locations) {
+		var refs = []*serverconfigs.HTTPLocationRef{}
+		err = json.Unmarshal(web.Locations, &refs)
+		if err != nil {
+			return 0, err
+		}
+		newRefs, err := this.cloneLocationRefs(tx, refs, 0, fromServerId)
+		if err != nil {
+			return 0, err
+		}
+		op.Locations, err = json.Marshal(newRefs)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// Websocket
+	if IsNotNull(web.Websocket) {
+		var ref = &serverconfigs.HTTPWebsocketRef{}
+		err = json.Unmarshal(web.Websocket, ref)
+		if err != nil {
+			return 0, err
+		}
+		ref.WebsocketId, err = SharedHTTPWebsocketDAO.CloneWebsocket(tx, ref.WebsocketId)
+		if err != nil {
+			return 0, err
+		}
+		op.Websocket, err = json.Marshal(ref)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// 重写规则
+	if IsNotNull(web.RewriteRules) {
+		var refs = []*serverconfigs.HTTPRewriteRef{}
+		err = json.Unmarshal(web.RewriteRules, &refs)
+		if err != nil {
+			return 0, err
+		}
+		var newRefs = []*serverconfigs.HTTPRewriteRef{}
+		for _, ref := range refs {
+			newRuleId, err := SharedHTTPRewriteRuleDAO.CloneRewriteRule(tx, ref.RewriteRuleId)
+			if err != nil {
+				return 0, err
+			}
+			if newRuleId > 0 {
+				newRefs = append(newRefs, &serverconfigs.HTTPRewriteRef{
+					IsOn:          ref.IsOn,
+					RewriteRuleId: newRuleId,
+				})
+			}
+		}
+		op.RewriteRules, err = json.Marshal(newRefs)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// Fastcgi
+	if IsNotNull(web.Fastcgi) {
+		var ref = &serverconfigs.HTTPFastcgiRef{}
+		err = json.Unmarshal(web.Fastcgi, ref)
+		if err != nil {
+			return 0, err
+		}
+		var newFastcgiIds = []int64{}
+		for _, fastcgiId := range ref.FastcgiIds {
+			newFastcgiId, err := SharedHTTPFastcgiDAO.CloneFastcgi(tx, fastcgiId)
+			if err != nil {
+				return 0, err
+			}
+			if newFastcgiId > 0 {
+				newFastcgiIds = append(newFastcgiIds, newFastcgiId)
+			}
+		}
+		ref.FastcgiIds = newFastcgiIds
+		op.Fastcgi, err = json.Marshal(ref)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// 认证
+	if IsNotNull(web.Auth) {
+		var authConfig = &serverconfigs.HTTPAuthConfig{}
+		err = json.Unmarshal(web.Auth, authConfig)
+		if err != nil {
+			return 0, err
+		}
+		var newRefs = []*serverconfigs.HTTPAuthPolicyRef{}
+		for _, ref := range authConfig.PolicyRefs {
+			newPolicyId, err := SharedHTTPAuthPolicyDAO.CloneAuthPolicy(tx, ref.AuthPolicyId)
+			if err != nil {
+				return 0, err
+			}
+			if newPolicyId > 0 {
+				newRefs = append(newRefs, &serverconfigs.HTTPAuthPolicyRef{
+					IsOn:         ref.IsOn,
+					AuthPolicyId: newPolicyId,
+				})
+			}
+		}
+		authConfig.PolicyRefs = newRefs
+		op.Auth, err = json.Marshal(authConfig)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// WAF
+	if IsNotNull(web.Firewall) {
+		var firewallRef = &firewallconfigs.HTTPFirewallRef{}
+		err = json.Unmarshal(web.Firewall, firewallRef)
+		if err != nil {
+			return 0, err
+		}
+		if firewallRef.FirewallPolicyId > 0 && fromServerId > 0 {
+			policy, err := SharedHTTPFirewallPolicyDAO.FindEnabledHTTPFirewallPolicy(tx, firewallRef.FirewallPolicyId)
+			if err != nil {
+				return 0, err
+			}
+			if policy == nil || int64(policy.ServerId) == fromServerId {
+				firewallRef.FirewallPolicyId = 0
+			}
+		}
+		op.Firewall, err = json.Marshal(firewallRef)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return this.SaveInt64(tx, op)
+}
+
+// 复制Header策略引用中的策略
+func (this *HTTPWebDAO) cloneHeaderPolicyRef(tx *dbs.Tx, refJSON []byte) ([]byte, error) {
+	var ref = &shared.HTTPHeaderPolicyRef{}
+	err := json.Unmarshal(refJSON, ref)
+	if err != nil {
+		return nil, err
+	}
+	ref.HeaderPolicyId, err = SharedHTTPHeaderPolicyDAO.CloneHeaderPolicy(tx, ref.HeaderPolicyId)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(ref)
+}
+
+// 复制路由规则引用中的路由规则
+func (this *HTTPWebDAO) cloneLocationRefs(tx *dbs.Tx, refs []*serverconfigs.HTTPLocationRef, parentId int64, fromServerId int64) ([]*serverconfigs.HTTPLocationRef, error) {
+	var newRefs = []*serverconfigs.HTTPLocationRef{}
+	for _, ref := range refs {
+		newLocationId, err := SharedHTTPLocationDAO.CloneLocation(tx, ref.LocationId, parentId, fromServerId)
+		if err != nil {
+			return nil, err
+		}
+		if newLocationId <= 0 {
+			continue
+		}
+		children, err := this.cloneLocationRefs(tx, ref.Children, newLocationId, fromServerId)
+		if err != nil {
+			return nil, err
+		}
+		newRefs = append(newRefs, &serverconfigs.HTTPLocationRef{
+			IsOn:       ref.IsOn,
+			LocationId: newLocationId,
+			Children:   children,
+		})
+	}
+	return newRefs, nil
+}
+
 // NotifyUpdate 通知更新
 func (this *HTTPWebDAO) NotifyUpdate(tx *dbs.Tx, webId int64) error {
 	// server
@@ -67,11 +67,16 @@ func (this *IPLibraryArtifactDAO) FindEnabledIPLibraryArtifact(tx *dbs.Tx, id in
 }
 
 // CreateArtifact 创建制品
-func (this *IPLibraryArtifactDAO) CreateArtifact(tx *dbs.Tx, name string, fileId int64, libraryFileId int64, meta *iplibrary.Meta) (int64, error) {
+func (this *IPLibraryArtifactDAO) CreateArtifact(tx *dbs.Tx, name string, fileId int64, libraryFileId int64, meta *iplibrary.Meta, driver iplibrary.DriverType) (int64, error) {
+	if len(driver) == 0 {
+		driver = iplibrary.DriverTypeDefault
+	}
+
 	var op = NewIPLibraryArtifactOperator()
 	op.Name = name
 	op.FileId = fileId
 	op.LibraryFileId = libraryFileId
+	op.Driver = driver
 
 	metaJSON, err := json.Marshal(meta)
 	if err != nil {
@@ -102,7 +107,7 @@ func (this *IPLibraryArtifactDAO) FindPublicArtifact(tx *dbs.Tx) (*IPLibraryArti
 	one, err := this.Query(tx).
 		State(IPLibraryArtifactStateEnabled).
 		Attr("isPublic", true).
-		Result("id", "fileId", "code").
+		Result("id", "fileId", "code", "driver").
 		Find()
 	if err != nil || one == nil {
 		return nil, err
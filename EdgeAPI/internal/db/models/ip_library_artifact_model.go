@@ -12,6 +12,7 @@ type IPLibraryArtifact struct {
 	Meta          dbs.JSON `field:"meta"`          // 元数据
 	IsPublic      bool     `field:"isPublic"`      // 是否为公用
 	Code          string   `field:"code"`          // 代号
+	Driver        string   `field:"driver"`        // 驱动类型
 	State         uint8    `field:"state"`         // 状态
 }
 
@@ -24,6 +25,7 @@ type IPLibraryArtifactOperator struct {
 	Meta          any // 元数据
 	IsPublic      any // 是否为公用
 	Code          any // 代号
+	Driver        any // 驱动类型
 	State         any // 状态
 }
 
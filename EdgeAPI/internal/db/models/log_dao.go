@@ -172,6 +172,10 @@ func (this *LogDAO) DeleteLogsPermanentlyBeforeDays(tx *dbs.Tx, days int) error
 
 // SumLogsSize 计算当前日志容量大小
 func (this *LogDAO) SumLogsSize() (int64, error) {
+	// SQLite中无法读取单个表格的容量
+	if dbutils.IsSQLite(this.Instance) {
+		return 0, nil
+	}
 	col, err := this.Instance.FindCol(0, "SELECT DATA_LENGTH FROM information_schema.TABLES WHERE TABLE_SCHEMA=? AND TABLE_NAME=? LIMIT 1", this.Instance.Name(), this.Table)
 	if err != nil {
 		return 0, err
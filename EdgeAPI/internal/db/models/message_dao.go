@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
@@ -41,6 +43,10 @@ const (
 	MessageTypeSSLCertExpiring            MessageType = "SSLCertExpiring"            // SSL证书即将过期
 	MessageTypeSSLCertACMETaskFailed      MessageType = "SSLCertACMETaskFailed"      // SSL证书任务执行失败
 	MessageTypeSSLCertACMETaskSuccess     MessageType = "SSLCertACMETaskSuccess"     // SSL证书任务执行成功
+	MessageTypeSSLCertDeployFailed        MessageType = "SSLCertDeployFailed"        // SSL证书部署到外部平台失败
+	MessageTypeSSLCertRevoked             MessageType = "SSLCertRevoked"             // SSL证书已被吊销（OCSP）
+	MessageTypeSSLCertOCSPUnknown         MessageType = "SSLCertOCSPUnknown"         // SSL证书OCSP状态未知
+	MessageTypeSSLCertSuperseded          MessageType = "SSLCertSuperseded"          // 已过期的SSL证书被新证书替代
 	MessageTypeLogCapacityOverflow        MessageType = "LogCapacityOverflow"        // 日志超出最大限制
 	MessageTypeServerNamesAuditingSuccess MessageType = "ServerNamesAuditingSuccess" // 服务域名审核成功（用户）
 	MessageTypeServerNamesAuditingFailed  MessageType = "ServerNamesAuditingFailed"  // 服务域名审核失败（用户）
@@ -49,6 +55,8 @@ const (
 	MessageTypeFirewallEvent              MessageType = "FirewallEvent"              // 防火墙事件
 	MessageTypeIPAddrUp                   MessageType = "IPAddrUp"                   // IP地址上线
 	MessageTypeIPAddrDown                 MessageType = "IPAddrDown"                 // IP地址下线
+	MessageTypeUserAlertTriggered         MessageType = "UserAlertTriggered"         // 用户告警规则触发（用户）
+	MessageTypeDomainExpiring             MessageType = "DomainExpiring"             // 注册域名即将过期
 
 	MessageTypeNSNodeInactive MessageType = "NSNodeInactive" // NS节点不活跃
 	MessageTypeNSNodeActive   MessageType = "NSNodeActive"   // NS节点活跃
@@ -121,6 +129,12 @@ func (this *MessageDAO) CreateClusterMessage(tx *dbs.Tx, role string, clusterId
 		return err
 	}
 
+	// 处于静默期的消息不再发送给媒介接收人
+	silenced, err := this.checkSilence(tx, role, clusterId, 0, messageType, level)
+	if err != nil || silenced {
+		return err
+	}
+
 	// 发送给媒介接收人
 	err = SharedMessageTaskDAO.CreateMessageTasks(tx, role, clusterId, 0, 0, messageType, subject, body)
 	if err != nil {
@@ -152,6 +166,12 @@ func (this *MessageDAO) CreateNodeMessage(tx *dbs.Tx, role string, clusterId int
 		return err
 	}
 
+	// 处于静默期的消息不再发送给媒介接收人
+	silenced, err := this.checkSilence(tx, role, clusterId, nodeId, messageType, level)
+	if err != nil || silenced {
+		return err
+	}
+
 	// 发送给媒介接收人 - 集群
 	err = SharedMessageTaskDAO.CreateMessageTasks(tx, role, clusterId, nodeId, 0, messageType, subject, body)
 	if err != nil {
@@ -199,6 +219,20 @@ func (this *MessageDAO) DeleteMessagesBeforeDay(tx *dbs.Tx, dayTime time.Time) e
 	return err
 }
 
+// CleanWithRetention 按保留策略分批删除过期的消息
+func (this *MessageDAO) CleanWithRetention(tx *dbs.Tx, policy *systemconfigs.RetentionPolicy) error {
+	if !policy.IsOn() {
+		return nil
+	}
+	var day = timeutil.Format("Ymd", policy.ExpiresTime(time.Now()))
+	_, err := dbutils.DeleteInBatches(this, policy.BatchSize, func() *dbs.Query {
+		return this.Query(tx).
+			Where("day<:day").
+			Param("day", day)
+	})
+	return err
+}
+
 // CountUnreadMessages 计算未读消息数量
 func (this *MessageDAO) CountUnreadMessages(tx *dbs.Tx, adminId int64, userId int64) (int64, error) {
 	query := this.Query(tx).
@@ -334,3 +368,16 @@ func (this *MessageDAO) calHash(role string, clusterId int64, nodeId int64, subj
 	h.Write(paramsJSON)
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
+
+// 检查消息是否处于静默期，如果是则记录屏蔽次数
+func (this *MessageDAO) checkSilence(tx *dbs.Tx, role string, clusterId int64, nodeId int64, messageType MessageType, level string) (bool, error) {
+	silence, err := SharedMessageSilenceDAO.FindMatchedSilence(tx, role, clusterId, nodeId, messageType, level)
+	if err != nil || silence == nil {
+		return false, err
+	}
+	err = SharedMessageSilenceDAO.IncreaseMutedCount(tx, int64(silence.Id))
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
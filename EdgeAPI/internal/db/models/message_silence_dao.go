@@ -0,0 +1,185 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+const (
+	MessageSilenceStateEnabled  = 1 // 已启用
+	MessageSilenceStateDisabled = 0 // 已禁用
+)
+
+type MessageSilenceDAO dbs.DAO
+
+func NewMessageSilenceDAO() *MessageSilenceDAO {
+	return dbs.NewDAO(&MessageSilenceDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeMessageSilences",
+			Model:  new(MessageSilence),
+			PkName: "id",
+		},
+	}).(*MessageSilenceDAO)
+}
+
+var SharedMessageSilenceDAO *MessageSilenceDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedMessageSilenceDAO = NewMessageSilenceDAO()
+	})
+}
+
+// EnableMessageSilence 启用条目
+func (this *MessageSilenceDAO) EnableMessageSilence(tx *dbs.Tx, id int64) error {
+	_, err := this.Query(tx).
+		Pk(id).
+		Set("state", MessageSilenceStateEnabled).
+		Update()
+	return err
+}
+
+// DisableMessageSilence 禁用条目
+func (this *MessageSilenceDAO) DisableMessageSilence(tx *dbs.Tx, id int64) error {
+	_, err := this.Query(tx).
+		Pk(id).
+		Set("state", MessageSilenceStateDisabled).
+		Update()
+	return err
+}
+
+// FindEnabledMessageSilence 查找启用中的条目
+func (this *MessageSilenceDAO) FindEnabledMessageSilence(tx *dbs.Tx, id int64) (*MessageSilence, error) {
+	result, err := this.Query(tx).
+		Pk(id).
+		Attr("state", MessageSilenceStateEnabled).
+		Find()
+	if result == nil {
+		return nil, err
+	}
+	return result.(*MessageSilence), err
+}
+
+// CreateSilence 创建静默规则
+func (this *MessageSilenceDAO) CreateSilence(tx *dbs.Tx, adminId int64, name string, reason string, role string, clusterId int64, nodeId int64, messageType MessageType, level string, startAt int64, endAt int64, scheduleJSON []byte) (int64, error) {
+	var op = NewMessageSilenceOperator()
+	op.AdminId = adminId
+	op.Name = name
+	op.Reason = utils.LimitString(reason, 255)
+	op.Role = role
+	op.ClusterId = clusterId
+	op.NodeId = nodeId
+	op.Type = messageType
+	op.Level = level
+	op.StartAt = startAt
+	op.EndAt = endAt
+	if len(scheduleJSON) > 0 {
+		op.Schedule = scheduleJSON
+	}
+	op.IsOn = true
+	op.State = MessageSilenceStateEnabled
+	return this.SaveInt64(tx, op)
+}
+
+// UpdateSilence 修改静默规则
+func (this *MessageSilenceDAO) UpdateSilence(tx *dbs.Tx, silenceId int64, name string, reason string, role string, clusterId int64, nodeId int64, messageType MessageType, level string, startAt int64, endAt int64, scheduleJSON []byte, isOn bool) error {
+	if silenceId <= 0 {
+		return errors.New("invalid silenceId")
+	}
+
+	var op = NewMessageSilenceOperator()
+	op.Id = silenceId
+	op.Name = name
+	op.Reason = utils.LimitString(reason, 255)
+	op.Role = role
+	op.ClusterId = clusterId
+	op.NodeId = nodeId
+	op.Type = messageType
+	op.Level = level
+	op.StartAt = startAt
+	op.EndAt = endAt
+	if len(scheduleJSON) > 0 {
+		op.Schedule = scheduleJSON
+	} else {
+		op.Schedule = dbs.SQL("NULL")
+	}
+	op.IsOn = isOn
+	return this.Save(tx, op)
+}
+
+// CountAllEnabledSilences 计算静默规则数量
+func (this *MessageSilenceDAO) CountAllEnabledSilences(tx *dbs.Tx, clusterId int64) (int64, error) {
+	var query = this.Query(tx)
+	if clusterId > 0 {
+		query.Attr("clusterId", clusterId)
+	}
+	return query.
+		State(MessageSilenceStateEnabled).
+		Count()
+}
+
+// ListEnabledSilences 列出单页静默规则
+func (this *MessageSilenceDAO) ListEnabledSilences(tx *dbs.Tx, clusterId int64, offset int64, size int64) (result []*MessageSilence, err error) {
+	var query = this.Query(tx)
+	if clusterId > 0 {
+		query.Attr("clusterId", clusterId)
+	}
+	_, err = query.
+		State(MessageSilenceStateEnabled).
+		DescPk().
+		Offset(offset).
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// FindMatchedSilence 查找当前时间匹配消息的静默规则
+func (this *MessageSilenceDAO) FindMatchedSilence(tx *dbs.Tx, role string, clusterId int64, nodeId int64, messageType MessageType, level string) (*MessageSilence, error) {
+	var now = time.Now()
+	var query = this.Query(tx)
+	if len(role) > 0 {
+		query.Attr("role", []string{"", role})
+	}
+	if len(messageType) > 0 {
+		query.Attr("type", []string{"", MessageTypeAll, messageType})
+	}
+	if len(level) > 0 {
+		query.Attr("level", []string{"", level})
+	}
+	var ones []*MessageSilence
+	_, err := query.
+		State(MessageSilenceStateEnabled).
+		Attr("isOn", true).
+		Attr("clusterId", []int64{0, clusterId}).
+		Attr("nodeId", []int64{0, nodeId}).
+		Where("(endAt=0 OR endAt>:now)").
+		Param("now", now.Unix()).
+		AscPk().
+		Slice(&ones).
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range ones {
+		if one.Match(role, clusterId, nodeId, messageType, level, now) {
+			return one, nil
+		}
+	}
+	return nil, nil
+}
+
+// IncreaseMutedCount 增加屏蔽的消息数
+func (this *MessageSilenceDAO) IncreaseMutedCount(tx *dbs.Tx, silenceId int64) error {
+	return this.Query(tx).
+		Pk(silenceId).
+		Set("countMuted", dbs.SQL("countMuted+1")).
+		Set("lastMutedAt", time.Now().Unix()).
+		UpdateQuickly()
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/assert"
+	_ "github.com/iwind/TeaGo/bootstrap"
+)
+
+func TestMessageSilence_Match(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var now = time.Date(2024, 5, 11, 23, 0, 0, 0, time.Local)
+
+	var silence = &MessageSilence{
+		IsOn:      true,
+		ClusterId: 1,
+		Type:      MessageTypeNodeInactive,
+	}
+	a.IsTrue(silence.Match("node", 1, 2, MessageTypeNodeInactive, MessageLevelError, now))
+	a.IsFalse(silence.Match("node", 2, 2, MessageTypeNodeInactive, MessageLevelError, now))
+	a.IsFalse(silence.Match("node", 1, 2, MessageTypeNodeActive, MessageLevelError, now))
+
+	silence.Level = MessageLevelWarning
+	a.IsFalse(silence.Match("node", 1, 2, MessageTypeNodeInactive, MessageLevelError, now))
+	silence.Level = ""
+
+	// 临时静默
+	silence.StartAt = uint64(now.Unix() - 60)
+	silence.EndAt = uint64(now.Unix() + 60)
+	a.IsTrue(silence.Match("node", 1, 2, MessageTypeNodeInactive, MessageLevelError, now))
+	a.IsFalse(silence.Match("node", 1, 2, MessageTypeNodeInactive, MessageLevelError, now.Add(2*time.Minute)))
+	silence.StartAt = 0
+	silence.EndAt = 0
+
+	// 周期性静默
+	silence.Schedule = []byte(`{"weekdays":[6],"timeFrom":"22:00","timeTo":"06:00"}`)
+	a.IsTrue(silence.Match("node", 1, 2, MessageTypeNodeInactive, MessageLevelError, now))
+	a.IsFalse(silence.Match("node", 1, 2, MessageTypeNodeInactive, MessageLevelError, now.Add(-12*time.Hour)))
+
+	silence.IsOn = false
+	a.IsFalse(silence.Match("node", 1, 2, MessageTypeNodeInactive, MessageLevelError, now))
+}
@@ -0,0 +1,48 @@
+package models
+
+import "github.com/iwind/TeaGo/dbs"
+
+// MessageSilence 告警静默规则
+type MessageSilence struct {
+	Id          uint64   `field:"id"`          // ID
+	AdminId     uint32   `field:"adminId"`     // 创建者管理员ID
+	Name        string   `field:"name"`        // 名称
+	Reason      string   `field:"reason"`      // 静默原因
+	Role        string   `field:"role"`        // 节点角色
+	ClusterId   uint32   `field:"clusterId"`   // 集群ID
+	NodeId      uint32   `field:"nodeId"`      // 节点ID
+	Type        string   `field:"type"`        // 消息类型
+	Level       string   `field:"level"`       // 消息级别
+	StartAt     uint64   `field:"startAt"`     // 开始时间
+	EndAt       uint64   `field:"endAt"`       // 结束时间
+	Schedule    dbs.JSON `field:"schedule"`    // 周期性静默时间段
+	IsOn        bool     `field:"isOn"`        // 是否启用
+	CountMuted  uint64   `field:"countMuted"`  // 屏蔽的消息数
+	LastMutedAt uint64   `field:"lastMutedAt"` // 最后屏蔽时间
+	CreatedAt   uint64   `field:"createdAt"`   // 创建时间
+	State       uint8    `field:"state"`       // 状态
+}
+
+type MessageSilenceOperator struct {
+	Id          any // ID
+	AdminId     any // 创建者管理员ID
+	Name        any // 名称
+	Reason      any // 静默原因
+	Role        any // 节点角色
+	ClusterId   any // 集群ID
+	NodeId      any // 节点ID
+	Type        any // 消息类型
+	Level       any // 消息级别
+	StartAt     any // 开始时间
+	EndAt       any // 结束时间
+	Schedule    any // 周期性静默时间段
+	IsOn        any // 是否启用
+	CountMuted  any // 屏蔽的消息数
+	LastMutedAt any // 最后屏蔽时间
+	CreatedAt   any // 创建时间
+	State       any // 状态
+}
+
+func NewMessageSilenceOperator() *MessageSilenceOperator {
+	return &MessageSilenceOperator{}
+}
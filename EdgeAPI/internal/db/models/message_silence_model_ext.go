@@ -0,0 +1,66 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/monitorconfigs"
+)
+
+// DecodeSchedule 解析周期性静默时间段
+func (this *MessageSilence) DecodeSchedule() (*monitorconfigs.SilenceScheduleConfig, error) {
+	if !IsNotNull(this.Schedule) {
+		return nil, nil
+	}
+
+	var config = &monitorconfigs.SilenceScheduleConfig{}
+	err := json.Unmarshal(this.Schedule, config)
+	if err != nil {
+		return nil, err
+	}
+	err = config.Init()
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Match 检查消息是否匹配当前静默规则
+func (this *MessageSilence) Match(role string, clusterId int64, nodeId int64, messageType MessageType, level string, now time.Time) bool {
+	if !this.IsOn {
+		return false
+	}
+	if len(this.Role) > 0 && this.Role != role {
+		return false
+	}
+	if this.ClusterId > 0 && int64(this.ClusterId) != clusterId {
+		return false
+	}
+	if this.NodeId > 0 && int64(this.NodeId) != nodeId {
+		return false
+	}
+	if len(this.Type) > 0 && this.Type != MessageTypeAll && this.Type != messageType {
+		return false
+	}
+	if len(this.Level) > 0 && this.Level != level {
+		return false
+	}
+	var timestamp = now.Unix()
+	if this.StartAt > 0 && timestamp < int64(this.StartAt) {
+		return false
+	}
+	if this.EndAt > 0 && timestamp >= int64(this.EndAt) {
+		return false
+	}
+
+	// 周期性时间段
+	schedule, err := this.DecodeSchedule()
+	if err != nil {
+		return false
+	}
+	if schedule != nil && !schedule.Match(now) {
+		return false
+	}
+
+	return true
+}
@@ -3,12 +3,11 @@ package models
 import (
 	"time"
 
-	"github.com/TeaOSLab/EdgeAPI/internal/goman"
-	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
-	"github.com/iwind/TeaGo/rands"
 	timeutil "github.com/iwind/TeaGo/utils/time"
 )
 
@@ -32,21 +31,6 @@ func NewMessageTaskDAO() *MessageTaskDAO {
 
 var SharedMessageTaskDAO *MessageTaskDAO
 
-func init() {
-	dbs.OnReadyDone(func() {
-		// 清理数据任务
-		var ticker = time.NewTicker(time.Duration(rands.Int(24, 48)) * time.Hour)
-		goman.New(func() {
-			for range ticker.C {
-				err := SharedMessageTaskDAO.CleanExpiredMessageTasks(nil, 30) // 只保留30天
-				if err != nil {
-					remotelogs.Error("SharedMessageTaskDAO", "clean expired data failed: "+err.Error())
-				}
-			}
-		})
-	})
-}
-
 func init() {
 	dbs.OnReady(func() {
 		SharedMessageTaskDAO = NewMessageTaskDAO()
@@ -95,3 +79,17 @@ func (this *MessageTaskDAO) CleanExpiredMessageTasks(tx *dbs.Tx, days int) error
 		Delete()
 	return err
 }
+
+// CleanWithRetention 按保留策略分批清理
+func (this *MessageTaskDAO) CleanWithRetention(tx *dbs.Tx, policy *systemconfigs.RetentionPolicy) error {
+	if !policy.IsOn() {
+		return nil
+	}
+	var day = timeutil.Format("Ymd", policy.ExpiresTime(time.Now()))
+	_, err := dbutils.DeleteInBatches(this, policy.BatchSize, func() *dbs.Query {
+		return this.Query(tx).
+			Where("(day IS NULL OR day<:day)").
+			Param("day", day)
+	})
+	return err
+}
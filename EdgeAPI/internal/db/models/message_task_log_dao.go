@@ -3,32 +3,16 @@ package models
 import (
 	"time"
 
-	"github.com/TeaOSLab/EdgeAPI/internal/goman"
-	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
-	"github.com/iwind/TeaGo/rands"
 	timeutil "github.com/iwind/TeaGo/utils/time"
 )
 
 type MessageTaskLogDAO dbs.DAO
 
-func init() {
-	dbs.OnReadyDone(func() {
-		// 清理数据任务
-		var ticker = time.NewTicker(time.Duration(rands.Int(24, 48)) * time.Hour)
-		goman.New(func() {
-			for range ticker.C {
-				err := SharedMessageTaskLogDAO.CleanExpiredLogs(nil, 30) // 只保留30天
-				if err != nil {
-					remotelogs.Error("SharedMessageTaskLogDAO", "clean expired data failed: "+err.Error())
-				}
-			}
-		})
-	})
-}
-
 func NewMessageTaskLogDAO() *MessageTaskLogDAO {
 	return dbs.NewDAO(&MessageTaskLogDAO{
 		DAOObject: dbs.DAOObject{
@@ -90,3 +74,17 @@ func (this *MessageTaskLogDAO) CleanExpiredLogs(tx *dbs.Tx, days int) error {
 		Delete()
 	return err
 }
+
+// CleanWithRetention 按保留策略分批清理
+func (this *MessageTaskLogDAO) CleanWithRetention(tx *dbs.Tx, policy *systemconfigs.RetentionPolicy) error {
+	if !policy.IsOn() {
+		return nil
+	}
+	var day = timeutil.Format("Ymd", policy.ExpiresTime(time.Now()))
+	_, err := dbutils.DeleteInBatches(this, policy.BatchSize, func() *dbs.Query {
+		return this.Query(tx).
+			Where("(day IS NULL OR day<:day)").
+			Param("day", day)
+	})
+	return err
+}
@@ -5,41 +5,24 @@ import (
 	"regexp"
 	"sort"
 	"strconv"
-	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	"github.com/TeaOSLab/EdgeAPI/internal/goman"
-	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
 	"github.com/iwind/TeaGo/lists"
 	"github.com/iwind/TeaGo/maps"
-	"github.com/iwind/TeaGo/rands"
 	"github.com/iwind/TeaGo/types"
 	timeutil "github.com/iwind/TeaGo/utils/time"
 )
 
 type MetricStatDAO dbs.DAO
 
-func init() {
-	dbs.OnReadyDone(func() {
-		// 清理数据任务
-		var ticker = time.NewTicker(time.Duration(rands.Int(24, 48)) * time.Hour)
-		goman.New(func() {
-			for range ticker.C {
-				err := SharedMetricStatDAO.Clean(nil)
-				if err != nil {
-					remotelogs.Error("SharedMetricStatDAO", "clean expired data failed: "+err.Error())
-				}
-			}
-		})
-	})
-}
-
 const MetricStatTablePartials = 20 // 表格Partial数量
 
 var metricHashRegexp = regexp.MustCompile(`^\w+$`)
@@ -138,13 +121,18 @@ func (this *MetricStatDAO) DeleteNodeItemStats(tx *dbs.Tx, nodeId int64, serverI
 			Attr("time", time)
 		if len(keepKeys) > 0 {
 			query.Reuse(false)
-			var s []string
+			var keepHashes = []string{}
 			for _, k := range keepKeys {
 				if metricHashRegexp.MatchString(k) {
-					s = append(s, "'"+k+"@"+types.String(nodeId)+"'")
+					keepHashes = append(keepHashes, k+"@"+types.String(nodeId))
 				}
 			}
-			query.Where("hash NOT IN (" + strings.Join(s, ",") + ")")
+
+			// 要保留的数据格式都不正确时不做任何删除，防止删除所有数据
+			if len(keepHashes) == 0 {
+				return nil
+			}
+			dbutils.WhereNotIn(query, "hash", "keepHashes", keepHashes)
 		}
 		err := query.
 			DeleteQuickly()
@@ -686,6 +674,18 @@ func (this *MetricStatDAO) FindLatestItemStatsWithServerId(tx *dbs.Tx, serverId
 
 // Clean 清理数据
 func (this *MetricStatDAO) Clean(tx *dbs.Tx) error {
+	return this.CleanWithRetention(tx, nil)
+}
+
+// CleanWithRetention 按指标的保留周期和保留策略分批清理数据
+func (this *MetricStatDAO) CleanWithRetention(tx *dbs.Tx, policy *systemconfigs.RetentionPolicy) error {
+	var retentionDay string
+	var batchSize int
+	if policy.IsOn() {
+		retentionDay = timeutil.Format("Ymd", policy.ExpiresTime(time.Now()))
+		batchSize = policy.BatchSize
+	}
+
 	for _, category := range serverconfigs.FindAllMetricItemCategoryCodes() {
 		var offset int64 = 0
 		var size int64 = 100
@@ -702,13 +702,16 @@ func (this *MetricStatDAO) Clean(tx *dbs.Tx) error {
 					ExpiresPeriod: int(item.ExpiresPeriod),
 				}
 				var expiresDay = config.ServerExpiresDay()
+				if retentionDay > expiresDay {
+					expiresDay = retentionDay
+				}
 				err := this.runBatch(func(table string, locker *sync.Mutex) error {
-					_, err := this.Query(tx).
-						Table(table).
-						Attr("itemId", item.Id).
-						Lte("createdDay", expiresDay).
-						Limit(10_000). // 一次性不要删除太多，防止阻塞其他操作
-						Delete()
+					_, err := dbutils.DeleteInBatches(this, batchSize, func() *dbs.Query {
+						return this.Query(tx).
+							Table(table).
+							Attr("itemId", item.Id).
+							Lte("createdDay", expiresDay)
+					})
 					return err
 				})
 				if err != nil {
@@ -4,14 +4,13 @@ import (
 	"sync"
 	"time"
 
-	"github.com/TeaOSLab/EdgeAPI/internal/goman"
-	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
 	"github.com/iwind/TeaGo/maps"
-	"github.com/iwind/TeaGo/rands"
 	"github.com/iwind/TeaGo/types"
 	timeutil "github.com/iwind/TeaGo/utils/time"
 )
@@ -20,21 +19,6 @@ type MetricSumStatDAO dbs.DAO
 
 const MetricSumStatTablePartials = 20 // 表格Partial数量
 
-func init() {
-	dbs.OnReadyDone(func() {
-		// 清理数据任务
-		var ticker = time.NewTicker(time.Duration(rands.Int(24, 48)) * time.Hour)
-		goman.New(func() {
-			for range ticker.C {
-				err := SharedMetricSumStatDAO.Clean(nil)
-				if err != nil {
-					remotelogs.Error("SharedMetricSumStatDAO", "clean expired data failed: "+err.Error())
-				}
-			}
-		})
-	})
-}
-
 func NewMetricSumStatDAO() *MetricSumStatDAO {
 	return dbs.NewDAO(&MetricSumStatDAO{
 		DAOObject: dbs.DAOObject{
@@ -215,6 +199,18 @@ func (this *MetricSumStatDAO) DeleteItemStats(tx *dbs.Tx, itemId int64) error {
 
 // Clean 清理数据
 func (this *MetricSumStatDAO) Clean(tx *dbs.Tx) error {
+	return this.CleanWithRetention(tx, nil)
+}
+
+// CleanWithRetention 按指标的保留周期和保留策略分批清理数据
+func (this *MetricSumStatDAO) CleanWithRetention(tx *dbs.Tx, policy *systemconfigs.RetentionPolicy) error {
+	var retentionDay string
+	var batchSize int
+	if policy.IsOn() {
+		retentionDay = timeutil.Format("Ymd", policy.ExpiresTime(time.Now()))
+		batchSize = policy.BatchSize
+	}
+
 	for _, category := range serverconfigs.FindAllMetricItemCategoryCodes() {
 		var offset int64 = 0
 		var size int64 = 100
@@ -231,14 +227,17 @@ func (this *MetricSumStatDAO) Clean(tx *dbs.Tx) error {
 					ExpiresPeriod: int(item.ExpiresPeriod),
 				}
 				var expiresDay = config.ServerExpiresDay()
+				if retentionDay > expiresDay {
+					expiresDay = retentionDay
+				}
 				err = this.runBatch(func(table string, locker *sync.Mutex) error {
-					_, err := this.Query(tx).
-						Table(table).
-						Attr("itemId", item.Id).
-						Where("(createdDay IS NULL OR createdDay<:day)").
-						Param("day", expiresDay).
-						Limit(10_000). // 一次性不要删除太多，防止阻塞其他操作
-						Delete()
+					_, err := dbutils.DeleteInBatches(this, batchSize, func() *dbs.Query {
+						return this.Query(tx).
+							Table(table).
+							Attr("itemId", item.Id).
+							Where("(createdDay IS NULL OR createdDay<:day)").
+							Param("day", expiresDay)
+					})
 					return err
 				})
 				if err != nil {
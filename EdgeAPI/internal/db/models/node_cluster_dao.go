@@ -569,7 +569,7 @@ func (this *NodeClusterDAO) ExistClusterDNSName(tx *dbs.Tx, dnsName string, excl
 }
 
 // UpdateClusterDNS 修改集群DNS相关信息
-func (this *NodeClusterDAO) UpdateClusterDNS(tx *dbs.Tx, clusterId int64, dnsName string, dnsDomainId int64, nodesAutoSync bool, serversAutoSync bool, cnameRecords []string, ttl int32, cnameAsDomain bool, includingLnNodes bool) error {
+func (this *NodeClusterDAO) UpdateClusterDNS(tx *dbs.Tx, clusterId int64, dnsName string, dnsDomainId int64, nodesAutoSync bool, serversAutoSync bool, cnameRecords []string, ttl int32, cnameAsDomain bool, includingLnNodes bool, conflictPolicy dnsconfigs.RecordConflictPolicy) error {
 	if clusterId <= 0 {
 		return errors.New("invalid clusterId")
 	}
@@ -617,6 +617,7 @@ func (this *NodeClusterDAO) UpdateClusterDNS(tx *dbs.Tx, clusterId int64, dnsNam
 		TTL:              ttl,
 		CNAMEAsDomain:    cnameAsDomain,
 		IncludingLnNodes: includingLnNodes,
+		ConflictPolicy:   conflictPolicy,
 	}
 	dnsJSON, err := json.Marshal(dnsConfig)
 	if err != nil {
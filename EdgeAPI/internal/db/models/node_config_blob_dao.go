@@ -0,0 +1,116 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/rands"
+)
+
+// NodeConfigBlobUsedInterval 更新最后使用时间的最小间隔，避免每次读取都写数据库
+const NodeConfigBlobUsedInterval = 3600
+
+type NodeConfigBlobDAO dbs.DAO
+
+func init() {
+	dbs.OnReadyDone(func() {
+		// 清理长时间没有使用的配置
+		var ticker = time.NewTicker(time.Duration(rands.Int(12, 24)) * time.Hour)
+		goman.New(func() {
+			for range ticker.C {
+				err := SharedNodeConfigBlobDAO.Clean(nil, 7) // 只保留N天内使用过的
+				if err != nil {
+					remotelogs.Error("NodeConfigBlobDAO", "clean expired data failed: "+err.Error())
+				}
+			}
+		})
+	})
+}
+
+func NewNodeConfigBlobDAO() *NodeConfigBlobDAO {
+	return dbs.NewDAO(&NodeConfigBlobDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeNodeConfigBlobs",
+			Model:  new(NodeConfigBlob),
+			PkName: "id",
+		},
+	}).(*NodeConfigBlobDAO)
+}
+
+var SharedNodeConfigBlobDAO *NodeConfigBlobDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedNodeConfigBlobDAO = NewNodeConfigBlobDAO()
+	})
+}
+
+// NodeConfigHash 计算配置内容的哈希值
+func NodeConfigHash(configJSON []byte) string {
+	var sum = sha256.Sum256(configJSON)
+	return hex.EncodeToString(sum[:])
+}
+
+// FindBlobData 根据哈希值查找压缩后的配置内容，如果不存在则返回nil
+func (this *NodeConfigBlobDAO) FindBlobData(tx *dbs.Tx, hash string) ([]byte, error) {
+	if len(hash) == 0 {
+		return nil, nil
+	}
+	one, err := this.Query(tx).
+		Attr("hash", hash).
+		Result("id", "data", "usedAt").
+		Find()
+	if err != nil || one == nil {
+		return nil, err
+	}
+	var blob = one.(*NodeConfigBlob)
+
+	// 更新使用时间
+	var now = time.Now().Unix()
+	if int64(blob.UsedAt) < now-NodeConfigBlobUsedInterval {
+		err = this.Query(tx).
+			Pk(blob.Id).
+			Set("usedAt", now).
+			UpdateQuickly()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return blob.Data, nil
+}
+
+// CreateBlob 保存压缩后的配置内容，如果已经存在则只更新使用时间
+func (this *NodeConfigBlobDAO) CreateBlob(tx *dbs.Tx, hash string, data []byte, rawSize int) error {
+	var now = time.Now().Unix()
+	return this.Query(tx).
+		InsertOrUpdateQuickly(maps.Map{
+			"hash":      hash,
+			"data":      data,
+			"dataSize":  len(data),
+			"rawSize":   rawSize,
+			"createdAt": now,
+			"usedAt":    now,
+		}, maps.Map{
+			"usedAt": now,
+		})
+}
+
+// Clean 清理N天内没有使用的配置
+func (this *NodeConfigBlobDAO) Clean(tx *dbs.Tx, days int) error {
+	if days <= 0 {
+		days = 7
+	}
+	_, err := this.Query(tx).
+		Lt("usedAt", time.Now().Unix()-int64(days)*86400).
+		Delete()
+	return err
+}
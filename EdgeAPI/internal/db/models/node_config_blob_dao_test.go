@@ -0,0 +1,17 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestNodeConfigHash(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	var hash = models.NodeConfigHash([]byte(`{"id":1}`))
+	t.Log(hash)
+	a.IsTrue(len(hash) == 64)
+	a.IsTrue(hash == models.NodeConfigHash([]byte(`{"id":1}`)))
+	a.IsTrue(hash != models.NodeConfigHash([]byte(`{"id":2}`)))
+}
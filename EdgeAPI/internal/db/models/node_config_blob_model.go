@@ -0,0 +1,26 @@
+package models
+
+// NodeConfigBlob 节点配置内容（按哈希值存储）
+type NodeConfigBlob struct {
+	Id        uint64 `field:"id"`        // ID
+	Hash      string `field:"hash"`      // 配置内容SHA256
+	Data      []byte `field:"data"`      // 压缩后的配置内容
+	DataSize  uint32 `field:"dataSize"`  // 压缩后的尺寸
+	RawSize   uint32 `field:"rawSize"`   // 原始尺寸
+	CreatedAt uint64 `field:"createdAt"` // 创建时间
+	UsedAt    uint64 `field:"usedAt"`    // 最后使用时间
+}
+
+type NodeConfigBlobOperator struct {
+	Id        any // ID
+	Hash      any // 配置内容SHA256
+	Data      any // 压缩后的配置内容
+	DataSize  any // 压缩后的尺寸
+	RawSize   any // 原始尺寸
+	CreatedAt any // 创建时间
+	UsedAt    any // 最后使用时间
+}
+
+func NewNodeConfigBlobOperator() *NodeConfigBlobOperator {
+	return &NodeConfigBlobOperator{}
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
-	"strings"
 	"time"
 
 	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
@@ -14,7 +13,6 @@ import (
 	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
-	"github.com/TeaOSLab/EdgeAPI/internal/utils/numberutils"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils/sizes"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils/ttlcache"
 	"github.com/TeaOSLab/EdgeAPI/internal/zero"
@@ -99,6 +97,8 @@ func (this *NodeDAO) DisableNode(tx *dbs.Tx, nodeId int64) (err error) {
 		return err
 	}
 
+	this.NotifyBGPUpdate()
+
 	// 删除运行日志
 	return SharedNodeLogDAO.DeleteNodeLogs(tx, nodeconfigs.NodeRoleNode, nodeId)
 }
@@ -837,25 +837,50 @@ func (this *NodeDAO) UpdateNodeStatus(tx *dbs.Tx, nodeId int64, nodeStatus *node
 		return err
 	}
 
-	_, err = this.Query(tx).
+	// 修改之前的状态
+	oneNode, err := this.Query(tx).
 		Pk(nodeId).
-		Set("isActive", true).
-		Set("status", nodeStatusJSON).
-		Update()
+		Result("isInstalled", "isActive", "status", "version").
+		Find()
 	if err != nil {
 		return err
 	}
+	if oneNode == nil {
+		return nil
+	}
+	var oldNode = oneNode.(*Node)
 
-	// 自动设置安装状态
-	isInstalled, err := this.Query(tx).
-		Pk(nodeId).
-		Result("isInstalled").
-		FindBoolCol()
-	if err != nil {
-		return err
+	// 上线时需要立即写入
+	if tx != nil || !oldNode.IsActive || !dbutils.SharedUpdateQueue.Set(this.Instance, this.Table, this.PkName, nodeId, map[string]any{"status": nodeStatusJSON}) {
+		// 删除队列中旧的状态，防止之后覆盖当前的状态
+		dbutils.SharedUpdateQueue.Remove(this.Instance, this.Table, nodeId, "status")
+
+		_, err = this.Query(tx).
+			Pk(nodeId).
+			Set("isActive", true).
+			Set("status", nodeStatusJSON).
+			Update()
+		if err != nil {
+			return err
+		}
+	}
+
+	// 状态变化
+	if !oldNode.IsActive {
+		this.publishNodeState(tx, nodeId, NodeStateEventOnline)
+	}
+	var isSynced = nodeStatus.ConfigVersion == int64(oldNode.Version)
+	oldStatus, _ := oldNode.DecodeStatus()
+	if oldStatus == nil || (oldStatus.ConfigVersion == int64(oldNode.Version)) != isSynced {
+		if isSynced {
+			this.publishNodeState(tx, nodeId, NodeStateEventSynced)
+		} else {
+			this.publishNodeState(tx, nodeId, NodeStateEventUnsynced)
+		}
 	}
 
-	if !isInstalled {
+	// 自动设置安装状态
+	if !oldNode.IsInstalled {
 		return this.UpdateNodeIsInstalled(tx, nodeId, true)
 	}
 
@@ -896,6 +921,8 @@ func (this *NodeDAO) UpdateNodeIsOn(tx *dbs.Tx, nodeId int64, isOn bool) error {
 		return err
 	}
 
+	this.NotifyBGPUpdate()
+
 	return this.NotifyDNSUpdate(tx, nodeId)
 }
 
@@ -985,8 +1012,46 @@ func (this *NodeDAO) UpdateNodeInstallStatus(tx *dbs.Tx, nodeId int64, status *N
 }
 
 // ComposeNodeConfig 组合配置
-// TODO 提升运行速度
+// 启用DAO缓存时，同一个节点版本的配置只组合一次，创建节点任务时缓存失效
 func (this *NodeDAO) ComposeNodeConfig(tx *dbs.Tx, nodeId int64, dataMap *shared.DataMap, cacheMap *utils.CacheMap) (*nodeconfigs.NodeConfig, error) {
+	if tx != nil || !dbutils.SharedDAOCache.IsOn() {
+		return this.composeNodeConfig(tx, nodeId, dataMap, cacheMap)
+	}
+
+	version, err := this.FindNodeVersion(tx, nodeId)
+	if err != nil {
+		return nil, err
+	}
+	var cacheKey = types.String(nodeId) + "@" + types.String(version) + "@" + types.String(dataMap != nil)
+
+	// 配置中的DataMap会一起缓存，读取时放回到当前的DataMap中
+	var cachedConfig = &nodeconfigs.NodeConfig{}
+	if dbutils.SharedDAOCache.ReadJSON(tx, DAOCacheNodeConfig, cacheKey, cachedConfig) {
+		if dataMap != nil {
+			if cachedConfig.DataMap != nil {
+				for _, data := range cachedConfig.DataMap.Map {
+					dataMap.Put(data)
+				}
+			}
+			cachedConfig.DataMap = dataMap
+			if cacheMap != nil {
+				cacheMap.Put("DataMap", dataMap)
+			}
+		}
+		return cachedConfig, nil
+	}
+
+	config, err := this.composeNodeConfig(tx, nodeId, dataMap, cacheMap)
+	if err != nil {
+		return nil, err
+	}
+	dbutils.SharedDAOCache.WriteJSON(tx, DAOCacheNodeConfig, cacheKey, config)
+	return config, nil
+}
+
+// 组合配置
+// TODO 提升运行速度
+func (this *NodeDAO) composeNodeConfig(tx *dbs.Tx, nodeId int64, dataMap *shared.DataMap, cacheMap *utils.CacheMap) (*nodeconfigs.NodeConfig, error) {
 	if cacheMap == nil {
 		cacheMap = utils.NewCacheMap()
 	}
@@ -1870,6 +1935,14 @@ func (this *NodeDAO) UpdateNodeUpCount(tx *dbs.Tx, nodeId int64, isUp bool, maxU
 	}
 
 	if changed {
+		if isUp {
+			this.publishNodeState(tx, nodeId, NodeStateEventUp)
+		} else {
+			this.publishNodeState(tx, nodeId, NodeStateEventDown)
+		}
+
+		this.NotifyBGPUpdate()
+
 		err = this.NotifyDNSUpdate(tx, nodeId)
 		if err != nil {
 			return true, err
@@ -1885,16 +1958,34 @@ func (this *NodeDAO) UpdateNodeUp(tx *dbs.Tx, nodeId int64, isUp bool) error {
 		return errors.New("invalid nodeId")
 	}
 
+	oldIsUp, err := this.Query(tx).
+		Pk(nodeId).
+		Result("isUp").
+		FindBoolCol()
+	if err != nil {
+		return err
+	}
+
 	var op = NewNodeOperator()
 	op.Id = nodeId
 	op.IsUp = isUp
 	op.CountUp = 0
 	op.CountDown = 0
-	err := this.Save(tx, op)
+	err = this.Save(tx, op)
 	if err != nil {
 		return err
 	}
 
+	if oldIsUp != isUp {
+		if isUp {
+			this.publishNodeState(tx, nodeId, NodeStateEventUp)
+		} else {
+			this.publishNodeState(tx, nodeId, NodeStateEventDown)
+		}
+	}
+
+	this.NotifyBGPUpdate()
+
 	// TODO 只有前后状态不一致的时候才需要更新DNS
 
 	return this.NotifyDNSUpdate(tx, nodeId)
@@ -1905,12 +1996,58 @@ func (this *NodeDAO) UpdateNodeActive(tx *dbs.Tx, nodeId int64, isActive bool) e
 	if nodeId <= 0 {
 		return errors.New("invalid nodeId")
 	}
-	_, err := this.Query(tx).
+	oldIsActive, err := this.FindNodeActive(tx, nodeId)
+	if err != nil {
+		return err
+	}
+	_, err = this.Query(tx).
 		Pk(nodeId).
 		Set("isActive", isActive).
 		Set("inactiveNotifiedAt", 0).
 		Update()
-	return err
+	if err != nil {
+		return err
+	}
+
+	if oldIsActive != isActive {
+		if isActive {
+			this.publishNodeState(tx, nodeId, NodeStateEventOnline)
+		} else {
+			this.publishNodeState(tx, nodeId, NodeStateEventOffline)
+		}
+	}
+	return nil
+}
+
+// FindNodeStates 查找一组节点的当前状态
+// clusterId 为0表示所有集群
+func (this *NodeDAO) FindNodeStates(tx *dbs.Tx, clusterId int64) (result []*NodeState, err error) {
+	var query = this.Query(tx).
+		State(NodeStateEnabled).
+		Result("id", "clusterId", "isActive", "isUp", "status", "version")
+	if clusterId > 0 {
+		query.Attr("clusterId", clusterId)
+	}
+	ones, err := query.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range ones {
+		var node = one.(*Node)
+		var isSynced bool
+		status, _ := node.DecodeStatus()
+		if status != nil {
+			isSynced = status.ConfigVersion == int64(node.Version)
+		}
+		result = append(result, &NodeState{
+			NodeId:    int64(node.Id),
+			ClusterId: int64(node.ClusterId),
+			IsActive:  node.IsActive,
+			IsUp:      node.IsUp,
+			IsSynced:  isSynced,
+		})
+	}
+	return
 }
 
 // UpdateNodeInactiveNotifiedAt 修改节点的离线通知时间
@@ -1975,13 +2112,9 @@ func (this *NodeDAO) FindEnabledNodesWithIds(tx *dbs.Tx, nodeIds []int64) (resul
 	if len(nodeIds) == 0 {
 		return nil, nil
 	}
-	idStrings := []string{}
-	for _, nodeId := range nodeIds {
-		idStrings = append(idStrings, numberutils.FormatInt64(nodeId))
-	}
-	_, err = this.Query(tx).
-		State(NodeStateEnabled).
-		Where("id IN ("+strings.Join(idStrings, ", ")+")").
+	var query = this.Query(tx).
+		State(NodeStateEnabled)
+	_, err = dbutils.WhereIn(query, "id", "nodeIds", nodeIds).
 		Result("id", "connectedAPINodes", "isActive", "isOn").
 		Slice(&result).
 		Reuse(false).
@@ -2265,6 +2398,33 @@ func (this *NodeDAO) UpdateNodeAPIConfig(tx *dbs.Tx, nodeId int64, apiNodeAddrs
 	return this.NotifyUpdate(tx, nodeId)
 }
 
+// UpdateNodeSSLKeyPublicKey 修改节点接收证书私钥用的公钥
+func (this *NodeDAO) UpdateNodeSSLKeyPublicKey(tx *dbs.Tx, nodeId int64, publicKey []byte) error {
+	if nodeId <= 0 {
+		return errors.New("invalid nodeId")
+	}
+	return this.Query(tx).
+		Pk(nodeId).
+		Set("sslKeyPublicKey", publicKey).
+		UpdateQuickly()
+}
+
+// FindAllEnabledNodesWithSSLKeyPublicKey 查找所有已设置接收证书私钥公钥的节点
+func (this *NodeDAO) FindAllEnabledNodesWithSSLKeyPublicKey(tx *dbs.Tx, clusterIds []int64) (result []*Node, err error) {
+	var query = this.Query(tx).
+		State(NodeStateEnabled).
+		Result("id", "clusterId", "sslKeyPublicKey").
+		Where("LENGTH(sslKeyPublicKey)>0")
+	if len(clusterIds) > 0 {
+		query.Attr("clusterId", clusterIds)
+	}
+	_, err = query.
+		AscPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
 // NotifyUpdate 通知节点相关更新
 func (this *NodeDAO) NotifyUpdate(tx *dbs.Tx, nodeId int64) error {
 	// 这里只需要通知单个集群即可，因为节点是公用的，更新一个就相当于更新了所有
@@ -2296,6 +2456,24 @@ func (this *NodeDAO) NotifyLevelUpdate(tx *dbs.Tx, nodeId int64) error {
 	return nil
 }
 
+// FindAllEnabledNodesWithKeyword 根据名称或IP地址查找节点
+func (this *NodeDAO) FindAllEnabledNodesWithKeyword(tx *dbs.Tx, keyword string, size int64) (result []*Node, err error) {
+	if len(keyword) == 0 {
+		return
+	}
+	_, err = this.Query(tx).
+		State(NodeStateEnabled).
+		Result("id", "name", "clusterId", "isOn").
+		Where("(name LIKE :keyword OR id IN (SELECT nodeId FROM "+SharedNodeIPAddressDAO.Table+" WHERE role=:role AND state=1 AND ip LIKE :keyword))").
+		Param("keyword", dbutils.QuoteLike(keyword)).
+		Param("role", nodeconfigs.NodeRoleNode).
+		DescPk().
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
 // NotifyDNSUpdate 通知节点相关DNS更新
 func (this *NodeDAO) NotifyDNSUpdate(tx *dbs.Tx, nodeId int64) error {
 	clusterIds, err := this.FindEnabledAndOnNodeClusterIds(tx, nodeId)
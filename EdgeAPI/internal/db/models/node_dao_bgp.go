@@ -0,0 +1,97 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// NodeBGPNotifier 节点BGP宣告状态需要更新的通知
+var NodeBGPNotifier = make(chan bool, 2)
+
+// FindNodeBGP 查找节点BGP宣告配置和状态
+func (this *NodeDAO) FindNodeBGP(tx *dbs.Tx, nodeId int64) (config *nodeconfigs.NodeBGPConfig, status *nodeconfigs.NodeBGPStatus, err error) {
+	one, err := this.Query(tx).
+		Pk(nodeId).
+		Result("bgp", "bgpStatus").
+		Find()
+	if err != nil || one == nil {
+		return nil, nil, err
+	}
+	var node = one.(*Node)
+	return node.DecodeBGPConfig(), node.DecodeBGPStatus(), nil
+}
+
+// UpdateNodeBGPConfig 修改节点BGP宣告配置
+func (this *NodeDAO) UpdateNodeBGPConfig(tx *dbs.Tx, nodeId int64, config *nodeconfigs.NodeBGPConfig) error {
+	if nodeId <= 0 {
+		return ErrNotFound
+	}
+
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	var op = NewNodeOperator()
+	op.Id = nodeId
+	op.Bgp = configJSON
+	err = this.Save(tx, op)
+	if err != nil {
+		return err
+	}
+
+	this.NotifyBGPUpdate()
+	return nil
+}
+
+// UpdateNodeBGPMaintenance 修改节点BGP维护状态
+func (this *NodeDAO) UpdateNodeBGPMaintenance(tx *dbs.Tx, nodeId int64, isMaintaining bool) error {
+	config, _, err := this.FindNodeBGP(tx, nodeId)
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		return ErrNotFound
+	}
+	if config.IsMaintaining == isMaintaining {
+		return nil
+	}
+	config.IsMaintaining = isMaintaining
+	return this.UpdateNodeBGPConfig(tx, nodeId, config)
+}
+
+// UpdateNodeBGPStatus 修改节点BGP宣告状态
+func (this *NodeDAO) UpdateNodeBGPStatus(tx *dbs.Tx, nodeId int64, status *nodeconfigs.NodeBGPStatus) error {
+	statusJSON, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return this.Query(tx).
+		Pk(nodeId).
+		Set("bgpStatus", statusJSON).
+		UpdateQuickly()
+}
+
+// FindAllBGPNodes 查找所有设置过BGP宣告的节点
+// 包含已删除的节点，以便撤回这些节点之前的宣告
+func (this *NodeDAO) FindAllBGPNodes(tx *dbs.Tx) (result []*Node, err error) {
+	_, err = this.Query(tx).
+		Result("id", "name", "isOn", "isUp", "state", "bgp", "bgpStatus").
+		Where("(JSON_CONTAINS(bgp, 'true', '$.isOn') OR JSON_CONTAINS(bgpStatus, 'true', '$.isAnnounced'))").
+		AscPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// NotifyBGPUpdate 通知更新BGP宣告状态
+func (this *NodeDAO) NotifyBGPUpdate() {
+	select {
+	case NodeBGPNotifier <- true:
+	default:
+	}
+}
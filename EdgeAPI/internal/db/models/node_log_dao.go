@@ -11,6 +11,7 @@ import (
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
@@ -137,6 +138,37 @@ func (this *NodeLogDAO) DeleteExpiredLogs(tx *dbs.Tx, days int) error {
 	return err
 }
 
+// CleanWithRetention 按保留策略分批清除过期的日志
+// info级别的日志最多保留3天
+func (this *NodeLogDAO) CleanWithRetention(tx *dbs.Tx, policy *systemconfigs.RetentionPolicy) error {
+	if !policy.IsOn() {
+		return nil
+	}
+
+	var expiresTime = policy.ExpiresTime(time.Now())
+	var infoExpiresTime = time.Now().AddDate(0, 0, -3)
+	if infoExpiresTime.After(expiresTime) {
+		var infoExpireDay = timeutil.Format("Ymd", infoExpiresTime)
+		_, err := dbutils.DeleteInBatches(this, policy.BatchSize, func() *dbs.Query {
+			return this.Query(tx).
+				Attr("level", "info").
+				Where("day<=:day").
+				Param("day", infoExpireDay)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	var expireDay = timeutil.Format("Ymd", expiresTime)
+	_, err := dbutils.DeleteInBatches(this, policy.BatchSize, func() *dbs.Query {
+		return this.Query(tx).
+			Where("day<=:day").
+			Param("day", expireDay)
+	})
+	return err
+}
+
 // CountNodeLogs 计算节点日志数量
 func (this *NodeLogDAO) CountNodeLogs(tx *dbs.Tx,
 	role string,
@@ -50,6 +50,9 @@ type Node struct {
 	BackupIPs              dbs.JSON `field:"backupIPs"`              // 备用IP
 	ActionStatus           dbs.JSON `field:"actionStatus"`           // 当前动作配置
 	BypassMobile           int32    `field:"bypassMobile"`           // 是否过移动
+	SslKeyPublicKey        []byte   `field:"sslKeyPublicKey"`        // 接收证书私钥用的公钥
+	Bgp                    dbs.JSON `field:"bgp"`                    // BGP宣告配置
+	BgpStatus              dbs.JSON `field:"bgpStatus"`              // BGP宣告状态
 }
 
 type NodeOperator struct {
@@ -99,6 +102,9 @@ type NodeOperator struct {
 	BackupIPs              any // 备用IP
 	ActionStatus           any // 当前动作配置
 	BypassMobile           any // 是否过移动
+	SslKeyPublicKey        any // 接收证书私钥用的公钥
+	Bgp                    any // BGP宣告配置
+	BgpStatus              any // BGP宣告状态
 }
 
 func NewNodeOperator() *NodeOperator {
@@ -174,6 +174,34 @@ func (this *Node) DecodeDNSResolver() *nodeconfigs.DNSResolverConfig {
 	return resolverConfig
 }
 
+// DecodeBGPConfig 解析BGP宣告配置
+func (this *Node) DecodeBGPConfig() *nodeconfigs.NodeBGPConfig {
+	var config = nodeconfigs.NewNodeBGPConfig()
+	if IsNull(this.Bgp) {
+		return config
+	}
+
+	err := json.Unmarshal(this.Bgp, config)
+	if err != nil {
+		// ignore error
+	}
+	return config
+}
+
+// DecodeBGPStatus 解析BGP宣告状态
+func (this *Node) DecodeBGPStatus() *nodeconfigs.NodeBGPStatus {
+	var status = &nodeconfigs.NodeBGPStatus{}
+	if IsNull(this.BgpStatus) {
+		return status
+	}
+
+	err := json.Unmarshal(this.BgpStatus, status)
+	if err != nil {
+		// ignore error
+	}
+	return status
+}
+
 // DecodeLnAddrs 解析Ln地址
 func (this *Node) DecodeLnAddrs() []string {
 	if IsNull(this.LnAddrs) {
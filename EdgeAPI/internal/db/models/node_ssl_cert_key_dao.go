@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+type NodeSSLCertKeyDAO dbs.DAO
+
+func NewNodeSSLCertKeyDAO() *NodeSSLCertKeyDAO {
+	return dbs.NewDAO(&NodeSSLCertKeyDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeNodeSSLCertKeys",
+			Model:  new(NodeSSLCertKey),
+			PkName: "id",
+		},
+	}).(*NodeSSLCertKeyDAO)
+}
+
+var SharedNodeSSLCertKeyDAO *NodeSSLCertKeyDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedNodeSSLCertKeyDAO = NewNodeSSLCertKeyDAO()
+	})
+}
+
+// CreateKey 创建等待节点接收的私钥
+func (this *NodeSSLCertKeyDAO) CreateKey(tx *dbs.Tx, nodeId int64, clusterId int64, certId int64, fingerprint string, sealedKeyData []byte) error {
+	// 同一个节点同一个私钥只保留最新的一条
+	err := this.Query(tx).
+		Attr("nodeId", nodeId).
+		Attr("fingerprint", fingerprint).
+		DeleteQuickly()
+	if err != nil {
+		return err
+	}
+
+	var op = NewNodeSSLCertKeyOperator()
+	op.NodeId = nodeId
+	op.CertId = certId
+	op.Fingerprint = fingerprint
+	op.SealedKeyData = sealedKeyData
+	op.CreatedAt = time.Now().Unix()
+	err = this.Save(tx, op)
+	if err != nil {
+		return err
+	}
+
+	return SharedNodeTaskDAO.CreateNodeTask(tx, nodeconfigs.NodeRoleNode, clusterId, nodeId, 0, 0, NodeTaskTypeSSLCertKeysChanged)
+}
+
+// FindAllNodeKeys 查找节点所有等待接收的私钥
+func (this *NodeSSLCertKeyDAO) FindAllNodeKeys(tx *dbs.Tx, nodeId int64) (result []*NodeSSLCertKey, err error) {
+	_, err = this.Query(tx).
+		Attr("nodeId", nodeId).
+		AscPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// DeleteNodeKeys 删除节点已经接收的私钥
+func (this *NodeSSLCertKeyDAO) DeleteNodeKeys(tx *dbs.Tx, nodeId int64, keyIds []int64) error {
+	if nodeId <= 0 || len(keyIds) == 0 {
+		return nil
+	}
+	return this.Query(tx).
+		Attr("nodeId", nodeId).
+		Attr("id", keyIds).
+		DeleteQuickly()
+}
@@ -0,0 +1,24 @@
+package models
+
+// NodeSSLCertKey 等待节点接收的证书私钥
+type NodeSSLCertKey struct {
+	Id            uint64 `field:"id"`            // ID
+	NodeId        uint32 `field:"nodeId"`        // 节点ID
+	CertId        uint64 `field:"certId"`        // 证书ID
+	Fingerprint   string `field:"fingerprint"`   // 证书公钥指纹
+	SealedKeyData []byte `field:"sealedKeyData"` // 加密后的私钥
+	CreatedAt     uint64 `field:"createdAt"`     // 创建时间
+}
+
+type NodeSSLCertKeyOperator struct {
+	Id            any // ID
+	NodeId        any // 节点ID
+	CertId        any // 证书ID
+	Fingerprint   any // 证书公钥指纹
+	SealedKeyData any // 加密后的私钥
+	CreatedAt     any // 创建时间
+}
+
+func NewNodeSSLCertKeyOperator() *NodeSSLCertKeyOperator {
+	return &NodeSSLCertKeyOperator{}
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// NodeStateEventType 节点状态变化类型
+type NodeStateEventType = string
+
+const (
+	NodeStateEventOnline   NodeStateEventType = "online"   // 连接到API节点
+	NodeStateEventOffline  NodeStateEventType = "offline"  // 和API节点断开连接
+	NodeStateEventUp       NodeStateEventType = "up"       // 健康检查上线
+	NodeStateEventDown     NodeStateEventType = "down"     // 健康检查下线
+	NodeStateEventSynced   NodeStateEventType = "synced"   // 配置已同步
+	NodeStateEventUnsynced NodeStateEventType = "unsynced" // 配置未同步
+)
+
+// NodeStateEvent 节点状态变化事件
+type NodeStateEvent struct {
+	NodeId    int64
+	ClusterId int64
+	Type      NodeStateEventType
+	CreatedAt int64
+}
+
+// NodeState 节点当前状态
+type NodeState struct {
+	NodeId    int64
+	ClusterId int64
+	IsActive  bool
+	IsUp      bool
+	IsSynced  bool
+}
+
+// DiffNodeStates 对比节点状态，返回变化的事件类型
+func DiffNodeStates(oldState *NodeState, newState *NodeState) (eventTypes []NodeStateEventType) {
+	if oldState == nil || newState == nil {
+		return
+	}
+	if oldState.IsActive != newState.IsActive {
+		if newState.IsActive {
+			eventTypes = append(eventTypes, NodeStateEventOnline)
+		} else {
+			eventTypes = append(eventTypes, NodeStateEventOffline)
+		}
+	}
+	if oldState.IsUp != newState.IsUp {
+		if newState.IsUp {
+			eventTypes = append(eventTypes, NodeStateEventUp)
+		} else {
+			eventTypes = append(eventTypes, NodeStateEventDown)
+		}
+	}
+	if oldState.IsSynced != newState.IsSynced {
+		if newState.IsSynced {
+			eventTypes = append(eventTypes, NodeStateEventSynced)
+		} else {
+			eventTypes = append(eventTypes, NodeStateEventUnsynced)
+		}
+	}
+	return
+}
+
+// SharedNodeStateHub 节点状态变化的订阅中心
+var SharedNodeStateHub = NewNodeStateHub()
+
+// NodeStateHub 节点状态变化的订阅中心
+// 只分发当前API节点上发生的变化，订阅者处理不及时的事件会被丢弃
+type NodeStateHub struct {
+	subscribers map[chan *NodeStateEvent]bool
+	locker      sync.Mutex
+}
+
+func NewNodeStateHub() *NodeStateHub {
+	return &NodeStateHub{
+		subscribers: map[chan *NodeStateEvent]bool{},
+	}
+}
+
+// Subscribe 订阅节点状态变化，使用完后需要调用返回的取消函数
+func (this *NodeStateHub) Subscribe() (events <-chan *NodeStateEvent, cancel func()) {
+	var ch = make(chan *NodeStateEvent, 256)
+
+	this.locker.Lock()
+	this.subscribers[ch] = true
+	this.locker.Unlock()
+
+	return ch, func() {
+		this.locker.Lock()
+		delete(this.subscribers, ch)
+		this.locker.Unlock()
+	}
+}
+
+// Publish 发布节点状态变化
+func (this *NodeStateHub) Publish(event *NodeStateEvent) {
+	if event.CreatedAt <= 0 {
+		event.CreatedAt = time.Now().Unix()
+	}
+
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	for ch := range this.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// 发布节点状态变化
+func (this *NodeDAO) publishNodeState(tx *dbs.Tx, nodeId int64, eventType NodeStateEventType) {
+	clusterId, err := this.FindNodeClusterId(tx, nodeId)
+	if err != nil {
+		return
+	}
+	SharedNodeStateHub.Publish(&NodeStateEvent{
+		NodeId:    nodeId,
+		ClusterId: clusterId,
+		Type:      eventType,
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestDiffNodeStates(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var oldState = &models.NodeState{NodeId: 1, IsActive: true, IsUp: true, IsSynced: false}
+	a.IsTrue(len(models.DiffNodeStates(oldState, &models.NodeState{NodeId: 1, IsActive: true, IsUp: true, IsSynced: false})) == 0)
+
+	var eventTypes = models.DiffNodeStates(oldState, &models.NodeState{NodeId: 1, IsActive: false, IsUp: true, IsSynced: true})
+	t.Log(eventTypes)
+	a.IsTrue(len(eventTypes) == 2)
+	a.IsTrue(eventTypes[0] == models.NodeStateEventOffline)
+	a.IsTrue(eventTypes[1] == models.NodeStateEventSynced)
+
+	a.IsTrue(len(models.DiffNodeStates(nil, oldState)) == 0)
+}
+
+func TestNodeStateHub_Publish(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var hub = models.NewNodeStateHub()
+	events, cancel := hub.Subscribe()
+	hub.Publish(&models.NodeStateEvent{NodeId: 1, Type: models.NodeStateEventOnline})
+
+	var event = <-events
+	a.IsTrue(event.NodeId == 1)
+	a.IsTrue(event.CreatedAt > 0)
+
+	cancel()
+	hub.Publish(&models.NodeStateEvent{NodeId: 2, Type: models.NodeStateEventOffline})
+	a.IsTrue(len(events) == 0)
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	_ "github.com/go-sql-driver/mysql"
@@ -36,6 +37,9 @@ const (
 	NodeTaskTypeUpdatingServers              NodeTaskType = "updatingServers"              // 更新一组服务
 	NodeTaskTypeTOAChanged                   NodeTaskType = "toaChanged"                   // TOA配置变化
 	NodeTaskTypePlanChanged                  NodeTaskType = "planChanged"                  // 套餐变化
+	NodeTaskTypeSSLCertKeysChanged           NodeTaskType = "sslCertKeysChanged"           // 证书私钥变化
+	NodeTaskTypeACMEAuthenticationsChanged   NodeTaskType = "acmeAuthenticationsChanged"   // ACME HTTP认证信息变化
+	NodeTaskTypeHTTPCacheTaskChanged         NodeTaskType = "httpCacheTaskChanged"         // 缓存任务变化
 
 	// NS相关
 
@@ -73,6 +77,8 @@ func (this *NodeTaskDAO) CreateNodeTask(tx *dbs.Tx, role string, clusterId int64
 	if clusterId <= 0 || nodeId <= 0 {
 		return nil
 	}
+	dbutils.SharedDAOCache.Purge(DAOCacheNodeConfig)
+
 	var uniqueId = role + "@" + types.String(nodeId) + "@node@" + types.String(serverId) + "@" + taskType
 
 	// 用户信息
@@ -119,6 +125,7 @@ func (this *NodeTaskDAO) CreateClusterTask(tx *dbs.Tx, role string, clusterId in
 	if clusterId <= 0 {
 		return nil
 	}
+	dbutils.SharedDAOCache.Purge(DAOCacheNodeConfig)
 
 	var uniqueId = role + "@" + types.String(clusterId) + "@" + types.String(serverId) + "@cluster@" + taskType
 
@@ -128,8 +135,17 @@ func (this *NodeTaskDAO) CreateClusterTask(tx *dbs.Tx, role string, clusterId in
 		uniqueId += "@" + types.String(userId)
 	}
 
+	// 多个API节点同时写入时按顺序生成版本号
+	var unlock = this.LockClusterFence(role, clusterId)
+	defer unlock()
+
+	version, err := this.increaseClusterVersion(role, clusterId)
+	if err != nil {
+		return err
+	}
+
 	var updatedAt = time.Now().Unix()
-	_, _, err := this.Query(tx).
+	_, _, err = this.Query(tx).
 		InsertOrUpdate(maps.Map{
 			"role":       role,
 			"clusterId":  clusterId,
@@ -143,14 +159,14 @@ func (this *NodeTaskDAO) CreateClusterTask(tx *dbs.Tx, role string, clusterId in
 			"isOk":       0,
 			"isNotified": 0,
 			"error":      "",
-			"version":    time.Now().UnixNano(),
+			"version":    version,
 		}, maps.Map{
 			"updatedAt":  updatedAt,
 			"isDone":     0,
 			"isOk":       0,
 			"isNotified": 0,
 			"error":      "",
-			"version":    time.Now().UnixNano(),
+			"version":    version,
 			"serverId":   serverId,
 		})
 	return err
@@ -158,6 +174,21 @@ func (this *NodeTaskDAO) CreateClusterTask(tx *dbs.Tx, role string, clusterId in
 
 // ExtractNodeClusterTask 分解边缘节点集群任务
 func (this *NodeTaskDAO) ExtractNodeClusterTask(tx *dbs.Tx, clusterId int64, userId int64, serverId int64, taskType NodeTaskType) error {
+	var unlock = this.LockClusterFence(nodeconfigs.NodeRoleNode, clusterId)
+	defer unlock()
+
+	// 只删除已经分解的集群任务，分解过程中新加入的任务留到下次分解
+	maxVersion, err := this.Query(tx).
+		Attr("role", nodeconfigs.NodeRoleNode).
+		Attr("clusterId", clusterId).
+		Attr("nodeId", 0).
+		Attr("type", taskType).
+		Result("MAX(version)").
+		FindInt64Col(0)
+	if err != nil {
+		return err
+	}
+
 	nodeIds, err := SharedNodeDAO.FindAllNodeIdsMatch(tx, clusterId, true, configutils.BoolStateYes)
 	if err != nil {
 		return err
@@ -186,6 +217,7 @@ func (this *NodeTaskDAO) ExtractNodeClusterTask(tx *dbs.Tx, clusterId int64, use
 		Attr("clusterId", clusterId).
 		Attr("nodeId", 0).
 		Attr("type", taskType).
+		Lte("version", maxVersion).
 		Delete()
 	if err != nil {
 		return err
@@ -435,3 +467,9 @@ func (this *NodeTaskDAO) UpdateTasksNotified(tx *dbs.Tx, taskIds []int64) error
 func (this *NodeTaskDAO) increaseVersion(tx *dbs.Tx) (version int64, err error) {
 	return SharedSysLockerDAO.Increase(tx, "NODE_TASK_VERSION", 0)
 }
+
+// 生成集群任务版本号
+// 在集群写入栅栏内调用，以当前时间为基础，并保证比集群以前的版本号大，避免多个API节点的时钟不一致时版本号倒退
+func (this *NodeTaskDAO) increaseClusterVersion(role string, clusterId int64) (version int64, err error) {
+	return SharedSysLockerDAO.IncreaseAtLeast(nil, "NODE_CLUSTER_TASK_VERSION@"+role+"@"+types.String(clusterId), time.Now().UnixNano())
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/iwind/TeaGo/types"
+	stringutil "github.com/iwind/TeaGo/utils/string"
+)
+
+// NodeTaskFenceTimeoutSeconds 等待集群写入栅栏的最长时间
+// 超时后不再等待，继续写入，并通过冲突事件重新通知集群，避免因为锁等待导致配置修改失败
+var NodeTaskFenceTimeoutSeconds = 10
+
+// 两次冲突事件的最小间隔
+const nodeTaskFenceConflictInterval = 30 * time.Second
+
+// NodeTaskFenceQueue 本地排队队列，按照加入的顺序依次获得锁
+type NodeTaskFenceQueue struct {
+	queues map[string][]chan struct{} // key => waiters
+
+	locker sync.Mutex
+}
+
+func NewNodeTaskFenceQueue() *NodeTaskFenceQueue {
+	return &NodeTaskFenceQueue{
+		queues: map[string][]chan struct{}{},
+	}
+}
+
+// Lock 排队获取锁
+func (this *NodeTaskFenceQueue) Lock(key string) {
+	var ch = make(chan struct{})
+	this.locker.Lock()
+	var waiters = this.queues[key]
+	this.queues[key] = append(waiters, ch)
+	this.locker.Unlock()
+
+	// 前面还有其他的等待者
+	if len(waiters) > 0 {
+		<-ch
+	}
+}
+
+// Unlock 释放锁，并唤醒下一个等待者
+func (this *NodeTaskFenceQueue) Unlock(key string) {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	var waiters = this.queues[key]
+	if len(waiters) == 0 {
+		return
+	}
+	waiters = waiters[1:]
+	if len(waiters) == 0 {
+		delete(this.queues, key)
+		return
+	}
+	this.queues[key] = waiters
+	close(waiters[0])
+}
+
+// Len 排队中（包括持有锁）的数量
+func (this *NodeTaskFenceQueue) Len(key string) int {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return len(this.queues[key])
+}
+
+var nodeTaskFenceQueue = NewNodeTaskFenceQueue()
+
+var nodeTaskFenceConflictMap = map[string]time.Time{} // key => last conflict time
+var nodeTaskFenceConflictLocker = sync.Mutex{}
+
+// LockClusterFence 获取集群任务写入栅栏
+// 同一个API节点内通过本地队列排队，多个API节点之间通过MySQL咨询锁（GET_LOCK）排队，保证同一个集群的任务按顺序写入
+func (this *NodeTaskDAO) LockClusterFence(role string, clusterId int64) (unlock func()) {
+	var key = role + "@" + types.String(clusterId)
+	nodeTaskFenceQueue.Lock(key)
+
+	var localUnlock = func() {
+		nodeTaskFenceQueue.Unlock(key)
+	}
+
+	if this.Instance == nil {
+		return localUnlock
+	}
+
+	var lockName = this.fenceLockName(key)
+	conn, err := this.Instance.Raw().Conn(context.Background())
+	if err != nil {
+		remotelogs.Error("NODE_TASK_FENCE", "get db connection failed: "+err.Error())
+		return localUnlock
+	}
+
+	// 先尝试不等待直接获取锁，如果失败说明有其他API节点正在写入同一个集群
+	ok, err := this.getFenceLock(conn, lockName, 0)
+	if err == nil && !ok {
+		ok, err = this.getFenceLock(conn, lockName, NodeTaskFenceTimeoutSeconds)
+		this.notifyFenceConflict(role, clusterId, key)
+	}
+	if err != nil || !ok {
+		_ = conn.Close()
+		if err != nil {
+			remotelogs.Error("NODE_TASK_FENCE", "lock cluster '"+key+"' failed: "+err.Error())
+		} else {
+			remotelogs.Warn("NODE_TASK_FENCE", "wait for cluster '"+key+"' timeout, write without fence")
+		}
+		return localUnlock
+	}
+
+	return func() {
+		_, err := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName)
+		if err != nil {
+			remotelogs.Error("NODE_TASK_FENCE", "unlock cluster '"+key+"' failed: "+err.Error())
+		}
+		_ = conn.Close()
+		localUnlock()
+	}
+}
+
+// 获取咨询锁
+func (this *NodeTaskDAO) getFenceLock(conn *sql.Conn, lockName string, timeoutSeconds int) (ok bool, err error) {
+	var result sql.NullInt64
+	err = conn.QueryRowContext(context.Background(), "SELECT GET_LOCK(?, ?)", lockName, timeoutSeconds).Scan(&result)
+	if err != nil {
+		return false, err
+	}
+	return result.Valid && result.Int64 == 1, nil
+}
+
+// 咨询锁名称，同一个MySQL服务上可能有多个数据库，所以需要加上数据库名，且长度不能超过64
+func (this *NodeTaskDAO) fenceLockName(key string) string {
+	var lockName = "edgeNodeTaskFence@" + this.Instance.Name() + "@" + key
+	if len(lockName) > 64 {
+		lockName = "edgeNodeTaskFence@" + stringutil.Md5(lockName)
+	}
+	return lockName
+}
+
+// 记录冲突事件，由事件重新通知集群，保证节点最终取得最新的配置
+func (this *NodeTaskDAO) notifyFenceConflict(role string, clusterId int64, key string) {
+	nodeTaskFenceConflictLocker.Lock()
+	var lastTime = nodeTaskFenceConflictMap[key]
+	if time.Since(lastTime) < nodeTaskFenceConflictInterval {
+		nodeTaskFenceConflictLocker.Unlock()
+		return
+	}
+	nodeTaskFenceConflictMap[key] = time.Now()
+	nodeTaskFenceConflictLocker.Unlock()
+
+	err := SharedSysEventDAO.CreateEvent(nil, NewNodeClusterConfigConflictEvent(role, clusterId))
+	if err != nil {
+		remotelogs.Error("NODE_TASK_FENCE", "create conflict event failed: "+err.Error())
+	}
+}
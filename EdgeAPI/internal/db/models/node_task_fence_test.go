@@ -0,0 +1,53 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestNodeTaskFenceQueue(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var queue = models.NewNodeTaskFenceQueue()
+	queue.Lock("node@1")
+
+	var result = []int{}
+	var resultLocker = sync.Mutex{}
+	var wg = sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			queue.Lock("node@1")
+			resultLocker.Lock()
+			result = append(result, i)
+			resultLocker.Unlock()
+			queue.Unlock("node@1")
+		}(i)
+
+		// 保证按顺序加入队列
+		for queue.Len("node@1") < i+2 {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	// 不同的集群不需要排队
+	queue.Lock("node@2")
+	queue.Unlock("node@2")
+
+	queue.Unlock("node@1")
+	wg.Wait()
+
+	t.Log(result)
+	a.IsTrue(len(result) == 5)
+	for i, v := range result {
+		a.IsTrue(i == v)
+	}
+	a.IsTrue(queue.Len("node@1") == 0)
+}
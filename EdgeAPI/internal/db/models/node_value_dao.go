@@ -5,8 +5,10 @@ import (
 	"strings"
 	"time"
 
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
@@ -75,20 +77,26 @@ func (this *NodeValueDAO) CreateValue(tx *dbs.Tx, clusterId int64, role nodeconf
 
 // Clean 清除数据
 func (this *NodeValueDAO) Clean(tx *dbs.Tx) error {
-	var hour = timeutil.Format("YmdH", time.Now().Add(-2*time.Hour))
-	_, err := this.Query(tx).
-		Where("hour<=:hour").
-		Param("hour", hour).
-		Delete()
-	if err != nil {
-		return err
+	return this.CleanWithRetention(tx, systemconfigs.NewRetentionConfig().NodeValue)
+}
+
+// CleanWithRetention 按保留策略分批清除数据
+func (this *NodeValueDAO) CleanWithRetention(tx *dbs.Tx, policy *systemconfigs.RetentionPolicy) error {
+	if !policy.IsOn() {
+		return nil
 	}
-	return nil
+	var hour = timeutil.Format("YmdH", policy.ExpiresTime(time.Now()))
+	_, err := dbutils.DeleteInBatches(this, policy.BatchSize, func() *dbs.Query {
+		return this.Query(tx).
+			Where("hour<=:hour").
+			Param("hour", hour)
+	})
+	return err
 }
 
 // ListValues 列出最近的的数据
 func (this *NodeValueDAO) ListValues(tx *dbs.Tx, role string, nodeId int64, item string, timeRange nodeconfigs.NodeValueRange) (result []*NodeValue, err error) {
-	query := this.Query(tx).
+	query := dbutils.NewReadQuery(tx, this).
 		Attr("role", role).
 		Attr("nodeId", nodeId).
 		Attr("item", item)
@@ -110,7 +118,7 @@ func (this *NodeValueDAO) ListValues(tx *dbs.Tx, role string, nodeId int64, item
 
 // ListValuesWithClusterId 列出集群最近的的平均数据
 func (this *NodeValueDAO) ListValuesWithClusterId(tx *dbs.Tx, role string, clusterId int64, item string, key string, timeRange nodeconfigs.NodeValueRange) (result []*NodeValue, err error) {
-	query := this.Query(tx).
+	query := dbutils.NewReadQuery(tx, this).
 		Attr("role", role).
 		Attr("item", item).
 		Result("AVG(JSON_EXTRACT(value, '$." + key + "')) AS value, MIN(createdAt) AS createdAt")
@@ -154,7 +162,7 @@ func (this *NodeValueDAO) ListValuesWithClusterId(tx *dbs.Tx, role string, clust
 
 // ListValuesForUserNodes 列出用户节点相关的平均数据
 func (this *NodeValueDAO) ListValuesForUserNodes(tx *dbs.Tx, item string, key string, timeRange nodeconfigs.NodeValueRange) (result []*NodeValue, err error) {
-	query := this.Query(tx).
+	query := dbutils.NewReadQuery(tx, this).
 		Attr("role", "user").
 		Attr("item", item).
 		Result("AVG(JSON_EXTRACT(value, '$." + key + "')) AS value, MIN(createdAt) AS createdAt")
@@ -189,7 +197,7 @@ func (this *NodeValueDAO) ListValuesForUserNodes(tx *dbs.Tx, item string, key st
 
 // ListValuesForNSNodes 列出用户节点相关的平均数据
 func (this *NodeValueDAO) ListValuesForNSNodes(tx *dbs.Tx, item string, key string, timeRange nodeconfigs.NodeValueRange) (result []*NodeValue, err error) {
-	query := this.Query(tx).
+	query := dbutils.NewReadQuery(tx, this).
 		Attr("role", "dns").
 		Attr("item", item).
 		Result("AVG(JSON_EXTRACT(value, '$." + key + "')) AS value, MIN(createdAt) AS createdAt")
@@ -228,7 +236,7 @@ func (this *NodeValueDAO) SumAllNodeValues(tx *dbs.Tx, role string, item nodecon
 		return 0, 0, 0, nil
 	}
 
-	var query = this.Query(tx).
+	var query = dbutils.NewReadQuery(tx, this).
 		Result("SUM(JSON_EXTRACT(value, '$."+param+"')) AS sumValue", "AVG(JSON_EXTRACT(value, '$."+param+"')) AS avgValue", "MAX(JSON_EXTRACT(value, '$."+param+"')) AS maxValueResult"). // maxValue 是个MySQL Keyword，这里使用maxValueResult代替
 		Attr("role", role).
 		Attr("item", item)
@@ -256,7 +264,7 @@ func (this *NodeValueDAO) SumNodeValues(tx *dbs.Tx, role string, nodeId int64, i
 		return 0, nil
 	}
 
-	query := this.Query(tx).
+	query := dbutils.NewReadQuery(tx, this).
 		Attr("role", role).
 		Attr("nodeId", nodeId).
 		Attr("item", item)
@@ -285,7 +293,7 @@ func (this *NodeValueDAO) SumNodeGroupValues(tx *dbs.Tx, role string, groupId in
 		return 0, nil
 	}
 
-	query := this.Query(tx).
+	query := dbutils.NewReadQuery(tx, this).
 		Attr("role", role).
 		Where("nodeId IN (SELECT id FROM "+SharedNodeDAO.Table+" WHERE groupId=:groupId AND state=1)").
 		Param("groupId", groupId).
@@ -315,7 +323,7 @@ func (this *NodeValueDAO) SumNodeClusterValues(tx *dbs.Tx, role string, clusterI
 		return 0, nil
 	}
 
-	query := this.Query(tx).
+	query := dbutils.NewReadQuery(tx, this).
 		Attr("role", role).
 		Attr("clusterId", clusterId).
 		Attr("item", item)
@@ -5,6 +5,7 @@ package models
 import (
 	"encoding/json"
 
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	_ "github.com/go-sql-driver/mysql"
@@ -152,6 +153,10 @@ func (this *NSNodeDAO) UpdateNodeStatus(tx *dbs.Tx, nodeId int64, nodeStatus *no
 		return err
 	}
 
+	if tx == nil && dbutils.SharedUpdateQueue.Set(this.Instance, this.Table, this.PkName, nodeId, map[string]any{"status": nodeStatusJSON}) {
+		return nil
+	}
+
 	_, err = this.Query(tx).
 		Pk(nodeId).
 		Set("status", nodeStatusJSON).
@@ -12,6 +12,7 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
 	"github.com/iwind/TeaGo/types"
 )
 
@@ -425,6 +426,15 @@ func (this *OriginDAO) ComposeOriginConfig(tx *dbs.Tx, originId int64, dataMap *
 		}
 		config.Addr = addr
 		isOSS = ossconfigs.IsOSSProtocol(string(addr.Protocol))
+
+		// API节点解析的IP
+		if IsResolvableOriginAddr(addr) {
+			resolvedIPs, err := SharedOriginResolutionDAO.FindResolvedIPs(tx, originId, addr.Host)
+			if err != nil {
+				return nil, err
+			}
+			addr.ResolvedIPs = resolvedIPs
+		}
 	}
 
 	// oss
@@ -591,6 +601,65 @@ func (this *OriginDAO) UpdateOriginReverseProxyId(tx *dbs.Tx, originId int64, re
 		UpdateQuickly()
 }
 
+// ListEnabledOriginAddrsAfterId 列出某个ID之后的启用的源站地址
+func (this *OriginDAO) ListEnabledOriginAddrsAfterId(tx *dbs.Tx, lastOriginId int64, size int64) (result []*Origin, err error) {
+	_, err = this.Query(tx).
+		Result(OriginField_Id, OriginField_Addr).
+		State(OriginStateEnabled).
+		Attr(OriginField_IsOn, true).
+		Gt(OriginField_Id, lastOriginId).
+		AscPk().
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// ReplaceOriginCert 将所有源站中的证书替换为另外一个证书
+func (this *OriginDAO) ReplaceOriginCert(tx *dbs.Tx, oldCertId int64, newCertId int64) error {
+	if oldCertId <= 0 || newCertId <= 0 || oldCertId == newCertId {
+		return nil
+	}
+
+	var origins []*Origin
+	_, err := this.Query(tx).
+		State(OriginStateEnabled).
+		Where("JSON_CONTAINS(cert, :certJSON)").
+		Param("certJSON", maps.Map{"certId": oldCertId}.AsJSON()).
+		Result("id", "cert").
+		Slice(&origins).
+		FindAll()
+	if err != nil {
+		return err
+	}
+
+	for _, origin := range origins {
+		var ref = &sslconfigs.SSLCertRef{}
+		err = json.Unmarshal(origin.Cert, ref)
+		if err != nil {
+			return err
+		}
+		ref.CertId = newCertId
+		refJSON, err := json.Marshal(ref)
+		if err != nil {
+			return err
+		}
+
+		err = this.Query(tx).
+			Pk(origin.Id).
+			Set("cert", refJSON).
+			UpdateQuickly()
+		if err != nil {
+			return err
+		}
+		err = this.NotifyUpdate(tx, int64(origin.Id))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NotifyUpdate 通知更新
 func (this *OriginDAO) NotifyUpdate(tx *dbs.Tx, originId int64) error {
 	reverseProxyId, err := SharedReverseProxyDAO.FindReverseProxyContainsOriginId(tx, originId)
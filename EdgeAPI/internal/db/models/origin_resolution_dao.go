@@ -0,0 +1,167 @@
+package models
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+const (
+	OriginResolutionMinTTL   = 30   // 最小缓存时间（秒）
+	OriginResolutionMaxTTL   = 3600 // 最大缓存时间（秒）
+	OriginResolutionRetryTTL = 60   // 解析失败后重试间隔（秒）
+)
+
+type OriginResolutionDAO dbs.DAO
+
+func NewOriginResolutionDAO() *OriginResolutionDAO {
+	return dbs.NewDAO(&OriginResolutionDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeOriginResolutions",
+			Model:  new(OriginResolution),
+			PkName: "id",
+		},
+	}).(*OriginResolutionDAO)
+}
+
+var SharedOriginResolutionDAO *OriginResolutionDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedOriginResolutionDAO = NewOriginResolutionDAO()
+	})
+}
+
+// IsResolvableOriginAddr 判断源站地址是否需要由API节点解析
+func IsResolvableOriginAddr(addr *serverconfigs.NetworkAddressConfig) bool {
+	if addr == nil || len(addr.Host) == 0 {
+		return false
+	}
+
+	// 目前只有HTTP源站在节点上使用解析结果
+	if !addr.Protocol.IsHTTPFamily() && !addr.Protocol.IsHTTPSFamily() {
+		return false
+	}
+	if configutils.HasVariables(addr.Host) {
+		return false
+	}
+	return net.ParseIP(addr.Host) == nil
+}
+
+// FindOriginResolution 查找源站的解析结果
+func (this *OriginResolutionDAO) FindOriginResolution(tx *dbs.Tx, originId int64) (*OriginResolution, error) {
+	one, err := this.Query(tx).
+		Attr("originId", originId).
+		Find()
+	if err != nil || one == nil {
+		return nil, err
+	}
+	return one.(*OriginResolution), nil
+}
+
+// FindResolvedIPs 查找某个主机名当前解析到的IP
+func (this *OriginResolutionDAO) FindResolvedIPs(tx *dbs.Tx, originId int64, host string) ([]string, error) {
+	resolution, err := this.FindOriginResolution(tx, originId)
+	if err != nil || resolution == nil {
+		return nil, err
+	}
+	if resolution.Host != host {
+		return nil, nil
+	}
+	return resolution.DecodeIPs(), nil
+}
+
+// UpdateOriginResolution 保存解析结果
+// 只有在IP实际发生变化时才返回 changed=true，以便调用者决定是否通知节点更新配置
+func (this *OriginResolutionDAO) UpdateOriginResolution(tx *dbs.Tx, originId int64, host string, ips []string, ttl uint32, resolveErr error) (changed bool, err error) {
+	oldResolution, err := this.FindOriginResolution(tx, originId)
+	if err != nil {
+		return false, err
+	}
+
+	var now = time.Now().Unix()
+	var op = NewOriginResolutionOperator()
+	if oldResolution != nil {
+		op.Id = oldResolution.Id
+	}
+	op.OriginId = originId
+	op.Host = host
+	op.ResolvedAt = now
+
+	// 解析失败时保留原来的IP，稍后重试
+	if resolveErr != nil || len(ips) == 0 {
+		var errString = "no ip addresses found"
+		if resolveErr != nil {
+			errString = resolveErr.Error()
+		}
+		op.Error = utils.LimitString(errString, 1024)
+		op.ExpiresAt = now + OriginResolutionRetryTTL
+		if oldResolution != nil && oldResolution.Host != host {
+			// 主机名已经改变，原来的IP已经失效
+			op.Ips = "[]"
+			changed = len(oldResolution.DecodeIPs()) > 0
+		}
+		if changed {
+			op.ChangedAt = now
+			op.CountChanges = dbs.SQL("countChanges+1")
+		}
+		err = this.Save(tx, op)
+		return changed, err
+	}
+
+	ips = append([]string{}, ips...)
+	sort.Strings(ips)
+	ipsJSON, err := json.Marshal(ips)
+	if err != nil {
+		return false, err
+	}
+	op.Ips = ipsJSON
+	op.Error = ""
+
+	if ttl < OriginResolutionMinTTL {
+		ttl = OriginResolutionMinTTL
+	} else if ttl > OriginResolutionMaxTTL {
+		ttl = OriginResolutionMaxTTL
+	}
+	op.Ttl = ttl
+	op.ExpiresAt = now + int64(ttl)
+
+	if oldResolution == nil || oldResolution.Host != host || !this.equalIPs(oldResolution.DecodeIPs(), ips) {
+		changed = true
+		op.ChangedAt = now
+		op.CountChanges = dbs.SQL("countChanges+1")
+	}
+
+	err = this.Save(tx, op)
+	return changed, err
+}
+
+// DeleteOriginResolution 删除源站的解析结果
+func (this *OriginResolutionDAO) DeleteOriginResolution(tx *dbs.Tx, originId int64) error {
+	_, err := this.Query(tx).
+		Attr("originId", originId).
+		Delete()
+	return err
+}
+
+// 比较两组已排序的IP
+func (this *OriginResolutionDAO) equalIPs(ips1 []string, ips2 []string) bool {
+	if len(ips1) != len(ips2) {
+		return false
+	}
+	for index, ip := range ips1 {
+		if ips2[index] != ip {
+			return false
+		}
+	}
+	return true
+}
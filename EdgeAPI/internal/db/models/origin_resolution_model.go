@@ -0,0 +1,34 @@
+package models
+
+import "github.com/iwind/TeaGo/dbs"
+
+// OriginResolution 源站域名解析结果
+type OriginResolution struct {
+	Id           uint64   `field:"id"`           // ID
+	OriginId     uint32   `field:"originId"`     // 源站ID
+	Host         string   `field:"host"`         // 主机名
+	Ips          dbs.JSON `field:"ips"`          // 解析到的IP
+	Ttl          uint32   `field:"ttl"`          // TTL（秒）
+	Error        string   `field:"error"`        // 最后一次解析错误
+	ResolvedAt   uint64   `field:"resolvedAt"`   // 解析时间
+	ExpiresAt    uint64   `field:"expiresAt"`    // 过期时间
+	ChangedAt    uint64   `field:"changedAt"`    // IP最后变化时间
+	CountChanges uint32   `field:"countChanges"` // IP变化次数
+}
+
+type OriginResolutionOperator struct {
+	Id           any // ID
+	OriginId     any // 源站ID
+	Host         any // 主机名
+	Ips          any // 解析到的IP
+	Ttl          any // TTL（秒）
+	Error        any // 最后一次解析错误
+	ResolvedAt   any // 解析时间
+	ExpiresAt    any // 过期时间
+	ChangedAt    any // IP最后变化时间
+	CountChanges any // IP变化次数
+}
+
+func NewOriginResolutionOperator() *OriginResolutionOperator {
+	return &OriginResolutionOperator{}
+}
@@ -0,0 +1,14 @@
+package models
+
+import (
+	"encoding/json"
+)
+
+// DecodeIPs 解析IP列表
+func (this *OriginResolution) DecodeIPs() []string {
+	var ips = []string{}
+	if IsNotNull(this.Ips) {
+		_ = json.Unmarshal(this.Ips, &ips)
+	}
+	return ips
+}
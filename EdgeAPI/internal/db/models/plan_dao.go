@@ -5,6 +5,7 @@ package models
 import (
 	"encoding/json"
 
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
 	_ "github.com/go-sql-driver/mysql"
@@ -91,6 +92,66 @@ func (this *PlanDAO) FindEnabledPlan(tx *dbs.Tx, planId int64, cacheMap *utils.C
 	return result.(*Plan), nil
 }
 
+// FindBasicPlan 查找套餐基本信息
+func (this *PlanDAO) FindBasicPlan(tx *dbs.Tx, planId int64) (*Plan, error) {
+	one, err := this.Query(tx).
+		Pk(planId).
+		State(PlanStateEnabled).
+		Result("id", "name", "description", "isOn", "clusterId", "priceType", "monthlyPrice", "seasonallyPrice", "yearlyPrice").
+		Find()
+	if err != nil || one == nil {
+		return nil, err
+	}
+	return one.(*Plan), nil
+}
+
+// CreatePlan 创建套餐
+func (this *PlanDAO) CreatePlan(tx *dbs.Tx, plan *PlanOperator) (int64, error) {
+	if plan == nil {
+		return 0, errors.New("plan should not be nil")
+	}
+	plan.Id = nil
+	plan.IsOn = true
+	plan.State = PlanStateEnabled
+	return this.SaveInt64(tx, plan)
+}
+
+// UpdatePlan 修改套餐
+func (this *PlanDAO) UpdatePlan(tx *dbs.Tx, planId int64, plan *PlanOperator) error {
+	if planId <= 0 {
+		return errors.New("invalid planId")
+	}
+	if plan == nil {
+		return errors.New("plan should not be nil")
+	}
+
+	oldClusterId, err := this.FindPlanClusterId(tx, planId)
+	if err != nil {
+		return err
+	}
+
+	plan.Id = planId
+	plan.State = nil
+	err = this.Save(tx, plan)
+	if err != nil {
+		return err
+	}
+
+	// 集群变化时需要同时通知旧的集群
+	if oldClusterId > 0 && oldClusterId != types.Int64(plan.ClusterId) {
+		err = this.NotifyUpdate(tx, planId, oldClusterId)
+		if err != nil {
+			return err
+		}
+	}
+
+	clusterId, err := this.FindPlanClusterId(tx, planId)
+	if err != nil {
+		return err
+	}
+	return this.NotifyUpdate(tx, planId, clusterId)
+}
+
 // FindPlanName 根据主键查找名称
 func (this *PlanDAO) FindPlanName(tx *dbs.Tx, id int64) (string, error) {
 	return this.Query(tx).
@@ -128,6 +189,31 @@ func (this *PlanDAO) FindAllEnabledPlans(tx *dbs.Tx) (result []*Plan, err error)
 	return
 }
 
+// FindAllAvailablePlans 查找所有启用的套餐
+func (this *PlanDAO) FindAllAvailablePlans(tx *dbs.Tx) (result []*Plan, err error) {
+	_, err = this.Query(tx).
+		State(PlanStateEnabled).
+		Attr("isOn", true).
+		Slice(&result).
+		Desc("order").
+		AscPk().
+		FindAll()
+	return
+}
+
+// FindAllAvailableBasicPlans 查找所有启用的套餐的基本信息
+func (this *PlanDAO) FindAllAvailableBasicPlans(tx *dbs.Tx) (result []*Plan, err error) {
+	_, err = this.Query(tx).
+		State(PlanStateEnabled).
+		Attr("isOn", true).
+		Result("id", "name", "description", "isOn", "clusterId", "priceType", "monthlyPrice", "seasonallyPrice", "yearlyPrice").
+		Slice(&result).
+		Desc("order").
+		AscPk().
+		FindAll()
+	return
+}
+
 // SortPlans 增加排序
 func (this *PlanDAO) SortPlans(tx *dbs.Tx, planIds []int64) error {
 	if len(planIds) == 0 {
@@ -28,6 +28,7 @@ const (
 	PlanField_DailyWebsocketConnections   dbs.FieldName = "dailyWebsocketConnections"   // 每日Websocket连接数
 	PlanField_MonthlyWebsocketConnections dbs.FieldName = "monthlyWebsocketConnections" // 每月Websocket连接数
 	PlanField_MaxUploadSize               dbs.FieldName = "maxUploadSize"               // 最大上传
+	PlanField_Quota                       dbs.FieldName = "quota"                       // 用户对象数量限制
 )
 
 // Plan 用户套餐
@@ -57,6 +58,7 @@ type Plan struct {
 	DailyWebsocketConnections   uint64   `field:"dailyWebsocketConnections"`   // 每日Websocket连接数
 	MonthlyWebsocketConnections uint64   `field:"monthlyWebsocketConnections"` // 每月Websocket连接数
 	MaxUploadSize               dbs.JSON `field:"maxUploadSize"`               // 最大上传
+	Quota                       dbs.JSON `field:"quota"`                       // 用户对象数量限制
 }
 
 type PlanOperator struct {
@@ -85,6 +87,7 @@ type PlanOperator struct {
 	DailyWebsocketConnections   any // 每日Websocket连接数
 	MonthlyWebsocketConnections any // 每月Websocket连接数
 	MaxUploadSize               any // 最大上传
+	Quota                       any // 用户对象数量限制
 }
 
 func NewPlanOperator() *PlanOperator {
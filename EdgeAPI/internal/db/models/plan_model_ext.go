@@ -1 +1,37 @@
 package models
+
+import (
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+)
+
+type PlanPriceType = string
+
+const (
+	PlanPriceTypeTraffic   PlanPriceType = "traffic"   // 按流量
+	PlanPriceTypeBandwidth PlanPriceType = "bandwidth" // 按带宽
+	PlanPriceTypePeriod    PlanPriceType = "period"    // 按周期
+)
+
+// IsValidPlanPriceType 检查价格类型是否正确
+func IsValidPlanPriceType(priceType string) bool {
+	switch priceType {
+	case PlanPriceTypeTraffic, PlanPriceTypeBandwidth, PlanPriceTypePeriod:
+		return true
+	}
+	return false
+}
+
+// DecodeQuota 解析用户对象数量限制，没有设置时返回nil
+func (this *Plan) DecodeQuota() (*userconfigs.UserQuotaConfig, error) {
+	if !IsNotNull(this.Quota) {
+		return nil, nil
+	}
+	var config = userconfigs.NewUserQuotaConfig()
+	err := json.Unmarshal(this.Quota, config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
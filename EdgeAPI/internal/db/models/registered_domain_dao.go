@@ -0,0 +1,133 @@
+package models
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+type RegisteredDomainDAO dbs.DAO
+
+func NewRegisteredDomainDAO() *RegisteredDomainDAO {
+	return dbs.NewDAO(&RegisteredDomainDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeRegisteredDomains",
+			Model:  new(RegisteredDomain),
+			PkName: "id",
+		},
+	}).(*RegisteredDomainDAO)
+}
+
+var SharedRegisteredDomainDAO *RegisteredDomainDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedRegisteredDomainDAO = NewRegisteredDomainDAO()
+	})
+}
+
+// RegisteredDomainKey 域名在同步时使用的唯一标识
+func RegisteredDomainKey(userId int64, domain string) string {
+	return types.String(userId) + "@" + domain
+}
+
+// FindAllDomainKeys 读取所有域名的标识
+// 返回 key => domainId
+func (this *RegisteredDomainDAO) FindAllDomainKeys(tx *dbs.Tx) (map[string]int64, error) {
+	ones, _, err := this.Query(tx).
+		Result("id", "userId", "domain").
+		FindOnes()
+	if err != nil {
+		return nil, err
+	}
+	var result = map[string]int64{}
+	for _, one := range ones {
+		result[RegisteredDomainKey(one.GetInt64("userId"), one.GetString("domain"))] = one.GetInt64("id")
+	}
+	return result, nil
+}
+
+// CreateDomain 添加域名
+func (this *RegisteredDomainDAO) CreateDomain(tx *dbs.Tx, userId int64, domain string) error {
+	return this.Query(tx).
+		InsertOrUpdateQuickly(maps.Map{
+			"userId":    userId,
+			"domain":    domain,
+			"createdAt": time.Now().Unix(),
+		}, maps.Map{
+			"userId": userId,
+		})
+}
+
+// DeleteDomains 删除不再使用的域名
+func (this *RegisteredDomainDAO) DeleteDomains(tx *dbs.Tx, domainIds []int64) error {
+	if len(domainIds) == 0 {
+		return nil
+	}
+	return this.Query(tx).
+		Pk(domainIds).
+		DeleteQuickly()
+}
+
+// FindDomainsToCheck 查找需要检查的域名
+// 默认每周检查一次；即将到期的域名和上次检查失败的域名每天检查一次，以便及时发现续费
+func (this *RegisteredDomainDAO) FindDomainsToCheck(tx *dbs.Tx, size int64) (result []*RegisteredDomain, err error) {
+	var now = time.Now().Unix()
+	_, err = this.Query(tx).
+		Where("(checkedAt<:weekAgo OR (checkedAt<:dayAgo AND ((expiresAt>0 AND expiresAt<:monthLater) OR LENGTH(checkError)>0)))").
+		Param("weekAgo", now-7*86400).
+		Param("dayAgo", now-86400).
+		Param("monthLater", now+31*86400).
+		Asc("checkedAt").
+		AscPk().
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// UpdateDomainChecked 保存检查结果
+func (this *RegisteredDomainDAO) UpdateDomainChecked(tx *dbs.Tx, domainId int64, expiresAt int64, registrar string, checkError string) error {
+	var query = this.Query(tx).
+		Pk(domainId).
+		Set("checkedAt", time.Now().Unix()).
+		Set("checkError", utils.LimitString(checkError, 1024))
+	if len(checkError) == 0 {
+		// 检查失败时保留上次的结果
+		query.Set("expiresAt", expiresAt)
+		query.Set("registrar", utils.LimitString(registrar, 255))
+	}
+	return query.UpdateQuickly()
+}
+
+// FindAllExpiringDomains 查找N天后到期并且当天没有通知过的域名
+func (this *RegisteredDomainDAO) FindAllExpiringDomains(tx *dbs.Tx, days int) (result []*RegisteredDomain, err error) {
+	if days < 0 {
+		days = 0
+	}
+
+	_, err = this.Query(tx).
+		Gt("expiresAt", 0).
+		Where("FROM_UNIXTIME(expiresAt, '%Y-%m-%d')=:day AND FROM_UNIXTIME(notifiedAt, '%Y-%m-%d')!=:today").
+		Param("day", timeutil.FormatTime("Y-m-d", time.Now().Unix()+int64(days*86400))).
+		Param("today", timeutil.Format("Y-m-d")).
+		AscPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// UpdateDomainNotifiedAt 设置通知时间
+func (this *RegisteredDomainDAO) UpdateDomainNotifiedAt(tx *dbs.Tx, domainId int64) error {
+	return this.Query(tx).
+		Pk(domainId).
+		Set("notifiedAt", time.Now().Unix()).
+		UpdateQuickly()
+}
@@ -0,0 +1,30 @@
+package models
+
+// RegisteredDomain 网站使用的注册域名
+type RegisteredDomain struct {
+	Id         uint64 `field:"id"`         // ID
+	UserId     uint32 `field:"userId"`     // 用户ID
+	Domain     string `field:"domain"`     // 可注册的域名
+	ExpiresAt  uint64 `field:"expiresAt"`  // 注册过期时间
+	Registrar  string `field:"registrar"`  // 注册商
+	CheckedAt  uint64 `field:"checkedAt"`  // 上次检查时间
+	CheckError string `field:"checkError"` // 上次检查错误
+	NotifiedAt uint64 `field:"notifiedAt"` // 上次通知时间
+	CreatedAt  uint64 `field:"createdAt"`  // 创建时间
+}
+
+type RegisteredDomainOperator struct {
+	Id         any // ID
+	UserId     any // 用户ID
+	Domain     any // 可注册的域名
+	ExpiresAt  any // 注册过期时间
+	Registrar  any // 注册商
+	CheckedAt  any // 上次检查时间
+	CheckError any // 上次检查错误
+	NotifiedAt any // 上次通知时间
+	CreatedAt  any // 创建时间
+}
+
+func NewRegisteredDomainOperator() *RegisteredDomainOperator {
+	return &RegisteredDomainOperator{}
+}
@@ -280,6 +280,10 @@ func (this *ReportNodeDAO) UpdateNodeStatus(tx *dbs.Tx, nodeId int64, nodeStatus
 		return err
 	}
 
+	if tx == nil && dbutils.SharedUpdateQueue.Set(this.Instance, this.Table, this.PkName, nodeId, map[string]any{"status": nodeStatusJSON}) {
+		return nil
+	}
+
 	_, err = this.Query(tx).
 		Pk(nodeId).
 		Set("status", nodeStatusJSON).
@@ -283,6 +283,76 @@ func (this *ReverseProxyDAO) CreateReverseProxy(tx *dbs.Tx, adminId int64, userI
 	return reverseProxyId, nil
 }
 
+// CreateReverseProxyFromConfig 从完整的配置中创建反向代理，其中的源站也会一起创建
+// 源站中的证书引用需要调用者事先换成当前系统中的证书ID
+func (this *ReverseProxyDAO) CreateReverseProxyFromConfig(tx *dbs.Tx, adminId int64, userId int64, config *serverconfigs.ReverseProxyConfig) (int64, error) {
+	if config == nil {
+		return 0, errors.New("'config' should not be nil")
+	}
+
+	var createOrigins = func(origins []*serverconfigs.OriginConfig) ([]byte, error) {
+		var originRefs = []*serverconfigs.OriginRef{}
+		for _, origin := range origins {
+			if origin == nil {
+				continue
+			}
+			var addrJSON []byte
+			if origin.Addr != nil {
+				var err error
+				addrJSON, err = json.Marshal(origin.Addr)
+				if err != nil {
+					return nil, err
+				}
+			}
+			originId, err := SharedOriginDAO.CreateOrigin(tx, adminId, userId, origin.Name, addrJSON, origin.OSS, origin.Description, types.Int32(origin.Weight), origin.IsOn, origin.ConnTimeout, origin.ReadTimeout, origin.IdleTimeout, types.Int32(origin.MaxConns), types.Int32(origin.MaxIdleConns), origin.CertRef, origin.Domains, origin.RequestHost, origin.FollowPort, origin.HTTP2Enabled)
+			if err != nil {
+				return nil, err
+			}
+			originRefs = append(originRefs, &serverconfigs.OriginRef{
+				IsOn:     true,
+				OriginId: originId,
+			})
+		}
+		return json.Marshal(originRefs)
+	}
+
+	primaryOriginRefsJSON, err := createOrigins(config.PrimaryOrigins)
+	if err != nil {
+		return 0, err
+	}
+	backupOriginRefsJSON, err := createOrigins(config.BackupOrigins)
+	if err != nil {
+		return 0, err
+	}
+
+	var schedulingJSON []byte
+	if config.Scheduling != nil {
+		schedulingJSON, err = json.Marshal(config.Scheduling)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	reverseProxyId, err := this.CreateReverseProxy(tx, adminId, userId, schedulingJSON, primaryOriginRefsJSON, backupOriginRefsJSON)
+	if err != nil {
+		return 0, err
+	}
+
+	var proxyProtocolJSON []byte
+	if config.ProxyProtocol != nil {
+		proxyProtocolJSON, err = json.Marshal(config.ProxyProtocol)
+		if err != nil {
+			return 0, err
+		}
+	}
+	err = this.UpdateReverseProxy(tx, reverseProxyId, types.Int8(config.RequestHostType), config.RequestHost, config.RequestHostExcludingPort, config.RequestURI, config.StripPrefix, config.AutoFlush, config.AddHeaders, config.ConnTimeout, config.ReadTimeout, config.IdleTimeout, types.Int32(config.MaxConns), types.Int32(config.MaxIdleConns), proxyProtocolJSON, config.FollowRedirects, config.Retry50X, config.Retry40X)
+	if err != nil {
+		return 0, err
+	}
+
+	return reverseProxyId, nil
+}
+
 // CloneReverseProxy 复制反向代理
 func (this *ReverseProxyDAO) CloneReverseProxy(tx *dbs.Tx, fromReverseProxyId int64) (newReverseProxyId int64, err error) {
 	if fromReverseProxyId <= 0 {
@@ -574,6 +644,26 @@ func (this *ReverseProxyDAO) UpdateReverseProxy(tx *dbs.Tx,
 	return this.NotifyUpdate(tx, reverseProxyId)
 }
 
+// UpdateReverseProxyRequestHost 修改回源主机名
+func (this *ReverseProxyDAO) UpdateReverseProxyRequestHost(tx *dbs.Tx, reverseProxyId int64, requestHostType int8, requestHost string) error {
+	if reverseProxyId <= 0 {
+		return errors.New("invalid reverseProxyId")
+	}
+	if requestHostType < 0 {
+		requestHostType = 0
+	}
+
+	var op = NewReverseProxyOperator()
+	op.Id = reverseProxyId
+	op.RequestHostType = requestHostType
+	op.RequestHost = requestHost
+	err := this.Save(tx, op)
+	if err != nil {
+		return err
+	}
+	return this.NotifyUpdate(tx, reverseProxyId)
+}
+
 // FindReverseProxyContainsOriginId 查找包含某个源站的反向代理ID
 func (this *ReverseProxyDAO) FindReverseProxyContainsOriginId(tx *dbs.Tx, originId int64) (int64, error) {
 	return this.Query(tx).
@@ -639,3 +729,22 @@ func (this *ReverseProxyDAO) NotifyUpdate(tx *dbs.Tx, reverseProxyId int64) erro
 
 	return nil
 }
+
+// CloneReverseProxyRef 复制反向代理引用中的反向代理，返回新的引用
+func (this *ReverseProxyDAO) CloneReverseProxyRef(tx *dbs.Tx, reverseProxyRefJSON []byte) ([]byte, error) {
+	if !IsNotNull(reverseProxyRefJSON) {
+		return nil, nil
+	}
+	var ref = &serverconfigs.ReverseProxyRef{}
+	err := json.Unmarshal(reverseProxyRefJSON, ref)
+	if err != nil {
+		return nil, err
+	}
+	if ref.ReverseProxyId > 0 {
+		ref.ReverseProxyId, err = this.CloneReverseProxy(tx, ref.ReverseProxyId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(ref)
+}
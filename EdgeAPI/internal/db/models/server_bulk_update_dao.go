@@ -0,0 +1,548 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+// MaxServerBulkUpdateServers 单次批量修改最多可以修改的服务数量
+const MaxServerBulkUpdateServers = 1000
+
+// 批量修改的项目
+const (
+	ServerBulkItemRequestHost = "requestHost" // 回源主机名
+	ServerBulkItemWebsocket   = "websocket"   // Websocket开关
+	ServerBulkItemCache       = "cache"       // 缓存开关
+	ServerBulkItemCachePolicy = "cachePolicy" // 缓存条件使用的缓存策略
+)
+
+type ServerBulkUpdateDAO dbs.DAO
+
+func NewServerBulkUpdateDAO() *ServerBulkUpdateDAO {
+	return dbs.NewDAO(&ServerBulkUpdateDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeServerBulkUpdates",
+			Model:  new(ServerBulkUpdate),
+			PkName: "id",
+		},
+	}).(*ServerBulkUpdateDAO)
+}
+
+var SharedServerBulkUpdateDAO *ServerBulkUpdateDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedServerBulkUpdateDAO = NewServerBulkUpdateDAO()
+	})
+}
+
+// ServerBulkFilter 批量修改时筛选服务的条件
+// 指定了服务ID时忽略其他条件
+type ServerBulkFilter struct {
+	ServerIds []int64
+	ClusterId int64
+	GroupId   int64
+	UserId    int64
+	Keyword   string
+}
+
+// ServerBulkPatch 批量修改的内容，为nil的项目不修改
+type ServerBulkPatch struct {
+	RequestHost   *ServerBulkRequestHostPatch `json:"requestHost,omitempty"`   // 回源主机名
+	WebsocketIsOn *bool                       `json:"websocketIsOn,omitempty"` // 是否启用Websocket
+	CacheIsOn     *bool                       `json:"cacheIsOn,omitempty"`     // 是否启用缓存
+	CachePolicy   *ServerBulkCachePolicyPatch `json:"cachePolicy,omitempty"`   // 替换缓存条件中的缓存策略
+}
+
+// ServerBulkRequestHostPatch 回源主机名设置
+type ServerBulkRequestHostPatch struct {
+	RequestHostType int8   `json:"requestHostType"` // 参考 serverconfigs.RequestHostType
+	RequestHost     string `json:"requestHost"`
+}
+
+// ServerBulkCachePolicyPatch 缓存策略替换
+type ServerBulkCachePolicyPatch struct {
+	FromCachePolicyId int64 `json:"fromCachePolicyId"`
+	ToCachePolicyId   int64 `json:"toCachePolicyId"`
+}
+
+// Validate 校验修改内容
+func (this *ServerBulkPatch) Validate() error {
+	if this.RequestHost == nil && this.WebsocketIsOn == nil && this.CacheIsOn == nil && this.CachePolicy == nil {
+		return errors.New("patch should not be empty")
+	}
+	if this.CachePolicy != nil && (this.CachePolicy.FromCachePolicyId <= 0 || this.CachePolicy.ToCachePolicyId <= 0) {
+		return errors.New("invalid cache policy id")
+	}
+	return nil
+}
+
+// ServerBulkChange 单个服务中的一项修改
+type ServerBulkChange struct {
+	ServerId   int64
+	ServerName string
+	Item       string // 修改的项目，参考 ServerBulkItem*
+	OldValue   string
+	NewValue   string
+}
+
+// ServerBulkSnapshot 单个服务修改之前的配置，用于回滚
+type ServerBulkSnapshot struct {
+	ServerId int64 `json:"serverId"`
+
+	HasRequestHost  bool   `json:"hasRequestHost"`
+	ReverseProxyId  int64  `json:"reverseProxyId"`
+	RequestHostType int8   `json:"requestHostType"`
+	RequestHost     string `json:"requestHost"`
+
+	WebId        int64           `json:"webId"`
+	HasWebsocket bool            `json:"hasWebsocket"`
+	Websocket    json.RawMessage `json:"websocket"`
+	HasCache     bool            `json:"hasCache"`
+	Cache        json.RawMessage `json:"cache"`
+}
+
+// 单个服务的修改计划
+type serverBulkPlan struct {
+	snapshot *ServerBulkSnapshot
+	changes  []*ServerBulkChange
+
+	requestHost  *ServerBulkRequestHostPatch
+	websocketRef *serverconfigs.HTTPWebsocketRef // WebsocketId为0时需要创建
+	cacheConfig  *serverconfigs.HTTPCacheConfig
+}
+
+// FindServerIdsWithFilter 查找符合条件的服务ID
+func (this *ServerBulkUpdateDAO) FindServerIdsWithFilter(tx *dbs.Tx, filter *ServerBulkFilter) ([]int64, error) {
+	if filter == nil {
+		return nil, errors.New("filter should not be empty")
+	}
+
+	var serverIds = []int64{}
+	if len(filter.ServerIds) > 0 {
+		var serverIdMap = map[int64]bool{}
+		for _, serverId := range filter.ServerIds {
+			if serverId > 0 && !serverIdMap[serverId] {
+				serverIdMap[serverId] = true
+				serverIds = append(serverIds, serverId)
+			}
+		}
+	} else {
+		// 防止误操作修改所有的服务
+		if filter.ClusterId <= 0 && filter.GroupId == 0 && filter.UserId <= 0 && len(filter.Keyword) == 0 {
+			return nil, errors.New("filter should not be empty")
+		}
+
+		servers, err := SharedServerDAO.ListEnabledServersMatch(tx, 0, MaxServerBulkUpdateServers+1, filter.GroupId, filter.Keyword, filter.UserId, filter.ClusterId, 0, []string{"http"}, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, server := range servers {
+			serverIds = append(serverIds, int64(server.Id))
+		}
+	}
+
+	if len(serverIds) > MaxServerBulkUpdateServers {
+		return nil, errors.New("too many servers, max: " + types.String(MaxServerBulkUpdateServers))
+	}
+	return serverIds, nil
+}
+
+// PreviewBulkPatch 预览修改内容，不会写入数据
+func (this *ServerBulkUpdateDAO) PreviewBulkPatch(tx *dbs.Tx, serverIds []int64, patch *ServerBulkPatch) ([]*ServerBulkChange, error) {
+	plans, err := this.composePlans(tx, serverIds, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes = []*ServerBulkChange{}
+	for _, plan := range plans {
+		changes = append(changes, plan.changes...)
+	}
+	return changes, nil
+}
+
+// ApplyBulkPatch 执行修改，并记录修改之前的配置
+// 需要在事务中执行，以保证所有的服务同时修改成功或者失败；没有任何修改时返回的ID为0
+func (this *ServerBulkUpdateDAO) ApplyBulkPatch(tx *dbs.Tx, adminId int64, serverIds []int64, patch *ServerBulkPatch) (bulkUpdateId int64, changes []*ServerBulkChange, err error) {
+	plans, err := this.composePlans(tx, serverIds, patch)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	changes = []*ServerBulkChange{}
+	var changedServerIds = []int64{}
+	var snapshots = []*ServerBulkSnapshot{}
+	for _, plan := range plans {
+		err = this.applyPlan(tx, plan)
+		if err != nil {
+			return 0, nil, errors.New("update server '" + types.String(plan.snapshot.ServerId) + "' failed: " + err.Error())
+		}
+		changes = append(changes, plan.changes...)
+		changedServerIds = append(changedServerIds, plan.snapshot.ServerId)
+		snapshots = append(snapshots, plan.snapshot)
+	}
+	if len(plans) == 0 {
+		return 0, changes, nil
+	}
+
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return 0, nil, err
+	}
+	serverIdsJSON, err := json.Marshal(changedServerIds)
+	if err != nil {
+		return 0, nil, err
+	}
+	snapshotsJSON, err := json.Marshal(snapshots)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var op = NewServerBulkUpdateOperator()
+	op.AdminId = adminId
+	op.Patch = patchJSON
+	op.ServerIds = serverIdsJSON
+	op.Snapshots = snapshotsJSON
+	op.CountServers = len(changedServerIds)
+	op.IsRolledBack = false
+	op.CreatedAt = time.Now().Unix()
+	bulkUpdateId, err = this.SaveInt64(tx, op)
+	if err != nil {
+		return 0, nil, err
+	}
+	return bulkUpdateId, changes, nil
+}
+
+// RollbackBulkUpdate 将服务恢复到批量修改之前的配置
+// 批量修改之后对同样项目的其他修改也会被覆盖
+func (this *ServerBulkUpdateDAO) RollbackBulkUpdate(tx *dbs.Tx, bulkUpdateId int64) error {
+	bulkUpdate, err := this.FindBulkUpdate(tx, bulkUpdateId)
+	if err != nil {
+		return err
+	}
+	if bulkUpdate == nil {
+		return errors.New("can not find bulk update '" + types.String(bulkUpdateId) + "'")
+	}
+	if bulkUpdate.IsRolledBack {
+		return errors.New("the bulk update has been rolled back")
+	}
+
+	var snapshots = []*ServerBulkSnapshot{}
+	if IsNotNull(bulkUpdate.Snapshots) {
+		err = json.Unmarshal(bulkUpdate.Snapshots, &snapshots)
+		if err != nil {
+			return errors.New("decode snapshots failed: " + err.Error())
+		}
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.HasRequestHost && snapshot.ReverseProxyId > 0 {
+			err = SharedReverseProxyDAO.UpdateReverseProxyRequestHost(tx, snapshot.ReverseProxyId, snapshot.RequestHostType, snapshot.RequestHost)
+			if err != nil {
+				return err
+			}
+		}
+		if snapshot.HasWebsocket && snapshot.WebId > 0 {
+			err = SharedHTTPWebDAO.UpdateWebsocket(tx, snapshot.WebId, snapshot.Websocket)
+			if err != nil {
+				return err
+			}
+		}
+		if snapshot.HasCache && snapshot.WebId > 0 {
+			err = SharedHTTPWebDAO.UpdateWebCache(tx, snapshot.WebId, snapshot.Cache)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = this.Query(tx).
+		Pk(bulkUpdateId).
+		Set("isRolledBack", true).
+		Set("rolledBackAt", time.Now().Unix()).
+		Update()
+	return err
+}
+
+// FindBulkUpdate 查找批量修改记录
+func (this *ServerBulkUpdateDAO) FindBulkUpdate(tx *dbs.Tx, bulkUpdateId int64) (*ServerBulkUpdate, error) {
+	one, err := this.Query(tx).
+		Pk(bulkUpdateId).
+		Find()
+	if err != nil || one == nil {
+		return nil, err
+	}
+	return one.(*ServerBulkUpdate), nil
+}
+
+// CountBulkUpdates 计算批量修改记录数量
+func (this *ServerBulkUpdateDAO) CountBulkUpdates(tx *dbs.Tx) (int64, error) {
+	return this.Query(tx).
+		Count()
+}
+
+// ListBulkUpdates 列出单页批量修改记录
+func (this *ServerBulkUpdateDAO) ListBulkUpdates(tx *dbs.Tx, offset int64, size int64) (result []*ServerBulkUpdate, err error) {
+	_, err = this.Query(tx).
+		Offset(offset).
+		Limit(size).
+		DescPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// 分析每个服务需要修改的内容
+// 只返回有修改的服务
+func (this *ServerBulkUpdateDAO) composePlans(tx *dbs.Tx, serverIds []int64, patch *ServerBulkPatch) ([]*serverBulkPlan, error) {
+	if patch == nil {
+		return nil, errors.New("patch should not be empty")
+	}
+	err := patch.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if len(serverIds) > MaxServerBulkUpdateServers {
+		return nil, errors.New("too many servers, max: " + types.String(MaxServerBulkUpdateServers))
+	}
+
+	var plans = []*serverBulkPlan{}
+	for _, serverId := range serverIds {
+		plan, err := this.composePlan(tx, serverId, patch)
+		if err != nil {
+			return nil, err
+		}
+		if plan != nil && len(plan.changes) > 0 {
+			plans = append(plans, plan)
+		}
+	}
+	return plans, nil
+}
+
+// 分析单个服务需要修改的内容
+func (this *ServerBulkUpdateDAO) composePlan(tx *dbs.Tx, serverId int64, patch *ServerBulkPatch) (*serverBulkPlan, error) {
+	server, err := SharedServerDAO.FindEnabledServer(tx, serverId)
+	if err != nil || server == nil {
+		return nil, err
+	}
+
+	var plan = &serverBulkPlan{
+		snapshot: &ServerBulkSnapshot{
+			ServerId: serverId,
+			WebId:    int64(server.WebId),
+		},
+	}
+	var addChange = func(item string, oldValue string, newValue string) {
+		plan.changes = append(plan.changes, &ServerBulkChange{
+			ServerId:   serverId,
+			ServerName: server.Name,
+			Item:       item,
+			OldValue:   oldValue,
+			NewValue:   newValue,
+		})
+	}
+
+	// 回源主机名
+	if patch.RequestHost != nil && IsNotNull(server.ReverseProxy) {
+		var ref = &serverconfigs.ReverseProxyRef{}
+		err = json.Unmarshal(server.ReverseProxy, ref)
+		if err != nil {
+			return nil, err
+		}
+		if ref.ReverseProxyId > 0 {
+			reverseProxy, err := SharedReverseProxyDAO.FindEnabledReverseProxy(tx, ref.ReverseProxyId)
+			if err != nil {
+				return nil, err
+			}
+			if reverseProxy != nil && (reverseProxy.RequestHostType != uint8(patch.RequestHost.RequestHostType) || reverseProxy.RequestHost != patch.RequestHost.RequestHost) {
+				plan.snapshot.HasRequestHost = true
+				plan.snapshot.ReverseProxyId = ref.ReverseProxyId
+				plan.snapshot.RequestHostType = int8(reverseProxy.RequestHostType)
+				plan.snapshot.RequestHost = reverseProxy.RequestHost
+				plan.requestHost = patch.RequestHost
+
+				oldJSON, _ := json.Marshal(&ServerBulkRequestHostPatch{
+					RequestHostType: int8(reverseProxy.RequestHostType),
+					RequestHost:     reverseProxy.RequestHost,
+				})
+				newJSON, _ := json.Marshal(patch.RequestHost)
+				addChange(ServerBulkItemRequestHost, string(oldJSON), string(newJSON))
+			}
+		}
+	}
+
+	if server.WebId == 0 || (patch.WebsocketIsOn == nil && patch.CacheIsOn == nil && patch.CachePolicy == nil) {
+		return plan, nil
+	}
+	web, err := SharedHTTPWebDAO.FindEnabledHTTPWeb(tx, int64(server.WebId))
+	if err != nil || web == nil {
+		return plan, err
+	}
+
+	// Websocket
+	if patch.WebsocketIsOn != nil {
+		var oldRef *serverconfigs.HTTPWebsocketRef
+		if IsNotNull(web.Websocket) {
+			oldRef = &serverconfigs.HTTPWebsocketRef{}
+			err = json.Unmarshal(web.Websocket, oldRef)
+			if err != nil {
+				return nil, err
+			}
+		}
+		newRef, changed := PatchServerBulkWebsocketRef(oldRef, *patch.WebsocketIsOn)
+		if changed {
+			plan.snapshot.HasWebsocket = true
+			plan.snapshot.Websocket = json.RawMessage(JSONBytes(web.Websocket))
+			plan.websocketRef = newRef
+			addChange(ServerBulkItemWebsocket, types.String(oldRef != nil && oldRef.IsOn), types.String(newRef.IsOn))
+		}
+	}
+
+	// 缓存
+	if patch.CacheIsOn != nil || patch.CachePolicy != nil {
+		var oldConfig *serverconfigs.HTTPCacheConfig
+		if IsNotNull(web.Cache) {
+			oldConfig = &serverconfigs.HTTPCacheConfig{}
+			err = json.Unmarshal(web.Cache, oldConfig)
+			if err != nil {
+				return nil, err
+			}
+		}
+		newConfig, items := PatchServerBulkCacheConfig(oldConfig, patch)
+		if len(items) > 0 {
+			plan.snapshot.HasCache = true
+			plan.snapshot.Cache = json.RawMessage(JSONBytes(web.Cache))
+			plan.cacheConfig = newConfig
+			for _, item := range items {
+				switch item {
+				case ServerBulkItemCache:
+					addChange(item, types.String(oldConfig != nil && oldConfig.IsOn), types.String(newConfig.IsOn))
+				case ServerBulkItemCachePolicy:
+					addChange(item, types.String(patch.CachePolicy.FromCachePolicyId), types.String(patch.CachePolicy.ToCachePolicyId))
+				}
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// 写入单个服务的修改
+func (this *ServerBulkUpdateDAO) applyPlan(tx *dbs.Tx, plan *serverBulkPlan) error {
+	if plan.requestHost != nil {
+		err := SharedReverseProxyDAO.UpdateReverseProxyRequestHost(tx, plan.snapshot.ReverseProxyId, plan.requestHost.RequestHostType, plan.requestHost.RequestHost)
+		if err != nil {
+			return err
+		}
+	}
+
+	if plan.websocketRef != nil {
+		if plan.websocketRef.WebsocketId <= 0 {
+			websocketId, err := SharedHTTPWebsocketDAO.CreateWebsocket(tx, nil, true, nil, true, "")
+			if err != nil {
+				return err
+			}
+			plan.websocketRef.WebsocketId = websocketId
+		}
+		websocketJSON, err := json.Marshal(plan.websocketRef)
+		if err != nil {
+			return err
+		}
+		err = SharedHTTPWebDAO.UpdateWebsocket(tx, plan.snapshot.WebId, websocketJSON)
+		if err != nil {
+			return err
+		}
+	}
+
+	if plan.cacheConfig != nil {
+		cacheJSON, err := plan.cacheConfig.AsJSON()
+		if err != nil {
+			return err
+		}
+		err = SharedHTTPWebDAO.UpdateWebCache(tx, plan.snapshot.WebId, cacheJSON)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PatchServerBulkWebsocketRef 修改Websocket开关
+// 原来没有Websocket设置并且需要启用时，返回的WebsocketId为0，需要调用者创建
+func PatchServerBulkWebsocketRef(oldRef *serverconfigs.HTTPWebsocketRef, isOn bool) (newRef *serverconfigs.HTTPWebsocketRef, changed bool) {
+	if oldRef == nil {
+		if !isOn {
+			return nil, false
+		}
+		return &serverconfigs.HTTPWebsocketRef{
+			IsPrior: true,
+			IsOn:    true,
+		}, true
+	}
+	if oldRef.IsOn == isOn && (oldRef.IsPrior || !isOn) {
+		return oldRef, false
+	}
+
+	var ref = *oldRef
+	ref.IsPrior = true
+	ref.IsOn = isOn
+	return &ref, true
+}
+
+// PatchServerBulkCacheConfig 修改缓存开关和缓存条件中的缓存策略，返回修改的项目
+// 不会改变传入的配置
+func PatchServerBulkCacheConfig(oldConfig *serverconfigs.HTTPCacheConfig, patch *ServerBulkPatch) (newConfig *serverconfigs.HTTPCacheConfig, items []string) {
+	if oldConfig != nil {
+		var config = *oldConfig
+		config.CacheRefs = nil
+		for _, cacheRef := range oldConfig.CacheRefs {
+			var ref = *cacheRef
+			config.CacheRefs = append(config.CacheRefs, &ref)
+		}
+		newConfig = &config
+	}
+
+	if patch.CacheIsOn != nil {
+		var isOn = *patch.CacheIsOn
+		if newConfig == nil {
+			if isOn {
+				newConfig = &serverconfigs.HTTPCacheConfig{
+					IsPrior:   true,
+					IsOn:      true,
+					CacheRefs: []*serverconfigs.HTTPCacheRef{},
+				}
+				items = append(items, ServerBulkItemCache)
+			}
+		} else if newConfig.IsOn != isOn || (isOn && !newConfig.IsPrior) {
+			newConfig.IsPrior = true
+			newConfig.IsOn = isOn
+			items = append(items, ServerBulkItemCache)
+		}
+	}
+
+	if patch.CachePolicy != nil && newConfig != nil && patch.CachePolicy.FromCachePolicyId != patch.CachePolicy.ToCachePolicyId {
+		var policyChanged = false
+		for _, cacheRef := range newConfig.CacheRefs {
+			if cacheRef.CachePolicyId == patch.CachePolicy.FromCachePolicyId {
+				cacheRef.CachePolicyId = patch.CachePolicy.ToCachePolicyId
+				cacheRef.CachePolicy = nil
+				policyChanged = true
+			}
+		}
+		if policyChanged {
+			items = append(items, ServerBulkItemCachePolicy)
+		}
+	}
+
+	return
+}
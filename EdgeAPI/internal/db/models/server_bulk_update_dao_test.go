@@ -0,0 +1,96 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestPatchServerBulkWebsocketRef(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	{
+		ref, changed := models.PatchServerBulkWebsocketRef(nil, false)
+		a.IsFalse(changed)
+		a.IsNil(ref)
+	}
+
+	{
+		ref, changed := models.PatchServerBulkWebsocketRef(nil, true)
+		a.IsTrue(changed)
+		a.IsTrue(ref.IsOn)
+		a.IsTrue(ref.WebsocketId == 0)
+	}
+
+	{
+		var oldRef = &serverconfigs.HTTPWebsocketRef{IsPrior: true, IsOn: true, WebsocketId: 2}
+		ref, changed := models.PatchServerBulkWebsocketRef(oldRef, true)
+		a.IsFalse(changed)
+		a.IsTrue(ref == oldRef)
+	}
+
+	{
+		var oldRef = &serverconfigs.HTTPWebsocketRef{IsPrior: true, IsOn: true, WebsocketId: 2}
+		ref, changed := models.PatchServerBulkWebsocketRef(oldRef, false)
+		a.IsTrue(changed)
+		a.IsFalse(ref.IsOn)
+		a.IsTrue(ref.WebsocketId == 2)
+		a.IsTrue(oldRef.IsOn)
+	}
+}
+
+func TestPatchServerBulkCacheConfig(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var isOn = true
+	var isOff = false
+
+	{
+		config, items := models.PatchServerBulkCacheConfig(nil, &models.ServerBulkPatch{CacheIsOn: &isOff})
+		a.IsNil(config)
+		a.IsTrue(len(items) == 0)
+	}
+
+	{
+		config, items := models.PatchServerBulkCacheConfig(nil, &models.ServerBulkPatch{CacheIsOn: &isOn})
+		a.IsTrue(config.IsOn)
+		a.IsTrue(len(items) == 1 && items[0] == models.ServerBulkItemCache)
+	}
+
+	{
+		var oldConfig = &serverconfigs.HTTPCacheConfig{
+			IsPrior: true,
+			IsOn:    true,
+			CacheRefs: []*serverconfigs.HTTPCacheRef{
+				{CachePolicyId: 1},
+				{CachePolicyId: 2},
+			},
+		}
+		config, items := models.PatchServerBulkCacheConfig(oldConfig, &models.ServerBulkPatch{
+			CacheIsOn: &isOn,
+			CachePolicy: &models.ServerBulkCachePolicyPatch{
+				FromCachePolicyId: 1,
+				ToCachePolicyId:   3,
+			},
+		})
+		a.IsTrue(len(items) == 1 && items[0] == models.ServerBulkItemCachePolicy)
+		a.IsTrue(config.CacheRefs[0].CachePolicyId == 3)
+		a.IsTrue(config.CacheRefs[1].CachePolicyId == 2)
+
+		// 不改变原有配置
+		a.IsTrue(oldConfig.CacheRefs[0].CachePolicyId == 1)
+	}
+
+	{
+		var oldConfig = &serverconfigs.HTTPCacheConfig{IsPrior: true, IsOn: true}
+		_, items := models.PatchServerBulkCacheConfig(oldConfig, &models.ServerBulkPatch{
+			CachePolicy: &models.ServerBulkCachePolicyPatch{
+				FromCachePolicyId: 1,
+				ToCachePolicyId:   3,
+			},
+		})
+		a.IsTrue(len(items) == 0)
+	}
+}
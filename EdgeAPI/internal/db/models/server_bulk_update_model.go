@@ -0,0 +1,32 @@
+package models
+
+import "github.com/iwind/TeaGo/dbs"
+
+// ServerBulkUpdate 服务批量修改记录
+type ServerBulkUpdate struct {
+	Id           uint64   `field:"id"`           // ID
+	AdminId      uint32   `field:"adminId"`      // 管理员ID
+	Patch        dbs.JSON `field:"patch"`        // 修改内容
+	ServerIds    dbs.JSON `field:"serverIds"`    // 修改的服务ID
+	Snapshots    dbs.JSON `field:"snapshots"`    // 修改之前的配置
+	CountServers uint32   `field:"countServers"` // 修改的服务数量
+	IsRolledBack bool     `field:"isRolledBack"` // 是否已回滚
+	RolledBackAt uint64   `field:"rolledBackAt"` // 回滚时间
+	CreatedAt    uint64   `field:"createdAt"`    // 创建时间
+}
+
+type ServerBulkUpdateOperator struct {
+	Id           interface{} // ID
+	AdminId      interface{} // 管理员ID
+	Patch        interface{} // 修改内容
+	ServerIds    interface{} // 修改的服务ID
+	Snapshots    interface{} // 修改之前的配置
+	CountServers interface{} // 修改的服务数量
+	IsRolledBack interface{} // 是否已回滚
+	RolledBackAt interface{} // 回滚时间
+	CreatedAt    interface{} // 创建时间
+}
+
+func NewServerBulkUpdateOperator() *ServerBulkUpdateOperator {
+	return &ServerBulkUpdateOperator{}
+}
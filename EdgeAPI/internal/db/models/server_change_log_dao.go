@@ -0,0 +1,139 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/rands"
+)
+
+type ServerChangeLogDAO dbs.DAO
+
+func init() {
+	dbs.OnReadyDone(func() {
+		// 清理过期的变更记录
+		var ticker = time.NewTicker(time.Duration(rands.Int(24, 48)) * time.Hour)
+		goman.New(func() {
+			for range ticker.C {
+				err := SharedServerChangeLogDAO.Clean(nil, 90) // 只保留N天
+				if err != nil {
+					remotelogs.Error("ServerChangeLogDAO", "clean expired data failed: "+err.Error())
+				}
+			}
+		})
+	})
+}
+
+func NewServerChangeLogDAO() *ServerChangeLogDAO {
+	return dbs.NewDAO(&ServerChangeLogDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeServerChangeLogs",
+			Model:  new(ServerChangeLog),
+			PkName: "id",
+		},
+	}).(*ServerChangeLogDAO)
+}
+
+var SharedServerChangeLogDAO *ServerChangeLogDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedServerChangeLogDAO = NewServerChangeLogDAO()
+	})
+}
+
+// RecordServerChanges 对比服务当前配置和上一次的配置快照，记录字段变更
+// 第一次调用时只保存配置快照
+func (this *ServerChangeLogDAO) RecordServerChanges(tx *dbs.Tx, serverId int64) error {
+	if serverId <= 0 {
+		return nil
+	}
+
+	config, err := SharedServerDAO.ComposeServerConfigWithServerId(tx, serverId, true, false)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	oldConfig, err := SharedServerConfigSnapshotDAO.FindServerConfig(tx, serverId)
+	if err != nil {
+		return err
+	}
+	if oldConfig == string(configJSON) {
+		return nil
+	}
+	err = SharedServerConfigSnapshotDAO.UpdateServerConfig(tx, serverId, string(configJSON))
+	if err != nil {
+		return err
+	}
+	if len(oldConfig) == 0 {
+		return nil
+	}
+
+	changes, err := DiffServerConfigJSON([]byte(oldConfig), configJSON)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	var summary = SummarizeServerChanges(changes)
+	if len(changes) > ServerChangeMaxItems {
+		changes = changes[:ServerChangeMaxItems]
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	var op = NewServerChangeLogOperator()
+	op.ServerId = serverId
+	op.UserId = config.UserId
+	op.Summary = summary
+	op.Changes = changesJSON
+	op.CreatedAt = time.Now().Unix()
+	return this.Save(tx, op)
+}
+
+// CountServerLogs 计算服务变更记录数量
+func (this *ServerChangeLogDAO) CountServerLogs(tx *dbs.Tx, serverId int64) (int64, error) {
+	return this.Query(tx).
+		Attr("serverId", serverId).
+		Count()
+}
+
+// ListServerLogs 列出单页服务变更记录
+func (this *ServerChangeLogDAO) ListServerLogs(tx *dbs.Tx, serverId int64, offset int64, size int64) (result []*ServerChangeLog, err error) {
+	_, err = this.Query(tx).
+		Attr("serverId", serverId).
+		Offset(offset).
+		Limit(size).
+		DescPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// Clean 清理N天以前的变更记录
+func (this *ServerChangeLogDAO) Clean(tx *dbs.Tx, days int) error {
+	if days <= 0 {
+		days = 90
+	}
+	return this.Query(tx).
+		Lt("createdAt", time.Now().Unix()-int64(days*86400)).
+		DeleteQuickly()
+}
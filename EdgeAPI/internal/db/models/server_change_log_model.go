@@ -0,0 +1,26 @@
+package models
+
+import "github.com/iwind/TeaGo/dbs"
+
+// ServerChangeLog 服务变更记录
+type ServerChangeLog struct {
+	Id        uint64   `field:"id"`        // ID
+	ServerId  uint32   `field:"serverId"`  // 服务ID
+	UserId    uint32   `field:"userId"`    // 用户ID
+	Summary   string   `field:"summary"`   // 变更摘要
+	Changes   dbs.JSON `field:"changes"`   // 字段变更
+	CreatedAt uint64   `field:"createdAt"` // 创建时间
+}
+
+type ServerChangeLogOperator struct {
+	Id        any // ID
+	ServerId  any // 服务ID
+	UserId    any // 用户ID
+	Summary   any // 变更摘要
+	Changes   any // 字段变更
+	CreatedAt any // 创建时间
+}
+
+func NewServerChangeLogOperator() *ServerChangeLogOperator {
+	return &ServerChangeLogOperator{}
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+)
+
+// DecodeChanges 解析字段变更
+func (this *ServerChangeLog) DecodeChanges() []*ServerChange {
+	var result = []*ServerChange{}
+	if !IsNotNull(this.Changes) {
+		return result
+	}
+	err := json.Unmarshal(this.Changes, &result)
+	if err != nil {
+		remotelogs.Error("ServerChangeLog", "decode changes failed: "+err.Error())
+	}
+	return result
+}
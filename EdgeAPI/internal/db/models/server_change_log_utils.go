@@ -0,0 +1,241 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ServerChangeMaxItems 每条变更记录最多保存的字段变更数量
+const ServerChangeMaxItems = 100
+
+// 字段值最多保存的长度
+const serverChangeMaxValueLength = 256
+
+type ServerChangeAction = string
+
+const (
+	ServerChangeActionAdd    ServerChangeAction = "add"
+	ServerChangeActionUpdate ServerChangeAction = "update"
+	ServerChangeActionDelete ServerChangeAction = "delete"
+)
+
+// ServerChange 字段变更
+type ServerChange struct {
+	Path     string             `json:"path"`     // 字段路径，比如 web.cache.isOn
+	Label    string             `json:"label"`    // 字段所属的设置名称，比如 缓存设置
+	Action   ServerChangeAction `json:"action"`   // 变更动作
+	OldValue string             `json:"oldValue"` // 旧的值（JSON）
+	NewValue string             `json:"newValue"` // 新的值（JSON）
+}
+
+// 不需要记录的字段，这些字段会自动变化，或者不属于服务本身的设置
+var serverChangeIgnoredPaths = []string{
+	"trafficLimitStatus",
+	"httpFirewallPolicyId",
+	"httpFirewallPolicy",
+	"httpCachePolicyId",
+	"httpCachePolicy",
+}
+
+// 字段路径前缀 => 设置名称，越具体的前缀越靠前
+var serverChangeLabels = []struct {
+	prefix string
+	label  string
+}{
+	{"web.cache", "缓存设置"},
+	{"web.firewallRef", "WAF设置"},
+	{"web.firewallPolicy", "WAF设置"},
+	{"web.locations", "路由规则"},
+	{"web.locationRefs", "路由规则"},
+	{"web.rewriteRules", "重写规则"},
+	{"web.rewriteRefs", "重写规则"},
+	{"web.redirectToHTTPS", "HTTPS跳转"},
+	{"web.hostRedirects", "URL跳转"},
+	{"web.pages", "自定义页面"},
+	{"web.shutdown", "临时关闭"},
+	{"web.requestHeaderPolicy", "请求Header"},
+	{"web.responseHeaderPolicy", "响应Header"},
+	{"web.accessLog", "访问日志"},
+	{"web.statRef", "统计设置"},
+	{"web.compression", "内容压缩"},
+	{"web.optimization", "页面优化"},
+	{"web.webp", "WebP"},
+	{"web.charset", "字符编码"},
+	{"web.root", "静态分发"},
+	{"web.websocket", "Websocket"},
+	{"web.fastcgi", "Fastcgi"},
+	{"web.auth", "访问鉴权"},
+	{"web.referers", "防盗链"},
+	{"web.userAgent", "UA名单"},
+	{"web.remoteAddr", "访客IP"},
+	{"web.requestLimit", "请求限制"},
+	{"web.requestScripts", "边缘脚本"},
+	{"web.uam", "5秒盾"},
+	{"web.cc", "CC防护"},
+	{"web", "Web设置"},
+	{"serverNames", "域名"},
+	{"aliasServerNames", "域名"},
+	{"http", "HTTP"},
+	{"https", "HTTPS"},
+	{"tcp", "TCP"},
+	{"tls", "TLS"},
+	{"udp", "UDP"},
+	{"reverseProxy", "源站"},
+	{"reverseProxyRef", "源站"},
+	{"trafficLimit", "流量限制"},
+	{"userPlan", "套餐"},
+	{"group", "分组"},
+	{"uam", "5秒盾"},
+	{"isOn", "启用状态"},
+	{"name", "名称"},
+	{"description", "描述"},
+}
+
+// DiffServerConfigJSON 对比两个服务配置，取得字段级别的变更
+func DiffServerConfigJSON(oldConfigJSON []byte, newConfigJSON []byte) ([]*ServerChange, error) {
+	var oldValues = map[string]string{}
+	var newValues = map[string]string{}
+	for _, pair := range []struct {
+		data   []byte
+		values map[string]string
+	}{{oldConfigJSON, oldValues}, {newConfigJSON, newValues}} {
+		if len(pair.data) == 0 {
+			continue
+		}
+		var value any
+		err := json.Unmarshal(pair.data, &value)
+		if err != nil {
+			return nil, err
+		}
+		flattenServerConfigValue("", value, pair.values)
+	}
+
+	var paths = []string{}
+	for path, oldValue := range oldValues {
+		newValue, ok := newValues[path]
+		if !ok || newValue != oldValue {
+			paths = append(paths, path)
+		}
+	}
+	for path := range newValues {
+		_, ok := oldValues[path]
+		if !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var result = []*ServerChange{}
+	for _, path := range paths {
+		if isServerChangeIgnored(path) {
+			continue
+		}
+		oldValue, oldOk := oldValues[path]
+		newValue, newOk := newValues[path]
+		var action = ServerChangeActionUpdate
+		if !oldOk {
+			action = ServerChangeActionAdd
+		} else if !newOk {
+			action = ServerChangeActionDelete
+		}
+		result = append(result, &ServerChange{
+			Path:     path,
+			Label:    serverChangeLabel(path),
+			Action:   action,
+			OldValue: truncateServerChangeValue(oldValue),
+			NewValue: truncateServerChangeValue(newValue),
+		})
+	}
+	return result, nil
+}
+
+// SummarizeServerChanges 生成可读的变更摘要，比如：修改了域名、缓存设置
+func SummarizeServerChanges(changes []*ServerChange) string {
+	if len(changes) == 0 {
+		return ""
+	}
+	var labels = []string{}
+	var labelMap = map[string]bool{}
+	for _, change := range changes {
+		if labelMap[change.Label] {
+			continue
+		}
+		labelMap[change.Label] = true
+		labels = append(labels, change.Label)
+	}
+	return "修改了" + strings.Join(labels, "、") + "（" + strconv.Itoa(len(changes)) + "项）"
+}
+
+// 将配置展开为 路径 => 值 的形式
+func flattenServerConfigValue(path string, value any, result map[string]string) {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 0 && len(path) > 0 {
+			result[path] = "{}"
+			return
+		}
+		for key, subValue := range v {
+			var subPath = key
+			if len(path) > 0 {
+				subPath = path + "." + key
+			}
+			flattenServerConfigValue(subPath, subValue, result)
+		}
+	case []any:
+		if len(v) == 0 {
+			result[path] = "[]"
+			return
+		}
+		for index, subValue := range v {
+			flattenServerConfigValue(path+"["+strconv.Itoa(index)+"]", subValue, result)
+		}
+	default:
+		valueJSON, err := json.Marshal(v)
+		if err != nil {
+			return
+		}
+		result[path] = string(valueJSON)
+	}
+}
+
+func isServerChangeIgnored(path string) bool {
+	for _, ignoredPath := range serverChangeIgnoredPaths {
+		if matchServerChangePath(path, ignoredPath) {
+			return true
+		}
+	}
+	return false
+}
+
+func serverChangeLabel(path string) string {
+	for _, label := range serverChangeLabels {
+		if matchServerChangePath(path, label.prefix) {
+			return label.label
+		}
+	}
+	return "其他设置"
+}
+
+// 判断路径是否属于某个字段，顶级字段需要完整匹配（http 不匹配 https），子字段可以匹配相关的字段（web.cache 匹配 web.cacheRefs）
+func matchServerChangePath(path string, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	if len(path) == len(prefix) {
+		return true
+	}
+	var c = path[len(prefix)]
+	return c == '.' || c == '[' || strings.Contains(prefix, ".")
+}
+
+func truncateServerChangeValue(value string) string {
+	var runes = []rune(value)
+	if len(runes) <= serverChangeMaxValueLength {
+		return value
+	}
+	return string(runes[:serverChangeMaxValueLength]) + "..."
+}
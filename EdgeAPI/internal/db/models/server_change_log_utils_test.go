@@ -0,0 +1,34 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestDiffServerConfigJSON(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var oldConfig = []byte(`{"isOn":true,"serverNames":[{"name":"example.com"}],"web":{"cache":{"isOn":false},"webp":{"isOn":true}},"trafficLimitStatus":{"untilDay":"20240101"},"http":{"isOn":true}}`)
+	var newConfig = []byte(`{"isOn":true,"serverNames":[{"name":"example.com"},{"name":"www.example.com"}],"web":{"cache":{"isOn":true}},"trafficLimitStatus":{"untilDay":"20240102"},"https":{"isOn":true},"http":{"isOn":true}}`)
+
+	changes, err := models.DiffServerConfigJSON(oldConfig, newConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, change := range changes {
+		t.Log(change.Action, change.Path, change.Label, change.OldValue, "=>", change.NewValue)
+	}
+	a.IsTrue(len(changes) == 4)
+	a.IsTrue(changes[0].Path == "https.isOn" && changes[0].Label == "HTTPS" && changes[0].Action == models.ServerChangeActionAdd)
+	a.IsTrue(changes[1].Path == "serverNames[1].name" && changes[1].Label == "域名")
+	a.IsTrue(changes[2].Path == "web.cache.isOn" && changes[2].Label == "缓存设置" && changes[2].OldValue == "false" && changes[2].NewValue == "true")
+	a.IsTrue(changes[3].Path == "web.webp.isOn" && changes[3].Label == "WebP" && changes[3].Action == models.ServerChangeActionDelete)
+
+	var summary = models.SummarizeServerChanges(changes)
+	t.Log(summary)
+	a.IsTrue(summary == "修改了HTTPS、域名、缓存设置、WebP（4项）")
+}
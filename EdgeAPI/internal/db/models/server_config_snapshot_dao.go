@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
+)
+
+type ServerConfigSnapshotDAO dbs.DAO
+
+func NewServerConfigSnapshotDAO() *ServerConfigSnapshotDAO {
+	return dbs.NewDAO(&ServerConfigSnapshotDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeServerConfigSnapshots",
+			Model:  new(ServerConfigSnapshot),
+			PkName: "id",
+		},
+	}).(*ServerConfigSnapshotDAO)
+}
+
+var SharedServerConfigSnapshotDAO *ServerConfigSnapshotDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedServerConfigSnapshotDAO = NewServerConfigSnapshotDAO()
+	})
+}
+
+// FindServerConfig 查找服务上一次的配置快照
+func (this *ServerConfigSnapshotDAO) FindServerConfig(tx *dbs.Tx, serverId int64) (string, error) {
+	return this.Query(tx).
+		Attr("serverId", serverId).
+		Result("config").
+		FindStringCol("")
+}
+
+// UpdateServerConfig 保存服务配置快照
+func (this *ServerConfigSnapshotDAO) UpdateServerConfig(tx *dbs.Tx, serverId int64, config string) error {
+	var now = time.Now().Unix()
+	return this.Query(tx).
+		InsertOrUpdateQuickly(maps.Map{
+			"serverId":  serverId,
+			"config":    config,
+			"updatedAt": now,
+		}, maps.Map{
+			"config":    config,
+			"updatedAt": now,
+		})
+}
+
+// DeleteServerConfig 删除服务配置快照
+func (this *ServerConfigSnapshotDAO) DeleteServerConfig(tx *dbs.Tx, serverId int64) error {
+	return this.Query(tx).
+		Attr("serverId", serverId).
+		DeleteQuickly()
+}
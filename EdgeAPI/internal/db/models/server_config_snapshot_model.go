@@ -0,0 +1,20 @@
+package models
+
+// ServerConfigSnapshot 服务配置快照，用来生成变更记录
+type ServerConfigSnapshot struct {
+	Id        uint64 `field:"id"`        // ID
+	ServerId  uint32 `field:"serverId"`  // 服务ID
+	Config    string `field:"config"`    // 配置
+	UpdatedAt uint64 `field:"updatedAt"` // 更新时间
+}
+
+type ServerConfigSnapshotOperator struct {
+	Id        any // ID
+	ServerId  any // 服务ID
+	Config    any // 配置
+	UpdatedAt any // 更新时间
+}
+
+func NewServerConfigSnapshotOperator() *ServerConfigSnapshotOperator {
+	return &ServerConfigSnapshotOperator{}
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/goman"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
@@ -11,7 +12,6 @@ import (
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
 	"github.com/iwind/TeaGo/logs"
-	"github.com/iwind/TeaGo/maps"
 	"github.com/iwind/TeaGo/rands"
 	"github.com/iwind/TeaGo/types"
 	timeutil "github.com/iwind/TeaGo/utils/time"
@@ -23,8 +23,23 @@ import (
 
 type ServerDailyStatDAO dbs.DAO
 
+// 批量写入的字段，和 SaveStats() 中的数据一一对应
+var serverDailyStatColumns = []string{"userId", "serverId", "regionId", "bytes", "cachedBytes", "countRequests", "countCachedRequests", "countAttackRequests", "attackBytes", "countStatus4xx", "countStatus5xx", "planId", "day", "hour", "timeFrom", "timeTo"}
+
+const serverDailyStatOnDuplicateUpdate = "bytes=bytes+VALUES(bytes), cachedBytes=cachedBytes+VALUES(cachedBytes), countRequests=countRequests+VALUES(countRequests), countCachedRequests=countCachedRequests+VALUES(countCachedRequests), countAttackRequests=countAttackRequests+VALUES(countAttackRequests), attackBytes=attackBytes+VALUES(attackBytes), countStatus4xx=countStatus4xx+VALUES(countStatus4xx), countStatus5xx=countStatus5xx+VALUES(countStatus5xx), planId=VALUES(planId)"
+
+// 统计数据批量写入队列
+var serverDailyStatWriter = dbutils.NewBatchWriter[[]any]("SERVER_DAILY_STAT", 100_000, 500, 1*time.Second, func(rows [][]any) error {
+	return SharedServerDailyStatDAO.insertStatRows(nil, rows)
+})
+
 func init() {
 	dbs.OnReadyDone(func() {
+		// 批量写入统计数据
+		goman.New(func() {
+			serverDailyStatWriter.Start()
+		})
+
 		// 清理数据任务
 		var ticker = time.NewTicker(time.Duration(rands.Int(24, 48)) * time.Hour)
 		goman.New(func() {
@@ -61,6 +76,7 @@ func init() {
 func (this *ServerDailyStatDAO) SaveStats(tx *dbs.Tx, stats []*pb.ServerDailyStat) error {
 	var serverUserMap = map[int64]int64{} // serverId => userId
 	var cacheMap = utils.NewCacheMap()
+	var rows = make([][]any, 0, len(stats))
 	for _, stat := range stats {
 		var day = timeutil.FormatTime("Ymd", stat.CreatedAt)
 		var hour = timeutil.FormatTime("YmdH", stat.CreatedAt)
@@ -82,40 +98,24 @@ func (this *ServerDailyStatDAO) SaveStats(tx *dbs.Tx, stats []*pb.ServerDailySta
 			}
 		}
 
-		_, _, err := this.Query(tx).
-			Param("bytes", stat.Bytes).
-			Param("cachedBytes", stat.CachedBytes).
-			Param("countRequests", stat.CountRequests).
-			Param("countCachedRequests", stat.CountCachedRequests).
-			Param("countAttackRequests", stat.CountAttackRequests).
-			Param("attackBytes", stat.AttackBytes).
-			InsertOrUpdate(maps.Map{
-				"userId":              serverUserId,
-				"serverId":            stat.ServerId,
-				"regionId":            stat.NodeRegionId,
-				"bytes":               stat.Bytes,
-				"cachedBytes":         stat.CachedBytes,
-				"countRequests":       stat.CountRequests,
-				"countCachedRequests": stat.CountCachedRequests,
-				"countAttackRequests": stat.CountAttackRequests,
-				"attackBytes":         stat.AttackBytes,
-				"planId":              stat.PlanId,
-				"day":                 day,
-				"hour":                hour,
-				"timeFrom":            timeFrom,
-				"timeTo":              timeTo,
-			}, maps.Map{
-				"bytes":               dbs.SQL("bytes+:bytes"),
-				"cachedBytes":         dbs.SQL("cachedBytes+:cachedBytes"),
-				"countRequests":       dbs.SQL("countRequests+:countRequests"),
-				"countCachedRequests": dbs.SQL("countCachedRequests+:countCachedRequests"),
-				"countAttackRequests": dbs.SQL("countAttackRequests+:countAttackRequests"),
-				"attackBytes":         dbs.SQL("attackBytes+:attackBytes"),
-				"planId":              stat.PlanId,
-			})
-		if err != nil {
-			return err
-		}
+		rows = append(rows, []any{
+			serverUserId,
+			stat.ServerId,
+			stat.NodeRegionId,
+			stat.Bytes,
+			stat.CachedBytes,
+			stat.CountRequests,
+			stat.CountCachedRequests,
+			stat.CountAttackRequests,
+			stat.AttackBytes,
+			stat.CountStatus4Xx,
+			stat.CountStatus5Xx,
+			stat.PlanId,
+			day,
+			hour,
+			timeFrom,
+			timeTo,
+		})
 
 		// 更新流量限制状态
 		if stat.CheckTrafficLimiting {
@@ -137,9 +137,83 @@ func (this *ServerDailyStatDAO) SaveStats(tx *dbs.Tx, stats []*pb.ServerDailySta
 		}
 	}
 
-	return nil
+	// 不在事务中时放入批量写入队列
+	if tx == nil && serverDailyStatWriter.IsRunning() {
+		for index, row := range rows {
+			if !serverDailyStatWriter.Push(row, 1*time.Second) {
+				// 队列已满时直接写入剩余的数据
+				return this.insertStatRows(nil, rows[index:])
+			}
+		}
+		return nil
+	}
+
+	return this.insertStatRows(tx, rows)
+}
+
+// 使用多行INSERT写入统计数据
+func (this *ServerDailyStatDAO) insertStatRows(tx *dbs.Tx, rows [][]any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var executor dbutils.SQLExecutor = this.Instance
+	if tx != nil {
+		executor = tx
+	}
+	_, err := dbutils.BatchInsert(executor, this.Table, serverDailyStatColumns, rows, serverDailyStatOnDuplicateUpdate)
+	return err
 }
 
+// SumUserAlertDailyBytes 计算用户告警使用的某天流量
+// serverId 为0表示用户的所有网站
+func (this *ServerDailyStatDAO) SumUserAlertDailyBytes(tx *dbs.Tx, userId int64, serverId int64, day string) (int64, error) {
+	if !regexputils.YYYYMMDD.MatchString(day) {
+		return 0, errors.New("invalid 'day':" + day)
+	}
+
+	var query = this.Query(tx).
+		Attr("userId", userId)
+	if serverId > 0 {
+		query.Attr("serverId", serverId)
+	}
+	return query.
+		Attr("day", day).
+		SumInt64("bytes", 0)
+}
+
+// SumUserAlertMinutelyStat 计算用户告警使用的某个5分钟内的统计
+// minute 格式为YYYYMMDDHHMM，并且已经格式化成每5分钟一个值；serverId 为0表示用户的所有网站
+func (this *ServerDailyStatDAO) SumUserAlertMinutelyStat(tx *dbs.Tx, userId int64, serverId int64, minute string) (stat *pb.ServerDailyStat, err error) {
+	stat = &pb.ServerDailyStat{}
+
+	if !regexp.MustCompile(`^\d{12}$`).MatchString(minute) {
+		return nil, errors.New("invalid 'minute':" + minute)
+	}
+
+	var query = this.Query(tx).
+		Attr("userId", userId)
+	if serverId > 0 {
+		query.Attr("serverId", serverId)
+	}
+	one, _, err := query.
+		Result("SUM(bytes) AS bytes, SUM(countRequests) AS countRequests, SUM(countStatus4xx) AS countStatus4xx, SUM(countStatus5xx) AS countStatus5xx").
+		Attr("day", minute[:8]).
+		Attr("timeFrom", minute[8:]+"00").
+		FindOne()
+	if err != nil {
+		return nil, err
+	}
+	if one == nil {
+		return
+	}
+
+	stat.Bytes = one.GetInt64("bytes")
+	stat.CountRequests = one.GetInt64("countRequests")
+	stat.CountStatus4Xx = one.GetInt64("countStatus4xx")
+	stat.CountStatus5Xx = one.GetInt64("countStatus5xx")
+	return
+}
 
 // SumCurrentDailyStat 查找当前时刻的数据统计
 func (this *ServerDailyStatDAO) SumCurrentDailyStat(tx *dbs.Tx, serverId int64) (*ServerDailyStat, error) {
@@ -641,6 +715,43 @@ func (this *ServerDailyStatDAO) FindStatsBetweenDays(tx *dbs.Tx, userId int64, s
 	return
 }
 
+// FindStatsWithGroup 查找日期段内的5分钟统计，用于统计查询
+// groupField 为分组字段，可以为空、serverId 或 regionId
+func (this *ServerDailyStatDAO) FindStatsWithGroup(tx *dbs.Tx, userId int64, serverIds []int64, dayFrom string, dayTo string, groupField string) (result []*ServerDailyStat, err error) {
+	if !regexputils.YYYYMMDD.MatchString(dayFrom) || !regexputils.YYYYMMDD.MatchString(dayTo) {
+		return
+	}
+	if dayFrom > dayTo {
+		dayFrom, dayTo = dayTo, dayFrom
+	}
+
+	var query = this.Query(tx).
+		Result("SUM(bytes) AS bytes", "SUM(cachedBytes) AS cachedBytes", "SUM(countRequests) AS countRequests", "SUM(countCachedRequests) AS countCachedRequests", "SUM(countAttackRequests) AS countAttackRequests", "SUM(attackBytes) AS attackBytes", "SUM(countStatus4xx) AS countStatus4xx", "SUM(countStatus5xx) AS countStatus5xx", "day", "timeFrom").
+		Between("day", dayFrom, dayTo).
+		Group("day").
+		Group("timeFrom")
+	if userId > 0 {
+		query.Attr("userId", userId)
+	}
+	if len(serverIds) > 0 {
+		query.Attr("serverId", serverIds)
+	}
+
+	switch groupField {
+	case "":
+	case "serverId", "regionId":
+		query.Result(groupField).
+			Group(groupField)
+	default:
+		return nil, errors.New("invalid group field '" + groupField + "'")
+	}
+
+	_, err = query.
+		Slice(&result).
+		FindAll()
+	return
+}
+
 // FindMonthlyStatsWithPlan 查找某月有套餐的流量
 // month YYYYMM
 func (this *ServerDailyStatDAO) FindMonthlyStatsWithPlan(tx *dbs.Tx, month string) (result []*ServerDailyStat, err error) {
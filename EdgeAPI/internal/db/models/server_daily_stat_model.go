@@ -12,6 +12,8 @@ type ServerDailyStat struct {
 	CountCachedRequests uint64  `field:"countCachedRequests"` // 缓存的请求数
 	CountAttackRequests uint64  `field:"countAttackRequests"` // 攻击请求数
 	AttackBytes         uint64  `field:"attackBytes"`         // 攻击流量
+	CountStatus4xx      uint64  `field:"countStatus4xx"`      // 4xx响应数
+	CountStatus5xx      uint64  `field:"countStatus5xx"`      // 5xx响应数
 	Day                 string  `field:"day"`                 // 日期YYYYMMDD
 	Hour                string  `field:"hour"`                // YYYYMMDDHH
 	TimeFrom            string  `field:"timeFrom"`            // 开始时间HHMMSS
@@ -32,6 +34,8 @@ type ServerDailyStatOperator struct {
 	CountCachedRequests interface{} // 缓存的请求数
 	CountAttackRequests interface{} // 攻击请求数
 	AttackBytes         interface{} // 攻击流量
+	CountStatus4xx      interface{} // 4xx响应数
+	CountStatus5xx      interface{} // 5xx响应数
 	Day                 interface{} // 日期YYYYMMDD
 	Hour                interface{} // YYYYMMDDHH
 	TimeFrom            interface{} // 开始时间HHMMSS
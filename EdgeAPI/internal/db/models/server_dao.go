@@ -12,14 +12,17 @@ import (
 	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models/dns"
 	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils/numberutils"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils/regexputils"
+	"github.com/TeaOSLab/EdgeAPI/internal/webhooks"
 	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/shared"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
@@ -91,6 +94,18 @@ func (this *ServerDAO) DisableServer(tx *dbs.Tx, serverId int64) (err error) {
 		return err
 	}
 
+	// 删除配置快照
+	err = SharedServerConfigSnapshotDAO.DeleteServerConfig(tx, serverId)
+	if err != nil {
+		return err
+	}
+
+	// 删除搜索关键词
+	err = SharedServerSearchKeywordDAO.DeleteServerKeywords(tx, serverId)
+	if err != nil {
+		return err
+	}
+
 	err = this.NotifyUpdate(tx, serverId)
 	if err != nil {
 		return err
@@ -105,6 +120,13 @@ func (this *ServerDAO) DisableServer(tx *dbs.Tx, serverId int64) (err error) {
 
 // FindEnabledServer 查找启用中的服务
 func (this *ServerDAO) FindEnabledServer(tx *dbs.Tx, serverId int64) (*Server, error) {
+	var cacheKey = types.String(serverId)
+	cachedServer, ok := dbutils.SharedDAOCache.Read(tx, DAOCacheServer, cacheKey)
+	if ok {
+		var server = *cachedServer.(*Server)
+		return &server, nil
+	}
+
 	result, err := this.Query(tx).
 		Pk(serverId).
 		Attr("state", ServerStateEnabled).
@@ -112,6 +134,10 @@ func (this *ServerDAO) FindEnabledServer(tx *dbs.Tx, serverId int64) (*Server, e
 	if result == nil {
 		return nil, err
 	}
+
+	var server = *result.(*Server)
+	dbutils.SharedDAOCache.Write(tx, DAOCacheServer, cacheKey, &server)
+
 	return result.(*Server), err
 }
 
@@ -167,6 +193,12 @@ func (this *ServerDAO) CreateServer(tx *dbs.Tx,
 	excludeNodesJSON []byte,
 	groupIds []int64,
 	userPlanId int64) (serverId int64, err error) {
+	// 用户配额
+	err = CheckUserQuota(tx, userId, userconfigs.UserQuotaItemServers, 1)
+	if err != nil {
+		return 0, err
+	}
+
 	var op = NewServerOperator()
 	op.UserId = userId
 	op.AdminId = adminId
@@ -279,9 +311,103 @@ func (this *ServerDAO) CreateServer(tx *dbs.Tx,
 		return 0, err
 	}
 
+	// Webhook事件
+	err = SharedWebhookDAO.Trigger(tx, webhooks.EventServerCreated, maps.Map{
+		"serverId":  serverId,
+		"name":      name,
+		"type":      serverType,
+		"userId":    userId,
+		"clusterId": clusterId,
+	})
+	if err != nil {
+		remotelogs.Error("ServerDAO", "trigger webhook event failed: "+err.Error())
+	}
+
 	return serverId, nil
 }
 
+// CloneServer 复制服务，并使用新的域名
+// 源站、路由规则、Header和HTTPS/TLS的SSL策略等都复制一份新的，缓存策略和公共的WAF策略仍然引用原有策略
+func (this *ServerDAO) CloneServer(tx *dbs.Tx, fromServerId int64, name string, serverNamesJSON []byte, isAuditing bool, auditingServerNamesJSON []byte) (serverId int64, err error) {
+	server, err := this.FindEnabledServer(tx, fromServerId)
+	if err != nil {
+		return 0, err
+	}
+	if server == nil {
+		return 0, errors.New("can not find server with id '" + types.String(fromServerId) + "'")
+	}
+
+	// Web配置
+	webId, err := SharedHTTPWebDAO.CloneWeb(tx, int64(server.WebId), fromServerId)
+	if err != nil {
+		return 0, err
+	}
+
+	// 反向代理
+	reverseProxyJSON, err := SharedReverseProxyDAO.CloneReverseProxyRef(tx, server.ReverseProxy)
+	if err != nil {
+		return 0, err
+	}
+
+	// HTTPS和TLS
+	httpsJSON, err := this.cloneProtocolSSLPolicy(tx, server.Https)
+	if err != nil {
+		return 0, err
+	}
+	tlsJSON, err := this.cloneProtocolSSLPolicy(tx, server.Tls)
+	if err != nil {
+		return 0, err
+	}
+
+	serverId, err = this.CreateServer(tx, int64(server.AdminId), int64(server.UserId), server.Type, name, server.Description, serverNamesJSON, isAuditing, auditingServerNamesJSON, server.Http, httpsJSON, server.Tcp, tlsJSON, server.Udp, webId, reverseProxyJSON, int64(server.ClusterId), server.IncludeNodes, server.ExcludeNodes, server.DecodeGroupIds(), 0)
+	if err != nil {
+		return 0, err
+	}
+
+	// 其他设置
+	var op = NewServerOperator()
+	op.Id = serverId
+	op.IsOn = server.IsOn
+	op.SupportCNAME = server.SupportCNAME
+	if IsNotNull(server.Uam) {
+		op.Uam = server.Uam
+	}
+	if IsNotNull(server.TrafficLimit) {
+		op.TrafficLimit = server.TrafficLimit
+	}
+	err = this.Save(tx, op)
+	if err != nil {
+		return 0, err
+	}
+
+	return serverId, this.NotifyUpdate(tx, serverId)
+}
+
+// 复制HTTPS或TLS协议配置中的SSL策略
+func (this *ServerDAO) cloneProtocolSSLPolicy(tx *dbs.Tx, protocolJSON []byte) ([]byte, error) {
+	if !IsNotNull(protocolJSON) {
+		return protocolJSON, nil
+	}
+
+	var protocolMap = maps.Map{}
+	err := json.Unmarshal(protocolJSON, &protocolMap)
+	if err != nil {
+		return nil, err
+	}
+	var refMap = protocolMap.GetMap("sslPolicyRef")
+	if refMap == nil || refMap.GetInt64("sslPolicyId") <= 0 {
+		return protocolJSON, nil
+	}
+
+	newPolicyId, err := SharedSSLPolicyDAO.CloneSSLPolicy(tx, refMap.GetInt64("sslPolicyId"))
+	if err != nil {
+		return nil, err
+	}
+	refMap["sslPolicyId"] = newPolicyId
+	protocolMap["sslPolicyRef"] = refMap
+	return json.Marshal(protocolMap)
+}
+
 // UpdateServerBasic 修改服务基本信息
 func (this *ServerDAO) UpdateServerBasic(tx *dbs.Tx, serverId int64, name string, description string, clusterId int64, keepOldConfigs bool, isOn bool, groupIds []int64) error {
 	if serverId <= 0 {
@@ -1578,8 +1704,27 @@ func (this *ServerDAO) FindAllServersDNSWithClusterId(tx *dbs.Tx, clusterId int6
 }
 
 // FindAllEnabledServersWithDomain 根据域名查找服务
-// TODO 需要改成使用plainServerNames
+// 同时查找域名的Punycode和Unicode格式
 func (this *ServerDAO) FindAllEnabledServersWithDomain(tx *dbs.Tx, domain string) (result []*Server, err error) {
+	var serverIdMap = map[int64]bool{}
+	for _, variant := range serverNameVariants(domain) {
+		servers, err := this.findAllEnabledServersWithDomain(tx, variant)
+		if err != nil {
+			return nil, err
+		}
+		for _, server := range servers {
+			if serverIdMap[int64(server.Id)] {
+				continue
+			}
+			serverIdMap[int64(server.Id)] = true
+			result = append(result, server)
+		}
+	}
+	return
+}
+
+// TODO 需要改成使用plainServerNames
+func (this *ServerDAO) findAllEnabledServersWithDomain(tx *dbs.Tx, domain string) (result []*Server, err error) {
 	if len(domain) == 0 {
 		return
 	}
@@ -1626,8 +1771,68 @@ func (this *ServerDAO) FindAllEnabledServersWithDomain(tx *dbs.Tx, domain string
 	return
 }
 
+// FindAllEnabledClusterIdsWithDomain 查找使用某个域名（包括匹配的泛域名）的服务所在的集群ID
+func (this *ServerDAO) FindAllEnabledClusterIdsWithDomain(tx *dbs.Tx, domain string) ([]int64, error) {
+	var variants = serverNameVariants(domain)
+	if len(variants) == 0 {
+		return nil, nil
+	}
+
+	// 域名本身，以及 *.b.example.com、*.example.com 等泛域名
+	var searches = []string{}
+	for _, variant := range variants {
+		searches = append(searches, variant)
+		for {
+			var index = strings.Index(variant, ".")
+			if index <= 0 {
+				break
+			}
+			variant = variant[index+1:]
+			if strings.Contains(variant, ".") {
+				searches = append(searches, "*."+variant)
+			}
+		}
+	}
+
+	var conds = []string{}
+	var query = this.Query(tx).
+		State(ServerStateEnabled).
+		Gt("clusterId", 0).
+		Result("DISTINCT clusterId")
+	for index, search := range searches {
+		var nameParam = "name" + types.String(index)
+		var subNameParam = "subName" + types.String(index)
+		conds = append(conds, "JSON_CONTAINS(serverNames, :"+nameParam+")", "JSON_CONTAINS(serverNames, :"+subNameParam+")")
+		query.Param(nameParam, maps.Map{"name": search}.AsJSON())
+		query.Param(subNameParam, maps.Map{"subNames": search}.AsJSON())
+	}
+	ones, err := query.
+		Where("(" + strings.Join(conds, " OR ") + ")").
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var result = []int64{}
+	for _, one := range ones {
+		result = append(result, int64(one.(*Server).ClusterId))
+	}
+	return result, nil
+}
+
 // FindEnabledServerWithDomain 根据域名查找服务集群ID
+// 同时查找域名的Punycode和Unicode格式
 func (this *ServerDAO) FindEnabledServerWithDomain(tx *dbs.Tx, userId int64, domain string) (server *Server, err error) {
+	for _, variant := range serverNameVariants(domain) {
+		server, err = this.findEnabledServerWithDomain(tx, userId, variant)
+		if err != nil || server != nil {
+			return
+		}
+	}
+	return
+}
+
+func (this *ServerDAO) findEnabledServerWithDomain(tx *dbs.Tx, userId int64, domain string) (server *Server, err error) {
 	if len(domain) == 0 {
 		return
 	}
@@ -1986,7 +2191,18 @@ func (this *ServerDAO) CheckPortIsUsing(tx *dbs.Tx, clusterId int64, protocolFam
 }
 
 // ExistServerNameInCluster 检查ServerName是否已存在
+// 同时检查域名的Punycode和Unicode格式
 func (this *ServerDAO) ExistServerNameInCluster(tx *dbs.Tx, clusterId int64, serverName string, excludeServerId int64, supportWildcard bool) (bool, error) {
+	for _, variant := range serverNameVariants(serverName) {
+		exists, err := this.existServerNameInCluster(tx, clusterId, variant, excludeServerId, supportWildcard)
+		if err != nil || exists {
+			return exists, err
+		}
+	}
+	return false, nil
+}
+
+func (this *ServerDAO) existServerNameInCluster(tx *dbs.Tx, clusterId int64, serverName string, excludeServerId int64, supportWildcard bool) (bool, error) {
 	var query = this.Query(tx).
 		Attr("clusterId", clusterId).
 		Where("(JSON_CONTAINS(serverNames, :jsonQuery1) OR JSON_CONTAINS(serverNames, :jsonQuery2))").
@@ -2724,6 +2940,74 @@ func (this *ServerDAO) FindEnabledServersWithUserPlanId(tx *dbs.Tx, userPlanId i
 	return
 }
 
+// UpdateServerExternalId 设置网站的外部ID
+func (this *ServerDAO) UpdateServerExternalId(tx *dbs.Tx, serverId int64, externalId string) error {
+	if serverId <= 0 {
+		return errors.New("serverId should not be smaller than 0")
+	}
+
+	err := dbutils.ValidateExternalId(externalId)
+	if err != nil {
+		return err
+	}
+
+	if len(externalId) > 0 {
+		existServerId, err := this.FindEnabledServerIdWithExternalId(tx, 0, externalId)
+		if err != nil {
+			return err
+		}
+		if existServerId > 0 && existServerId != serverId {
+			return errors.New("the externalId '" + externalId + "' is used by another server '" + types.String(existServerId) + "'")
+		}
+	}
+
+	return this.Query(tx).
+		Pk(serverId).
+		Set("externalId", externalId).
+		UpdateQuickly()
+}
+
+// FindEnabledServerIdWithExternalId 根据外部ID查找网站ID
+func (this *ServerDAO) FindEnabledServerIdWithExternalId(tx *dbs.Tx, userId int64, externalId string) (int64, error) {
+	if len(externalId) == 0 {
+		return 0, nil
+	}
+	var query = this.Query(tx).
+		State(ServerStateEnabled).
+		Attr("externalId", externalId)
+	if userId > 0 {
+		query.Attr("userId", userId)
+	}
+	return query.
+		ResultPk().
+		FindInt64Col(0)
+}
+
+// FindEnabledServerIdsWithName 根据名称查找网站ID
+func (this *ServerDAO) FindEnabledServerIdsWithName(tx *dbs.Tx, userId int64, name string, size int64) (serverIds []int64, err error) {
+	if len(name) == 0 {
+		return nil, nil
+	}
+	var query = this.Query(tx).
+		State(ServerStateEnabled).
+		Attr("name", name)
+	if userId > 0 {
+		query.Attr("userId", userId)
+	}
+	ones, err := query.
+		ResultPk().
+		AscPk().
+		Limit(size).
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range ones {
+		serverIds = append(serverIds, int64(one.(*Server).Id))
+	}
+	return
+}
+
 // UpdateServersClusterIdWithPlanId 修改套餐所在集群
 func (this *ServerDAO) UpdateServersClusterIdWithPlanId(tx *dbs.Tx, planId int64, clusterId int64) error {
 	return this.Query(tx).
@@ -3089,6 +3373,20 @@ func (this *ServerDAO) CountAllServerNamesWithUserId(tx *dbs.Tx, userId int64, u
 		SumInt64("JSON_LENGTH(plainServerNames)", 0)
 }
 
+// ListEnabledServerPlainNames 分批读取网站的用户ID和扁平化域名列表
+func (this *ServerDAO) ListEnabledServerPlainNames(tx *dbs.Tx, lastServerId int64, size int64) (result []*Server, err error) {
+	_, err = this.Query(tx).
+		Result("id", "userId", "plainServerNames").
+		Gt("id", lastServerId).
+		State(ServerStateEnabled).
+		Attr("isOn", true).
+		AscPk().
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
 // CountServerNames 计算某个网站下的所有域名数
 func (this *ServerDAO) CountServerNames(tx *dbs.Tx, serverId int64) (int64, error) {
 	if serverId <= 0 {
@@ -3172,6 +3470,20 @@ func (this *ServerDAO) NotifyUpdate(tx *dbs.Tx, serverId int64) error {
 		return nil
 	}
 
+	dbutils.SharedDAOCache.Delete(DAOCacheServer, types.String(serverId))
+
+	// 变更记录
+	err := SharedServerChangeLogDAO.RecordServerChanges(tx, serverId)
+	if err != nil {
+		remotelogs.Error("ServerDAO", "record server changes failed: "+err.Error())
+	}
+
+	// 搜索关键词
+	err = SharedServerSearchKeywordDAO.UpdateServerKeywords(tx, serverId)
+	if err != nil {
+		remotelogs.Error("ServerDAO", "update server search keywords failed: "+err.Error())
+	}
+
 	// 创建任务
 	clusterId, err := this.FindServerClusterId(tx, serverId)
 	if err != nil {
@@ -3183,6 +3495,12 @@ func (this *ServerDAO) NotifyUpdate(tx *dbs.Tx, serverId int64) error {
 	return SharedNodeTaskDAO.CreateClusterTask(tx, nodeconfigs.NodeRoleNode, clusterId, 0, serverId, NodeTaskTypeConfigChanged)
 }
 
+// IncreaseServerVersion 增加网站版本号，用于防止多人同时修改时互相覆盖
+// version>0 时只有当前版本号和它一致时才会增加，否则返回false；需要和修改操作在同一个事务中执行
+func (this *ServerDAO) IncreaseServerVersion(tx *dbs.Tx, serverId int64, version int64) (bool, error) {
+	return increaseVersion(this.Query(tx), serverId, version)
+}
+
 // NotifyClusterUpdate 同步指定的集群
 func (this *ServerDAO) NotifyClusterUpdate(tx *dbs.Tx, clusterId, serverId int64) error {
 	if serverId <= 0 {
@@ -3299,3 +3617,39 @@ func (this *ServerDAO) FindUserServerByServerName(tx *dbs.Tx, serverName string)
 		FindAll()
 	return
 }
+
+// FindAllEnabledServersWithKeyword 根据名称或域名查找服务
+func (this *ServerDAO) FindAllEnabledServersWithKeyword(tx *dbs.Tx, keyword string, size int64) (result []*Server, err error) {
+	if len(keyword) == 0 {
+		return
+	}
+	_, err = this.Query(tx).
+		State(ServerStateEnabled).
+		Result("id", "name", "type", "serverNames", "userId", "isOn").
+		Where("(name LIKE :keyword OR serverNames LIKE :keyword)").
+		Param("keyword", dbutils.QuoteLike(keyword)).
+		DescPk().
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// 域名的不同格式：原始格式（小写）、Punycode格式和Unicode格式
+// 以前保存的域名可能是Unicode格式，也可能是Punycode格式，所以需要同时查找
+func serverNameVariants(domain string) []string {
+	domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+	if len(domain) == 0 {
+		return nil
+	}
+	var result = []string{domain}
+	asciiDomain, err := configutils.NormalizeDomain(domain)
+	if err == nil && len(asciiDomain) > 0 && !lists.ContainsString(result, asciiDomain) {
+		result = append(result, asciiDomain)
+	}
+	var unicodeDomain = configutils.UnicodeDomain(asciiDomain)
+	if len(unicodeDomain) > 0 && !lists.ContainsString(result, unicodeDomain) {
+		result = append(result, unicodeDomain)
+	}
+	return result
+}
@@ -51,6 +51,7 @@ const (
 	ServerField_BandwidthBytes      dbs.FieldName = "bandwidthBytes"      // 最近带宽峰值
 	ServerField_CountAttackRequests dbs.FieldName = "countAttackRequests" // 最近攻击请求数
 	ServerField_CountRequests       dbs.FieldName = "countRequests"       // 最近总请求数
+	ServerField_ExternalId          dbs.FieldName = "externalId"          // 外部ID
 )
 
 // Server 服务
@@ -103,6 +104,7 @@ type Server struct {
 	BandwidthBytes      uint64   `field:"bandwidthBytes"`      // 最近带宽峰值
 	CountAttackRequests uint64   `field:"countAttackRequests"` // 最近攻击请求数
 	CountRequests       uint64   `field:"countRequests"`       // 最近总请求数
+	ExternalId          string   `field:"externalId"`          // 外部ID
 }
 
 type ServerOperator struct {
@@ -154,6 +156,7 @@ type ServerOperator struct {
 	BandwidthBytes      any // 最近带宽峰值
 	CountAttackRequests any // 最近攻击请求数
 	CountRequests       any // 最近总请求数
+	ExternalId          any // 外部ID
 }
 
 func NewServerOperator() *ServerOperator {
@@ -176,6 +176,18 @@ func (this *Server) DecodeServerNames() (serverNames []*serverconfigs.ServerName
 	return
 }
 
+// DecodePlainServerNames 获取扁平化的域名列表
+func (this *Server) DecodePlainServerNames() (serverNames []string) {
+	if !IsNotNull(this.PlainServerNames) {
+		return
+	}
+	err := json.Unmarshal(this.PlainServerNames, &serverNames)
+	if err != nil {
+		remotelogs.Error("Server/DecodePlainServerNames", "decode plain server names failed: "+err.Error())
+	}
+	return
+}
+
 // FirstServerName 获取第一个域名
 func (this *Server) FirstServerName() string {
 	serverNames, _ := this.DecodeServerNames()
@@ -0,0 +1,243 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/rands"
+	"github.com/iwind/TeaGo/types"
+)
+
+type ServerSearchKeywordDAO dbs.DAO
+
+func init() {
+	dbs.OnReadyDone(func() {
+		// 为还没有索引的服务（比如升级之前创建的服务）建立索引
+		var ticker = time.NewTicker(time.Duration(rands.Int(5, 10)) * time.Minute)
+		goman.New(func() {
+			for range ticker.C {
+				err := SharedServerSearchKeywordDAO.BuildMissingIndexes(nil, 1000)
+				if err != nil {
+					remotelogs.Error("ServerSearchKeywordDAO", "build missing indexes failed: "+err.Error())
+				}
+			}
+		})
+	})
+}
+
+func NewServerSearchKeywordDAO() *ServerSearchKeywordDAO {
+	return dbs.NewDAO(&ServerSearchKeywordDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeServerSearchKeywords",
+			Model:  new(ServerSearchKeyword),
+			PkName: "id",
+		},
+	}).(*ServerSearchKeywordDAO)
+}
+
+var SharedServerSearchKeywordDAO *ServerSearchKeywordDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedServerSearchKeywordDAO = NewServerSearchKeywordDAO()
+	})
+}
+
+// UpdateServerKeywords 重建服务的搜索关键词
+func (this *ServerSearchKeywordDAO) UpdateServerKeywords(tx *dbs.Tx, serverId int64) error {
+	if serverId <= 0 {
+		return nil
+	}
+
+	config, err := SharedServerDAO.ComposeServerConfigWithServerId(tx, serverId, true, false)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return this.DeleteServerKeywords(tx, serverId)
+		}
+		return err
+	}
+
+	// 证书只需要域名信息，不需要证书数据
+	if config.HTTPS != nil && config.HTTPS.SSLPolicyRef != nil && config.HTTPS.SSLPolicyRef.SSLPolicyId > 0 {
+		sslPolicy, err := SharedSSLPolicyDAO.ComposePolicyConfig(tx, config.HTTPS.SSLPolicyRef.SSLPolicyId, true, nil, nil)
+		if err != nil {
+			return err
+		}
+		config.HTTPS.SSLPolicy = sslPolicy
+	}
+	if config.TLS != nil && config.TLS.SSLPolicyRef != nil && config.TLS.SSLPolicyRef.SSLPolicyId > 0 {
+		sslPolicy, err := SharedSSLPolicyDAO.ComposePolicyConfig(tx, config.TLS.SSLPolicyRef.SSLPolicyId, true, nil, nil)
+		if err != nil {
+			return err
+		}
+		config.TLS.SSLPolicy = sslPolicy
+	}
+
+	var items = ExtractServerSearchKeywords(config)
+
+	err = this.DeleteServerKeywords(tx, serverId)
+	if err != nil {
+		return err
+	}
+
+	var now = time.Now().Unix()
+	for _, item := range items {
+		var op = NewServerSearchKeywordOperator()
+		op.ServerId = serverId
+		op.UserId = config.UserId
+		op.ClusterId = config.ClusterId
+		op.Type = item.Type
+		op.Keyword = item.Keyword
+		op.UpdatedAt = now
+		err = this.Save(tx, op)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteServerKeywords 删除服务的搜索关键词
+func (this *ServerSearchKeywordDAO) DeleteServerKeywords(tx *dbs.Tx, serverId int64) error {
+	return this.Query(tx).
+		Attr("serverId", serverId).
+		DeleteQuickly()
+}
+
+// BuildMissingIndexes 为还没有索引的服务建立索引
+func (this *ServerSearchKeywordDAO) BuildMissingIndexes(tx *dbs.Tx, size int64) error {
+	ones, _, err := SharedServerDAO.Query(tx).
+		State(ServerStateEnabled).
+		Where("id NOT IN (SELECT serverId FROM " + this.Table + ")").
+		ResultPk().
+		Limit(size).
+		FindOnes()
+	if err != nil {
+		return err
+	}
+	for _, one := range ones {
+		err = this.UpdateServerKeywords(tx, one.GetInt64("id"))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SearchServers 搜索服务
+// 完全匹配的关键词排在前面，然后是包含关键词的匹配
+func (this *ServerSearchKeywordDAO) SearchServers(tx *dbs.Tx, userId int64, clusterId int64, keyword string, searchTypes []string, size int64) (result []*ServerSearchKeyword, err error) {
+	keyword = strings.ToLower(strings.TrimSpace(keyword))
+	if len(keyword) == 0 || size <= 0 {
+		return
+	}
+
+	var searchCluster = len(searchTypes) == 0
+	var keywordTypes = []string{}
+	for _, searchType := range searchTypes {
+		if searchType == ServerSearchTypeCluster {
+			searchCluster = true
+		} else {
+			keywordTypes = append(keywordTypes, searchType)
+		}
+	}
+
+	var resultMap = map[string]bool{} // serverId@type => true
+	var appendResult = func(keywords []*ServerSearchKeyword) {
+		for _, serverKeyword := range keywords {
+			if int64(len(result)) >= size {
+				return
+			}
+			var key = types.String(serverKeyword.ServerId) + "@" + serverKeyword.Type
+			if resultMap[key] {
+				continue
+			}
+			resultMap[key] = true
+			result = append(result, serverKeyword)
+		}
+	}
+
+	// 关键词
+	if len(searchTypes) == 0 || len(keywordTypes) > 0 {
+		for _, exact := range []bool{true, false} {
+			var query = this.Query(tx)
+			if userId > 0 {
+				query.Attr("userId", userId)
+			}
+			if clusterId > 0 {
+				query.Attr("clusterId", clusterId)
+			}
+			if len(keywordTypes) > 0 {
+				query.Attr("type", keywordTypes)
+			}
+			if exact {
+				query.Attr("keyword", keyword)
+			} else {
+				query.Where("keyword LIKE :keyword").
+					Param("keyword", dbutils.QuoteLike(keyword)).
+					Neq("keyword", keyword)
+			}
+
+			var keywords = []*ServerSearchKeyword{}
+			_, err = query.
+				AscPk().
+				Limit(size).
+				Slice(&keywords).
+				FindAll()
+			if err != nil {
+				return nil, err
+			}
+			appendResult(keywords)
+		}
+	}
+
+	// 集群
+	if searchCluster && int64(len(result)) < size {
+		clusters, err := SharedNodeClusterDAO.ListEnabledClusters(tx, keyword, false, true, 0, 10)
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range clusters {
+			if clusterId > 0 && int64(cluster.Id) != clusterId {
+				continue
+			}
+
+			var query = SharedServerDAO.Query(tx).
+				State(ServerStateEnabled).
+				Attr("clusterId", cluster.Id).
+				Result("id", "userId", "clusterId")
+			if userId > 0 {
+				query.Attr("userId", userId)
+			}
+			ones, _, err := query.
+				AscPk().
+				Limit(size).
+				FindOnes()
+			if err != nil {
+				return nil, err
+			}
+
+			var keywords = []*ServerSearchKeyword{}
+			for _, one := range ones {
+				keywords = append(keywords, &ServerSearchKeyword{
+					ServerId:  one.GetUint32("id"),
+					UserId:    one.GetUint32("userId"),
+					ClusterId: one.GetUint32("clusterId"),
+					Type:      ServerSearchTypeCluster,
+					Keyword:   cluster.Name,
+				})
+			}
+			appendResult(keywords)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,26 @@
+package models
+
+// ServerSearchKeyword 服务搜索关键词
+type ServerSearchKeyword struct {
+	Id        uint64 `field:"id"`        // ID
+	ServerId  uint32 `field:"serverId"`  // 服务ID
+	UserId    uint32 `field:"userId"`    // 用户ID
+	ClusterId uint32 `field:"clusterId"` // 集群ID
+	Type      string `field:"type"`      // 类型：name, description, serverName, origin, certDomain
+	Keyword   string `field:"keyword"`   // 关键词
+	UpdatedAt uint64 `field:"updatedAt"` // 更新时间
+}
+
+type ServerSearchKeywordOperator struct {
+	Id        any // ID
+	ServerId  any // 服务ID
+	UserId    any // 用户ID
+	ClusterId any // 集群ID
+	Type      any // 类型：name, description, serverName, origin, certDomain
+	Keyword   any // 关键词
+	UpdatedAt any // 更新时间
+}
+
+func NewServerSearchKeywordOperator() *ServerSearchKeywordOperator {
+	return &ServerSearchKeywordOperator{}
+}
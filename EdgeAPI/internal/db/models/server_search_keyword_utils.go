@@ -0,0 +1,142 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models
+
+import (
+	"strings"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+)
+
+type ServerSearchType = string
+
+const (
+	ServerSearchTypeName        ServerSearchType = "name"        // 服务名称
+	ServerSearchTypeDescription ServerSearchType = "description" // 服务描述
+	ServerSearchTypeServerName  ServerSearchType = "serverName"  // 域名
+	ServerSearchTypeOrigin      ServerSearchType = "origin"      // 源站地址
+	ServerSearchTypeCertDomain  ServerSearchType = "certDomain"  // 证书域名
+	ServerSearchTypeCluster     ServerSearchType = "cluster"     // 集群，在搜索时实时查询，不保存在索引中
+)
+
+// 关键词最大长度，和数据表中的字段长度一致
+const serverSearchKeywordMaxLength = 255
+
+// ServerSearchKeywordItem 从服务配置中提取的关键词
+type ServerSearchKeywordItem struct {
+	Type    ServerSearchType
+	Keyword string
+}
+
+// ExtractServerSearchKeywords 从服务配置中提取需要索引的关键词
+// 证书信息需要事先放在 HTTPS/TLS 的 SSLPolicy 中
+func ExtractServerSearchKeywords(config *serverconfigs.ServerConfig) []*ServerSearchKeywordItem {
+	var result = []*ServerSearchKeywordItem{}
+	if config == nil {
+		return result
+	}
+
+	var keywordMap = map[string]bool{} // type@keyword => true
+	var add = func(searchType ServerSearchType, keyword string) {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if len(keyword) == 0 {
+			return
+		}
+		var runes = []rune(keyword)
+		if len(runes) > serverSearchKeywordMaxLength {
+			keyword = string(runes[:serverSearchKeywordMaxLength])
+		}
+		var key = searchType + "@" + keyword
+		if keywordMap[key] {
+			return
+		}
+		keywordMap[key] = true
+		result = append(result, &ServerSearchKeywordItem{
+			Type:    searchType,
+			Keyword: keyword,
+		})
+	}
+
+	// 域名同时索引Punycode和Unicode格式
+	var addDomain = func(searchType ServerSearchType, domain string) {
+		add(searchType, domain)
+		asciiDomain, err := configutils.NormalizeDomain(domain)
+		if err == nil && len(asciiDomain) > 0 {
+			add(searchType, asciiDomain)
+			add(searchType, configutils.UnicodeDomain(asciiDomain))
+		}
+	}
+
+	add(ServerSearchTypeName, config.Name)
+	add(ServerSearchTypeDescription, config.Description)
+
+	for _, serverName := range config.ServerNames {
+		if len(serverName.SubNames) > 0 {
+			for _, subName := range serverName.SubNames {
+				addDomain(ServerSearchTypeServerName, subName)
+			}
+		} else {
+			addDomain(ServerSearchTypeServerName, serverName.Name)
+		}
+	}
+	for _, aliasServerName := range config.AliasServerNames {
+		addDomain(ServerSearchTypeServerName, aliasServerName)
+	}
+
+	// 源站
+	var addReverseProxy = func(reverseProxy *serverconfigs.ReverseProxyConfig) {
+		if reverseProxy == nil {
+			return
+		}
+		for _, origins := range [][]*serverconfigs.OriginConfig{reverseProxy.PrimaryOrigins, reverseProxy.BackupOrigins} {
+			for _, origin := range origins {
+				if origin == nil || origin.Addr == nil || len(origin.Addr.Host) == 0 {
+					continue
+				}
+				add(ServerSearchTypeOrigin, origin.Addr.Host)
+				if len(origin.Addr.PortRange) > 0 {
+					add(ServerSearchTypeOrigin, configutils.QuoteIP(origin.Addr.Host)+":"+origin.Addr.PortRange)
+				}
+			}
+		}
+	}
+	addReverseProxy(config.ReverseProxy)
+	if config.Web != nil {
+		var walkLocations func(locations []*serverconfigs.HTTPLocationConfig)
+		walkLocations = func(locations []*serverconfigs.HTTPLocationConfig) {
+			for _, location := range locations {
+				if location == nil {
+					continue
+				}
+				addReverseProxy(location.ReverseProxy)
+				walkLocations(location.Children)
+			}
+		}
+		walkLocations(config.Web.Locations)
+	}
+
+	// 证书
+	var addSSLPolicy = func(sslPolicy *sslconfigs.SSLPolicy) {
+		if sslPolicy == nil {
+			return
+		}
+		for _, cert := range sslPolicy.Certs {
+			if cert == nil {
+				continue
+			}
+			for _, dnsName := range cert.DNSNames {
+				addDomain(ServerSearchTypeCertDomain, dnsName)
+			}
+		}
+	}
+	if config.HTTPS != nil {
+		addSSLPolicy(config.HTTPS.SSLPolicy)
+	}
+	if config.TLS != nil {
+		addSSLPolicy(config.TLS.SSLPolicy)
+	}
+
+	return result
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestExtractServerSearchKeywords(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var config = &serverconfigs.ServerConfig{
+		Name:        "Example",
+		Description: "",
+		ServerNames: []*serverconfigs.ServerNameConfig{
+			{Name: "Example.com"},
+			{Name: "*.example.com", SubNames: []string{"a.example.com", "例子.测试"}},
+		},
+		ReverseProxy: &serverconfigs.ReverseProxyConfig{
+			PrimaryOrigins: []*serverconfigs.OriginConfig{
+				{Addr: &serverconfigs.NetworkAddressConfig{Host: "1.2.3.4", PortRange: "80"}},
+			},
+			BackupOrigins: []*serverconfigs.OriginConfig{
+				{Addr: &serverconfigs.NetworkAddressConfig{Host: "::1", PortRange: "8080"}},
+			},
+		},
+		Web: &serverconfigs.HTTPWebConfig{
+			Locations: []*serverconfigs.HTTPLocationConfig{
+				{
+					Children: []*serverconfigs.HTTPLocationConfig{
+						{
+							ReverseProxy: &serverconfigs.ReverseProxyConfig{
+								PrimaryOrigins: []*serverconfigs.OriginConfig{
+									{Addr: &serverconfigs.NetworkAddressConfig{Host: "origin.example.org"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		HTTPS: &serverconfigs.HTTPSProtocolConfig{
+			SSLPolicy: &sslconfigs.SSLPolicy{
+				Certs: []*sslconfigs.SSLCertConfig{
+					{DNSNames: []string{"example.com", "*.example.com"}},
+				},
+			},
+		},
+	}
+
+	var keywordMap = map[string]bool{}
+	for _, item := range models.ExtractServerSearchKeywords(config) {
+		var key = item.Type + "@" + item.Keyword
+		a.IsFalse(keywordMap[key])
+		keywordMap[key] = true
+	}
+	t.Log(keywordMap)
+
+	a.IsTrue(keywordMap["name@example"])
+	a.IsFalse(keywordMap["description@"])
+	a.IsTrue(keywordMap["serverName@example.com"])
+	a.IsTrue(keywordMap["serverName@a.example.com"])
+	a.IsFalse(keywordMap["serverName@*.example.com"])
+	a.IsTrue(keywordMap["serverName@例子.测试"])
+	a.IsTrue(keywordMap["serverName@xn--fsqu00a.xn--0zwm56d"])
+	a.IsTrue(keywordMap["origin@1.2.3.4"])
+	a.IsTrue(keywordMap["origin@1.2.3.4:80"])
+	a.IsTrue(keywordMap["origin@::1"])
+	a.IsTrue(keywordMap["origin@[::1]:8080"])
+	a.IsTrue(keywordMap["origin@origin.example.org"])
+	a.IsTrue(keywordMap["certDomain@*.example.com"])
+}
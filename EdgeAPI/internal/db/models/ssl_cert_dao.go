@@ -4,14 +4,20 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/keyvault"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/shared"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
@@ -43,6 +49,26 @@ func init() {
 	dbs.OnReady(func() {
 		SharedSSLCertDAO = NewSSLCertDAO()
 	})
+
+	// 补充已有证书的指纹
+	dbs.OnReadyDone(func() {
+		goman.New(func() {
+			err := SharedSSLCertDAO.FillMissingFingerprints(nil)
+			if err != nil {
+				remotelogs.Error("SSLCertDAO", "fill cert fingerprints failed: "+err.Error())
+			}
+		})
+	})
+
+	// 加密已有证书的私钥
+	dbs.OnReadyDone(func() {
+		goman.New(func() {
+			err := SharedSSLCertDAO.EncryptPlainKeys(nil)
+			if err != nil {
+				remotelogs.Error("SSLCertDAO", "encrypt cert keys failed: "+err.Error())
+			}
+		})
+	})
 }
 
 // Init 初始化
@@ -93,6 +119,12 @@ func (this *SSLCertDAO) FindSSLCertName(tx *dbs.Tx, id int64) (string, error) {
 
 // CreateCert 创建证书
 func (this *SSLCertDAO) CreateCert(tx *dbs.Tx, adminId int64, userId int64, isOn bool, name string, description string, serverName string, isCA bool, certData []byte, keyData []byte, timeBeginAt int64, timeEndAt int64, dnsNames []string, commonNames []string) (int64, error) {
+	// 用户配额
+	err := CheckUserQuota(tx, userId, userconfigs.UserQuotaItemSSLCerts, 1)
+	if err != nil {
+		return 0, err
+	}
+
 	var op = NewSSLCertOperator()
 	op.AdminId = adminId
 	op.UserId = userId
@@ -103,10 +135,16 @@ func (this *SSLCertDAO) CreateCert(tx *dbs.Tx, adminId int64, userId int64, isOn
 	op.ServerName = serverName
 	op.IsCA = isCA
 	op.CertData = certData
-	op.KeyData = keyData
+	op.Fingerprint = this.certFingerprint(certData)
 	op.TimeBeginAt = timeBeginAt
 	op.TimeEndAt = timeEndAt
 
+	encryptedKeyData, err := keyvault.SharedVault().Encrypt(keyData)
+	if err != nil {
+		return 0, errors.New("encrypt key failed: " + err.Error())
+	}
+	op.KeyData = encryptedKeyData
+
 	dnsNamesJSON, err := json.Marshal(dnsNames)
 	if err != nil {
 		return 0, err
@@ -145,7 +183,9 @@ func (this *SSLCertDAO) UpdateCert(tx *dbs.Tx,
 		return errors.New("invalid certId")
 	}
 
-	oldOne, err := this.Query(tx).Find()
+	oldOne, err := this.Query(tx).
+		Pk(certId).
+		Find()
 	if err != nil {
 		return err
 	}
@@ -153,7 +193,12 @@ func (this *SSLCertDAO) UpdateCert(tx *dbs.Tx,
 		return nil
 	}
 	var oldCert = oldOne.(*SSLCert)
-	var dataIsChanged = !bytes.Equal(certData, oldCert.CertData) || !bytes.Equal(keyData, oldCert.KeyData)
+	oldKeyData, err := oldCert.DecodeKeyData()
+	if err != nil {
+		// 无法解密时当作私钥已修改
+		oldKeyData = nil
+	}
+	var dataIsChanged = !bytes.Equal(certData, oldCert.CertData) || !bytes.Equal(keyData, oldKeyData)
 
 	var op = NewSSLCertOperator()
 	op.Id = certId
@@ -166,9 +211,14 @@ func (this *SSLCertDAO) UpdateCert(tx *dbs.Tx,
 	// cert和key均为有重新上传才会修改
 	if len(certData) > 0 {
 		op.CertData = certData
+		op.Fingerprint = this.certFingerprint(certData)
 	}
 	if len(keyData) > 0 {
-		op.KeyData = keyData
+		encryptedKeyData, err := keyvault.SharedVault().Encrypt(keyData)
+		if err != nil {
+			return errors.New("encrypt key failed: " + err.Error())
+		}
+		op.KeyData = encryptedKeyData
 	}
 
 	op.TimeBeginAt = timeBeginAt
@@ -223,6 +273,11 @@ func (this *SSLCertDAO) ComposeCertConfig(tx *dbs.Tx, certId int64, ignoreData b
 		return nil, nil
 	}
 
+	keyData, err := cert.DecodeKeyData()
+	if err != nil {
+		return nil, errors.New("decrypt key of cert '" + types.String(certId) + "' failed: " + err.Error())
+	}
+
 	var config = &sslconfigs.SSLCertConfig{}
 	config.Id = int64(cert.Id)
 	config.IsOn = cert.IsOn
@@ -235,17 +290,20 @@ func (this *SSLCertDAO) ComposeCertConfig(tx *dbs.Tx, certId int64, ignoreData b
 			if len(cert.CertData) > 0 {
 				config.CertData = dataMap.Put(cert.CertData)
 			}
-			if len(cert.KeyData) > 0 {
-				config.KeyData = dataMap.Put(cert.KeyData)
+			if len(keyData) > 0 {
+				config.KeyData = dataMap.Put(keyData)
 			}
 		} else {
 			config.CertData = cert.CertData
-			config.KeyData = cert.KeyData
+			config.KeyData = keyData
 		}
 	}
 	config.ServerName = cert.ServerName
 	config.TimeBeginAt = int64(cert.TimeBeginAt)
 	config.TimeEndAt = int64(cert.TimeEndAt)
+	config.RevokedAt = int64(cert.RevokedAt)
+	config.KeyStorage = cert.KeyStorage
+	config.Tags = cert.DecodeTags()
 
 	// OCSP
 	if int64(cert.OcspExpiresAt) > time.Now().Unix() {
@@ -259,6 +317,8 @@ func (this *SSLCertDAO) ComposeCertConfig(tx *dbs.Tx, certId int64, ignoreData b
 		config.OCSPExpiresAt = int64(cert.OcspExpiresAt)
 	}
 	config.OCSPError = cert.OcspError
+	config.OCSPStatus = cert.OcspStatus
+	config.OCSPStatusCheckedAt = int64(cert.OcspStatusCheckedAt)
 
 	if IsNotNull(cert.DnsNames) {
 		var dnsNames = []string{}
@@ -286,7 +346,7 @@ func (this *SSLCertDAO) ComposeCertConfig(tx *dbs.Tx, certId int64, ignoreData b
 }
 
 // CountCerts 计算符合条件的证书数量
-func (this *SSLCertDAO) CountCerts(tx *dbs.Tx, isCA bool, isAvailable bool, isExpired bool, expiringDays int64, keyword string, userId int64, domains []string, userOnly bool) (int64, error) {
+func (this *SSLCertDAO) CountCerts(tx *dbs.Tx, isCA bool, isAvailable bool, isExpired bool, expiringDays int64, keyword string, userId int64, domains []string, userOnly bool, tags []string) (int64, error) {
 	var query = this.Query(tx).
 		State(SSLCertStateEnabled)
 	if isCA {
@@ -317,6 +377,9 @@ func (this *SSLCertDAO) CountCerts(tx *dbs.Tx, isCA bool, isAvailable bool, isEx
 		}
 	}
 
+	// 标签
+	this.buildTagsSearchingQuery(query, tags)
+
 	// 域名
 	err := this.buildDomainSearchingQuery(query, domains)
 	if err != nil {
@@ -327,7 +390,7 @@ func (this *SSLCertDAO) CountCerts(tx *dbs.Tx, isCA bool, isAvailable bool, isEx
 }
 
 // ListCertIds 列出符合条件的证书
-func (this *SSLCertDAO) ListCertIds(tx *dbs.Tx, isCA bool, isAvailable bool, isExpired bool, expiringDays int64, keyword string, userId int64, domains []string, userOnly bool, offset int64, size int64) (certIds []int64, err error) {
+func (this *SSLCertDAO) ListCertIds(tx *dbs.Tx, isCA bool, isAvailable bool, isExpired bool, expiringDays int64, keyword string, userId int64, domains []string, userOnly bool, tags []string, offset int64, size int64) (certIds []int64, err error) {
 	var query = this.Query(tx).
 		State(SSLCertStateEnabled)
 	if isCA {
@@ -358,6 +421,9 @@ func (this *SSLCertDAO) ListCertIds(tx *dbs.Tx, isCA bool, isAvailable bool, isE
 		}
 	}
 
+	// 标签
+	this.buildTagsSearchingQuery(query, tags)
+
 	// 域名
 	err = this.buildDomainSearchingQuery(query, domains)
 	if err != nil {
@@ -381,6 +447,65 @@ func (this *SSLCertDAO) ListCertIds(tx *dbs.Tx, isCA bool, isAvailable bool, isE
 	return result, nil
 }
 
+// UpdateCertTags 修改证书标签
+func (this *SSLCertDAO) UpdateCertTags(tx *dbs.Tx, certId int64, tags []string) error {
+	if certId <= 0 {
+		return errors.New("invalid certId")
+	}
+
+	tags, err := sslconfigs.NormalizeSSLCertTags(tags)
+	if err != nil {
+		return err
+	}
+	tagsJSON, err := json.Marshal(tags)
+	if err != nil {
+		return err
+	}
+
+	// 标签只用于管理，不需要通知节点更新
+	return this.Query(tx).
+		Pk(certId).
+		Set("tags", tagsJSON).
+		UpdateQuickly()
+}
+
+// FindAllCertTags 查找所有用到的标签，以及每个标签对应的证书数量
+func (this *SSLCertDAO) FindAllCertTags(tx *dbs.Tx, userId int64, userOnly bool) (tags []string, counts map[string]int64, err error) {
+	var query = this.Query(tx).
+		State(SSLCertStateEnabled).
+		Where("(tags IS NOT NULL AND JSON_LENGTH(tags)>0)")
+	if userId > 0 {
+		query.Attr("userId", userId)
+	} else {
+		if userOnly {
+			query.Gt("userId", 0)
+		} else {
+			// 只查询管理员上传的
+			query.Attr("userId", 0)
+		}
+	}
+
+	ones, err := query.
+		Result("tags").
+		FindAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts = map[string]int64{}
+	for _, one := range ones {
+		for _, tag := range one.(*SSLCert).DecodeTags() {
+			_, ok := counts[tag]
+			if !ok {
+				tags = append(tags, tag)
+			}
+			counts[tag]++
+		}
+	}
+	sort.Strings(tags)
+	return
+}
+
 // UpdateCertACME 设置证书的ACME信息
 func (this *SSLCertDAO) UpdateCertACME(tx *dbs.Tx, certId int64, acmeTaskId int64) error {
 	if certId <= 0 {
@@ -394,6 +519,23 @@ func (this *SSLCertDAO) UpdateCertACME(tx *dbs.Tx, certId int64, acmeTaskId int6
 	return err
 }
 
+// UpdateCertRevoked 设置证书已被吊销，同时停用证书
+func (this *SSLCertDAO) UpdateCertRevoked(tx *dbs.Tx, certId int64, reason uint) error {
+	if certId <= 0 {
+		return errors.New("invalid certId")
+	}
+	var op = NewSSLCertOperator()
+	op.Id = certId
+	op.IsOn = false
+	op.RevokedAt = time.Now().Unix()
+	op.RevokeReason = reason
+	err := this.Save(tx, op)
+	if err != nil {
+		return err
+	}
+	return this.NotifyUpdate(tx, certId)
+}
+
 // FindAllExpiringCerts 查找需要自动更新的任务
 // 这里我们只返回有限的字段以节省内存
 func (this *SSLCertDAO) FindAllExpiringCerts(tx *dbs.Tx, days int) (result []*SSLCert, err error) {
@@ -415,6 +557,189 @@ func (this *SSLCertDAO) FindAllExpiringCerts(tx *dbs.Tx, days int) (result []*SS
 	return
 }
 
+// FindAllCertsExpiringBefore 查找在某个时间之前过期、但还没有过期的证书
+func (this *SSLCertDAO) FindAllCertsExpiringBefore(tx *dbs.Tx, timestamp int64) (result []*SSLCert, err error) {
+	_, err = this.Query(tx).
+		State(SSLCertStateEnabled).
+		Attr("isOn", true).
+		Gt("timeEndAt", time.Now().Unix()).
+		Lte("timeEndAt", timestamp).
+		Result("id", "adminId", "userId", "timeEndAt", "name", "dnsNames", "notifiedAt", "acmeTaskId").
+		Slice(&result).
+		AscPk().
+		FindAll()
+	return
+}
+
+// FindAllCertsWithFingerprint 根据SHA256指纹查找证书
+// 用于追查泄露或者错误签发的证书，includeDeleted 表示是否包含已删除的证书
+func (this *SSLCertDAO) FindAllCertsWithFingerprint(tx *dbs.Tx, fingerprint string, userId int64, includeDeleted bool) (result []*SSLCert, err error) {
+	if len(fingerprint) == 0 {
+		return
+	}
+	var query = this.Query(tx).
+		Attr("fingerprint", fingerprint)
+	if userId > 0 {
+		query.Attr("userId", userId)
+	}
+	if !includeDeleted {
+		query.State(SSLCertStateEnabled)
+	}
+	_, err = query.
+		Result("id", "adminId", "userId", "state", "isOn", "name", "description", "serverName", "isCA", "timeBeginAt", "timeEndAt", "dnsNames", "commonNames", "isACME", "acmeTaskId", "createdAt", "updatedAt", "fingerprint", "ocspStatus", "ocspStatusCheckedAt").
+		Slice(&result).
+		DescPk().
+		FindAll()
+	return
+}
+
+// FindDuplicatedCertId 查找和证书内容相同的已有证书
+// 使用证书指纹比较；正规证书颁发机构签发的证书中，签发者和序列号相同的证书内容一定相同，因此同样可以识别
+func (this *SSLCertDAO) FindDuplicatedCertId(tx *dbs.Tx, userId int64, isCA bool, keyStorage sslconfigs.SSLCertKeyStorage, certData []byte) (int64, error) {
+	var fingerprint = this.certFingerprint(certData)
+	if len(fingerprint) == 0 {
+		return 0, nil
+	}
+
+	return this.Query(tx).
+		State(SSLCertStateEnabled).
+		Attr("fingerprint", fingerprint).
+		Attr("userId", userId).
+		Attr("isCA", isCA).
+		Attr("keyStorage", keyStorage).
+		ResultPk().
+		AscPk().
+		FindInt64Col(0)
+}
+
+// FindAllDuplicatedCertGroups 查找所有重复的证书
+// 每组中的证书ID从小到大排列，第一个为最早创建的证书
+func (this *SSLCertDAO) FindAllDuplicatedCertGroups(tx *dbs.Tx, userId int64, userOnly bool) (groups [][]int64, err error) {
+	var query = this.Query(tx).
+		State(SSLCertStateEnabled).
+		Where("(fingerprint IS NOT NULL AND fingerprint!='')")
+	if userId > 0 {
+		query.Attr("userId", userId)
+	} else if userOnly {
+		query.Gt("userId", 0)
+	}
+
+	var certs []*SSLCert
+	_, err = query.
+		Result("id", "userId", "isCA", "keyStorage", "fingerprint").
+		AscPk().
+		Slice(&certs).
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var groupMap = map[string][]int64{} // key => certIds
+	var keys = []string{}
+	for _, cert := range certs {
+		var key = types.String(cert.UserId) + "_" + types.String(cert.IsCA) + "_" + cert.KeyStorage + "_" + cert.Fingerprint
+		_, ok := groupMap[key]
+		if !ok {
+			keys = append(keys, key)
+		}
+		groupMap[key] = append(groupMap[key], int64(cert.Id))
+	}
+	for _, key := range keys {
+		if len(groupMap[key]) > 1 {
+			groups = append(groups, groupMap[key])
+		}
+	}
+	return
+}
+
+// FillMissingFingerprints 为还没有指纹的证书计算指纹
+func (this *SSLCertDAO) FillMissingFingerprints(tx *dbs.Tx) error {
+	var lastId int64 = 0
+	for {
+		var certs []*SSLCert
+		_, err := this.Query(tx).
+			Gt("id", lastId).
+			Where("(fingerprint IS NULL OR fingerprint='')").
+			Result("id", "certData").
+			AscPk().
+			Limit(100).
+			Slice(&certs).
+			FindAll()
+		if err != nil {
+			return err
+		}
+		if len(certs) == 0 {
+			return nil
+		}
+
+		for _, cert := range certs {
+			lastId = int64(cert.Id)
+
+			var fingerprint = this.certFingerprint(cert.CertData)
+			if len(fingerprint) == 0 {
+				continue
+			}
+			err = this.Query(tx).
+				Pk(cert.Id).
+				Set("fingerprint", fingerprint).
+				UpdateQuickly()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// EncryptPlainKeys 使用主密钥加密还没有加密的私钥
+func (this *SSLCertDAO) EncryptPlainKeys(tx *dbs.Tx) error {
+	var vault = keyvault.SharedVault()
+	if !vault.IsEnabled() {
+		return nil
+	}
+	if vault.Err() != nil {
+		return vault.Err()
+	}
+
+	var lastId int64 = 0
+	for {
+		var certs []*SSLCert
+		_, err := this.Query(tx).
+			Gt("id", lastId).
+			Where("(keyData IS NOT NULL AND keyData!='' AND keyData NOT LIKE :encryptedPrefix)").
+			Param("encryptedPrefix", keyvault.EncryptedPrefix+"%").
+			Result("id", "keyData").
+			AscPk().
+			Limit(100).
+			Slice(&certs).
+			FindAll()
+		if err != nil {
+			return err
+		}
+		if len(certs) == 0 {
+			return nil
+		}
+
+		for _, cert := range certs {
+			lastId = int64(cert.Id)
+
+			encryptedKeyData, err := vault.Encrypt(cert.KeyData)
+			if err != nil {
+				return err
+			}
+
+			// 只更新仍然是明文的私钥，避免覆盖同时修改的数据
+			err = this.Query(tx).
+				Pk(cert.Id).
+				Attr("keyData", cert.KeyData).
+				Set("keyData", encryptedKeyData).
+				UpdateQuickly()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // UpdateCertNotifiedAt 设置当前证书事件通知时间
 func (this *SSLCertDAO) UpdateCertNotifiedAt(tx *dbs.Tx, certId int64) error {
 	_, err := this.Query(tx).
@@ -462,6 +787,74 @@ func (this *SSLCertDAO) UpdateCertUser(tx *dbs.Tx, certId int64, userId int64) e
 		UpdateQuickly()
 }
 
+// UpdateCertExternalId 设置证书的外部ID
+func (this *SSLCertDAO) UpdateCertExternalId(tx *dbs.Tx, certId int64, externalId string) error {
+	if certId <= 0 {
+		return errors.New("invalid certId")
+	}
+
+	err := dbutils.ValidateExternalId(externalId)
+	if err != nil {
+		return err
+	}
+
+	if len(externalId) > 0 {
+		existCertId, err := this.FindEnabledCertIdWithExternalId(tx, 0, externalId)
+		if err != nil {
+			return err
+		}
+		if existCertId > 0 && existCertId != certId {
+			return errors.New("the externalId '" + externalId + "' is used by another cert '" + types.String(existCertId) + "'")
+		}
+	}
+
+	return this.Query(tx).
+		Pk(certId).
+		Set("externalId", externalId).
+		UpdateQuickly()
+}
+
+// FindEnabledCertIdWithExternalId 根据外部ID查找证书ID
+func (this *SSLCertDAO) FindEnabledCertIdWithExternalId(tx *dbs.Tx, userId int64, externalId string) (int64, error) {
+	if len(externalId) == 0 {
+		return 0, nil
+	}
+	var query = this.Query(tx).
+		State(SSLCertStateEnabled).
+		Attr("externalId", externalId)
+	if userId > 0 {
+		query.Attr("userId", userId)
+	}
+	return query.
+		ResultPk().
+		FindInt64Col(0)
+}
+
+// FindEnabledCertIdsWithName 根据名称查找证书ID
+func (this *SSLCertDAO) FindEnabledCertIdsWithName(tx *dbs.Tx, userId int64, name string, size int64) (certIds []int64, err error) {
+	if len(name) == 0 {
+		return nil, nil
+	}
+	var query = this.Query(tx).
+		State(SSLCertStateEnabled).
+		Attr("name", name)
+	if userId > 0 {
+		query.Attr("userId", userId)
+	}
+	ones, err := query.
+		ResultPk().
+		AscPk().
+		Limit(size).
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range ones {
+		certIds = append(certIds, int64(one.(*SSLCert).Id))
+	}
+	return
+}
+
 // ListCertsToUpdateOCSP 查找需要更新OCSP的证书
 func (this *SSLCertDAO) ListCertsToUpdateOCSP(tx *dbs.Tx, maxTries int, size int64) (result []*SSLCert, err error) {
 	var nowTime = time.Now().Unix()
@@ -495,6 +888,37 @@ func (this *SSLCertDAO) ListCertsToUpdateOCSP(tx *dbs.Tx, maxTries int, size int
 	return
 }
 
+// ListCertsToCheckOCSPStatus 查找需要检查OCSP状态的证书
+// 已经被吊销的证书不再检查
+func (this *SSLCertDAO) ListCertsToCheckOCSPStatus(tx *dbs.Tx, checkedBefore int64, size int64) (result []*SSLCert, err error) {
+	var nowTime = time.Now().Unix()
+	_, err = this.Query(tx).
+		State(SSLCertStateEnabled).
+		Attr("isOn", true).
+		Attr("isCA", false).
+		Lt("timeBeginAt", nowTime).
+		Gt("timeEndAt", nowTime).
+		Lt("ocspStatusCheckedAt", checkedBefore).
+		Where("(ocspStatus IS NULL OR ocspStatus!=:revokedStatus)").
+		Param("revokedStatus", sslconfigs.SSLCertOCSPStatusRevoked).
+		Result("id", "adminId", "userId", "name", "certData", "dnsNames", "ocspStatus").
+		Asc("ocspStatusCheckedAt").
+		AscPk().
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// UpdateCertOCSPStatus 修改证书OCSP状态
+func (this *SSLCertDAO) UpdateCertOCSPStatus(tx *dbs.Tx, certId int64, status sslconfigs.SSLCertOCSPStatus) error {
+	return this.Query(tx).
+		Pk(certId).
+		Set("ocspStatus", status).
+		Set("ocspStatusCheckedAt", time.Now().Unix()).
+		UpdateQuickly()
+}
+
 // ListCertOCSPAfterVersion 列出某个版本后的OCSP
 func (this *SSLCertDAO) ListCertOCSPAfterVersion(tx *dbs.Tx, version int64, size int64) (result []*SSLCert, err error) {
 	// 不需要判断ocsp是否为空
@@ -653,6 +1077,74 @@ func (this *SSLCertDAO) ResetAllSSLCertsWithOCSPError(tx *dbs.Tx) error {
 		UpdateQuickly()
 }
 
+// UpdateCertKeyStorage 修改证书私钥存储位置
+func (this *SSLCertDAO) UpdateCertKeyStorage(tx *dbs.Tx, certId int64, keyStorage sslconfigs.SSLCertKeyStorage) error {
+	if certId <= 0 {
+		return errors.New("invalid certId")
+	}
+	var query = this.Query(tx).
+		Pk(certId).
+		Set("keyStorage", keyStorage)
+	if keyStorage == sslconfigs.SSLCertKeyStorageNode {
+		// 私钥只保存在节点上
+		query.Set("keyData", "")
+	}
+	return query.UpdateQuickly()
+}
+
+// FindCertKeyStorage 查找证书私钥存储位置
+func (this *SSLCertDAO) FindCertKeyStorage(tx *dbs.Tx, certId int64) (sslconfigs.SSLCertKeyStorage, error) {
+	return this.Query(tx).
+		Pk(certId).
+		Result("keyStorage").
+		FindStringCol("")
+}
+
+// SealCertKeyForNodes 使用节点公钥加密私钥并等待节点接收
+// 管理员证书发送到所有节点，用户证书只发送到用户所在集群的节点
+func (this *SSLCertDAO) SealCertKeyForNodes(tx *dbs.Tx, certId int64, userId int64, certData []byte, keyData []byte) error {
+	if len(keyData) == 0 {
+		return errors.New("'keyData' should not be empty")
+	}
+
+	fingerprint, err := sslconfigs.CertKeyFingerprint(certData)
+	if err != nil {
+		return err
+	}
+
+	var clusterIds = []int64{}
+	if userId > 0 {
+		clusterId, err := SharedUserDAO.FindUserClusterId(tx, userId)
+		if err != nil {
+			return err
+		}
+		if clusterId <= 0 {
+			return errors.New("can not find cluster of user '" + types.String(userId) + "'")
+		}
+		clusterIds = append(clusterIds, clusterId)
+	}
+
+	nodes, err := SharedNodeDAO.FindAllEnabledNodesWithSSLKeyPublicKey(tx, clusterIds)
+	if err != nil {
+		return err
+	}
+	if len(nodes) == 0 {
+		return errors.New("there is no node available to store private key")
+	}
+
+	for _, node := range nodes {
+		sealedKeyData, err := sslconfigs.SealNodeCertKey(node.SslKeyPublicKey, keyData)
+		if err != nil {
+			return fmt.Errorf("seal key for node '%d' failed: %w", node.Id, err)
+		}
+		err = SharedNodeSSLCertKeyDAO.CreateKey(tx, int64(node.Id), int64(node.ClusterId), certId, fingerprint, sealedKeyData)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NotifyUpdate 通知更新
 func (this *SSLCertDAO) NotifyUpdate(tx *dbs.Tx, certId int64) error {
 	policyIds, err := SharedSSLPolicyDAO.FindAllEnabledPolicyIdsWithCertId(tx, certId)
@@ -663,6 +1155,11 @@ func (this *SSLCertDAO) NotifyUpdate(tx *dbs.Tx, certId int64) error {
 		return nil
 	}
 
+	// 证书包含在策略配置中
+	for _, policyId := range policyIds {
+		SharedSSLPolicyDAO.deleteDAOCache(policyId)
+	}
+
 	// 通知服务更新
 	serverIds, err := SharedServerDAO.FindAllEnabledServerIdsWithSSLPolicyIds(tx, policyIds)
 	if err != nil {
@@ -683,6 +1180,23 @@ func (this *SSLCertDAO) NotifyUpdate(tx *dbs.Tx, certId int64) error {
 	return nil
 }
 
+// 构造标签搜索查询，需要同时包含所有标签
+func (this *SSLCertDAO) buildTagsSearchingQuery(query *dbs.Query, tags []string) {
+	for index, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if len(tag) == 0 {
+			continue
+		}
+		tagJSON, err := json.Marshal(tag)
+		if err != nil {
+			continue
+		}
+		var param = "tag" + types.String(index)
+		query.Where("JSON_CONTAINS(tags, :"+param+")").
+			Param(param, string(tagJSON))
+	}
+}
+
 // 构造通过域名搜索证书的查询对象
 func (this *SSLCertDAO) buildDomainSearchingQuery(query *dbs.Query, domains []string) error {
 	if len(domains) == 0 {
@@ -752,3 +1266,29 @@ func (this *SSLCertDAO) buildDomainSearchingQuery(query *dbs.Query, domains []st
 
 	return nil
 }
+
+// FindAllEnabledCertsWithKeyword 根据名称、CN或DNS名称查找证书
+func (this *SSLCertDAO) FindAllEnabledCertsWithKeyword(tx *dbs.Tx, keyword string, size int64) (result []*SSLCert, err error) {
+	if len(keyword) == 0 {
+		return
+	}
+	_, err = this.Query(tx).
+		State(SSLCertStateEnabled).
+		Result("id", "name", "commonNames", "dnsNames", "userId", "timeEndAt", "isOn").
+		Where("(name LIKE :keyword OR commonNames LIKE :keyword OR dnsNames LIKE :keyword)").
+		Param("keyword", dbutils.QuoteLike(keyword)).
+		DescPk().
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// 计算证书指纹，证书数据无法解析时返回空
+func (this *SSLCertDAO) certFingerprint(certData []byte) string {
+	fingerprint, err := sslconfigs.CertFingerprint(certData)
+	if err != nil {
+		return ""
+	}
+	return fingerprint
+}
@@ -0,0 +1,291 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/certdeploy"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
+)
+
+const (
+	SSLCertDeployTargetStateEnabled  = 1 // 已启用
+	SSLCertDeployTargetStateDisabled = 0 // 已禁用
+)
+
+type SSLCertDeployTargetDAO dbs.DAO
+
+func NewSSLCertDeployTargetDAO() *SSLCertDeployTargetDAO {
+	return dbs.NewDAO(&SSLCertDeployTargetDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeSSLCertDeployTargets",
+			Model:  new(SSLCertDeployTarget),
+			PkName: "id",
+		},
+	}).(*SSLCertDeployTargetDAO)
+}
+
+var SharedSSLCertDeployTargetDAO *SSLCertDeployTargetDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedSSLCertDeployTargetDAO = NewSSLCertDeployTargetDAO()
+	})
+}
+
+// EnableSSLCertDeployTarget 启用条目
+func (this *SSLCertDeployTargetDAO) EnableSSLCertDeployTarget(tx *dbs.Tx, id int64) error {
+	_, err := this.Query(tx).
+		Pk(id).
+		Set("state", SSLCertDeployTargetStateEnabled).
+		Update()
+	return err
+}
+
+// DisableSSLCertDeployTarget 禁用条目
+func (this *SSLCertDeployTargetDAO) DisableSSLCertDeployTarget(tx *dbs.Tx, id int64) error {
+	_, err := this.Query(tx).
+		Pk(id).
+		Set("state", SSLCertDeployTargetStateDisabled).
+		Update()
+	return err
+}
+
+// FindEnabledSSLCertDeployTarget 查找启用中的条目
+func (this *SSLCertDeployTargetDAO) FindEnabledSSLCertDeployTarget(tx *dbs.Tx, id int64) (*SSLCertDeployTarget, error) {
+	result, err := this.Query(tx).
+		Pk(id).
+		State(SSLCertDeployTargetStateEnabled).
+		Find()
+	if result == nil {
+		return nil, err
+	}
+	return result.(*SSLCertDeployTarget), err
+}
+
+// CreateTarget 创建部署目标
+func (this *SSLCertDeployTargetDAO) CreateTarget(tx *dbs.Tx, adminId int64, userId int64, certId int64, name string, targetType certdeploy.TargetType, paramsJSON []byte, isOn bool) (int64, error) {
+	err := this.validateParams(targetType, paramsJSON)
+	if err != nil {
+		return 0, err
+	}
+
+	var op = NewSSLCertDeployTargetOperator()
+	op.AdminId = adminId
+	op.UserId = userId
+	op.CertId = certId
+	op.Name = name
+	op.Type = targetType
+	op.Params = paramsJSON
+	op.IsOn = isOn
+	op.CreatedAt = time.Now().Unix()
+	op.State = SSLCertDeployTargetStateEnabled
+	return this.SaveInt64(tx, op)
+}
+
+// UpdateTarget 修改部署目标
+// 参数中被掩码的值会使用原有的值代替
+func (this *SSLCertDeployTargetDAO) UpdateTarget(tx *dbs.Tx, targetId int64, name string, paramsJSON []byte, isOn bool) error {
+	if targetId <= 0 {
+		return errors.New("invalid targetId")
+	}
+
+	target, err := this.FindEnabledSSLCertDeployTarget(tx, targetId)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		return errors.New("can not find target with id '" + types.String(targetId) + "'")
+	}
+
+	paramsJSON, err = certdeploy.UnmaskParams(target.Params, paramsJSON)
+	if err != nil {
+		return err
+	}
+	err = this.validateParams(target.Type, paramsJSON)
+	if err != nil {
+		return err
+	}
+
+	var op = NewSSLCertDeployTargetOperator()
+	op.Id = targetId
+	op.Name = name
+	op.Params = paramsJSON
+	op.IsOn = isOn
+	return this.Save(tx, op)
+}
+
+// FindAllEnabledTargetsWithCertId 查找证书的所有部署目标
+func (this *SSLCertDeployTargetDAO) FindAllEnabledTargetsWithCertId(tx *dbs.Tx, certId int64) (result []*SSLCertDeployTarget, err error) {
+	if certId <= 0 {
+		return
+	}
+	_, err = this.Query(tx).
+		Attr("certId", certId).
+		State(SSLCertDeployTargetStateEnabled).
+		AscPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// FindTargetCertId 查找部署目标对应的证书ID
+func (this *SSLCertDeployTargetDAO) FindTargetCertId(tx *dbs.Tx, targetId int64) (int64, error) {
+	return this.Query(tx).
+		Pk(targetId).
+		Result("certId").
+		FindInt64Col(0)
+}
+
+// ReplaceTargetCert 将部署目标关联的证书替换为另外一个证书
+func (this *SSLCertDeployTargetDAO) ReplaceTargetCert(tx *dbs.Tx, oldCertId int64, newCertId int64) error {
+	if oldCertId <= 0 || newCertId <= 0 || oldCertId == newCertId {
+		return nil
+	}
+	return this.Query(tx).
+		State(SSLCertDeployTargetStateEnabled).
+		Attr("certId", oldCertId).
+		Set("certId", newCertId).
+		UpdateQuickly()
+}
+
+// UpdateTargetDeployResult 记录部署结果
+func (this *SSLCertDeployTargetDAO) UpdateTargetDeployResult(tx *dbs.Tx, targetId int64, deployErr error) error {
+	var errString = ""
+	if deployErr != nil {
+		errString = utils.LimitString(deployErr.Error(), 1024)
+	}
+	return this.Query(tx).
+		Pk(targetId).
+		Set("lastDeployedAt", time.Now().Unix()).
+		Set("lastDeployIsOk", deployErr == nil).
+		Set("lastDeployError", errString).
+		UpdateQuickly()
+}
+
+// DeployTarget 部署证书到某个目标
+// 和外部平台的交互可能持续较长时间，所以不使用事务
+func (this *SSLCertDeployTargetDAO) DeployTarget(targetId int64) error {
+	target, err := this.FindEnabledSSLCertDeployTarget(nil, targetId)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		return errors.New("can not find target with id '" + types.String(targetId) + "'")
+	}
+
+	deployErr := this.deploy(target)
+	err = this.UpdateTargetDeployResult(nil, targetId, deployErr)
+	if err != nil {
+		return err
+	}
+	return deployErr
+}
+
+// DeployCert 部署证书到所有启用的目标
+// 单个目标失败不影响其他目标，失败时发送消息通知
+func (this *SSLCertDeployTargetDAO) DeployCert(certId int64) error {
+	targets, err := this.FindAllEnabledTargetsWithCertId(nil, certId)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		if !target.IsOn {
+			continue
+		}
+
+		deployErr := this.deploy(target)
+		err = this.UpdateTargetDeployResult(nil, int64(target.Id), deployErr)
+		if err != nil {
+			return err
+		}
+		if deployErr == nil {
+			continue
+		}
+
+		remotelogs.Error("SSLCertDeployTargetDAO", "deploy cert '"+types.String(certId)+"' to target '"+target.Name+"' failed: "+deployErr.Error())
+
+		var subject = "SSL证书部署失败"
+		var msg = "SSL证书部署到\"" + target.Name + "\"（" + certdeploy.FindTargetTypeName(target.Type) + "）失败：" + deployErr.Error()
+		paramsJSON, err := json.Marshal(maps.Map{
+			"certId":   certId,
+			"targetId": target.Id,
+		})
+		if err != nil {
+			return err
+		}
+		err = SharedMessageDAO.CreateMessage(nil, int64(target.AdminId), int64(target.UserId), MessageTypeSSLCertDeployFailed, MessageLevelError, subject, msg, paramsJSON)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (this *SSLCertDeployTargetDAO) deploy(target *SSLCertDeployTarget) error {
+	cert, err := SharedSSLCertDAO.FindEnabledSSLCert(nil, int64(target.CertId))
+	if err != nil {
+		return err
+	}
+	if cert == nil {
+		return errors.New("can not find cert with id '" + types.String(target.CertId) + "'")
+	}
+	if len(cert.KeyData) == 0 {
+		return errors.New("the private key of the cert is not stored in database, can not deploy to external platforms")
+	}
+
+	keyData, err := cert.DecodeKeyData()
+	if err != nil {
+		return errors.New("decrypt key failed: " + err.Error())
+	}
+
+	targetInstance, err := this.initTarget(target.Type, target.Params)
+	if err != nil {
+		return err
+	}
+
+	return targetInstance.Deploy(&certdeploy.Cert{
+		Id:          int64(cert.Id),
+		Name:        cert.Name,
+		CertData:    cert.CertData,
+		KeyData:     keyData,
+		DNSNames:    cert.DecodeDNSNames(),
+		TimeBeginAt: int64(cert.TimeBeginAt),
+		TimeEndAt:   int64(cert.TimeEndAt),
+	})
+}
+
+func (this *SSLCertDeployTargetDAO) initTarget(targetType certdeploy.TargetType, paramsJSON []byte) (certdeploy.TargetInterface, error) {
+	var targetInstance = certdeploy.FindTarget(targetType)
+	if targetInstance == nil {
+		return nil, errors.New("invalid target type '" + targetType + "'")
+	}
+
+	var params = maps.Map{}
+	if len(paramsJSON) > 0 {
+		err := json.Unmarshal(paramsJSON, &params)
+		if err != nil {
+			return nil, errors.New("decode params failed: " + err.Error())
+		}
+	}
+	err := targetInstance.Init(params)
+	if err != nil {
+		return nil, err
+	}
+	return targetInstance, nil
+}
+
+// 检查参数是否正确
+func (this *SSLCertDeployTargetDAO) validateParams(targetType certdeploy.TargetType, paramsJSON []byte) error {
+	_, err := this.initTarget(targetType, paramsJSON)
+	return err
+}
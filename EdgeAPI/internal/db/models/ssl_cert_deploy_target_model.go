@@ -0,0 +1,40 @@
+package models
+
+import "github.com/iwind/TeaGo/dbs"
+
+// SSLCertDeployTarget SSL证书部署目标
+type SSLCertDeployTarget struct {
+	Id              uint64   `field:"id"`              // ID
+	AdminId         uint32   `field:"adminId"`         // 管理员ID
+	UserId          uint64   `field:"userId"`          // 用户ID
+	CertId          uint64   `field:"certId"`          // 证书ID
+	IsOn            bool     `field:"isOn"`            // 是否启用
+	Name            string   `field:"name"`            // 名称
+	Type            string   `field:"type"`            // 部署目标类型
+	Params          dbs.JSON `field:"params"`          // 参数
+	LastDeployedAt  uint64   `field:"lastDeployedAt"`  // 最后部署时间
+	LastDeployIsOk  bool     `field:"lastDeployIsOk"`  // 最后部署是否成功
+	LastDeployError string   `field:"lastDeployError"` // 最后部署错误信息
+	CreatedAt       uint64   `field:"createdAt"`       // 创建时间
+	State           uint8    `field:"state"`           // 状态
+}
+
+type SSLCertDeployTargetOperator struct {
+	Id              any // ID
+	AdminId         any // 管理员ID
+	UserId          any // 用户ID
+	CertId          any // 证书ID
+	IsOn            any // 是否启用
+	Name            any // 名称
+	Type            any // 部署目标类型
+	Params          any // 参数
+	LastDeployedAt  any // 最后部署时间
+	LastDeployIsOk  any // 最后部署是否成功
+	LastDeployError any // 最后部署错误信息
+	CreatedAt       any // 创建时间
+	State           any // 状态
+}
+
+func NewSSLCertDeployTargetOperator() *SSLCertDeployTargetOperator {
+	return &SSLCertDeployTargetOperator{}
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/iwind/TeaGo/maps"
+)
+
+// DecodeParams 解析参数
+func (this *SSLCertDeployTarget) DecodeParams() (maps.Map, error) {
+	var result = maps.Map{}
+	if len(this.Params) == 0 {
+		return result, nil
+	}
+	err := json.Unmarshal(this.Params, &result)
+	return result, err
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/rands"
+)
+
+type SSLCertExpireNoticeDAO dbs.DAO
+
+func NewSSLCertExpireNoticeDAO() *SSLCertExpireNoticeDAO {
+	return dbs.NewDAO(&SSLCertExpireNoticeDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeSSLCertExpireNotices",
+			Model:  new(SSLCertExpireNotice),
+			PkName: "id",
+		},
+	}).(*SSLCertExpireNoticeDAO)
+}
+
+var SharedSSLCertExpireNoticeDAO *SSLCertExpireNoticeDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedSSLCertExpireNoticeDAO = NewSSLCertExpireNoticeDAO()
+	})
+
+	dbs.OnReadyDone(func() {
+		// 清理数据任务
+		var ticker = time.NewTicker(time.Duration(rands.Int(24, 48)) * time.Hour)
+		goman.New(func() {
+			for range ticker.C {
+				err := SharedSSLCertExpireNoticeDAO.Clean(nil, 400) // 需要大于证书最长的有效期
+				if err != nil {
+					remotelogs.Error("SSLCertExpireNoticeDAO", "clean expired data failed: "+err.Error())
+				}
+			}
+		})
+	})
+}
+
+// ExistNotice 检查是否已经针对证书的某个过期时间发送过通知
+func (this *SSLCertExpireNoticeDAO) ExistNotice(tx *dbs.Tx, certId int64, days int, timeEndAt int64) (bool, error) {
+	return this.Query(tx).
+		Attr("certId", certId).
+		Attr("days", days).
+		Attr("timeEndAt", timeEndAt).
+		Exist()
+}
+
+// CreateNotice 记录通知
+// 证书更新后过期时间会变化，所以同一个证书可以针对新的过期时间再次通知
+func (this *SSLCertExpireNoticeDAO) CreateNotice(tx *dbs.Tx, certId int64, days int, timeEndAt int64) error {
+	return this.Query(tx).
+		InsertOrUpdateQuickly(maps.Map{
+			"certId":    certId,
+			"days":      days,
+			"timeEndAt": timeEndAt,
+			"createdAt": time.Now().Unix(),
+		}, maps.Map{
+			"createdAt": time.Now().Unix(),
+		})
+}
+
+// Clean 清理N天以前的记录
+func (this *SSLCertExpireNoticeDAO) Clean(tx *dbs.Tx, days int) error {
+	_, err := this.Query(tx).
+		Lt("createdAt", time.Now().Unix()-int64(days)*86400).
+		Delete()
+	return err
+}
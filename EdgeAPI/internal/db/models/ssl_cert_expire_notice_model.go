@@ -0,0 +1,22 @@
+package models
+
+// SSLCertExpireNotice SSL证书过期通知记录
+type SSLCertExpireNotice struct {
+	Id        uint64 `field:"id"`        // ID
+	CertId    uint64 `field:"certId"`    // 证书ID
+	Days      uint32 `field:"days"`      // 提前通知的天数
+	TimeEndAt uint64 `field:"timeEndAt"` // 通知时证书的过期时间
+	CreatedAt uint64 `field:"createdAt"` // 通知时间
+}
+
+type SSLCertExpireNoticeOperator struct {
+	Id        any // ID
+	CertId    any // 证书ID
+	Days      any // 提前通知的天数
+	TimeEndAt any // 通知时证书的过期时间
+	CreatedAt any // 通知时间
+}
+
+func NewSSLCertExpireNoticeOperator() *SSLCertExpireNoticeOperator {
+	return &SSLCertExpireNoticeOperator{}
+}
@@ -1,6 +1,10 @@
 package models
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/keyvault"
+)
 
 func (this *SSLCert) DecodeDNSNames() []string {
 	if len(this.DnsNames) == 0 {
@@ -29,3 +33,23 @@ func (this *SSLCert) DecodeCommonNames() []string {
 
 	return result
 }
+
+// DecodeTags 解析标签
+func (this *SSLCert) DecodeTags() []string {
+	if len(this.Tags) == 0 {
+		return nil
+	}
+
+	var result = []string{}
+	var err = json.Unmarshal(this.Tags, &result)
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+// DecodeKeyData 读取解密后的私钥
+func (this *SSLCert) DecodeKeyData() ([]byte, error) {
+	return keyvault.SharedVault().Decrypt(this.KeyData)
+}
@@ -3,7 +3,9 @@ package models
 import (
 	"encoding/json"
 	"errors"
+	"time"
 
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/shared"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
@@ -90,6 +92,17 @@ func (this *SSLPolicyDAO) ComposePolicyConfig(tx *dbs.Tx, policyId int64, ignore
 		return cacheConfig.(*sslconfigs.SSLPolicy), nil
 	}
 
+	// 使用DataMap时证书数据不在配置中，所以不能使用DAO缓存
+	var daoCacheKey = types.String(policyId) + "@" + types.String(ignoreData)
+	var canUseDAOCache = ignoreData || dataMap == nil
+	if canUseDAOCache {
+		var daoCacheConfig = &sslconfigs.SSLPolicy{}
+		if dbutils.SharedDAOCache.ReadJSON(tx, DAOCacheSSLPolicyConfig, daoCacheKey, daoCacheConfig) {
+			cacheMap.Put(cacheKey, daoCacheConfig)
+			return daoCacheConfig, nil
+		}
+	}
+
 	policy, err := this.FindEnabledSSLPolicy(tx, policyId)
 	if err != nil {
 		return nil, err
@@ -176,6 +189,9 @@ func (this *SSLPolicyDAO) ComposePolicyConfig(tx *dbs.Tx, policyId int64, ignore
 	if cacheMap != nil {
 		cacheMap.Put(cacheKey, config)
 	}
+	if canUseDAOCache {
+		dbutils.SharedDAOCache.WriteJSON(tx, DAOCacheSSLPolicyConfig, daoCacheKey, config)
+	}
 
 	return config, nil
 }
@@ -201,6 +217,184 @@ func (this *SSLPolicyDAO) FindAllEnabledPolicyIdsWithCertId(tx *dbs.Tx, certId i
 	return policyIds, nil
 }
 
+// ReplacePolicyCert 将所有策略中的证书替换为另外一个证书
+func (this *SSLPolicyDAO) ReplacePolicyCert(tx *dbs.Tx, oldCertId int64, newCertId int64) error {
+	if oldCertId <= 0 || newCertId <= 0 || oldCertId == newCertId {
+		return nil
+	}
+
+	var policies []*SSLPolicy
+	_, err := this.Query(tx).
+		State(SSLPolicyStateEnabled).
+		Where("(JSON_CONTAINS(certs, :certJSON) OR JSON_CONTAINS(clientCACerts, :certJSON))").
+		Param("certJSON", maps.Map{"certId": oldCertId}.AsJSON()).
+		Result("id", "certs", "clientCACerts").
+		Slice(&policies).
+		FindAll()
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		var op = NewSSLPolicyOperator()
+		op.Id = policy.Id
+
+		certsJSON, isChanged, err := this.replaceCertRefs(policy.Certs, oldCertId, newCertId)
+		if err != nil {
+			return err
+		}
+		if isChanged {
+			op.Certs = certsJSON
+		}
+
+		clientCACertsJSON, isChanged, err := this.replaceCertRefs(policy.ClientCACerts, oldCertId, newCertId)
+		if err != nil {
+			return err
+		}
+		if isChanged {
+			op.ClientCACerts = clientCACertsJSON
+		}
+
+		err = this.Save(tx, op)
+		if err != nil {
+			return err
+		}
+		err = this.NotifyUpdate(tx, int64(policy.Id))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListEnabledPolicyIdsAfterId 按ID从小到大列出启用的策略ID
+func (this *SSLPolicyDAO) ListEnabledPolicyIdsAfterId(tx *dbs.Tx, lastPolicyId int64, size int64) (policyIds []int64, err error) {
+	ones, err := this.Query(tx).
+		State(SSLPolicyStateEnabled).
+		Gt("id", lastPolicyId).
+		ResultPk().
+		AscPk().
+		Limit(size).
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range ones {
+		policyIds = append(policyIds, int64(one.(*SSLPolicy).Id))
+	}
+	return
+}
+
+// RemoveSupersededCerts 从策略中移除已过期并且已被新证书替代的证书
+// 新证书需要在同一个策略中、正在有效期内，并且包含旧证书的所有域名
+func (this *SSLPolicyDAO) RemoveSupersededCerts(tx *dbs.Tx, policyId int64, cacheMap *utils.CacheMap) (result []*SSLPolicySupersededCert, err error) {
+	policy, err := this.FindEnabledSSLPolicy(tx, policyId)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+	if !IsNotNull(policy.Certs) {
+		return nil, nil
+	}
+
+	var refs = []*sslconfigs.SSLCertRef{}
+	err = json.Unmarshal(policy.Certs, &refs)
+	if err != nil {
+		return nil, err
+	}
+	if len(refs) < 2 {
+		return nil, nil
+	}
+
+	var certs = []*sslconfigs.SSLCertConfig{}
+	for _, ref := range refs {
+		cert, err := SharedSSLCertDAO.ComposeCertConfig(tx, ref.CertId, true, nil, cacheMap)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+
+	var now = time.Now().Unix()
+	var newRefs = []*sslconfigs.SSLCertRef{}
+	for index, ref := range refs {
+		var cert = certs[index]
+		if cert == nil || cert.TimeEndAt >= now || len(cert.DNSNames) == 0 {
+			newRefs = append(newRefs, ref)
+			continue
+		}
+
+		var newCertId int64
+		for newIndex, newCert := range certs {
+			if newIndex == index || newCert == nil || !newCert.IsOn || !refs[newIndex].IsOn || newCert.TimeEndAt < now {
+				continue
+			}
+			if cert.IsSupersededBy(newCert.DNSNames, newCert.TimeEndAt) {
+				newCertId = newCert.Id
+				break
+			}
+		}
+		if newCertId <= 0 {
+			newRefs = append(newRefs, ref)
+			continue
+		}
+
+		result = append(result, &SSLPolicySupersededCert{
+			PolicyId:  policyId,
+			UserId:    int64(policy.UserId),
+			CertId:    cert.Id,
+			CertName:  cert.Name,
+			NewCertId: newCertId,
+		})
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	newRefsJSON, err := json.Marshal(newRefs)
+	if err != nil {
+		return nil, err
+	}
+	err = this.Query(tx).
+		Pk(policyId).
+		Set("certs", newRefsJSON).
+		UpdateQuickly()
+	if err != nil {
+		return nil, err
+	}
+	return result, this.NotifyUpdate(tx, policyId)
+}
+
+// 替换证书引用列表中的证书，并去除重复的引用
+func (this *SSLPolicyDAO) replaceCertRefs(refsJSON []byte, oldCertId int64, newCertId int64) (resultJSON []byte, isChanged bool, err error) {
+	if !IsNotNull(refsJSON) {
+		return
+	}
+	var refs = []*sslconfigs.SSLCertRef{}
+	err = json.Unmarshal(refsJSON, &refs)
+	if err != nil {
+		return
+	}
+
+	var newRefs = []*sslconfigs.SSLCertRef{}
+	var certIdMap = map[int64]bool{}
+	for _, ref := range refs {
+		if ref.CertId == oldCertId {
+			ref.CertId = newCertId
+			isChanged = true
+		}
+		if certIdMap[ref.CertId] {
+			continue
+		}
+		certIdMap[ref.CertId] = true
+		newRefs = append(newRefs, ref)
+	}
+	if !isChanged {
+		return
+	}
+	resultJSON, err = json.Marshal(newRefs)
+	return
+}
+
 // CreatePolicy 创建Policy
 func (this *SSLPolicyDAO) CreatePolicy(tx *dbs.Tx, adminId int64, userId int64, http2Enabled bool, http3Enabled bool, minVersion string, certsJSON []byte, hstsJSON []byte, ocspIsOn bool, clientAuthType int32, clientCACertsJSON []byte, cipherSuitesIsOn bool, cipherSuites []string) (int64, error) {
 	var op = NewSSLPolicyOperator()
@@ -325,6 +519,8 @@ func (this *SSLPolicyDAO) UpdatePolicyUser(tx *dbs.Tx, policyId int64, userId in
 
 // NotifyUpdate 通知更新
 func (this *SSLPolicyDAO) NotifyUpdate(tx *dbs.Tx, policyId int64) error {
+	this.deleteDAOCache(policyId)
+
 	serverIds, err := SharedServerDAO.FindAllEnabledServerIdsWithSSLPolicyIds(tx, []int64{policyId})
 	if err != nil {
 		return err
@@ -337,3 +533,47 @@ func (this *SSLPolicyDAO) NotifyUpdate(tx *dbs.Tx, policyId int64) error {
 	}
 	return nil
 }
+
+// 删除策略配置缓存
+func (this *SSLPolicyDAO) deleteDAOCache(policyId int64) {
+	for _, ignoreData := range []bool{true, false} {
+		dbutils.SharedDAOCache.Delete(DAOCacheSSLPolicyConfig, types.String(policyId)+"@"+types.String(ignoreData))
+	}
+}
+
+// CloneSSLPolicy 复制策略，证书仍然使用原有的证书
+func (this *SSLPolicyDAO) CloneSSLPolicy(tx *dbs.Tx, fromPolicyId int64) (newPolicyId int64, err error) {
+	if fromPolicyId <= 0 {
+		return
+	}
+	policy, err := this.FindEnabledSSLPolicy(tx, fromPolicyId)
+	if err != nil || policy == nil {
+		return 0, err
+	}
+
+	var op = NewSSLPolicyOperator()
+	op.AdminId = policy.AdminId
+	op.UserId = policy.UserId
+	op.IsOn = policy.IsOn
+	if IsNotNull(policy.Certs) {
+		op.Certs = policy.Certs
+	}
+	if IsNotNull(policy.ClientCACerts) {
+		op.ClientCACerts = policy.ClientCACerts
+	}
+	op.ClientAuthType = policy.ClientAuthType
+	op.MinVersion = policy.MinVersion
+	op.CipherSuitesIsOn = policy.CipherSuitesIsOn
+	if IsNotNull(policy.CipherSuites) {
+		op.CipherSuites = policy.CipherSuites
+	}
+	if IsNotNull(policy.Hsts) {
+		op.Hsts = policy.Hsts
+	}
+	op.Http2Enabled = policy.Http2Enabled
+	op.Http3Enabled = policy.Http3Enabled
+	op.OcspIsOn = policy.OcspIsOn
+	op.State = SSLPolicyStateEnabled
+	op.CreatedAt = time.Now().Unix()
+	return this.SaveInt64(tx, op)
+}
@@ -19,3 +19,12 @@ func (this *SSLPolicy) DecodeCerts() []*sslconfigs.SSLCertRef {
 	}
 	return refs
 }
+
+// SSLPolicySupersededCert 从策略中移除的已被替代的证书
+type SSLPolicySupersededCert struct {
+	PolicyId  int64  // 策略ID
+	UserId    int64  // 策略所属用户ID
+	CertId    int64  // 被移除的证书ID
+	CertName  string // 被移除的证书名称
+	NewCertId int64  // 替代的新证书ID
+}
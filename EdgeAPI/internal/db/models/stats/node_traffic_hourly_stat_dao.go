@@ -199,6 +199,27 @@ func (this *NodeTrafficHourlyStatDAO) FindHourlyStatsWithNodeId(tx *dbs.Tx, role
 	return result, nil
 }
 
+// FindHourlyStatsWithNodes 查找一组节点的小时统计，用于统计查询
+// clusterId 和 nodeIds 均为可选项
+func (this *NodeTrafficHourlyStatDAO) FindHourlyStatsWithNodes(tx *dbs.Tx, role string, clusterId int64, nodeIds []int64, hourFrom string, hourTo string) (result []*NodeTrafficHourlyStat, err error) {
+	var query = this.Query(tx).
+		Attr("role", role).
+		Between("hour", hourFrom, hourTo).
+		Result("nodeId, hour, SUM(bytes) AS bytes, SUM(cachedBytes) AS cachedBytes, SUM(countRequests) AS countRequests, SUM(countCachedRequests) AS countCachedRequests, SUM(countAttackRequests) AS countAttackRequests, SUM(attackBytes) AS attackBytes").
+		Group("nodeId").
+		Group("hour")
+	if clusterId > 0 {
+		query.Attr("clusterId", clusterId)
+	}
+	if len(nodeIds) > 0 {
+		query.Attr("nodeId", nodeIds)
+	}
+	_, err = query.
+		Slice(&result).
+		FindAll()
+	return
+}
+
 // CleanDays 清理历史数据
 func (this *NodeTrafficHourlyStatDAO) CleanDays(tx *dbs.Tx, days int) error {
 	var hour = timeutil.Format("Ymd00", time.Now().AddDate(0, 0, -days))
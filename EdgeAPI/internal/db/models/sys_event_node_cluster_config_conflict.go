@@ -0,0 +1,37 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models
+
+import (
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+)
+
+// NodeClusterConfigConflictEvent 多个API节点同时写入同一个集群的配置
+// 执行时重新通知集群配置变化，保证节点最终取得最新的配置
+type NodeClusterConfigConflictEvent struct {
+	Role      string `json:"role"`
+	ClusterId int64  `json:"clusterId"`
+}
+
+func NewNodeClusterConfigConflictEvent(role string, clusterId int64) *NodeClusterConfigConflictEvent {
+	return &NodeClusterConfigConflictEvent{
+		Role:      role,
+		ClusterId: clusterId,
+	}
+}
+
+func (this *NodeClusterConfigConflictEvent) Type() string {
+	return "NodeClusterConfigConflict"
+}
+
+func (this *NodeClusterConfigConflictEvent) Run() error {
+	if this.ClusterId <= 0 {
+		return nil
+	}
+
+	var taskType = NodeTaskTypeConfigChanged
+	if this.Role == nodeconfigs.NodeRoleDNS {
+		taskType = NSNodeTaskTypeConfigChanged
+	}
+	return SharedNodeTaskDAO.CreateClusterTask(nil, this.Role, this.ClusterId, 0, 0, taskType)
+}
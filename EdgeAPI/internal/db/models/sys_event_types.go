@@ -9,6 +9,7 @@ var eventTypeMapping = map[string]reflect.Type{} // eventType => reflect type
 func init() {
 	for _, event := range []EventInterface{
 		// Event列表
+		&NodeClusterConfigConflictEvent{},
 	} {
 		eventTypeMapping[event.Type()] = reflect.ValueOf(event).Elem().Type()
 	}
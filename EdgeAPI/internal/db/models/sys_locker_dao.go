@@ -115,6 +115,52 @@ func (this *SysLockerDAO) Unlock(tx *dbs.Tx, key string) error {
 	return err
 }
 
+// TryLease 尝试获得一个有效期为 lease 秒的锁，锁被占用时返回false
+// 使用条件更新保证多个API节点同时获取时只有一个可以成功；获得的锁到期后自动失效
+func (this *SysLockerDAO) TryLease(tx *dbs.Tx, key string, lease int64) (ok bool, err error) {
+	if lease <= 0 {
+		lease = 1
+	}
+
+	var now = time.Now().Unix()
+	rowsAffected, err := this.Query(tx).
+		Attr("key", key).
+		Lt("timeoutAt", now).
+		Set("version", dbs.SQL("version+1")).
+		Set("timeoutAt", now+lease-1).
+		Update()
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected > 0 {
+		return true, nil
+	}
+
+	exists, err := this.Query(tx).
+		Attr("key", key).
+		Exist()
+	if err != nil || exists {
+		return false, err
+	}
+
+	var op = NewSysLockerOperator()
+	op.Key = key
+	op.Version = 1
+	op.TimeoutAt = now + lease - 1
+	err = this.Save(tx, op)
+	if err != nil {
+		// 同时被其他节点创建
+		exists, existErr := this.Query(tx).
+			Attr("key", key).
+			Exist()
+		if existErr == nil && exists {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 const sysLockerStep = 8
 
 var increment = NewSysLockerIncrement(sysLockerStep)
@@ -192,6 +238,23 @@ func (this *SysLockerDAO) Increase(tx *dbs.Tx, key string, defaultValue int64) (
 		FindInt64Col(0)
 }
 
+// IncreaseAtLeast 增加版本号，并保证新的版本号不小于 minValue
+// 不使用预分配的版本号，调用者需要自行保证同一个key不会被并发修改
+func (this *SysLockerDAO) IncreaseAtLeast(tx *dbs.Tx, key string, minValue int64) (int64, error) {
+	err := this.Query(tx).
+		Reuse(false).
+		InsertOrUpdateQuickly(maps.Map{
+			"key":     key,
+			"version": minValue,
+		}, maps.Map{
+			"version": dbs.SQL("GREATEST(version+1, " + types.String(minValue) + ")"),
+		})
+	if err != nil {
+		return 0, err
+	}
+	return this.Read(tx, key)
+}
+
 // 读取当前版本号
 func (this *SysLockerDAO) Read(tx *dbs.Tx, key string) (int64, error) {
 	return this.Query(tx).
@@ -29,6 +29,34 @@ func TestSysLockerDAO_Lock(t *testing.T) {
 	}
 }
 
+func TestSysLockerDAO_TryLease(t *testing.T) {
+	var tx *dbs.Tx
+
+	var dao = NewSysLockerDAO()
+
+	var wg = sync.WaitGroup{}
+	var locker = sync.Mutex{}
+	var countOk = 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			isOk, err := dao.TryLease(tx, "test_lease", 5)
+			if err != nil {
+				t.Log(err)
+				return
+			}
+			if isOk {
+				locker.Lock()
+				countOk++
+				locker.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	t.Log("ok:", countOk)
+}
+
 func TestSysLocker_Increase_SQL(t *testing.T) {
 	var dao = NewSysLockerDAO()
 	value, err := dao.Read(nil, "hello")
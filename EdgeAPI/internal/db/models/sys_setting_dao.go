@@ -246,6 +246,23 @@ func (this *SysSettingDAO) ReadUserRegisterConfig(tx *dbs.Tx) (*userconfigs.User
 	return config, nil
 }
 
+// ReadUserQuotaConfig 读取全局用户对象数量限制
+func (this *SysSettingDAO) ReadUserQuotaConfig(tx *dbs.Tx) (*userconfigs.UserQuotaConfig, error) {
+	valueJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeUserQuotaConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = userconfigs.NewUserQuotaConfig()
+	if len(valueJSON) > 0 {
+		err = json.Unmarshal(valueJSON, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
 func (this *SysSettingDAO) ReadDatabaseConfig(tx *dbs.Tx) (config *systemconfigs.DatabaseConfig, err error) {
 	valueJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeDatabaseConfigSetting)
 	if err != nil {
@@ -262,3 +279,176 @@ func (this *SysSettingDAO) ReadDatabaseConfig(tx *dbs.Tx) (config *systemconfigs
 	}
 	return config, nil
 }
+
+// ReadIPLibraryConfig 读取IP库配置
+func (this *SysSettingDAO) ReadIPLibraryConfig(tx *dbs.Tx) (*systemconfigs.IPLibraryConfig, error) {
+	valueJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeIPLibraryConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(valueJSON) == 0 {
+		return systemconfigs.NewIPLibraryConfig(), nil
+	}
+
+	var config = systemconfigs.NewIPLibraryConfig()
+	err = json.Unmarshal(valueJSON, config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// ReadACMEIssueConfig 读取ACME证书签发设置
+func (this *SysSettingDAO) ReadACMEIssueConfig(tx *dbs.Tx) (*systemconfigs.ACMEIssueConfig, error) {
+	valueJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeACMEIssueConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(valueJSON) == 0 {
+		return systemconfigs.NewACMEIssueConfig(), nil
+	}
+
+	var config = systemconfigs.NewACMEIssueConfig()
+	err = json.Unmarshal(valueJSON, config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// ReadAPIRateLimitConfig 读取API请求频率限制设置
+func (this *SysSettingDAO) ReadAPIRateLimitConfig(tx *dbs.Tx) (*systemconfigs.APIRateLimitConfig, error) {
+	valueJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeAPIRateLimitConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = systemconfigs.NewAPIRateLimitConfig()
+	if len(valueJSON) > 0 {
+		err = json.Unmarshal(valueJSON, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// ReadMTLSConfig 读取双向TLS认证设置
+func (this *SysSettingDAO) ReadMTLSConfig(tx *dbs.Tx) (*systemconfigs.MTLSConfig, error) {
+	valueJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeMTLSConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = systemconfigs.NewMTLSConfig()
+	if len(valueJSON) > 0 {
+		err = json.Unmarshal(valueJSON, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// ReadSSLCertExpireNoticeConfig 读取SSL证书过期提醒设置
+func (this *SysSettingDAO) ReadSSLCertExpireNoticeConfig(tx *dbs.Tx) (*systemconfigs.SSLCertExpireNoticeConfig, error) {
+	valueJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeSSLCertExpireNotice)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = systemconfigs.NewSSLCertExpireNoticeConfig()
+	if len(valueJSON) > 0 {
+		err = json.Unmarshal(valueJSON, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	config.Init()
+	return config, nil
+}
+
+// ReadBackupConfig 读取数据库备份设置
+func (this *SysSettingDAO) ReadBackupConfig(tx *dbs.Tx) (*systemconfigs.BackupConfig, error) {
+	valueJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeBackupConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = systemconfigs.NewBackupConfig()
+	if len(valueJSON) > 0 {
+		err = json.Unmarshal(valueJSON, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// ReadAccessLogArchiveConfig 读取访问日志归档设置
+func (this *SysSettingDAO) ReadAccessLogArchiveConfig(tx *dbs.Tx) (*systemconfigs.AccessLogArchiveConfig, error) {
+	valueJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeAccessLogArchive)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = systemconfigs.NewAccessLogArchiveConfig()
+	if len(valueJSON) > 0 {
+		err = json.Unmarshal(valueJSON, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// ReadTaskScheduleConfig 读取后台任务执行计划设置
+func (this *SysSettingDAO) ReadTaskScheduleConfig(tx *dbs.Tx) (*systemconfigs.TaskScheduleConfig, error) {
+	valueJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeTaskScheduleConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = systemconfigs.NewTaskScheduleConfig()
+	if len(valueJSON) > 0 {
+		err = json.Unmarshal(valueJSON, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// ReadRetentionConfig 读取数据保留设置
+// 没有保存过设置时，访问日志的保留天数沿用数据库设置中的天数
+func (this *SysSettingDAO) ReadRetentionConfig(tx *dbs.Tx) (*systemconfigs.RetentionConfig, error) {
+	valueJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeRetentionConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = systemconfigs.NewRetentionConfig()
+	if len(valueJSON) > 0 {
+		err = json.Unmarshal(valueJSON, config)
+		if err != nil {
+			return nil, err
+		}
+		config.Init()
+		return config, nil
+	}
+
+	databaseConfigJSON, err := this.ReadSetting(tx, systemconfigs.SettingCodeDatabaseConfigSetting)
+	if err != nil {
+		return nil, err
+	}
+	config.AccessLog.Days = 0
+	if len(databaseConfigJSON) > 0 {
+		var databaseConfig = systemconfigs.NewDatabaseConfig()
+		err = json.Unmarshal(databaseConfigJSON, databaseConfig)
+		if err != nil {
+			return nil, err
+		}
+		config.AccessLog.Days = databaseConfig.ServerAccessLog.Clean.Days
+	}
+	return config, nil
+}
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"time"
+
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+const taskRunInterruptedError = "interrupted: the api node was stopped before the task finished"
+
+type TaskRunDAO dbs.DAO
+
+func NewTaskRunDAO() *TaskRunDAO {
+	return dbs.NewDAO(&TaskRunDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeTaskRuns",
+			Model:  new(TaskRun),
+			PkName: "id",
+		},
+	}).(*TaskRunDAO)
+}
+
+var SharedTaskRunDAO *TaskRunDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedTaskRunDAO = NewTaskRunDAO()
+	})
+}
+
+// CreateRun 记录任务开始执行
+// 同一个API节点上同一任务上一次没有结束的记录说明执行过程中被中断，会被标记为失败
+func (this *TaskRunDAO) CreateRun(tx *dbs.Tx, apiNodeId int64, code string, isManual bool, startedAt time.Time) (int64, error) {
+	_, err := this.Query(tx).
+		Attr("apiNodeId", apiNodeId).
+		Attr("code", code).
+		Attr("isManual", isManual).
+		Attr("finishedAt", 0).
+		Set("isOk", false).
+		Set("error", taskRunInterruptedError).
+		Set("finishedAt", startedAt.Unix()).
+		Update()
+	if err != nil {
+		return 0, err
+	}
+
+	var op = NewTaskRunOperator()
+	op.ApiNodeId = apiNodeId
+	op.Code = code
+	op.IsManual = isManual
+	op.IsOk = false
+	op.StartedAt = startedAt.Unix()
+	op.FinishedAt = 0
+	op.Day = timeutil.Format("Ymd", startedAt)
+	err = this.Save(tx, op)
+	if err != nil {
+		return 0, err
+	}
+	return types.Int64(op.Id), nil
+}
+
+// FinishRun 记录任务执行结束
+func (this *TaskRunDAO) FinishRun(tx *dbs.Tx, runId int64, cost time.Duration, runErr error) error {
+	if runId <= 0 {
+		return nil
+	}
+
+	var op = NewTaskRunOperator()
+	op.Id = runId
+	op.IsOk = runErr == nil
+	if runErr != nil {
+		op.Error = utils.LimitString(runErr.Error(), 1024)
+	}
+	op.FinishedAt = time.Now().Unix()
+	op.CostMs = cost.Milliseconds()
+	return this.Save(tx, op)
+}
+
+// FindLatestRun 查找任务最近一次执行记录
+func (this *TaskRunDAO) FindLatestRun(tx *dbs.Tx, code string) (*TaskRun, error) {
+	one, err := this.Query(tx).
+		Attr("code", code).
+		DescPk().
+		Find()
+	if err != nil || one == nil {
+		return nil, err
+	}
+	return one.(*TaskRun), nil
+}
+
+// CountRuns 计算执行记录数量
+func (this *TaskRunDAO) CountRuns(tx *dbs.Tx, code string, errorOnly bool) (int64, error) {
+	return this.runsQuery(tx, code, errorOnly).
+		Count()
+}
+
+// ListRuns 列出单页执行记录
+func (this *TaskRunDAO) ListRuns(tx *dbs.Tx, code string, errorOnly bool, offset int64, size int64) (result []*TaskRun, err error) {
+	_, err = this.runsQuery(tx, code, errorOnly).
+		Offset(offset).
+		Limit(size).
+		DescPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// CleanWithRetention 按保留策略分批清理
+func (this *TaskRunDAO) CleanWithRetention(tx *dbs.Tx, policy *systemconfigs.RetentionPolicy) error {
+	if !policy.IsOn() {
+		return nil
+	}
+	var day = timeutil.Format("Ymd", policy.ExpiresTime(time.Now()))
+	_, err := dbutils.DeleteInBatches(this, policy.BatchSize, func() *dbs.Query {
+		return this.Query(tx).
+			Where("(day IS NULL OR day<:day)").
+			Param("day", day)
+	})
+	return err
+}
+
+func (this *TaskRunDAO) runsQuery(tx *dbs.Tx, code string, errorOnly bool) *dbs.Query {
+	var query = this.Query(tx)
+	if len(code) > 0 {
+		query.Attr("code", code)
+	}
+	if errorOnly {
+		query.Attr("isOk", false).
+			Gt("finishedAt", 0)
+	}
+	return query
+}
@@ -0,0 +1,36 @@
+package models_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/iwind/TeaGo/bootstrap"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func TestTaskRunDAO_CreateRun(t *testing.T) {
+	var dao = models.NewTaskRunDAO()
+	var tx *dbs.Tx
+
+	runId, err := dao.CreateRun(tx, 1, "test", false, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("runId:", runId)
+
+	err = dao.FinishRun(tx, runId, 100*time.Millisecond, errors.New("test error"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	run, err := dao.FindLatestRun(tx, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run != nil {
+		t.Log(run.Id, run.IsOk, run.Error, run.CostMs)
+	}
+}
@@ -0,0 +1,32 @@
+package models
+
+// TaskRun 后台任务执行记录
+type TaskRun struct {
+	Id         uint64 `field:"id"`         // ID
+	ApiNodeId  uint32 `field:"apiNodeId"`  // API节点ID
+	Code       string `field:"code"`       // 任务代号
+	IsManual   bool   `field:"isManual"`   // 是否为手动执行
+	IsOk       bool   `field:"isOk"`       // 是否成功
+	Error      string `field:"error"`      // 错误信息
+	StartedAt  uint64 `field:"startedAt"`  // 开始时间
+	FinishedAt uint64 `field:"finishedAt"` // 结束时间
+	CostMs     uint32 `field:"costMs"`     // 耗时（毫秒）
+	Day        string `field:"day"`        // YYYYMMDD
+}
+
+type TaskRunOperator struct {
+	Id         interface{} // ID
+	ApiNodeId  interface{} // API节点ID
+	Code       interface{} // 任务代号
+	IsManual   interface{} // 是否为手动执行
+	IsOk       interface{} // 是否成功
+	Error      interface{} // 错误信息
+	StartedAt  interface{} // 开始时间
+	FinishedAt interface{} // 结束时间
+	CostMs     interface{} // 耗时（毫秒）
+	Day        interface{} // YYYYMMDD
+}
+
+func NewTaskRunOperator() *TaskRunOperator {
+	return &TaskRunOperator{}
+}
@@ -0,0 +1,6 @@
+package models
+
+// IsRunning 是否正在执行
+func (this *TaskRun) IsRunning() bool {
+	return this.FinishedAt == 0
+}
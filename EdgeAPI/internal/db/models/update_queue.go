@@ -0,0 +1,18 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models
+
+import (
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		// 节点状态等非关键数据的异步更新队列
+		goman.New(func() {
+			dbutils.SharedUpdateQueue.Start()
+		})
+	})
+}
@@ -1,8 +1,10 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/iwind/TeaGo/Tea"
@@ -67,7 +69,8 @@ func (this *UserAccessKeyDAO) FindEnabledUserAccessKey(tx *dbs.Tx, id int64) (*U
 }
 
 // CreateAccessKey 创建Key
-func (this *UserAccessKeyDAO) CreateAccessKey(tx *dbs.Tx, adminId int64, userId int64, description string) (int64, error) {
+// scopes 为空表示不限制权限范围
+func (this *UserAccessKeyDAO) CreateAccessKey(tx *dbs.Tx, adminId int64, userId int64, description string, scopes []string) (int64, error) {
 	if adminId <= 0 && userId <= 0 {
 		return 0, errors.New("invalid adminId or userId")
 	}
@@ -75,6 +78,13 @@ func (this *UserAccessKeyDAO) CreateAccessKey(tx *dbs.Tx, adminId int64, userId
 	op.AdminId = adminId
 	op.UserId = userId
 	op.Description = description
+	if len(scopes) > 0 {
+		scopesJSON, err := json.Marshal(scopes)
+		if err != nil {
+			return 0, err
+		}
+		op.Scopes = scopesJSON
+	}
 	op.UniqueId = rands.String(16)
 	op.Secret = rands.String(32)
 	op.IsOn = true
@@ -132,12 +142,41 @@ func (this *UserAccessKeyDAO) FindAccessKeyWithUniqueId(tx *dbs.Tx, uniqueId str
 
 // UpdateAccessKeyAccessedAt 更新AccessKey访问时间
 func (this *UserAccessKeyDAO) UpdateAccessKeyAccessedAt(tx *dbs.Tx, accessKeyId int64) error {
+	var accessedAt = time.Now().Unix()
+
+	// 访问时间不需要实时写入
+	if tx == nil && dbutils.SharedUpdateQueue.Set(this.Instance, this.Table, this.PkName, accessKeyId, map[string]any{"accessedAt": accessedAt}) {
+		return nil
+	}
+
 	return this.Query(tx).
 		Pk(accessKeyId).
-		Set("accessedAt", time.Now().Unix()).
+		Set("accessedAt", accessedAt).
 		UpdateQuickly()
 }
 
+// UpdateAccessKeyScopes 修改权限范围
+// scopes 为空表示不限制权限范围
+func (this *UserAccessKeyDAO) UpdateAccessKeyScopes(tx *dbs.Tx, accessKeyId int64, scopes []string) error {
+	if accessKeyId <= 0 {
+		return errors.New("invalid accessKeyId")
+	}
+
+	var query = this.Query(tx).
+		Pk(accessKeyId)
+	if len(scopes) > 0 {
+		scopesJSON, err := json.Marshal(scopes)
+		if err != nil {
+			return err
+		}
+		query.Set("scopes", scopesJSON)
+	} else {
+		query.Set("scopes", dbs.SQL("NULL"))
+	}
+	_, err := query.Update()
+	return err
+}
+
 // CountAllEnabledAccessKeys 计算可用AccessKey数量
 func (this *UserAccessKeyDAO) CountAllEnabledAccessKeys(tx *dbs.Tx, adminId int64, userId int64) (int64, error) {
 	return this.Query(tx).
@@ -1 +1,6 @@
 package models
+
+// DecodeScopes 解析权限范围，为空表示不限制
+func (this *UserAccessKey) DecodeScopes() []string {
+	return decodeScopes(this.Scopes)
+}
@@ -0,0 +1,185 @@
+package models
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+const (
+	UserAlertRuleStateEnabled  = 1 // 已启用
+	UserAlertRuleStateDisabled = 0 // 已禁用
+)
+
+// UserAlertRuleMaxPerUser 每个用户最多可以创建的告警规则数量
+const UserAlertRuleMaxPerUser = 50
+
+type UserAlertRuleDAO dbs.DAO
+
+func NewUserAlertRuleDAO() *UserAlertRuleDAO {
+	return dbs.NewDAO(&UserAlertRuleDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeUserAlertRules",
+			Model:  new(UserAlertRule),
+			PkName: "id",
+		},
+	}).(*UserAlertRuleDAO)
+}
+
+var SharedUserAlertRuleDAO *UserAlertRuleDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedUserAlertRuleDAO = NewUserAlertRuleDAO()
+	})
+}
+
+// DisableUserAlertRule 禁用条目
+func (this *UserAlertRuleDAO) DisableUserAlertRule(tx *dbs.Tx, ruleId int64) error {
+	_, err := this.Query(tx).
+		Pk(ruleId).
+		Set("state", UserAlertRuleStateDisabled).
+		Update()
+	return err
+}
+
+// FindEnabledUserAlertRule 查找启用中的条目
+func (this *UserAlertRuleDAO) FindEnabledUserAlertRule(tx *dbs.Tx, ruleId int64) (*UserAlertRule, error) {
+	result, err := this.Query(tx).
+		Pk(ruleId).
+		State(UserAlertRuleStateEnabled).
+		Find()
+	if result == nil {
+		return nil, err
+	}
+	return result.(*UserAlertRule), err
+}
+
+// CreateRule 创建规则
+func (this *UserAlertRuleDAO) CreateRule(tx *dbs.Tx, userId int64, serverId int64, item string, value float64, notifyMinutes int32, receiversJSON []byte, isOn bool) (int64, error) {
+	if userId <= 0 {
+		return 0, errors.New("invalid userId")
+	}
+
+	count, err := this.Query(tx).
+		Attr("userId", userId).
+		State(UserAlertRuleStateEnabled).
+		Count()
+	if err != nil {
+		return 0, err
+	}
+	if count >= UserAlertRuleMaxPerUser {
+		return 0, errors.New("too many alert rules")
+	}
+
+	var op = NewUserAlertRuleOperator()
+	op.UserId = userId
+	op.ServerId = serverId
+	op.Item = item
+	op.Value = value
+	op.NotifyMinutes = notifyMinutes
+	op.Receivers = receiversJSON
+	op.IsOn = isOn
+	op.CreatedAt = time.Now().Unix()
+	op.State = UserAlertRuleStateEnabled
+	return this.SaveInt64(tx, op)
+}
+
+// UpdateRule 修改规则
+func (this *UserAlertRuleDAO) UpdateRule(tx *dbs.Tx, ruleId int64, serverId int64, item string, value float64, notifyMinutes int32, receiversJSON []byte, isOn bool) error {
+	if ruleId <= 0 {
+		return errors.New("invalid ruleId")
+	}
+
+	var op = NewUserAlertRuleOperator()
+	op.Id = ruleId
+	op.ServerId = serverId
+	op.Item = item
+	op.Value = value
+	op.NotifyMinutes = notifyMinutes
+	op.Receivers = receiversJSON
+	op.IsOn = isOn
+	op.NotifiedAt = 0 // 修改后重新开始计算
+	return this.Save(tx, op)
+}
+
+// CheckUserRule 检查用户是否拥有某个规则
+func (this *UserAlertRuleDAO) CheckUserRule(tx *dbs.Tx, userId int64, ruleId int64) error {
+	if userId <= 0 || ruleId <= 0 {
+		return ErrNotFound
+	}
+	exists, err := this.Query(tx).
+		Pk(ruleId).
+		Attr("userId", userId).
+		State(UserAlertRuleStateEnabled).
+		Exist()
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// FindAllEnabledUserRules 查找用户的所有规则
+// serverId 为0表示不限网站
+func (this *UserAlertRuleDAO) FindAllEnabledUserRules(tx *dbs.Tx, userId int64, serverId int64) (result []*UserAlertRule, err error) {
+	if userId <= 0 {
+		return
+	}
+	var query = this.Query(tx).
+		Attr("userId", userId)
+	if serverId > 0 {
+		query.Attr("serverId", serverId)
+	}
+	_, err = query.
+		State(UserAlertRuleStateEnabled).
+		AscPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// FindAllEnabledAndOnUserIds 查找有启用中的规则的用户ID
+func (this *UserAlertRuleDAO) FindAllEnabledAndOnUserIds(tx *dbs.Tx) (userIds []int64, err error) {
+	ones, _, err := this.Query(tx).
+		Result("DISTINCT userId").
+		Attr("isOn", true).
+		State(UserAlertRuleStateEnabled).
+		FindOnes()
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range ones {
+		var userId = one.GetInt64("userId")
+		if userId > 0 {
+			userIds = append(userIds, userId)
+		}
+	}
+	return
+}
+
+// FindAllEnabledAndOnUserRules 查找用户所有启用中的规则
+func (this *UserAlertRuleDAO) FindAllEnabledAndOnUserRules(tx *dbs.Tx, userId int64) (result []*UserAlertRule, err error) {
+	_, err = this.Query(tx).
+		Attr("userId", userId).
+		Attr("isOn", true).
+		State(UserAlertRuleStateEnabled).
+		AscPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// UpdateRuleNotifiedAt 设置上次通知时间
+func (this *UserAlertRuleDAO) UpdateRuleNotifiedAt(tx *dbs.Tx, ruleId int64, notifiedAt int64) error {
+	return this.Query(tx).
+		Pk(ruleId).
+		Set("notifiedAt", notifiedAt).
+		UpdateQuickly()
+}
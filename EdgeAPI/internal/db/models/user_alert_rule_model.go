@@ -0,0 +1,36 @@
+package models
+
+import "github.com/iwind/TeaGo/dbs"
+
+// UserAlertRule 用户告警规则
+type UserAlertRule struct {
+	Id            uint64   `field:"id"`            // ID
+	UserId        uint32   `field:"userId"`        // 用户ID
+	ServerId      uint32   `field:"serverId"`      // 网站ID
+	IsOn          bool     `field:"isOn"`          // 是否启用
+	Item          string   `field:"item"`          // 监控项
+	Value         float64  `field:"value"`         // 阈值
+	NotifyMinutes uint32   `field:"notifyMinutes"` // 重复通知间隔（单位分钟）
+	Receivers     dbs.JSON `field:"receivers"`     // 接收设置
+	NotifiedAt    uint64   `field:"notifiedAt"`    // 上次通知时间
+	CreatedAt     uint64   `field:"createdAt"`     // 创建时间
+	State         uint8    `field:"state"`         // 状态
+}
+
+type UserAlertRuleOperator struct {
+	Id            any // ID
+	UserId        any // 用户ID
+	ServerId      any // 网站ID
+	IsOn          any // 是否启用
+	Item          any // 监控项
+	Value         any // 阈值
+	NotifyMinutes any // 重复通知间隔（单位分钟）
+	Receivers     any // 接收设置
+	NotifiedAt    any // 上次通知时间
+	CreatedAt     any // 创建时间
+	State         any // 状态
+}
+
+func NewUserAlertRuleOperator() *UserAlertRuleOperator {
+	return &UserAlertRuleOperator{}
+}
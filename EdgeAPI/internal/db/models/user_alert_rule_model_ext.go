@@ -0,0 +1,16 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+)
+
+// DecodeReceivers 解析接收设置
+func (this *UserAlertRule) DecodeReceivers() *userconfigs.UserAlertReceiversConfig {
+	var config = userconfigs.NewUserAlertReceiversConfig()
+	if IsNotNull(this.Receivers) {
+		_ = json.Unmarshal(this.Receivers, config)
+	}
+	return config
+}
@@ -767,6 +767,23 @@ func (this *UserDAO) FindUserBandwidthAlgoForView(tx *dbs.Tx, userId int64, uiCo
 	return systemconfigs.BandwidthAlgoSecondly, nil
 }
 
+// FindAllEnabledUsersWithKeyword 根据用户名、全名或邮箱查找用户
+func (this *UserDAO) FindAllEnabledUsersWithKeyword(tx *dbs.Tx, keyword string, size int64) (result []*User, err error) {
+	if len(keyword) == 0 {
+		return
+	}
+	_, err = this.Query(tx).
+		State(UserStateEnabled).
+		Result("id", "username", "fullname", "email").
+		Where("(username LIKE :keyword OR fullname LIKE :keyword OR email LIKE :keyword)").
+		Param("keyword", dbutils.QuoteLike(keyword)).
+		DescPk().
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
 // NotifyUpdate 用户变更通知
 func (this *UserDAO) NotifyUpdate(tx *dbs.Tx, userId int64) error {
 	if userId <= 0 {
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
@@ -270,6 +271,10 @@ func (this *UserNodeDAO) UpdateNodeStatus(tx *dbs.Tx, nodeId int64, nodeStatus *
 		return err
 	}
 
+	if tx == nil && dbutils.SharedUpdateQueue.Set(this.Instance, this.Table, this.PkName, nodeId, map[string]any{"status": nodeStatusJSON}) {
+		return nil
+	}
+
 	_, err = this.Query(tx).
 		Pk(nodeId).
 		Set("status", nodeStatusJSON).
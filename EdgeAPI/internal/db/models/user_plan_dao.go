@@ -9,6 +9,7 @@ import (
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
 	"github.com/iwind/TeaGo/types"
+	timeutil "github.com/iwind/TeaGo/utils/time"
 )
 
 const (
@@ -170,6 +171,28 @@ func (this *UserPlanDAO) FindAllEnabledPlansForServer(tx *dbs.Tx, userId int64,
 	return
 }
 
+// FindAvailablePlanIdsWithUserId 查找用户所有未过期的套餐对应的套餐ID
+func (this *UserPlanDAO) FindAvailablePlanIdsWithUserId(tx *dbs.Tx, userId int64) ([]int64, error) {
+	if userId <= 0 {
+		return nil, nil
+	}
+	ones, err := this.Query(tx).
+		Result("DISTINCT planId").
+		Attr("userId", userId).
+		Attr("isOn", true).
+		State(UserPlanStateEnabled).
+		Gte("dayTo", timeutil.Format("Y-m-d")).
+		FindAll()
+	if err != nil {
+		return nil, err
+	}
+	var planIds = []int64{}
+	for _, one := range ones {
+		planIds = append(planIds, int64(one.(*UserPlan).PlanId))
+	}
+	return planIds, nil
+}
+
 // CheckUserPlan 检查用户套餐
 func (this *UserPlanDAO) CheckUserPlan(tx *dbs.Tx, userId int64, userPlanId int64) error {
 	exists, err := this.Query(tx).
@@ -0,0 +1,28 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models
+
+import (
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// UserQuotaChecker 检查用户是否还可以创建一定数量的某类对象
+type UserQuotaChecker func(tx *dbs.Tx, userId int64, item userconfigs.UserQuotaItem, increment int64) error
+
+var userQuotaChecker UserQuotaChecker
+
+// SetUserQuotaChecker 设置用户配额检查函数
+// 计算配额需要用到子包中的DAO，为了避免循环引用，由 quotas 包在初始化时设置
+func SetUserQuotaChecker(checker UserQuotaChecker) {
+	userQuotaChecker = checker
+}
+
+// CheckUserQuota 在创建用户对象之前检查配额，所有的Create*方法都应当调用
+// userId 为0时表示管理员创建的对象，不做限制
+func CheckUserQuota(tx *dbs.Tx, userId int64, item userconfigs.UserQuotaItem, increment int64) error {
+	if userQuotaChecker == nil || userId <= 0 || increment <= 0 {
+		return nil
+	}
+	return userQuotaChecker(tx, userId, item, increment)
+}
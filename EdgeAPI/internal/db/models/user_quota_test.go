@@ -0,0 +1,35 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func TestCheckUserQuota(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	// 没有设置检查函数
+	a.IsNil(models.CheckUserQuota(nil, 1, userconfigs.UserQuotaItemServers, 1))
+
+	var countCalls = 0
+	models.SetUserQuotaChecker(func(tx *dbs.Tx, userId int64, item userconfigs.UserQuotaItem, increment int64) error {
+		countCalls++
+		return errors.New("quota exceeded")
+	})
+	defer models.SetUserQuotaChecker(nil)
+
+	// 管理员创建的对象
+	a.IsNil(models.CheckUserQuota(nil, 0, userconfigs.UserQuotaItemServers, 1))
+	a.IsNil(models.CheckUserQuota(nil, 1, userconfigs.UserQuotaItemServers, 0))
+	a.IsTrue(countCalls == 0)
+
+	a.IsNotNil(models.CheckUserQuota(nil, 1, userconfigs.UserQuotaItemServers, 1))
+	a.IsTrue(countCalls == 1)
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package models
+
+import (
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// 增加对象的版本号，用于乐观锁
+// expectedVersion>0 时只有当前版本号和它一致时才会增加，否则返回 false 表示数据已经被其他人修改
+func increaseVersion(query *dbs.Query, id int64, expectedVersion int64) (ok bool, err error) {
+	if id <= 0 {
+		return true, nil
+	}
+
+	query.Pk(id).
+		Increase("version", 1)
+	if expectedVersion > 0 {
+		query.Attr("version", expectedVersion)
+	}
+	rowsAffected, err := query.Update()
+	if err != nil {
+		return false, err
+	}
+	return expectedVersion <= 0 || rowsAffected > 0, nil
+}
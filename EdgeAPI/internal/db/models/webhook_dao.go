@@ -0,0 +1,195 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/webhooks"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
+)
+
+const (
+	WebhookStateEnabled  = 1 // 已启用
+	WebhookStateDisabled = 0 // 已禁用
+)
+
+type WebhookDAO dbs.DAO
+
+func NewWebhookDAO() *WebhookDAO {
+	return dbs.NewDAO(&WebhookDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeWebhooks",
+			Model:  new(Webhook),
+			PkName: "id",
+		},
+	}).(*WebhookDAO)
+}
+
+var SharedWebhookDAO *WebhookDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedWebhookDAO = NewWebhookDAO()
+	})
+}
+
+// EnableWebhook 启用条目
+func (this *WebhookDAO) EnableWebhook(tx *dbs.Tx, id int64) error {
+	_, err := this.Query(tx).
+		Pk(id).
+		Set("state", WebhookStateEnabled).
+		Update()
+	return err
+}
+
+// DisableWebhook 禁用条目
+func (this *WebhookDAO) DisableWebhook(tx *dbs.Tx, id int64) error {
+	_, err := this.Query(tx).
+		Pk(id).
+		Set("state", WebhookStateDisabled).
+		Update()
+	return err
+}
+
+// FindEnabledWebhook 查找启用中的条目
+func (this *WebhookDAO) FindEnabledWebhook(tx *dbs.Tx, id int64) (*Webhook, error) {
+	result, err := this.Query(tx).
+		Pk(id).
+		State(WebhookStateEnabled).
+		Find()
+	if result == nil {
+		return nil, err
+	}
+	return result.(*Webhook), err
+}
+
+// CreateWebhook 创建Webhook
+func (this *WebhookDAO) CreateWebhook(tx *dbs.Tx, adminId int64, name string, url string, secret string, events []string, isOn bool) (int64, error) {
+	eventsJSON, err := this.encodeEvents(url, events)
+	if err != nil {
+		return 0, err
+	}
+
+	var op = NewWebhookOperator()
+	op.AdminId = adminId
+	op.Name = name
+	op.Url = url
+	op.Secret = secret
+	op.Events = eventsJSON
+	op.IsOn = isOn
+	op.CreatedAt = time.Now().Unix()
+	op.State = WebhookStateEnabled
+	return this.SaveInt64(tx, op)
+}
+
+// UpdateWebhook 修改Webhook
+// secret 为空表示保持不变
+func (this *WebhookDAO) UpdateWebhook(tx *dbs.Tx, webhookId int64, name string, url string, secret string, events []string, isOn bool) error {
+	if webhookId <= 0 {
+		return errors.New("invalid webhookId")
+	}
+
+	eventsJSON, err := this.encodeEvents(url, events)
+	if err != nil {
+		return err
+	}
+
+	var op = NewWebhookOperator()
+	op.Id = webhookId
+	op.Name = name
+	op.Url = url
+	if len(secret) > 0 {
+		op.Secret = secret
+	}
+	op.Events = eventsJSON
+	op.IsOn = isOn
+	return this.Save(tx, op)
+}
+
+// CountAllEnabledWebhooks 计算Webhook数量
+func (this *WebhookDAO) CountAllEnabledWebhooks(tx *dbs.Tx) (int64, error) {
+	return this.Query(tx).
+		State(WebhookStateEnabled).
+		Count()
+}
+
+// ListEnabledWebhooks 列出单页Webhook
+func (this *WebhookDAO) ListEnabledWebhooks(tx *dbs.Tx, offset int64, size int64) (result []*Webhook, err error) {
+	_, err = this.Query(tx).
+		State(WebhookStateEnabled).
+		Offset(offset).
+		Limit(size).
+		DescPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// FindAllAvailableWebhooks 查找所有启用的Webhook
+func (this *WebhookDAO) FindAllAvailableWebhooks(tx *dbs.Tx) (result []*Webhook, err error) {
+	_, err = this.Query(tx).
+		State(WebhookStateEnabled).
+		Attr("isOn", true).
+		AscPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// Trigger 触发事件
+// 为每个订阅了此事件的Webhook生成一条投递记录，由后台任务负责投递和重试
+func (this *WebhookDAO) Trigger(tx *dbs.Tx, event webhooks.Event, data maps.Map) error {
+	hooks, err := this.FindAllAvailableWebhooks(tx)
+	if err != nil {
+		return err
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	if data == nil {
+		data = maps.Map{}
+	}
+	payloadJSON, err := json.Marshal(maps.Map{
+		"event":     event,
+		"createdAt": time.Now().Unix(),
+		"data":      data,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		if !hook.MatchEvent(event) {
+			continue
+		}
+		_, err = SharedWebhookDeliveryDAO.CreateDelivery(tx, int64(hook.Id), event, payloadJSON)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 检查并编码事件列表
+func (this *WebhookDAO) encodeEvents(url string, events []string) ([]byte, error) {
+	err := webhooks.ValidateURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		return nil, errors.New("'events' should not be empty")
+	}
+	for _, event := range events {
+		if !webhooks.IsValidEvent(event) {
+			return nil, errors.New("invalid event '" + event + "'")
+		}
+	}
+	return json.Marshal(events)
+}
@@ -0,0 +1,176 @@
+package models
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/webhooks"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+type WebhookDeliveryDAO dbs.DAO
+
+func NewWebhookDeliveryDAO() *WebhookDeliveryDAO {
+	return dbs.NewDAO(&WebhookDeliveryDAO{
+		DAOObject: dbs.DAOObject{
+			DB:     Tea.Env,
+			Table:  "edgeWebhookDeliveries",
+			Model:  new(WebhookDelivery),
+			PkName: "id",
+		},
+	}).(*WebhookDeliveryDAO)
+}
+
+var SharedWebhookDeliveryDAO *WebhookDeliveryDAO
+
+func init() {
+	dbs.OnReady(func() {
+		SharedWebhookDeliveryDAO = NewWebhookDeliveryDAO()
+	})
+}
+
+// CreateDelivery 创建投递记录
+func (this *WebhookDeliveryDAO) CreateDelivery(tx *dbs.Tx, webhookId int64, event string, payloadJSON []byte) (int64, error) {
+	var now = time.Now().Unix()
+	var op = NewWebhookDeliveryOperator()
+	op.WebhookId = webhookId
+	op.Event = event
+	op.Payload = payloadJSON
+	op.IsFinished = false
+	op.IsOk = false
+	op.NextAt = now
+	op.CreatedAt = now
+	return this.SaveInt64(tx, op)
+}
+
+// FindDelivery 查找投递记录
+func (this *WebhookDeliveryDAO) FindDelivery(tx *dbs.Tx, deliveryId int64) (*WebhookDelivery, error) {
+	one, err := this.Query(tx).
+		Pk(deliveryId).
+		Find()
+	if err != nil || one == nil {
+		return nil, err
+	}
+	return one.(*WebhookDelivery), nil
+}
+
+// FindDueDeliveries 查找需要投递的记录
+func (this *WebhookDeliveryDAO) FindDueDeliveries(tx *dbs.Tx, size int64) (result []*WebhookDelivery, err error) {
+	_, err = this.Query(tx).
+		Attr("isFinished", false).
+		Lte("nextAt", time.Now().Unix()).
+		Asc("nextAt").
+		AscPk().
+		Limit(size).
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// UpdateDeliveryResult 记录投递结果
+// 投递失败时按照重试间隔设置下次投递时间，超过最多投递次数后不再重试
+func (this *WebhookDeliveryDAO) UpdateDeliveryResult(tx *dbs.Tx, deliveryId int64, attempts int, statusCode int, deliverErr error) error {
+	var now = time.Now()
+	var op = NewWebhookDeliveryOperator()
+	op.Id = deliveryId
+	op.Attempts = attempts
+	op.StatusCode = statusCode
+	op.UpdatedAt = now.Unix()
+	if deliverErr == nil {
+		op.IsOk = true
+		op.IsFinished = true
+		op.Error = ""
+	} else {
+		op.IsOk = false
+		op.Error = utils.LimitString(deliverErr.Error(), 1024)
+		if attempts >= webhooks.MaxAttempts {
+			op.IsFinished = true
+		} else {
+			op.NextAt = now.Add(webhooks.NextRetryDelay(attempts)).Unix()
+		}
+	}
+	return this.Save(tx, op)
+}
+
+// Deliver 投递事件并记录结果
+// 投递过程需要请求外部接口，不能在事务中调用
+func (this *WebhookDeliveryDAO) Deliver(delivery *WebhookDelivery) (statusCode int, deliverErr error, err error) {
+	var tx *dbs.Tx
+	var deliveryId = int64(delivery.Id)
+
+	webhook, err := SharedWebhookDAO.FindEnabledWebhook(tx, int64(delivery.WebhookId))
+	if err != nil {
+		return 0, nil, err
+	}
+	if webhook == nil || !webhook.IsOn {
+		deliverErr = errors.New("the webhook has been disabled or deleted")
+		return 0, deliverErr, this.FinishDelivery(tx, deliveryId, deliverErr.Error())
+	}
+
+	statusCode, deliverErr = webhooks.Send(webhook.Url, webhook.Secret, delivery.Event, deliveryId, delivery.Payload)
+	err = this.UpdateDeliveryResult(tx, deliveryId, int(delivery.Attempts)+1, statusCode, deliverErr)
+	return
+}
+
+// FinishDelivery 结束投递，不再重试
+func (this *WebhookDeliveryDAO) FinishDelivery(tx *dbs.Tx, deliveryId int64, errString string) error {
+	return this.Query(tx).
+		Pk(deliveryId).
+		Set("isFinished", true).
+		Set("error", utils.LimitString(errString, 1024)).
+		Set("updatedAt", time.Now().Unix()).
+		UpdateQuickly()
+}
+
+// RedeliverDelivery 重新投递
+func (this *WebhookDeliveryDAO) RedeliverDelivery(tx *dbs.Tx, deliveryId int64) error {
+	return this.Query(tx).
+		Pk(deliveryId).
+		Set("isFinished", false).
+		Set("isOk", false).
+		Set("attempts", 0).
+		Set("nextAt", time.Now().Unix()).
+		UpdateQuickly()
+}
+
+// CountDeliveries 计算投递记录数量
+func (this *WebhookDeliveryDAO) CountDeliveries(tx *dbs.Tx, webhookId int64, event string) (int64, error) {
+	var query = this.Query(tx)
+	if webhookId > 0 {
+		query.Attr("webhookId", webhookId)
+	}
+	if len(event) > 0 {
+		query.Attr("event", event)
+	}
+	return query.Count()
+}
+
+// ListDeliveries 列出单页投递记录
+func (this *WebhookDeliveryDAO) ListDeliveries(tx *dbs.Tx, webhookId int64, event string, offset int64, size int64) (result []*WebhookDelivery, err error) {
+	var query = this.Query(tx)
+	if webhookId > 0 {
+		query.Attr("webhookId", webhookId)
+	}
+	if len(event) > 0 {
+		query.Attr("event", event)
+	}
+	_, err = query.
+		Offset(offset).
+		Limit(size).
+		DescPk().
+		Slice(&result).
+		FindAll()
+	return
+}
+
+// CleanDays 清除N天以前的投递记录
+func (this *WebhookDeliveryDAO) CleanDays(tx *dbs.Tx, days int) error {
+	_, err := this.Query(tx).
+		Lt("createdAt", time.Now().Unix()-int64(days)*86400).
+		Attr("isFinished", true).
+		Delete()
+	return err
+}
@@ -0,0 +1,38 @@
+package models
+
+import "github.com/iwind/TeaGo/dbs"
+
+// WebhookDelivery Webhook事件投递记录
+type WebhookDelivery struct {
+	Id         uint64   `field:"id"`         // ID
+	WebhookId  uint64   `field:"webhookId"`  // Webhook ID
+	Event      string   `field:"event"`      // 事件代号
+	Payload    dbs.JSON `field:"payload"`    // 投递内容
+	IsFinished bool     `field:"isFinished"` // 是否已结束
+	IsOk       bool     `field:"isOk"`       // 是否投递成功
+	StatusCode uint32   `field:"statusCode"` // 最后一次投递的响应状态码
+	Error      string   `field:"error"`      // 最后一次投递的错误信息
+	Attempts   uint32   `field:"attempts"`   // 已投递次数
+	NextAt     uint64   `field:"nextAt"`     // 下次投递时间
+	CreatedAt  uint64   `field:"createdAt"`  // 创建时间
+	UpdatedAt  uint64   `field:"updatedAt"`  // 最后投递时间
+}
+
+type WebhookDeliveryOperator struct {
+	Id         any // ID
+	WebhookId  any // Webhook ID
+	Event      any // 事件代号
+	Payload    any // 投递内容
+	IsFinished any // 是否已结束
+	IsOk       any // 是否投递成功
+	StatusCode any // 最后一次投递的响应状态码
+	Error      any // 最后一次投递的错误信息
+	Attempts   any // 已投递次数
+	NextAt     any // 下次投递时间
+	CreatedAt  any // 创建时间
+	UpdatedAt  any // 最后投递时间
+}
+
+func NewWebhookDeliveryOperator() *WebhookDeliveryOperator {
+	return &WebhookDeliveryOperator{}
+}
@@ -0,0 +1,32 @@
+package models
+
+import "github.com/iwind/TeaGo/dbs"
+
+// Webhook 事件Webhook
+type Webhook struct {
+	Id        uint64   `field:"id"`        // ID
+	AdminId   uint32   `field:"adminId"`   // 管理员ID
+	IsOn      bool     `field:"isOn"`      // 是否启用
+	Name      string   `field:"name"`      // 名称
+	Url       string   `field:"url"`       // 接收事件的URL
+	Secret    string   `field:"secret"`    // 签名密钥
+	Events    dbs.JSON `field:"events"`    // 订阅的事件
+	CreatedAt uint64   `field:"createdAt"` // 创建时间
+	State     uint8    `field:"state"`     // 状态
+}
+
+type WebhookOperator struct {
+	Id        any // ID
+	AdminId   any // 管理员ID
+	IsOn      any // 是否启用
+	Name      any // 名称
+	Url       any // 接收事件的URL
+	Secret    any // 签名密钥
+	Events    any // 订阅的事件
+	CreatedAt any // 创建时间
+	State     any // 状态
+}
+
+func NewWebhookOperator() *WebhookOperator {
+	return &WebhookOperator{}
+}
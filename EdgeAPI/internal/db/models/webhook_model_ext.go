@@ -0,0 +1,23 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/webhooks"
+	"github.com/iwind/TeaGo/lists"
+)
+
+// DecodeEvents 解析订阅的事件
+func (this *Webhook) DecodeEvents() []string {
+	var events = []string{}
+	if IsNotNull(this.Events) {
+		_ = json.Unmarshal(this.Events, &events)
+	}
+	return events
+}
+
+// MatchEvent 检查是否订阅了某个事件
+func (this *Webhook) MatchEvent(event string) bool {
+	var events = this.DecodeEvents()
+	return lists.ContainsString(events, webhooks.EventAll) || lists.ContainsString(events, event)
+}
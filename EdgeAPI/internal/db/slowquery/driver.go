@@ -0,0 +1,199 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package slowquery
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQLDriverName 可以记录慢查询的MySQL驱动名称
+// 只在打开数据库时使用，参考 Setup()
+const MySQLDriverName = "mysql-slowquery"
+
+func init() {
+	sql.Register(MySQLDriverName, NewDriver(&mysql.MySQLDriver{}))
+}
+
+// Driver 记录慢查询的驱动
+// 对原有驱动的连接和语句进行包装，在执行语句时计算耗时
+type Driver struct {
+	rawDriver driver.Driver
+}
+
+func NewDriver(rawDriver driver.Driver) *Driver {
+	return &Driver{rawDriver: rawDriver}
+}
+
+func (this *Driver) Open(dsn string) (driver.Conn, error) {
+	conn, err := this.rawDriver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{Conn: conn}, nil
+}
+
+// Conn 数据库连接
+type Conn struct {
+	driver.Conn
+}
+
+func (this *Conn) Prepare(query string) (driver.Stmt, error) {
+	stmt, err := this.Conn.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	return &Stmt{Stmt: stmt, query: query}, nil
+}
+
+func (this *Conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var stmt driver.Stmt
+	var err error
+	preparer, ok := this.Conn.(driver.ConnPrepareContext)
+	if ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = this.Conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Stmt{Stmt: stmt, query: query}, nil
+}
+
+func (this *Conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	beginner, ok := this.Conn.(driver.ConnBeginTx)
+	if ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return this.Conn.Begin()
+}
+
+func (this *Conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := this.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	var before = time.Now()
+	result, err := execer.ExecContext(ctx, query, args)
+	if err == nil {
+		Check(query, time.Since(before))
+	}
+	return result, err
+}
+
+func (this *Conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := this.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	var before = time.Now()
+	rows, err := queryer.QueryContext(ctx, query, args)
+	if err == nil {
+		Check(query, time.Since(before))
+	}
+	return rows, err
+}
+
+func (this *Conn) Ping(ctx context.Context) error {
+	pinger, ok := this.Conn.(driver.Pinger)
+	if ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (this *Conn) ResetSession(ctx context.Context) error {
+	resetter, ok := this.Conn.(driver.SessionResetter)
+	if ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (this *Conn) IsValid() bool {
+	validator, ok := this.Conn.(driver.Validator)
+	if ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+func (this *Conn) CheckNamedValue(value *driver.NamedValue) error {
+	checker, ok := this.Conn.(driver.NamedValueChecker)
+	if ok {
+		return checker.CheckNamedValue(value)
+	}
+	return driver.ErrSkip
+}
+
+// Stmt 预处理语句
+type Stmt struct {
+	driver.Stmt
+
+	query string
+}
+
+func (this *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	var before = time.Now()
+	var result driver.Result
+	var err error
+	execer, ok := this.Stmt.(driver.StmtExecContext)
+	if ok {
+		result, err = execer.ExecContext(ctx, args)
+	} else {
+		values, convertErr := namedValuesToValues(args)
+		if convertErr != nil {
+			return nil, convertErr
+		}
+		result, err = this.Stmt.Exec(values)
+	}
+	if err == nil {
+		Check(this.query, time.Since(before))
+	}
+	return result, err
+}
+
+func (this *Stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	var before = time.Now()
+	var rows driver.Rows
+	var err error
+	queryer, ok := this.Stmt.(driver.StmtQueryContext)
+	if ok {
+		rows, err = queryer.QueryContext(ctx, args)
+	} else {
+		values, convertErr := namedValuesToValues(args)
+		if convertErr != nil {
+			return nil, convertErr
+		}
+		rows, err = this.Stmt.Query(values)
+	}
+	if err == nil {
+		Check(this.query, time.Since(before))
+	}
+	return rows, err
+}
+
+func (this *Stmt) CheckNamedValue(value *driver.NamedValue) error {
+	checker, ok := this.Stmt.(driver.NamedValueChecker)
+	if ok {
+		return checker.CheckNamedValue(value)
+	}
+	return driver.ErrSkip
+}
+
+func namedValuesToValues(args []driver.NamedValue) ([]driver.Value, error) {
+	var values = make([]driver.Value, 0, len(args))
+	for _, arg := range args {
+		if len(arg.Name) > 0 {
+			return nil, errors.New("sql: driver does not support the use of Named Parameters")
+		}
+		values = append(values, arg.Value)
+	}
+	return values, nil
+}
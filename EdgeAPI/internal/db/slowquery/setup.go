@@ -0,0 +1,32 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package slowquery
+
+import (
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// Setup 使用可以记录慢查询的驱动打开默认的MySQL数据库
+// 需要在第一次调用 dbs.Default() 之前调用；TeaGo根据驱动名称决定字段的引号，所以打开之后需要恢复原来的驱动名称
+func Setup() error {
+	var globalConfig = dbs.GlobalConfig()
+	if len(globalConfig.DBs) == 0 {
+		// 触发框架加载配置文件，空的ID不会打开数据库
+		_, _ = dbs.NewInstance("")
+	}
+
+	var dbId = globalConfig.Default.DB
+	if len(dbId) == 0 {
+		dbId = Tea.Env
+	}
+	config, ok := globalConfig.DBs[dbId]
+	if !ok || config == nil || config.Driver != "mysql" {
+		return nil
+	}
+
+	config.Driver = MySQLDriverName
+	_, err := dbs.Default()
+	config.Driver = "mysql"
+	return err
+}
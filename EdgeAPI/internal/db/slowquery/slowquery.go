@@ -0,0 +1,108 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package slowquery
+
+import (
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/iwind/TeaGo/logs"
+)
+
+// DefaultThreshold 默认的慢查询阈值
+const DefaultThreshold = 1 * time.Second
+
+const maxQueryLength = 512 // 日志中语句的最大长度
+
+var threshold atomic.Int64
+var logFunc atomic.Value // func(message string)
+
+func init() {
+	threshold.Store(int64(DefaultThreshold))
+}
+
+// SetThreshold 设置慢查询阈值，小于等于0表示不记录
+func SetThreshold(duration time.Duration) {
+	threshold.Store(int64(duration))
+}
+
+// Threshold 当前的慢查询阈值
+func Threshold() time.Duration {
+	return time.Duration(threshold.Load())
+}
+
+// SetLogFunc 设置日志输出函数，默认输出到终端
+func SetLogFunc(f func(message string)) {
+	logFunc.Store(f)
+}
+
+// Check 检查语句是否为慢查询，如果是则记录日志
+func Check(query string, cost time.Duration) {
+	var currentThreshold = Threshold()
+	if currentThreshold <= 0 || cost < currentThreshold {
+		return
+	}
+
+	var dao, caller = FindCaller()
+	var message = "cost " + cost.Truncate(time.Millisecond).String()
+	if len(dao) > 0 {
+		message += ", dao: " + dao
+	}
+	if len(caller) > 0 {
+		message += ", caller: " + caller
+	}
+
+	query = strings.Join(strings.Fields(query), " ")
+	if len(query) > maxQueryLength {
+		query = query[:maxQueryLength] + "..."
+	}
+	message += ", query: " + query
+
+	f, ok := logFunc.Load().(func(message string))
+	if ok && f != nil {
+		f(message)
+	} else {
+		logs.Println("[SLOW_QUERY]" + message)
+	}
+}
+
+// FindCaller 从调用栈中查找执行语句的DAO方法，以及调用此DAO方法的函数
+// 比如 models.(*NodeDAO).FindEnabledNode 和 services.(*NodeService).FindEnabledNode
+func FindCaller() (dao string, caller string) {
+	var pcs = make([]uintptr, 64)
+	var count = runtime.Callers(2, pcs)
+	var frames = runtime.CallersFrames(pcs[:count])
+	for {
+		frame, more := frames.Next()
+		var function = frame.Function
+		if len(function) > 0 && !isFrameworkFunction(function) {
+			var shortName = function
+			var index = strings.LastIndex(shortName, "/")
+			if index >= 0 {
+				shortName = shortName[index+1:]
+			}
+
+			if len(dao) == 0 && strings.Contains(function, "/internal/db/models") && strings.Contains(function, "DAO)") {
+				dao = shortName
+			} else if len(dao) > 0 && !strings.Contains(function, "/internal/db/") {
+				caller = shortName
+				break
+			}
+		}
+		if !more {
+			break
+		}
+	}
+	return
+}
+
+// 是否为框架中的函数
+func isFrameworkFunction(function string) bool {
+	return strings.HasPrefix(function, "runtime.") ||
+		strings.HasPrefix(function, "database/sql.") ||
+		strings.Contains(function, "/TeaGo/") ||
+		strings.Contains(function, "/internal/db/slowquery.") ||
+		strings.Contains(function, "/internal/db/utils.")
+}
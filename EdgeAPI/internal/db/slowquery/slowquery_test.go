@@ -0,0 +1,85 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package slowquery_test
+
+import (
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/slowquery"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestCheck(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var messages = []string{}
+	slowquery.SetLogFunc(func(message string) {
+		messages = append(messages, message)
+	})
+	defer slowquery.SetLogFunc(nil)
+	defer slowquery.SetThreshold(slowquery.DefaultThreshold)
+
+	slowquery.SetThreshold(1 * time.Second)
+	slowquery.Check("SELECT 1", 10*time.Millisecond)
+	a.IsTrue(len(messages) == 0)
+
+	slowquery.Check("SELECT   *\n FROM edgeNodes", 2*time.Second)
+	a.IsTrue(len(messages) == 1)
+	t.Log(messages)
+	a.IsTrue(strings.Contains(messages[0], "query: SELECT * FROM edgeNodes"))
+
+	slowquery.SetThreshold(0)
+	slowquery.Check("SELECT 1", 2*time.Second)
+	a.IsTrue(len(messages) == 1)
+}
+
+func TestDriver(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	sql.Register("sqlite-slowquery-test", slowquery.NewDriver(&sqlite.Driver{}))
+	db, err := sql.Open("sqlite-slowquery-test", sqlite.ComposeDSN(filepath.Join(t.TempDir(), "edge.db")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	var messages = []string{}
+	slowquery.SetLogFunc(func(message string) {
+		messages = append(messages, message)
+	})
+	defer slowquery.SetLogFunc(nil)
+	defer slowquery.SetThreshold(slowquery.DefaultThreshold)
+	slowquery.SetThreshold(1 * time.Nanosecond)
+
+	_, err = db.Exec("CREATE TABLE edgeTests (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stmt, err := db.Prepare("INSERT INTO edgeTests (name) VALUES (?)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = stmt.Exec("a")
+	_ = stmt.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var name string
+	err = db.QueryRow("SELECT name FROM edgeTests WHERE id=?", 1).Scan(&name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(name == "a")
+
+	t.Log(messages)
+	a.IsTrue(len(messages) == 3)
+}
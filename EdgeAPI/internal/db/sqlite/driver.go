@@ -0,0 +1,102 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/mattn/go-sqlite3"
+)
+
+// DriverName 在数据库配置中使用的驱动名称
+// 和 go-sqlite3 自带的 sqlite3 驱动不同，此驱动会将框架和程序中常用的MySQL语句转换为SQLite语句
+const DriverName = "sqlite"
+
+// DefaultFile 默认的数据库文件
+const DefaultFile = "data/edge.db"
+
+func init() {
+	sql.Register(DriverName, &Driver{})
+}
+
+// ComposeDSN 根据数据库文件生成DSN
+// 相对路径会转换为相对于程序根目录的路径
+func ComposeDSN(file string) string {
+	if len(file) == 0 {
+		file = DefaultFile
+	}
+	if !filepath.IsAbs(file) {
+		file = Tea.Root + Tea.DS + file
+	}
+
+	// 等待锁的时间和日志模式，可以在文件名后自行指定
+	if strings.Contains(file, "?") {
+		return file
+	}
+	return file + "?_busy_timeout=10000&_journal_mode=WAL&_txlock=immediate"
+}
+
+// Driver SQLite驱动
+type Driver struct {
+	rawDriver sqlite3.SQLiteDriver
+}
+
+func (this *Driver) Open(dsn string) (driver.Conn, error) {
+	// 自动创建数据库文件所在目录
+	var file = strings.TrimPrefix(dsn, "file:")
+	var index = strings.Index(file, "?")
+	if index >= 0 {
+		file = file[:index]
+	}
+	if len(file) > 0 && file != ":memory:" {
+		var dir = filepath.Dir(file)
+		_, err := os.Stat(dir)
+		if err != nil && os.IsNotExist(err) {
+			err = os.MkdirAll(dir, 0777)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	conn, err := this.rawDriver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{SQLiteConn: conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+// Conn 数据库连接，在执行语句之前转换语句
+type Conn struct {
+	*sqlite3.SQLiteConn
+}
+
+func (this *Conn) Prepare(query string) (driver.Stmt, error) {
+	return this.SQLiteConn.Prepare(TranslateSQL(query))
+}
+
+func (this *Conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return this.SQLiteConn.PrepareContext(ctx, TranslateSQL(query))
+}
+
+func (this *Conn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return this.SQLiteConn.Exec(TranslateSQL(query), args)
+}
+
+func (this *Conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return this.SQLiteConn.ExecContext(ctx, TranslateSQL(query), args)
+}
+
+func (this *Conn) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return this.SQLiteConn.Query(TranslateSQL(query), args)
+}
+
+func (this *Conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return this.SQLiteConn.QueryContext(ctx, TranslateSQL(query), args)
+}
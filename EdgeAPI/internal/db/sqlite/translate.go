@@ -0,0 +1,169 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package sqlite
+
+import (
+	"regexp"
+	"strings"
+)
+
+var showTablesReg = regexp.MustCompile(`(?is)^SHOW\s+TABLES(?:\s+LIKE\s+('[^']*'))?$`)
+var showColumnsReg = regexp.MustCompile("(?is)^SHOW\\s+(?:FULL\\s+)?(?:COLUMNS|FIELDS)\\s+FROM\\s+`?(\\w+)`?$")
+var findTableReg = regexp.MustCompile(`(?is)^SELECT\s+\*\s+FROM\s+INFORMATION_SCHEMA\.TABLES\s+WHERE\s+table_schema\s*=\s*\?\s+AND\s+table_name\s*=\s*\?$`)
+var globalVarReg = regexp.MustCompile(`(?i)^SELECT\s+@@\w+$`)
+var truncateReg = regexp.MustCompile(`(?i)^TRUNCATE\s+(?:TABLE\s+)?(\S+)$`)
+var versionReg = regexp.MustCompile(`(?i)^SELECT\s+VERSION\(\)$`)
+var insertIgnoreReg = regexp.MustCompile(`(?i)^INSERT\s+IGNORE\s+`)
+var insertWithoutIntoReg = regexp.MustCompile(`(?i)^(INSERT(?:\s+OR\s+IGNORE)?|REPLACE)\s+`)
+var intoReg = regexp.MustCompile(`(?i)^INTO\s`)
+var sqlCacheReg = regexp.MustCompile(`(?i)\bSQL_(?:NO_)?CACHE\b`)
+var lockReg = regexp.MustCompile(`(?is)\s+(?:FOR\s+UPDATE|LOCK\s+IN\s+SHARE\s+MODE)\s*$`)
+var useIndexReg = regexp.MustCompile(`(?is)\s+(?:USE|FORCE|IGNORE)\s+INDEX\s*(?:FOR\s+(?:JOIN|ORDER\s+BY|GROUP\s+BY)\s*)?\([^)]*\)`)
+var onDuplicateReg = regexp.MustCompile(`(?is)\s+ON\s+DUPLICATE\s+KEY\s+UPDATE\s+`)
+var valuesFuncReg = regexp.MustCompile("(?i)\\bVALUES\\s*\\(\\s*(`?\\w+`?)\\s*\\)")
+var insertValuesReg = regexp.MustCompile(`(?is)^INSERT(?:\s+OR\s+\w+)?\s+INTO\s+\S+\s*\(([^)]*)\)\s*VALUES\s*\(`)
+
+// TranslateSQL 将MySQL语句转换为SQLite语句
+// 只转换框架和程序中常用的语句，其余语句原样返回；反引号、LIMIT offset, count 等写法SQLite本身已经支持
+func TranslateSQL(query string) string {
+	var trimmedQuery = strings.TrimRight(strings.TrimSpace(query), ";")
+
+	// 读取表格和字段信息
+	if len(trimmedQuery) > 4 && strings.EqualFold(trimmedQuery[:4], "SHOW") {
+		var matches = showTablesReg.FindStringSubmatch(trimmedQuery)
+		if len(matches) > 0 {
+			var sqlString = `SELECT name AS "Tables" FROM sqlite_master WHERE type='table' AND name NOT LIKE 'sqlite\_%' ESCAPE '\'`
+			if len(matches[1]) > 0 {
+				sqlString += ` AND name LIKE ` + matches[1] + ` ESCAPE '\'`
+			}
+			return sqlString + " ORDER BY name"
+		}
+
+		matches = showColumnsReg.FindStringSubmatch(trimmedQuery)
+		if len(matches) > 0 {
+			return `SELECT name AS "Field", type AS "Type", NULL AS "Collation", ` +
+				`CASE WHEN "notnull"=1 OR pk>0 THEN 'NO' ELSE 'YES' END AS "Null", ` +
+				`CASE WHEN pk>0 THEN 'PRI' ELSE '' END AS "Key", ` +
+				`TRIM(dflt_value, '''') AS "Default", ` +
+				`CASE WHEN pk=1 AND UPPER(type)='INTEGER' THEN 'auto_increment' ELSE '' END AS "Extra", ` +
+				`'' AS "Comment" ` +
+				`FROM pragma_table_info('` + matches[1] + `') ORDER BY cid`
+		}
+		return query
+	}
+	if findTableReg.MatchString(trimmedQuery) {
+		return `SELECT ? AS "TABLE_SCHEMA", name AS "TABLE_NAME", '' AS "TABLE_COMMENT", '' AS "TABLE_COLLATION", 'SQLite' AS "ENGINE" FROM sqlite_master WHERE type='table' AND name=?`
+	}
+	if truncateReg.MatchString(trimmedQuery) {
+		return truncateReg.ReplaceAllString(trimmedQuery, "DELETE FROM $1")
+	}
+	if globalVarReg.MatchString(trimmedQuery) {
+		return "SELECT NULL"
+	}
+	if versionReg.MatchString(trimmedQuery) {
+		return "SELECT sqlite_version()"
+	}
+
+	// INSERT IGNORE 和 INSERT ... ON DUPLICATE KEY UPDATE
+	if insertIgnoreReg.MatchString(trimmedQuery) {
+		trimmedQuery = insertIgnoreReg.ReplaceAllString(trimmedQuery, "INSERT OR IGNORE ")
+		query = trimmedQuery
+	}
+	{
+		var loc = insertWithoutIntoReg.FindStringIndex(trimmedQuery)
+		if loc != nil && !intoReg.MatchString(trimmedQuery[loc[1]:]) {
+			trimmedQuery = trimmedQuery[:loc[1]] + "INTO " + trimmedQuery[loc[1]:]
+			query = trimmedQuery
+		}
+	}
+	{
+		var newQuery = translateInsertId(trimmedQuery)
+		if newQuery != trimmedQuery {
+			trimmedQuery = newQuery
+			query = newQuery
+		}
+	}
+	{
+		var loc = onDuplicateReg.FindStringIndex(trimmedQuery)
+		if loc != nil {
+			// SQLite 3.35 以后可以省略冲突的字段
+			var updates = valuesFuncReg.ReplaceAllString(trimmedQuery[loc[1]:], "excluded.$1")
+			trimmedQuery = trimmedQuery[:loc[0]] + " ON CONFLICT DO UPDATE SET " + updates
+			query = trimmedQuery
+		}
+	}
+
+	// 查询中SQLite不支持的提示
+	if strings.Contains(query, "SQL_") {
+		query = sqlCacheReg.ReplaceAllString(query, "")
+	}
+	if strings.Contains(query, " INDEX") {
+		query = useIndexReg.ReplaceAllString(query, "")
+	}
+	if strings.Contains(query, "UPDATE") || strings.Contains(query, "MODE") {
+		query = lockReg.ReplaceAllString(query, "")
+	}
+
+	return query
+}
+
+// 插入时主键为0的时候自动生成ID，和MySQL保持一致
+// INSERT INTO t (`id`, ...) VALUES (?, ...) 会被转换为 INSERT INTO t (`id`, ...) VALUES (NULLIF(?, 0), ...)
+func translateInsertId(query string) string {
+	var matches = insertValuesReg.FindStringSubmatchIndex(query)
+	if len(matches) == 0 {
+		return query
+	}
+
+	var idIndex = -1
+	for index, field := range strings.Split(query[matches[2]:matches[3]], ",") {
+		if strings.Trim(strings.TrimSpace(field), "`\"") == "id" {
+			idIndex = index
+			break
+		}
+	}
+	if idIndex < 0 {
+		return query
+	}
+
+	// 查找对应的值
+	var valueIndex = 0
+	var valueStart = matches[1]
+	var depth = 0
+	var inString = false
+	for i := matches[1]; i < len(query); i++ {
+		var c = query[i]
+		if inString {
+			if c == '\'' {
+				inString = false
+			}
+			continue
+		}
+		switch c {
+		case '\'':
+			inString = true
+		case '(':
+			depth++
+		case ',', ')':
+			if depth > 0 {
+				if c == ')' {
+					depth--
+				}
+				continue
+			}
+			if valueIndex == idIndex {
+				if strings.TrimSpace(query[valueStart:i]) == "?" {
+					var placeholderIndex = valueStart + strings.Index(query[valueStart:i], "?")
+					return query[:placeholderIndex] + "NULLIF(?, 0)" + query[placeholderIndex+1:]
+				}
+				return query
+			}
+			if c == ')' {
+				return query
+			}
+			valueIndex++
+			valueStart = i + 1
+		}
+	}
+	return query
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package sqlite_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func TestTranslateSQL(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	for _, query := range []string{
+		"SELECT * FROM edgeNodes WHERE id=? LIMIT 1",
+		"UPDATE edgeNodes SET name=? WHERE id=?",
+		"INSERT INTO edgeNodes (name) VALUES (?)",
+	} {
+		a.IsTrue(sqlite.TranslateSQL(query) == query)
+	}
+
+	a.IsTrue(sqlite.TranslateSQL("SELECT @@max_prepared_stmt_count") == "SELECT NULL")
+	a.IsTrue(sqlite.TranslateSQL("TRUNCATE TABLE `edgeLogs`") == "DELETE FROM `edgeLogs`")
+	a.IsTrue(sqlite.TranslateSQL("INSERT IGNORE INTO edgeA (a) VALUES (1)") == "INSERT OR IGNORE INTO edgeA (a) VALUES (1)")
+	a.IsTrue(sqlite.TranslateSQL("INSERT edgeVersions (version) VALUES (?)") == "INSERT INTO edgeVersions (version) VALUES (?)")
+	a.IsTrue(sqlite.TranslateSQL("REPLACE `edgeA`\n (`a`) VALUES (1)") == "REPLACE INTO `edgeA`\n (`a`) VALUES (1)")
+	a.IsTrue(sqlite.TranslateSQL("SELECT SQL_NO_CACHE id FROM edgeA FOR UPDATE") == "SELECT  id FROM edgeA")
+	a.IsTrue(sqlite.TranslateSQL("SELECT id FROM edgeA USE INDEX (name) WHERE name=?") == "SELECT id FROM edgeA WHERE name=?")
+
+	a.IsTrue(sqlite.TranslateSQL("INSERT INTO `edgeA` (`name`, `id`, `state`) VALUES (?, ?, ?)") == "INSERT INTO `edgeA` (`name`, `id`, `state`) VALUES (?, NULLIF(?, 0), ?)")
+	a.IsTrue(sqlite.TranslateSQL("INSERT INTO `edgeA` (`name`, `id`) VALUES (CONCAT(?, ?), 1)") == "INSERT INTO `edgeA` (`name`, `id`) VALUES (CONCAT(?, ?), 1)")
+
+	var upsert = sqlite.TranslateSQL("INSERT INTO `edgeA` (`a`, `b`) VALUES (:a, :b)\nON DUPLICATE KEY UPDATE\n`b`=`b`+VALUES(`b`)")
+	t.Log(upsert)
+	a.IsTrue(upsert == "INSERT INTO `edgeA` (`a`, `b`) VALUES (:a, :b) ON CONFLICT DO UPDATE SET `b`=`b`+excluded.`b`")
+}
+
+func TestDriver_Table(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	db, err := dbs.NewInstanceFromConfig(&dbs.DBConfig{
+		Driver: sqlite.DriverName,
+		Dsn:    sqlite.ComposeDSN(filepath.Join(t.TempDir(), "edge.db")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err = db.Exec("CREATE TABLE `edgeTests` (`id` INTEGER PRIMARY KEY AUTOINCREMENT, `name` TEXT NOT NULL DEFAULT '', `count` INTEGER DEFAULT '0')")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("CREATE UNIQUE INDEX `edgeTests_name` ON `edgeTests` (`name`)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tableNames, err := db.TableNames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(len(tableNames) == 1 && tableNames[0] == "edgeTests")
+
+	table, err := db.FindTable("edgeTests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsNotNil(table)
+	a.IsTrue(len(table.Fields) == 3)
+	a.IsTrue(table.Fields[0].Name == "id" && table.Fields[0].IsPrimaryKey && table.Fields[0].AutoIncrement)
+	a.IsTrue(table.Fields[2].DefaultValueString == "0")
+
+	for i := 0; i < 2; i++ {
+		_, err = db.Exec("INSERT INTO `edgeTests` (`name`, `count`) VALUES (?, 1) ON DUPLICATE KEY UPDATE `count`=`count`+VALUES(`count`)", "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	count, err := db.FindCol(0, "SELECT `count` FROM `edgeTests` WHERE `name`=? LIMIT 0, 1", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(count == int64(2))
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils
+
+import (
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// DefaultDeleteBatchSize 分批删除时每批默认的数量
+const DefaultDeleteBatchSize = 10_000
+
+// DeleteInBatches 分批删除数据，防止一次性删除太多数据时长时间阻塞其他操作
+// newQuery 每次需要返回一个新的查询；SQLite不支持 DELETE ... LIMIT，所以会一次性删除
+func DeleteInBatches(dao dbs.DAOWrapper, batchSize int, newQuery func() *dbs.Query) (total int64, err error) {
+	if batchSize <= 0 {
+		batchSize = DefaultDeleteBatchSize
+	}
+
+	if IsSQLite(dao.Object().Instance) {
+		return newQuery().Delete()
+	}
+
+	for {
+		count, err := newQuery().
+			Limit(int64(batchSize)).
+			Delete()
+		if err != nil {
+			return total, err
+		}
+		total += count
+		if count < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
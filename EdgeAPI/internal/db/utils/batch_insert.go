@@ -0,0 +1,84 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+)
+
+const (
+	batchInsertMaxRows         = 500   // 单条语句最多写入的行数
+	batchInsertMaxPlaceholders = 65535 // MySQL单条语句最多可以使用的占位符数量
+)
+
+// SQLExecutor 可以执行SQL语句的对象，比如 *dbs.DB 和 *dbs.Tx
+type SQLExecutor interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+// BatchInsert 使用多行INSERT语句写入数据
+// rows 中每一行的值需要和 columns 一一对应；onDuplicateUpdate 为 ON DUPLICATE KEY UPDATE 之后的部分，为空时表示不处理冲突
+// 返回最后一行的ID，用于自动分表等场景，有冲突更新时此值没有意义
+func BatchInsert(executor SQLExecutor, table string, columns []string, rows [][]any, onDuplicateUpdate string) (lastId int64, err error) {
+	if len(columns) == 0 {
+		return 0, errors.New("batch insert: 'columns' should not be empty")
+	}
+
+	var rowsPerStatement = batchInsertMaxPlaceholders / len(columns)
+	if rowsPerStatement > batchInsertMaxRows {
+		rowsPerStatement = batchInsertMaxRows
+	}
+
+	for len(rows) > 0 {
+		var statementRows = rows
+		if len(statementRows) > rowsPerStatement {
+			statementRows = statementRows[:rowsPerStatement]
+		}
+		rows = rows[len(statementRows):]
+
+		var args = make([]any, 0, len(statementRows)*len(columns))
+		for _, row := range statementRows {
+			if len(row) != len(columns) {
+				return 0, errors.New("batch insert: values count not match columns count")
+			}
+			args = append(args, row...)
+		}
+
+		result, err := executor.Exec(BatchInsertSQL(table, columns, len(statementRows), onDuplicateUpdate), args...)
+		if err != nil {
+			return 0, err
+		}
+
+		// MySQL返回的是此语句写入的第一行ID
+		firstId, err := result.LastInsertId()
+		if err == nil && firstId > 0 {
+			lastId = firstId + int64(len(statementRows)) - 1
+		}
+	}
+
+	return lastId, nil
+}
+
+// BatchInsertSQL 生成多行INSERT语句
+func BatchInsertSQL(table string, columns []string, countRows int, onDuplicateUpdate string) string {
+	var quotedColumns = make([]string, 0, len(columns))
+	for _, column := range columns {
+		quotedColumns = append(quotedColumns, "`"+column+"`")
+	}
+	var rowPlaceholders = "(" + strings.TrimSuffix(strings.Repeat("?,", len(columns)), ",") + ")"
+
+	var builder strings.Builder
+	builder.WriteString("INSERT INTO `" + table + "` (" + strings.Join(quotedColumns, ",") + ") VALUES ")
+	for i := 0; i < countRows; i++ {
+		if i > 0 {
+			builder.WriteString(",")
+		}
+		builder.WriteString(rowPlaceholders)
+	}
+	if len(onDuplicateUpdate) > 0 {
+		builder.WriteString(" ON DUPLICATE KEY UPDATE " + onDuplicateUpdate)
+	}
+	return builder.String()
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func TestBatchInsertSQL(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(dbutils.BatchInsertSQL("edgeA", []string{"a", "b"}, 2, "") == "INSERT INTO `edgeA` (`a`,`b`) VALUES (?,?),(?,?)")
+	a.IsTrue(dbutils.BatchInsertSQL("edgeA", []string{"a"}, 1, "a=a+VALUES(a)") == "INSERT INTO `edgeA` (`a`) VALUES (?) ON DUPLICATE KEY UPDATE a=a+VALUES(a)")
+}
+
+func TestBatchInsert(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	db, err := dbs.NewInstanceFromConfig(&dbs.DBConfig{
+		Driver: sqlite.DriverName,
+		Dsn:    sqlite.ComposeDSN(filepath.Join(t.TempDir(), "edge.db")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err = db.Exec("CREATE TABLE `edgeStats` (`id` INTEGER PRIMARY KEY AUTOINCREMENT, `day` TEXT NOT NULL DEFAULT '', `count` INTEGER DEFAULT '0')")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("CREATE UNIQUE INDEX `edgeStats_day` ON `edgeStats` (`day`)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rows = [][]any{}
+	for i := 0; i < 1200; i++ {
+		rows = append(rows, []any{"2024010" + string(rune('1'+i%3)), 1})
+	}
+	_, err = dbutils.BatchInsert(db, "edgeStats", []string{"day", "count"}, rows, "count=count+VALUES(count)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := db.FindCol(0, "SELECT COUNT(*) FROM `edgeStats`")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(count == int64(3))
+
+	total, err := db.FindCol(0, "SELECT SUM(`count`) FROM `edgeStats`")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(total == int64(1200))
+
+	// 值的数量和字段数量不一致
+	_, err = dbutils.BatchInsert(db, "edgeStats", []string{"day", "count"}, [][]any{{"20240101"}}, "")
+	a.IsTrue(err != nil)
+}
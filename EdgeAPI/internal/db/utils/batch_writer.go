@@ -0,0 +1,146 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/iwind/TeaGo/logs"
+)
+
+// BatchWriter 批量写入器
+// 数据先放入内存队列，积累到 batchSize 条或者每隔 interval 时间一次性写入；队列满时 Push 会等待写入，从而对调用方形成反压
+type BatchWriter[T any] struct {
+	name      string
+	queue     chan T
+	batchSize int
+	interval  time.Duration
+	writeFunc func(items []T) error
+
+	items       []T
+	isRunning   atomic.Bool
+	locker      sync.Mutex
+	writeLocker sync.Mutex
+}
+
+// NewBatchWriter 获取新的批量写入器
+func NewBatchWriter[T any](name string, queueSize int, batchSize int, interval time.Duration, writeFunc func(items []T) error) *BatchWriter[T] {
+	if queueSize <= 0 {
+		queueSize = 10_000
+	}
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	if interval <= 0 {
+		interval = 1 * time.Second
+	}
+	return &BatchWriter[T]{
+		name:      name,
+		queue:     make(chan T, queueSize),
+		batchSize: batchSize,
+		interval:  interval,
+		writeFunc: writeFunc,
+	}
+}
+
+// Push 放入数据
+// 队列已满时最多等待 timeout 时间，超时后返回false
+func (this *BatchWriter[T]) Push(item T, timeout time.Duration) bool {
+	select {
+	case this.queue <- item:
+		return true
+	default:
+	}
+
+	if timeout <= 0 {
+		return false
+	}
+
+	var timer = time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case this.queue <- item:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+// Start 开始写入循环
+func (this *BatchWriter[T]) Start() {
+	if !this.isRunning.CompareAndSwap(false, true) {
+		return
+	}
+
+	var ticker = time.NewTicker(this.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case item := <-this.queue:
+			this.locker.Lock()
+			this.items = append(this.items, item)
+			var isFull = len(this.items) >= this.batchSize
+			this.locker.Unlock()
+
+			if isFull {
+				this.flushAndLog()
+			}
+		case <-ticker.C:
+			this.flushAndLog()
+		}
+	}
+}
+
+// IsRunning 是否已启动
+func (this *BatchWriter[T]) IsRunning() bool {
+	return this.isRunning.Load()
+}
+
+// Len 等待写入的数据数量
+func (this *BatchWriter[T]) Len() int {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return len(this.items) + len(this.queue)
+}
+
+// Flush 立即写入所有等待中的数据
+func (this *BatchWriter[T]) Flush() error {
+	this.writeLocker.Lock()
+	defer this.writeLocker.Unlock()
+
+	for {
+		this.locker.Lock()
+	Loop:
+		for len(this.items) < this.batchSize {
+			select {
+			case item := <-this.queue:
+				this.items = append(this.items, item)
+			default:
+				break Loop
+			}
+		}
+		var items = this.items
+		this.items = nil
+		this.locker.Unlock()
+
+		if len(items) == 0 {
+			return nil
+		}
+
+		err := this.writeFunc(items)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (this *BatchWriter[T]) flushAndLog() {
+	err := this.Flush()
+	if err != nil {
+		logs.Println("[BATCH_WRITER]" + this.name + ": write failed: " + err.Error())
+	}
+}
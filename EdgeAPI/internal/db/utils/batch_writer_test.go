@@ -0,0 +1,64 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils_test
+
+import (
+	"testing"
+	"time"
+
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestBatchWriter_Flush(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var batches = [][]int{}
+	var writer = dbutils.NewBatchWriter[int]("TEST", 10, 4, 1*time.Second, func(items []int) error {
+		batches = append(batches, items)
+		return nil
+	})
+	for i := 0; i < 10; i++ {
+		a.IsTrue(writer.Push(i, 0))
+	}
+
+	// 队列已满
+	a.IsFalse(writer.Push(10, 10*time.Millisecond))
+	a.IsTrue(writer.Len() == 10)
+
+	err := writer.Flush()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(writer.Len() == 0)
+	a.IsTrue(len(batches) == 3)
+	a.IsTrue(len(batches[0]) == 4 && len(batches[2]) == 2)
+}
+
+func TestBatchWriter_Start(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var countItems = make(chan int, 10)
+	var writer = dbutils.NewBatchWriter[int]("TEST", 10, 100, 50*time.Millisecond, func(items []int) error {
+		countItems <- len(items)
+		return nil
+	})
+	go writer.Start()
+
+	for i := 0; i < 5; i++ {
+		a.IsTrue(writer.Push(i, time.Second))
+	}
+
+	// 不足一批时按时间写入
+	var total = 0
+	var timeout = time.After(2 * time.Second)
+	for total < 5 {
+		select {
+		case count := <-countItems:
+			total += count
+		case <-timeout:
+			t.Fatal("timeout")
+		}
+	}
+	a.IsTrue(writer.IsRunning())
+}
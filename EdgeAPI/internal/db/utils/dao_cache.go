@@ -0,0 +1,155 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/ttlcache"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// DefaultDAOCacheTTL 默认的DAO缓存时间
+const DefaultDAOCacheTTL = 60 * time.Second
+
+var SharedDAOCache = NewDAOCache()
+
+// DAOCache DAO查询结果缓存
+// 默认不启用；只缓存事务之外的查询，数据更新时需要调用 Delete() 或 Purge() 使缓存失效，
+// 没有覆盖到的更新方法最多在TTL之后生效
+type DAOCache struct {
+	isOn atomic.Bool
+	ttl  atomic.Int64 // 秒
+
+	cache       *ttlcache.Cache
+	generations map[string]uint64 // namespace => generation
+	locker      sync.RWMutex
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+func NewDAOCache() *DAOCache {
+	var cache = &DAOCache{
+		generations: map[string]uint64{},
+	}
+	cache.ttl.Store(int64(DefaultDAOCacheTTL / time.Second))
+	return cache
+}
+
+// SetOn 设置是否启用
+func (this *DAOCache) SetOn(isOn bool) {
+	if isOn {
+		this.locker.Lock()
+		if this.cache == nil {
+			this.cache = ttlcache.NewCache(ttlcache.NewPiecesOption(32), ttlcache.NewMaxItemsOption(100_000))
+		}
+		this.locker.Unlock()
+	}
+	this.isOn.Store(isOn)
+}
+
+// IsOn 是否已启用
+func (this *DAOCache) IsOn() bool {
+	return this.isOn.Load()
+}
+
+// SetTTL 设置缓存时间
+func (this *DAOCache) SetTTL(ttl time.Duration) {
+	if ttl < time.Second {
+		ttl = DefaultDAOCacheTTL
+	}
+	this.ttl.Store(int64(ttl / time.Second))
+}
+
+// Read 读取缓存
+func (this *DAOCache) Read(tx *dbs.Tx, namespace string, key string) (value any, ok bool) {
+	if tx != nil || !this.IsOn() {
+		return nil, false
+	}
+
+	var item = this.cache.Read(this.composeKey(namespace, key))
+	if item == nil {
+		this.misses.Add(1)
+		return nil, false
+	}
+	this.hits.Add(1)
+	return item.Value, true
+}
+
+// Write 写入缓存
+// 缓存的值会被所有调用者共享，所以读取后不能修改，或者在读写时复制
+func (this *DAOCache) Write(tx *dbs.Tx, namespace string, key string, value any) {
+	if tx != nil || !this.IsOn() || value == nil {
+		return
+	}
+	this.cache.Write(this.composeKey(namespace, key), value, time.Now().Unix()+this.ttl.Load())
+}
+
+// ReadJSON 读取以JSON格式缓存的数据
+// 每次读取都会得到一个新的对象，适合缓存组合后的配置
+func (this *DAOCache) ReadJSON(tx *dbs.Tx, namespace string, key string, ptr any) bool {
+	value, ok := this.Read(tx, namespace, key)
+	if !ok {
+		return false
+	}
+	data, ok := value.([]byte)
+	if !ok {
+		return false
+	}
+	return json.Unmarshal(data, ptr) == nil
+}
+
+// WriteJSON 以JSON格式写入缓存
+func (this *DAOCache) WriteJSON(tx *dbs.Tx, namespace string, key string, value any) {
+	if tx != nil || !this.IsOn() || value == nil {
+		return
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	this.Write(tx, namespace, key, data)
+}
+
+// Delete 删除某个缓存
+func (this *DAOCache) Delete(namespace string, key string) {
+	if !this.IsOn() {
+		return
+	}
+	this.cache.Delete(this.composeKey(namespace, key))
+}
+
+// Purge 清除某个命名空间下的所有缓存
+func (this *DAOCache) Purge(namespace string) {
+	if !this.IsOn() {
+		return
+	}
+	this.locker.Lock()
+	this.generations[namespace]++
+	this.locker.Unlock()
+}
+
+// Clear 清除所有缓存，比如在恢复数据库之后
+func (this *DAOCache) Clear() {
+	if !this.IsOn() {
+		return
+	}
+	this.cache.Clean()
+}
+
+// Stats 命中和未命中的次数
+func (this *DAOCache) Stats() (hits uint64, misses uint64) {
+	return this.hits.Load(), this.misses.Load()
+}
+
+func (this *DAOCache) composeKey(namespace string, key string) string {
+	this.locker.RLock()
+	var generation = this.generations[namespace]
+	this.locker.RUnlock()
+	return namespace + "@" + strconv.FormatUint(generation, 10) + "@" + key
+}
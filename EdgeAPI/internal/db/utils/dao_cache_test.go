@@ -0,0 +1,63 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils_test
+
+import (
+	"testing"
+
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func TestDAOCache(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var cache = dbutils.NewDAOCache()
+
+	// 默认不启用
+	cache.Write(nil, "server", "1", "a")
+	_, ok := cache.Read(nil, "server", "1")
+	a.IsFalse(ok)
+
+	cache.SetOn(true)
+	cache.Write(nil, "server", "1", "a")
+	cache.Write(nil, "server", "2", "b")
+	value, ok := cache.Read(nil, "server", "1")
+	a.IsTrue(ok && value == "a")
+
+	// 事务中不使用缓存
+	_, ok = cache.Read(&dbs.Tx{}, "server", "1")
+	a.IsFalse(ok)
+
+	cache.Delete("server", "1")
+	_, ok = cache.Read(nil, "server", "1")
+	a.IsFalse(ok)
+
+	cache.Purge("server")
+	_, ok = cache.Read(nil, "server", "2")
+	a.IsFalse(ok)
+
+	hits, misses := cache.Stats()
+	a.IsTrue(hits == 1 && misses == 2)
+}
+
+func TestDAOCache_JSON(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var cache = dbutils.NewDAOCache()
+	cache.SetOn(true)
+
+	type config struct {
+		Name string `json:"name"`
+	}
+	cache.WriteJSON(nil, "config", "1", &config{Name: "a"})
+
+	var c1 = &config{}
+	a.IsTrue(cache.ReadJSON(nil, "config", "1", c1))
+	c1.Name = "b"
+
+	var c2 = &config{}
+	a.IsTrue(cache.ReadJSON(nil, "config", "1", c2))
+	a.IsTrue(c2.Name == "a")
+}
@@ -0,0 +1,137 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+var sqliteIndexReg = regexp.MustCompile(`USING (?:COVERING )?INDEX (\S+)`)
+
+// ExplainResult 查询计划分析结果
+type ExplainResult struct {
+	IsFullScan   bool     // 是否有全表扫描
+	UsesFilesort bool     // 是否需要额外的排序或者临时表
+	Keys         []string // 使用到的索引
+	Rows         int64    // 预计扫描的行数，只有MySQL有此数据
+	Details      []string // 查询计划中的每一步
+}
+
+// ExplainQuery 分析查询语句的执行计划
+func ExplainQuery(db *dbs.DB, sqlString string, args ...any) (*ExplainResult, error) {
+	var result = &ExplainResult{}
+
+	if IsSQLite(db) {
+		ones, _, err := db.FindOnes("EXPLAIN QUERY PLAN "+sqlString, args...)
+		if err != nil {
+			return nil, err
+		}
+		for _, one := range ones {
+			var detail = one.GetString("detail")
+			result.Details = append(result.Details, detail)
+
+			// 老版本为 SCAN TABLE xxx
+			if strings.HasPrefix(detail, "SCAN ") && !strings.Contains(detail, " USING ") {
+				result.IsFullScan = true
+			}
+			if strings.HasPrefix(detail, "USE TEMP B-TREE") {
+				result.UsesFilesort = true
+			}
+			var matches = sqliteIndexReg.FindStringSubmatch(detail)
+			if len(matches) > 1 {
+				result.Keys = append(result.Keys, matches[1])
+			}
+		}
+		return result, nil
+	}
+
+	ones, _, err := db.FindOnes("EXPLAIN "+sqlString, args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range ones {
+		var table = one.GetString("table")
+		var scanType = one.GetString("type")
+		var key = one.GetString("key")
+		var extra = one.GetString("Extra")
+		var rows = types.Int64(one.Get("rows"))
+
+		result.Details = append(result.Details, "table="+table+" type="+scanType+" key="+key+" rows="+types.String(rows)+" extra="+extra)
+
+		// 派生表的全表扫描不计算在内
+		if scanType == "ALL" && !strings.HasPrefix(table, "<") {
+			result.IsFullScan = true
+		}
+		if strings.Contains(extra, "Using filesort") || strings.Contains(extra, "Using temporary") {
+			result.UsesFilesort = true
+		}
+		if len(key) > 0 {
+			result.Keys = append(result.Keys, key)
+		}
+		result.Rows += rows
+	}
+	return result, nil
+}
+
+// FindTableIndexes 读取表格上的所有索引
+// 返回 索引名 => 按顺序排列的字段
+func FindTableIndexes(db *dbs.DB, table string) (map[string][]string, error) {
+	var result = map[string][]string{}
+
+	if IsSQLite(db) {
+		ones, _, err := db.FindOnes("SELECT il.name AS indexName, ii.name AS columnName FROM pragma_index_list(?) il JOIN pragma_index_info(il.name) ii ORDER BY il.name, ii.seqno", table)
+		if err != nil {
+			return nil, err
+		}
+		for _, one := range ones {
+			var indexName = one.GetString("indexName")
+			result[indexName] = append(result[indexName], one.GetString("columnName"))
+		}
+
+		// INTEGER PRIMARY KEY 不在索引列表中
+		pkName, err := db.FindCol(0, "SELECT name FROM pragma_table_info(?) WHERE pk=1", table)
+		if err == nil && pkName != nil {
+			result["PRIMARY"] = []string{types.String(pkName)}
+		}
+		return result, nil
+	}
+
+	ones, _, err := db.FindOnes("SHOW INDEX FROM `" + table + "`")
+	if err != nil {
+		return nil, err
+	}
+
+	// SHOW INDEX 的结果已经按照字段顺序排列
+	for _, one := range ones {
+		var indexName = one.GetString("Key_name")
+		result[indexName] = append(result[indexName], one.GetString("Column_name"))
+	}
+	return result, nil
+}
+
+// HasIndexWithPrefix 检查是否有以某些字段开头的索引
+func HasIndexWithPrefix(indexes map[string][]string, columns []string) bool {
+	if len(columns) == 0 {
+		return true
+	}
+	for _, indexColumns := range indexes {
+		if len(indexColumns) < len(columns) {
+			continue
+		}
+		var matched = true
+		for i, column := range columns {
+			if !strings.EqualFold(indexColumns[i], column) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
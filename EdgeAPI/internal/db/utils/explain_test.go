@@ -0,0 +1,67 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func TestExplainQuery(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	db, err := dbs.NewInstanceFromConfig(&dbs.DBConfig{
+		Driver: sqlite.DriverName,
+		Dsn:    sqlite.ComposeDSN(filepath.Join(t.TempDir(), "edge.db")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err = db.Exec("CREATE TABLE `edgeStats` (`id` INTEGER PRIMARY KEY AUTOINCREMENT, `serverId` INTEGER DEFAULT '0', `day` TEXT, `bytes` INTEGER DEFAULT '0')")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("CREATE INDEX `edgeStats_serverId_day` ON `edgeStats` (`serverId`, `day`)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	indexes, err := dbutils.FindTableIndexes(db, "edgeStats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(indexes)
+	a.IsTrue(dbutils.HasIndexWithPrefix(indexes, []string{"serverId"}))
+	a.IsTrue(dbutils.HasIndexWithPrefix(indexes, []string{"serverId", "day"}))
+	a.IsTrue(dbutils.HasIndexWithPrefix(indexes, []string{"id"}))
+	a.IsFalse(dbutils.HasIndexWithPrefix(indexes, []string{"day"}))
+
+	{
+		result, err := dbutils.ExplainQuery(db, "SELECT SUM(bytes) FROM edgeStats WHERE serverId=? AND day>=?", 1, "20240101")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Log(result.Details)
+		a.IsFalse(result.IsFullScan)
+		a.IsTrue(len(result.Keys) == 1 && result.Keys[0] == "edgeStats_serverId_day")
+	}
+
+	{
+		result, err := dbutils.ExplainQuery(db, "SELECT id FROM edgeStats WHERE bytes>? ORDER BY bytes DESC", 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Log(result.Details)
+		a.IsTrue(result.IsFullScan)
+		a.IsTrue(result.UsesFilesort)
+	}
+}
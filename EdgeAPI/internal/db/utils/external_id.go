@@ -0,0 +1,30 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/iwind/TeaGo/types"
+)
+
+// MaxExternalIdLength 外部ID最大长度
+const MaxExternalIdLength = 128
+
+var externalIdReg = regexp.MustCompile(`^[\w.:/@-]+$`)
+
+// ValidateExternalId 校验外部ID
+// 外部ID由调用方（比如Terraform等自动化工具）指定，用来在不同环境中稳定地标识同一个对象，为空表示不设置
+func ValidateExternalId(externalId string) error {
+	if len(externalId) == 0 {
+		return nil
+	}
+	if len(externalId) > MaxExternalIdLength {
+		return errors.New("'externalId' should not be longer than " + types.String(MaxExternalIdLength) + " characters")
+	}
+	if !externalIdReg.MatchString(externalId) {
+		return errors.New("'externalId' should only contain letters, digits and '_.:/@-'")
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils
+
+import (
+	"database/sql"
+
+	"github.com/iwind/TeaGo/maps"
+)
+
+// ComposePoolStatsValue 连接池监控数值
+// 等待次数和等待时间为两次统计之间的差值，其余为当前值
+func ComposePoolStatsValue(stats sql.DBStats, lastStats sql.DBStats) maps.Map {
+	var usage float64
+	if stats.MaxOpenConnections > 0 {
+		usage = float64(stats.InUse) * 100 / float64(stats.MaxOpenConnections)
+	}
+
+	var waitCount = stats.WaitCount - lastStats.WaitCount
+	var waitDuration = stats.WaitDuration - lastStats.WaitDuration
+	if waitCount < 0 || waitDuration < 0 {
+		waitCount = stats.WaitCount
+		waitDuration = stats.WaitDuration
+	}
+
+	return maps.Map{
+		"maxOpen":           stats.MaxOpenConnections,
+		"open":              stats.OpenConnections,
+		"inUse":             stats.InUse,
+		"idle":              stats.Idle,
+		"usage":             usage,
+		"waitCount":         waitCount,
+		"waitDurationMs":    waitDuration.Milliseconds(),
+		"maxIdleClosed":     stats.MaxIdleClosed - lastStats.MaxIdleClosed,
+		"maxIdleTimeClosed": stats.MaxIdleTimeClosed - lastStats.MaxIdleTimeClosed,
+		"maxLifetimeClosed": stats.MaxLifetimeClosed - lastStats.MaxLifetimeClosed,
+	}
+}
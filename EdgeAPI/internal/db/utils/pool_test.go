@@ -0,0 +1,32 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestComposePoolStatsValue(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var value = dbutils.ComposePoolStatsValue(sql.DBStats{
+		MaxOpenConnections: 128,
+		OpenConnections:    40,
+		InUse:              32,
+		Idle:               8,
+		WaitCount:          15,
+		WaitDuration:       3 * time.Second,
+	}, sql.DBStats{
+		WaitCount:    5,
+		WaitDuration: 1 * time.Second,
+	})
+	t.Log(value)
+	a.IsTrue(value.GetFloat64("usage") == 25)
+	a.IsTrue(value.GetInt64("waitCount") == 10)
+	a.IsTrue(value.GetInt64("waitDurationMs") == 2000)
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/logs"
+)
+
+var SharedReplicaManager = NewReplicaManager()
+
+// 只读副本
+type replicaDB struct {
+	dsn       string
+	db        *dbs.DB
+	isHealthy atomic.Bool
+}
+
+// ReplicaManager 只读副本管理
+// 列表、统计等耗时的只读查询可以分流到只读副本上，写入和事务中的查询仍然使用主库
+type ReplicaManager struct {
+	replicas []*replicaDB
+	index    atomic.Uint64
+
+	locker sync.RWMutex
+}
+
+func NewReplicaManager() *ReplicaManager {
+	return &ReplicaManager{}
+}
+
+// Update 更新只读副本DSN列表
+// DSN格式和MySQL驱动一致，比如 user:password@tcp(127.0.0.1:3306)/db_edge?charset=utf8mb4&timeout=30s
+func (this *ReplicaManager) Update(dsnList []string) {
+	this.locker.Lock()
+
+	var oldReplicaMap = map[string]*replicaDB{}
+	for _, replica := range this.replicas {
+		oldReplicaMap[replica.dsn] = replica
+	}
+
+	var newReplicas = []*replicaDB{}
+	for _, dsn := range dsnList {
+		if len(dsn) == 0 {
+			continue
+		}
+		oldReplica, ok := oldReplicaMap[dsn]
+		if ok {
+			newReplicas = append(newReplicas, oldReplica)
+			delete(oldReplicaMap, dsn)
+			continue
+		}
+
+		db, err := dbs.NewInstanceFromConfig(&dbs.DBConfig{
+			Driver: "mysql",
+			Dsn:    dsn,
+			Prefix: "edge",
+		})
+		if err != nil {
+			logs.Println("[DB_REPLICA]open replica failed: " + err.Error())
+			continue
+		}
+		newReplicas = append(newReplicas, &replicaDB{
+			dsn: dsn,
+			db:  db,
+		})
+	}
+	this.replicas = newReplicas
+	this.locker.Unlock()
+
+	// 关闭已经删除的副本
+	for _, replica := range oldReplicaMap {
+		_ = replica.db.Close()
+	}
+
+	this.Check()
+}
+
+// Check 检查所有副本是否可用
+func (this *ReplicaManager) Check() {
+	this.locker.RLock()
+	var replicas = this.replicas
+	this.locker.RUnlock()
+
+	for _, replica := range replicas {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err := replica.db.Raw().PingContext(ctx)
+		cancel()
+
+		var wasHealthy = replica.isHealthy.Load()
+		replica.isHealthy.Store(err == nil)
+		if err != nil && wasHealthy {
+			logs.Println("[DB_REPLICA]replica is unavailable: " + err.Error())
+		}
+	}
+}
+
+// Start 定时检查副本
+func (this *ReplicaManager) Start() {
+	var ticker = time.NewTicker(30 * time.Second)
+	for range ticker.C {
+		this.Check()
+	}
+}
+
+// Pick 轮流选择一个可用的副本，没有可用的副本时返回nil
+func (this *ReplicaManager) Pick() *dbs.DB {
+	this.locker.RLock()
+	defer this.locker.RUnlock()
+
+	var count = len(this.replicas)
+	if count == 0 {
+		return nil
+	}
+
+	var start = this.index.Add(1)
+	for i := 0; i < count; i++ {
+		var replica = this.replicas[(start+uint64(i))%uint64(count)]
+		if replica.isHealthy.Load() {
+			return replica.db
+		}
+	}
+	return nil
+}
+
+// Count 副本数量
+func (this *ReplicaManager) Count() int {
+	this.locker.RLock()
+	defer this.locker.RUnlock()
+	return len(this.replicas)
+}
+
+// NewReadQuery 构造只读查询
+// 不在事务中、并且表格在主库中时，优先使用可用的只读副本
+func NewReadQuery(tx *dbs.Tx, dao dbs.DAOWrapper) *dbs.Query {
+	var query = dao.Object().Query(tx)
+	if tx != nil {
+		return query
+	}
+
+	// 只分流主库中的表格，访问日志数据库节点等不在此列
+	var instance = dao.Object().Instance
+	if instance != nil {
+		defaultDB, err := dbs.Default()
+		if err != nil || defaultDB != instance {
+			return query
+		}
+	}
+
+	var replica = SharedReplicaManager.Pick()
+	if replica != nil {
+		query.DB(replica)
+	}
+	return query
+}
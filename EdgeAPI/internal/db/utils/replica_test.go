@@ -0,0 +1,25 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils_test
+
+import (
+	"testing"
+
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestReplicaManager_Pick(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var manager = dbutils.NewReplicaManager()
+	a.IsTrue(manager.Pick() == nil)
+
+	// 不可用的副本不会被选中
+	manager.Update([]string{"root:123456@tcp(127.0.0.1:1)/db_edge?timeout=1s", ""})
+	a.IsTrue(manager.Count() == 1)
+	a.IsTrue(manager.Pick() == nil)
+
+	manager.Update(nil)
+	a.IsTrue(manager.Count() == 0)
+}
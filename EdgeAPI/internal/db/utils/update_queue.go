@@ -0,0 +1,292 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/logs"
+	"github.com/iwind/TeaGo/types"
+)
+
+// 每个事务中最多执行的更新语句数量
+const updateQueueTxSize = 500
+
+// SharedUpdateQueue 共享的异步更新队列
+// 节点心跳状态、访问时间等数据更新很频繁，但是允许短时间延迟，所以不在事务中（tx==nil）时放入队列中合并写入；
+// 在事务中时需要同步写入，防止和事务中的其他修改顺序不一致
+var SharedUpdateQueue = NewUpdateQueue(10_000, 1*time.Second)
+
+// UpdateQueue 非关键数据的异步更新队列
+// 适合节点状态、访问时间、计数器等允许短时间延迟和少量丢失的数据；同一条记录的多次更新会被合并，然后定期在同一个事务中批量写入
+type UpdateQueue struct {
+	maxSize  int
+	interval time.Duration
+
+	updateMap map[updateQueueKey]*queuedUpdate
+
+	isRunning   atomic.Bool
+	locker      sync.Mutex
+	flushLocker sync.Mutex
+}
+
+type updateQueueKey struct {
+	db    *dbs.DB
+	table string
+	pk    int64
+}
+
+// 等待写入的单条记录
+type queuedUpdate struct {
+	db      *dbs.DB
+	table   string
+	pkName  string
+	pk      int64
+	values  map[string]any   // field => value
+	deltas  map[string]int64 // field => delta
+	orderId int64
+}
+
+// NewUpdateQueue 获取新的更新队列
+// maxSize 为最多等待写入的记录数
+func NewUpdateQueue(maxSize int, interval time.Duration) *UpdateQueue {
+	if maxSize <= 0 {
+		maxSize = 10_000
+	}
+	if interval <= 0 {
+		interval = 1 * time.Second
+	}
+	return &UpdateQueue{
+		maxSize:   maxSize,
+		interval:  interval,
+		updateMap: map[updateQueueKey]*queuedUpdate{},
+	}
+}
+
+// Set 放入字段更新
+// 同一条记录的同一个字段只会写入最后一次的值；队列没有启动或者已满时返回false，调用方需要自行同步写入
+func (this *UpdateQueue) Set(db *dbs.DB, table string, pkName string, pk int64, values map[string]any) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	update, ok := this.findUpdate(db, table, pkName, pk)
+	if !ok {
+		return false
+	}
+	for field, value := range values {
+		update.values[field] = value
+		delete(update.deltas, field)
+	}
+	return true
+}
+
+// Increase 放入计数器累加
+// 同一条记录的同一个字段会合并为一次累加；队列没有启动或者已满时返回false，调用方需要自行同步写入
+func (this *UpdateQueue) Increase(db *dbs.DB, table string, pkName string, pk int64, field string, delta int64) bool {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	update, ok := this.findUpdate(db, table, pkName, pk)
+	if !ok {
+		return false
+	}
+
+	// 已经设置了新值的字段，直接在新值上累加
+	value, hasValue := update.values[field]
+	if hasValue {
+		update.values[field] = types.Int64(value) + delta
+		return true
+	}
+	update.deltas[field] += delta
+	return true
+}
+
+// Remove 删除等待写入的字段，并等待正在进行的写入结束
+// 同步写入同一个字段之前需要调用，防止队列中旧的数据在之后覆盖新写入的数据
+func (this *UpdateQueue) Remove(db *dbs.DB, table string, pk int64, fields ...string) {
+	this.flushLocker.Lock()
+	defer this.flushLocker.Unlock()
+
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	var key = updateQueueKey{
+		db:    db,
+		table: table,
+		pk:    pk,
+	}
+	update, ok := this.updateMap[key]
+	if !ok {
+		return
+	}
+	for _, field := range fields {
+		delete(update.values, field)
+		delete(update.deltas, field)
+	}
+	if len(update.values) == 0 && len(update.deltas) == 0 {
+		delete(this.updateMap, key)
+	}
+}
+
+// Start 开始写入循环
+func (this *UpdateQueue) Start() {
+	if !this.isRunning.CompareAndSwap(false, true) {
+		return
+	}
+
+	var ticker = time.NewTicker(this.interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		err := this.Flush()
+		if err != nil {
+			logs.Println("[UPDATE_QUEUE]write failed: " + err.Error())
+		}
+	}
+}
+
+// IsRunning 是否已启动
+func (this *UpdateQueue) IsRunning() bool {
+	return this.isRunning.Load()
+}
+
+// Len 等待写入的记录数量
+func (this *UpdateQueue) Len() int {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return len(this.updateMap)
+}
+
+// Flush 立即写入所有等待中的数据
+// 写入失败的数据会被丢弃，并返回最后一个错误
+func (this *UpdateQueue) Flush() error {
+	this.flushLocker.Lock()
+	defer this.flushLocker.Unlock()
+
+	this.locker.Lock()
+	var updateMap = this.updateMap
+	this.updateMap = map[updateQueueKey]*queuedUpdate{}
+	this.locker.Unlock()
+
+	if len(updateMap) == 0 {
+		return nil
+	}
+
+	// 按数据库分组，并保持放入的顺序
+	var dbUpdates = map[*dbs.DB][]*queuedUpdate{}
+	var dbList = []*dbs.DB{}
+	for _, update := range updateMap {
+		_, ok := dbUpdates[update.db]
+		if !ok {
+			dbList = append(dbList, update.db)
+		}
+		dbUpdates[update.db] = append(dbUpdates[update.db], update)
+	}
+
+	var lastErr error
+	for _, db := range dbList {
+		var updates = dbUpdates[db]
+		sort.Slice(updates, func(i, j int) bool {
+			return updates[i].orderId < updates[j].orderId
+		})
+		for len(updates) > 0 {
+			var size = updateQueueTxSize
+			if size > len(updates) {
+				size = len(updates)
+			}
+			err := this.writeUpdates(db, updates[:size])
+			if err != nil {
+				lastErr = err
+			}
+			updates = updates[size:]
+		}
+	}
+	return lastErr
+}
+
+// 在同一个事务中写入
+func (this *UpdateQueue) writeUpdates(db *dbs.DB, updates []*queuedUpdate) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, update := range updates {
+		sqlString, args := update.compose()
+		_, err = tx.Exec(sqlString, args...)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// 查找或创建等待写入的记录
+// 调用者需要加锁
+func (this *UpdateQueue) findUpdate(db *dbs.DB, table string, pkName string, pk int64) (*queuedUpdate, bool) {
+	if db == nil || !this.isRunning.Load() {
+		return nil, false
+	}
+
+	var key = updateQueueKey{
+		db:    db,
+		table: table,
+		pk:    pk,
+	}
+	update, ok := this.updateMap[key]
+	if ok {
+		return update, true
+	}
+	if len(this.updateMap) >= this.maxSize {
+		return nil, false
+	}
+
+	update = &queuedUpdate{
+		db:      db,
+		table:   table,
+		pkName:  pkName,
+		pk:      pk,
+		values:  map[string]any{},
+		deltas:  map[string]int64{},
+		orderId: updateQueueOrderId.Add(1),
+	}
+	this.updateMap[key] = update
+	return update, true
+}
+
+var updateQueueOrderId atomic.Int64
+
+// 组合更新语句
+func (this *queuedUpdate) compose() (sqlString string, args []any) {
+	var fields = []string{}
+	for field := range this.values {
+		fields = append(fields, field)
+	}
+	for field := range this.deltas {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var pieces = []string{}
+	for _, field := range fields {
+		value, ok := this.values[field]
+		if ok {
+			pieces = append(pieces, "`"+field+"`=?")
+			args = append(args, value)
+		} else {
+			pieces = append(pieces, "`"+field+"`=`"+field+"`+?")
+			args = append(args, this.deltas[field])
+		}
+	}
+	args = append(args, this.pk)
+	return "UPDATE `" + this.table + "` SET " + strings.Join(pieces, ", ") + " WHERE `" + this.pkName + "`=?", args
+}
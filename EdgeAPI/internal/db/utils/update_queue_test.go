@@ -0,0 +1,136 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+func TestUpdateQueue_Flush(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	db, err := dbs.NewInstanceFromConfig(&dbs.DBConfig{
+		Driver: sqlite.DriverName,
+		Dsn:    sqlite.ComposeDSN(filepath.Join(t.TempDir(), "edge.db")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err = db.Exec("CREATE TABLE `edgeNodes` (`id` INTEGER PRIMARY KEY AUTOINCREMENT, `status` TEXT, `countUp` INTEGER DEFAULT '0')")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("INSERT INTO `edgeNodes` (`id`, `status`, `countUp`) VALUES (1, '', 10), (2, '', 0)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var queue = dbutils.NewUpdateQueue(2, 1*time.Hour)
+
+	// 没有启动时需要同步写入
+	a.IsFalse(queue.Set(db, "edgeNodes", "id", 1, map[string]any{"status": "a"}))
+
+	go queue.Start()
+	for !queue.IsRunning() {
+		time.Sleep(1 * time.Millisecond)
+	}
+
+	a.IsTrue(queue.Set(db, "edgeNodes", "id", 1, map[string]any{"status": "a"}))
+	a.IsTrue(queue.Set(db, "edgeNodes", "id", 1, map[string]any{"status": "b"}))
+	a.IsTrue(queue.Increase(db, "edgeNodes", "id", 1, "countUp", 1))
+	a.IsTrue(queue.Increase(db, "edgeNodes", "id", 1, "countUp", 2))
+	a.IsTrue(queue.Set(db, "edgeNodes", "id", 2, map[string]any{"countUp": 5}))
+	a.IsTrue(queue.Increase(db, "edgeNodes", "id", 2, "countUp", 1))
+
+	// 队列已满
+	a.IsFalse(queue.Set(db, "edgeNodes", "id", 3, map[string]any{"status": "c"}))
+	a.IsTrue(queue.Len() == 2)
+
+	err = queue.Flush()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(queue.Len() == 0)
+
+	one, err := db.FindOne("SELECT `status`, `countUp` FROM `edgeNodes` WHERE `id`=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(one.GetString("status") == "b")
+	a.IsTrue(types.Int64(one.Get("countUp")) == 13)
+
+	countUp, err := db.FindCol(0, "SELECT `countUp` FROM `edgeNodes` WHERE `id`=2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(types.Int64(countUp) == 6)
+}
+
+func TestUpdateQueue_Remove(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	db, err := dbs.NewInstanceFromConfig(&dbs.DBConfig{
+		Driver: sqlite.DriverName,
+		Dsn:    sqlite.ComposeDSN(filepath.Join(t.TempDir(), "edge.db")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err = db.Exec("CREATE TABLE `edgeNodes` (`id` INTEGER PRIMARY KEY AUTOINCREMENT, `status` TEXT, `countUp` INTEGER DEFAULT '0')")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("INSERT INTO `edgeNodes` (`id`, `status`, `countUp`) VALUES (1, '', 0), (2, '', 0)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var queue = dbutils.NewUpdateQueue(10, 1*time.Hour)
+	go queue.Start()
+	for !queue.IsRunning() {
+		time.Sleep(1 * time.Millisecond)
+	}
+
+	a.IsTrue(queue.Set(db, "edgeNodes", "id", 1, map[string]any{"status": "old"}))
+	a.IsTrue(queue.Set(db, "edgeNodes", "id", 2, map[string]any{"status": "old"}))
+	a.IsTrue(queue.Increase(db, "edgeNodes", "id", 2, "countUp", 1))
+
+	// 同步写入新的状态
+	queue.Remove(db, "edgeNodes", 1, "status")
+	queue.Remove(db, "edgeNodes", 2, "status")
+	_, err = db.Exec("UPDATE `edgeNodes` SET `status`='new'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(queue.Len() == 1)
+
+	err = queue.Flush()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ones, _, err := db.FindOnes("SELECT `status`, `countUp` FROM `edgeNodes` ORDER BY `id` ASC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(len(ones) == 2)
+	a.IsTrue(ones[0].GetString("status") == "new")
+	a.IsTrue(ones[1].GetString("status") == "new")
+	a.IsTrue(types.Int64(ones[1].Get("countUp")) == 1)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
 	executils "github.com/TeaOSLab/EdgeAPI/internal/utils/exec"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/dbs"
@@ -47,8 +48,19 @@ func QuoteLikeSuffix(keyword string) string {
 	return "%" + QuoteLikeKeyword(keyword)
 }
 
+// IsSQLite 检查数据库是否为SQLite
+func IsSQLite(db *dbs.DB) bool {
+	if db == nil {
+		return false
+	}
+	return db.Driver() == sqlite.DriverName
+}
+
 // SetGlobalVarMin 设置变量最小值
 func SetGlobalVarMin(db *dbs.DB, variableName string, minValue int) error {
+	if IsSQLite(db) {
+		return nil
+	}
 	result, err := db.FindOne("SHOW VARIABLES WHERE variable_name=?", variableName)
 	if err != nil {
 		return err
@@ -66,6 +78,9 @@ func SetGlobalVarMin(db *dbs.DB, variableName string, minValue int) error {
 
 // SetGlobalVarMax 设置变量最大值
 func SetGlobalVarMax(db *dbs.DB, variableName string, maxValue int) error {
+	if IsSQLite(db) {
+		return nil
+	}
 	result, err := db.FindOne("SHOW VARIABLES WHERE variable_name=?", variableName)
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@
 package dbutils_test
 
 import (
+	"strings"
 	"testing"
 
 	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
@@ -43,4 +44,14 @@ func TestFindMySQLPath(t *testing.T) {
 
 func TestStartLocalMySQL(t *testing.T) {
 	dbutils.StartLocalMySQL()
-}
\ No newline at end of file
+}
+
+func TestValidateExternalId(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsNil(dbutils.ValidateExternalId(""))
+	a.IsNil(dbutils.ValidateExternalId("tf-server-1"))
+	a.IsNil(dbutils.ValidateExternalId("prod/example.com:443"))
+	a.IsNotNil(dbutils.ValidateExternalId("a b"))
+	a.IsNotNil(dbutils.ValidateExternalId("中文"))
+	a.IsNotNil(dbutils.ValidateExternalId(strings.Repeat("a", dbutils.MaxExternalIdLength+1)))
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// MaxInParams 单个IN条件中最多使用的参数数量，超出时拆分成多个条件
+const MaxInParams = 1000
+
+// WhereIn 为查询添加 field IN (...) 条件，使用占位符代替拼接的值
+// paramName 为参数名前缀，在同一个查询中不能重复；因为框架会按SQL缓存解析结果，所以不要使用随机的参数名；values 为空时查询不到任何数据
+func WhereIn[T int64 | int32 | string](query *dbs.Query, field string, paramName string, values []T) *dbs.Query {
+	if len(values) == 0 {
+		return query.Where("1=0")
+	}
+	return query.Where(composeInCondition(query, field, "IN", " OR ", paramName, values))
+}
+
+// WhereNotIn 为查询添加 field NOT IN (...) 条件，使用占位符代替拼接的值
+// values 为空时不添加任何条件，其他参考 WhereIn()
+func WhereNotIn[T int64 | int32 | string](query *dbs.Query, field string, paramName string, values []T) *dbs.Query {
+	if len(values) == 0 {
+		return query
+	}
+	return query.Where(composeInCondition(query, field, "NOT IN", " AND ", paramName, values))
+}
+
+func composeInCondition[T int64 | int32 | string](query *dbs.Query, field string, op string, joiner string, paramName string, values []T) string {
+	var conditions = []string{}
+	for start := 0; start < len(values); start += MaxInParams {
+		var end = start + MaxInParams
+		if end > len(values) {
+			end = len(values)
+		}
+
+		var placeholders = make([]string, 0, end-start)
+		for index := start; index < end; index++ {
+			var name = paramName + "_" + strconv.Itoa(index)
+			query.Param(name, values[index])
+			placeholders = append(placeholders, ":"+name)
+		}
+		conditions = append(conditions, field+" "+op+" ("+strings.Join(placeholders, ", ")+")")
+	}
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+	return "(" + strings.Join(conditions, joiner) + ")"
+}
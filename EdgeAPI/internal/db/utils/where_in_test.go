@@ -0,0 +1,98 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dbutils_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func TestWhereIn(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	db, err := dbs.NewInstanceFromConfig(&dbs.DBConfig{
+		Driver: sqlite.DriverName,
+		Dsn:    sqlite.ComposeDSN(filepath.Join(t.TempDir(), "edge.db")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err = db.Exec("CREATE TABLE `edgeTasks` (`id` INTEGER PRIMARY KEY AUTOINCREMENT, `name` TEXT NOT NULL DEFAULT '')")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rows = [][]any{}
+	for i := 0; i < 3000; i++ {
+		rows = append(rows, []any{"task"})
+	}
+	_, err = dbutils.BatchInsert(db, "edgeTasks", []string{"name"}, rows, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var newQuery = func() *dbs.Query {
+		return dbs.NewQuery(nil).DB(db).Table("edgeTasks")
+	}
+
+	// 超过单个条件的参数数量
+	var ids = []int64{}
+	for i := 1; i <= dbutils.MaxInParams*2+10; i++ {
+		ids = append(ids, int64(i))
+	}
+
+	{
+		count, err := dbutils.WhereIn(newQuery(), "id", "ids", ids).Count()
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.IsTrue(count == int64(len(ids)))
+	}
+
+	{
+		count, err := dbutils.WhereNotIn(newQuery(), "id", "ids", ids).Count()
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.IsTrue(count == int64(3000-len(ids)))
+	}
+
+	{
+		count, err := dbutils.WhereIn(newQuery(), "id", "ids", []int64{}).Count()
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.IsTrue(count == 0)
+	}
+
+	{
+		count, err := dbutils.WhereNotIn(newQuery(), "id", "ids", []int64{}).Count()
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.IsTrue(count == 3000)
+	}
+
+	// 字符串
+	{
+		count, err := dbutils.WhereIn(newQuery(), "name", "names", []string{"task", "' OR 1=1"}).Count()
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.IsTrue(count == 3000)
+
+		count, err = dbutils.WhereNotIn(newQuery(), "name", "names", []string{"' OR 1=1"}).Count()
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.IsTrue(count == 3000)
+	}
+}
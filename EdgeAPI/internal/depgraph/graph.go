@@ -0,0 +1,220 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package depgraph
+
+import (
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+type NodeType = string
+
+const (
+	NodeTypeServer             NodeType = "server"             // 网站
+	NodeTypeServerGroup        NodeType = "serverGroup"        // 网站分组
+	NodeTypeUser               NodeType = "user"               // 用户
+	NodeTypeSSLPolicy          NodeType = "sslPolicy"          // SSL策略
+	NodeTypeSSLCert            NodeType = "sslCert"            // SSL证书
+	NodeTypeACMETask           NodeType = "acmeTask"           // ACME任务
+	NodeTypeACMEUser           NodeType = "acmeUser"           // ACME用户
+	NodeTypeDNSProvider        NodeType = "dnsProvider"        // DNS服务商
+	NodeTypeDNSDomain          NodeType = "dnsDomain"          // DNS域名
+	NodeTypeNodeCluster        NodeType = "nodeCluster"        // 集群
+	NodeTypeNode               NodeType = "node"               // 边缘节点
+	NodeTypeHTTPFirewallPolicy NodeType = "httpFirewallPolicy" // WAF策略
+	NodeTypeHTTPCachePolicy    NodeType = "httpCachePolicy"    // 缓存策略
+)
+
+// AllNodeTypes 所有支持的对象类型
+func AllNodeTypes() []NodeType {
+	return []NodeType{
+		NodeTypeServer,
+		NodeTypeServerGroup,
+		NodeTypeUser,
+		NodeTypeSSLPolicy,
+		NodeTypeSSLCert,
+		NodeTypeACMETask,
+		NodeTypeACMEUser,
+		NodeTypeDNSProvider,
+		NodeTypeDNSDomain,
+		NodeTypeNodeCluster,
+		NodeTypeNode,
+		NodeTypeHTTPFirewallPolicy,
+		NodeTypeHTTPCachePolicy,
+	}
+}
+
+const (
+	DefaultMaxDepth = 2
+	MaxDepth        = 5
+	DefaultMaxNodes = 200
+	MaxNodes        = 2000
+
+	maxLinksPerNode = 100 // 单个对象最多展开的关联对象数量
+)
+
+// Node 图中的对象
+type Node struct {
+	Key       string   `json:"key"` // 唯一标识，格式为 类型:ID
+	Type      NodeType `json:"type"`
+	Id        int64    `json:"id"`
+	Name      string   `json:"name"`
+	Depth     int      `json:"depth"`     // 和根对象的距离
+	IsMissing bool     `json:"isMissing"` // 对象已被删除或不存在
+}
+
+// Edge 依赖关系，From 依赖于 To
+type Edge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Relation string `json:"relation"`
+}
+
+// Graph 依赖关系图
+type Graph struct {
+	Nodes       []*Node `json:"nodes"`
+	Edges       []*Edge `json:"edges"`
+	IsTruncated bool    `json:"isTruncated"` // 是否因为数量限制没有完全展开
+}
+
+// link 对象的一个关联
+type link struct {
+	Type     NodeType
+	Id       int64
+	Relation string
+	IsOut    bool // true表示当前对象依赖于关联对象，false表示关联对象依赖于当前对象
+}
+
+// loadFunc 读取对象的名称和关联
+type loadFunc func(tx *dbs.Tx, nodeType NodeType, id int64) (name string, found bool, links []*link, err error)
+
+// Builder 依赖关系图构造器
+type Builder struct {
+	tx       *dbs.Tx
+	maxDepth int
+	maxNodes int
+	load     loadFunc
+}
+
+func NewBuilder(tx *dbs.Tx, maxDepth int, maxNodes int) *Builder {
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	if maxDepth > MaxDepth {
+		maxDepth = MaxDepth
+	}
+	if maxNodes <= 0 {
+		maxNodes = DefaultMaxNodes
+	}
+	if maxNodes > MaxNodes {
+		maxNodes = MaxNodes
+	}
+	return &Builder{
+		tx:       tx,
+		maxDepth: maxDepth,
+		maxNodes: maxNodes,
+		load:     loadObject,
+	}
+}
+
+// Build 从某个对象开始，按广度优先展开依赖关系图
+func (this *Builder) Build(rootType NodeType, rootId int64) (*Graph, error) {
+	if !IsValidNodeType(rootType) {
+		return nil, errors.New("invalid object type '" + rootType + "'")
+	}
+	if rootId <= 0 {
+		return nil, errors.New("invalid object id '" + types.String(rootId) + "'")
+	}
+
+	var graph = &Graph{
+		Nodes: []*Node{},
+		Edges: []*Edge{},
+	}
+	var nodeMap = map[string]*Node{}
+	var edgeMap = map[string]bool{}
+
+	var root = &Node{
+		Key:  NodeKey(rootType, rootId),
+		Type: rootType,
+		Id:   rootId,
+	}
+	nodeMap[root.Key] = root
+	graph.Nodes = append(graph.Nodes, root)
+
+	var queue = []*Node{root}
+	for len(queue) > 0 {
+		var node = queue[0]
+		queue = queue[1:]
+
+		name, found, links, err := this.load(this.tx, node.Type, node.Id)
+		if err != nil {
+			return nil, err
+		}
+		node.Name = name
+		node.IsMissing = !found
+		if !found || node.Depth >= this.maxDepth {
+			continue
+		}
+
+		if len(links) > maxLinksPerNode {
+			links = links[:maxLinksPerNode]
+			graph.IsTruncated = true
+		}
+
+		for _, l := range links {
+			if l.Id <= 0 {
+				continue
+			}
+			var key = NodeKey(l.Type, l.Id)
+			_, exists := nodeMap[key]
+			if !exists {
+				if len(graph.Nodes) >= this.maxNodes {
+					graph.IsTruncated = true
+					continue
+				}
+				var linkedNode = &Node{
+					Key:   key,
+					Type:  l.Type,
+					Id:    l.Id,
+					Depth: node.Depth + 1,
+				}
+				nodeMap[key] = linkedNode
+				graph.Nodes = append(graph.Nodes, linkedNode)
+				queue = append(queue, linkedNode)
+			}
+
+			var edge = &Edge{
+				From:     node.Key,
+				To:       key,
+				Relation: l.Relation,
+			}
+			if !l.IsOut {
+				edge.From, edge.To = key, node.Key
+			}
+			var edgeKey = edge.From + "->" + edge.To + "@" + edge.Relation
+			if edgeMap[edgeKey] {
+				continue
+			}
+			edgeMap[edgeKey] = true
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+
+	return graph, nil
+}
+
+// NodeKey 对象唯一标识
+func NodeKey(nodeType NodeType, id int64) string {
+	return nodeType + ":" + types.String(id)
+}
+
+// IsValidNodeType 检查对象类型是否支持
+func IsValidNodeType(nodeType NodeType) bool {
+	for _, t := range AllNodeTypes() {
+		if t == nodeType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package depgraph
+
+import (
+	"testing"
+
+	"github.com/iwind/TeaGo/dbs"
+)
+
+type testObject struct {
+	name  string
+	links []*link
+}
+
+func newTestBuilder(maxDepth int, maxNodes int, objects map[string]*testObject) *Builder {
+	var builder = NewBuilder(nil, maxDepth, maxNodes)
+	builder.load = func(tx *dbs.Tx, nodeType NodeType, id int64) (name string, found bool, links []*link, err error) {
+		object, ok := objects[NodeKey(nodeType, id)]
+		if !ok {
+			return "", false, nil, nil
+		}
+		return object.name, true, object.links, nil
+	}
+	return builder
+}
+
+func testObjects() map[string]*testObject {
+	return map[string]*testObject{
+		"server:1": {name: "example.com", links: []*link{
+			{Type: NodeTypeNodeCluster, Id: 1, Relation: RelationUsesCluster, IsOut: true},
+			{Type: NodeTypeSSLPolicy, Id: 1, Relation: RelationUsesSSLPolicy, IsOut: true},
+			{Type: NodeTypeSSLPolicy, Id: 1, Relation: RelationUsesSSLPolicy, IsOut: true}, // HTTPS和TLS使用同一个策略
+		}},
+		"sslPolicy:1": {name: "policy", links: []*link{
+			{Type: NodeTypeSSLCert, Id: 1, Relation: RelationUsesCert, IsOut: true},
+			{Type: NodeTypeServer, Id: 1, Relation: RelationUsesSSLPolicy},
+		}},
+		"sslCert:1": {name: "cert", links: []*link{
+			{Type: NodeTypeACMETask, Id: 1, Relation: RelationIssuedBy, IsOut: true},
+			{Type: NodeTypeSSLPolicy, Id: 1, Relation: RelationUsesCert},
+		}},
+		"acmeTask:1": {name: "example.com", links: []*link{
+			{Type: NodeTypeDNSProvider, Id: 1, Relation: RelationUsesDNSProvider, IsOut: true},
+			{Type: NodeTypeDNSProvider, Id: 0, Relation: RelationUsesDNSAliasProvider, IsOut: true},
+		}},
+		"nodeCluster:1": {name: "cluster", links: []*link{
+			{Type: NodeTypeNode, Id: 1, Relation: RelationBelongsToCluster},
+			{Type: NodeTypeNode, Id: 2, Relation: RelationBelongsToCluster},
+		}},
+		"node:1": {name: "node1"},
+		// node:2 不存在
+	}
+}
+
+func TestBuilder_Build(t *testing.T) {
+	graph, err := newTestBuilder(MaxDepth, 0, testObjects()).Build(NodeTypeServer, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var nodeMap = map[string]*Node{}
+	for _, node := range graph.Nodes {
+		nodeMap[node.Key] = node
+	}
+	if len(nodeMap) != len(graph.Nodes) {
+		t.Fatal("duplicate nodes")
+	}
+	if len(graph.Nodes) != 8 {
+		t.Fatal("expect 8 nodes, but got", len(graph.Nodes))
+	}
+	if nodeMap["acmeTask:1"].Depth != 3 {
+		t.Fatal("expect depth 3, but got", nodeMap["acmeTask:1"].Depth)
+	}
+	if !nodeMap["node:2"].IsMissing || nodeMap["node:1"].IsMissing {
+		t.Fatal("wrong missing status")
+	}
+	if graph.IsTruncated {
+		t.Fatal("should not be truncated")
+	}
+
+	var edgeMap = map[string]bool{}
+	for _, edge := range graph.Edges {
+		var key = edge.From + "->" + edge.To
+		if edgeMap[key] {
+			t.Fatal("duplicate edge:", key)
+		}
+		edgeMap[key] = true
+	}
+	for _, key := range []string{
+		"server:1->sslPolicy:1",
+		"sslPolicy:1->sslCert:1",
+		"sslCert:1->acmeTask:1",
+		"node:2->nodeCluster:1",
+	} {
+		if !edgeMap[key] {
+			t.Fatal("missing edge:", key)
+		}
+	}
+	if len(graph.Edges) != 7 {
+		t.Fatal("expect 7 edges, but got", len(graph.Edges))
+	}
+}
+
+func TestBuilder_Build_Depth(t *testing.T) {
+	graph, err := newTestBuilder(1, 0, testObjects()).Build(NodeTypeServer, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(graph.Nodes) != 3 {
+		t.Fatal("expect 3 nodes, but got", len(graph.Nodes))
+	}
+	for _, node := range graph.Nodes {
+		if node.Depth > 1 {
+			t.Fatal("node '" + node.Key + "' exceeds max depth")
+		}
+	}
+}
+
+func TestBuilder_Build_MaxNodes(t *testing.T) {
+	graph, err := newTestBuilder(MaxDepth, 4, testObjects()).Build(NodeTypeServer, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(graph.Nodes) != 4 {
+		t.Fatal("expect 4 nodes, but got", len(graph.Nodes))
+	}
+	if !graph.IsTruncated {
+		t.Fatal("should be truncated")
+	}
+}
+
+func TestBuilder_Build_Invalid(t *testing.T) {
+	var builder = newTestBuilder(0, 0, testObjects())
+	_, err := builder.Build("unknown", 1)
+	if err == nil {
+		t.Fatal("should fail with unknown type")
+	}
+	_, err = builder.Build(NodeTypeServer, 0)
+	if err == nil {
+		t.Fatal("should fail with invalid id")
+	}
+}
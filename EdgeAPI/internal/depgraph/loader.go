@@ -0,0 +1,332 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package depgraph
+
+import (
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	acmemodels "github.com/TeaOSLab/EdgeAPI/internal/db/models/acme"
+	dnsmodels "github.com/TeaOSLab/EdgeAPI/internal/db/models/dns"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/firewallconfigs"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+const (
+	RelationUsesCluster          = "usesCluster"          // 网站部署在集群上
+	RelationOwnedBy              = "ownedBy"              // 属于用户
+	RelationInGroup              = "inGroup"              // 属于网站分组
+	RelationUsesSSLPolicy        = "usesSSLPolicy"        // 使用SSL策略
+	RelationUsesCert             = "usesCert"             // SSL策略使用证书
+	RelationIssuedBy             = "issuedBy"             // 证书由ACME任务申请
+	RelationUsesACMEUser         = "usesACMEUser"         // ACME任务使用ACME用户
+	RelationUsesDNSProvider      = "usesDNSProvider"      // 使用DNS服务商
+	RelationUsesDNSAliasProvider = "usesDNSAliasProvider" // ACME任务的DNS别名域名使用DNS服务商
+	RelationUsesDNSDomain        = "usesDNSDomain"        // 集群使用DNS域名
+	RelationBelongsToCluster     = "belongsToCluster"     // 节点属于集群
+	RelationUsesFirewallPolicy   = "usesFirewallPolicy"   // 使用WAF策略
+	RelationUsesCachePolicy      = "usesCachePolicy"      // 使用缓存策略
+)
+
+// 从数据库中读取对象的名称和关联
+func loadObject(tx *dbs.Tx, nodeType NodeType, id int64) (name string, found bool, links []*link, err error) {
+	switch nodeType {
+	case NodeTypeServer:
+		return loadServer(tx, id)
+	case NodeTypeServerGroup:
+		return loadServerGroup(tx, id)
+	case NodeTypeUser:
+		user, err := models.SharedUserDAO.FindEnabledUser(tx, id, nil)
+		if err != nil || user == nil {
+			return "", false, nil, err
+		}
+		return user.Username, true, nil, nil
+	case NodeTypeSSLPolicy:
+		return loadSSLPolicy(tx, id)
+	case NodeTypeSSLCert:
+		return loadSSLCert(tx, id)
+	case NodeTypeACMETask:
+		return loadACMETask(tx, id)
+	case NodeTypeACMEUser:
+		acmeUser, err := acmemodels.SharedACMEUserDAO.FindEnabledACMEUser(tx, id)
+		if err != nil || acmeUser == nil {
+			return "", false, nil, err
+		}
+		return acmeUser.Email, true, nil, nil
+	case NodeTypeDNSProvider:
+		return loadDNSProvider(tx, id)
+	case NodeTypeDNSDomain:
+		return loadDNSDomain(tx, id)
+	case NodeTypeNodeCluster:
+		return loadNodeCluster(tx, id)
+	case NodeTypeNode:
+		return loadNode(tx, id)
+	case NodeTypeHTTPFirewallPolicy:
+		return loadHTTPFirewallPolicy(tx, id)
+	case NodeTypeHTTPCachePolicy:
+		return loadHTTPCachePolicy(tx, id)
+	}
+	return "", false, nil, nil
+}
+
+func loadServer(tx *dbs.Tx, serverId int64) (name string, found bool, links []*link, err error) {
+	server, err := models.SharedServerDAO.FindEnabledServer(tx, serverId)
+	if err != nil || server == nil {
+		return "", false, nil, err
+	}
+
+	links = append(links,
+		&link{Type: NodeTypeNodeCluster, Id: int64(server.ClusterId), Relation: RelationUsesCluster, IsOut: true},
+		&link{Type: NodeTypeUser, Id: int64(server.UserId), Relation: RelationOwnedBy, IsOut: true},
+	)
+	for _, groupId := range server.DecodeGroupIds() {
+		links = append(links, &link{Type: NodeTypeServerGroup, Id: groupId, Relation: RelationInGroup, IsOut: true})
+	}
+
+	var httpsConfig = server.DecodeHTTPS()
+	if httpsConfig != nil && httpsConfig.SSLPolicyRef != nil {
+		links = append(links, &link{Type: NodeTypeSSLPolicy, Id: httpsConfig.SSLPolicyRef.SSLPolicyId, Relation: RelationUsesSSLPolicy, IsOut: true})
+	}
+	var tlsConfig = server.DecodeTLS()
+	if tlsConfig != nil && tlsConfig.SSLPolicyRef != nil {
+		links = append(links, &link{Type: NodeTypeSSLPolicy, Id: tlsConfig.SSLPolicyRef.SSLPolicyId, Relation: RelationUsesSSLPolicy, IsOut: true})
+	}
+
+	// 网站单独设置的WAF策略
+	if server.WebId > 0 {
+		web, err := models.SharedHTTPWebDAO.FindEnabledHTTPWeb(tx, int64(server.WebId))
+		if err != nil {
+			return "", false, nil, err
+		}
+		if web != nil && models.IsNotNull(web.Firewall) {
+			var firewallRef = &firewallconfigs.HTTPFirewallRef{}
+			err = json.Unmarshal(web.Firewall, firewallRef)
+			if err == nil && firewallRef.IsOn {
+				links = append(links, &link{Type: NodeTypeHTTPFirewallPolicy, Id: firewallRef.FirewallPolicyId, Relation: RelationUsesFirewallPolicy, IsOut: true})
+			}
+		}
+	}
+
+	return server.Name, true, links, nil
+}
+
+func loadServerGroup(tx *dbs.Tx, groupId int64) (name string, found bool, links []*link, err error) {
+	group, err := models.SharedServerGroupDAO.FindEnabledServerGroup(tx, groupId)
+	if err != nil || group == nil {
+		return "", false, nil, err
+	}
+
+	serverIds, err := models.SharedServerDAO.FindAllEnabledServerIdsWithGroupId(tx, groupId)
+	if err != nil {
+		return "", false, nil, err
+	}
+	for _, serverId := range serverIds {
+		links = append(links, &link{Type: NodeTypeServer, Id: serverId, Relation: RelationInGroup})
+	}
+	return group.Name, true, links, nil
+}
+
+func loadSSLPolicy(tx *dbs.Tx, policyId int64) (name string, found bool, links []*link, err error) {
+	policy, err := models.SharedSSLPolicyDAO.FindEnabledSSLPolicy(tx, policyId)
+	if err != nil || policy == nil {
+		return "", false, nil, err
+	}
+
+	for _, certRef := range policy.DecodeCerts() {
+		links = append(links, &link{Type: NodeTypeSSLCert, Id: certRef.CertId, Relation: RelationUsesCert, IsOut: true})
+	}
+
+	servers, err := models.SharedServerDAO.FindAllEnabledServersWithSSLPolicyIds(tx, []int64{policyId})
+	if err != nil {
+		return "", false, nil, err
+	}
+	for _, server := range servers {
+		links = append(links, &link{Type: NodeTypeServer, Id: int64(server.Id), Relation: RelationUsesSSLPolicy})
+	}
+
+	return "SSL策略 #" + types.String(policyId), true, links, nil
+}
+
+func loadSSLCert(tx *dbs.Tx, certId int64) (name string, found bool, links []*link, err error) {
+	cert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, certId)
+	if err != nil || cert == nil {
+		return "", false, nil, err
+	}
+
+	if cert.AcmeTaskId > 0 {
+		links = append(links, &link{Type: NodeTypeACMETask, Id: int64(cert.AcmeTaskId), Relation: RelationIssuedBy, IsOut: true})
+	}
+
+	policyIds, err := models.SharedSSLPolicyDAO.FindAllEnabledPolicyIdsWithCertId(tx, certId)
+	if err != nil {
+		return "", false, nil, err
+	}
+	for _, policyId := range policyIds {
+		links = append(links, &link{Type: NodeTypeSSLPolicy, Id: policyId, Relation: RelationUsesCert})
+	}
+
+	return cert.Name, true, links, nil
+}
+
+func loadACMETask(tx *dbs.Tx, taskId int64) (name string, found bool, links []*link, err error) {
+	task, err := acmemodels.SharedACMETaskDAO.FindEnabledACMETask(tx, taskId)
+	if err != nil || task == nil {
+		return "", false, nil, err
+	}
+
+	links = append(links,
+		&link{Type: NodeTypeACMEUser, Id: int64(task.AcmeUserId), Relation: RelationUsesACMEUser, IsOut: true},
+		&link{Type: NodeTypeDNSProvider, Id: int64(task.DnsProviderId), Relation: RelationUsesDNSProvider, IsOut: true},
+		&link{Type: NodeTypeDNSProvider, Id: int64(task.DnsAliasProviderId), Relation: RelationUsesDNSAliasProvider, IsOut: true},
+		&link{Type: NodeTypeSSLCert, Id: int64(task.CertId), Relation: RelationIssuedBy},
+	)
+
+	return acmeTaskName(task), true, links, nil
+}
+
+func loadDNSProvider(tx *dbs.Tx, providerId int64) (name string, found bool, links []*link, err error) {
+	provider, err := dnsmodels.SharedDNSProviderDAO.FindEnabledDNSProvider(tx, providerId)
+	if err != nil || provider == nil {
+		return "", false, nil, err
+	}
+
+	domains, err := dnsmodels.SharedDNSDomainDAO.FindAllEnabledDomainsWithProviderId(tx, providerId)
+	if err != nil {
+		return "", false, nil, err
+	}
+	for _, domain := range domains {
+		links = append(links, &link{Type: NodeTypeDNSDomain, Id: int64(domain.Id), Relation: RelationUsesDNSProvider})
+	}
+
+	tasks, err := acmemodels.SharedACMETaskDAO.FindAllEnabledTasksWithDNSProviderId(tx, providerId, maxLinksPerNode)
+	if err != nil {
+		return "", false, nil, err
+	}
+	for _, task := range tasks {
+		links = append(links, &link{Type: NodeTypeACMETask, Id: int64(task.Id), Relation: RelationUsesDNSProvider})
+	}
+
+	return provider.Name, true, links, nil
+}
+
+func loadDNSDomain(tx *dbs.Tx, domainId int64) (name string, found bool, links []*link, err error) {
+	domain, err := dnsmodels.SharedDNSDomainDAO.FindEnabledDNSDomain(tx, domainId, nil)
+	if err != nil || domain == nil {
+		return "", false, nil, err
+	}
+
+	links = append(links, &link{Type: NodeTypeDNSProvider, Id: int64(domain.ProviderId), Relation: RelationUsesDNSProvider, IsOut: true})
+
+	clusters, err := models.SharedNodeClusterDAO.FindAllEnabledClustersWithDNSDomainId(tx, domainId)
+	if err != nil {
+		return "", false, nil, err
+	}
+	for _, cluster := range clusters {
+		links = append(links, &link{Type: NodeTypeNodeCluster, Id: int64(cluster.Id), Relation: RelationUsesDNSDomain})
+	}
+
+	return domain.Name, true, links, nil
+}
+
+func loadNodeCluster(tx *dbs.Tx, clusterId int64) (name string, found bool, links []*link, err error) {
+	cluster, err := models.SharedNodeClusterDAO.FindEnabledNodeCluster(tx, clusterId)
+	if err != nil || cluster == nil {
+		return "", false, nil, err
+	}
+
+	links = append(links,
+		&link{Type: NodeTypeDNSDomain, Id: int64(cluster.DnsDomainId), Relation: RelationUsesDNSDomain, IsOut: true},
+		&link{Type: NodeTypeHTTPFirewallPolicy, Id: int64(cluster.HttpFirewallPolicyId), Relation: RelationUsesFirewallPolicy, IsOut: true},
+		&link{Type: NodeTypeHTTPCachePolicy, Id: int64(cluster.CachePolicyId), Relation: RelationUsesCachePolicy, IsOut: true},
+	)
+
+	nodes, err := models.SharedNodeDAO.FindAllEnabledNodesWithClusterId(tx, clusterId, true)
+	if err != nil {
+		return "", false, nil, err
+	}
+	for _, node := range nodes {
+		links = append(links, &link{Type: NodeTypeNode, Id: int64(node.Id), Relation: RelationBelongsToCluster})
+	}
+
+	servers, err := models.SharedServerDAO.ListEnabledServersMatch(tx, 0, maxLinksPerNode, 0, "", 0, clusterId, 0, nil, "")
+	if err != nil {
+		return "", false, nil, err
+	}
+	for _, server := range servers {
+		links = append(links, &link{Type: NodeTypeServer, Id: int64(server.Id), Relation: RelationUsesCluster})
+	}
+
+	return cluster.Name, true, links, nil
+}
+
+func loadNode(tx *dbs.Tx, nodeId int64) (name string, found bool, links []*link, err error) {
+	node, err := models.SharedNodeDAO.FindEnabledNode(tx, nodeId)
+	if err != nil || node == nil {
+		return "", false, nil, err
+	}
+
+	links = append(links, &link{Type: NodeTypeNodeCluster, Id: int64(node.ClusterId), Relation: RelationBelongsToCluster, IsOut: true})
+	for _, clusterId := range node.DecodeSecondaryClusterIds() {
+		links = append(links, &link{Type: NodeTypeNodeCluster, Id: clusterId, Relation: RelationBelongsToCluster, IsOut: true})
+	}
+
+	return node.Name, true, links, nil
+}
+
+func loadHTTPFirewallPolicy(tx *dbs.Tx, policyId int64) (name string, found bool, links []*link, err error) {
+	policy, err := models.SharedHTTPFirewallPolicyDAO.FindEnabledHTTPFirewallPolicy(tx, policyId)
+	if err != nil || policy == nil {
+		return "", false, nil, err
+	}
+
+	clusterIds, err := models.SharedNodeClusterDAO.FindAllEnabledNodeClusterIdsWithHTTPFirewallPolicyId(tx, policyId)
+	if err != nil {
+		return "", false, nil, err
+	}
+	for _, clusterId := range clusterIds {
+		links = append(links, &link{Type: NodeTypeNodeCluster, Id: clusterId, Relation: RelationUsesFirewallPolicy})
+	}
+
+	webIds, err := models.SharedHTTPWebDAO.FindAllWebIdsWithHTTPFirewallPolicyId(tx, policyId)
+	if err != nil {
+		return "", false, nil, err
+	}
+	servers, err := models.SharedServerDAO.FindAllEnabledServersWithWebIds(tx, webIds)
+	if err != nil {
+		return "", false, nil, err
+	}
+	for _, server := range servers {
+		links = append(links, &link{Type: NodeTypeServer, Id: int64(server.Id), Relation: RelationUsesFirewallPolicy})
+	}
+
+	return policy.Name, true, links, nil
+}
+
+func loadHTTPCachePolicy(tx *dbs.Tx, policyId int64) (name string, found bool, links []*link, err error) {
+	policy, err := models.SharedHTTPCachePolicyDAO.FindEnabledHTTPCachePolicy(tx, policyId)
+	if err != nil || policy == nil {
+		return "", false, nil, err
+	}
+
+	clusterIds, err := models.SharedNodeClusterDAO.FindAllEnabledNodeClusterIdsWithCachePolicyId(tx, policyId)
+	if err != nil {
+		return "", false, nil, err
+	}
+	for _, clusterId := range clusterIds {
+		links = append(links, &link{Type: NodeTypeNodeCluster, Id: clusterId, Relation: RelationUsesCachePolicy})
+	}
+
+	return policy.Name, true, links, nil
+}
+
+func acmeTaskName(task *acmemodels.ACMETask) string {
+	var domains = task.DecodeDomains()
+	if len(domains) == 0 {
+		return ""
+	}
+	if len(domains) == 1 {
+		return domains[0]
+	}
+	return domains[0] + " ..."
+}
@@ -0,0 +1,7 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package cloudflare
+
+type BatchDNSRecordsResponse struct {
+	BaseResponse
+}
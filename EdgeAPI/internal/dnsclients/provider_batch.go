@@ -0,0 +1,64 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dnsclients
+
+import (
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients/dnstypes"
+)
+
+// MaxBatchRecords 单次批量操作的最多记录数
+const MaxBatchRecords = 200
+
+// BatchProviderInterface 支持批量操作记录的服务商接口
+// 批量操作要求是原子的：要么全部成功，要么全部失败
+type BatchProviderInterface interface {
+	// AddRecords 批量添加记录
+	AddRecords(domain string, newRecords []*dnstypes.Record) error
+
+	// DeleteRecords 批量删除记录
+	DeleteRecords(domain string, records []*dnstypes.Record) error
+}
+
+// AddRecords 添加一组记录，返回和记录一一对应的错误
+// 如果服务商支持批量操作则先尝试批量添加，批量添加失败时再逐个添加，以便找出出错的记录
+func AddRecords(provider ProviderInterface, domain string, newRecords []*dnstypes.Record) []error {
+	var errs = make([]error, len(newRecords))
+	if len(newRecords) == 0 {
+		return errs
+	}
+
+	batchProvider, ok := provider.(BatchProviderInterface)
+	if ok && len(newRecords) > 1 {
+		err := batchProvider.AddRecords(domain, newRecords)
+		if err == nil {
+			return errs
+		}
+	}
+
+	for index, record := range newRecords {
+		errs[index] = provider.AddRecord(domain, record)
+	}
+	return errs
+}
+
+// DeleteRecords 删除一组记录，返回和记录一一对应的错误
+// 如果服务商支持批量操作则先尝试批量删除，批量删除失败时再逐个删除，以便找出出错的记录
+func DeleteRecords(provider ProviderInterface, domain string, records []*dnstypes.Record) []error {
+	var errs = make([]error, len(records))
+	if len(records) == 0 {
+		return errs
+	}
+
+	batchProvider, ok := provider.(BatchProviderInterface)
+	if ok && len(records) > 1 {
+		err := batchProvider.DeleteRecords(domain, records)
+		if err == nil {
+			return errs
+		}
+	}
+
+	for index, record := range records {
+		errs[index] = provider.DeleteRecord(domain, record)
+	}
+	return errs
+}
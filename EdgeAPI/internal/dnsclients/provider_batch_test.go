@@ -0,0 +1,68 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dnsclients_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients"
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients/dnstypes"
+	"github.com/iwind/TeaGo/assert"
+)
+
+type testBatchProvider struct {
+	dnsclients.ProviderInterface
+
+	batchFails   bool
+	countBatches int
+	records      []string
+}
+
+func (this *testBatchProvider) AddRecord(domain string, newRecord *dnstypes.Record) error {
+	if newRecord.Name == "bad" {
+		return errors.New("invalid record")
+	}
+	this.records = append(this.records, newRecord.Name)
+	return nil
+}
+
+func (this *testBatchProvider) AddRecords(domain string, newRecords []*dnstypes.Record) error {
+	this.countBatches++
+	if this.batchFails {
+		return errors.New("batch failed")
+	}
+	for _, record := range newRecords {
+		this.records = append(this.records, record.Name)
+	}
+	return nil
+}
+
+func (this *testBatchProvider) DeleteRecords(domain string, records []*dnstypes.Record) error {
+	return nil
+}
+
+func TestAddRecords_Batch(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var provider = &testBatchProvider{}
+	var errs = dnsclients.AddRecords(provider, "example.com", []*dnstypes.Record{{Name: "a"}, {Name: "b"}})
+	a.IsTrue(len(errs) == 2)
+	a.IsNil(errs[0])
+	a.IsNil(errs[1])
+	a.IsTrue(provider.countBatches == 1)
+	a.IsTrue(len(provider.records) == 2)
+}
+
+func TestAddRecords_Fallback(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var provider = &testBatchProvider{batchFails: true}
+	var errs = dnsclients.AddRecords(provider, "example.com", []*dnstypes.Record{{Name: "a"}, {Name: "bad"}, {Name: "c"}})
+	a.IsTrue(len(errs) == 3)
+	a.IsNil(errs[0])
+	a.IsNotNil(errs[1])
+	a.IsNil(errs[2])
+	a.IsTrue(provider.countBatches == 1)
+	a.IsTrue(len(provider.records) == 2)
+}
@@ -289,6 +289,53 @@ func (this *CloudFlareProvider) DeleteRecord(domain string, record *dnstypes.Rec
 	return nil
 }
 
+// AddRecords 批量添加记录
+func (this *CloudFlareProvider) AddRecords(domain string, newRecords []*dnstypes.Record) error {
+	zoneId, err := this.findZoneIdWithDomain(domain)
+	if err != nil {
+		return err
+	}
+
+	var posts = []maps.Map{}
+	for _, newRecord := range newRecords {
+		var ttl = newRecord.TTL
+		if ttl <= 0 {
+			ttl = 1 // 自动默认
+		}
+		posts = append(posts, maps.Map{
+			"type":    newRecord.Type,
+			"name":    newRecord.Name + "." + domain,
+			"content": newRecord.Value,
+			"ttl":     ttl,
+		})
+	}
+
+	resp := new(cloudflare.BatchDNSRecordsResponse)
+	return this.doAPI(http.MethodPost, "zones/"+zoneId+"/dns_records/batch", nil, maps.Map{
+		"posts": posts,
+	}, resp)
+}
+
+// DeleteRecords 批量删除记录
+func (this *CloudFlareProvider) DeleteRecords(domain string, records []*dnstypes.Record) error {
+	zoneId, err := this.findZoneIdWithDomain(domain)
+	if err != nil {
+		return err
+	}
+
+	var deletes = []maps.Map{}
+	for _, record := range records {
+		deletes = append(deletes, maps.Map{
+			"id": record.Id,
+		})
+	}
+
+	resp := new(cloudflare.BatchDNSRecordsResponse)
+	return this.doAPI(http.MethodPost, "zones/"+zoneId+"/dns_records/batch", nil, maps.Map{
+		"deletes": deletes,
+	}, resp)
+}
+
 // DefaultRoute 默认线路
 func (this *CloudFlareProvider) DefaultRoute() string {
 	return CloudFlareDefaultRoute
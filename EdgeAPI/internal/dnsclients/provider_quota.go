@@ -0,0 +1,63 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dnsclients
+
+// ProviderQuotaWarningRatio 调用次数达到配额的此比例时发出警告
+const ProviderQuotaWarningRatio = 0.8
+
+// ProviderQuota 服务商API调用配额
+// 这里是根据服务商公开文档估算的默认值，实际配额可能会因账号类型不同而有所差异
+type ProviderQuota struct {
+	MaxCallsPerSecond int   // 每秒最多调用次数，0表示不限制
+	MaxCallsPerDay    int64 // 每天最多调用次数，0表示不限制
+}
+
+// IsApproaching 判断调用次数是否接近每日配额
+func (this *ProviderQuota) IsApproaching(countCalls int64) bool {
+	if this == nil || this.MaxCallsPerDay <= 0 {
+		return false
+	}
+	return float64(countCalls) >= float64(this.MaxCallsPerDay)*ProviderQuotaWarningRatio
+}
+
+// Percent 已使用的每日配额百分比
+func (this *ProviderQuota) Percent(countCalls int64) float32 {
+	if this == nil || this.MaxCallsPerDay <= 0 {
+		return 0
+	}
+	return float32(countCalls) * 100 / float32(this.MaxCallsPerDay)
+}
+
+// FindProviderQuota 查找服务商默认的API调用配额
+func FindProviderQuota(providerType ProviderType) *ProviderQuota {
+	switch providerType {
+	case ProviderTypeDNSPod:
+		return &ProviderQuota{
+			MaxCallsPerSecond: 20,
+			MaxCallsPerDay:    100_000,
+		}
+	case ProviderTypeAliDNS:
+		return &ProviderQuota{
+			MaxCallsPerSecond: 10,
+			MaxCallsPerDay:    100_000,
+		}
+	case ProviderTypeHuaweiDNS:
+		return &ProviderQuota{
+			MaxCallsPerSecond: 10,
+			MaxCallsPerDay:    100_000,
+		}
+	case ProviderTypeCloudFlare:
+		// 每5分钟1200次
+		return &ProviderQuota{
+			MaxCallsPerSecond: 4,
+			MaxCallsPerDay:    345_600,
+		}
+	case ProviderTypeDNSLA:
+		return &ProviderQuota{
+			MaxCallsPerSecond: 10,
+			MaxCallsPerDay:    50_000,
+		}
+	}
+
+	return &ProviderQuota{}
+}
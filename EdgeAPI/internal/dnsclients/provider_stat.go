@@ -0,0 +1,201 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dnsclients
+
+import (
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models/dns"
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients/dnstypes"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		// 记录调用统计
+		SetProviderCallHook(func(providerType ProviderType, providerId int64, method string, costMs float64, callErr error) {
+			goman.New(func() {
+				err := dns.SharedDNSProviderCallStatDAO.IncreaseStat(nil, providerId, costMs, callErr == nil)
+				if err != nil {
+					remotelogs.Error("DNS_PROVIDER", "increase call stat failed: "+err.Error())
+				}
+			})
+		})
+	})
+}
+
+// ProviderCallHook 服务商API调用回调
+type ProviderCallHook func(providerType ProviderType, providerId int64, method string, costMs float64, err error)
+
+var providerCallHook ProviderCallHook
+var providerCallHookLocker sync.RWMutex
+
+// SetProviderCallHook 设置服务商API调用回调，用来记录调用统计
+func SetProviderCallHook(hook ProviderCallHook) {
+	providerCallHookLocker.Lock()
+	providerCallHook = hook
+	providerCallHookLocker.Unlock()
+}
+
+var providerLimiterMap = map[int64]*providerLimiter{} // providerId => *providerLimiter
+var providerLimiterLocker sync.Mutex
+
+// 服务商API调用限速器
+type providerLimiter struct {
+	locker   sync.Mutex
+	interval time.Duration
+	nextAt   time.Time
+}
+
+func newProviderLimiter(maxCallsPerSecond int) *providerLimiter {
+	return &providerLimiter{
+		interval: time.Second / time.Duration(maxCallsPerSecond),
+	}
+}
+
+// Wait 等待下一个可用的调用时间
+func (this *providerLimiter) Wait() {
+	this.locker.Lock()
+	var now = time.Now()
+	var callAt = this.nextAt
+	if callAt.Before(now) {
+		callAt = now
+	}
+	this.nextAt = callAt.Add(this.interval)
+	this.locker.Unlock()
+
+	var duration = callAt.Sub(now)
+	if duration > 0 {
+		time.Sleep(duration)
+	}
+}
+
+// 查找服务商共享的限速器，同一个服务商账号的所有实例共享同一个限速器
+func findProviderLimiter(providerId int64, quota *ProviderQuota) *providerLimiter {
+	if quota == nil || quota.MaxCallsPerSecond <= 0 {
+		return nil
+	}
+	if providerId <= 0 {
+		return newProviderLimiter(quota.MaxCallsPerSecond)
+	}
+
+	providerLimiterLocker.Lock()
+	defer providerLimiterLocker.Unlock()
+
+	limiter, ok := providerLimiterMap[providerId]
+	if !ok {
+		limiter = newProviderLimiter(quota.MaxCallsPerSecond)
+		providerLimiterMap[providerId] = limiter
+	}
+	return limiter
+}
+
+// StatProvider 对服务商API调用进行统计和限速，并在调用之前规范化域名和记录名称
+type StatProvider struct {
+	ProviderInterface
+
+	providerType ProviderType
+	providerId   int64
+	limiter      *providerLimiter
+}
+
+// NewStatProvider 获取新对象
+func NewStatProvider(providerType ProviderType, providerId int64, provider ProviderInterface) *StatProvider {
+	return &StatProvider{
+		ProviderInterface: provider,
+		providerType:      providerType,
+		providerId:        providerId,
+		limiter:           findProviderLimiter(providerId, FindProviderQuota(providerType)),
+	}
+}
+
+// Raw 原始的服务商对象
+func (this *StatProvider) Raw() ProviderInterface {
+	return this.ProviderInterface
+}
+
+// GetDomains 获取所有域名列表
+func (this *StatProvider) GetDomains() (domains []string, err error) {
+	var before = this.begin()
+	domains, err = this.ProviderInterface.GetDomains()
+	this.end("GetDomains", before, err)
+	return
+}
+
+// GetRecords 获取域名解析记录列表
+func (this *StatProvider) GetRecords(domain string) (records []*dnstypes.Record, err error) {
+	var before = this.begin()
+	records, err = this.ProviderInterface.GetRecords(NormalizeDomain(domain))
+	this.end("GetRecords", before, err)
+	return
+}
+
+// GetRoutes 读取域名支持的线路数据
+func (this *StatProvider) GetRoutes(domain string) (routes []*dnstypes.Route, err error) {
+	var before = this.begin()
+	routes, err = this.ProviderInterface.GetRoutes(NormalizeDomain(domain))
+	this.end("GetRoutes", before, err)
+	return
+}
+
+// QueryRecord 查询单个记录
+func (this *StatProvider) QueryRecord(domain string, name string, recordType dnstypes.RecordType) (record *dnstypes.Record, err error) {
+	var before = this.begin()
+	record, err = this.ProviderInterface.QueryRecord(NormalizeDomain(domain), NormalizeDomain(name), recordType)
+	this.end("QueryRecord", before, err)
+	return
+}
+
+// QueryRecords 查询多个记录
+func (this *StatProvider) QueryRecords(domain string, name string, recordType dnstypes.RecordType) (records []*dnstypes.Record, err error) {
+	var before = this.begin()
+	records, err = this.ProviderInterface.QueryRecords(NormalizeDomain(domain), NormalizeDomain(name), recordType)
+	this.end("QueryRecords", before, err)
+	return
+}
+
+// AddRecord 设置记录
+func (this *StatProvider) AddRecord(domain string, newRecord *dnstypes.Record) error {
+	normalizeRecord(newRecord)
+	var before = this.begin()
+	var err = this.ProviderInterface.AddRecord(NormalizeDomain(domain), newRecord)
+	this.end("AddRecord", before, err)
+	return err
+}
+
+// UpdateRecord 修改记录
+func (this *StatProvider) UpdateRecord(domain string, record *dnstypes.Record, newRecord *dnstypes.Record) error {
+	normalizeRecord(newRecord)
+	var before = this.begin()
+	var err = this.ProviderInterface.UpdateRecord(NormalizeDomain(domain), record, newRecord)
+	this.end("UpdateRecord", before, err)
+	return err
+}
+
+// DeleteRecord 删除记录
+func (this *StatProvider) DeleteRecord(domain string, record *dnstypes.Record) error {
+	var before = this.begin()
+	var err = this.ProviderInterface.DeleteRecord(NormalizeDomain(domain), record)
+	this.end("DeleteRecord", before, err)
+	return err
+}
+
+func (this *StatProvider) begin() time.Time {
+	if this.limiter != nil {
+		this.limiter.Wait()
+	}
+	return time.Now()
+}
+
+func (this *StatProvider) end(method string, before time.Time, err error) {
+	providerCallHookLocker.RLock()
+	var hook = providerCallHook
+	providerCallHookLocker.RUnlock()
+
+	if hook != nil {
+		hook(this.providerType, this.providerId, method, time.Since(before).Seconds()*1000, err)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dnsclients_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients"
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients/dnstypes"
+	"github.com/iwind/TeaGo/assert"
+)
+
+type testStatProvider struct {
+	dnsclients.ProviderInterface
+
+	countAdded int
+}
+
+func (this *testStatProvider) AddRecord(domain string, newRecord *dnstypes.Record) error {
+	this.countAdded++
+	if newRecord.Name == "fail" {
+		return errors.New("add failed")
+	}
+	return nil
+}
+
+func TestStatProvider_Hook(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var countCalls = 0
+	var countFailedCalls = 0
+	dnsclients.SetProviderCallHook(func(providerType dnsclients.ProviderType, providerId int64, method string, costMs float64, err error) {
+		a.IsTrue(providerType == dnsclients.ProviderTypeCustomHTTP)
+		a.IsTrue(providerId == 1)
+		a.IsTrue(method == "AddRecord")
+		countCalls++
+		if err != nil {
+			countFailedCalls++
+		}
+	})
+	defer dnsclients.SetProviderCallHook(nil)
+
+	var rawProvider = &testStatProvider{}
+	var provider = dnsclients.NewStatProvider(dnsclients.ProviderTypeCustomHTTP, 1, rawProvider)
+	a.IsNil(provider.AddRecord("example.com", &dnstypes.Record{Name: "www"}))
+	a.IsNotNil(provider.AddRecord("example.com", &dnstypes.Record{Name: "fail"}))
+	a.IsTrue(rawProvider.countAdded == 2)
+	a.IsTrue(countCalls == 2)
+	a.IsTrue(countFailedCalls == 1)
+}
+
+func TestStatProvider_Limiter(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	// CloudFlare默认每秒4次
+	var provider = dnsclients.NewStatProvider(dnsclients.ProviderTypeCloudFlare, 0, &testStatProvider{})
+	var before = time.Now()
+	for i := 0; i < 5; i++ {
+		_ = provider.AddRecord("example.com", &dnstypes.Record{Name: "www"})
+	}
+	var cost = time.Since(before)
+	t.Log(cost)
+	a.IsTrue(cost >= 900*time.Millisecond)
+}
+
+func TestProviderQuota(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var quota = dnsclients.FindProviderQuota(dnsclients.ProviderTypeDNSLA)
+	a.IsTrue(quota.MaxCallsPerDay > 0)
+	a.IsFalse(quota.IsApproaching(quota.MaxCallsPerDay / 2))
+	a.IsTrue(quota.IsApproaching(quota.MaxCallsPerDay * 9 / 10))
+	a.IsTrue(quota.Percent(quota.MaxCallsPerDay/2) == 50)
+
+	var customQuota = dnsclients.FindProviderQuota(dnsclients.ProviderTypeCustomHTTP)
+	a.IsFalse(customQuota.IsApproaching(1_000_000))
+}
@@ -0,0 +1,189 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dnsclients
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients/dnstypes"
+	"github.com/TeaOSLab/EdgeCommon/pkg/dnsconfigs"
+)
+
+type RecordConflictType = string
+
+const (
+	RecordConflictTypeDuplicate RecordConflictType = "duplicate" // 已经有完全相同的记录
+	RecordConflictTypeCNAME     RecordConflictType = "cname"     // CNAME记录和同名的其他记录冲突
+)
+
+// RecordConflictError 记录冲突错误
+type RecordConflictError struct {
+	Domain    string
+	Record    *dnstypes.Record
+	Type      RecordConflictType
+	Conflicts []*dnstypes.Record
+}
+
+func (this *RecordConflictError) Error() string {
+	var fullname = this.Domain
+	if this.Record != nil && len(this.Record.Name) > 0 {
+		fullname = this.Record.Name + "." + this.Domain
+	}
+
+	var conflictStrings = []string{}
+	for _, record := range this.Conflicts {
+		conflictStrings = append(conflictStrings, record.Type+" "+record.Value)
+	}
+
+	switch this.Type {
+	case RecordConflictTypeDuplicate:
+		return "record '" + fullname + "' already exists: " + strings.Join(conflictStrings, ", ")
+	case RecordConflictTypeCNAME:
+		return "record '" + fullname + "' conflicts with existing records: " + strings.Join(conflictStrings, ", ") + ", CNAME record can not coexist with other records on the same name and route"
+	}
+	return "record '" + fullname + "' conflicts with existing records"
+}
+
+// IsRecordConflictError 判断是否为记录冲突错误
+func IsRecordConflictError(err error) (conflictErr *RecordConflictError, ok bool) {
+	if err == nil {
+		return nil, false
+	}
+	ok = errors.As(err, &conflictErr)
+	return
+}
+
+// DetectRecordConflict 检查新记录和已有记录之间的冲突
+// 如果没有冲突则返回nil
+func DetectRecordConflict(domain string, records []*dnstypes.Record, newRecord *dnstypes.Record, defaultRoute string) *RecordConflictError {
+	if newRecord == nil {
+		return nil
+	}
+
+	var newName = normalizeRecordName(newRecord.Name)
+	var newRoute = normalizeRecordRoute(newRecord.Route, defaultRoute)
+	var newValue = normalizeRecordValue(newRecord.Value)
+
+	var duplicates = []*dnstypes.Record{}
+	var cnameConflicts = []*dnstypes.Record{}
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+		if normalizeRecordName(record.Name) != newName || normalizeRecordRoute(record.Route, defaultRoute) != newRoute {
+			continue
+		}
+
+		if record.Type == newRecord.Type && normalizeRecordValue(record.Value) == newValue {
+			duplicates = append(duplicates, record)
+			continue
+		}
+
+		// CNAME记录不能和同名的其他记录共存
+		if newRecord.Type == dnstypes.RecordTypeCNAME || record.Type == dnstypes.RecordTypeCNAME {
+			cnameConflicts = append(cnameConflicts, record)
+		}
+	}
+
+	if len(duplicates) > 0 {
+		return &RecordConflictError{
+			Domain:    domain,
+			Record:    newRecord,
+			Type:      RecordConflictTypeDuplicate,
+			Conflicts: duplicates,
+		}
+	}
+
+	if len(cnameConflicts) > 0 {
+		return &RecordConflictError{
+			Domain:    domain,
+			Record:    newRecord,
+			Type:      RecordConflictTypeCNAME,
+			Conflicts: cnameConflicts,
+		}
+	}
+
+	return nil
+}
+
+// AddRecordWithPolicy 检查冲突后再添加记录
+// records 为域名下已有的记录，如果为nil，则从服务商查询同名的相关记录
+// 返回的 isAdded 表示是否真正添加了记录
+func AddRecordWithPolicy(provider ProviderInterface, domain string, records []*dnstypes.Record, newRecord *dnstypes.Record, policy dnsconfigs.RecordConflictPolicy) (isAdded bool, err error) {
+	if records == nil {
+		records, err = queryRelatedRecords(provider, domain, newRecord)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	var conflictErr = DetectRecordConflict(domain, records, newRecord, provider.DefaultRoute())
+	if conflictErr != nil {
+		switch conflictErr.Type {
+		case RecordConflictTypeDuplicate:
+			// 已经存在，不需要重复添加
+			return false, nil
+		case RecordConflictTypeCNAME:
+			switch policy {
+			case dnsconfigs.RecordConflictPolicySkip:
+				return false, nil
+			case dnsconfigs.RecordConflictPolicyOverwrite:
+				for _, record := range conflictErr.Conflicts {
+					err = provider.DeleteRecord(domain, record)
+					if err != nil {
+						return false, err
+					}
+				}
+			default:
+				return false, conflictErr
+			}
+		}
+	}
+
+	err = provider.AddRecord(domain, newRecord)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// 查询和新记录同名的可能冲突的记录
+func queryRelatedRecords(provider ProviderInterface, domain string, newRecord *dnstypes.Record) ([]*dnstypes.Record, error) {
+	var recordTypes = []dnstypes.RecordType{dnstypes.RecordTypeCNAME}
+	switch newRecord.Type {
+	case dnstypes.RecordTypeCNAME:
+		recordTypes = append(recordTypes, dnstypes.RecordTypeA, dnstypes.RecordTypeAAAA, dnstypes.RecordTypeTXT)
+	default:
+		recordTypes = append(recordTypes, newRecord.Type)
+	}
+
+	var result = []*dnstypes.Record{}
+	for _, recordType := range recordTypes {
+		records, err := provider.QueryRecords(domain, newRecord.Name, recordType)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, records...)
+	}
+	return result, nil
+}
+
+func normalizeRecordName(name string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == "@" {
+		return ""
+	}
+	return name
+}
+
+func normalizeRecordRoute(route string, defaultRoute string) string {
+	if len(route) == 0 {
+		return defaultRoute
+	}
+	return route
+}
+
+func normalizeRecordValue(value string) string {
+	return strings.ToLower(strings.TrimSuffix(value, "."))
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dnsclients_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients"
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients/dnstypes"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestDetectRecordConflict(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var records = []*dnstypes.Record{
+		{Name: "www", Type: dnstypes.RecordTypeCNAME, Value: "cdn.example.com.", Route: "default"},
+		{Name: "node", Type: dnstypes.RecordTypeA, Value: "192.168.1.100", Route: "default"},
+		{Name: "node", Type: dnstypes.RecordTypeA, Value: "192.168.1.101", Route: "telecom"},
+	}
+
+	// 没有冲突
+	a.IsNil(dnsclients.DetectRecordConflict("example.com", records, &dnstypes.Record{Name: "node", Type: dnstypes.RecordTypeA, Value: "192.168.1.102", Route: "default"}, "default"))
+	a.IsNil(dnsclients.DetectRecordConflict("example.com", records, &dnstypes.Record{Name: "www", Type: dnstypes.RecordTypeA, Value: "192.168.1.102", Route: "telecom"}, "default"))
+
+	// 重复的记录
+	{
+		var conflictErr = dnsclients.DetectRecordConflict("example.com", records, &dnstypes.Record{Name: "node", Type: dnstypes.RecordTypeA, Value: "192.168.1.100", Route: ""}, "default")
+		a.IsNotNil(conflictErr)
+		a.IsTrue(conflictErr.Type == dnsclients.RecordConflictTypeDuplicate)
+	}
+	{
+		var conflictErr = dnsclients.DetectRecordConflict("example.com", records, &dnstypes.Record{Name: "WWW", Type: dnstypes.RecordTypeCNAME, Value: "cdn.example.com", Route: "default"}, "default")
+		a.IsNotNil(conflictErr)
+		a.IsTrue(conflictErr.Type == dnsclients.RecordConflictTypeDuplicate)
+	}
+
+	// CNAME冲突
+	{
+		var conflictErr = dnsclients.DetectRecordConflict("example.com", records, &dnstypes.Record{Name: "www", Type: dnstypes.RecordTypeA, Value: "192.168.1.100", Route: "default"}, "default")
+		a.IsNotNil(conflictErr)
+		a.IsTrue(conflictErr.Type == dnsclients.RecordConflictTypeCNAME)
+		t.Log(conflictErr.Error())
+	}
+	{
+		var conflictErr = dnsclients.DetectRecordConflict("example.com", records, &dnstypes.Record{Name: "node", Type: dnstypes.RecordTypeCNAME, Value: "cdn.example.com.", Route: "default"}, "default")
+		a.IsNotNil(conflictErr)
+		a.IsTrue(conflictErr.Type == dnsclients.RecordConflictTypeCNAME)
+		a.IsTrue(len(conflictErr.Conflicts) == 1)
+
+		conflictErr2, ok := dnsclients.IsRecordConflictError(conflictErr)
+		a.IsTrue(ok)
+		a.IsTrue(conflictErr2 == conflictErr)
+	}
+}
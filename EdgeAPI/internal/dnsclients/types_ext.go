@@ -9,38 +9,42 @@ import (
 
 // FindProvider 查找服务商实例
 func FindProvider(providerType ProviderType, providerId int64) ProviderInterface {
+	var provider ProviderInterface
 	switch providerType {
 	case ProviderTypeDNSPod:
-		return &DNSPodProvider{
+		provider = &DNSPodProvider{
 			ProviderId: providerId,
 		}
 	case ProviderTypeAliDNS:
-		return &AliDNSProvider{
+		provider = &AliDNSProvider{
 			ProviderId: providerId,
 		}
 	case ProviderTypeHuaweiDNS:
-		return &HuaweiDNSProvider{
+		provider = &HuaweiDNSProvider{
 			ProviderId: providerId,
 		}
 	case ProviderTypeCloudFlare:
-		return &CloudFlareProvider{
+		provider = &CloudFlareProvider{
 			ProviderId: providerId,
 		}
 	case ProviderTypeCustomHTTP:
-		return &CustomHTTPProvider{
+		provider = &CustomHTTPProvider{
 			ProviderId: providerId,
 		}
 	case ProviderTypeEdgeDNSAPI:
-		return &EdgeDNSAPIProvider{
+		provider = &EdgeDNSAPIProvider{
 			ProviderId: providerId,
 		}
 	case ProviderTypeDNSLA:
-		return &DNSLaProvider{
+		provider = &DNSLaProvider{
 			ProviderId: providerId,
 		}
 	}
+	if provider == nil {
+		return nil
+	}
 
-	return nil
+	return NewStatProvider(providerType, providerId, provider)
 }
 
 func filterTypeMaps(typeMaps []maps.Map) []maps.Map {
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"strings"
 
+	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients/dnstypes"
+	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
 	"github.com/iwind/TeaGo/maps"
 )
 
@@ -66,3 +68,37 @@ func UnmaskAPIParams(oldParamsJSON []byte, newParamsJSON []byte) (resultJSON []b
 	resultJSON, err = json.Marshal(newParams)
 	return
 }
+
+// NormalizeDomain 规范化域名或记录名称：转换为小写、去除末尾的点（.），国际化域名转换为Punycode格式
+// 无法转换时返回原来的域名，由服务商API返回具体的错误
+func NormalizeDomain(domain string) string {
+	result, err := configutils.NormalizeDomain(domain)
+	if err != nil {
+		return domain
+	}
+	return result
+}
+
+// 规范化记录名称和CNAME记录值，直接修改记录，以便服务商可以回写记录ID
+func normalizeRecord(record *dnstypes.Record) {
+	if record == nil {
+		return
+	}
+	record.Name = NormalizeDomain(record.Name)
+	if record.Type == dnstypes.RecordTypeCNAME && !isASCII(record.Value) {
+		var hasDot = strings.HasSuffix(record.Value, ".")
+		record.Value = NormalizeDomain(record.Value)
+		if hasDot {
+			record.Value += "."
+		}
+	}
+}
+
+func isASCII(s string) bool {
+	for _, c := range s {
+		if c >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
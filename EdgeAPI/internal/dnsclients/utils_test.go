@@ -34,3 +34,11 @@ func TestUnmaskAPIParams(t *testing.T) {
 	}
 	t.Log(string(data))
 }
+
+func TestNormalizeDomain(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(dnsclients.NormalizeDomain("Example.COM.") == "example.com")
+	a.IsTrue(dnsclients.NormalizeDomain("中文.com") == "xn--fiq228c.com")
+	a.IsTrue(dnsclients.NormalizeDomain("@") == "@")
+	a.IsTrue(dnsclients.NormalizeDomain("") == "")
+}
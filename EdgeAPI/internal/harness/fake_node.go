@@ -0,0 +1,130 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package harness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	rpcutils "github.com/TeaOSLab/EdgeAPI/internal/rpc/utils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// FakeNode 模拟的边缘节点
+// 使用和边缘节点相同的认证方式通过RPC调用API服务
+type FakeNode struct {
+	Id        int64
+	UniqueId  string
+	Secret    string
+	ClusterId int64
+
+	conn *grpc.ClientConn
+
+	configVersion int64
+	taskVersion   int64
+}
+
+func newFakeNode(rpcAddr string, nodeId int64, uniqueId string, secret string, clusterId int64) (*FakeNode, error) {
+	conn, err := grpc.Dial(rpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("connect to api node failed: %w", err)
+	}
+
+	return &FakeNode{
+		Id:            nodeId,
+		UniqueId:      uniqueId,
+		Secret:        secret,
+		ClusterId:     clusterId,
+		conn:          conn,
+		configVersion: -1,
+	}, nil
+}
+
+// Context 节点调用RPC时使用的上下文
+func (this *FakeNode) Context() context.Context {
+	ctx, err := composeContext(this.UniqueId, this.Secret, rpcutils.UserTypeNode, 0)
+	if err != nil {
+		// 只有加密方法不存在时才会出错，属于程序错误
+		panic(err)
+	}
+	return ctx
+}
+
+// Conn RPC连接
+func (this *FakeNode) Conn() *grpc.ClientConn {
+	return this.conn
+}
+
+func (this *FakeNode) NodeRPC() pb.NodeServiceClient {
+	return pb.NewNodeServiceClient(this.conn)
+}
+
+func (this *FakeNode) NodeTaskRPC() pb.NodeTaskServiceClient {
+	return pb.NewNodeTaskServiceClient(this.conn)
+}
+
+func (this *FakeNode) SSLCertRPC() pb.SSLCertServiceClient {
+	return pb.NewSSLCertServiceClient(this.conn)
+}
+
+// LoadConfig 像边缘节点一样读取当前节点配置
+// 如果配置没有变化，则返回nil
+func (this *FakeNode) LoadConfig() (*nodeconfigs.NodeConfig, error) {
+	resp, err := this.NodeRPC().FindCurrentNodeConfig(this.Context(), &pb.FindCurrentNodeConfigRequest{
+		Version:         this.configVersion,
+		NodeTaskVersion: this.taskVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsChanged {
+		return nil, nil
+	}
+	if resp.IsCompressed {
+		return nil, errors.New("unexpected compressed node config")
+	}
+
+	var config = &nodeconfigs.NodeConfig{}
+	err = json.Unmarshal(resp.NodeJSON, config)
+	if err != nil {
+		return nil, fmt.Errorf("decode node config failed: %w", err)
+	}
+	this.configVersion = config.Version
+	return config, nil
+}
+
+// SyncTasks 读取当前节点的同步任务，并全部报告为执行成功
+// 返回读取到的任务
+func (this *FakeNode) SyncTasks() ([]*pb.NodeTask, error) {
+	resp, err := this.NodeTaskRPC().FindNodeTasks(this.Context(), &pb.FindNodeTasksRequest{
+		Version: this.taskVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range resp.NodeTasks {
+		_, err = this.NodeTaskRPC().ReportNodeTaskDone(this.Context(), &pb.ReportNodeTaskDoneRequest{
+			NodeTaskId: task.Id,
+			IsOk:       true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("report node task '%d' failed: %w", task.Id, err)
+		}
+		if task.Version > this.taskVersion {
+			this.taskVersion = task.Version
+		}
+	}
+
+	return resp.NodeTasks, nil
+}
+
+// Close 关闭连接
+func (this *FakeNode) Close() error {
+	return this.conn.Close()
+}
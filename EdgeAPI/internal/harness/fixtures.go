@@ -0,0 +1,179 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package harness
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/rands"
+	"github.com/iwind/TeaGo/types"
+)
+
+// Fixtures 测试数据
+// 所有数据都通过DAO创建，以保证和正常使用时的数据一致
+type Fixtures struct {
+	harness *Harness
+	tx      *dbs.Tx
+}
+
+func newFixtures(harness *Harness) *Fixtures {
+	return &Fixtures{
+		harness: harness,
+	}
+}
+
+// CreateAdmin 创建超级管理员
+func (this *Fixtures) CreateAdmin(username string) (adminId int64, err error) {
+	return models.SharedAdminDAO.CreateAdmin(this.tx, username, true, rands.HexString(16), username, true, []byte("[]"))
+}
+
+// DefaultClusterId 安装时自动创建的默认集群
+func (this *Fixtures) DefaultClusterId() (int64, error) {
+	clusterIds, err := models.SharedNodeClusterDAO.FindAllEnableClusterIds(this.tx)
+	if err != nil {
+		return 0, err
+	}
+	if len(clusterIds) == 0 {
+		return 0, errors.New("can not find default cluster")
+	}
+	return clusterIds[0], nil
+}
+
+// CreateCluster 创建集群
+func (this *Fixtures) CreateCluster(name string) (clusterId int64, err error) {
+	return models.SharedNodeClusterDAO.CreateCluster(this.tx, 0, name, 0, "/usr/local/goedge", 0, "", 0, 0, 0, nil, serverconfigs.NewGlobalServerConfig(), false, false, false, 0, 0)
+}
+
+// CreateNode 在集群中创建边缘节点，并返回可以调用API的模拟节点
+func (this *Fixtures) CreateNode(clusterId int64, name string) (*FakeNode, error) {
+	nodeId, err := models.SharedNodeDAO.CreateNode(this.tx, 0, name, clusterId, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	node, err := models.SharedNodeDAO.FindEnabledNode(this.tx, nodeId)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, errors.New("can not find node '" + types.String(nodeId) + "'")
+	}
+
+	return this.harness.connectNode(nodeId, node.UniqueId, node.Secret, clusterId)
+}
+
+// CreateUser 创建平台用户
+func (this *Fixtures) CreateUser(username string, clusterId int64) (userId int64, err error) {
+	return models.SharedUserDAO.CreateUser(this.tx, username, rands.HexString(16), username, "", "", "", "", "harness", clusterId, nil, "", true)
+}
+
+// CreateHTTPServer 创建一个监听80端口的HTTP反向代理网站
+func (this *Fixtures) CreateHTTPServer(userId int64, clusterId int64, serverNames []string) (serverId int64, err error) {
+	if len(serverNames) == 0 {
+		return 0, errors.New("'serverNames' should not be empty")
+	}
+
+	webId, err := models.SharedHTTPWebDAO.CreateWeb(this.tx, 0, userId, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var serverNameConfigs = []*serverconfigs.ServerNameConfig{}
+	for _, serverName := range serverNames {
+		serverNameConfigs = append(serverNameConfigs, &serverconfigs.ServerNameConfig{
+			Name: serverName,
+			Type: serverconfigs.ServerNameTypeFull,
+		})
+	}
+	serverNamesJSON, err := json.Marshal(serverNameConfigs)
+	if err != nil {
+		return 0, err
+	}
+
+	var httpConfig = &serverconfigs.HTTPProtocolConfig{}
+	httpConfig.IsOn = true
+	httpConfig.Listen = []*serverconfigs.NetworkAddressConfig{
+		{
+			Protocol:  serverconfigs.ProtocolHTTP,
+			PortRange: "80",
+		},
+	}
+	httpJSON, err := json.Marshal(httpConfig)
+	if err != nil {
+		return 0, err
+	}
+
+	reverseProxyId, err := models.SharedReverseProxyDAO.CreateReverseProxy(this.tx, 0, userId, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	reverseProxyRefJSON, err := json.Marshal(&serverconfigs.ReverseProxyRef{
+		IsOn:           true,
+		ReverseProxyId: reverseProxyId,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return models.SharedServerDAO.CreateServer(this.tx, 0, userId, serverconfigs.ServerTypeHTTPProxy, serverNames[0], "", serverNamesJSON, false, nil, httpJSON, nil, nil, nil, nil, webId, reverseProxyRefJSON, clusterId, nil, nil, nil, 0)
+}
+
+// CreateSelfSignedCert 创建一个自签名证书
+func (this *Fixtures) CreateSelfSignedCert(userId int64, dnsNames []string, validity time.Duration) (certId int64, err error) {
+	if len(dnsNames) == 0 {
+		return 0, errors.New("'dnsNames' should not be empty")
+	}
+
+	certData, keyData, err := GenerateSelfSignedCert(dnsNames, validity)
+	if err != nil {
+		return 0, err
+	}
+
+	var now = time.Now()
+	return models.SharedSSLCertDAO.CreateCert(this.tx, 0, userId, true, dnsNames[0], "", "", false, certData, keyData, now.Unix(), now.Add(validity).Unix(), dnsNames, []string{dnsNames[0]})
+}
+
+// GenerateSelfSignedCert 生成自签名证书和私钥，格式为PEM
+func GenerateSelfSignedCert(dnsNames []string, validity time.Duration) (certData []byte, keyData []byte, err error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var now = time.Now()
+	var template = &x509.Certificate{
+		SerialNumber: big.NewInt(now.UnixNano()),
+		Subject: pkix.Name{
+			CommonName: dnsNames[0],
+		},
+		DNSNames:              dnsNames,
+		NotBefore:             now.Add(-1 * time.Minute),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certData = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes})
+	keyData = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes})
+	return
+}
@@ -0,0 +1,391 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package harness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/nodes"
+	rpcutils "github.com/TeaOSLab/EdgeAPI/internal/rpc/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/setup"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/rands"
+	"github.com/iwind/TeaGo/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// 和 build/configs/db.template.yaml 中的 boolFields 保持一致
+var boolFields = []string{"uamIsOn", "followPort", "requestHostExcludingPort", "autoRemoteStart", "autoInstallNftables", "enableIPLists", "detectAgents", "checkingPorts", "enableRecordHealthCheck", "offlineIsNotified", "http2Enabled", "http3Enabled", "enableHTTP2", "retry50X", "retry40X", "autoSystemTuning", "disableDefaultDB", "autoTrimDisks", "enableGlobalPages", "ignoreLocal", "ignoreSearchEngine"}
+
+// DAO都是全局共享的，所以同一个进程中只能启动一个测试环境
+var isStarted int32
+
+// dbs.NotifyReady() 只需要调用一次
+var readyOnce = sync.Once{}
+
+type Options struct {
+	MySQLImage   string        // 启动MySQL时使用的Docker镜像，默认为 mysql:8.0
+	MySQLDSN     string        // 使用已有的MySQL服务，格式为 user:password@tcp(host:port)/，不为空时不再启动Docker容器
+	StartTimeout time.Duration // 等待MySQL启动的超时时间
+	KeepDB       bool          // 停止后是否保留数据库，以便于排查问题
+	Verbose      bool
+}
+
+// DefaultOptions 从环境变量中读取默认选项
+//   - EDGE_HARNESS_MYSQL_DSN 已有的MySQL服务
+//   - EDGE_HARNESS_MYSQL_IMAGE MySQL的Docker镜像
+//   - EDGE_HARNESS_KEEP_DB 设置为1时保留测试数据库
+func DefaultOptions() *Options {
+	return &Options{
+		MySQLImage:   os.Getenv("EDGE_HARNESS_MYSQL_IMAGE"),
+		MySQLDSN:     os.Getenv("EDGE_HARNESS_MYSQL_DSN"),
+		StartTimeout: 3 * time.Minute,
+		KeepDB:       os.Getenv("EDGE_HARNESS_KEEP_DB") == "1",
+	}
+}
+
+// Harness 端到端集成测试环境
+// 包含一个临时数据库、在进程内启动的API服务以及可以调用API的模拟边缘节点
+type Harness struct {
+	options *Options
+
+	mysql  *MySQLServer
+	dbName string
+
+	listener net.Listener
+	rpcAddr  string
+	conn     *grpc.ClientConn
+
+	adminNodeId string
+	adminSecret string
+	adminId     int64
+
+	fixtures *Fixtures
+
+	fakeNodes      []*FakeNode
+	fakeNodeLocker sync.Mutex
+}
+
+func NewHarness(options *Options) *Harness {
+	if options == nil {
+		options = DefaultOptions()
+	}
+	if options.StartTimeout <= 0 {
+		options.StartTimeout = 3 * time.Minute
+	}
+
+	var harness = &Harness{
+		options: options,
+	}
+	harness.fixtures = newFixtures(harness)
+	return harness
+}
+
+// Start 启动测试环境
+func (this *Harness) Start() error {
+	if !atomic.CompareAndSwapInt32(&isStarted, 0, 1) {
+		return errors.New("only one harness can be started in a process")
+	}
+
+	// MySQL
+	if len(this.options.MySQLDSN) > 0 {
+		this.mysql = NewExternalMySQLServer(this.options.MySQLDSN)
+	} else {
+		this.mysql = NewDockerMySQLServer(this.options.MySQLImage)
+	}
+	this.log("starting mysql ...")
+	err := this.mysql.Start(this.options.StartTimeout)
+	if err != nil {
+		return err
+	}
+
+	// 数据库
+	err = this.setupDB()
+	if err != nil {
+		return fmt.Errorf("setup database failed: %w", err)
+	}
+
+	// API服务
+	err = this.startAPINode()
+	if err != nil {
+		return fmt.Errorf("start api node failed: %w", err)
+	}
+
+	// 管理员
+	err = this.setupAdmin()
+	if err != nil {
+		return fmt.Errorf("setup admin failed: %w", err)
+	}
+
+	this.log("harness started, rpc: " + this.rpcAddr + ", database: " + this.dbName)
+	return nil
+}
+
+// Stop 停止测试环境，并清理数据库和容器
+func (this *Harness) Stop() error {
+	this.fakeNodeLocker.Lock()
+	for _, fakeNode := range this.fakeNodes {
+		_ = fakeNode.Close()
+	}
+	this.fakeNodes = nil
+	this.fakeNodeLocker.Unlock()
+
+	if this.conn != nil {
+		_ = this.conn.Close()
+	}
+	if this.listener != nil {
+		_ = this.listener.Close()
+	}
+
+	if this.mysql != nil {
+		if !this.options.KeepDB && len(this.dbName) > 0 {
+			err := this.mysql.DropDatabase(this.dbName)
+			if err != nil {
+				this.log("drop database '" + this.dbName + "' failed: " + err.Error())
+			}
+		}
+
+		err := this.mysql.Stop()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Fixtures 用来创建测试数据
+func (this *Harness) Fixtures() *Fixtures {
+	return this.fixtures
+}
+
+// RPCAddr API服务的RPC地址
+func (this *Harness) RPCAddr() string {
+	return this.rpcAddr
+}
+
+// Conn 连接API服务的RPC连接
+func (this *Harness) Conn() *grpc.ClientConn {
+	return this.conn
+}
+
+// AdminId 默认管理员ID
+func (this *Harness) AdminId() int64 {
+	return this.adminId
+}
+
+// AdminContext 以默认管理员身份调用API的上下文
+func (this *Harness) AdminContext() context.Context {
+	return this.adminContext(this.adminId)
+}
+
+// UserContext 以平台用户身份调用API的上下文
+// 为了简化测试，这里使用管理节点的令牌，服务中判断权限时只依赖用户ID
+func (this *Harness) UserContext(userId int64) context.Context {
+	ctx, err := composeContext(this.adminNodeId, this.adminSecret, rpcutils.UserTypeUser, userId)
+	if err != nil {
+		panic(err)
+	}
+	return ctx
+}
+
+// ExtractNodeTasks 将集群任务分解为节点任务
+// 和后台任务 NodeTaskExtractor 的操作一致，测试环境中不启动后台任务，所以需要手动调用
+func (this *Harness) ExtractNodeTasks() error {
+	return models.SharedNodeTaskDAO.ExtractAllClusterTasks(nil, nodeconfigs.NodeRoleNode)
+}
+
+// WaitFor 等待某个条件成立，主要用于等待异步执行的任务
+func (this *Harness) WaitFor(timeout time.Duration, f func() (bool, error)) error {
+	var deadline = time.Now().Add(timeout)
+	for {
+		ok, err := f()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return errors.New("waiting timeout")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func (this *Harness) adminContext(adminId int64) context.Context {
+	ctx, err := composeContext(this.adminNodeId, this.adminSecret, rpcutils.UserTypeAdmin, adminId)
+	if err != nil {
+		panic(err)
+	}
+	return ctx
+}
+
+// 创建并安装数据库
+func (this *Harness) setupDB() error {
+	this.dbName = "edge_harness_" + rands.HexString(8)
+	this.log("creating database '" + this.dbName + "' ...")
+	dbConfig, err := this.mysql.CreateDatabase(this.dbName)
+	if err != nil {
+		return err
+	}
+
+	// 需要在所有DAO初始化之前设置，以免读取配置文件
+	var globalConfig = dbs.GlobalConfig()
+	globalConfig.DBs = map[string]*dbs.DBConfig{
+		Tea.Env: dbConfig,
+	}
+	globalConfig.Default.DB = Tea.Env
+	globalConfig.Fields = map[string][]string{
+		"bool": boolFields,
+	}
+
+	this.log("installing tables ...")
+	var executor = setup.NewSQLExecutor(dbConfig)
+	err = executor.Run(this.options.Verbose)
+	if err != nil {
+		return err
+	}
+
+	readyOnce.Do(func() {
+		dbs.NotifyReady()
+	})
+
+	return nil
+}
+
+// 在进程内启动API服务
+func (this *Harness) startAPINode() error {
+	var tx *dbs.Tx
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	this.listener = listener
+	this.rpcAddr = listener.Addr().String()
+
+	// 创建API节点
+	_, port, err := net.SplitHostPort(this.rpcAddr)
+	if err != nil {
+		return err
+	}
+	var listenAddrs = []*serverconfigs.NetworkAddressConfig{
+		{
+			Protocol:  serverconfigs.ProtocolHTTP,
+			Host:      "127.0.0.1",
+			PortRange: port,
+		},
+	}
+	var httpConfig = &serverconfigs.HTTPProtocolConfig{}
+	httpConfig.IsOn = true
+	httpConfig.Listen = listenAddrs
+	httpJSON, err := json.Marshal(httpConfig)
+	if err != nil {
+		return err
+	}
+	accessAddrsJSON, err := json.Marshal(listenAddrs)
+	if err != nil {
+		return err
+	}
+	apiNodeId, err := models.SharedAPINodeDAO.CreateAPINode(tx, "Harness API Node", "", httpJSON, nil, false, nil, nil, accessAddrsJSON, true)
+	if err != nil {
+		return err
+	}
+	apiNode, err := models.SharedAPINodeDAO.FindEnabledAPINode(tx, apiNodeId, nil)
+	if err != nil {
+		return err
+	}
+	if apiNode == nil {
+		return errors.New("can not find api node '" + types.String(apiNodeId) + "'")
+	}
+
+	token, err := models.SharedApiTokenDAO.FindEnabledTokenWithNode(tx, apiNode.UniqueId)
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		err = models.SharedApiTokenDAO.CreateAPIToken(tx, apiNode.UniqueId, apiNode.Secret, nodeconfigs.NodeRoleAPI)
+		if err != nil {
+			return err
+		}
+	}
+
+	var apiConfig = &configs.APIConfig{
+		NodeId: apiNode.UniqueId,
+		Secret: apiNode.Secret,
+	}
+	apiConfig.SetNumberId(apiNodeId)
+	configs.SetSharedAPIConfig(apiConfig)
+
+	go func() {
+		// 关闭监听器时会返回错误，这里不需要处理
+		_ = nodes.NewAPINode().ServeRPC(listener)
+	}()
+
+	conn, err := grpc.Dial(this.rpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	this.conn = conn
+
+	return nil
+}
+
+// 准备管理员及管理节点令牌
+func (this *Harness) setupAdmin() error {
+	var tx *dbs.Tx
+
+	token, err := models.SharedApiTokenDAO.FindEnabledTokenWithRole(tx, nodeconfigs.NodeRoleAdmin)
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return errors.New("can not find admin api token")
+	}
+	this.adminNodeId = token.NodeId
+	this.adminSecret = token.Secret
+
+	adminId, err := this.fixtures.CreateAdmin("admin")
+	if err != nil {
+		return err
+	}
+	this.adminId = adminId
+
+	return nil
+}
+
+// 连接模拟节点
+func (this *Harness) connectNode(nodeId int64, uniqueId string, secret string, clusterId int64) (*FakeNode, error) {
+	fakeNode, err := newFakeNode(this.rpcAddr, nodeId, uniqueId, secret, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	this.fakeNodeLocker.Lock()
+	this.fakeNodes = append(this.fakeNodes, fakeNode)
+	this.fakeNodeLocker.Unlock()
+
+	return fakeNode, nil
+}
+
+func (this *Harness) log(message string) {
+	if this.options.Verbose {
+		log.Println("[HARNESS]" + message)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package harness_test
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/harness"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(harness.Main(m))
+}
+
+func TestGenerateSelfSignedCert(t *testing.T) {
+	certData, keyData, err := harness.GenerateSelfSignedCert([]string{"example.com", "www.example.com"}, 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keyData) == 0 {
+		t.Fatal("key should not be empty")
+	}
+
+	block, _ := pem.Decode(certData)
+	if block == nil {
+		t.Fatal("invalid cert pem")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cert.DNSNames) != 2 || cert.Subject.CommonName != "example.com" {
+		t.Fatal("unexpected cert:", cert.DNSNames, cert.Subject.CommonName)
+	}
+}
+
+func TestHarness_NodeConfig(t *testing.T) {
+	var h = harness.Setup(t)
+	var fixtures = h.Fixtures()
+
+	clusterId, err := fixtures.CreateCluster("Config Cluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	node, err := fixtures.CreateNode(clusterId, "Config Node")
+	if err != nil {
+		t.Fatal(err)
+	}
+	userId, err := fixtures.CreateUser("config_user", clusterId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverId, err := fixtures.CreateHTTPServer(userId, clusterId, []string{"config.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nodeConfig, err := node.LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodeConfig == nil {
+		t.Fatal("node config should be changed on first loading")
+	}
+
+	var found bool
+	for _, server := range nodeConfig.Servers {
+		if server.Id == serverId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Fatal("server '", serverId, "' not found in node config")
+	}
+
+	// 没有变化时不返回配置
+	nodeConfig, err = node.LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodeConfig != nil {
+		t.Fatal("node config should not be changed")
+	}
+}
+
+func TestHarness_NodeTasks(t *testing.T) {
+	var h = harness.Setup(t)
+	var fixtures = h.Fixtures()
+
+	clusterId, err := fixtures.CreateCluster("Task Cluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	node, err := fixtures.CreateNode(clusterId, "Task Node")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 清空创建节点时产生的任务
+	err = h.ExtractNodeTasks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = node.SyncTasks()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 修改网站后节点应该收到任务
+	_, err = fixtures.CreateHTTPServer(0, clusterId, []string{"task.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.ExtractNodeTasks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tasks, err := node.SyncTasks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) == 0 {
+		t.Fatal("node should receive tasks after server created")
+	}
+
+	// 已经完成的任务不会再次返回
+	tasks, err = node.SyncTasks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 0 {
+		t.Fatal("expect no more tasks, but got", len(tasks))
+	}
+}
+
+func TestHarness_AdminCert(t *testing.T) {
+	var h = harness.Setup(t)
+
+	var dnsNames = []string{"cert.example.com"}
+	certData, keyData, err := harness.GenerateSelfSignedCert(dnsNames, 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var certRPC = pb.NewSSLCertServiceClient(h.Conn())
+	var now = time.Now()
+	createResp, err := certRPC.CreateSSLCert(h.AdminContext(), &pb.CreateSSLCertRequest{
+		IsOn:        true,
+		Name:        "harness cert",
+		CertData:    certData,
+		KeyData:     keyData,
+		TimeBeginAt: now.Unix(),
+		TimeEndAt:   now.Add(24 * time.Hour).Unix(),
+		DnsNames:    dnsNames,
+		CommonNames: dnsNames,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configResp, err := certRPC.FindEnabledSSLCertConfig(h.AdminContext(), &pb.FindEnabledSSLCertConfigRequest{SslCertId: createResp.SslCertId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var certConfig = &sslconfigs.SSLCertConfig{}
+	err = json.Unmarshal(configResp.SslCertJSON, certConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if certConfig.Name != "harness cert" || len(certConfig.DNSNames) != 1 || certConfig.DNSNames[0] != dnsNames[0] {
+		t.Fatal("unexpected cert config:", certConfig.Name, certConfig.DNSNames)
+	}
+
+	// 节点无权调用管理员接口
+	clusterId, err := h.Fixtures().DefaultClusterId()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node, err := h.Fixtures().CreateNode(clusterId, "Cert Node")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = node.SSLCertRPC().CreateSSLCert(node.Context(), &pb.CreateSSLCertRequest{
+		Name:     "node cert",
+		CertData: certData,
+		KeyData:  keyData,
+	})
+	if err == nil {
+		t.Fatal("node should not be able to create cert")
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package harness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	executils "github.com/TeaOSLab/EdgeAPI/internal/utils/exec"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/rands"
+)
+
+const DefaultMySQLImage = "mysql:8.0"
+
+// ErrMySQLUnavailable 没有可用的MySQL服务
+// 既没有设置外部MySQL服务，也没有可用的Docker
+var ErrMySQLUnavailable = errors.New("mysql is unavailable: docker not found and no external mysql dsn set")
+
+// MySQLServer 测试用的MySQL服务
+// 可以是一个临时启动的Docker容器，也可以是一个已有的外部服务
+type MySQLServer struct {
+	image string
+	dsn   string // user:password@tcp(host:port)/
+
+	containerId string
+}
+
+// NewDockerMySQLServer 使用Docker启动临时的MySQL服务
+func NewDockerMySQLServer(image string) *MySQLServer {
+	if len(image) == 0 {
+		image = DefaultMySQLImage
+	}
+	return &MySQLServer{
+		image: image,
+	}
+}
+
+// NewExternalMySQLServer 使用已有的MySQL服务
+// dsn 格式为 user:password@tcp(host:port)/
+func NewExternalMySQLServer(dsn string) *MySQLServer {
+	if !strings.HasSuffix(dsn, "/") {
+		dsn += "/"
+	}
+	return &MySQLServer{
+		dsn: dsn,
+	}
+}
+
+// Start 启动服务，并等待服务可以连接
+func (this *MySQLServer) Start(timeout time.Duration) error {
+	if len(this.dsn) == 0 {
+		err := this.startContainer()
+		if err != nil {
+			return err
+		}
+	}
+
+	return this.waitReady(timeout)
+}
+
+// Stop 停止服务
+// 只会停止和删除由当前对象启动的容器，不会影响外部服务
+func (this *MySQLServer) Stop() error {
+	if len(this.containerId) == 0 {
+		return nil
+	}
+
+	var cmd = executils.NewTimeoutCmd(60*time.Second, "docker", "rm", "-f", "-v", this.containerId).WithStderr()
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("remove container '%s' failed: %w: %s", this.containerId, err, cmd.Stderr())
+	}
+	this.containerId = ""
+	return nil
+}
+
+// CreateDatabase 创建一个新的数据库，并返回数据库配置
+func (this *MySQLServer) CreateDatabase(name string) (*dbs.DBConfig, error) {
+	db, err := this.rawDB()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err = db.Exec("CREATE DATABASE `" + name + "` DEFAULT CHARSET utf8mb4")
+	if err != nil {
+		return nil, fmt.Errorf("create database '%s' failed: %w", name, err)
+	}
+
+	return &dbs.DBConfig{
+		Driver: "mysql",
+		Dsn:    this.dsn + name + "?charset=utf8mb4&timeout=30s&multiStatements=true",
+		Prefix: "edge",
+	}, nil
+}
+
+// DropDatabase 删除数据库
+func (this *MySQLServer) DropDatabase(name string) error {
+	db, err := this.rawDB()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err = db.Exec("DROP DATABASE IF EXISTS `" + name + "`")
+	return err
+}
+
+// 启动Docker容器
+func (this *MySQLServer) startContainer() error {
+	dockerExe, err := executils.LookPath("docker")
+	if err != nil || len(dockerExe) == 0 {
+		return ErrMySQLUnavailable
+	}
+
+	var password = rands.HexString(16)
+	var runCmd = executils.NewTimeoutCmd(5*time.Minute, dockerExe,
+		"run", "-d", "--rm",
+		"--label", "goedge.harness=1",
+		"-e", "MYSQL_ROOT_PASSWORD="+password,
+		"-p", "127.0.0.1::3306",
+		this.image,
+		"--character-set-server=utf8mb4",
+		"--collation-server=utf8mb4_general_ci",
+	).WithStdout().WithStderr()
+	err = runCmd.Run()
+	if err != nil {
+		return fmt.Errorf("start mysql container failed: %w: %s", err, runCmd.Stderr())
+	}
+	this.containerId = runCmd.Stdout()
+	if len(this.containerId) == 0 {
+		return errors.New("start mysql container failed: empty container id")
+	}
+
+	// 读取映射的端口
+	var portCmd = executils.NewTimeoutCmd(30*time.Second, dockerExe, "port", this.containerId, "3306/tcp").WithStdout().WithStderr()
+	err = portCmd.Run()
+	if err != nil {
+		_ = this.Stop()
+		return fmt.Errorf("read mysql container port failed: %w: %s", err, portCmd.Stderr())
+	}
+
+	// 可能会返回多行，比如同时包含IPv4和IPv6的地址，这里只取第一行
+	var addr = strings.TrimSpace(strings.Split(portCmd.Stdout(), "\n")[0])
+	if len(addr) == 0 {
+		_ = this.Stop()
+		return errors.New("read mysql container port failed: empty address")
+	}
+
+	this.dsn = "root:" + password + "@tcp(" + addr + ")/"
+	return nil
+}
+
+// 等待服务可以连接
+// MySQL容器在初始化数据目录时会重启一次，所以需要持续检查直到超时
+func (this *MySQLServer) waitReady(timeout time.Duration) error {
+	db, err := this.rawDB()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	var lastErr error
+	var deadline = time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		lastErr = db.Raw().PingContext(ctx)
+		cancel()
+		if lastErr == nil {
+			return nil
+		}
+		time.Sleep(1 * time.Second)
+	}
+	return fmt.Errorf("waiting mysql timeout: %w", lastErr)
+}
+
+func (this *MySQLServer) rawDB() (*dbs.DB, error) {
+	db, err := dbs.NewInstanceFromConfig(&dbs.DBConfig{
+		Driver: "mysql",
+		Dsn:    this.dsn + "?charset=utf8mb4&timeout=30s",
+		Prefix: "edge",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create database instance failed: %w", err)
+	}
+	return db, nil
+}
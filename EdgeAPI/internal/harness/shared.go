@@ -0,0 +1,61 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package harness
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"testing"
+)
+
+var sharedHarness *Harness
+var sharedErr error
+var sharedOnce = sync.Once{}
+
+// Setup 获取当前进程中共享的测试环境，第一次调用时启动
+// 如果没有可用的MySQL服务或者使用了 -short 参数，则跳过当前测试
+//
+// 使用示例：
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(harness.Main(m))
+//	}
+//
+//	func TestXXX(t *testing.T) {
+//		var h = harness.Setup(t)
+//		...
+//	}
+func Setup(t testing.TB) *Harness {
+	if testing.Short() {
+		t.Skip("skip integration test in short mode")
+	}
+
+	sharedOnce.Do(func() {
+		var options = DefaultOptions()
+		options.Verbose = testing.Verbose()
+		sharedHarness = NewHarness(options)
+		sharedErr = sharedHarness.Start()
+	})
+
+	if sharedErr != nil {
+		if errors.Is(sharedErr, ErrMySQLUnavailable) {
+			t.Skip("skip integration test: " + sharedErr.Error())
+		}
+		t.Fatal("start harness failed: " + sharedErr.Error())
+	}
+	return sharedHarness
+}
+
+// Main 执行所有测试，并在结束后清理共享的测试环境
+// 需要在 TestMain() 中调用，否则临时启动的MySQL容器不会被删除
+func Main(m *testing.M) int {
+	var code = m.Run()
+	if sharedHarness != nil {
+		err := sharedHarness.Stop()
+		if err != nil {
+			log.Println("[HARNESS]stop harness failed: " + err.Error())
+		}
+	}
+	return code
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package harness
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
+	"github.com/TeaOSLab/EdgeAPI/internal/encrypt"
+	"github.com/iwind/TeaGo/maps"
+	"google.golang.org/grpc/metadata"
+)
+
+// 生成调用RPC时使用的上下文
+// 和各个节点中RPC客户端生成的认证信息保持一致
+func composeContext(nodeId string, secret string, userType string, userId int64) (context.Context, error) {
+	var m = maps.Map{
+		"timestamp": time.Now().Unix(),
+		"type":      userType,
+		"userId":    userId,
+	}
+
+	method, err := encrypt.NewMethodInstance(teaconst.EncryptMethod, secret, nodeId)
+	if err != nil {
+		return nil, err
+	}
+	data, err := method.Encrypt(m.AsJSON())
+	if err != nil {
+		return nil, err
+	}
+	var token = base64.StdEncoding.EncodeToString(data)
+
+	return metadata.AppendToOutgoingContext(context.Background(), "nodeId", nodeId, "token", token), nil
+}
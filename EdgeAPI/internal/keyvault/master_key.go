@@ -0,0 +1,97 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package keyvault
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+)
+
+// LoadMasterKey 根据配置加载主密钥
+func LoadMasterKey(config *configs.KeyEncryptionConfig) ([]byte, error) {
+	switch config.Type {
+	case configs.KeyEncryptionTypeFile:
+		return loadMasterKeyFromFile(config.KeyFile)
+	case configs.KeyEncryptionTypeAliyunKMS:
+		return loadMasterKeyFromAliyunKMS(config.AliyunKMS)
+	}
+	return nil, errors.New("invalid key encryption type '" + config.Type + "'")
+}
+
+// 从本地文件中读取
+func loadMasterKeyFromFile(keyFile string) ([]byte, error) {
+	if len(keyFile) == 0 {
+		return nil, errors.New("'keyFile' required")
+	}
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeMasterKey(string(bytes.TrimSpace(data)))
+}
+
+// 通过阿里云KMS解密数据密钥
+func loadMasterKeyFromAliyunKMS(config *configs.AliyunKMSConfig) ([]byte, error) {
+	if config == nil {
+		return nil, errors.New("'aliyunKMS' required")
+	}
+	if len(config.RegionId) == 0 {
+		return nil, errors.New("'regionId' required")
+	}
+	if len(config.CiphertextBlob) == 0 {
+		return nil, errors.New("'ciphertextBlob' required")
+	}
+
+	client, err := sdk.NewClientWithAccessKey(config.RegionId, config.AccessKeyId, config.AccessKeySecret)
+	if err != nil {
+		return nil, err
+	}
+
+	var req = requests.NewCommonRequest()
+	req.Method = "POST"
+	req.Scheme = "https"
+	req.Domain = "kms." + config.RegionId + ".aliyuncs.com"
+	req.Version = "2016-01-20"
+	req.ApiName = "Decrypt"
+	req.FormParams["CiphertextBlob"] = config.CiphertextBlob
+
+	resp, err := client.ProcessCommonRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result = struct {
+		Plaintext string `json:"Plaintext"`
+	}{}
+	err = json.Unmarshal(resp.GetHttpContentBytes(), &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Plaintext) == 0 {
+		return nil, errors.New("empty 'Plaintext' in response")
+	}
+	return DecodeMasterKey(result.Plaintext)
+}
+
+// DecodeMasterKey 解析HEX或Base64编码的主密钥
+func DecodeMasterKey(encodedKey string) ([]byte, error) {
+	if len(encodedKey) == MasterKeySize*2 {
+		key, err := hex.DecodeString(encodedKey)
+		if err == nil {
+			return key, nil
+		}
+	}
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err == nil && len(key) == MasterKeySize {
+		return key, nil
+	}
+	return nil, errors.New("master key should be 32 bytes encoded in HEX or Base64")
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package keyvault
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"sync"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+)
+
+// EncryptedPrefix 加密后数据的前缀，格式为：enc:v1:主密钥ID:Base64(随机数+密文)
+const EncryptedPrefix = "enc:v1:"
+
+const MasterKeySize = 32
+
+var sharedVault *Vault
+var sharedVaultOnce = sync.Once{}
+
+// SharedVault 获取共享的密钥库
+// 第一次调用时从API节点配置中加载主密钥，没有配置时不加密
+func SharedVault() *Vault {
+	sharedVaultOnce.Do(func() {
+		sharedVault = &Vault{}
+
+		config, err := configs.SharedAPIConfig()
+		if err != nil || config.KeyEncryption == nil || len(config.KeyEncryption.Type) == 0 {
+			return
+		}
+
+		sharedVault.isEnabled = true
+		masterKey, err := LoadMasterKey(config.KeyEncryption)
+		if err != nil {
+			sharedVault.err = errors.New("load master key failed: " + err.Error())
+			return
+		}
+		sharedVault.err = sharedVault.init(masterKey)
+	})
+	return sharedVault
+}
+
+// Vault 使用主密钥加密和解密数据
+type Vault struct {
+	isEnabled bool
+	keyId     string
+	aead      cipher.AEAD
+	err       error
+}
+
+// NewVault 使用主密钥构造密钥库
+func NewVault(masterKey []byte) (*Vault, error) {
+	var vault = &Vault{isEnabled: true}
+	err := vault.init(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	return vault, nil
+}
+
+func (this *Vault) init(masterKey []byte) error {
+	if len(masterKey) != MasterKeySize {
+		return errors.New("master key should be 32 bytes")
+	}
+
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	this.aead = aead
+
+	// 主密钥ID，用来识别数据是否使用当前主密钥加密
+	var sum = sha256.Sum256(masterKey)
+	this.keyId = hex.EncodeToString(sum[:4])
+	return nil
+}
+
+// IsEnabled 是否启用了加密
+func (this *Vault) IsEnabled() bool {
+	return this.isEnabled
+}
+
+// Err 加载主密钥时的错误
+func (this *Vault) Err() error {
+	return this.err
+}
+
+// Encrypt 加密数据
+// 没有启用加密时返回原始数据
+func (this *Vault) Encrypt(plainData []byte) ([]byte, error) {
+	if !this.isEnabled || len(plainData) == 0 || IsEncrypted(plainData) {
+		return plainData, nil
+	}
+	if this.err != nil {
+		return nil, this.err
+	}
+
+	var nonce = make([]byte, this.aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+	var sealed = this.aead.Seal(nonce, nonce, plainData, []byte(this.keyId))
+
+	var result = []byte(EncryptedPrefix + this.keyId + ":")
+	return append(result, base64.StdEncoding.EncodeToString(sealed)...), nil
+}
+
+// Decrypt 解密数据
+// 未加密的数据直接返回，以便兼容加密之前保存的数据
+func (this *Vault) Decrypt(data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	if !this.isEnabled {
+		return nil, errors.New("data is encrypted, but key encryption is not configured")
+	}
+	if this.err != nil {
+		return nil, this.err
+	}
+
+	var pieces = bytes.SplitN(data[len(EncryptedPrefix):], []byte(":"), 2)
+	if len(pieces) != 2 {
+		return nil, errors.New("invalid encrypted data")
+	}
+	if string(pieces[0]) != this.keyId {
+		return nil, errors.New("data is encrypted with another master key '" + string(pieces[0]) + "'")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(string(pieces[1]))
+	if err != nil {
+		return nil, errors.New("invalid encrypted data: " + err.Error())
+	}
+	var nonceSize = this.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, errors.New("invalid encrypted data")
+	}
+	plainData, err := this.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], pieces[0])
+	if err != nil {
+		return nil, errors.New("decrypt failed: " + err.Error())
+	}
+	return plainData, nil
+}
+
+// IsEncrypted 判断数据是否已加密
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(EncryptedPrefix))
+}
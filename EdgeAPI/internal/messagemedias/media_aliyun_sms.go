@@ -0,0 +1,86 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package messagemedias
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dysmsapi"
+)
+
+// AliyunSmsMedia 阿里云短信
+type AliyunSmsMedia struct {
+	AccessKeyId     string `json:"accessKeyId"`
+	AccessKeySecret string `json:"accessKeySecret"`
+	Sign            string `json:"sign"`         // 签名名称
+	TemplateCode    string `json:"templateCode"` // 模板CODE
+
+	// 模板变量，变量名 => 变量值模板，比如 subject => ${MessageSubject}
+	Variables []*SmsTemplateVariable `json:"variables"`
+}
+
+// SmsTemplateVariable 短信模板变量
+type SmsTemplateVariable struct {
+	Name  string `json:"name"`  // 变量名
+	Value string `json:"value"` // 变量值模板
+}
+
+func NewAliyunSmsMedia() *AliyunSmsMedia {
+	return &AliyunSmsMedia{}
+}
+
+func (this *AliyunSmsMedia) Send(user string, subject string, body string) (respBytes []byte, err error) {
+	if len(user) == 0 {
+		return nil, errors.New("'user' (mobile) should not be empty")
+	}
+	if len(this.AccessKeyId) == 0 || len(this.AccessKeySecret) == 0 {
+		return nil, errors.New("'accessKeyId' and 'accessKeySecret' should not be empty")
+	}
+	if len(this.Sign) == 0 {
+		return nil, errors.New("'sign' should not be empty")
+	}
+	if len(this.TemplateCode) == 0 {
+		return nil, errors.New("'templateCode' should not be empty")
+	}
+
+	client, err := dysmsapi.NewClientWithAccessKey("cn-hangzhou", this.AccessKeyId, this.AccessKeySecret)
+	if err != nil {
+		return nil, err
+	}
+
+	var templateParams = map[string]string{}
+	for _, variable := range this.Variables {
+		if len(variable.Name) == 0 {
+			continue
+		}
+		// 阿里云短信单个变量最多35个字符
+		templateParams[variable.Name] = truncateString(RenderTemplate(variable.Value, user, subject, body), 35)
+	}
+	templateParamsJSON, err := json.Marshal(templateParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var req = dysmsapi.CreateSendSmsRequest()
+	req.Scheme = "https"
+	req.PhoneNumbers = user
+	req.SignName = this.Sign
+	req.TemplateCode = this.TemplateCode
+	req.TemplateParam = string(templateParamsJSON)
+
+	resp, err := client.SendSms(req)
+	if err != nil {
+		return nil, err
+	}
+	respBytes = resp.GetHttpContentBytes()
+	if resp.Code != "OK" {
+		return respBytes, errors.New("send sms failed: " + resp.Code + ": " + resp.Message)
+	}
+	return respBytes, nil
+}
+
+// RequireUser 是否需要用户标识
+func (this *AliyunSmsMedia) RequireUser() bool {
+	return true
+}
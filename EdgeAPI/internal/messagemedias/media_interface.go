@@ -0,0 +1,13 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package messagemedias
+
+// MediaInterface 消息媒介接口
+type MediaInterface interface {
+	// Send 发送消息
+	// user 为接收用户标识，比如手机号、企业微信成员账号等
+	Send(user string, subject string, body string) (respBytes []byte, err error)
+
+	// RequireUser 是否需要接收用户标识
+	RequireUser() bool
+}
@@ -0,0 +1,182 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package messagemedias
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	"github.com/iwind/TeaGo/types"
+)
+
+// 企业微信应用的AccessToken缓存
+var qyWeixinTokenMap = map[string]*qyWeixinToken{} // corpId@corpSecret => token
+var qyWeixinTokenLocker = &sync.Mutex{}
+
+type qyWeixinToken struct {
+	AccessToken string
+	ExpiresAt   int64
+}
+
+// QyWeixinMedia 企业微信应用
+type QyWeixinMedia struct {
+	CorpId     string `json:"corpId"`     // 企业ID
+	AgentId    string `json:"agentId"`    // 应用AgentId
+	AppSecret  string `json:"appSecret"`  // 应用Secret
+	TextFormat string `json:"textFormat"` // 内容格式：text、markdown
+	Template   string `json:"template"`   // 消息模板，为空表示使用默认格式
+}
+
+func NewQyWeixinMedia() *QyWeixinMedia {
+	return &QyWeixinMedia{}
+}
+
+func (this *QyWeixinMedia) Send(user string, subject string, body string) (respBytes []byte, err error) {
+	if len(this.CorpId) == 0 {
+		return nil, errors.New("'corpId' should not be empty")
+	}
+	if len(this.AgentId) == 0 {
+		return nil, errors.New("'agentId' should not be empty")
+	}
+	if len(this.AppSecret) == 0 {
+		return nil, errors.New("'appSecret' should not be empty")
+	}
+
+	accessToken, err := this.findAccessToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(user) == 0 {
+		user = "@all"
+	}
+
+	var content = RenderTemplate(this.Template, user, subject, body)
+	var msg = map[string]any{
+		"touser":  user,
+		"agentid": types.Int64(this.AgentId),
+	}
+	if this.TextFormat == "markdown" {
+		msg["msgtype"] = "markdown"
+		msg["markdown"] = map[string]any{
+			"content": content,
+		}
+	} else {
+		msg["msgtype"] = "text"
+		msg["text"] = map[string]any{
+			"content": content,
+		}
+	}
+
+	msgJSON, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	respBytes, err = postJSON("https://qyapi.weixin.qq.com/cgi-bin/message/send?access_token="+url.QueryEscape(accessToken), msgJSON)
+	if err != nil {
+		return respBytes, err
+	}
+	return respBytes, checkQyWeixinResponse(respBytes)
+}
+
+// RequireUser 是否需要用户标识
+func (this *QyWeixinMedia) RequireUser() bool {
+	return false
+}
+
+// 获取AccessToken
+func (this *QyWeixinMedia) findAccessToken() (string, error) {
+	var cacheKey = this.CorpId + "@" + this.AppSecret
+
+	qyWeixinTokenLocker.Lock()
+	defer qyWeixinTokenLocker.Unlock()
+
+	token, ok := qyWeixinTokenMap[cacheKey]
+	if ok && token.ExpiresAt > time.Now().Unix() {
+		return token.AccessToken, nil
+	}
+
+	resp, err := utils.SharedHttpClient(10 * time.Second).Get("https://qyapi.weixin.qq.com/cgi-bin/gettoken?corpid=" + url.QueryEscape(this.CorpId) + "&corpsecret=" + url.QueryEscape(this.AppSecret))
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var tokenResp = &struct {
+		ErrCode     int    `json:"errcode"`
+		ErrMsg      string `json:"errmsg"`
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}{}
+	err = json.Unmarshal(data, tokenResp)
+	if err != nil {
+		return "", errors.New("decode access token response failed: " + err.Error())
+	}
+	if tokenResp.ErrCode != 0 {
+		return "", errors.New("get access token failed: " + tokenResp.ErrMsg)
+	}
+
+	// 提前1分钟过期
+	qyWeixinTokenMap[cacheKey] = &qyWeixinToken{
+		AccessToken: tokenResp.AccessToken,
+		ExpiresAt:   time.Now().Unix() + tokenResp.ExpiresIn - 60,
+	}
+	return tokenResp.AccessToken, nil
+}
+
+// 检查企业微信接口返回的错误
+func checkQyWeixinResponse(respBytes []byte) error {
+	var resp = &struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}{}
+	err := json.Unmarshal(respBytes, resp)
+	if err != nil {
+		return errors.New("decode response failed: " + err.Error())
+	}
+	if resp.ErrCode != 0 {
+		return errors.New("send message failed: " + types.String(resp.ErrCode) + ": " + resp.ErrMsg)
+	}
+	return nil
+}
+
+// 发送JSON数据
+func postJSON(apiURL string, data []byte) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodPost, apiURL, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	resp, err := utils.SharedHttpClient(10 * time.Second).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return respBytes, errors.New("invalid response status code '" + types.String(resp.StatusCode) + "': " + strings.TrimSpace(string(respBytes)))
+	}
+	return respBytes, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package messagemedias
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var qyWeixinMobilesSplitReg = regexp.MustCompile(`\s*[,，]\s*`)
+
+// QyWeixinRobotMedia 企业微信群机器人
+type QyWeixinRobotMedia struct {
+	WebHookURL string `json:"webHookURL"` // WebHook地址
+	TextFormat string `json:"textFormat"` // 内容格式：text、markdown
+	Template   string `json:"template"`   // 消息模板，为空表示使用默认格式
+}
+
+func NewQyWeixinRobotMedia() *QyWeixinRobotMedia {
+	return &QyWeixinRobotMedia{}
+}
+
+func (this *QyWeixinRobotMedia) Send(user string, subject string, body string) (respBytes []byte, err error) {
+	if len(this.WebHookURL) == 0 {
+		return nil, errors.New("'webHookURL' should not be empty")
+	}
+
+	// user 为要At的成员手机号，多个用逗号隔开
+	var mobiles = []string{}
+	for _, mobile := range qyWeixinMobilesSplitReg.Split(strings.TrimSpace(user), -1) {
+		if len(mobile) > 0 {
+			mobiles = append(mobiles, mobile)
+		}
+	}
+
+	var content = RenderTemplate(this.Template, user, subject, body)
+	var msg = map[string]any{}
+	if this.TextFormat == "markdown" {
+		// markdown格式不支持mentioned_mobile_list，只能在内容中使用<@userid>
+		msg["msgtype"] = "markdown"
+		msg["markdown"] = map[string]any{
+			"content": content,
+		}
+	} else {
+		msg["msgtype"] = "text"
+		msg["text"] = map[string]any{
+			"content":               content,
+			"mentioned_mobile_list": mobiles,
+		}
+	}
+
+	msgJSON, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	respBytes, err = postJSON(this.WebHookURL, msgJSON)
+	if err != nil {
+		return respBytes, err
+	}
+	return respBytes, checkQyWeixinResponse(respBytes)
+}
+
+// RequireUser 是否需要用户标识
+func (this *QyWeixinRobotMedia) RequireUser() bool {
+	return false
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package messagemedias
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
+	sms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sms/v20210111"
+)
+
+// TencentSmsMedia 腾讯云短信
+type TencentSmsMedia struct {
+	SecretId    string   `json:"secretId"`
+	SecretKey   string   `json:"secretKey"`
+	Region      string   `json:"region"`      // 地域，默认为 ap-guangzhou
+	SdkAppId    string   `json:"sdkAppId"`    // 短信应用ID
+	Sign        string   `json:"sign"`        // 签名内容
+	TemplateId  string   `json:"templateId"`  // 模板ID
+	ParamValues []string `json:"paramValues"` // 按顺序排列的模板参数值模板，比如 ${MessageSubject}
+}
+
+func NewTencentSmsMedia() *TencentSmsMedia {
+	return &TencentSmsMedia{}
+}
+
+func (this *TencentSmsMedia) Send(user string, subject string, body string) (respBytes []byte, err error) {
+	if len(user) == 0 {
+		return nil, errors.New("'user' (mobile) should not be empty")
+	}
+	if len(this.SecretId) == 0 || len(this.SecretKey) == 0 {
+		return nil, errors.New("'secretId' and 'secretKey' should not be empty")
+	}
+	if len(this.SdkAppId) == 0 {
+		return nil, errors.New("'sdkAppId' should not be empty")
+	}
+	if len(this.TemplateId) == 0 {
+		return nil, errors.New("'templateId' should not be empty")
+	}
+
+	var region = this.Region
+	if len(region) == 0 {
+		region = "ap-guangzhou"
+	}
+
+	client, err := sms.NewClient(common.NewCredential(this.SecretId, this.SecretKey), region, profile.NewClientProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	// 国内手机号需要加上+86前缀
+	var mobile = user
+	if !strings.HasPrefix(mobile, "+") {
+		mobile = "+86" + mobile
+	}
+
+	var params = []string{}
+	for _, value := range this.ParamValues {
+		params = append(params, RenderTemplate(value, user, subject, body))
+	}
+
+	var req = sms.NewSendSmsRequest()
+	req.PhoneNumberSet = common.StringPtrs([]string{mobile})
+	req.SmsSdkAppId = common.StringPtr(this.SdkAppId)
+	req.SignName = common.StringPtr(this.Sign)
+	req.TemplateId = common.StringPtr(this.TemplateId)
+	req.TemplateParamSet = common.StringPtrs(params)
+
+	resp, err := client.SendSms(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBytes, err = json.Marshal(resp.Response)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range resp.Response.SendStatusSet {
+		if status.Code != nil && *status.Code != "Ok" {
+			var message string
+			if status.Message != nil {
+				message = *status.Message
+			}
+			return respBytes, errors.New("send sms failed: " + *status.Code + ": " + message)
+		}
+	}
+	return respBytes, nil
+}
+
+// RequireUser 是否需要用户标识
+func (this *TencentSmsMedia) RequireUser() bool {
+	return true
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package messagemedias
+
+import (
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/monitorconfigs"
+)
+
+var SharedRateLimiter = NewRateLimiter()
+
+// RateLimiter 媒介实例发送频率限制
+// 每个媒介实例在 Minutes 分钟内最多发送 Count 条消息
+type RateLimiter struct {
+	timestampsMap map[int64][]int64 // instanceId => [timestamp1, ...]
+	locker        sync.Mutex
+}
+
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		timestampsMap: map[int64][]int64{},
+	}
+}
+
+// Allow 检查是否允许发送，如果允许则记录一次发送
+func (this *RateLimiter) Allow(instanceId int64, rate *monitorconfigs.RateConfig) bool {
+	if rate == nil || rate.Minutes <= 0 || rate.Count <= 0 {
+		return true
+	}
+
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	var now = time.Now().Unix()
+	var minTimestamp = now - int64(rate.Minutes)*60
+
+	// 清理过期的记录
+	var timestamps = this.timestampsMap[instanceId]
+	var index = 0
+	for index < len(timestamps) && timestamps[index] <= minTimestamp {
+		index++
+	}
+	timestamps = timestamps[index:]
+
+	if len(timestamps) >= int(rate.Count) {
+		this.timestampsMap[instanceId] = timestamps
+		return false
+	}
+
+	this.timestampsMap[instanceId] = append(timestamps, now)
+	return true
+}
+
+// Reset 重置某个媒介实例的发送记录
+func (this *RateLimiter) Reset(instanceId int64) {
+	this.locker.Lock()
+	delete(this.timestampsMap, instanceId)
+	this.locker.Unlock()
+}
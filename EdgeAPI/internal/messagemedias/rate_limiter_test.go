@@ -0,0 +1,29 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package messagemedias_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/messagemedias"
+	"github.com/TeaOSLab/EdgeCommon/pkg/monitorconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var limiter = messagemedias.NewRateLimiter()
+	var rate = &monitorconfigs.RateConfig{
+		Minutes: 1,
+		Count:   2,
+	}
+	a.IsTrue(limiter.Allow(1, rate))
+	a.IsTrue(limiter.Allow(1, rate))
+	a.IsFalse(limiter.Allow(1, rate))
+	a.IsTrue(limiter.Allow(2, rate))
+	a.IsTrue(limiter.Allow(1, nil))
+
+	limiter.Reset(1)
+	a.IsTrue(limiter.Allow(1, rate))
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package messagemedias
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/monitorconfigs"
+	"github.com/iwind/TeaGo/maps"
+)
+
+// ErrRateLimited 超出媒介实例的发送频率限制
+var ErrRateLimited = errors.New("message media instance exceeds the sending rate limit")
+
+// SendWithInstance 使用媒介实例发送消息
+func SendWithInstance(instance *models.MessageMediaInstance, user string, subject string, body string) (respBytes []byte, err error) {
+	if instance == nil {
+		return nil, errors.New("media instance should not be nil")
+	}
+	if !instance.IsOn {
+		return nil, errors.New("media instance '" + instance.Name + "' is disabled")
+	}
+
+	var params = maps.Map{}
+	if len(instance.Params) > 0 {
+		err = json.Unmarshal(instance.Params, &params)
+		if err != nil {
+			return nil, errors.New("decode media params failed: " + err.Error())
+		}
+	}
+
+	media, err := NewMediaWithType(instance.MediaType, params)
+	if err != nil {
+		return nil, err
+	}
+	if media.RequireUser() && len(user) == 0 {
+		return nil, errors.New("media '" + FindMediaTypeName(instance.MediaType) + "' require user")
+	}
+
+	// 发送频率
+	if models.IsNotNull(instance.Rate) {
+		var rateConfig = &monitorconfigs.RateConfig{}
+		err = json.Unmarshal(instance.Rate, rateConfig)
+		if err != nil {
+			return nil, errors.New("decode rate config failed: " + err.Error())
+		}
+		if !SharedRateLimiter.Allow(int64(instance.Id), rateConfig) {
+			return nil, ErrRateLimited
+		}
+	}
+
+	return media.Send(user, subject, body)
+}
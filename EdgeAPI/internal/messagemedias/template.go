@@ -0,0 +1,51 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package messagemedias
+
+import (
+	"strings"
+	"time"
+
+	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+// 模板中可以使用的变量
+const (
+	TemplateVarMessageUser    = "${MessageUser}"
+	TemplateVarMessageSubject = "${MessageSubject}"
+	TemplateVarMessageBody    = "${MessageBody}"
+	TemplateVarProductName    = "${ProductName}"
+	TemplateVarDatetime       = "${Datetime}"
+)
+
+// RenderTemplate 使用消息内容渲染模板
+// 如果模板为空，则使用默认的格式
+func RenderTemplate(template string, user string, subject string, body string) string {
+	if len(strings.TrimSpace(template)) == 0 {
+		if len(subject) == 0 {
+			return body
+		}
+		return subject + "\n\n" + body
+	}
+
+	return strings.NewReplacer(
+		TemplateVarMessageUser, user,
+		TemplateVarMessageSubject, subject,
+		TemplateVarMessageBody, body,
+		TemplateVarProductName, teaconst.GlobalProductName,
+		TemplateVarDatetime, timeutil.Format("Y-m-d H:i:s", time.Now()),
+	).Replace(template)
+}
+
+// 截取字符串，以免超出短信等媒介的长度限制
+func truncateString(s string, maxRunes int) string {
+	if maxRunes <= 0 {
+		return s
+	}
+	var runes = []rune(s)
+	if len(runes) <= maxRunes {
+		return s
+	}
+	return string(runes[:maxRunes])
+}
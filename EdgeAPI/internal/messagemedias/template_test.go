@@ -0,0 +1,18 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package messagemedias_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/messagemedias"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsTrue(messagemedias.RenderTemplate("", "", "Hello", "World") == "Hello\n\nWorld")
+	a.IsTrue(messagemedias.RenderTemplate("", "", "", "World") == "World")
+	a.IsTrue(messagemedias.RenderTemplate("[${MessageSubject}] ${MessageBody} @${MessageUser}", "13800000000", "Hello", "World") == "[Hello] World @13800000000")
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package messagemedias
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/iwind/TeaGo/maps"
+)
+
+type MediaType = string
+
+// 媒介类型代号，需要和 edgeMessageMedias 表中的 type 保持一致
+const (
+	MediaTypeQyWeixin      MediaType = "qyWeixin"      // 企业微信应用
+	MediaTypeQyWeixinRobot MediaType = "qyWeixinRobot" // 企业微信群机器人
+	MediaTypeAliyunSms     MediaType = "aliyunSms"     // 阿里云短信
+	MediaTypeTencentSms    MediaType = "tencentSms"    // 腾讯云短信
+)
+
+// FindAllMediaTypes 当前支持的所有媒介类型
+func FindAllMediaTypes() []maps.Map {
+	return []maps.Map{
+		{
+			"name":        "企业微信应用",
+			"code":        MediaTypeQyWeixin,
+			"description": "通过企业微信应用发送通知消息。",
+		},
+		{
+			"name":        "企业微信群机器人",
+			"code":        MediaTypeQyWeixinRobot,
+			"description": "通过企业微信群机器人发送通知消息。",
+		},
+		{
+			"name":        "阿里云短信",
+			"code":        MediaTypeAliyunSms,
+			"description": "通过阿里云短信服务发送短信。",
+		},
+		{
+			"name":        "腾讯云短信",
+			"code":        MediaTypeTencentSms,
+			"description": "通过腾讯云短信服务发送短信。",
+		},
+	}
+}
+
+// FindMediaTypeName 查找媒介类型名称
+func FindMediaTypeName(mediaType MediaType) string {
+	for _, m := range FindAllMediaTypes() {
+		if m.GetString("code") == mediaType {
+			return m.GetString("name")
+		}
+	}
+	return ""
+}
+
+// NewMediaWithType 根据类型和参数创建媒介
+func NewMediaWithType(mediaType MediaType, params maps.Map) (MediaInterface, error) {
+	var media MediaInterface
+	switch mediaType {
+	case MediaTypeQyWeixin:
+		media = NewQyWeixinMedia()
+	case MediaTypeQyWeixinRobot:
+		media = NewQyWeixinRobotMedia()
+	case MediaTypeAliyunSms:
+		media = NewAliyunSmsMedia()
+	case MediaTypeTencentSms:
+		media = NewTencentSmsMedia()
+	default:
+		return nil, errors.New("unsupported media type '" + mediaType + "'")
+	}
+
+	if len(params) > 0 {
+		paramsJSON, err := json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(paramsJSON, media)
+		if err != nil {
+			return nil, errors.New("decode media params failed: " + err.Error())
+		}
+	}
+
+	return media, nil
+}
@@ -3,6 +3,7 @@ package nodes
 import (
 	"context"
 	"crypto/tls"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -27,7 +28,9 @@ import (
 	"github.com/TeaOSLab/EdgeAPI/internal/goman"
 	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
 	"github.com/TeaOSLab/EdgeAPI/internal/rpc"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/mtls"
 	"github.com/TeaOSLab/EdgeAPI/internal/setup"
+	"github.com/TeaOSLab/EdgeAPI/internal/tasks"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/iplibrary"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
@@ -49,6 +52,9 @@ import (
 
 var sharedAPIConfig *configs.APIConfig = nil
 
+// 停止时等待后台任务结束的最长时间
+const apiNodeShutdownTimeout = 60 * time.Second
+
 type APINode struct {
 	serviceInstanceMap    map[string]any
 	serviceInstanceLocker sync.Mutex
@@ -61,6 +67,8 @@ type APINode struct {
 	issuesFile string
 
 	progress *utils.Progress
+
+	quitOnce sync.Once
 }
 
 func NewAPINode() *APINode {
@@ -185,6 +193,24 @@ func (this *APINode) Start() {
 		NewNodeStatusExecutor().Listen()
 	})
 
+	// 请求频率限制
+	this.startRateLimitLoader()
+
+	// 双向TLS认证
+	this.startMTLSLoader()
+
+	// 健康检查
+	this.startHealthChecker()
+
+	// 数据库只读副本
+	this.startDBReplicas()
+
+	// 数据库表结构检查
+	this.startDBSchemaCheck()
+
+	// 数据库连接池
+	this.startDBPoolMonitor()
+
 	// 访问日志存储管理器
 	this.setProgress("ACCESS_LOG_STORAGES", "正在启动访问日志存储器")
 	this.startAccessLogStorages()
@@ -278,6 +304,7 @@ func (this *APINode) listenRPC(listener net.Listener, tlsConfig *tls.Config) err
 		grpc.MaxRecvMsgSize(512 << 20),
 		grpc.MaxSendMsgSize(512 << 20),
 		grpc.UnaryInterceptor(this.unaryInterceptor),
+		grpc.StreamInterceptor(this.streamInterceptor),
 	}
 
 	if tlsConfig == nil {
@@ -309,11 +336,15 @@ func (this *APINode) checkDB() error {
 		}
 
 		simpleConfig, err := configs.ParseSimpleDBConfig(data)
-		if err == nil && len(simpleConfig.Host) > 0 {
+		if err == nil && (len(simpleConfig.Host) > 0 || simpleConfig.IsSQLite()) {
 			err = simpleConfig.GenerateOldConfig()
 			if err != nil {
 				return err
 			}
+
+			this.setupDBFailover(simpleConfig)
+			this.setupSlowQuery(simpleConfig)
+			this.setupDAOCache(simpleConfig)
 		}
 	}
 
@@ -387,7 +418,10 @@ func (this *APINode) autoUpgrade() error {
 	}()
 	one, err := db.FindOne("SELECT version FROM edgeVersions LIMIT 1")
 	if err != nil {
-		return fmt.Errorf("query version failed: %w", err)
+		// 新创建的SQLite数据库中还没有任何表格，直接安装
+		if !dbutils.IsSQLite(db) || !strings.Contains(err.Error(), "no such table") {
+			return fmt.Errorf("query version failed: %w", err)
+		}
 	}
 	if one != nil {
 		// 如果是同样的版本，则直接认为是最新版本
@@ -408,6 +442,12 @@ func (this *APINode) autoUpgrade() error {
 	return nil
 }
 
+// ServeRPC 在指定的监听器上提供RPC服务
+// 不会执行检查数据库、升级等启动流程，需要调用者事先准备好数据库，主要用于集成测试
+func (this *APINode) ServeRPC(listener net.Listener) error {
+	return this.listenRPC(listener, nil)
+}
+
 // 自动设置数据库
 func (this *APINode) setupDB() error {
 	db, err := dbs.Default()
@@ -415,6 +455,11 @@ func (this *APINode) setupDB() error {
 		return err
 	}
 
+	// SQLite不需要设置
+	if dbutils.IsSQLite(db) {
+		return nil
+	}
+
 	// 检查是否为root用户
 	config, _ := db.Config()
 	if config == nil {
@@ -590,9 +635,9 @@ func (this *APINode) listenPorts(apiNode *models.APINode) (isListening bool) {
 					remotelogs.Println("API_NODE", "retry listening port ':"+port+"' only ok")
 				}
 				goman.New(func() {
-					err := this.listenRPC(listener, &tls.Config{
+					err := this.listenRPC(listener, mtls.SharedVerifier.ServerTLSConfig(&tls.Config{
 						Certificates: certs,
-					})
+					}))
 					if err != nil {
 						remotelogs.Error("API_NODE", "listening '"+addr+"' rpc: "+err.Error())
 						return
@@ -731,8 +776,7 @@ func (this *APINode) listenSock() error {
 				_ = cmd.ReplyOk()
 
 				// 退出主进程
-				events.Notify(events.EventQuit)
-				os.Exit(0)
+				this.quit()
 			case "starting": // 是否正在启动
 				_ = cmd.Reply(&gosock.Command{
 					Code: "starting",
@@ -793,6 +837,18 @@ func (this *APINode) listenSock() error {
 						Params: map[string]any{"count": 0},
 					})
 				}
+			case "db.pool": // 查询数据库连接池状态
+				db, _ := dbs.Default()
+				if db != nil {
+					var stats = db.Raw().Stats()
+					_ = cmd.Reply(&gosock.Command{
+						Params: dbutils.ComposePoolStatsValue(stats, sql.DBStats{}),
+					})
+				} else {
+					_ = cmd.Reply(&gosock.Command{
+						Params: map[string]any{},
+					})
+				}
 			case "instance": // 获取实例代号
 				_ = cmd.Reply(&gosock.Command{
 					Params: map[string]any{
@@ -853,8 +909,48 @@ func (this *APINode) listenSock() error {
 
 // 服务过滤器
 func (this *APINode) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	// 请求跟踪
+	ctx = traceGRPCContext(ctx)
+
+	// 客户端证书
+	err = this.checkClientCert(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	// API版本
+	versionHeaders, err := this.checkAPIVersion(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	_ = grpc.SetHeader(ctx, versionHeaders)
+
+	// 请求频率限制
+	err = this.checkRateLimit(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	// 审计日志
+	var before = time.Now()
+	defer func() {
+		recordAuditLog(ctx, info.FullMethod, req, err, before, "")
+	}()
+
+	// 慢调用日志
+	var tagCostMap map[string]float64
+	defer func() {
+		logSlowCall(ctx, info.FullMethod, time.Since(before), tagCostMap)
+	}()
+
+	// 响应压缩
+	defer func() {
+		if err == nil {
+			this.negotiateCompressor(ctx, resp)
+		}
+	}()
+
 	if teaconst.Debug {
-		var before = time.Now()
 		var traceCtx = rpc.NewContext(ctx)
 		resp, err = handler(traceCtx, req)
 
@@ -864,8 +960,8 @@ func (this *APINode) unaryInterceptor(ctx context.Context, req any, info *grpc.U
 			remotelogs.Error("API_NODE", "create method stat failed: "+statErr.Error())
 		}
 
-		var tagMap = traceCtx.TagMap()
-		for tag, tagCostMs := range tagMap {
+		tagCostMap = traceCtx.TagMap()
+		for tag, tagCostMs := range tagCostMap {
 			statErr = models.SharedAPIMethodStatDAO.CreateStat(nil, info.FullMethod, tag, tagCostMs)
 			if statErr != nil {
 				remotelogs.Error("API_NODE", "create method stat failed: "+statErr.Error())
@@ -878,7 +974,10 @@ func (this *APINode) unaryInterceptor(ctx context.Context, req any, info *grpc.U
 	if err != nil {
 		statusErr, ok := status.FromError(err)
 		if ok {
-			err = status.Error(statusErr.Code(), "'"+info.FullMethod+"()' says: "+err.Error())
+			// 保留错误详情
+			var statusProto = statusErr.Proto()
+			statusProto.Message = "'" + info.FullMethod + "()' says: " + err.Error()
+			err = status.ErrorProto(statusProto)
 		} else {
 			err = errors.New("'" + info.FullMethod + "()' says: " + err.Error())
 		}
@@ -972,10 +1071,36 @@ func (this *APINode) listenSignals() {
 	var queue = make(chan os.Signal, 8)
 	signal.Notify(queue, syscall.SIGTERM, syscall.SIGINT, syscall.SIGKILL, syscall.SIGQUIT)
 	goman.New(func() {
+		var isQuitting = false
 		for range queue {
-			events.Notify(events.EventQuit)
-			os.Exit(0)
-			return
+			// 再次收到信号时不再等待后台任务
+			if isQuitting {
+				events.Notify(events.EventQuit)
+				os.Exit(0)
+				return
+			}
+			isQuitting = true
+			goman.New(this.quit)
+		}
+	})
+}
+
+// 退出主进程
+// 退出之前停止后台任务并等待正在执行的任务结束，然后写入异步更新队列中的数据
+func (this *APINode) quit() {
+	this.quitOnce.Do(func() {
+		remotelogs.Println("API_NODE", "quitting ...")
+
+		tasks.SharedShutdownCoordinator.Shutdown(apiNodeShutdownTimeout)
+
+		if dbutils.SharedUpdateQueue.IsRunning() {
+			err := dbutils.SharedUpdateQueue.Flush()
+			if err != nil {
+				remotelogs.Error("API_NODE", "flush update queue failed: "+err.Error())
+			}
 		}
+
+		events.Notify(events.EventQuit)
+		os.Exit(0)
 	})
 }
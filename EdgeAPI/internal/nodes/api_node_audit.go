@@ -0,0 +1,64 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/audit"
+	rpcutils "github.com/TeaOSLab/EdgeAPI/internal/rpc/utils"
+	"google.golang.org/grpc/peer"
+)
+
+// 记录管理员和用户修改数据的RPC调用
+// ip 为空时从上下文中获取
+func recordAuditLog(ctx context.Context, fullMethod string, req any, callErr error, before time.Time, ip string) {
+	// 管理员模拟用户登录时查询操作也需要记录
+	var impersonatorAdminId = rpcutils.ImpersonatorAdminId(ctx)
+	if impersonatorAdminId <= 0 && !audit.IsMutatingMethod(fullMethod) {
+		return
+	}
+
+	userType, _, reqUserId, err := rpcutils.ValidateRequest(ctx, rpcutils.UserTypeAdmin, rpcutils.UserTypeUser)
+	if err != nil {
+		return
+	}
+
+	var adminId int64
+	var userId int64
+	switch userType {
+	case rpcutils.UserTypeAdmin:
+		adminId = reqUserId
+	case rpcutils.UserTypeUser:
+		userId = reqUserId
+	default:
+		return
+	}
+
+	if len(ip) == 0 {
+		p, ok := peer.FromContext(ctx)
+		if ok && p.Addr != nil {
+			ip, _, _ = net.SplitHostPort(p.Addr.String())
+		}
+	}
+
+	var errString string
+	if callErr != nil {
+		errString = callErr.Error()
+	}
+
+	audit.SharedRecorder.Add(&audit.Entry{
+		AdminId:             adminId,
+		UserId:              userId,
+		ImpersonatorAdminId: impersonatorAdminId,
+		Method:              fullMethod,
+		Request:             audit.Summary(req),
+		IsOk:                callErr == nil,
+		Error:               errString,
+		CostMs:              time.Since(before).Milliseconds(),
+		IP:                  ip,
+		IsRest:              rpcutils.IsRest(ctx),
+	})
+}
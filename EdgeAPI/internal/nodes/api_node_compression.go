@@ -0,0 +1,57 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/protobuf/proto"
+)
+
+// 响应压缩的最小尺寸，小于此尺寸的响应不压缩，以节省CPU
+const rpcCompressMinSize = 2 << 10
+
+// 按优先级排列的压缩算法
+// 只有在当前进程中注册了并且客户端通过 grpc-accept-encoding 声明支持时才会使用
+var rpcPreferredCompressors = []string{"zstd", gzip.Name}
+
+// 根据响应尺寸和客户端支持的压缩算法选择响应的压缩方式
+// 默认情况下gRPC会使用和请求相同的压缩方式，这里对于大的响应尽量压缩，对于小的响应不压缩
+func (this *APINode) negotiateCompressor(ctx context.Context, resp any) {
+	message, ok := resp.(proto.Message)
+	if !ok || message == nil {
+		return
+	}
+
+	clientCompressors, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil {
+		return
+	}
+
+	var compressorName = selectRPCCompressor(proto.Size(message), clientCompressors)
+	if len(compressorName) == 0 {
+		return
+	}
+	_ = grpc.SetSendCompressor(ctx, compressorName)
+}
+
+// 选择压缩算法，返回空表示保持默认
+func selectRPCCompressor(size int, clientCompressors []string) string {
+	if size < rpcCompressMinSize {
+		return encoding.Identity
+	}
+	for _, name := range rpcPreferredCompressors {
+		if encoding.GetCompressor(name) == nil {
+			continue
+		}
+		for _, clientName := range clientCompressors {
+			if clientName == name {
+				return name
+			}
+		}
+	}
+	return ""
+}
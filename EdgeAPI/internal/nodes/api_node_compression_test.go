@@ -0,0 +1,20 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"testing"
+
+	"github.com/iwind/TeaGo/assert"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+func TestSelectRPCCompressor(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsTrue(selectRPCCompressor(100, []string{gzip.Name}) == encoding.Identity)
+	a.IsTrue(selectRPCCompressor(1<<20, []string{gzip.Name}) == gzip.Name)
+	a.IsTrue(selectRPCCompressor(1<<20, []string{"zstd", gzip.Name}) == gzip.Name) // zstd没有注册
+	a.IsTrue(selectRPCCompressor(1<<20, nil) == "")
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/iwind/TeaGo/logs"
+)
+
+// 设置DAO缓存
+func (this *APINode) setupDAOCache(config *configs.SimpleDBConfig) {
+	if config.Cache == nil || !config.Cache.IsOn {
+		dbutils.SharedDAOCache.SetOn(false)
+		return
+	}
+
+	ttl, err := config.Cache.TTLDuration()
+	if err != nil {
+		logs.Println("[API_NODE]" + err.Error())
+	}
+	if ttl > 0 {
+		dbutils.SharedDAOCache.SetTTL(ttl)
+	}
+	dbutils.SharedDAOCache.SetOn(true)
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// 设置数据库连接池，并定时记录连接池状态
+func (this *APINode) startDBPoolMonitor() {
+	db, err := dbs.Default()
+	if err != nil {
+		return
+	}
+
+	// 空闲连接保留时间，其余的设置已经写入到 .db.yaml 中
+	data, err := os.ReadFile(Tea.ConfigFile("db.yaml"))
+	if err == nil {
+		config, err := configs.ParseSimpleDBConfig(data)
+		if err == nil && config.Pool != nil {
+			var maxIdleTime = config.Pool.MaxIdleTimeDuration()
+			if maxIdleTime > 0 {
+				db.Raw().SetConnMaxIdleTime(maxIdleTime)
+			}
+		}
+	}
+
+	goman.New(func() {
+		var ticker = time.NewTicker(1 * time.Minute)
+		var lastStats = db.Raw().Stats()
+		for range ticker.C {
+			var stats = db.Raw().Stats()
+			valueJSON, err := json.Marshal(dbutils.ComposePoolStatsValue(stats, lastStats))
+			lastStats = stats
+			if err != nil || sharedAPIConfig == nil {
+				continue
+			}
+
+			err = models.SharedNodeValueDAO.CreateValue(nil, 0, nodeconfigs.NodeRoleAPI, sharedAPIConfig.NumberId(), nodeconfigs.NodeValueItemDBPool, valueJSON, time.Now().Unix())
+			if err != nil {
+				remotelogs.Error("API_NODE", "save db pool stats failed: "+err.Error())
+			}
+		}
+	})
+}
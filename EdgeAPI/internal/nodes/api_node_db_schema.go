@@ -0,0 +1,46 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"strings"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeAPI/internal/setup"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+// 日志中最多列出的表结构差异数量
+const maxLoggedDBSchemaDrifts = 20
+
+// 检查数据库表结构
+// 升级过程中部分语句执行失败时，表结构会和当前版本不一致，这里在启动时提前报告，而不是等到执行SQL时才出错
+func (this *APINode) startDBSchemaCheck() {
+	goman.New(func() {
+		db, err := dbs.Default()
+		if err != nil {
+			return
+		}
+
+		drifts, err := setup.CheckSQLSchema(db)
+		if err != nil {
+			remotelogs.Error("API_NODE", "check database schema failed: "+err.Error())
+			return
+		}
+		if len(drifts) == 0 {
+			return
+		}
+
+		var driftStrings = []string{}
+		for _, drift := range drifts {
+			if len(driftStrings) >= maxLoggedDBSchemaDrifts {
+				driftStrings = append(driftStrings, "...")
+				break
+			}
+			driftStrings = append(driftStrings, drift.String())
+		}
+		remotelogs.Warn("API_NODE", "found "+types.String(len(drifts))+" database schema differences, please check the database upgrade: "+strings.Join(driftStrings, ", "))
+	})
+}
@@ -0,0 +1,25 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/failover"
+	"github.com/iwind/TeaGo/logs"
+	"github.com/iwind/TeaGo/types"
+)
+
+// 设置备用主库
+// 主库不可用时新的连接会自动切换到可用的备用主库
+func (this *APINode) setupDBFailover(config *configs.SimpleDBConfig) {
+	var dsnList = config.FailoverDSNList()
+	if len(dsnList) == 0 {
+		return
+	}
+	err := failover.Setup(dsnList)
+	if err != nil {
+		logs.Println("[API_NODE]setup db failover failed: " + err.Error())
+		return
+	}
+	logs.Println("[API_NODE]loaded " + types.String(len(dsnList)) + " db failover hosts")
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/events"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/iwind/TeaGo/dbs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// 标准的gRPC健康检查服务（grpc.health.v1.Health）
+// 供负载均衡器和Kubernetes就绪探针使用，不需要认证
+var sharedHealthServer = health.NewServer()
+
+// 注册健康检查服务
+func (this *APINode) registerHealthService(server *grpc.Server) {
+	healthpb.RegisterHealthServer(server, sharedHealthServer)
+}
+
+// 定时检查数据库连接，并更新健康状态
+func (this *APINode) startHealthChecker() {
+	var check = func() {
+		var status = healthpb.HealthCheckResponse_SERVING
+		err := this.pingDB()
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			remotelogs.Error("API_NODE", "health check: ping database failed: "+err.Error())
+		}
+		sharedHealthServer.SetServingStatus("", status)
+	}
+	check()
+
+	goman.New(func() {
+		var ticker = time.NewTicker(10 * time.Second)
+		for range ticker.C {
+			check()
+		}
+	})
+
+	// 退出时不再接收新的请求
+	events.On(events.EventQuit, func() {
+		sharedHealthServer.Shutdown()
+	})
+}
+
+// 检查数据库连接
+func (this *APINode) pingDB() error {
+	db, err := dbs.Default()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return db.Raw().PingContext(ctx)
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/mtls"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// 定时加载双向TLS认证设置和内部CA
+func (this *APINode) startMTLSLoader() {
+	var load = func() {
+		err := mtls.SharedVerifier.Reload(nil)
+		if err != nil {
+			remotelogs.Error("API_NODE", "load mtls config failed: "+err.Error())
+		}
+	}
+	load()
+
+	goman.New(func() {
+		var ticker = time.NewTicker(30 * time.Second)
+		for range ticker.C {
+			load()
+		}
+	})
+}
+
+// 检查客户端证书
+func (this *APINode) checkClientCert(ctx context.Context, fullMethod string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	var nodeIds = md.Get("nodeid")
+	if len(nodeIds) == 0 || len(nodeIds[0]) == 0 {
+		return nil
+	}
+
+	// 找不到令牌时交给后续的认证逻辑处理
+	apiToken, err := models.SharedApiTokenDAO.FindEnabledTokenWithNodeCacheable(nil, nodeIds[0])
+	if err != nil || apiToken == nil {
+		return nil
+	}
+
+	err = mtls.SharedVerifier.Verify(ctx, fullMethod, apiToken.Role, nodeIds[0])
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "'"+fullMethod+"()' says: "+err.Error())
+	}
+	return nil
+}
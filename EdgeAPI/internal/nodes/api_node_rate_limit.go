@@ -0,0 +1,73 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// 定时加载请求频率限制设置
+func (this *APINode) startRateLimitLoader() {
+	var load = func() {
+		config, err := models.SharedSysSettingDAO.ReadAPIRateLimitConfig(nil)
+		if err != nil {
+			remotelogs.Error("API_NODE", "read api rate limit config failed: "+err.Error())
+			return
+		}
+		ratelimit.SharedLimiter.UpdateConfig(config)
+	}
+	load()
+
+	goman.New(func() {
+		var ticker = time.NewTicker(30 * time.Second)
+		for range ticker.C {
+			load()
+		}
+	})
+}
+
+// 检查请求频率
+func (this *APINode) checkRateLimit(ctx context.Context, fullMethod string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	var nodeIds = md.Get("nodeid")
+	if len(nodeIds) == 0 {
+		return nil
+	}
+	if !ratelimit.SharedLimiter.Allow(nodeIds[0], fullMethod) {
+		return status.Error(codes.ResourceExhausted, "'"+fullMethod+"()' says: too many requests, please try again later")
+	}
+	return nil
+}
+
+// 流式请求过滤器
+func (this *APINode) streamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := this.checkClientCert(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	versionHeaders, err := this.checkAPIVersion(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	_ = stream.SetHeader(versionHeaders)
+
+	err = this.checkRateLimit(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
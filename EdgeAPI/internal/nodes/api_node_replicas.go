@@ -0,0 +1,39 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"os"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/types"
+)
+
+// 启动数据库只读副本
+func (this *APINode) startDBReplicas() {
+	data, err := os.ReadFile(Tea.ConfigFile("db.yaml"))
+	if err != nil {
+		return
+	}
+	config, err := configs.ParseSimpleDBConfig(data)
+	if err != nil {
+		remotelogs.Error("API_NODE", "parse db config failed: "+err.Error())
+		return
+	}
+
+	// SQLite单机部署时不使用只读副本
+	if config.IsSQLite() || len(config.Replicas) == 0 {
+		return
+	}
+
+	dbutils.SharedReplicaManager.Update(config.Replicas)
+	remotelogs.Println("API_NODE", "loaded "+types.String(dbutils.SharedReplicaManager.Count())+" db replicas")
+
+	goman.New(func() {
+		dbutils.SharedReplicaManager.Start()
+	})
+}
@@ -140,11 +140,26 @@ func (this *APINode) registerServices(server *grpc.Server) {
 		pb.RegisterHTTPRewriteRuleServiceServer(server, instance)
 		this.rest(instance)
 	}
+	{
+		var instance = this.serviceInstance(&services.ConfigValidationService{}).(*services.ConfigValidationService)
+		pb.RegisterConfigValidationServiceServer(server, instance)
+		this.rest(instance)
+	}
+	{
+		var instance = this.serviceInstance(&services.DependencyGraphService{}).(*services.DependencyGraphService)
+		pb.RegisterDependencyGraphServiceServer(server, instance)
+		this.rest(instance)
+	}
 	{
 		var instance = this.serviceInstance(&services.SSLCertService{}).(*services.SSLCertService)
 		pb.RegisterSSLCertServiceServer(server, instance)
 		this.rest(instance)
 	}
+	{
+		var instance = this.serviceInstance(&services.SSLCertDeployTargetService{}).(*services.SSLCertDeployTargetService)
+		pb.RegisterSSLCertDeployTargetServiceServer(server, instance)
+		this.rest(instance)
+	}
 	{
 		var instance = this.serviceInstance(&services.SSLPolicyService{}).(*services.SSLPolicyService)
 		pb.RegisterSSLPolicyServiceServer(server, instance)
@@ -190,6 +205,11 @@ func (this *APINode) registerServices(server *grpc.Server) {
 		pb.RegisterMessageServiceServer(server, instance)
 		this.rest(instance)
 	}
+	{
+		var instance = this.serviceInstance(&services.MessageSilenceService{}).(*services.MessageSilenceService)
+		pb.RegisterMessageSilenceServiceServer(server, instance)
+		this.rest(instance)
+	}
 	{
 		var instance = this.serviceInstance(&services.NodeGroupService{}).(*services.NodeGroupService)
 		pb.RegisterNodeGroupServiceServer(server, instance)
@@ -345,6 +365,11 @@ func (this *APINode) registerServices(server *grpc.Server) {
 		pb.RegisterUserAccessKeyServiceServer(server, instance)
 		this.rest(instance)
 	}
+	{
+		var instance = this.serviceInstance(&services.UserAlertRuleService{}).(*services.UserAlertRuleService)
+		pb.RegisterUserAlertRuleServiceServer(server, instance)
+		this.rest(instance)
+	}
 	{
 		var instance = this.serviceInstance(&services.SysLockerService{}).(*services.SysLockerService)
 		pb.RegisterSysLockerServiceServer(server, instance)
@@ -536,8 +561,83 @@ func (this *APINode) registerServices(server *grpc.Server) {
 		this.rest(instance)
 	}
 
+	{
+		var instance = this.serviceInstance(&services.WebhookService{}).(*services.WebhookService)
+		pb.RegisterWebhookServiceServer(server, instance)
+		this.rest(instance)
+	}
+
+	{
+		var instance = this.serviceInstance(&services.AuditLogService{}).(*services.AuditLogService)
+		pb.RegisterAuditLogServiceServer(server, instance)
+		this.rest(instance)
+	}
+
+	{
+		var instance = this.serviceInstance(&services.StatQueryService{}).(*services.StatQueryService)
+		pb.RegisterStatQueryServiceServer(server, instance)
+		this.rest(instance)
+	}
+
+	{
+		var instance = this.serviceInstance(&services.ConfigBundleService{}).(*services.ConfigBundleService)
+		pb.RegisterConfigBundleServiceServer(server, instance)
+		this.rest(instance)
+	}
+
+	{
+		var instance = this.serviceInstance(&services.QuotaService{}).(*services.QuotaService)
+		pb.RegisterQuotaServiceServer(server, instance)
+		this.rest(instance)
+	}
+
+	{
+		var instance = this.serviceInstance(&services.TaskService{}).(*services.TaskService)
+		pb.RegisterTaskServiceServer(server, instance)
+		this.rest(instance)
+	}
+
+	{
+		var instance = this.serviceInstance(&services.NodeCommandService{}).(*services.NodeCommandService)
+		pb.RegisterNodeCommandServiceServer(server, instance)
+		this.rest(instance)
+	}
+
+	{
+		var instance = this.serviceInstance(&services.SearchService{}).(*services.SearchService)
+		pb.RegisterSearchServiceServer(server, instance)
+		this.rest(instance)
+	}
+
+	{
+		var instance = this.serviceInstance(&services.InternalCAService{}).(*services.InternalCAService)
+		pb.RegisterInternalCAServiceServer(server, instance)
+		this.rest(instance)
+	}
+
+	{
+		var instance = this.serviceInstance(&services.BackupService{}).(*services.BackupService)
+		pb.RegisterBackupServiceServer(server, instance)
+		this.rest(instance)
+	}
+
+	{
+		var instance = this.serviceInstance(&services.AccessLogArchiveService{}).(*services.AccessLogArchiveService)
+		pb.RegisterAccessLogArchiveServiceServer(server, instance)
+		this.rest(instance)
+	}
+
+	{
+		var instance = this.serviceInstance(&services.ServerBulkUpdateService{}).(*services.ServerBulkUpdateService)
+		pb.RegisterServerBulkUpdateServiceServer(server, instance)
+		this.rest(instance)
+	}
+
 	APINodeServicesRegister(this, server)
 
+	// 健康检查
+	this.registerHealthService(server)
+
 	// TODO check service names
 	for serviceName := range server.GetServiceInfo() {
 		var index = strings.LastIndex(serviceName, ".")
@@ -0,0 +1,32 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/slowquery"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/iwind/TeaGo/logs"
+)
+
+// 设置慢查询日志
+// 慢查询会连同调用的DAO方法一起记录到远程日志中
+func (this *APINode) setupSlowQuery(config *configs.SimpleDBConfig) {
+	threshold, err := config.SlowQueryThresholdDuration()
+	if err != nil {
+		logs.Println("[API_NODE]" + err.Error())
+		threshold = slowquery.DefaultThreshold
+	}
+	slowquery.SetThreshold(threshold)
+	slowquery.SetLogFunc(func(message string) {
+		remotelogs.Warn("SLOW_QUERY", message)
+	})
+
+	if config.IsSQLite() || threshold <= 0 {
+		return
+	}
+	err = slowquery.Setup()
+	if err != nil {
+		logs.Println("[API_NODE]setup slow query log failed: " + err.Error())
+	}
+}
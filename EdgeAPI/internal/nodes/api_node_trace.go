@@ -0,0 +1,77 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/traceutils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// 为GRPC请求设置跟踪ID
+// 优先使用客户端传入的跟踪ID，以便和客户端的日志关联，并通过响应Header返回给客户端
+func traceGRPCContext(ctx context.Context) context.Context {
+	var traceId string
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		var values = md.Get(traceutils.MetadataKey)
+		if len(values) > 0 && traceutils.IsValidTraceId(values[0]) {
+			traceId = values[0]
+		}
+	}
+	if len(traceId) == 0 {
+		traceId = traceutils.NewTraceId()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(traceutils.MetadataKey, traceId))
+	return traceutils.WithTraceId(ctx, traceId)
+}
+
+// 从HTTP请求中读取跟踪ID，如果没有则生成新的
+func traceHTTPRequestId(headerValue string) string {
+	if traceutils.IsValidTraceId(headerValue) {
+		return headerValue
+	}
+	return traceutils.NewTraceId()
+}
+
+// 记录慢调用
+// tagCostMap 为调试模式下各个标签的耗时，单位毫秒
+func logSlowCall(ctx context.Context, fullMethod string, cost time.Duration, tagCostMap map[string]float64) {
+	var threshold = configs.DefaultSlowCallThresholdMs * time.Millisecond
+	apiConfig, _ := configs.SharedAPIConfig()
+	if apiConfig != nil {
+		threshold = apiConfig.SlowCall.Threshold()
+	}
+	if threshold <= 0 || cost < threshold {
+		return
+	}
+
+	remotelogs.WarnCtx(ctx, "SLOW_CALL", composeSlowCallMessage(fullMethod, cost, tagCostMap))
+}
+
+func composeSlowCallMessage(fullMethod string, cost time.Duration, tagCostMap map[string]float64) string {
+	var message = fmt.Sprintf("'%s()' costs %.2fms", fullMethod, cost.Seconds()*1000)
+	if len(tagCostMap) > 0 {
+		var tags = []string{}
+		for tag := range tagCostMap {
+			tags = append(tags, tag)
+		}
+		sort.Strings(tags)
+
+		var pieces = []string{}
+		for _, tag := range tags {
+			pieces = append(pieces, fmt.Sprintf("%s: %.2fms", tag, tagCostMap[tag]))
+		}
+		message += ", tags: " + strings.Join(pieces, ", ")
+	}
+	return message
+}
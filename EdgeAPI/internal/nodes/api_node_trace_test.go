@@ -0,0 +1,31 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestTraceHTTPRequestId(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsTrue(traceHTTPRequestId("abc-123") == "abc-123")
+	a.IsTrue(len(traceHTTPRequestId("")) == 16)
+	a.IsTrue(traceHTTPRequestId("abc 123") != "abc 123")
+}
+
+func TestComposeSlowCallMessage(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsTrue(composeSlowCallMessage("/pb.ServerService/findEnabledServer", 1500*time.Millisecond, nil) == "'/pb.ServerService/findEnabledServer()' costs 1500.00ms")
+
+	var message = composeSlowCallMessage("/pb.ServerService/findEnabledServer", 1500*time.Millisecond, map[string]float64{
+		"server": 1000,
+		"cert":   200.5,
+	})
+	t.Log(message)
+	a.IsTrue(message == "'/pb.ServerService/findEnabledServer()' costs 1500.00ms, tags: cert: 200.50ms, server: 1000.00ms")
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"context"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/versioning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// 检查请求的API版本，返回需要在响应中设置的Header
+func (this *APINode) checkAPIVersion(ctx context.Context, fullMethod string) (metadata.MD, error) {
+	version, err := versioning.FromIncomingContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "'"+fullMethod+"()' says: "+err.Error())
+	}
+
+	deprecation, err := versioning.SharedRegistry.Check(version, fullMethod)
+	if err != nil {
+		return nil, status.Error(codes.Unimplemented, "'"+fullMethod+"()' says: "+err.Error())
+	}
+	return metadata.New(versioning.ResponseHeaders(version, deprecation)), nil
+}
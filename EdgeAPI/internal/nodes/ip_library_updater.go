@@ -65,6 +65,23 @@ func (this *IPLibraryUpdater) FindLatestFile() (code string, fileId int64, err e
 	return artifact.Code, int64(artifact.FileId), nil
 }
 
+// FindLatestFileWithDriver 检查最新的IP库文件及其驱动类型
+func (this *IPLibraryUpdater) FindLatestFileWithDriver() (code string, fileId int64, driverType iplibrary.DriverType, err error) {
+	artifact, err := models.SharedIPLibraryArtifactDAO.FindPublicArtifact(nil)
+	if err != nil {
+		return "", 0, "", err
+	}
+	if artifact == nil {
+		return "", 0, "", nil
+	}
+
+	driverType = artifact.Driver
+	if len(driverType) == 0 {
+		driverType = iplibrary.DriverTypeDefault
+	}
+	return artifact.Code, int64(artifact.FileId), driverType, nil
+}
+
 // DownloadFile 下载文件
 func (this *IPLibraryUpdater) DownloadFile(fileId int64, writer io.Writer) error {
 	if fileId <= 0 {
@@ -13,17 +13,26 @@ import (
 	"time"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/scopes"
 	"github.com/TeaOSLab/EdgeAPI/internal/rpc/services"
 	rpcutils "github.com/TeaOSLab/EdgeAPI/internal/rpc/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/versioning"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils/sizes"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/traceutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/apiversions"
+	"github.com/iwind/TeaGo/lists"
 	"github.com/iwind/TeaGo/maps"
 )
 
-var servicePathReg = regexp.MustCompile(`^/([a-zA-Z0-9]+)/([a-zA-Z0-9]+)$`)
+var servicePathReg = regexp.MustCompile(`^(?:/(v\d+))?/([a-zA-Z0-9]+)/([a-zA-Z0-9]+)$`)
 var restServicesMap = map[string]reflect.Value{
 	"APIAccessTokenService": reflect.ValueOf(new(services.APIAccessTokenService)),
 }
 
+// 模拟用户登录时禁止调用的服务和方法，防止通过短期令牌获得用户长期的登录凭证
+var impersonationDeniedServices = []string{"UserAccessKeyService"}
+var impersonationDeniedMethods = []string{"UserService.updateUserLogin"}
+
 type RestServer struct{}
 
 func (this *RestServer) Listen(listener net.Listener) error {
@@ -46,6 +55,10 @@ func (this *RestServer) ListenHTTPS(listener net.Listener, tlsConfig *tls.Config
 func (this *RestServer) handle(writer http.ResponseWriter, req *http.Request) {
 	var path = req.URL.Path
 
+	// 请求跟踪
+	var traceId = traceHTTPRequestId(req.Header.Get(traceutils.HTTPHeader))
+	writer.Header().Set(traceutils.HTTPHeader, traceId)
+
 	// 是否显示Pretty后的JSON
 	var shouldPretty = req.Header.Get("X-Edge-Response-Pretty") == "on"
 
@@ -66,7 +79,7 @@ func (this *RestServer) handle(writer http.ResponseWriter, req *http.Request) {
 	}
 
 	var matches = servicePathReg.FindStringSubmatch(path)
-	if len(matches) != 3 {
+	if len(matches) != 4 {
 		writer.WriteHeader(http.StatusNotFound)
 		this.writeJSON(writer, maps.Map{
 			"code":    "404",
@@ -76,8 +89,24 @@ func (this *RestServer) handle(writer http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	var serviceName = matches[1]
-	var methodName = matches[2]
+	var serviceName = matches[2]
+	var methodName = matches[3]
+
+	// API版本，路径中的版本优先于Header中的版本
+	var versionString = matches[1]
+	if len(versionString) == 0 {
+		versionString = req.Header.Get(apiversions.HTTPHeader)
+	}
+	apiVersion, err := apiversions.ParseVersion(versionString)
+	if err != nil {
+		writer.WriteHeader(http.StatusBadRequest)
+		this.writeJSON(writer, maps.Map{
+			"code":    400,
+			"message": err.Error(),
+			"data":    maps.Map{},
+		}, shouldPretty)
+		return
+	}
 
 	serviceType, ok := restServicesMap[serviceName]
 	if !ok {
@@ -146,8 +175,24 @@ func (this *RestServer) handle(writer http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	// 检查方法在当前版本中是否可用
+	{
+		var fullMethod = "/pb." + serviceName + "/" + strings.ToLower(methodName[:1]) + methodName[1:]
+		deprecation, err := versioning.SharedRegistry.Check(apiVersion, fullMethod)
+		if err != nil {
+			writer.WriteHeader(http.StatusNotFound)
+			this.writeJSON(writer, maps.Map{
+				"code":    404,
+				"message": err.Error(),
+				"data":    maps.Map{},
+			}, shouldPretty)
+			return
+		}
+		this.writeVersionHeaders(writer, apiVersion, deprecation)
+	}
+
 	// 上下文
-	var ctx = context.Background()
+	var ctx = traceutils.WithTraceId(context.Background(), traceId)
 
 	if serviceName != "APIAccessTokenService" || (methodName != "GetAPIAccessToken" && methodName != "getAPIAccessToken") {
 		// 校验TOKEN
@@ -183,10 +228,12 @@ func (this *RestServer) handle(writer http.ResponseWriter, req *http.Request) {
 			return
 		}
 
+		var plainCtx *rpcutils.PlainContext
 		if accessToken.UserId > 0 {
-			ctx = rpcutils.NewPlainContext("user", int64(accessToken.UserId))
+			plainCtx = rpcutils.NewPlainContext("user", int64(accessToken.UserId))
+			plainCtx.ImpersonatorAdminId = int64(accessToken.ImpersonatorAdminId)
 		} else if accessToken.AdminId > 0 {
-			ctx = rpcutils.NewPlainContext("admin", int64(accessToken.AdminId))
+			plainCtx = rpcutils.NewPlainContext("admin", int64(accessToken.AdminId))
 		} else {
 			// TODO 支持更多类型的角色
 			this.writeJSON(writer, maps.Map{
@@ -196,6 +243,33 @@ func (this *RestServer) handle(writer http.ResponseWriter, req *http.Request) {
 			}, shouldPretty)
 			return
 		}
+
+		// 检查令牌的权限范围
+		plainCtx.Scopes = accessToken.DecodeScopes()
+		err = scopes.Check(plainCtx.Scopes, serviceName, methodName)
+		if err != nil {
+			writer.WriteHeader(http.StatusForbidden)
+			this.writeJSON(writer, maps.Map{
+				"code":    403,
+				"data":    maps.Map{},
+				"message": err.Error(),
+			}, shouldPretty)
+			return
+		}
+
+		// 检查模拟用户登录的令牌
+		if plainCtx.ImpersonatorAdminId > 0 && this.isImpersonationDenied(serviceName, methodName) {
+			writer.WriteHeader(http.StatusForbidden)
+			this.writeJSON(writer, maps.Map{
+				"code":    403,
+				"data":    maps.Map{},
+				"message": "the method is not allowed for impersonation token",
+			}, shouldPretty)
+			return
+		}
+
+		plainCtx.SetTraceId(traceId)
+		ctx = plainCtx
 	}
 
 	// TODO 可以设置最大可接收内容尺寸
@@ -228,8 +302,20 @@ func (this *RestServer) handle(writer http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	var before = time.Now()
 	var result = method.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(reqValue)})
 	var resultErr = result[1].Interface()
+
+	// 慢调用日志
+	var fullMethod = "/pb." + serviceName + "/" + strings.ToLower(methodName[:1]) + methodName[1:]
+	logSlowCall(ctx, fullMethod, time.Since(before), nil)
+
+	// 审计日志
+	{
+		callErr, _ := resultErr.(error)
+		remoteIP, _, _ := net.SplitHostPort(req.RemoteAddr)
+		recordAuditLog(ctx, fullMethod, reqValue, callErr, before, remoteIP)
+	}
 	if resultErr != nil {
 		e, ok := resultErr.(error)
 		if ok {
@@ -271,6 +357,36 @@ func (this *RestServer) handle(writer http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// 在响应中输出API版本和废弃信息
+func (this *RestServer) writeVersionHeaders(writer http.ResponseWriter, version apiversions.Version, deprecation *versioning.Deprecation) {
+	for key, value := range versioning.ResponseHeaders(version, deprecation) {
+		writer.Header().Set(key, value)
+	}
+	if deprecation == nil {
+		return
+	}
+
+	// 标准的废弃Header：RFC 8594
+	writer.Header().Set("Deprecation", "true")
+	if len(deprecation.Sunset) > 0 {
+		sunset, err := time.Parse("2006-01-02", deprecation.Sunset)
+		if err == nil {
+			writer.Header().Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+		}
+	}
+}
+
+// 判断是否禁止模拟登录的用户调用
+func (this *RestServer) isImpersonationDenied(serviceName string, methodName string) bool {
+	if lists.ContainsString(impersonationDeniedServices, serviceName) {
+		return true
+	}
+	if len(methodName) > 0 {
+		methodName = strings.ToLower(methodName[:1]) + methodName[1:]
+	}
+	return lists.ContainsString(impersonationDeniedMethods, serviceName+"."+methodName)
+}
+
 func (this *RestServer) writeJSON(writer http.ResponseWriter, v maps.Map, pretty bool) {
 	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
 
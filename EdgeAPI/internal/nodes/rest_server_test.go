@@ -0,0 +1,50 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/versioning"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/apiversions"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestRestServer_IsImpersonationDenied(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var server = &RestServer{}
+	a.IsTrue(server.isImpersonationDenied("UserAccessKeyService", "createUserAccessKey"))
+	a.IsTrue(server.isImpersonationDenied("UserService", "updateUserLogin"))
+	a.IsTrue(server.isImpersonationDenied("UserService", "UpdateUserLogin"))
+	a.IsFalse(server.isImpersonationDenied("UserService", "findEnabledUser"))
+	a.IsFalse(server.isImpersonationDenied("ServerService", "listEnabledServersMatch"))
+}
+
+func TestRestServer_ServicePath(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var matches = servicePathReg.FindStringSubmatch("/ServerService/findEnabledServer")
+	a.IsTrue(len(matches) == 4 && matches[1] == "" && matches[2] == "ServerService" && matches[3] == "findEnabledServer")
+
+	matches = servicePathReg.FindStringSubmatch("/v1/ServerService/findEnabledServer")
+	a.IsTrue(len(matches) == 4 && matches[1] == "v1" && matches[2] == "ServerService")
+
+	a.IsTrue(len(servicePathReg.FindStringSubmatch("/a/b/ServerService/findEnabledServer")) == 0)
+}
+
+func TestRestServer_WriteVersionHeaders(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var server = &RestServer{}
+	var writer = httptest.NewRecorder()
+	server.writeVersionHeaders(writer, apiversions.V1, &versioning.Deprecation{
+		Replacement: "/pb.RegionCityService/findAllRegionCities",
+		Sunset:      "2030-01-01",
+	})
+	a.IsTrue(writer.Header().Get(apiversions.HTTPHeader) == apiversions.V1)
+	a.IsTrue(writer.Header().Get("Deprecation") == "true")
+	a.IsTrue(writer.Header().Get("Sunset") == "Tue, 01 Jan 2030 00:00:00 GMT")
+	a.IsTrue(writer.Header().Get(apiversions.MetadataKeyReplacement) == "/pb.RegionCityService/findAllRegionCities")
+}
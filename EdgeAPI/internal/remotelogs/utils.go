@@ -1,11 +1,13 @@
 package remotelogs
 
 import (
+	"context"
 	"time"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/configs"
 	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
 	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/traceutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/cespare/xxhash"
@@ -98,6 +100,21 @@ func Error(tag string, description string) {
 	}
 }
 
+// PrintlnCtx 打印普通信息，并附带上下文中的请求跟踪ID
+func PrintlnCtx(ctx context.Context, tag string, description string) {
+	Println(tag, withTraceId(ctx, description))
+}
+
+// WarnCtx 打印警告信息，并附带上下文中的请求跟踪ID
+func WarnCtx(ctx context.Context, tag string, description string) {
+	Warn(tag, withTraceId(ctx, description))
+}
+
+// ErrorCtx 打印错误信息，并附带上下文中的请求跟踪ID
+func ErrorCtx(ctx context.Context, tag string, description string) {
+	Error(tag, withTraceId(ctx, description))
+}
+
 // SetDAO 设置存储接口
 func SetDAO(dao DAOInterface) {
 	sharedDAO = dao
@@ -144,3 +161,12 @@ Loop:
 
 	return nil
 }
+
+// 在描述中附加请求跟踪ID，以便按跟踪ID搜索日志
+func withTraceId(ctx context.Context, description string) string {
+	var traceId = traceutils.TraceId(ctx)
+	if len(traceId) == 0 {
+		return description
+	}
+	return description + " (traceId: " + traceId + ")"
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package audit
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/scopes"
+)
+
+const (
+	MaxRequestSize = 4096 // 请求摘要最大长度
+	MaxValueSize   = 256  // 单个字段值最大长度
+)
+
+// 需要隐藏内容的字段名关键词
+var sensitiveKeywords = []string{"password", "secret", "token", "privatekey", "keydata", "accesskey", "passwd"}
+
+// SharedRecorder 共享的审计日志记录器
+var SharedRecorder = NewRecorder(1024)
+
+// Entry 单条审计记录
+type Entry struct {
+	AdminId             int64
+	UserId              int64
+	ImpersonatorAdminId int64 // 模拟用户登录的管理员ID
+	Method              string
+	Request             string
+	IsOk                bool
+	Error               string
+	CostMs              int64
+	IP                  string
+	IsRest              bool
+}
+
+// Recorder 审计日志记录器
+// 日志先放入队列，再由单独的goroutine写入数据库，避免影响RPC响应速度
+type Recorder struct {
+	queue chan *Entry
+}
+
+func NewRecorder(queueSize int) *Recorder {
+	var recorder = &Recorder{
+		queue: make(chan *Entry, queueSize),
+	}
+	goman.New(func() {
+		recorder.loop()
+	})
+	return recorder
+}
+
+// Add 添加记录
+// 队列已满时丢弃
+func (this *Recorder) Add(entry *Entry) {
+	select {
+	case this.queue <- entry:
+	default:
+		remotelogs.Warn("AUDIT", "audit log queue is full, drop log for '"+entry.Method+"'")
+	}
+}
+
+func (this *Recorder) loop() {
+	for entry := range this.queue {
+		if models.SharedAuditLogDAO == nil {
+			continue
+		}
+		err := models.SharedAuditLogDAO.CreateAuditLog(nil, entry.AdminId, entry.UserId, entry.ImpersonatorAdminId, entry.Method, entry.Request, entry.IsOk, entry.Error, entry.CostMs, entry.IP, entry.IsRest)
+		if err != nil {
+			remotelogs.Error("AUDIT", "create audit log failed: "+err.Error())
+		}
+	}
+}
+
+// IsMutatingMethod 判断是否为修改数据的方法
+// fullMethod 格式为 /pb.ServerService/createServer
+func IsMutatingMethod(fullMethod string) bool {
+	var index = strings.LastIndex(fullMethod, "/")
+	if index < 0 {
+		return false
+	}
+	return scopes.LevelOfMethod(fullMethod[index+1:]) >= scopes.LevelWrite
+}
+
+// Summary 生成请求摘要
+// 隐藏敏感字段，截断过长的字段值
+func Summary(req any) string {
+	if req == nil {
+		return ""
+	}
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return ""
+	}
+
+	var value any
+	err = json.Unmarshal(reqJSON, &value)
+	if err != nil {
+		return ""
+	}
+
+	summaryJSON, err := json.Marshal(summaryValue("", value))
+	if err != nil {
+		return ""
+	}
+	if len(summaryJSON) > MaxRequestSize {
+		return truncate(string(summaryJSON), MaxRequestSize) + "..."
+	}
+	return string(summaryJSON)
+}
+
+func summaryValue(key string, value any) any {
+	if len(key) > 0 && isSensitiveKey(key) {
+		if value == nil {
+			return nil
+		}
+		return "******"
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		var result = map[string]any{}
+		for childKey, childValue := range v {
+			result[childKey] = summaryValue(childKey, childValue)
+		}
+		return result
+	case []any:
+		var result = []any{}
+		for _, childValue := range v {
+			result = append(result, summaryValue(key, childValue))
+		}
+		return result
+	case string:
+		if len(v) > MaxValueSize {
+			return truncate(v, MaxValueSize) + "...(" + strconv.Itoa(len(v)) + " bytes)"
+		}
+		return v
+	}
+	return value
+}
+
+// 截断字符串，不截断多字节字符
+func truncate(s string, size int) string {
+	if len(s) <= size {
+		return s
+	}
+	for size > 0 && !utf8.RuneStart(s[size]) {
+		size--
+	}
+	return s[:size]
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, keyword := range sensitiveKeywords {
+		if strings.Contains(key, keyword) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package audit_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/audit"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestIsMutatingMethod(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(audit.IsMutatingMethod("/pb.ServerService/createServer"))
+	a.IsTrue(audit.IsMutatingMethod("/pb.ServerService/deleteServer"))
+	a.IsFalse(audit.IsMutatingMethod("/pb.ServerService/findEnabledServer"))
+	a.IsFalse(audit.IsMutatingMethod("/pb.ServerService/countAllEnabledServers"))
+	a.IsFalse(audit.IsMutatingMethod("createServer"))
+}
+
+func TestSummary(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var summary = audit.Summary(&pb.CreateUserAccessKeyRequest{
+		UserId:      1,
+		Description: "test",
+	})
+	t.Log(summary)
+	a.IsTrue(strings.Contains(summary, `"description":"test"`))
+
+	summary = audit.Summary(&pb.UpdateSSLCertRequest{
+		SslCertId: 1,
+		Name:      strings.Repeat("中", 200),
+		KeyData:   []byte("PRIVATE KEY"),
+	})
+	t.Log(summary)
+	a.IsTrue(strings.Contains(summary, `"keyData":"******"`))
+	a.IsTrue(strings.Contains(summary, "(600 bytes)"))
+	a.IsFalse(strings.Contains(summary, "�"))
+
+	a.IsTrue(audit.Summary(nil) == "")
+}
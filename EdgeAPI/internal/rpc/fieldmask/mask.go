@@ -0,0 +1,200 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package fieldmask
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Mask 字段掩码
+// 用来在响应中只保留调用者需要的字段，路径使用点号分隔，比如 "id"、"nodeCluster.name"
+type Mask struct {
+	fields map[string]*Mask // 为nil表示保留所有字段
+}
+
+// Parse 解析字段路径
+// paths 为空时表示保留所有字段
+func Parse(paths []string) *Mask {
+	var mask = &Mask{}
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if len(path) == 0 {
+			continue
+		}
+		mask.add(strings.Split(path, "."))
+	}
+	return mask
+}
+
+// IsAll 是否保留所有字段
+func (this *Mask) IsAll() bool {
+	return this == nil || this.fields == nil
+}
+
+// Has 判断是否需要某个字段
+// 可以用来跳过不需要的字段的查询
+func (this *Mask) Has(field string) bool {
+	if this.IsAll() {
+		return true
+	}
+	_, ok := this.fields[field]
+	return ok
+}
+
+// Sub 取得某个字段的子掩码
+func (this *Mask) Sub(field string) *Mask {
+	if this.IsAll() {
+		return nil
+	}
+	return this.fields[field]
+}
+
+// Validate 检查掩码中的字段是否都存在于某个消息中
+func (this *Mask) Validate(message proto.Message) error {
+	return this.validate(message.ProtoReflect().Descriptor(), "")
+}
+
+// PruneMessage 清除消息中不需要的字段
+func (this *Mask) PruneMessage(message proto.Message) {
+	if message == nil {
+		return
+	}
+	this.prune(message.ProtoReflect())
+}
+
+// PruneJSON 清除JSON对象中不需要的字段
+func (this *Mask) PruneJSON(data []byte) ([]byte, error) {
+	if this.IsAll() || len(data) == 0 {
+		return data, nil
+	}
+
+	var m = map[string]any{}
+	err := json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, err
+	}
+	this.pruneMap(m)
+	return json.Marshal(m)
+}
+
+func (this *Mask) add(pieces []string) {
+	if this.fields == nil {
+		this.fields = map[string]*Mask{}
+	}
+
+	var field = pieces[0]
+	subMask, ok := this.fields[field]
+	if len(pieces) == 1 {
+		// 整个字段都需要
+		this.fields[field] = nil
+		return
+	}
+	if ok && subMask == nil {
+		return
+	}
+	if subMask == nil {
+		subMask = &Mask{}
+		this.fields[field] = subMask
+	}
+	subMask.add(pieces[1:])
+}
+
+func (this *Mask) validate(descriptor protoreflect.MessageDescriptor, prefix string) error {
+	if this.IsAll() {
+		return nil
+	}
+	for field, subMask := range this.fields {
+		var fieldDescriptor = findField(descriptor, field)
+		if fieldDescriptor == nil {
+			return errors.New("invalid field mask '" + prefix + field + "'")
+		}
+		if subMask.IsAll() {
+			continue
+		}
+		if fieldDescriptor.Message() == nil || fieldDescriptor.IsMap() {
+			return errors.New("field '" + prefix + field + "' has no sub fields")
+		}
+		err := subMask.validate(fieldDescriptor.Message(), prefix+field+".")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (this *Mask) prune(message protoreflect.Message) {
+	if this.IsAll() {
+		return
+	}
+
+	var fieldDescriptors = message.Descriptor().Fields()
+	for i := 0; i < fieldDescriptors.Len(); i++ {
+		var fieldDescriptor = fieldDescriptors.Get(i)
+		subMask, ok := this.lookup(fieldDescriptor)
+		if !ok {
+			message.Clear(fieldDescriptor)
+			continue
+		}
+		if subMask.IsAll() || fieldDescriptor.Message() == nil || fieldDescriptor.IsMap() || !message.Has(fieldDescriptor) {
+			continue
+		}
+		if fieldDescriptor.IsList() {
+			var list = message.Get(fieldDescriptor).List()
+			for j := 0; j < list.Len(); j++ {
+				subMask.prune(list.Get(j).Message())
+			}
+		} else {
+			subMask.prune(message.Get(fieldDescriptor).Message())
+		}
+	}
+}
+
+func (this *Mask) pruneMap(m map[string]any) {
+	if this.IsAll() {
+		return
+	}
+	for key, value := range m {
+		subMask, ok := this.fields[key]
+		if !ok {
+			delete(m, key)
+			continue
+		}
+		if subMask.IsAll() {
+			continue
+		}
+		switch v := value.(type) {
+		case map[string]any:
+			subMask.pruneMap(v)
+		case []any:
+			for _, item := range v {
+				itemMap, isMap := item.(map[string]any)
+				if isMap {
+					subMask.pruneMap(itemMap)
+				}
+			}
+		}
+	}
+}
+
+// 同时支持proto字段名和JSON字段名
+func (this *Mask) lookup(fieldDescriptor protoreflect.FieldDescriptor) (subMask *Mask, ok bool) {
+	subMask, ok = this.fields[string(fieldDescriptor.Name())]
+	if ok {
+		return
+	}
+	subMask, ok = this.fields[fieldDescriptor.JSONName()]
+	return
+}
+
+func findField(descriptor protoreflect.MessageDescriptor, field string) protoreflect.FieldDescriptor {
+	var fieldDescriptor = descriptor.Fields().ByName(protoreflect.Name(field))
+	if fieldDescriptor != nil {
+		return fieldDescriptor
+	}
+	return descriptor.Fields().ByJSONName(field)
+}
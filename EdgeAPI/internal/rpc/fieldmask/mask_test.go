@@ -0,0 +1,78 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package fieldmask_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/fieldmask"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestMask_Has(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	{
+		var mask = fieldmask.Parse(nil)
+		a.IsTrue(mask.IsAll())
+		a.IsTrue(mask.Has("name"))
+	}
+
+	{
+		var mask = fieldmask.Parse([]string{"id", "nodeCluster.name", " "})
+		a.IsFalse(mask.IsAll())
+		a.IsTrue(mask.Has("id"))
+		a.IsTrue(mask.Has("nodeCluster"))
+		a.IsFalse(mask.Has("name"))
+		a.IsTrue(mask.Sub("nodeCluster").Has("name"))
+		a.IsFalse(mask.Sub("nodeCluster").Has("id"))
+	}
+
+	{
+		var mask = fieldmask.Parse([]string{"nodeCluster.name", "nodeCluster"})
+		a.IsTrue(mask.Sub("nodeCluster").IsAll())
+	}
+}
+
+func TestMask_Validate(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsNil(fieldmask.Parse([]string{"id", "nodeCluster.name", "dnsRoutes.code"}).Validate(&pb.Node{}))
+	a.IsNotNil(fieldmask.Parse([]string{"unknownField"}).Validate(&pb.Node{}))
+	a.IsNotNil(fieldmask.Parse([]string{"name.length"}).Validate(&pb.Node{}))
+}
+
+func TestMask_PruneMessage(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var node = &pb.Node{
+		Id:         1,
+		Name:       "node1",
+		StatusJSON: []byte("{}"),
+		NodeCluster: &pb.NodeCluster{
+			Id:   2,
+			Name: "cluster1",
+		},
+		DnsRoutes: []*pb.DNSRoute{
+			{Name: "route1", Code: "code1"},
+		},
+	}
+	fieldmask.Parse([]string{"id", "nodeCluster.name", "dnsRoutes.code"}).PruneMessage(node)
+	a.IsTrue(node.Id == 1)
+	a.IsTrue(len(node.Name) == 0)
+	a.IsTrue(len(node.StatusJSON) == 0)
+	a.IsTrue(node.NodeCluster.Id == 0)
+	a.IsTrue(node.NodeCluster.Name == "cluster1")
+	a.IsTrue(len(node.DnsRoutes) == 1)
+	a.IsTrue(len(node.DnsRoutes[0].Name) == 0)
+	a.IsTrue(node.DnsRoutes[0].Code == "code1")
+}
+
+func TestMask_PruneJSON(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	data, err := fieldmask.Parse([]string{"id", "web.root"}).PruneJSON([]byte(`{"id":1,"name":"a","web":{"root":"/","isOn":true}}`))
+	a.IsNil(err)
+	a.IsTrue(string(data) == `{"id":1,"web":{"root":"/"}}`)
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package mtls
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/certgen"
+)
+
+const (
+	CACommonName   = "GoEdge Internal CA"
+	CAOrganization = "GoEdge"
+	MaxPreviousCAs = 5 // 最多保留的旧CA数量
+)
+
+// CA 内部CA，用来签发节点的客户端证书
+type CA struct {
+	CertData      []byte   `json:"certData"`      // 当前CA证书
+	KeyData       []byte   `json:"keyData"`       // 当前CA私钥，保存时会被加密
+	CreatedAt     int64    `json:"createdAt"`     // 创建时间
+	PreviousCerts [][]byte `json:"previousCerts"` // 轮换之前的CA证书，在删除之前仍然被信任
+}
+
+// NewCA 生成新的内部CA
+func NewCA() (*CA, error) {
+	certData, keyData, err := certgen.Generate(&certgen.Options{
+		CommonName:   CACommonName,
+		Organization: CAOrganization,
+		IsCA:         true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CA{
+		CertData:  certData,
+		KeyData:   keyData,
+		CreatedAt: time.Now().Unix(),
+	}, nil
+}
+
+// Cert 解析当前CA证书
+func (this *CA) Cert() (*x509.Certificate, error) {
+	var block, _ = pem.Decode(this.CertData)
+	if block == nil {
+		return nil, errors.New("invalid CA cert data")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// Rotate 轮换CA，当前CA证书会被保留在信任列表中
+func (this *CA) Rotate() error {
+	newCA, err := NewCA()
+	if err != nil {
+		return err
+	}
+
+	var previousCerts = append([][]byte{this.CertData}, this.PreviousCerts...)
+	if len(previousCerts) > MaxPreviousCAs {
+		previousCerts = previousCerts[:MaxPreviousCAs]
+	}
+
+	this.CertData = newCA.CertData
+	this.KeyData = newCA.KeyData
+	this.CreatedAt = newCA.CreatedAt
+	this.PreviousCerts = previousCerts
+	return nil
+}
+
+// RevokePrevious 删除所有旧CA
+func (this *CA) RevokePrevious() {
+	this.PreviousCerts = nil
+}
+
+// TrustedCertsData 所有被信任的CA证书，当前CA在最前面
+func (this *CA) TrustedCertsData() []byte {
+	var result = append([]byte{}, this.CertData...)
+	for _, certData := range this.PreviousCerts {
+		result = append(result, certData...)
+	}
+	return result
+}
+
+// CertPool 所有被信任的CA证书组成的证书池
+func (this *CA) CertPool() *x509.CertPool {
+	var pool = x509.NewCertPool()
+	pool.AppendCertsFromPEM(this.TrustedCertsData())
+	return pool
+}
+
+// IssueClientCert 根据证书请求签发客户端证书
+// 证书的CN为节点的唯一ID，OU为节点角色
+func (this *CA) IssueClientCert(csrData []byte, role string, uniqueId string, days int) (certData []byte, expiresAt int64, err error) {
+	if len(role) == 0 || len(uniqueId) == 0 {
+		return nil, 0, errors.New("'role' and 'uniqueId' should not be empty")
+	}
+
+	certData, err = certgen.SignCSR(csrData, &certgen.Options{
+		CommonName:         uniqueId,
+		Organization:       CAOrganization,
+		OrganizationalUnit: role,
+		Days:               days,
+		IsClient:           true,
+		CACertData:         this.CertData,
+		CAKeyData:          this.KeyData,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var block, _ = pem.Decode(certData)
+	if block == nil {
+		return nil, 0, errors.New("invalid cert data")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, 0, err
+	}
+	return certData, cert.NotAfter.Unix(), nil
+}
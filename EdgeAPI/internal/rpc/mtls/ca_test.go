@@ -0,0 +1,94 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package mtls_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/mtls"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func testCSR(t *testing.T) []byte {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+}
+
+func verifyClientCert(certData []byte, pool *x509.CertPool) error {
+	var block, _ = pem.Decode(certData)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:     pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err
+}
+
+func TestCA_IssueAndRotate(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	ca, err := mtls.NewCA()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certData, expiresAt, err := ca.IssueClientCert(testCSR(t), "node", "abc", 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(expiresAt > 0)
+	a.IsTrue(verifyClientCert(certData, ca.CertPool()) == nil)
+
+	// 轮换后旧证书仍然可用
+	err = ca.Rotate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(len(ca.PreviousCerts) == 1)
+	a.IsTrue(verifyClientCert(certData, ca.CertPool()) == nil)
+
+	newCertData, _, err := ca.IssueClientCert(testCSR(t), "node", "abc", 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(verifyClientCert(newCertData, ca.CertPool()) == nil)
+
+	// 删除旧CA后旧证书不可用
+	ca.RevokePrevious()
+	a.IsTrue(verifyClientCert(certData, ca.CertPool()) != nil)
+	a.IsTrue(verifyClientCert(newCertData, ca.CertPool()) == nil)
+
+	_, _, err = ca.IssueClientCert(testCSR(t), "", "abc", 30)
+	a.IsNotNil(err)
+}
+
+func TestCA_RotateLimit(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	ca, err := mtls.NewCA()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < mtls.MaxPreviousCAs+2; i++ {
+		err = ca.Rotate()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	a.IsTrue(len(ca.PreviousCerts) == mtls.MaxPreviousCAs)
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package mtls
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/keyvault"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+var caLocker = &sync.Mutex{}
+
+// LoadCA 从数据库中读取内部CA，不存在时返回nil
+func LoadCA(tx *dbs.Tx) (*CA, error) {
+	valueJSON, err := models.SharedSysSettingDAO.ReadSetting(tx, systemconfigs.SettingCodeInternalCA)
+	if err != nil {
+		return nil, err
+	}
+	if len(valueJSON) == 0 {
+		return nil, nil
+	}
+
+	var ca = &CA{}
+	err = json.Unmarshal(valueJSON, ca)
+	if err != nil {
+		return nil, err
+	}
+	if len(ca.CertData) == 0 {
+		return nil, nil
+	}
+
+	ca.KeyData, err = keyvault.SharedVault().Decrypt(ca.KeyData)
+	if err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
+// 保存内部CA，私钥会被加密
+func saveCA(tx *dbs.Tx, ca *CA) error {
+	encryptedKeyData, err := keyvault.SharedVault().Encrypt(ca.KeyData)
+	if err != nil {
+		return err
+	}
+
+	var caCopy = *ca
+	caCopy.KeyData = encryptedKeyData
+	valueJSON, err := json.Marshal(caCopy)
+	if err != nil {
+		return err
+	}
+	return models.SharedSysSettingDAO.UpdateSetting(tx, systemconfigs.SettingCodeInternalCA, valueJSON)
+}
+
+// EnsureCA 读取内部CA，不存在时自动创建
+func EnsureCA(tx *dbs.Tx) (*CA, error) {
+	caLocker.Lock()
+	defer caLocker.Unlock()
+
+	ca, err := LoadCA(tx)
+	if err != nil {
+		return nil, err
+	}
+	if ca != nil {
+		return ca, nil
+	}
+
+	ca, err = NewCA()
+	if err != nil {
+		return nil, err
+	}
+	err = saveCA(tx, ca)
+	if err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
+// RotateCA 轮换内部CA
+func RotateCA(tx *dbs.Tx) (*CA, error) {
+	caLocker.Lock()
+	defer caLocker.Unlock()
+
+	ca, err := LoadCA(tx)
+	if err != nil {
+		return nil, err
+	}
+	if ca == nil {
+		ca, err = NewCA()
+	} else {
+		err = ca.Rotate()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = saveCA(tx, ca)
+	if err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
+// RevokePreviousCAs 删除轮换之前的CA
+func RevokePreviousCAs(tx *dbs.Tx) error {
+	caLocker.Lock()
+	defer caLocker.Unlock()
+
+	ca, err := LoadCA(tx)
+	if err != nil {
+		return err
+	}
+	if ca == nil || len(ca.PreviousCerts) == 0 {
+		return nil
+	}
+	ca.RevokePrevious()
+	return saveCA(tx, ca)
+}
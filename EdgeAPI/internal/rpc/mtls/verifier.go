@@ -0,0 +1,129 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"sync"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/iwind/TeaGo/dbs"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// SharedVerifier API节点共享的客户端证书校验器
+var SharedVerifier = NewVerifier()
+
+// 不需要客户端证书就可以调用的方法，节点用来申请第一个客户端证书
+var exemptMethods = map[string]bool{
+	"/pb.InternalCAService/issueNodeClientCert": true,
+}
+
+// Verifier 客户端证书校验器
+type Verifier struct {
+	config *systemconfigs.MTLSConfig
+	pool   *x509.CertPool
+
+	locker sync.RWMutex
+}
+
+func NewVerifier() *Verifier {
+	return &Verifier{}
+}
+
+// Reload 从数据库中重新加载设置和CA
+func (this *Verifier) Reload(tx *dbs.Tx) error {
+	config, err := models.SharedSysSettingDAO.ReadMTLSConfig(tx)
+	if err != nil {
+		return err
+	}
+	ca, err := LoadCA(tx)
+	if err != nil {
+		return err
+	}
+	this.Update(config, ca)
+	return nil
+}
+
+// Update 修改设置和CA
+func (this *Verifier) Update(config *systemconfigs.MTLSConfig, ca *CA) {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	this.config = config
+	if ca != nil {
+		this.pool = ca.CertPool()
+	} else {
+		this.pool = nil
+	}
+}
+
+// ServerTLSConfig 在HTTPS端口的TLS配置上增加客户端证书校验
+// 每次握手时读取最新的CA，所以轮换CA后不需要重启监听
+func (this *Verifier) ServerTLSConfig(baseConfig *tls.Config) *tls.Config {
+	var config = baseConfig.Clone()
+	config.GetConfigForClient = func(info *tls.ClientHelloInfo) (*tls.Config, error) {
+		this.locker.RLock()
+		var isOn = this.config != nil && this.config.IsOn
+		var pool = this.pool
+		this.locker.RUnlock()
+
+		if !isOn || pool == nil {
+			return nil, nil
+		}
+
+		var clientConfig = baseConfig.Clone()
+		clientConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		clientConfig.ClientCAs = pool
+		return clientConfig, nil
+	}
+	return config
+}
+
+// Verify 检查请求携带的客户端证书
+// role 和 uniqueId 为请求中API令牌对应的节点角色和唯一ID
+func (this *Verifier) Verify(ctx context.Context, fullMethod string, role string, uniqueId string) error {
+	this.locker.RLock()
+	var config = this.config
+	this.locker.RUnlock()
+
+	if !config.RequireRole(role) || exemptMethods[fullMethod] {
+		return nil
+	}
+
+	var cert = PeerCert(ctx)
+	if cert == nil {
+		return errors.New("client certificate required")
+	}
+	if cert.Subject.CommonName != uniqueId {
+		return errors.New("client certificate does not match node '" + uniqueId + "'")
+	}
+	for _, unit := range cert.Subject.OrganizationalUnit {
+		if unit == role {
+			return nil
+		}
+	}
+	return errors.New("client certificate does not match role '" + role + "'")
+}
+
+// PeerCert 读取请求中已经通过校验的客户端证书
+func PeerCert(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	var chains = tlsInfo.State.VerifiedChains
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return nil
+	}
+	return chains[0][0]
+}
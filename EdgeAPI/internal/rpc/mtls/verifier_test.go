@@ -0,0 +1,90 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package mtls_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/mtls"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/iwind/TeaGo/assert"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func peerContext(certData []byte) context.Context {
+	var tlsInfo = credentials.TLSInfo{}
+	if len(certData) > 0 {
+		var block, _ = pem.Decode(certData)
+		cert, _ := x509.ParseCertificate(block.Bytes)
+		tlsInfo.State.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr:     &net.TCPAddr{IP: net.ParseIP("127.0.0.1")},
+		AuthInfo: tlsInfo,
+	})
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	ca, err := mtls.NewCA()
+	if err != nil {
+		t.Fatal(err)
+	}
+	certData, _, err := ca.IssueClientCert(testCSR(t), "node", "abc", 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var verifier = mtls.NewVerifier()
+	const method = "/pb.NodeService/findCurrentNodeConfig"
+
+	// 没有设置时不检查
+	a.IsNil(verifier.Verify(peerContext(nil), method, "node", "abc"))
+
+	var config = systemconfigs.NewMTLSConfig()
+	config.IsOn = true
+	verifier.Update(config, ca)
+	a.IsNil(verifier.Verify(peerContext(nil), method, "node", "abc"))
+
+	config.IsEnforced = true
+	a.IsNotNil(verifier.Verify(peerContext(nil), method, "node", "abc"))
+	a.IsNil(verifier.Verify(peerContext(nil), "/pb.InternalCAService/issueNodeClientCert", "node", "abc"))
+	a.IsNil(verifier.Verify(peerContext(certData), method, "node", "abc"))
+	a.IsNotNil(verifier.Verify(peerContext(certData), method, "node", "def"))
+	a.IsNotNil(verifier.Verify(peerContext(certData), method, "user", "abc"))
+
+	// 不需要证书的角色
+	config.Roles = []string{"admin"}
+	a.IsNil(verifier.Verify(peerContext(nil), method, "node", "abc"))
+	a.IsNotNil(verifier.Verify(peerContext(nil), method, "admin", "abc"))
+}
+
+func TestVerifier_ServerTLSConfig(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	ca, err := mtls.NewCA()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var verifier = mtls.NewVerifier()
+	var tlsConfig = verifier.ServerTLSConfig(&tls.Config{})
+
+	clientConfig, err := tlsConfig.GetConfigForClient(&tls.ClientHelloInfo{})
+	a.IsNil(err)
+	a.IsTrue(clientConfig == nil)
+
+	var config = systemconfigs.NewMTLSConfig()
+	config.IsOn = true
+	verifier.Update(config, ca)
+	clientConfig, err = tlsConfig.GetConfigForClient(&tls.ClientHelloInfo{})
+	a.IsNil(err)
+	a.IsTrue(clientConfig != nil && clientConfig.ClientAuth == tls.VerifyClientCertIfGiven)
+}
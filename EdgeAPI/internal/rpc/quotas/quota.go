@@ -0,0 +1,190 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package quotas
+
+import (
+	"fmt"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	acmemodels "github.com/TeaOSLab/EdgeAPI/internal/db/models/acme"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models/dns"
+	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+	"github.com/iwind/TeaGo/dbs"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Source = string
+
+const (
+	SourcePlan   Source = "plan"   // 用户套餐
+	SourceGlobal Source = "global" // 全局设置
+)
+
+func init() {
+	// 在DAO中创建对象时检查配额
+	models.SetUserQuotaChecker(Check)
+}
+
+// Quota 用户某个配额项目的使用情况
+type Quota struct {
+	Item     userconfigs.UserQuotaItem
+	MaxCount int64 // 最大数量，0表示不限制
+	Count    int64 // 已使用数量
+	Source   Source
+}
+
+// IsAllowed 检查是否还可以增加一定数量的对象
+func (this *Quota) IsAllowed(increment int64) bool {
+	return this.MaxCount <= 0 || this.Count+increment <= this.MaxCount
+}
+
+// ExceededError 超出配额错误
+// 通过GRPC返回时状态码为ResourceExhausted，并附带QuotaFailure详情
+type ExceededError struct {
+	Quota     *Quota
+	Increment int64
+}
+
+func (this *ExceededError) Error() string {
+	return fmt.Sprintf("quota exceeded: '%s' max: %d, current: %d, increment: %d", this.Quota.Item, this.Quota.MaxCount, this.Quota.Count, this.Increment)
+}
+
+// GRPCStatus 转换为GRPC状态
+func (this *ExceededError) GRPCStatus() *status.Status {
+	var s = status.New(codes.ResourceExhausted, this.Error())
+	detailedStatus, err := s.WithDetails(&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{
+			{
+				Subject:     this.Quota.Item,
+				Description: fmt.Sprintf("max: %d, current: %d, source: %s", this.Quota.MaxCount, this.Quota.Count, this.Quota.Source),
+			},
+		},
+	})
+	if err != nil {
+		return s
+	}
+	return detailedStatus
+}
+
+// FindUserQuotaConfig 查找用户的对象数量限制
+// 如果用户有未过期且设置了限制的套餐，则合并这些套餐的限制，否则使用全局设置
+func FindUserQuotaConfig(tx *dbs.Tx, userId int64) (config *userconfigs.UserQuotaConfig, source Source, err error) {
+	planIds, err := models.SharedUserPlanDAO.FindAvailablePlanIdsWithUserId(tx, userId)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, planId := range planIds {
+		plan, err := models.SharedPlanDAO.FindEnabledPlan(tx, planId, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		if plan == nil || !plan.IsOn {
+			continue
+		}
+		planConfig, err := plan.DecodeQuota()
+		if err != nil {
+			return nil, "", err
+		}
+		if planConfig == nil {
+			continue
+		}
+		if config == nil {
+			config = planConfig
+		} else {
+			config.Merge(planConfig)
+		}
+	}
+	if config != nil {
+		return config, SourcePlan, nil
+	}
+
+	config, err = models.SharedSysSettingDAO.ReadUserQuotaConfig(tx)
+	if err != nil {
+		return nil, "", err
+	}
+	return config, SourceGlobal, nil
+}
+
+// CountUserItems 计算用户已有的某类对象数量
+func CountUserItems(tx *dbs.Tx, userId int64, item userconfigs.UserQuotaItem) (int64, error) {
+	switch item {
+	case userconfigs.UserQuotaItemServers:
+		return models.SharedServerDAO.CountAllEnabledServersMatch(tx, 0, "", userId, 0, configutils.BoolStateAll, nil, 0)
+	case userconfigs.UserQuotaItemSSLCerts:
+		return models.SharedSSLCertDAO.CountCerts(tx, false, false, false, 0, "", userId, nil, false, nil)
+	case userconfigs.UserQuotaItemDNSRecords:
+		return dns.SharedDNSDomainDAO.CountAllEnabledRecordsWithUserId(tx, userId)
+	case userconfigs.UserQuotaItemACMETasks:
+		return acmemodels.SharedACMETaskDAO.CountAllEnabledACMETasks(tx, userId, false, false, 0, "", false)
+	}
+	return 0, fmt.Errorf("invalid quota item '%s'", item)
+}
+
+// FindUserQuotas 查找用户所有配额项目的使用情况
+func FindUserQuotas(tx *dbs.Tx, userId int64) ([]*Quota, error) {
+	config, source, err := FindUserQuotaConfig(tx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var result = []*Quota{}
+	for _, def := range userconfigs.FindAllUserQuotaItems() {
+		count, err := CountUserItems(tx, userId, def.Code)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &Quota{
+			Item:     def.Code,
+			MaxCount: config.MaxCount(def.Code),
+			Count:    count,
+			Source:   source,
+		})
+	}
+	return result, nil
+}
+
+// FindUserQuota 查找用户某个配额项目的使用情况
+func FindUserQuota(tx *dbs.Tx, userId int64, item userconfigs.UserQuotaItem) (*Quota, error) {
+	config, source, err := FindUserQuotaConfig(tx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var quota = &Quota{
+		Item:     item,
+		MaxCount: config.MaxCount(item),
+		Source:   source,
+	}
+
+	// 不限制时不需要计算数量
+	if quota.MaxCount > 0 {
+		quota.Count, err = CountUserItems(tx, userId, item)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return quota, nil
+}
+
+// Check 检查用户是否还可以创建一定数量的某类对象
+// userId 为0时表示管理员创建的对象，不做限制
+func Check(tx *dbs.Tx, userId int64, item userconfigs.UserQuotaItem, increment int64) error {
+	if userId <= 0 || increment <= 0 {
+		return nil
+	}
+
+	quota, err := FindUserQuota(tx, userId, item)
+	if err != nil {
+		return err
+	}
+	if !quota.IsAllowed(increment) {
+		return &ExceededError{
+			Quota:     quota,
+			Increment: increment,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package quotas_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/quotas"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+	"github.com/iwind/TeaGo/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQuota_IsAllowed(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsTrue((&quotas.Quota{MaxCount: 0, Count: 100}).IsAllowed(1))
+	a.IsTrue((&quotas.Quota{MaxCount: 10, Count: 9}).IsAllowed(1))
+	a.IsFalse((&quotas.Quota{MaxCount: 10, Count: 9}).IsAllowed(2))
+	a.IsFalse((&quotas.Quota{MaxCount: 10, Count: 10}).IsAllowed(1))
+}
+
+func TestExceededError_GRPCStatus(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var err error = &quotas.ExceededError{
+		Quota: &quotas.Quota{
+			Item:     userconfigs.UserQuotaItemServers,
+			MaxCount: 10,
+			Count:    10,
+			Source:   quotas.SourceGlobal,
+		},
+		Increment: 1,
+	}
+	t.Log(err.Error())
+
+	s, ok := status.FromError(err)
+	a.IsTrue(ok)
+	a.IsTrue(s.Code() == codes.ResourceExhausted)
+	a.IsTrue(len(s.Details()) == 1)
+
+	quotaFailure, ok := s.Details()[0].(*errdetails.QuotaFailure)
+	a.IsTrue(ok)
+	a.IsTrue(quotaFailure.Violations[0].Subject == userconfigs.UserQuotaItemServers)
+}
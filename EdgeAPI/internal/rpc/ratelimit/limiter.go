@@ -0,0 +1,133 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package ratelimit
+
+import (
+	"math"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+)
+
+// SharedLimiter API节点共享的请求频率限制器
+var SharedLimiter = NewLimiter()
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// Limiter RPC请求频率限制器
+// 使用令牌桶算法，每个API令牌一个桶，规则中指定了方法时，该方法单独使用一个桶
+type Limiter struct {
+	config  *systemconfigs.APIRateLimitConfig
+	buckets map[string]*bucket // key => bucket
+	locker  sync.Mutex
+
+	lastCleanAt time.Time
+}
+
+func NewLimiter() *Limiter {
+	return &Limiter{
+		buckets:     map[string]*bucket{},
+		lastCleanAt: time.Now(),
+	}
+}
+
+// UpdateConfig 修改配置
+// 配置有变化时清空已有的计数
+func (this *Limiter) UpdateConfig(config *systemconfigs.APIRateLimitConfig) {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	if reflect.DeepEqual(this.config, config) {
+		return
+	}
+	this.config = config
+	this.buckets = map[string]*bucket{}
+}
+
+// Allow 检查是否允许某个令牌请求某个方法
+func (this *Limiter) Allow(nodeId string, method string) bool {
+	if len(nodeId) == 0 {
+		return true
+	}
+
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	var config = this.config
+	if config == nil || !config.IsOn {
+		return true
+	}
+
+	key, qps, burst := this.match(config, nodeId, method)
+	if qps <= 0 {
+		return true
+	}
+	if burst <= 0 {
+		burst = int(math.Ceil(qps))
+	}
+
+	var now = time.Now()
+	this.clean(now)
+
+	b, ok := this.buckets[key]
+	if !ok {
+		b = &bucket{
+			tokens:    float64(burst),
+			updatedAt: now,
+		}
+		this.buckets[key] = b
+	} else {
+		b.tokens = math.Min(float64(burst), b.tokens+now.Sub(b.updatedAt).Seconds()*qps)
+		b.updatedAt = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// 查找适用的规则
+func (this *Limiter) match(config *systemconfigs.APIRateLimitConfig, nodeId string, method string) (key string, qps float64, burst int) {
+	var nodeRule *systemconfigs.APIRateLimitRule
+	var methodRule *systemconfigs.APIRateLimitRule
+	for _, rule := range config.Rules {
+		if rule == nil {
+			continue
+		}
+		if rule.NodeId == nodeId && rule.Method == method {
+			return nodeId + "@" + method, rule.QPS, rule.Burst
+		}
+		if rule.NodeId == nodeId && len(rule.Method) == 0 && nodeRule == nil {
+			nodeRule = rule
+		} else if len(rule.NodeId) == 0 && rule.Method == method && methodRule == nil {
+			methodRule = rule
+		}
+	}
+	if nodeRule != nil {
+		return nodeId, nodeRule.QPS, nodeRule.Burst
+	}
+	if methodRule != nil {
+		return nodeId + "@" + method, methodRule.QPS, methodRule.Burst
+	}
+	return nodeId, config.QPS, config.Burst
+}
+
+// 清理长时间没有使用的计数
+func (this *Limiter) clean(now time.Time) {
+	if now.Sub(this.lastCleanAt) < 1*time.Minute {
+		return
+	}
+	this.lastCleanAt = now
+	for key, b := range this.buckets {
+		if now.Sub(b.updatedAt) > 10*time.Minute {
+			delete(this.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/ratelimit"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var limiter = ratelimit.NewLimiter()
+
+	// 没有配置时不限制
+	for i := 0; i < 100; i++ {
+		a.IsTrue(limiter.Allow("node1", "/pb.ServerService/findEnabledServer"))
+	}
+
+	limiter.UpdateConfig(&systemconfigs.APIRateLimitConfig{
+		IsOn:  true,
+		QPS:   1,
+		Burst: 3,
+		Rules: []*systemconfigs.APIRateLimitRule{
+			{NodeId: "node2", QPS: 1, Burst: 5},
+			{Method: "/pb.HTTPAccessLogService/createHTTPAccessLogs", QPS: 0},
+			{NodeId: "node3", Method: "/pb.ServerService/findEnabledServer", QPS: 1, Burst: 1},
+		},
+	})
+
+	// 默认规则
+	for i := 0; i < 3; i++ {
+		a.IsTrue(limiter.Allow("node1", "/pb.ServerService/findEnabledServer"))
+	}
+	a.IsFalse(limiter.Allow("node1", "/pb.ServerService/findEnabledServer"))
+	a.IsFalse(limiter.Allow("node1", "/pb.NodeService/findEnabledNode")) // 和其他方法共享计数
+
+	// 不限制的方法
+	for i := 0; i < 10; i++ {
+		a.IsTrue(limiter.Allow("node1", "/pb.HTTPAccessLogService/createHTTPAccessLogs"))
+	}
+
+	// 针对令牌的规则
+	for i := 0; i < 5; i++ {
+		a.IsTrue(limiter.Allow("node2", "/pb.ServerService/findEnabledServer"))
+	}
+	a.IsFalse(limiter.Allow("node2", "/pb.ServerService/findEnabledServer"))
+
+	// 针对令牌和方法的规则单独计数
+	a.IsTrue(limiter.Allow("node3", "/pb.ServerService/findEnabledServer"))
+	a.IsFalse(limiter.Allow("node3", "/pb.ServerService/findEnabledServer"))
+	a.IsTrue(limiter.Allow("node3", "/pb.NodeService/findEnabledNode"))
+
+	// 没有令牌ID的请求不限制
+	a.IsTrue(limiter.Allow("", "/pb.ServerService/findEnabledServer"))
+
+	// 恢复
+	time.Sleep(1100 * time.Millisecond)
+	a.IsTrue(limiter.Allow("node1", "/pb.ServerService/findEnabledServer"))
+	a.IsFalse(limiter.Allow("node1", "/pb.ServerService/findEnabledServer"))
+}
+
+func TestLimiter_UpdateConfig(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var limiter = ratelimit.NewLimiter()
+	var config = &systemconfigs.APIRateLimitConfig{IsOn: true, QPS: 1, Burst: 1}
+	limiter.UpdateConfig(config)
+	a.IsTrue(limiter.Allow("node1", "/pb.ServerService/findEnabledServer"))
+	a.IsFalse(limiter.Allow("node1", "/pb.ServerService/findEnabledServer"))
+
+	// 相同的配置不会清空计数
+	limiter.UpdateConfig(&systemconfigs.APIRateLimitConfig{IsOn: true, QPS: 1, Burst: 1})
+	a.IsFalse(limiter.Allow("node1", "/pb.ServerService/findEnabledServer"))
+
+	// 关闭
+	limiter.UpdateConfig(&systemconfigs.APIRateLimitConfig{IsOn: false, QPS: 1, Burst: 1})
+	a.IsTrue(limiter.Allow("node1", "/pb.ServerService/findEnabledServer"))
+	a.IsTrue(limiter.Allow("node1", "/pb.ServerService/findEnabledServer"))
+}
@@ -0,0 +1,256 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package scopes
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Level 权限级别
+type Level = int
+
+const (
+	LevelNone  Level = 0
+	LevelRead  Level = 1 // 查询
+	LevelWrite Level = 2 // 查询、创建和修改
+	LevelAdmin Level = 3 // 所有操作，包括删除
+)
+
+var levelCodes = map[string]Level{
+	"read":  LevelRead,
+	"write": LevelWrite,
+	"admin": LevelAdmin,
+}
+
+const ResourceAll = "*"
+
+// ResourceDefinition 资源定义
+type ResourceDefinition struct {
+	Code     string   `json:"code"`
+	Name     string   `json:"name"`
+	Prefixes []string `json:"prefixes"` // 所属服务名前缀
+}
+
+var allResources = []*ResourceDefinition{
+	{
+		Code:     "server",
+		Name:     "网站服务",
+		Prefixes: []string{"Server", "HTTP", "ReverseProxy", "Origin", "UpdatingServerList"},
+	},
+	{
+		Code:     "cert",
+		Name:     "证书",
+		Prefixes: []string{"SSL", "ACME"},
+	},
+	{
+		Code:     "dns",
+		Name:     "DNS",
+		Prefixes: []string{"DNS"},
+	},
+	{
+		Code:     "node",
+		Name:     "节点和集群",
+		Prefixes: []string{"Node"},
+	},
+	{
+		Code:     "firewall",
+		Name:     "防火墙和IP名单",
+		Prefixes: []string{"HTTPFirewall", "Firewall", "IPList", "IPItem", "IPLibrary"},
+	},
+	{
+		Code:     "log",
+		Name:     "日志",
+		Prefixes: []string{"HTTPAccessLog", "NodeLog"},
+	},
+	{
+		Code:     "stat",
+		Name:     "统计",
+		Prefixes: []string{"ServerBandwidthStat", "ServerDailyStat", "ServerStatBoard", "TrafficDailyStat", "MetricStat", "MetricChart", "StatQuery"},
+	},
+	{
+		Code:     "user",
+		Name:     "用户",
+		Prefixes: []string{"User", "Quota"},
+	},
+}
+
+// 查询类方法的前缀
+var readMethodPrefixes = []string{"find", "list", "count", "check", "get", "compose", "tail", "lookup", "exists", "suggest", "sum", "read", "search", "validate", "watch"}
+
+// 删除类方法的前缀
+var adminMethodPrefixes = []string{"delete", "remove", "purge", "clean"}
+
+// FindAllResources 取得所有资源定义
+func FindAllResources() []*ResourceDefinition {
+	return allResources
+}
+
+// FindAllLevelCodes 取得所有级别代号
+func FindAllLevelCodes() []string {
+	return []string{"read", "write", "admin"}
+}
+
+// Scope 单个权限范围，格式为 resource:level，比如 dns:write
+type Scope struct {
+	Resource string
+	Level    Level
+}
+
+// ParseScope 分析权限范围
+func ParseScope(scopeString string) (*Scope, error) {
+	var pieces = strings.Split(strings.TrimSpace(scopeString), ":")
+	if len(pieces) != 2 {
+		return nil, errors.New("invalid scope '" + scopeString + "', should be in format 'RESOURCE:LEVEL'")
+	}
+
+	var resource = strings.ToLower(pieces[0])
+	if resource != ResourceAll && FindResource(resource) == nil {
+		return nil, errors.New("invalid scope '" + scopeString + "': unknown resource '" + pieces[0] + "'")
+	}
+
+	level, ok := levelCodes[strings.ToLower(pieces[1])]
+	if !ok {
+		return nil, errors.New("invalid scope '" + scopeString + "': unknown level '" + pieces[1] + "'")
+	}
+
+	return &Scope{
+		Resource: resource,
+		Level:    level,
+	}, nil
+}
+
+// NormalizeScopes 校验并整理权限范围，去除重复项后排序
+func NormalizeScopes(scopeStrings []string) ([]string, error) {
+	var result = []string{}
+	var found = map[string]bool{}
+	for _, scopeString := range scopeStrings {
+		scope, err := ParseScope(scopeString)
+		if err != nil {
+			return nil, err
+		}
+		var s = scope.String()
+		if found[s] {
+			continue
+		}
+		found[s] = true
+		result = append(result, s)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// IsSubset 判断权限范围 scopeStrings 是否都包含在 parentScopeStrings 中
+// parentScopeStrings 为空表示不限制
+func IsSubset(scopeStrings []string, parentScopeStrings []string) bool {
+	if len(parentScopeStrings) == 0 {
+		return true
+	}
+	if len(scopeStrings) == 0 {
+		return false
+	}
+	for _, scopeString := range scopeStrings {
+		scope, err := ParseScope(scopeString)
+		if err != nil {
+			return false
+		}
+		if !allow(parentScopeStrings, scope.Resource, scope.Level) {
+			return false
+		}
+	}
+	return true
+}
+
+// String 转换为字符串
+func (this *Scope) String() string {
+	for code, level := range levelCodes {
+		if level == this.Level {
+			return this.Resource + ":" + code
+		}
+	}
+	return this.Resource + ":"
+}
+
+// FindResource 根据代号查找资源
+func FindResource(code string) *ResourceDefinition {
+	for _, resource := range allResources {
+		if resource.Code == code {
+			return resource
+		}
+	}
+	return nil
+}
+
+// ResourceOfService 查找服务所属资源，如果没有找到则返回空
+// 如果匹配多个资源，则使用最长的前缀
+func ResourceOfService(serviceName string) string {
+	var result string
+	var maxLength int
+	for _, resource := range allResources {
+		for _, prefix := range resource.Prefixes {
+			if len(prefix) > maxLength && strings.HasPrefix(serviceName, prefix) {
+				result = resource.Code
+				maxLength = len(prefix)
+			}
+		}
+	}
+	return result
+}
+
+// LevelOfMethod 调用方法需要的级别
+func LevelOfMethod(methodName string) Level {
+	// 批量操作和单个操作的权限相同
+	var lowerName = strings.TrimPrefix(strings.ToLower(methodName), "batch")
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(lowerName, prefix) {
+			return LevelRead
+		}
+	}
+	for _, prefix := range adminMethodPrefixes {
+		if strings.HasPrefix(lowerName, prefix) {
+			return LevelAdmin
+		}
+	}
+	return LevelWrite
+}
+
+// Check 检查权限范围是否允许调用某个服务方法
+// scopeStrings 为空表示不限制
+func Check(scopeStrings []string, serviceName string, methodName string) error {
+	if len(scopeStrings) == 0 {
+		return nil
+	}
+
+	var resource = ResourceOfService(serviceName)
+	var level = LevelOfMethod(methodName)
+	if !allow(scopeStrings, resource, level) {
+		var levelCode = ""
+		for code, l := range levelCodes {
+			if l == level {
+				levelCode = code
+			}
+		}
+		if len(resource) == 0 {
+			return errors.New("permission denied: '" + serviceName + "." + methodName + "()' is not allowed for scoped access tokens")
+		}
+		return errors.New("permission denied: '" + serviceName + "." + methodName + "()' requires scope '" + resource + ":" + levelCode + "'")
+	}
+	return nil
+}
+
+func allow(scopeStrings []string, resource string, level Level) bool {
+	for _, scopeString := range scopeStrings {
+		scope, err := ParseScope(scopeString)
+		if err != nil {
+			continue
+		}
+		if scope.Level < level {
+			continue
+		}
+		if scope.Resource == ResourceAll || (len(resource) > 0 && scope.Resource == resource) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package scopes_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/scopes"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestParseScope(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	{
+		scope, err := scopes.ParseScope("dns:write")
+		a.IsTrue(err == nil)
+		a.IsTrue(scope.Resource == "dns")
+		a.IsTrue(scope.Level == scopes.LevelWrite)
+		a.IsTrue(scope.String() == "dns:write")
+	}
+	{
+		scope, err := scopes.ParseScope("*:READ")
+		a.IsTrue(err == nil)
+		a.IsTrue(scope.String() == "*:read")
+	}
+	{
+		_, err := scopes.ParseScope("dns")
+		a.IsTrue(err != nil)
+	}
+	{
+		_, err := scopes.ParseScope("abc:read")
+		a.IsTrue(err != nil)
+	}
+	{
+		_, err := scopes.ParseScope("dns:owner")
+		a.IsTrue(err != nil)
+	}
+}
+
+func TestNormalizeScopes(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	result, err := scopes.NormalizeScopes([]string{"server:admin", "DNS:write", "dns:write"})
+	a.IsTrue(err == nil)
+	a.IsTrue(len(result) == 2)
+	a.IsTrue(result[0] == "dns:write")
+	a.IsTrue(result[1] == "server:admin")
+
+	_, err = scopes.NormalizeScopes([]string{"dns:write", "cert"})
+	a.IsTrue(err != nil)
+}
+
+func TestResourceOfService(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(scopes.ResourceOfService("ServerService") == "server")
+	a.IsTrue(scopes.ResourceOfService("HTTPWebService") == "server")
+	a.IsTrue(scopes.ResourceOfService("HTTPFirewallPolicyService") == "firewall")
+	a.IsTrue(scopes.ResourceOfService("HTTPAccessLogService") == "log")
+	a.IsTrue(scopes.ResourceOfService("NodeLogService") == "log")
+	a.IsTrue(scopes.ResourceOfService("NodeClusterService") == "node")
+	a.IsTrue(scopes.ResourceOfService("ServerDailyStatService") == "stat")
+	a.IsTrue(scopes.ResourceOfService("ACMETaskService") == "cert")
+	a.IsTrue(scopes.ResourceOfService("AdminService") == "")
+}
+
+func TestLevelOfMethod(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(scopes.LevelOfMethod("FindEnabledServer") == scopes.LevelRead)
+	a.IsTrue(scopes.LevelOfMethod("listEnabledServersMatch") == scopes.LevelRead)
+	a.IsTrue(scopes.LevelOfMethod("CreateServer") == scopes.LevelWrite)
+	a.IsTrue(scopes.LevelOfMethod("UpdateServerIsOn") == scopes.LevelWrite)
+	a.IsTrue(scopes.LevelOfMethod("DeleteServer") == scopes.LevelAdmin)
+	a.IsTrue(scopes.LevelOfMethod("batchCreateDNSRecords") == scopes.LevelWrite)
+	a.IsTrue(scopes.LevelOfMethod("batchDeleteDNSRecords") == scopes.LevelAdmin)
+}
+
+func TestCheck(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	// 不限制
+	a.IsTrue(scopes.Check(nil, "AdminService", "DeleteAdmin") == nil)
+
+	var tokenScopes = []string{"dns:write", "cert:read", "server:admin"}
+	a.IsTrue(scopes.Check(tokenScopes, "DNSDomainService", "FindAllDNSDomainRoutes") == nil)
+	a.IsTrue(scopes.Check(tokenScopes, "DNSDomainService", "UpdateDNSDomain") == nil)
+	a.IsTrue(scopes.Check(tokenScopes, "DNSDomainService", "DeleteDNSDomain") != nil)
+	a.IsTrue(scopes.Check(tokenScopes, "SSLCertService", "FindEnabledSSLCertConfig") == nil)
+	a.IsTrue(scopes.Check(tokenScopes, "SSLCertService", "CreateSSLCert") != nil)
+	a.IsTrue(scopes.Check(tokenScopes, "ServerService", "DeleteServer") == nil)
+	a.IsTrue(scopes.Check(tokenScopes, "NodeService", "FindEnabledNode") != nil)
+	a.IsTrue(scopes.Check(tokenScopes, "AdminService", "FindEnabledAdmin") != nil)
+
+	// 所有资源
+	a.IsTrue(scopes.Check([]string{"*:read"}, "AdminService", "FindEnabledAdmin") == nil)
+	a.IsTrue(scopes.Check([]string{"*:read"}, "AdminService", "CreateOrUpdateAdmin") != nil)
+}
+
+func TestIsSubset(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(scopes.IsSubset([]string{"dns:read"}, nil))
+	a.IsTrue(scopes.IsSubset(nil, nil))
+	a.IsFalse(scopes.IsSubset(nil, []string{"dns:write"}))
+	a.IsTrue(scopes.IsSubset([]string{"dns:read"}, []string{"dns:write"}))
+	a.IsTrue(scopes.IsSubset([]string{"dns:admin", "cert:read"}, []string{"*:admin"}))
+	a.IsFalse(scopes.IsSubset([]string{"dns:admin"}, []string{"dns:write"}))
+	a.IsFalse(scopes.IsSubset([]string{"*:read"}, []string{"dns:write"}))
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+)
+
+// AccessLogArchiveService 访问日志归档服务
+type AccessLogArchiveService struct {
+	BaseService
+}
+
+// FindAccessLogArchiveConfig 读取归档设置
+func (this *AccessLogArchiveService) FindAccessLogArchiveConfig(ctx context.Context, req *pb.FindAccessLogArchiveConfigRequest) (*pb.FindAccessLogArchiveConfigResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := models.SharedSysSettingDAO.ReadAccessLogArchiveConfig(this.NullTx())
+	if err != nil {
+		return nil, err
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FindAccessLogArchiveConfigResponse{AccessLogArchiveConfigJSON: configJSON}, nil
+}
+
+// UpdateAccessLogArchiveConfig 修改归档设置
+func (this *AccessLogArchiveService) UpdateAccessLogArchiveConfig(ctx context.Context, req *pb.UpdateAccessLogArchiveConfigRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = systemconfigs.NewAccessLogArchiveConfig()
+	err = json.Unmarshal(req.AccessLogArchiveConfigJSON, config)
+	if err != nil {
+		return nil, errors.New("decode access log archive config failed: " + err.Error())
+	}
+	err = config.Validate()
+	if err != nil {
+		return nil, errors.New("validate access log archive config failed: " + err.Error())
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	err = models.SharedSysSettingDAO.UpdateSetting(this.NullTx(), systemconfigs.SettingCodeAccessLogArchive, configJSON)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// FindAllAccessLogArchives 查找已归档的访问日志
+func (this *AccessLogArchiveService) FindAllAccessLogArchives(ctx context.Context, req *pb.FindAllAccessLogArchivesRequest) (*pb.FindAllAccessLogArchivesResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	archives, err := models.SharedAccessLogArchiveDAO.FindAllArchives(this.NullTx(), req.LogType, req.DayFrom, req.DayTo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbArchives = []*pb.FindAllAccessLogArchivesResponse_AccessLogArchive{}
+	for _, archive := range archives {
+		pbArchives = append(pbArchives, &pb.FindAllAccessLogArchivesResponse_AccessLogArchive{
+			Id:        int64(archive.Id),
+			DbNodeId:  int64(archive.DbNodeId),
+			TableName: archive.TableName,
+			LogType:   archive.LogType,
+			Day:       archive.Day,
+			ObjectKey: archive.ObjectKey,
+			Size:      int64(archive.Size),
+			CountRows: int64(archive.CountRows),
+			CreatedAt: int64(archive.CreatedAt),
+		})
+	}
+	return &pb.FindAllAccessLogArchivesResponse{AccessLogArchives: pbArchives}, nil
+}
+
+// FindAccessLogArchiveRanges 查找已归档的连续日期范围
+func (this *AccessLogArchiveService) FindAccessLogArchiveRanges(ctx context.Context, req *pb.FindAccessLogArchiveRangesRequest) (*pb.FindAccessLogArchiveRangesResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dayRanges, err := models.SharedAccessLogArchiveDAO.FindArchivedDayRanges(this.NullTx(), req.LogType)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbDayRanges = []*pb.FindAccessLogArchiveRangesResponse_DayRange{}
+	for _, dayRange := range dayRanges {
+		pbDayRanges = append(pbDayRanges, &pb.FindAccessLogArchiveRangesResponse_DayRange{
+			DayFrom: dayRange.DayFrom,
+			DayTo:   dayRange.DayTo,
+		})
+	}
+	return &pb.FindAccessLogArchiveRangesResponse{DayRanges: pbDayRanges}, nil
+}
@@ -34,3 +34,28 @@ func (this *ACMEAuthenticationService) FindACMEAuthenticationKeyWithToken(ctx co
 	}
 	return &pb.FindACMEAuthenticationKeyWithTokenResponse{Key: auth.Key}, nil
 }
+
+// FindRecentACMEAuthentications 查找最近的认证信息
+func (this *ACMEAuthenticationService) FindRecentACMEAuthentications(ctx context.Context, req *pb.FindRecentACMEAuthenticationsRequest) (*pb.FindRecentACMEAuthenticationsResponse, error) {
+	_, err := this.ValidateNode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	auths, err := acme.SharedACMEAuthenticationDAO.FindRecentAuths(tx, req.SinceTime, 1000)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbAuths = []*pb.FindRecentACMEAuthenticationsResponse_ACMEAuthentication{}
+	for _, auth := range auths {
+		pbAuths = append(pbAuths, &pb.FindRecentACMEAuthenticationsResponse_ACMEAuthentication{
+			Domain:    auth.Domain,
+			Token:     auth.Token,
+			Key:       auth.Key,
+			CreatedAt: int64(auth.CreatedAt),
+		})
+	}
+	return &pb.FindRecentACMEAuthenticationsResponse{AcmeAuthentications: pbAuths}, nil
+}
@@ -30,6 +30,8 @@ func (this *ACMEProviderService) FindAllACMEProviders(ctx context.Context, req *
 			ApiURL:         provider.APIURL,
 			RequireEAB:     provider.RequireEAB,
 			EabDescription: provider.EABDescription,
+			CanGenerateEAB: provider.CanGenerateEAB,
+			TestAPIURL:     provider.TestAPIURL,
 		})
 	}
 
@@ -58,6 +60,8 @@ func (this *ACMEProviderService) FindACMEProviderWithCode(ctx context.Context, r
 			ApiURL:         provider.APIURL,
 			RequireEAB:     provider.RequireEAB,
 			EabDescription: provider.EABDescription,
+			CanGenerateEAB: provider.CanGenerateEAB,
+			TestAPIURL:     provider.TestAPIURL,
 		},
 	}, nil
 }
@@ -4,10 +4,13 @@ package services
 
 import (
 	"context"
+	"strings"
 
 	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models/acme"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/types"
 )
 
 // ACMEProviderAccountService ACME服务商账号服务
@@ -22,8 +25,14 @@ func (this *ACMEProviderAccountService) CreateACMEProviderAccount(ctx context.Co
 		return nil, err
 	}
 
+	// 自动生成并校验EAB
+	eabKid, eabKey, err := this.composeEAB(req.ProviderCode, req.EabKid, req.EabKey, req.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+
 	var tx = this.NullTx()
-	accountId, err := acme.SharedACMEProviderAccountDAO.CreateAccount(tx, userId, req.Name, req.ProviderCode, req.EabKid, req.EabKey)
+	accountId, err := acme.SharedACMEProviderAccountDAO.CreateAccount(tx, userId, req.Name, req.ProviderCode, eabKid, eabKey)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +101,21 @@ func (this *ACMEProviderAccountService) UpdateACMEProviderAccount(ctx context.Co
 		}
 	}
 
-	err = acme.SharedACMEProviderAccountDAO.UpdateAccount(tx, req.AcmeProviderAccountId, req.Name, req.EabKid, req.EabKey)
+	account, err := acme.SharedACMEProviderAccountDAO.FindEnabledACMEProviderAccount(tx, req.AcmeProviderAccountId)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, errors.New("can not find account with id '" + types.String(req.AcmeProviderAccountId) + "'")
+	}
+
+	// 自动生成并校验EAB
+	eabKid, eabKey, err := this.composeEAB(account.ProviderCode, req.EabKid, req.EabKey, req.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = acme.SharedACMEProviderAccountDAO.UpdateAccount(tx, req.AcmeProviderAccountId, req.Name, eabKid, eabKey)
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +181,7 @@ func (this *ACMEProviderAccountService) FindEnabledACMEProviderAccount(ctx conte
 			ApiURL:         provider.APIURL,
 			RequireEAB:     provider.RequireEAB,
 			EabDescription: provider.EABDescription,
+			CanGenerateEAB: provider.CanGenerateEAB,
 		}
 	}
 
@@ -212,6 +236,7 @@ func (this *ACMEProviderAccountService) ListEnabledACMEProviderAccounts(ctx cont
 				ApiURL:         provider.APIURL,
 				RequireEAB:     provider.RequireEAB,
 				EabDescription: provider.EABDescription,
+				CanGenerateEAB: provider.CanGenerateEAB,
 			}
 		}
 
@@ -229,3 +254,27 @@ func (this *ACMEProviderAccountService) ListEnabledACMEProviderAccounts(ctx cont
 
 	return &pb.ListEnabledACMEProviderAccountsResponse{AcmeProviderAccounts: pbAccounts}, nil
 }
+
+// 在EAB为空并且提供了API Key时，调用服务商接口自动生成EAB，然后校验EAB格式
+func (this *ACMEProviderAccountService) composeEAB(providerCode string, eabKid string, eabKey string, apiKey string) (resultKid string, resultKey string, err error) {
+	eabKid = strings.TrimSpace(eabKid)
+	eabKey = strings.TrimSpace(eabKey)
+
+	var provider = acmeutils.FindProviderWithCode(providerCode)
+	if provider == nil || !provider.RequireEAB {
+		return eabKid, eabKey, nil
+	}
+
+	if (len(eabKid) == 0 || len(eabKey) == 0) && len(apiKey) > 0 && provider.CanGenerateEAB {
+		eabKid, eabKey, err = acmeutils.GenerateEAB(providerCode, apiKey)
+		if err != nil {
+			return "", "", errors.New("generate EAB failed: " + err.Error())
+		}
+	}
+
+	err = acmeutils.ValidateEAB(eabKid, eabKey)
+	if err != nil {
+		return "", "", errors.New("invalid EAB: " + err.Error())
+	}
+	return eabKid, eabKey, nil
+}
@@ -2,13 +2,18 @@ package services
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
+	"time"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/acme"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	acmemodels "github.com/TeaOSLab/EdgeAPI/internal/db/models/acme"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models/dns"
 	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients"
+	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/dbs"
 )
 
 // ACMETaskService ACME任务相关服务
@@ -120,6 +125,7 @@ func (this *ACMETaskService) ListEnabledACMETasks(ctx context.Context, req *pb.L
 				Description:    provider.Description,
 				RequireEAB:     provider.RequireEAB,
 				EabDescription: provider.EABDescription,
+				CanGenerateEAB: provider.CanGenerateEAB,
 			}
 		}
 
@@ -146,6 +152,7 @@ func (this *ACMETaskService) ListEnabledACMETasks(ctx context.Context, req *pb.L
 						Description:    provider.Description,
 						RequireEAB:     provider.RequireEAB,
 						EabDescription: provider.EABDescription,
+						CanGenerateEAB: provider.CanGenerateEAB,
 					}
 				}
 			}
@@ -216,6 +223,13 @@ func (this *ACMETaskService) ListEnabledACMETasks(ctx context.Context, req *pb.L
 			LatestACMETaskLog: pbTaskLog,
 			AuthType:          task.AuthType,
 			AuthURL:           task.AuthURL,
+			DnsAliasDomain:    task.DnsAliasDomain,
+			KeyType:           task.KeyType,
+			PreferredChain:    task.PreferredChain,
+			ThrottledUntil:    int64(task.ThrottledUntil),
+			ThrottleReason:    task.ThrottleReason,
+			DirectoryURL:      task.DirectoryURL,
+			HasCSR:            len(task.Csr) > 0,
 		})
 	}
 
@@ -239,17 +253,103 @@ func (this *ACMETaskService) CreateACMETask(ctx context.Context, req *pb.CreateA
 		}
 	}
 
+	if len(req.KeyType) > 0 && !acme.IsValidKeyType(req.KeyType) {
+		return nil, errors.New("invalid key type '" + req.KeyType + "'")
+	}
+	_, err = acme.NormalizePreferredChain(req.PreferredChain)
+	if err != nil {
+		return nil, err
+	}
+
+	// CSR
+	var csr *x509.CertificateRequest
+	if len(req.CsrData) > 0 {
+		csr, err = acme.ParseCSR(req.CsrData)
+		if err != nil {
+			return nil, errors.New("invalid csr: " + err.Error())
+		}
+
+		// 没有指定域名时使用CSR中的域名
+		if len(req.Domains) == 0 {
+			req.Domains, err = acme.CSRDomains(csr)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// 规范化域名
+	req.DnsDomain, req.DnsAliasDomain, req.Domains, err = this.normalizeDomains(req.DnsDomain, req.DnsAliasDomain, req.Domains)
+	if err != nil {
+		return nil, err
+	}
+	if req.IncludeWildcardApex {
+		req.Domains = acme.IncludeWildcardApex(req.Domains)
+	}
+	if csr != nil {
+		err = acme.CheckCSRDomains(csr, req.Domains)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var tx = this.NullTx()
+
+	directoryURL, err := this.normalizeDirectoryURL(tx, req.AcmeUserId, req.DirectoryURL, adminId > 0)
+	if err != nil {
+		return nil, err
+	}
+
 	taskId, err := acmemodels.SharedACMETaskDAO.CreateACMETask(tx, adminId, userId, req.AuthType, req.AcmeUserId, req.DnsProviderId, req.DnsDomain, req.Domains, req.AutoRenew, req.AuthURL, req.Async)
 	if err != nil {
 		return nil, err
 	}
+
+	// DNS别名
+	if req.DnsAliasProviderId > 0 && len(req.DnsAliasDomain) > 0 {
+		err = acmemodels.SharedACMETaskDAO.UpdateACMETaskDNSAlias(tx, taskId, req.DnsAliasProviderId, req.DnsAliasDomain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 私钥类型
+	if len(req.KeyType) > 0 {
+		err = acmemodels.SharedACMETaskDAO.UpdateACMETaskKeyType(tx, taskId, req.KeyType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 首选证书链
+	if len(req.PreferredChain) > 0 {
+		err = acmemodels.SharedACMETaskDAO.UpdateACMETaskPreferredChain(tx, taskId, req.PreferredChain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 自定义目录
+	if len(directoryURL) > 0 {
+		err = acmemodels.SharedACMETaskDAO.UpdateACMETaskDirectoryURL(tx, taskId, directoryURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// CSR
+	if csr != nil {
+		err = acmemodels.SharedACMETaskDAO.UpdateACMETaskCSR(tx, taskId, acme.EncodeCSR(csr))
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &pb.CreateACMETaskResponse{AcmeTaskId: taskId}, nil
 }
 
 // UpdateACMETask 修改任务
 func (this *ACMETaskService) UpdateACMETask(ctx context.Context, req *pb.UpdateACMETaskRequest) (*pb.RPCSuccess, error) {
-	_, userId, err := this.ValidateAdminAndUser(ctx, false)
+	adminId, userId, err := this.ValidateAdminAndUser(ctx, false)
 	if err != nil {
 		return nil, err
 	}
@@ -264,10 +364,82 @@ func (this *ACMETaskService) UpdateACMETask(ctx context.Context, req *pb.UpdateA
 		return nil, this.PermissionError()
 	}
 
+	directoryURL, err := this.normalizeDirectoryURL(tx, req.AcmeUserId, req.DirectoryURL, adminId > 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// 规范化域名
+	req.DnsDomain, req.DnsAliasDomain, req.Domains, err = this.normalizeDomains(req.DnsDomain, req.DnsAliasDomain, req.Domains)
+	if err != nil {
+		return nil, err
+	}
+	if req.IncludeWildcardApex {
+		req.Domains = acme.IncludeWildcardApex(req.Domains)
+	}
+
+	// CSR，未提供新的CSR时检查域名是否和已有的CSR一致
+	var csrData = req.CsrData
+	if len(csrData) == 0 && !req.RemoveCSR {
+		csrData, err = acmemodels.SharedACMETaskDAO.FindACMETaskCSR(tx, req.AcmeTaskId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var csr *x509.CertificateRequest
+	if len(csrData) > 0 {
+		csr, err = acme.ParseCSR(csrData)
+		if err != nil {
+			return nil, errors.New("invalid csr: " + err.Error())
+		}
+		err = acme.CheckCSRDomains(csr, req.Domains)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = acmemodels.SharedACMETaskDAO.UpdateACMETask(tx, req.AcmeTaskId, req.AcmeUserId, req.DnsProviderId, req.DnsDomain, req.Domains, req.AutoRenew, req.AuthURL)
 	if err != nil {
 		return nil, err
 	}
+
+	// DNS别名
+	err = acmemodels.SharedACMETaskDAO.UpdateACMETaskDNSAlias(tx, req.AcmeTaskId, req.DnsAliasProviderId, req.DnsAliasDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	// 私钥类型，为空时保持不变，以便兼容旧的客户端
+	if len(req.KeyType) > 0 {
+		err = acmemodels.SharedACMETaskDAO.UpdateACMETaskKeyType(tx, req.AcmeTaskId, req.KeyType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 首选证书链，为空表示使用CA的默认证书链
+	err = acmemodels.SharedACMETaskDAO.UpdateACMETaskPreferredChain(tx, req.AcmeTaskId, req.PreferredChain)
+	if err != nil {
+		return nil, err
+	}
+
+	// 自定义目录，为空表示使用服务商的正式目录
+	err = acmemodels.SharedACMETaskDAO.UpdateACMETaskDirectoryURL(tx, req.AcmeTaskId, directoryURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// CSR
+	if len(req.CsrData) > 0 || req.RemoveCSR {
+		var newCSRData []byte
+		if csr != nil {
+			newCSRData = acme.EncodeCSR(csr)
+		}
+		err = acmemodels.SharedACMETaskDAO.UpdateACMETaskCSR(tx, req.AcmeTaskId, newCSRData)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return this.Success()
 }
 
@@ -312,7 +484,7 @@ func (this *ACMETaskService) RunACMETask(ctx context.Context, req *pb.RunACMETas
 		return nil, this.PermissionError()
 	}
 
-	isOk, msg, certId := acmemodels.SharedACMETaskDAO.RunTask(tx, req.AcmeTaskId)
+	isOk, msg, certId := acmemodels.SharedACMETaskDAO.RunTask(req.AcmeTaskId)
 
 	return &pb.RunACMETaskResponse{
 		IsOk:      isOk,
@@ -321,6 +493,108 @@ func (this *ACMETaskService) RunACMETask(ctx context.Context, req *pb.RunACMETas
 	}, nil
 }
 
+// DryRunACMETask 在CA测试环境中试运行任务
+func (this *ACMETaskService) DryRunACMETask(ctx context.Context, req *pb.DryRunACMETaskRequest) (*pb.DryRunACMETaskResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+
+	canAccess, err := acmemodels.SharedACMETaskDAO.CheckUserACMETask(tx, userId, req.AcmeTaskId)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccess {
+		return nil, this.PermissionError()
+	}
+
+	report, err := acmemodels.SharedACMETaskDAO.DryRunTask(req.AcmeTaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbSteps = []*pb.DryRunACMETaskResponse_Step{}
+	for _, step := range report.Steps {
+		pbSteps = append(pbSteps, &pb.DryRunACMETaskResponse_Step{
+			Name:    step.Name,
+			IsOk:    step.IsOk,
+			Message: step.Message,
+			CostMs:  step.CostMs,
+		})
+	}
+
+	return &pb.DryRunACMETaskResponse{
+		IsOk:          report.IsOk,
+		Error:         report.Error,
+		DirectoryURL:  report.DirectoryURL,
+		Steps:         pbSteps,
+		CertDNSNames:  report.CertDNSNames,
+		CertIssuer:    report.CertIssuer,
+		CertExpiresAt: report.CertExpiresAt,
+	}, nil
+}
+
+// WatchACMETaskProgress 监听任务执行进度
+func (this *ACMETaskService) WatchACMETaskProgress(req *pb.WatchACMETaskProgressRequest, server pb.ACMETaskService_WatchACMETaskProgressServer) error {
+	_, userId, err := this.ValidateAdminAndUser(server.Context(), false)
+	if err != nil {
+		return err
+	}
+
+	var tx = this.NullTx()
+
+	canAccess, err := acmemodels.SharedACMETaskDAO.CheckUserACMETask(tx, userId, req.AcmeTaskId)
+	if err != nil {
+		return err
+	}
+	if !canAccess {
+		return this.PermissionError()
+	}
+
+	events, cancel := acme.SharedTaskProgressHub.Subscribe(req.AcmeTaskId)
+	defer cancel()
+
+	err = server.Send(&pb.WatchACMETaskProgressResponse{
+		IsSubscribed: true,
+		CreatedAt:    time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-server.Context().Done():
+			return nil
+		case event := <-events:
+			var resp = &pb.WatchACMETaskProgressResponse{
+				IsFinished: event.IsFinished,
+				IsOk:       event.IsOk,
+				Error:      event.Error,
+				SslCertId:  event.CertId,
+				CreatedAt:  event.CreatedAt,
+			}
+			if event.Step != nil {
+				resp.Step = &pb.WatchACMETaskProgressResponse_Step{
+					Name:    event.Step.Name,
+					IsOk:    event.Step.IsOk,
+					Message: event.Step.Message,
+					CostMs:  event.Step.CostMs,
+				}
+			}
+			err = server.Send(resp)
+			if err != nil {
+				return err
+			}
+			if event.IsFinished {
+				return nil
+			}
+		}
+	}
+}
+
 // FindEnabledACMETask 查找单个任务信息
 func (this *ACMETaskService) FindEnabledACMETask(ctx context.Context, req *pb.FindEnabledACMETaskRequest) (*pb.FindEnabledACMETaskResponse, error) {
 	_, userId, err := this.ValidateAdminAndUser(ctx, false)
@@ -373,6 +647,8 @@ func (this *ACMETaskService) FindEnabledACMETask(ctx context.Context, req *pb.Fi
 					Description:    provider.Description,
 					RequireEAB:     provider.RequireEAB,
 					EabDescription: provider.EABDescription,
+					CanGenerateEAB: provider.CanGenerateEAB,
+					TestAPIURL:     provider.TestAPIURL,
 				}
 			}
 
@@ -399,6 +675,7 @@ func (this *ACMETaskService) FindEnabledACMETask(ctx context.Context, req *pb.Fi
 							Description:    provider.Description,
 							RequireEAB:     provider.RequireEAB,
 							EabDescription: provider.EABDescription,
+							CanGenerateEAB: provider.CanGenerateEAB,
 						}
 					}
 				}
@@ -421,6 +698,23 @@ func (this *ACMETaskService) FindEnabledACMETask(ctx context.Context, req *pb.Fi
 		}
 	}
 
+	// DNS别名
+	var pbAliasProvider *pb.DNSProvider
+	if task.DnsAliasProviderId > 0 {
+		aliasProvider, err := dns.SharedDNSProviderDAO.FindEnabledDNSProvider(tx, int64(task.DnsAliasProviderId))
+		if err != nil {
+			return nil, err
+		}
+		if aliasProvider != nil {
+			pbAliasProvider = &pb.DNSProvider{
+				Id:       int64(aliasProvider.Id),
+				Name:     aliasProvider.Name,
+				Type:     aliasProvider.Type,
+				TypeName: dnsclients.FindProviderTypeName(aliasProvider.Type),
+			}
+		}
+	}
+
 	// 证书
 	var pbCert *pb.SSLCert
 	if task.CertId > 0 {
@@ -439,6 +733,15 @@ func (this *ACMETaskService) FindEnabledACMETask(ctx context.Context, req *pb.Fi
 		AuthType:    task.AuthType,
 		AuthURL:     task.AuthURL,
 		SslCert:     pbCert,
+
+		DnsAliasDomain:   task.DnsAliasDomain,
+		DnsAliasProvider: pbAliasProvider,
+		KeyType:          task.KeyType,
+		PreferredChain:   task.PreferredChain,
+		ThrottledUntil:   int64(task.ThrottledUntil),
+		ThrottleReason:   task.ThrottleReason,
+		DirectoryURL:     task.DirectoryURL,
+		HasCSR:           len(task.Csr) > 0,
 	}}, nil
 }
 
@@ -478,3 +781,35 @@ func (this *ACMETaskService) FindACMETaskUser(ctx context.Context, req *pb.FindA
 		},
 	}, nil
 }
+
+// 校验任务自定义的ACME目录，只有管理员才能使用服务商测试目录之外的URL
+func (this *ACMETaskService) normalizeDirectoryURL(tx *dbs.Tx, acmeUserId int64, directoryURL string, allowCustom bool) (string, error) {
+	if len(directoryURL) == 0 {
+		return "", nil
+	}
+
+	var providerCode = acme.DefaultProviderCode
+	acmeUser, err := acmemodels.SharedACMEUserDAO.FindEnabledACMEUser(tx, acmeUserId)
+	if err != nil {
+		return "", err
+	}
+	if acmeUser != nil && len(acmeUser.ProviderCode) > 0 {
+		providerCode = acmeUser.ProviderCode
+	}
+
+	return acme.NormalizeDirectoryURL(acme.FindProviderWithCode(providerCode), directoryURL, allowCustom)
+}
+
+// 规范化任务中的域名，国际化域名转换为Punycode格式，并去除重复的域名
+func (this *ACMETaskService) normalizeDomains(dnsDomain string, dnsAliasDomain string, domains []string) (resultDNSDomain string, resultDNSAliasDomain string, resultDomains []string, err error) {
+	resultDNSDomain, err = configutils.NormalizeDomain(dnsDomain)
+	if err != nil {
+		return
+	}
+	resultDNSAliasDomain, err = configutils.NormalizeDomain(dnsAliasDomain)
+	if err != nil {
+		return
+	}
+	resultDomains, err = acme.NormalizeDomains(domains)
+	return
+}
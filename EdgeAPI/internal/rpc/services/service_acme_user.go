@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/acme"
 	acmemodels "github.com/TeaOSLab/EdgeAPI/internal/db/models/acme"
@@ -36,6 +38,60 @@ func (this *ACMEUserService) CreateACMEUser(ctx context.Context, req *pb.CreateA
 	return &pb.CreateACMEUserResponse{AcmeUserId: acmeUserId}, nil
 }
 
+// ImportACMEUser 导入已有的用户
+func (this *ACMEUserService) ImportACMEUser(ctx context.Context, req *pb.ImportACMEUserRequest) (*pb.ImportACMEUserResponse, error) {
+	// 校验请求
+	adminId, userId, err := this.ValidateAdminAndUser(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if adminId > 0 {
+		if req.UserId > 0 {
+			userId = req.UserId
+		}
+	}
+
+	if len(req.AcmeProviderCode) == 0 {
+		req.AcmeProviderCode = acme.DefaultProviderCode
+	}
+	var provider = acme.FindProviderWithCode(req.AcmeProviderCode)
+	if provider == nil {
+		return nil, errors.New("can not find provider with code '" + req.AcmeProviderCode + "'")
+	}
+
+	privateKey, err := acme.ParsePrivateKeyFromPEM(req.PrivateKeyData)
+	if err != nil {
+		return nil, err
+	}
+	privateKeyText, err := acme.EncodePrivateKeyToBase64(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	accountURL, err := acme.ValidateAccountURL(provider, req.AccountURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// 从服务商查找账号，以确认私钥和账号是匹配的
+	resource, err := acme.ResolveAccount(provider, req.Email, privateKey, accountURL)
+	if err != nil {
+		return nil, err
+	}
+	registrationJSON, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	acmeUserId, err := acmemodels.SharedACMEUserDAO.ImportACMEUser(tx, adminId, userId, req.AcmeProviderCode, req.AcmeProviderAccountId, req.Email, req.Description, privateKeyText, registrationJSON)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ImportACMEUserResponse{AcmeUserId: acmeUserId}, nil
+}
+
 // UpdateACMEUser 修改用户
 func (this *ACMEUserService) UpdateACMEUser(ctx context.Context, req *pb.UpdateACMEUserRequest) (*pb.RPCSuccess, error) {
 	// 校验请求
@@ -148,6 +204,7 @@ func (this *ACMEUserService) ListACMEUsers(ctx context.Context, req *pb.ListACME
 				Description:    provider.Description,
 				RequireEAB:     provider.RequireEAB,
 				EabDescription: provider.EABDescription,
+				CanGenerateEAB: provider.CanGenerateEAB,
 			}
 		}
 
@@ -174,6 +231,7 @@ func (this *ACMEUserService) ListACMEUsers(ctx context.Context, req *pb.ListACME
 						Description:    provider.Description,
 						RequireEAB:     provider.RequireEAB,
 						EabDescription: provider.EABDescription,
+						CanGenerateEAB: provider.CanGenerateEAB,
 					}
 				}
 			}
@@ -230,6 +288,7 @@ func (this *ACMEUserService) FindEnabledACMEUser(ctx context.Context, req *pb.Fi
 			Description:    provider.Description,
 			RequireEAB:     provider.RequireEAB,
 			EabDescription: provider.EABDescription,
+			CanGenerateEAB: provider.CanGenerateEAB,
 		}
 	}
 
@@ -256,6 +315,7 @@ func (this *ACMEUserService) FindEnabledACMEUser(ctx context.Context, req *pb.Fi
 					Description:    provider.Description,
 					RequireEAB:     provider.RequireEAB,
 					EabDescription: provider.EABDescription,
+					CanGenerateEAB: provider.CanGenerateEAB,
 				}
 			}
 		}
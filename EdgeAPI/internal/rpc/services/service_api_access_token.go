@@ -3,9 +3,14 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/scopes"
+	rpcutils "github.com/TeaOSLab/EdgeAPI/internal/rpc/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/types"
 )
 
 // APIAccessTokenService AccessToken相关服务
@@ -65,6 +70,20 @@ func (this *APIAccessTokenService) GetAPIAccessToken(ctx context.Context, req *p
 		return nil, errors.New("invalid type '" + req.Type + "'")
 	}
 
+	// 权限范围
+	// 申请的权限范围不能超出AccessKey的权限范围
+	var keyScopes = accessKey.DecodeScopes()
+	var tokenScopes = keyScopes
+	if len(req.Scopes) > 0 {
+		tokenScopes, err = scopes.NormalizeScopes(req.Scopes)
+		if err != nil {
+			return nil, errors.New("validate scopes failed: " + err.Error())
+		}
+		if !scopes.IsSubset(tokenScopes, keyScopes) {
+			return nil, errors.New("the requested scopes exceed the scopes of the access key")
+		}
+	}
+
 	// 更新AccessKey访问时间
 	err = models.SharedUserAccessKeyDAO.UpdateAccessKeyAccessedAt(tx, int64(accessKey.Id))
 	if err != nil {
@@ -72,7 +91,7 @@ func (this *APIAccessTokenService) GetAPIAccessToken(ctx context.Context, req *p
 	}
 
 	// 创建AccessToken
-	token, expiresAt, err := models.SharedAPIAccessTokenDAO.GenerateAccessToken(tx, int64(accessKey.AdminId), int64(accessKey.UserId))
+	token, expiresAt, err := models.SharedAPIAccessTokenDAO.GenerateAccessToken(tx, int64(accessKey.AdminId), int64(accessKey.UserId), tokenScopes)
 	if err != nil {
 		return nil, err
 	}
@@ -80,5 +99,84 @@ func (this *APIAccessTokenService) GetAPIAccessToken(ctx context.Context, req *p
 	return &pb.GetAPIAccessTokenResponse{
 		Token:     token,
 		ExpiresAt: expiresAt,
+		Scopes:    tokenScopes,
+	}, nil
+}
+
+// CreateUserImpersonationToken 获取模拟用户登录的AccessToken
+func (this *APIAccessTokenService) CreateUserImpersonationToken(ctx context.Context, req *pb.CreateUserImpersonationTokenRequest) (*pb.CreateUserImpersonationTokenResponse, error) {
+	adminId, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Reason = strings.TrimSpace(req.Reason)
+	if len(req.Reason) == 0 {
+		return nil, errors.New("'reason' should not be empty")
+	}
+	if len([]rune(req.Reason)) > 200 {
+		return nil, errors.New("'reason' should not be longer than 200 characters")
+	}
+
+	var life = time.Duration(req.LifeSeconds) * time.Second
+	if life <= 0 {
+		life = 15 * time.Minute
+	}
+	if life > models.MaxImpersonationTokenLife {
+		return nil, errors.New("'lifeSeconds' should not be greater than " + types.String(int64(models.MaxImpersonationTokenLife.Seconds())))
+	}
+
+	var tx = this.NullTx()
+
+	// 检查用户状态
+	user, err := models.SharedUserDAO.FindEnabledUser(tx, req.UserId, nil)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !user.IsOn {
+		return nil, errors.New("the user is not available")
+	}
+
+	// 权限范围
+	// 通过REST调用时不能超出当前令牌的权限范围
+	var callerScopes []string
+	plainCtx, ok := ctx.(*rpcutils.PlainContext)
+	if ok {
+		callerScopes = plainCtx.Scopes
+	}
+	var tokenScopes = callerScopes
+	if len(req.Scopes) > 0 {
+		tokenScopes, err = scopes.NormalizeScopes(req.Scopes)
+		if err != nil {
+			return nil, errors.New("validate scopes failed: " + err.Error())
+		}
+		if len(callerScopes) > 0 && !scopes.IsSubset(tokenScopes, callerScopes) {
+			return nil, errors.New("the requested scopes exceed the scopes of current access token")
+		}
+	}
+
+	token, expiresAt, err := models.SharedAPIAccessTokenDAO.GenerateImpersonationToken(tx, adminId, req.UserId, tokenScopes, req.Reason, life)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateUserImpersonationTokenResponse{
+		Token:     token,
+		ExpiresAt: expiresAt,
+		Scopes:    tokenScopes,
 	}, nil
 }
+
+// RevokeUserImpersonationTokens 撤销模拟用户登录的AccessToken
+func (this *APIAccessTokenService) RevokeUserImpersonationTokens(ctx context.Context, req *pb.RevokeUserImpersonationTokensRequest) (*pb.RPCSuccess, error) {
+	adminId, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = models.SharedAPIAccessTokenDAO.DeleteImpersonationTokens(this.NullTx(), adminId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
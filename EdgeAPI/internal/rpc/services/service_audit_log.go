@@ -0,0 +1,109 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// AuditLogService RPC操作审计日志服务
+type AuditLogService struct {
+	BaseService
+}
+
+// CountAuditLogs 计算审计日志数量
+func (this *AuditLogService) CountAuditLogs(ctx context.Context, req *pb.CountAuditLogsRequest) (*pb.RPCCountResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	count, err := models.SharedAuditLogDAO.CountAuditLogs(tx, req.AdminId, req.UserId, req.Method, req.Keyword, req.Result, req.DayFrom, req.DayTo)
+	if err != nil {
+		return nil, err
+	}
+	return this.SuccessCount(count)
+}
+
+// ListAuditLogs 列出单页审计日志
+func (this *AuditLogService) ListAuditLogs(ctx context.Context, req *pb.ListAuditLogsRequest) (*pb.ListAuditLogsResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	auditLogs, err := models.SharedAuditLogDAO.ListAuditLogs(tx, req.AdminId, req.UserId, req.Method, req.Keyword, req.Result, req.DayFrom, req.DayTo, req.Offset, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbAuditLogs = []*pb.AuditLog{}
+	for _, auditLog := range auditLogs {
+		pbAuditLog, err := this.composeAuditLog(tx, auditLog)
+		if err != nil {
+			return nil, err
+		}
+		pbAuditLogs = append(pbAuditLogs, pbAuditLog)
+	}
+	return &pb.ListAuditLogsResponse{AuditLogs: pbAuditLogs}, nil
+}
+
+// FindAuditLog 查找单条审计日志
+func (this *AuditLogService) FindAuditLog(ctx context.Context, req *pb.FindAuditLogRequest) (*pb.FindAuditLogResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	auditLog, err := models.SharedAuditLogDAO.FindAuditLog(tx, req.AuditLogId)
+	if err != nil {
+		return nil, err
+	}
+	if auditLog == nil {
+		return &pb.FindAuditLogResponse{AuditLog: nil}, nil
+	}
+
+	pbAuditLog, err := this.composeAuditLog(tx, auditLog)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FindAuditLogResponse{AuditLog: pbAuditLog}, nil
+}
+
+// 组合审计日志信息
+func (this *AuditLogService) composeAuditLog(tx *dbs.Tx, auditLog *models.AuditLog) (*pb.AuditLog, error) {
+	var userName string
+	var err error
+	if auditLog.AdminId > 0 {
+		userName, err = models.SharedAdminDAO.FindAdminFullname(tx, int64(auditLog.AdminId))
+	} else if auditLog.UserId > 0 {
+		userName, err = models.SharedUserDAO.FindUserFullname(tx, int64(auditLog.UserId))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AuditLog{
+		Id:        int64(auditLog.Id),
+		AdminId:   int64(auditLog.AdminId),
+		UserId:    int64(auditLog.UserId),
+		UserName:  userName,
+		Method:    auditLog.Method,
+		Request:   auditLog.Request,
+		IsOk:      auditLog.IsOk,
+		Error:     auditLog.Error,
+		CostMs:    int64(auditLog.CostMs),
+		Ip:        auditLog.Ip,
+		IsRest:    auditLog.IsRest,
+		CreatedAt: int64(auditLog.CreatedAt),
+
+		ImpersonatorAdminId: int64(auditLog.ImpersonatorAdminId),
+	}, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/backup"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+)
+
+// BackupService 数据库备份服务
+type BackupService struct {
+	BaseService
+}
+
+// FindBackupConfig 读取备份设置
+func (this *BackupService) FindBackupConfig(ctx context.Context, req *pb.FindBackupConfigRequest) (*pb.FindBackupConfigResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := models.SharedSysSettingDAO.ReadBackupConfig(this.NullTx())
+	if err != nil {
+		return nil, err
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FindBackupConfigResponse{BackupConfigJSON: configJSON}, nil
+}
+
+// UpdateBackupConfig 修改备份设置
+func (this *BackupService) UpdateBackupConfig(ctx context.Context, req *pb.UpdateBackupConfigRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = systemconfigs.NewBackupConfig()
+	err = json.Unmarshal(req.BackupConfigJSON, config)
+	if err != nil {
+		return nil, errors.New("decode backup config failed: " + err.Error())
+	}
+	err = config.Validate()
+	if err != nil {
+		return nil, errors.New("validate backup config failed: " + err.Error())
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	err = models.SharedSysSettingDAO.UpdateSetting(this.NullTx(), systemconfigs.SettingCodeBackupConfig, configJSON)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// CreateBackup 立即备份
+func (this *BackupService) CreateBackup(ctx context.Context, req *pb.CreateBackupRequest) (*pb.CreateBackupResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := models.SharedSysSettingDAO.ReadBackupConfig(this.NullTx())
+	if err != nil {
+		return nil, err
+	}
+	file, err := backup.SharedManager.Backup(config)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateBackupResponse{
+		Name:      file.Name,
+		Size:      file.Size,
+		CreatedAt: file.CreatedAt,
+	}, nil
+}
+
+// ListBackups 列出所有备份
+func (this *BackupService) ListBackups(ctx context.Context, req *pb.ListBackupsRequest) (*pb.ListBackupsResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := models.SharedSysSettingDAO.ReadBackupConfig(this.NullTx())
+	if err != nil {
+		return nil, err
+	}
+	files, err := backup.SharedManager.List(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbFiles = []*pb.ListBackupsResponse_BackupFile{}
+	for _, file := range files {
+		pbFiles = append(pbFiles, &pb.ListBackupsResponse_BackupFile{
+			Name:      file.Name,
+			Size:      file.Size,
+			CreatedAt: file.CreatedAt,
+		})
+	}
+	return &pb.ListBackupsResponse{BackupFiles: pbFiles}, nil
+}
+
+// RestoreBackup 从备份中恢复
+func (this *BackupService) RestoreBackup(ctx context.Context, req *pb.RestoreBackupRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Name) == 0 {
+		return nil, errors.New("'name' should not be empty")
+	}
+
+	config, err := models.SharedSysSettingDAO.ReadBackupConfig(this.NullTx())
+	if err != nil {
+		return nil, err
+	}
+	err = backup.SharedManager.Restore(config, req.Name, req.RestoreConfigs)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// DeleteBackup 删除备份
+func (this *BackupService) DeleteBackup(ctx context.Context, req *pb.DeleteBackupRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := models.SharedSysSettingDAO.ReadBackupConfig(this.NullTx())
+	if err != nil {
+		return nil, err
+	}
+	err = backup.SharedManager.Delete(config, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
@@ -8,6 +8,7 @@ import (
 	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models/authority"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/encrypt"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/rpc"
@@ -17,6 +18,7 @@ import (
 	"github.com/iwind/TeaGo/dbs"
 	"github.com/iwind/TeaGo/lists"
 	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -227,6 +229,30 @@ func (this *BaseService) NotImplementedYet() error {
 	return status.Error(codes.Unimplemented, "not implemented yet")
 }
 
+// VersionConflictError 数据已被其他人修改的错误
+func (this *BaseService) VersionConflictError() error {
+	return status.Error(codes.Aborted, "the data has been modified by others, please reload and try again")
+}
+
+// CheckExternalId 检查外部ID是否合法并且未被其他对象使用
+func (this *BaseService) CheckExternalId(externalId string, itemName string, findFunc func(externalId string) (int64, error)) error {
+	if len(externalId) == 0 {
+		return nil
+	}
+	err := dbutils.ValidateExternalId(externalId)
+	if err != nil {
+		return err
+	}
+	existId, err := findFunc(externalId)
+	if err != nil {
+		return err
+	}
+	if existId > 0 {
+		return status.Error(codes.AlreadyExists, "the externalId '"+externalId+"' is used by another "+itemName+" '"+types.String(existId)+"'")
+	}
+	return nil
+}
+
 // NullTx 空的数据库事务
 func (this *BaseService) NullTx() *dbs.Tx {
 	return nil
@@ -0,0 +1,125 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configbundle"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+// ConfigBundleService 配置导入导出服务
+type ConfigBundleService struct {
+	BaseService
+}
+
+// ExportConfigBundle 导出配置包
+func (this *ConfigBundleService) ExportConfigBundle(ctx context.Context, req *pb.ExportConfigBundleRequest) (*pb.ExportConfigBundleResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var format = req.Format
+	if len(format) == 0 {
+		format = configbundle.FormatJSON
+	}
+	if format != configbundle.FormatJSON && format != configbundle.FormatYAML {
+		return nil, errors.New("invalid format '" + format + "'")
+	}
+
+	var tx = this.NullTx()
+	var exporter = configbundle.NewExporter(tx, req.IncludeSecrets)
+	for _, certId := range req.SslCertIds {
+		err = exporter.AddSSLCert(certId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, providerId := range req.DnsProviderIds {
+		err = exporter.AddDNSProvider(providerId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, policyId := range req.HttpCachePolicyIds {
+		err = exporter.AddHTTPCachePolicy(policyId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, policyId := range req.HttpFirewallPolicyIds {
+		err = exporter.AddHTTPFirewallPolicy(policyId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, serverId := range req.ServerIds {
+		err = exporter.AddServer(serverId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bundleData, err := configbundle.Encode(exporter.Bundle(), format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportConfigBundleResponse{
+		BundleData: bundleData,
+		Format:     format,
+		Version:    configbundle.Version,
+	}, nil
+}
+
+// ImportConfigBundle 导入配置包
+func (this *ConfigBundleService) ImportConfigBundle(ctx context.Context, req *pb.ImportConfigBundleRequest) (*pb.ImportConfigBundleResponse, error) {
+	adminId, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := configbundle.Decode(req.BundleData)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.NodeClusterId > 0 {
+		exists, err := models.SharedNodeClusterDAO.ExistsEnabledCluster(this.NullTx(), req.NodeClusterId)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, errors.New("can not find cluster with id '" + types.String(req.NodeClusterId) + "'")
+		}
+	}
+
+	var importer *configbundle.Importer
+	err = this.RunTx(func(tx *dbs.Tx) error {
+		importer = configbundle.NewImporter(tx, adminId, req.UserId, req.NodeClusterId, req.SkipExistServerNames)
+		return importer.Import(bundle)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pbIdMappings = []*pb.ImportConfigBundleResponse_IdMapping{}
+	for _, mapping := range importer.IdMap().Mappings() {
+		pbIdMappings = append(pbIdMappings, &pb.ImportConfigBundleResponse_IdMapping{
+			ItemType: mapping.ItemType,
+			OldId:    mapping.OldId,
+			NewId:    mapping.NewId,
+		})
+	}
+
+	return &pb.ImportConfigBundleResponse{
+		IdMappings: pbIdMappings,
+		Warnings:   importer.Warnings(),
+	}, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/validators"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+)
+
+// 单个连接中最多保存的文档校验结果数量
+const maxValidationDocumentsPerStream = 128
+
+// ConfigValidationService 配置校验服务
+type ConfigValidationService struct {
+	BaseService
+}
+
+// ValidateConfig 校验配置
+func (this *ConfigValidationService) ValidateConfig(ctx context.Context, req *pb.ValidateConfigRequest) (*pb.ValidateConfigResponse, error) {
+	_, _, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics, err := validators.ValidateConfigJSON(req.Kind, req.ConfigJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ValidateConfigResponse{
+		IsValid:     !diagnostics.HasErrors(),
+		Diagnostics: this.convertDiagnostics(diagnostics),
+	}, nil
+}
+
+// ValidateConfigStream 实时校验配置
+func (this *ConfigValidationService) ValidateConfigStream(server pb.ConfigValidationService_ValidateConfigStreamServer) error {
+	_, _, err := this.ValidateAdminAndUser(server.Context(), true)
+	if err != nil {
+		return err
+	}
+
+	// 每个文档上一次的校验结果，只在当前连接中有效
+	var lastDiagnosticsMap = map[string]validators.Diagnostics{} // documentId => diagnostics
+
+	for {
+		req, err := server.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		var resp = &pb.ValidateConfigStreamResponse{
+			RequestId:  req.RequestId,
+			DocumentId: req.DocumentId,
+		}
+
+		diagnostics, err := validators.ValidateConfigJSON(req.Kind, req.ConfigJSON)
+		if err != nil {
+			// 不中断连接，只返回错误信息
+			resp.Error = err.Error()
+			err = server.Send(resp)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		lastDiagnostics, ok := lastDiagnosticsMap[req.DocumentId]
+		if !ok && len(lastDiagnosticsMap) >= maxValidationDocumentsPerStream {
+			lastDiagnosticsMap = map[string]validators.Diagnostics{}
+		}
+		lastDiagnosticsMap[req.DocumentId] = diagnostics
+
+		added, resolved := validators.DiffDiagnostics(lastDiagnostics, diagnostics)
+
+		resp.IsValid = !diagnostics.HasErrors()
+		resp.Diagnostics = this.convertDiagnostics(diagnostics)
+		resp.AddedDiagnostics = this.convertDiagnostics(added)
+		resp.ResolvedDiagnostics = this.convertDiagnostics(resolved)
+		err = server.Send(resp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (this *ConfigValidationService) convertDiagnostics(diagnostics validators.Diagnostics) []*pb.ConfigDiagnostic {
+	var pbDiagnostics = []*pb.ConfigDiagnostic{}
+	for _, diagnostic := range diagnostics {
+		pbDiagnostics = append(pbDiagnostics, &pb.ConfigDiagnostic{
+			Path:    diagnostic.Path,
+			Level:   diagnostic.Level,
+			Message: diagnostic.Message,
+		})
+	}
+	return pbDiagnostics
+}
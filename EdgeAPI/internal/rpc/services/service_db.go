@@ -4,10 +4,14 @@ import (
 	"context"
 	"strings"
 
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	dbutils "github.com/TeaOSLab/EdgeAPI/internal/db/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/setup"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/iwind/TeaGo/dbs"
 	"github.com/iwind/TeaGo/lists"
+	"github.com/iwind/TeaGo/maps"
 )
 
 // DBService 数据库相关服务
@@ -26,9 +30,25 @@ func (this *DBService) FindAllDBTables(ctx context.Context, req *pb.FindAllDBTab
 	if err != nil {
 		return nil, err
 	}
-	ones, _, err := db.FindPreparedOnes("SELECT * FROM information_schema.`TABLES` WHERE TABLE_SCHEMA=?", db.Name())
-	if err != nil {
-		return nil, err
+	var ones []maps.Map
+	if dbutils.IsSQLite(db) {
+		// SQLite中只能读取表名
+		tableNames, err := db.TableNames()
+		if err != nil {
+			return nil, err
+		}
+		for _, tableName := range tableNames {
+			ones = append(ones, maps.Map{
+				"TABLE_NAME": tableName,
+				"TABLE_TYPE": "BASE TABLE",
+				"ENGINE":     "SQLite",
+			})
+		}
+	} else {
+		ones, _, err = db.FindPreparedOnes("SELECT * FROM information_schema.`TABLES` WHERE TABLE_SCHEMA=?", db.Name())
+		if err != nil {
+			return nil, err
+		}
 	}
 	pbTables := []*pb.DBTable{}
 	for _, one := range ones {
@@ -103,3 +123,69 @@ func (this *DBService) TruncateDBTable(ctx context.Context, req *pb.TruncateDBTa
 	}
 	return this.Success()
 }
+
+// FindDBIndexAdvices 检查常用查询的索引
+func (this *DBService) FindDBIndexAdvices(ctx context.Context, req *pb.FindDBIndexAdvicesRequest) (*pb.FindDBIndexAdvicesResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := dbs.Default()
+	if err != nil {
+		return nil, err
+	}
+
+	var pbAdvices = []*pb.FindDBIndexAdvicesResponse_DBIndexAdvice{}
+	for _, advice := range models.AnalyzeDBIndexes(db) {
+		var pbAdvice = &pb.FindDBIndexAdvicesResponse_DBIndexAdvice{
+			Name:         advice.Name,
+			Description:  advice.Description,
+			DbTable:      advice.Table,
+			Sql:          advice.SQL,
+			Columns:      advice.Columns,
+			HasIndex:     advice.HasIndex,
+			SuggestedSQL: advice.SuggestedSQL,
+			Error:        advice.Error,
+		}
+		if advice.Explain != nil {
+			pbAdvice.IsFullScan = advice.Explain.IsFullScan
+			pbAdvice.UsesFilesort = advice.Explain.UsesFilesort
+			pbAdvice.Keys = advice.Explain.Keys
+			pbAdvice.Rows = advice.Explain.Rows
+			pbAdvice.Details = advice.Explain.Details
+		}
+		pbAdvices = append(pbAdvices, pbAdvice)
+	}
+	return &pb.FindDBIndexAdvicesResponse{DbIndexAdvices: pbAdvices}, nil
+}
+
+// CheckDBSchema 检查数据库表结构和当前版本是否一致
+func (this *DBService) CheckDBSchema(ctx context.Context, req *pb.CheckDBSchemaRequest) (*pb.CheckDBSchemaResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := dbs.Default()
+	if err != nil {
+		return nil, err
+	}
+
+	drifts, err := setup.CheckSQLSchema(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbDrifts = []*pb.CheckDBSchemaResponse_DBSchemaDrift{}
+	for _, drift := range drifts {
+		pbDrifts = append(pbDrifts, &pb.CheckDBSchemaResponse_DBSchemaDrift{
+			Type:     drift.Type,
+			DbTable:  drift.Table,
+			Name:     drift.Name,
+			Expected: drift.Expected,
+			Actual:   drift.Actual,
+		})
+	}
+	return &pb.CheckDBSchemaResponse{DbSchemaDrifts: pbDrifts}, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/depgraph"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+)
+
+// DependencyGraphService 对象依赖关系服务
+type DependencyGraphService struct {
+	BaseService
+}
+
+// FindDependencyGraph 查找某个对象周围的依赖关系图
+func (this *DependencyGraphService) FindDependencyGraph(ctx context.Context, req *pb.FindDependencyGraphRequest) (*pb.FindDependencyGraphResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	graph, err := depgraph.NewBuilder(tx, int(req.Depth), int(req.MaxNodes)).Build(req.ObjectType, req.ObjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbNodes = []*pb.FindDependencyGraphResponse_Node{}
+	for _, node := range graph.Nodes {
+		pbNodes = append(pbNodes, &pb.FindDependencyGraphResponse_Node{
+			Key:       node.Key,
+			Type:      node.Type,
+			Id:        node.Id,
+			Name:      node.Name,
+			Depth:     int32(node.Depth),
+			IsMissing: node.IsMissing,
+		})
+	}
+
+	var pbEdges = []*pb.FindDependencyGraphResponse_Edge{}
+	for _, edge := range graph.Edges {
+		pbEdges = append(pbEdges, &pb.FindDependencyGraphResponse_Edge{
+			From:     edge.From,
+			To:       edge.To,
+			Relation: edge.Relation,
+		})
+	}
+
+	return &pb.FindDependencyGraphResponse{
+		Nodes:       pbNodes,
+		Edges:       pbEdges,
+		IsTruncated: graph.IsTruncated,
+	}, nil
+}
@@ -12,14 +12,17 @@ import (
 	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients/dnstypes"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/quotas"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils/numberutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/dnsconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/iputils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
 	"github.com/iwind/TeaGo/dbs"
 	"github.com/iwind/TeaGo/lists"
 	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
 )
 
 // DNSDomainService DNS域名相关服务
@@ -790,6 +793,20 @@ func (this *DNSDomainService) syncClusterDNS(req *pb.SyncDNSDomainDataRequest) (
 		if err != nil {
 			return nil, err
 		}
+
+		// 冲突处理策略
+		var conflictPolicy = dnsconfigs.RecordConflictPolicyError
+		dnsConfig, err := cluster.DecodeDNSConfig()
+		if err != nil {
+			return nil, err
+		}
+		if len(dnsConfig.ConflictPolicy) > 0 {
+			conflictPolicy = dnsConfig.ConflictPolicy
+		}
+		for _, change := range changes {
+			change["conflictPolicy"] = conflictPolicy
+		}
+
 		allChanges = append(allChanges, changes...)
 	}
 	for _, change := range allChanges {
@@ -802,10 +819,13 @@ func (this *DNSDomainService) syncClusterDNS(req *pb.SyncDNSDomainDataRequest) (
 
 		switch action {
 		case "create":
-			err = manager.AddRecord(domainName, record)
+			isAdded, err := dnsclients.AddRecordWithPolicy(manager, domainName, records, record, change.GetString("conflictPolicy"))
 			if err != nil {
 				return &pb.SyncDNSDomainDataResponse{IsOk: false, Error: "创建域名记录失败：" + err.Error()}, nil
 			}
+			if isAdded {
+				records = append(records, record)
+			}
 		case "delete":
 			err = manager.DeleteRecord(domainName, record)
 			if err != nil {
@@ -931,3 +951,232 @@ func (this *DNSDomainService) SyncDNSDomainsFromProvider(ctx context.Context, re
 		HasChanges: hasChanges,
 	}, nil
 }
+
+// BatchCreateDNSRecords 批量创建域名解析记录
+func (this *DNSDomainService) BatchCreateDNSRecords(ctx context.Context, req *pb.BatchCreateDNSRecordsRequest) (*pb.BatchCreateDNSRecordsResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.DnsRecords) == 0 {
+		return nil, errors.New("'dnsRecords' should not be empty")
+	}
+	if len(req.DnsRecords) > dnsclients.MaxBatchRecords {
+		return nil, errors.New("too many records, max: " + types.String(dnsclients.MaxBatchRecords))
+	}
+
+	var tx = this.NullTx()
+	domain, manager, err := this.findDomainProvider(tx, req.DnsDomainId)
+	if err != nil {
+		return nil, err
+	}
+
+	var results = make([]*pb.DNSRecordResult, len(req.DnsRecords))
+	var records = []*dnstypes.Record{}
+	var recordIndexes = []int{}
+	for index, pbRecord := range req.DnsRecords {
+		results[index] = &pb.DNSRecordResult{Index: int32(index)}
+
+		var record = &dnstypes.Record{
+			Name:  pbRecord.Name,
+			Type:  pbRecord.Type,
+			Value: pbRecord.Value,
+			Route: pbRecord.Route,
+			TTL:   pbRecord.Ttl,
+		}
+		err = this.validateDNSRecord(record)
+		if err != nil {
+			results[index].Error = err.Error()
+			continue
+		}
+		if len(record.Route) == 0 {
+			record.Route = manager.DefaultRoute()
+		}
+		records = append(records, record)
+		recordIndexes = append(recordIndexes, index)
+	}
+
+	// 用户配额，记录直接添加到DNS服务商，不经过DAO，所以在这里检查
+	err = quotas.Check(tx, int64(domain.UserId), userconfigs.UserQuotaItemDNSRecords, int64(len(records)))
+	if err != nil {
+		return nil, err
+	}
+
+	var errs = dnsclients.AddRecords(manager, domain.Name, records)
+	this.fillDNSRecordResults(results, recordIndexes, errs)
+
+	err = this.refreshDomainRecords(tx, domain, manager, len(records) > 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BatchCreateDNSRecordsResponse{DnsRecordResults: results}, nil
+}
+
+// BatchDeleteDNSRecords 批量删除域名解析记录
+func (this *DNSDomainService) BatchDeleteDNSRecords(ctx context.Context, req *pb.BatchDeleteDNSRecordsRequest) (*pb.BatchDeleteDNSRecordsResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.DnsRecords) == 0 {
+		return nil, errors.New("'dnsRecords' should not be empty")
+	}
+	if len(req.DnsRecords) > dnsclients.MaxBatchRecords {
+		return nil, errors.New("too many records, max: " + types.String(dnsclients.MaxBatchRecords))
+	}
+
+	var tx = this.NullTx()
+	domain, manager, err := this.findDomainProvider(tx, req.DnsDomainId)
+	if err != nil {
+		return nil, err
+	}
+
+	var results = make([]*pb.DNSRecordResult, len(req.DnsRecords))
+	var records = []*dnstypes.Record{}
+	var recordIndexes = []int{}
+	for index, pbRecord := range req.DnsRecords {
+		results[index] = &pb.DNSRecordResult{Index: int32(index)}
+
+		var record = &dnstypes.Record{
+			Id:    pbRecord.Id,
+			Name:  pbRecord.Name,
+			Type:  pbRecord.Type,
+			Value: pbRecord.Value,
+			Route: pbRecord.Route,
+		}
+
+		// 没有ID时从服务商查找
+		if len(record.Id) == 0 {
+			err = this.validateDNSRecord(record)
+			if err != nil {
+				results[index].Error = err.Error()
+				continue
+			}
+			if len(record.Route) == 0 {
+				record.Route = manager.DefaultRoute()
+			}
+			providerRecords, err := manager.QueryRecords(domain.Name, record.Name, record.Type)
+			if err != nil {
+				results[index].Error = "query record failed: " + err.Error()
+				continue
+			}
+			var foundRecord *dnstypes.Record
+			for _, providerRecord := range providerRecords {
+				if providerRecord.Value == record.Value && providerRecord.Route == record.Route {
+					foundRecord = providerRecord
+					break
+				}
+			}
+			if foundRecord == nil {
+				results[index].Error = "can not find record"
+				continue
+			}
+			record = foundRecord
+		}
+
+		records = append(records, record)
+		recordIndexes = append(recordIndexes, index)
+	}
+
+	var errs = dnsclients.DeleteRecords(manager, domain.Name, records)
+	this.fillDNSRecordResults(results, recordIndexes, errs)
+
+	err = this.refreshDomainRecords(tx, domain, manager, len(records) > 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BatchDeleteDNSRecordsResponse{DnsRecordResults: results}, nil
+}
+
+// 查找域名及其服务商
+func (this *DNSDomainService) findDomainProvider(tx *dbs.Tx, domainId int64) (*dns.DNSDomain, dnsclients.ProviderInterface, error) {
+	domain, err := dns.SharedDNSDomainDAO.FindEnabledDNSDomain(tx, domainId, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if domain == nil {
+		return nil, nil, errors.New("can not find domain '" + types.String(domainId) + "'")
+	}
+
+	provider, err := dns.SharedDNSProviderDAO.FindEnabledDNSProvider(tx, int64(domain.ProviderId))
+	if err != nil {
+		return nil, nil, err
+	}
+	if provider == nil {
+		return nil, nil, errors.New("can not find provider for domain '" + domain.Name + "'")
+	}
+
+	var manager = dnsclients.FindProvider(provider.Type, int64(provider.Id))
+	if manager == nil {
+		return nil, nil, errors.New("provider type '" + provider.Type + "' is not supported yet")
+	}
+	params, err := provider.DecodeAPIParams()
+	if err != nil {
+		return nil, nil, errors.New("decode params failed: " + err.Error())
+	}
+	err = manager.Auth(params)
+	if err != nil {
+		return nil, nil, errors.New("auth failed: " + err.Error())
+	}
+	return domain, manager, nil
+}
+
+// 校验要操作的解析记录
+func (this *DNSDomainService) validateDNSRecord(record *dnstypes.Record) error {
+	if len(record.Name) == 0 {
+		return errors.New("'name' should not be empty")
+	}
+	if len(record.Value) == 0 {
+		return errors.New("'value' should not be empty")
+	}
+	if record.TTL < 0 {
+		return errors.New("'ttl' should not be negative")
+	}
+
+	switch record.Type {
+	case dnstypes.RecordTypeA:
+		if !iputils.IsIPv4(record.Value) {
+			return errors.New("invalid IPv4 value '" + record.Value + "'")
+		}
+	case dnstypes.RecordTypeAAAA:
+		if !iputils.IsIPv6(record.Value) {
+			return errors.New("invalid IPv6 value '" + record.Value + "'")
+		}
+	case dnstypes.RecordTypeCNAME, dnstypes.RecordTypeTXT:
+	default:
+		return errors.New("unsupported record type '" + record.Type + "'")
+	}
+	return nil
+}
+
+// 将执行结果填充到对应的位置
+func (this *DNSDomainService) fillDNSRecordResults(results []*pb.DNSRecordResult, recordIndexes []int, errs []error) {
+	for i, index := range recordIndexes {
+		if errs[i] != nil {
+			results[index].Error = errs[i].Error()
+		} else {
+			results[index].IsOk = true
+		}
+	}
+}
+
+// 重新从服务商读取并保存域名的解析记录
+func (this *DNSDomainService) refreshDomainRecords(tx *dbs.Tx, domain *dns.DNSDomain, manager dnsclients.ProviderInterface, hasChanges bool) error {
+	if !hasChanges {
+		return nil
+	}
+	records, err := manager.GetRecords(domain.Name)
+	if err != nil {
+		// 记录已经修改成功，这里失败的话等待下次同步即可
+		return nil
+	}
+	recordsJSON, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	return dns.SharedDNSDomainDAO.UpdateDomainRecords(tx, int64(domain.Id), recordsJSON)
+}
@@ -6,8 +6,11 @@ import (
 
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models/dns"
 	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
+	timeutil "github.com/iwind/TeaGo/utils/time"
 )
 
 // DNSProviderService DNS服务商相关服务
@@ -25,11 +28,26 @@ func (this *DNSProviderService) CreateDNSProvider(ctx context.Context, req *pb.C
 
 	var tx = this.NullTx()
 
+	// 外部ID
+	err = this.CheckExternalId(req.ExternalId, "dns provider", func(externalId string) (int64, error) {
+		return dns.SharedDNSProviderDAO.FindEnabledDNSProviderIdWithExternalId(tx, 0, externalId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	providerId, err := dns.SharedDNSProviderDAO.CreateDNSProvider(tx, adminId, userId, req.Type, req.Name, req.ApiParamsJSON, req.MinTTL)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(req.ExternalId) > 0 {
+		err = dns.SharedDNSProviderDAO.UpdateDNSProviderExternalId(tx, providerId, req.ExternalId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &pb.CreateDNSProviderResponse{DnsProviderId: providerId}, nil
 }
 
@@ -116,6 +134,7 @@ func (this *DNSProviderService) ListEnabledDNSProviders(ctx context.Context, req
 			ApiParamsJSON: provider.ApiParams,
 			DataUpdatedAt: int64(provider.DataUpdatedAt),
 			MinTTL:        int32(provider.MinTTL),
+			ExternalId:    provider.ExternalId,
 		})
 	}
 	return &pb.ListEnabledDNSProvidersResponse{DnsProviders: result}, nil
@@ -222,6 +241,7 @@ func (this *DNSProviderService) FindEnabledDNSProvider(ctx context.Context, req
 			ApiParamsJSON: provider.ApiParams,
 			DataUpdatedAt: int64(provider.DataUpdatedAt),
 			MinTTL:        int32(provider.MinTTL),
+			ExternalId:    provider.ExternalId,
 		},
 	}, nil
 }
@@ -271,3 +291,163 @@ func (this *DNSProviderService) FindAllEnabledDNSProvidersWithType(ctx context.C
 	}
 	return &pb.FindAllEnabledDNSProvidersWithTypeResponse{DnsProviders: result}, nil
 }
+
+// FindDNSProviderCallStats 查找服务商API调用统计和配额
+func (this *DNSProviderService) FindDNSProviderCallStats(ctx context.Context, req *pb.FindDNSProviderCallStatsRequest) (*pb.FindDNSProviderCallStatsResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	provider, err := dns.SharedDNSProviderDAO.FindEnabledDNSProvider(tx, req.DnsProviderId)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return nil, errors.New("can not find provider with id '" + types.String(req.DnsProviderId) + "'")
+	}
+
+	stats, err := dns.SharedDNSProviderCallStatDAO.FindStatsWithProviderId(tx, req.DnsProviderId, int(req.Days))
+	if err != nil {
+		return nil, err
+	}
+
+	var today = timeutil.Format("Ymd")
+	var countTodayCalls int64
+	var pbStats = []*pb.DNSProviderCallStat{}
+	for _, stat := range stats {
+		if stat.Day == today {
+			countTodayCalls = int64(stat.CountCalls)
+		}
+		pbStats = append(pbStats, &pb.DNSProviderCallStat{
+			Id:               int64(stat.Id),
+			DnsProviderId:    int64(stat.ProviderId),
+			Day:              stat.Day,
+			CountCalls:       int64(stat.CountCalls),
+			CountFailedCalls: int64(stat.CountFailedCalls),
+			CostMs:           float32(stat.CostMs),
+			PeekMs:           float32(stat.PeekMs),
+		})
+	}
+
+	var quota = dnsclients.FindProviderQuota(provider.Type)
+	return &pb.FindDNSProviderCallStatsResponse{
+		DnsProviderCallStats: pbStats,
+		MaxCallsPerDay:       quota.MaxCallsPerDay,
+		MaxCallsPerSecond:    int32(quota.MaxCallsPerSecond),
+		CountTodayCalls:      countTodayCalls,
+		IsApproachingQuota:   quota.IsApproaching(countTodayCalls),
+	}, nil
+}
+
+// FindAllDNSProviderQuotaWarnings 查找接近配额上限的服务商
+func (this *DNSProviderService) FindAllDNSProviderQuotaWarnings(ctx context.Context, req *pb.FindAllDNSProviderQuotaWarningsRequest) (*pb.FindAllDNSProviderQuotaWarningsResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	stats, err := dns.SharedDNSProviderCallStatDAO.FindAllStatsWithDay(tx, timeutil.Format("Ymd"))
+	if err != nil {
+		return nil, err
+	}
+
+	var pbWarnings = []*pb.FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning{}
+	for _, stat := range stats {
+		provider, err := dns.SharedDNSProviderDAO.FindEnabledDNSProvider(tx, int64(stat.ProviderId))
+		if err != nil {
+			return nil, err
+		}
+		if provider == nil {
+			continue
+		}
+
+		var quota = dnsclients.FindProviderQuota(provider.Type)
+		var countCalls = int64(stat.CountCalls)
+		if !quota.IsApproaching(countCalls) {
+			continue
+		}
+
+		pbWarnings = append(pbWarnings, &pb.FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning{
+			DnsProvider: &pb.DNSProvider{
+				Id:       int64(provider.Id),
+				Name:     provider.Name,
+				Type:     provider.Type,
+				TypeName: dnsclients.FindProviderTypeName(provider.Type),
+			},
+			CountCalls:     countCalls,
+			MaxCallsPerDay: quota.MaxCallsPerDay,
+			Percent:        quota.Percent(countCalls),
+		})
+	}
+
+	return &pb.FindAllDNSProviderQuotaWarningsResponse{
+		DnsProviderQuotaWarnings: pbWarnings,
+	}, nil
+}
+
+// LookupDNSProvider 根据ID、外部ID或者名称查找服务商
+func (this *DNSProviderService) LookupDNSProvider(ctx context.Context, req *pb.LookupDNSProviderRequest) (*pb.LookupDNSProviderResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	var providerId = req.DnsProviderId
+	if providerId <= 0 {
+		if len(req.ExternalId) > 0 {
+			providerId, err = dns.SharedDNSProviderDAO.FindEnabledDNSProviderIdWithExternalId(tx, 0, req.ExternalId)
+			if err != nil {
+				return nil, err
+			}
+		} else if len(req.Name) > 0 {
+			providerIds, err := dns.SharedDNSProviderDAO.FindEnabledDNSProviderIdsWithName(tx, 0, req.Name, 2)
+			if err != nil {
+				return nil, err
+			}
+			if len(providerIds) > 1 {
+				return nil, errors.New("found multiple dns providers with name '" + req.Name + "', please lookup with 'dnsProviderId' or 'externalId' instead")
+			}
+			if len(providerIds) == 1 {
+				providerId = providerIds[0]
+			}
+		} else {
+			return nil, errors.New("'dnsProviderId', 'externalId' or 'name' is required")
+		}
+	}
+	if providerId <= 0 {
+		return &pb.LookupDNSProviderResponse{}, nil
+	}
+
+	provider, err := dns.SharedDNSProviderDAO.FindEnabledDNSProvider(tx, providerId)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return &pb.LookupDNSProviderResponse{}, nil
+	}
+
+	return &pb.LookupDNSProviderResponse{
+		DnsProviderId: int64(provider.Id),
+		Name:          provider.Name,
+		Type:          provider.Type,
+		ExternalId:    provider.ExternalId,
+	}, nil
+}
+
+// UpdateDNSProviderExternalId 设置服务商外部ID
+func (this *DNSProviderService) UpdateDNSProviderExternalId(ctx context.Context, req *pb.UpdateDNSProviderExternalIdRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dns.SharedDNSProviderDAO.UpdateDNSProviderExternalId(this.NullTx(), req.DnsProviderId, req.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
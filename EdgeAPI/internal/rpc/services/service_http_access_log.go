@@ -2,8 +2,10 @@ package services
 
 import (
 	"context"
+	"strings"
 	"sync"
 
+	"github.com/TeaOSLab/EdgeAPI/internal/accesslogs"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	rpcutils "github.com/TeaOSLab/EdgeAPI/internal/rpc/utils"
@@ -44,6 +46,9 @@ func (this *HTTPAccessLogService) CreateHTTPAccessLogs(ctx context.Context, req
 		return nil, err
 	}
 
+	// 实时日志
+	accesslogs.SharedTailHub.Publish(req.HttpAccessLogs)
+
 	return &pb.CreateHTTPAccessLogsResponse{}, nil
 }
 
@@ -70,7 +75,7 @@ func (this *HTTPAccessLogService) ListHTTPAccessLogs(ctx context.Context, req *p
 		}
 	}
 
-	accessLogs, requestId, hasMore, err := models.SharedHTTPAccessLogDAO.ListAccessLogs(tx, req.Partition, req.RequestId, req.Size, req.Day, req.HourFrom, req.HourTo, req.NodeClusterId, req.NodeId, req.ServerId, req.Reverse, req.HasError, req.FirewallPolicyId, req.FirewallRuleGroupId, req.FirewallRuleSetId, req.HasFirewallPolicy, req.UserId, req.Keyword, req.Ip, req.Domain)
+	accessLogs, requestId, hasMore, err := models.SharedHTTPAccessLogDAO.ListAccessLogs(tx, req.Partition, req.RequestId, req.Size, req.Day, req.HourFrom, req.HourTo, req.NodeClusterId, req.NodeId, req.ServerId, req.Reverse, req.HasError, req.FirewallPolicyId, req.FirewallRuleGroupId, req.FirewallRuleSetId, req.HasFirewallPolicy, req.UserId, req.Keyword, req.Ip, req.Domain, req.RequestURIKeyword, req.UserAgentKeyword, req.RemoteAddrKeyword)
 	if err != nil {
 		return nil, err
 	}
@@ -131,6 +136,47 @@ func (this *HTTPAccessLogService) ListHTTPAccessLogs(ctx context.Context, req *p
 	}, nil
 }
 
+// TailHTTPAccessLogs 实时查看新的访问日志
+func (this *HTTPAccessLogService) TailHTTPAccessLogs(req *pb.TailHTTPAccessLogsRequest, server pb.HTTPAccessLogService_TailHTTPAccessLogsServer) error {
+	_, userId, err := this.ValidateAdminAndUser(server.Context(), true)
+	if err != nil {
+		return err
+	}
+
+	// 平台用户只能查看自己网站的日志
+	if userId > 0 {
+		if req.ServerId <= 0 {
+			return errors.New("'serverId' should not be empty")
+		}
+		err = models.SharedServerDAO.CheckUserServer(this.NullTx(), userId, req.ServerId)
+		if err != nil {
+			return err
+		}
+	}
+
+	events, cancel := accesslogs.SharedTailHub.Subscribe(&accesslogs.TailFilter{
+		ServerId: req.ServerId,
+		Statuses: req.Statuses,
+		IP:       strings.TrimSpace(req.Ip),
+	})
+	defer cancel()
+
+	for {
+		select {
+		case <-server.Context().Done():
+			return nil
+		case event := <-events:
+			err = server.Send(&pb.TailHTTPAccessLogsResponse{
+				HttpAccessLogs: event.AccessLogs,
+				DroppedCount:   event.DroppedCount,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // FindHTTPAccessLog 查找单个日志
 func (this *HTTPAccessLogService) FindHTTPAccessLog(ctx context.Context, req *pb.FindHTTPAccessLogRequest) (*pb.FindHTTPAccessLogResponse, error) {
 	// 校验请求
@@ -8,6 +8,7 @@ import (
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/shared"
+	"github.com/iwind/TeaGo/dbs"
 )
 
 type HTTPCachePolicyService struct {
@@ -83,8 +84,6 @@ func (this *HTTPCachePolicyService) UpdateHTTPCachePolicy(ctx context.Context, r
 		return nil, err
 	}
 
-	var tx = this.NullTx()
-
 	if req.CapacityJSON != nil {
 		req.CapacityJSON, err = utils.JSONDecodeConfig(req.CapacityJSON, &shared.SizeCapacity{})
 		if err != nil {
@@ -104,7 +103,18 @@ func (this *HTTPCachePolicyService) UpdateHTTPCachePolicy(ctx context.Context, r
 		}
 	}
 
-	err = models.SharedHTTPCachePolicyDAO.UpdateCachePolicy(tx, req.HttpCachePolicyId, req.IsOn, req.Name, req.Description, req.CapacityJSON, req.MaxSizeJSON, req.Type, req.OptionsJSON, req.SyncCompressionCache, req.FetchTimeoutJSON)
+	// 在同一个事务中检查版本号和修改
+	err = this.RunTx(func(tx *dbs.Tx) error {
+		ok, err := models.SharedHTTPCachePolicyDAO.IncreasePolicyVersion(tx, req.HttpCachePolicyId, req.Version)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return this.VersionConflictError()
+		}
+
+		return models.SharedHTTPCachePolicyDAO.UpdateCachePolicy(tx, req.HttpCachePolicyId, req.IsOn, req.Name, req.Description, req.CapacityJSON, req.MaxSizeJSON, req.Type, req.OptionsJSON, req.SyncCompressionCache, req.FetchTimeoutJSON)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +217,7 @@ func (this *HTTPCachePolicyService) FindEnabledHTTPCachePolicy(ctx context.Conte
 		Name:         policy.Name,
 		IsOn:         policy.IsOn,
 		MaxBytesJSON: policy.MaxSize,
+		Version:      int64(policy.Version),
 	}}, nil
 }
 
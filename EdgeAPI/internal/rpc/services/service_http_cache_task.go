@@ -442,3 +442,48 @@ func (this *HTTPCacheTaskService) ResetHTTPCacheTask(ctx context.Context, req *p
 
 	return this.Success()
 }
+
+// FindHTTPCacheTaskProgress 查询任务执行进度
+func (this *HTTPCacheTaskService) FindHTTPCacheTaskProgress(ctx context.Context, req *pb.FindHTTPCacheTaskProgressRequest) (*pb.FindHTTPCacheTaskProgressResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	if userId > 0 {
+		err = models.SharedHTTPCacheTaskDAO.CheckUserTask(tx, userId, req.HttpCacheTaskId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	task, err := models.SharedHTTPCacheTaskDAO.FindEnabledHTTPCacheTask(tx, req.HttpCacheTaskId)
+	if err != nil {
+		return nil, err
+	}
+	if task == nil {
+		return nil, errors.New("can not find task with id '" + types.String(req.HttpCacheTaskId) + "'")
+	}
+
+	countKeys, err := models.SharedHTTPCacheTaskKeyDAO.CountTaskKeys(tx, req.HttpCacheTaskId)
+	if err != nil {
+		return nil, err
+	}
+	countDoneKeys, err := models.SharedHTTPCacheTaskKeyDAO.CountDoneTaskKeys(tx, req.HttpCacheTaskId)
+	if err != nil {
+		return nil, err
+	}
+	countFailedKeys, err := models.SharedHTTPCacheTaskKeyDAO.CountFailedTaskKeys(tx, req.HttpCacheTaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FindHTTPCacheTaskProgressResponse{
+		CountKeys:       countKeys,
+		CountDoneKeys:   countDoneKeys,
+		CountFailedKeys: countFailedKeys,
+		IsDone:          task.IsDone,
+		IsOk:            task.IsOk,
+	}, nil
+}
@@ -119,6 +119,8 @@ func (this *HTTPCacheTaskKeyService) FindDoingHTTPCacheTaskKeys(ctx context.Cont
 
 	if req.Size <= 0 {
 		req.Size = 100
+	} else if req.Size > 1000 {
+		req.Size = 1000
 	}
 
 	var tx *dbs.Tx
@@ -200,3 +202,47 @@ func (this *HTTPCacheTaskKeyService) CountHTTPCacheTaskKeysWithDay(ctx context.C
 	}
 	return this.SuccessCount(countKeys)
 }
+
+// ListHTTPCacheTaskKeys 列出任务下的单页Key及执行结果
+func (this *HTTPCacheTaskKeyService) ListHTTPCacheTaskKeys(ctx context.Context, req *pb.ListHTTPCacheTaskKeysRequest) (*pb.ListHTTPCacheTaskKeysResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	if userId > 0 {
+		err = models.SharedHTTPCacheTaskDAO.CheckUserTask(tx, userId, req.HttpCacheTaskId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Size <= 0 {
+		req.Size = 100
+	} else if req.Size > 1000 {
+		req.Size = 1000
+	}
+
+	keys, err := models.SharedHTTPCacheTaskKeyDAO.ListTaskKeys(tx, req.HttpCacheTaskId, req.OnlyFailed, req.Offset, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbKeys = []*pb.HTTPCacheTaskKey{}
+	for _, key := range keys {
+		pbKeys = append(pbKeys, &pb.HTTPCacheTaskKey{
+			Id:            int64(key.Id),
+			TaskId:        int64(key.TaskId),
+			Key:           key.Key,
+			Type:          key.Type,
+			KeyType:       key.KeyType,
+			IsDone:        key.IsDone,
+			IsDoing:       !key.IsDone && len(key.DecodeNodes()) > 0,
+			ErrorsJSON:    key.Errors,
+			NodeClusterId: int64(key.ClusterId),
+		})
+	}
+
+	return &pb.ListHTTPCacheTaskKeysResponse{HttpCacheTaskKeys: pbKeys}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/validators"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/firewallconfigs"
 )
@@ -28,6 +29,12 @@ func (this *HTTPFirewallRuleSetService) CreateOrUpdateHTTPFirewallRuleSetFromCon
 		return nil, err
 	}
 
+	// 校验规则集
+	err = validators.ValidateHTTPFirewallRuleSet(setConfig).AsError()
+	if err != nil {
+		return nil, err
+	}
+
 	if userId > 0 && setConfig.Id > 0 {
 		err = models.SharedHTTPFirewallRuleSetDAO.CheckUserRuleSet(nil, userId, setConfig.Id)
 		if err != nil {
@@ -2,9 +2,14 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/validators"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/shared"
 	"github.com/iwind/TeaGo/types"
 )
 
@@ -21,6 +26,12 @@ func (this *HTTPRewriteRuleService) CreateHTTPRewriteRule(ctx context.Context, r
 		return nil, err
 	}
 
+	// 校验规则
+	err = this.validateRewriteRule(req.Pattern, req.Replace, req.Mode, req.RedirectStatus, req.ProxyHost, req.CondsJSON)
+	if err != nil {
+		return nil, err
+	}
+
 	var tx = this.NullTx()
 
 	rewriteRuleId, err := models.SharedHTTPRewriteRuleDAO.CreateRewriteRule(tx, userId, req.Pattern, req.Replace, req.Mode, types.Int(req.RedirectStatus), req.IsBreak, req.ProxyHost, req.WithQuery, req.IsOn, req.CondsJSON)
@@ -39,6 +50,12 @@ func (this *HTTPRewriteRuleService) UpdateHTTPRewriteRule(ctx context.Context, r
 		return nil, err
 	}
 
+	// 校验规则
+	err = this.validateRewriteRule(req.Pattern, req.Replace, req.Mode, req.RedirectStatus, req.ProxyHost, req.CondsJSON)
+	if err != nil {
+		return nil, err
+	}
+
 	var tx = this.NullTx()
 	if userId > 0 {
 		err = models.SharedHTTPRewriteRuleDAO.CheckUserRewriteRule(tx, userId, req.RewriteRuleId)
@@ -54,3 +71,23 @@ func (this *HTTPRewriteRuleService) UpdateHTTPRewriteRule(ctx context.Context, r
 
 	return this.Success()
 }
+
+// 使用和实时校验相同的校验器检查重写规则
+func (this *HTTPRewriteRuleService) validateRewriteRule(pattern string, replace string, mode string, redirectStatus int32, proxyHost string, condsJSON []byte) error {
+	var rule = &serverconfigs.HTTPRewriteRule{
+		Pattern:        pattern,
+		Replace:        replace,
+		Mode:           mode,
+		RedirectStatus: types.Int(redirectStatus),
+		ProxyHost:      proxyHost,
+	}
+	if len(condsJSON) > 0 {
+		var conds = &shared.HTTPRequestCondsConfig{}
+		err := json.Unmarshal(condsJSON, conds)
+		if err != nil {
+			return errors.New("decode conds failed: " + err.Error())
+		}
+		rule.Conds = conds
+	}
+	return validators.ValidateHTTPRewriteRule(rule).AsError()
+}
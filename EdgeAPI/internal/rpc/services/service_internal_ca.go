@@ -0,0 +1,145 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/mtls"
+	rpcutils "github.com/TeaOSLab/EdgeAPI/internal/rpc/utils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"google.golang.org/grpc/metadata"
+)
+
+// InternalCAService 内部CA服务
+type InternalCAService struct {
+	BaseService
+}
+
+// FindInternalCA 查找当前内部CA信息
+func (this *InternalCAService) FindInternalCA(ctx context.Context, req *pb.FindInternalCARequest) (*pb.FindInternalCAResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	ca, err := mtls.EnsureCA(tx)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := ca.Cert()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := models.SharedSysSettingDAO.ReadMTLSConfig(tx)
+	if err != nil {
+		return nil, err
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FindInternalCAResponse{
+		CaCertData:       ca.CertData,
+		CreatedAt:        ca.CreatedAt,
+		ExpiresAt:        cert.NotAfter.Unix(),
+		CountPreviousCAs: int32(len(ca.PreviousCerts)),
+		MtlsConfigJSON:   configJSON,
+	}, nil
+}
+
+// RotateInternalCA 轮换内部CA
+func (this *InternalCAService) RotateInternalCA(ctx context.Context, req *pb.RotateInternalCARequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	_, err = mtls.RotateCA(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 当前API节点立即生效，其他API节点会定时加载
+	err = mtls.SharedVerifier.Reload(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return this.Success()
+}
+
+// RevokePreviousInternalCAs 删除轮换之前的CA
+func (this *InternalCAService) RevokePreviousInternalCAs(ctx context.Context, req *pb.RevokePreviousInternalCAsRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	err = mtls.RevokePreviousCAs(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = mtls.SharedVerifier.Reload(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return this.Success()
+}
+
+// IssueNodeClientCert 为当前节点签发客户端证书
+// 此方法不要求客户端证书，以便节点申请第一个证书
+func (this *InternalCAService) IssueNodeClientCert(ctx context.Context, req *pb.IssueNodeClientCertRequest) (*pb.IssueNodeClientCertResponse, error) {
+	role, _, err := this.ValidateNodeId(ctx, rpcutils.UserTypeNode, rpcutils.UserTypeAdmin, rpcutils.UserTypeUser)
+	if err != nil {
+		return nil, err
+	}
+
+	// 证书中使用节点的唯一ID，和API令牌对应
+	var uniqueId string
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		var nodeIds = md.Get("nodeid")
+		if len(nodeIds) > 0 {
+			uniqueId = nodeIds[0]
+		}
+	}
+	if len(uniqueId) == 0 {
+		return nil, errors.New("context: need 'nodeId'")
+	}
+
+	if len(req.CsrData) == 0 {
+		return nil, errors.New("'csrData' should not be empty")
+	}
+
+	var tx = this.NullTx()
+	config, err := models.SharedSysSettingDAO.ReadMTLSConfig(tx)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := mtls.EnsureCA(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	certData, expiresAt, err := ca.IssueClientCert(req.CsrData, role, uniqueId, config.CertDays())
+	if err != nil {
+		return nil, errors.New("issue client cert failed: " + err.Error())
+	}
+
+	return &pb.IssueNodeClientCertResponse{
+		CertData:   certData,
+		CaCertData: ca.TrustedCertsData(),
+		ExpiresAt:  expiresAt,
+	}, nil
+}
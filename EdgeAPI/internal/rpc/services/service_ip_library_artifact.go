@@ -5,12 +5,16 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"os"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	rpcutils "github.com/TeaOSLab/EdgeAPI/internal/rpc/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/tasks"
 	"github.com/TeaOSLab/EdgeCommon/pkg/iplibrary"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
 )
 
 // IPLibraryArtifactService IP库制品
@@ -33,9 +37,17 @@ func (this *IPLibraryArtifactService) CreateIPLibraryArtifact(ctx context.Contex
 		return nil, errors.New("decode meta failed: " + err.Error())
 	}
 
+	var driver = req.Driver
+	if len(driver) == 0 {
+		driver = iplibrary.DriverTypeDefault
+	}
+	if !iplibrary.IsValidDriverType(driver) {
+		return nil, errors.New("invalid driver '" + driver + "'")
+	}
+
 	// TODO 更新数据库中的省市县等信息？
 
-	artifactId, err := models.SharedIPLibraryArtifactDAO.CreateArtifact(tx, req.Name, req.FileId, 0, meta)
+	artifactId, err := models.SharedIPLibraryArtifactDAO.CreateArtifact(tx, req.Name, req.FileId, 0, meta, driver)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +108,7 @@ func (this *IPLibraryArtifactService) FindAllIPLibraryArtifacts(ctx context.Cont
 			MetaJSON:  artifact.Meta,
 			IsPublic:  artifact.IsPublic,
 			Code:      artifact.Code,
+			Driver:    artifact.Driver,
 			File:      pbFile,
 		})
 	}
@@ -130,6 +143,7 @@ func (this *IPLibraryArtifactService) FindIPLibraryArtifact(ctx context.Context,
 			MetaJSON:  artifact.Meta,
 			IsPublic:  artifact.IsPublic,
 			Code:      artifact.Code,
+			Driver:    artifact.Driver,
 		},
 	}, nil
 }
@@ -160,6 +174,7 @@ func (this *IPLibraryArtifactService) FindPublicIPLibraryArtifact(ctx context.Co
 			MetaJSON:  artifact.Meta,
 			IsPublic:  artifact.IsPublic,
 			Code:      artifact.Code,
+			Driver:    artifact.Driver,
 		},
 	}, nil
 }
@@ -180,3 +195,133 @@ func (this *IPLibraryArtifactService) DeleteIPLibraryArtifact(ctx context.Contex
 
 	return this.Success()
 }
+
+// DownloadIPLibraryArtifact 从第三方IP库下载新的制品
+func (this *IPLibraryArtifactService) DownloadIPLibraryArtifact(ctx context.Context, req *pb.DownloadIPLibraryArtifactRequest) (*pb.DownloadIPLibraryArtifactResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	config, err := models.SharedSysSettingDAO.ReadIPLibraryConfig(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	artifactId, err := tasks.SharedIPLibraryDownloadTask.Download(config, req.IsPublic)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DownloadIPLibraryArtifactResponse{IpLibraryArtifactId: artifactId}, nil
+}
+
+// CompareIPLibraryArtifacts 对比两个制品的查询结果
+func (this *IPLibraryArtifactService) CompareIPLibraryArtifacts(ctx context.Context, req *pb.CompareIPLibraryArtifactsRequest) (*pb.CompareIPLibraryArtifactsResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	const maxIPs = 10000
+	if len(req.Ips) > maxIPs {
+		return nil, errors.New("too many ips, should not be greater than " + types.String(maxIPs))
+	}
+
+	var tx = this.NullTx()
+
+	reader1, err := this.loadArtifactReader(tx, req.IpLibraryArtifactId1)
+	if err != nil {
+		return nil, err
+	}
+	if req.IpLibraryArtifactId1 > 0 {
+		defer reader1.Destroy()
+	}
+
+	reader2, err := this.loadArtifactReader(tx, req.IpLibraryArtifactId2)
+	if err != nil {
+		return nil, err
+	}
+	if req.IpLibraryArtifactId2 > 0 {
+		defer reader2.Destroy()
+	}
+
+	var result = iplibrary.CompareReaders(reader1, reader2, req.Ips, 100)
+	var pbDiffs = []*pb.CompareIPLibraryArtifactsResponse_Diff{}
+	for _, diff := range result.Diffs {
+		pbDiffs = append(pbDiffs, &pb.CompareIPLibraryArtifactsResponse_Diff{
+			Ip:       diff.IP,
+			Summary1: diff.Summary1,
+			Summary2: diff.Summary2,
+		})
+	}
+
+	return &pb.CompareIPLibraryArtifactsResponse{
+		CountIPs:             int64(result.CountIPs),
+		CountFound1:          int64(result.CountFound1),
+		CountFound2:          int64(result.CountFound2),
+		CountCountryMatches:  int64(result.CountCountryMatches),
+		CountProvinceMatches: int64(result.CountProvinceMatches),
+		CountCityMatches:     int64(result.CountCityMatches),
+		CountProviderMatches: int64(result.CountProviderMatches),
+		Diffs:                pbDiffs,
+	}, nil
+}
+
+// 加载制品对应的IP库读取器
+// artifactId 为0时返回内置IP库的读取器，此读取器不能销毁
+func (this *IPLibraryArtifactService) loadArtifactReader(tx *dbs.Tx, artifactId int64) (iplibrary.ReaderInterface, error) {
+	if artifactId <= 0 {
+		return iplibrary.DefaultReader()
+	}
+
+	artifact, err := models.SharedIPLibraryArtifactDAO.FindEnabledIPLibraryArtifact(tx, artifactId)
+	if err != nil {
+		return nil, err
+	}
+	if artifact == nil || artifact.FileId == 0 {
+		return nil, errors.New("can not find artifact with id '" + types.String(artifactId) + "'")
+	}
+
+	var driver = artifact.Driver
+	if len(driver) == 0 {
+		driver = iplibrary.DriverTypeDefault
+	}
+
+	fp, err := os.CreateTemp("", "ip-library-compare-*"+iplibrary.DriverFileExt(driver))
+	if err != nil {
+		return nil, err
+	}
+	var path = fp.Name()
+	defer func() {
+		_ = os.Remove(path)
+	}()
+
+	chunkIds, err := models.SharedFileChunkDAO.FindAllFileChunkIds(tx, int64(artifact.FileId))
+	if err != nil {
+		_ = fp.Close()
+		return nil, err
+	}
+	for _, chunkId := range chunkIds {
+		chunk, err := models.SharedFileChunkDAO.FindFileChunk(tx, chunkId)
+		if err != nil {
+			_ = fp.Close()
+			return nil, err
+		}
+		if chunk == nil {
+			_ = fp.Close()
+			return nil, errors.New("can not find file chunk with chunk id '" + types.String(chunkId) + "'")
+		}
+		_, err = fp.Write(chunk.Data)
+		if err != nil {
+			_ = fp.Close()
+			return nil, err
+		}
+	}
+	err = fp.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return iplibrary.NewReaderWithDriver(driver, path)
+}
@@ -0,0 +1,236 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/langs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/langs/codes"
+	"github.com/TeaOSLab/EdgeCommon/pkg/monitorconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+// MessageSilenceService 告警静默规则相关服务
+type MessageSilenceService struct {
+	BaseService
+}
+
+// CreateMessageSilence 创建静默规则
+func (this *MessageSilenceService) CreateMessageSilence(ctx context.Context, req *pb.CreateMessageSilenceRequest) (*pb.CreateMessageSilenceResponse, error) {
+	adminId, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	startAt, endAt, err := this.composeSilencePeriod(req.StartAt, req.EndAt, req.DurationSeconds, req.ScheduleJSON)
+	if err != nil {
+		return nil, err
+	}
+	err = this.validateSilenceMatcher(req.Level, req.ScheduleJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var silenceId int64
+	err = this.RunTx(func(tx *dbs.Tx) error {
+		silenceId, err = models.SharedMessageSilenceDAO.CreateSilence(tx, adminId, req.Name, req.Reason, req.Role, req.NodeClusterId, req.NodeId, req.Type, req.Level, startAt, endAt, req.ScheduleJSON)
+		if err != nil {
+			return err
+		}
+		return this.createSilenceLog(tx, adminId, codes.MessageSilence_LogCreateMessageSilence, silenceId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateMessageSilenceResponse{MessageSilenceId: silenceId}, nil
+}
+
+// UpdateMessageSilence 修改静默规则
+func (this *MessageSilenceService) UpdateMessageSilence(ctx context.Context, req *pb.UpdateMessageSilenceRequest) (*pb.RPCSuccess, error) {
+	adminId, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	startAt, endAt, err := this.composeSilencePeriod(req.StartAt, req.EndAt, req.DurationSeconds, req.ScheduleJSON)
+	if err != nil {
+		return nil, err
+	}
+	err = this.validateSilenceMatcher(req.Level, req.ScheduleJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	err = this.RunTx(func(tx *dbs.Tx) error {
+		silence, err := models.SharedMessageSilenceDAO.FindEnabledMessageSilence(tx, req.MessageSilenceId)
+		if err != nil {
+			return err
+		}
+		if silence == nil {
+			return errors.New("can not find silence with id '" + types.String(req.MessageSilenceId) + "'")
+		}
+
+		err = models.SharedMessageSilenceDAO.UpdateSilence(tx, req.MessageSilenceId, req.Name, req.Reason, req.Role, req.NodeClusterId, req.NodeId, req.Type, req.Level, startAt, endAt, req.ScheduleJSON, req.IsOn)
+		if err != nil {
+			return err
+		}
+		return this.createSilenceLog(tx, adminId, codes.MessageSilence_LogUpdateMessageSilence, req.MessageSilenceId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// DeleteMessageSilence 删除静默规则
+func (this *MessageSilenceService) DeleteMessageSilence(ctx context.Context, req *pb.DeleteMessageSilenceRequest) (*pb.RPCSuccess, error) {
+	adminId, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = this.RunTx(func(tx *dbs.Tx) error {
+		err = models.SharedMessageSilenceDAO.DisableMessageSilence(tx, req.MessageSilenceId)
+		if err != nil {
+			return err
+		}
+		return this.createSilenceLog(tx, adminId, codes.MessageSilence_LogDeleteMessageSilence, req.MessageSilenceId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// CountAllEnabledMessageSilences 计算静默规则数量
+func (this *MessageSilenceService) CountAllEnabledMessageSilences(ctx context.Context, req *pb.CountAllEnabledMessageSilencesRequest) (*pb.RPCCountResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	count, err := models.SharedMessageSilenceDAO.CountAllEnabledSilences(tx, req.NodeClusterId)
+	if err != nil {
+		return nil, err
+	}
+	return this.SuccessCount(count)
+}
+
+// ListEnabledMessageSilences 列出单页静默规则
+func (this *MessageSilenceService) ListEnabledMessageSilences(ctx context.Context, req *pb.ListEnabledMessageSilencesRequest) (*pb.ListEnabledMessageSilencesResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	silences, err := models.SharedMessageSilenceDAO.ListEnabledSilences(tx, req.NodeClusterId, req.Offset, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbSilences = []*pb.MessageSilence{}
+	for _, silence := range silences {
+		pbSilences = append(pbSilences, this.convertSilence(silence))
+	}
+	return &pb.ListEnabledMessageSilencesResponse{MessageSilences: pbSilences}, nil
+}
+
+// FindEnabledMessageSilence 查找单个静默规则
+func (this *MessageSilenceService) FindEnabledMessageSilence(ctx context.Context, req *pb.FindEnabledMessageSilenceRequest) (*pb.FindEnabledMessageSilenceResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	silence, err := models.SharedMessageSilenceDAO.FindEnabledMessageSilence(tx, req.MessageSilenceId)
+	if err != nil {
+		return nil, err
+	}
+	if silence == nil {
+		return &pb.FindEnabledMessageSilenceResponse{MessageSilence: nil}, nil
+	}
+	return &pb.FindEnabledMessageSilenceResponse{MessageSilence: this.convertSilence(silence)}, nil
+}
+
+// 计算静默的起止时间
+func (this *MessageSilenceService) composeSilencePeriod(startAt int64, endAt int64, durationSeconds int64, scheduleJSON []byte) (int64, int64, error) {
+	if startAt < 0 || endAt < 0 || durationSeconds < 0 {
+		return 0, 0, errors.New("invalid silence period")
+	}
+
+	// 临时静默：从开始时间（或者当前时间）起持续一段时间
+	if durationSeconds > 0 {
+		var from = startAt
+		if from <= 0 {
+			from = time.Now().Unix()
+		}
+		endAt = from + durationSeconds
+	}
+
+	if endAt > 0 && endAt <= startAt {
+		return 0, 0, errors.New("'endAt' should be greater than 'startAt'")
+	}
+	if endAt <= 0 && len(scheduleJSON) == 0 {
+		return 0, 0, errors.New("either 'endAt', 'durationSeconds' or 'scheduleJSON' should be set")
+	}
+	return startAt, endAt, nil
+}
+
+// 校验匹配条件
+func (this *MessageSilenceService) validateSilenceMatcher(level string, scheduleJSON []byte) error {
+	switch level {
+	case "", models.MessageLevelInfo, models.MessageLevelWarning, models.MessageLevelError, models.MessageLevelSuccess:
+	default:
+		return errors.New("invalid level '" + level + "'")
+	}
+
+	if len(scheduleJSON) > 0 {
+		var schedule = &monitorconfigs.SilenceScheduleConfig{}
+		err := json.Unmarshal(scheduleJSON, schedule)
+		if err != nil {
+			return errors.New("decode 'scheduleJSON' failed: " + err.Error())
+		}
+		err = schedule.Init()
+		if err != nil {
+			return errors.New("validate 'scheduleJSON' failed: " + err.Error())
+		}
+	}
+	return nil
+}
+
+// 记录操作日志，以便审计谁静默了哪些告警
+func (this *MessageSilenceService) createSilenceLog(tx *dbs.Tx, adminId int64, messageCode langs.MessageCode, silenceId int64) error {
+	var args = []any{silenceId}
+	return models.SharedLogDAO.CreateLog(tx, "admin", adminId, "info", langs.Message(langs.DefaultManager().DefaultLang(), messageCode, args...), "", "", messageCode, args)
+}
+
+func (this *MessageSilenceService) convertSilence(silence *models.MessageSilence) *pb.MessageSilence {
+	return &pb.MessageSilence{
+		Id:            int64(silence.Id),
+		IsOn:          silence.IsOn,
+		Name:          silence.Name,
+		Reason:        silence.Reason,
+		Role:          silence.Role,
+		NodeClusterId: int64(silence.ClusterId),
+		NodeId:        int64(silence.NodeId),
+		Type:          silence.Type,
+		Level:         silence.Level,
+		StartAt:       int64(silence.StartAt),
+		EndAt:         int64(silence.EndAt),
+		ScheduleJSON:  silence.Schedule,
+		AdminId:       int64(silence.AdminId),
+		CountMuted:    int64(silence.CountMuted),
+		LastMutedAt:   int64(silence.LastMutedAt),
+		CreatedAt:     int64(silence.CreatedAt),
+	}
+}
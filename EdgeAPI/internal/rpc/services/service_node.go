@@ -18,6 +18,7 @@ import (
 	"github.com/TeaOSLab/EdgeAPI/internal/goman"
 	"github.com/TeaOSLab/EdgeAPI/internal/installers"
 	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/fieldmask"
 	rpcutils "github.com/TeaOSLab/EdgeAPI/internal/rpc/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils/numberutils"
@@ -183,12 +184,19 @@ func (this *NodeService) ListEnabledNodesMatch(ctx context.Context, req *pb.List
 		return nil, err
 	}
 
+	// 字段掩码
+	var mask = fieldmask.Parse(req.FieldMask)
+	err = mask.Validate(&pb.Node{})
+	if err != nil {
+		return nil, err
+	}
+
 	var tx = this.NullTx()
 
 	var dnsDomainId = int64(0)
 	var domainRoutes = []*dnstypes.Route{}
 
-	if req.NodeClusterId > 0 {
+	if req.NodeClusterId > 0 && mask.Has("dnsRoutes") {
 		clusterDNS, err := models.SharedNodeClusterDAO.FindClusterDNSInfo(tx, req.NodeClusterId, nil)
 		if err != nil {
 			return nil, err
@@ -240,23 +248,28 @@ func (this *NodeService) ListEnabledNodesMatch(ctx context.Context, req *pb.List
 	var cacheMap = utils.NewCacheMap()
 	for _, node := range nodes {
 		// 主集群信息
-		clusterName, err := models.SharedNodeClusterDAO.FindNodeClusterName(tx, int64(node.ClusterId))
-		if err != nil {
-			return nil, err
+		var clusterName string
+		if mask.Has("nodeCluster") {
+			clusterName, err = models.SharedNodeClusterDAO.FindNodeClusterName(tx, int64(node.ClusterId))
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		// 从集群
-		secondaryClusters, err := models.SharedNodeClusterDAO.FindEnabledNodeClustersWithIds(tx, node.DecodeSecondaryClusterIds())
-		if err != nil {
-			return nil, err
-		}
 		var pbSecondaryClusters = []*pb.NodeCluster{}
-		for _, secondaryCluster := range secondaryClusters {
-			pbSecondaryClusters = append(pbSecondaryClusters, &pb.NodeCluster{
-				Id:   int64(secondaryCluster.Id),
-				IsOn: secondaryCluster.IsOn,
-				Name: secondaryCluster.Name,
-			})
+		if mask.Has("secondaryNodeClusters") {
+			secondaryClusters, err := models.SharedNodeClusterDAO.FindEnabledNodeClustersWithIds(tx, node.DecodeSecondaryClusterIds())
+			if err != nil {
+				return nil, err
+			}
+			for _, secondaryCluster := range secondaryClusters {
+				pbSecondaryClusters = append(pbSecondaryClusters, &pb.NodeCluster{
+					Id:   int64(secondaryCluster.Id),
+					IsOn: secondaryCluster.IsOn,
+					Name: secondaryCluster.Name,
+				})
+			}
 		}
 
 		// 安装信息
@@ -278,7 +291,7 @@ func (this *NodeService) ListEnabledNodesMatch(ctx context.Context, req *pb.List
 
 		// 分组信息
 		var pbGroup *pb.NodeGroup = nil
-		if node.GroupId > 0 {
+		if node.GroupId > 0 && mask.Has("nodeGroup") {
 			group, err := models.SharedNodeGroupDAO.FindEnabledNodeGroup(tx, int64(node.GroupId))
 			if err != nil {
 				return nil, err
@@ -293,7 +306,9 @@ func (this *NodeService) ListEnabledNodesMatch(ctx context.Context, req *pb.List
 
 		// DNS线路
 		var pbRoutes = []*pb.DNSRoute{}
-		if dnsDomainId > 0 {
+		if !mask.Has("dnsRoutes") {
+			// 不需要DNS线路
+		} else if dnsDomainId > 0 {
 			routeCodes, err := node.DNSRouteCodesForDomainId(dnsDomainId)
 			if err != nil {
 				return nil, err
@@ -346,7 +361,7 @@ func (this *NodeService) ListEnabledNodesMatch(ctx context.Context, req *pb.List
 
 		// 区域
 		var pbRegion *pb.NodeRegion = nil
-		if node.RegionId > 0 {
+		if node.RegionId > 0 && mask.Has("nodeRegion") {
 			region, err := models.SharedNodeRegionDAO.FindEnabledNodeRegion(tx, int64(node.RegionId))
 			if err != nil {
 				return nil, err
@@ -361,12 +376,15 @@ func (this *NodeService) ListEnabledNodesMatch(ctx context.Context, req *pb.List
 		}
 
 		// 状态
-		statusJSON, err := models.SharedNodeValueDAO.ComposeNodeStatusJSON(tx, nodeconfigs.NodeRoleNode, int64(node.Id), node.Status)
-		if err != nil {
-			return nil, err
+		var statusJSON []byte
+		if mask.Has("statusJSON") {
+			statusJSON, err = models.SharedNodeValueDAO.ComposeNodeStatusJSON(tx, nodeconfigs.NodeRoleNode, int64(node.Id), node.Status)
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		result = append(result, &pb.Node{
+		var pbNode = &pb.Node{
 			Id:          int64(node.Id),
 			Name:        node.Name,
 			Version:     int64(node.Version),
@@ -389,7 +407,9 @@ func (this *NodeService) ListEnabledNodesMatch(ctx context.Context, req *pb.List
 			IsBackupForCluster:    node.IsBackupForCluster,
 			IsBackupForGroup:      node.IsBackupForGroup,
 			BypassMobile:          node.BypassMobile,
-		})
+		}
+		mask.PruneMessage(pbNode)
+		result = append(result, pbNode)
 	}
 
 	return &pb.ListEnabledNodesMatchResponse{
@@ -778,47 +798,69 @@ func (this *NodeService) FindCurrentNodeConfig(ctx context.Context, req *pb.Find
 		return nil, err
 	}
 
-	// 压缩
-	var data []byte
-	var isCompressed = false
-	var buffer = &bytes.Buffer{}
-	var writer io.Writer = buffer
-	var brotliWriter *brotli.Writer
-	if req.Compress {
-		brotliWriter = brotli.NewWriterLevel(writer, 5)
-		writer = brotliWriter
-	}
-
-	var encoder = json.NewEncoder(writer)
-	err = encoder.Encode(nodeConfig)
+	configJSON, err := json.Marshal(nodeConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	if brotliWriter != nil {
-		err = brotliWriter.Close()
+	// 和节点当前的配置内容一致
+	var configHash = models.NodeConfigHash(configJSON)
+	if len(req.ConfigHash) > 0 && req.ConfigHash == configHash {
+		return &pb.FindCurrentNodeConfigResponse{
+			IsChanged:  false,
+			Timestamp:  time.Now().Unix(),
+			ConfigHash: configHash,
+		}, nil
+	}
+
+	// 已经压缩过的相同配置内容
+	if req.Compress {
+		blobData, err := models.SharedNodeConfigBlobDAO.FindBlobData(tx, configHash)
+		if err != nil {
+			return nil, err
+		}
+		if len(blobData) > 0 {
+			return &pb.FindCurrentNodeConfigResponse{
+				IsChanged:    true,
+				NodeJSON:     blobData,
+				DataSize:     int64(len(blobData)),
+				IsCompressed: true,
+				Timestamp:    time.Now().Unix(),
+				ConfigHash:   configHash,
+			}, nil
+		}
+	}
+
+	// 压缩
+	var data = configJSON
+	var isCompressed = false
+	if req.Compress {
+		var buffer = &bytes.Buffer{}
+		var brotliWriter = brotli.NewWriterLevel(buffer, 5)
+		_, err = brotliWriter.Write(configJSON)
+		if err == nil {
+			err = brotliWriter.Close()
+		}
+
+		// 如果失败，则使用未压缩的内容
 		if err == nil {
 			data = buffer.Bytes()
 			isCompressed = true
-		} else {
-			// 如果失败，则使用最直接方法重新编码
-			data, err = json.Marshal(nodeConfig)
+
+			err = models.SharedNodeConfigBlobDAO.CreateBlob(tx, configHash, data, len(configJSON))
 			if err != nil {
-				return nil, err
+				remotelogs.Error("NODE_SERVICE", "save node config blob failed: "+err.Error())
 			}
 		}
-	} else {
-		data = buffer.Bytes()
 	}
 
-	buffer.Reset()
-
 	return &pb.FindCurrentNodeConfigResponse{
 		IsChanged:    true,
 		NodeJSON:     data,
 		DataSize:     int64(len(data)),
 		IsCompressed: isCompressed,
 		Timestamp:    time.Now().Unix(),
+		ConfigHash:   configHash,
 	}, nil
 }
 
@@ -1776,6 +1818,76 @@ func (this *NodeService) UpdateNodeBypassMobile(ctx context.Context, req *pb.Upd
 	return this.Success()
 }
 
+// FindNodeBGPConfig 读取节点BGP宣告配置和状态
+func (this *NodeService) FindNodeBGPConfig(ctx context.Context, req *pb.FindNodeBGPConfigRequest) (*pb.FindNodeBGPConfigResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	config, status, err := models.SharedNodeDAO.FindNodeBGP(tx, req.NodeId)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, errors.New("can not find node '" + types.String(req.NodeId) + "'")
+	}
+
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	statusJSON, err := json.Marshal(status)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FindNodeBGPConfigResponse{
+		BgpJSON:       configJSON,
+		BgpStatusJSON: statusJSON,
+	}, nil
+}
+
+// UpdateNodeBGPConfig 修改节点BGP宣告配置
+func (this *NodeService) UpdateNodeBGPConfig(ctx context.Context, req *pb.UpdateNodeBGPConfigRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = nodeconfigs.NewNodeBGPConfig()
+	err = json.Unmarshal(req.BgpJSON, config)
+	if err != nil {
+		return nil, err
+	}
+	err = config.Init()
+	if err != nil {
+		return nil, errors.New("validate bgp config failed: " + err.Error())
+	}
+
+	var tx = this.NullTx()
+	err = models.SharedNodeDAO.UpdateNodeBGPConfig(tx, req.NodeId, config)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// UpdateNodeBGPMaintenance 修改节点BGP维护状态
+func (this *NodeService) UpdateNodeBGPMaintenance(ctx context.Context, req *pb.UpdateNodeBGPMaintenanceRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	err = models.SharedNodeDAO.UpdateNodeBGPMaintenance(tx, req.NodeId, req.IsMaintaining)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
 // UpdateNodeCache 修改节点缓存设置
 func (this *NodeService) UpdateNodeCache(ctx context.Context, req *pb.UpdateNodeCacheRequest) (*pb.RPCSuccess, error) {
 	_, err := this.ValidateAdmin(ctx)
@@ -12,6 +12,7 @@ import (
 	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/tasks"
+	"github.com/TeaOSLab/EdgeCommon/pkg/dnsconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
@@ -533,6 +534,7 @@ func (this *NodeClusterService) FindEnabledNodeClusterDNS(ctx context.Context, r
 			Ttl:              dnsConfig.TTL,
 			CnameAsDomain:    dnsConfig.CNAMEAsDomain,
 			IncludingLnNodes: dnsConfig.IncludingLnNodes,
+			ConflictPolicy:   dnsConfig.ConflictPolicy,
 		}, nil
 	}
 
@@ -593,6 +595,7 @@ func (this *NodeClusterService) FindEnabledNodeClusterDNS(ctx context.Context, r
 		Ttl:              dnsConfig.TTL,
 		CnameAsDomain:    dnsConfig.CNAMEAsDomain,
 		IncludingLnNodes: dnsConfig.IncludingLnNodes,
+		ConflictPolicy:   dnsConfig.ConflictPolicy,
 		DefaultRoute:     defaultRoute,
 	}, nil
 }
@@ -700,9 +703,15 @@ func (this *NodeClusterService) UpdateNodeClusterDNS(ctx context.Context, req *p
 		return nil, err
 	}
 
+	if len(req.ConflictPolicy) == 0 {
+		req.ConflictPolicy = dnsconfigs.RecordConflictPolicyError
+	} else if !dnsconfigs.IsValidRecordConflictPolicy(req.ConflictPolicy) {
+		return nil, errors.New("invalid conflict policy '" + req.ConflictPolicy + "'")
+	}
+
 	var tx = this.NullTx()
 
-	err = models.SharedNodeClusterDAO.UpdateClusterDNS(tx, req.NodeClusterId, req.DnsName, req.DnsDomainId, req.NodesAutoSync, req.ServersAutoSync, req.CnameRecords, req.Ttl, req.CnameAsDomain, req.IncludingLnNodes)
+	err = models.SharedNodeClusterDAO.UpdateClusterDNS(tx, req.NodeClusterId, req.DnsName, req.DnsDomainId, req.NodesAutoSync, req.ServersAutoSync, req.CnameRecords, req.Ttl, req.CnameAsDomain, req.IncludingLnNodes, req.ConflictPolicy)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,158 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeCommon/pkg/messageconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/types"
+)
+
+// 同时执行命令的最大节点数
+const maxNodeCommandConcurrency = 16
+
+// NodeCommandService 节点远程命令服务
+type NodeCommandService struct {
+	BaseService
+}
+
+// FindAllNodeCommands 查找所有可以远程执行的命令
+func (this *NodeCommandService) FindAllNodeCommands(ctx context.Context, req *pb.FindAllNodeCommandsRequest) (*pb.FindAllNodeCommandsResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbCommands = []*pb.FindAllNodeCommandsResponse_NodeCommand{}
+	for _, def := range nodeconfigs.FindAllNodeCommands() {
+		pbCommands = append(pbCommands, &pb.FindAllNodeCommandsResponse_NodeCommand{
+			Code:        def.Code,
+			Name:        def.Name,
+			Description: def.Description,
+		})
+	}
+	return &pb.FindAllNodeCommandsResponse{NodeCommands: pbCommands}, nil
+}
+
+// RunNodeCommand 在一组节点上执行远程命令，并逐个返回执行结果
+func (this *NodeCommandService) RunNodeCommand(req *pb.RunNodeCommandRequest, server pb.NodeCommandService_RunNodeCommandServer) error {
+	_, err := this.ValidateAdmin(server.Context())
+	if err != nil {
+		return err
+	}
+
+	if !nodeconfigs.IsValidNodeCommand(req.Command) {
+		return errors.New("invalid command '" + req.Command + "'")
+	}
+
+	var tx = this.NullTx()
+
+	// 需要执行命令的节点
+	var nodeIds = []int64{}
+	var nodeIdMap = map[int64]bool{}
+	if req.NodeClusterId > 0 {
+		clusterNodeIds, err := models.SharedNodeDAO.FindAllEnabledNodeIdsWithClusterId(tx, req.NodeClusterId)
+		if err != nil {
+			return err
+		}
+		for _, nodeId := range clusterNodeIds {
+			nodeIdMap[nodeId] = true
+			nodeIds = append(nodeIds, nodeId)
+		}
+	}
+	for _, nodeId := range req.NodeIds {
+		if nodeId > 0 && !nodeIdMap[nodeId] {
+			nodeIdMap[nodeId] = true
+			nodeIds = append(nodeIds, nodeId)
+		}
+	}
+	if len(nodeIds) == 0 {
+		return errors.New("'nodeIds' or 'nodeClusterId' should be specified")
+	}
+
+	var timeoutSeconds = req.TimeoutSeconds
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = 30
+	}
+
+	commandJSON, err := json.Marshal(&messageconfigs.RunNodeCommandMessage{
+		Command: req.Command,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 并发执行，执行结果按完成顺序返回
+	var resultChan = make(chan *pb.RunNodeCommandResponse, len(nodeIds))
+	var limiter = make(chan bool, maxNodeCommandConcurrency)
+	var wg = &sync.WaitGroup{}
+	for _, nodeId := range nodeIds {
+		var node, err = models.SharedNodeDAO.FindEnabledBasicNode(tx, nodeId)
+		if err != nil {
+			return err
+		}
+		if node == nil {
+			resultChan <- &pb.RunNodeCommandResponse{
+				NodeId:  nodeId,
+				IsOk:    false,
+				Message: "node '" + types.String(nodeId) + "' not found",
+			}
+			continue
+		}
+
+		wg.Add(1)
+		goman.New(func() {
+			defer wg.Done()
+
+			limiter <- true
+			defer func() {
+				<-limiter
+			}()
+
+			var before = time.Now()
+			var resp = &pb.RunNodeCommandResponse{
+				NodeId:   nodeId,
+				NodeName: node.Name,
+			}
+			result, err := SendCommandToNode(nodeId, 0, messageconfigs.MessageCodeRunNodeCommand, commandJSON, timeoutSeconds, true)
+			if err != nil {
+				resp.Message = err.Error()
+			} else {
+				resp.IsOk = result.IsOk
+				resp.Message = result.Message
+				resp.DataJSON = result.DataJSON
+			}
+			resp.CostMs = time.Since(before).Milliseconds()
+			resultChan <- resp
+		})
+	}
+
+	goman.New(func() {
+		wg.Wait()
+		close(resultChan)
+	})
+
+	for {
+		select {
+		case <-server.Context().Done():
+			return nil
+		case resp, ok := <-resultChan:
+			if !ok {
+				return nil
+			}
+			err = server.Send(resp)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
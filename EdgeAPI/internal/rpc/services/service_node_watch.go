@@ -0,0 +1,104 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+)
+
+// 检查数据库中节点状态的间隔，用于发现在其他API节点上发生的变化
+const nodeStatesCheckInterval = 30 * time.Second
+
+// WatchNodeStates 监听节点状态变化
+func (this *NodeService) WatchNodeStates(req *pb.WatchNodeStatesRequest, server pb.NodeService_WatchNodeStatesServer) error {
+	_, err := this.ValidateAdmin(server.Context())
+	if err != nil {
+		return err
+	}
+
+	var tx = this.NullTx()
+
+	events, cancel := models.SharedNodeStateHub.Subscribe()
+	defer cancel()
+
+	// 当前状态
+	var stateMap = map[int64]*models.NodeState{} // nodeId => *NodeState
+	states, err := models.SharedNodeDAO.FindNodeStates(tx, req.NodeClusterId)
+	if err != nil {
+		return err
+	}
+	for _, state := range states {
+		stateMap[state.NodeId] = state
+	}
+
+	err = server.Send(&pb.WatchNodeStatesResponse{
+		IsSubscribed: true,
+		CreatedAt:    time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var send = func(nodeId int64, clusterId int64, eventType models.NodeStateEventType, createdAt int64) error {
+		return server.Send(&pb.WatchNodeStatesResponse{
+			NodeId:        nodeId,
+			NodeClusterId: clusterId,
+			Type:          eventType,
+			CreatedAt:     createdAt,
+		})
+	}
+
+	var ticker = time.NewTicker(nodeStatesCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-server.Context().Done():
+			return nil
+		case event := <-events:
+			if req.NodeClusterId > 0 && event.ClusterId != req.NodeClusterId {
+				continue
+			}
+
+			// 更新当前状态，防止检查数据库时重复发送
+			state, ok := stateMap[event.NodeId]
+			if ok {
+				switch event.Type {
+				case models.NodeStateEventOnline, models.NodeStateEventOffline:
+					state.IsActive = event.Type == models.NodeStateEventOnline
+				case models.NodeStateEventUp, models.NodeStateEventDown:
+					state.IsUp = event.Type == models.NodeStateEventUp
+				case models.NodeStateEventSynced, models.NodeStateEventUnsynced:
+					state.IsSynced = event.Type == models.NodeStateEventSynced
+				}
+			}
+
+			err = send(event.NodeId, event.ClusterId, event.Type, event.CreatedAt)
+			if err != nil {
+				return err
+			}
+		case <-ticker.C:
+			states, err := models.SharedNodeDAO.FindNodeStates(tx, req.NodeClusterId)
+			if err != nil {
+				return err
+			}
+			var now = time.Now().Unix()
+			for _, state := range states {
+				oldState, ok := stateMap[state.NodeId]
+				stateMap[state.NodeId] = state
+				if !ok {
+					continue
+				}
+				for _, eventType := range models.DiffNodeStates(oldState, state) {
+					err = send(state.NodeId, state.ClusterId, eventType, now)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/shared"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
 	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
 )
 
 // OriginService 源站相关管理
@@ -273,3 +274,64 @@ func (this *OriginService) UpdateOriginIsOn(ctx context.Context, req *pb.UpdateO
 
 	return this.Success()
 }
+
+// FindOriginResolution 查找源站域名在API节点上的解析结果
+func (this *OriginService) FindOriginResolution(ctx context.Context, req *pb.FindOriginResolutionRequest) (*pb.FindOriginResolutionResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+
+	if userId > 0 {
+		err = models.SharedOriginDAO.CheckUserOrigin(tx, userId, req.OriginId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	origin, err := models.SharedOriginDAO.FindEnabledOrigin(tx, req.OriginId)
+	if err != nil {
+		return nil, err
+	}
+	if origin == nil {
+		return nil, errors.New("can not find origin '" + types.String(req.OriginId) + "'")
+	}
+
+	addr, err := origin.DecodeAddr()
+	if err != nil {
+		return nil, err
+	}
+	if !models.IsResolvableOriginAddr(addr) {
+		return &pb.FindOriginResolutionResponse{
+			IsResolvable: false,
+			Host:         addr.Host,
+		}, nil
+	}
+
+	resolution, err := models.SharedOriginResolutionDAO.FindOriginResolution(tx, req.OriginId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 尚未解析或者主机名已修改
+	if resolution == nil || resolution.Host != addr.Host {
+		return &pb.FindOriginResolutionResponse{
+			IsResolvable: true,
+			Host:         addr.Host,
+		}, nil
+	}
+
+	return &pb.FindOriginResolutionResponse{
+		IsResolvable: true,
+		Host:         resolution.Host,
+		Ips:          resolution.DecodeIPs(),
+		Ttl:          int32(resolution.Ttl),
+		Error:        resolution.Error,
+		ResolvedAt:   int64(resolution.ResolvedAt),
+		ExpiresAt:    int64(resolution.ExpiresAt),
+		ChangedAt:    int64(resolution.ChangedAt),
+		CountChanges: int32(resolution.CountChanges),
+	}, nil
+}
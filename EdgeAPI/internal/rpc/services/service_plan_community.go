@@ -5,8 +5,13 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+	"github.com/iwind/TeaGo/types"
 )
 
 // PlanService 套餐相关服务
@@ -16,50 +21,352 @@ type PlanService struct {
 
 // CreatePlan 创建套餐
 func (this *PlanService) CreatePlan(ctx context.Context, req *pb.CreatePlanRequest) (*pb.CreatePlanResponse, error) {
-	return &pb.CreatePlanResponse{}, nil
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var op = models.NewPlanOperator()
+	err = this.fillPlanOperator(op, req.Name, req.Description, req.ClusterId, req.TrafficLimitJSON, req.BandwidthLimitPerNodeJSON, req.HasFullFeatures, req.FeaturesJSON, req.PriceType, req.TrafficPriceJSON, req.BandwidthPriceJSON, req.MonthlyPrice, req.SeasonallyPrice, req.YearlyPrice, req.TotalServers, req.TotalServerNamesPerServer, req.TotalServerNames, req.DailyRequests, req.MonthlyRequests, req.DailyWebsocketConnections, req.MonthlyWebsocketConnections, req.MaxUploadSizeJSON, req.QuotaJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	planId, err := models.SharedPlanDAO.CreatePlan(tx, op)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreatePlanResponse{PlanId: planId}, nil
 }
 
 // UpdatePlan 修改套餐
 func (this *PlanService) UpdatePlan(ctx context.Context, req *pb.UpdatePlanRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	plan, err := models.SharedPlanDAO.FindEnabledPlan(tx, req.PlanId, nil)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil {
+		return nil, errors.New("can not find plan '" + types.String(req.PlanId) + "'")
+	}
+
+	var op = models.NewPlanOperator()
+	err = this.fillPlanOperator(op, req.Name, req.Description, req.ClusterId, req.TrafficLimitJSON, req.BandwidthLimitPerNodeJSON, req.HasFullFeatures, req.FeaturesJSON, req.PriceType, req.TrafficPriceJSON, req.BandwidthPriceJSON, req.MonthlyPrice, req.SeasonallyPrice, req.YearlyPrice, req.TotalServers, req.TotalServerNamesPerServer, req.TotalServerNames, req.DailyRequests, req.MonthlyRequests, req.DailyWebsocketConnections, req.MonthlyWebsocketConnections, req.MaxUploadSizeJSON, req.QuotaJSON)
+	if err != nil {
+		return nil, err
+	}
+	op.IsOn = req.IsOn
+
+	err = models.SharedPlanDAO.UpdatePlan(tx, req.PlanId, op)
+	if err != nil {
+		return nil, err
+	}
 	return this.Success()
 }
 
 // DeletePlan 删除套餐
 func (this *PlanService) DeletePlan(ctx context.Context, req *pb.DeletePlanRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	err = models.SharedPlanDAO.DisablePlan(tx, req.PlanId)
+	if err != nil {
+		return nil, err
+	}
 	return this.Success()
 }
 
 // FindEnabledPlan 查找单个套餐
 func (this *PlanService) FindEnabledPlan(ctx context.Context, req *pb.FindEnabledPlanRequest) (*pb.FindEnabledPlanResponse, error) {
-	return &pb.FindEnabledPlanResponse{Plan: nil}, nil
+	_, userId, err := this.ValidateAdminAndUser(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	plan, err := models.SharedPlanDAO.FindEnabledPlan(tx, req.PlanId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// 用户只能查看启用的套餐
+	if plan == nil || (userId > 0 && !plan.IsOn) {
+		return &pb.FindEnabledPlanResponse{Plan: nil}, nil
+	}
+	return &pb.FindEnabledPlanResponse{Plan: this.composePlan(plan)}, nil
 }
 
 // FindBasicPlan 查找套餐基本信息
 func (this *PlanService) FindBasicPlan(ctx context.Context, req *pb.FindBasicPlanRequest) (*pb.FindBasicPlanResponse, error) {
-	return nil, this.NotImplementedYet()
+	_, userId, err := this.ValidateAdminAndUser(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	plan, err := models.SharedPlanDAO.FindBasicPlan(tx, req.PlanId)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil || (userId > 0 && !plan.IsOn) {
+		return &pb.FindBasicPlanResponse{Plan: nil}, nil
+	}
+	return &pb.FindBasicPlanResponse{Plan: this.composeBasicPlan(plan)}, nil
 }
 
 // CountAllEnabledPlans 计算套餐数量
 func (this *PlanService) CountAllEnabledPlans(ctx context.Context, req *pb.CountAllEnabledPlansRequest) (*pb.RPCCountResponse, error) {
-	return this.SuccessCount(0)
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	count, err := models.SharedPlanDAO.CountAllEnabledPlans(tx)
+	if err != nil {
+		return nil, err
+	}
+	return this.SuccessCount(count)
 }
 
 // ListEnabledPlans 列出单页套餐
 func (this *PlanService) ListEnabledPlans(ctx context.Context, req *pb.ListEnabledPlansRequest) (*pb.ListEnabledPlansResponse, error) {
-	return &pb.ListEnabledPlansResponse{Plans: nil}, nil
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	plans, err := models.SharedPlanDAO.ListEnabledPlans(tx, req.Offset, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbPlans = []*pb.Plan{}
+	for _, plan := range plans {
+		pbPlans = append(pbPlans, this.composePlan(plan))
+	}
+	return &pb.ListEnabledPlansResponse{Plans: pbPlans}, nil
 }
 
 // SortPlans 对套餐进行排序
 func (this *PlanService) SortPlans(ctx context.Context, req *pb.SortPlansRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	err = models.SharedPlanDAO.SortPlans(tx, req.PlanIds)
+	if err != nil {
+		return nil, err
+	}
 	return this.Success()
 }
 
 // FindAllAvailablePlans 列出所有可用的套餐
 func (this *PlanService) FindAllAvailablePlans(ctx context.Context, req *pb.FindAllAvailablePlansRequest) (*pb.FindAllAvailablePlansResponse, error) {
-	return nil, this.NotImplementedYet()
+	_, _, err := this.ValidateAdminAndUser(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	plans, err := models.SharedPlanDAO.FindAllAvailablePlans(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbPlans = []*pb.Plan{}
+	for _, plan := range plans {
+		pbPlans = append(pbPlans, this.composePlan(plan))
+	}
+	return &pb.FindAllAvailablePlansResponse{Plans: pbPlans}, nil
 }
 
 // FindAllAvailableBasicPlans 列出所有可用的套餐的基本信息
 func (this *PlanService) FindAllAvailableBasicPlans(ctx context.Context, req *pb.FindAllAvailableBasicPlansRequest) (*pb.FindAllAvailableBasicPlansResponse, error) {
-	return nil, this.NotImplementedYet()
+	_, _, err := this.ValidateAdminAndUser(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	plans, err := models.SharedPlanDAO.FindAllAvailableBasicPlans(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbPlans = []*pb.Plan{}
+	for _, plan := range plans {
+		pbPlans = append(pbPlans, this.composeBasicPlan(plan))
+	}
+	return &pb.FindAllAvailableBasicPlansResponse{Plans: pbPlans}, nil
+}
+
+// 检查并设置套餐字段
+func (this *PlanService) fillPlanOperator(op *models.PlanOperator,
+	name string,
+	description string,
+	clusterId int64,
+	trafficLimitJSON []byte,
+	bandwidthLimitPerNodeJSON []byte,
+	hasFullFeatures bool,
+	featuresJSON []byte,
+	priceType string,
+	trafficPriceJSON []byte,
+	bandwidthPriceJSON []byte,
+	monthlyPrice float32,
+	seasonallyPrice float32,
+	yearlyPrice float32,
+	totalServers int32,
+	totalServerNamesPerServer int32,
+	totalServerNames int32,
+	dailyRequests int64,
+	monthlyRequests int64,
+	dailyWebsocketConnections int64,
+	monthlyWebsocketConnections int64,
+	maxUploadSizeJSON []byte,
+	quotaJSON []byte) error {
+	if len(name) == 0 {
+		return errors.New("'name' should not be empty")
+	}
+	if len(priceType) > 0 && !models.IsValidPlanPriceType(priceType) {
+		return errors.New("invalid price type '" + priceType + "'")
+	}
+	if monthlyPrice < 0 || seasonallyPrice < 0 || yearlyPrice < 0 {
+		return errors.New("price should not be negative")
+	}
+	if totalServers < 0 || totalServerNamesPerServer < 0 || totalServerNames < 0 || dailyRequests < 0 || monthlyRequests < 0 || dailyWebsocketConnections < 0 || monthlyWebsocketConnections < 0 {
+		return errors.New("quota should not be negative")
+	}
+
+	// 集群
+	if clusterId > 0 {
+		exists, err := models.SharedNodeClusterDAO.ExistsEnabledCluster(this.NullTx(), clusterId)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.New("can not find cluster '" + types.String(clusterId) + "'")
+		}
+	}
+
+	// 功能列表
+	if len(featuresJSON) > 0 {
+		var features = []string{}
+		err := json.Unmarshal(featuresJSON, &features)
+		if err != nil {
+			return errors.New("decode 'featuresJSON' failed: " + err.Error())
+		}
+	}
+
+	for field, data := range map[string][]byte{
+		"trafficLimitJSON":          trafficLimitJSON,
+		"bandwidthLimitPerNodeJSON": bandwidthLimitPerNodeJSON,
+		"trafficPriceJSON":          trafficPriceJSON,
+		"bandwidthPriceJSON":        bandwidthPriceJSON,
+		"maxUploadSizeJSON":         maxUploadSizeJSON,
+	} {
+		if len(data) > 0 && !json.Valid(data) {
+			return errors.New("invalid '" + field + "'")
+		}
+	}
+
+	// 用户对象数量限制
+	if models.IsNotNull(quotaJSON) {
+		var quotaConfig = userconfigs.NewUserQuotaConfig()
+		err := json.Unmarshal(quotaJSON, quotaConfig)
+		if err != nil {
+			return errors.New("decode 'quotaJSON' failed: " + err.Error())
+		}
+		err = quotaConfig.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	op.Name = name
+	op.Description = description
+	op.ClusterId = clusterId
+	op.TrafficLimit = this.planJSON(trafficLimitJSON)
+	op.BandwidthLimitPerNode = this.planJSON(bandwidthLimitPerNodeJSON)
+	op.HasFullFeatures = hasFullFeatures
+	op.Features = this.planJSON(featuresJSON)
+	op.PriceType = priceType
+	op.TrafficPrice = this.planJSON(trafficPriceJSON)
+	op.BandwidthPrice = this.planJSON(bandwidthPriceJSON)
+	op.MonthlyPrice = monthlyPrice
+	op.SeasonallyPrice = seasonallyPrice
+	op.YearlyPrice = yearlyPrice
+	op.TotalServers = totalServers
+	op.TotalServerNamesPerServer = totalServerNamesPerServer
+	op.TotalServerNames = totalServerNames
+	op.DailyRequests = dailyRequests
+	op.MonthlyRequests = monthlyRequests
+	op.DailyWebsocketConnections = dailyWebsocketConnections
+	op.MonthlyWebsocketConnections = monthlyWebsocketConnections
+	op.MaxUploadSize = this.planJSON(maxUploadSizeJSON)
+	op.Quota = this.planJSON(quotaJSON)
+	return nil
+}
+
+// JSON字段为空时保存为null
+func (this *PlanService) planJSON(data []byte) any {
+	if len(data) == 0 {
+		return "null"
+	}
+	return data
+}
+
+func (this *PlanService) composePlan(plan *models.Plan) *pb.Plan {
+	return &pb.Plan{
+		Id:                          int64(plan.Id),
+		IsOn:                        plan.IsOn,
+		Name:                        plan.Name,
+		Description:                 plan.Description,
+		ClusterId:                   int64(plan.ClusterId),
+		TrafficLimitJSON:            plan.TrafficLimit,
+		BandwidthLimitPerNodeJSON:   plan.BandwidthLimitPerNode,
+		HasFullFeatures:             plan.HasFullFeatures,
+		FeaturesJSON:                plan.Features,
+		PriceType:                   plan.PriceType,
+		TrafficPriceJSON:            plan.TrafficPrice,
+		BandwidthPriceJSON:          plan.BandwidthPrice,
+		MonthlyPrice:                plan.MonthlyPrice,
+		SeasonallyPrice:             plan.SeasonallyPrice,
+		YearlyPrice:                 plan.YearlyPrice,
+		TotalServers:                int32(plan.TotalServers),
+		TotalServerNamesPerServer:   int32(plan.TotalServerNamesPerServer),
+		TotalServerNames:            int32(plan.TotalServerNames),
+		DailyRequests:               int64(plan.DailyRequests),
+		MonthlyRequests:             int64(plan.MonthlyRequests),
+		DailyWebsocketConnections:   int64(plan.DailyWebsocketConnections),
+		MonthlyWebsocketConnections: int64(plan.MonthlyWebsocketConnections),
+		MaxUploadSizeJSON:           plan.MaxUploadSize,
+		QuotaJSON:                   plan.Quota,
+	}
+}
+
+func (this *PlanService) composeBasicPlan(plan *models.Plan) *pb.Plan {
+	return &pb.Plan{
+		Id:              int64(plan.Id),
+		IsOn:            plan.IsOn,
+		Name:            plan.Name,
+		Description:     plan.Description,
+		ClusterId:       int64(plan.ClusterId),
+		PriceType:       plan.PriceType,
+		MonthlyPrice:    plan.MonthlyPrice,
+		SeasonallyPrice: plan.SeasonallyPrice,
+		YearlyPrice:     plan.YearlyPrice,
+	}
 }
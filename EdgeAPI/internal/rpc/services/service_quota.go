@@ -0,0 +1,133 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/quotas"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+)
+
+// QuotaService 用户配额服务
+type QuotaService struct {
+	BaseService
+}
+
+// FindUserQuotas 查询用户所有配额使用情况
+func (this *QuotaService) FindUserQuotas(ctx context.Context, req *pb.FindUserQuotasRequest) (*pb.FindUserQuotasResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if userId > 0 {
+		req.UserId = userId
+	}
+	if req.UserId <= 0 {
+		return nil, errors.New("invalid 'userId'")
+	}
+
+	var tx = this.NullTx()
+	userQuotas, err := quotas.FindUserQuotas(tx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbUserQuotas = []*pb.UserQuota{}
+	for _, quota := range userQuotas {
+		pbUserQuotas = append(pbUserQuotas, this.convertQuotaToPB(quota))
+	}
+	return &pb.FindUserQuotasResponse{UserQuotas: pbUserQuotas}, nil
+}
+
+// CheckUserQuota 检查用户是否还可以创建某类对象
+func (this *QuotaService) CheckUserQuota(ctx context.Context, req *pb.CheckUserQuotaRequest) (*pb.CheckUserQuotaResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if userId > 0 {
+		req.UserId = userId
+	}
+	if req.UserId <= 0 {
+		return nil, errors.New("invalid 'userId'")
+	}
+	if !userconfigs.IsValidUserQuotaItem(req.Item) {
+		return nil, errors.New("invalid quota item '" + req.Item + "'")
+	}
+	if req.Increment <= 0 {
+		req.Increment = 1
+	}
+
+	var tx = this.NullTx()
+	quota, err := quotas.FindUserQuota(tx, req.UserId, req.Item)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CheckUserQuotaResponse{
+		IsAllowed: quota.IsAllowed(req.Increment),
+		UserQuota: this.convertQuotaToPB(quota),
+	}, nil
+}
+
+// FindGlobalUserQuotaConfig 查询全局用户配额设置
+func (this *QuotaService) FindGlobalUserQuotaConfig(ctx context.Context, req *pb.FindGlobalUserQuotaConfigRequest) (*pb.FindGlobalUserQuotaConfigResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	config, err := models.SharedSysSettingDAO.ReadUserQuotaConfig(tx)
+	if err != nil {
+		return nil, err
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FindGlobalUserQuotaConfigResponse{QuotaJSON: configJSON}, nil
+}
+
+// UpdateGlobalUserQuotaConfig 修改全局用户配额设置
+func (this *QuotaService) UpdateGlobalUserQuotaConfig(ctx context.Context, req *pb.UpdateGlobalUserQuotaConfigRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var config = userconfigs.NewUserQuotaConfig()
+	err = json.Unmarshal(req.QuotaJSON, config)
+	if err != nil {
+		return nil, errors.New("decode 'quotaJSON' failed: " + err.Error())
+	}
+	err = config.Validate()
+	if err != nil {
+		return nil, err
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	err = models.SharedSysSettingDAO.UpdateSetting(tx, systemconfigs.SettingCodeUserQuotaConfig, configJSON)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+func (this *QuotaService) convertQuotaToPB(quota *quotas.Quota) *pb.UserQuota {
+	return &pb.UserQuota{
+		Item:     quota.Item,
+		MaxCount: quota.MaxCount,
+		Count:    quota.Count,
+		Source:   quota.Source,
+	}
+}
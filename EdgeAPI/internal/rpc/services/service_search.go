@@ -0,0 +1,49 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/search"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+)
+
+// SearchService 全局搜索服务
+type SearchService struct {
+	BaseService
+}
+
+// SearchAll 在网站、证书、节点和用户中搜索关键词
+func (this *SearchService) SearchAll(ctx context.Context, req *pb.SearchAllRequest) (*pb.SearchAllResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resultType := range req.Types {
+		if !search.IsValidResultType(resultType) {
+			return nil, errors.New("invalid type '" + resultType + "'")
+		}
+	}
+
+	results, err := search.Search(this.NullTx(), req.Keyword, req.Types, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbResults = []*pb.SearchAllResponse_SearchResult{}
+	for _, result := range results {
+		pbResults = append(pbResults, &pb.SearchAllResponse_SearchResult{
+			Type:         result.Type,
+			Id:           result.Id,
+			Title:        result.Title,
+			Subtitle:     result.Subtitle,
+			MatchedField: result.MatchedField,
+			MatchedValue: result.MatchedValue,
+			Score:        int32(result.Score),
+		})
+	}
+	return &pb.SearchAllResponse{SearchResults: pbResults}, nil
+}
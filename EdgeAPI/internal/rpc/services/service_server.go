@@ -13,12 +13,15 @@ import (
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models/clients"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models/dns"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/fieldmask"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/quotas"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils"
 	"github.com/TeaOSLab/EdgeAPI/internal/utils/domainutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
 	"github.com/iwind/TeaGo/dbs"
 	"github.com/iwind/TeaGo/lists"
 	"github.com/iwind/TeaGo/maps"
@@ -182,6 +185,20 @@ func (this *ServerService) CreateServer(ctx context.Context, req *pb.CreateServe
 		}
 	}
 
+	// 外部ID
+	err := this.CheckExternalId(req.ExternalId, "server", func(externalId string) (int64, error) {
+		return models.SharedServerDAO.FindEnabledServerIdWithExternalId(tx, 0, externalId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 用户配额
+	err = quotas.Check(tx, req.UserId, userconfigs.UserQuotaItemServers, 1)
+	if err != nil {
+		return nil, err
+	}
+
 	// 自动创建WebId
 	if (req.Type == serverconfigs.ServerTypeHTTPWeb || req.Type == serverconfigs.ServerTypeHTTPProxy) && req.WebId <= 0 {
 		webId, err := models.SharedHTTPWebDAO.CreateWeb(tx, adminId, userId, nil)
@@ -196,6 +213,13 @@ func (this *ServerService) CreateServer(ctx context.Context, req *pb.CreateServe
 		return nil, err
 	}
 
+	if len(req.ExternalId) > 0 {
+		err = models.SharedServerDAO.UpdateServerExternalId(tx, serverId, req.ExternalId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &pb.CreateServerResponse{ServerId: serverId}, nil
 }
 
@@ -237,6 +261,12 @@ func (this *ServerService) CreateBasicHTTPServer(ctx context.Context, req *pb.Cr
 		return nil, errors.New("invalid 'nodeClusterId'")
 	}
 
+	// 用户配额
+	err = quotas.Check(tx, req.UserId, userconfigs.UserQuotaItemServers, 1)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(req.Domains) == 0 {
 		return nil, errors.New("'domains' should not be empty")
 	}
@@ -507,6 +537,12 @@ func (this *ServerService) CreateBasicTCPServer(ctx context.Context, req *pb.Cre
 		return nil, errors.New("invalid 'nodeClusterId'")
 	}
 
+	// 用户配额
+	err = quotas.Check(tx, req.UserId, userconfigs.UserQuotaItemServers, 1)
+	if err != nil {
+		return nil, err
+	}
+
 	// 检查用户权限
 	if userId > 0 {
 		features, err := models.SharedUserDAO.FindUserFeatures(tx, userId)
@@ -705,6 +741,75 @@ func (this *ServerService) CreateBasicTCPServer(ctx context.Context, req *pb.Cre
 	return &pb.CreateBasicTCPServerResponse{ServerId: serverId}, nil
 }
 
+// CloneServer 复制网站，使用新的域名
+func (this *ServerService) CloneServer(ctx context.Context, req *pb.CloneServerRequest) (*pb.CloneServerResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	if userId > 0 {
+		err = models.SharedServerDAO.CheckUserServer(tx, userId, req.ServerId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	clusterId, err := models.SharedServerDAO.FindServerClusterId(tx, req.ServerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 域名
+	var serverNames = []*serverconfigs.ServerNameConfig{}
+	if len(req.ServerNamesJSON) > 0 {
+		err = json.Unmarshal(req.ServerNamesJSON, &serverNames)
+		if err != nil {
+			return nil, errors.New("decode 'serverNamesJSON' failed: " + err.Error())
+		}
+	}
+	if len(serverNames) == 0 {
+		return nil, errors.New("'serverNamesJSON' should not be empty")
+	}
+	for _, serverName := range serverconfigs.PlainServerNames(serverNames) {
+		existServerName, err := models.SharedServerDAO.ExistServerNameInCluster(tx, clusterId, serverName, 0, true)
+		if err != nil {
+			return nil, err
+		}
+		if existServerName {
+			return nil, errors.New("domain '" + serverName + "' already created by other server")
+		}
+	}
+
+	var name = req.Name
+	if len(name) == 0 {
+		name = serverNames[0].FirstName()
+	}
+
+	// 是否需要审核
+	var isAuditing = false
+	var serverNamesJSON = req.ServerNamesJSON
+	var auditingServerNamesJSON = []byte("[]")
+	if userId > 0 && clusterId > 0 {
+		globalServerConfig, err := models.SharedNodeClusterDAO.FindClusterGlobalServerConfig(tx, clusterId)
+		if err != nil {
+			return nil, err
+		}
+		if globalServerConfig != nil && globalServerConfig.HTTPAll.DomainAuditingIsOn {
+			isAuditing = true
+			serverNamesJSON = []byte("[]")
+			auditingServerNamesJSON = req.ServerNamesJSON
+		}
+	}
+
+	serverId, err := models.SharedServerDAO.CloneServer(tx, req.ServerId, name, serverNamesJSON, isAuditing, auditingServerNamesJSON)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CloneServerResponse{ServerId: serverId}, nil
+}
+
 // AddServerOrigin 为网站添加源站
 func (this *ServerService) AddServerOrigin(ctx context.Context, req *pb.AddServerOriginRequest) (*pb.RPCSuccess, error) {
 	_, userId, err := this.ValidateAdminAndUser(ctx, true)
@@ -940,7 +1045,10 @@ func (this *ServerService) UpdateServerBasic(ctx context.Context, req *pb.Update
 		return nil, errors.New("can not find server")
 	}
 
-	err = models.SharedServerDAO.UpdateServerBasic(tx, req.ServerId, req.Name, req.Description, req.NodeClusterId, req.KeepOldConfigs, req.IsOn, req.ServerGroupIds)
+	// 修改配置
+	err = this.updateServerWithVersion(req.ServerId, req.Version, func(tx *dbs.Tx) error {
+		return models.SharedServerDAO.UpdateServerBasic(tx, req.ServerId, req.Name, req.Description, req.NodeClusterId, req.KeepOldConfigs, req.IsOn, req.ServerGroupIds)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1022,6 +1130,21 @@ func (this *ServerService) UpdateServerIsOn(ctx context.Context, req *pb.UpdateS
 	return this.Success()
 }
 
+// 在同一个事务中增加网站版本号并修改网站
+// version>0 时如果网站已经被其他人修改，则返回版本冲突错误，不做任何修改
+func (this *ServerService) updateServerWithVersion(serverId int64, version int64, update func(tx *dbs.Tx) error) error {
+	return this.RunTx(func(tx *dbs.Tx) error {
+		ok, err := models.SharedServerDAO.IncreaseServerVersion(tx, serverId, version)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return this.VersionConflictError()
+		}
+		return update(tx)
+	})
+}
+
 // UpdateServerHTTP 修改HTTP服务
 func (this *ServerService) UpdateServerHTTP(ctx context.Context, req *pb.UpdateServerHTTPRequest) (*pb.RPCSuccess, error) {
 	// 校验请求
@@ -1040,7 +1163,9 @@ func (this *ServerService) UpdateServerHTTP(ctx context.Context, req *pb.UpdateS
 	}
 
 	// 修改配置
-	err = models.SharedServerDAO.UpdateServerHTTP(tx, req.ServerId, req.HttpJSON)
+	err = this.updateServerWithVersion(req.ServerId, req.Version, func(tx *dbs.Tx) error {
+		return models.SharedServerDAO.UpdateServerHTTP(tx, req.ServerId, req.HttpJSON)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1066,7 +1191,9 @@ func (this *ServerService) UpdateServerHTTPS(ctx context.Context, req *pb.Update
 	}
 
 	// 修改配置
-	err = models.SharedServerDAO.UpdateServerHTTPS(tx, req.ServerId, req.HttpsJSON)
+	err = this.updateServerWithVersion(req.ServerId, req.Version, func(tx *dbs.Tx) error {
+		return models.SharedServerDAO.UpdateServerHTTPS(tx, req.ServerId, req.HttpsJSON)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1089,10 +1216,10 @@ func (this *ServerService) UpdateServerTCP(ctx context.Context, req *pb.UpdateSe
 		}
 	}
 
-	var tx = this.NullTx()
-
 	// 修改配置
-	err = models.SharedServerDAO.UpdateServerTCP(tx, req.ServerId, req.TcpJSON)
+	err = this.updateServerWithVersion(req.ServerId, req.Version, func(tx *dbs.Tx) error {
+		return models.SharedServerDAO.UpdateServerTCP(tx, req.ServerId, req.TcpJSON)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1115,10 +1242,10 @@ func (this *ServerService) UpdateServerTLS(ctx context.Context, req *pb.UpdateSe
 		}
 	}
 
-	var tx = this.NullTx()
-
 	// 修改配置
-	err = models.SharedServerDAO.UpdateServerTLS(tx, req.ServerId, req.TlsJSON)
+	err = this.updateServerWithVersion(req.ServerId, req.Version, func(tx *dbs.Tx) error {
+		return models.SharedServerDAO.UpdateServerTLS(tx, req.ServerId, req.TlsJSON)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1145,10 +1272,10 @@ func (this *ServerService) UpdateServerUDP(ctx context.Context, req *pb.UpdateSe
 		return nil, errors.New("invalid serverId")
 	}
 
-	var tx = this.NullTx()
-
 	// 修改配置
-	err = models.SharedServerDAO.UpdateServerUDP(tx, req.ServerId, req.UdpJSON)
+	err = this.updateServerWithVersion(req.ServerId, req.Version, func(tx *dbs.Tx) error {
+		return models.SharedServerDAO.UpdateServerUDP(tx, req.ServerId, req.UdpJSON)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1200,7 +1327,9 @@ func (this *ServerService) UpdateServerReverseProxy(ctx context.Context, req *pb
 	}
 
 	// 修改配置
-	err = models.SharedServerDAO.UpdateServerReverseProxyRef(tx, req.ServerId, req.ReverseProxyJSON)
+	err = this.updateServerWithVersion(req.ServerId, req.Version, func(tx *dbs.Tx) error {
+		return models.SharedServerDAO.UpdateServerReverseProxyRef(tx, req.ServerId, req.ReverseProxyJSON)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1259,6 +1388,14 @@ func (this *ServerService) UpdateServerNames(ctx context.Context, req *pb.Update
 
 	var tx = this.NullTx()
 
+	// 检查用户
+	if userId > 0 {
+		err = models.SharedServerDAO.CheckUserServer(tx, userId, req.ServerId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// 转换为小写
 	var serverNameConfigs = []*serverconfigs.ServerNameConfig{}
 	if len(req.ServerNamesJSON) > 0 {
@@ -1283,14 +1420,9 @@ func (this *ServerService) UpdateServerNames(ctx context.Context, req *pb.Update
 		return nil, err
 	}
 
-	// 检查用户
+	// 是否需要审核
+	var requireAuditing = false
 	if userId > 0 {
-		err = models.SharedServerDAO.CheckUserServer(tx, userId, req.ServerId)
-		if err != nil {
-			return nil, err
-		}
-
-		// 是否需要审核
 		clusterId, err := models.SharedServerDAO.FindServerClusterId(tx, req.ServerId)
 		if err != nil {
 			return nil, err
@@ -1300,27 +1432,26 @@ func (this *ServerService) UpdateServerNames(ctx context.Context, req *pb.Update
 			if err != nil {
 				return nil, err
 			}
-			if globalServerConfig != nil && globalServerConfig.HTTPAll.DomainAuditingIsOn {
-				err = models.SharedServerDAO.UpdateAuditingServerNames(tx, req.ServerId, true, req.ServerNamesJSON)
-				if err != nil {
-					return nil, err
-				}
-
-				// 发送审核通知
-				err = models.SharedMessageDAO.CreateMessage(tx, 0, 0, models.MessageTypeServerNamesRequireAuditing, models.MessageLevelWarning, "有新的网站域名需要审核", "有新的网站域名需要审核", maps.Map{
-					"serverId": req.ServerId,
-				}.AsJSON())
-				if err != nil {
-					return nil, err
-				}
-
-				return this.Success()
-			}
+			requireAuditing = globalServerConfig != nil && globalServerConfig.HTTPAll.DomainAuditingIsOn
 		}
 	}
 
 	// 修改配置
-	err = models.SharedServerDAO.UpdateServerNames(tx, req.ServerId, req.ServerNamesJSON)
+	err = this.updateServerWithVersion(req.ServerId, req.Version, func(tx *dbs.Tx) error {
+		if !requireAuditing {
+			return models.SharedServerDAO.UpdateServerNames(tx, req.ServerId, req.ServerNamesJSON)
+		}
+
+		err := models.SharedServerDAO.UpdateAuditingServerNames(tx, req.ServerId, true, req.ServerNamesJSON)
+		if err != nil {
+			return err
+		}
+
+		// 发送审核通知
+		return models.SharedMessageDAO.CreateMessage(tx, 0, 0, models.MessageTypeServerNamesRequireAuditing, models.MessageLevelWarning, "有新的网站域名需要审核", "有新的网站域名需要审核", maps.Map{
+			"serverId": req.ServerId,
+		}.AsJSON())
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1830,6 +1961,8 @@ func (this *ServerService) FindEnabledServer(ctx context.Context, req *pb.FindEn
 		ServerGroups: pbGroups,
 		UserId:       int64(server.UserId),
 		User:         pbUser,
+		Version:      int64(server.Version),
+		ExternalId:   server.ExternalId,
 	}}, nil
 }
 
@@ -2867,7 +3000,7 @@ func (this *ServerService) PurgeServerCache(ctx context.Context, req *pb.PurgeSe
 
 		var countKeys = 0
 
-		for _, key := range req.Keys {
+		for _, key := range pbTask.Keys {
 			if len(key) == 0 {
 				continue
 			}
@@ -2913,6 +3046,8 @@ func (this *ServerService) PurgeServerCache(ctx context.Context, req *pb.PurgeSe
 		if err != nil {
 			return nil, err
 		}
+
+		purgeResponse.HttpCacheTaskIds = append(purgeResponse.HttpCacheTaskIds, taskId)
 	}
 
 	purgeResponse.IsOk = true
@@ -3174,6 +3309,12 @@ func (this *ServerService) ComposeServerConfig(ctx context.Context, req *pb.Comp
 	if err != nil {
 		return nil, err
 	}
+
+	// 字段掩码
+	configJSON, err = fieldmask.Parse(req.FieldMask).PruneJSON(configJSON)
+	if err != nil {
+		return nil, err
+	}
 	return &pb.ComposeServerConfigResponse{ServerConfigJSON: configJSON}, nil
 }
 
@@ -3365,3 +3506,212 @@ func (this *ServerService) FindServerAuditingPrompt(ctx context.Context, req *pb
 		PromptText: "",
 	}, nil
 }
+
+// CountAllServerChangeLogs 计算网站变更记录数量
+func (this *ServerService) CountAllServerChangeLogs(ctx context.Context, req *pb.CountAllServerChangeLogsRequest) (*pb.RPCCountResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	if userId > 0 {
+		err = models.SharedServerDAO.CheckUserServer(tx, userId, req.ServerId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	count, err := models.SharedServerChangeLogDAO.CountServerLogs(tx, req.ServerId)
+	if err != nil {
+		return nil, err
+	}
+	return this.SuccessCount(count)
+}
+
+// ListServerChangeLogs 列出单页网站变更记录
+func (this *ServerService) ListServerChangeLogs(ctx context.Context, req *pb.ListServerChangeLogsRequest) (*pb.ListServerChangeLogsResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	if userId > 0 {
+		err = models.SharedServerDAO.CheckUserServer(tx, userId, req.ServerId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logs, err := models.SharedServerChangeLogDAO.ListServerLogs(tx, req.ServerId, req.Offset, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	var pbLogs = []*pb.ServerChangeLog{}
+	for _, log := range logs {
+		var pbChanges = []*pb.ServerChangeLog_Change{}
+		for _, change := range log.DecodeChanges() {
+			pbChanges = append(pbChanges, &pb.ServerChangeLog_Change{
+				Path:     change.Path,
+				Label:    change.Label,
+				Action:   change.Action,
+				OldValue: change.OldValue,
+				NewValue: change.NewValue,
+			})
+		}
+		pbLogs = append(pbLogs, &pb.ServerChangeLog{
+			Id:        int64(log.Id),
+			ServerId:  int64(log.ServerId),
+			Summary:   log.Summary,
+			Changes:   pbChanges,
+			CreatedAt: int64(log.CreatedAt),
+		})
+	}
+	return &pb.ListServerChangeLogsResponse{ServerChangeLogs: pbLogs}, nil
+}
+
+// SearchServers 搜索网站
+func (this *ServerService) SearchServers(ctx context.Context, req *pb.SearchServersRequest) (*pb.SearchServersResponse, error) {
+	adminId, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if adminId > 0 && req.UserId > 0 {
+		userId = req.UserId
+	}
+
+	var size = req.Size
+	if size <= 0 {
+		size = 100
+	} else if size > 1000 {
+		size = 1000
+	}
+
+	var tx = this.NullTx()
+	keywords, err := models.SharedServerSearchKeywordDAO.SearchServers(tx, userId, req.NodeClusterId, req.Keyword, req.Types, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbServerMap = map[int64]*pb.Server{} // serverId => *pb.Server
+	var clusterNameMap = map[int64]string{}  // clusterId => name
+	var pbMatches = []*pb.SearchServersResponse_Match{}
+	for _, keyword := range keywords {
+		var serverId = int64(keyword.ServerId)
+		pbServer, ok := pbServerMap[serverId]
+		if !ok {
+			server, err := models.SharedServerDAO.FindEnabledServerBasic(tx, serverId)
+			if err != nil {
+				return nil, err
+			}
+			if server != nil {
+				var clusterId = int64(server.ClusterId)
+				clusterName, ok := clusterNameMap[clusterId]
+				if !ok && clusterId > 0 {
+					clusterName, err = models.SharedNodeClusterDAO.FindNodeClusterName(tx, clusterId)
+					if err != nil {
+						return nil, err
+					}
+					clusterNameMap[clusterId] = clusterName
+				}
+
+				pbServer = &pb.Server{
+					Id:   serverId,
+					Name: server.Name,
+					IsOn: server.IsOn,
+					NodeCluster: &pb.NodeCluster{
+						Id:   clusterId,
+						Name: clusterName,
+					},
+				}
+			}
+			pbServerMap[serverId] = pbServer
+		}
+		if pbServer == nil {
+			continue
+		}
+
+		pbMatches = append(pbMatches, &pb.SearchServersResponse_Match{
+			Type:    keyword.Type,
+			Keyword: keyword.Keyword,
+			Server:  pbServer,
+		})
+	}
+	return &pb.SearchServersResponse{Matches: pbMatches}, nil
+}
+
+// LookupServer 根据ID、外部ID或者名称查找网站
+func (this *ServerService) LookupServer(ctx context.Context, req *pb.LookupServerRequest) (*pb.LookupServerResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if userId > 0 {
+		req.UserId = userId
+	}
+
+	var tx = this.NullTx()
+	var serverId = req.ServerId
+	if serverId <= 0 {
+		if len(req.ExternalId) > 0 {
+			serverId, err = models.SharedServerDAO.FindEnabledServerIdWithExternalId(tx, req.UserId, req.ExternalId)
+			if err != nil {
+				return nil, err
+			}
+		} else if len(req.Name) > 0 {
+			serverIds, err := models.SharedServerDAO.FindEnabledServerIdsWithName(tx, req.UserId, req.Name, 2)
+			if err != nil {
+				return nil, err
+			}
+			if len(serverIds) > 1 {
+				return nil, errors.New("found multiple servers with name '" + req.Name + "', please lookup with 'serverId' or 'externalId' instead")
+			}
+			if len(serverIds) == 1 {
+				serverId = serverIds[0]
+			}
+		} else {
+			return nil, errors.New("'serverId', 'externalId' or 'name' is required")
+		}
+	}
+	if serverId <= 0 {
+		return &pb.LookupServerResponse{}, nil
+	}
+
+	server, err := models.SharedServerDAO.FindEnabledServer(tx, serverId)
+	if err != nil {
+		return nil, err
+	}
+	if server == nil || (req.UserId > 0 && int64(server.UserId) != req.UserId) {
+		return &pb.LookupServerResponse{}, nil
+	}
+
+	return &pb.LookupServerResponse{
+		ServerId:   int64(server.Id),
+		Name:       server.Name,
+		ExternalId: server.ExternalId,
+		Version:    int64(server.Version),
+	}, nil
+}
+
+// UpdateServerExternalId 设置网站外部ID
+func (this *ServerService) UpdateServerExternalId(ctx context.Context, req *pb.UpdateServerExternalIdRequest) (*pb.RPCSuccess, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	if userId > 0 {
+		err = models.SharedServerDAO.CheckUserServer(tx, userId, req.ServerId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = models.SharedServerDAO.UpdateServerExternalId(tx, req.ServerId, req.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
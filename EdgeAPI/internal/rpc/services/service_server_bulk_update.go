@@ -0,0 +1,185 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// ServerBulkUpdateService 服务批量修改服务
+type ServerBulkUpdateService struct {
+	BaseService
+}
+
+// PreviewServerBulkUpdate 预览批量修改的内容
+func (this *ServerBulkUpdateService) PreviewServerBulkUpdate(ctx context.Context, req *pb.PreviewServerBulkUpdateRequest) (*pb.PreviewServerBulkUpdateResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := this.decodePatch(req.PatchJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	serverIds, err := models.SharedServerBulkUpdateDAO.FindServerIdsWithFilter(tx, this.composeFilter(req.ServerBulkFilter))
+	if err != nil {
+		return nil, err
+	}
+	changes, err := models.SharedServerBulkUpdateDAO.PreviewBulkPatch(tx, serverIds, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PreviewServerBulkUpdateResponse{
+		CountServers:      int64(len(serverIds)),
+		ServerBulkChanges: this.composeChanges(changes),
+	}, nil
+}
+
+// CreateServerBulkUpdate 执行批量修改
+func (this *ServerBulkUpdateService) CreateServerBulkUpdate(ctx context.Context, req *pb.CreateServerBulkUpdateRequest) (*pb.CreateServerBulkUpdateResponse, error) {
+	adminId, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := this.decodePatch(req.PatchJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var bulkUpdateId int64
+	var changes []*models.ServerBulkChange
+	err = this.RunTx(func(tx *dbs.Tx) error {
+		serverIds, err := models.SharedServerBulkUpdateDAO.FindServerIdsWithFilter(tx, this.composeFilter(req.ServerBulkFilter))
+		if err != nil {
+			return err
+		}
+		bulkUpdateId, changes, err = models.SharedServerBulkUpdateDAO.ApplyBulkPatch(tx, adminId, serverIds, patch)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateServerBulkUpdateResponse{
+		ServerBulkUpdateId: bulkUpdateId,
+		ServerBulkChanges:  this.composeChanges(changes),
+	}, nil
+}
+
+// RollbackServerBulkUpdate 回滚批量修改
+func (this *ServerBulkUpdateService) RollbackServerBulkUpdate(ctx context.Context, req *pb.RollbackServerBulkUpdateRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = this.RunTx(func(tx *dbs.Tx) error {
+		return models.SharedServerBulkUpdateDAO.RollbackBulkUpdate(tx, req.ServerBulkUpdateId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// CountServerBulkUpdates 计算批量修改记录数量
+func (this *ServerBulkUpdateService) CountServerBulkUpdates(ctx context.Context, req *pb.CountServerBulkUpdatesRequest) (*pb.RPCCountResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := models.SharedServerBulkUpdateDAO.CountBulkUpdates(this.NullTx())
+	if err != nil {
+		return nil, err
+	}
+	return this.SuccessCount(count)
+}
+
+// ListServerBulkUpdates 列出单页批量修改记录
+func (this *ServerBulkUpdateService) ListServerBulkUpdates(ctx context.Context, req *pb.ListServerBulkUpdatesRequest) (*pb.ListServerBulkUpdatesResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bulkUpdates, err := models.SharedServerBulkUpdateDAO.ListBulkUpdates(this.NullTx(), req.Offset, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbBulkUpdates = []*pb.ServerBulkUpdate{}
+	for _, bulkUpdate := range bulkUpdates {
+		var serverIds = []int64{}
+		if models.IsNotNull(bulkUpdate.ServerIds) {
+			err = json.Unmarshal(bulkUpdate.ServerIds, &serverIds)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		pbBulkUpdates = append(pbBulkUpdates, &pb.ServerBulkUpdate{
+			Id:           int64(bulkUpdate.Id),
+			AdminId:      int64(bulkUpdate.AdminId),
+			PatchJSON:    bulkUpdate.Patch,
+			ServerIds:    serverIds,
+			CountServers: int32(bulkUpdate.CountServers),
+			IsRolledBack: bulkUpdate.IsRolledBack,
+			RolledBackAt: int64(bulkUpdate.RolledBackAt),
+			CreatedAt:    int64(bulkUpdate.CreatedAt),
+		})
+	}
+	return &pb.ListServerBulkUpdatesResponse{ServerBulkUpdates: pbBulkUpdates}, nil
+}
+
+// 解析修改内容
+func (this *ServerBulkUpdateService) decodePatch(patchJSON []byte) (*models.ServerBulkPatch, error) {
+	var patch = &models.ServerBulkPatch{}
+	err := json.Unmarshal(patchJSON, patch)
+	if err != nil {
+		return nil, errors.New("decode patch failed: " + err.Error())
+	}
+	err = patch.Validate()
+	if err != nil {
+		return nil, errors.New("validate patch failed: " + err.Error())
+	}
+	return patch, nil
+}
+
+func (this *ServerBulkUpdateService) composeFilter(pbFilter *pb.ServerBulkFilter) *models.ServerBulkFilter {
+	if pbFilter == nil {
+		return nil
+	}
+	return &models.ServerBulkFilter{
+		ServerIds: pbFilter.ServerIds,
+		ClusterId: pbFilter.NodeClusterId,
+		GroupId:   pbFilter.ServerGroupId,
+		UserId:    pbFilter.UserId,
+		Keyword:   pbFilter.Keyword,
+	}
+}
+
+func (this *ServerBulkUpdateService) composeChanges(changes []*models.ServerBulkChange) []*pb.ServerBulkChange {
+	var pbChanges = []*pb.ServerBulkChange{}
+	for _, change := range changes {
+		pbChanges = append(pbChanges, &pb.ServerBulkChange{
+			ServerId:   change.ServerId,
+			ServerName: change.ServerName,
+			Item:       change.Item,
+			OldValue:   change.OldValue,
+			NewValue:   change.NewValue,
+		})
+	}
+	return pbChanges
+}
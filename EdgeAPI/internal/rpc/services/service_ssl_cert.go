@@ -4,9 +4,14 @@ import (
 	"context"
 	"encoding/json"
 
+	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/TeaOSLab/EdgeAPI/internal/certbundle"
+	"github.com/TeaOSLab/EdgeAPI/internal/certchain"
+	"github.com/TeaOSLab/EdgeAPI/internal/certgen"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models/acme"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
 	"github.com/iwind/TeaGo/dbs"
@@ -40,7 +45,102 @@ func (this *SSLCertService) CreateSSLCert(ctx context.Context, req *pb.CreateSSL
 		return nil, errors.New("invalid TimeEndAt")
 	}
 
-	certId, err := models.SharedSSLCertDAO.CreateCert(tx, adminId, userId, req.IsOn, req.Name, req.Description, req.ServerName, req.IsCA, req.CertData, req.KeyData, req.TimeBeginAt, req.TimeEndAt, req.DnsNames, req.CommonNames)
+	// 证书链
+	req.CertData, err = this.completeCertChain(ctx, req.IsCA, req.CertData)
+	if err != nil {
+		return nil, err
+	}
+
+	// 标签
+	tags, err := sslconfigs.NormalizeSSLCertTags(req.Tags)
+	if err != nil {
+		return nil, errors.New("invalid 'tags': " + err.Error())
+	}
+
+	// 私钥存储位置
+	if !sslconfigs.IsValidSSLCertKeyStorage(req.KeyStorage) {
+		return nil, errors.New("invalid 'keyStorage': " + req.KeyStorage)
+	}
+
+	// 外部ID
+	err = this.CheckExternalId(req.ExternalId, "cert", func(externalId string) (int64, error) {
+		return models.SharedSSLCertDAO.FindEnabledCertIdWithExternalId(tx, 0, externalId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 已经上传过相同的证书时直接返回已有的证书
+	// 指定了外部ID时调用方需要一个独立的证书，所以不复用已有证书
+	if len(req.ExternalId) == 0 {
+		duplicatedCertId, err := models.SharedSSLCertDAO.FindDuplicatedCertId(tx, userId, req.IsCA, req.KeyStorage, req.CertData)
+		if err != nil {
+			return nil, err
+		}
+		if duplicatedCertId > 0 {
+			return &pb.CreateSSLCertResponse{
+				SslCertId:    duplicatedCertId,
+				IsDuplicated: true,
+			}, nil
+		}
+	}
+
+	if req.KeyStorage == sslconfigs.SSLCertKeyStorageNode {
+		if req.IsCA {
+			return nil, errors.New("CA cert does not need key storage")
+		}
+		if len(req.KeyData) == 0 {
+			return nil, errors.New("'keyData' should not be empty")
+		}
+
+		var certId int64
+		err = this.RunTx(func(tx *dbs.Tx) error {
+			// 数据库中不保存私钥
+			certId, err = models.SharedSSLCertDAO.CreateCert(tx, adminId, userId, req.IsOn, req.Name, req.Description, req.ServerName, req.IsCA, req.CertData, nil, req.TimeBeginAt, req.TimeEndAt, req.DnsNames, req.CommonNames)
+			if err != nil {
+				return err
+			}
+			err = models.SharedSSLCertDAO.UpdateCertKeyStorage(tx, certId, req.KeyStorage)
+			if err != nil {
+				return err
+			}
+			if len(tags) > 0 {
+				err = models.SharedSSLCertDAO.UpdateCertTags(tx, certId, tags)
+				if err != nil {
+					return err
+				}
+			}
+			if len(req.ExternalId) > 0 {
+				err = models.SharedSSLCertDAO.UpdateCertExternalId(tx, certId, req.ExternalId)
+				if err != nil {
+					return err
+				}
+			}
+			return models.SharedSSLCertDAO.SealCertKeyForNodes(tx, certId, userId, req.CertData, req.KeyData)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &pb.CreateSSLCertResponse{SslCertId: certId}, nil
+	}
+
+	var certId int64
+	err = this.RunTx(func(tx *dbs.Tx) error {
+		certId, err = models.SharedSSLCertDAO.CreateCert(tx, adminId, userId, req.IsOn, req.Name, req.Description, req.ServerName, req.IsCA, req.CertData, req.KeyData, req.TimeBeginAt, req.TimeEndAt, req.DnsNames, req.CommonNames)
+		if err != nil {
+			return err
+		}
+		if len(tags) > 0 {
+			err = models.SharedSSLCertDAO.UpdateCertTags(tx, certId, tags)
+			if err != nil {
+				return err
+			}
+		}
+		if len(req.ExternalId) > 0 {
+			return models.SharedSSLCertDAO.UpdateCertExternalId(tx, certId, req.ExternalId)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -64,13 +164,28 @@ func (this *SSLCertService) CreateSSLCerts(ctx context.Context, req *pb.CreateSS
 		}
 	}
 
+	// 证书链
+	for _, cert := range req.SSLCerts {
+		cert.CertData, err = this.completeCertChain(ctx, cert.IsCA, cert.CertData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var certIds = []int64{}
 	err = this.RunTx(func(tx *dbs.Tx) error {
 		for _, cert := range req.SSLCerts {
-			certId, err := models.SharedSSLCertDAO.CreateCert(tx, adminId, userId, cert.IsOn, cert.Name, cert.Description, cert.ServerName, cert.IsCA, cert.CertData, cert.KeyData, cert.TimeBeginAt, cert.TimeEndAt, cert.DnsNames, cert.CommonNames)
+			// 同一批次中先创建的证书也会被查找到
+			certId, err := models.SharedSSLCertDAO.FindDuplicatedCertId(tx, userId, cert.IsCA, sslconfigs.SSLCertKeyStorageDB, cert.CertData)
 			if err != nil {
 				return err
 			}
+			if certId <= 0 {
+				certId, err = models.SharedSSLCertDAO.CreateCert(tx, adminId, userId, cert.IsOn, cert.Name, cert.Description, cert.ServerName, cert.IsCA, cert.CertData, cert.KeyData, cert.TimeBeginAt, cert.TimeEndAt, cert.DnsNames, cert.CommonNames)
+				if err != nil {
+					return err
+				}
+			}
 			certIds = append(certIds, certId)
 		}
 		return nil
@@ -106,11 +221,96 @@ func (this *SSLCertService) UpdateSSLCert(ctx context.Context, req *pb.UpdateSSL
 		}
 	}
 
+	// 证书链
+	req.CertData, err = this.completeCertChain(ctx, req.IsCA, req.CertData)
+	if err != nil {
+		return nil, err
+	}
+
+	// 私钥存储位置
+	if !sslconfigs.IsValidSSLCertKeyStorage(req.KeyStorage) {
+		return nil, errors.New("invalid 'keyStorage': " + req.KeyStorage)
+	}
+	oldKeyStorage, err := models.SharedSSLCertDAO.FindCertKeyStorage(tx, req.SslCertId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 切换存储位置时必须重新上传私钥
+	if oldKeyStorage != req.KeyStorage && len(req.KeyData) == 0 {
+		return nil, errors.New("'keyData' should be uploaded again when changing key storage")
+	}
+
+	if req.KeyStorage == sslconfigs.SSLCertKeyStorageNode {
+		if req.IsCA {
+			return nil, errors.New("CA cert does not need key storage")
+		}
+
+		// 只更新了证书时，私钥必须和节点上已有的私钥一致
+		if len(req.KeyData) == 0 && len(req.CertData) > 0 {
+			oldCert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, req.SslCertId)
+			if err != nil {
+				return nil, err
+			}
+			if oldCert != nil {
+				oldFingerprint, err := sslconfigs.CertKeyFingerprint(oldCert.CertData)
+				if err != nil {
+					return nil, err
+				}
+				newFingerprint, err := sslconfigs.CertKeyFingerprint(req.CertData)
+				if err != nil {
+					return nil, err
+				}
+				if oldFingerprint != newFingerprint {
+					return nil, errors.New("'keyData' should be uploaded again because the public key of cert has been changed")
+				}
+			}
+		}
+
+		err = this.RunTx(func(tx *dbs.Tx) error {
+			err = models.SharedSSLCertDAO.UpdateCert(tx, req.SslCertId, req.IsOn, req.Name, req.Description, req.ServerName, req.IsCA, req.CertData, nil, req.TimeBeginAt, req.TimeEndAt, req.DnsNames, req.CommonNames)
+			if err != nil {
+				return err
+			}
+			err = models.SharedSSLCertDAO.UpdateCertKeyStorage(tx, req.SslCertId, req.KeyStorage)
+			if err != nil {
+				return err
+			}
+			if len(req.KeyData) > 0 {
+				var certData = req.CertData
+				if len(certData) == 0 {
+					cert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, req.SslCertId)
+					if err != nil {
+						return err
+					}
+					if cert == nil {
+						return errors.New("can not find cert")
+					}
+					certData = cert.CertData
+				}
+				return models.SharedSSLCertDAO.SealCertKeyForNodes(tx, req.SslCertId, userId, certData, req.KeyData)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return this.Success()
+	}
+
 	err = models.SharedSSLCertDAO.UpdateCert(tx, req.SslCertId, req.IsOn, req.Name, req.Description, req.ServerName, req.IsCA, req.CertData, req.KeyData, req.TimeBeginAt, req.TimeEndAt, req.DnsNames, req.CommonNames)
 	if err != nil {
 		return nil, err
 	}
 
+	// 从节点切换回数据库
+	if oldKeyStorage != req.KeyStorage {
+		err = models.SharedSSLCertDAO.UpdateCertKeyStorage(tx, req.SslCertId, req.KeyStorage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return this.Success()
 }
 
@@ -192,7 +392,7 @@ func (this *SSLCertService) CountSSLCerts(ctx context.Context, req *pb.CountSSLC
 		return nil, errors.New("invalid user")
 	}
 
-	count, err := models.SharedSSLCertDAO.CountCerts(tx, req.IsCA, req.IsAvailable, req.IsExpired, int64(req.ExpiringDays), req.Keyword, userId, req.Domains, req.UserOnly)
+	count, err := models.SharedSSLCertDAO.CountCerts(tx, req.IsCA, req.IsAvailable, req.IsExpired, int64(req.ExpiringDays), req.Keyword, userId, req.Domains, req.UserOnly, req.Tags)
 	if err != nil {
 		return nil, err
 	}
@@ -216,7 +416,7 @@ func (this *SSLCertService) ListSSLCerts(ctx context.Context, req *pb.ListSSLCer
 
 	var tx = this.NullTx()
 
-	certIds, err := models.SharedSSLCertDAO.ListCertIds(tx, req.IsCA, req.IsAvailable, req.IsExpired, int64(req.ExpiringDays), req.Keyword, userId, req.Domains, req.UserOnly, req.Offset, req.Size)
+	certIds, err := models.SharedSSLCertDAO.ListCertIds(tx, req.IsCA, req.IsAvailable, req.IsExpired, int64(req.ExpiringDays), req.Keyword, userId, req.Domains, req.UserOnly, req.Tags, req.Offset, req.Size)
 	if err != nil {
 		return nil, err
 	}
@@ -406,3 +606,507 @@ func (this *SSLCertService) FindSSLCertUser(ctx context.Context, req *pb.FindSSL
 		},
 	}, nil
 }
+
+// RevokeSSLCert 在证书颁发机构吊销证书
+func (this *SSLCertService) RevokeSSLCert(ctx context.Context, req *pb.RevokeSSLCertRequest) (*pb.RPCSuccess, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SslCertId <= 0 {
+		return nil, errors.New("invalid 'sslCertId'")
+	}
+	if req.Reason < 0 || !acmeutils.IsValidRevokeReason(uint(req.Reason)) {
+		return nil, errors.New("invalid revoke reason '" + types.String(req.Reason) + "'")
+	}
+
+	var tx = this.NullTx()
+
+	// 检查权限
+	if userId > 0 {
+		err = models.SharedSSLCertDAO.CheckUserCert(tx, req.SslCertId, userId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = acme.SharedACMETaskDAO.RevokeACMECert(tx, req.SslCertId, uint(req.Reason))
+	if err != nil {
+		return nil, err
+	}
+
+	return this.Success()
+}
+
+// UpdateNodeSSLCertKeyPublicKey 设置节点接收证书私钥用的公钥
+func (this *SSLCertService) UpdateNodeSSLCertKeyPublicKey(ctx context.Context, req *pb.UpdateNodeSSLCertKeyPublicKeyRequest) (*pb.RPCSuccess, error) {
+	nodeId, err := this.ValidateNode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.PublicKey) != 32 {
+		return nil, errors.New("invalid 'publicKey'")
+	}
+
+	var tx = this.NullTx()
+	err = models.SharedNodeDAO.UpdateNodeSSLKeyPublicKey(tx, nodeId, req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// FindNodeSSLCertKeys 查找当前节点等待接收的证书私钥
+func (this *SSLCertService) FindNodeSSLCertKeys(ctx context.Context, req *pb.FindNodeSSLCertKeysRequest) (*pb.FindNodeSSLCertKeysResponse, error) {
+	nodeId, err := this.ValidateNode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	keys, err := models.SharedNodeSSLCertKeyDAO.FindAllNodeKeys(tx, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbKeys = []*pb.FindNodeSSLCertKeysResponse_SSLCertKey{}
+	for _, key := range keys {
+		pbKeys = append(pbKeys, &pb.FindNodeSSLCertKeysResponse_SSLCertKey{
+			Id:            int64(key.Id),
+			Fingerprint:   key.Fingerprint,
+			SealedKeyData: key.SealedKeyData,
+		})
+	}
+	return &pb.FindNodeSSLCertKeysResponse{SslCertKeys: pbKeys}, nil
+}
+
+// ConfirmNodeSSLCertKeys 确认节点已经保存证书私钥
+func (this *SSLCertService) ConfirmNodeSSLCertKeys(ctx context.Context, req *pb.ConfirmNodeSSLCertKeysRequest) (*pb.RPCSuccess, error) {
+	nodeId, err := this.ValidateNode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	err = models.SharedNodeSSLCertKeyDAO.DeleteNodeKeys(tx, nodeId, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// FindAllSSLCertsWithFingerprint 根据SHA256指纹查找证书
+func (this *SSLCertService) FindAllSSLCertsWithFingerprint(ctx context.Context, req *pb.FindAllSSLCertsWithFingerprintRequest) (*pb.FindAllSSLCertsWithFingerprintResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint, err := sslconfigs.NormalizeCertFingerprint(req.Fingerprint)
+	if err != nil {
+		return nil, errors.New("invalid 'fingerprint': " + err.Error())
+	}
+
+	// 只有管理员才能查看已删除的证书
+	var includeDeleted = req.IncludeDeleted && userId <= 0
+
+	var tx = this.NullTx()
+	certs, err := models.SharedSSLCertDAO.FindAllCertsWithFingerprint(tx, fingerprint, userId, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbCerts = []*pb.SSLCert{}
+	for _, cert := range certs {
+		pbCerts = append(pbCerts, &pb.SSLCert{
+			Id:                  int64(cert.Id),
+			IsOn:                cert.IsOn,
+			Name:                cert.Name,
+			TimeBeginAt:         int64(cert.TimeBeginAt),
+			TimeEndAt:           int64(cert.TimeEndAt),
+			DnsNames:            cert.DecodeDNSNames(),
+			CommonNames:         cert.DecodeCommonNames(),
+			IsACME:              cert.IsACME,
+			AcmeTaskId:          int64(cert.AcmeTaskId),
+			Description:         cert.Description,
+			IsCA:                cert.IsCA,
+			ServerName:          cert.ServerName,
+			CreatedAt:           int64(cert.CreatedAt),
+			UpdatedAt:           int64(cert.UpdatedAt),
+			Fingerprint:         cert.Fingerprint,
+			IsDeleted:           cert.State != models.SSLCertStateEnabled,
+			OcspStatus:          cert.OcspStatus,
+			OcspStatusCheckedAt: int64(cert.OcspStatusCheckedAt),
+			Tags:                cert.DecodeTags(),
+		})
+	}
+	return &pb.FindAllSSLCertsWithFingerprintResponse{SslCerts: pbCerts}, nil
+}
+
+// ExportSSLCert 导出证书为PKCS#12或JKS格式
+func (this *SSLCertService) ExportSSLCert(ctx context.Context, req *pb.ExportSSLCertRequest) (*pb.ExportSSLCertResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Format != certbundle.FormatPKCS12 && req.Format != certbundle.FormatJKS {
+		return nil, errors.New("invalid 'format': " + req.Format)
+	}
+	if len(req.Password) < certbundle.MinPasswordLength {
+		return nil, errors.New("'password' should be at least " + types.String(certbundle.MinPasswordLength) + " characters")
+	}
+
+	var tx = this.NullTx()
+	if userId > 0 {
+		err = models.SharedSSLCertDAO.CheckUserCert(tx, req.SslCertId, userId)
+		if err != nil {
+			return nil, this.PermissionError()
+		}
+	}
+
+	cert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, req.SslCertId)
+	if err != nil {
+		return nil, err
+	}
+	if cert == nil {
+		return nil, errors.New("can not find cert")
+	}
+	if cert.IsCA {
+		return nil, errors.New("CA cert can not be exported")
+	}
+	if cert.KeyStorage == sslconfigs.SSLCertKeyStorageNode || len(cert.KeyData) == 0 {
+		return nil, errors.New("the private key of the cert is not stored in database")
+	}
+
+	keyData, err := cert.DecodeKeyData()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := certbundle.Encode(req.Format, cert.CertData, keyData, req.Alias, req.Password)
+	if err != nil {
+		return nil, errors.New("export failed: " + err.Error())
+	}
+
+	return &pb.ExportSSLCertResponse{
+		Data:     data,
+		Filename: "cert-" + types.String(cert.Id) + certbundle.FindFormatExt(req.Format),
+	}, nil
+}
+
+// MergeDuplicatedSSLCerts 合并重复的证书
+// 每组重复的证书中保留最早创建的证书，其他证书的引用都改为保留的证书，然后删除其他证书
+func (this *SSLCertService) MergeDuplicatedSSLCerts(ctx context.Context, req *pb.MergeDuplicatedSSLCertsRequest) (*pb.MergeDuplicatedSSLCertsResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbGroups = []*pb.MergeDuplicatedSSLCertsResponse_Group{}
+	err = this.RunTx(func(tx *dbs.Tx) error {
+		groups, err := models.SharedSSLCertDAO.FindAllDuplicatedCertGroups(tx, userId, false)
+		if err != nil {
+			return err
+		}
+
+		for _, certIds := range groups {
+			var certId = certIds[0]
+			var duplicatedCertIds = certIds[1:]
+			pbGroups = append(pbGroups, &pb.MergeDuplicatedSSLCertsResponse_Group{
+				SslCertId:            certId,
+				DuplicatedSSLCertIds: duplicatedCertIds,
+			})
+
+			if req.DryRun {
+				continue
+			}
+
+			for _, duplicatedCertId := range duplicatedCertIds {
+				err = this.mergeCert(tx, certId, duplicatedCertId)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MergeDuplicatedSSLCertsResponse{Groups: pbGroups}, nil
+}
+
+// 将重复证书的引用改为保留的证书，然后删除重复的证书
+func (this *SSLCertService) mergeCert(tx *dbs.Tx, certId int64, duplicatedCertId int64) error {
+	duplicatedCert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, duplicatedCertId)
+	if err != nil {
+		return err
+	}
+	if duplicatedCert == nil {
+		return nil
+	}
+
+	err = models.SharedSSLPolicyDAO.ReplacePolicyCert(tx, duplicatedCertId, certId)
+	if err != nil {
+		return err
+	}
+	err = models.SharedOriginDAO.ReplaceOriginCert(tx, duplicatedCertId, certId)
+	if err != nil {
+		return err
+	}
+	err = models.SharedSSLCertDeployTargetDAO.ReplaceTargetCert(tx, duplicatedCertId, certId)
+	if err != nil {
+		return err
+	}
+
+	// ACME任务续期后更新保留的证书
+	err = acme.SharedACMETaskDAO.ReplaceTaskCert(tx, duplicatedCertId, certId)
+	if err != nil {
+		return err
+	}
+	if duplicatedCert.IsACME && duplicatedCert.AcmeTaskId > 0 {
+		cert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, certId)
+		if err != nil {
+			return err
+		}
+		if cert != nil && !cert.IsACME {
+			err = models.SharedSSLCertDAO.UpdateCertACME(tx, certId, int64(duplicatedCert.AcmeTaskId))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return models.SharedSSLCertDAO.DisableSSLCert(tx, duplicatedCertId)
+}
+
+// GenerateSSLCert 生成自签名证书或者使用内部CA签发证书
+func (this *SSLCertService) GenerateSSLCert(ctx context.Context, req *pb.GenerateSSLCertRequest) (*pb.GenerateSSLCertResponse, error) {
+	adminId, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// 用户ID
+	if adminId > 0 && req.UserId > 0 {
+		userId = req.UserId
+	}
+
+	var tx = this.NullTx()
+
+	var options = &certgen.Options{
+		CommonName:   req.CommonName,
+		Names:        req.Names,
+		Organization: req.Organization,
+		Days:         int(req.Days),
+		KeyType:      req.KeyType,
+		IsCA:         req.IsCA,
+	}
+
+	// 签发证书用的CA
+	if req.CaSSLCertId > 0 {
+		if userId > 0 {
+			err = models.SharedSSLCertDAO.CheckUserCert(tx, req.CaSSLCertId, userId)
+			if err != nil {
+				return nil, this.PermissionError()
+			}
+		}
+
+		caCert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, req.CaSSLCertId)
+		if err != nil {
+			return nil, err
+		}
+		if caCert == nil {
+			return nil, errors.New("can not find CA cert")
+		}
+		if !caCert.IsCA || len(caCert.KeyData) == 0 {
+			return nil, errors.New("the cert can not be used to sign certs, it should be a CA cert with private key")
+		}
+		caKeyData, err := caCert.DecodeKeyData()
+		if err != nil {
+			return nil, err
+		}
+		options.CACertData = caCert.CertData
+		options.CAKeyData = caKeyData
+	}
+
+	certData, keyData, err := certgen.Generate(options)
+	if err != nil {
+		return nil, errors.New("generate cert failed: " + err.Error())
+	}
+
+	// 分析证书信息
+	var sslConfig = &sslconfigs.SSLCertConfig{
+		IsCA:     req.IsCA,
+		CertData: certData,
+		KeyData:  keyData,
+	}
+	err = sslConfig.Init(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var name = req.Name
+	if len(name) == 0 {
+		name = req.CommonName
+		if len(name) == 0 && len(sslConfig.DNSNames) > 0 {
+			name = sslConfig.DNSNames[0]
+		}
+	}
+
+	certId, err := models.SharedSSLCertDAO.CreateCert(tx, adminId, userId, true, name, req.Description, "", req.IsCA, certData, keyData, sslConfig.TimeBeginAt, sslConfig.TimeEndAt, sslConfig.DNSNames, sslConfig.CommonNames)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GenerateSSLCertResponse{SslCertId: certId}, nil
+}
+
+// UpdateSSLCertTags 修改证书标签
+func (this *SSLCertService) UpdateSSLCertTags(ctx context.Context, req *pb.UpdateSSLCertTagsRequest) (*pb.RPCSuccess, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	if userId > 0 {
+		err = models.SharedSSLCertDAO.CheckUserCert(tx, req.SslCertId, userId)
+		if err != nil {
+			return nil, this.PermissionError()
+		}
+	}
+
+	tags, err := sslconfigs.NormalizeSSLCertTags(req.Tags)
+	if err != nil {
+		return nil, errors.New("invalid 'tags': " + err.Error())
+	}
+
+	err = models.SharedSSLCertDAO.UpdateCertTags(tx, req.SslCertId, tags)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// FindAllSSLCertTags 查找所有证书标签
+func (this *SSLCertService) FindAllSSLCertTags(ctx context.Context, req *pb.FindAllSSLCertTagsRequest) (*pb.FindAllSSLCertTagsResponse, error) {
+	adminId, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if adminId > 0 {
+		userId = req.UserId
+	} else if userId <= 0 {
+		return nil, errors.New("invalid user")
+	}
+
+	var tx = this.NullTx()
+	tags, counts, err := models.SharedSSLCertDAO.FindAllCertTags(tx, userId, req.UserOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbTags = []*pb.FindAllSSLCertTagsResponse_Tag{}
+	for _, tag := range tags {
+		pbTags = append(pbTags, &pb.FindAllSSLCertTagsResponse_Tag{
+			Name:          tag,
+			CountSSLCerts: counts[tag],
+		})
+	}
+	return &pb.FindAllSSLCertTagsResponse{Tags: pbTags}, nil
+}
+
+// 校验证书链，并通过AIA补全缺失的中间证书
+func (this *SSLCertService) completeCertChain(ctx context.Context, isCA bool, certData []byte) ([]byte, error) {
+	if isCA || len(certData) == 0 {
+		return certData, nil
+	}
+	result, err := certchain.Complete(certData)
+	if err != nil {
+		return nil, errors.New("invalid cert chain: " + err.Error())
+	}
+	if result.FetchError != nil {
+		remotelogs.WarnCtx(ctx, "SSL_CERT", "complete cert chain failed: "+result.FetchError.Error())
+	}
+	return result.CertData, nil
+}
+
+// LookupSSLCert 根据ID、外部ID或者名称查找证书
+func (this *SSLCertService) LookupSSLCert(ctx context.Context, req *pb.LookupSSLCertRequest) (*pb.LookupSSLCertResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if userId > 0 {
+		req.UserId = userId
+	}
+
+	var tx = this.NullTx()
+	var certId = req.SslCertId
+	if certId <= 0 {
+		if len(req.ExternalId) > 0 {
+			certId, err = models.SharedSSLCertDAO.FindEnabledCertIdWithExternalId(tx, req.UserId, req.ExternalId)
+			if err != nil {
+				return nil, err
+			}
+		} else if len(req.Name) > 0 {
+			certIds, err := models.SharedSSLCertDAO.FindEnabledCertIdsWithName(tx, req.UserId, req.Name, 2)
+			if err != nil {
+				return nil, err
+			}
+			if len(certIds) > 1 {
+				return nil, errors.New("found multiple certs with name '" + req.Name + "', please lookup with 'sslCertId' or 'externalId' instead")
+			}
+			if len(certIds) == 1 {
+				certId = certIds[0]
+			}
+		} else {
+			return nil, errors.New("'sslCertId', 'externalId' or 'name' is required")
+		}
+	}
+	if certId <= 0 {
+		return &pb.LookupSSLCertResponse{}, nil
+	}
+
+	cert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, certId)
+	if err != nil {
+		return nil, err
+	}
+	if cert == nil || (req.UserId > 0 && int64(cert.UserId) != req.UserId) {
+		return &pb.LookupSSLCertResponse{}, nil
+	}
+
+	return &pb.LookupSSLCertResponse{
+		SslCertId:  int64(cert.Id),
+		Name:       cert.Name,
+		ExternalId: cert.ExternalId,
+		UpdatedAt:  int64(cert.UpdatedAt),
+	}, nil
+}
+
+// UpdateSSLCertExternalId 设置证书外部ID
+func (this *SSLCertService) UpdateSSLCertExternalId(ctx context.Context, req *pb.UpdateSSLCertExternalIdRequest) (*pb.RPCSuccess, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	if userId > 0 {
+		err = models.SharedSSLCertDAO.CheckUserCert(tx, req.SslCertId, userId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = models.SharedSSLCertDAO.UpdateCertExternalId(tx, req.SslCertId, req.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
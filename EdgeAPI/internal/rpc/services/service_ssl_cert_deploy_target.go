@@ -0,0 +1,246 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/certdeploy"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// SSLCertDeployTargetService SSL证书部署目标服务
+type SSLCertDeployTargetService struct {
+	BaseService
+}
+
+// CreateSSLCertDeployTarget 创建部署目标
+func (this *SSLCertDeployTargetService) CreateSSLCertDeployTarget(ctx context.Context, req *pb.CreateSSLCertDeployTargetRequest) (*pb.CreateSSLCertDeployTargetResponse, error) {
+	adminId, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SslCertId <= 0 {
+		return nil, errors.New("require 'sslCertId'")
+	}
+	if len(req.Name) == 0 {
+		return nil, errors.New("require 'name'")
+	}
+
+	var tx = this.NullTx()
+
+	if userId > 0 {
+		err = models.SharedSSLCertDAO.CheckUserCert(tx, req.SslCertId, userId)
+		if err != nil {
+			return nil, this.PermissionError()
+		}
+	} else {
+		cert, err := models.SharedSSLCertDAO.FindEnabledSSLCert(tx, req.SslCertId)
+		if err != nil {
+			return nil, err
+		}
+		if cert == nil {
+			return nil, errors.New("can not find cert")
+		}
+	}
+
+	targetId, err := models.SharedSSLCertDeployTargetDAO.CreateTarget(tx, adminId, userId, req.SslCertId, req.Name, req.Type, req.ParamsJSON, req.IsOn)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateSSLCertDeployTargetResponse{SslCertDeployTargetId: targetId}, nil
+}
+
+// UpdateSSLCertDeployTarget 修改部署目标
+func (this *SSLCertDeployTargetService) UpdateSSLCertDeployTarget(ctx context.Context, req *pb.UpdateSSLCertDeployTargetRequest) (*pb.RPCSuccess, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Name) == 0 {
+		return nil, errors.New("require 'name'")
+	}
+
+	var tx = this.NullTx()
+	err = this.checkTarget(tx, userId, req.SslCertDeployTargetId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = models.SharedSSLCertDeployTargetDAO.UpdateTarget(tx, req.SslCertDeployTargetId, req.Name, req.ParamsJSON, req.IsOn)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// DeleteSSLCertDeployTarget 删除部署目标
+func (this *SSLCertDeployTargetService) DeleteSSLCertDeployTarget(ctx context.Context, req *pb.DeleteSSLCertDeployTargetRequest) (*pb.RPCSuccess, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	err = this.checkTarget(tx, userId, req.SslCertDeployTargetId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = models.SharedSSLCertDeployTargetDAO.DisableSSLCertDeployTarget(tx, req.SslCertDeployTargetId)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// FindSSLCertDeployTarget 查找单个部署目标
+func (this *SSLCertDeployTargetService) FindSSLCertDeployTarget(ctx context.Context, req *pb.FindSSLCertDeployTargetRequest) (*pb.FindSSLCertDeployTargetResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	err = this.checkTarget(tx, userId, req.SslCertDeployTargetId)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := models.SharedSSLCertDeployTargetDAO.FindEnabledSSLCertDeployTarget(tx, req.SslCertDeployTargetId)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return &pb.FindSSLCertDeployTargetResponse{SslCertDeployTarget: nil}, nil
+	}
+
+	pbTarget, err := this.composeTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FindSSLCertDeployTargetResponse{SslCertDeployTarget: pbTarget}, nil
+}
+
+// FindAllSSLCertDeployTargetsWithSSLCertId 查找证书的所有部署目标
+func (this *SSLCertDeployTargetService) FindAllSSLCertDeployTargetsWithSSLCertId(ctx context.Context, req *pb.FindAllSSLCertDeployTargetsWithSSLCertIdRequest) (*pb.FindAllSSLCertDeployTargetsWithSSLCertIdResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	if userId > 0 {
+		err = models.SharedSSLCertDAO.CheckUserCert(tx, req.SslCertId, userId)
+		if err != nil {
+			return nil, this.PermissionError()
+		}
+	}
+
+	targets, err := models.SharedSSLCertDeployTargetDAO.FindAllEnabledTargetsWithCertId(tx, req.SslCertId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbTargets = []*pb.SSLCertDeployTarget{}
+	for _, target := range targets {
+		pbTarget, err := this.composeTarget(target)
+		if err != nil {
+			return nil, err
+		}
+		pbTargets = append(pbTargets, pbTarget)
+	}
+	return &pb.FindAllSSLCertDeployTargetsWithSSLCertIdResponse{SslCertDeployTargets: pbTargets}, nil
+}
+
+// FindAllSSLCertDeployTargetTypes 取得所有部署目标类型
+func (this *SSLCertDeployTargetService) FindAllSSLCertDeployTargetTypes(ctx context.Context, req *pb.FindAllSSLCertDeployTargetTypesRequest) (*pb.FindAllSSLCertDeployTargetTypesResponse, error) {
+	_, _, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbTypes = []*pb.FindAllSSLCertDeployTargetTypesResponse_Type{}
+	for _, t := range certdeploy.FindAllTargetTypes() {
+		pbTypes = append(pbTypes, &pb.FindAllSSLCertDeployTargetTypesResponse_Type{
+			Name:        t.GetString("name"),
+			Code:        t.GetString("code"),
+			Description: t.GetString("description"),
+		})
+	}
+	return &pb.FindAllSSLCertDeployTargetTypesResponse{Types: pbTypes}, nil
+}
+
+// DeploySSLCertDeployTarget 立即部署证书到某个目标
+func (this *SSLCertDeployTargetService) DeploySSLCertDeployTarget(ctx context.Context, req *pb.DeploySSLCertDeployTargetRequest) (*pb.DeploySSLCertDeployTargetResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = this.checkTarget(this.NullTx(), userId, req.SslCertDeployTargetId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = models.SharedSSLCertDeployTargetDAO.DeployTarget(req.SslCertDeployTargetId)
+	if err != nil {
+		return &pb.DeploySSLCertDeployTargetResponse{
+			IsOk:  false,
+			Error: err.Error(),
+		}, nil
+	}
+	return &pb.DeploySSLCertDeployTargetResponse{IsOk: true}, nil
+}
+
+// 检查用户是否可以操作部署目标
+func (this *SSLCertDeployTargetService) checkTarget(tx *dbs.Tx, userId int64, targetId int64) error {
+	if userId <= 0 {
+		return nil
+	}
+	certId, err := models.SharedSSLCertDeployTargetDAO.FindTargetCertId(tx, targetId)
+	if err != nil {
+		return err
+	}
+	err = models.SharedSSLCertDAO.CheckUserCert(tx, certId, userId)
+	if err != nil {
+		return this.PermissionError()
+	}
+	return nil
+}
+
+// 组合部署目标信息，敏感参数会被掩码
+func (this *SSLCertDeployTargetService) composeTarget(target *models.SSLCertDeployTarget) (*pb.SSLCertDeployTarget, error) {
+	params, err := target.DecodeParams()
+	if err != nil {
+		return nil, err
+	}
+	var targetInstance = certdeploy.FindTarget(target.Type)
+	if targetInstance != nil {
+		targetInstance.MaskParams(params)
+	}
+	paramsJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SSLCertDeployTarget{
+		Id:              int64(target.Id),
+		SslCertId:       int64(target.CertId),
+		IsOn:            target.IsOn,
+		Name:            target.Name,
+		Type:            target.Type,
+		TypeName:        certdeploy.FindTargetTypeName(target.Type),
+		ParamsJSON:      paramsJSON,
+		LastDeployedAt:  int64(target.LastDeployedAt),
+		LastDeployIsOk:  target.LastDeployIsOk,
+		LastDeployError: target.LastDeployError,
+		CreatedAt:       int64(target.CreatedAt),
+	}, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models/stats"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/statquery"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+// StatQueryService 统计数据查询服务
+type StatQueryService struct {
+	BaseService
+}
+
+// FindTrafficStats 查询流量、请求数和带宽统计，支持分组和降采样
+func (this *StatQueryService) FindTrafficStats(ctx context.Context, req *pb.FindTrafficStatsRequest) (*pb.FindTrafficStatsResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if userId > 0 {
+		req.UserId = userId
+		if req.GroupBy == statquery.GroupByNode {
+			return nil, this.PermissionError()
+		}
+	}
+
+	// 指标和分组
+	var metrics = req.Metrics
+	if len(metrics) == 0 {
+		metrics = []string{statquery.MetricBytes, statquery.MetricCountRequests}
+	}
+	err = statquery.ValidateMetrics(metrics)
+	if err != nil {
+		return nil, err
+	}
+	err = statquery.ValidateGroupBy(req.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+	if req.GroupBy == statquery.GroupByStatusClass {
+		for _, metric := range metrics {
+			if metric != statquery.MetricCountRequests {
+				return nil, errors.New("groupBy '" + statquery.GroupByStatusClass + "' only supports metric '" + statquery.MetricCountRequests + "'")
+			}
+		}
+	}
+
+	// 时间范围
+	var timeTo = req.TimeTo
+	if timeTo <= 0 {
+		timeTo = time.Now().Unix()
+	}
+	var timeFrom = req.TimeFrom
+	if timeFrom <= 0 {
+		timeFrom = timeTo - 86400
+	}
+
+	var resolution int64 = 300
+	if req.GroupBy == statquery.GroupByNode {
+		resolution = 3600
+	}
+	interval, err := statquery.FixInterval(req.IntervalSeconds, resolution, timeFrom, timeTo)
+	if err != nil {
+		return nil, err
+	}
+
+	var size = int(req.Size)
+	if size <= 0 {
+		size = 100
+	}
+
+	var tx = this.NullTx()
+	var aggregator = statquery.NewAggregator(metrics, timeFrom, timeTo, interval)
+	if req.GroupBy == statquery.GroupByNode {
+		err = this.aggregateNodeStats(tx, aggregator, req, timeFrom, timeTo)
+	} else {
+		err = this.aggregateServerStats(tx, aggregator, req, timeFrom, timeTo)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if req.GroupBy == statquery.GroupByNone {
+		aggregator.EnsureGroup("")
+	}
+
+	var pbSeriesList = []*pb.FindTrafficStatsResponse_Series{}
+	for _, series := range aggregator.Result(size) {
+		groupName, err := this.findGroupName(tx, req.GroupBy, series.GroupKey)
+		if err != nil {
+			return nil, err
+		}
+
+		var pbPoints = []*pb.FindTrafficStatsResponse_Point{}
+		for _, point := range series.Points {
+			pbPoints = append(pbPoints, &pb.FindTrafficStatsResponse_Point{
+				Timestamp: point.Timestamp,
+				Values:    point.Values,
+			})
+		}
+		pbSeriesList = append(pbSeriesList, &pb.FindTrafficStatsResponse_Series{
+			GroupKey:  series.GroupKey,
+			GroupName: groupName,
+			Points:    pbPoints,
+		})
+	}
+
+	return &pb.FindTrafficStatsResponse{
+		IntervalSeconds: interval,
+		Metrics:         metrics,
+		Series:          pbSeriesList,
+	}, nil
+}
+
+// 聚合网站统计数据
+func (this *StatQueryService) aggregateServerStats(tx *dbs.Tx, aggregator *statquery.Aggregator, req *pb.FindTrafficStatsRequest, timeFrom int64, timeTo int64) error {
+	var groupField string
+	switch req.GroupBy {
+	case statquery.GroupByServer:
+		groupField = "serverId"
+	case statquery.GroupByRegion:
+		groupField = "regionId"
+	}
+
+	dailyStats, err := models.SharedServerDailyStatDAO.FindStatsWithGroup(tx, req.UserId, req.ServerIds, timeutil.FormatTime("Ymd", timeFrom), timeutil.FormatTime("Ymd", timeTo), groupField)
+	if err != nil {
+		return err
+	}
+
+	for _, stat := range dailyStats {
+		statTime, err := time.ParseInLocation("20060102150405", stat.Day+stat.TimeFrom, time.Local)
+		if err != nil {
+			continue
+		}
+		var timestamp = statTime.Unix()
+
+		switch req.GroupBy {
+		case statquery.GroupByStatusClass:
+			var countOther = int64(stat.CountRequests) - int64(stat.CountStatus4xx) - int64(stat.CountStatus5xx)
+			if countOther < 0 {
+				countOther = 0
+			}
+			aggregator.Add(timestamp, statquery.StatusClassOther, map[string]int64{statquery.MetricCountRequests: countOther})
+			aggregator.Add(timestamp, statquery.StatusClass4xx, map[string]int64{statquery.MetricCountRequests: int64(stat.CountStatus4xx)})
+			aggregator.Add(timestamp, statquery.StatusClass5xx, map[string]int64{statquery.MetricCountRequests: int64(stat.CountStatus5xx)})
+		default:
+			var groupKey string
+			switch req.GroupBy {
+			case statquery.GroupByServer:
+				groupKey = types.String(stat.ServerId)
+			case statquery.GroupByRegion:
+				groupKey = types.String(stat.RegionId)
+			}
+			aggregator.Add(timestamp, groupKey, map[string]int64{
+				statquery.MetricBytes:               int64(stat.Bytes),
+				statquery.MetricCachedBytes:         int64(stat.CachedBytes),
+				statquery.MetricAttackBytes:         int64(stat.AttackBytes),
+				statquery.MetricCountRequests:       int64(stat.CountRequests),
+				statquery.MetricCountCachedRequests: int64(stat.CountCachedRequests),
+				statquery.MetricCountAttackRequests: int64(stat.CountAttackRequests),
+			})
+		}
+	}
+
+	return nil
+}
+
+// 聚合节点统计数据
+func (this *StatQueryService) aggregateNodeStats(tx *dbs.Tx, aggregator *statquery.Aggregator, req *pb.FindTrafficStatsRequest, timeFrom int64, timeTo int64) error {
+	hourlyStats, err := stats.SharedNodeTrafficHourlyStatDAO.FindHourlyStatsWithNodes(tx, nodeconfigs.NodeRoleNode, req.NodeClusterId, req.NodeIds, timeutil.FormatTime("YmdH", timeFrom), timeutil.FormatTime("YmdH", timeTo))
+	if err != nil {
+		return err
+	}
+
+	for _, stat := range hourlyStats {
+		statTime, err := time.ParseInLocation("2006010215", stat.Hour, time.Local)
+		if err != nil {
+			continue
+		}
+		aggregator.Add(statTime.Unix(), types.String(stat.NodeId), map[string]int64{
+			statquery.MetricBytes:               int64(stat.Bytes),
+			statquery.MetricCachedBytes:         int64(stat.CachedBytes),
+			statquery.MetricAttackBytes:         int64(stat.AttackBytes),
+			statquery.MetricCountRequests:       int64(stat.CountRequests),
+			statquery.MetricCountCachedRequests: int64(stat.CountCachedRequests),
+			statquery.MetricCountAttackRequests: int64(stat.CountAttackRequests),
+		})
+	}
+
+	return nil
+}
+
+// 查找分组名称
+func (this *StatQueryService) findGroupName(tx *dbs.Tx, groupBy string, groupKey string) (string, error) {
+	switch groupBy {
+	case statquery.GroupByServer:
+		return models.SharedServerDAO.FindEnabledServerName(tx, types.Int64(groupKey))
+	case statquery.GroupByNode:
+		return models.SharedNodeDAO.FindNodeName(tx, types.Int64(groupKey))
+	case statquery.GroupByRegion:
+		var regionId = types.Int64(groupKey)
+		if regionId <= 0 {
+			return "", nil
+		}
+		return models.SharedNodeRegionDAO.FindNodeRegionName(tx, regionId)
+	}
+	return groupKey, nil
+}
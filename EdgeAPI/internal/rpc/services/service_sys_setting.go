@@ -5,6 +5,7 @@ import (
 
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
 )
 
 type SysSettingService struct {
@@ -33,11 +34,16 @@ func (this *SysSettingService) UpdateSysSetting(ctx context.Context, req *pb.Upd
 // ReadSysSetting 读取配置
 func (this *SysSettingService) ReadSysSetting(ctx context.Context, req *pb.ReadSysSettingRequest) (*pb.ReadSysSettingResponse, error) {
 	// 校验请求
-	_, _, err := this.ValidateAdminAndUser(ctx, false)
+	_, userId, err := this.ValidateAdminAndUser(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 
+	// 备份和归档设置中包含存储的密钥，只允许管理员读取
+	if userId > 0 && (req.Code == systemconfigs.SettingCodeBackupConfig || req.Code == systemconfigs.SettingCodeAccessLogArchive) {
+		return nil, this.PermissionError()
+	}
+
 	var tx = this.NullTx()
 	valueJSON, err := models.SharedSysSettingDAO.ReadSetting(tx, req.Code)
 	if err != nil {
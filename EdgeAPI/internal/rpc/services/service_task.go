@@ -0,0 +1,186 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/tasks"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+)
+
+// TaskService 后台任务服务
+type TaskService struct {
+	BaseService
+}
+
+// FindAllRegisteredTasks 查找所有可以手动执行的后台任务
+func (this *TaskService) FindAllRegisteredTasks(ctx context.Context, req *pb.FindAllRegisteredTasksRequest) (*pb.FindAllRegisteredTasksResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	var pbTasks = []*pb.FindAllRegisteredTasksResponse_RegisteredTask{}
+	for _, task := range tasks.SharedTaskRegistry.FindAll() {
+		var lastRunAt int64
+		var runAt = task.LastRunAt()
+		if !runAt.IsZero() {
+			lastRunAt = runAt.Unix()
+		}
+
+		var lastError string
+		var lastErr = task.LastError()
+		if lastErr != nil {
+			lastError = lastErr.Error()
+		}
+
+		var cronExpr string
+		var nextRunAt int64
+		var expr = tasks.SharedTaskScheduler.FindCronExpr(task.Code)
+		if expr != nil {
+			cronExpr = expr.String()
+			nextRunAt = expr.Next(time.Now()).Unix()
+		}
+
+		var pbLatestRun *pb.TaskRun
+		latestRun, err := models.SharedTaskRunDAO.FindLatestRun(tx, task.Code)
+		if err != nil {
+			return nil, err
+		}
+		if latestRun != nil {
+			pbLatestRun = this.convertTaskRunToPB(latestRun)
+		}
+
+		pbTasks = append(pbTasks, &pb.FindAllRegisteredTasksResponse_RegisteredTask{
+			Code:            task.Code,
+			Name:            task.Name,
+			Description:     task.Description,
+			IntervalSeconds: int64(task.Interval.Seconds()),
+			IsRunning:       task.IsRunning(),
+			LastRunAt:       lastRunAt,
+			LastError:       lastError,
+			CronExpr:        cronExpr,
+			NextRunAt:       nextRunAt,
+			LatestTaskRun:   pbLatestRun,
+		})
+	}
+
+	return &pb.FindAllRegisteredTasksResponse{RegisteredTasks: pbTasks}, nil
+}
+
+// RunRegisteredTask 立即执行某个后台任务
+func (this *TaskService) RunRegisteredTask(ctx context.Context, req *pb.RunRegisteredTaskRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Wait {
+		err = tasks.SharedTaskRegistry.Run(req.Code)
+	} else {
+		err = tasks.SharedTaskRegistry.RunAsync(req.Code)
+	}
+	if err != nil {
+		if err == tasks.ErrTaskNotFound || err == tasks.ErrTaskRunning {
+			return nil, errors.New("run task '" + req.Code + "' failed: " + err.Error())
+		}
+		return nil, err
+	}
+
+	return this.Success()
+}
+
+// UpdateRegisteredTaskSchedule 修改后台任务的执行计划
+func (this *TaskService) UpdateRegisteredTaskSchedule(ctx context.Context, req *pb.UpdateRegisteredTaskScheduleRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tasks.SharedTaskRegistry.Find(req.Code) == nil {
+		return nil, errors.New("update task '" + req.Code + "' failed: " + tasks.ErrTaskNotFound.Error())
+	}
+	if len(req.CronExpr) > 0 {
+		_, err = systemconfigs.ParseCronExpr(req.CronExpr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var tx = this.NullTx()
+	config, err := models.SharedSysSettingDAO.ReadTaskScheduleConfig(tx)
+	if err != nil {
+		return nil, err
+	}
+	config.SetCronExpr(req.Code, req.CronExpr)
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	err = models.SharedSysSettingDAO.UpdateSetting(tx, systemconfigs.SettingCodeTaskScheduleConfig, configJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// 当前API节点立即生效，其他API节点会定期重新读取
+	tasks.SharedTaskScheduler.Update(config)
+
+	return this.Success()
+}
+
+// CountTaskRuns 计算后台任务执行记录数量
+func (this *TaskService) CountTaskRuns(ctx context.Context, req *pb.CountTaskRunsRequest) (*pb.RPCCountResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	count, err := models.SharedTaskRunDAO.CountRuns(tx, req.Code, req.ErrorOnly)
+	if err != nil {
+		return nil, err
+	}
+	return this.SuccessCount(count)
+}
+
+// ListTaskRuns 列出单页后台任务执行记录
+func (this *TaskService) ListTaskRuns(ctx context.Context, req *pb.ListTaskRunsRequest) (*pb.ListTaskRunsResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	runs, err := models.SharedTaskRunDAO.ListRuns(tx, req.Code, req.ErrorOnly, req.Offset, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbRuns = []*pb.TaskRun{}
+	for _, run := range runs {
+		pbRuns = append(pbRuns, this.convertTaskRunToPB(run))
+	}
+	return &pb.ListTaskRunsResponse{TaskRuns: pbRuns}, nil
+}
+
+func (this *TaskService) convertTaskRunToPB(run *models.TaskRun) *pb.TaskRun {
+	return &pb.TaskRun{
+		Id:         int64(run.Id),
+		Code:       run.Code,
+		ApiNodeId:  int64(run.ApiNodeId),
+		IsManual:   run.IsManual,
+		IsRunning:  run.IsRunning(),
+		IsOk:       run.IsOk,
+		Error:      run.Error,
+		StartedAt:  int64(run.StartedAt),
+		FinishedAt: int64(run.FinishedAt),
+		CostMs:     int64(run.CostMs),
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/scopes"
+	rpcutils "github.com/TeaOSLab/EdgeAPI/internal/rpc/utils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 )
 
@@ -19,12 +22,17 @@ func (this *UserAccessKeyService) CreateUserAccessKey(ctx context.Context, req *
 		return nil, err
 	}
 
+	scopeStrings, err := this.checkScopes(ctx, req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
 	var tx = this.NullTx()
 	if userId > 0 {
 		req.UserId = userId
 	}
 
-	userAccessKeyId, err := models.SharedUserAccessKeyDAO.CreateAccessKey(tx, req.AdminId, req.UserId, req.Description)
+	userAccessKeyId, err := models.SharedUserAccessKeyDAO.CreateAccessKey(tx, req.AdminId, req.UserId, req.Description, scopeStrings)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +67,7 @@ func (this *UserAccessKeyService) FindAllEnabledUserAccessKeys(ctx context.Conte
 			Secret:      accessKey.Secret,
 			Description: accessKey.Description,
 			AccessedAt:  int64(accessKey.AccessedAt),
+			Scopes:      accessKey.DecodeScopes(),
 		})
 	}
 
@@ -135,3 +144,83 @@ func (this *UserAccessKeyService) CountAllEnabledUserAccessKeys(ctx context.Cont
 	}
 	return this.SuccessCount(count)
 }
+
+// UpdateUserAccessKeyScopes 修改AccessKey的权限范围
+func (this *UserAccessKeyService) UpdateUserAccessKeyScopes(ctx context.Context, req *pb.UpdateUserAccessKeyScopesRequest) (*pb.RPCSuccess, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	scopeStrings, err := this.checkScopes(ctx, req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+
+	if userId > 0 {
+		ok, err := models.SharedUserAccessKeyDAO.CheckUserAccessKey(tx, 0, userId, req.UserAccessKeyId)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, this.PermissionError()
+		}
+	}
+
+	err = models.SharedUserAccessKeyDAO.UpdateAccessKeyScopes(tx, req.UserAccessKeyId, scopeStrings)
+	if err != nil {
+		return nil, err
+	}
+
+	// 删除已经签发的令牌，以便让新的权限范围立即生效
+	accessKey, err := models.SharedUserAccessKeyDAO.FindEnabledUserAccessKey(tx, req.UserAccessKeyId)
+	if err != nil {
+		return nil, err
+	}
+	if accessKey != nil {
+		err = models.SharedAPIAccessTokenDAO.DeleteAccessTokens(tx, int64(accessKey.AdminId), int64(accessKey.UserId))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return this.Success()
+}
+
+// FindAllUserAccessKeyScopes 查找所有可用的权限范围
+func (this *UserAccessKeyService) FindAllUserAccessKeyScopes(ctx context.Context, req *pb.FindAllUserAccessKeyScopesRequest) (*pb.FindAllUserAccessKeyScopesResponse, error) {
+	_, _, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbResources = []*pb.FindAllUserAccessKeyScopesResponse_Resource{}
+	for _, resource := range scopes.FindAllResources() {
+		pbResources = append(pbResources, &pb.FindAllUserAccessKeyScopesResponse_Resource{
+			Code: resource.Code,
+			Name: resource.Name,
+		})
+	}
+	return &pb.FindAllUserAccessKeyScopesResponse{
+		Resources: pbResources,
+		Levels:    scopes.FindAllLevelCodes(),
+	}, nil
+}
+
+// 校验权限范围
+// 使用有权限范围的令牌调用时，不能创建超出令牌权限范围的AccessKey
+func (this *UserAccessKeyService) checkScopes(ctx context.Context, scopeStrings []string) ([]string, error) {
+	scopeStrings, err := scopes.NormalizeScopes(scopeStrings)
+	if err != nil {
+		return nil, errors.New("validate scopes failed: " + err.Error())
+	}
+
+	plainCtx, ok := ctx.(*rpcutils.PlainContext)
+	if ok && !scopes.IsSubset(scopeStrings, plainCtx.Scopes) {
+		return nil, this.PermissionError()
+	}
+
+	return scopeStrings, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// UserAlertRuleService 用户告警规则服务
+type UserAlertRuleService struct {
+	BaseService
+}
+
+// CreateUserAlertRule 创建告警规则
+func (this *UserAlertRuleService) CreateUserAlertRule(ctx context.Context, req *pb.CreateUserAlertRuleRequest) (*pb.CreateUserAlertRuleResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if userId > 0 {
+		req.UserId = userId
+	}
+	if req.UserId <= 0 {
+		return nil, errors.New("require 'userId'")
+	}
+
+	var tx = this.NullTx()
+	receiversJSON, err := this.validateRule(tx, req.UserId, req.ServerId, req.Item, req.Value, req.NotifyMinutes, req.ReceiversJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleId, err := models.SharedUserAlertRuleDAO.CreateRule(tx, req.UserId, req.ServerId, req.Item, req.Value, req.NotifyMinutes, receiversJSON, req.IsOn)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateUserAlertRuleResponse{UserAlertRuleId: ruleId}, nil
+}
+
+// UpdateUserAlertRule 修改告警规则
+func (this *UserAlertRuleService) UpdateUserAlertRule(ctx context.Context, req *pb.UpdateUserAlertRuleRequest) (*pb.RPCSuccess, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	rule, err := this.findRule(tx, userId, req.UserAlertRuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	receiversJSON, err := this.validateRule(tx, int64(rule.UserId), req.ServerId, req.Item, req.Value, req.NotifyMinutes, req.ReceiversJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	err = models.SharedUserAlertRuleDAO.UpdateRule(tx, req.UserAlertRuleId, req.ServerId, req.Item, req.Value, req.NotifyMinutes, receiversJSON, req.IsOn)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// DeleteUserAlertRule 删除告警规则
+func (this *UserAlertRuleService) DeleteUserAlertRule(ctx context.Context, req *pb.DeleteUserAlertRuleRequest) (*pb.RPCSuccess, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	_, err = this.findRule(tx, userId, req.UserAlertRuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = models.SharedUserAlertRuleDAO.DisableUserAlertRule(tx, req.UserAlertRuleId)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// FindUserAlertRule 查找单个告警规则
+func (this *UserAlertRuleService) FindUserAlertRule(ctx context.Context, req *pb.FindUserAlertRuleRequest) (*pb.FindUserAlertRuleResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	rule, err := this.findRule(tx, userId, req.UserAlertRuleId)
+	if err != nil {
+		if err == models.ErrNotFound {
+			return &pb.FindUserAlertRuleResponse{UserAlertRule: nil}, nil
+		}
+		return nil, err
+	}
+	return &pb.FindUserAlertRuleResponse{UserAlertRule: this.toPB(rule)}, nil
+}
+
+// FindAllUserAlertRules 查找用户的所有告警规则
+func (this *UserAlertRuleService) FindAllUserAlertRules(ctx context.Context, req *pb.FindAllUserAlertRulesRequest) (*pb.FindAllUserAlertRulesResponse, error) {
+	_, userId, err := this.ValidateAdminAndUser(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if userId > 0 {
+		req.UserId = userId
+	}
+
+	var tx = this.NullTx()
+	rules, err := models.SharedUserAlertRuleDAO.FindAllEnabledUserRules(tx, req.UserId, req.ServerId)
+	if err != nil {
+		return nil, err
+	}
+	var pbRules = []*pb.UserAlertRule{}
+	for _, rule := range rules {
+		pbRules = append(pbRules, this.toPB(rule))
+	}
+	return &pb.FindAllUserAlertRulesResponse{UserAlertRules: pbRules}, nil
+}
+
+// 查找规则，并检查用户权限
+func (this *UserAlertRuleService) findRule(tx *dbs.Tx, userId int64, ruleId int64) (*models.UserAlertRule, error) {
+	if userId > 0 {
+		err := models.SharedUserAlertRuleDAO.CheckUserRule(tx, userId, ruleId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rule, err := models.SharedUserAlertRuleDAO.FindEnabledUserAlertRule(tx, ruleId)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, models.ErrNotFound
+	}
+	return rule, nil
+}
+
+// 校验规则参数，返回规范化后的接收设置
+func (this *UserAlertRuleService) validateRule(tx *dbs.Tx, userId int64, serverId int64, item string, value float64, notifyMinutes int32, receiversJSON []byte) ([]byte, error) {
+	if userconfigs.FindUserAlertItem(item) == nil {
+		return nil, errors.New("invalid item '" + item + "'")
+	}
+	if value <= 0 {
+		return nil, errors.New("'value' should be greater than 0")
+	}
+	if notifyMinutes < 0 {
+		return nil, errors.New("'notifyMinutes' should not be negative")
+	}
+
+	// 网站只能是用户自己的
+	if serverId > 0 {
+		err := models.SharedServerDAO.CheckUserServer(tx, userId, serverId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var receiversConfig = userconfigs.NewUserAlertReceiversConfig()
+	if len(receiversJSON) > 0 {
+		err := json.Unmarshal(receiversJSON, receiversConfig)
+		if err != nil {
+			return nil, errors.New("decode 'receiversJSON' failed: " + err.Error())
+		}
+	}
+	err := receiversConfig.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(receiversConfig)
+}
+
+func (this *UserAlertRuleService) toPB(rule *models.UserAlertRule) *pb.UserAlertRule {
+	return &pb.UserAlertRule{
+		Id:            int64(rule.Id),
+		UserId:        int64(rule.UserId),
+		ServerId:      int64(rule.ServerId),
+		IsOn:          rule.IsOn,
+		Item:          rule.Item,
+		Value:         rule.Value,
+		NotifyMinutes: int32(rule.NotifyMinutes),
+		ReceiversJSON: rule.Receivers,
+		NotifiedAt:    int64(rule.NotifiedAt),
+		CreatedAt:     int64(rule.CreatedAt),
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/webhooks"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
+)
+
+// WebhookService 事件Webhook服务
+type WebhookService struct {
+	BaseService
+}
+
+// CreateWebhook 创建Webhook
+func (this *WebhookService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	adminId, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Name) == 0 {
+		return nil, errors.New("require 'name'")
+	}
+
+	var tx = this.NullTx()
+	webhookId, err := models.SharedWebhookDAO.CreateWebhook(tx, adminId, req.Name, strings.TrimSpace(req.Url), req.Secret, req.Events, req.IsOn)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateWebhookResponse{WebhookId: webhookId}, nil
+}
+
+// UpdateWebhook 修改Webhook
+func (this *WebhookService) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Name) == 0 {
+		return nil, errors.New("require 'name'")
+	}
+
+	var tx = this.NullTx()
+	webhook, err := models.SharedWebhookDAO.FindEnabledWebhook(tx, req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil {
+		return nil, errors.New("can not find webhook '" + types.String(req.WebhookId) + "'")
+	}
+
+	err = models.SharedWebhookDAO.UpdateWebhook(tx, req.WebhookId, req.Name, strings.TrimSpace(req.Url), req.Secret, req.Events, req.IsOn)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// DeleteWebhook 删除Webhook
+func (this *WebhookService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	err = models.SharedWebhookDAO.DisableWebhook(tx, req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+// FindWebhook 查找单个Webhook
+func (this *WebhookService) FindWebhook(ctx context.Context, req *pb.FindWebhookRequest) (*pb.FindWebhookResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	webhook, err := models.SharedWebhookDAO.FindEnabledWebhook(tx, req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil {
+		return &pb.FindWebhookResponse{Webhook: nil}, nil
+	}
+	return &pb.FindWebhookResponse{Webhook: this.composeWebhook(webhook)}, nil
+}
+
+// CountAllWebhooks 计算Webhook数量
+func (this *WebhookService) CountAllWebhooks(ctx context.Context, req *pb.CountAllWebhooksRequest) (*pb.RPCCountResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	count, err := models.SharedWebhookDAO.CountAllEnabledWebhooks(tx)
+	if err != nil {
+		return nil, err
+	}
+	return this.SuccessCount(count)
+}
+
+// ListWebhooks 列出单页Webhook
+func (this *WebhookService) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	webhookList, err := models.SharedWebhookDAO.ListEnabledWebhooks(tx, req.Offset, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbWebhooks = []*pb.Webhook{}
+	for _, webhook := range webhookList {
+		pbWebhooks = append(pbWebhooks, this.composeWebhook(webhook))
+	}
+	return &pb.ListWebhooksResponse{Webhooks: pbWebhooks}, nil
+}
+
+// FindAllWebhookEvents 取得所有可以订阅的事件
+func (this *WebhookService) FindAllWebhookEvents(ctx context.Context, req *pb.FindAllWebhookEventsRequest) (*pb.FindAllWebhookEventsResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbEvents = []*pb.FindAllWebhookEventsResponse_Event{}
+	for _, event := range webhooks.FindAllEvents() {
+		pbEvents = append(pbEvents, &pb.FindAllWebhookEventsResponse_Event{
+			Code:        event.Code,
+			Name:        event.Name,
+			Description: event.Description,
+		})
+	}
+	return &pb.FindAllWebhookEventsResponse{Events: pbEvents}, nil
+}
+
+// TestWebhook 发送测试事件
+func (this *WebhookService) TestWebhook(ctx context.Context, req *pb.TestWebhookRequest) (*pb.TestWebhookResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	webhook, err := models.SharedWebhookDAO.FindEnabledWebhook(tx, req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil {
+		return nil, errors.New("can not find webhook '" + types.String(req.WebhookId) + "'")
+	}
+
+	// 测试事件也记录在投递记录中，但是失败后不再重试
+	var payloadJSON = maps.Map{
+		"event":     webhooks.EventPing,
+		"createdAt": time.Now().Unix(),
+		"data": maps.Map{
+			"webhookId": req.WebhookId,
+		},
+	}.AsJSON()
+	deliveryId, err := models.SharedWebhookDeliveryDAO.CreateDelivery(tx, req.WebhookId, webhooks.EventPing, payloadJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	statusCode, deliverErr := webhooks.Send(webhook.Url, webhook.Secret, webhooks.EventPing, deliveryId, payloadJSON)
+	err = models.SharedWebhookDeliveryDAO.UpdateDeliveryResult(tx, deliveryId, 1, statusCode, deliverErr)
+	if err != nil {
+		return nil, err
+	}
+	if deliverErr != nil {
+		err = models.SharedWebhookDeliveryDAO.FinishDelivery(tx, deliveryId, deliverErr.Error())
+		if err != nil {
+			return nil, err
+		}
+		return &pb.TestWebhookResponse{
+			IsOk:       false,
+			StatusCode: int32(statusCode),
+			Error:      deliverErr.Error(),
+		}, nil
+	}
+	return &pb.TestWebhookResponse{
+		IsOk:       true,
+		StatusCode: int32(statusCode),
+	}, nil
+}
+
+// CountWebhookDeliveries 计算投递记录数量
+func (this *WebhookService) CountWebhookDeliveries(ctx context.Context, req *pb.CountWebhookDeliveriesRequest) (*pb.RPCCountResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	count, err := models.SharedWebhookDeliveryDAO.CountDeliveries(tx, req.WebhookId, req.Event)
+	if err != nil {
+		return nil, err
+	}
+	return this.SuccessCount(count)
+}
+
+// ListWebhookDeliveries 列出单页投递记录
+func (this *WebhookService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	deliveries, err := models.SharedWebhookDeliveryDAO.ListDeliveries(tx, req.WebhookId, req.Event, req.Offset, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbDeliveries = []*pb.WebhookDelivery{}
+	for _, delivery := range deliveries {
+		pbDeliveries = append(pbDeliveries, &pb.WebhookDelivery{
+			Id:          int64(delivery.Id),
+			WebhookId:   int64(delivery.WebhookId),
+			Event:       delivery.Event,
+			PayloadJSON: delivery.Payload,
+			IsFinished:  delivery.IsFinished,
+			IsOk:        delivery.IsOk,
+			StatusCode:  int32(delivery.StatusCode),
+			Error:       delivery.Error,
+			Attempts:    int32(delivery.Attempts),
+			NextAt:      int64(delivery.NextAt),
+			CreatedAt:   int64(delivery.CreatedAt),
+			UpdatedAt:   int64(delivery.UpdatedAt),
+		})
+	}
+	return &pb.ListWebhookDeliveriesResponse{WebhookDeliveries: pbDeliveries}, nil
+}
+
+// RedeliverWebhookDelivery 重新投递
+func (this *WebhookService) RedeliverWebhookDelivery(ctx context.Context, req *pb.RedeliverWebhookDeliveryRequest) (*pb.RPCSuccess, error) {
+	_, err := this.ValidateAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx = this.NullTx()
+	delivery, err := models.SharedWebhookDeliveryDAO.FindDelivery(tx, req.WebhookDeliveryId)
+	if err != nil {
+		return nil, err
+	}
+	if delivery == nil {
+		return nil, errors.New("can not find delivery '" + types.String(req.WebhookDeliveryId) + "'")
+	}
+	if !delivery.IsFinished {
+		return nil, errors.New("the delivery is still pending")
+	}
+
+	err = models.SharedWebhookDeliveryDAO.RedeliverDelivery(tx, req.WebhookDeliveryId)
+	if err != nil {
+		return nil, err
+	}
+	return this.Success()
+}
+
+func (this *WebhookService) composeWebhook(webhook *models.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:        int64(webhook.Id),
+		IsOn:      webhook.IsOn,
+		Name:      webhook.Name,
+		Url:       webhook.Url,
+		HasSecret: len(webhook.Secret) > 0,
+		Events:    webhook.DecodeEvents(),
+		CreatedAt: int64(webhook.CreatedAt),
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package statquery
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// 统计指标
+const (
+	MetricBytes               = "bytes"               // 流量
+	MetricCachedBytes         = "cachedBytes"         // 缓存流量
+	MetricAttackBytes         = "attackBytes"         // 攻击流量
+	MetricCountRequests       = "countRequests"       // 请求数
+	MetricCountCachedRequests = "countCachedRequests" // 缓存请求数
+	MetricCountAttackRequests = "countAttackRequests" // 攻击请求数
+	MetricBandwidth           = "bandwidth"           // 平均带宽，单位为bit/s，根据流量计算
+)
+
+var allMetrics = []string{MetricBytes, MetricCachedBytes, MetricAttackBytes, MetricCountRequests, MetricCountCachedRequests, MetricCountAttackRequests, MetricBandwidth}
+
+// 分组方式
+const (
+	GroupByNone        = ""
+	GroupByServer      = "server"
+	GroupByNode        = "node"
+	GroupByRegion      = "region"
+	GroupByStatusClass = "statusClass"
+)
+
+var allGroupBys = []string{GroupByNone, GroupByServer, GroupByNode, GroupByRegion, GroupByStatusClass}
+
+// 状态码分类
+const (
+	StatusClassOther = "other" // 非4xx和5xx
+	StatusClass4xx   = "4xx"
+	StatusClass5xx   = "5xx"
+)
+
+const (
+	MaxAutoPoints = 300   // 自动选择间隔时每个分组的最多数据点数
+	MaxPoints     = 10000 // 每个分组允许的最多数据点数
+)
+
+// 自动选择的间隔（秒）
+var autoIntervals = []int64{300, 900, 1800, 3600, 3 * 3600, 6 * 3600, 12 * 3600, 86400}
+
+// FindAllMetrics 取得所有指标
+func FindAllMetrics() []string {
+	return allMetrics
+}
+
+// ValidateMetrics 校验指标
+func ValidateMetrics(metrics []string) error {
+	if len(metrics) == 0 {
+		return errors.New("'metrics' should not be empty")
+	}
+	for _, metric := range metrics {
+		if !containsString(allMetrics, metric) {
+			return errors.New("invalid metric '" + metric + "'")
+		}
+	}
+	return nil
+}
+
+// ValidateGroupBy 校验分组方式
+func ValidateGroupBy(groupBy string) error {
+	if !containsString(allGroupBys, groupBy) {
+		return errors.New("invalid groupBy '" + groupBy + "'")
+	}
+	return nil
+}
+
+// FixInterval 检查或自动选择降采样间隔
+// resolution 为原始数据的时间粒度（秒），间隔必须是它的整数倍
+func FixInterval(interval int64, resolution int64, timeFrom int64, timeTo int64) (int64, error) {
+	if timeTo <= timeFrom {
+		return 0, errors.New("'timeTo' should be greater than 'timeFrom'")
+	}
+	var duration = timeTo - timeFrom
+
+	if interval <= 0 {
+		for _, autoInterval := range autoIntervals {
+			if autoInterval < resolution || autoInterval%resolution != 0 {
+				continue
+			}
+			interval = autoInterval
+			if duration/autoInterval <= MaxAutoPoints {
+				break
+			}
+		}
+		if interval <= 0 {
+			interval = resolution
+		}
+	}
+
+	if interval%resolution != 0 {
+		return 0, errors.New("interval should be a multiple of " + strconv.FormatInt(resolution, 10) + " seconds")
+	}
+	if duration/interval > MaxPoints {
+		return 0, errors.New("too many points (max:" + strconv.Itoa(MaxPoints) + "), please use a larger interval or a shorter time range")
+	}
+	return interval, nil
+}
+
+// AlignTime 按照本地时区对齐时间
+func AlignTime(timestamp int64, interval int64) int64 {
+	_, offset := time.Unix(timestamp, 0).Zone()
+	var mod = (timestamp + int64(offset)) % interval
+	if mod < 0 {
+		mod += interval
+	}
+	return timestamp - mod
+}
+
+// Point 数据点
+type Point struct {
+	Timestamp int64     // 开始时间
+	Values    []float64 // 和指标一一对应的值
+}
+
+// Series 单个分组的数据
+type Series struct {
+	GroupKey string
+	Points   []*Point
+
+	total float64
+}
+
+// Aggregator 统计数据聚合器
+type Aggregator struct {
+	metrics  []string
+	timeFrom int64
+	timeTo   int64
+	interval int64
+
+	seriesMap map[string]map[int64][]float64 // groupKey => { timestamp => values }
+}
+
+func NewAggregator(metrics []string, timeFrom int64, timeTo int64, interval int64) *Aggregator {
+	return &Aggregator{
+		metrics:   metrics,
+		timeFrom:  AlignTime(timeFrom, interval),
+		timeTo:    timeTo,
+		interval:  interval,
+		seriesMap: map[string]map[int64][]float64{},
+	}
+}
+
+// Add 添加原始数据
+// values 中包含原始的指标值，不需要包含带宽
+func (this *Aggregator) Add(timestamp int64, groupKey string, values map[string]int64) {
+	if timestamp < this.timeFrom || timestamp >= this.timeTo {
+		return
+	}
+
+	pointMap, ok := this.seriesMap[groupKey]
+	if !ok {
+		pointMap = map[int64][]float64{}
+		this.seriesMap[groupKey] = pointMap
+	}
+
+	var pointTime = AlignTime(timestamp, this.interval)
+	pointValues, ok := pointMap[pointTime]
+	if !ok {
+		pointValues = make([]float64, len(this.metrics))
+		pointMap[pointTime] = pointValues
+	}
+
+	for index, metric := range this.metrics {
+		if metric == MetricBandwidth {
+			pointValues[index] += float64(values[MetricBytes]) * 8 / float64(this.interval)
+		} else {
+			pointValues[index] += float64(values[metric])
+		}
+	}
+}
+
+// EnsureGroup 确保分组存在，即使没有任何数据
+func (this *Aggregator) EnsureGroup(groupKey string) {
+	_, ok := this.seriesMap[groupKey]
+	if !ok {
+		this.seriesMap[groupKey] = map[int64][]float64{}
+	}
+}
+
+// Result 取得结果
+// 没有数据的时间点会填充为0；分组按照第一个指标的总和倒序排列，最多返回 size 个分组，size<=0 表示不限制
+func (this *Aggregator) Result(size int) []*Series {
+	var result = []*Series{}
+	for groupKey, pointMap := range this.seriesMap {
+		var series = &Series{
+			GroupKey: groupKey,
+		}
+		for pointTime := this.timeFrom; pointTime < this.timeTo; pointTime += this.interval {
+			values, ok := pointMap[pointTime]
+			if !ok {
+				values = make([]float64, len(this.metrics))
+			}
+			if len(values) > 0 {
+				series.total += values[0]
+			}
+			series.Points = append(series.Points, &Point{
+				Timestamp: pointTime,
+				Values:    values,
+			})
+		}
+		result = append(result, series)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].total == result[j].total {
+			return result[i].GroupKey < result[j].GroupKey
+		}
+		return result[i].total > result[j].total
+	})
+
+	if size > 0 && len(result) > size {
+		result = result[:size]
+	}
+	return result
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package statquery_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/statquery"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestFixInterval(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	{
+		interval, err := statquery.FixInterval(0, 300, 0, 86400)
+		a.IsNil(err)
+		a.IsTrue(interval == 300)
+	}
+	{
+		interval, err := statquery.FixInterval(0, 300, 0, 7*86400)
+		a.IsNil(err)
+		a.IsTrue(interval == 3600)
+	}
+	{
+		interval, err := statquery.FixInterval(0, 3600, 0, 86400)
+		a.IsNil(err)
+		a.IsTrue(interval == 3600)
+	}
+	{
+		_, err := statquery.FixInterval(600, 3600, 0, 86400)
+		a.IsNotNil(err)
+	}
+	{
+		_, err := statquery.FixInterval(300, 300, 0, 365*86400)
+		a.IsNotNil(err)
+	}
+	{
+		_, err := statquery.FixInterval(300, 300, 100, 100)
+		a.IsNotNil(err)
+	}
+}
+
+func TestAggregator_Result(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var timeFrom = statquery.AlignTime(time.Now().Unix(), 3600) - 3600
+	var timeTo = timeFrom + 3600
+	var aggregator = statquery.NewAggregator([]string{statquery.MetricBytes, statquery.MetricBandwidth}, timeFrom, timeTo, 900)
+	aggregator.Add(timeFrom, "1", map[string]int64{statquery.MetricBytes: 900})
+	aggregator.Add(timeFrom+300, "1", map[string]int64{statquery.MetricBytes: 900})
+	aggregator.Add(timeFrom+1800, "2", map[string]int64{statquery.MetricBytes: 9000})
+	aggregator.Add(timeTo, "2", map[string]int64{statquery.MetricBytes: 9000}) // 超出范围
+	aggregator.EnsureGroup("3")
+
+	var result = aggregator.Result(2)
+	a.IsTrue(len(result) == 2)
+	a.IsTrue(result[0].GroupKey == "2")
+	a.IsTrue(result[1].GroupKey == "1")
+	a.IsTrue(len(result[1].Points) == 4)
+	a.IsTrue(result[1].Points[0].Values[0] == 1800)
+	a.IsTrue(result[1].Points[0].Values[1] == 16)
+	a.IsTrue(result[1].Points[1].Values[0] == 0)
+	a.IsTrue(result[0].Points[2].Values[0] == 9000)
+}
+
+func TestValidateMetrics(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsNil(statquery.ValidateMetrics([]string{"bytes", "bandwidth"}))
+	a.IsNotNil(statquery.ValidateMetrics(nil))
+	a.IsNotNil(statquery.ValidateMetrics([]string{"bytes", "abc"}))
+	a.IsNil(statquery.ValidateGroupBy("server"))
+	a.IsNotNil(statquery.ValidateGroupBy("abc"))
+}
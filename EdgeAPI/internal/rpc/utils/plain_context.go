@@ -3,6 +3,8 @@ package rpcutils
 import (
 	"context"
 	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/traceutils"
 )
 
 func IsRest(ctx context.Context) bool {
@@ -13,9 +15,23 @@ func IsRest(ctx context.Context) bool {
 	return ok
 }
 
+// ImpersonatorAdminId 取得模拟用户登录的管理员ID，不是模拟登录时返回0
+func ImpersonatorAdminId(ctx context.Context) int64 {
+	if ctx == nil {
+		return 0
+	}
+	plainCtx, ok := ctx.(*PlainContext)
+	if !ok {
+		return 0
+	}
+	return plainCtx.ImpersonatorAdminId
+}
+
 type PlainContext struct {
-	UserType string
-	UserId   int64
+	UserType            string
+	UserId              int64
+	Scopes              []string // 访问令牌的权限范围，为空表示不限制
+	ImpersonatorAdminId int64    // 模拟用户登录的管理员ID
 
 	ctx context.Context
 }
@@ -28,6 +44,11 @@ func NewPlainContext(userType string, userId int64) *PlainContext {
 	}
 }
 
+// SetTraceId 设置请求跟踪ID
+func (this *PlainContext) SetTraceId(traceId string) {
+	this.ctx = traceutils.WithTraceId(this.ctx, traceId)
+}
+
 func (this *PlainContext) Deadline() (deadline time.Time, ok bool) {
 	return this.ctx.Deadline()
 }
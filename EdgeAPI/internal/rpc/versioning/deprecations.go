@@ -0,0 +1,97 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package versioning
+
+import (
+	"sync"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/apiversions"
+	_ "github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Deprecation 方法的废弃信息
+type Deprecation struct {
+	Method      string              // 方法，格式为 /pb.ServiceName/methodName
+	Since       apiversions.Version // 从哪个版本开始废弃
+	RemovedIn   apiversions.Version // 在哪个版本中删除，为空表示暂不删除
+	Sunset      string              // 计划停用日期，格式为 YYYY-MM-DD
+	Replacement string              // 替代的方法
+	Message     string              // 说明，只能使用ASCII字符
+}
+
+// 废弃方法的补充信息
+// 在proto中使用 option deprecated = true 标记的方法会自动加入废弃列表，这里只需要填写替代方法等补充信息
+var deprecationDetails = map[string]*Deprecation{
+	"/pb.RegionCityService/findAllEnabledRegionCities":                     {Replacement: "/pb.RegionCityService/findAllRegionCities"},
+	"/pb.RegionCityService/findEnabledRegionCity":                          {Replacement: "/pb.RegionCityService/findRegionCity"},
+	"/pb.RegionCountryService/findAllEnabledRegionCountries":               {Replacement: "/pb.RegionCountryService/findAllRegionCountries"},
+	"/pb.RegionCountryService/findEnabledRegionCountry":                    {Replacement: "/pb.RegionCountryService/findRegionCountry"},
+	"/pb.RegionProviderService/findAllEnabledRegionProviders":              {Replacement: "/pb.RegionProviderService/findAllRegionProviders"},
+	"/pb.RegionProviderService/findEnabledRegionProvider":                  {Replacement: "/pb.RegionProviderService/findRegionProvider"},
+	"/pb.RegionProvinceService/findAllEnabledRegionProvincesWithCountryId": {Replacement: "/pb.RegionProvinceService/findAllRegionProvincesWithRegionCountryId"},
+	"/pb.RegionProvinceService/findEnabledRegionProvince":                  {Replacement: "/pb.RegionProvinceService/findRegionProvince"},
+	"/pb.IPLibraryService/createIPLibrary":                                 {Message: "IP libraries are managed by IPLibraryFileService and IPLibraryArtifactService"},
+	"/pb.IPLibraryService/findLatestIPLibraryWithType":                     {Message: "IP libraries are managed by IPLibraryFileService and IPLibraryArtifactService"},
+	"/pb.IPLibraryService/findEnabledIPLibrary":                            {Message: "IP libraries are managed by IPLibraryFileService and IPLibraryArtifactService"},
+	"/pb.IPLibraryService/findAllEnabledIPLibrariesWithType":               {Message: "IP libraries are managed by IPLibraryFileService and IPLibraryArtifactService"},
+	"/pb.IPLibraryService/deleteIPLibrary":                                 {Message: "IP libraries are managed by IPLibraryFileService and IPLibraryArtifactService"},
+}
+
+var deprecationMap map[string]*Deprecation
+var deprecationOnce = sync.Once{}
+
+// FindDeprecation 查找方法的废弃信息，没有废弃时返回nil
+func FindDeprecation(fullMethod string) *Deprecation {
+	deprecationOnce.Do(func() {
+		deprecationMap = loadDeprecations()
+	})
+	return deprecationMap[fullMethod]
+}
+
+// FindAllDeprecations 查找所有废弃的方法
+func FindAllDeprecations() []*Deprecation {
+	deprecationOnce.Do(func() {
+		deprecationMap = loadDeprecations()
+	})
+	var result = []*Deprecation{}
+	for _, deprecation := range deprecationMap {
+		result = append(result, deprecation)
+	}
+	return result
+}
+
+// 从proto定义中读取所有废弃的方法
+func loadDeprecations() map[string]*Deprecation {
+	var result = map[string]*Deprecation{}
+	protoregistry.GlobalFiles.RangeFilesByPackage("pb", func(file protoreflect.FileDescriptor) bool {
+		var services = file.Services()
+		for i := 0; i < services.Len(); i++ {
+			var service = services.Get(i)
+			var methods = service.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				var method = methods.Get(j)
+				options, ok := method.Options().(*descriptorpb.MethodOptions)
+				if !ok || !options.GetDeprecated() {
+					continue
+				}
+
+				var fullMethod = "/" + string(service.FullName()) + "/" + string(method.Name())
+				var deprecation = &Deprecation{}
+				detail, ok := deprecationDetails[fullMethod]
+				if ok {
+					*deprecation = *detail
+				}
+				deprecation.Method = fullMethod
+				if len(deprecation.Since) == 0 {
+					deprecation.Since = apiversions.V1
+				}
+				result[fullMethod] = deprecation
+			}
+		}
+		return true
+	})
+	return result
+}
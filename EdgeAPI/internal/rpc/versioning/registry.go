@@ -0,0 +1,138 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package versioning
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/apiversions"
+	"google.golang.org/grpc/metadata"
+)
+
+// SharedRegistry API版本注册表
+var SharedRegistry = NewRegistry()
+
+// Registry 记录服务和方法从哪个版本开始提供
+// 没有注册的服务：在v1冻结列表中的属于v1，其他的属于最新版本
+type Registry struct {
+	services map[string]apiversions.Version // serviceName => since version
+	methods  map[string]apiversions.Version // fullMethod => since version
+
+	locker sync.RWMutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		services: map[string]apiversions.Version{},
+		methods:  map[string]apiversions.Version{},
+	}
+}
+
+// RegisterService 注册服务从哪个版本开始提供
+func (this *Registry) RegisterService(serviceName string, since apiversions.Version) {
+	this.locker.Lock()
+	this.services[serviceName] = since
+	this.locker.Unlock()
+}
+
+// RegisterMethod 注册方法从哪个版本开始提供，用于在v1已有的服务中增加新方法
+// fullMethod 格式为 /pb.ServiceName/methodName
+func (this *Registry) RegisterMethod(fullMethod string, since apiversions.Version) {
+	this.locker.Lock()
+	this.methods[fullMethod] = since
+	this.locker.Unlock()
+}
+
+// SinceVersion 方法从哪个版本开始提供
+func (this *Registry) SinceVersion(fullMethod string) apiversions.Version {
+	this.locker.RLock()
+	defer this.locker.RUnlock()
+
+	since, ok := this.methods[fullMethod]
+	if ok {
+		return since
+	}
+
+	var serviceName = parseServiceName(fullMethod)
+	since, ok = this.services[serviceName]
+	if ok {
+		return since
+	}
+	if v1Services[serviceName] {
+		return apiversions.V1
+	}
+	return apiversions.Latest
+}
+
+// Check 检查某个版本中是否可以调用某个方法，并返回方法的废弃信息
+func (this *Registry) Check(version apiversions.Version, fullMethod string) (*Deprecation, error) {
+	// 只检查API服务，忽略健康检查等内置服务
+	if !strings.HasPrefix(fullMethod, "/pb.") {
+		return nil, nil
+	}
+
+	var since = this.SinceVersion(fullMethod)
+	if apiversions.Compare(version, since) < 0 {
+		return nil, errors.New("the method is not available in api " + version + ", please use " + since + " or later")
+	}
+
+	var deprecation = FindDeprecation(fullMethod)
+	if deprecation != nil && len(deprecation.RemovedIn) > 0 && apiversions.Compare(version, deprecation.RemovedIn) >= 0 {
+		var message = "the method has been removed in api " + deprecation.RemovedIn
+		if len(deprecation.Replacement) > 0 {
+			message += ", please use '" + deprecation.Replacement + "' instead"
+		}
+		return nil, errors.New(message)
+	}
+	return deprecation, nil
+}
+
+// FromIncomingContext 读取gRPC请求中的版本
+func FromIncomingContext(ctx context.Context) (apiversions.Version, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return apiversions.Default, nil
+	}
+	var values = md.Get(apiversions.MetadataKey)
+	if len(values) == 0 {
+		return apiversions.Default, nil
+	}
+	return apiversions.ParseVersion(values[0])
+}
+
+// ResponseHeaders 需要在响应中返回的版本和废弃信息
+func ResponseHeaders(version apiversions.Version, deprecation *Deprecation) map[string]string {
+	var headers = map[string]string{
+		apiversions.MetadataKey: version,
+	}
+	if deprecation == nil {
+		return headers
+	}
+
+	headers[apiversions.MetadataKeyDeprecated] = "true"
+	if len(deprecation.Sunset) > 0 {
+		headers[apiversions.MetadataKeySunset] = deprecation.Sunset
+	}
+	if len(deprecation.RemovedIn) > 0 {
+		headers[apiversions.MetadataKeyRemovedIn] = deprecation.RemovedIn
+	}
+	if len(deprecation.Replacement) > 0 {
+		headers[apiversions.MetadataKeyReplacement] = deprecation.Replacement
+	}
+	if len(deprecation.Message) > 0 {
+		headers[apiversions.MetadataKeyDeprecation] = deprecation.Message
+	}
+	return headers
+}
+
+// 从 /pb.ServiceName/methodName 中读取服务名
+func parseServiceName(fullMethod string) string {
+	var pieces = strings.Split(strings.TrimPrefix(fullMethod, "/"), "/")
+	if len(pieces) != 2 {
+		return ""
+	}
+	return strings.TrimPrefix(pieces[0], "pb.")
+}
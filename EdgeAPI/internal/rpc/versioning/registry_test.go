@@ -0,0 +1,80 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package versioning_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/rpc/versioning"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/apiversions"
+	"github.com/iwind/TeaGo/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRegistry_Check(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var registry = versioning.NewRegistry()
+
+	// v1已有的服务
+	_, err := registry.Check(apiversions.V1, "/pb.ServerService/findEnabledServer")
+	a.IsNil(err)
+	_, err = registry.Check(apiversions.V2, "/pb.ServerService/findEnabledServer")
+	a.IsNil(err)
+
+	// 没有在v1中的服务
+	_, err = registry.Check(apiversions.V1, "/pb.SomeNewService/doSomething")
+	a.IsTrue(err != nil)
+	_, err = registry.Check(apiversions.V2, "/pb.SomeNewService/doSomething")
+	a.IsNil(err)
+
+	// v1已有服务中新增的方法
+	registry.RegisterMethod("/pb.ServerService/someNewMethod", apiversions.V2)
+	_, err = registry.Check(apiversions.V1, "/pb.ServerService/someNewMethod")
+	a.IsTrue(err != nil)
+	a.IsTrue(registry.SinceVersion("/pb.ServerService/findEnabledServer") == apiversions.V1)
+
+	// 内置服务
+	_, err = registry.Check(apiversions.V1, "/grpc.health.v1.Health/Check")
+	a.IsNil(err)
+}
+
+func TestRegistry_Deprecation(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var registry = versioning.NewRegistry()
+	deprecation, err := registry.Check(apiversions.V1, "/pb.RegionCityService/findAllEnabledRegionCities")
+	a.IsNil(err)
+	a.IsTrue(deprecation != nil)
+	a.IsTrue(deprecation.Replacement == "/pb.RegionCityService/findAllRegionCities")
+
+	var headers = versioning.ResponseHeaders(apiversions.V1, deprecation)
+	a.IsTrue(headers[apiversions.MetadataKey] == apiversions.V1)
+	a.IsTrue(headers[apiversions.MetadataKeyDeprecated] == "true")
+	a.IsTrue(headers[apiversions.MetadataKeyReplacement] == deprecation.Replacement)
+
+	deprecation, err = registry.Check(apiversions.V1, "/pb.RegionCityService/findAllRegionCities")
+	a.IsNil(err)
+	a.IsTrue(deprecation == nil)
+	a.IsTrue(len(versioning.ResponseHeaders(apiversions.V1, nil)) == 1)
+
+	a.IsTrue(len(versioning.FindAllDeprecations()) > 0)
+}
+
+func TestFromIncomingContext(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	version, err := versioning.FromIncomingContext(context.Background())
+	a.IsNil(err)
+	a.IsTrue(version == apiversions.Default)
+
+	var ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiversions.MetadataKey, "v2"))
+	version, err = versioning.FromIncomingContext(ctx)
+	a.IsNil(err)
+	a.IsTrue(version == apiversions.V2)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiversions.MetadataKey, "v9"))
+	_, err = versioning.FromIncomingContext(ctx)
+	a.IsTrue(err != nil)
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package versioning
+
+// v1版本冻结时已有的服务
+// 此列表不能再增加或删除服务，之后新增的服务只能在v2及以后的版本中使用
+var v1Services = map[string]bool{
+	"ACMEAuthenticationService":              true,
+	"ACMEProviderAccountService":             true,
+	"ACMEProviderService":                    true,
+	"ACMETaskService":                        true,
+	"ACMEUserService":                        true,
+	"ADNetworkService":                       true,
+	"ADPackageInstanceService":               true,
+	"ADPackagePeriodService":                 true,
+	"ADPackagePriceService":                  true,
+	"ADPackageService":                       true,
+	"APIAccessTokenService":                  true,
+	"APIMethodStatService":                   true,
+	"APINodeService":                         true,
+	"APITokenService":                        true,
+	"AdminService":                           true,
+	"AuditLogService":                        true,
+	"AuthorityKeyService":                    true,
+	"AuthorityNodeService":                   true,
+	"ClientAgentIPService":                   true,
+	"ClientAgentService":                     true,
+	"ConfigBundleService":                    true,
+	"ConfigValidationService":                true,
+	"DBNodeService":                          true,
+	"DBService":                              true,
+	"DNSDomainService":                       true,
+	"DNSProviderService":                     true,
+	"DNSService":                             true,
+	"DNSTaskService":                         true,
+	"DependencyGraphService":                 true,
+	"FileChunkService":                       true,
+	"FileService":                            true,
+	"FirewallService":                        true,
+	"FormalClientBrowserService":             true,
+	"FormalClientSystemService":              true,
+	"HTTPAccessLogPolicyService":             true,
+	"HTTPAccessLogService":                   true,
+	"HTTPAuthPolicyService":                  true,
+	"HTTPCachePolicyService":                 true,
+	"HTTPCacheTaskKeyService":                true,
+	"HTTPCacheTaskService":                   true,
+	"HTTPFastcgiService":                     true,
+	"HTTPFirewallPolicyService":              true,
+	"HTTPFirewallRuleGroupService":           true,
+	"HTTPFirewallRuleSetService":             true,
+	"HTTPGzipService":                        true,
+	"HTTPHeaderPolicyService":                true,
+	"HTTPHeaderService":                      true,
+	"HTTPLocationService":                    true,
+	"HTTPPageService":                        true,
+	"HTTPRewriteRuleService":                 true,
+	"HTTPWebService":                         true,
+	"HTTPWebsocketService":                   true,
+	"IPItemService":                          true,
+	"IPLibraryArtifactService":               true,
+	"IPLibraryFileService":                   true,
+	"IPLibraryService":                       true,
+	"IPListService":                          true,
+	"InternalCAService":                      true,
+	"LatestItemService":                      true,
+	"LogService":                             true,
+	"LoginService":                           true,
+	"LoginSessionService":                    true,
+	"LoginTicketService":                     true,
+	"MessageMediaInstanceService":            true,
+	"MessageMediaService":                    true,
+	"MessageReceiverService":                 true,
+	"MessageRecipientGroupService":           true,
+	"MessageRecipientService":                true,
+	"MessageService":                         true,
+	"MessageSilenceService":                  true,
+	"MessageTaskLogService":                  true,
+	"MessageTaskService":                     true,
+	"MetricChartService":                     true,
+	"MetricItemService":                      true,
+	"MetricStatService":                      true,
+	"NSAccessLogService":                     true,
+	"NSClusterService":                       true,
+	"NSDomainGroupService":                   true,
+	"NSDomainService":                        true,
+	"NSKeyService":                           true,
+	"NSNodeService":                          true,
+	"NSPlanService":                          true,
+	"NSQuestionOptionService":                true,
+	"NSRecordHourlyStatService":              true,
+	"NSRecordService":                        true,
+	"NSRouteCategoryService":                 true,
+	"NSRouteService":                         true,
+	"NSService":                              true,
+	"NSUserPlanService":                      true,
+	"NodeActionService":                      true,
+	"NodeClusterFirewallActionService":       true,
+	"NodeClusterMetricItemService":           true,
+	"NodeClusterService":                     true,
+	"NodeCommandService":                     true,
+	"NodeGrantService":                       true,
+	"NodeGroupService":                       true,
+	"NodeIPAddressLogService":                true,
+	"NodeIPAddressService":                   true,
+	"NodeIPAddressThresholdService":          true,
+	"NodeLogService":                         true,
+	"NodeLoginService":                       true,
+	"NodePriceItemService":                   true,
+	"NodeRegionService":                      true,
+	"NodeService":                            true,
+	"NodeTaskService":                        true,
+	"NodeThresholdService":                   true,
+	"NodeValueService":                       true,
+	"OrderMethodService":                     true,
+	"OriginService":                          true,
+	"PingService":                            true,
+	"PlanService":                            true,
+	"PostCategoryService":                    true,
+	"PostService":                            true,
+	"PriceService":                           true,
+	"QuotaService":                           true,
+	"RegionCityService":                      true,
+	"RegionCountryService":                   true,
+	"RegionProviderService":                  true,
+	"RegionProvinceService":                  true,
+	"RegionTownService":                      true,
+	"ReportNodeGroupService":                 true,
+	"ReportNodeService":                      true,
+	"ReportResultService":                    true,
+	"ReverseProxyService":                    true,
+	"SMSSenderService":                       true,
+	"SSLCertDeployTargetService":             true,
+	"SSLCertService":                         true,
+	"SSLPolicyService":                       true,
+	"ScriptService":                          true,
+	"SearchService":                          true,
+	"ServerBandwidthStatService":             true,
+	"ServerBillService":                      true,
+	"ServerClientBrowserMonthlyStatService":  true,
+	"ServerClientSystemMonthlyStatService":   true,
+	"ServerDailyStatService":                 true,
+	"ServerDomainHourlyStatService":          true,
+	"ServerGroupService":                     true,
+	"ServerHTTPFirewallDailyStatService":     true,
+	"ServerRegionCityMonthlyStatService":     true,
+	"ServerRegionCountryMonthlyStatService":  true,
+	"ServerRegionProviderMonthlyStatService": true,
+	"ServerRegionProvinceMonthlyStatService": true,
+	"ServerService":                          true,
+	"ServerStatBoardChartService":            true,
+	"ServerStatBoardService":                 true,
+	"StatQueryService":                       true,
+	"SysLockerService":                       true,
+	"SysSettingService":                      true,
+	"TaskService":                            true,
+	"TrafficDailyStatService":                true,
+	"TrafficPackagePeriodService":            true,
+	"TrafficPackagePriceService":             true,
+	"TrafficPackageService":                  true,
+	"UpdatingServerListService":              true,
+	"UserADInstanceService":                  true,
+	"UserAccessKeyService":                   true,
+	"UserAccountDailyStatService":            true,
+	"UserAccountLogService":                  true,
+	"UserAccountService":                     true,
+	"UserAlertRuleService":                   true,
+	"UserBillService":                        true,
+	"UserEmailVerificationService":           true,
+	"UserIdentityService":                    true,
+	"UserMobileVerificationService":          true,
+	"UserNodeService":                        true,
+	"UserOrderService":                       true,
+	"UserPlanService":                        true,
+	"UserScriptService":                      true,
+	"UserService":                            true,
+	"UserTicketCategoryService":              true,
+	"UserTicketLogService":                   true,
+	"UserTicketService":                      true,
+	"UserTrafficBillService":                 true,
+	"UserTrafficPackageService":              true,
+	"UserVerifyCodeService":                  true,
+	"WebhookService":                         true,
+}
@@ -0,0 +1,303 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package search
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/iwind/TeaGo/dbs"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+const (
+	DefaultSize = 20
+	MaxSize     = 100
+)
+
+// ResultType 搜索结果类型
+type ResultType = string
+
+const (
+	ResultTypeServer  ResultType = "server"  // 网站服务，按域名搜索
+	ResultTypeSSLCert ResultType = "sslCert" // 证书，按CN和DNS名称搜索
+	ResultTypeNode    ResultType = "node"    // 边缘节点，按IP搜索
+	ResultTypeUser    ResultType = "user"    // 用户，按邮箱搜索
+)
+
+// FindAllResultTypes 所有结果类型，分值相同时按此顺序排列
+func FindAllResultTypes() []ResultType {
+	return []ResultType{ResultTypeServer, ResultTypeSSLCert, ResultTypeNode, ResultTypeUser}
+}
+
+// IsValidResultType 检查结果类型是否合法
+func IsValidResultType(resultType ResultType) bool {
+	for _, t := range FindAllResultTypes() {
+		if t == resultType {
+			return true
+		}
+	}
+	return false
+}
+
+// Result 统一的搜索结果
+type Result struct {
+	Type         ResultType
+	Id           int64
+	Title        string // 标题
+	Subtitle     string // 副标题
+	MatchedField string // 匹配的字段
+	MatchedValue string // 匹配的值
+	Score        int    // 匹配度，越大越靠前
+}
+
+// 用于匹配的字段
+type matchField struct {
+	name    string
+	values  []string
+	penalty int // 次要字段降低一点分值，让主要字段的匹配排在前面
+}
+
+// MatchScore 计算关键词和某个值的匹配度，不匹配时返回0
+func MatchScore(keyword string, value string) int {
+	keyword = strings.ToLower(strings.TrimSpace(keyword))
+	value = strings.ToLower(value)
+	if len(keyword) == 0 || len(value) == 0 {
+		return 0
+	}
+
+	switch {
+	case value == keyword:
+		return 100
+	case strings.HasPrefix(value, keyword):
+		return 80
+	case strings.HasSuffix(value, "."+keyword): // 上级域名
+		return 70
+	case strings.Contains(value, keyword):
+		return 50
+	}
+	return 0
+}
+
+// 从一组字段中选出匹配度最高的值
+func bestMatch(keyword string, fields []*matchField) (fieldName string, value string, score int) {
+	for _, field := range fields {
+		for _, v := range field.values {
+			var s = MatchScore(keyword, v)
+			if s <= 0 {
+				continue
+			}
+			s -= field.penalty
+			if s > score {
+				fieldName = field.name
+				value = v
+				score = s
+			}
+		}
+	}
+
+	// 数据库中匹配到，但是解析后的值中没有匹配到（比如JSON转义），仍然保留在结果中
+	if score <= 0 {
+		score = 1
+	}
+	return
+}
+
+// Sort 对结果排序：匹配度从高到低，相同匹配度时按类型和ID排列
+func Sort(results []*Result) {
+	var typeOrders = map[ResultType]int{}
+	for index, resultType := range FindAllResultTypes() {
+		typeOrders[resultType] = index
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		var r1 = results[i]
+		var r2 = results[j]
+		if r1.Score != r2.Score {
+			return r1.Score > r2.Score
+		}
+		if r1.Type != r2.Type {
+			return typeOrders[r1.Type] < typeOrders[r2.Type]
+		}
+		return r1.Id > r2.Id
+	})
+}
+
+// Search 在多类对象中搜索关键词
+// resultTypes 为空时搜索所有类型；size 为返回的最大结果数
+func Search(tx *dbs.Tx, keyword string, resultTypes []ResultType, size int64) ([]*Result, error) {
+	keyword = strings.TrimSpace(keyword)
+	if len(keyword) == 0 {
+		return nil, nil
+	}
+	if size <= 0 {
+		size = DefaultSize
+	} else if size > MaxSize {
+		size = MaxSize
+	}
+	if len(resultTypes) == 0 {
+		resultTypes = FindAllResultTypes()
+	}
+
+	var results = []*Result{}
+	for _, resultType := range resultTypes {
+		var typeResults []*Result
+		var err error
+		switch resultType {
+		case ResultTypeServer:
+			typeResults, err = searchServers(tx, keyword, size)
+		case ResultTypeSSLCert:
+			typeResults, err = searchSSLCerts(tx, keyword, size)
+		case ResultTypeNode:
+			typeResults, err = searchNodes(tx, keyword, size)
+		case ResultTypeUser:
+			typeResults, err = searchUsers(tx, keyword, size)
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, typeResults...)
+	}
+
+	Sort(results)
+
+	if int64(len(results)) > size {
+		results = results[:size]
+	}
+	return results, nil
+}
+
+func searchServers(tx *dbs.Tx, keyword string, size int64) ([]*Result, error) {
+	servers, err := models.SharedServerDAO.FindAllEnabledServersWithKeyword(tx, keyword, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var results = []*Result{}
+	for _, server := range servers {
+		var domains = []string{}
+		serverNames, _ := server.DecodeServerNames()
+		for _, serverName := range serverNames {
+			if len(serverName.Name) > 0 {
+				domains = append(domains, serverName.Name)
+			}
+			domains = append(domains, serverName.SubNames...)
+		}
+
+		fieldName, value, score := bestMatch(keyword, []*matchField{
+			{name: "serverName", values: domains},
+			{name: "name", values: []string{server.Name}, penalty: 5},
+		})
+		results = append(results, &Result{
+			Type:         ResultTypeServer,
+			Id:           int64(server.Id),
+			Title:        server.Name,
+			Subtitle:     server.FirstServerName(),
+			MatchedField: fieldName,
+			MatchedValue: value,
+			Score:        score,
+		})
+	}
+	return results, nil
+}
+
+func searchSSLCerts(tx *dbs.Tx, keyword string, size int64) ([]*Result, error) {
+	certs, err := models.SharedSSLCertDAO.FindAllEnabledCertsWithKeyword(tx, keyword, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var results = []*Result{}
+	for _, cert := range certs {
+		var commonNames = cert.DecodeCommonNames()
+		fieldName, value, score := bestMatch(keyword, []*matchField{
+			{name: "commonName", values: commonNames},
+			{name: "dnsName", values: cert.DecodeDNSNames()},
+			{name: "name", values: []string{cert.Name}, penalty: 5},
+		})
+
+		var subtitle = strings.Join(commonNames, ", ")
+		if cert.TimeEndAt > 0 {
+			if len(subtitle) > 0 {
+				subtitle += " "
+			}
+			subtitle += "(" + timeutil.FormatTime("Y-m-d", int64(cert.TimeEndAt)) + ")"
+		}
+
+		results = append(results, &Result{
+			Type:         ResultTypeSSLCert,
+			Id:           int64(cert.Id),
+			Title:        cert.Name,
+			Subtitle:     subtitle,
+			MatchedField: fieldName,
+			MatchedValue: value,
+			Score:        score,
+		})
+	}
+	return results, nil
+}
+
+func searchNodes(tx *dbs.Tx, keyword string, size int64) ([]*Result, error) {
+	nodes, err := models.SharedNodeDAO.FindAllEnabledNodesWithKeyword(tx, keyword, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var results = []*Result{}
+	for _, node := range nodes {
+		ips, err := models.SharedNodeIPAddressDAO.FindAllEnabledAddressStringsWithNode(tx, int64(node.Id), nodeconfigs.NodeRoleNode)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldName, value, score := bestMatch(keyword, []*matchField{
+			{name: "ip", values: ips},
+			{name: "name", values: []string{node.Name}, penalty: 5},
+		})
+
+		var subtitle = strings.Join(ips, ", ")
+		results = append(results, &Result{
+			Type:         ResultTypeNode,
+			Id:           int64(node.Id),
+			Title:        node.Name,
+			Subtitle:     subtitle,
+			MatchedField: fieldName,
+			MatchedValue: value,
+			Score:        score,
+		})
+	}
+	return results, nil
+}
+
+func searchUsers(tx *dbs.Tx, keyword string, size int64) ([]*Result, error) {
+	users, err := models.SharedUserDAO.FindAllEnabledUsersWithKeyword(tx, keyword, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var results = []*Result{}
+	for _, user := range users {
+		fieldName, value, score := bestMatch(keyword, []*matchField{
+			{name: "email", values: []string{user.Email}},
+			{name: "username", values: []string{user.Username}, penalty: 5},
+			{name: "fullname", values: []string{user.Fullname}, penalty: 5},
+		})
+
+		var title = user.Username
+		if len(user.Fullname) > 0 {
+			title += " (" + user.Fullname + ")"
+		}
+		results = append(results, &Result{
+			Type:         ResultTypeUser,
+			Id:           int64(user.Id),
+			Title:        title,
+			Subtitle:     user.Email,
+			MatchedField: fieldName,
+			MatchedValue: value,
+			Score:        score,
+		})
+	}
+	return results, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package search_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/search"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestMatchScore(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsTrue(search.MatchScore("example.com", "example.com") == 100)
+	a.IsTrue(search.MatchScore("Example.COM", "example.com") == 100)
+	a.IsTrue(search.MatchScore("192.168.1", "192.168.1.100") == 80)
+	a.IsTrue(search.MatchScore("example.com", "www.example.com") == 70)
+	a.IsTrue(search.MatchScore("ample", "www.example.com") == 50)
+	a.IsTrue(search.MatchScore("other", "www.example.com") == 0)
+	a.IsTrue(search.MatchScore("", "www.example.com") == 0)
+}
+
+func TestSort(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var results = []*search.Result{
+		{Type: search.ResultTypeUser, Id: 1, Score: 100},
+		{Type: search.ResultTypeServer, Id: 2, Score: 50},
+		{Type: search.ResultTypeServer, Id: 3, Score: 100},
+		{Type: search.ResultTypeServer, Id: 4, Score: 100},
+		{Type: search.ResultTypeNode, Id: 5, Score: 80},
+	}
+	search.Sort(results)
+
+	var ids = []int64{}
+	for _, result := range results {
+		ids = append(ids, result.Id)
+	}
+	a.IsTrue(len(ids) == 5)
+	a.IsTrue(ids[0] == 4 && ids[1] == 3 && ids[2] == 1 && ids[3] == 5 && ids[4] == 2)
+}
+
+func TestIsValidResultType(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsTrue(search.IsValidResultType(search.ResultTypeSSLCert))
+	a.IsFalse(search.IsValidResultType("order"))
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"sync"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+)
+
+const (
+	SQLDialectMySQL    = "mysql"
+	SQLDialectPostgres = "postgres"
+	SQLDialectSQLite   = "sqlite"
+)
+
+// 编译时使用的数据库方言
+// 可以通过 -ldflags "-X github.com/TeaOSLab/EdgeAPI/internal/setup.sqlDialectName=postgres" 修改
+var sqlDialectName = SQLDialectMySQL
+
+var sqlDialectMap = map[string]SQLDialect{} // name => dialect
+var sqlDialectLocker = &sync.RWMutex{}
+
+// SQLDialect 数据库方言，用来将与数据库无关的表结构转换为具体数据库的SQL语句
+type SQLDialect interface {
+	// Name 方言名称
+	Name() string
+
+	// QuoteIdentifier 转义表名、字段名等标识符
+	QuoteIdentifier(name string) string
+
+	// CreateTable 创建表格的语句
+	CreateTable(table *SQLSchemaTable) []string
+
+	// AddColumn 增加字段的语句
+	AddColumn(tableName string, column *SQLSchemaColumn) []string
+
+	// ModifyColumn 修改字段的语句
+	ModifyColumn(tableName string, column *SQLSchemaColumn) []string
+
+	// DropColumn 删除字段的语句
+	DropColumn(tableName string, columnName string) []string
+
+	// AddIndex 增加索引的语句
+	AddIndex(tableName string, index *SQLSchemaIndex) []string
+
+	// DropIndex 删除索引的语句
+	DropIndex(tableName string, indexName string) []string
+}
+
+// RegisterSQLDialect 注册数据库方言
+func RegisterSQLDialect(dialect SQLDialect) {
+	sqlDialectLocker.Lock()
+	sqlDialectMap[dialect.Name()] = dialect
+	sqlDialectLocker.Unlock()
+}
+
+// FindSQLDialect 根据名称查找数据库方言
+func FindSQLDialect(name string) SQLDialect {
+	sqlDialectLocker.RLock()
+	defer sqlDialectLocker.RUnlock()
+	return sqlDialectMap[name]
+}
+
+// FindSQLDialectWithDriver 根据数据库驱动查找方言，没有对应的方言时使用编译时选择的方言
+func FindSQLDialectWithDriver(driverName string) SQLDialect {
+	if driverName == sqlite.DriverName {
+		return FindSQLDialect(SQLDialectSQLite)
+	}
+	return DefaultSQLDialect()
+}
+
+// DefaultSQLDialect 编译时选择的数据库方言
+func DefaultSQLDialect() SQLDialect {
+	var dialect = FindSQLDialect(sqlDialectName)
+	if dialect == nil {
+		dialect = FindSQLDialect(SQLDialectMySQL)
+	}
+	return dialect
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"strconv"
+	"strings"
+)
+
+func init() {
+	RegisterSQLDialect(&MySQLDialect{})
+}
+
+// MySQLDialect MySQL方言
+// 生成的建表语句和 SHOW CREATE TABLE 的结果保持一致
+type MySQLDialect struct {
+}
+
+func (this *MySQLDialect) Name() string {
+	return SQLDialectMySQL
+}
+
+func (this *MySQLDialect) QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func (this *MySQLDialect) CreateTable(table *SQLSchemaTable) []string {
+	var lines = []string{}
+	for _, column := range table.Columns {
+		lines = append(lines, "  "+this.QuoteIdentifier(column.Name)+" "+this.columnDefinition(column))
+	}
+	for _, index := range table.Indexes {
+		lines = append(lines, "  "+this.indexDefinition(index))
+	}
+
+	var engine = table.Engine
+	if len(engine) == 0 {
+		engine = "InnoDB"
+	}
+	var charset = table.Charset
+	if len(charset) == 0 {
+		charset = "utf8mb4"
+	} else if pos := strings.Index(charset, "_"); pos > 0 {
+		// utf8mb4_general_ci => utf8mb4
+		charset = charset[:pos]
+	}
+
+	var sql = "CREATE TABLE " + this.QuoteIdentifier(table.Name) + " (\n" + strings.Join(lines, ",\n") + "\n) ENGINE=" + engine + " DEFAULT CHARSET=" + charset
+	if len(table.Comment) > 0 {
+		sql += " COMMENT=" + this.quoteString(table.Comment)
+	}
+	return []string{sql}
+}
+
+func (this *MySQLDialect) AddColumn(tableName string, column *SQLSchemaColumn) []string {
+	return []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " ADD " + this.QuoteIdentifier(column.Name) + " " + this.columnDefinition(column)}
+}
+
+func (this *MySQLDialect) ModifyColumn(tableName string, column *SQLSchemaColumn) []string {
+	return []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " MODIFY " + this.QuoteIdentifier(column.Name) + " " + this.columnDefinition(column)}
+}
+
+func (this *MySQLDialect) DropColumn(tableName string, columnName string) []string {
+	return []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " DROP COLUMN " + this.QuoteIdentifier(columnName)}
+}
+
+func (this *MySQLDialect) AddIndex(tableName string, index *SQLSchemaIndex) []string {
+	return []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " ADD " + this.indexDefinition(index)}
+}
+
+func (this *MySQLDialect) DropIndex(tableName string, indexName string) []string {
+	if indexName == "PRIMARY" {
+		return []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " DROP PRIMARY KEY"}
+	}
+	return []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " DROP KEY " + this.QuoteIdentifier(indexName)}
+}
+
+// 字段定义
+func (this *MySQLDialect) columnDefinition(column *SQLSchemaColumn) string {
+	var def = column.Type
+	switch {
+	case column.Type == SQLColumnTypeDecimal:
+		def += "(" + strconv.Itoa(column.Length) + "," + strconv.Itoa(column.Scale) + ")"
+	case column.Length > 0:
+		def += "(" + strconv.Itoa(column.Length) + ")"
+	}
+	if column.IsUnsigned {
+		def += " unsigned"
+	}
+	if column.IsNotNull || column.AutoIncrement {
+		def += " NOT NULL"
+	}
+	if column.AutoIncrement {
+		def += " AUTO_INCREMENT"
+	}
+	if column.HasDefault {
+		def += " DEFAULT " + this.quoteString(column.Default)
+	} else if !column.IsNotNull && !column.AutoIncrement && !column.IsLarge() {
+		def += " DEFAULT NULL"
+	}
+	if len(column.Comment) > 0 {
+		def += " COMMENT " + this.quoteString(column.Comment)
+	}
+	return def
+}
+
+// 索引定义
+func (this *MySQLDialect) indexDefinition(index *SQLSchemaIndex) string {
+	var fields = []string{}
+	for _, field := range index.Fields {
+		fields = append(fields, this.QuoteIdentifier(field))
+	}
+
+	var def string
+	switch {
+	case index.IsPrimary:
+		def = "PRIMARY KEY"
+	case index.IsUnique:
+		def = "UNIQUE KEY " + this.QuoteIdentifier(index.Name)
+	default:
+		def = "KEY " + this.QuoteIdentifier(index.Name)
+	}
+	def += " (" + strings.Join(fields, ",") + ")"
+	if len(index.Method) > 0 {
+		def += " USING " + index.Method
+	}
+	return def
+}
+
+func (this *MySQLDialect) quoteString(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "'", "''")
+	return "'" + s + "'"
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"strconv"
+	"strings"
+)
+
+func init() {
+	RegisterSQLDialect(&PostgresDialect{})
+}
+
+// PostgresDialect PostgreSQL方言
+// 注释使用 COMMENT ON 单独设置；索引名在同一个Schema中需唯一，所以会加上表名作为前缀
+type PostgresDialect struct {
+}
+
+func (this *PostgresDialect) Name() string {
+	return SQLDialectPostgres
+}
+
+func (this *PostgresDialect) QuoteIdentifier(name string) string {
+	return "\"" + strings.ReplaceAll(name, "\"", "\"\"") + "\""
+}
+
+func (this *PostgresDialect) CreateTable(table *SQLSchemaTable) []string {
+	var lines = []string{}
+	for _, column := range table.Columns {
+		lines = append(lines, "  "+this.QuoteIdentifier(column.Name)+" "+this.columnDefinition(column))
+	}
+	for _, index := range table.Indexes {
+		if index.IsPrimary {
+			lines = append(lines, "  PRIMARY KEY ("+this.joinFields(index.Fields)+")")
+		}
+	}
+
+	var result = []string{"CREATE TABLE " + this.QuoteIdentifier(table.Name) + " (\n" + strings.Join(lines, ",\n") + "\n)"}
+
+	// 索引
+	for _, index := range table.Indexes {
+		if !index.IsPrimary {
+			result = append(result, this.AddIndex(table.Name, index)...)
+		}
+	}
+
+	// 注释
+	if len(table.Comment) > 0 {
+		result = append(result, "COMMENT ON TABLE "+this.QuoteIdentifier(table.Name)+" IS "+this.quoteString(table.Comment))
+	}
+	for _, column := range table.Columns {
+		if len(column.Comment) > 0 {
+			result = append(result, this.commentColumn(table.Name, column))
+		}
+	}
+
+	return result
+}
+
+func (this *PostgresDialect) AddColumn(tableName string, column *SQLSchemaColumn) []string {
+	var result = []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " ADD COLUMN " + this.QuoteIdentifier(column.Name) + " " + this.columnDefinition(column)}
+	if len(column.Comment) > 0 {
+		result = append(result, this.commentColumn(tableName, column))
+	}
+	return result
+}
+
+func (this *PostgresDialect) ModifyColumn(tableName string, column *SQLSchemaColumn) []string {
+	var prefix = "ALTER TABLE " + this.QuoteIdentifier(tableName) + " ALTER COLUMN " + this.QuoteIdentifier(column.Name)
+	var result = []string{prefix + " TYPE " + this.columnType(column)}
+	if column.HasDefault {
+		result = append(result, prefix+" SET DEFAULT "+this.quoteString(column.Default))
+	} else if !column.AutoIncrement {
+		result = append(result, prefix+" DROP DEFAULT")
+	}
+	if column.IsNotNull || column.AutoIncrement {
+		result = append(result, prefix+" SET NOT NULL")
+	} else {
+		result = append(result, prefix+" DROP NOT NULL")
+	}
+	result = append(result, this.commentColumn(tableName, column))
+	return result
+}
+
+func (this *PostgresDialect) DropColumn(tableName string, columnName string) []string {
+	return []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " DROP COLUMN " + this.QuoteIdentifier(columnName)}
+}
+
+func (this *PostgresDialect) AddIndex(tableName string, index *SQLSchemaIndex) []string {
+	if index.IsPrimary {
+		return []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " ADD PRIMARY KEY (" + this.joinFields(index.Fields) + ")"}
+	}
+
+	var sql = "CREATE "
+	if index.IsUnique {
+		sql += "UNIQUE "
+	}
+	sql += "INDEX " + this.QuoteIdentifier(this.indexName(tableName, index.Name)) + " ON " + this.QuoteIdentifier(tableName)
+	if len(index.Method) > 0 {
+		sql += " USING " + strings.ToLower(index.Method)
+	}
+	sql += " (" + this.joinFields(index.Fields) + ")"
+	return []string{sql}
+}
+
+func (this *PostgresDialect) DropIndex(tableName string, indexName string) []string {
+	if indexName == "PRIMARY" {
+		return []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " DROP CONSTRAINT " + this.QuoteIdentifier(tableName+"_pkey")}
+	}
+	return []string{"DROP INDEX " + this.QuoteIdentifier(this.indexName(tableName, indexName))}
+}
+
+// 字段类型
+// PostgreSQL不支持无符号整数，所以无符号整数会使用更大的类型来存储
+func (this *PostgresDialect) columnType(column *SQLSchemaColumn) string {
+	switch column.Type {
+	case SQLColumnTypeTinyInt:
+		return "SMALLINT"
+	case SQLColumnTypeSmallInt:
+		if column.IsUnsigned {
+			return "INTEGER"
+		}
+		return "SMALLINT"
+	case SQLColumnTypeInt:
+		if column.IsUnsigned {
+			return "BIGINT"
+		}
+		return "INTEGER"
+	case SQLColumnTypeBigInt:
+		if column.IsUnsigned {
+			return "NUMERIC(20,0)"
+		}
+		return "BIGINT"
+	case SQLColumnTypeDecimal:
+		return "NUMERIC(" + strconv.Itoa(column.Length) + "," + strconv.Itoa(column.Scale) + ")"
+	case SQLColumnTypeVarchar:
+		return "VARCHAR(" + strconv.Itoa(column.Length) + ")"
+	case SQLColumnTypeText, SQLColumnTypeLongText:
+		return "TEXT"
+	case SQLColumnTypeVarBinary, SQLColumnTypeBlob, SQLColumnTypeMediumBlob, SQLColumnTypeLongBlob:
+		return "BYTEA"
+	case SQLColumnTypeJSON:
+		return "JSON"
+	case SQLColumnTypeDate:
+		return "DATE"
+	case SQLColumnTypeTime:
+		return "TIME"
+	}
+	return strings.ToUpper(column.Type)
+}
+
+// 字段定义
+func (this *PostgresDialect) columnDefinition(column *SQLSchemaColumn) string {
+	// 自增字段
+	// 自增ID不会超过 BIGINT 的范围，所以不需要使用 NUMERIC
+	if column.AutoIncrement {
+		var def = "BIGINT"
+		if column.Type == SQLColumnTypeInt && !column.IsUnsigned {
+			def = "INTEGER"
+		}
+		return def + " GENERATED BY DEFAULT AS IDENTITY"
+	}
+
+	var def = this.columnType(column)
+	if column.IsNotNull {
+		def += " NOT NULL"
+	}
+	if column.HasDefault {
+		def += " DEFAULT " + this.quoteString(column.Default)
+	}
+	return def
+}
+
+func (this *PostgresDialect) commentColumn(tableName string, column *SQLSchemaColumn) string {
+	return "COMMENT ON COLUMN " + this.QuoteIdentifier(tableName) + "." + this.QuoteIdentifier(column.Name) + " IS " + this.quoteString(column.Comment)
+}
+
+func (this *PostgresDialect) indexName(tableName string, indexName string) string {
+	return tableName + "_" + indexName
+}
+
+func (this *PostgresDialect) joinFields(fields []string) string {
+	var result = []string{}
+	for _, field := range fields {
+		result = append(result, this.QuoteIdentifier(field))
+	}
+	return strings.Join(result, ",")
+}
+
+func (this *PostgresDialect) quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
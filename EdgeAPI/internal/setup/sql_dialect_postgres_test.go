@@ -0,0 +1,63 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestPostgresDialect_CreateTable(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var dialect = FindSQLDialect(SQLDialectPostgres)
+	var sqlStrings = dialect.CreateTable(&SQLSchemaTable{
+		Name:    "edgeTests",
+		Comment: "测试",
+		Columns: []*SQLSchemaColumn{
+			{Name: "id", Type: SQLColumnTypeBigInt, Length: 11, IsUnsigned: true, AutoIncrement: true, Comment: "ID"},
+			{Name: "isOn", Type: SQLColumnTypeTinyInt, Length: 1, IsUnsigned: true, HasDefault: true, Default: "1"},
+			{Name: "name", Type: SQLColumnTypeVarchar, Length: 255, Comment: "名称"},
+		},
+		Indexes: []*SQLSchemaIndex{
+			{Name: "PRIMARY", Fields: []string{"id"}, IsPrimary: true, IsUnique: true},
+			{Name: "name", Fields: []string{"name"}, IsUnique: true, Method: "BTREE"},
+		},
+	})
+	for _, sqlString := range sqlStrings {
+		t.Log(sqlString)
+	}
+	a.IsTrue(len(sqlStrings) == 5)
+	a.IsTrue(sqlStrings[0] == "CREATE TABLE \"edgeTests\" (\n"+
+		"  \"id\" BIGINT GENERATED BY DEFAULT AS IDENTITY,\n"+
+		"  \"isOn\" SMALLINT DEFAULT '1',\n"+
+		"  \"name\" VARCHAR(255),\n"+
+		"  PRIMARY KEY (\"id\")\n"+
+		")")
+	a.IsTrue(sqlStrings[1] == "CREATE UNIQUE INDEX \"edgeTests_name\" ON \"edgeTests\" USING btree (\"name\")")
+	a.IsTrue(sqlStrings[2] == "COMMENT ON TABLE \"edgeTests\" IS '测试'")
+}
+
+func TestPostgresDialect_AllTables(t *testing.T) {
+	var result = &SQLDumpResult{}
+	err := json.Unmarshal(sqlData, result)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dialect = FindSQLDialect(SQLDialectPostgres)
+	for _, table := range result.Tables {
+		schemaTable, err := ParseSQLSchemaTable(table)
+		if err != nil {
+			t.Fatal(table.Name + ": " + err.Error())
+		}
+		for _, sqlString := range dialect.CreateTable(schemaTable) {
+			if strings.Contains(sqlString, "`") {
+				t.Fatal(table.Name + ": unexpected mysql identifier: " + sqlString)
+			}
+		}
+	}
+}
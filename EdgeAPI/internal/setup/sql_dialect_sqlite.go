@@ -0,0 +1,139 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"strings"
+)
+
+func init() {
+	RegisterSQLDialect(&SQLiteDialect{})
+}
+
+// SQLiteDialect SQLite方言，用于单机部署
+// SQLite只有几种存储类型，所以字段长度和无符号等信息会被忽略；不支持注释和修改字段
+type SQLiteDialect struct {
+}
+
+func (this *SQLiteDialect) Name() string {
+	return SQLDialectSQLite
+}
+
+func (this *SQLiteDialect) QuoteIdentifier(name string) string {
+	return "\"" + strings.ReplaceAll(name, "\"", "\"\"") + "\""
+}
+
+func (this *SQLiteDialect) CreateTable(table *SQLSchemaTable) []string {
+	var lines = []string{}
+	var hasAutoIncrement = false
+	for _, column := range table.Columns {
+		if column.AutoIncrement {
+			hasAutoIncrement = true
+		}
+		lines = append(lines, "  "+this.QuoteIdentifier(column.Name)+" "+this.columnDefinition(column))
+	}
+	for _, index := range table.Indexes {
+		// 自增字段已经是主键
+		if index.IsPrimary && !hasAutoIncrement {
+			lines = append(lines, "  PRIMARY KEY ("+this.joinFields(index.Fields)+")")
+		}
+	}
+
+	var result = []string{"CREATE TABLE " + this.QuoteIdentifier(table.Name) + " (\n" + strings.Join(lines, ",\n") + "\n)"}
+
+	// 索引
+	for _, index := range table.Indexes {
+		if !index.IsPrimary {
+			result = append(result, this.AddIndex(table.Name, index)...)
+		}
+	}
+
+	return result
+}
+
+func (this *SQLiteDialect) AddColumn(tableName string, column *SQLSchemaColumn) []string {
+	// 增加的字段如果不能为空，则必须有默认值
+	var def = this.columnDefinition(column)
+	if column.IsNotNull && !column.HasDefault {
+		def = strings.TrimSuffix(def, " NOT NULL")
+	}
+	return []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " ADD COLUMN " + this.QuoteIdentifier(column.Name) + " " + def}
+}
+
+// ModifyColumn SQLite不支持修改字段，因为存储类型是动态的，大部分修改都可以忽略
+func (this *SQLiteDialect) ModifyColumn(tableName string, column *SQLSchemaColumn) []string {
+	return nil
+}
+
+func (this *SQLiteDialect) DropColumn(tableName string, columnName string) []string {
+	return []string{"ALTER TABLE " + this.QuoteIdentifier(tableName) + " DROP COLUMN " + this.QuoteIdentifier(columnName)}
+}
+
+func (this *SQLiteDialect) AddIndex(tableName string, index *SQLSchemaIndex) []string {
+	// SQLite不支持在建表后修改主键
+	if index.IsPrimary {
+		return nil
+	}
+
+	var sql = "CREATE "
+	if index.IsUnique {
+		sql += "UNIQUE "
+	}
+	sql += "INDEX " + this.QuoteIdentifier(this.indexName(tableName, index.Name)) + " ON " + this.QuoteIdentifier(tableName) + " (" + this.joinFields(index.Fields) + ")"
+	return []string{sql}
+}
+
+func (this *SQLiteDialect) DropIndex(tableName string, indexName string) []string {
+	if indexName == "PRIMARY" {
+		return nil
+	}
+	return []string{"DROP INDEX " + this.QuoteIdentifier(this.indexName(tableName, indexName))}
+}
+
+// 字段类型
+func (this *SQLiteDialect) columnType(column *SQLSchemaColumn) string {
+	switch column.Type {
+	case SQLColumnTypeTinyInt, SQLColumnTypeSmallInt, SQLColumnTypeInt, SQLColumnTypeBigInt:
+		return "INTEGER"
+	case SQLColumnTypeDecimal:
+		return "NUMERIC"
+	case SQLColumnTypeVarBinary, SQLColumnTypeBlob, SQLColumnTypeMediumBlob, SQLColumnTypeLongBlob:
+		return "BLOB"
+	}
+
+	// 字符串、JSON、日期和时间
+	return "TEXT"
+}
+
+// 字段定义
+func (this *SQLiteDialect) columnDefinition(column *SQLSchemaColumn) string {
+	// 只有 INTEGER PRIMARY KEY 才能自增
+	if column.AutoIncrement {
+		return "INTEGER PRIMARY KEY AUTOINCREMENT"
+	}
+
+	var def = this.columnType(column)
+	if column.HasDefault {
+		def += " DEFAULT " + this.quoteString(column.Default)
+	}
+	if column.IsNotNull {
+		def += " NOT NULL"
+	}
+	return def
+}
+
+func (this *SQLiteDialect) indexName(tableName string, indexName string) string {
+	return tableName + "_" + indexName
+}
+
+func (this *SQLiteDialect) joinFields(fields []string) string {
+	var result = []string{}
+	for _, field := range fields {
+		result = append(result, this.QuoteIdentifier(field))
+	}
+	return strings.Join(result, ",")
+}
+
+func (this *SQLiteDialect) quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
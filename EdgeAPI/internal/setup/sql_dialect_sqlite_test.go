@@ -0,0 +1,43 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"testing"
+
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestSQLiteDialect_CreateTable(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var dialect = FindSQLDialect(SQLDialectSQLite)
+	var sqlStrings = dialect.CreateTable(&SQLSchemaTable{
+		Name:    "edgeTests",
+		Comment: "测试",
+		Columns: []*SQLSchemaColumn{
+			{Name: "id", Type: SQLColumnTypeBigInt, Length: 11, IsUnsigned: true, AutoIncrement: true, Comment: "ID"},
+			{Name: "isOn", Type: SQLColumnTypeTinyInt, Length: 1, IsUnsigned: true, HasDefault: true, Default: "1"},
+			{Name: "name", Type: SQLColumnTypeVarchar, Length: 255, IsNotNull: true, Comment: "名称"},
+			{Name: "data", Type: SQLColumnTypeLongBlob},
+		},
+		Indexes: []*SQLSchemaIndex{
+			{Name: "PRIMARY", Fields: []string{"id"}, IsPrimary: true, IsUnique: true},
+			{Name: "name", Fields: []string{"name"}, IsUnique: true, Method: "BTREE"},
+		},
+	})
+	for _, sqlString := range sqlStrings {
+		t.Log(sqlString)
+	}
+	a.IsTrue(len(sqlStrings) == 2)
+	a.IsTrue(sqlStrings[0] == "CREATE TABLE \"edgeTests\" (\n"+
+		"  \"id\" INTEGER PRIMARY KEY AUTOINCREMENT,\n"+
+		"  \"isOn\" INTEGER DEFAULT '1',\n"+
+		"  \"name\" TEXT NOT NULL,\n"+
+		"  \"data\" BLOB\n"+
+		")")
+	a.IsTrue(sqlStrings[1] == "CREATE UNIQUE INDEX \"edgeTests_name\" ON \"edgeTests\" (\"name\")")
+
+	a.IsTrue(len(dialect.ModifyColumn("edgeTests", &SQLSchemaColumn{Name: "name", Type: SQLColumnTypeText})) == 0)
+	a.IsTrue(dialect.AddColumn("edgeTests", &SQLSchemaColumn{Name: "code", Type: SQLColumnTypeVarchar, IsNotNull: true})[0] == "ALTER TABLE \"edgeTests\" ADD COLUMN \"code\" TEXT")
+}
@@ -75,16 +75,24 @@ type sqlItem struct {
 
 type SQLDump struct {
 	logWriter io.Writer
+	dialect   SQLDialect
 }
 
 func NewSQLDump() *SQLDump {
-	return &SQLDump{}
+	return &SQLDump{
+		dialect: DefaultSQLDialect(),
+	}
 }
 
 func (this *SQLDump) SetLogWriter(logWriter io.Writer) {
 	this.logWriter = logWriter
 }
 
+// SetDialect 设置数据库方言
+func (this *SQLDump) SetDialect(dialect SQLDialect) {
+	this.dialect = dialect
+}
+
 // Dump 导出数据
 func (this *SQLDump) Dump(db *dbs.DB, includingRecords bool) (result *SQLDumpResult, err error) {
 	result = &SQLDumpResult{}
@@ -99,8 +107,6 @@ func (this *SQLDump) Dump(db *dbs.DB, includingRecords bool) (result *SQLDumpRes
 		return nil, err
 	}
 
-	var autoIncrementReg = regexp.MustCompile(` AUTO_INCREMENT=\d+`)
-
 	for _, table := range fullTableMap {
 		var tableName = table.Name
 
@@ -112,32 +118,7 @@ func (this *SQLDump) Dump(db *dbs.DB, includingRecords bool) (result *SQLDumpRes
 			continue
 		}
 
-		var sqlTable = &SQLTable{
-			Name:       table.Name,
-			Engine:     table.Engine,
-			Charset:    table.Collation,
-			Definition: autoIncrementReg.ReplaceAllString(table.Code, ""),
-		}
-
-		// 字段
-		var fields = []*SQLField{}
-		for _, field := range table.Fields {
-			fields = append(fields, &SQLField{
-				Name:       field.Name,
-				Definition: field.Definition(),
-			})
-		}
-		sqlTable.Fields = fields
-
-		// 索引
-		var indexes = []*SQLIndex{}
-		for _, index := range table.Indexes {
-			indexes = append(indexes, &SQLIndex{
-				Name:       index.Name,
-				Definition: index.Definition(),
-			})
-		}
-		sqlTable.Indexes = indexes
+		var sqlTable = this.composeSQLTable(table)
 
 		// Records
 		var records = []*SQLRecord{}
@@ -178,7 +159,7 @@ func (this *SQLDump) Dump(db *dbs.DB, includingRecords bool) (result *SQLDumpRes
 // Apply 应用数据
 func (this *SQLDump) Apply(db *dbs.DB, newResult *SQLDumpResult, showLog bool) (ops []string, err error) {
 	// 设置Innodb事务提交模式
-	{
+	if this.dialect.Name() == SQLDialectMySQL {
 		// 检查是否为root用户
 		config, _ := db.Config()
 		if config == nil {
@@ -203,6 +184,10 @@ func (this *SQLDump) Apply(db *dbs.DB, newResult *SQLDumpResult, showLog bool) (
 	var execQueue = make(chan *sqlItem, 256)
 
 	var threads = 32
+	if this.dialect.Name() == SQLDialectSQLite {
+		// SQLite同时只能有一个写入
+		threads = 1
+	}
 	var wg = sync.WaitGroup{}
 	wg.Add(threads + 1 /** applyQueue **/)
 
@@ -239,7 +224,7 @@ func (this *SQLDump) Apply(db *dbs.DB, newResult *SQLDumpResult, showLog bool) (
 	}
 
 	if len(sqlErrors) == 0 {
-		// 升级数据
+		// 执行数据库迁移
 		err = UpgradeSQLData(db)
 		if err != nil {
 			return nil, errors.New("upgrade data failed: " + err.Error())
@@ -259,13 +244,24 @@ func (this *SQLDump) applyQueue(db *dbs.DB, newResult *SQLDumpResult, showLog bo
 		}
 	}
 
-	currentResult, err := this.Dump(db, false)
+	var currentResult *SQLDumpResult
+	if this.dialect.Name() == SQLDialectSQLite {
+		currentResult, err = this.dumpSQLite(db)
+	} else {
+		currentResult, err = this.Dump(db, false)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	// 新增表格
 	for _, newTable := range newResult.Tables {
+		// 转换为与数据库无关的表结构
+		newSchemaTable, err := ParseSQLSchemaTable(newTable)
+		if err != nil {
+			return nil, errors.New("parse table '" + newTable.Name + "' failed: " + err.Error())
+		}
+
 		var oldTable = currentResult.FindTable(newTable.Name)
 		if oldTable == nil {
 			var op = "+ table " + newTable.Name
@@ -273,109 +269,28 @@ func (this *SQLDump) applyQueue(db *dbs.DB, newResult *SQLDumpResult, showLog bo
 			if showLog {
 				this.log(op)
 			}
-			if len(newTable.Records) == 0 {
-				execSQL(newTable.Definition)
-			} else {
-				_, err = db.Exec(newTable.Definition)
-				if err != nil {
-					return nil, errors.New("'" + op + "' failed: " + err.Error())
-				}
-			}
-		} else if oldTable.Definition != newTable.Definition {
-			// 对比字段
-			// +
-			for _, newField := range newTable.Fields {
-				var oldField = oldTable.FindField(newField.Name)
-				if oldField == nil {
-					var op = "+ " + newTable.Name + " " + newField.Name
-					ops = append(ops, op)
-					if showLog {
-						this.log(op)
-					}
-					_, err = db.Exec("ALTER TABLE " + newTable.Name + " ADD `" + newField.Name + "` " + newField.Definition)
-					if err != nil {
-						return nil, errors.New("'" + op + "' failed: " + err.Error())
-					}
-				} else if !newField.EqualDefinition(oldField.Definition) {
-					var op = "* " + newTable.Name + " " + newField.Name
-					ops = append(ops, op)
-					if showLog {
-						this.log(op)
-					}
-					_, err = db.Exec("ALTER TABLE " + newTable.Name + " MODIFY `" + newField.Name + "` " + newField.Definition)
+			// 有多条语句时需要按顺序执行
+			var sqlStrings = this.dialect.CreateTable(newSchemaTable)
+			for _, sqlString := range sqlStrings {
+				if len(newTable.Records) == 0 && len(sqlStrings) == 1 {
+					execSQL(sqlString)
+				} else {
+					_, err = db.Exec(sqlString)
 					if err != nil {
 						return nil, errors.New("'" + op + "' failed: " + err.Error())
 					}
 				}
 			}
-
-			// 对比索引
-			// +
-			for _, newIndex := range newTable.Indexes {
-				var oldIndex = oldTable.FindIndex(newIndex.Name)
-				if oldIndex == nil {
-					var op = "+ index " + newTable.Name + " " + newIndex.Name
-					ops = append(ops, op)
-					if showLog {
-						this.log(op)
-					}
-					_, err = db.Exec("ALTER TABLE " + newTable.Name + " ADD " + newIndex.Definition)
-					if err != nil {
-						err = this.tryCreateIndex(err, db, newTable.Name, newIndex.Definition)
-						if err != nil {
-							return nil, errors.New("'" + op + "' failed: " + err.Error())
-						}
-					}
-				} else if oldIndex.Definition != newIndex.Definition {
-					var op = "* index " + newTable.Name + " " + newIndex.Name
-					ops = append(ops, op)
-					if showLog {
-						this.log(op)
-					}
-					_, err = db.Exec("ALTER TABLE " + newTable.Name + " DROP KEY " + newIndex.Name)
-					if err != nil {
-						return nil, errors.New("'" + op + "' drop old key failed: " + err.Error())
-					}
-					_, err = db.Exec("ALTER TABLE " + newTable.Name + " ADD " + newIndex.Definition)
-					if err != nil {
-						err = this.tryCreateIndex(err, db, newTable.Name, newIndex.Definition)
-						if err != nil {
-							return nil, errors.New("'" + op + "' failed: " + err.Error())
-						}
-					}
-				}
-			}
-
-			// -
-			for _, oldIndex := range oldTable.Indexes {
-				var newIndex = newTable.FindIndex(oldIndex.Name)
-				if newIndex == nil {
-					var op = "- index " + oldTable.Name + " " + oldIndex.Name
-					ops = append(ops, op)
-					if showLog {
-						this.log(op)
-					}
-					_, err = db.Exec("ALTER TABLE " + oldTable.Name + " DROP KEY " + oldIndex.Name)
-					if err != nil {
-						return nil, errors.New("'" + op + "' failed: " + err.Error())
-					}
-				}
+		} else if this.dialect.Name() == SQLDialectSQLite {
+			err = this.applySQLiteTableChanges(db, newSchemaTable, oldTable, showLog, &ops)
+			if err != nil {
+				return nil, err
 			}
-
-			// 对比字段
-			// -
-			for _, oldField := range oldTable.Fields {
-				var newField = newTable.FindField(oldField.Name)
-				if newField == nil {
-					var op = "- field " + oldTable.Name + " " + oldField.Name
-					ops = append(ops, op)
-					if showLog {
-						this.log(op)
-					}
-					_, err = db.Exec("ALTER TABLE " + oldTable.Name + " DROP COLUMN `" + oldField.Name + "`")
-					if err != nil {
-						return nil, errors.New("'" + op + "' failed: " + err.Error())
-					}
+		} else {
+			for _, change := range DiffSQLTables(oldTable, newTable) {
+				err = this.applyTableChange(db, newSchemaTable, change, showLog, &ops)
+				if err != nil {
+					return nil, err
 				}
 			}
 		}
@@ -432,7 +347,7 @@ func (this *SQLDump) applyQueue(db *dbs.DB, newResult *SQLDumpResult, showLog bo
 						continue
 					}
 
-					params = append(params, "`"+k+"`")
+					params = append(params, this.dialect.QuoteIdentifier(k))
 					args = append(args, "?")
 					values = append(values, v)
 				}
@@ -456,7 +371,7 @@ func (this *SQLDump) applyQueue(db *dbs.DB, newResult *SQLDumpResult, showLog bo
 						continue
 					}
 
-					args = append(args, "`"+k+"`"+"=?")
+					args = append(args, this.dialect.QuoteIdentifier(k)+"=?")
 					values = append(values, v)
 				}
 				values = append(values, one.GetInt("id"))
@@ -531,6 +446,39 @@ func (this *SQLDump) findFullTables(db *dbs.DB, tableNames []string) ([]*dbs.Tab
 	return fullTables, nil
 }
 
+var sqlAutoIncrementReg = regexp.MustCompile(` AUTO_INCREMENT=\d+`)
+
+// 将数据库中的表结构转换为和 sql.json 中一致的格式，不包含记录
+func (this *SQLDump) composeSQLTable(table *dbs.Table) *SQLTable {
+	var sqlTable = &SQLTable{
+		Name:       table.Name,
+		Engine:     table.Engine,
+		Charset:    table.Collation,
+		Definition: sqlAutoIncrementReg.ReplaceAllString(table.Code, ""),
+	}
+
+	// 字段
+	var fields = []*SQLField{}
+	for _, field := range table.Fields {
+		fields = append(fields, &SQLField{
+			Name:       field.Name,
+			Definition: field.Definition(),
+		})
+	}
+	sqlTable.Fields = fields
+
+	// 索引
+	var indexes = []*SQLIndex{}
+	for _, index := range table.Indexes {
+		indexes = append(indexes, &SQLIndex{
+			Name:       index.Name,
+			Definition: index.Definition(),
+		})
+	}
+	sqlTable.Indexes = indexes
+	return sqlTable
+}
+
 // 查找有记录的表
 func (this *SQLDump) findRecordsTable(tableName string) *SQLRecordsTable {
 	for _, table := range recordsTables {
@@ -541,8 +489,73 @@ func (this *SQLDump) findRecordsTable(tableName string) *SQLRecordsTable {
 	return nil
 }
 
+// 执行单个表结构变更
+func (this *SQLDump) applyTableChange(db *dbs.DB, table *SQLSchemaTable, change *SQLTableChange, showLog bool, ops *[]string) error {
+	var op string
+	var sqlStrings []string
+	var isIndex bool
+	switch change.Type {
+	case SQLTableChangeAddField, SQLTableChangeModifyField:
+		var column = table.FindColumn(change.Name)
+		if column == nil {
+			return errors.New("can not find field '" + change.Name + "' in table '" + table.Name + "'")
+		}
+		if change.Type == SQLTableChangeAddField {
+			op = "+ " + table.Name + " " + change.Name
+			sqlStrings = this.dialect.AddColumn(table.Name, column)
+		} else {
+			op = "* " + table.Name + " " + change.Name
+			sqlStrings = this.dialect.ModifyColumn(table.Name, column)
+		}
+	case SQLTableChangeDropField:
+		op = "- field " + table.Name + " " + change.Name
+		sqlStrings = this.dialect.DropColumn(table.Name, change.Name)
+	case SQLTableChangeAddIndex, SQLTableChangeModifyIndex:
+		var index = table.FindIndex(change.Name)
+		if index == nil {
+			return errors.New("can not find index '" + change.Name + "' in table '" + table.Name + "'")
+		}
+		if change.Type == SQLTableChangeAddIndex {
+			op = "+ index " + table.Name + " " + change.Name
+		} else {
+			op = "* index " + table.Name + " " + change.Name
+
+			// 先删除旧的索引
+			for _, sqlString := range this.dialect.DropIndex(table.Name, change.Name) {
+				_, err := db.Exec(sqlString)
+				if err != nil {
+					return errors.New("'" + op + "' drop old key failed: " + err.Error())
+				}
+			}
+		}
+		sqlStrings = this.dialect.AddIndex(table.Name, index)
+		isIndex = true
+	case SQLTableChangeDropIndex:
+		op = "- index " + table.Name + " " + change.Name
+		sqlStrings = this.dialect.DropIndex(table.Name, change.Name)
+	default:
+		return errors.New("unknown table change '" + change.Type + "'")
+	}
+
+	*ops = append(*ops, op)
+	if showLog {
+		this.log(op)
+	}
+
+	for _, sqlString := range sqlStrings {
+		_, err := db.Exec(sqlString)
+		if err != nil && isIndex {
+			err = this.tryCreateIndex(err, db, table.Name, sqlString)
+		}
+		if err != nil {
+			return errors.New("'" + op + "' failed: " + err.Error())
+		}
+	}
+	return nil
+}
+
 // 创建索引
-func (this *SQLDump) tryCreateIndex(err error, db *dbs.DB, tableName string, indexDefinition string) error {
+func (this *SQLDump) tryCreateIndex(err error, db *dbs.DB, tableName string, indexSQL string) error {
 	if err == nil {
 		return nil
 	}
@@ -551,14 +564,14 @@ func (this *SQLDump) tryCreateIndex(err error, db *dbs.DB, tableName string, ind
 	if strings.Contains(err.Error(), "Error 1062: Duplicate entry") && (strings.HasSuffix(tableName, "Stats") || strings.HasSuffix(tableName, "Values")) {
 		var tries = 5 // 尝试次数
 		for i := 0; i < tries; i++ {
-			_, err = db.Exec("TRUNCATE TABLE " + tableName)
+			_, err = db.Exec("TRUNCATE TABLE " + this.dialect.QuoteIdentifier(tableName))
 			if err != nil {
 				if i == tries-1 {
 					return err
 				}
 				continue
 			}
-			_, err = db.Exec("ALTER TABLE " + tableName + " ADD " + indexDefinition)
+			_, err = db.Exec(indexSQL)
 			if err != nil {
 				if i == tries-1 {
 					return err
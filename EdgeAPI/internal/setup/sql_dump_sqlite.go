@@ -0,0 +1,79 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"strings"
+
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+// 读取SQLite数据库中的表格
+// 只读取字段和索引的名称，SQLite中字段定义和MySQL不同，无法和 sql.json 中的定义对比
+func (this *SQLDump) dumpSQLite(db *dbs.DB) (*SQLDumpResult, error) {
+	var result = &SQLDumpResult{}
+
+	tableNames, err := db.TableNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tableName := range tableNames {
+		var sqlTable = &SQLTable{
+			Name: tableName,
+		}
+
+		table, err := db.FindTable(tableName)
+		if err != nil {
+			return nil, err
+		}
+		if table != nil {
+			for _, field := range table.Fields {
+				sqlTable.Fields = append(sqlTable.Fields, &SQLField{
+					Name: field.Name,
+				})
+			}
+		}
+
+		ones, _, err := db.FindOnes("SELECT name FROM sqlite_master WHERE type='index' AND tbl_name=?", tableName)
+		if err != nil {
+			return nil, err
+		}
+		for _, one := range ones {
+			var indexName = types.String(one["name"])
+			if !strings.HasPrefix(indexName, tableName+"_") {
+				continue
+			}
+			sqlTable.Indexes = append(sqlTable.Indexes, &SQLIndex{
+				Name: strings.TrimPrefix(indexName, tableName+"_"),
+			})
+		}
+
+		result.Tables = append(result.Tables, sqlTable)
+	}
+
+	return result, nil
+}
+
+// 为SQLite中已有的表格增加字段和索引
+// 不会修改和删除已有的字段和索引
+func (this *SQLDump) applySQLiteTableChanges(db *dbs.DB, table *SQLSchemaTable, oldTable *SQLTable, showLog bool, ops *[]string) error {
+	for _, column := range table.Columns {
+		if oldTable.FindField(column.Name) == nil {
+			err := this.applyTableChange(db, table, &SQLTableChange{Type: SQLTableChangeAddField, Name: column.Name}, showLog, ops)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, index := range table.Indexes {
+		if !index.IsPrimary && oldTable.FindIndex(index.Name) == nil {
+			err := this.applyTableChange(db, table, &SQLTableChange{Type: SQLTableChangeAddIndex, Name: index.Name}, showLog, ops)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -62,6 +62,7 @@ func (this *SQLExecutor) Run(showLog bool) error {
 	}()
 
 	var sqlDump = NewSQLDump()
+	sqlDump.SetDialect(FindSQLDialectWithDriver(this.dbConfig.Driver))
 	sqlDump.SetLogWriter(this.logWriter)
 	if this.logWriter != nil {
 		showLog = true
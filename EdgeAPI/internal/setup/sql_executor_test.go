@@ -1,9 +1,13 @@
 package setup
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+	"github.com/iwind/TeaGo/assert"
 	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/lists"
 )
 
 func TestSQLExecutor_Run(t *testing.T) {
@@ -19,6 +23,23 @@ func TestSQLExecutor_Run(t *testing.T) {
 	t.Log("ok")
 }
 
+func TestSQLExecutor_Run_SQLite(t *testing.T) {
+	var executor = NewSQLExecutor(&dbs.DBConfig{
+		Driver: sqlite.DriverName,
+		Prefix: "edge",
+		Dsn:    sqlite.ComposeDSN(filepath.Join(t.TempDir(), "edge.db")),
+	})
+
+	// 重复执行以检查升级过程
+	for i := 0; i < 2; i++ {
+		err := executor.Run(false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Log("ok")
+}
+
 func TestSQLExecutor_checkCluster(t *testing.T) {
 	var executor = NewSQLExecutor(&dbs.DBConfig{
 		Driver: "mysql",
@@ -102,3 +123,57 @@ func TestSQLExecutor_checkClientAgents(t *testing.T) {
 	}
 	t.Log("ok")
 }
+
+func TestCheckSQLSchema_SQLite(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var dbConfig = &dbs.DBConfig{
+		Driver: sqlite.DriverName,
+		Prefix: "edge",
+		Dsn:    sqlite.ComposeDSN(filepath.Join(t.TempDir(), "edge.db")),
+	}
+	err := NewSQLExecutor(dbConfig).Run(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := dbs.NewInstanceFromConfig(dbConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	drifts, err := CheckSQLSchema(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(len(drifts) == 0)
+
+	// 模拟升级到一半失败
+	for _, sqlString := range []string{
+		"DROP TABLE `edgeVersions`",
+		"DROP INDEX `edgeNodes_uniqueId`",
+		"ALTER TABLE `edgeNodes` DROP COLUMN `secret`",
+	} {
+		_, err = db.Exec(sqlString)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	drifts, err = CheckSQLSchema(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var driftStrings = []string{}
+	for _, drift := range drifts {
+		driftStrings = append(driftStrings, drift.String())
+	}
+	t.Log(driftStrings)
+	a.IsTrue(lists.ContainsString(driftStrings, "missingTable edgeVersions"))
+	a.IsTrue(lists.ContainsString(driftStrings, "missingIndex edgeNodes.uniqueId"))
+	a.IsTrue(lists.ContainsString(driftStrings, "missingColumn edgeNodes.secret"))
+	a.IsTrue(len(drifts) == 3)
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/iwind/TeaGo/dbs"
+)
+
+// SQLMigration 数据库迁移
+// 新增表格、字段和索引只需要修改 sql.json，数据升级以及无法通过比对表结构完成的修改需要增加迁移；
+// 迁移按照版本号从小到大执行，执行之后记录在 edgeSchemaMigrations 表中，已经执行过的迁移内容不能再修改
+type SQLMigration struct {
+	Version     string                 // 版本号，同一个版本有多个迁移时可以使用 1.3.4.1 这样的形式
+	Description string                 // 描述
+	Up          []string               // 升级语句，使用MySQL语法
+	Down        []string               // 回滚语句，为空表示不能回滚
+	UpFunc      func(db *dbs.DB) error // 升级函数，在升级语句之后执行
+}
+
+// Checksum 迁移内容校验和
+// 升级函数的内容无法计算，所以只计算版本号、描述和语句
+func (this *SQLMigration) Checksum() string {
+	var builder strings.Builder
+	builder.WriteString(this.Version + "\n")
+	builder.WriteString(this.Description + "\n")
+	for _, sqlString := range this.Up {
+		builder.WriteString("up:" + sqlString + "\n")
+	}
+	for _, sqlString := range this.Down {
+		builder.WriteString("down:" + sqlString + "\n")
+	}
+	if this.UpFunc != nil {
+		builder.WriteString("func\n")
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(builder.String())))
+}
+
+// CanDown 是否可以回滚
+func (this *SQLMigration) CanDown() bool {
+	return len(this.Down) > 0 && this.UpFunc == nil
+}
+
+// RegisterSQLMigration 注册迁移
+func RegisterSQLMigration(migration *SQLMigration) {
+	sqlMigrations = append(sqlMigrations, migration)
+}
@@ -0,0 +1,331 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/lists"
+	"github.com/iwind/TeaGo/types"
+)
+
+// SQLMigrationTable 迁移记录表
+const SQLMigrationTable = "edgeSchemaMigrations"
+
+// SQLMigrationRecord 迁移记录
+type SQLMigrationRecord struct {
+	Version     string
+	Description string
+	Checksum    string
+	IsApplied   bool  // 是否已完成，未完成的表示迁移中途失败
+	IsBaseline  bool  // 是否为基线，即安装或者升级前数据库中已经包含此迁移的内容，没有实际执行
+	StartedAt   int64 // 开始时间
+	AppliedAt   int64 // 完成时间
+	Error       string
+}
+
+// SQLMigrator 数据库迁移执行器
+type SQLMigrator struct {
+	db         *dbs.DB
+	migrations []*SQLMigration
+}
+
+func NewSQLMigrator(db *dbs.DB) *SQLMigrator {
+	var migrations = append([]*SQLMigration{}, sqlMigrations...)
+	sort.SliceStable(migrations, func(i, j int) bool {
+		return CompareVersion(migrations[i].Version, migrations[j].Version) < 0
+	})
+
+	return &SQLMigrator{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+// NewSQLMigratorFromCmd 使用配置文件中的数据库构造迁移执行器
+func NewSQLMigratorFromCmd() (*SQLMigrator, error) {
+	config, err := configs.LoadDBConfig()
+	if err != nil {
+		return nil, err
+	}
+	db, err := dbs.NewInstanceFromConfig(config.DBs[Tea.Env])
+	if err != nil {
+		return nil, err
+	}
+	return NewSQLMigrator(db), nil
+}
+
+// Close 关闭数据库连接
+func (this *SQLMigrator) Close() error {
+	return this.db.Close()
+}
+
+// Migrations 所有迁移
+func (this *SQLMigrator) Migrations() []*SQLMigration {
+	return this.migrations
+}
+
+// Up 执行所有未执行的迁移
+func (this *SQLMigrator) Up() error {
+	err := this.initTable()
+	if err != nil {
+		return err
+	}
+
+	records, err := this.FindRecords()
+	if err != nil {
+		return err
+	}
+	var recordMap = map[string]*SQLMigrationRecord{} // version => record
+	for _, record := range records {
+		// 检查上次中途失败的迁移
+		if !record.IsApplied {
+			var errString = "migration '" + record.Version + "' was partially applied"
+			if len(record.Error) > 0 {
+				errString += " (" + record.Error + ")"
+			}
+			return errors.New(errString + ", please check the database and run 'edge-api migrations repair " + record.Version + "' after fixing it")
+		}
+		recordMap[record.Version] = record
+	}
+
+	// 数据库当前版本
+	versionCol, err := this.db.FindCol(0, "SELECT version FROM edgeVersions")
+	if err != nil {
+		return err
+	}
+	var dbVersion = types.String(versionCol)
+
+	for _, migration := range this.migrations {
+		record, ok := recordMap[migration.Version]
+		if ok {
+			if record.Checksum != migration.Checksum() {
+				return errors.New("checksum of migration '" + migration.Version + "' mismatch, applied migrations should not be changed")
+			}
+			continue
+		}
+
+		// 新安装的数据库或者数据库版本不低于迁移版本时，数据已经是最新的
+		if len(dbVersion) == 0 || CompareVersion(dbVersion, migration.Version) >= 0 {
+			err = this.insertRecord(migration, true, true)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = this.apply(migration)
+		if err != nil {
+			return fmt.Errorf("apply migration '%s' failed: %w", migration.Version, err)
+		}
+	}
+
+	return nil
+}
+
+// Down 回滚某个迁移
+func (this *SQLMigrator) Down(version string) error {
+	var migration = this.findMigration(version)
+	if migration == nil {
+		return errors.New("could not find migration '" + version + "'")
+	}
+	if !migration.CanDown() {
+		return errors.New("migration '" + version + "' can not be rolled back")
+	}
+
+	err := this.initTable()
+	if err != nil {
+		return err
+	}
+
+	record, err := this.FindRecord(version)
+	if err != nil {
+		return err
+	}
+	if record == nil || !record.IsApplied {
+		return errors.New("migration '" + version + "' has not been applied")
+	}
+
+	// 回滚期间标记为未完成，中途失败时可以检测到
+	_, err = this.db.Exec("UPDATE "+SQLMigrationTable+" SET isApplied=0 WHERE version=?", version)
+	if err != nil {
+		return err
+	}
+	for _, sqlString := range migration.Down {
+		_, err = this.db.Exec(sqlString)
+		if err != nil {
+			_ = this.updateRecordError(version, err)
+			return fmt.Errorf("rollback migration '%s' failed: %w", version, err)
+		}
+	}
+
+	_, err = this.db.Exec("DELETE FROM "+SQLMigrationTable+" WHERE version=?", version)
+	return err
+}
+
+// Repair 清除中途失败的迁移记录，下次升级时重新执行
+func (this *SQLMigrator) Repair(version string) error {
+	record, err := this.FindRecord(version)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		return errors.New("could not find record of migration '" + version + "'")
+	}
+	if record.IsApplied {
+		return errors.New("migration '" + version + "' has been applied successfully, no need to repair")
+	}
+
+	_, err = this.db.Exec("DELETE FROM "+SQLMigrationTable+" WHERE version=?", version)
+	return err
+}
+
+// FindRecords 查找所有迁移记录
+func (this *SQLMigrator) FindRecords() (result []*SQLMigrationRecord, err error) {
+	tableNames, err := this.db.TableNames()
+	if err != nil {
+		return nil, err
+	}
+	if !lists.ContainsString(tableNames, SQLMigrationTable) {
+		return nil, nil
+	}
+
+	ones, _, err := this.db.FindOnes("SELECT version, description, checksum, isApplied, isBaseline, startedAt, appliedAt, lastError FROM " + SQLMigrationTable + " ORDER BY id ASC")
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range ones {
+		result = append(result, &SQLMigrationRecord{
+			Version:     one.GetString("version"),
+			Description: one.GetString("description"),
+			Checksum:    one.GetString("checksum"),
+			IsApplied:   one.GetInt("isApplied") == 1,
+			IsBaseline:  one.GetInt("isBaseline") == 1,
+			StartedAt:   one.GetInt64("startedAt"),
+			AppliedAt:   one.GetInt64("appliedAt"),
+			Error:       one.GetString("lastError"),
+		})
+	}
+	return
+}
+
+// FindRecord 查找单个迁移记录
+func (this *SQLMigrator) FindRecord(version string) (*SQLMigrationRecord, error) {
+	records, err := this.FindRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		if record.Version == version {
+			return record, nil
+		}
+	}
+	return nil, nil
+}
+
+// 执行单个迁移
+func (this *SQLMigrator) apply(migration *SQLMigration) error {
+	err := this.insertRecord(migration, false, false)
+	if err != nil {
+		return err
+	}
+
+	for _, sqlString := range migration.Up {
+		_, err = this.db.Exec(sqlString)
+		if err != nil {
+			_ = this.updateRecordError(migration.Version, err)
+			return err
+		}
+	}
+
+	if migration.UpFunc != nil {
+		err = migration.UpFunc(this.db)
+		if err != nil {
+			_ = this.updateRecordError(migration.Version, err)
+			return err
+		}
+	}
+
+	_, err = this.db.Exec("UPDATE "+SQLMigrationTable+" SET isApplied=1, appliedAt=?, lastError='' WHERE version=?", time.Now().Unix(), migration.Version)
+	return err
+}
+
+func (this *SQLMigrator) insertRecord(migration *SQLMigration, isApplied bool, isBaseline bool) error {
+	var now = time.Now().Unix()
+	var appliedAt int64
+	if isApplied {
+		appliedAt = now
+	}
+	_, err := this.db.Exec("INSERT INTO "+SQLMigrationTable+" (version, description, checksum, isApplied, isBaseline, startedAt, appliedAt, lastError) VALUES (?, ?, ?, ?, ?, ?, ?, '')",
+		migration.Version,
+		migration.Description,
+		migration.Checksum(),
+		types.Int(isApplied),
+		types.Int(isBaseline),
+		now,
+		appliedAt)
+	return err
+}
+
+func (this *SQLMigrator) updateRecordError(version string, err error) error {
+	var errString = err.Error()
+	if len(errString) > 1024 {
+		errString = errString[:1024]
+	}
+	_, updateErr := this.db.Exec("UPDATE "+SQLMigrationTable+" SET lastError=? WHERE version=?", errString, version)
+	return updateErr
+}
+
+func (this *SQLMigrator) findMigration(version string) *SQLMigration {
+	for _, migration := range this.migrations {
+		if migration.Version == version {
+			return migration
+		}
+	}
+	return nil
+}
+
+// 创建迁移记录表
+func (this *SQLMigrator) initTable() error {
+	tableNames, err := this.db.TableNames()
+	if err != nil {
+		return err
+	}
+	if lists.ContainsString(tableNames, SQLMigrationTable) {
+		return nil
+	}
+
+	var table = &SQLSchemaTable{
+		Name:    SQLMigrationTable,
+		Comment: "数据库迁移记录",
+		Columns: []*SQLSchemaColumn{
+			{Name: "id", Type: SQLColumnTypeBigInt, Length: 20, IsUnsigned: true, IsNotNull: true, AutoIncrement: true, Comment: "ID"},
+			{Name: "version", Type: SQLColumnTypeVarchar, Length: 64, HasDefault: true, Comment: "版本号"},
+			{Name: "description", Type: SQLColumnTypeVarchar, Length: 255, HasDefault: true, Comment: "描述"},
+			{Name: "checksum", Type: SQLColumnTypeVarchar, Length: 64, HasDefault: true, Comment: "校验和"},
+			{Name: "isApplied", Type: SQLColumnTypeTinyInt, Length: 1, IsUnsigned: true, HasDefault: true, Default: "0", Comment: "是否已完成"},
+			{Name: "isBaseline", Type: SQLColumnTypeTinyInt, Length: 1, IsUnsigned: true, HasDefault: true, Default: "0", Comment: "是否为基线"},
+			{Name: "startedAt", Type: SQLColumnTypeBigInt, Length: 20, IsUnsigned: true, HasDefault: true, Default: "0", Comment: "开始时间"},
+			{Name: "appliedAt", Type: SQLColumnTypeBigInt, Length: 20, IsUnsigned: true, HasDefault: true, Default: "0", Comment: "完成时间"},
+			{Name: "lastError", Type: SQLColumnTypeVarchar, Length: 1024, HasDefault: true, Comment: "错误信息"},
+		},
+		Indexes: []*SQLSchemaIndex{
+			{Name: "PRIMARY", Fields: []string{"id"}, IsPrimary: true, IsUnique: true},
+			{Name: "version", Fields: []string{"version"}, IsUnique: true},
+		},
+	}
+
+	for _, sqlString := range FindSQLDialectWithDriver(this.db.Driver()).CreateTable(table) {
+		_, err = this.db.Exec(sqlString)
+		if err != nil {
+			return fmt.Errorf("create table '%s' failed: %w", SQLMigrationTable, err)
+		}
+	}
+	return nil
+}
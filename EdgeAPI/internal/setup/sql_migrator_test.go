@@ -0,0 +1,131 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/sqlite"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/lists"
+)
+
+func TestSQLMigrator_Up(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	db, err := dbs.NewInstanceFromConfig(&dbs.DBConfig{
+		Driver: sqlite.DriverName,
+		Prefix: "edge",
+		Dsn:    sqlite.ComposeDSN(filepath.Join(t.TempDir(), "edge.db")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err = db.Exec("CREATE TABLE edgeVersions (version TEXT)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("INSERT INTO edgeVersions (version) VALUES ('1.0.0')")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var countOldCalls = 0
+	var failErr = errors.New("test error")
+	var migrations = []*SQLMigration{
+		{Version: "0.9.0", UpFunc: func(db *dbs.DB) error {
+			countOldCalls++
+			return nil
+		}},
+		{Version: "1.1.0", Up: []string{"CREATE TABLE edgeMigrationTests (id INTEGER)"}, Down: []string{"DROP TABLE edgeMigrationTests"}},
+		{Version: "1.2.0", UpFunc: func(db *dbs.DB) error {
+			return failErr
+		}},
+	}
+	var migrator = &SQLMigrator{db: db, migrations: migrations}
+
+	// 中途失败
+	err = migrator.Up()
+	a.IsTrue(err != nil)
+	a.IsTrue(countOldCalls == 0)
+
+	tableNames, err := db.TableNames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(lists.ContainsString(tableNames, "edgeMigrationTests"))
+
+	record, err := migrator.FindRecord("0.9.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(record != nil && record.IsApplied && record.IsBaseline)
+
+	record, err = migrator.FindRecord("1.2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(record != nil && !record.IsApplied && record.Error == "test error")
+
+	// 未修复之前不能继续升级
+	failErr = nil
+	err = migrator.Up()
+	a.IsTrue(err != nil)
+	t.Log(err)
+
+	err = migrator.Repair("1.2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = migrator.Up()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 回滚
+	err = migrator.Down("1.2.0")
+	a.IsTrue(err != nil)
+	err = migrator.Down("1.1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableNames, err = db.TableNames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsFalse(lists.ContainsString(tableNames, "edgeMigrationTests"))
+
+	// 已经执行的迁移内容被修改
+	err = migrator.Up()
+	if err != nil {
+		t.Fatal(err)
+	}
+	migrations[1].Up = []string{"CREATE TABLE edgeMigrationTests (id INTEGER, name TEXT)"}
+	err = migrator.Up()
+	a.IsTrue(err != nil)
+	t.Log(err)
+}
+
+func TestSQLMigrator_Migrations(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var migrations = NewSQLMigrator(nil).Migrations()
+	a.IsTrue(len(migrations) > 0)
+
+	var versionMap = map[string]bool{}
+	for index, migration := range migrations {
+		a.IsFalse(versionMap[migration.Version])
+		versionMap[migration.Version] = true
+
+		if index > 0 {
+			a.IsTrue(CompareVersion(migrations[index-1].Version, migration.Version) <= 0)
+		}
+	}
+}
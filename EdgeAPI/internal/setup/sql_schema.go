@@ -0,0 +1,298 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SQLColumnType 与数据库无关的字段类型
+type SQLColumnType = string
+
+const (
+	SQLColumnTypeTinyInt    SQLColumnType = "tinyint"
+	SQLColumnTypeSmallInt   SQLColumnType = "smallint"
+	SQLColumnTypeInt        SQLColumnType = "int"
+	SQLColumnTypeBigInt     SQLColumnType = "bigint"
+	SQLColumnTypeDecimal    SQLColumnType = "decimal"
+	SQLColumnTypeVarchar    SQLColumnType = "varchar"
+	SQLColumnTypeVarBinary  SQLColumnType = "varbinary"
+	SQLColumnTypeText       SQLColumnType = "text"
+	SQLColumnTypeLongText   SQLColumnType = "longtext"
+	SQLColumnTypeBlob       SQLColumnType = "blob"
+	SQLColumnTypeMediumBlob SQLColumnType = "mediumblob"
+	SQLColumnTypeLongBlob   SQLColumnType = "longblob"
+	SQLColumnTypeJSON       SQLColumnType = "json"
+	SQLColumnTypeDate       SQLColumnType = "date"
+	SQLColumnTypeTime       SQLColumnType = "time"
+)
+
+// SQLSchemaColumn 字段定义
+type SQLSchemaColumn struct {
+	Name          string
+	Type          SQLColumnType
+	Length        int  // 字符串长度、整数显示宽度或者小数精度
+	Scale         int  // 小数位数
+	IsUnsigned    bool // 是否为无符号整数
+	IsNotNull     bool
+	AutoIncrement bool
+	HasDefault    bool
+	Default       string
+	Comment       string
+}
+
+// IsInteger 是否为整数类型
+func (this *SQLSchemaColumn) IsInteger() bool {
+	switch this.Type {
+	case SQLColumnTypeTinyInt, SQLColumnTypeSmallInt, SQLColumnTypeInt, SQLColumnTypeBigInt:
+		return true
+	}
+	return false
+}
+
+// IsLarge 是否为不能设置默认值的大字段
+func (this *SQLSchemaColumn) IsLarge() bool {
+	switch this.Type {
+	case SQLColumnTypeText, SQLColumnTypeLongText, SQLColumnTypeBlob, SQLColumnTypeMediumBlob, SQLColumnTypeLongBlob:
+		return true
+	}
+	return false
+}
+
+// SQLSchemaIndex 索引定义
+type SQLSchemaIndex struct {
+	Name      string
+	Fields    []string
+	IsPrimary bool
+	IsUnique  bool
+	Method    string // 索引算法，比如BTREE，为空表示使用数据库默认值
+}
+
+// SQLSchemaTable 与数据库无关的表结构定义
+type SQLSchemaTable struct {
+	Name    string
+	Comment string
+	Columns []*SQLSchemaColumn
+	Indexes []*SQLSchemaIndex
+
+	// 以下选项只对MySQL有效
+	Engine  string
+	Charset string
+}
+
+// FindColumn 根据名称查找字段
+func (this *SQLSchemaTable) FindColumn(columnName string) *SQLSchemaColumn {
+	for _, column := range this.Columns {
+		if column.Name == columnName {
+			return column
+		}
+	}
+	return nil
+}
+
+// FindIndex 根据名称查找索引
+func (this *SQLSchemaTable) FindIndex(indexName string) *SQLSchemaIndex {
+	for _, index := range this.Indexes {
+		if index.Name == indexName {
+			return index
+		}
+	}
+	return nil
+}
+
+var sqlSchemaColumnReg = regexp.MustCompile("^`([^`]+)` ([a-z]+)(?:\\(([0-9, ]+)\\))?( unsigned)?(.*)$")
+var sqlSchemaIndexReg = regexp.MustCompile("^(PRIMARY KEY|UNIQUE KEY `([^`]+)`|KEY `([^`]+)`) \\(([^)]+)\\)(?: USING ([A-Z]+))?$")
+var sqlSchemaTableReg = regexp.MustCompile("^CREATE TABLE `([^`]+)` \\($")
+
+// ParseSQLSchemaTable 从sql.json中的表格信息分析表结构
+// sql.json中的建表语句来自MySQL，这里将其转换为与数据库无关的结构，以便生成其他数据库的语句
+func ParseSQLSchemaTable(table *SQLTable) (*SQLSchemaTable, error) {
+	var lines = strings.Split(strings.TrimSpace(table.Definition), "\n")
+	if len(lines) < 3 {
+		return nil, errors.New("invalid table definition")
+	}
+
+	var matches = sqlSchemaTableReg.FindStringSubmatch(strings.TrimSpace(lines[0]))
+	if len(matches) == 0 {
+		return nil, errors.New("invalid table definition header '" + lines[0] + "'")
+	}
+
+	var schemaTable = &SQLSchemaTable{
+		Name:    matches[1],
+		Engine:  table.Engine,
+		Charset: table.Charset,
+	}
+
+	// 表格选项
+	var options = strings.TrimSpace(lines[len(lines)-1])
+	if !strings.HasPrefix(options, ")") {
+		return nil, errors.New("invalid table definition footer '" + options + "'")
+	}
+	var commentIndex = strings.Index(options, " COMMENT=")
+	if commentIndex > 0 {
+		comment, _, err := parseSQLString(options[commentIndex+len(" COMMENT="):])
+		if err != nil {
+			return nil, errors.New("parse table comment failed: " + err.Error())
+		}
+		schemaTable.Comment = comment
+	}
+
+	for _, line := range lines[1 : len(lines)-1] {
+		line = strings.TrimSuffix(strings.TrimSpace(line), ",")
+		if strings.HasPrefix(line, "`") {
+			column, err := parseSQLSchemaColumn(line)
+			if err != nil {
+				return nil, errors.New("parse column failed: " + err.Error())
+			}
+			schemaTable.Columns = append(schemaTable.Columns, column)
+		} else {
+			index, err := parseSQLSchemaIndex(line)
+			if err != nil {
+				return nil, errors.New("parse index failed: " + err.Error())
+			}
+			schemaTable.Indexes = append(schemaTable.Indexes, index)
+		}
+	}
+
+	return schemaTable, nil
+}
+
+// 分析字段定义
+func parseSQLSchemaColumn(line string) (*SQLSchemaColumn, error) {
+	var matches = sqlSchemaColumnReg.FindStringSubmatch(line)
+	if len(matches) == 0 {
+		return nil, errors.New("invalid column '" + line + "'")
+	}
+
+	var column = &SQLSchemaColumn{
+		Name:       matches[1],
+		Type:       matches[2],
+		IsUnsigned: len(matches[4]) > 0,
+	}
+
+	switch column.Type {
+	case SQLColumnTypeTinyInt, SQLColumnTypeSmallInt, SQLColumnTypeInt, SQLColumnTypeBigInt,
+		SQLColumnTypeDecimal, SQLColumnTypeVarchar, SQLColumnTypeVarBinary, SQLColumnTypeText, SQLColumnTypeLongText,
+		SQLColumnTypeBlob, SQLColumnTypeMediumBlob, SQLColumnTypeLongBlob, SQLColumnTypeJSON,
+		SQLColumnTypeDate, SQLColumnTypeTime:
+	default:
+		return nil, errors.New("unsupported column type '" + column.Type + "'")
+	}
+
+	// 长度
+	if len(matches[3]) > 0 {
+		var pieces = strings.Split(matches[3], ",")
+		length, err := strconv.Atoi(strings.TrimSpace(pieces[0]))
+		if err != nil {
+			return nil, errors.New("invalid column length '" + matches[3] + "'")
+		}
+		column.Length = length
+		if len(pieces) > 1 {
+			scale, err := strconv.Atoi(strings.TrimSpace(pieces[1]))
+			if err != nil {
+				return nil, errors.New("invalid column scale '" + matches[3] + "'")
+			}
+			column.Scale = scale
+		}
+	}
+
+	// 其他属性
+	var rest = strings.TrimSpace(matches[5])
+	for len(rest) > 0 {
+		switch {
+		case strings.HasPrefix(rest, "NOT NULL"):
+			column.IsNotNull = true
+			rest = rest[len("NOT NULL"):]
+		case strings.HasPrefix(rest, "AUTO_INCREMENT"):
+			column.AutoIncrement = true
+			rest = rest[len("AUTO_INCREMENT"):]
+		case strings.HasPrefix(rest, "DEFAULT NULL"):
+			rest = rest[len("DEFAULT NULL"):]
+		case strings.HasPrefix(rest, "DEFAULT "):
+			value, remaining, err := parseSQLString(rest[len("DEFAULT "):])
+			if err != nil {
+				return nil, errors.New("invalid default value in '" + line + "': " + err.Error())
+			}
+			column.HasDefault = true
+			column.Default = value
+			rest = remaining
+		case strings.HasPrefix(rest, "COMMENT "):
+			value, remaining, err := parseSQLString(rest[len("COMMENT "):])
+			if err != nil {
+				return nil, errors.New("invalid comment in '" + line + "': " + err.Error())
+			}
+			column.Comment = value
+			rest = remaining
+		default:
+			return nil, errors.New("unknown column option '" + rest + "'")
+		}
+		rest = strings.TrimSpace(rest)
+	}
+
+	return column, nil
+}
+
+// 分析索引定义
+func parseSQLSchemaIndex(line string) (*SQLSchemaIndex, error) {
+	var matches = sqlSchemaIndexReg.FindStringSubmatch(line)
+	if len(matches) == 0 {
+		return nil, errors.New("invalid index '" + line + "'")
+	}
+
+	var index = &SQLSchemaIndex{
+		Method: matches[5],
+	}
+	switch {
+	case matches[1] == "PRIMARY KEY":
+		index.Name = "PRIMARY"
+		index.IsPrimary = true
+		index.IsUnique = true
+	case len(matches[2]) > 0:
+		index.Name = matches[2]
+		index.IsUnique = true
+	default:
+		index.Name = matches[3]
+	}
+
+	for _, field := range strings.Split(matches[4], ",") {
+		field = strings.TrimSpace(field)
+		if !strings.HasPrefix(field, "`") || !strings.HasSuffix(field, "`") || len(field) < 3 {
+			return nil, errors.New("invalid index field '" + field + "'")
+		}
+		index.Fields = append(index.Fields, field[1:len(field)-1])
+	}
+
+	return index, nil
+}
+
+// 分析以单引号包含的字符串，返回字符串内容和剩余的部分
+func parseSQLString(s string) (value string, rest string, err error) {
+	if !strings.HasPrefix(s, "'") {
+		return "", s, errors.New("string should start with quote")
+	}
+
+	var builder = strings.Builder{}
+	for i := 1; i < len(s); i++ {
+		var c = s[i]
+		switch c {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				builder.WriteByte(s[i])
+			}
+		case '\'':
+			if i+1 < len(s) && s[i+1] == '\'' {
+				builder.WriteByte('\'')
+				i++
+				continue
+			}
+			return builder.String(), s[i+1:], nil
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	return "", "", errors.New("unclosed string")
+}
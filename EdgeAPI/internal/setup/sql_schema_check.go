@@ -0,0 +1,180 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/iwind/TeaGo/dbs"
+)
+
+type SQLSchemaDriftType = string
+
+const (
+	SQLSchemaDriftMissingTable   SQLSchemaDriftType = "missingTable"
+	SQLSchemaDriftMissingColumn  SQLSchemaDriftType = "missingColumn"
+	SQLSchemaDriftModifiedColumn SQLSchemaDriftType = "modifiedColumn"
+	SQLSchemaDriftMissingIndex   SQLSchemaDriftType = "missingIndex"
+	SQLSchemaDriftModifiedIndex  SQLSchemaDriftType = "modifiedIndex"
+)
+
+// SQLSchemaDrift 数据库中的表结构和 sql.json 中的定义不一致的地方
+type SQLSchemaDrift struct {
+	Type     SQLSchemaDriftType
+	Table    string
+	Name     string // 字段名或索引名，缺少表格时为空
+	Expected string // 期望的定义
+	Actual   string // 数据库中的定义，缺少时为空
+}
+
+// String 用于日志中的描述
+func (this *SQLSchemaDrift) String() string {
+	var s = this.Type + " " + this.Table
+	if len(this.Name) > 0 {
+		s += "." + this.Name
+	}
+	return s
+}
+
+// CheckSQLSchema 对比数据库中的表结构和当前版本的 sql.json
+// 只报告缺少或者不一致的表格、字段和索引，数据库中多出来的字段和索引不在此列；SQLite只检查是否缺少
+func CheckSQLSchema(db *dbs.DB) ([]*SQLSchemaDrift, error) {
+	var expectedResult = &SQLDumpResult{}
+	err := json.Unmarshal(sqlData, expectedResult)
+	if err != nil {
+		return nil, fmt.Errorf("decode sql data failed: %w", err)
+	}
+
+	var sqlDump = NewSQLDump()
+	sqlDump.SetDialect(FindSQLDialectWithDriver(db.Driver()))
+	return sqlDump.Check(db, expectedResult)
+}
+
+// Check 对比数据库中的表结构和期望的表结构
+func (this *SQLDump) Check(db *dbs.DB, expectedResult *SQLDumpResult) ([]*SQLSchemaDrift, error) {
+	var currentResult *SQLDumpResult
+	var err error
+	if this.dialect.Name() == SQLDialectSQLite {
+		currentResult, err = this.dumpSQLite(db)
+	} else {
+		currentResult, err = this.dumpTables(db, expectedResult)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var drifts = []*SQLSchemaDrift{}
+	for _, expectedTable := range expectedResult.Tables {
+		var currentTable = currentResult.FindTable(expectedTable.Name)
+		if currentTable == nil {
+			drifts = append(drifts, &SQLSchemaDrift{
+				Type:     SQLSchemaDriftMissingTable,
+				Table:    expectedTable.Name,
+				Expected: expectedTable.Definition,
+			})
+			continue
+		}
+
+		if this.dialect.Name() == SQLDialectSQLite {
+			schemaTable, err := ParseSQLSchemaTable(expectedTable)
+			if err != nil {
+				return nil, fmt.Errorf("parse table '%s' failed: %w", expectedTable.Name, err)
+			}
+			drifts = append(drifts, this.checkSQLiteTable(schemaTable, currentTable, expectedTable)...)
+			continue
+		}
+
+		for _, change := range DiffSQLTables(currentTable, expectedTable) {
+			var drift = &SQLSchemaDrift{
+				Table: expectedTable.Name,
+				Name:  change.Name,
+			}
+			switch change.Type {
+			case SQLTableChangeAddField, SQLTableChangeModifyField:
+				drift.Type = SQLSchemaDriftMissingColumn
+				drift.Expected = expectedTable.FindField(change.Name).Definition
+				var currentField = currentTable.FindField(change.Name)
+				if currentField != nil {
+					drift.Type = SQLSchemaDriftModifiedColumn
+					drift.Actual = currentField.Definition
+				}
+			case SQLTableChangeAddIndex, SQLTableChangeModifyIndex:
+				drift.Type = SQLSchemaDriftMissingIndex
+				drift.Expected = expectedTable.FindIndex(change.Name).Definition
+				var currentIndex = currentTable.FindIndex(change.Name)
+				if currentIndex != nil {
+					drift.Type = SQLSchemaDriftModifiedIndex
+					drift.Actual = currentIndex.Definition
+				}
+			default:
+				// 多出来的字段和索引不影响程序运行
+				continue
+			}
+			drifts = append(drifts, drift)
+		}
+	}
+	return drifts, nil
+}
+
+// 读取期望的表格在数据库中的表结构
+func (this *SQLDump) dumpTables(db *dbs.DB, expectedResult *SQLDumpResult) (*SQLDumpResult, error) {
+	tableNames, err := db.TableNames()
+	if err != nil {
+		return nil, err
+	}
+
+	var existTableNames = []string{}
+	for _, tableName := range tableNames {
+		if expectedResult.FindTable(tableName) != nil {
+			existTableNames = append(existTableNames, tableName)
+		}
+	}
+
+	fullTables, err := this.findFullTables(db, existTableNames)
+	if err != nil {
+		return nil, err
+	}
+
+	var result = &SQLDumpResult{}
+	for _, table := range fullTables {
+		result.Tables = append(result.Tables, this.composeSQLTable(table))
+	}
+	return result, nil
+}
+
+// 检查SQLite中的表格是否缺少字段和索引
+func (this *SQLDump) checkSQLiteTable(schemaTable *SQLSchemaTable, currentTable *SQLTable, expectedTable *SQLTable) []*SQLSchemaDrift {
+	var drifts = []*SQLSchemaDrift{}
+	for _, column := range schemaTable.Columns {
+		if currentTable.FindField(column.Name) != nil {
+			continue
+		}
+		var drift = &SQLSchemaDrift{
+			Type:  SQLSchemaDriftMissingColumn,
+			Table: schemaTable.Name,
+			Name:  column.Name,
+		}
+		var field = expectedTable.FindField(column.Name)
+		if field != nil {
+			drift.Expected = field.Definition
+		}
+		drifts = append(drifts, drift)
+	}
+	for _, index := range schemaTable.Indexes {
+		if index.IsPrimary || currentTable.FindIndex(index.Name) != nil {
+			continue
+		}
+		var drift = &SQLSchemaDrift{
+			Type:  SQLSchemaDriftMissingIndex,
+			Table: schemaTable.Name,
+			Name:  index.Name,
+		}
+		var sqlIndex = expectedTable.FindIndex(index.Name)
+		if sqlIndex != nil {
+			drift.Expected = sqlIndex.Definition
+		}
+		drifts = append(drifts, drift)
+	}
+	return drifts
+}
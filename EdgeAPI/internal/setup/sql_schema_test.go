@@ -0,0 +1,70 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestParseSQLSchemaTable(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	schemaTable, err := ParseSQLSchemaTable(&SQLTable{
+		Name:    "edgeTests",
+		Engine:  "InnoDB",
+		Charset: "utf8mb4_general_ci",
+		Definition: "CREATE TABLE `edgeTests` (\n" +
+			"  `id` bigint(11) unsigned NOT NULL AUTO_INCREMENT COMMENT 'ID',\n" +
+			"  `name` varchar(255) DEFAULT NULL COMMENT '名称',\n" +
+			"  `type` varchar(64) DEFAULT '' COMMENT '类型',\n" +
+			"  `price` decimal(11,2) unsigned DEFAULT '0.00' COMMENT 'It''s price',\n" +
+			"  `data` longtext COMMENT '数据',\n" +
+			"  PRIMARY KEY (`id`),\n" +
+			"  UNIQUE KEY `name_type` (`name`,`type`) USING BTREE\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='测试'",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(schemaTable.Name == "edgeTests")
+	a.IsTrue(schemaTable.Comment == "测试")
+	a.IsTrue(len(schemaTable.Columns) == 5)
+	a.IsTrue(len(schemaTable.Indexes) == 2)
+
+	var idColumn = schemaTable.FindColumn("id")
+	a.IsTrue(idColumn != nil && idColumn.AutoIncrement && idColumn.IsUnsigned && idColumn.Length == 11)
+
+	var typeColumn = schemaTable.FindColumn("type")
+	a.IsTrue(typeColumn != nil && typeColumn.HasDefault && typeColumn.Default == "")
+
+	var priceColumn = schemaTable.FindColumn("price")
+	a.IsTrue(priceColumn != nil && priceColumn.Length == 11 && priceColumn.Scale == 2 && priceColumn.Comment == "It's price")
+
+	var index = schemaTable.FindIndex("name_type")
+	a.IsTrue(index != nil && index.IsUnique && len(index.Fields) == 2 && index.Method == "BTREE")
+}
+
+// 所有表格转换后重新生成的MySQL建表语句应该和原来的一致
+func TestParseSQLSchemaTable_MySQL(t *testing.T) {
+	var result = &SQLDumpResult{}
+	err := json.Unmarshal(sqlData, result)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dialect = FindSQLDialect(SQLDialectMySQL)
+	for _, table := range result.Tables {
+		schemaTable, err := ParseSQLSchemaTable(table)
+		if err != nil {
+			t.Fatal(table.Name + ": " + err.Error())
+		}
+		var sqlStrings = dialect.CreateTable(schemaTable)
+		if len(sqlStrings) != 1 || sqlStrings[0] != table.Definition {
+			t.Fatal(table.Name + ": definition not equal:\n" + sqlStrings[0] + "\n" + table.Definition)
+		}
+	}
+	t.Log("checked", len(result.Tables), "tables")
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+type SQLTableChangeType = string
+
+const (
+	SQLTableChangeAddField    SQLTableChangeType = "+field"
+	SQLTableChangeModifyField SQLTableChangeType = "*field"
+	SQLTableChangeDropField   SQLTableChangeType = "-field"
+	SQLTableChangeAddIndex    SQLTableChangeType = "+index"
+	SQLTableChangeModifyIndex SQLTableChangeType = "*index"
+	SQLTableChangeDropIndex   SQLTableChangeType = "-index"
+)
+
+// SQLTableChange 表结构变更
+type SQLTableChange struct {
+	Type SQLTableChangeType
+	Name string // 字段名或索引名
+}
+
+// DiffSQLTables 对比表结构，返回需要执行的变更
+// 执行顺序为：增加和修改字段、增加和修改索引、删除索引、删除字段
+func DiffSQLTables(oldTable *SQLTable, newTable *SQLTable) []*SQLTableChange {
+	var changes = []*SQLTableChange{}
+	if oldTable.Definition == newTable.Definition {
+		return changes
+	}
+
+	// 字段 +/*
+	for _, newField := range newTable.Fields {
+		var oldField = oldTable.FindField(newField.Name)
+		if oldField == nil {
+			changes = append(changes, &SQLTableChange{Type: SQLTableChangeAddField, Name: newField.Name})
+		} else if !newField.EqualDefinition(oldField.Definition) {
+			changes = append(changes, &SQLTableChange{Type: SQLTableChangeModifyField, Name: newField.Name})
+		}
+	}
+
+	// 索引 +/*
+	for _, newIndex := range newTable.Indexes {
+		var oldIndex = oldTable.FindIndex(newIndex.Name)
+		if oldIndex == nil {
+			changes = append(changes, &SQLTableChange{Type: SQLTableChangeAddIndex, Name: newIndex.Name})
+		} else if oldIndex.Definition != newIndex.Definition {
+			changes = append(changes, &SQLTableChange{Type: SQLTableChangeModifyIndex, Name: newIndex.Name})
+		}
+	}
+
+	// 索引 -
+	for _, oldIndex := range oldTable.Indexes {
+		if newTable.FindIndex(oldIndex.Name) == nil {
+			changes = append(changes, &SQLTableChange{Type: SQLTableChangeDropIndex, Name: oldIndex.Name})
+		}
+	}
+
+	// 字段 -
+	for _, oldField := range oldTable.Fields {
+		if newTable.FindField(oldField.Name) == nil {
+			changes = append(changes, &SQLTableChange{Type: SQLTableChangeDropField, Name: oldField.Name})
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package setup
+
+import (
+	"testing"
+
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestDiffSQLTables(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var oldTable = &SQLTable{
+		Definition: "old",
+		Fields: []*SQLField{
+			{Name: "id", Definition: "bigint unsigned auto_increment COMMENT 'ID'"},
+			{Name: "name", Definition: "varchar(100) COMMENT '名称'"},
+			{Name: "oldField", Definition: "varchar(100) COMMENT '旧字段'"},
+		},
+		Indexes: []*SQLIndex{
+			{Name: "PRIMARY", Definition: "UNIQUE KEY `PRIMARY` (`id`) USING BTREE"},
+			{Name: "name", Definition: "KEY `name` (`name`) USING BTREE"},
+			{Name: "oldIndex", Definition: "KEY `oldIndex` (`oldField`) USING BTREE"},
+		},
+	}
+	var newTable = &SQLTable{
+		Definition: "new",
+		Fields: []*SQLField{
+			{Name: "id", Definition: "bigint(20) unsigned auto_increment COMMENT 'ID'"},
+			{Name: "name", Definition: "varchar(255) COMMENT '名称'"},
+			{Name: "newField", Definition: "varchar(100) COMMENT '新字段'"},
+		},
+		Indexes: []*SQLIndex{
+			{Name: "PRIMARY", Definition: "UNIQUE KEY `PRIMARY` (`id`) USING BTREE"},
+			{Name: "name", Definition: "UNIQUE KEY `name` (`name`) USING BTREE"},
+			{Name: "newIndex", Definition: "KEY `newIndex` (`newField`) USING BTREE"},
+		},
+	}
+
+	var changes = DiffSQLTables(oldTable, newTable)
+	var result = []string{}
+	for _, change := range changes {
+		result = append(result, change.Type+" "+change.Name)
+	}
+	t.Log(result)
+	a.IsTrue(len(result) == 6)
+	a.IsTrue(result[0] == "*field name")
+	a.IsTrue(result[1] == "+field newField")
+	a.IsTrue(result[2] == "*index name")
+	a.IsTrue(result[3] == "+index newIndex")
+	a.IsTrue(result[4] == "-index oldIndex")
+	a.IsTrue(result[5] == "-field oldField")
+
+	// 定义相同时没有变化
+	a.IsTrue(len(DiffSQLTables(oldTable, oldTable)) == 0)
+}
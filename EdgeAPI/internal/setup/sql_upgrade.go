@@ -21,114 +21,41 @@ import (
 	"github.com/iwind/TeaGo/types"
 )
 
-type upgradeVersion struct {
-	version string
-	f       func(db *dbs.DB) error
-}
-
-var upgradeFuncs = []*upgradeVersion{
-	{
-		"0.0.3", upgradeV0_0_3,
-	},
-	{
-		"0.0.5", upgradeV0_0_5,
-	},
-	{
-		"0.0.6", upgradeV0_0_6,
-	},
-	{
-		"0.0.9", upgradeV0_0_9,
-	},
-	{
-		"0.0.10", upgradeV0_0_10,
-	},
-	{
-		"0.2.5", upgradeV0_2_5,
-	},
-	{
-		"0.2.8.1", upgradeV0_2_8_1,
-	},
-	{
-		"0.3.0", upgradeV0_3_0,
-	},
-	{
-		"0.3.1", upgradeV0_3_1,
-	},
-	{
-		"0.3.2", upgradeV0_3_2,
-	},
-	{
-		"0.3.3", upgradeV0_3_3,
-	},
-	{
-		"0.3.7", upgradeV0_3_7,
-	},
-	{
-		"0.4.0", upgradeV0_4_0,
-	},
-	{
-		"0.4.1", upgradeV0_4_1,
-	},
-	{
-		"0.4.5", upgradeV0_4_5,
-	},
-	{
-		"0.4.7", upgradeV0_4_7,
-	},
-	{
-		"0.4.8", upgradeV0_4_8,
-	},
-	{
-		"0.4.9", upgradeV0_4_9,
-	},
-	{
-		"0.4.11", upgradeV0_4_11,
-	},
-	{
-		"0.5.3", upgradeV0_5_3,
-	},
-	{
-		"0.5.6", upgradeV0_5_6,
-	},
-	{
-		"0.5.8", upgradeV0_5_8,
-	},
-	{
-		"1.2.1", upgradeV1_2_1,
-	},
-	{
-		"1.2.9", upgradeV1_2_9,
-	},
-	{
-		"1.2.10", upgradeV1_2_10,
-	},
-	{
-		"1.3.2", upgradeV1_3_2,
-	},
-	{
-		"1.3.4", upgradeV1_3_4,
-	},
+// 数据库迁移
+// 需要增加新的迁移时，在此列表最后加入即可
+var sqlMigrations = []*SQLMigration{
+	{Version: "0.0.3", Description: "upgrade data to v0.0.3", UpFunc: upgradeV0_0_3},
+	{Version: "0.0.5", Description: "upgrade data to v0.0.5", UpFunc: upgradeV0_0_5},
+	{Version: "0.0.6", Description: "upgrade data to v0.0.6", UpFunc: upgradeV0_0_6},
+	{Version: "0.0.9", Description: "upgrade data to v0.0.9", UpFunc: upgradeV0_0_9},
+	{Version: "0.0.10", Description: "upgrade data to v0.0.10", UpFunc: upgradeV0_0_10},
+	{Version: "0.2.5", Description: "upgrade data to v0.2.5", UpFunc: upgradeV0_2_5},
+	{Version: "0.2.8.1", Description: "upgrade data to v0.2.8.1", UpFunc: upgradeV0_2_8_1},
+	{Version: "0.3.0", Description: "upgrade data to v0.3.0", UpFunc: upgradeV0_3_0},
+	{Version: "0.3.1", Description: "upgrade data to v0.3.1", UpFunc: upgradeV0_3_1},
+	{Version: "0.3.2", Description: "upgrade data to v0.3.2", UpFunc: upgradeV0_3_2},
+	{Version: "0.3.3", Description: "upgrade data to v0.3.3", UpFunc: upgradeV0_3_3},
+	{Version: "0.3.7", Description: "upgrade data to v0.3.7", UpFunc: upgradeV0_3_7},
+	{Version: "0.4.0", Description: "upgrade data to v0.4.0", UpFunc: upgradeV0_4_0},
+	{Version: "0.4.1", Description: "upgrade data to v0.4.1", UpFunc: upgradeV0_4_1},
+	{Version: "0.4.5", Description: "upgrade data to v0.4.5", UpFunc: upgradeV0_4_5},
+	{Version: "0.4.7", Description: "upgrade data to v0.4.7", UpFunc: upgradeV0_4_7},
+	{Version: "0.4.8", Description: "upgrade data to v0.4.8", UpFunc: upgradeV0_4_8},
+	{Version: "0.4.9", Description: "upgrade data to v0.4.9", UpFunc: upgradeV0_4_9},
+	{Version: "0.4.11", Description: "upgrade data to v0.4.11", UpFunc: upgradeV0_4_11},
+	{Version: "0.5.3", Description: "upgrade data to v0.5.3", UpFunc: upgradeV0_5_3},
+	{Version: "0.5.6", Description: "upgrade data to v0.5.6", UpFunc: upgradeV0_5_6},
+	{Version: "0.5.8", Description: "upgrade data to v0.5.8", UpFunc: upgradeV0_5_8},
+	{Version: "1.2.1", Description: "upgrade data to v1.2.1", UpFunc: upgradeV1_2_1},
+	{Version: "1.2.9", Description: "upgrade data to v1.2.9", UpFunc: upgradeV1_2_9},
+	{Version: "1.2.10", Description: "upgrade data to v1.2.10", UpFunc: upgradeV1_2_10},
+	{Version: "1.3.2", Description: "upgrade data to v1.3.2", UpFunc: upgradeV1_3_2},
+	{Version: "1.3.4", Description: "upgrade data to v1.3.4", UpFunc: upgradeV1_3_4},
 }
 
 // UpgradeSQLData 升级SQL数据
 func UpgradeSQLData(db *dbs.DB) error {
-	version, err := db.FindCol(0, "SELECT version FROM edgeVersions")
-	if err != nil {
-		return err
-	}
-	var versionString = types.String(version)
-	if len(versionString) > 0 {
-		for _, f := range upgradeFuncs {
-			if CompareVersion(versionString, f.version) >= 0 {
-				continue
-			}
-			err = f.f(db)
-			if err != nil {
-				return err
-			}
-		}
-	}
-	return nil
+	return NewSQLMigrator(db).Up()
 }
 
 // v0.0.3
@@ -0,0 +1,210 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"context"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/backup"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/errors"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/s3utils"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		var duration = 1 * time.Hour
+		var task = NewAccessLogArchiveTask(duration)
+		SharedTaskRegistry.Register("accessLogArchive", "归档访问日志", "按照归档设置将较早的访问日志表上传到对象存储后从数据库中删除，只在主节点执行", duration, task.Loop)
+
+		goman.New(func() {
+			task.Start()
+		})
+	})
+}
+
+// AccessLogArchiveTask 访问日志归档任务
+// 每个访问日志表导出为一个和数据库备份格式相同的压缩文件，可以使用备份恢复功能重新导入
+type AccessLogArchiveTask struct {
+	BaseTask
+
+	ticker *TaskTicker
+}
+
+func NewAccessLogArchiveTask(duration time.Duration) *AccessLogArchiveTask {
+	return &AccessLogArchiveTask{
+		ticker: SharedTaskScheduler.NewTicker("accessLogArchive", duration),
+	}
+}
+
+func (this *AccessLogArchiveTask) Start() {
+	for range this.ticker.C {
+		// 只在主节点执行和记录
+		if !this.IsPrimaryNode() {
+			continue
+		}
+
+		err := this.RunLoop(this.ticker.TaskCode(), this.Loop)
+		if isShuttingDownErr(err) {
+			return
+		}
+		if err != nil {
+			this.logErr("AccessLogArchiveTask", err.Error())
+		}
+	}
+}
+
+func (this *AccessLogArchiveTask) Loop() error {
+	if !this.IsPrimaryNode() {
+		return nil
+	}
+
+	config, err := models.SharedSysSettingDAO.ReadAccessLogArchiveConfig(nil)
+	if err != nil {
+		return err
+	}
+	if !config.IsOn || config.Days <= 0 || config.S3 == nil {
+		return nil
+	}
+
+	var endDay = timeutil.Format("Ymd", time.Now().AddDate(0, 0, -config.Days))
+	var client = s3utils.NewClient(config.S3)
+
+	// 当前连接的数据库
+	db, err := dbs.Default()
+	if err != nil {
+		return err
+	}
+	err = this.archiveDB(client, config.S3.Prefix, 0, db, endDay)
+	if err != nil {
+		return err
+	}
+
+	// 日志数据库节点
+	nodes, err := models.SharedDBNodeDAO.FindAllEnabledAndOnDBNodes(nil)
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		err = func(node *models.DBNode) error {
+			nodeDB, err := dbs.NewInstanceFromConfig(node.DBConfig())
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = nodeDB.Close()
+			}()
+
+			return this.archiveDB(client, config.S3.Prefix, int64(node.Id), nodeDB, endDay)
+		}(node)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// 归档某个数据库中早于endDay的访问日志表
+func (this *AccessLogArchiveTask) archiveDB(client *s3utils.Client, prefix string, dbNodeId int64, db *dbs.DB, endDay string) error {
+	ones, columnNames, err := db.FindPreparedOnes("SHOW TABLES")
+	if err != nil {
+		return err
+	}
+	if len(columnNames) != 1 {
+		return errors.New("invalid column names: " + strings.Join(columnNames, ", "))
+	}
+	var columnName = columnNames[0]
+
+	var tableNames = []string{}
+	for _, one := range ones {
+		var tableName = one.GetString(columnName)
+		var matches = accessLogTableReg.FindStringSubmatch(tableName)
+		if len(matches) == 0 || matches[2] >= endDay {
+			continue
+		}
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+
+	for _, tableName := range tableNames {
+		// 上次归档后可能没有删除成功
+		exists, err := models.SharedAccessLogArchiveDAO.ExistArchive(nil, dbNodeId, tableName)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			err = this.archiveTable(client, prefix, dbNodeId, db, tableName)
+			if err != nil {
+				return errors.New("archive table '" + tableName + "' failed: " + err.Error())
+			}
+		}
+
+		_, err = db.Exec("DROP TABLE " + tableName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 导出表格并上传
+func (this *AccessLogArchiveTask) archiveTable(client *s3utils.Client, prefix string, dbNodeId int64, db *dbs.DB, tableName string) error {
+	var matches = accessLogTableReg.FindStringSubmatch(tableName)
+	if len(matches) == 0 {
+		return errors.New("invalid access log table")
+	}
+	var logType = "http"
+	if strings.EqualFold(matches[1], "edgeNSAccessLogs") {
+		logType = "ns"
+	}
+	var day = matches[2]
+
+	tmpFile, err := os.CreateTemp("", "goedge-access-log-*"+backup.FileExt)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	var dumper = backup.NewDumper(db)
+	dumper.Tables = []string{tableName}
+	_, rows, err := dumper.Dump(context.Background(), tmpFile)
+	if err != nil {
+		return err
+	}
+	size, err := tmpFile.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	_, err = tmpFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	// 按照日期分目录，同一天不同数据库节点的表格名称可能相同
+	var objectKey = prefix + "access-logs/" + day + "/db" + types.String(dbNodeId) + "-" + tableName + backup.FileExt
+	err = client.PutObject(objectKey, tmpFile, size)
+	if err != nil {
+		return err
+	}
+
+	err = models.SharedAccessLogArchiveDAO.CreateArchive(nil, dbNodeId, tableName, logType, day, objectKey, size, rows)
+	if err != nil {
+		return err
+	}
+	remotelogs.Println("ACCESS_LOG_ARCHIVE", "archived table '"+tableName+"' to '"+objectKey+"'")
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/backup"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		var duration = 10 * time.Minute
+		var task = NewDBBackupTask(duration)
+		SharedTaskRegistry.Register("dbBackup", "数据库备份", "按照备份设置定时备份数据库和API节点配置文件，只在主节点执行", duration, task.Loop)
+
+		goman.New(func() {
+			task.Start()
+		})
+	})
+}
+
+// DBBackupTask 定时备份数据库
+// 距离最近一次备份超过备份间隔时执行备份
+type DBBackupTask struct {
+	BaseTask
+
+	ticker *TaskTicker
+}
+
+func NewDBBackupTask(duration time.Duration) *DBBackupTask {
+	return &DBBackupTask{
+		ticker: SharedTaskScheduler.NewTicker("dbBackup", duration),
+	}
+}
+
+func (this *DBBackupTask) Start() {
+	for range this.ticker.C {
+		// 只在主节点执行和记录
+		if !this.IsPrimaryNode() {
+			continue
+		}
+
+		err := this.RunLoop(this.ticker.TaskCode(), this.Loop)
+		if isShuttingDownErr(err) {
+			return
+		}
+		if err != nil {
+			this.logErr("DBBackupTask", err.Error())
+		}
+	}
+}
+
+func (this *DBBackupTask) Loop() error {
+	if !this.IsPrimaryNode() {
+		return nil
+	}
+
+	config, err := models.SharedSysSettingDAO.ReadBackupConfig(nil)
+	if err != nil {
+		return err
+	}
+	if !config.IsOn {
+		return nil
+	}
+
+	var intervalHours = config.IntervalHours
+	if intervalHours <= 0 {
+		intervalHours = systemconfigs.DefaultBackupIntervalHours
+	}
+
+	files, err := backup.SharedManager.List(config)
+	if err != nil {
+		return err
+	}
+	if len(files) > 0 && time.Now().Unix()-files[len(files)-1].CreatedAt < int64(intervalHours)*3600 {
+		return nil
+	}
+
+	file, err := backup.SharedManager.Backup(config)
+	if err != nil {
+		if err == backup.ErrBusy {
+			return nil
+		}
+		return err
+	}
+	remotelogs.Println("DB_BACKUP", "backup '"+file.Name+"' created")
+	return nil
+}
@@ -11,17 +11,23 @@ import (
 	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients"
 	"github.com/TeaOSLab/EdgeAPI/internal/dnsclients/dnstypes"
 	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/webhooks"
 	"github.com/TeaOSLab/EdgeCommon/pkg/dnsconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/iputils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	"github.com/iwind/TeaGo/dbs"
 	"github.com/iwind/TeaGo/lists"
+	"github.com/iwind/TeaGo/maps"
 )
 
 func init() {
 	dbs.OnReadyDone(func() {
+		var duration = 20 * time.Second
+		var task = NewDNSTaskExecutor(duration)
+		SharedTaskRegistry.Register("dnsSync", "同步DNS记录", "执行等待中的DNS同步任务，只在主节点执行", duration, task.Loop)
+
 		goman.New(func() {
-			NewDNSTaskExecutor(20 * time.Second).Start()
+			task.Start()
 		})
 	})
 }
@@ -30,12 +36,12 @@ func init() {
 type DNSTaskExecutor struct {
 	BaseTask
 
-	ticker *time.Ticker
+	ticker *TaskTicker
 }
 
 func NewDNSTaskExecutor(duration time.Duration) *DNSTaskExecutor {
 	return &DNSTaskExecutor{
-		ticker: time.NewTicker(duration),
+		ticker: SharedTaskScheduler.NewTicker("dnsSync", duration),
 	}
 }
 
@@ -47,7 +53,15 @@ func (this *DNSTaskExecutor) Start() {
 			time.Sleep(3 * time.Second) // 人为延长N秒，等待可能的几个任务合并
 		}
 
-		err := this.Loop()
+		// 只在主节点执行和记录
+		if !this.IsPrimaryNode() {
+			continue
+		}
+
+		err := this.RunLoop(this.ticker.TaskCode(), this.Loop)
+		if isShuttingDownErr(err) {
+			return
+		}
 		if err != nil {
 			this.logErr("DNSTaskExecutor", err.Error())
 		}
@@ -69,49 +83,46 @@ func (this *DNSTaskExecutor) loop() error {
 	}
 
 	for _, task := range tasks {
+		// API节点正在停止时，执行完当前任务后即退出，剩余的任务由下次启动或者其他API节点继续执行
+		if SharedShutdownCoordinator.IsShuttingDown() {
+			break
+		}
+
 		var taskId = int64(task.Id)
 		var taskVersion = int64(task.Version)
 		switch task.Type {
 		case dnsmodels.DNSTaskTypeServerChange:
 			err = this.doServer(taskId, int64(task.Version), int64(task.ClusterId), int64(task.ServerId))
-			if err != nil {
-				err = dnsmodels.SharedDNSTaskDAO.UpdateDNSTaskError(nil, taskId, err.Error())
-				if err != nil {
-					return err
-				}
-			}
 		case dnsmodels.DNSTaskTypeNodeChange:
 			err = this.doNode(taskId, taskVersion, int64(task.ClusterId), int64(task.NodeId))
-			if err != nil {
-				err = dnsmodels.SharedDNSTaskDAO.UpdateDNSTaskError(nil, taskId, err.Error())
-				if err != nil {
-					return err
-				}
-			}
 		case dnsmodels.DNSTaskTypeClusterChange, dnsmodels.DNSTaskTypeClusterNodesChange:
 			err = this.doCluster(taskId, taskVersion, int64(task.ClusterId), task.Type == dnsmodels.DNSTaskTypeClusterNodesChange)
-			if err != nil {
-				err = dnsmodels.SharedDNSTaskDAO.UpdateDNSTaskError(nil, taskId, err.Error())
-				if err != nil {
-					return err
-				}
-			}
 		case dnsmodels.DNSTaskTypeClusterRemoveDomain:
 			err = this.doClusterRemove(taskId, taskVersion, int64(task.ClusterId), int64(task.DomainId), task.RecordName)
-			if err != nil {
-				err = dnsmodels.SharedDNSTaskDAO.UpdateDNSTaskError(nil, taskId, err.Error())
-				if err != nil {
-					return err
-				}
-			}
 		case dnsmodels.DNSTaskTypeDomainChange:
 			err = this.doDomainWithTask(taskId, taskVersion, int64(task.DomainId))
+		default:
+			continue
+		}
+		if err != nil {
+			err = dnsmodels.SharedDNSTaskDAO.UpdateDNSTaskError(nil, taskId, err.Error())
 			if err != nil {
-				err = dnsmodels.SharedDNSTaskDAO.UpdateDNSTaskError(nil, taskId, err.Error())
-				if err != nil {
-					return err
-				}
+				return err
 			}
+			continue
+		}
+
+		// 触发Webhook事件
+		err = models.SharedWebhookDAO.Trigger(nil, webhooks.EventDNSChanged, maps.Map{
+			"dnsTaskId": taskId,
+			"type":      task.Type,
+			"clusterId": task.ClusterId,
+			"serverId":  task.ServerId,
+			"nodeId":    task.NodeId,
+			"domainId":  task.DomainId,
+		})
+		if err != nil {
+			this.logErr("DNSTaskExecutor", "trigger webhook event failed: "+err.Error())
 		}
 	}
 
@@ -196,8 +207,12 @@ func (this *DNSTaskExecutor) doServer(taskId int64, taskVersion int64, oldCluste
 		return nil
 	}
 	var ttl int32 = 0
+	var conflictPolicy = dnsconfigs.RecordConflictPolicyError
 	if dnsConfig != nil {
 		ttl = dnsConfig.TTL
+		if len(dnsConfig.ConflictPolicy) > 0 {
+			conflictPolicy = dnsConfig.ConflictPolicy
+		}
 	}
 
 	recordValue := clusterDNSName + "." + domain + "."
@@ -254,14 +269,14 @@ func (this *DNSTaskExecutor) doServer(taskId int64, taskVersion int64, oldCluste
 			}
 		}
 
-		err = manager.AddRecord(domain, &dnstypes.Record{
+		_, err = dnsclients.AddRecordWithPolicy(manager, domain, nil, &dnstypes.Record{
 			Id:    "",
 			Name:  recordName,
 			Type:  recordType,
 			Value: recordValue,
 			Route: recordRoute,
 			TTL:   ttl,
-		})
+		}, conflictPolicy)
 		if err != nil {
 			return err
 		}
@@ -348,8 +363,12 @@ func (this *DNSTaskExecutor) doCluster(taskId int64, taskVersion int64, clusterI
 	var clusterDomain = clusterDNSName + "." + domain
 
 	var ttl int32 = 0
+	var conflictPolicy = dnsconfigs.RecordConflictPolicyError
 	if dnsConfig != nil {
 		ttl = dnsConfig.TTL
+		if len(dnsConfig.ConflictPolicy) > 0 {
+			conflictPolicy = dnsConfig.ConflictPolicy
+		}
 	}
 
 	// 以前的节点记录
@@ -446,18 +465,22 @@ func (this *DNSTaskExecutor) doCluster(taskId int64, taskVersion int64, clusterI
 				}
 				addingNodeRecordKeysMap[fullKey] = true
 
-				err = manager.AddRecord(domain, &dnstypes.Record{
+				var newRecord = &dnstypes.Record{
 					Id:    "",
 					Name:  clusterDNSName,
 					Type:  recordType,
 					Value: ip,
 					Route: route,
 					TTL:   ttl,
-				})
+				}
+				isAdded, err := dnsclients.AddRecordWithPolicy(manager, domain, records, newRecord, conflictPolicy)
 				if err != nil {
 					return err
 				}
-				isChanged = true
+				if isAdded {
+					isChanged = true
+					records = append(records, newRecord)
+				}
 				newRecordKeys = append(newRecordKeys, key)
 			}
 		}
@@ -499,18 +522,22 @@ func (this *DNSTaskExecutor) doCluster(taskId int64, taskVersion int64, clusterI
 			serverDNSNames = append(serverDNSNames, dnsName)
 			_, ok := serverRecordsMap[dnsName]
 			if !ok {
-				isChanged = true
-				err = manager.AddRecord(domain, &dnstypes.Record{
+				var newRecord = &dnstypes.Record{
 					Id:    "",
 					Name:  dnsName,
 					Type:  dnstypes.RecordTypeCNAME,
 					Value: clusterDomain + ".",
 					Route: "", // 注意这里为空，需要在执行过程中获取默认值
 					TTL:   ttl,
-				})
+				}
+				isAdded, err := dnsclients.AddRecordWithPolicy(manager, domain, records, newRecord, conflictPolicy)
 				if err != nil {
 					return err
 				}
+				if isAdded {
+					isChanged = true
+					records = append(records, newRecord)
+				}
 			}
 		}
 
@@ -528,18 +555,22 @@ func (this *DNSTaskExecutor) doCluster(taskId int64, taskVersion int64, clusterI
 			serverDNSNames = append(serverDNSNames, cnameRecord)
 			_, ok := serverRecordsMap[cnameRecord]
 			if !ok {
-				isChanged = true
-				err = manager.AddRecord(domain, &dnstypes.Record{
+				var newRecord = &dnstypes.Record{
 					Id:    "",
 					Name:  cnameRecord,
 					Type:  dnstypes.RecordTypeCNAME,
 					Value: clusterDomain + ".",
 					Route: "", // 注意这里为空，需要在执行过程中获取默认值
 					TTL:   ttl,
-				})
+				}
+				isAdded, err := dnsclients.AddRecordWithPolicy(manager, domain, records, newRecord, conflictPolicy)
 				if err != nil {
 					return err
 				}
+				if isAdded {
+					isChanged = true
+					records = append(records, newRecord)
+				}
 			}
 		}
 
@@ -94,8 +94,8 @@ func (this *HealthCheckClusterTask) Stop() {
 
 // Loop 单个循环任务
 func (this *HealthCheckClusterTask) Loop() error {
-	// 检查是否为主节点
-	if !this.IsPrimaryNode() {
+	// 检查当前API节点是否负责此集群
+	if !this.IsPartitionOwner("healthCheck@" + types.String(this.clusterId)) {
 		return nil
 	}
 
@@ -0,0 +1,263 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/iplibrary"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/iwind/TeaGo/dbs"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+var SharedIPLibraryDownloadTask = NewIPLibraryDownloadTask(1 * time.Hour)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		goman.New(func() {
+			SharedIPLibraryDownloadTask.Start()
+		})
+	})
+}
+
+// IPLibraryDownloadTask 定时从第三方IP库下载新的制品
+type IPLibraryDownloadTask struct {
+	BaseTask
+
+	ticker     *time.Ticker
+	httpClient *http.Client
+	locker     sync.Mutex
+}
+
+func NewIPLibraryDownloadTask(duration time.Duration) *IPLibraryDownloadTask {
+	return &IPLibraryDownloadTask{
+		ticker:     time.NewTicker(duration),
+		httpClient: utils.SharedHttpClient(10 * time.Minute),
+	}
+}
+
+func (this *IPLibraryDownloadTask) Start() {
+	for range this.ticker.C {
+		err := this.Loop()
+		if err != nil {
+			this.logErr("IPLibraryDownloadTask", err.Error())
+		}
+	}
+}
+
+func (this *IPLibraryDownloadTask) Loop() error {
+	// 检查是否为主节点
+	if !this.IsPrimaryNode() {
+		return nil
+	}
+
+	var tx *dbs.Tx
+	config, err := models.SharedSysSettingDAO.ReadIPLibraryConfig(tx)
+	if err != nil {
+		return err
+	}
+	if !config.AutoUpdate || config.Driver == iplibrary.DriverTypeDefault {
+		return nil
+	}
+
+	var intervalDays = config.UpdateIntervalDays
+	if intervalDays <= 0 {
+		intervalDays = 7
+	}
+	if config.LastUpdatedAt > time.Now().Unix()-int64(intervalDays)*86400 {
+		return nil
+	}
+
+	_, err = this.Download(config, true)
+	return err
+}
+
+// Download 下载IP库并创建制品
+func (this *IPLibraryDownloadTask) Download(config *systemconfigs.IPLibraryConfig, isPublic bool) (artifactId int64, err error) {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	var tx *dbs.Tx
+
+	// 无论成功与否都记录更新时间，防止失败时频繁重试
+	defer func() {
+		config.LastUpdatedAt = time.Now().Unix()
+		configJSON, jsonErr := json.Marshal(config)
+		if jsonErr == nil {
+			_ = models.SharedSysSettingDAO.UpdateSetting(tx, systemconfigs.SettingCodeIPLibraryConfig, configJSON)
+		}
+	}()
+
+	tmpFile, err := os.CreateTemp("", "ip-library-*"+iplibrary.DriverFileExt(config.Driver))
+	if err != nil {
+		return 0, fmt.Errorf("create temp file failed: %w", err)
+	}
+	var tmpPath = tmpFile.Name()
+	defer func() {
+		_ = os.Remove(tmpPath)
+	}()
+
+	switch config.Driver {
+	case iplibrary.DriverTypeMaxMind:
+		err = this.downloadMaxMind(config, tmpFile)
+	case iplibrary.DriverTypeIPIP:
+		err = this.downloadIPIP(config, tmpFile)
+	default:
+		err = errors.New("ip library driver '" + config.Driver + "' does not support downloading")
+	}
+	_ = tmpFile.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	// 校验文件
+	reader, err := iplibrary.NewReaderWithDriver(config.Driver, tmpPath)
+	if err != nil {
+		return 0, fmt.Errorf("validate downloaded file failed: %w", err)
+	}
+	var meta = reader.Meta()
+	reader.Destroy()
+
+	// 保存到文件
+	stat, err := os.Stat(tmpPath)
+	if err != nil {
+		return 0, err
+	}
+	fileId, err := models.SharedFileDAO.CreateFile(tx, 0, 0, "ipLibraryFile", "", "ip-library-"+config.Driver+iplibrary.DriverFileExt(config.Driver), stat.Size(), "", false)
+	if err != nil {
+		return 0, err
+	}
+	fp, err := os.Open(tmpPath)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+	var buf = make([]byte, 256*1024)
+	for {
+		n, readErr := fp.Read(buf)
+		if n > 0 {
+			_, err = models.SharedFileChunkDAO.CreateFileChunk(tx, fileId, buf[:n])
+			if err != nil {
+				return 0, err
+			}
+		}
+		if readErr != nil {
+			if readErr != io.EOF {
+				return 0, readErr
+			}
+			break
+		}
+	}
+	err = models.SharedFileDAO.UpdateFileIsFinished(tx, fileId)
+	if err != nil {
+		return 0, err
+	}
+
+	var name = iplibrary.FindDriverTypeName(config.Driver) + " " + timeutil.Format("Y-m-d")
+	artifactId, err = models.SharedIPLibraryArtifactDAO.CreateArtifact(tx, name, fileId, 0, meta, config.Driver)
+	if err != nil {
+		return 0, err
+	}
+
+	if isPublic {
+		err = models.SharedIPLibraryArtifactDAO.UpdateArtifactPublic(tx, artifactId, true)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return artifactId, nil
+}
+
+// 从MaxMind下载.mmdb文件
+func (this *IPLibraryDownloadTask) downloadMaxMind(config *systemconfigs.IPLibraryConfig, writer io.Writer) error {
+	if len(config.MaxMindLicenseKey) == 0 {
+		return errors.New("'maxMindLicenseKey' should not be empty")
+	}
+	var editionId = config.MaxMindEditionId
+	if len(editionId) == 0 {
+		editionId = "GeoLite2-City"
+	}
+
+	var downloadURL = "https://download.maxmind.com/app/geoip_download?edition_id=" + url.QueryEscape(editionId) + "&license_key=" + url.QueryEscape(config.MaxMindLicenseKey) + "&suffix=tar.gz"
+	resp, err := this.get(downloadURL)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	gzipReader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return fmt.Errorf("decode gzip failed: %w", err)
+	}
+	var tarReader = tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return fmt.Errorf("decode tar failed: %w", err)
+		}
+		if header.Typeflag == tar.TypeReg && strings.HasSuffix(header.Name, ".mmdb") {
+			_, err = io.Copy(writer, tarReader)
+			return err
+		}
+	}
+	return errors.New("can not find '.mmdb' file in downloaded package")
+}
+
+// 从ipip.net下载.ipdb文件
+func (this *IPLibraryDownloadTask) downloadIPIP(config *systemconfigs.IPLibraryConfig, writer io.Writer) error {
+	if len(config.IPIPDownloadURL) == 0 {
+		return errors.New("'ipipDownloadURL' should not be empty")
+	}
+
+	resp, err := this.get(config.IPIPDownloadURL)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	_, err = io.Copy(writer, resp.Body)
+	return err
+}
+
+func (this *IPLibraryDownloadTask) get(downloadURL string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", teaconst.ProductName+"/"+teaconst.Version)
+	resp, err := this.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, errors.New("invalid response status code '" + resp.Status + "'")
+	}
+	return resp, nil
+}
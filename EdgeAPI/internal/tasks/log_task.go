@@ -72,6 +72,12 @@ func (this *LogTask) LoopClean() error {
 		if err != nil {
 			return err
 		}
+
+		// 审计日志和操作日志使用同样的保留天数
+		err = models.SharedAuditLogDAO.DeleteAuditLogsBeforeDays(nil, config.Days)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
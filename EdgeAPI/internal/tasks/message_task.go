@@ -10,8 +10,12 @@ import (
 
 func init() {
 	dbs.OnReadyDone(func() {
+		var duration = 24 * time.Hour
+		var task = NewMessageTask(duration)
+		SharedTaskRegistry.Register("messageClean", "清理消息", "按数据保留设置删除过期的消息、消息发送任务和发送日志", duration, task.Loop)
+
 		goman.New(func() {
-			NewMessageTask(24 * time.Hour).Start()
+			task.Start()
 		})
 	})
 }
@@ -20,20 +24,28 @@ func init() {
 type MessageTask struct {
 	BaseTask
 
-	ticker *time.Ticker
+	ticker *TaskTicker
 }
 
 // NewMessageTask 获取新对象
 func NewMessageTask(duration time.Duration) *MessageTask {
 	return &MessageTask{
-		ticker: time.NewTicker(duration),
+		ticker: SharedTaskScheduler.NewTicker("messageClean", duration),
 	}
 }
 
 // Start 开始运行
 func (this *MessageTask) Start() {
 	for range this.ticker.C {
-		err := this.Loop()
+		// 多个API节点时，每个周期只在其中一个节点上执行
+		if !this.TryLock(this.ticker.TaskCode(), this.ticker.Lease()) {
+			continue
+		}
+
+		err := this.RunLoop(this.ticker.TaskCode(), this.Loop)
+		if isShuttingDownErr(err) {
+			return
+		}
 		if err != nil {
 			this.logErr("MessageTask", err.Error())
 		}
@@ -42,6 +54,20 @@ func (this *MessageTask) Start() {
 
 // Loop 单次运行
 func (this *MessageTask) Loop() error {
-	dayTime := time.Now().AddDate(0, 0, -30) // TODO 这个30天应该可以在界面上设置
-	return models.NewMessageDAO().DeleteMessagesBeforeDay(nil, dayTime)
+	config, err := models.SharedSysSettingDAO.ReadRetentionConfig(nil)
+	if err != nil {
+		return err
+	}
+
+	err = models.SharedMessageDAO.CleanWithRetention(nil, config.Message)
+	if err != nil {
+		return err
+	}
+
+	// 消息发送任务和发送日志
+	err = models.SharedMessageTaskDAO.CleanWithRetention(nil, config.TaskLog)
+	if err != nil {
+		return err
+	}
+	return models.SharedMessageTaskLogDAO.CleanWithRetention(nil, config.TaskLog)
 }
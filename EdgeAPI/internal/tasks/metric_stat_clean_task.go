@@ -0,0 +1,66 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		var duration = 24 * time.Hour
+		var task = NewMetricStatCleanTask(duration)
+		SharedTaskRegistry.Register("metricStatClean", "清理指标统计数据", "按指标的保留周期和数据保留设置删除过期的指标统计数据", duration, task.Loop)
+
+		goman.New(func() {
+			task.Start()
+		})
+	})
+}
+
+// MetricStatCleanTask 清理指标统计数据的任务
+type MetricStatCleanTask struct {
+	BaseTask
+
+	ticker *TaskTicker
+}
+
+func NewMetricStatCleanTask(duration time.Duration) *MetricStatCleanTask {
+	return &MetricStatCleanTask{
+		ticker: SharedTaskScheduler.NewTicker("metricStatClean", duration),
+	}
+}
+
+func (this *MetricStatCleanTask) Start() {
+	for range this.ticker.C {
+		// 多个API节点时，每个周期只在其中一个节点上执行
+		if !this.TryLock(this.ticker.TaskCode(), this.ticker.Lease()) {
+			continue
+		}
+
+		err := this.RunLoop(this.ticker.TaskCode(), this.Loop)
+		if isShuttingDownErr(err) {
+			return
+		}
+		if err != nil {
+			this.logErr("MetricStatCleanTask", err.Error())
+		}
+	}
+}
+
+func (this *MetricStatCleanTask) Loop() error {
+	config, err := models.SharedSysSettingDAO.ReadRetentionConfig(nil)
+	if err != nil {
+		return err
+	}
+
+	err = models.SharedMetricStatDAO.CleanWithRetention(nil, config.MetricStat)
+	if err != nil {
+		return err
+	}
+	return models.SharedMetricSumStatDAO.CleanWithRetention(nil, config.MetricStat)
+}
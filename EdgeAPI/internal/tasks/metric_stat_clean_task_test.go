@@ -0,0 +1,22 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/tasks"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func TestMetricStatCleanTask_Loop(t *testing.T) {
+	dbs.NotifyReady()
+
+	var task = tasks.NewMetricStatCleanTask(24 * time.Hour)
+	err := task.Loop()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("ok")
+}
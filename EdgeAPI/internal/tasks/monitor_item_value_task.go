@@ -13,8 +13,12 @@ import (
 
 func init() {
 	dbs.OnReadyDone(func() {
+		var duration = 1 * time.Hour
+		var task = NewMonitorItemValueTask(duration)
+		SharedTaskRegistry.Register("monitorItemValueClean", "清理节点监控数据", "按数据保留设置删除过期的节点监控数值", duration, task.Loop)
+
 		goman.New(func() {
-			NewMonitorItemValueTask(1 * time.Hour).Start()
+			task.Start()
 		})
 	})
 }
@@ -23,24 +27,31 @@ func init() {
 type MonitorItemValueTask struct {
 	BaseTask
 
-	ticker *time.Ticker
+	ticker *TaskTicker
 }
 
 // NewMonitorItemValueTask 获取新对象
 func NewMonitorItemValueTask(duration time.Duration) *MonitorItemValueTask {
-	var ticker = time.NewTicker(duration)
 	if Tea.IsTesting() {
-		ticker = time.NewTicker(1 * time.Minute)
+		duration = 1 * time.Minute
 	}
 
 	return &MonitorItemValueTask{
-		ticker: ticker,
+		ticker: SharedTaskScheduler.NewTicker("monitorItemValueClean", duration),
 	}
 }
 
 func (this *MonitorItemValueTask) Start() {
 	for range this.ticker.C {
-		err := this.Loop()
+		// 多个API节点时，每个周期只在其中一个节点上执行
+		if !this.TryLock(this.ticker.TaskCode(), this.ticker.Lease()) {
+			continue
+		}
+
+		err := this.RunLoop(this.ticker.TaskCode(), this.Loop)
+		if isShuttingDownErr(err) {
+			return
+		}
 		if err != nil {
 			this.logErr("MonitorItemValueTask", err.Error())
 		}
@@ -48,5 +59,9 @@ func (this *MonitorItemValueTask) Start() {
 }
 
 func (this *MonitorItemValueTask) Loop() error {
-	return models.SharedNodeValueDAO.Clean(nil)
+	config, err := models.SharedSysSettingDAO.ReadRetentionConfig(nil)
+	if err != nil {
+		return err
+	}
+	return models.SharedNodeValueDAO.CleanWithRetention(nil, config.NodeValue)
 }
@@ -0,0 +1,215 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/bgpclients"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/lists"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		goman.New(func() {
+			NewNodeBGPTask(1 * time.Minute).Start()
+		})
+	})
+}
+
+// NodeBGPTask 根据节点上线、下线、维护等状态宣告或撤回BGP路由
+type NodeBGPTask struct {
+	BaseTask
+
+	ticker *time.Ticker
+}
+
+func NewNodeBGPTask(duration time.Duration) *NodeBGPTask {
+	return &NodeBGPTask{
+		ticker: time.NewTicker(duration),
+	}
+}
+
+func (this *NodeBGPTask) Start() {
+	for {
+		select {
+		case <-this.ticker.C:
+		case <-models.NodeBGPNotifier:
+			time.Sleep(3 * time.Second) // 等待可能的几个状态变化合并
+		}
+
+		err := this.Loop()
+		if err != nil {
+			this.logErr("NodeBGPTask", err.Error())
+		}
+	}
+}
+
+func (this *NodeBGPTask) Loop() error {
+	if !this.IsPrimaryNode() {
+		return nil
+	}
+
+	nodes, err := models.SharedNodeDAO.FindAllBGPNodes(nil)
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		err = this.syncNode(node)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (this *NodeBGPTask) syncNode(node *models.Node) error {
+	var nodeId = int64(node.Id)
+	var config = node.DecodeBGPConfig()
+	var status = node.DecodeBGPStatus()
+
+	var shouldAnnounce = node.State == models.NodeStateEnabled && config.ShouldAnnounce(node.IsOn, node.IsUp)
+	var event string
+	switch {
+	case node.State != models.NodeStateEnabled:
+		event = "deleted"
+	case !config.IsOn || !node.IsOn:
+		event = "disabled"
+	case config.IsMaintaining:
+		event = "maintenance"
+	case !node.IsUp:
+		event = "down"
+	default:
+		event = "up"
+	}
+
+	var oldError = status.Error
+	var newStatus = &nodeconfigs.NodeBGPStatus{
+		IsAnnounced: status.IsAnnounced,
+		Config:      status.Config,
+		Event:       event,
+		UpdatedAt:   time.Now().Unix(),
+	}
+
+	if shouldAnnounce {
+		// 已经以相同的配置宣告过
+		if status.IsAnnounced && len(oldError) == 0 && this.sameAnnouncement(status.Config, config) {
+			return nil
+		}
+
+		// 先撤回不再需要的宣告
+		if status.IsAnnounced && status.Config != nil {
+			var staleConfig = this.staleAnnouncement(status.Config, config)
+			if staleConfig != nil {
+				err := this.withdraw(staleConfig)
+				if err != nil {
+					newStatus.Error = "withdraw stale prefixes failed: " + err.Error()
+					return this.updateStatus(nodeId, oldError, newStatus)
+				}
+			}
+		}
+
+		err := this.announce(config)
+		if err != nil {
+			newStatus.Error = "announce failed: " + err.Error()
+			return this.updateStatus(nodeId, oldError, newStatus)
+		}
+		newStatus.IsAnnounced = true
+		newStatus.Config = config
+		return this.updateStatus(nodeId, oldError, newStatus)
+	}
+
+	if !status.IsAnnounced {
+		return nil
+	}
+
+	// 使用宣告时的配置撤回
+	var withdrawConfig = status.Config
+	if withdrawConfig == nil {
+		withdrawConfig = config
+	}
+	err := this.withdraw(withdrawConfig)
+	if err != nil {
+		newStatus.Error = "withdraw failed: " + err.Error()
+		return this.updateStatus(nodeId, oldError, newStatus)
+	}
+	newStatus.IsAnnounced = false
+	return this.updateStatus(nodeId, oldError, newStatus)
+}
+
+func (this *NodeBGPTask) announce(config *nodeconfigs.NodeBGPConfig) error {
+	driver, err := bgpclients.NewDriver(config)
+	if err != nil {
+		return err
+	}
+	return driver.Announce()
+}
+
+func (this *NodeBGPTask) withdraw(config *nodeconfigs.NodeBGPConfig) error {
+	driver, err := bgpclients.NewDriver(config)
+	if err != nil {
+		return err
+	}
+	return driver.Withdraw()
+}
+
+// 判断两次宣告的内容是否一致
+func (this *NodeBGPTask) sameAnnouncement(oldConfig *nodeconfigs.NodeBGPConfig, newConfig *nodeconfigs.NodeBGPConfig) bool {
+	if oldConfig == nil || newConfig == nil {
+		return false
+	}
+	return this.announcementSignature(oldConfig) == this.announcementSignature(newConfig)
+}
+
+func (this *NodeBGPTask) announcementSignature(config *nodeconfigs.NodeBGPConfig) string {
+	data, _ := json.Marshal([]any{config.Driver, config.Endpoint, config.Prefixes, config.NextHop, config.ASPath, config.Communities})
+	return string(data)
+}
+
+// 找出新配置中不再宣告的部分，返回nil表示没有需要撤回的
+func (this *NodeBGPTask) staleAnnouncement(oldConfig *nodeconfigs.NodeBGPConfig, newConfig *nodeconfigs.NodeBGPConfig) *nodeconfigs.NodeBGPConfig {
+	// 更换了控制器，需要撤回之前所有的宣告
+	if oldConfig.Driver != newConfig.Driver || oldConfig.Endpoint != newConfig.Endpoint {
+		return oldConfig
+	}
+
+	var stalePrefixes = []string{}
+	for _, prefix := range oldConfig.Prefixes {
+		if !lists.ContainsString(newConfig.Prefixes, prefix) {
+			stalePrefixes = append(stalePrefixes, prefix)
+		}
+	}
+	if len(stalePrefixes) == 0 {
+		return nil
+	}
+
+	var staleConfig = *oldConfig
+	staleConfig.Prefixes = stalePrefixes
+	return &staleConfig
+}
+
+func (this *NodeBGPTask) updateStatus(nodeId int64, oldError string, status *nodeconfigs.NodeBGPStatus) error {
+	err := models.SharedNodeDAO.UpdateNodeBGPStatus(nil, nodeId, status)
+	if err != nil {
+		return err
+	}
+
+	// 记录日志，同样的错误只记录一次
+	if len(status.Error) > 0 {
+		if status.Error != oldError {
+			_ = models.SharedNodeLogDAO.CreateLog(nil, nodeconfigs.NodeRoleNode, nodeId, 0, 0, models.LevelError, "BGP", "event '"+status.Event+"': "+status.Error, time.Now().Unix(), "", nil)
+		}
+		return nil
+	}
+	var action = "withdraw"
+	if status.IsAnnounced {
+		action = "announce"
+	}
+	_ = models.SharedNodeLogDAO.CreateLog(nil, nodeconfigs.NodeRoleNode, nodeId, 0, 0, models.LevelSuccess, "BGP", "event '"+status.Event+"': "+action+" prefixes successfully", time.Now().Unix(), "", nil)
+	return nil
+}
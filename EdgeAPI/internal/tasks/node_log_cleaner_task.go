@@ -10,8 +10,12 @@ import (
 
 func init() {
 	dbs.OnReadyDone(func() {
+		var duration = 24 * time.Hour
+		var task = NewNodeLogCleanerTask(duration)
+		SharedTaskRegistry.Register("nodeLogClean", "清理节点日志", "按数据保留设置删除过期的节点运行日志和后台任务执行记录", duration, task.Loop)
+
 		goman.New(func() {
-			NewNodeLogCleanerTask(24 * time.Hour).Start()
+			task.Start()
 		})
 	})
 }
@@ -20,18 +24,26 @@ func init() {
 type NodeLogCleanerTask struct {
 	BaseTask
 
-	ticker *time.Ticker
+	ticker *TaskTicker
 }
 
 func NewNodeLogCleanerTask(duration time.Duration) *NodeLogCleanerTask {
 	return &NodeLogCleanerTask{
-		ticker: time.NewTicker(duration),
+		ticker: SharedTaskScheduler.NewTicker("nodeLogClean", duration),
 	}
 }
 
 func (this *NodeLogCleanerTask) Start() {
 	for range this.ticker.C {
-		err := this.Loop()
+		// 多个API节点时，每个周期只在其中一个节点上执行
+		if !this.TryLock(this.ticker.TaskCode(), this.ticker.Lease()) {
+			continue
+		}
+
+		err := this.RunLoop(this.ticker.TaskCode(), this.Loop)
+		if isShuttingDownErr(err) {
+			return
+		}
 		if err != nil {
 			this.logErr("NodeLogCleanerTask", err.Error())
 		}
@@ -39,12 +51,15 @@ func (this *NodeLogCleanerTask) Start() {
 }
 
 func (this *NodeLogCleanerTask) Loop() error {
-	// 删除 N天 以前的info日志
-	err := models.SharedNodeLogDAO.DeleteExpiredLogsWithLevel(nil, "info", 3)
+	config, err := models.SharedSysSettingDAO.ReadRetentionConfig(nil)
+	if err != nil {
+		return err
+	}
+	err = models.SharedNodeLogDAO.CleanWithRetention(nil, config.NodeLog)
 	if err != nil {
 		return err
 	}
 
-	// TODO 7天这个数值改成可以设置
-	return models.SharedNodeLogDAO.DeleteExpiredLogs(nil, 7)
+	// 后台任务执行记录
+	return models.SharedTaskRunDAO.CleanWithRetention(nil, config.TaskRun)
 }
@@ -7,8 +7,10 @@ import (
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	"github.com/TeaOSLab/EdgeAPI/internal/goman"
 	"github.com/TeaOSLab/EdgeAPI/internal/installers"
+	"github.com/TeaOSLab/EdgeAPI/internal/webhooks"
 	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
 	"github.com/iwind/TeaGo/types"
 )
 
@@ -57,16 +59,18 @@ func (this *NodeMonitorTask) Start() {
 }
 
 func (this *NodeMonitorTask) Loop() error {
-	// 检查是否为主节点
-	if !this.IsPrimaryNode() {
-		return nil
-	}
-
 	clusters, err := models.SharedNodeClusterDAO.FindAllEnableClusters(nil)
 	if err != nil {
 		return err
 	}
 	for _, cluster := range clusters {
+		// 每个集群只由一个API节点负责
+		var clusterId = int64(cluster.Id)
+		if !this.IsPartitionOwner("nodeMonitor@" + types.String(clusterId)) {
+			this.resetCluster(clusterId)
+			continue
+		}
+
 		err := this.MonitorCluster(cluster)
 		if err != nil {
 			return err
@@ -156,6 +160,17 @@ func (this *NodeMonitorTask) MonitorCluster(cluster *models.NodeCluster) error {
 				if err != nil {
 					return err
 				}
+
+				// Webhook事件
+				err = models.SharedWebhookDAO.Trigger(nil, webhooks.EventNodeOffline, maps.Map{
+					"nodeId":      nodeId,
+					"nodeName":    node.Name,
+					"clusterId":   clusterId,
+					"clusterName": cluster.Name,
+				})
+				if err != nil {
+					this.logErr("NodeMonitorTask", "trigger webhook event failed: "+err.Error())
+				}
 			}
 		} else {
 			delete(this.inactiveMap, key)
@@ -167,3 +182,13 @@ func (this *NodeMonitorTask) MonitorCluster(cluster *models.NodeCluster) error {
 
 	return nil
 }
+
+// 清除集群的离线计数，集群已经由别的API节点负责
+func (this *NodeMonitorTask) resetCluster(clusterId int64) {
+	var prefix = types.String(clusterId) + "@"
+	for key := range this.inactiveMap {
+		if strings.HasPrefix(key, prefix) {
+			delete(this.inactiveMap, key)
+		}
+	}
+}
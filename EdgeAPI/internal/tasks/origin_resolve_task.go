@@ -0,0 +1,204 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/taskutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/iwind/TeaGo/dbs"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		goman.New(func() {
+			NewOriginResolveTask(30 * time.Second).Start()
+		})
+	})
+}
+
+// 域名解析结果
+type originHostResult struct {
+	ips       []string
+	ttl       uint32
+	err       error
+	expiresAt int64
+}
+
+// 源站解析状态
+type originResolveState struct {
+	host      string
+	expiresAt int64
+}
+
+// OriginResolveTask 在API节点上解析源站域名，域名按照在线API节点分区解析
+// 只有在IP实际发生变化时才通知节点更新配置，避免不必要的配置重载
+type OriginResolveTask struct {
+	BaseTask
+
+	ticker *time.Ticker
+
+	hostMap   map[string]*originHostResult  // host => result
+	originMap map[int64]*originResolveState // originId => state
+}
+
+func NewOriginResolveTask(duration time.Duration) *OriginResolveTask {
+	return &OriginResolveTask{
+		ticker:    time.NewTicker(duration),
+		hostMap:   map[string]*originHostResult{},
+		originMap: map[int64]*originResolveState{},
+	}
+}
+
+func (this *OriginResolveTask) Start() {
+	for range this.ticker.C {
+		err := this.Loop()
+		if err != nil {
+			this.logErr("OriginResolveTask", err.Error())
+		}
+	}
+}
+
+func (this *OriginResolveTask) Loop() error {
+	var tx *dbs.Tx
+	var now = time.Now().Unix()
+	var lastOriginId int64
+	var size int64 = 1000
+	for {
+		origins, err := models.SharedOriginDAO.ListEnabledOriginAddrsAfterId(tx, lastOriginId, size)
+		if err != nil {
+			return fmt.Errorf("list origins failed: %w", err)
+		}
+		if len(origins) == 0 {
+			break
+		}
+		lastOriginId = int64(origins[len(origins)-1].Id)
+
+		err = this.resolveOrigins(tx, origins, now)
+		if err != nil {
+			return err
+		}
+
+		if int64(len(origins)) < size {
+			break
+		}
+	}
+
+	// 清理过期的解析结果
+	for host, result := range this.hostMap {
+		if result.expiresAt <= now {
+			delete(this.hostMap, host)
+		}
+	}
+
+	return nil
+}
+
+func (this *OriginResolveTask) resolveOrigins(tx *dbs.Tx, origins []*models.Origin, now int64) error {
+	var originHostMap = map[int64]string{} // originId => host
+	var hosts = []string{}
+	var hostSet = map[string]bool{}
+	for _, origin := range origins {
+		if !models.IsNotNull(origin.Addr) {
+			continue
+		}
+		var addr = &serverconfigs.NetworkAddressConfig{}
+		err := json.Unmarshal(origin.Addr, addr)
+		if err != nil || !models.IsResolvableOriginAddr(addr) {
+			continue
+		}
+
+		var originId = int64(origin.Id)
+
+		// 源站按照域名分配给不同的API节点，同一个域名只会在一个API节点上解析
+		if !this.IsPartitionOwner("originResolve@" + addr.Host) {
+			delete(this.originMap, originId)
+			continue
+		}
+
+		state, ok := this.originMap[originId]
+		if ok && state.host == addr.Host && state.expiresAt > now {
+			continue
+		}
+		originHostMap[originId] = addr.Host
+
+		if hostSet[addr.Host] {
+			continue
+		}
+		result, ok := this.hostMap[addr.Host]
+		if !ok || result.expiresAt <= now {
+			hosts = append(hosts, addr.Host)
+			hostSet[addr.Host] = true
+		}
+	}
+
+	// 同一个域名只解析一次
+	if len(hosts) > 0 {
+		err := taskutils.RunConcurrent(hosts, taskutils.DefaultConcurrent, func(task any, locker *sync.RWMutex) {
+			var host = task.(string)
+			ips, ttl, err := utils.LookupIPs(host)
+
+			var result = &originHostResult{
+				ips: ips,
+				ttl: ttl,
+				err: err,
+			}
+			if err != nil || len(ips) == 0 {
+				result.expiresAt = now + models.OriginResolutionRetryTTL
+			} else {
+				var cacheTTL = int64(ttl)
+				if cacheTTL < models.OriginResolutionMinTTL {
+					cacheTTL = models.OriginResolutionMinTTL
+				} else if cacheTTL > models.OriginResolutionMaxTTL {
+					cacheTTL = models.OriginResolutionMaxTTL
+				}
+				result.expiresAt = now + cacheTTL
+			}
+
+			locker.Lock()
+			this.hostMap[host] = result
+			locker.Unlock()
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for originId, host := range originHostMap {
+		var result = this.hostMap[host]
+		if result == nil {
+			continue
+		}
+
+		changed, err := models.SharedOriginResolutionDAO.UpdateOriginResolution(tx, originId, host, result.ips, result.ttl, result.err)
+		if err != nil {
+			return fmt.Errorf("update origin resolution failed: %w", err)
+		}
+		if result.err != nil {
+			remotelogs.Warn("OriginResolveTask", "resolve origin '"+host+"' failed: "+result.err.Error())
+		}
+
+		// 只有IP变化时才通知更新
+		if changed {
+			err = models.SharedOriginDAO.NotifyUpdate(tx, originId)
+			if err != nil {
+				return fmt.Errorf("notify origin update failed: %w", err)
+			}
+		}
+
+		this.originMap[originId] = &originResolveState{
+			host:      host,
+			expiresAt: result.expiresAt,
+		}
+	}
+
+	return nil
+}
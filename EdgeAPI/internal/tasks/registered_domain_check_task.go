@@ -0,0 +1,211 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/rdaputils"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		var duration = 1 * time.Hour
+		var task = NewRegisteredDomainCheckTask(duration)
+		SharedTaskRegistry.Register("registeredDomainCheck", "检查域名注册信息", "通过RDAP检查网站域名的注册过期时间，只在主节点执行", duration, task.Loop)
+
+		goman.New(func() {
+			task.Start()
+		})
+	})
+}
+
+// RegisteredDomainCheckTask 通过RDAP检查网站所用域名的注册过期时间
+type RegisteredDomainCheckTask struct {
+	BaseTask
+
+	ticker     *TaskTicker
+	rdapClient *rdaputils.Client
+
+	lastSyncDay string
+}
+
+func NewRegisteredDomainCheckTask(duration time.Duration) *RegisteredDomainCheckTask {
+	return &RegisteredDomainCheckTask{
+		ticker:     SharedTaskScheduler.NewTicker("registeredDomainCheck", duration),
+		rdapClient: rdaputils.NewClient(utils.SharedHttpClient(10 * time.Second)),
+	}
+}
+
+func (this *RegisteredDomainCheckTask) Start() {
+	for range this.ticker.C {
+		// 只在主节点执行和记录
+		if !this.IsPrimaryNode() {
+			continue
+		}
+
+		err := this.RunLoop(this.ticker.TaskCode(), this.Loop)
+		if isShuttingDownErr(err) {
+			return
+		}
+		if err != nil {
+			this.logErr("RegisteredDomainCheckTask", err.Error())
+		}
+	}
+}
+
+func (this *RegisteredDomainCheckTask) Loop() error {
+	// 检查是否为主节点
+	if !this.IsPrimaryNode() {
+		return nil
+	}
+
+	var tx *dbs.Tx
+
+	// 每天同步一次域名列表
+	var today = timeutil.Format("Ymd")
+	if this.lastSyncDay != today {
+		err := this.syncDomains(tx)
+		if err != nil {
+			return err
+		}
+		this.lastSyncDay = today
+	}
+
+	err := this.checkDomains(tx)
+	if err != nil {
+		return err
+	}
+
+	return this.notifyDomains(tx)
+}
+
+// 从网站域名中提取需要检查的注册域名
+func (this *RegisteredDomainCheckTask) syncDomains(tx *dbs.Tx) error {
+	existKeys, err := models.SharedRegisteredDomainDAO.FindAllDomainKeys(tx)
+	if err != nil {
+		return err
+	}
+
+	var lastServerId int64
+	const size = 1000
+	for {
+		servers, err := models.SharedServerDAO.ListEnabledServerPlainNames(tx, lastServerId, size)
+		if err != nil {
+			return err
+		}
+		for _, server := range servers {
+			lastServerId = int64(server.Id)
+			for _, serverName := range server.DecodePlainServerNames() {
+				domain, err := rdaputils.RegisteredDomain(serverName)
+				if err != nil {
+					continue
+				}
+				var key = models.RegisteredDomainKey(int64(server.UserId), domain)
+				_, ok := existKeys[key]
+				if ok {
+					// 标记为仍在使用
+					existKeys[key] = 0
+					continue
+				}
+				err = models.SharedRegisteredDomainDAO.CreateDomain(tx, int64(server.UserId), domain)
+				if err != nil {
+					return err
+				}
+				existKeys[key] = 0
+			}
+		}
+		if len(servers) < size {
+			break
+		}
+	}
+
+	// 删除不再使用的域名
+	var staleIds = []int64{}
+	for _, domainId := range existKeys {
+		if domainId > 0 {
+			staleIds = append(staleIds, domainId)
+		}
+	}
+	return models.SharedRegisteredDomainDAO.DeleteDomains(tx, staleIds)
+}
+
+// 查询注册信息
+func (this *RegisteredDomainCheckTask) checkDomains(tx *dbs.Tx) error {
+	// 每次只检查一部分，避免触发RDAP服务器的频率限制
+	domains, err := models.SharedRegisteredDomainDAO.FindDomainsToCheck(tx, 100)
+	if err != nil {
+		return err
+	}
+	for _, domain := range domains {
+		var checkError string
+		var expiresAt int64
+		var registrar string
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		info, err := this.rdapClient.Lookup(ctx, domain.Domain)
+		cancel()
+		if err != nil {
+			checkError = err.Error()
+		} else {
+			expiresAt = info.ExpiresAt.Unix()
+			registrar = info.Registrar
+		}
+
+		err = models.SharedRegisteredDomainDAO.UpdateDomainChecked(tx, int64(domain.Id), expiresAt, registrar, checkError)
+		if err != nil {
+			return err
+		}
+
+		time.Sleep(1 * time.Second)
+	}
+	return nil
+}
+
+// 发送到期提醒
+func (this *RegisteredDomainCheckTask) notifyDomains(tx *dbs.Tx) error {
+	for _, days := range []int{30, 14, 7, 0} {
+		domains, err := models.SharedRegisteredDomainDAO.FindAllExpiringDomains(tx, days)
+		if err != nil {
+			return err
+		}
+		for _, domain := range domains {
+			var expiresDay = timeutil.FormatTime("Y-m-d", int64(domain.ExpiresAt))
+			var subject string
+			var msg string
+			if days == 0 {
+				subject = "域名\"" + domain.Domain + "\"在今天（" + expiresDay + "）注册到期"
+				msg = "域名\"" + domain.Domain + "\"在今天（" + expiresDay + "）注册到期，到期后网站将无法访问，请尽快联系域名注册商续费。"
+			} else {
+				subject = "域名\"" + domain.Domain + "\"在" + strconv.Itoa(days) + "天后注册到期"
+				msg = "域名\"" + domain.Domain + "\"将在" + strconv.Itoa(days) + "天后（" + expiresDay + "）注册到期，到期后网站将无法访问，请及时续费。"
+			}
+			if len(domain.Registrar) > 0 {
+				msg += "注册商：" + domain.Registrar + "。"
+			}
+
+			err = models.SharedMessageDAO.CreateMessage(tx, 0, int64(domain.UserId), models.MessageTypeDomainExpiring, models.MessageLevelWarning, subject, msg, maps.Map{
+				"domainId":  domain.Id,
+				"domain":    domain.Domain,
+				"expiresAt": domain.ExpiresAt,
+			}.AsJSON())
+			if err != nil {
+				return err
+			}
+
+			err = models.SharedRegisteredDomainDAO.UpdateDomainNotifiedAt(tx, int64(domain.Id))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -1,7 +1,6 @@
 package tasks
 
 import (
-	"encoding/json"
 	"regexp"
 	"strings"
 	"time"
@@ -9,35 +8,49 @@ import (
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	"github.com/TeaOSLab/EdgeAPI/internal/errors"
 	"github.com/TeaOSLab/EdgeAPI/internal/goman"
-	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
 	"github.com/iwind/TeaGo/dbs"
 	timeutil "github.com/iwind/TeaGo/utils/time"
 )
 
 func init() {
 	dbs.OnReadyDone(func() {
+		var duration = 6 * time.Hour
+		var task = NewServerAccessLogCleaner(duration)
+		SharedTaskRegistry.Register("serverAccessLogClean", "清理访问日志", "按数据保留设置删除过期的访问日志", duration, task.Loop)
+
 		goman.New(func() {
-			NewServerAccessLogCleaner(6 * time.Hour).Start()
+			task.Start()
 		})
 	})
 }
 
+// 访问日志表名，包括日期和可选的分区序号
+var accessLogTableReg = regexp.MustCompile(`^(?i)(edgeHTTPAccessLogs|edgeNSAccessLogs)_(\d{8})(_\d{4})?$`)
+
 // ServerAccessLogCleaner 服务访问日志自动清理
 type ServerAccessLogCleaner struct {
 	BaseTask
 
-	ticker *time.Ticker
+	ticker *TaskTicker
 }
 
 func NewServerAccessLogCleaner(duration time.Duration) *ServerAccessLogCleaner {
 	return &ServerAccessLogCleaner{
-		ticker: time.NewTicker(duration),
+		ticker: SharedTaskScheduler.NewTicker("serverAccessLogClean", duration),
 	}
 }
 
 func (this *ServerAccessLogCleaner) Start() {
 	for range this.ticker.C {
-		err := this.Loop()
+		// 多个API节点时，每个周期只在其中一个节点上执行
+		if !this.TryLock(this.ticker.TaskCode(), this.ticker.Lease()) {
+			continue
+		}
+
+		err := this.RunLoop(this.ticker.TaskCode(), this.Loop)
+		if isShuttingDownErr(err) {
+			return
+		}
 		if err != nil {
 			this.logErr("[TASK][ServerAccessLogCleaner]", err.Error())
 		}
@@ -46,23 +59,23 @@ func (this *ServerAccessLogCleaner) Start() {
 
 func (this *ServerAccessLogCleaner) Loop() error {
 	// 当前设置
-	configJSON, err := models.SharedSysSettingDAO.ReadSetting(nil, systemconfigs.SettingCodeDatabaseConfigSetting)
+	config, err := models.SharedSysSettingDAO.ReadRetentionConfig(nil)
 	if err != nil {
 		return err
 	}
-	if len(configJSON) == 0 {
+	if !config.AccessLog.IsOn() {
 		return nil
 	}
-	var config = systemconfigs.NewDatabaseConfig()
-	err = json.Unmarshal(configJSON, config)
+
+	// 启用归档后由归档任务在归档之后删除
+	archiveConfig, err := models.SharedSysSettingDAO.ReadAccessLogArchiveConfig(nil)
 	if err != nil {
 		return err
 	}
-	if config.ServerAccessLog.Clean.Days <= 0 {
+	if archiveConfig.IsOn {
 		return nil
 	}
-	var days = config.ServerAccessLog.Clean.Days
-	var endDay = timeutil.Format("Ymd", time.Now().AddDate(0, 0, -days+1))
+	var endDay = timeutil.Format("Ymd", config.AccessLog.ExpiresTime(time.Now()).AddDate(0, 0, 1))
 
 	// 当前连接的数据库
 	db, err := dbs.Default()
@@ -115,16 +128,15 @@ func (this *ServerAccessLogCleaner) cleanDB(db *dbs.DB, endDay string) error {
 		return errors.New("invalid column names: " + strings.Join(columnNames, ", "))
 	}
 	var columnName = columnNames[0]
-	var reg = regexp.MustCompile(`^(?i)(edgeHTTPAccessLogs|edgeNSAccessLogs)_(\d{8})(_\d{4})?$`)
 	for _, one := range ones {
 		var tableName = one.GetString(columnName)
 		if len(tableName) == 0 {
 			continue
 		}
-		if !reg.MatchString(tableName) {
+		var matches = accessLogTableReg.FindStringSubmatch(tableName)
+		if len(matches) == 0 {
 			continue
 		}
-		var matches = reg.FindStringSubmatch(tableName)
 		var day = matches[2]
 
 		if day < endDay {
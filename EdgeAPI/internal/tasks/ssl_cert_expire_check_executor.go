@@ -2,7 +2,6 @@ package tasks
 
 import (
 	"encoding/json"
-	"strconv"
 	"strings"
 	"time"
 
@@ -17,8 +16,12 @@ import (
 
 func init() {
 	dbs.OnReadyDone(func() {
+		var duration = 1 * time.Hour
+		var task = NewSSLCertExpireCheckExecutor(duration)
+		SharedTaskRegistry.Register("sslCertRenewalScan", "扫描需要续期的证书", "查找即将过期并开启了自动续期的证书，并发起续期，只在主节点执行", duration, task.Loop)
+
 		goman.New(func() {
-			NewSSLCertExpireCheckExecutor(1 * time.Hour).Start()
+			task.Start()
 		})
 	})
 }
@@ -27,19 +30,27 @@ func init() {
 type SSLCertExpireCheckExecutor struct {
 	BaseTask
 
-	ticker *time.Ticker
+	ticker *TaskTicker
 }
 
 func NewSSLCertExpireCheckExecutor(duration time.Duration) *SSLCertExpireCheckExecutor {
 	return &SSLCertExpireCheckExecutor{
-		ticker: time.NewTicker(duration),
+		ticker: SharedTaskScheduler.NewTicker("sslCertRenewalScan", duration),
 	}
 }
 
 // Start 启动任务
 func (this *SSLCertExpireCheckExecutor) Start() {
 	for range this.ticker.C {
-		err := this.Loop()
+		// 切换主节点时可能有多个节点同时认为自己是主节点，这里再加锁防止重复续期
+		if !this.IsPrimaryNode() || !this.TryLock(this.ticker.TaskCode(), this.ticker.Lease()) {
+			continue
+		}
+
+		err := this.RunLoop(this.ticker.TaskCode(), this.Loop)
+		if isShuttingDownErr(err) {
+			return
+		}
 		if err != nil {
 			this.logErr("SSLCertExpireCheckExecutor", err.Error())
 		}
@@ -53,127 +64,59 @@ func (this *SSLCertExpireCheckExecutor) Loop() error {
 		return nil
 	}
 
-	// 查找需要自动更新的证书
-	// 30, 14 ... 是到期的天数
-	for _, days := range []int{30, 14, 7} {
+	// 过期前的提醒由 SSLCertExpireNoticeTask 发送，这里只处理自动续期
+
+	// 自动续期
+	for _, days := range []int{3, 2, 1} {
 		certs, err := models.SharedSSLCertDAO.FindAllExpiringCerts(nil, days)
 		if err != nil {
 			return err
 		}
 		for _, cert := range certs {
-			// 发送消息
-			var subject = "SSL证书\"" + cert.Name + "\"在" + strconv.Itoa(days) + "天后将到期，"
-			var msg = "SSL证书\"" + cert.Name + "\"（" + this.summaryDNSNames(cert.DnsNames) + "）在" + strconv.Itoa(days) + "天后将到期，"
-
-			// 是否有自动更新任务
-			if cert.AcmeTaskId > 0 {
-				task, err := acme.SharedACMETaskDAO.FindEnabledACMETask(nil, int64(cert.AcmeTaskId))
-				if err != nil {
-					return err
-				}
-				if task != nil {
-					if task.AutoRenew == 1 {
-						msg += "此证书是免费申请的证书，且已设置了自动续期，将会在到期前三天自动尝试续期。"
-					} else {
-						msg += "此证书是免费申请的证书，没有设置自动续期，请在到期前手动执行续期任务。"
-					}
-				}
-			} else {
-				msg += "请及时更新证书。"
+			if cert.AcmeTaskId == 0 {
+				continue
 			}
 
-			err = models.SharedMessageDAO.CreateMessage(nil, int64(cert.AdminId), int64(cert.UserId), models.MessageTypeSSLCertExpiring, models.MessageLevelWarning, subject, msg, maps.Map{
-				"certId":     cert.Id,
-				"acmeTaskId": cert.AcmeTaskId,
-			}.AsJSON())
+			task, err := acme.SharedACMETaskDAO.FindEnabledACMETask(nil, int64(cert.AcmeTaskId))
 			if err != nil {
 				return err
 			}
-
-			// 设置最后通知时间
-			err = models.SharedSSLCertDAO.UpdateCertNotifiedAt(nil, int64(cert.Id))
-			if err != nil {
-				return err
+			if task == nil || task.AutoRenew != 1 {
+				continue
 			}
-		}
-	}
-
-	// 自动续期
-	for _, days := range []int{3, 2, 1} {
-		certs, err := models.SharedSSLCertDAO.FindAllExpiringCerts(nil, days)
-		if err != nil {
-			return err
-		}
-		for _, cert := range certs {
-			// 发送消息
-			var subject = "SSL证书\"" + cert.Name + "\"在" + strconv.Itoa(days) + "天后将到期，"
-			var msg = "SSL证书\"" + cert.Name + "\"（" + this.summaryDNSNames(cert.DnsNames) + "）在" + strconv.Itoa(days) + "天后将到期，"
 
-			// 是否有自动更新任务
-			if cert.AcmeTaskId > 0 {
-				task, err := acme.SharedACMETaskDAO.FindEnabledACMETask(nil, int64(cert.AcmeTaskId))
+			if task.Async {
+				// 异步任务加入签发队列，按证书过期时间优先续期
+				err = acme.SharedACMETaskDAO.QueueACMETaskRenewal(nil, int64(task.Id), int64(cert.TimeEndAt))
 				if err != nil {
 					return err
 				}
-				if task != nil {
-					if task.AutoRenew == 1 {
-						isOk, errMsg, _ := acme.SharedACMETaskDAO.RunTask(nil, int64(cert.AcmeTaskId))
-						if isOk {
-							// 发送成功通知
-							subject = "系统已成功为你自动更新了证书\"" + cert.Name + "\""
-							msg = "系统已成功为你自动更新了证书\"" + cert.Name + "\"（" + this.summaryDNSNames(cert.DnsNames) + "）。"
-							err = models.SharedMessageDAO.CreateMessage(nil, int64(cert.AdminId), int64(cert.UserId), models.MessageTypeSSLCertACMETaskSuccess, models.MessageLevelSuccess, subject, msg, maps.Map{
-								"certId":     cert.Id,
-								"acmeTaskId": cert.AcmeTaskId,
-							}.AsJSON())
-							if err != nil {
-								return err
-							}
-
-							// 更新通知时间
-							err = models.SharedSSLCertDAO.UpdateCertNotifiedAt(nil, int64(cert.Id))
-							if err != nil {
-								return err
-							}
-						} else {
-							// 发送失败通知
-							subject = "系统在尝试自动更新证书\"" + cert.Name + "\"时发生错误"
-							msg = "系统在尝试自动更新证书\"" + cert.Name + "\"（" + this.summaryDNSNames(cert.DnsNames) + "）时发生错误：" + errMsg + "。请检查系统设置并修复错误。"
-							err = models.SharedMessageDAO.CreateMessage(nil, int64(cert.AdminId), int64(cert.UserId), models.MessageTypeSSLCertACMETaskFailed, models.MessageLevelError, subject, msg, maps.Map{
-								"certId":     cert.Id,
-								"acmeTaskId": cert.AcmeTaskId,
-							}.AsJSON())
-							if err != nil {
-								return err
-							}
-
-							// 更新通知时间
-							err = models.SharedSSLCertDAO.UpdateCertNotifiedAt(nil, int64(cert.Id))
-							if err != nil {
-								return err
-							}
-						}
-
-						// 中止不发送消息
-						continue
-
-					} else {
-						msg += "此证书是免费申请的证书，没有设置自动续期，请在到期前手动执行续期任务。"
-					}
-				}
 			} else {
-				msg += "请及时更新证书。"
-			}
-
-			err = models.SharedMessageDAO.CreateMessage(nil, int64(cert.AdminId), int64(cert.UserId), models.MessageTypeSSLCertExpiring, models.MessageLevelWarning, subject, msg, maps.Map{
-				"certId":     cert.Id,
-				"acmeTaskId": cert.AcmeTaskId,
-			}.AsJSON())
-			if err != nil {
-				return err
+				isOk, errMsg, _ := acme.SharedACMETaskDAO.RunTask(int64(cert.AcmeTaskId))
+				var subject, msg string
+				if isOk {
+					// 发送成功通知
+					subject = "系统已成功为你自动更新了证书\"" + cert.Name + "\""
+					msg = "系统已成功为你自动更新了证书\"" + cert.Name + "\"（" + summarySSLCertDNSNames(cert.DnsNames) + "）。"
+					err = models.SharedMessageDAO.CreateMessage(nil, int64(cert.AdminId), int64(cert.UserId), models.MessageTypeSSLCertACMETaskSuccess, models.MessageLevelSuccess, subject, msg, maps.Map{
+						"certId":     cert.Id,
+						"acmeTaskId": cert.AcmeTaskId,
+					}.AsJSON())
+				} else {
+					// 发送失败通知
+					subject = "系统在尝试自动更新证书\"" + cert.Name + "\"时发生错误"
+					msg = "系统在尝试自动更新证书\"" + cert.Name + "\"（" + summarySSLCertDNSNames(cert.DnsNames) + "）时发生错误：" + errMsg + "。请检查系统设置并修复错误。"
+					err = models.SharedMessageDAO.CreateMessage(nil, int64(cert.AdminId), int64(cert.UserId), models.MessageTypeSSLCertACMETaskFailed, models.MessageLevelError, subject, msg, maps.Map{
+						"certId":     cert.Id,
+						"acmeTaskId": cert.AcmeTaskId,
+					}.AsJSON())
+				}
+				if err != nil {
+					return err
+				}
 			}
 
-			// 设置最后通知时间
+			// 更新通知时间，当天不再重复续期
 			err = models.SharedSSLCertDAO.UpdateCertNotifiedAt(nil, int64(cert.Id))
 			if err != nil {
 				return err
@@ -191,7 +134,7 @@ func (this *SSLCertExpireCheckExecutor) Loop() error {
 			// 发送消息
 			var today = timeutil.Format("Y-m-d")
 			var subject = "SSL证书\"" + cert.Name + "\"在今天（" + today + "）过期"
-			var msg = "SSL证书\"" + cert.Name + "\"（" + this.summaryDNSNames(cert.DnsNames) + "）在今天（" + today + "）过期，请及时更新证书，之后将不再重复提醒。"
+			var msg = "SSL证书\"" + cert.Name + "\"（" + summarySSLCertDNSNames(cert.DnsNames) + "）在今天（" + today + "）过期，请及时更新证书，之后将不再重复提醒。"
 			err = models.SharedMessageDAO.CreateMessage(nil, int64(cert.AdminId), int64(cert.UserId), models.MessageTypeSSLCertExpiring, models.MessageLevelWarning, subject, msg, maps.Map{
 				"certId":     cert.Id,
 				"acmeTaskId": cert.AcmeTaskId,
@@ -212,7 +155,7 @@ func (this *SSLCertExpireCheckExecutor) Loop() error {
 }
 
 // 对证书中DNS域名的描述
-func (this *SSLCertExpireCheckExecutor) summaryDNSNames(dnsNamesJSON []byte) string {
+func summarySSLCertDNSNames(dnsNamesJSON []byte) string {
 	if len(dnsNamesJSON) == 0 {
 		return ""
 	}
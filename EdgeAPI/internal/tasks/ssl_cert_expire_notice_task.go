@@ -0,0 +1,144 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models/acme"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		var duration = 1 * time.Hour
+		var task = NewSSLCertExpireNoticeTask(duration)
+		SharedTaskRegistry.Register("sslCertExpireNotice", "证书过期提醒", "提醒即将过期的证书，只在主节点执行", duration, task.Loop)
+
+		goman.New(func() {
+			task.Start()
+		})
+	})
+}
+
+// SSLCertExpireNoticeTask 在SSL证书过期前提醒管理员或用户
+// 包括上传的证书和免费申请的证书，每个证书在每个提醒时间点只提醒一次
+type SSLCertExpireNoticeTask struct {
+	BaseTask
+
+	ticker *TaskTicker
+}
+
+func NewSSLCertExpireNoticeTask(duration time.Duration) *SSLCertExpireNoticeTask {
+	return &SSLCertExpireNoticeTask{
+		ticker: SharedTaskScheduler.NewTicker("sslCertExpireNotice", duration),
+	}
+}
+
+func (this *SSLCertExpireNoticeTask) Start() {
+	for range this.ticker.C {
+		// 只在主节点执行和记录
+		if !this.IsPrimaryNode() {
+			continue
+		}
+
+		err := this.RunLoop(this.ticker.TaskCode(), this.Loop)
+		if isShuttingDownErr(err) {
+			return
+		}
+		if err != nil {
+			this.logErr("SSLCertExpireNoticeTask", err.Error())
+		}
+	}
+}
+
+func (this *SSLCertExpireNoticeTask) Loop() error {
+	if !this.IsPrimaryNode() {
+		return nil
+	}
+
+	config, err := models.SharedSysSettingDAO.ReadSSLCertExpireNoticeConfig(nil)
+	if err != nil {
+		return err
+	}
+	if !config.IsOn || len(config.Days) == 0 {
+		return nil
+	}
+
+	var now = time.Now().Unix()
+	certs, err := models.SharedSSLCertDAO.FindAllCertsExpiringBefore(nil, now+int64(config.MaxDays())*86400)
+	if err != nil {
+		return err
+	}
+	for _, cert := range certs {
+		var certId = int64(cert.Id)
+		var timeEndAt = int64(cert.TimeEndAt)
+		days, ok := config.MatchDays(timeEndAt - now)
+		if !ok {
+			continue
+		}
+
+		// 同一个过期时间在同一个时间点只提醒一次
+		exists, err := models.SharedSSLCertExpireNoticeDAO.ExistNotice(nil, certId, days, timeEndAt)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		err = this.notify(cert, this.remainingDays(timeEndAt-now))
+		if err != nil {
+			return err
+		}
+
+		err = models.SharedSSLCertExpireNoticeDAO.CreateNotice(nil, certId, days, timeEndAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// 发送提醒消息
+func (this *SSLCertExpireNoticeTask) notify(cert *models.SSLCert, remainingDays int) error {
+	var dnsNamesSummary = summarySSLCertDNSNames(cert.DnsNames)
+	var subject = "SSL证书\"" + cert.Name + "\"在" + types.String(remainingDays) + "天后将到期，"
+	var msg = "SSL证书\"" + cert.Name + "\"（" + dnsNamesSummary + "）在" + types.String(remainingDays) + "天后将到期，"
+
+	// 是否有自动更新任务
+	if cert.AcmeTaskId > 0 {
+		task, err := acme.SharedACMETaskDAO.FindEnabledACMETask(nil, int64(cert.AcmeTaskId))
+		if err != nil {
+			return err
+		}
+		if task != nil && task.AutoRenew == 1 {
+			msg += "此证书是免费申请的证书，且已设置了自动续期，将会在到期前三天自动尝试续期。"
+		} else if task != nil {
+			msg += "此证书是免费申请的证书，没有设置自动续期，请在到期前手动执行续期任务。"
+		} else {
+			msg += "请及时更新证书。"
+		}
+	} else {
+		msg += "请及时更新证书。"
+	}
+
+	return models.SharedMessageDAO.CreateMessage(nil, int64(cert.AdminId), int64(cert.UserId), models.MessageTypeSSLCertExpiring, models.MessageLevelWarning, subject, msg, maps.Map{
+		"certId":     cert.Id,
+		"acmeTaskId": cert.AcmeTaskId,
+	}.AsJSON())
+}
+
+// 剩余天数，不足一天按一天计算
+func (this *SSLCertExpireNoticeTask) remainingDays(remainingSeconds int64) int {
+	var days = int((remainingSeconds + 86399) / 86400)
+	if days < 1 {
+		days = 1
+	}
+	return days
+}
@@ -1,11 +1,14 @@
 package tasks
 
 import (
+	acmeutils "github.com/TeaOSLab/EdgeAPI/internal/acme"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models/acme"
 	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
 	"github.com/iwind/TeaGo/dbs"
-	"github.com/iwind/TeaGo/logs"
-	"sync"
+	"github.com/iwind/TeaGo/types"
+	"sync/atomic"
 	"time"
 )
 
@@ -18,104 +21,145 @@ func init() {
 }
 
 // SSLCertIssueExecutor 证书签发任务
+// 从数据库中读取待签发的任务放入签发队列，由队列控制总并发数以及每个ACME服务商、账号和DNS服务商的并发数
 type SSLCertIssueExecutor struct {
 	BaseTask
-	ticker *time.Ticker
+
+	ticker     *time.Ticker
+	pool       *acmeutils.IssueWorkerPool
+	maxRetries int32 // 签发失败后自动重试的最多次数
 }
 
 func NewSSLCertIssueExecutor(duration time.Duration) *SSLCertIssueExecutor {
-	return &SSLCertIssueExecutor{
+	var executor = &SSLCertIssueExecutor{
 		ticker: time.NewTicker(duration),
 	}
+	var defaultConfig = systemconfigs.NewACMEIssueConfig()
+	executor.pool = acmeutils.NewIssueWorkerPool(executor.convertLimits(defaultConfig), executor.runJob)
+	executor.maxRetries = int32(defaultConfig.MaxRetries)
+	return executor
 }
 
 // Start 启动任务
 func (this *SSLCertIssueExecutor) Start() {
+	SharedShutdownCoordinator.OnShutdown(this.ticker.Stop)
+
 	for range this.ticker.C {
+		if !SharedShutdownCoordinator.Begin("sslCertIssue") {
+			return
+		}
 		err := this.Loop()
+		SharedShutdownCoordinator.End("sslCertIssue")
 		if err != nil {
-			this.logErr("SSLCertExpireCheckExecutor", err.Error())
+			this.logErr("SSLCertIssueExecutor", err.Error())
 		}
 	}
 }
 
-var (
-	concurrent     = 10
-	mutex          sync.Mutex
-	queue          = make(chan *acme.ACMETask, 10)
-	runningTaskIds = make(map[int64]bool)
-)
+// Loop 单次执行
+func (this *SSLCertIssueExecutor) Loop() error {
+	// 检查是否为主节点
+	if !this.IsPrimaryNode() {
+		return nil
+	}
 
-func taskConsumer(wg *sync.WaitGroup) {
-	defer wg.Done()
-	for task := range queue {
-		mutex.Lock()
-		runningTaskIds[int64(task.Id)] = true
-		mutex.Unlock()
-
-		go func(t *acme.ACMETask) {
-			defer func() {
-				mutex.Lock()
-				delete(runningTaskIds, int64(t.Id))
-				mutex.Unlock()
-			}()
-
-			ok, errMsg := acme.SharedACMETaskDAO.RunTaskAndAutoBindServer(nil, int64(t.Id), t.DecodeDomains())
-			if !ok {
-				logs.Printf(errMsg)
-			}
-		}(task)
+	// 读取并发设置
+	config, err := models.SharedSysSettingDAO.ReadACMEIssueConfig(nil)
+	if err != nil {
+		return err
 	}
-}
+	var limits = this.convertLimits(config)
+	this.pool.UpdateLimits(limits)
+	atomic.StoreInt32(&this.maxRetries, int32(config.MaxRetries))
 
-func taskProducer(wg *sync.WaitGroup) {
-	defer wg.Done()
+	// 排队中的任务足够多时，不再读取新的任务
+	var maxPending = limits.Concurrency
+	var countPending = this.pool.CountPending()
+	if countPending >= maxPending {
+		return nil
+	}
 
-	for {
-		time.Sleep(2 * time.Second)
-		if len(runningTaskIds) < concurrent {
-			excludeTasks := make([]int64, 0, len(runningTaskIds))
-			for k := range runningTaskIds {
-				excludeTasks = append(excludeTasks, k)
-			}
-			tasks, err := acme.SharedACMETaskDAO.FindIssueACMETask(nil, 2, int64(concurrent-len(runningTaskIds)), excludeTasks)
+	// 合并域名重叠的任务
+	_, err = acme.SharedACMETaskDAO.MergePendingTasks(nil, 2, this.pool.TaskIds())
+	if err != nil {
+		return err
+	}
+
+	tasks, err := acme.SharedACMETaskDAO.FindIssueACMETask(nil, 2, int64(maxPending-countPending), this.pool.TaskIds())
+	if err != nil {
+		return err
+	}
+
+	var providerCodeMap = map[int64]string{} // acmeUserId => providerCode
+	for _, task := range tasks {
+		var accountId = int64(task.AcmeUserId)
+		providerCode, ok := providerCodeMap[accountId]
+		if !ok {
+			providerCode, err = this.findProviderCode(accountId)
 			if err != nil {
-				logs.Printf("Failed to find tasks from database, %v", err)
-				continue
-			}
-			if len(tasks) == 0 {
-				continue
+				return err
 			}
+			providerCodeMap[accountId] = providerCode
+		}
 
-			for _, task := range tasks {
-				queue <- task
+		var dnsProviderId int64
+		if task.AuthType == acmeutils.AuthTypeDNS {
+			if task.DnsAliasProviderId > 0 && len(task.DnsAliasDomain) > 0 {
+				dnsProviderId = int64(task.DnsAliasProviderId)
+			} else {
+				dnsProviderId = int64(task.DnsProviderId)
 			}
 		}
+
+		this.pool.Push(&acmeutils.IssueJob{
+			TaskId:        int64(task.Id),
+			Domains:       task.DecodeDomains(),
+			ProviderCode:  providerCode,
+			AccountId:     accountId,
+			DNSProviderId: dnsProviderId,
+			Priority:      int(task.Priority),
+		})
 	}
+
+	return nil
 }
 
-// Loop 单次执行
-func (this *SSLCertIssueExecutor) Loop() error {
-	// 检查是否为主节点
-	if !this.IsPrimaryNode() {
-		return nil
+// 执行签发任务
+func (this *SSLCertIssueExecutor) runJob(job *acmeutils.IssueJob) {
+	// API节点正在停止时不再开始新的签发，任务会在下次启动后重新加入队列
+	var name = "sslCertIssue:" + types.String(job.TaskId)
+	if !SharedShutdownCoordinator.Begin(name) {
+		return
 	}
-	var queue = make(chan *acme.ACMETask, concurrent)
-	tasks, err := acme.SharedACMETaskDAO.FindIssueACMETask(nil, 2, int64(concurrent), nil)
-	if err != nil {
-		return err
+	defer SharedShutdownCoordinator.End(name)
+
+	ok, errMsg := acme.SharedACMETaskDAO.RunTaskAndAutoBindServer(job.TaskId, job.Domains, int(atomic.LoadInt32(&this.maxRetries)))
+	if !ok {
+		this.logErr("SSLCertIssueExecutor", "issue task '"+types.String(job.TaskId)+"' failed: "+errMsg)
 	}
-	if len(tasks) == 0 {
-		return nil
+}
+
+// 查找ACME账号对应的服务商代号
+func (this *SSLCertIssueExecutor) findProviderCode(acmeUserId int64) (string, error) {
+	user, err := acme.SharedACMEUserDAO.FindEnabledACMEUser(nil, acmeUserId)
+	if err != nil {
+		return "", err
 	}
-	for _, task := range tasks {
-		queue <- task
+	if user == nil || len(user.ProviderCode) == 0 {
+		return acmeutils.DefaultProviderCode, nil
 	}
+	return user.ProviderCode, nil
+}
 
-	var wg sync.WaitGroup
-	wg.Add(2)
-	go taskConsumer(&wg)
-	go taskProducer(&wg)
-	wg.Wait()
-	return nil
+func (this *SSLCertIssueExecutor) convertLimits(config *systemconfigs.ACMEIssueConfig) acmeutils.IssueLimits {
+	var limits = acmeutils.IssueLimits{
+		Concurrency:       config.Concurrency,
+		MaxPerProvider:    config.MaxPerProvider,
+		MaxPerAccount:     config.MaxPerAccount,
+		MaxPerDNSProvider: config.MaxPerDNSProvider,
+	}
+	if limits.Concurrency <= 0 {
+		limits.Concurrency = systemconfigs.NewACMEIssueConfig().Concurrency
+	}
+	return limits
 }
@@ -0,0 +1,163 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/certchain"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+	"golang.org/x/crypto/ocsp"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		goman.New(func() {
+			NewSSLCertOCSPStatusTask(10 * time.Minute).Start()
+		})
+	})
+}
+
+// 每个证书检查OCSP状态的间隔
+const sslCertOCSPStatusCheckInterval = 6 * time.Hour
+
+// SSLCertOCSPStatusTask 定期通过OCSP检查证书状态，证书被吊销或状态未知时提醒管理员或用户
+// 和SSLCertUpdateOCSPTask不同，这里检查所有有效的证书，而不只是开启了OCSP Stapling的证书
+type SSLCertOCSPStatusTask struct {
+	BaseTask
+
+	ticker *time.Ticker
+}
+
+func NewSSLCertOCSPStatusTask(duration time.Duration) *SSLCertOCSPStatusTask {
+	return &SSLCertOCSPStatusTask{
+		ticker: time.NewTicker(duration),
+	}
+}
+
+func (this *SSLCertOCSPStatusTask) Start() {
+	for range this.ticker.C {
+		err := this.Loop()
+		if err != nil {
+			this.logErr("SSLCertOCSPStatusTask", err.Error())
+		}
+	}
+}
+
+func (this *SSLCertOCSPStatusTask) Loop() error {
+	if !this.IsPrimaryNode() {
+		return nil
+	}
+
+	var tx *dbs.Tx
+	certs, err := models.SharedSSLCertDAO.ListCertsToCheckOCSPStatus(tx, time.Now().Add(-sslCertOCSPStatusCheckInterval).Unix(), 50)
+	if err != nil {
+		return err
+	}
+
+	for _, cert := range certs {
+		status, result, err := this.CheckCert(cert.CertData)
+		if err != nil {
+			// 检查失败时保持原有状态，等待下次检查
+			remotelogs.Warn("SSLCertOCSPStatusTask", "check cert '"+cert.Name+"' failed: "+err.Error())
+			status = cert.OcspStatus
+		} else if status != cert.OcspStatus && (status == sslconfigs.SSLCertOCSPStatusRevoked || status == sslconfigs.SSLCertOCSPStatusUnknown) {
+			err = this.notify(cert, status, result)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = models.SharedSSLCertDAO.UpdateCertOCSPStatus(tx, int64(cert.Id), status)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckCert 检查单个证书的OCSP状态
+func (this *SSLCertOCSPStatusTask) CheckCert(certData []byte) (status sslconfigs.SSLCertOCSPStatus, result *certchain.OCSPResult, err error) {
+	var certs = []*x509.Certificate{}
+	for {
+		var block *pem.Block
+		block, certData = pem.Decode(certData)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return "", nil, errors.New("parse certificate failed: " + err.Error())
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return "", nil, errors.New("no certificates found")
+	}
+
+	var leaf = certs[0]
+	if len(leaf.OCSPServer) == 0 {
+		return sslconfigs.SSLCertOCSPStatusNone, nil, nil
+	}
+
+	issuer, err := certchain.FindIssuer(leaf, certs[1:])
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, err = certchain.QueryOCSP(leaf, issuer)
+	if err != nil {
+		return "", nil, err
+	}
+	if result == nil {
+		return sslconfigs.SSLCertOCSPStatusNone, nil, nil
+	}
+
+	switch result.Status {
+	case ocsp.Good:
+		status = sslconfigs.SSLCertOCSPStatusGood
+	case ocsp.Revoked:
+		status = sslconfigs.SSLCertOCSPStatusRevoked
+	default:
+		status = sslconfigs.SSLCertOCSPStatusUnknown
+	}
+	return status, result, nil
+}
+
+// 发送提醒消息
+func (this *SSLCertOCSPStatusTask) notify(cert *models.SSLCert, status sslconfigs.SSLCertOCSPStatus, result *certchain.OCSPResult) error {
+	var dnsNamesSummary = summarySSLCertDNSNames(cert.DnsNames)
+	var params = maps.Map{
+		"certId": cert.Id,
+		"status": status,
+	}
+
+	if status == sslconfigs.SSLCertOCSPStatusRevoked {
+		var subject = "SSL证书\"" + cert.Name + "\"已被证书颁发机构吊销"
+		var msg = "SSL证书\"" + cert.Name + "\"（" + dnsNamesSummary + "）已被证书颁发机构吊销"
+		if result != nil && result.RevokedAt > 0 {
+			msg += "，吊销时间：" + timeutil.FormatTime("Y-m-d H:i:s", result.RevokedAt)
+			params["revokedAt"] = result.RevokedAt
+			params["revocationReason"] = result.RevocationReason
+		}
+		msg += "。使用此证书的网站在浏览器中可能无法访问，请尽快更换证书。"
+		return models.SharedMessageDAO.CreateMessage(nil, int64(cert.AdminId), int64(cert.UserId), models.MessageTypeSSLCertRevoked, models.MessageLevelError, subject, msg, params.AsJSON())
+	}
+
+	var subject = "SSL证书\"" + cert.Name + "\"的OCSP状态未知"
+	var msg = "证书颁发机构的OCSP服务无法识别SSL证书\"" + cert.Name + "\"（" + dnsNamesSummary + "），请检查证书是否由正规的证书颁发机构签发。"
+	return models.SharedMessageDAO.CreateMessage(nil, int64(cert.AdminId), int64(cert.UserId), models.MessageTypeSSLCertOCSPUnknown, models.MessageLevelWarning, subject, msg, params.AsJSON())
+}
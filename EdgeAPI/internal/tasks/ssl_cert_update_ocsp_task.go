@@ -105,7 +105,12 @@ func (this *SSLCertUpdateOCSPTask) UpdateCertOCSP(certOne *models.SSLCert) (ocsp
 		return
 	}
 
-	keyPair, err := tls.X509KeyPair(certOne.CertData, certOne.KeyData)
+	keyData, err := certOne.DecodeKeyData()
+	if err != nil {
+		return nil, 0, errors.New("decrypt key failed: " + err.Error())
+	}
+
+	keyPair, err := tls.X509KeyPair(certOne.CertData, keyData)
 	if err != nil {
 		return nil, 0, errors.New("parse certificate failed: " + err.Error())
 	}
@@ -0,0 +1,143 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"strings"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeAPI/internal/utils"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		goman.New(func() {
+			NewSSLPolicySupersededCertCleanTask(1 * time.Hour).Start()
+		})
+	})
+}
+
+// SSLPolicySupersededCertCleanTask 定期从所有SSL策略中移除已过期并且已被新证书替代的证书
+// 和ACME任务自动绑定证书时的处理方式一致，新证书需要包含旧证书的所有域名
+type SSLPolicySupersededCertCleanTask struct {
+	BaseTask
+
+	ticker *time.Ticker
+}
+
+func NewSSLPolicySupersededCertCleanTask(duration time.Duration) *SSLPolicySupersededCertCleanTask {
+	return &SSLPolicySupersededCertCleanTask{
+		ticker: time.NewTicker(duration),
+	}
+}
+
+func (this *SSLPolicySupersededCertCleanTask) Start() {
+	for range this.ticker.C {
+		err := this.Loop()
+		if err != nil {
+			this.logErr("SSLPolicySupersededCertCleanTask", err.Error())
+		}
+	}
+}
+
+func (this *SSLPolicySupersededCertCleanTask) Loop() error {
+	if !this.IsPrimaryNode() {
+		return nil
+	}
+
+	changes, err := this.Clean()
+	if err != nil {
+		return err
+	}
+	return this.report(changes)
+}
+
+// Clean 扫描所有策略并移除被替代的证书，返回所有改动
+func (this *SSLPolicySupersededCertCleanTask) Clean() (changes []*models.SSLPolicySupersededCert, err error) {
+	var tx *dbs.Tx
+	var cacheMap = utils.NewCacheMap()
+	var lastPolicyId int64
+	const size = 100
+	for {
+		policyIds, err := models.SharedSSLPolicyDAO.ListEnabledPolicyIdsAfterId(tx, lastPolicyId, size)
+		if err != nil {
+			return changes, err
+		}
+		if len(policyIds) == 0 {
+			break
+		}
+
+		for _, policyId := range policyIds {
+			policyChanges, err := models.SharedSSLPolicyDAO.RemoveSupersededCerts(tx, policyId, cacheMap)
+			if err != nil {
+				// 单个策略出错时不影响其他策略
+				remotelogs.Warn("SSLPolicySupersededCertCleanTask", "clean policy '"+types.String(policyId)+"' failed: "+err.Error())
+				continue
+			}
+			changes = append(changes, policyChanges...)
+		}
+
+		lastPolicyId = policyIds[len(policyIds)-1]
+		if len(policyIds) < size {
+			break
+		}
+	}
+	return
+}
+
+// 记录日志，并按用户发送汇总消息
+func (this *SSLPolicySupersededCertCleanTask) report(changes []*models.SSLPolicySupersededCert) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	var userIds = []int64{}
+	var userChanges = map[int64][]*models.SSLPolicySupersededCert{} // userId => changes
+	for _, change := range changes {
+		remotelogs.Println("SSLPolicySupersededCertCleanTask", "remove cert '"+types.String(change.CertId)+"' from ssl policy '"+types.String(change.PolicyId)+"', superseded by cert '"+types.String(change.NewCertId)+"'")
+
+		_, ok := userChanges[change.UserId]
+		if !ok {
+			userIds = append(userIds, change.UserId)
+		}
+		userChanges[change.UserId] = append(userChanges[change.UserId], change)
+	}
+
+	for _, userId := range userIds {
+		var certNames = []string{}
+		var certIdMap = map[int64]bool{}
+		var items = []maps.Map{}
+		for _, change := range userChanges[userId] {
+			if !certIdMap[change.CertId] {
+				certIdMap[change.CertId] = true
+				certNames = append(certNames, "\""+change.CertName+"\"")
+			}
+			items = append(items, maps.Map{
+				"policyId":  change.PolicyId,
+				"certId":    change.CertId,
+				"newCertId": change.NewCertId,
+			})
+		}
+
+		var certNamesSummary = strings.Join(certNames, "、")
+		if len(certNames) > 10 {
+			certNamesSummary = strings.Join(certNames[:10], "、") + "等" + types.String(len(certNames)) + "个证书"
+		}
+
+		var subject = "已从HTTPS配置中移除" + types.String(len(certNames)) + "个已过期的SSL证书"
+		var msg = "以下已过期的SSL证书已被包含相同域名的新证书替代，系统已自动将它们从相关的HTTPS配置中移除：" + certNamesSummary + "。"
+		err := models.SharedMessageDAO.CreateMessage(nil, 0, userId, models.MessageTypeSSLCertSuperseded, models.MessageLevelInfo, subject, msg, maps.Map{
+			"changes": items,
+		}.AsJSON())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
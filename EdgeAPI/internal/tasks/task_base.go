@@ -3,6 +3,8 @@
 package tasks
 
 import (
+	"time"
+
 	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
 	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
 )
@@ -17,3 +19,29 @@ func (this *BaseTask) logErr(taskType string, errString string) {
 func (this *BaseTask) IsPrimaryNode() bool {
 	return models.SharedAPINodeDAO.CheckAPINodeIsPrimaryWithoutErr()
 }
+
+// IsPartitionOwner 检查当前API节点是否负责某个分区任务
+// 适用于可以按集群等维度拆分、在多个API节点上并行执行的任务
+func (this *BaseTask) IsPartitionOwner(key string) bool {
+	return SharedTaskPartitioner.IsOwner(key)
+}
+
+// TryLock 尝试在所有API节点中获得执行任务的锁，在 lease 时间内只有一个API节点能获得
+// 适用于没有限定主节点、但是在每个周期内只需要执行一次的任务
+func (this *BaseTask) TryLock(taskCode string, lease time.Duration) bool {
+	var seconds = int64(lease.Seconds())
+	if seconds <= 0 {
+		seconds = 1
+	}
+	ok, err := models.SharedSysLockerDAO.TryLease(nil, "task:"+taskCode, seconds)
+	if err != nil {
+		remotelogs.Error("TASK", "lock task '"+taskCode+"' failed: "+err.Error())
+		return false
+	}
+	return ok
+}
+
+// RunLoop 定时执行一次任务，并记录执行结果
+func (this *BaseTask) RunLoop(taskCode string, loop func() error) error {
+	return runAndRecordTask(taskCode, false, loop)
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/cespare/xxhash"
+	"github.com/iwind/TeaGo/types"
+)
+
+var SharedTaskPartitioner = NewTaskPartitioner(30 * time.Second)
+
+// TaskPartitioner 在多个在线API节点之间分配后台任务
+// 使用最高随机权重（Rendezvous）哈希，API节点增减时只有少部分任务需要迁移
+type TaskPartitioner struct {
+	refreshInterval time.Duration
+
+	nodeIds   []int64 // 在线的API节点ID，从小到大排序
+	updatedAt time.Time
+
+	locker sync.Mutex
+}
+
+func NewTaskPartitioner(refreshInterval time.Duration) *TaskPartitioner {
+	return &TaskPartitioner{
+		refreshInterval: refreshInterval,
+	}
+}
+
+// IsOwner 检查当前API节点是否负责某个任务
+func (this *TaskPartitioner) IsOwner(key string) bool {
+	config, err := configs.SharedAPIConfig()
+	if err != nil {
+		return false
+	}
+	var currentNodeId = config.NumberId()
+
+	nodeIds, err := this.findNodeIds(currentNodeId)
+	if err != nil {
+		// 无法读取节点列表时退化为由主节点执行
+		remotelogs.Error("TASK_PARTITIONER", "find active api nodes failed: "+err.Error())
+		return models.SharedAPINodeDAO.CheckAPINodeIsPrimaryWithoutErr()
+	}
+
+	return PickPartitionOwner(key, nodeIds) == currentNodeId
+}
+
+// 读取在线API节点列表，并定期刷新
+func (this *TaskPartitioner) findNodeIds(currentNodeId int64) ([]int64, error) {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	if len(this.nodeIds) > 0 && time.Since(this.updatedAt) < this.refreshInterval {
+		return this.nodeIds, nil
+	}
+
+	nodeIds, err := models.SharedAPINodeDAO.FindAllActiveAPINodeIds(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// 当前节点尚未上报状态时，也参与分配
+	var foundCurrent = false
+	for _, nodeId := range nodeIds {
+		if nodeId == currentNodeId {
+			foundCurrent = true
+			break
+		}
+	}
+	if !foundCurrent && currentNodeId > 0 {
+		nodeIds = append(nodeIds, currentNodeId)
+		sort.Slice(nodeIds, func(i, j int) bool {
+			return nodeIds[i] < nodeIds[j]
+		})
+	}
+
+	if !this.equalNodeIds(this.nodeIds, nodeIds) {
+		if len(this.nodeIds) > 0 {
+			remotelogs.Println("TASK_PARTITIONER", "api nodes changed: "+this.formatNodeIds(this.nodeIds)+" => "+this.formatNodeIds(nodeIds)+", rebalancing tasks")
+		}
+		this.nodeIds = nodeIds
+	}
+	this.updatedAt = time.Now()
+
+	return this.nodeIds, nil
+}
+
+func (this *TaskPartitioner) equalNodeIds(nodeIds1 []int64, nodeIds2 []int64) bool {
+	if len(nodeIds1) != len(nodeIds2) {
+		return false
+	}
+	for index, nodeId := range nodeIds1 {
+		if nodeIds2[index] != nodeId {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *TaskPartitioner) formatNodeIds(nodeIds []int64) string {
+	var s = "["
+	for index, nodeId := range nodeIds {
+		if index > 0 {
+			s += ","
+		}
+		s += types.String(nodeId)
+	}
+	return s + "]"
+}
+
+// PickPartitionOwner 从节点列表中选择负责某个任务的节点
+func PickPartitionOwner(key string, nodeIds []int64) int64 {
+	var ownerId int64
+	var maxWeight uint64
+	for _, nodeId := range nodeIds {
+		var weight = xxhash.Sum64String(key + "@" + types.String(nodeId))
+		if ownerId == 0 || weight > maxWeight || (weight == maxWeight && nodeId < ownerId) {
+			ownerId = nodeId
+			maxWeight = weight
+		}
+	}
+	return ownerId
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/tasks"
+	"github.com/iwind/TeaGo/assert"
+	"github.com/iwind/TeaGo/types"
+)
+
+func TestPickPartitionOwner(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsTrue(tasks.PickPartitionOwner("a", nil) == 0)
+	a.IsTrue(tasks.PickPartitionOwner("a", []int64{3}) == 3)
+
+	var nodeIds = []int64{1, 2, 3}
+	var countMap = map[int64]int{}
+	for i := 0; i < 3000; i++ {
+		var key = "healthCheck@" + types.String(i)
+		var ownerId = tasks.PickPartitionOwner(key, nodeIds)
+		a.IsTrue(ownerId == tasks.PickPartitionOwner(key, []int64{3, 2, 1}))
+		countMap[ownerId]++
+	}
+	t.Log(countMap)
+	for _, nodeId := range nodeIds {
+		a.IsTrue(countMap[nodeId] > 800)
+	}
+}
+
+func TestPickPartitionOwner_Rebalance(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var moved = 0
+	for i := 0; i < 3000; i++ {
+		var key = "healthCheck@" + types.String(i)
+		var oldOwnerId = tasks.PickPartitionOwner(key, []int64{1, 2, 3})
+		var newOwnerId = tasks.PickPartitionOwner(key, []int64{1, 3})
+
+		// 只有原来属于节点2的任务才需要迁移
+		if oldOwnerId != 2 {
+			a.IsTrue(oldOwnerId == newOwnerId)
+		} else {
+			moved++
+		}
+	}
+	t.Log("moved:", moved)
+}
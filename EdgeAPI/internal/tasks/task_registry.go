@@ -0,0 +1,160 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+)
+
+var SharedTaskRegistry = NewTaskRegistry()
+
+var ErrTaskNotFound = errors.New("task not found")
+var ErrTaskRunning = errors.New("task is running")
+
+// RegisteredTask 可以手动执行的后台任务
+type RegisteredTask struct {
+	Code        string        // 代号
+	Name        string        // 名称
+	Description string        // 描述
+	Interval    time.Duration // 定时执行的间隔
+
+	loop func() error
+
+	isRunning bool
+	lastRunAt time.Time
+	lastErr   error
+
+	locker sync.Mutex
+}
+
+// IsRunning 是否正在手动执行
+func (this *RegisteredTask) IsRunning() bool {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return this.isRunning
+}
+
+// LastRunAt 上次手动执行的时间
+func (this *RegisteredTask) LastRunAt() time.Time {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return this.lastRunAt
+}
+
+// LastError 上次手动执行的错误
+func (this *RegisteredTask) LastError() error {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return this.lastErr
+}
+
+// 执行一次任务
+// 同一个任务同时只能手动执行一次，和定时执行之间不做互斥，所以任务的Loop()需要能够重复执行
+func (this *RegisteredTask) run() error {
+	this.locker.Lock()
+	if this.isRunning {
+		this.locker.Unlock()
+		return ErrTaskRunning
+	}
+	this.isRunning = true
+	this.lastRunAt = time.Now()
+	this.locker.Unlock()
+
+	var err = runAndRecordTask(this.Code, true, this.loop)
+
+	this.locker.Lock()
+	this.isRunning = false
+	this.lastErr = err
+	this.locker.Unlock()
+
+	return err
+}
+
+// TaskRegistry 后台任务注册表
+type TaskRegistry struct {
+	tasks []*RegisteredTask
+
+	locker sync.RWMutex
+}
+
+func NewTaskRegistry() *TaskRegistry {
+	return &TaskRegistry{}
+}
+
+// Register 注册任务，相同代号的任务会被覆盖
+func (this *TaskRegistry) Register(code string, name string, description string, interval time.Duration, loop func() error) {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	var task = &RegisteredTask{
+		Code:        code,
+		Name:        name,
+		Description: description,
+		Interval:    interval,
+		loop:        loop,
+	}
+
+	for index, oldTask := range this.tasks {
+		if oldTask.Code == code {
+			this.tasks[index] = task
+			return
+		}
+	}
+	this.tasks = append(this.tasks, task)
+}
+
+// FindAll 查找所有任务，按注册顺序排列
+func (this *TaskRegistry) FindAll() []*RegisteredTask {
+	this.locker.RLock()
+	defer this.locker.RUnlock()
+
+	var result = make([]*RegisteredTask, len(this.tasks))
+	copy(result, this.tasks)
+	return result
+}
+
+// Find 根据代号查找任务
+func (this *TaskRegistry) Find(code string) *RegisteredTask {
+	this.locker.RLock()
+	defer this.locker.RUnlock()
+
+	for _, task := range this.tasks {
+		if task.Code == code {
+			return task
+		}
+	}
+	return nil
+}
+
+// Run 立即执行任务，并等待执行结束
+func (this *TaskRegistry) Run(code string) error {
+	var task = this.Find(code)
+	if task == nil {
+		return ErrTaskNotFound
+	}
+	return task.run()
+}
+
+// RunAsync 在后台立即执行任务
+func (this *TaskRegistry) RunAsync(code string) error {
+	var task = this.Find(code)
+	if task == nil {
+		return ErrTaskNotFound
+	}
+	if task.IsRunning() {
+		return ErrTaskRunning
+	}
+
+	goman.New(func() {
+		err := task.run()
+		if err != nil && err != ErrTaskRunning {
+			remotelogs.Error("TASK", "run '"+code+"' manually failed: "+err.Error())
+		}
+	})
+	return nil
+}
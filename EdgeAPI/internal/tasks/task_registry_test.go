@@ -0,0 +1,70 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/tasks"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestTaskRegistry_Run(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var registry = tasks.NewTaskRegistry()
+	var count = 0
+	registry.Register("a", "A", "", 1*time.Hour, func() error {
+		count++
+		return nil
+	})
+	registry.Register("b", "B", "", 1*time.Minute, func() error {
+		return errors.New("b failed")
+	})
+	registry.Register("a", "A2", "", 1*time.Hour, func() error {
+		count += 10
+		return nil
+	})
+
+	var allTasks = registry.FindAll()
+	a.IsTrue(len(allTasks) == 2)
+	a.IsTrue(allTasks[0].Code == "a" && allTasks[0].Name == "A2")
+	a.IsTrue(allTasks[1].Code == "b")
+
+	a.IsNil(registry.Run("a"))
+	a.IsTrue(count == 10)
+	a.IsFalse(registry.Find("a").LastRunAt().IsZero())
+	a.IsNil(registry.Find("a").LastError())
+
+	a.IsNotNil(registry.Run("b"))
+	a.IsNotNil(registry.Find("b").LastError())
+
+	a.IsTrue(registry.Run("c") == tasks.ErrTaskNotFound)
+	a.IsTrue(registry.RunAsync("c") == tasks.ErrTaskNotFound)
+}
+
+func TestTaskRegistry_Running(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var registry = tasks.NewTaskRegistry()
+	var started = make(chan bool)
+	var done = make(chan bool)
+	registry.Register("slow", "Slow", "", 1*time.Hour, func() error {
+		started <- true
+		<-done
+		return nil
+	})
+
+	go func() {
+		_ = registry.Run("slow")
+	}()
+	<-started
+
+	a.IsTrue(registry.Find("slow").IsRunning())
+	a.IsTrue(registry.Run("slow") == tasks.ErrTaskRunning)
+	a.IsTrue(registry.RunAsync("slow") == tasks.ErrTaskRunning)
+
+	done <- true
+}
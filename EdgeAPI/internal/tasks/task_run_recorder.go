@@ -0,0 +1,47 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/configs"
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+)
+
+// 执行任务并记录执行的开始时间、耗时和结果
+// 记录失败不影响任务执行
+// API节点正在停止时不再执行
+func runAndRecordTask(taskCode string, isManual bool, loop func() error) error {
+	if !SharedShutdownCoordinator.Begin(taskCode) {
+		return ErrShuttingDown
+	}
+	defer SharedShutdownCoordinator.End(taskCode)
+
+	if models.SharedTaskRunDAO == nil {
+		// 数据库尚未准备好
+		return loop()
+	}
+
+	var apiNodeId int64
+	config, err := configs.SharedAPIConfig()
+	if err == nil {
+		apiNodeId = config.NumberId()
+	}
+
+	var startedAt = time.Now()
+	runId, err := models.SharedTaskRunDAO.CreateRun(nil, apiNodeId, taskCode, isManual, startedAt)
+	if err != nil {
+		remotelogs.Error("TASK", "create run record of '"+taskCode+"' failed: "+err.Error())
+	}
+
+	var loopErr = loop()
+
+	err = models.SharedTaskRunDAO.FinishRun(nil, runId, time.Since(startedAt), loopErr)
+	if err != nil {
+		remotelogs.Error("TASK", "finish run record of '"+taskCode+"' failed: "+err.Error())
+	}
+
+	return loopErr
+}
@@ -0,0 +1,203 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+)
+
+var SharedTaskScheduler = NewTaskScheduler(1*time.Minute, func() (*systemconfigs.TaskScheduleConfig, error) {
+	if models.SharedSysSettingDAO == nil {
+		// 数据库尚未准备好
+		return systemconfigs.NewTaskScheduleConfig(), nil
+	}
+	return models.SharedSysSettingDAO.ReadTaskScheduleConfig(nil)
+})
+
+// TaskScheduler 后台任务执行计划
+// 任务可以在设置中指定Cron表达式，没有指定时按照默认的间隔执行；设置会定期重新读取，修改之后不需要重启API节点
+type TaskScheduler struct {
+	refreshInterval time.Duration
+	loadConfig      func() (*systemconfigs.TaskScheduleConfig, error)
+
+	exprMap   map[string]*systemconfigs.CronExpr // task code => expr
+	updatedAt time.Time
+
+	tickers   []*TaskTicker
+	isStopped bool
+
+	locker sync.Mutex
+}
+
+func NewTaskScheduler(refreshInterval time.Duration, loadConfig func() (*systemconfigs.TaskScheduleConfig, error)) *TaskScheduler {
+	return &TaskScheduler{
+		refreshInterval: refreshInterval,
+		loadConfig:      loadConfig,
+		exprMap:         map[string]*systemconfigs.CronExpr{},
+	}
+}
+
+// FindCronExpr 查找任务的Cron表达式，没有设置时返回nil
+func (this *TaskScheduler) FindCronExpr(taskCode string) *systemconfigs.CronExpr {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	if time.Since(this.updatedAt) >= this.refreshInterval {
+		config, err := this.loadConfig()
+		if err != nil {
+			// 读取失败时继续使用原有的执行计划
+			remotelogs.Error("TASK_SCHEDULER", "load task schedule config failed: "+err.Error())
+		} else {
+			this.update(config)
+		}
+		this.updatedAt = time.Now()
+	}
+
+	return this.exprMap[taskCode]
+}
+
+// Update 立即使用新的执行计划
+func (this *TaskScheduler) Update(config *systemconfigs.TaskScheduleConfig) {
+	this.locker.Lock()
+	this.update(config)
+	this.updatedAt = time.Now()
+	this.locker.Unlock()
+}
+
+// NextRunAt 计算任务下一次执行的时间
+func (this *TaskScheduler) NextRunAt(taskCode string, interval time.Duration, lastRunAt time.Time) time.Time {
+	var expr = this.FindCronExpr(taskCode)
+	if expr != nil {
+		return expr.Next(lastRunAt)
+	}
+	return lastRunAt.Add(interval)
+}
+
+// NewTicker 创建按照执行计划触发的Ticker，用来代替 time.NewTicker()
+func (this *TaskScheduler) NewTicker(taskCode string, interval time.Duration) *TaskTicker {
+	var c = make(chan time.Time, 1)
+	var ticker = &TaskTicker{
+		C:         c,
+		c:         c,
+		scheduler: this,
+		taskCode:  taskCode,
+		interval:  interval,
+		done:      make(chan bool),
+	}
+
+	this.locker.Lock()
+	var isStopped = this.isStopped
+	if !isStopped {
+		this.tickers = append(this.tickers, ticker)
+	}
+	this.locker.Unlock()
+
+	if isStopped {
+		ticker.Stop()
+		return ticker
+	}
+
+	goman.New(func() {
+		ticker.loop()
+	})
+	return ticker
+}
+
+// StopAll 停止所有Ticker，之后创建的Ticker也不会再触发
+func (this *TaskScheduler) StopAll() {
+	this.locker.Lock()
+	var tickers = this.tickers
+	this.tickers = nil
+	this.isStopped = true
+	this.locker.Unlock()
+
+	for _, ticker := range tickers {
+		ticker.Stop()
+	}
+}
+
+func (this *TaskScheduler) update(config *systemconfigs.TaskScheduleConfig) {
+	var exprMap = map[string]*systemconfigs.CronExpr{}
+	if config != nil {
+		for taskCode, rawExpr := range config.CronExprs {
+			expr, err := systemconfigs.ParseCronExpr(rawExpr)
+			if err != nil {
+				remotelogs.Error("TASK_SCHEDULER", "parse cron expression of task '"+taskCode+"' failed: "+err.Error())
+				continue
+			}
+			exprMap[taskCode] = expr
+		}
+	}
+	this.exprMap = exprMap
+}
+
+// TaskTicker 按照执行计划定时触发
+// 和 time.Ticker 一样，任务执行时间过长时会丢弃中间的触发
+type TaskTicker struct {
+	C <-chan time.Time
+
+	c         chan time.Time
+	scheduler *TaskScheduler
+	taskCode  string
+	interval  time.Duration
+
+	done     chan bool
+	stopOnce sync.Once
+}
+
+// Stop 停止触发
+func (this *TaskTicker) Stop() {
+	this.stopOnce.Do(func() {
+		close(this.done)
+	})
+}
+
+// TaskCode 任务代号
+func (this *TaskTicker) TaskCode() string {
+	return this.taskCode
+}
+
+// Lease 从现在到下一次执行的时长，用作分布式锁的有效期
+func (this *TaskTicker) Lease() time.Duration {
+	var now = time.Now()
+	var nextRunAt = this.scheduler.NextRunAt(this.taskCode, this.interval, now)
+	if nextRunAt.IsZero() || !nextRunAt.After(now) {
+		return this.interval
+	}
+	return nextRunAt.Sub(now)
+}
+
+func (this *TaskTicker) loop() {
+	var lastRunAt = time.Now()
+	for {
+		var now = time.Now()
+		var nextRunAt = this.scheduler.NextRunAt(this.taskCode, this.interval, lastRunAt)
+		if !nextRunAt.IsZero() && !now.Before(nextRunAt) {
+			lastRunAt = now
+			select {
+			case this.c <- now:
+			default:
+			}
+			continue
+		}
+
+		// 执行计划可能会修改，所以最多等待一个刷新周期后重新计算
+		var wait = this.scheduler.refreshInterval
+		if !nextRunAt.IsZero() && nextRunAt.Sub(now) < wait {
+			wait = nextRunAt.Sub(now)
+		}
+		var timer = time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-this.done:
+			timer.Stop()
+			return
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/tasks"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestTaskScheduler_NextRunAt(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var config = systemconfigs.NewTaskScheduleConfig()
+	config.SetCronExpr("dbBackup", "30 3 * * *")
+	var scheduler = tasks.NewTaskScheduler(1*time.Hour, func() (*systemconfigs.TaskScheduleConfig, error) {
+		return config, nil
+	})
+
+	var lastRunAt = time.Date(2024, 5, 10, 3, 40, 0, 0, time.Local)
+	a.IsTrue(scheduler.NextRunAt("dbBackup", 10*time.Minute, lastRunAt).Equal(time.Date(2024, 5, 11, 3, 30, 0, 0, time.Local)))
+	a.IsTrue(scheduler.NextRunAt("messageClean", 10*time.Minute, lastRunAt).Equal(lastRunAt.Add(10 * time.Minute)))
+
+	// 立即生效
+	scheduler.Update(systemconfigs.NewTaskScheduleConfig())
+	a.IsNil(scheduler.FindCronExpr("dbBackup"))
+}
+
+func TestTaskScheduler_NewTicker(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var scheduler = tasks.NewTaskScheduler(20*time.Millisecond, func() (*systemconfigs.TaskScheduleConfig, error) {
+		return systemconfigs.NewTaskScheduleConfig(), nil
+	})
+	var ticker = scheduler.NewTicker("test", 50*time.Millisecond)
+	defer ticker.Stop()
+
+	select {
+	case <-ticker.C:
+	case <-time.After(1 * time.Second):
+		t.Fatal("ticker should be triggered")
+	}
+
+	// 改成每年执行一次之后，不再按照间隔触发
+	var config = systemconfigs.NewTaskScheduleConfig()
+	config.SetCronExpr("test", "@yearly")
+	scheduler = tasks.NewTaskScheduler(20*time.Millisecond, func() (*systemconfigs.TaskScheduleConfig, error) {
+		return config, nil
+	})
+	var yearlyTicker = scheduler.NewTicker("test", 50*time.Millisecond)
+	defer yearlyTicker.Stop()
+
+	var triggered = false
+	select {
+	case <-yearlyTicker.C:
+		triggered = true
+	case <-time.After(300 * time.Millisecond):
+	}
+	a.IsFalse(triggered)
+}
+
+func TestTaskTicker_Lease(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var config = systemconfigs.NewTaskScheduleConfig()
+	config.SetCronExpr("dbBackup", "@yearly")
+	var scheduler = tasks.NewTaskScheduler(1*time.Hour, func() (*systemconfigs.TaskScheduleConfig, error) {
+		return config, nil
+	})
+
+	var ticker = scheduler.NewTicker("messageClean", 10*time.Minute)
+	defer ticker.Stop()
+	a.IsTrue(ticker.TaskCode() == "messageClean")
+	a.IsTrue(ticker.Lease() > 9*time.Minute && ticker.Lease() <= 10*time.Minute)
+
+	var yearlyTicker = scheduler.NewTicker("dbBackup", 10*time.Minute)
+	defer yearlyTicker.Stop()
+	a.IsTrue(yearlyTicker.Lease() > 10*time.Minute)
+}
+
+func TestTaskScheduler_StopAll(t *testing.T) {
+	var scheduler = tasks.NewTaskScheduler(20*time.Millisecond, func() (*systemconfigs.TaskScheduleConfig, error) {
+		return systemconfigs.NewTaskScheduleConfig(), nil
+	})
+	var ticker = scheduler.NewTicker("test", 50*time.Millisecond)
+	scheduler.StopAll()
+
+	// 停止之后创建的Ticker也不会触发
+	var newTicker = scheduler.NewTicker("test", 50*time.Millisecond)
+
+	select {
+	case <-ticker.C:
+		t.Fatal("ticker should not be triggered")
+	case <-newTicker.C:
+		t.Fatal("new ticker should not be triggered")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/iwind/TeaGo/types"
+)
+
+var SharedShutdownCoordinator = NewShutdownCoordinator()
+
+var ErrShuttingDown = errors.New("api node is shutting down")
+
+// 是否因为API节点正在停止而没有执行，此时任务循环需要退出
+func isShuttingDownErr(err error) bool {
+	return errors.Is(err, ErrShuttingDown)
+}
+
+func init() {
+	SharedShutdownCoordinator.OnShutdown(SharedTaskScheduler.StopAll)
+}
+
+// ShutdownCoordinator 停止API节点时协调后台任务
+// 停止之后不再开始新的任务，等待正在执行的任务结束或者到达安全点之后再退出，防止DNS记录、证书等只修改了一部分
+type ShutdownCoordinator struct {
+	isShuttingDown bool
+	runningMap     map[string]int // name => count
+	countRunning   int
+	doneChan       chan bool // 所有任务都结束时关闭
+
+	onShutdownFuncs []func()
+
+	locker sync.Mutex
+}
+
+func NewShutdownCoordinator() *ShutdownCoordinator {
+	return &ShutdownCoordinator{
+		runningMap: map[string]int{},
+	}
+}
+
+// OnShutdown 增加开始停止时的回调，用来停止定时器等
+func (this *ShutdownCoordinator) OnShutdown(f func()) {
+	this.locker.Lock()
+	this.onShutdownFuncs = append(this.onShutdownFuncs, f)
+	this.locker.Unlock()
+}
+
+// Begin 标记任务开始执行，正在停止时返回false，此时不能再执行任务
+// 返回true时，任务结束后需要调用 End()
+func (this *ShutdownCoordinator) Begin(name string) bool {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	if this.isShuttingDown {
+		return false
+	}
+	this.runningMap[name]++
+	this.countRunning++
+	return true
+}
+
+// End 标记任务执行结束
+func (this *ShutdownCoordinator) End(name string) {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	if this.runningMap[name] <= 1 {
+		delete(this.runningMap, name)
+	} else {
+		this.runningMap[name]--
+	}
+	if this.countRunning > 0 {
+		this.countRunning--
+	}
+	if this.countRunning == 0 && this.doneChan != nil {
+		close(this.doneChan)
+		this.doneChan = nil
+	}
+}
+
+// IsShuttingDown 是否正在停止
+// 执行时间较长的任务可以在安全点检查，正在停止时提前结束
+func (this *ShutdownCoordinator) IsShuttingDown() bool {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	return this.isShuttingDown
+}
+
+// RunningNames 正在执行的任务名称
+func (this *ShutdownCoordinator) RunningNames() []string {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	var result = []string{}
+	for name, count := range this.runningMap {
+		if count > 1 {
+			name += "*" + types.String(count)
+		}
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Shutdown 开始停止，并等待正在执行的任务结束
+// 超过 timeout 仍有任务没有结束时返回false
+func (this *ShutdownCoordinator) Shutdown(timeout time.Duration) bool {
+	this.locker.Lock()
+	var onShutdownFuncs []func()
+	if !this.isShuttingDown {
+		this.isShuttingDown = true
+		onShutdownFuncs = this.onShutdownFuncs
+	}
+	var doneChan = this.doneChan
+	if this.countRunning > 0 && doneChan == nil {
+		doneChan = make(chan bool)
+		this.doneChan = doneChan
+	}
+	this.locker.Unlock()
+
+	for _, f := range onShutdownFuncs {
+		f()
+	}
+
+	if doneChan == nil {
+		return true
+	}
+
+	remotelogs.Println("TASK", "waiting for running tasks to finish: "+strings.Join(this.RunningNames(), ", "))
+
+	var timer = time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-doneChan:
+		return true
+	case <-timer.C:
+		remotelogs.Warn("TASK", "tasks are still running after "+timeout.String()+": "+strings.Join(this.RunningNames(), ", "))
+		return false
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/tasks"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestShutdownCoordinator_Shutdown(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var coordinator = tasks.NewShutdownCoordinator()
+	var isCalled = false
+	coordinator.OnShutdown(func() {
+		isCalled = true
+	})
+
+	a.IsTrue(coordinator.Begin("dnsSync"))
+	a.IsTrue(coordinator.Begin("sslCertIssue"))
+	a.IsTrue(len(coordinator.RunningNames()) == 2)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		coordinator.End("dnsSync")
+		coordinator.End("sslCertIssue")
+	}()
+
+	var before = time.Now()
+	a.IsTrue(coordinator.Shutdown(1 * time.Second))
+	a.IsTrue(time.Since(before) >= 50*time.Millisecond)
+	a.IsTrue(isCalled)
+	a.IsTrue(coordinator.IsShuttingDown())
+
+	// 停止后不能再开始新的任务
+	a.IsFalse(coordinator.Begin("dnsSync"))
+}
+
+func TestShutdownCoordinator_Timeout(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var coordinator = tasks.NewShutdownCoordinator()
+	a.IsTrue(coordinator.Shutdown(1 * time.Second))
+
+	coordinator = tasks.NewShutdownCoordinator()
+	a.IsTrue(coordinator.Begin("sslCertIssue"))
+	a.IsTrue(coordinator.Begin("sslCertIssue"))
+	a.IsFalse(coordinator.Shutdown(50 * time.Millisecond))
+	a.IsTrue(coordinator.RunningNames()[0] == "sslCertIssue*2")
+}
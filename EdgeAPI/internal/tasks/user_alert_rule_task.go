@@ -0,0 +1,246 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		goman.New(func() {
+			NewUserAlertRuleTask(5 * time.Minute).Start()
+		})
+	})
+}
+
+// UserAlertRuleTask 检查用户自定义的告警规则
+// 按用户分区执行，每个API节点只检查自己负责的用户
+type UserAlertRuleTask struct {
+	BaseTask
+
+	ticker     *time.Ticker
+	httpClient *http.Client
+}
+
+func NewUserAlertRuleTask(duration time.Duration) *UserAlertRuleTask {
+	return &UserAlertRuleTask{
+		ticker:     time.NewTicker(duration),
+		httpClient: newUserAlertWebhookClient(5 * time.Second),
+	}
+}
+
+func (this *UserAlertRuleTask) Start() {
+	for range this.ticker.C {
+		err := this.Loop()
+		if err != nil {
+			this.logErr("UserAlertRuleTask", err.Error())
+		}
+	}
+}
+
+func (this *UserAlertRuleTask) Loop() error {
+	var tx *dbs.Tx
+	userIds, err := models.SharedUserAlertRuleDAO.FindAllEnabledAndOnUserIds(tx)
+	if err != nil {
+		return err
+	}
+
+	// 统计数据是每5分钟上传一次，这里使用上一个完整的时间段
+	var slotTime = time.Now().Unix()/300*300 - 300
+	for _, userId := range userIds {
+		if !this.IsPartitionOwner("userAlert@" + types.String(userId)) {
+			continue
+		}
+
+		// 单个用户出错不影响其他用户
+		err = this.checkUser(tx, userId, slotTime)
+		if err != nil {
+			this.logErr("UserAlertRuleTask", "check user '"+types.String(userId)+"' failed: "+err.Error())
+		}
+	}
+	return nil
+}
+
+// 检查单个用户的所有规则
+func (this *UserAlertRuleTask) checkUser(tx *dbs.Tx, userId int64, slotTime int64) error {
+	rules, err := models.SharedUserAlertRuleDAO.FindAllEnabledAndOnUserRules(tx, userId)
+	if err != nil {
+		return err
+	}
+
+	var day = timeutil.FormatTime("Ymd", slotTime)
+	var minute = timeutil.FormatTime("YmdHi", slotTime)
+	var now = time.Now().Unix()
+	for _, rule := range rules {
+		var serverId = int64(rule.ServerId)
+
+		var dailyBytes int64
+		var minuteStat *pb.ServerDailyStat
+		if rule.Item == userconfigs.UserAlertItemDailyTraffic {
+			dailyBytes, err = models.SharedServerDailyStatDAO.SumUserAlertDailyBytes(tx, userId, serverId, day)
+		} else {
+			minuteStat, err = models.SharedServerDailyStatDAO.SumUserAlertMinutelyStat(tx, userId, serverId, minute)
+		}
+		if err != nil {
+			return err
+		}
+
+		currentValue, ok := CalculateUserAlertValue(rule.Item, dailyBytes, minuteStat)
+		if !ok || currentValue < rule.Value {
+			continue
+		}
+
+		// 通知间隔
+		if rule.NotifiedAt > 0 {
+			if rule.NotifyMinutes == 0 {
+				// 不重复通知，直到当天结束
+				if timeutil.FormatTime("Ymd", int64(rule.NotifiedAt)) == timeutil.Format("Ymd") {
+					continue
+				}
+			} else if now-int64(rule.NotifiedAt) < int64(rule.NotifyMinutes)*60 {
+				continue
+			}
+		}
+
+		err = models.SharedUserAlertRuleDAO.UpdateRuleNotifiedAt(tx, int64(rule.Id), now)
+		if err != nil {
+			return err
+		}
+
+		err = this.notify(tx, rule, currentValue)
+		if err != nil {
+			remotelogs.Warn("UserAlertRuleTask", "notify rule '"+types.String(rule.Id)+"' failed: "+err.Error())
+		}
+	}
+	return nil
+}
+
+// 发送通知
+func (this *UserAlertRuleTask) notify(tx *dbs.Tx, rule *models.UserAlertRule, currentValue float64) error {
+	var itemDefinition = userconfigs.FindUserAlertItem(rule.Item)
+	if itemDefinition == nil {
+		return errors.New("invalid item '" + rule.Item + "'")
+	}
+
+	var target = "所有网站"
+	if rule.ServerId > 0 {
+		serverName, err := models.SharedServerDAO.FindEnabledServerName(tx, int64(rule.ServerId))
+		if err != nil {
+			return err
+		}
+		target = "网站\"" + serverName + "\""
+	}
+
+	var subject = "告警：" + itemDefinition.Name + "超过阈值"
+	var body = fmt.Sprintf("%s的%s为%.2f%s，超过了设置的阈值%.2f%s", target, itemDefinition.Name, currentValue, itemDefinition.Unit, rule.Value, itemDefinition.Unit)
+	var params = maps.Map{
+		"ruleId":    rule.Id,
+		"serverId":  rule.ServerId,
+		"item":      rule.Item,
+		"value":     currentValue,
+		"threshold": rule.Value,
+		"unit":      itemDefinition.Unit,
+		"time":      time.Now().Unix(),
+	}
+
+	var receivers = rule.DecodeReceivers()
+	if receivers.NotifyMessage {
+		err := models.SharedMessageDAO.CreateMessage(tx, 0, int64(rule.UserId), models.MessageTypeUserAlertTriggered, models.MessageLevelWarning, subject, body, params.AsJSON())
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(receivers.WebhookURL) > 0 {
+		params["subject"] = subject
+		params["body"] = body
+		req, err := http.NewRequest(http.MethodPost, receivers.WebhookURL, bytes.NewReader(params.AsJSON()))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := this.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("post webhook failed: %w", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			return errors.New("post webhook failed: invalid response status code '" + types.String(resp.StatusCode) + "'")
+		}
+	}
+
+	return nil
+}
+
+// CalculateUserAlertValue 计算监控项当前的值，单位和 userconfigs.FindAllUserAlertItems() 中的定义一致
+func CalculateUserAlertValue(item userconfigs.UserAlertItem, dailyBytes int64, minuteStat *pb.ServerDailyStat) (value float64, ok bool) {
+	switch item {
+	case userconfigs.UserAlertItemDailyTraffic:
+		return float64(dailyBytes) / (1 << 30), true
+	}
+
+	if minuteStat == nil {
+		return 0, false
+	}
+	switch item {
+	case userconfigs.UserAlertItemBandwidth:
+		return float64(minuteStat.Bytes) * 8 / 300 / 1_000_000, true
+	case userconfigs.UserAlertItemStatus4xxRatio:
+		if minuteStat.CountRequests < userconfigs.UserAlertMinRequests {
+			return 0, false
+		}
+		return float64(minuteStat.CountStatus4Xx) * 100 / float64(minuteStat.CountRequests), true
+	case userconfigs.UserAlertItemStatus5xxRatio:
+		if minuteStat.CountRequests < userconfigs.UserAlertMinRequests {
+			return 0, false
+		}
+		return float64(minuteStat.CountStatus5Xx) * 100 / float64(minuteStat.CountRequests), true
+	}
+	return 0, false
+}
+
+// Webhook地址由用户设置，不允许访问内网地址
+func newUserAlertWebhookClient(timeout time.Duration) *http.Client {
+	var dialer = &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			var ip = net.ParseIP(host)
+			if ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() || ip.IsLoopback() {
+				return errors.New("webhook address '" + host + "' is not allowed")
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			MaxIdleConnsPerHost: 4,
+			IdleConnTimeout:     2 * time.Minute,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/tasks"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestCalculateUserAlertValue(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	{
+		value, ok := tasks.CalculateUserAlertValue(userconfigs.UserAlertItemDailyTraffic, 3<<30, nil)
+		a.IsTrue(ok)
+		a.IsTrue(value == 3)
+	}
+
+	{
+		_, ok := tasks.CalculateUserAlertValue(userconfigs.UserAlertItemBandwidth, 0, nil)
+		a.IsFalse(ok)
+	}
+
+	{
+		value, ok := tasks.CalculateUserAlertValue(userconfigs.UserAlertItemBandwidth, 0, &pb.ServerDailyStat{Bytes: 300 * 1_000_000 / 8})
+		a.IsTrue(ok)
+		a.IsTrue(value == 1)
+	}
+
+	{
+		// 请求数太少
+		_, ok := tasks.CalculateUserAlertValue(userconfigs.UserAlertItemStatus5xxRatio, 0, &pb.ServerDailyStat{CountRequests: 10, CountStatus5Xx: 10})
+		a.IsFalse(ok)
+	}
+
+	{
+		value, ok := tasks.CalculateUserAlertValue(userconfigs.UserAlertItemStatus4xxRatio, 0, &pb.ServerDailyStat{CountRequests: 200, CountStatus4Xx: 50})
+		a.IsTrue(ok)
+		a.IsTrue(value == 25)
+	}
+}
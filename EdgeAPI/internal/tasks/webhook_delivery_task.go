@@ -0,0 +1,95 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package tasks
+
+import (
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/db/models"
+	"github.com/TeaOSLab/EdgeAPI/internal/goman"
+	"github.com/TeaOSLab/EdgeAPI/internal/remotelogs"
+	"github.com/iwind/TeaGo/dbs"
+	"github.com/iwind/TeaGo/types"
+)
+
+func init() {
+	dbs.OnReadyDone(func() {
+		goman.New(func() {
+			NewWebhookDeliveryTask(5 * time.Second).Start()
+		})
+	})
+}
+
+// WebhookDeliveryTask 投递Webhook事件，并重试投递失败的事件
+type WebhookDeliveryTask struct {
+	BaseTask
+
+	ticker      *time.Ticker
+	lastCleanAt time.Time
+}
+
+func NewWebhookDeliveryTask(duration time.Duration) *WebhookDeliveryTask {
+	return &WebhookDeliveryTask{
+		ticker: time.NewTicker(duration),
+	}
+}
+
+func (this *WebhookDeliveryTask) Start() {
+	for range this.ticker.C {
+		err := this.Loop()
+		if err != nil {
+			this.logErr("WebhookDeliveryTask", err.Error())
+		}
+	}
+}
+
+func (this *WebhookDeliveryTask) Loop() error {
+	if !this.IsPrimaryNode() {
+		return nil
+	}
+
+	// 每天清理一次过期的投递记录
+	if time.Since(this.lastCleanAt) > 24*time.Hour {
+		this.lastCleanAt = time.Now()
+		err := models.SharedWebhookDeliveryDAO.CleanDays(nil, 30)
+		if err != nil {
+			return err
+		}
+	}
+
+	deliveries, err := models.SharedWebhookDeliveryDAO.FindDueDeliveries(nil, 100)
+	if err != nil {
+		return err
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	// 同时投递，避免单个接口响应缓慢时阻塞其他事件
+	const concurrent = 8
+	var deliveryChan = make(chan *models.WebhookDelivery, len(deliveries))
+	for _, delivery := range deliveries {
+		deliveryChan <- delivery
+	}
+	close(deliveryChan)
+
+	var wg = &sync.WaitGroup{}
+	for i := 0; i < concurrent; i++ {
+		wg.Add(1)
+		goman.New(func() {
+			defer wg.Done()
+			for delivery := range deliveryChan {
+				_, deliverErr, err := models.SharedWebhookDeliveryDAO.Deliver(delivery)
+				if err != nil {
+					this.logErr("WebhookDeliveryTask", "update delivery '"+types.String(delivery.Id)+"' failed: "+err.Error())
+				} else if deliverErr != nil {
+					remotelogs.Warn("WebhookDeliveryTask", "deliver event '"+delivery.Event+"' to webhook '"+types.String(delivery.WebhookId)+"' failed: "+deliverErr.Error())
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	return nil
+}
@@ -5,10 +5,18 @@ package domainutils
 import (
 	"regexp"
 	"strings"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
 )
 
 // ValidateDomainFormat 校验域名格式
 func ValidateDomainFormat(domain string) bool {
+	// 国际化域名（IDN）转换为Punycode格式后再校验
+	domain, err := configutils.NormalizeDomain(domain)
+	if err != nil {
+		return false
+	}
+
 	pieces := strings.Split(domain, ".")
 	for _, piece := range pieces {
 		if piece == "-" ||
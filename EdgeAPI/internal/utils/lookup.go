@@ -2,6 +2,7 @@ package utils
 
 import (
 	"errors"
+	"sort"
 	"sync"
 
 	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
@@ -179,6 +180,62 @@ func LookupTXT(host string, extraResolvers []*dnsconfigs.DNSResolver) ([]string,
 	return nil, lastErr
 }
 
+// LookupIPs 查询域名对应的IPv4和IPv6地址
+// 返回的IP已排序，ttl为所有记录中最小的TTL（秒）
+func LookupIPs(host string) (ips []string, ttl uint32, err error) {
+	if sharedDNSClient == nil {
+		return nil, 0, errors.New("could not find dns client")
+	}
+
+	var serverAddrs = composeDNSResolverAddrs(nil)
+	if len(serverAddrs) == 0 {
+		return nil, 0, errors.New("could not find dns servers")
+	}
+
+	var hasValidServer = false
+	var lastErr error
+	for _, recordType := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		var m = new(dns.Msg)
+		m.SetQuestion(dns.Fqdn(host), recordType)
+		m.RecursionDesired = true
+
+		for _, serverAddr := range serverAddrs {
+			r, _, exchangeErr := sharedDNSClient.Exchange(m, serverAddr)
+			if exchangeErr != nil {
+				lastErr = exchangeErr
+				continue
+			}
+			hasValidServer = true
+
+			for _, answer := range r.Answer {
+				var ip string
+				switch record := answer.(type) {
+				case *dns.A:
+					ip = record.A.String()
+				case *dns.AAAA:
+					ip = record.AAAA.String()
+				default:
+					continue
+				}
+				if !lists.ContainsString(ips, ip) {
+					ips = append(ips, ip)
+				}
+				if ttl == 0 || answer.Header().Ttl < ttl {
+					ttl = answer.Header().Ttl
+				}
+			}
+			break
+		}
+	}
+
+	if !hasValidServer {
+		return nil, 0, lastErr
+	}
+
+	sort.Strings(ips)
+	return ips, ttl, nil
+}
+
 // 组合DNS解析服务器地址
 func composeDNSResolverAddrs(extraResolvers []*dnsconfigs.DNSResolver) []string {
 	sharedDNSLocker.RLock()
@@ -13,6 +13,10 @@ func TestLookupCNAME(t *testing.T) {
 	t.Log(utils.LookupCNAME("www.yun4s.cn"))
 }
 
+func TestLookupIPs(t *testing.T) {
+	t.Log(utils.LookupIPs("goedge.cn"))
+}
+
 func TestLookupNS(t *testing.T) {
 	t.Log(utils.LookupNS("goedge.cn", nil))
 }
@@ -0,0 +1,233 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package rdaputils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// DefaultBootstrapURL IANA提供的DNS RDAP服务器列表
+const DefaultBootstrapURL = "https://data.iana.org/rdap/dns.json"
+
+var ErrNoRDAPServer = errors.New("no rdap server for the domain")
+var ErrNoExpiration = errors.New("no expiration event in rdap response")
+
+// DomainInfo 域名注册信息
+type DomainInfo struct {
+	Domain    string
+	ExpiresAt time.Time
+	Registrar string
+}
+
+// Client RDAP客户端
+type Client struct {
+	BootstrapURL string
+	HTTPClient   *http.Client
+
+	bootstrapTTL  time.Duration
+	bootstrapAt   time.Time
+	bootstrapMap  map[string][]string // tld => [base url1, ...]
+	bootstrapLock sync.Mutex
+}
+
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{
+		BootstrapURL: DefaultBootstrapURL,
+		HTTPClient:   httpClient,
+		bootstrapTTL: 24 * time.Hour,
+	}
+}
+
+// Lookup 查询域名注册信息
+// domain 需要是可注册的域名，比如 example.com，可以使用 RegisteredDomain() 获取
+func (this *Client) Lookup(ctx context.Context, domain string) (*DomainInfo, error) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	var dotIndex = strings.LastIndex(domain, ".")
+	if dotIndex <= 0 {
+		return nil, errors.New("invalid domain '" + domain + "'")
+	}
+
+	baseURLs, err := this.findServers(ctx, domain[dotIndex+1:])
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, baseURL := range baseURLs {
+		info, err := this.lookupWithServer(ctx, baseURL, domain)
+		if err == nil {
+			return info, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+func (this *Client) lookupWithServer(ctx context.Context, baseURL string, domain string) (*DomainInfo, error) {
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+
+	var resp = &domainResponse{}
+	err := this.getJSON(ctx, baseURL+"domain/"+domain, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var info = &DomainInfo{
+		Domain:    domain,
+		Registrar: resp.registrar(),
+	}
+	for _, event := range resp.Events {
+		if event.EventAction == "expiration" {
+			expiresAt, err := time.Parse(time.RFC3339, event.EventDate)
+			if err != nil {
+				return nil, fmt.Errorf("invalid expiration date '%s': %w", event.EventDate, err)
+			}
+			info.ExpiresAt = expiresAt
+			return info, nil
+		}
+	}
+	return nil, ErrNoExpiration
+}
+
+// 查找顶级域名对应的RDAP服务器
+func (this *Client) findServers(ctx context.Context, tld string) ([]string, error) {
+	this.bootstrapLock.Lock()
+	defer this.bootstrapLock.Unlock()
+
+	if this.bootstrapMap == nil || time.Since(this.bootstrapAt) > this.bootstrapTTL {
+		var bootstrap = &bootstrapResponse{}
+		err := this.getJSON(ctx, this.BootstrapURL, bootstrap)
+		if err != nil {
+			// 使用旧的数据
+			if this.bootstrapMap == nil {
+				return nil, fmt.Errorf("load rdap bootstrap failed: %w", err)
+			}
+		} else {
+			var bootstrapMap = map[string][]string{}
+			for _, service := range bootstrap.Services {
+				if len(service) != 2 {
+					continue
+				}
+				for _, serviceTLD := range service[0] {
+					bootstrapMap[strings.ToLower(serviceTLD)] = service[1]
+				}
+			}
+			this.bootstrapMap = bootstrapMap
+			this.bootstrapAt = time.Now()
+		}
+	}
+
+	baseURLs, ok := this.bootstrapMap[tld]
+	if !ok || len(baseURLs) == 0 {
+		return nil, ErrNoRDAPServer
+	}
+	return baseURLs, nil
+}
+
+func (this *Client) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/rdap+json, application/json")
+	resp, err := this.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request '%s' failed: invalid status code %d", url, resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// RegisteredDomain 获取域名的可注册部分，比如 www.example.co.uk => example.co.uk
+// 不支持IP地址和没有公共后缀的域名
+func RegisteredDomain(domain string) (string, error) {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimPrefix(domain, "*.")
+	domain = strings.TrimPrefix(domain, ".")
+	domain = strings.TrimSuffix(domain, ".")
+	if len(domain) == 0 || strings.ContainsAny(domain, "*~^$") {
+		return "", errors.New("invalid domain '" + domain + "'")
+	}
+	if net.ParseIP(domain) != nil {
+		return "", errors.New("'" + domain + "' is an ip address")
+	}
+
+	asciiDomain, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return "", err
+	}
+
+	// 只检查ICANN管理的后缀
+	suffix, icann := publicsuffix.PublicSuffix(asciiDomain)
+	if !icann || suffix == asciiDomain {
+		return "", errors.New("'" + domain + "' has no registrable part")
+	}
+	return publicsuffix.EffectiveTLDPlusOne(asciiDomain)
+}
+
+type bootstrapResponse struct {
+	Services [][][]string `json:"services"`
+}
+
+type domainResponse struct {
+	Events []struct {
+		EventAction string `json:"eventAction"`
+		EventDate   string `json:"eventDate"`
+	} `json:"events"`
+	Entities []struct {
+		Roles      []string `json:"roles"`
+		VCardArray []any    `json:"vcardArray"`
+	} `json:"entities"`
+}
+
+// 从vCard中读取注册商名称
+func (this *domainResponse) registrar() string {
+	for _, entity := range this.Entities {
+		var isRegistrar = false
+		for _, role := range entity.Roles {
+			if role == "registrar" {
+				isRegistrar = true
+				break
+			}
+		}
+		if !isRegistrar || len(entity.VCardArray) != 2 {
+			continue
+		}
+
+		properties, ok := entity.VCardArray[1].([]any)
+		if !ok {
+			continue
+		}
+		for _, property := range properties {
+			values, ok := property.([]any)
+			if ok && len(values) == 4 && values[0] == "fn" {
+				name, _ := values[3].(string)
+				return name
+			}
+		}
+	}
+	return ""
+}
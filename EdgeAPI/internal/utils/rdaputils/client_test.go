@@ -0,0 +1,84 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package rdaputils_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/rdaputils"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestRegisteredDomain(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	for domain, expected := range map[string]string{
+		"example.com":         "example.com",
+		"www.example.com":     "example.com",
+		"*.example.com":       "example.com",
+		"a.b.example.co.uk":   "example.co.uk",
+		"WWW.Example.COM.":    "example.com",
+		"www.例子.中国":           "xn--fsqu00a.xn--fiqs8s",
+		"foo.bar.example.net": "example.net",
+	} {
+		result, err := rdaputils.RegisteredDomain(domain)
+		if err != nil {
+			t.Fatal(domain, err)
+		}
+		a.IsTrue(result == expected)
+	}
+
+	for _, domain := range []string{"", "com", "co.uk", "127.0.0.1", "::1", "~.*example\\.com", "localhost"} {
+		_, err := rdaputils.RegisteredDomain(domain)
+		a.IsNotNil(err)
+	}
+}
+
+func TestClient_Lookup(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/dns.json":
+			_, _ = writer.Write([]byte(`{"services":[[["com","net"],["` + server.URL + `/rdap/"]]]}`))
+		case "/rdap/domain/example.com":
+			_, _ = writer.Write([]byte(`{
+	"events": [
+		{"eventAction": "registration", "eventDate": "1995-08-14T04:00:00Z"},
+		{"eventAction": "expiration", "eventDate": "2030-08-13T04:00:00Z"}
+	],
+	"entities": [
+		{"roles": ["registrar"], "vcardArray": ["vcard", [["version", {}, "text", "4.0"], ["fn", {}, "text", "Example Registrar, Inc."]]]}
+	]
+}`))
+		case "/rdap/domain/noexpiration.com":
+			_, _ = writer.Write([]byte(`{"events": []}`))
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	var client = rdaputils.NewClient(server.Client())
+	client.BootstrapURL = server.URL + "/dns.json"
+
+	info, err := client.Lookup(context.Background(), "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(info.ExpiresAt.Year() == 2030)
+	a.IsTrue(info.Registrar == "Example Registrar, Inc.")
+
+	_, err = client.Lookup(context.Background(), "noexpiration.com")
+	a.IsTrue(err == rdaputils.ErrNoExpiration)
+
+	_, err = client.Lookup(context.Background(), "notfound.net")
+	a.IsNotNil(err)
+
+	_, err = client.Lookup(context.Background(), "example.org")
+	a.IsTrue(err == rdaputils.ErrNoRDAPServer)
+}
@@ -0,0 +1,294 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package s3utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+)
+
+// DefaultRegion 没有设置区域时使用的区域
+const DefaultRegion = "us-east-1"
+
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// Object 对象信息
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// Client S3兼容的对象存储客户端
+// 只实现了备份和归档需要的几个接口，请求使用 AWS Signature Version 4 签名
+type Client struct {
+	config     *systemconfigs.S3StorageConfig
+	httpClient *http.Client
+
+	now func() time.Time
+}
+
+func NewClient(config *systemconfigs.S3StorageConfig) *Client {
+	return &Client{
+		config: config,
+		httpClient: &http.Client{
+			Timeout: 1 * time.Hour,
+		},
+		now: time.Now,
+	}
+}
+
+// PutObject 上传对象
+// 签名需要内容的哈希值，所以reader需要支持Seek
+func (this *Client) PutObject(key string, reader io.ReadSeeker, size int64) error {
+	var hash = sha256.New()
+	_, err := io.Copy(hash, reader)
+	if err != nil {
+		return err
+	}
+	_, err = reader.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	req, err := this.newRequest(http.MethodPut, key, nil, io.NopCloser(reader), hex.EncodeToString(hash.Sum(nil)))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	resp, err := this.do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// GetObject 下载对象
+// 调用者需要关闭返回的Reader
+func (this *Client) GetObject(key string) (io.ReadCloser, error) {
+	req, err := this.newRequest(http.MethodGet, key, nil, nil, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := this.do(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// DeleteObject 删除对象
+func (this *Client) DeleteObject(key string) error {
+	req, err := this.newRequest(http.MethodDelete, key, nil, nil, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	resp, err := this.do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// ListObjects 列出某个前缀下的所有对象
+func (this *Client) ListObjects(prefix string) ([]*Object, error) {
+	var result = []*Object{}
+	var continuationToken = ""
+	for {
+		var query = url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if len(continuationToken) > 0 {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		req, err := this.newRequest(http.MethodGet, "", query, nil, emptyPayloadHash)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := this.do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var listResult = &listBucketResult{}
+		err = xml.NewDecoder(resp.Body).Decode(listResult)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("decode list result failed: %w", err)
+		}
+		for _, content := range listResult.Contents {
+			lastModified, _ := time.Parse(time.RFC3339, content.LastModified)
+			result = append(result, &Object{
+				Key:          content.Key,
+				Size:         content.Size,
+				LastModified: lastModified,
+			})
+		}
+
+		if !listResult.IsTruncated || len(listResult.NextContinuationToken) == 0 {
+			break
+		}
+		continuationToken = listResult.NextContinuationToken
+	}
+	return result, nil
+}
+
+// 构造请求
+// key为空时表示对存储桶操作
+func (this *Client) newRequest(method string, key string, query url.Values, body io.ReadCloser, payloadHash string) (*http.Request, error) {
+	endpoint, err := url.Parse(this.config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint '%s': %w", this.config.Endpoint, err)
+	}
+	if len(endpoint.Scheme) == 0 || len(endpoint.Host) == 0 {
+		return nil, errors.New("invalid endpoint '" + this.config.Endpoint + "'")
+	}
+
+	var host = endpoint.Host
+	var path = strings.TrimRight(endpoint.Path, "/")
+	if this.config.UsePathStyle {
+		path += "/" + this.config.Bucket
+	} else {
+		host = this.config.Bucket + "." + host
+	}
+	path += "/" + key
+
+	var canonicalURI = uriEncode(path, false)
+	var rawURL = endpoint.Scheme + "://" + host + canonicalURI
+	if len(query) > 0 {
+		rawURL += "?" + canonicalQuery(query)
+	}
+	req, err := http.NewRequest(method, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
+	this.sign(req, canonicalURI, payloadHash)
+	return req, nil
+}
+
+func (this *Client) do(req *http.Request) (*http.Response, error) {
+	resp, err := this.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		_ = resp.Body.Close()
+
+		var errorResult = &errorResponse{}
+		if xml.Unmarshal(data, errorResult) == nil && len(errorResult.Code) > 0 {
+			return nil, fmt.Errorf("s3 %s '%s' failed: %s: %s", req.Method, req.URL.Path, errorResult.Code, errorResult.Message)
+		}
+		return nil, fmt.Errorf("s3 %s '%s' failed: status code %d", req.Method, req.URL.Path, resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// 使用 AWS Signature Version 4 签名
+func (this *Client) sign(req *http.Request, canonicalURI string, payloadHash string) {
+	var now = this.now().UTC()
+	var amzDate = now.Format("20060102T150405Z")
+	var date = now.Format("20060102")
+	var region = this.config.Region
+	if len(region) == 0 {
+		region = DefaultRegion
+	}
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	var signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	var canonicalRequest = strings.Join([]string{
+		req.Method,
+		canonicalURI,
+		req.URL.RawQuery,
+		"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + payloadHash + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	var scope = date + "/" + region + "/s3/aws4_request"
+	var stringToSign = "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	var signingKey = hmacSHA256([]byte("AWS4"+this.config.AccessKeySecret), date)
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	var signature = hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+this.config.AccessKeyId+"/"+scope+", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string `xml:"Key"`
+		Size         int64  `xml:"Size"`
+		LastModified string `xml:"LastModified"`
+	} `xml:"Contents"`
+}
+
+type errorResponse struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// 按照参数名排序后的查询参数
+func canonicalQuery(query url.Values) string {
+	var keys = []string{}
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pieces = []string{}
+	for _, key := range keys {
+		for _, value := range query[key] {
+			pieces = append(pieces, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(pieces, "&")
+}
+
+// 按照 RFC 3986 编码，除了 A-Z a-z 0-9 - _ . ~ 之外的字符都需要编码
+func uriEncode(s string, encodeSlash bool) string {
+	var builder = strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		var c = s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			builder.WriteByte(c)
+		} else {
+			builder.WriteString(fmt.Sprintf("%%%02X", c))
+		}
+	}
+	return builder.String()
+}
+
+func sha256Hex(data []byte) string {
+	var sum = sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	var h = hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
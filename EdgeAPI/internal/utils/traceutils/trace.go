@@ -0,0 +1,52 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package traceutils
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+)
+
+// MetadataKey 在GRPC元数据中传递跟踪ID的键名
+const MetadataKey = "x-edge-trace-id"
+
+// HTTPHeader 在HTTP请求和响应中传递跟踪ID的报头
+const HTTPHeader = "X-Edge-Trace-Id"
+
+// MaxTraceIdLength 跟踪ID最大长度
+const MaxTraceIdLength = 64
+
+var traceIdReg = regexp.MustCompile(`^[\w.-]+$`)
+
+type contextKey struct{}
+
+// NewTraceId 生成新的跟踪ID
+func NewTraceId() string {
+	var b = make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// IsValidTraceId 检查客户端传入的跟踪ID是否合法
+func IsValidTraceId(traceId string) bool {
+	return len(traceId) > 0 && len(traceId) <= MaxTraceIdLength && traceIdReg.MatchString(traceId)
+}
+
+// WithTraceId 在上下文中设置跟踪ID
+func WithTraceId(ctx context.Context, traceId string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, contextKey{}, traceId)
+}
+
+// TraceId 从上下文中读取跟踪ID，没有设置时返回空字符串
+func TraceId(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	traceId, _ := ctx.Value(contextKey{}).(string)
+	return traceId
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package traceutils_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/traceutils"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestNewTraceId(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var traceId1 = traceutils.NewTraceId()
+	var traceId2 = traceutils.NewTraceId()
+	t.Log(traceId1, traceId2)
+	a.IsTrue(len(traceId1) == 16)
+	a.IsTrue(traceId1 != traceId2)
+	a.IsTrue(traceutils.IsValidTraceId(traceId1))
+}
+
+func TestIsValidTraceId(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsTrue(traceutils.IsValidTraceId("abc-123_DEF.4"))
+	a.IsFalse(traceutils.IsValidTraceId(""))
+	a.IsFalse(traceutils.IsValidTraceId("abc 123"))
+	a.IsFalse(traceutils.IsValidTraceId("abc\n123"))
+	a.IsFalse(traceutils.IsValidTraceId(strings.Repeat("a", traceutils.MaxTraceIdLength+1)))
+}
+
+func TestWithTraceId(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsTrue(traceutils.TraceId(nil) == "")
+	a.IsTrue(traceutils.TraceId(context.Background()) == "")
+
+	var ctx = traceutils.WithTraceId(context.Background(), "abc")
+	a.IsTrue(traceutils.TraceId(ctx) == "abc")
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	a.IsTrue(traceutils.TraceId(ctx) == "abc")
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package validators
+
+import (
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/firewallconfigs"
+)
+
+var firewallNamedParamReg = regexp.MustCompile(`^\${\s*(.+)\s*}$`)
+
+// ValidateHTTPFirewallRuleSet 校验WAF规则集
+func ValidateHTTPFirewallRuleSet(set *firewallconfigs.HTTPFirewallRuleSet) Diagnostics {
+	var diagnostics = Diagnostics{}
+	if set == nil {
+		diagnostics.addError("", "配置不能为空")
+		return diagnostics
+	}
+
+	if len(strings.TrimSpace(set.Name)) == 0 {
+		diagnostics.addError("name", "请输入规则集名称")
+	}
+
+	switch set.Connector {
+	case "", firewallconfigs.HTTPFirewallRuleConnectorAnd, firewallconfigs.HTTPFirewallRuleConnectorOr:
+	default:
+		diagnostics.addError("connector", "规则之间的关系只能是and或or")
+	}
+
+	if len(set.Rules) == 0 {
+		diagnostics.addError("rules", "请至少添加一个规则")
+	}
+	for index, rule := range set.Rules {
+		diagnostics = append(diagnostics, ValidateHTTPFirewallRule(rule, "rules["+strconv.Itoa(index)+"]")...)
+	}
+
+	if len(set.Actions) == 0 {
+		diagnostics.addWarning("actions", "没有设置动作，匹配后不会执行任何操作")
+	}
+	for index, action := range set.Actions {
+		var path = "actions[" + strconv.Itoa(index) + "]"
+		if action == nil || len(action.Code) == 0 {
+			diagnostics.addError(path, "请选择动作")
+			continue
+		}
+		if firewallconfigs.FindActionDefinition(action.Code) == nil {
+			diagnostics.addError(joinPath(path, "code"), "不支持的动作'"+action.Code+"'")
+		}
+	}
+
+	return diagnostics
+}
+
+// ValidateHTTPFirewallRule 校验WAF规则
+// pathPrefix 为规则在上级配置中的路径，比如 rules[0]
+func ValidateHTTPFirewallRule(rule *firewallconfigs.HTTPFirewallRule, pathPrefix string) Diagnostics {
+	var diagnostics = Diagnostics{}
+	if rule == nil {
+		diagnostics.addError(pathPrefix, "配置不能为空")
+		return diagnostics
+	}
+
+	// 参数
+	var paramPath = joinPath(pathPrefix, "param")
+	if len(rule.Param) == 0 {
+		diagnostics.addError(paramPath, "请选择参数")
+	} else if !firewallNamedParamReg.MatchString(rule.Param) {
+		diagnostics.addError(paramPath, "参数格式应该为${参数名}")
+	} else if firewallconfigs.FindCheckpointDefinition(rule.Prefix()) == nil {
+		diagnostics.addWarning(paramPath, "未知的参数'"+rule.Param+"'")
+	}
+
+	// 操作符
+	var operatorPath = joinPath(pathPrefix, "operator")
+	var valuePath = joinPath(pathPrefix, "value")
+	if len(rule.Operator) == 0 {
+		diagnostics.addError(operatorPath, "请选择操作符")
+		return diagnostics
+	}
+	if len(firewallconfigs.FindRuleOperatorName(rule.Operator)) == 0 {
+		diagnostics.addError(operatorPath, "不支持的操作符'"+rule.Operator+"'")
+		return diagnostics
+	}
+
+	// 对比值
+	switch rule.Operator {
+	case firewallconfigs.HTTPFirewallRuleOperatorMatch, firewallconfigs.HTTPFirewallRuleOperatorNotMatch:
+		var expr = rule.Value
+		if rule.IsCaseInsensitive && !strings.HasPrefix(expr, "(?i)") {
+			expr = "(?i)" + expr
+		}
+		_, err := regexp.Compile(expr)
+		if err != nil {
+			diagnostics.addError(valuePath, "不是正确的正则表达式："+err.Error())
+		}
+	case firewallconfigs.HTTPFirewallRuleOperatorGt,
+		firewallconfigs.HTTPFirewallRuleOperatorGte,
+		firewallconfigs.HTTPFirewallRuleOperatorLt,
+		firewallconfigs.HTTPFirewallRuleOperatorLte,
+		firewallconfigs.HTTPFirewallRuleOperatorEq,
+		firewallconfigs.HTTPFirewallRuleOperatorNeq:
+		_, err := strconv.ParseFloat(strings.TrimSpace(rule.Value), 64)
+		if err != nil {
+			diagnostics.addWarning(valuePath, "对比值不是数字，将被当做0处理")
+		}
+	case firewallconfigs.HTTPFirewallRuleOperatorEqIP,
+		firewallconfigs.HTTPFirewallRuleOperatorGtIP,
+		firewallconfigs.HTTPFirewallRuleOperatorGteIP,
+		firewallconfigs.HTTPFirewallRuleOperatorLtIP,
+		firewallconfigs.HTTPFirewallRuleOperatorLteIP:
+		if net.ParseIP(strings.TrimSpace(rule.Value)) == nil {
+			diagnostics.addError(valuePath, "对比值'"+rule.Value+"'不是正确的IP地址")
+		}
+	}
+
+	return diagnostics
+}
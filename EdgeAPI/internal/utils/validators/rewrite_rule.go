@@ -0,0 +1,73 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package validators
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+)
+
+var rewriteBackReferenceReg = regexp.MustCompile(`\$\{(\d+)}`)
+
+// ValidateHTTPRewriteRule 校验重写规则
+func ValidateHTTPRewriteRule(rule *serverconfigs.HTTPRewriteRule) Diagnostics {
+	var diagnostics = Diagnostics{}
+	if rule == nil {
+		diagnostics.addError("", "配置不能为空")
+		return diagnostics
+	}
+
+	// 匹配规则
+	var countSubexp = -1
+	if len(rule.Pattern) == 0 {
+		diagnostics.addError("pattern", "请输入匹配规则")
+	} else {
+		reg, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			diagnostics.addError("pattern", "匹配规则不是正确的正则表达式："+err.Error())
+		} else {
+			countSubexp = reg.NumSubexp()
+		}
+	}
+
+	// 目标URL
+	if len(rule.Replace) == 0 {
+		diagnostics.addError("replace", "请输入目标URL")
+	} else if countSubexp >= 0 {
+		for _, match := range rewriteBackReferenceReg.FindAllStringSubmatch(rule.Replace, -1) {
+			index, _ := strconv.Atoi(match[1])
+			if index > countSubexp {
+				diagnostics.addWarning("replace", "匹配规则中没有第"+match[1]+"个分组，"+match[0]+"将被替换为空")
+			}
+		}
+	}
+
+	// 模式
+	switch rule.Mode {
+	case "", serverconfigs.HTTPRewriteModeProxy:
+		if strings.Contains(rule.ProxyHost, "://") {
+			diagnostics.addWarning("proxyHost", "回源主机名不需要包含协议")
+		}
+	case serverconfigs.HTTPRewriteModeRedirect:
+		switch rule.RedirectStatus {
+		case 0, 301, 302, 303, 307, 308:
+		default:
+			diagnostics.addError("redirectStatus", "跳转状态码只能是301、302、303、307或308")
+		}
+	default:
+		diagnostics.addError("mode", "不支持的模式'"+rule.Mode+"'")
+	}
+
+	// 匹配条件
+	if rule.Conds != nil {
+		err := rule.Conds.Init()
+		if err != nil {
+			diagnostics.addError("conds", "匹配条件错误："+err.Error())
+		}
+	}
+
+	return diagnostics
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package validators
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/firewallconfigs"
+)
+
+// ConfigKind 要校验的配置类型
+type ConfigKind = string
+
+const (
+	ConfigKindHTTPRewriteRule     ConfigKind = "httpRewriteRule"     // 重写规则
+	ConfigKindHTTPFirewallRuleSet ConfigKind = "httpFirewallRuleSet" // WAF规则集
+	ConfigKindHTTPFirewallRule    ConfigKind = "httpFirewallRule"    // WAF规则
+)
+
+type DiagnosticLevel = string
+
+const (
+	DiagnosticLevelError   DiagnosticLevel = "error"   // 错误，无法保存
+	DiagnosticLevelWarning DiagnosticLevel = "warning" // 警告，可以保存
+)
+
+// Diagnostic 校验结果
+type Diagnostic struct {
+	Path    string          `json:"path"`    // 字段路径，比如 rules[0].value
+	Level   DiagnosticLevel `json:"level"`   // 级别
+	Message string          `json:"message"` // 提示信息
+}
+
+// Key 用于比较两次校验结果的唯一标识
+func (this *Diagnostic) Key() string {
+	return this.Level + "@" + this.Path + "@" + this.Message
+}
+
+// Diagnostics 一组校验结果
+type Diagnostics []*Diagnostic
+
+func (this *Diagnostics) addError(path string, message string) {
+	*this = append(*this, &Diagnostic{
+		Path:    path,
+		Level:   DiagnosticLevelError,
+		Message: message,
+	})
+}
+
+func (this *Diagnostics) addWarning(path string, message string) {
+	*this = append(*this, &Diagnostic{
+		Path:    path,
+		Level:   DiagnosticLevelWarning,
+		Message: message,
+	})
+}
+
+// HasErrors 是否有错误
+func (this Diagnostics) HasErrors() bool {
+	for _, diagnostic := range this {
+		if diagnostic.Level == DiagnosticLevelError {
+			return true
+		}
+	}
+	return false
+}
+
+// AsError 将错误转换为error，用于保存时的校验，没有错误时返回nil
+func (this Diagnostics) AsError() error {
+	var messages = []string{}
+	for _, diagnostic := range this {
+		if diagnostic.Level != DiagnosticLevelError {
+			continue
+		}
+		if len(diagnostic.Path) > 0 {
+			messages = append(messages, diagnostic.Path+": "+diagnostic.Message)
+		} else {
+			messages = append(messages, diagnostic.Message)
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return errors.New("validate config failed: " + strings.Join(messages, "; "))
+}
+
+// ValidateConfigJSON 校验某个类型的配置
+func ValidateConfigJSON(kind ConfigKind, configJSON []byte) (Diagnostics, error) {
+	var diagnostics = Diagnostics{}
+	if len(configJSON) == 0 {
+		diagnostics.addError("", "配置不能为空")
+		return diagnostics, nil
+	}
+
+	switch kind {
+	case ConfigKindHTTPRewriteRule:
+		var rule = &serverconfigs.HTTPRewriteRule{}
+		err := json.Unmarshal(configJSON, rule)
+		if err != nil {
+			diagnostics.addError("", "配置格式错误："+err.Error())
+			return diagnostics, nil
+		}
+		return ValidateHTTPRewriteRule(rule), nil
+	case ConfigKindHTTPFirewallRuleSet:
+		var set = &firewallconfigs.HTTPFirewallRuleSet{}
+		err := json.Unmarshal(configJSON, set)
+		if err != nil {
+			diagnostics.addError("", "配置格式错误："+err.Error())
+			return diagnostics, nil
+		}
+		return ValidateHTTPFirewallRuleSet(set), nil
+	case ConfigKindHTTPFirewallRule:
+		var rule = &firewallconfigs.HTTPFirewallRule{}
+		err := json.Unmarshal(configJSON, rule)
+		if err != nil {
+			diagnostics.addError("", "配置格式错误："+err.Error())
+			return diagnostics, nil
+		}
+		return ValidateHTTPFirewallRule(rule, ""), nil
+	}
+	return nil, errors.New("unsupported config kind '" + kind + "'")
+}
+
+// DiffDiagnostics 对比两次校验结果，返回新增的和已解决的
+func DiffDiagnostics(oldDiagnostics Diagnostics, newDiagnostics Diagnostics) (added Diagnostics, resolved Diagnostics) {
+	var oldMap = map[string]bool{}
+	for _, diagnostic := range oldDiagnostics {
+		oldMap[diagnostic.Key()] = true
+	}
+	var newMap = map[string]bool{}
+	for _, diagnostic := range newDiagnostics {
+		newMap[diagnostic.Key()] = true
+		if !oldMap[diagnostic.Key()] {
+			added = append(added, diagnostic)
+		}
+	}
+	for _, diagnostic := range oldDiagnostics {
+		if !newMap[diagnostic.Key()] {
+			resolved = append(resolved, diagnostic)
+		}
+	}
+	return
+}
+
+func joinPath(prefix string, field string) string {
+	if len(prefix) == 0 {
+		return field
+	}
+	return prefix + "." + field
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/utils/validators"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestValidateConfigJSON_HTTPRewriteRule(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	{
+		diagnostics, err := validators.ValidateConfigJSON(validators.ConfigKindHTTPRewriteRule, []byte(`{"pattern":"^/article/(\\d+).html","replace":"/a/${1}","mode":"redirect","redirectStatus":301}`))
+		a.IsNil(err)
+		a.IsTrue(len(diagnostics) == 0)
+	}
+	{
+		diagnostics, err := validators.ValidateConfigJSON(validators.ConfigKindHTTPRewriteRule, []byte(`{"pattern":"^/article/(\\d+.html","replace":"/a/${1}","mode":"redirect","redirectStatus":200}`))
+		a.IsNil(err)
+		for _, diagnostic := range diagnostics {
+			t.Logf("%+v", diagnostic)
+		}
+		a.IsTrue(len(diagnostics) == 2)
+		a.IsTrue(diagnostics.HasErrors())
+		a.IsNotNil(diagnostics.AsError())
+	}
+	{
+		// 不存在的分组只是警告
+		diagnostics, err := validators.ValidateConfigJSON(validators.ConfigKindHTTPRewriteRule, []byte(`{"pattern":"^/a","replace":"/b/${2}"}`))
+		a.IsNil(err)
+		a.IsTrue(len(diagnostics) == 1)
+		a.IsFalse(diagnostics.HasErrors())
+		a.IsNil(diagnostics.AsError())
+	}
+	{
+		_, err := validators.ValidateConfigJSON("unknown", []byte(`{}`))
+		a.IsNotNil(err)
+	}
+}
+
+func TestValidateConfigJSON_HTTPFirewallRuleSet(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	diagnostics, err := validators.ValidateConfigJSON(validators.ConfigKindHTTPFirewallRuleSet, []byte(`{
+	"name": "test",
+	"connector": "or",
+	"rules": [
+		{"param": "${requestPath}", "operator": "match", "value": "(abc"},
+		{"param": "${remoteAddr}", "operator": "eq ip", "value": "1.2.3"},
+		{"param": "${requestPath}", "operator": "unknown", "value": ""},
+		{"param": "${requestLength}", "operator": "gt", "value": "1024"}
+	],
+	"actions": [{"code": "block"}]
+}`))
+	a.IsNil(err)
+	for _, diagnostic := range diagnostics {
+		t.Logf("%+v", diagnostic)
+	}
+	a.IsTrue(len(diagnostics) == 3)
+	a.IsTrue(diagnostics[0].Path == "rules[0].value")
+	a.IsTrue(diagnostics[1].Path == "rules[1].value")
+	a.IsTrue(diagnostics[2].Path == "rules[2].operator")
+}
+
+func TestDiffDiagnostics(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	oldDiagnostics, _ := validators.ValidateConfigJSON(validators.ConfigKindHTTPRewriteRule, []byte(`{"pattern":"(","replace":""}`))
+	newDiagnostics, _ := validators.ValidateConfigJSON(validators.ConfigKindHTTPRewriteRule, []byte(`{"pattern":"(","replace":"/a","mode":"abc"}`))
+	added, resolved := validators.DiffDiagnostics(oldDiagnostics, newDiagnostics)
+	a.IsTrue(len(added) == 1 && added[0].Path == "mode")
+	a.IsTrue(len(resolved) == 1 && resolved[0].Path == "replace")
+}
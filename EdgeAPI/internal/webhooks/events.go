@@ -0,0 +1,66 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package webhooks
+
+type Event = string
+
+const (
+	EventAll           Event = "*"               // 所有事件
+	EventPing          Event = "ping"            // 测试
+	EventNodeOffline   Event = "node.offline"    // 边缘节点离线
+	EventSSLCertIssued Event = "sslCert.issued"  // ACME任务签发证书成功
+	EventACMEFailed    Event = "acmeTask.failed" // ACME任务执行失败
+	EventDNSChanged    Event = "dns.changed"     // DNS记录同步完成
+	EventServerCreated Event = "server.created"  // 创建网站
+)
+
+// EventDefinition 事件定义
+type EventDefinition struct {
+	Code        Event  `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// FindAllEvents 所有可以订阅的事件
+func FindAllEvents() []*EventDefinition {
+	return []*EventDefinition{
+		{
+			Code:        EventNodeOffline,
+			Name:        "节点离线",
+			Description: "边缘节点连续多次检测处于离线状态。",
+		},
+		{
+			Code:        EventSSLCertIssued,
+			Name:        "证书签发成功",
+			Description: "ACME任务成功签发或者续期了证书。",
+		},
+		{
+			Code:        EventACMEFailed,
+			Name:        "证书签发失败",
+			Description: "ACME任务执行失败。",
+		},
+		{
+			Code:        EventDNSChanged,
+			Name:        "DNS变更",
+			Description: "集群或者网站的DNS记录已同步到DNS服务商。",
+		},
+		{
+			Code:        EventServerCreated,
+			Name:        "网站创建",
+			Description: "创建了新的网站。",
+		},
+	}
+}
+
+// IsValidEvent 检查事件代号是否正确
+func IsValidEvent(event Event) bool {
+	if event == EventAll {
+		return true
+	}
+	for _, def := range FindAllEvents() {
+		if def.Code == event {
+			return true
+		}
+	}
+	return false
+}
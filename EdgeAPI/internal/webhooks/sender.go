@@ -0,0 +1,95 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	teaconst "github.com/TeaOSLab/EdgeAPI/internal/const"
+)
+
+const (
+	HeaderEvent     = "X-Edge-Event"     // 事件代号
+	HeaderDelivery  = "X-Edge-Delivery"  // 投递ID
+	HeaderSignature = "X-Edge-Signature" // 请求内容签名：sha256=HEX(HMAC-SHA256(secret, body))
+)
+
+const MaxAttempts = 8 // 最多投递次数
+
+var httpClient = &http.Client{
+	Timeout: 15 * time.Second,
+}
+
+// ValidateURL 检查接收事件的URL
+func ValidateURL(rawURL string) error {
+	if len(rawURL) == 0 {
+		return errors.New("'url' should not be empty")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return errors.New("invalid url '" + rawURL + "'")
+	}
+	return nil
+}
+
+// Sign 计算请求内容签名
+func Sign(secret string, body []byte) string {
+	var h = hmac.New(sha256.New, []byte(secret))
+	h.Write(body)
+	return "sha256=" + hex.EncodeToString(h.Sum(nil))
+}
+
+// Send 发送事件
+// 只有返回2xx状态码才认为投递成功
+func Send(rawURL string, secret string, event Event, deliveryId int64, body []byte) (statusCode int, err error) {
+	req, err := http.NewRequest(http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", teaconst.GlobalProductName+"/"+teaconst.Version)
+	req.Header.Set(HeaderEvent, event)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(deliveryId, 10))
+	if len(secret) > 0 {
+		req.Header.Set(HeaderSignature, Sign(secret, body))
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respData, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, errors.New("invalid response status '" + resp.Status + "': " + string(respData))
+	}
+	return resp.StatusCode, nil
+}
+
+// NextRetryDelay 第N次投递失败后，下一次重试前需要等待的时间
+// 从30秒开始依次翻倍，最长不超过6小时
+func NextRetryDelay(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	var delay = 30 * time.Second
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= 6*time.Hour {
+			return 6 * time.Hour
+		}
+	}
+	return delay
+}
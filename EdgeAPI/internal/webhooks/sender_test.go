@@ -0,0 +1,64 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package webhooks_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAPI/internal/webhooks"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestSend(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var body = []byte(`{"event":"ping"}`)
+	var server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		data, _ := io.ReadAll(req.Body)
+		if req.Header.Get(webhooks.HeaderEvent) != webhooks.EventPing ||
+			req.Header.Get(webhooks.HeaderDelivery) != "123" ||
+			req.Header.Get(webhooks.HeaderSignature) != webhooks.Sign("secret", data) {
+			writer.WriteHeader(http.StatusForbidden)
+			return
+		}
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	statusCode, err := webhooks.Send(server.URL, "secret", webhooks.EventPing, 123, body)
+	a.IsTrue(err == nil)
+	a.IsTrue(statusCode == http.StatusNoContent)
+
+	statusCode, err = webhooks.Send(server.URL, "wrong", webhooks.EventPing, 123, body)
+	a.IsTrue(err != nil)
+	a.IsTrue(statusCode == http.StatusForbidden)
+}
+
+func TestSign(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(webhooks.Sign("key", []byte("The quick brown fox jumps over the lazy dog")) == "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8")
+}
+
+func TestNextRetryDelay(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(webhooks.NextRetryDelay(0) == 30*time.Second)
+	a.IsTrue(webhooks.NextRetryDelay(1) == 30*time.Second)
+	a.IsTrue(webhooks.NextRetryDelay(2) == 1*time.Minute)
+	a.IsTrue(webhooks.NextRetryDelay(5) == 8*time.Minute)
+	a.IsTrue(webhooks.NextRetryDelay(100) == 6*time.Hour)
+}
+
+func TestValidateURL(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(webhooks.ValidateURL("https://example.com/hook") == nil)
+	a.IsTrue(webhooks.ValidateURL("") != nil)
+	a.IsTrue(webhooks.ValidateURL("ftp://example.com") != nil)
+	a.IsTrue(webhooks.ValidateURL("/hook") != nil)
+	a.IsTrue(webhooks.IsValidEvent(webhooks.EventNodeOffline))
+	a.IsTrue(webhooks.IsValidEvent(webhooks.EventAll))
+	a.IsFalse(webhooks.IsValidEvent("unknown"))
+}
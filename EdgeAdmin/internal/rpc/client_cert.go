@@ -0,0 +1,174 @@
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/Tea"
+)
+
+const (
+	ClientCertFileName = "api_client.pem" // API节点签发的客户端证书
+	ClientKeyFileName  = "api_client.key" // 客户端证书私钥
+)
+
+// SharedClientCertManager 双向TLS认证使用的客户端证书
+var SharedClientCertManager = NewClientCertManager(Tea.ConfigFile(ClientCertFileName), Tea.ConfigFile(ClientKeyFileName))
+
+// ClientCertManager 客户端证书管理器
+type ClientCertManager struct {
+	certFile string
+	keyFile  string
+
+	cert     *tls.Certificate
+	isLoaded bool
+
+	locker sync.RWMutex
+}
+
+func NewClientCertManager(certFile string, keyFile string) *ClientCertManager {
+	return &ClientCertManager{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+}
+
+// GetClientCertificate 在TLS握手时提供客户端证书
+// 没有证书时返回空证书，由API节点决定是否允许连接
+func (this *ClientCertManager) GetClientCertificate(info *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	var cert = this.Cert()
+	if cert == nil {
+		return &tls.Certificate{}, nil
+	}
+	return cert, nil
+}
+
+// Cert 当前的客户端证书
+func (this *ClientCertManager) Cert() *tls.Certificate {
+	this.locker.RLock()
+	if this.isLoaded {
+		var cert = this.cert
+		this.locker.RUnlock()
+		return cert
+	}
+	this.locker.RUnlock()
+
+	this.locker.Lock()
+	defer this.locker.Unlock()
+	if !this.isLoaded {
+		this.isLoaded = true
+		cert, err := this.loadFiles()
+		if err == nil {
+			this.cert = cert
+		}
+	}
+	return this.cert
+}
+
+// NeedRenew 检查是否需要重新申请证书
+// 证书不存在、不属于当前节点或者剩余有效期不足三分之一时需要重新申请
+func (this *ClientCertManager) NeedRenew(nodeId string) bool {
+	var cert = this.Cert()
+	if cert == nil || cert.Leaf == nil {
+		return true
+	}
+	var leaf = cert.Leaf
+	if leaf.Subject.CommonName != nodeId {
+		return true
+	}
+	var lifetime = leaf.NotAfter.Sub(leaf.NotBefore)
+	return time.Until(leaf.NotAfter) < lifetime/3
+}
+
+// Renew 生成新的私钥，并向API节点申请客户端证书
+func (this *ClientCertManager) Renew(client *RPCClient) error {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, privateKey)
+	if err != nil {
+		return err
+	}
+	var csrData = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	resp, err := client.InternalCARPC().IssueNodeClientCert(client.Context(0), &pb.IssueNodeClientCertRequest{CsrData: csrData})
+	if err != nil {
+		return err
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return err
+	}
+	var keyData = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+
+	cert, err := parseClientCert(resp.CertData, keyData)
+	if err != nil {
+		return err
+	}
+
+	// 先写私钥，再写证书
+	err = writeFileAtomic(this.keyFile, keyData, 0600)
+	if err != nil {
+		return err
+	}
+	err = writeFileAtomic(this.certFile, resp.CertData, 0644)
+	if err != nil {
+		return err
+	}
+
+	this.locker.Lock()
+	this.cert = cert
+	this.isLoaded = true
+	this.locker.Unlock()
+
+	return nil
+}
+
+// 从文件中加载证书
+func (this *ClientCertManager) loadFiles() (*tls.Certificate, error) {
+	certData, err := os.ReadFile(this.certFile)
+	if err != nil {
+		return nil, err
+	}
+	keyData, err := os.ReadFile(this.keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return parseClientCert(certData, keyData)
+}
+
+func parseClientCert(certData []byte, keyData []byte) (*tls.Certificate, error) {
+	pair, err := tls.X509KeyPair(certData, keyData)
+	if err != nil {
+		return nil, err
+	}
+	if len(pair.Certificate) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	pair.Leaf = leaf
+	return &pair, nil
+}
+
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	var tmpPath = path + ".tmp"
+	err := os.WriteFile(tmpPath, data, perm)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -16,6 +16,7 @@ import (
 	teaconst "github.com/TeaOSLab/EdgeAdmin/internal/const"
 	"github.com/TeaOSLab/EdgeAdmin/internal/encrypt"
 	"github.com/TeaOSLab/EdgeAdmin/internal/utils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/apiversions"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/dao"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/iwind/TeaGo/lists"
@@ -253,6 +254,14 @@ func (this *RPCClient) HTTPRewriteRuleRPC() pb.HTTPRewriteRuleServiceClient {
 	return pb.NewHTTPRewriteRuleServiceClient(this.pickConn())
 }
 
+func (this *RPCClient) ConfigValidationRPC() pb.ConfigValidationServiceClient {
+	return pb.NewConfigValidationServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) DependencyGraphRPC() pb.DependencyGraphServiceClient {
+	return pb.NewDependencyGraphServiceClient(this.pickConn())
+}
+
 // HTTPAccessLogRPC 访问日志
 func (this *RPCClient) HTTPAccessLogRPC() pb.HTTPAccessLogServiceClient {
 	return pb.NewHTTPAccessLogServiceClient(this.pickConn())
@@ -270,6 +279,10 @@ func (this *RPCClient) SSLCertRPC() pb.SSLCertServiceClient {
 	return pb.NewSSLCertServiceClient(this.pickConn())
 }
 
+func (this *RPCClient) SSLCertDeployTargetRPC() pb.SSLCertDeployTargetServiceClient {
+	return pb.NewSSLCertDeployTargetServiceClient(this.pickConn())
+}
+
 func (this *RPCClient) SSLPolicyRPC() pb.SSLPolicyServiceClient {
 	return pb.NewSSLPolicyServiceClient(this.pickConn())
 }
@@ -378,6 +391,54 @@ func (this *RPCClient) UserIdentityRPC() pb.UserIdentityServiceClient {
 	return pb.NewUserIdentityServiceClient(this.pickConn())
 }
 
+func (this *RPCClient) WebhookRPC() pb.WebhookServiceClient {
+	return pb.NewWebhookServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) AuditLogRPC() pb.AuditLogServiceClient {
+	return pb.NewAuditLogServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) StatQueryRPC() pb.StatQueryServiceClient {
+	return pb.NewStatQueryServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) ConfigBundleRPC() pb.ConfigBundleServiceClient {
+	return pb.NewConfigBundleServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) QuotaRPC() pb.QuotaServiceClient {
+	return pb.NewQuotaServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) TaskRPC() pb.TaskServiceClient {
+	return pb.NewTaskServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) NodeCommandRPC() pb.NodeCommandServiceClient {
+	return pb.NewNodeCommandServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) SearchRPC() pb.SearchServiceClient {
+	return pb.NewSearchServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) InternalCARPC() pb.InternalCAServiceClient {
+	return pb.NewInternalCAServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) BackupRPC() pb.BackupServiceClient {
+	return pb.NewBackupServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) AccessLogArchiveRPC() pb.AccessLogArchiveServiceClient {
+	return pb.NewAccessLogArchiveServiceClient(this.pickConn())
+}
+
+func (this *RPCClient) ServerBulkUpdateRPC() pb.ServerBulkUpdateServiceClient {
+	return pb.NewServerBulkUpdateServiceClient(this.pickConn())
+}
+
 func (this *RPCClient) LoginRPC() pb.LoginServiceClient {
 	return pb.NewLoginServiceClient(this.pickConn())
 }
@@ -449,7 +510,7 @@ func (this *RPCClient) Context(adminId int64) context.Context {
 		return context.Background()
 	}
 	var token = base64.StdEncoding.EncodeToString(data)
-	ctx = metadata.AppendToOutgoingContext(ctx, "nodeId", this.apiConfig.NodeId, "token", token)
+	ctx = metadata.AppendToOutgoingContext(ctx, "nodeId", this.apiConfig.NodeId, "token", token, apiversions.MetadataKey, apiversions.Latest)
 	return ctx
 }
 
@@ -472,7 +533,7 @@ func (this *RPCClient) APIContext(apiNodeId int64) context.Context {
 		return context.Background()
 	}
 	var token = base64.StdEncoding.EncodeToString(data)
-	ctx = metadata.AppendToOutgoingContext(ctx, "nodeId", this.apiConfig.NodeId, "token", token)
+	ctx = metadata.AppendToOutgoingContext(ctx, "nodeId", this.apiConfig.NodeId, "token", token, apiversions.MetadataKey, apiversions.Latest)
 	return ctx
 }
 
@@ -527,7 +588,8 @@ func (this *RPCClient) init() error {
 			conn, err = grpc.Dial(apiHost, grpc.WithTransportCredentials(insecure.NewCredentials()), callOptions, keepaliveParams)
 		} else if u.Scheme == "https" {
 			conn, err = grpc.Dial(apiHost, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
-				InsecureSkipVerify: true,
+				InsecureSkipVerify:   true,
+				GetClientCertificate: SharedClientCertManager.GetClientCertificate,
 			})), callOptions, keepaliveParams)
 		} else {
 			return errors.New("parse endpoint failed: invalid scheme '" + u.Scheme + "'")
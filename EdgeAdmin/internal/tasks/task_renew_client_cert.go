@@ -0,0 +1,83 @@
+package tasks
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeAdmin/internal/configs"
+	teaconst "github.com/TeaOSLab/EdgeAdmin/internal/const"
+	"github.com/TeaOSLab/EdgeAdmin/internal/events"
+	"github.com/TeaOSLab/EdgeAdmin/internal/goman"
+	"github.com/TeaOSLab/EdgeAdmin/internal/rpc"
+	"github.com/TeaOSLab/EdgeAdmin/internal/setup"
+	"github.com/iwind/TeaGo/Tea"
+	"github.com/iwind/TeaGo/logs"
+)
+
+func init() {
+	events.On(events.EventStart, func() {
+		task := NewRenewClientCertTask()
+		goman.New(func() {
+			task.Start()
+		})
+	})
+}
+
+// RenewClientCertTask 客户端证书续期任务
+// 定期检查双向TLS认证使用的客户端证书，快过期时重新申请
+type RenewClientCertTask struct {
+}
+
+func NewRenewClientCertTask() *RenewClientCertTask {
+	return &RenewClientCertTask{}
+}
+
+func (this *RenewClientCertTask) Start() {
+	// 启动时先检查一次
+	this.loopAndLog()
+
+	ticker := time.NewTicker(6 * time.Hour)
+	if Tea.IsTesting() {
+		// 快速测试
+		ticker = time.NewTicker(1 * time.Minute)
+	}
+	for range ticker.C {
+		this.loopAndLog()
+	}
+}
+
+func (this *RenewClientCertTask) Loop() error {
+	// 如果还没有安装直接返回
+	if !setup.IsConfigured() || teaconst.IsRecoverMode {
+		return nil
+	}
+
+	config, err := configs.LoadAPIConfig()
+	if err != nil {
+		return err
+	}
+
+	if !rpc.SharedClientCertManager.NeedRenew(config.NodeId) {
+		return nil
+	}
+
+	rpcClient, err := rpc.SharedRPC()
+	if err != nil {
+		return err
+	}
+	err = rpc.SharedClientCertManager.Renew(rpcClient)
+	if err != nil {
+		// 旧版本的API节点不支持签发客户端证书
+		if rpc.IsUnimplementedError(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (this *RenewClientCertTask) loopAndLog() {
+	err := this.Loop()
+	if err != nil {
+		logs.Println("[TASK][RENEW_CLIENT_CERT]" + err.Error())
+	}
+}
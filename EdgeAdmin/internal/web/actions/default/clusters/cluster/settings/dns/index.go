@@ -3,6 +3,7 @@ package dns
 import (
 	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
 	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/default/dns/domains/domainutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/dnsconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/langs/codes"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/iwind/TeaGo/actions"
@@ -69,6 +70,14 @@ func (this *IndexAction) RunGet(params struct {
 	this.Data["cnameAsDomain"] = dnsInfoResp.CnameAsDomain
 	this.Data["includingLnNodes"] = dnsInfoResp.IncludingLnNodes
 
+	// 冲突处理策略
+	if len(dnsInfoResp.ConflictPolicy) == 0 {
+		this.Data["conflictPolicy"] = dnsconfigs.RecordConflictPolicyError
+	} else {
+		this.Data["conflictPolicy"] = dnsInfoResp.ConflictPolicy
+	}
+	this.Data["conflictPolicies"] = dnsconfigs.FindAllRecordConflictPolicies()
+
 	this.Show()
 }
 
@@ -83,6 +92,7 @@ func (this *IndexAction) RunPost(params struct {
 	Ttl              int32
 	CnameAsDomain    bool
 	IncludingLnNodes bool
+	ConflictPolicy   string
 
 	ConfirmResetDomain bool // 是否确认重置域名
 
@@ -132,6 +142,7 @@ func (this *IndexAction) RunPost(params struct {
 		Ttl:              params.Ttl,
 		CnameAsDomain:    params.CnameAsDomain,
 		IncludingLnNodes: params.IncludingLnNodes,
+		ConflictPolicy:   params.ConflictPolicy,
 	})
 	if err != nil {
 		this.ErrorPage(err)
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/dnsconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/iwind/TeaGo/lists"
@@ -13,6 +14,12 @@ import (
 
 // ValidateDomainFormat 校验域名格式
 func ValidateDomainFormat(domain string) bool {
+	// 国际化域名（IDN）转换为Punycode格式后再校验
+	domain, err := configutils.NormalizeDomain(domain)
+	if err != nil {
+		return false
+	}
+
 	pieces := strings.Split(domain, ".")
 	for _, piece := range pieces {
 		if piece == "-" ||
@@ -2,6 +2,7 @@ package providers
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
@@ -65,6 +66,37 @@ func (this *ProviderAction) RunGet(params struct {
 		"localEdgeDNS": localEdgeDNSMap,
 	}
 
+	// API调用统计
+	callStatsResp, err := this.RPC().DNSProviderRPC().FindDNSProviderCallStats(this.AdminContext(), &pb.FindDNSProviderCallStatsRequest{
+		DnsProviderId: params.ProviderId,
+		Days:          7,
+	})
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+	var callStatMaps = []maps.Map{}
+	for _, stat := range callStatsResp.DnsProviderCallStats {
+		var day = stat.Day
+		if len(day) == 8 {
+			day = day[:4] + "-" + day[4:6] + "-" + day[6:]
+		}
+		callStatMaps = append(callStatMaps, maps.Map{
+			"day":              day,
+			"countCalls":       stat.CountCalls,
+			"countFailedCalls": stat.CountFailedCalls,
+			"costMs":           fmt.Sprintf("%.2f", stat.CostMs),
+			"peekMs":           fmt.Sprintf("%.2f", stat.PeekMs),
+		})
+	}
+	this.Data["callStats"] = maps.Map{
+		"stats":              callStatMaps,
+		"maxCallsPerDay":     callStatsResp.MaxCallsPerDay,
+		"maxCallsPerSecond":  callStatsResp.MaxCallsPerSecond,
+		"countTodayCalls":    callStatsResp.CountTodayCalls,
+		"isApproachingQuota": callStatsResp.IsApproachingQuota,
+	}
+
 	// 域名数量
 	countDomainsResp, err := this.RPC().DNSDomainRPC().CountAllDNSDomainsWithDNSProviderId(this.AdminContext(), &pb.CountAllDNSDomainsWithDNSProviderIdRequest{
 		DnsProviderId: params.ProviderId,
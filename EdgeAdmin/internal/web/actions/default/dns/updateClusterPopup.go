@@ -3,6 +3,7 @@ package dns
 import (
 	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
 	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/default/dns/domains/domainutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/dnsconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/langs/codes"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/iwind/TeaGo/actions"
@@ -56,6 +57,14 @@ func (this *UpdateClusterPopupAction) RunGet(params struct {
 	this.Data["cnameAsDomain"] = dnsInfoResp.CnameAsDomain
 	this.Data["includingLnNodes"] = dnsInfoResp.IncludingLnNodes
 
+	// 冲突处理策略
+	if len(dnsInfoResp.ConflictPolicy) == 0 {
+		this.Data["conflictPolicy"] = dnsconfigs.RecordConflictPolicyError
+	} else {
+		this.Data["conflictPolicy"] = dnsInfoResp.ConflictPolicy
+	}
+	this.Data["conflictPolicies"] = dnsconfigs.FindAllRecordConflictPolicies()
+
 	// 所有服务商
 	providerTypesResp, err := this.RPC().DNSProviderRPC().FindAllDNSProviderTypes(this.AdminContext(), &pb.FindAllDNSProviderTypesRequest{})
 	if err != nil {
@@ -84,6 +93,7 @@ func (this *UpdateClusterPopupAction) RunPost(params struct {
 	Ttl              int32
 	CnameAsDomain    bool
 	IncludingLnNodes bool
+	ConflictPolicy   string
 
 	Must *actions.Must
 	CSRF *actionutils.CSRF
@@ -121,6 +131,7 @@ func (this *UpdateClusterPopupAction) RunPost(params struct {
 		Ttl:              params.Ttl,
 		CnameAsDomain:    params.CnameAsDomain,
 		IncludingLnNodes: params.IncludingLnNodes,
+		ConflictPolicy:   params.ConflictPolicy,
 	})
 	if err != nil {
 		this.ErrorPage(err)
@@ -38,6 +38,7 @@ func (this *CreatePopupAction) RunGet(params struct {
 			"description":    provider.Description,
 			"requireEAB":     provider.RequireEAB,
 			"eabDescription": provider.EabDescription,
+			"canGenerateEAB": provider.CanGenerateEAB,
 		})
 	}
 
@@ -51,6 +52,7 @@ func (this *CreatePopupAction) RunPost(params struct {
 	ProviderCode string
 	EabKid       string
 	EabKey       string
+	ApiKey       string
 
 	Must *actions.Must
 	CSRF *actionutils.CSRF
@@ -77,7 +79,7 @@ func (this *CreatePopupAction) RunPost(params struct {
 		this.Fail("请选择服务商")
 	}
 
-	if provider.RequireEAB {
+	if provider.RequireEAB && (!provider.CanGenerateEAB || len(params.ApiKey) == 0) {
 		params.Must.
 			Field("eabKid", params.EabKid).
 			Require("请输入EAB Kid").
@@ -90,6 +92,7 @@ func (this *CreatePopupAction) RunPost(params struct {
 		ProviderCode: params.ProviderCode,
 		EabKid:       params.EabKid,
 		EabKey:       params.EabKey,
+		ApiKey:       params.ApiKey,
 	})
 	if err != nil {
 		this.ErrorPage(err)
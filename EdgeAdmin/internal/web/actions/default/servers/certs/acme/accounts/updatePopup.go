@@ -41,6 +41,7 @@ func (this *UpdatePopupAction) RunGet(params struct {
 			"description":    account.AcmeProvider.Description,
 			"eabDescription": account.AcmeProvider.EabDescription,
 			"requireEAB":     account.AcmeProvider.RequireEAB,
+			"canGenerateEAB": account.AcmeProvider.CanGenerateEAB,
 		}
 	}
 
@@ -63,6 +64,7 @@ func (this *UpdatePopupAction) RunPost(params struct {
 	ProviderCode string
 	EabKid       string
 	EabKey       string
+	ApiKey       string
 
 	Must *actions.Must
 	CSRF *actionutils.CSRF
@@ -86,7 +88,7 @@ func (this *UpdatePopupAction) RunPost(params struct {
 		this.Fail("请选择服务商")
 	}
 
-	if provider.RequireEAB {
+	if provider.RequireEAB && (!provider.CanGenerateEAB || len(params.ApiKey) == 0) {
 		params.Must.
 			Field("eabKid", params.EabKid).
 			Require("请输入EAB Kid").
@@ -99,6 +101,7 @@ func (this *UpdatePopupAction) RunPost(params struct {
 		Name:                  params.Name,
 		EabKid:                params.EabKid,
 		EabKey:                params.EabKey,
+		ApiKey:                params.ApiKey,
 	})
 	if err != nil {
 		this.ErrorPage(err)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
 	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/default/dns/domains/domainutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/langs/codes"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/iwind/TeaGo/actions"
@@ -29,8 +30,9 @@ func (this *CreateAction) RunGet(params struct{}) {
 	var providerMaps = []maps.Map{}
 	for _, provider := range providersResp.AcmeProviders {
 		providerMaps = append(providerMaps, maps.Map{
-			"name": provider.Name,
-			"code": provider.Code,
+			"name":       provider.Name,
+			"code":       provider.Code,
+			"testAPIURL": provider.TestAPIURL,
 		})
 	}
 	this.Data["providers"] = providerMaps
@@ -54,6 +56,12 @@ func (this *CreateAction) RunGet(params struct{}) {
 	}
 	this.Data["dnsProviders"] = dnsProviderMaps
 
+	// 私钥类型
+	this.Data["keyTypes"] = findAllKeyTypes()
+
+	// 首选证书链
+	this.Data["preferredChains"] = findAllPreferredChains()
+
 	this.Show()
 }
 
@@ -65,8 +73,18 @@ func (this *CreateAction) RunPost(params struct {
 	DnsProviderId  int64
 	DnsDomain      string
 	Domains        []string
-	AutoRenew      bool
-	AuthURL        string
+
+	DnsAliasProviderId int64
+	DnsAliasDomain     string
+
+	AutoRenew bool
+	AuthURL   string
+	KeyType   string
+
+	PreferredChain string
+	DirectoryURL   string
+
+	IncludeWildcardApex bool
 
 	Must *actions.Must
 }) {
@@ -92,14 +110,29 @@ func (this *CreateAction) RunPost(params struct {
 		}
 	}
 
+	// DNS别名
+	var dnsAliasDomain = strings.Trim(strings.ToLower(params.DnsAliasDomain), ".")
+	if params.AuthType == "dns" && params.DnsAliasProviderId > 0 {
+		if len(dnsAliasDomain) == 0 {
+			this.Fail("请输入DNS别名域名")
+		}
+		if !domainutils.ValidateDomainFormat(dnsAliasDomain) {
+			this.Fail("请输入正确的DNS别名域名")
+		}
+	} else {
+		params.DnsAliasProviderId = 0
+		dnsAliasDomain = ""
+	}
+
 	if len(params.Domains) == 0 {
 		this.Fail("请输入证书域名列表")
 	}
 	var realDomains = []string{}
+	var normalizedDNSDomain = normalizeDomain(dnsDomain)
 	for _, domain := range params.Domains {
-		domain = strings.ToLower(domain)
+		domain = normalizeDomain(domain)
 		if params.AuthType == "dns" { // DNS认证
-			if !strings.HasSuffix(domain, "."+dnsDomain) && domain != dnsDomain {
+			if !strings.HasSuffix(domain, "."+normalizedDNSDomain) && domain != normalizedDNSDomain {
 				this.Fail("证书域名中的" + domain + "和顶级域名不一致")
 			}
 		} else if params.AuthType == "http" { // HTTP认证
@@ -120,7 +153,14 @@ func (this *CreateAction) RunPost(params struct {
 			Domains:       realDomains,
 			AutoRenew:     params.AutoRenew,
 			AuthURL:       params.AuthURL,
-			Async:         false,
+
+			DnsAliasProviderId:  params.DnsAliasProviderId,
+			DnsAliasDomain:      dnsAliasDomain,
+			KeyType:             params.KeyType,
+			PreferredChain:      params.PreferredChain,
+			DirectoryURL:        strings.TrimSpace(params.DirectoryURL),
+			IncludeWildcardApex: params.IncludeWildcardApex,
+			Async:               false,
 		})
 		if err != nil {
 			this.ErrorPage(err)
@@ -137,6 +177,13 @@ func (this *CreateAction) RunPost(params struct {
 			Domains:       realDomains,
 			AutoRenew:     params.AutoRenew,
 			AuthURL:       params.AuthURL,
+
+			DnsAliasProviderId:  params.DnsAliasProviderId,
+			DnsAliasDomain:      dnsAliasDomain,
+			KeyType:             params.KeyType,
+			PreferredChain:      params.PreferredChain,
+			DirectoryURL:        strings.TrimSpace(params.DirectoryURL),
+			IncludeWildcardApex: params.IncludeWildcardApex,
 		})
 		if err != nil {
 			this.ErrorPage(err)
@@ -150,3 +197,12 @@ func (this *CreateAction) RunPost(params struct {
 
 	this.Success()
 }
+
+// 将域名转换为小写的Punycode格式，以便和API节点保存的格式一致
+func normalizeDomain(domain string) string {
+	normalizedDomain, err := configutils.NormalizeDomain(domain)
+	if err != nil || len(normalizedDomain) == 0 {
+		return strings.ToLower(domain)
+	}
+	return normalizedDomain
+}
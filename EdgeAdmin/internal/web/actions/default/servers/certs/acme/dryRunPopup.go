@@ -0,0 +1,82 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/langs/codes"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/maps"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+// DryRunPopupAction 在CA测试环境中试运行任务
+type DryRunPopupAction struct {
+	actionutils.ParentAction
+}
+
+func (this *DryRunPopupAction) Init() {
+	this.Nav("", "", "")
+}
+
+func (this *DryRunPopupAction) RunGet(params struct {
+	TaskId int64
+}) {
+	taskResp, err := this.RPC().ACMETaskRPC().FindEnabledACMETask(this.AdminContext(), &pb.FindEnabledACMETaskRequest{AcmeTaskId: params.TaskId})
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+	var task = taskResp.AcmeTask
+	if task == nil {
+		this.NotFound("acmeTask", params.TaskId)
+		return
+	}
+
+	this.Data["task"] = maps.Map{
+		"id":       task.Id,
+		"authType": task.AuthType,
+		"domains":  task.Domains,
+	}
+
+	this.Show()
+}
+
+func (this *DryRunPopupAction) RunPost(params struct {
+	TaskId int64
+}) {
+	defer this.CreateLogInfo(codes.ACMETask_LogDryRunACMETask, params.TaskId)
+
+	resp, err := this.RPC().ACMETaskRPC().DryRunACMETask(this.AdminContext(), &pb.DryRunACMETaskRequest{AcmeTaskId: params.TaskId})
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+
+	var stepMaps = []maps.Map{}
+	for _, step := range resp.Steps {
+		stepMaps = append(stepMaps, maps.Map{
+			"name":    step.Name,
+			"isOk":    step.IsOk,
+			"message": step.Message,
+			"costMs":  step.CostMs,
+		})
+	}
+
+	var certExpiresTime = ""
+	if resp.CertExpiresAt > 0 {
+		certExpiresTime = timeutil.FormatTime("Y-m-d H:i:s", resp.CertExpiresAt)
+	}
+
+	this.Data["report"] = maps.Map{
+		"isOk":            resp.IsOk,
+		"error":           resp.Error,
+		"directoryURL":    resp.DirectoryURL,
+		"steps":           stepMaps,
+		"certDNSNames":    resp.CertDNSNames,
+		"certIssuer":      resp.CertIssuer,
+		"certExpiresTime": certExpiresTime,
+	}
+
+	this.Success()
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/iwind/TeaGo/maps"
 	timeutil "github.com/iwind/TeaGo/utils/time"
+	"time"
 )
 
 type IndexAction struct {
@@ -257,6 +258,15 @@ func (this *IndexAction) RunGet(params struct {
 			}
 		}
 
+		// CA频率限制
+		var throttleMap maps.Map = nil
+		if task.ThrottledUntil > time.Now().Unix() {
+			throttleMap = maps.Map{
+				"untilTime": timeutil.FormatTime("Y-m-d H:i:s", task.ThrottledUntil),
+				"reason":    task.ThrottleReason,
+			}
+		}
+
 		// user
 		userResp, err := this.RPC().ACMETaskRPC().FindACMETaskUser(this.AdminContext(), &pb.FindACMETaskUserRequest{AcmeTaskId: task.Id})
 		if err != nil {
@@ -290,6 +300,7 @@ func (this *IndexAction) RunGet(params struct {
 			"cert":        certMap,
 			"log":         logMap,
 			"user":        taskUserMap,
+			"throttle":    throttleMap,
 		})
 	}
 	this.Data["tasks"] = taskMaps
@@ -0,0 +1,38 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import "github.com/iwind/TeaGo/maps"
+
+const defaultKeyType = "rsa2048"
+
+// 所有支持的私钥类型
+func findAllKeyTypes() []maps.Map {
+	return []maps.Map{
+		{
+			"name":        "RSA 2048",
+			"code":        "rsa2048",
+			"description": "兼容性最好，适合需要支持旧客户端的场景。",
+		},
+		{
+			"name":        "RSA 3072",
+			"code":        "rsa3072",
+			"description": "",
+		},
+		{
+			"name":        "RSA 4096",
+			"code":        "rsa4096",
+			"description": "安全性更高，但握手速度较慢。",
+		},
+		{
+			"name":        "ECDSA P-256",
+			"code":        "ec256",
+			"description": "证书更小、握手更快，推荐现代客户端使用。",
+		},
+		{
+			"name":        "ECDSA P-384",
+			"code":        "ec384",
+			"description": "",
+		},
+	}
+}
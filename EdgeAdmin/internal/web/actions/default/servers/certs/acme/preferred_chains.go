@@ -0,0 +1,23 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import "github.com/iwind/TeaGo/maps"
+
+// 常用的首选证书链
+func findAllPreferredChains() []maps.Map {
+	return []maps.Map{
+		{
+			"name":        "ISRG Root X1",
+			"description": "Let's Encrypt的短证书链，证书链更小，但不兼容Android 7.1.1以下的旧设备",
+		},
+		{
+			"name":        "ISRG Root X2",
+			"description": "Let's Encrypt的ECDSA根证书，需要配合ECDSA私钥使用",
+		},
+		{
+			"name":        "DST Root CA X3",
+			"description": "Let's Encrypt的长证书链，兼容旧的Android设备，但证书链较大",
+		},
+	}
+}
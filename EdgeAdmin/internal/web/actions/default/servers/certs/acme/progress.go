@@ -0,0 +1,23 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
+)
+
+// ProgressAction 读取任务执行进度
+type ProgressAction struct {
+	actionutils.ParentAction
+}
+
+func (this *ProgressAction) RunPost(params struct {
+	TaskId int64
+	Offset int
+}) {
+	steps, isFinished := findTaskProgress(params.TaskId, params.Offset)
+	this.Data["steps"] = steps
+	this.Data["isFinished"] = isFinished
+
+	this.Success()
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package acme
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeAdmin/internal/rpc"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/maps"
+)
+
+// 任务执行进度在内存中保留的时间
+const progressLife = 10 * time.Minute
+
+// 任务执行进度
+type taskProgress struct {
+	steps      []maps.Map
+	isFinished bool
+	expiresAt  time.Time
+}
+
+var taskProgressMap = map[int64]*taskProgress{} // taskId => *taskProgress
+var taskProgressLocker = &sync.Mutex{}
+
+// 开始监听任务执行进度，订阅成功后返回，以便之后执行的任务不会丢失进度
+func watchTaskProgress(ctx context.Context, rpcClient *rpc.RPCClient, taskId int64) error {
+	ctx, cancel := context.WithTimeout(ctx, progressLife)
+	stream, err := rpcClient.ACMETaskRPC().WatchACMETaskProgress(ctx, &pb.WatchACMETaskProgressRequest{AcmeTaskId: taskId})
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	// 等待订阅成功
+	_, err = stream.Recv()
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	taskProgressLocker.Lock()
+	cleanTaskProgress()
+	var progress = &taskProgress{
+		steps:     []maps.Map{},
+		expiresAt: time.Now().Add(progressLife),
+	}
+	taskProgressMap[taskId] = progress
+	taskProgressLocker.Unlock()
+
+	go func() {
+		defer cancel()
+
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				break
+			}
+
+			taskProgressLocker.Lock()
+			if resp.Step != nil {
+				progress.steps = append(progress.steps, maps.Map{
+					"name":    resp.Step.Name,
+					"isOk":    resp.Step.IsOk,
+					"message": resp.Step.Message,
+					"costMs":  resp.Step.CostMs,
+				})
+			}
+			if resp.IsFinished {
+				progress.isFinished = true
+			}
+			taskProgressLocker.Unlock()
+
+			if resp.IsFinished {
+				break
+			}
+		}
+	}()
+
+	return nil
+}
+
+// 读取任务执行进度
+func findTaskProgress(taskId int64, offset int) (steps []maps.Map, isFinished bool) {
+	taskProgressLocker.Lock()
+	defer taskProgressLocker.Unlock()
+
+	progress, ok := taskProgressMap[taskId]
+	if !ok {
+		return []maps.Map{}, false
+	}
+	if offset < 0 || offset > len(progress.steps) {
+		offset = len(progress.steps)
+	}
+
+	steps = append([]maps.Map{}, progress.steps[offset:]...)
+	return steps, progress.isFinished
+}
+
+// 清理过期的进度，调用前需要加锁
+func cleanTaskProgress() {
+	var now = time.Now()
+	for taskId, progress := range taskProgressMap {
+		if progress.expiresAt.Before(now) {
+			delete(taskProgressMap, taskId)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/langs/codes"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/logs"
 )
 
 type RunAction struct {
@@ -15,6 +16,12 @@ func (this *RunAction) RunPost(params struct {
 }) {
 	defer this.CreateLogInfo(codes.ACMETask_LogRunACMETask, params.TaskId)
 
+	// 监听执行进度，不影响任务执行
+	err := watchTaskProgress(this.AdminContext(), this.RPC(), params.TaskId)
+	if err != nil {
+		logs.Error(err)
+	}
+
 	runResp, err := this.RPC().ACMETaskRPC().RunACMETask(this.AdminContext(), &pb.RunACMETaskRequest{AcmeTaskId: params.TaskId})
 	if err != nil {
 		this.ErrorPage(err)
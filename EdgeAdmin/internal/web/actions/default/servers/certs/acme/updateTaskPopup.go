@@ -46,7 +46,11 @@ func (this *UpdateTaskPopupAction) RunGet(params struct {
 	}
 
 	var acmeUserMap maps.Map
+	var testAPIURL = ""
 	if task.AcmeUser != nil {
+		if task.AcmeUser.AcmeProvider != nil {
+			testAPIURL = task.AcmeUser.AcmeProvider.TestAPIURL
+		}
 		acmeUserMap = maps.Map{
 			"id": task.AcmeUser.Id,
 		}
@@ -56,6 +60,20 @@ func (this *UpdateTaskPopupAction) RunGet(params struct {
 		}
 	}
 
+	var dnsAliasProviderMap = maps.Map{
+		"id": 0,
+	}
+	if task.DnsAliasProvider != nil {
+		dnsAliasProviderMap = maps.Map{
+			"id": task.DnsAliasProvider.Id,
+		}
+	}
+
+	var keyType = task.KeyType
+	if len(keyType) == 0 {
+		keyType = defaultKeyType
+	}
+
 	this.Data["task"] = maps.Map{
 		"id":          task.Id,
 		"authType":    task.AuthType,
@@ -66,8 +84,23 @@ func (this *UpdateTaskPopupAction) RunGet(params struct {
 		"isOn":        task.IsOn,
 		"authURL":     task.AuthURL,
 		"dnsProvider": dnsProviderMap,
+
+		"dnsAliasProvider": dnsAliasProviderMap,
+		"dnsAliasDomain":   task.DnsAliasDomain,
+		"keyType":          keyType,
+		"preferredChain":   task.PreferredChain,
+		"directoryURL":     task.DirectoryURL,
 	}
 
+	// 服务商测试环境目录
+	this.Data["testAPIURL"] = testAPIURL
+
+	// 私钥类型
+	this.Data["keyTypes"] = findAllKeyTypes()
+
+	// 首选证书链
+	this.Data["preferredChains"] = findAllPreferredChains()
+
 	// 域名解析服务商
 	providersResp, err := this.RPC().DNSProviderRPC().FindAllEnabledDNSProviders(this.AdminContext(), &pb.FindAllEnabledDNSProvidersRequest{
 		AdminId: this.AdminId(),
@@ -97,8 +130,16 @@ func (this *UpdateTaskPopupAction) RunPost(params struct {
 	DnsProviderId int64
 	DnsDomain     string
 	DomainsJSON   []byte
-	AutoRenew     bool
-	AuthURL       string
+
+	DnsAliasProviderId int64
+	DnsAliasDomain     string
+
+	AutoRenew bool
+	AuthURL   string
+	KeyType   string
+
+	PreferredChain string
+	DirectoryURL   string
 
 	Must *actions.Must
 	CSRF *actionutils.CSRF
@@ -126,6 +167,20 @@ func (this *UpdateTaskPopupAction) RunPost(params struct {
 		}
 	}
 
+	// DNS别名
+	var dnsAliasDomain = strings.Trim(strings.ToLower(params.DnsAliasDomain), ".")
+	if params.AuthType == "dns" && params.DnsAliasProviderId > 0 {
+		if len(dnsAliasDomain) == 0 {
+			this.Fail("请输入DNS别名域名")
+		}
+		if !domainutils.ValidateDomainFormat(dnsAliasDomain) {
+			this.Fail("请输入正确的DNS别名域名")
+		}
+	} else {
+		params.DnsAliasProviderId = 0
+		dnsAliasDomain = ""
+	}
+
 	var domains = []string{}
 	if len(params.DomainsJSON) > 0 {
 		err := json.Unmarshal(params.DomainsJSON, &domains)
@@ -139,10 +194,11 @@ func (this *UpdateTaskPopupAction) RunPost(params struct {
 		this.Fail("请输入证书域名列表")
 	}
 	var realDomains = []string{}
+	var normalizedDNSDomain = normalizeDomain(dnsDomain)
 	for _, domain := range domains {
-		domain = strings.ToLower(domain)
+		domain = normalizeDomain(domain)
 		if params.AuthType == "dns" {
-			if !strings.HasSuffix(domain, "."+dnsDomain) && domain != dnsDomain {
+			if !strings.HasSuffix(domain, "."+normalizedDNSDomain) && domain != normalizedDNSDomain {
 				this.Fail("证书域名中的" + domain + "和顶级域名不一致")
 			}
 		} else if params.AuthType == "http" { // HTTP认证
@@ -161,6 +217,12 @@ func (this *UpdateTaskPopupAction) RunPost(params struct {
 		Domains:       realDomains,
 		AutoRenew:     params.AutoRenew,
 		AuthURL:       params.AuthURL,
+
+		DnsAliasProviderId: params.DnsAliasProviderId,
+		DnsAliasDomain:     dnsAliasDomain,
+		KeyType:            params.KeyType,
+		PreferredChain:     params.PreferredChain,
+		DirectoryURL:       strings.TrimSpace(params.DirectoryURL),
 	})
 	if err != nil {
 		this.ErrorPage(err)
@@ -0,0 +1,94 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package users
+
+import (
+	"strings"
+
+	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/langs/codes"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/actions"
+	"github.com/iwind/TeaGo/maps"
+)
+
+// ImportPopupAction 导入已有的ACME用户，比如从certbot、acme.sh中迁移过来的账号
+type ImportPopupAction struct {
+	actionutils.ParentAction
+}
+
+func (this *ImportPopupAction) Init() {
+	this.Nav("", "", "")
+}
+
+func (this *ImportPopupAction) RunGet(params struct {
+	PlatformUserId int64
+	ProviderCode   string
+}) {
+	this.Data["platformUserId"] = params.PlatformUserId
+	this.Data["providerCode"] = params.ProviderCode
+
+	// 服务商
+	providersResp, err := this.RPC().ACMEProviderRPC().FindAllACMEProviders(this.AdminContext(), &pb.FindAllACMEProvidersRequest{})
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+	var providerMaps = []maps.Map{}
+	for _, provider := range providersResp.AcmeProviders {
+		providerMaps = append(providerMaps, maps.Map{
+			"code":       provider.Code,
+			"name":       provider.Name,
+			"requireEAB": provider.RequireEAB,
+		})
+	}
+	this.Data["providers"] = providerMaps
+
+	this.Show()
+}
+
+func (this *ImportPopupAction) RunPost(params struct {
+	PlatformUserId int64
+	Email          string
+	ProviderCode   string
+	AccountId      int64
+	PrivateKey     string
+	AccountURL     string
+	Description    string
+
+	Must *actions.Must
+	CSRF *actionutils.CSRF
+}) {
+	params.Must.
+		Field("email", params.Email).
+		Require("请输入邮箱").
+		Email("请输入正确的邮箱格式").
+		Field("providerCode", params.ProviderCode).
+		Require("请选择所属服务商").
+		Field("privateKey", params.PrivateKey).
+		Require("请输入账号私钥")
+
+	var accountURL = strings.TrimSpace(params.AccountURL)
+	if len(accountURL) > 0 && !strings.HasPrefix(accountURL, "https://") {
+		this.FailField("accountURL", "账号URL必须以https://开头")
+	}
+
+	importResp, err := this.RPC().ACMEUserRPC().ImportACMEUser(this.AdminContext(), &pb.ImportACMEUserRequest{
+		UserId:                params.PlatformUserId,
+		Email:                 params.Email,
+		Description:           params.Description,
+		AcmeProviderCode:      params.ProviderCode,
+		AcmeProviderAccountId: params.AccountId,
+		PrivateKeyData:        []byte(strings.TrimSpace(params.PrivateKey)),
+		AccountURL:            accountURL,
+	})
+	if err != nil {
+		this.Fail("导入失败：" + err.Error())
+		return
+	}
+
+	// 日志
+	defer this.CreateLogInfo(codes.ACMEUser_LogImportACMEUser, importResp.AcmeUserId)
+
+	this.Success()
+}
@@ -270,6 +270,7 @@ func (this *IndexAction) RunGet(params struct {
 
 		certMaps = append(certMaps, maps.Map{
 			"isOn":         certConfig.IsOn,
+			"isRevoked":    certConfig.RevokedAt > 0,
 			"beginDay":     timeutil.FormatTime("Y-m-d", certConfig.TimeBeginAt),
 			"endDay":       timeutil.FormatTime("Y-m-d", certConfig.TimeEndAt),
 			"isExpired":    nowTime > certConfig.TimeEndAt,
@@ -24,6 +24,7 @@ func init() {
 			GetPost("/uploadBatchPopup", new(UploadBatchPopupAction)).
 			Post("/delete", new(DeleteAction)).
 			GetPost("/updatePopup", new(UpdatePopupAction)).
+			GetPost("/revokePopup", new(RevokePopupAction)).
 			Get("/certPopup", new(CertPopupAction)).
 			Get("/viewKey", new(ViewKeyAction)).
 			Get("/viewCert", new(ViewCertAction)).
@@ -39,6 +40,8 @@ func init() {
 			Get("", new(acme.IndexAction)).
 			GetPost("/create", new(acme.CreateAction)).
 			Post("/run", new(acme.RunAction)).
+			Post("/progress", new(acme.ProgressAction)).
+			GetPost("/dryRunPopup", new(acme.DryRunPopupAction)).
 			GetPost("/updateTaskPopup", new(acme.UpdateTaskPopupAction)).
 			Post("/deleteTask", new(acme.DeleteTaskAction)).
 			Post("/userOptions", new(acme.UserOptionsAction)).
@@ -47,6 +50,7 @@ func init() {
 			Prefix("/servers/certs/acme/users").
 			Get("", new(users.IndexAction)).
 			GetPost("/createPopup", new(users.CreatePopupAction)).
+			GetPost("/importPopup", new(users.ImportPopupAction)).
 			GetPost("/updatePopup", new(users.UpdatePopupAction)).
 			Post("/delete", new(users.DeleteAction)).
 			GetPost("/selectPopup", new(users.SelectPopupAction)).
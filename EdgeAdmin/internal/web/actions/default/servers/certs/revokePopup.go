@@ -0,0 +1,100 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package certs
+
+import (
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/langs/codes"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/iwind/TeaGo/maps"
+)
+
+// RevokePopupAction 吊销证书
+type RevokePopupAction struct {
+	actionutils.ParentAction
+}
+
+func (this *RevokePopupAction) Init() {
+	this.Nav("", "", "")
+}
+
+func (this *RevokePopupAction) RunGet(params struct {
+	CertId int64
+}) {
+	certConfigResp, err := this.RPC().SSLCertRPC().FindEnabledSSLCertConfig(this.AdminContext(), &pb.FindEnabledSSLCertConfigRequest{SslCertId: params.CertId})
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+	var certConfigJSON = certConfigResp.SslCertJSON
+	if len(certConfigJSON) == 0 {
+		this.NotFound("cert", params.CertId)
+		return
+	}
+
+	var certConfig = &sslconfigs.SSLCertConfig{}
+	err = json.Unmarshal(certConfigJSON, certConfig)
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+	certConfig.CertData = nil
+	certConfig.KeyData = nil
+	this.Data["certConfig"] = certConfig
+
+	this.Data["reasons"] = findAllRevokeReasons()
+
+	this.Show()
+}
+
+func (this *RevokePopupAction) RunPost(params struct {
+	CertId int64
+	Reason int32
+}) {
+	defer this.CreateLogInfo(codes.SSLCert_LogRevokeSSLCert, params.CertId)
+
+	_, err := this.RPC().SSLCertRPC().RevokeSSLCert(this.AdminContext(), &pb.RevokeSSLCertRequest{
+		SslCertId: params.CertId,
+		Reason:    params.Reason,
+	})
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+
+	this.Success()
+}
+
+// 所有可以使用的吊销原因，需要和API节点中的定义保持一致
+func findAllRevokeReasons() []maps.Map {
+	return []maps.Map{
+		{
+			"name":        "未指定",
+			"code":        0,
+			"description": "",
+		},
+		{
+			"name":        "私钥泄露",
+			"code":        1,
+			"description": "证书私钥已经泄露或者可能已经泄露。",
+		},
+		{
+			"name":        "信息变更",
+			"code":        3,
+			"description": "证书中的域名或者组织信息已经发生变化。",
+		},
+		{
+			"name":        "已被替代",
+			"code":        4,
+			"description": "已经申请了新的证书来代替此证书。",
+		},
+		{
+			"name":        "停止使用",
+			"code":        5,
+			"description": "证书中的域名已经不再使用，比如服务已下线。",
+		},
+	}
+}
@@ -56,6 +56,7 @@ func (this *UpdatePopupAction) RunPost(params struct {
 	IsCA        bool
 	Description string
 	IsOn        bool
+	KeyStorage  string
 
 	CertFile *actions.File
 	KeyFile  *actions.File
@@ -120,9 +121,27 @@ func (this *UpdatePopupAction) RunPost(params struct {
 		}
 	}
 
+	if params.IsCA {
+		params.KeyStorage = sslconfigs.SSLCertKeyStorageDB
+	}
+	if !sslconfigs.IsValidSSLCertKeyStorage(params.KeyStorage) {
+		this.Fail("请选择正确的私钥存储位置")
+	}
+	if !params.IsCA && params.KeyStorage != certConfig.KeyStorage && len(certConfig.KeyData) == 0 {
+		this.FailField("keyFile", "修改私钥存储位置时需要重新上传私钥")
+	}
+
 	// 校验
-	certConfig.IsCA = params.IsCA
-	err = certConfig.Init(context.TODO())
+	certConfig.KeyStorage = params.KeyStorage
+	if !params.IsCA && params.KeyStorage == sslconfigs.SSLCertKeyStorageNode && len(certConfig.KeyData) == 0 {
+		// 私钥保存在节点上，这里只校验证书
+		certConfig.IsCA = true
+		err = certConfig.Init(context.TODO())
+		certConfig.IsCA = false
+	} else {
+		certConfig.IsCA = params.IsCA
+		err = certConfig.Init(context.TODO())
+	}
 	if err != nil {
 		if params.IsCA {
 			this.Fail("证书校验错误：" + err.Error())
@@ -156,6 +175,7 @@ func (this *UpdatePopupAction) RunPost(params struct {
 		TimeEndAt:   certConfig.TimeEndAt,
 		DnsNames:    certConfig.DNSNames,
 		CommonNames: certConfig.CommonNames,
+		KeyStorage:  params.KeyStorage,
 	})
 	if err != nil {
 		this.ErrorPage(err)
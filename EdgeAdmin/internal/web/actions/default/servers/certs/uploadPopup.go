@@ -49,6 +49,7 @@ func (this *UploadPopupAction) RunPost(params struct {
 	IsCA        bool
 	Description string
 	IsOn        bool
+	KeyStorage  string
 
 	CertFile *actions.File
 	KeyFile  *actions.File
@@ -100,6 +101,13 @@ func (this *UploadPopupAction) RunPost(params struct {
 		}
 	}
 
+	if params.IsCA {
+		params.KeyStorage = sslconfigs.SSLCertKeyStorageDB
+	}
+	if !sslconfigs.IsValidSSLCertKeyStorage(params.KeyStorage) {
+		this.Fail("请选择正确的私钥存储位置")
+	}
+
 	// 校验
 	var certConfig = &sslconfigs.SSLCertConfig{
 		IsCA:     params.IsCA,
@@ -139,6 +147,7 @@ func (this *UploadPopupAction) RunPost(params struct {
 		TimeEndAt:   certConfig.TimeEndAt,
 		DnsNames:    certConfig.DNSNames,
 		CommonNames: certConfig.CommonNames,
+		KeyStorage:  params.KeyStorage,
 	})
 	if err != nil {
 		this.ErrorPage(err)
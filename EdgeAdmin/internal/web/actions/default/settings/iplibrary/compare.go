@@ -0,0 +1,102 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package iplibrary
+
+import (
+	"strings"
+
+	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/iplibrary"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/iwind/TeaGo/maps"
+)
+
+type CompareAction struct {
+	actionutils.ParentAction
+}
+
+func (this *CompareAction) Init() {
+	this.Nav("", "", "compare")
+}
+
+func (this *CompareAction) RunGet(params struct{}) {
+	artifactsResp, err := this.RPC().IPLibraryArtifactRPC().FindAllIPLibraryArtifacts(this.AdminContext(), &pb.FindAllIPLibraryArtifactsRequest{})
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+	var artifactMaps = []maps.Map{}
+	for _, artifact := range artifactsResp.IpLibraryArtifacts {
+		var driver = artifact.Driver
+		if len(driver) == 0 {
+			driver = iplibrary.DriverTypeDefault
+		}
+		artifactMaps = append(artifactMaps, maps.Map{
+			"id":         artifact.Id,
+			"name":       artifact.Name,
+			"code":       artifact.Code,
+			"driverName": iplibrary.FindDriverTypeName(driver),
+			"isPublic":   artifact.IsPublic,
+		})
+	}
+	this.Data["artifacts"] = artifactMaps
+
+	this.Show()
+}
+
+func (this *CompareAction) RunPost(params struct {
+	ArtifactId1 int64
+	ArtifactId2 int64
+	Ips         string
+}) {
+	var ipList = []string{}
+	for _, ip := range strings.Split(params.Ips, "\n") {
+		ip = strings.TrimSpace(ip)
+		if len(ip) > 0 {
+			ipList = append(ipList, ip)
+		}
+	}
+	if len(ipList) == 0 {
+		this.FailField("ips", "请输入要对比的IP")
+		return
+	}
+
+	resp, err := this.RPC().IPLibraryArtifactRPC().CompareIPLibraryArtifacts(this.AdminContext(), &pb.CompareIPLibraryArtifactsRequest{
+		IpLibraryArtifactId1: params.ArtifactId1,
+		IpLibraryArtifactId2: params.ArtifactId2,
+		Ips:                  ipList,
+	})
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+
+	var percent = func(count int64) float32 {
+		if resp.CountIPs == 0 {
+			return 0
+		}
+		return float32(count) * 100 / float32(resp.CountIPs)
+	}
+
+	var diffMaps = []maps.Map{}
+	for _, diff := range resp.Diffs {
+		diffMaps = append(diffMaps, maps.Map{
+			"ip":       diff.Ip,
+			"summary1": diff.Summary1,
+			"summary2": diff.Summary2,
+		})
+	}
+
+	this.Data["result"] = maps.Map{
+		"countIPs":               resp.CountIPs,
+		"countFound1":            resp.CountFound1,
+		"countFound2":            resp.CountFound2,
+		"countryMatchesPercent":  percent(resp.CountCountryMatches),
+		"provinceMatchesPercent": percent(resp.CountProvinceMatches),
+		"cityMatchesPercent":     percent(resp.CountCityMatches),
+		"providerMatchesPercent": percent(resp.CountProviderMatches),
+		"diffs":                  diffMaps,
+	}
+
+	this.Success()
+}
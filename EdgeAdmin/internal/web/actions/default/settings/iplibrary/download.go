@@ -0,0 +1,27 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package iplibrary
+
+import (
+	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/langs/codes"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+)
+
+type DownloadAction struct {
+	actionutils.ParentAction
+}
+
+func (this *DownloadAction) RunPost(params struct {
+	IsPublic bool
+}) {
+	resp, err := this.RPC().IPLibraryArtifactRPC().DownloadIPLibraryArtifact(this.AdminContext(), &pb.DownloadIPLibraryArtifactRequest{IsPublic: params.IsPublic})
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+
+	defer this.CreateLogInfo(codes.IPLibraryArtifact_LogDownloadIPLibraryArtifact, resp.IpLibraryArtifactId)
+
+	this.Success()
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package iplibrary
+
+import (
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/iplibrary"
+	"github.com/TeaOSLab/EdgeCommon/pkg/langs/codes"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/iwind/TeaGo/actions"
+	timeutil "github.com/iwind/TeaGo/utils/time"
+)
+
+type IndexAction struct {
+	actionutils.ParentAction
+}
+
+func (this *IndexAction) Init() {
+	this.Nav("", "", "index")
+}
+
+func (this *IndexAction) RunGet(params struct{}) {
+	config, err := readIPLibraryConfig(&this.ParentAction)
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+	this.Data["config"] = config
+
+	if config.LastUpdatedAt > 0 {
+		this.Data["lastUpdatedTime"] = timeutil.FormatTime("Y-m-d H:i:s", config.LastUpdatedAt)
+	} else {
+		this.Data["lastUpdatedTime"] = ""
+	}
+
+	this.Data["drivers"] = iplibrary.FindAllDriverTypes()
+	this.Data["maxMindEditions"] = systemconfigs.FindAllMaxMindEditions()
+
+	this.Show()
+}
+
+func (this *IndexAction) RunPost(params struct {
+	Driver             string
+	AutoUpdate         bool
+	UpdateIntervalDays int
+
+	MaxMindLicenseKey string
+	MaxMindEditionId  string
+
+	IpipDownloadURL string
+
+	Must *actions.Must
+	CSRF *actionutils.CSRF
+}) {
+	defer this.CreateLogInfo(codes.IPLibrary_LogUpdateIPLibrarySettings)
+
+	if !iplibrary.IsValidDriverType(params.Driver) {
+		this.Fail("请选择正确的IP库类型")
+		return
+	}
+
+	switch params.Driver {
+	case iplibrary.DriverTypeMaxMind:
+		params.Must.
+			Field("maxMindLicenseKey", params.MaxMindLicenseKey).
+			Require("请输入MaxMind License Key")
+	case iplibrary.DriverTypeIPIP:
+		params.Must.
+			Field("ipipDownloadURL", params.IpipDownloadURL).
+			Require("请输入ipip.net下载地址").
+			Match("^(?i)(http|https):", "下载地址必须以http://或者https://开头")
+	}
+
+	if params.AutoUpdate && params.UpdateIntervalDays <= 0 {
+		this.FailField("updateIntervalDays", "请输入正确的更新间隔天数")
+		return
+	}
+
+	config, err := readIPLibraryConfig(&this.ParentAction)
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+
+	config.Driver = params.Driver
+	config.AutoUpdate = params.AutoUpdate
+	config.UpdateIntervalDays = params.UpdateIntervalDays
+	config.MaxMindLicenseKey = params.MaxMindLicenseKey
+	config.MaxMindEditionId = params.MaxMindEditionId
+	config.IPIPDownloadURL = params.IpipDownloadURL
+
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+
+	_, err = this.RPC().SysSettingRPC().UpdateSysSetting(this.AdminContext(), &pb.UpdateSysSettingRequest{
+		Code:      systemconfigs.SettingCodeIPLibraryConfig,
+		ValueJSON: configJSON,
+	})
+	if err != nil {
+		this.ErrorPage(err)
+		return
+	}
+
+	this.Success()
+}
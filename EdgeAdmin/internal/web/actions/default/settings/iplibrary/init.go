@@ -0,0 +1,21 @@
+package iplibrary
+
+import (
+	"github.com/TeaOSLab/EdgeAdmin/internal/configloaders"
+	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/default/settings/settingutils"
+	"github.com/TeaOSLab/EdgeAdmin/internal/web/helpers"
+	"github.com/iwind/TeaGo"
+)
+
+func init() {
+	TeaGo.BeforeStart(func(server *TeaGo.Server) {
+		server.
+			Helper(helpers.NewUserMustAuth(configloaders.AdminModuleCodeSetting)).
+			Helper(settingutils.NewAdvancedHelper("ipLibrary")).
+			Prefix("/settings/iplibrary").
+			GetPost("", new(IndexAction)).
+			Post("/download", new(DownloadAction)).
+			GetPost("/compare", new(CompareAction)).
+			EndAll()
+	})
+}
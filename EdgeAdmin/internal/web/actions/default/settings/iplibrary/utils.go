@@ -0,0 +1,27 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package iplibrary
+
+import (
+	"encoding/json"
+
+	"github.com/TeaOSLab/EdgeAdmin/internal/web/actions/actionutils"
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+)
+
+// 读取IP库配置
+func readIPLibraryConfig(parent *actionutils.ParentAction) (*systemconfigs.IPLibraryConfig, error) {
+	valueResp, err := parent.RPC().SysSettingRPC().ReadSysSetting(parent.AdminContext(), &pb.ReadSysSettingRequest{Code: systemconfigs.SettingCodeIPLibraryConfig})
+	if err != nil {
+		return nil, err
+	}
+	var config = systemconfigs.NewIPLibraryConfig()
+	if len(valueResp.ValueJSON) > 0 {
+		err = json.Unmarshal(valueResp.ValueJSON, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
@@ -117,6 +117,7 @@ import (
 	_ "github.com/TeaOSLab/EdgeAdmin/internal/web/actions/default/settings"
 	_ "github.com/TeaOSLab/EdgeAdmin/internal/web/actions/default/settings/backup"
 	_ "github.com/TeaOSLab/EdgeAdmin/internal/web/actions/default/settings/database"
+	_ "github.com/TeaOSLab/EdgeAdmin/internal/web/actions/default/settings/iplibrary"
 	_ "github.com/TeaOSLab/EdgeAdmin/internal/web/actions/default/settings/lang"
 	_ "github.com/TeaOSLab/EdgeAdmin/internal/web/actions/default/settings/login"
 	_ "github.com/TeaOSLab/EdgeAdmin/internal/web/actions/default/settings/profile"
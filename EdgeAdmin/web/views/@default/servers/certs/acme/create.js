@@ -89,8 +89,14 @@ Tea.context(function () {
 	 */
 	this.dnsProviderId = 0
 	this.dnsDomain = ""
+	this.dnsAliasProviderId = 0
+	this.dnsAliasDomain = ""
 	this.autoRenew = true
+	this.keyType = "rsa2048"
+	this.preferredChain = ""
+	this.directoryURL = ""
 	this.domains = []
+	this.includeWildcardApex = true
 	this.taskId = 0
 	this.isRequesting = false
 
@@ -102,6 +108,37 @@ Tea.context(function () {
 		this.domains = v
 	}
 
+	// 通配符域名对应的、还没有加入到域名列表中的主域名
+	this.missingApexDomains = function () {
+		let that = this
+		let result = []
+		this.domains.forEach(function (domain) {
+			if (!domain.startsWith("*.")) {
+				return
+			}
+			let apex = domain.substring(2)
+			if (apex.length > 0 && !that.domains.$contains(apex) && !result.$contains(apex)) {
+				result.push(apex)
+			}
+		})
+		return result
+	}
+
+	this.selectPreferredChain = function (chain) {
+		this.preferredChain = chain
+	}
+
+	this.testAPIURL = function () {
+		let that = this
+		let provider = this.providers.$find(function (k, v) {
+			return v.code == that.providerCode
+		})
+		if (provider == null) {
+			return ""
+		}
+		return provider.testAPIURL
+	}
+
 	this.doDNS = function () {
 		this.isRequesting = true
 		let that = this
@@ -113,8 +150,14 @@ Tea.context(function () {
 				acmeUserId: this.userId,
 				dnsProviderId: this.dnsProviderId,
 				dnsDomain: this.dnsDomain,
+				dnsAliasProviderId: this.dnsAliasProviderId,
+				dnsAliasDomain: this.dnsAliasDomain,
 				domains: this.domains,
 				autoRenew: this.autoRenew ? 1 : 0,
+				keyType: this.keyType,
+				preferredChain: this.preferredChain,
+				directoryURL: this.directoryURL,
+				includeWildcardApex: (this.authType == "dns" && this.includeWildcardApex) ? 1 : 0,
 				taskId: this.taskId,
 				authURL: this.authURL
 			})
@@ -123,6 +166,8 @@ Tea.context(function () {
 				taskCreated = true
 
 				this.isRequesting = true
+				this.progressSteps = []
+				setTimeout(this.pollProgress, 1000)
 				this.$post(".run")
 					.timeout(300)
 					.params({
@@ -134,6 +179,9 @@ Tea.context(function () {
 					})
 					.done(function () {
 						that.isRequesting = false
+
+						// 读取最后的执行进度
+						that.loadProgress()
 					})
 			})
 			.done(function () {
@@ -143,6 +191,38 @@ Tea.context(function () {
 			})
 	}
 
+	/**
+	 * 执行进度
+	 */
+	this.progressSteps = []
+
+	this.pollProgress = function () {
+		if (!this.isRequesting || this.taskId <= 0) {
+			return
+		}
+		let that = this
+		this.loadProgress(function () {
+			setTimeout(that.pollProgress, 1000)
+		})
+	}
+
+	this.loadProgress = function (callback) {
+		let that = this
+		this.$post(".progress")
+			.params({
+				taskId: this.taskId,
+				offset: this.progressSteps.length
+			})
+			.success(function (resp) {
+				that.progressSteps.$pushAll(resp.data.steps)
+			})
+			.done(function () {
+				if (typeof callback == "function") {
+					callback()
+				}
+			})
+	}
+
 	/**
 	 * 完成
 	 */
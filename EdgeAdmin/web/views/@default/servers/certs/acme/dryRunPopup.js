@@ -0,0 +1,21 @@
+Tea.context(function () {
+	this.report = null
+	this.isRunning = false
+
+	this.dryRun = function () {
+		this.isRunning = true
+		this.report = null
+
+		this.$post("$")
+			.timeout(300)
+			.params({
+				taskId: this.task.id
+			})
+			.success(function (resp) {
+				this.report = resp.data.report
+			})
+			.done(function () {
+				this.isRunning = false
+			})
+	}
+})
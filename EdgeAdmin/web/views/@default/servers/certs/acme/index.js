@@ -57,6 +57,13 @@ Tea.context(function () {
 		})
 	}
 
+	this.dryRunTask = function (taskId) {
+		teaweb.popup("/servers/certs/acme/dryRunPopup?taskId=" + taskId, {
+			width: "50em",
+			height: "30em"
+		})
+	}
+
 	this.showError = function (err) {
 		teaweb.popupTip("任务执行失败：" + err)
 	}
@@ -0,0 +1,37 @@
+Tea.context(function () {
+	this.selectedProvider = null
+	this.accounts = []
+	this.accountId = 0
+
+	this.changeProvider = function () {
+		this.accountId = 0
+		this.selectedProvider = null
+		this.accounts = []
+
+		if (this.providerCode.length == 0) {
+			return
+		}
+
+		let that = this
+		let provider = this.providers.$find(function (k, v) {
+			return v.code == that.providerCode
+		})
+		if (provider == null) {
+			return
+		}
+
+		this.selectedProvider = provider
+
+		this.$post(".accountsWithCode")
+			.params({
+				code: provider.code
+			})
+			.success(function (resp) {
+				this.accounts = resp.data.accounts
+			})
+	}
+
+	if (this.providerCode.length > 0) {
+		this.changeProvider()
+	}
+})
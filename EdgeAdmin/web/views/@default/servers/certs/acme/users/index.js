@@ -11,6 +11,18 @@ Tea.context(function () {
 		})
 	}
 
+	this.importUser = function () {
+		teaweb.popup(Tea.url(".importPopup"), {
+			height: "36em",
+			width: "50em",
+			callback: function () {
+				teaweb.success("导入成功", function () {
+					teaweb.reload()
+				})
+			}
+		})
+	}
+
 	this.updateUser = function (userId) {
 		teaweb.popup("/servers/certs/acme/users/updatePopup?userId=" + userId, {
 			height: "27em",
@@ -51,4 +51,16 @@ Tea.context(function () {
 			}
 		})
 	}
+
+	// 吊销证书
+	this.revokeCert = function (certId) {
+		teaweb.popup("/servers/certs/revokePopup?certId=" + certId, {
+			height: "24em",
+			callback: function () {
+				teaweb.success("吊销成功", function () {
+					window.location.reload()
+				})
+			}
+		})
+	}
 })
\ No newline at end of file
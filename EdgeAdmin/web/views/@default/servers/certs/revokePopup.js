@@ -0,0 +1,4 @@
+Tea.context(function () {
+	this.success = NotifyPopup
+	this.reason = 0
+})
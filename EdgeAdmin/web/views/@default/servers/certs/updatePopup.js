@@ -1,6 +1,7 @@
 Tea.context(function () {
 	this.success = NotifyPopup
 	this.isCA = this.certConfig.isCA ? 1 : 0
+	this.keyStorage = (this.certConfig.keyStorage == null) ? "" : this.certConfig.keyStorage
 	this.textMode = false
 
 	this.switchTextMode = function () {
@@ -1,6 +1,7 @@
 Tea.context(function () {
 	this.success = NotifyPopup
 	this.isCA = 0
+	this.keyStorage = ""
 	this.textMode = false
 
 	this.switchTextMode = function () {
@@ -0,0 +1,7 @@
+Tea.context(function () {
+	this.result = null
+
+	this.success = function (resp) {
+		this.result = resp.data.result
+	}
+})
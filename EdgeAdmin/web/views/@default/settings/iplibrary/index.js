@@ -0,0 +1,19 @@
+Tea.context(function () {
+	this.success = NotifyReloadSuccess("保存成功")
+
+	this.download = function () {
+		let that = this
+		teaweb.confirm("确定要使用当前保存的设置下载最新的IP库吗？下载可能需要较长时间。", function () {
+			that.$post(".download")
+				.params({
+					isPublic: true
+				})
+				.timeout(600)
+				.success(function () {
+					teaweb.success("下载成功", function () {
+						teaweb.reload()
+					})
+				})
+		})
+	}
+})
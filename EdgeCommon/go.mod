@@ -6,6 +6,7 @@ require (
 	github.com/hashicorp/go-set v0.1.14
 	github.com/iwind/TeaGo v0.0.0-20240508072741-7647e70b7070
 	github.com/miekg/dns v1.1.61
+	github.com/oschwald/maxminddb-golang v1.12.0
 	golang.org/x/net v0.26.0
 	google.golang.org/grpc v1.63.2
 	google.golang.org/protobuf v1.33.0
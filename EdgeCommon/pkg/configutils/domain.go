@@ -1,12 +1,18 @@
 package configutils
 
 import (
+	"errors"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/iwind/TeaGo/logs"
 	"github.com/iwind/TeaGo/utils/string"
+	"golang.org/x/net/idna"
 )
 
+// 不使用STD3规则，以便支持通配符（*）和下划线（_）
+var domainIDNAProfile = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.StrictDomainName(false))
+
 // MatchDomains 从一组规则中匹配域名
 // 支持的格式：example.com, www.example.com, .example.com, *.example.com, ~(\d+).example.com
 // 更多参考：http://nginx.org/en/docs/http/ngx_http_core_module.html#server_name
@@ -91,3 +97,48 @@ func IsFuzzyDomain(domain string) bool {
 	}
 	return false
 }
+
+// NormalizeDomain 规范化域名：去除首尾空格和末尾的点（.）、转换为小写，并将国际化域名（IDN）转换为Punycode格式
+// 支持 *.example.com、.example.com 和带下划线的域名，正则表达式（~开头）保持不变
+func NormalizeDomain(domain string) (string, error) {
+	domain = strings.TrimSpace(domain)
+	if len(domain) == 0 || domain[0] == '~' {
+		return domain, nil
+	}
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	if isASCII(domain) {
+		return domain, nil
+	}
+
+	var prefix = ""
+	if domain[0] == '.' {
+		prefix = "."
+		domain = domain[1:]
+	}
+	asciiDomain, err := domainIDNAProfile.ToASCII(domain)
+	if err != nil {
+		return "", errors.New("invalid domain '" + prefix + domain + "': " + err.Error())
+	}
+	return prefix + asciiDomain, nil
+}
+
+// UnicodeDomain 将Punycode格式的域名转换为Unicode格式，如果无法转换则返回原来的域名
+func UnicodeDomain(domain string) string {
+	if !strings.Contains(domain, "xn--") {
+		return domain
+	}
+	unicodeDomain, err := domainIDNAProfile.ToUnicode(domain)
+	if err != nil {
+		return domain
+	}
+	return unicodeDomain
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -123,3 +123,28 @@ func TestIsSpecialDomain(t *testing.T) {
 	a.IsTrue(IsFuzzyDomain("~^hello\\.com"))
 	a.IsFalse(IsFuzzyDomain("hello.com"))
 }
+
+func TestNormalizeDomain(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	for _, item := range [][2]string{
+		{" Example.COM. ", "example.com"},
+		{"中文.com", "xn--fiq228c.com"},
+		{"*.中文.com", "*.xn--fiq228c.com"},
+		{".中文.com", ".xn--fiq228c.com"},
+		{"_acme-challenge.中文.com", "_acme-challenge.xn--fiq228c.com"},
+		{"Bücher.de", "xn--bcher-kva.de"},
+		{"xn--fiq228c.com", "xn--fiq228c.com"},
+		{"~^中文\\.com$", "~^中文\\.com$"},
+	} {
+		domain, err := NormalizeDomain(item[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Log(item[0], "=>", domain)
+		a.IsTrue(domain == item[1])
+	}
+
+	a.IsTrue(UnicodeDomain("*.xn--fiq228c.com") == "*.中文.com")
+	a.IsTrue(UnicodeDomain("example.com") == "example.com")
+}
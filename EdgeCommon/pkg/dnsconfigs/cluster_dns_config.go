@@ -9,11 +9,14 @@ type ClusterDNSConfig struct {
 
 	NodesAutoSync   bool `yaml:"nodesAutoSync" json:"nodesAutoSync"`     // 是否自动同步节点状态
 	ServersAutoSync bool `yaml:"serversAutoSync" json:"serversAutoSync"` // 是否自动同步服务状态
+
+	ConflictPolicy RecordConflictPolicy `yaml:"conflictPolicy" json:"conflictPolicy"` // 添加记录时发现冲突的处理策略
 }
 
 func DefaultClusterDNSConfig() *ClusterDNSConfig {
 	return &ClusterDNSConfig{
 		CNAMEAsDomain:    true,
 		IncludingLnNodes: true,
+		ConflictPolicy:   RecordConflictPolicyError,
 	}
 }
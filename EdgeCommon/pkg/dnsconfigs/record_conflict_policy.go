@@ -0,0 +1,49 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package dnsconfigs
+
+// RecordConflictPolicy 添加记录时发现冲突的处理策略
+type RecordConflictPolicy = string
+
+const (
+	RecordConflictPolicyError     RecordConflictPolicy = "error"     // 返回错误
+	RecordConflictPolicySkip      RecordConflictPolicy = "skip"      // 跳过，保留已有的记录
+	RecordConflictPolicyOverwrite RecordConflictPolicy = "overwrite" // 删除冲突的记录后再添加
+)
+
+type RecordConflictPolicyDefinition struct {
+	Name        string               `json:"name"`
+	Code        RecordConflictPolicy `json:"code"`
+	Description string               `json:"description"`
+}
+
+// FindAllRecordConflictPolicies 所有冲突处理策略
+func FindAllRecordConflictPolicies() []*RecordConflictPolicyDefinition {
+	return []*RecordConflictPolicyDefinition{
+		{
+			Name:        "报错",
+			Code:        RecordConflictPolicyError,
+			Description: "停止同步并提示冲突的记录，需要手动处理。",
+		},
+		{
+			Name:        "跳过",
+			Code:        RecordConflictPolicySkip,
+			Description: "保留已有的记录，不再添加新的记录。",
+		},
+		{
+			Name:        "覆盖",
+			Code:        RecordConflictPolicyOverwrite,
+			Description: "删除冲突的记录，然后添加新的记录。",
+		},
+	}
+}
+
+// IsValidRecordConflictPolicy 检查策略是否有效
+func IsValidRecordConflictPolicy(policy RecordConflictPolicy) bool {
+	for _, def := range FindAllRecordConflictPolicies() {
+		if def.Code == policy {
+			return true
+		}
+	}
+	return false
+}
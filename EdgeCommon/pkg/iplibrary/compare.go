@@ -0,0 +1,98 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package iplibrary
+
+import (
+	"net"
+)
+
+// CompareDiff 两个IP库查询结果的差异
+type CompareDiff struct {
+	IP       string `json:"ip"`
+	Summary1 string `json:"summary1"`
+	Summary2 string `json:"summary2"`
+}
+
+// CompareResult IP库准确性对比结果
+type CompareResult struct {
+	CountIPs int `json:"countIPs"` // 对比的IP数量
+
+	CountFound1 int `json:"countFound1"` // 第一个IP库能查到的IP数量
+	CountFound2 int `json:"countFound2"` // 第二个IP库能查到的IP数量
+
+	CountCountryMatches  int `json:"countCountryMatches"`  // 国家/地区一致的数量
+	CountProvinceMatches int `json:"countProvinceMatches"` // 省份一致的数量
+	CountCityMatches     int `json:"countCityMatches"`     // 城市一致的数量
+	CountProviderMatches int `json:"countProviderMatches"` // ISP一致的数量
+
+	Diffs []*CompareDiff `json:"diffs"` // 不一致的IP
+}
+
+// Percent 计算百分比
+func (this *CompareResult) Percent(count int) float32 {
+	if this.CountIPs == 0 {
+		return 0
+	}
+	return float32(count) * 100 / float32(this.CountIPs)
+}
+
+// CompareReaders 对比两个IP库对一组IP的查询结果
+// maxDiffs 为最多返回的差异数量
+func CompareReaders(reader1 ReaderInterface, reader2 ReaderInterface, ipList []string, maxDiffs int) *CompareResult {
+	var result = &CompareResult{
+		Diffs: []*CompareDiff{},
+	}
+
+	for _, ipString := range ipList {
+		var ip = net.ParseIP(ipString)
+		if ip == nil {
+			continue
+		}
+		result.CountIPs++
+
+		var result1 = reader1.Lookup(ip)
+		var result2 = reader2.Lookup(ip)
+		var isOk1 = result1 != nil && result1.IsOk()
+		var isOk2 = result2 != nil && result2.IsOk()
+		if isOk1 {
+			result.CountFound1++
+		}
+		if isOk2 {
+			result.CountFound2++
+		}
+
+		var summary1 string
+		var summary2 string
+		if isOk1 {
+			summary1 = result1.Summary()
+		}
+		if isOk2 {
+			summary2 = result2.Summary()
+		}
+
+		if isOk1 && isOk2 {
+			if result1.CountryId() == result2.CountryId() {
+				result.CountCountryMatches++
+			}
+			if result1.ProvinceId() == result2.ProvinceId() {
+				result.CountProvinceMatches++
+			}
+			if result1.CityId() == result2.CityId() {
+				result.CountCityMatches++
+			}
+			if result1.ProviderId() == result2.ProviderId() {
+				result.CountProviderMatches++
+			}
+		}
+
+		if summary1 != summary2 && (maxDiffs <= 0 || len(result.Diffs) < maxDiffs) {
+			result.Diffs = append(result.Diffs, &CompareDiff{
+				IP:       ipString,
+				Summary1: summary1,
+				Summary2: summary2,
+			})
+		}
+	}
+
+	return result
+}
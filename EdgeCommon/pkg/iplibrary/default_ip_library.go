@@ -27,20 +27,60 @@ func InitDefault() error {
 	libraryLocker.Lock()
 	defer libraryLocker.Unlock()
 
+	library, err := loadCommonLibrary()
+	if err != nil {
+		return err
+	}
+
+	defaultLibrary = library
+	return nil
+}
+
+// InitWithReader 使用某个IP库读取器作为默认的IP库
+func InitWithReader(reader ReaderInterface) {
+	libraryLocker.Lock()
+	defer libraryLocker.Unlock()
+
+	defaultLibrary = NewIPLibraryWithReader(reader)
+}
+
+// DefaultMeta 内置IP库的元数据
+// 第三方IP库中的区域名称需要通过此元数据转换为系统中的区域ID
+func DefaultMeta() (*Meta, error) {
+	reader, err := DefaultReader()
+	if err != nil {
+		return nil, err
+	}
+	return reader.Meta(), nil
+}
+
+// DefaultReader 内置IP库的读取器
+// 此读取器为公用对象，调用者不能销毁
+func DefaultReader() (ReaderInterface, error) {
+	libraryLocker.Lock()
+	defer libraryLocker.Unlock()
+
+	library, err := loadCommonLibrary()
+	if err != nil {
+		return nil, err
+	}
+	return library.reader, nil
+}
+
+// 加载内置的IP库，调用前需要加锁
+func loadCommonLibrary() (*IPLibrary, error) {
 	if commonLibrary != nil {
-		defaultLibrary = commonLibrary
-		return nil
+		return commonLibrary, nil
 	}
 
 	var library = NewIPLibrary()
 	err := library.InitFromData(ipLibraryData, "", ReaderVersionV1)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	commonLibrary = library
-	defaultLibrary = commonLibrary
-	return nil
+	return library, nil
 }
 
 // Lookup 查询IP信息
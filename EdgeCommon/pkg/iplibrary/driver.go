@@ -0,0 +1,96 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package iplibrary
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"github.com/iwind/TeaGo/maps"
+)
+
+type DriverType = string
+
+const (
+	DriverTypeDefault DriverType = "default" // 内置IP库格式
+	DriverTypeMaxMind DriverType = "maxmind" // MaxMind GeoLite2/GeoIP2
+	DriverTypeIPIP    DriverType = "ipip"    // ipip.net
+)
+
+// FindAllDriverTypes 所有IP库驱动
+func FindAllDriverTypes() []maps.Map {
+	return []maps.Map{
+		{
+			"name":        "内置IP库",
+			"code":        DriverTypeDefault,
+			"description": "系统内置的IP库格式。",
+			"ext":         ".db",
+		},
+		{
+			"name":        "MaxMind",
+			"code":        DriverTypeMaxMind,
+			"description": "MaxMind提供的GeoLite2或GeoIP2 City数据库（.mmdb）。",
+			"ext":         ".mmdb",
+		},
+		{
+			"name":        "ipip.net",
+			"code":        DriverTypeIPIP,
+			"description": "ipip.net提供的IPDB格式数据库（.ipdb）。",
+			"ext":         ".ipdb",
+		},
+	}
+}
+
+// FindDriverTypeName 查找驱动名称
+func FindDriverTypeName(driverType DriverType) string {
+	for _, driver := range FindAllDriverTypes() {
+		if driver.GetString("code") == driverType {
+			return driver.GetString("name")
+		}
+	}
+	return ""
+}
+
+// IsValidDriverType 判断驱动类型是否有效
+func IsValidDriverType(driverType DriverType) bool {
+	return len(FindDriverTypeName(driverType)) > 0
+}
+
+// DriverFileExt 驱动对应的文件扩展名
+func DriverFileExt(driverType DriverType) string {
+	for _, driver := range FindAllDriverTypes() {
+		if driver.GetString("code") == driverType {
+			return driver.GetString("ext")
+		}
+	}
+	return ".db"
+}
+
+// DriverTypeWithPath 根据文件扩展名判断驱动类型
+func DriverTypeWithPath(path string) DriverType {
+	var ext = strings.ToLower(filepath.Ext(path))
+	for _, driver := range FindAllDriverTypes() {
+		if driver.GetString("ext") == ext {
+			return driver.GetString("code")
+		}
+	}
+	return DriverTypeDefault
+}
+
+// NewReaderWithDriver 根据驱动类型从文件中创建读取器
+func NewReaderWithDriver(driverType DriverType, path string) (ReaderInterface, error) {
+	switch driverType {
+	case DriverTypeDefault, "":
+		fileReader, err := NewFileReader(path, "")
+		if err != nil {
+			return nil, err
+		}
+		return fileReader.RawReader(), nil
+	case DriverTypeMaxMind:
+		return NewMaxMindReader(path)
+	case DriverTypeIPIP:
+		return NewIPDBReader(path)
+	}
+	return nil, errors.New("invalid ip library driver '" + driverType + "'")
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package iplibrary
+
+import (
+	"strings"
+)
+
+// 将第三方IP库中的区域名称匹配到元数据中的区域ID
+type metaMatcher struct {
+	countryMap  map[string]uint16 // name => id
+	provinceMap map[string]uint16 // name => id
+	cityMap     map[string]uint32 // name => id
+	providerMap map[string]uint16 // name => id
+}
+
+func newMetaMatcher(meta *Meta) *metaMatcher {
+	var matcher = &metaMatcher{
+		countryMap:  map[string]uint16{},
+		provinceMap: map[string]uint16{},
+		cityMap:     map[string]uint32{},
+		providerMap: map[string]uint16{},
+	}
+	if meta == nil {
+		return matcher
+	}
+
+	for _, country := range meta.Countries {
+		for _, name := range append([]string{country.Name}, country.Codes...) {
+			matcher.putKey(name, func(key string) {
+				if _, ok := matcher.countryMap[key]; !ok {
+					matcher.countryMap[key] = country.Id
+				}
+			})
+		}
+	}
+	for _, province := range meta.Provinces {
+		for _, name := range append([]string{province.Name}, province.Codes...) {
+			matcher.putKey(name, func(key string) {
+				if _, ok := matcher.provinceMap[key]; !ok {
+					matcher.provinceMap[key] = province.Id
+				}
+			})
+		}
+	}
+	for _, city := range meta.Cities {
+		for _, name := range append([]string{city.Name}, city.Codes...) {
+			matcher.putKey(name, func(key string) {
+				if _, ok := matcher.cityMap[key]; !ok {
+					matcher.cityMap[key] = city.Id
+				}
+			})
+		}
+	}
+	for _, provider := range meta.Providers {
+		for _, name := range append([]string{provider.Name}, provider.Codes...) {
+			matcher.putKey(name, func(key string) {
+				if _, ok := matcher.providerMap[key]; !ok {
+					matcher.providerMap[key] = provider.Id
+				}
+			})
+		}
+	}
+
+	return matcher
+}
+
+// Match 匹配区域
+// 每个参数都可以传入多个候选名称，比如同时传入中文和英文名称
+func (this *metaMatcher) Match(countryNames []string, provinceNames []string, cityNames []string, providerNames []string) *ipRegion {
+	var region = &ipRegion{}
+	for _, name := range countryNames {
+		id, ok := this.countryMap[this.key(name)]
+		if ok {
+			region.CountryId = id
+			break
+		}
+	}
+	if region.CountryId == 0 {
+		return nil
+	}
+
+	for _, name := range provinceNames {
+		id, ok := this.lookupProvince(name)
+		if ok {
+			region.ProvinceId = id
+			break
+		}
+	}
+	for _, name := range cityNames {
+		id, ok := this.lookupCity(name)
+		if ok {
+			region.CityId = id
+			break
+		}
+	}
+	for _, name := range providerNames {
+		id, ok := this.providerMap[this.key(name)]
+		if ok {
+			region.ProviderId = id
+			break
+		}
+	}
+	return region
+}
+
+func (this *metaMatcher) lookupProvince(name string) (uint16, bool) {
+	var key = this.key(name)
+	if len(key) == 0 {
+		return 0, false
+	}
+	for _, suffix := range []string{"", "省", "市", "自治区"} {
+		id, ok := this.provinceMap[key+suffix]
+		if ok {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+func (this *metaMatcher) lookupCity(name string) (uint32, bool) {
+	var key = this.key(name)
+	if len(key) == 0 {
+		return 0, false
+	}
+	for _, suffix := range []string{"", "市"} {
+		id, ok := this.cityMap[key+suffix]
+		if ok {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+func (this *metaMatcher) putKey(name string, f func(key string)) {
+	var key = this.key(name)
+	if len(key) > 0 {
+		f(key)
+	}
+}
+
+func (this *metaMatcher) key(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
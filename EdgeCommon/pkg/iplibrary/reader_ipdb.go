@@ -0,0 +1,201 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package iplibrary
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/iwind/TeaGo/types"
+)
+
+const (
+	ipdbVersionIPv4 = 0x01
+	ipdbVersionIPv6 = 0x02
+)
+
+type ipdbMeta struct {
+	Build     int64          `json:"build"`
+	IPVersion uint16         `json:"ip_version"`
+	Languages map[string]int `json:"languages"`
+	NodeCount int            `json:"node_count"`
+	TotalSize int            `json:"total_size"`
+	Fields    []string       `json:"fields"`
+}
+
+// IPDBReader ipip.net IPDB格式数据库读取器
+type IPDBReader struct {
+	rawMeta  *ipdbMeta
+	data     []byte
+	v4Offset int
+
+	meta    *Meta
+	matcher *metaMatcher
+}
+
+// NewIPDBReader 从.ipdb文件中创建读取器
+func NewIPDBReader(path string) (*IPDBReader, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewIPDBReaderWithData(data)
+}
+
+// NewIPDBReaderWithData 从数据中创建读取器
+func NewIPDBReaderWithData(data []byte) (*IPDBReader, error) {
+	if len(data) < 4 {
+		return nil, errors.New("invalid ipdb file: file too small")
+	}
+
+	var metaLength = int(binary.BigEndian.Uint32(data[:4]))
+	if metaLength <= 0 || len(data) < 4+metaLength {
+		return nil, errors.New("invalid ipdb file: invalid meta length")
+	}
+
+	var rawMeta = &ipdbMeta{}
+	err := json.Unmarshal(data[4:4+metaLength], rawMeta)
+	if err != nil {
+		return nil, errors.New("invalid ipdb file: decode meta failed: " + err.Error())
+	}
+	if len(rawMeta.Languages) == 0 || len(rawMeta.Fields) == 0 {
+		return nil, errors.New("invalid ipdb file: invalid meta")
+	}
+
+	var content = data[4+metaLength:]
+	if len(content) != rawMeta.TotalSize || rawMeta.NodeCount*8 > len(content) {
+		return nil, errors.New("invalid ipdb file: database size mismatch")
+	}
+
+	defaultMeta, err := DefaultMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	var meta = *defaultMeta
+	meta.Code = DriverTypeIPIP + "-" + types.String(rawMeta.Build)
+	meta.Author = "ipip.net"
+	meta.CreatedAt = rawMeta.Build
+
+	var reader = &IPDBReader{
+		rawMeta: rawMeta,
+		data:    content,
+		meta:    &meta,
+		matcher: newMetaMatcher(defaultMeta),
+	}
+
+	// IPv4位于 ::ffff:0:0/96 下
+	var node = 0
+	for i := 0; i < 96 && node < rawMeta.NodeCount; i++ {
+		if i >= 80 {
+			node = reader.readNode(node, 1)
+		} else {
+			node = reader.readNode(node, 0)
+		}
+	}
+	reader.v4Offset = node
+
+	return reader, nil
+}
+
+func (this *IPDBReader) Meta() *Meta {
+	return this.meta
+}
+
+func (this *IPDBReader) Lookup(ip net.IP) *QueryResult {
+	var fields = this.Find(ip)
+	if len(fields) == 0 {
+		return &QueryResult{}
+	}
+
+	var region = this.matcher.Match([]string{fields["country_name"]}, []string{fields["region_name"]}, []string{fields["city_name"]}, []string{fields["isp_domain"], fields["owner_domain"]})
+	if region == nil {
+		return &QueryResult{}
+	}
+	return &QueryResult{
+		item: region,
+		meta: this.meta,
+	}
+}
+
+// Find 查找IP对应的原始字段
+func (this *IPDBReader) Find(ip net.IP) map[string]string {
+	if this.data == nil || ip == nil {
+		return nil
+	}
+
+	var node int
+	var bitCount int
+	var ipv4 = ip.To4()
+	if ipv4 != nil {
+		if this.rawMeta.IPVersion&ipdbVersionIPv4 == 0 {
+			return nil
+		}
+		ip = ipv4
+		node = this.v4Offset
+		bitCount = 32
+	} else {
+		if this.rawMeta.IPVersion&ipdbVersionIPv6 == 0 {
+			return nil
+		}
+		ip = ip.To16()
+		if ip == nil {
+			return nil
+		}
+		bitCount = 128
+	}
+
+	var nodeCount = this.rawMeta.NodeCount
+	for i := 0; i < bitCount; i++ {
+		if node > nodeCount {
+			break
+		}
+		node = this.readNode(node, int((ip[i>>3]>>(7-uint(i%8)))&1))
+	}
+	if node <= nodeCount {
+		return nil
+	}
+
+	// 读取数据
+	var offset = node - nodeCount + nodeCount*8
+	if offset+2 > len(this.data) {
+		return nil
+	}
+	var size = int(binary.BigEndian.Uint16(this.data[offset : offset+2]))
+	if offset+2+size > len(this.data) {
+		return nil
+	}
+
+	var values = strings.Split(string(this.data[offset+2:offset+2+size]), "\t")
+	var languageOffset, ok = this.rawMeta.Languages["CN"]
+	if !ok {
+		for _, languageOffset = range this.rawMeta.Languages {
+			break
+		}
+	}
+	if languageOffset+len(this.rawMeta.Fields) > len(values) {
+		return nil
+	}
+
+	var result = map[string]string{}
+	for index, field := range this.rawMeta.Fields {
+		result[field] = values[languageOffset+index]
+	}
+	return result
+}
+
+func (this *IPDBReader) Destroy() {
+	this.data = nil
+}
+
+func (this *IPDBReader) readNode(node int, index int) int {
+	var offset = node*8 + index*4
+	if offset+4 > len(this.data) {
+		return this.rawMeta.NodeCount
+	}
+	return int(binary.BigEndian.Uint32(this.data[offset : offset+4]))
+}
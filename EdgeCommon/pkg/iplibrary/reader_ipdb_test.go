@@ -0,0 +1,176 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package iplibrary_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/iplibrary"
+	"github.com/iwind/TeaGo/assert"
+)
+
+// 构造一个只包含若干IPv4 /24网段的IPDB文件
+func buildTestIPDBData(t *testing.T, networks map[string]string /** 1.2.3.0 => fields **/) []byte {
+	const emptyNode = -1
+	var nodes = [][2]int{{emptyNode, emptyNode}}
+	var leaves = map[[2]int]int{} // [node, bit] => data index
+	var values = []string{}
+
+	for network, value := range networks {
+		var ip = net.ParseIP(network).To4()
+		if ip == nil {
+			t.Fatal("invalid network '" + network + "'")
+		}
+
+		var bits = []int{}
+		for i := 0; i < 80; i++ {
+			bits = append(bits, 0)
+		}
+		for i := 0; i < 16; i++ {
+			bits = append(bits, 1)
+		}
+		for i := 0; i < 24; i++ {
+			bits = append(bits, int(ip[i>>3]>>(7-uint(i%8)))&1)
+		}
+
+		var node = 0
+		for _, bit := range bits[:len(bits)-1] {
+			if nodes[node][bit] == emptyNode {
+				nodes = append(nodes, [2]int{emptyNode, emptyNode})
+				nodes[node][bit] = len(nodes) - 1
+			}
+			node = nodes[node][bit]
+		}
+		leaves[[2]int{node, bits[len(bits)-1]}] = len(values)
+		values = append(values, value)
+	}
+
+	var nodeCount = len(nodes)
+
+	// 数据区，第一个字节用来占位，避免数据偏移为0
+	var dataSection = []byte{0}
+	var valueOffsets = []int{}
+	for _, value := range values {
+		valueOffsets = append(valueOffsets, len(dataSection))
+		dataSection = binary.BigEndian.AppendUint16(dataSection, uint16(len(value)))
+		dataSection = append(dataSection, value...)
+	}
+
+	var content = []byte{}
+	for nodeIndex, node := range nodes {
+		for bit, child := range node {
+			var value = nodeCount
+			if child != emptyNode {
+				value = child
+			} else if dataIndex, ok := leaves[[2]int{nodeIndex, bit}]; ok {
+				value = nodeCount + valueOffsets[dataIndex]
+			}
+			content = binary.BigEndian.AppendUint32(content, uint32(value))
+		}
+	}
+	content = append(content, dataSection...)
+
+	metaJSON, err := json.Marshal(map[string]any{
+		"build":      1700000000,
+		"ip_version": 1,
+		"languages":  map[string]int{"CN": 0},
+		"node_count": nodeCount,
+		"total_size": len(content),
+		"fields":     []string{"country_name", "region_name", "city_name", "isp_domain"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data = binary.BigEndian.AppendUint32(nil, uint32(len(metaJSON)))
+	data = append(data, metaJSON...)
+	return append(data, content...)
+}
+
+func TestIPDBReader_Lookup(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	reader, err := iplibrary.NewIPDBReaderWithData(buildTestIPDBData(t, map[string]string{
+		"1.2.3.0": "中国\t江苏\t南京\t电信",
+		"8.8.8.0": "美国\t\t\t谷歌",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(reader.Meta().Code == "ipip-1700000000")
+
+	{
+		var result = reader.Lookup(net.ParseIP("1.2.3.4"))
+		a.IsTrue(result.IsOk())
+		a.IsTrue(result.CountryName() == "中国")
+		a.IsTrue(result.ProvinceName() == "江苏省")
+		a.IsTrue(result.CityName() == "南京市")
+		a.IsTrue(result.ProviderName() == "电信")
+		t.Log(result.Summary())
+	}
+	{
+		var result = reader.Lookup(net.ParseIP("8.8.8.8"))
+		a.IsTrue(result.IsOk())
+		a.IsTrue(result.CountryName() == "美国")
+		a.IsTrue(result.ProvinceId() == 0)
+		t.Log(result.Summary())
+	}
+	{
+		a.IsFalse(reader.Lookup(net.ParseIP("9.9.9.9")).IsOk())
+		a.IsFalse(reader.Lookup(net.ParseIP("::1")).IsOk())
+	}
+}
+
+func TestIPDBReader_Invalid(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	_, err := iplibrary.NewIPDBReaderWithData([]byte("abc"))
+	a.IsNotNil(err)
+
+	_, err = iplibrary.NewIPDBReaderWithData([]byte{0, 0, 0, 2, '{', '}'})
+	a.IsNotNil(err)
+}
+
+func TestCompareReaders(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	reader1, err := iplibrary.NewIPDBReaderWithData(buildTestIPDBData(t, map[string]string{
+		"1.2.3.0": "中国\t江苏\t南京\t电信",
+		"8.8.8.0": "美国\t\t\t谷歌",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader2, err := iplibrary.NewIPDBReaderWithData(buildTestIPDBData(t, map[string]string{
+		"1.2.3.0": "中国\t江苏\t苏州\t电信",
+		"8.8.8.0": "美国\t\t\t谷歌",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result = iplibrary.CompareReaders(reader1, reader2, []string{"1.2.3.4", "8.8.8.8", "9.9.9.9", "invalid"}, 10)
+	a.IsTrue(result.CountIPs == 3)
+	a.IsTrue(result.CountFound1 == 2)
+	a.IsTrue(result.CountFound2 == 2)
+	a.IsTrue(result.CountCountryMatches == 2)
+	a.IsTrue(result.CountProvinceMatches == 2)
+	a.IsTrue(result.CountCityMatches == 1)
+	a.IsTrue(len(result.Diffs) == 1)
+	a.IsTrue(result.Diffs[0].IP == "1.2.3.4")
+	t.Logf("%+v", result.Diffs[0])
+}
+
+func TestDriverTypeWithPath(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(iplibrary.DriverTypeWithPath("data/ip-library.db") == iplibrary.DriverTypeDefault)
+	a.IsTrue(iplibrary.DriverTypeWithPath("data/ip-abc.v2.db") == iplibrary.DriverTypeDefault)
+	a.IsTrue(iplibrary.DriverTypeWithPath("data/GeoLite2-City.mmdb") == iplibrary.DriverTypeMaxMind)
+	a.IsTrue(iplibrary.DriverTypeWithPath("data/ipipfree.IPDB") == iplibrary.DriverTypeIPIP)
+	a.IsTrue(iplibrary.DriverFileExt(iplibrary.DriverTypeMaxMind) == ".mmdb")
+	a.IsTrue(iplibrary.IsValidDriverType(iplibrary.DriverTypeIPIP))
+	a.IsFalse(iplibrary.IsValidDriverType("abc"))
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package iplibrary
+
+import (
+	"net"
+
+	"github.com/iwind/TeaGo/types"
+	"github.com/oschwald/maxminddb-golang"
+)
+
+type maxMindNames struct {
+	Names map[string]string `maxminddb:"names"`
+}
+
+type maxMindRecord struct {
+	Country      maxMindNames   `maxminddb:"country"`
+	Subdivisions []maxMindNames `maxminddb:"subdivisions"`
+	City         maxMindNames   `maxminddb:"city"`
+
+	// GeoIP2 ISP/ASN 数据库中的字段
+	ISP                          string `maxminddb:"isp"`
+	AutonomousSystemOrganization string `maxminddb:"autonomous_system_organization"`
+}
+
+// MaxMindReader MaxMind GeoLite2/GeoIP2数据库读取器
+type MaxMindReader struct {
+	rawReader *maxminddb.Reader
+	meta      *Meta
+	matcher   *metaMatcher
+}
+
+// NewMaxMindReader 从.mmdb文件中创建读取器
+func NewMaxMindReader(path string) (*MaxMindReader, error) {
+	rawReader, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return newMaxMindReader(rawReader)
+}
+
+// NewMaxMindReaderWithData 从数据中创建读取器
+func NewMaxMindReaderWithData(data []byte) (*MaxMindReader, error) {
+	rawReader, err := maxminddb.FromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	return newMaxMindReader(rawReader)
+}
+
+func newMaxMindReader(rawReader *maxminddb.Reader) (*MaxMindReader, error) {
+	defaultMeta, err := DefaultMeta()
+	if err != nil {
+		_ = rawReader.Close()
+		return nil, err
+	}
+
+	var meta = *defaultMeta
+	meta.Code = DriverTypeMaxMind + "-" + types.String(rawReader.Metadata.BuildEpoch)
+	meta.Author = "MaxMind"
+	meta.CreatedAt = int64(rawReader.Metadata.BuildEpoch)
+
+	return &MaxMindReader{
+		rawReader: rawReader,
+		meta:      &meta,
+		matcher:   newMetaMatcher(defaultMeta),
+	}, nil
+}
+
+func (this *MaxMindReader) Meta() *Meta {
+	return this.meta
+}
+
+func (this *MaxMindReader) Lookup(ip net.IP) *QueryResult {
+	if this.rawReader == nil || ip == nil {
+		return &QueryResult{}
+	}
+
+	var record = &maxMindRecord{}
+	err := this.rawReader.Lookup(ip, record)
+	if err != nil {
+		return &QueryResult{}
+	}
+
+	var provinceNames = []string{}
+	if len(record.Subdivisions) > 0 {
+		provinceNames = this.names(record.Subdivisions[0])
+	}
+	var region = this.matcher.Match(this.names(record.Country), provinceNames, this.names(record.City), []string{record.ISP, record.AutonomousSystemOrganization})
+	if region == nil {
+		return &QueryResult{}
+	}
+	return &QueryResult{
+		item: region,
+		meta: this.meta,
+	}
+}
+
+func (this *MaxMindReader) Destroy() {
+	if this.rawReader != nil {
+		_ = this.rawReader.Close()
+		this.rawReader = nil
+	}
+}
+
+// 优先使用中文名称
+func (this *MaxMindReader) names(names maxMindNames) []string {
+	var result = []string{}
+	for _, lang := range []string{"zh-CN", "en"} {
+		var name = names.Names[lang]
+		if len(name) > 0 {
+			result = append(result, name)
+		}
+	}
+	return result
+}
@@ -230,6 +230,8 @@ func (this *QueryResult) realCountryId() uint16 {
 			return item.Region.CountryId
 		case ipv6ItemV2:
 			return item.Region.CountryId
+		case *ipRegion:
+			return item.CountryId
 		}
 
 	}
@@ -255,6 +257,8 @@ func (this *QueryResult) realProvinceId() uint16 {
 			return item.Region.ProvinceId
 		case ipv6ItemV2:
 			return item.Region.ProvinceId
+		case *ipRegion:
+			return item.ProvinceId
 		}
 
 	}
@@ -280,6 +284,8 @@ func (this *QueryResult) realCityId() uint32 {
 			return item.Region.CityId
 		case ipv6ItemV2:
 			return item.Region.CityId
+		case *ipRegion:
+			return item.CityId
 		}
 
 	}
@@ -305,6 +311,8 @@ func (this *QueryResult) realTownId() uint32 {
 			return item.Region.TownId
 		case ipv6ItemV2:
 			return item.Region.TownId
+		case *ipRegion:
+			return item.TownId
 		}
 
 	}
@@ -330,6 +338,8 @@ func (this *QueryResult) realProviderId() uint16 {
 			return item.Region.ProviderId
 		case ipv6ItemV2:
 			return item.Region.ProviderId
+		case *ipRegion:
+			return item.ProviderId
 		}
 
 	}
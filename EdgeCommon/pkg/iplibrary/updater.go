@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -31,6 +32,13 @@ type UpdaterSource interface {
 	LogError(err error)
 }
 
+// UpdaterDriverSource 支持多种IP库驱动的更新源
+// 如果更新源实现了此接口，则优先使用此接口查询最新的IP库文件
+type UpdaterDriverSource interface {
+	// FindLatestFileWithDriver 检查最新的IP库文件及其驱动类型
+	FindLatestFileWithDriver() (code string, fileId int64, driverType DriverType, err error)
+}
+
 type Updater struct {
 	source UpdaterSource
 
@@ -71,20 +79,22 @@ func (this *Updater) Start() {
 
 func (this *Updater) Init() error {
 	// 检查当前正在使用的IP库
-	var path = this.source.DataDir() + "/ip-library.db"
-	fp, err := os.Open(path)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil
+	for _, path := range this.defaultFilePaths() {
+		fp, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			return fmt.Errorf("read ip library file failed '%w'", err)
 		}
 
-		return fmt.Errorf("read ip library file failed '%w'", err)
-	}
-	defer func() {
+		err = this.loadFile(fp)
 		_ = fp.Close()
-	}()
+		return err
+	}
 
-	return this.loadFile(fp)
+	return nil
 }
 
 func (this *Updater) Loop() error {
@@ -98,7 +108,16 @@ func (this *Updater) Loop() error {
 		this.isUpdating = false
 	}()
 
-	code, fileId, err := this.source.FindLatestFile()
+	var code string
+	var fileId int64
+	var driverType = DriverTypeDefault
+	var err error
+	driverSource, ok := this.source.(UpdaterDriverSource)
+	if ok {
+		code, fileId, driverType, err = driverSource.FindLatestFileWithDriver()
+	} else {
+		code, fileId, err = this.source.FindLatestFile()
+	}
 	if err != nil {
 		// 不提示连接错误
 		if this.isConnError(err) {
@@ -112,12 +131,13 @@ func (this *Updater) Loop() error {
 			this.currentCode = ""
 			this.source.LogInfo("resetting to default ip library ...")
 
-			var defaultPath = this.source.DataDir() + "/ip-library.db"
-			_, err = os.Stat(defaultPath)
-			if err == nil {
-				err = os.Remove(defaultPath)
-				if err != nil {
-					this.source.LogError(errors.New("can not remove default 'ip-library.db'"))
+			for _, defaultPath := range this.defaultFilePaths() {
+				_, err = os.Stat(defaultPath)
+				if err == nil {
+					err = os.Remove(defaultPath)
+					if err != nil {
+						this.source.LogError(errors.New("can not remove default '" + filepath.Base(defaultPath) + "'"))
+					}
 				}
 			}
 
@@ -138,7 +158,7 @@ func (this *Updater) Loop() error {
 
 	// 检查是否存在
 	var dir = this.source.DataDir()
-	var path = dir + "/ip-" + code + ".db"
+	var path = dir + "/ip-" + code + DriverFileExt(driverType)
 	stat, err := os.Stat(path)
 	if err == nil && !stat.IsDir() && stat.Size() > 0 {
 		fp, err := os.Open(path)
@@ -215,18 +235,28 @@ func (this *Updater) Loop() error {
 func (this *Updater) loadFile(fp *os.File) error {
 	this.source.LogInfo("load ip library from '" + fp.Name() + "' ...")
 
-	var version = ReaderVersionV1
-	if strings.HasSuffix(fp.Name(), ".v2.db") {
-		version = ReaderVersionV2
-	}
+	var reader ReaderInterface
+	var driverType = DriverTypeWithPath(fp.Name())
+	if driverType == DriverTypeDefault {
+		var version = ReaderVersionV1
+		if strings.HasSuffix(fp.Name(), ".v2.db") {
+			version = ReaderVersionV2
+		}
 
-	fileReader, err := NewFileDataReader(fp, "", version)
-	if err != nil {
-		return fmt.Errorf("load ip library from reader failed: %w", err)
+		fileReader, err := NewFileDataReader(fp, "", version)
+		if err != nil {
+			return fmt.Errorf("load ip library from reader failed: %w", err)
+		}
+		reader = fileReader.RawReader()
+	} else {
+		driverReader, err := NewReaderWithDriver(driverType, fp.Name())
+		if err != nil {
+			return fmt.Errorf("load ip library with driver '%s' failed: %w", driverType, err)
+		}
+		reader = driverReader
 	}
 
-	var reader = fileReader.RawReader()
-	defaultLibrary = NewIPLibraryWithReader(reader)
+	InitWithReader(reader)
 	this.currentCode = reader.Meta().Code
 	return nil
 }
@@ -240,7 +270,15 @@ func (this *Updater) createDefaultFile(sourcePath string, dir string) error {
 		_ = sourceFp.Close()
 	}()
 
-	dstFp, err := os.Create(dir + "/ip-library.db")
+	// 删除其他格式的默认文件
+	var dstPath = dir + "/ip-library" + DriverFileExt(DriverTypeWithPath(sourcePath))
+	for _, defaultPath := range this.defaultFilePaths() {
+		if defaultPath != dstPath {
+			_ = os.Remove(defaultPath)
+		}
+	}
+
+	dstFp, err := os.Create(dstPath)
 	if err != nil {
 		return fmt.Errorf("prepare to copy file to 'ip-library.db' failed: %w", err)
 	}
@@ -254,6 +292,16 @@ func (this *Updater) createDefaultFile(sourcePath string, dir string) error {
 	return nil
 }
 
+// 所有可能的默认IP库文件路径
+func (this *Updater) defaultFilePaths() []string {
+	var dir = this.source.DataDir()
+	var result = []string{}
+	for _, driver := range FindAllDriverTypes() {
+		result = append(result, dir+"/ip-library"+driver.GetString("ext"))
+	}
+	return result
+}
+
 // isConnError 是否为连接错误
 func (this *Updater) isConnError(err error) bool {
 	if err == nil {
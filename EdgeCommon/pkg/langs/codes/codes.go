@@ -12,10 +12,12 @@ const (
 	ACMEProviderAccount_LogUpdateACMEProviderAccount            langs.MessageCode = "acme_provider_account@log_update_acme_provider_account"              // 修改ACME服务商账号 %d
 	ACMETask_LogCreateACMETask                                  langs.MessageCode = "acme_task@log_create_acme_task"                                      // 创建证书申请任务 %d
 	ACMETask_LogDeleteACMETask                                  langs.MessageCode = "acme_task@log_delete_acme_task"                                      // 删除证书申请任务 %d
+	ACMETask_LogDryRunACMETask                                  langs.MessageCode = "acme_task@log_dry_run_acme_task"                                     // 试运行ACME任务 %d
 	ACMETask_LogRunACMETask                                     langs.MessageCode = "acme_task@log_run_acme_task"                                         // 执行ACME任务 %d
 	ACMETask_LogUpdateACMETask                                  langs.MessageCode = "acme_task@log_update_acme_task"                                      // 修改证书申请任务 %d
 	ACMEUser_LogCreateACMEUser                                  langs.MessageCode = "acme_user@log_create_acme_user"                                      // 创建ACME用户 %d
 	ACMEUser_LogDeleteACMEUser                                  langs.MessageCode = "acme_user@log_delete_acme_user"                                      // 删除ACME用户 %d
+	ACMEUser_LogImportACMEUser                                  langs.MessageCode = "acme_user@log_import_acme_user"                                      // 导入ACME用户 %d
 	ACMEUser_LogUpdateACMEUser                                  langs.MessageCode = "acme_user@log_update_acme_user"                                      // 修改ACME用户 %d
 	ADNetwork_LogCreateADNetwork                                langs.MessageCode = "ad_network@log_create_ad_network"                                    // 创建高防IP线路 %d
 	ADNetwork_LogDeleteADNetwork                                langs.MessageCode = "ad_network@log_delete_ad_network"                                    // 删除高防IP线路
@@ -209,8 +211,10 @@ const (
 	IPItem_LogReadAllIPItems                                    langs.MessageCode = "ip_item@log_read_all_ip_items"                                       // 将所有IP名单置为已读
 	IPItem_LogUpdateIPItem                                      langs.MessageCode = "ip_item@log_update_ip_item"                                          // 修改IP名单中的IP %d
 	IPLibrary_LogFinishIPLibrary                                langs.MessageCode = "ip_library@log_finish_ip_library"                                    // 完成IP库%d 制作
+	IPLibrary_LogUpdateIPLibrarySettings                        langs.MessageCode = "ip_library@log_update_ip_library_settings"                           // 修改IP库设置
 	IPLibraryArtifact_LogCancelIPLibraryArtifact                langs.MessageCode = "ip_library_artifact@log_cancel_ip_library_artifact"                  // 取消使用IP库 %d
 	IPLibraryArtifact_LogDeleteIPLibraryArtifact                langs.MessageCode = "ip_library_artifact@log_delete_ip_library_artifact"                  // 删除IP库 %d
+	IPLibraryArtifact_LogDownloadIPLibraryArtifact              langs.MessageCode = "ip_library_artifact@log_download_ip_library_artifact"                // 下载IP库 %d
 	IPLibraryArtifact_LogUseIPLibraryArtifact                   langs.MessageCode = "ip_library_artifact@log_use_ip_library_artifact"                     // 使用IP库 %d
 	IPLibraryFile_LogDeleteIPLibraryFile                        langs.MessageCode = "ip_library_file@log_delete_ip_library_file"                          // 删除IP库文件 %d
 	IPLibraryFile_LogGenerateIPLibraryFile                      langs.MessageCode = "ip_library_file@log_generate_ip_library_file"                        // 重新生成IP库 %d 文件
@@ -245,6 +249,9 @@ const (
 	MessageRecipient_LogCreateMessageRecipient                  langs.MessageCode = "message_recipient@log_create_message_recipient"                      // 创建媒介接收人 %d
 	MessageRecipient_LogDeleteMessageRecipient                  langs.MessageCode = "message_recipient@log_delete_message_recipient"                      // 删除媒介接收人 %d
 	MessageRecipient_LogUpdateMessageRecipient                  langs.MessageCode = "message_recipient@log_update_message_recipient"                      // 修改媒介接收人 %d
+	MessageSilence_LogCreateMessageSilence                      langs.MessageCode = "message_silence@log_create_message_silence"                          // 创建告警静默规则 %d
+	MessageSilence_LogDeleteMessageSilence                      langs.MessageCode = "message_silence@log_delete_message_silence"                          // 删除告警静默规则 %d
+	MessageSilence_LogUpdateMessageSilence                      langs.MessageCode = "message_silence@log_update_message_silence"                          // 修改告警静默规则 %d
 	MessageTask_LogCreateTestingMessageTask                     langs.MessageCode = "message_task@log_create_testing_message_task"                        // 创建媒介测试任务 %d
 	MessageTask_LogDeleteMessageTask                            langs.MessageCode = "message_task@log_delete_message_task"                                // 删除消息发送任务 %d
 	MessageTask_LogUpdateMessageTaskStatus                      langs.MessageCode = "message_task@log_update_message_task_status"                         // 修改消息任务 %d 状态为 %d
@@ -642,6 +649,7 @@ const (
 	SSLCert_LogOCSPIgnoreOCSPStatus                             langs.MessageCode = "ssl_cert@log_ocsp_ignore_ocsp_status"                                // 忽略一组证书的OCSP状态
 	SSLCert_LogOCSPResetAllOCSPStatus                           langs.MessageCode = "ssl_cert@log_ocsp_reset_all_ocsp_status"                             // 忽略所有证书的OCSP状态
 	SSLCert_LogOCSPResetOCSPStatus                              langs.MessageCode = "ssl_cert@log_ocsp_reset_ocsp_status"                                 // 重置一组证书的OCSP状态
+	SSLCert_LogRevokeSSLCert                                    langs.MessageCode = "ssl_cert@log_revoke_ssl_cert"                                        // 吊销SSL证书 %d
 	SSLCert_LogUpdateSSLCert                                    langs.MessageCode = "ssl_cert@log_update_ssl_cert"                                        // 修改SSL证书 %d
 	SSLCert_LogUploadSSLCert                                    langs.MessageCode = "ssl_cert@log_upload_ssl_cert"                                        // 上传SSL证书 %d
 	SSLCert_LogUploadSSLCertBatch                               langs.MessageCode = "ssl_cert@log_upload_ssl_cert_batch"                                  // 批量上传证书
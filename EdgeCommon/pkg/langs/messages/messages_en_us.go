@@ -13,10 +13,12 @@ func init() {
 		"acme_provider_account@log_update_acme_provider_account":              "",
 		"acme_task@log_create_acme_task":                                      "",
 		"acme_task@log_delete_acme_task":                                      "",
+		"acme_task@log_dry_run_acme_task":                                     "",
 		"acme_task@log_run_acme_task":                                         "",
 		"acme_task@log_update_acme_task":                                      "",
 		"acme_user@log_create_acme_user":                                      "",
 		"acme_user@log_delete_acme_user":                                      "",
+		"acme_user@log_import_acme_user":                                      "",
 		"acme_user@log_update_acme_user":                                      "",
 		"ad_network@log_create_ad_network":                                    "",
 		"ad_network@log_delete_ad_network":                                    "",
@@ -210,8 +212,10 @@ func init() {
 		"ip_item@log_read_all_ip_items":                                       "",
 		"ip_item@log_update_ip_item":                                          "",
 		"ip_library@log_finish_ip_library":                                    "",
+		"ip_library@log_update_ip_library_settings":                           "",
 		"ip_library_artifact@log_cancel_ip_library_artifact":                  "",
 		"ip_library_artifact@log_delete_ip_library_artifact":                  "",
+		"ip_library_artifact@log_download_ip_library_artifact":                "",
 		"ip_library_artifact@log_use_ip_library_artifact":                     "",
 		"ip_library_file@log_delete_ip_library_file":                          "",
 		"ip_library_file@log_generate_ip_library_file":                        "",
@@ -246,6 +250,9 @@ func init() {
 		"message_recipient@log_create_message_recipient":                      "",
 		"message_recipient@log_delete_message_recipient":                      "",
 		"message_recipient@log_update_message_recipient":                      "",
+		"message_silence@log_create_message_silence":                          "",
+		"message_silence@log_delete_message_silence":                          "",
+		"message_silence@log_update_message_silence":                          "",
 		"message_task@log_create_testing_message_task":                        "",
 		"message_task@log_delete_message_task":                                "",
 		"message_task@log_update_message_task_status":                         "",
@@ -643,6 +650,7 @@ func init() {
 		"ssl_cert@log_ocsp_ignore_ocsp_status":                                "",
 		"ssl_cert@log_ocsp_reset_all_ocsp_status":                             "",
 		"ssl_cert@log_ocsp_reset_ocsp_status":                                 "",
+		"ssl_cert@log_revoke_ssl_cert":                                        "",
 		"ssl_cert@log_update_ssl_cert":                                        "",
 		"ssl_cert@log_upload_ssl_cert":                                        "",
 		"ssl_cert@log_upload_ssl_cert_batch":                                  "",
@@ -13,10 +13,12 @@ func init() {
 		"acme_provider_account@log_update_acme_provider_account":              "修改ACME服务商账号 %d",
 		"acme_task@log_create_acme_task":                                      "创建证书申请任务 %d",
 		"acme_task@log_delete_acme_task":                                      "删除证书申请任务 %d",
+		"acme_task@log_dry_run_acme_task":                                     "试运行ACME任务 %d",
 		"acme_task@log_run_acme_task":                                         "执行ACME任务 %d",
 		"acme_task@log_update_acme_task":                                      "修改证书申请任务 %d",
 		"acme_user@log_create_acme_user":                                      "创建ACME用户 %d",
 		"acme_user@log_delete_acme_user":                                      "删除ACME用户 %d",
+		"acme_user@log_import_acme_user":                                      "导入ACME用户 %d",
 		"acme_user@log_update_acme_user":                                      "修改ACME用户 %d",
 		"ad_network@log_create_ad_network":                                    "创建高防IP线路 %d",
 		"ad_network@log_delete_ad_network":                                    "删除高防IP线路",
@@ -210,8 +212,10 @@ func init() {
 		"ip_item@log_read_all_ip_items":                                       "将所有IP名单置为已读",
 		"ip_item@log_update_ip_item":                                          "修改IP名单中的IP %d",
 		"ip_library@log_finish_ip_library":                                    "完成IP库%d 制作",
+		"ip_library@log_update_ip_library_settings":                           "修改IP库设置",
 		"ip_library_artifact@log_cancel_ip_library_artifact":                  "取消使用IP库 %d",
 		"ip_library_artifact@log_delete_ip_library_artifact":                  "删除IP库 %d",
+		"ip_library_artifact@log_download_ip_library_artifact":                "下载IP库 %d",
 		"ip_library_artifact@log_use_ip_library_artifact":                     "使用IP库 %d",
 		"ip_library_file@log_delete_ip_library_file":                          "删除IP库文件 %d",
 		"ip_library_file@log_generate_ip_library_file":                        "重新生成IP库 %d 文件",
@@ -246,6 +250,9 @@ func init() {
 		"message_recipient@log_create_message_recipient":                      "创建媒介接收人 %d",
 		"message_recipient@log_delete_message_recipient":                      "删除媒介接收人 %d",
 		"message_recipient@log_update_message_recipient":                      "修改媒介接收人 %d",
+		"message_silence@log_create_message_silence":                          "创建告警静默规则 %d",
+		"message_silence@log_delete_message_silence":                          "删除告警静默规则 %d",
+		"message_silence@log_update_message_silence":                          "修改告警静默规则 %d",
 		"message_task@log_create_testing_message_task":                        "创建媒介测试任务 %d",
 		"message_task@log_delete_message_task":                                "删除消息发送任务 %d",
 		"message_task@log_update_message_task_status":                         "修改消息任务 %d 状态为 %d",
@@ -643,6 +650,7 @@ func init() {
 		"ssl_cert@log_ocsp_ignore_ocsp_status":                                "忽略一组证书的OCSP状态",
 		"ssl_cert@log_ocsp_reset_all_ocsp_status":                             "忽略所有证书的OCSP状态",
 		"ssl_cert@log_ocsp_reset_ocsp_status":                                 "重置一组证书的OCSP状态",
+		"ssl_cert@log_revoke_ssl_cert":                                        "吊销SSL证书 %d",
 		"ssl_cert@log_update_ssl_cert":                                        "修改SSL证书 %d",
 		"ssl_cert@log_upload_ssl_cert":                                        "上传SSL证书 %d",
 		"ssl_cert@log_upload_ssl_cert_batch":                                  "批量上传证书",
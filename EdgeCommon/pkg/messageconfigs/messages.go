@@ -12,6 +12,7 @@ const (
 	MessageCodeCheckLocalFirewall  MessageCode = "checkLocalFirewall"  // 检查本地防火墙
 	MessageCodeNewNodeTask         MessageCode = "newNodeTask"         // 有新的节点任务产生
 	MessageCodeChangeAPINode       MessageCode = "changeAPINode"       // 改变新的API节点
+	MessageCodeRunNodeCommand      MessageCode = "runNodeCommand"      // 执行远程命令
 )
 
 // ConnectedAPINodeMessage 连接API节点成功
@@ -61,3 +62,8 @@ type NewNodeTaskMessage struct {
 type ChangeAPINodeMessage struct {
 	Addr string `json:"addr"`
 }
+
+// RunNodeCommandMessage 执行远程命令
+type RunNodeCommandMessage struct {
+	Command string `json:"command"` // 命令代号，参考 nodeconfigs.NodeCommand
+}
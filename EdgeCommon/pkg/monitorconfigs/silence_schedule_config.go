@@ -0,0 +1,95 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package monitorconfigs
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/iwind/TeaGo/types"
+)
+
+var silenceTimeReg = regexp.MustCompile(`^(\d{1,2}):(\d{1,2})$`)
+
+// SilenceScheduleConfig 告警静默周期
+// 比如每周六、周日 22:00 - 次日 06:00 为维护窗口
+type SilenceScheduleConfig struct {
+	Weekdays []int  `json:"weekdays"` // 周几：1-7，7表示周日，为空表示每天
+	TimeFrom string `json:"timeFrom"` // 开始时间，格式为 HH:mm
+	TimeTo   string `json:"timeTo"`   // 结束时间，格式为 HH:mm，小于开始时间时表示到次日结束
+
+	minuteFrom int
+	minuteTo   int
+	weekdayMap map[int]bool
+}
+
+// Init 初始化
+func (this *SilenceScheduleConfig) Init() error {
+	minuteFrom, err := this.parseTime(this.TimeFrom)
+	if err != nil {
+		return errors.New("invalid 'timeFrom': " + err.Error())
+	}
+	minuteTo, err := this.parseTime(this.TimeTo)
+	if err != nil {
+		return errors.New("invalid 'timeTo': " + err.Error())
+	}
+	if minuteFrom == minuteTo {
+		return errors.New("'timeFrom' should not be equal to 'timeTo'")
+	}
+	this.minuteFrom = minuteFrom
+	this.minuteTo = minuteTo
+
+	this.weekdayMap = map[int]bool{}
+	for _, weekday := range this.Weekdays {
+		if weekday < 1 || weekday > 7 {
+			return errors.New("invalid weekday '" + types.String(weekday) + "'")
+		}
+		this.weekdayMap[weekday] = true
+	}
+
+	return nil
+}
+
+// Match 检查某个时间是否在静默周期内
+func (this *SilenceScheduleConfig) Match(t time.Time) bool {
+	var minute = t.Hour()*60 + t.Minute()
+
+	// 当天内
+	if this.minuteFrom < this.minuteTo {
+		return this.matchWeekday(t) && minute >= this.minuteFrom && minute < this.minuteTo
+	}
+
+	// 跨天：开始部分属于当天，结束部分属于前一天
+	if minute >= this.minuteFrom {
+		return this.matchWeekday(t)
+	}
+	if minute < this.minuteTo {
+		return this.matchWeekday(t.AddDate(0, 0, -1))
+	}
+	return false
+}
+
+func (this *SilenceScheduleConfig) matchWeekday(t time.Time) bool {
+	if len(this.weekdayMap) == 0 {
+		return true
+	}
+	var weekday = int(t.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+	return this.weekdayMap[weekday]
+}
+
+func (this *SilenceScheduleConfig) parseTime(s string) (int, error) {
+	var matches = silenceTimeReg.FindStringSubmatch(s)
+	if len(matches) == 0 {
+		return 0, errors.New("time should be in format 'HH:mm'")
+	}
+	var hour = types.Int(matches[1])
+	var minute = types.Int(matches[2])
+	if hour > 24 || minute > 59 || (hour == 24 && minute > 0) {
+		return 0, errors.New("time out of range")
+	}
+	return hour*60 + minute, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package monitorconfigs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/monitorconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestSilenceScheduleConfig_Init(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	a.IsNil((&monitorconfigs.SilenceScheduleConfig{TimeFrom: "22:00", TimeTo: "06:00"}).Init())
+	a.IsNil((&monitorconfigs.SilenceScheduleConfig{TimeFrom: "0:00", TimeTo: "24:00"}).Init())
+	a.IsNotNil((&monitorconfigs.SilenceScheduleConfig{TimeFrom: "22:00", TimeTo: "22:00"}).Init())
+	a.IsNotNil((&monitorconfigs.SilenceScheduleConfig{TimeFrom: "25:00", TimeTo: "06:00"}).Init())
+	a.IsNotNil((&monitorconfigs.SilenceScheduleConfig{TimeFrom: "abc", TimeTo: "06:00"}).Init())
+	a.IsNotNil((&monitorconfigs.SilenceScheduleConfig{TimeFrom: "22:00", TimeTo: "06:00", Weekdays: []int{0}}).Init())
+}
+
+func TestSilenceScheduleConfig_Match(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var config = &monitorconfigs.SilenceScheduleConfig{
+		TimeFrom: "09:30",
+		TimeTo:   "18:00",
+	}
+	a.IsNil(config.Init())
+	a.IsTrue(config.Match(time.Date(2024, 5, 6, 9, 30, 0, 0, time.Local)))
+	a.IsTrue(config.Match(time.Date(2024, 5, 6, 17, 59, 0, 0, time.Local)))
+	a.IsFalse(config.Match(time.Date(2024, 5, 6, 18, 0, 0, 0, time.Local)))
+	a.IsFalse(config.Match(time.Date(2024, 5, 6, 9, 29, 0, 0, time.Local)))
+}
+
+func TestSilenceScheduleConfig_Match_CrossDay(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	// 每周六 22:00 - 周日 06:00
+	var config = &monitorconfigs.SilenceScheduleConfig{
+		Weekdays: []int{6},
+		TimeFrom: "22:00",
+		TimeTo:   "06:00",
+	}
+	a.IsNil(config.Init())
+
+	// 2024-05-11 是周六
+	a.IsTrue(config.Match(time.Date(2024, 5, 11, 23, 0, 0, 0, time.Local)))
+	a.IsTrue(config.Match(time.Date(2024, 5, 12, 5, 59, 0, 0, time.Local)))
+	a.IsFalse(config.Match(time.Date(2024, 5, 12, 6, 0, 0, 0, time.Local)))
+	a.IsFalse(config.Match(time.Date(2024, 5, 12, 23, 0, 0, 0, time.Local)))
+	a.IsFalse(config.Match(time.Date(2024, 5, 11, 5, 0, 0, 0, time.Local)))
+	a.IsFalse(config.Match(time.Date(2024, 5, 11, 12, 0, 0, 0, time.Local)))
+}
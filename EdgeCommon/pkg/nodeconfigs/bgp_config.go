@@ -0,0 +1,126 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodeconfigs
+
+import (
+	"errors"
+	"net"
+	"regexp"
+	"strings"
+)
+
+type BGPDriver = string
+
+const (
+	BGPDriverExaBGP BGPDriver = "exabgp" // 通过ExaBGP的HTTP API
+	BGPDriverGoBGP  BGPDriver = "gobgp"  // 通过gobgp命令连接gobgpd的gRPC API
+)
+
+// FindAllBGPDrivers 所有支持的BGP驱动
+func FindAllBGPDrivers() []*BGPDriverDefinition {
+	return []*BGPDriverDefinition{
+		{
+			Name:        "ExaBGP",
+			Code:        BGPDriverExaBGP,
+			Description: "通过ExaBGP HTTP API发送announce/withdraw命令，Endpoint为HTTP API地址，比如 http://127.0.0.1:5000",
+		},
+		{
+			Name:        "GoBGP",
+			Code:        BGPDriverGoBGP,
+			Description: "通过API节点所在服务器上的gobgp命令操作gobgpd的全局路由表，Endpoint为gobgpd的gRPC地址，比如 127.0.0.1:50051",
+		},
+	}
+}
+
+// BGPDriverDefinition BGP驱动定义
+type BGPDriverDefinition struct {
+	Name        string `json:"name"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+var bgpCommunityReg = regexp.MustCompile(`^\d{1,10}:\d{1,10}(:\d{1,10})?$`)
+
+// NewNodeBGPConfig 默认的BGP配置
+func NewNodeBGPConfig() *NodeBGPConfig {
+	return &NodeBGPConfig{
+		Driver:         BGPDriverExaBGP,
+		WithdrawOnDown: true,
+	}
+}
+
+// NodeBGPConfig 节点BGP宣告配置
+// 节点上线时宣告配置中的前缀，下线或者维护时撤回，用于Anycast部署
+type NodeBGPConfig struct {
+	IsOn           bool     `yaml:"isOn" json:"isOn"`                     // 是否启用
+	Driver         string   `yaml:"driver" json:"driver"`                 // 驱动
+	Endpoint       string   `yaml:"endpoint" json:"endpoint"`             // 外部控制器地址
+	Prefixes       []string `yaml:"prefixes" json:"prefixes"`             // 宣告的前缀，比如 192.0.2.0/24
+	NextHop        string   `yaml:"nextHop" json:"nextHop"`               // 下一跳，为空表示self
+	ASPath         []uint32 `yaml:"asPath" json:"asPath"`                 // AS路径，可以用来做路径预置（prepend）
+	Communities    []string `yaml:"communities" json:"communities"`       // Community，比如 65000:100
+	WithdrawOnDown bool     `yaml:"withdrawOnDown" json:"withdrawOnDown"` // 节点下线时是否撤回
+	IsMaintaining  bool     `yaml:"isMaintaining" json:"isMaintaining"`   // 是否处于维护状态，维护期间撤回所有宣告
+}
+
+// Init 初始化并校验配置
+func (this *NodeBGPConfig) Init() error {
+	if !this.IsOn {
+		return nil
+	}
+
+	switch this.Driver {
+	case BGPDriverExaBGP, BGPDriverGoBGP:
+	default:
+		return errors.New("invalid bgp driver '" + this.Driver + "'")
+	}
+
+	this.Endpoint = strings.TrimSpace(this.Endpoint)
+	if len(this.Endpoint) == 0 {
+		return errors.New("'endpoint' should not be empty")
+	}
+
+	if len(this.Prefixes) == 0 {
+		return errors.New("'prefixes' should not be empty")
+	}
+	for index, prefix := range this.Prefixes {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(prefix))
+		if err != nil {
+			return errors.New("invalid prefix '" + prefix + "'")
+		}
+		this.Prefixes[index] = ipNet.String()
+	}
+
+	this.NextHop = strings.TrimSpace(this.NextHop)
+	if len(this.NextHop) > 0 && this.NextHop != "self" && net.ParseIP(this.NextHop) == nil {
+		return errors.New("invalid next hop '" + this.NextHop + "'")
+	}
+
+	for _, community := range this.Communities {
+		if !bgpCommunityReg.MatchString(community) {
+			return errors.New("invalid community '" + community + "'")
+		}
+	}
+
+	return nil
+}
+
+// ShouldAnnounce 根据节点状态判断是否应该宣告
+func (this *NodeBGPConfig) ShouldAnnounce(nodeIsOn bool, nodeIsUp bool) bool {
+	if !this.IsOn || this.IsMaintaining || !nodeIsOn {
+		return false
+	}
+	if !nodeIsUp && this.WithdrawOnDown {
+		return false
+	}
+	return true
+}
+
+// NodeBGPStatus 节点BGP宣告状态
+type NodeBGPStatus struct {
+	IsAnnounced bool           `json:"isAnnounced"` // 当前是否已宣告
+	Config      *NodeBGPConfig `json:"config"`      // 最后一次成功操作时使用的配置，用于撤回之前的宣告
+	Event       string         `json:"event"`       // 最后一次触发的事件：up, down, maintenance, disabled, config
+	Error       string         `json:"error"`       // 最后一次操作的错误信息
+	UpdatedAt   int64          `json:"updatedAt"`   // 最后一次操作时间
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodeconfigs
+
+// NodeCommand 可以远程在节点上执行的命令
+// 只有在这里定义的命令才能被下发，节点也只会执行这些命令
+type NodeCommand = string
+
+const (
+	NodeCommandReloadConfig       NodeCommand = "reloadConfig"       // 重新加载配置
+	NodeCommandRestartService     NodeCommand = "restartService"     // 重启节点服务
+	NodeCommandClearLocalCache    NodeCommand = "clearLocalCache"    // 清除本地缓存
+	NodeCommandCollectDiagnostics NodeCommand = "collectDiagnostics" // 收集诊断信息
+)
+
+// NodeCommandDefinition 命令定义
+type NodeCommandDefinition struct {
+	Name        string `json:"name"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// FindAllNodeCommands 所有可以远程执行的命令
+func FindAllNodeCommands() []*NodeCommandDefinition {
+	return []*NodeCommandDefinition{
+		{
+			Name:        "重新加载配置",
+			Code:        NodeCommandReloadConfig,
+			Description: "立即从API节点同步并加载最新的节点配置",
+		},
+		{
+			Name:        "重启服务",
+			Code:        NodeCommandRestartService,
+			Description: "重启节点进程，正在处理的连接会被中断",
+		},
+		{
+			Name:        "清除本地缓存",
+			Code:        NodeCommandClearLocalCache,
+			Description: "清除节点上所有缓存策略的本地缓存数据",
+		},
+		{
+			Name:        "收集诊断信息",
+			Code:        NodeCommandCollectDiagnostics,
+			Description: "收集节点版本、内存、连接数、缓存占用等运行状态",
+		},
+	}
+}
+
+// FindNodeCommandName 根据代号查找命令名称
+func FindNodeCommandName(code NodeCommand) string {
+	for _, def := range FindAllNodeCommands() {
+		if def.Code == code {
+			return def.Name
+		}
+	}
+	return ""
+}
+
+// IsValidNodeCommand 检查命令是否在白名单中
+func IsValidNodeCommand(code NodeCommand) bool {
+	return len(FindNodeCommandName(code)) > 0
+}
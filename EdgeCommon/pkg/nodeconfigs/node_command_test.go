@@ -0,0 +1,21 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodeconfigs
+
+import (
+	"testing"
+
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestIsValidNodeCommand(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	for _, def := range FindAllNodeCommands() {
+		a.IsTrue(IsValidNodeCommand(def.Code))
+		a.IsTrue(len(def.Name) > 0)
+	}
+	a.IsTrue(FindNodeCommandName(NodeCommandReloadConfig) == "重新加载配置")
+	a.IsFalse(IsValidNodeCommand(""))
+	a.IsFalse(IsValidNodeCommand("rm -rf /"))
+}
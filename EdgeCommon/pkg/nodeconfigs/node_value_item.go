@@ -25,6 +25,8 @@ const (
 	NodeValueItemCacheDir       NodeValueItem = "cacheDir"       // 缓存目录
 
 	NodeValueItemNetworkPackets NodeValueItem = "networkPackets" // 网络数据包统计
+
+	NodeValueItemDBPool NodeValueItem = "dbPool" // API节点数据库连接池
 )
 
 type nodeValueItemDefinition struct {
@@ -177,6 +179,36 @@ var nodeValueItemDefinitions = []*nodeValueItemDefinition{
 			},
 		},
 	},
+	{
+		Code: NodeValueItemDBPool,
+		Name: "数据库连接池",
+		Params: []*nodeValueItemParamDefinition{
+			{
+				Code:        "usage",
+				Name:        "使用比例",
+				Description: "使用中的连接数占最大连接数的比例，0到100之间的数字",
+				IsPercent:   true,
+			},
+			{
+				Code: "inUse",
+				Name: "使用中的连接数",
+			},
+			{
+				Code: "open",
+				Name: "打开的连接数",
+			},
+			{
+				Code:        "waitCount",
+				Name:        "等待次数",
+				Description: "统计周期内等待空闲连接的次数",
+			},
+			{
+				Code:        "waitDurationMs",
+				Name:        "等待时间（毫秒）",
+				Description: "统计周期内等待空闲连接的总时间",
+			},
+		},
+	},
 }
 
 // FindAllNodeValueItemDefinitions 获取所有监控项信息
@@ -0,0 +1,74 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package apiversions
+
+import (
+	"errors"
+	"strings"
+)
+
+// Version API版本
+type Version = string
+
+const (
+	V1 Version = "v1" // 冻结的版本，不再增加或删除服务
+	V2 Version = "v2" // 当前持续演进的版本
+
+	Latest  = V2 // 最新版本，和API节点一起发布的管理平台、边缘节点等使用此版本
+	Default = V1 // 请求中没有指定版本时使用的版本，以便外部集成在升级后仍然可以正常使用
+)
+
+const (
+	MetadataKey = "x-edge-api-version" // 请求和响应中用来指定版本的gRPC Metadata
+	HTTPHeader  = "X-Edge-API-Version" // REST API请求和响应中用来指定版本的Header
+)
+
+// 废弃的方法在响应中返回的Header
+const (
+	MetadataKeyDeprecated  = "x-edge-api-deprecated"  // 是否已废弃，值为 true
+	MetadataKeySunset      = "x-edge-api-sunset"      // 计划停用日期，格式为 YYYY-MM-DD
+	MetadataKeyRemovedIn   = "x-edge-api-removed-in"  // 在哪个版本中删除
+	MetadataKeyReplacement = "x-edge-api-replacement" // 替代的方法
+	MetadataKeyDeprecation = "x-edge-api-deprecation" // 废弃说明
+)
+
+// FindAllVersions 所有版本，从旧到新排列
+func FindAllVersions() []Version {
+	return []Version{V1, V2}
+}
+
+// IsValidVersion 检查版本是否合法
+func IsValidVersion(version Version) bool {
+	return Index(version) >= 0
+}
+
+// Index 版本的序号，不存在时返回-1
+func Index(version Version) int {
+	for index, v := range FindAllVersions() {
+		if v == version {
+			return index
+		}
+	}
+	return -1
+}
+
+// Compare 比较两个版本，version1较旧时返回负数，较新时返回正数，相同时返回0
+func Compare(version1 Version, version2 Version) int {
+	return Index(version1) - Index(version2)
+}
+
+// ParseVersion 分析请求中的版本，支持 v1、V1、1 等格式
+// 为空时返回默认版本
+func ParseVersion(value string) (Version, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if len(value) == 0 {
+		return Default, nil
+	}
+	if !strings.HasPrefix(value, "v") {
+		value = "v" + value
+	}
+	if !IsValidVersion(value) {
+		return "", errors.New("invalid api version '" + value + "'")
+	}
+	return value, nil
+}
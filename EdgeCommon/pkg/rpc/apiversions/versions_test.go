@@ -0,0 +1,38 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package apiversions_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/apiversions"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestParseVersion(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	for value, expected := range map[string]string{
+		"":     apiversions.Default,
+		"v1":   apiversions.V1,
+		"V2":   apiversions.V2,
+		" 2 ":  apiversions.V2,
+		"v100": "",
+		"abc":  "",
+	} {
+		version, err := apiversions.ParseVersion(value)
+		if len(expected) == 0 {
+			a.IsTrue(err != nil)
+		} else {
+			a.IsNil(err)
+			a.IsTrue(version == expected)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsTrue(apiversions.Compare(apiversions.V1, apiversions.V2) < 0)
+	a.IsTrue(apiversions.Compare(apiversions.V2, apiversions.V1) > 0)
+	a.IsTrue(apiversions.Compare(apiversions.Latest, apiversions.V2) == 0)
+}
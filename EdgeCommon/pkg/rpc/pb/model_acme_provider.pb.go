@@ -31,6 +31,8 @@ type ACMEProvider struct {
 	ApiURL         string `protobuf:"bytes,5,opt,name=apiURL,proto3" json:"apiURL,omitempty"`
 	RequireEAB     bool   `protobuf:"varint,6,opt,name=requireEAB,proto3" json:"requireEAB,omitempty"`
 	EabDescription string `protobuf:"bytes,7,opt,name=eabDescription,proto3" json:"eabDescription,omitempty"`
+	CanGenerateEAB bool   `protobuf:"varint,8,opt,name=canGenerateEAB,proto3" json:"canGenerateEAB,omitempty"` // 是否支持使用API Key自动生成EAB
+	TestAPIURL     string `protobuf:"bytes,9,opt,name=testAPIURL,proto3" json:"testAPIURL,omitempty"`          // 测试环境目录URL，为空表示没有测试环境
 }
 
 func (x *ACMEProvider) Reset() {
@@ -107,12 +109,26 @@ func (x *ACMEProvider) GetEabDescription() string {
 	return ""
 }
 
+func (x *ACMEProvider) GetCanGenerateEAB() bool {
+	if x != nil {
+		return x.CanGenerateEAB
+	}
+	return false
+}
+
+func (x *ACMEProvider) GetTestAPIURL() string {
+	if x != nil {
+		return x.TestAPIURL
+	}
+	return ""
+}
+
 var File_models_model_acme_provider_proto protoreflect.FileDescriptor
 
 var file_models_model_acme_provider_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x61,
 	0x63, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xb8, 0x01, 0x0a, 0x0c, 0x41, 0x43, 0x4d, 0x45, 0x50,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x80, 0x02, 0x0a, 0x0c, 0x41, 0x43, 0x4d, 0x45, 0x50,
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
 	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
@@ -124,8 +140,12 @@ var file_models_model_acme_provider_proto_rawDesc = []byte{
 	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x45, 0x41, 0x42, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x61, 0x62,
 	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0e, 0x65, 0x61, 0x62, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x45, 0x41, 0x42, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x61, 0x6e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x41, 0x42, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x73,
+	0x74, 0x41, 0x50, 0x49, 0x55, 0x52, 0x4c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x65, 0x73, 0x74, 0x41, 0x50, 0x49, 0x55, 0x52, 0x4c, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
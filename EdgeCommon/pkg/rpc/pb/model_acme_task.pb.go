@@ -33,10 +33,18 @@ type ACMETask struct {
 	AutoRenew         bool         `protobuf:"varint,6,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"`
 	AuthType          string       `protobuf:"bytes,7,opt,name=authType,proto3" json:"authType,omitempty"`
 	AuthURL           string       `protobuf:"bytes,8,opt,name=authURL,proto3" json:"authURL,omitempty"`
+	DnsAliasDomain    string       `protobuf:"bytes,9,opt,name=dnsAliasDomain,proto3" json:"dnsAliasDomain,omitempty"`   // DNS别名（CNAME委托）域名
+	KeyType           string       `protobuf:"bytes,10,opt,name=keyType,proto3" json:"keyType,omitempty"`                // 私钥类型：ec256、ec384、rsa2048、rsa3072、rsa4096
+	PreferredChain    string       `protobuf:"bytes,11,opt,name=preferredChain,proto3" json:"preferredChain,omitempty"`  // 首选证书链，为空表示使用CA的默认证书链
+	ThrottledUntil    int64        `protobuf:"varint,12,opt,name=throttledUntil,proto3" json:"throttledUntil,omitempty"` // 因CA签发频率限制推迟到的时间，0表示没有受到限制
+	ThrottleReason    string       `protobuf:"bytes,13,opt,name=throttleReason,proto3" json:"throttleReason,omitempty"`  // CA签发频率限制原因
+	DirectoryURL      string       `protobuf:"bytes,14,opt,name=directoryURL,proto3" json:"directoryURL,omitempty"`      // 自定义ACME目录URL，为空表示使用服务商的正式目录
+	HasCSR            bool         `protobuf:"varint,15,opt,name=hasCSR,proto3" json:"hasCSR,omitempty"`                 // 是否使用用户提供的CSR签发证书
 	AcmeUser          *ACMEUser    `protobuf:"bytes,30,opt,name=acmeUser,proto3" json:"acmeUser,omitempty"`
 	DnsProvider       *DNSProvider `protobuf:"bytes,31,opt,name=dnsProvider,proto3" json:"dnsProvider,omitempty"`
 	SslCert           *SSLCert     `protobuf:"bytes,32,opt,name=sslCert,proto3" json:"sslCert,omitempty"`
 	LatestACMETaskLog *ACMETaskLog `protobuf:"bytes,33,opt,name=latestACMETaskLog,proto3" json:"latestACMETaskLog,omitempty"`
+	DnsAliasProvider  *DNSProvider `protobuf:"bytes,34,opt,name=dnsAliasProvider,proto3" json:"dnsAliasProvider,omitempty"` // DNS别名（CNAME委托）所在域名的服务商
 }
 
 func (x *ACMETask) Reset() {
@@ -127,6 +135,55 @@ func (x *ACMETask) GetAuthURL() string {
 	return ""
 }
 
+func (x *ACMETask) GetDnsAliasDomain() string {
+	if x != nil {
+		return x.DnsAliasDomain
+	}
+	return ""
+}
+
+func (x *ACMETask) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *ACMETask) GetPreferredChain() string {
+	if x != nil {
+		return x.PreferredChain
+	}
+	return ""
+}
+
+func (x *ACMETask) GetThrottledUntil() int64 {
+	if x != nil {
+		return x.ThrottledUntil
+	}
+	return 0
+}
+
+func (x *ACMETask) GetThrottleReason() string {
+	if x != nil {
+		return x.ThrottleReason
+	}
+	return ""
+}
+
+func (x *ACMETask) GetDirectoryURL() string {
+	if x != nil {
+		return x.DirectoryURL
+	}
+	return ""
+}
+
+func (x *ACMETask) GetHasCSR() bool {
+	if x != nil {
+		return x.HasCSR
+	}
+	return false
+}
+
 func (x *ACMETask) GetAcmeUser() *ACMEUser {
 	if x != nil {
 		return x.AcmeUser
@@ -155,6 +212,13 @@ func (x *ACMETask) GetLatestACMETaskLog() *ACMETaskLog {
 	return nil
 }
 
+func (x *ACMETask) GetDnsAliasProvider() *DNSProvider {
+	if x != nil {
+		return x.DnsAliasProvider
+	}
+	return nil
+}
+
 var File_models_model_acme_task_proto protoreflect.FileDescriptor
 
 var file_models_model_acme_task_proto_rawDesc = []byte{
@@ -168,7 +232,7 @@ var file_models_model_acme_task_proto_rawDesc = []byte{
 	0x73, 0x73, 0x6c, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20,
 	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x61, 0x63, 0x6d,
 	0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x9b, 0x03, 0x0a, 0x08, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a,
+	0x22, 0xce, 0x05, 0x0a, 0x08, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
 	0x04, 0x69, 0x73, 0x4f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f,
 	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03,
@@ -181,20 +245,40 @@ var file_models_model_acme_task_proto_rawDesc = []byte{
 	0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70,
 	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70,
 	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c, 0x12, 0x28, 0x0a, 0x08, 0x61,
-	0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x62, 0x2e, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x08, 0x61, 0x63, 0x6d,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
-	0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x64, 0x6e, 0x73,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x73, 0x73, 0x6c, 0x43,
-	0x65, 0x72, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x52, 0x07, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x12,
-	0x3d, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73,
-	0x6b, 0x4c, 0x6f, 0x67, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x52, 0x11, 0x6c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x42, 0x06,
-	0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c, 0x12, 0x26, 0x0a, 0x0e, 0x64,
+	0x6e, 0x73, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x6e, 0x73, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c,
+	0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74,
+	0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73,
+	0x43, 0x53, 0x52, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x43, 0x53,
+	0x52, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x08, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0b, 0x64,
+	0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x0b, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x25,
+	0x0a, 0x07, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x52, 0x07, 0x73, 0x73,
+	0x6c, 0x43, 0x65, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41,
+	0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f,
+	0x67, 0x52, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73,
+	0x6b, 0x4c, 0x6f, 0x67, 0x12, 0x3b, 0x0a, 0x10, 0x64, 0x6e, 0x73, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
+	0x10, 0x64, 0x6e, 0x73, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -222,11 +306,12 @@ var file_models_model_acme_task_proto_depIdxs = []int32{
 	2, // 1: pb.ACMETask.dnsProvider:type_name -> pb.DNSProvider
 	3, // 2: pb.ACMETask.sslCert:type_name -> pb.SSLCert
 	4, // 3: pb.ACMETask.latestACMETaskLog:type_name -> pb.ACMETaskLog
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2, // 4: pb.ACMETask.dnsAliasProvider:type_name -> pb.DNSProvider
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_models_model_acme_task_proto_init() }
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: models/model_config_diagnostic.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 配置校验结果
+type ConfigDiagnostic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`       // 字段路径，比如 rules[0].value
+	Level   string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`     // 级别：error, warning
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // 提示信息
+}
+
+func (x *ConfigDiagnostic) Reset() {
+	*x = ConfigDiagnostic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_model_config_diagnostic_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigDiagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigDiagnostic) ProtoMessage() {}
+
+func (x *ConfigDiagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_models_model_config_diagnostic_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigDiagnostic.ProtoReflect.Descriptor instead.
+func (*ConfigDiagnostic) Descriptor() ([]byte, []int) {
+	return file_models_model_config_diagnostic_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigDiagnostic) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ConfigDiagnostic) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *ConfigDiagnostic) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_models_model_config_diagnostic_proto protoreflect.FileDescriptor
+
+var file_models_model_config_diagnostic_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x56, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_models_model_config_diagnostic_proto_rawDescOnce sync.Once
+	file_models_model_config_diagnostic_proto_rawDescData = file_models_model_config_diagnostic_proto_rawDesc
+)
+
+func file_models_model_config_diagnostic_proto_rawDescGZIP() []byte {
+	file_models_model_config_diagnostic_proto_rawDescOnce.Do(func() {
+		file_models_model_config_diagnostic_proto_rawDescData = protoimpl.X.CompressGZIP(file_models_model_config_diagnostic_proto_rawDescData)
+	})
+	return file_models_model_config_diagnostic_proto_rawDescData
+}
+
+var file_models_model_config_diagnostic_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_models_model_config_diagnostic_proto_goTypes = []interface{}{
+	(*ConfigDiagnostic)(nil), // 0: pb.ConfigDiagnostic
+}
+var file_models_model_config_diagnostic_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_models_model_config_diagnostic_proto_init() }
+func file_models_model_config_diagnostic_proto_init() {
+	if File_models_model_config_diagnostic_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_models_model_config_diagnostic_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigDiagnostic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_models_model_config_diagnostic_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_model_config_diagnostic_proto_goTypes,
+		DependencyIndexes: file_models_model_config_diagnostic_proto_depIdxs,
+		MessageInfos:      file_models_model_config_diagnostic_proto_msgTypes,
+	}.Build()
+	File_models_model_config_diagnostic_proto = out.File
+	file_models_model_config_diagnostic_proto_rawDesc = nil
+	file_models_model_config_diagnostic_proto_goTypes = nil
+	file_models_model_config_diagnostic_proto_depIdxs = nil
+}
@@ -31,7 +31,8 @@ type DNSProvider struct {
 	TypeName      string `protobuf:"bytes,4,opt,name=typeName,proto3" json:"typeName,omitempty"`
 	ApiParamsJSON []byte `protobuf:"bytes,5,opt,name=apiParamsJSON,proto3" json:"apiParamsJSON,omitempty"`
 	DataUpdatedAt int64  `protobuf:"varint,6,opt,name=dataUpdatedAt,proto3" json:"dataUpdatedAt,omitempty"`
-	MinTTL        int32  `protobuf:"varint,7,opt,name=minTTL,proto3" json:"minTTL,omitempty"` // 最小TTL
+	MinTTL        int32  `protobuf:"varint,7,opt,name=minTTL,proto3" json:"minTTL,omitempty"`        // 最小TTL
+	ExternalId    string `protobuf:"bytes,8,opt,name=externalId,proto3" json:"externalId,omitempty"` // 外部ID
 }
 
 func (x *DNSProvider) Reset() {
@@ -115,12 +116,19 @@ func (x *DNSProvider) GetMinTTL() int32 {
 	return 0
 }
 
+func (x *DNSProvider) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
 var File_models_model_dns_provider_proto protoreflect.FileDescriptor
 
 var file_models_model_dns_provider_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x64,
 	0x6e, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xc5, 0x01, 0x0a, 0x0b, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xe5, 0x01, 0x0a, 0x0b, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f,
 	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
@@ -132,7 +140,9 @@ var file_models_model_dns_provider_proto_rawDesc = []byte{
 	0x24, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
 	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x54, 0x54, 0x4c, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x54, 0x54, 0x4c, 0x42, 0x06, 0x5a,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x54, 0x54, 0x4c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x42, 0x06, 0x5a,
 	0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -32,7 +32,8 @@ type IPLibraryArtifact struct {
 	IsPublic  bool   `protobuf:"varint,5,opt,name=isPublic,proto3" json:"isPublic,omitempty"` // 是否公开
 	Name      string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
 	Code      string `protobuf:"bytes,7,opt,name=code,proto3" json:"code,omitempty"`
-	File      *File  `protobuf:"bytes,30,opt,name=file,proto3" json:"file,omitempty"` // 文件信息
+	Driver    string `protobuf:"bytes,8,opt,name=driver,proto3" json:"driver,omitempty"` // 驱动类型：default、maxmind、ipip
+	File      *File  `protobuf:"bytes,30,opt,name=file,proto3" json:"file,omitempty"`    // 文件信息
 }
 
 func (x *IPLibraryArtifact) Reset() {
@@ -116,6 +117,13 @@ func (x *IPLibraryArtifact) GetCode() string {
 	return ""
 }
 
+func (x *IPLibraryArtifact) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
 func (x *IPLibraryArtifact) GetFile() *File {
 	if x != nil {
 		return x.File
@@ -130,7 +138,7 @@ var file_models_model_ip_library_artifact_proto_rawDesc = []byte{
 	0x70, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
 	0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x17, 0x6d, 0x6f,
 	0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd7, 0x01, 0x0a, 0x11, 0x49, 0x50, 0x4c, 0x69, 0x62, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x01, 0x0a, 0x11, 0x49, 0x50, 0x4c, 0x69, 0x62, 0x72,
 	0x61, 0x72, 0x79, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
 	0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x69, 0x6c,
@@ -142,9 +150,11 @@ var file_models_model_ip_library_artifact_proto_rawDesc = []byte{
 	0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
 	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x12, 0x1c, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x08, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x42,
-	0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
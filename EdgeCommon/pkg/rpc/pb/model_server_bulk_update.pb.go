@@ -0,0 +1,420 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: models/model_server_bulk_update.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 服务批量修改记录
+type ServerBulkUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                      // 记录ID
+	AdminId      int64   `protobuf:"varint,2,opt,name=adminId,proto3" json:"adminId,omitempty"`            // 管理员ID
+	PatchJSON    []byte  `protobuf:"bytes,3,opt,name=patchJSON,proto3" json:"patchJSON,omitempty"`         // 修改内容
+	ServerIds    []int64 `protobuf:"varint,4,rep,packed,name=serverIds,proto3" json:"serverIds,omitempty"` // 修改的服务ID
+	CountServers int32   `protobuf:"varint,5,opt,name=countServers,proto3" json:"countServers,omitempty"`  // 修改的服务数量
+	IsRolledBack bool    `protobuf:"varint,6,opt,name=isRolledBack,proto3" json:"isRolledBack,omitempty"`  // 是否已回滚
+	RolledBackAt int64   `protobuf:"varint,7,opt,name=rolledBackAt,proto3" json:"rolledBackAt,omitempty"`  // 回滚时间
+	CreatedAt    int64   `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`        // 创建时间
+}
+
+func (x *ServerBulkUpdate) Reset() {
+	*x = ServerBulkUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_model_server_bulk_update_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerBulkUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerBulkUpdate) ProtoMessage() {}
+
+func (x *ServerBulkUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_models_model_server_bulk_update_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerBulkUpdate.ProtoReflect.Descriptor instead.
+func (*ServerBulkUpdate) Descriptor() ([]byte, []int) {
+	return file_models_model_server_bulk_update_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServerBulkUpdate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ServerBulkUpdate) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *ServerBulkUpdate) GetPatchJSON() []byte {
+	if x != nil {
+		return x.PatchJSON
+	}
+	return nil
+}
+
+func (x *ServerBulkUpdate) GetServerIds() []int64 {
+	if x != nil {
+		return x.ServerIds
+	}
+	return nil
+}
+
+func (x *ServerBulkUpdate) GetCountServers() int32 {
+	if x != nil {
+		return x.CountServers
+	}
+	return 0
+}
+
+func (x *ServerBulkUpdate) GetIsRolledBack() bool {
+	if x != nil {
+		return x.IsRolledBack
+	}
+	return false
+}
+
+func (x *ServerBulkUpdate) GetRolledBackAt() int64 {
+	if x != nil {
+		return x.RolledBackAt
+	}
+	return 0
+}
+
+func (x *ServerBulkUpdate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 批量修改时筛选服务的条件
+type ServerBulkFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerIds     []int64 `protobuf:"varint,1,rep,packed,name=serverIds,proto3" json:"serverIds,omitempty"`  // 服务ID，指定了服务ID时忽略其他条件
+	NodeClusterId int64   `protobuf:"varint,2,opt,name=nodeClusterId,proto3" json:"nodeClusterId,omitempty"` // 集群ID
+	ServerGroupId int64   `protobuf:"varint,3,opt,name=serverGroupId,proto3" json:"serverGroupId,omitempty"` // 分组ID
+	UserId        int64   `protobuf:"varint,4,opt,name=userId,proto3" json:"userId,omitempty"`               // 用户ID
+	Keyword       string  `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`              // 关键词
+}
+
+func (x *ServerBulkFilter) Reset() {
+	*x = ServerBulkFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_model_server_bulk_update_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerBulkFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerBulkFilter) ProtoMessage() {}
+
+func (x *ServerBulkFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_models_model_server_bulk_update_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerBulkFilter.ProtoReflect.Descriptor instead.
+func (*ServerBulkFilter) Descriptor() ([]byte, []int) {
+	return file_models_model_server_bulk_update_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServerBulkFilter) GetServerIds() []int64 {
+	if x != nil {
+		return x.ServerIds
+	}
+	return nil
+}
+
+func (x *ServerBulkFilter) GetNodeClusterId() int64 {
+	if x != nil {
+		return x.NodeClusterId
+	}
+	return 0
+}
+
+func (x *ServerBulkFilter) GetServerGroupId() int64 {
+	if x != nil {
+		return x.ServerGroupId
+	}
+	return 0
+}
+
+func (x *ServerBulkFilter) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ServerBulkFilter) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+// 服务批量修改的单项变更
+type ServerBulkChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId   int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"`    // 服务ID
+	ServerName string `protobuf:"bytes,2,opt,name=serverName,proto3" json:"serverName,omitempty"` // 服务名称
+	Item       string `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`             // 修改的项目：requestHost、websocket、cache、cachePolicy
+	OldValue   string `protobuf:"bytes,4,opt,name=oldValue,proto3" json:"oldValue,omitempty"`     // 旧的值
+	NewValue   string `protobuf:"bytes,5,opt,name=newValue,proto3" json:"newValue,omitempty"`     // 新的值
+}
+
+func (x *ServerBulkChange) Reset() {
+	*x = ServerBulkChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_model_server_bulk_update_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerBulkChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerBulkChange) ProtoMessage() {}
+
+func (x *ServerBulkChange) ProtoReflect() protoreflect.Message {
+	mi := &file_models_model_server_bulk_update_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerBulkChange.ProtoReflect.Descriptor instead.
+func (*ServerBulkChange) Descriptor() ([]byte, []int) {
+	return file_models_model_server_bulk_update_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ServerBulkChange) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *ServerBulkChange) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ServerBulkChange) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *ServerBulkChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ServerBulkChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+var File_models_model_server_bulk_update_proto protoreflect.FileDescriptor
+
+var file_models_model_server_bulk_update_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x82, 0x02, 0x0a, 0x10,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73,
+	0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x69, 0x73, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x22,
+	0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x41, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xae, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x9a, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x06,
+	0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_models_model_server_bulk_update_proto_rawDescOnce sync.Once
+	file_models_model_server_bulk_update_proto_rawDescData = file_models_model_server_bulk_update_proto_rawDesc
+)
+
+func file_models_model_server_bulk_update_proto_rawDescGZIP() []byte {
+	file_models_model_server_bulk_update_proto_rawDescOnce.Do(func() {
+		file_models_model_server_bulk_update_proto_rawDescData = protoimpl.X.CompressGZIP(file_models_model_server_bulk_update_proto_rawDescData)
+	})
+	return file_models_model_server_bulk_update_proto_rawDescData
+}
+
+var file_models_model_server_bulk_update_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_models_model_server_bulk_update_proto_goTypes = []interface{}{
+	(*ServerBulkUpdate)(nil), // 0: pb.ServerBulkUpdate
+	(*ServerBulkFilter)(nil), // 1: pb.ServerBulkFilter
+	(*ServerBulkChange)(nil), // 2: pb.ServerBulkChange
+}
+var file_models_model_server_bulk_update_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_models_model_server_bulk_update_proto_init() }
+func file_models_model_server_bulk_update_proto_init() {
+	if File_models_model_server_bulk_update_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_models_model_server_bulk_update_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerBulkUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_model_server_bulk_update_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerBulkFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_model_server_bulk_update_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerBulkChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_models_model_server_bulk_update_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_model_server_bulk_update_proto_goTypes,
+		DependencyIndexes: file_models_model_server_bulk_update_proto_depIdxs,
+		MessageInfos:      file_models_model_server_bulk_update_proto_msgTypes,
+	}.Build()
+	File_models_model_server_bulk_update_proto = out.File
+	file_models_model_server_bulk_update_proto_rawDesc = nil
+	file_models_model_server_bulk_update_proto_goTypes = nil
+	file_models_model_server_bulk_update_proto_depIdxs = nil
+}
@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: models/model_server_change_log.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 网站变更记录
+type ServerChangeLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`               // 变更记录ID
+	ServerId  int64                     `protobuf:"varint,2,opt,name=serverId,proto3" json:"serverId,omitempty"`   // 网站ID
+	Summary   string                    `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`      // 变更摘要，比如：修改了域名、缓存设置（3项）
+	Changes   []*ServerChangeLog_Change `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`      // 字段变更
+	CreatedAt int64                     `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // 变更时间
+}
+
+func (x *ServerChangeLog) Reset() {
+	*x = ServerChangeLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_model_server_change_log_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerChangeLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerChangeLog) ProtoMessage() {}
+
+func (x *ServerChangeLog) ProtoReflect() protoreflect.Message {
+	mi := &file_models_model_server_change_log_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerChangeLog.ProtoReflect.Descriptor instead.
+func (*ServerChangeLog) Descriptor() ([]byte, []int) {
+	return file_models_model_server_change_log_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServerChangeLog) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ServerChangeLog) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *ServerChangeLog) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ServerChangeLog) GetChanges() []*ServerChangeLog_Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ServerChangeLog) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ServerChangeLog_Change struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path     string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`         // 字段路径，比如 web.cache.isOn
+	Label    string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`       // 字段所属的设置名称，比如 缓存设置
+	Action   string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`     // 变更动作：add、update、delete
+	OldValue string `protobuf:"bytes,4,opt,name=oldValue,proto3" json:"oldValue,omitempty"` // 旧的值（JSON）
+	NewValue string `protobuf:"bytes,5,opt,name=newValue,proto3" json:"newValue,omitempty"` // 新的值（JSON）
+}
+
+func (x *ServerChangeLog_Change) Reset() {
+	*x = ServerChangeLog_Change{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_model_server_change_log_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerChangeLog_Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerChangeLog_Change) ProtoMessage() {}
+
+func (x *ServerChangeLog_Change) ProtoReflect() protoreflect.Message {
+	mi := &file_models_model_server_change_log_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerChangeLog_Change.ProtoReflect.Descriptor instead.
+func (*ServerChangeLog_Change) Descriptor() ([]byte, []int) {
+	return file_models_model_server_change_log_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ServerChangeLog_Change) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ServerChangeLog_Change) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ServerChangeLog_Change) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ServerChangeLog_Change) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ServerChangeLog_Change) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+var File_models_model_server_change_log_proto protoreflect.FileDescriptor
+
+var file_models_model_server_change_log_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6c, 0x6f, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xb0, 0x02, 0x0a, 0x0f, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x82, 0x01, 0x0a, 0x06, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x06, 0x5a,
+	0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_models_model_server_change_log_proto_rawDescOnce sync.Once
+	file_models_model_server_change_log_proto_rawDescData = file_models_model_server_change_log_proto_rawDesc
+)
+
+func file_models_model_server_change_log_proto_rawDescGZIP() []byte {
+	file_models_model_server_change_log_proto_rawDescOnce.Do(func() {
+		file_models_model_server_change_log_proto_rawDescData = protoimpl.X.CompressGZIP(file_models_model_server_change_log_proto_rawDescData)
+	})
+	return file_models_model_server_change_log_proto_rawDescData
+}
+
+var file_models_model_server_change_log_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_models_model_server_change_log_proto_goTypes = []interface{}{
+	(*ServerChangeLog)(nil),        // 0: pb.ServerChangeLog
+	(*ServerChangeLog_Change)(nil), // 1: pb.ServerChangeLog.Change
+}
+var file_models_model_server_change_log_proto_depIdxs = []int32{
+	1, // 0: pb.ServerChangeLog.changes:type_name -> pb.ServerChangeLog.Change
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_models_model_server_change_log_proto_init() }
+func file_models_model_server_change_log_proto_init() {
+	if File_models_model_server_change_log_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_models_model_server_change_log_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerChangeLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_model_server_change_log_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerChangeLog_Change); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_models_model_server_change_log_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_model_server_change_log_proto_goTypes,
+		DependencyIndexes: file_models_model_server_change_log_proto_depIdxs,
+		MessageInfos:      file_models_model_server_change_log_proto_msgTypes,
+	}.Build()
+	File_models_model_server_change_log_proto = out.File
+	file_models_model_server_change_log_proto_rawDesc = nil
+	file_models_model_server_change_log_proto_goTypes = nil
+	file_models_model_server_change_log_proto_depIdxs = nil
+}
@@ -42,7 +42,9 @@ type ServerDailyStat struct {
 	Hour                 string `protobuf:"bytes,13,opt,name=hour,proto3" json:"hour,omitempty"`
 	TimeFrom             string `protobuf:"bytes,14,opt,name=timeFrom,proto3" json:"timeFrom,omitempty"`
 	TimeTo               string `protobuf:"bytes,15,opt,name=timeTo,proto3" json:"timeTo,omitempty"`
-	CountIPs             int64  `protobuf:"varint,17,opt,name=countIPs,proto3" json:"countIPs,omitempty"` // 独立IP数量
+	CountIPs             int64  `protobuf:"varint,17,opt,name=countIPs,proto3" json:"countIPs,omitempty"`             // 独立IP数量
+	CountStatus4Xx       int64  `protobuf:"varint,18,opt,name=countStatus4xx,proto3" json:"countStatus4xx,omitempty"` // 4xx响应数量
+	CountStatus5Xx       int64  `protobuf:"varint,19,opt,name=countStatus5xx,proto3" json:"countStatus5xx,omitempty"` // 5xx响应数量
 }
 
 func (x *ServerDailyStat) Reset() {
@@ -196,12 +198,26 @@ func (x *ServerDailyStat) GetCountIPs() int64 {
 	return 0
 }
 
+func (x *ServerDailyStat) GetCountStatus4Xx() int64 {
+	if x != nil {
+		return x.CountStatus4Xx
+	}
+	return 0
+}
+
+func (x *ServerDailyStat) GetCountStatus5Xx() int64 {
+	if x != nil {
+		return x.CountStatus5Xx
+	}
+	return 0
+}
+
 var File_models_model_server_daily_stat_proto protoreflect.FileDescriptor
 
 var file_models_model_server_daily_stat_proto_rawDesc = []byte{
 	0x0a, 0x24, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x73,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xad, 0x04, 0x0a, 0x0f, 0x53,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xfd, 0x04, 0x0a, 0x0f, 0x53,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1a,
 	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
 	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
@@ -236,7 +252,12 @@ var file_models_model_server_daily_stat_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f,
 	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x1a,
 	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x50, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x50, 0x73, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x50, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x34, 0x78, 0x78, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x34,
+	0x78, 0x78, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x35, 0x78, 0x78, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x35, 0x78, 0x78, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f,
 	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -25,23 +25,28 @@ type SSLCert struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	IsOn          bool     `protobuf:"varint,2,opt,name=isOn,proto3" json:"isOn,omitempty"`
-	Name          string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	TimeBeginAt   int64    `protobuf:"varint,4,opt,name=timeBeginAt,proto3" json:"timeBeginAt,omitempty"`
-	TimeEndAt     int64    `protobuf:"varint,5,opt,name=timeEndAt,proto3" json:"timeEndAt,omitempty"`
-	DnsNames      []string `protobuf:"bytes,6,rep,name=dnsNames,proto3" json:"dnsNames,omitempty"`
-	CommonNames   []string `protobuf:"bytes,7,rep,name=commonNames,proto3" json:"commonNames,omitempty"`
-	IsACME        bool     `protobuf:"varint,8,opt,name=isACME,proto3" json:"isACME,omitempty"`
-	AcmeTaskId    int64    `protobuf:"varint,17,opt,name=acmeTaskId,proto3" json:"acmeTaskId,omitempty"`
-	Ocsp          []byte   `protobuf:"bytes,9,opt,name=ocsp,proto3" json:"ocsp,omitempty"`
-	OcspIsUpdated bool     `protobuf:"varint,10,opt,name=ocspIsUpdated,proto3" json:"ocspIsUpdated,omitempty"`
-	OcspError     string   `protobuf:"bytes,11,opt,name=ocspError,proto3" json:"ocspError,omitempty"`
-	Description   string   `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
-	IsCA          bool     `protobuf:"varint,13,opt,name=isCA,proto3" json:"isCA,omitempty"`
-	ServerName    string   `protobuf:"bytes,14,opt,name=serverName,proto3" json:"serverName,omitempty"`
-	CreatedAt     int64    `protobuf:"varint,15,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     int64    `protobuf:"varint,16,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Id                  int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsOn                bool     `protobuf:"varint,2,opt,name=isOn,proto3" json:"isOn,omitempty"`
+	Name                string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TimeBeginAt         int64    `protobuf:"varint,4,opt,name=timeBeginAt,proto3" json:"timeBeginAt,omitempty"`
+	TimeEndAt           int64    `protobuf:"varint,5,opt,name=timeEndAt,proto3" json:"timeEndAt,omitempty"`
+	DnsNames            []string `protobuf:"bytes,6,rep,name=dnsNames,proto3" json:"dnsNames,omitempty"`
+	CommonNames         []string `protobuf:"bytes,7,rep,name=commonNames,proto3" json:"commonNames,omitempty"`
+	IsACME              bool     `protobuf:"varint,8,opt,name=isACME,proto3" json:"isACME,omitempty"`
+	AcmeTaskId          int64    `protobuf:"varint,17,opt,name=acmeTaskId,proto3" json:"acmeTaskId,omitempty"`
+	Ocsp                []byte   `protobuf:"bytes,9,opt,name=ocsp,proto3" json:"ocsp,omitempty"`
+	OcspIsUpdated       bool     `protobuf:"varint,10,opt,name=ocspIsUpdated,proto3" json:"ocspIsUpdated,omitempty"`
+	OcspError           string   `protobuf:"bytes,11,opt,name=ocspError,proto3" json:"ocspError,omitempty"`
+	Description         string   `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	IsCA                bool     `protobuf:"varint,13,opt,name=isCA,proto3" json:"isCA,omitempty"`
+	ServerName          string   `protobuf:"bytes,14,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	CreatedAt           int64    `protobuf:"varint,15,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt           int64    `protobuf:"varint,16,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Fingerprint         string   `protobuf:"bytes,18,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`                  // 证书SHA256指纹
+	IsDeleted           bool     `protobuf:"varint,19,opt,name=isDeleted,proto3" json:"isDeleted,omitempty"`                     // 是否已删除
+	OcspStatus          string   `protobuf:"bytes,20,opt,name=ocspStatus,proto3" json:"ocspStatus,omitempty"`                    // OCSP监控状态：good、revoked、unknown，为空表示尚未检查或证书不支持OCSP
+	OcspStatusCheckedAt int64    `protobuf:"varint,21,opt,name=ocspStatusCheckedAt,proto3" json:"ocspStatusCheckedAt,omitempty"` // OCSP状态检查时间
+	Tags                []string `protobuf:"bytes,22,rep,name=tags,proto3" json:"tags,omitempty"`                                // 标签
 }
 
 func (x *SSLCert) Reset() {
@@ -195,12 +200,47 @@ func (x *SSLCert) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *SSLCert) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *SSLCert) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *SSLCert) GetOcspStatus() string {
+	if x != nil {
+		return x.OcspStatus
+	}
+	return ""
+}
+
+func (x *SSLCert) GetOcspStatusCheckedAt() int64 {
+	if x != nil {
+		return x.OcspStatusCheckedAt
+	}
+	return 0
+}
+
+func (x *SSLCert) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 var File_models_model_ssl_cert_proto protoreflect.FileDescriptor
 
 var file_models_model_ssl_cert_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x73,
 	0x73, 0x6c, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
-	0x62, 0x22, 0xe1, 0x03, 0x0a, 0x07, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x12, 0x0e, 0x0a,
+	0x62, 0x22, 0x87, 0x05, 0x0a, 0x07, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
 	0x04, 0x69, 0x73, 0x4f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f,
 	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -230,8 +270,18 @@ var file_models_model_ssl_cert_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x64, 0x41, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x63, 0x73, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x73, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x6f, 0x63, 0x73, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x6f, 0x63, 0x73, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x42, 0x06, 0x5a, 0x04, 0x2e,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
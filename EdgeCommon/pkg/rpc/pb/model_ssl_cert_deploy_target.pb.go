@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: models/model_ssl_cert_deploy_target.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SSL证书部署目标
+type SSLCertDeployTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SslCertId       int64  `protobuf:"varint,2,opt,name=sslCertId,proto3" json:"sslCertId,omitempty"`             // 证书ID
+	IsOn            bool   `protobuf:"varint,3,opt,name=isOn,proto3" json:"isOn,omitempty"`                       // 是否启用
+	Name            string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                        // 名称
+	Type            string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`                        // 部署目标类型
+	TypeName        string `protobuf:"bytes,6,opt,name=typeName,proto3" json:"typeName,omitempty"`                // 部署目标类型名称
+	ParamsJSON      []byte `protobuf:"bytes,7,opt,name=paramsJSON,proto3" json:"paramsJSON,omitempty"`            // 参数，敏感信息已经掩码
+	LastDeployedAt  int64  `protobuf:"varint,8,opt,name=lastDeployedAt,proto3" json:"lastDeployedAt,omitempty"`   // 最后部署时间
+	LastDeployIsOk  bool   `protobuf:"varint,9,opt,name=lastDeployIsOk,proto3" json:"lastDeployIsOk,omitempty"`   // 最后部署是否成功
+	LastDeployError string `protobuf:"bytes,10,opt,name=lastDeployError,proto3" json:"lastDeployError,omitempty"` // 最后部署错误信息
+	CreatedAt       int64  `protobuf:"varint,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`            // 创建时间
+}
+
+func (x *SSLCertDeployTarget) Reset() {
+	*x = SSLCertDeployTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_model_ssl_cert_deploy_target_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSLCertDeployTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSLCertDeployTarget) ProtoMessage() {}
+
+func (x *SSLCertDeployTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_models_model_ssl_cert_deploy_target_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSLCertDeployTarget.ProtoReflect.Descriptor instead.
+func (*SSLCertDeployTarget) Descriptor() ([]byte, []int) {
+	return file_models_model_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SSLCertDeployTarget) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SSLCertDeployTarget) GetSslCertId() int64 {
+	if x != nil {
+		return x.SslCertId
+	}
+	return 0
+}
+
+func (x *SSLCertDeployTarget) GetIsOn() bool {
+	if x != nil {
+		return x.IsOn
+	}
+	return false
+}
+
+func (x *SSLCertDeployTarget) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SSLCertDeployTarget) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SSLCertDeployTarget) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *SSLCertDeployTarget) GetParamsJSON() []byte {
+	if x != nil {
+		return x.ParamsJSON
+	}
+	return nil
+}
+
+func (x *SSLCertDeployTarget) GetLastDeployedAt() int64 {
+	if x != nil {
+		return x.LastDeployedAt
+	}
+	return 0
+}
+
+func (x *SSLCertDeployTarget) GetLastDeployIsOk() bool {
+	if x != nil {
+		return x.LastDeployIsOk
+	}
+	return false
+}
+
+func (x *SSLCertDeployTarget) GetLastDeployError() string {
+	if x != nil {
+		return x.LastDeployError
+	}
+	return ""
+}
+
+func (x *SSLCertDeployTarget) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+var File_models_model_ssl_cert_deploy_target_proto protoreflect.FileDescriptor
+
+var file_models_model_ssl_cert_deploy_target_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x73,
+	0x73, 0x6c, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22,
+	0xd3, 0x02, 0x0a, 0x13, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65,
+	0x72, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x73, 0x6c, 0x43,
+	0x65, 0x72, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x26, 0x0a,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x49, 0x73, 0x4f, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6c,
+	0x61, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x49, 0x73, 0x4f, 0x6b, 0x12, 0x28, 0x0a,
+	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_models_model_ssl_cert_deploy_target_proto_rawDescOnce sync.Once
+	file_models_model_ssl_cert_deploy_target_proto_rawDescData = file_models_model_ssl_cert_deploy_target_proto_rawDesc
+)
+
+func file_models_model_ssl_cert_deploy_target_proto_rawDescGZIP() []byte {
+	file_models_model_ssl_cert_deploy_target_proto_rawDescOnce.Do(func() {
+		file_models_model_ssl_cert_deploy_target_proto_rawDescData = protoimpl.X.CompressGZIP(file_models_model_ssl_cert_deploy_target_proto_rawDescData)
+	})
+	return file_models_model_ssl_cert_deploy_target_proto_rawDescData
+}
+
+var file_models_model_ssl_cert_deploy_target_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_models_model_ssl_cert_deploy_target_proto_goTypes = []interface{}{
+	(*SSLCertDeployTarget)(nil), // 0: pb.SSLCertDeployTarget
+}
+var file_models_model_ssl_cert_deploy_target_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_models_model_ssl_cert_deploy_target_proto_init() }
+func file_models_model_ssl_cert_deploy_target_proto_init() {
+	if File_models_model_ssl_cert_deploy_target_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_models_model_ssl_cert_deploy_target_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSLCertDeployTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_models_model_ssl_cert_deploy_target_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_model_ssl_cert_deploy_target_proto_goTypes,
+		DependencyIndexes: file_models_model_ssl_cert_deploy_target_proto_depIdxs,
+		MessageInfos:      file_models_model_ssl_cert_deploy_target_proto_msgTypes,
+	}.Build()
+	File_models_model_ssl_cert_deploy_target_proto = out.File
+	file_models_model_ssl_cert_deploy_target_proto_rawDesc = nil
+	file_models_model_ssl_cert_deploy_target_proto_goTypes = nil
+	file_models_model_ssl_cert_deploy_target_proto_depIdxs = nil
+}
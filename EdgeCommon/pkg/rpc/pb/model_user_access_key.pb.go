@@ -26,14 +26,15 @@ type UserAccessKey struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId      int64  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
-	SubUserId   int64  `protobuf:"varint,3,opt,name=subUserId,proto3" json:"subUserId,omitempty"`
-	IsOn        bool   `protobuf:"varint,4,opt,name=isOn,proto3" json:"isOn,omitempty"`
-	UniqueId    string `protobuf:"bytes,5,opt,name=uniqueId,proto3" json:"uniqueId,omitempty"`
-	Secret      string `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
-	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	AccessedAt  int64  `protobuf:"varint,8,opt,name=accessedAt,proto3" json:"accessedAt,omitempty"`
+	Id          int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int64    `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	SubUserId   int64    `protobuf:"varint,3,opt,name=subUserId,proto3" json:"subUserId,omitempty"`
+	IsOn        bool     `protobuf:"varint,4,opt,name=isOn,proto3" json:"isOn,omitempty"`
+	UniqueId    string   `protobuf:"bytes,5,opt,name=uniqueId,proto3" json:"uniqueId,omitempty"`
+	Secret      string   `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+	Description string   `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	AccessedAt  int64    `protobuf:"varint,8,opt,name=accessedAt,proto3" json:"accessedAt,omitempty"`
+	Scopes      []string `protobuf:"bytes,9,rep,name=scopes,proto3" json:"scopes,omitempty"` // 权限范围，为空表示不限制
 }
 
 func (x *UserAccessKey) Reset() {
@@ -124,12 +125,19 @@ func (x *UserAccessKey) GetAccessedAt() int64 {
 	return 0
 }
 
+func (x *UserAccessKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 var File_models_model_user_access_key_proto protoreflect.FileDescriptor
 
 var file_models_model_user_access_key_proto_rawDesc = []byte{
 	0x0a, 0x22, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x75,
 	0x73, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xdf, 0x01, 0x0a, 0x0d, 0x55, 0x73, 0x65,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xf7, 0x01, 0x0a, 0x0d, 0x55, 0x73, 0x65,
 	0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
 	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
@@ -143,8 +151,10 @@ var file_models_model_user_access_key_proto_rawDesc = []byte{
 	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
 	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63,
 	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
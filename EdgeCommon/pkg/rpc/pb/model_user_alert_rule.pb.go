@@ -0,0 +1,231 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: models/model_user_alert_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 用户告警规则
+type UserAlertRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64   `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`               // 用户ID
+	ServerId      int64   `protobuf:"varint,3,opt,name=serverId,proto3" json:"serverId,omitempty"`           // 网站ID，为0表示用户的所有网站
+	IsOn          bool    `protobuf:"varint,4,opt,name=isOn,proto3" json:"isOn,omitempty"`                   // 是否启用
+	Item          string  `protobuf:"bytes,5,opt,name=item,proto3" json:"item,omitempty"`                    // 监控项：dailyTraffic, bandwidth, status4xxRatio, status5xxRatio
+	Value         float64 `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`                // 阈值
+	NotifyMinutes int32   `protobuf:"varint,7,opt,name=notifyMinutes,proto3" json:"notifyMinutes,omitempty"` // 重复通知间隔（分钟）
+	ReceiversJSON []byte  `protobuf:"bytes,8,opt,name=receiversJSON,proto3" json:"receiversJSON,omitempty"`  // 接收设置
+	NotifiedAt    int64   `protobuf:"varint,9,opt,name=notifiedAt,proto3" json:"notifiedAt,omitempty"`       // 最后通知时间
+	CreatedAt     int64   `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`        // 创建时间
+}
+
+func (x *UserAlertRule) Reset() {
+	*x = UserAlertRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_model_user_alert_rule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserAlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAlertRule) ProtoMessage() {}
+
+func (x *UserAlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_models_model_user_alert_rule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAlertRule.ProtoReflect.Descriptor instead.
+func (*UserAlertRule) Descriptor() ([]byte, []int) {
+	return file_models_model_user_alert_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserAlertRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserAlertRule) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserAlertRule) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *UserAlertRule) GetIsOn() bool {
+	if x != nil {
+		return x.IsOn
+	}
+	return false
+}
+
+func (x *UserAlertRule) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *UserAlertRule) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *UserAlertRule) GetNotifyMinutes() int32 {
+	if x != nil {
+		return x.NotifyMinutes
+	}
+	return 0
+}
+
+func (x *UserAlertRule) GetReceiversJSON() []byte {
+	if x != nil {
+		return x.ReceiversJSON
+	}
+	return nil
+}
+
+func (x *UserAlertRule) GetNotifiedAt() int64 {
+	if x != nil {
+		return x.NotifiedAt
+	}
+	return 0
+}
+
+func (x *UserAlertRule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+var File_models_model_user_alert_rule_proto protoreflect.FileDescriptor
+
+var file_models_model_user_alert_rule_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x9b, 0x02, 0x0a, 0x0d, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73,
+	0x4f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x4a,
+	0x53, 0x4f, 0x4e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_models_model_user_alert_rule_proto_rawDescOnce sync.Once
+	file_models_model_user_alert_rule_proto_rawDescData = file_models_model_user_alert_rule_proto_rawDesc
+)
+
+func file_models_model_user_alert_rule_proto_rawDescGZIP() []byte {
+	file_models_model_user_alert_rule_proto_rawDescOnce.Do(func() {
+		file_models_model_user_alert_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_models_model_user_alert_rule_proto_rawDescData)
+	})
+	return file_models_model_user_alert_rule_proto_rawDescData
+}
+
+var file_models_model_user_alert_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_models_model_user_alert_rule_proto_goTypes = []interface{}{
+	(*UserAlertRule)(nil), // 0: pb.UserAlertRule
+}
+var file_models_model_user_alert_rule_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_models_model_user_alert_rule_proto_init() }
+func file_models_model_user_alert_rule_proto_init() {
+	if File_models_model_user_alert_rule_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_models_model_user_alert_rule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAlertRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_models_model_user_alert_rule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_model_user_alert_rule_proto_goTypes,
+		DependencyIndexes: file_models_model_user_alert_rule_proto_depIdxs,
+		MessageInfos:      file_models_model_user_alert_rule_proto_msgTypes,
+	}.Build()
+	File_models_model_user_alert_rule_proto = out.File
+	file_models_model_user_alert_rule_proto_rawDesc = nil
+	file_models_model_user_alert_rule_proto_goTypes = nil
+	file_models_model_user_alert_rule_proto_depIdxs = nil
+}
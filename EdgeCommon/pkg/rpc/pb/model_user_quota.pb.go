@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: models/model_user_quota.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 用户配额使用情况
+type UserQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item     string `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`          // 配额项目：servers, sslCerts, dnsRecords, acmeTasks
+	MaxCount int64  `protobuf:"varint,2,opt,name=maxCount,proto3" json:"maxCount,omitempty"` // 最大数量，0表示不限制
+	Count    int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`       // 已使用数量
+	Source   string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`      // 限制来源：plan（套餐）、global（全局设置）
+}
+
+func (x *UserQuota) Reset() {
+	*x = UserQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_model_user_quota_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserQuota) ProtoMessage() {}
+
+func (x *UserQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_models_model_user_quota_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserQuota.ProtoReflect.Descriptor instead.
+func (*UserQuota) Descriptor() ([]byte, []int) {
+	return file_models_model_user_quota_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserQuota) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *UserQuota) GetMaxCount() int64 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *UserQuota) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UserQuota) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+var File_models_model_user_quota_proto protoreflect.FileDescriptor
+
+var file_models_model_user_quota_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x02, 0x70, 0x62, 0x22, 0x69, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x06,
+	0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_models_model_user_quota_proto_rawDescOnce sync.Once
+	file_models_model_user_quota_proto_rawDescData = file_models_model_user_quota_proto_rawDesc
+)
+
+func file_models_model_user_quota_proto_rawDescGZIP() []byte {
+	file_models_model_user_quota_proto_rawDescOnce.Do(func() {
+		file_models_model_user_quota_proto_rawDescData = protoimpl.X.CompressGZIP(file_models_model_user_quota_proto_rawDescData)
+	})
+	return file_models_model_user_quota_proto_rawDescData
+}
+
+var file_models_model_user_quota_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_models_model_user_quota_proto_goTypes = []interface{}{
+	(*UserQuota)(nil), // 0: pb.UserQuota
+}
+var file_models_model_user_quota_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_models_model_user_quota_proto_init() }
+func file_models_model_user_quota_proto_init() {
+	if File_models_model_user_quota_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_models_model_user_quota_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_models_model_user_quota_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_model_user_quota_proto_goTypes,
+		DependencyIndexes: file_models_model_user_quota_proto_depIdxs,
+		MessageInfos:      file_models_model_user_quota_proto_msgTypes,
+	}.Build()
+	File_models_model_user_quota_proto = out.File
+	file_models_model_user_quota_proto_rawDesc = nil
+	file_models_model_user_quota_proto_goTypes = nil
+	file_models_model_user_quota_proto_depIdxs = nil
+}
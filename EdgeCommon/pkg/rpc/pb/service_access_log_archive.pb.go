@@ -0,0 +1,805 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_access_log_archive.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 读取归档设置
+type FindAccessLogArchiveConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindAccessLogArchiveConfigRequest) Reset() {
+	*x = FindAccessLogArchiveConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_access_log_archive_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAccessLogArchiveConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAccessLogArchiveConfigRequest) ProtoMessage() {}
+
+func (x *FindAccessLogArchiveConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_access_log_archive_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAccessLogArchiveConfigRequest.ProtoReflect.Descriptor instead.
+func (*FindAccessLogArchiveConfigRequest) Descriptor() ([]byte, []int) {
+	return file_service_access_log_archive_proto_rawDescGZIP(), []int{0}
+}
+
+type FindAccessLogArchiveConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessLogArchiveConfigJSON []byte `protobuf:"bytes,1,opt,name=accessLogArchiveConfigJSON,proto3" json:"accessLogArchiveConfigJSON,omitempty"` // 归档设置
+}
+
+func (x *FindAccessLogArchiveConfigResponse) Reset() {
+	*x = FindAccessLogArchiveConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_access_log_archive_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAccessLogArchiveConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAccessLogArchiveConfigResponse) ProtoMessage() {}
+
+func (x *FindAccessLogArchiveConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_access_log_archive_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAccessLogArchiveConfigResponse.ProtoReflect.Descriptor instead.
+func (*FindAccessLogArchiveConfigResponse) Descriptor() ([]byte, []int) {
+	return file_service_access_log_archive_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FindAccessLogArchiveConfigResponse) GetAccessLogArchiveConfigJSON() []byte {
+	if x != nil {
+		return x.AccessLogArchiveConfigJSON
+	}
+	return nil
+}
+
+// 修改归档设置
+type UpdateAccessLogArchiveConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessLogArchiveConfigJSON []byte `protobuf:"bytes,1,opt,name=accessLogArchiveConfigJSON,proto3" json:"accessLogArchiveConfigJSON,omitempty"` // 归档设置
+}
+
+func (x *UpdateAccessLogArchiveConfigRequest) Reset() {
+	*x = UpdateAccessLogArchiveConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_access_log_archive_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAccessLogArchiveConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccessLogArchiveConfigRequest) ProtoMessage() {}
+
+func (x *UpdateAccessLogArchiveConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_access_log_archive_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccessLogArchiveConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccessLogArchiveConfigRequest) Descriptor() ([]byte, []int) {
+	return file_service_access_log_archive_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateAccessLogArchiveConfigRequest) GetAccessLogArchiveConfigJSON() []byte {
+	if x != nil {
+		return x.AccessLogArchiveConfigJSON
+	}
+	return nil
+}
+
+// 查找已归档的访问日志
+type FindAllAccessLogArchivesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogType string `protobuf:"bytes,1,opt,name=logType,proto3" json:"logType,omitempty"` // 日志类型：http、ns，为空表示所有类型
+	DayFrom string `protobuf:"bytes,2,opt,name=dayFrom,proto3" json:"dayFrom,omitempty"` // 开始日期，格式为YYYYMMDD
+	DayTo   string `protobuf:"bytes,3,opt,name=dayTo,proto3" json:"dayTo,omitempty"`     // 结束日期，格式为YYYYMMDD
+}
+
+func (x *FindAllAccessLogArchivesRequest) Reset() {
+	*x = FindAllAccessLogArchivesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_access_log_archive_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllAccessLogArchivesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllAccessLogArchivesRequest) ProtoMessage() {}
+
+func (x *FindAllAccessLogArchivesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_access_log_archive_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllAccessLogArchivesRequest.ProtoReflect.Descriptor instead.
+func (*FindAllAccessLogArchivesRequest) Descriptor() ([]byte, []int) {
+	return file_service_access_log_archive_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FindAllAccessLogArchivesRequest) GetLogType() string {
+	if x != nil {
+		return x.LogType
+	}
+	return ""
+}
+
+func (x *FindAllAccessLogArchivesRequest) GetDayFrom() string {
+	if x != nil {
+		return x.DayFrom
+	}
+	return ""
+}
+
+func (x *FindAllAccessLogArchivesRequest) GetDayTo() string {
+	if x != nil {
+		return x.DayTo
+	}
+	return ""
+}
+
+type FindAllAccessLogArchivesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessLogArchives []*FindAllAccessLogArchivesResponse_AccessLogArchive `protobuf:"bytes,1,rep,name=accessLogArchives,proto3" json:"accessLogArchives,omitempty"`
+}
+
+func (x *FindAllAccessLogArchivesResponse) Reset() {
+	*x = FindAllAccessLogArchivesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_access_log_archive_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllAccessLogArchivesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllAccessLogArchivesResponse) ProtoMessage() {}
+
+func (x *FindAllAccessLogArchivesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_access_log_archive_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllAccessLogArchivesResponse.ProtoReflect.Descriptor instead.
+func (*FindAllAccessLogArchivesResponse) Descriptor() ([]byte, []int) {
+	return file_service_access_log_archive_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FindAllAccessLogArchivesResponse) GetAccessLogArchives() []*FindAllAccessLogArchivesResponse_AccessLogArchive {
+	if x != nil {
+		return x.AccessLogArchives
+	}
+	return nil
+}
+
+// 查找已归档的连续日期范围
+type FindAccessLogArchiveRangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogType string `protobuf:"bytes,1,opt,name=logType,proto3" json:"logType,omitempty"` // 日志类型：http、ns，为空表示所有类型
+}
+
+func (x *FindAccessLogArchiveRangesRequest) Reset() {
+	*x = FindAccessLogArchiveRangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_access_log_archive_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAccessLogArchiveRangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAccessLogArchiveRangesRequest) ProtoMessage() {}
+
+func (x *FindAccessLogArchiveRangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_access_log_archive_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAccessLogArchiveRangesRequest.ProtoReflect.Descriptor instead.
+func (*FindAccessLogArchiveRangesRequest) Descriptor() ([]byte, []int) {
+	return file_service_access_log_archive_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindAccessLogArchiveRangesRequest) GetLogType() string {
+	if x != nil {
+		return x.LogType
+	}
+	return ""
+}
+
+type FindAccessLogArchiveRangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DayRanges []*FindAccessLogArchiveRangesResponse_DayRange `protobuf:"bytes,1,rep,name=dayRanges,proto3" json:"dayRanges,omitempty"`
+}
+
+func (x *FindAccessLogArchiveRangesResponse) Reset() {
+	*x = FindAccessLogArchiveRangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_access_log_archive_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAccessLogArchiveRangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAccessLogArchiveRangesResponse) ProtoMessage() {}
+
+func (x *FindAccessLogArchiveRangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_access_log_archive_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAccessLogArchiveRangesResponse.ProtoReflect.Descriptor instead.
+func (*FindAccessLogArchiveRangesResponse) Descriptor() ([]byte, []int) {
+	return file_service_access_log_archive_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FindAccessLogArchiveRangesResponse) GetDayRanges() []*FindAccessLogArchiveRangesResponse_DayRange {
+	if x != nil {
+		return x.DayRanges
+	}
+	return nil
+}
+
+type FindAllAccessLogArchivesResponse_AccessLogArchive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DbNodeId  int64  `protobuf:"varint,2,opt,name=dbNodeId,proto3" json:"dbNodeId,omitempty"`   // 数据库节点ID，0表示默认数据库
+	TableName string `protobuf:"bytes,3,opt,name=tableName,proto3" json:"tableName,omitempty"`  // 原来的表名
+	LogType   string `protobuf:"bytes,4,opt,name=logType,proto3" json:"logType,omitempty"`      // 日志类型
+	Day       string `protobuf:"bytes,5,opt,name=day,proto3" json:"day,omitempty"`              // 日期
+	ObjectKey string `protobuf:"bytes,6,opt,name=objectKey,proto3" json:"objectKey,omitempty"`  // 对象存储中的Key
+	Size      int64  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`           // 压缩后的尺寸
+	CountRows int64  `protobuf:"varint,8,opt,name=countRows,proto3" json:"countRows,omitempty"` // 日志数量
+	CreatedAt int64  `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // 归档时间
+}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) Reset() {
+	*x = FindAllAccessLogArchivesResponse_AccessLogArchive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_access_log_archive_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllAccessLogArchivesResponse_AccessLogArchive) ProtoMessage() {}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_service_access_log_archive_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllAccessLogArchivesResponse_AccessLogArchive.ProtoReflect.Descriptor instead.
+func (*FindAllAccessLogArchivesResponse_AccessLogArchive) Descriptor() ([]byte, []int) {
+	return file_service_access_log_archive_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) GetDbNodeId() int64 {
+	if x != nil {
+		return x.DbNodeId
+	}
+	return 0
+}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) GetLogType() string {
+	if x != nil {
+		return x.LogType
+	}
+	return ""
+}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) GetCountRows() int64 {
+	if x != nil {
+		return x.CountRows
+	}
+	return 0
+}
+
+func (x *FindAllAccessLogArchivesResponse_AccessLogArchive) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type FindAccessLogArchiveRangesResponse_DayRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DayFrom string `protobuf:"bytes,1,opt,name=dayFrom,proto3" json:"dayFrom,omitempty"`
+	DayTo   string `protobuf:"bytes,2,opt,name=dayTo,proto3" json:"dayTo,omitempty"`
+}
+
+func (x *FindAccessLogArchiveRangesResponse_DayRange) Reset() {
+	*x = FindAccessLogArchiveRangesResponse_DayRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_access_log_archive_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAccessLogArchiveRangesResponse_DayRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAccessLogArchiveRangesResponse_DayRange) ProtoMessage() {}
+
+func (x *FindAccessLogArchiveRangesResponse_DayRange) ProtoReflect() protoreflect.Message {
+	mi := &file_service_access_log_archive_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAccessLogArchiveRangesResponse_DayRange.ProtoReflect.Descriptor instead.
+func (*FindAccessLogArchiveRangesResponse_DayRange) Descriptor() ([]byte, []int) {
+	return file_service_access_log_archive_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *FindAccessLogArchiveRangesResponse_DayRange) GetDayFrom() string {
+	if x != nil {
+		return x.DayFrom
+	}
+	return ""
+}
+
+func (x *FindAccessLogArchiveRangesResponse_DayRange) GetDayTo() string {
+	if x != nil {
+		return x.DayTo
+	}
+	return ""
+}
+
+var File_service_access_log_archive_proto protoreflect.FileDescriptor
+
+var file_service_access_log_archive_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x19, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x72,
+	0x70, 0x63, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x23, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x22, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x1a,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x1a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x65, 0x0a, 0x23,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x1a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f,
+	0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a,
+	0x53, 0x4f, 0x4e, 0x22, 0x6b, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x61, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x61, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61,
+	0x79, 0x54, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x79, 0x54, 0x6f,
+	0x22, 0x80, 0x03, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x35, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x1a, 0xf6, 0x01, 0x0a, 0x10, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x62, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x64, 0x62, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x67,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b,
+	0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x3d, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x22, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x09, 0x64, 0x61, 0x79,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70,
+	0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x64,
+	0x61, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x08, 0x44, 0x61, 0x79, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x61, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x61, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x61, 0x79, 0x54, 0x6f, 0x32, 0xb3, 0x03, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x6b, 0x0a, 0x1a, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f,
+	0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f,
+	0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x1c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x65, 0x0a, 0x18, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x1a, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_access_log_archive_proto_rawDescOnce sync.Once
+	file_service_access_log_archive_proto_rawDescData = file_service_access_log_archive_proto_rawDesc
+)
+
+func file_service_access_log_archive_proto_rawDescGZIP() []byte {
+	file_service_access_log_archive_proto_rawDescOnce.Do(func() {
+		file_service_access_log_archive_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_access_log_archive_proto_rawDescData)
+	})
+	return file_service_access_log_archive_proto_rawDescData
+}
+
+var file_service_access_log_archive_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_service_access_log_archive_proto_goTypes = []interface{}{
+	(*FindAccessLogArchiveConfigRequest)(nil),                 // 0: pb.FindAccessLogArchiveConfigRequest
+	(*FindAccessLogArchiveConfigResponse)(nil),                // 1: pb.FindAccessLogArchiveConfigResponse
+	(*UpdateAccessLogArchiveConfigRequest)(nil),               // 2: pb.UpdateAccessLogArchiveConfigRequest
+	(*FindAllAccessLogArchivesRequest)(nil),                   // 3: pb.FindAllAccessLogArchivesRequest
+	(*FindAllAccessLogArchivesResponse)(nil),                  // 4: pb.FindAllAccessLogArchivesResponse
+	(*FindAccessLogArchiveRangesRequest)(nil),                 // 5: pb.FindAccessLogArchiveRangesRequest
+	(*FindAccessLogArchiveRangesResponse)(nil),                // 6: pb.FindAccessLogArchiveRangesResponse
+	(*FindAllAccessLogArchivesResponse_AccessLogArchive)(nil), // 7: pb.FindAllAccessLogArchivesResponse.AccessLogArchive
+	(*FindAccessLogArchiveRangesResponse_DayRange)(nil),       // 8: pb.FindAccessLogArchiveRangesResponse.DayRange
+	(*RPCSuccess)(nil),                                        // 9: pb.RPCSuccess
+}
+var file_service_access_log_archive_proto_depIdxs = []int32{
+	7, // 0: pb.FindAllAccessLogArchivesResponse.accessLogArchives:type_name -> pb.FindAllAccessLogArchivesResponse.AccessLogArchive
+	8, // 1: pb.FindAccessLogArchiveRangesResponse.dayRanges:type_name -> pb.FindAccessLogArchiveRangesResponse.DayRange
+	0, // 2: pb.AccessLogArchiveService.findAccessLogArchiveConfig:input_type -> pb.FindAccessLogArchiveConfigRequest
+	2, // 3: pb.AccessLogArchiveService.updateAccessLogArchiveConfig:input_type -> pb.UpdateAccessLogArchiveConfigRequest
+	3, // 4: pb.AccessLogArchiveService.findAllAccessLogArchives:input_type -> pb.FindAllAccessLogArchivesRequest
+	5, // 5: pb.AccessLogArchiveService.findAccessLogArchiveRanges:input_type -> pb.FindAccessLogArchiveRangesRequest
+	1, // 6: pb.AccessLogArchiveService.findAccessLogArchiveConfig:output_type -> pb.FindAccessLogArchiveConfigResponse
+	9, // 7: pb.AccessLogArchiveService.updateAccessLogArchiveConfig:output_type -> pb.RPCSuccess
+	4, // 8: pb.AccessLogArchiveService.findAllAccessLogArchives:output_type -> pb.FindAllAccessLogArchivesResponse
+	6, // 9: pb.AccessLogArchiveService.findAccessLogArchiveRanges:output_type -> pb.FindAccessLogArchiveRangesResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_service_access_log_archive_proto_init() }
+func file_service_access_log_archive_proto_init() {
+	if File_service_access_log_archive_proto != nil {
+		return
+	}
+	file_models_rpc_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_access_log_archive_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAccessLogArchiveConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_access_log_archive_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAccessLogArchiveConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_access_log_archive_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAccessLogArchiveConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_access_log_archive_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllAccessLogArchivesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_access_log_archive_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllAccessLogArchivesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_access_log_archive_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAccessLogArchiveRangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_access_log_archive_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAccessLogArchiveRangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_access_log_archive_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllAccessLogArchivesResponse_AccessLogArchive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_access_log_archive_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAccessLogArchiveRangesResponse_DayRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_access_log_archive_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_access_log_archive_proto_goTypes,
+		DependencyIndexes: file_service_access_log_archive_proto_depIdxs,
+		MessageInfos:      file_service_access_log_archive_proto_msgTypes,
+	}.Build()
+	File_service_access_log_archive_proto = out.File
+	file_service_access_log_archive_proto_rawDesc = nil
+	file_service_access_log_archive_proto_goTypes = nil
+	file_service_access_log_archive_proto_depIdxs = nil
+}
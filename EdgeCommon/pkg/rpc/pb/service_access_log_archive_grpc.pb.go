@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_access_log_archive.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AccessLogArchiveService_FindAccessLogArchiveConfig_FullMethodName   = "/pb.AccessLogArchiveService/findAccessLogArchiveConfig"
+	AccessLogArchiveService_UpdateAccessLogArchiveConfig_FullMethodName = "/pb.AccessLogArchiveService/updateAccessLogArchiveConfig"
+	AccessLogArchiveService_FindAllAccessLogArchives_FullMethodName     = "/pb.AccessLogArchiveService/findAllAccessLogArchives"
+	AccessLogArchiveService_FindAccessLogArchiveRanges_FullMethodName   = "/pb.AccessLogArchiveService/findAccessLogArchiveRanges"
+)
+
+// AccessLogArchiveServiceClient is the client API for AccessLogArchiveService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AccessLogArchiveServiceClient interface {
+	// 读取归档设置
+	FindAccessLogArchiveConfig(ctx context.Context, in *FindAccessLogArchiveConfigRequest, opts ...grpc.CallOption) (*FindAccessLogArchiveConfigResponse, error)
+	// 修改归档设置
+	UpdateAccessLogArchiveConfig(ctx context.Context, in *UpdateAccessLogArchiveConfigRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 查找已归档的访问日志
+	FindAllAccessLogArchives(ctx context.Context, in *FindAllAccessLogArchivesRequest, opts ...grpc.CallOption) (*FindAllAccessLogArchivesResponse, error)
+	// 查找已归档的连续日期范围
+	FindAccessLogArchiveRanges(ctx context.Context, in *FindAccessLogArchiveRangesRequest, opts ...grpc.CallOption) (*FindAccessLogArchiveRangesResponse, error)
+}
+
+type accessLogArchiveServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccessLogArchiveServiceClient(cc grpc.ClientConnInterface) AccessLogArchiveServiceClient {
+	return &accessLogArchiveServiceClient{cc}
+}
+
+func (c *accessLogArchiveServiceClient) FindAccessLogArchiveConfig(ctx context.Context, in *FindAccessLogArchiveConfigRequest, opts ...grpc.CallOption) (*FindAccessLogArchiveConfigResponse, error) {
+	out := new(FindAccessLogArchiveConfigResponse)
+	err := c.cc.Invoke(ctx, AccessLogArchiveService_FindAccessLogArchiveConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessLogArchiveServiceClient) UpdateAccessLogArchiveConfig(ctx context.Context, in *UpdateAccessLogArchiveConfigRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, AccessLogArchiveService_UpdateAccessLogArchiveConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessLogArchiveServiceClient) FindAllAccessLogArchives(ctx context.Context, in *FindAllAccessLogArchivesRequest, opts ...grpc.CallOption) (*FindAllAccessLogArchivesResponse, error) {
+	out := new(FindAllAccessLogArchivesResponse)
+	err := c.cc.Invoke(ctx, AccessLogArchiveService_FindAllAccessLogArchives_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessLogArchiveServiceClient) FindAccessLogArchiveRanges(ctx context.Context, in *FindAccessLogArchiveRangesRequest, opts ...grpc.CallOption) (*FindAccessLogArchiveRangesResponse, error) {
+	out := new(FindAccessLogArchiveRangesResponse)
+	err := c.cc.Invoke(ctx, AccessLogArchiveService_FindAccessLogArchiveRanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccessLogArchiveServiceServer is the server API for AccessLogArchiveService service.
+// All implementations should embed UnimplementedAccessLogArchiveServiceServer
+// for forward compatibility
+type AccessLogArchiveServiceServer interface {
+	// 读取归档设置
+	FindAccessLogArchiveConfig(context.Context, *FindAccessLogArchiveConfigRequest) (*FindAccessLogArchiveConfigResponse, error)
+	// 修改归档设置
+	UpdateAccessLogArchiveConfig(context.Context, *UpdateAccessLogArchiveConfigRequest) (*RPCSuccess, error)
+	// 查找已归档的访问日志
+	FindAllAccessLogArchives(context.Context, *FindAllAccessLogArchivesRequest) (*FindAllAccessLogArchivesResponse, error)
+	// 查找已归档的连续日期范围
+	FindAccessLogArchiveRanges(context.Context, *FindAccessLogArchiveRangesRequest) (*FindAccessLogArchiveRangesResponse, error)
+}
+
+// UnimplementedAccessLogArchiveServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedAccessLogArchiveServiceServer struct {
+}
+
+func (UnimplementedAccessLogArchiveServiceServer) FindAccessLogArchiveConfig(context.Context, *FindAccessLogArchiveConfigRequest) (*FindAccessLogArchiveConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAccessLogArchiveConfig not implemented")
+}
+func (UnimplementedAccessLogArchiveServiceServer) UpdateAccessLogArchiveConfig(context.Context, *UpdateAccessLogArchiveConfigRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccessLogArchiveConfig not implemented")
+}
+func (UnimplementedAccessLogArchiveServiceServer) FindAllAccessLogArchives(context.Context, *FindAllAccessLogArchivesRequest) (*FindAllAccessLogArchivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllAccessLogArchives not implemented")
+}
+func (UnimplementedAccessLogArchiveServiceServer) FindAccessLogArchiveRanges(context.Context, *FindAccessLogArchiveRangesRequest) (*FindAccessLogArchiveRangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAccessLogArchiveRanges not implemented")
+}
+
+// UnsafeAccessLogArchiveServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccessLogArchiveServiceServer will
+// result in compilation errors.
+type UnsafeAccessLogArchiveServiceServer interface {
+	mustEmbedUnimplementedAccessLogArchiveServiceServer()
+}
+
+func RegisterAccessLogArchiveServiceServer(s grpc.ServiceRegistrar, srv AccessLogArchiveServiceServer) {
+	s.RegisterService(&AccessLogArchiveService_ServiceDesc, srv)
+}
+
+func _AccessLogArchiveService_FindAccessLogArchiveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAccessLogArchiveConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessLogArchiveServiceServer).FindAccessLogArchiveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessLogArchiveService_FindAccessLogArchiveConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessLogArchiveServiceServer).FindAccessLogArchiveConfig(ctx, req.(*FindAccessLogArchiveConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessLogArchiveService_UpdateAccessLogArchiveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccessLogArchiveConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessLogArchiveServiceServer).UpdateAccessLogArchiveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessLogArchiveService_UpdateAccessLogArchiveConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessLogArchiveServiceServer).UpdateAccessLogArchiveConfig(ctx, req.(*UpdateAccessLogArchiveConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessLogArchiveService_FindAllAccessLogArchives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllAccessLogArchivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessLogArchiveServiceServer).FindAllAccessLogArchives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessLogArchiveService_FindAllAccessLogArchives_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessLogArchiveServiceServer).FindAllAccessLogArchives(ctx, req.(*FindAllAccessLogArchivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessLogArchiveService_FindAccessLogArchiveRanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAccessLogArchiveRangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessLogArchiveServiceServer).FindAccessLogArchiveRanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessLogArchiveService_FindAccessLogArchiveRanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessLogArchiveServiceServer).FindAccessLogArchiveRanges(ctx, req.(*FindAccessLogArchiveRangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccessLogArchiveService_ServiceDesc is the grpc.ServiceDesc for AccessLogArchiveService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccessLogArchiveService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.AccessLogArchiveService",
+	HandlerType: (*AccessLogArchiveServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "findAccessLogArchiveConfig",
+			Handler:    _AccessLogArchiveService_FindAccessLogArchiveConfig_Handler,
+		},
+		{
+			MethodName: "updateAccessLogArchiveConfig",
+			Handler:    _AccessLogArchiveService_UpdateAccessLogArchiveConfig_Handler,
+		},
+		{
+			MethodName: "findAllAccessLogArchives",
+			Handler:    _AccessLogArchiveService_FindAllAccessLogArchives_Handler,
+		},
+		{
+			MethodName: "findAccessLogArchiveRanges",
+			Handler:    _AccessLogArchiveService_FindAccessLogArchiveRanges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_access_log_archive.proto",
+}
@@ -115,6 +115,172 @@ func (x *FindACMEAuthenticationKeyWithTokenResponse) GetKey() string {
 	return ""
 }
 
+// 查找最近的认证信息
+type FindRecentACMEAuthenticationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SinceTime int64 `protobuf:"varint,1,opt,name=sinceTime,proto3" json:"sinceTime,omitempty"` // 从此时间（包含）开始创建的认证信息，最多只会返回一小时内的认证信息
+}
+
+func (x *FindRecentACMEAuthenticationsRequest) Reset() {
+	*x = FindRecentACMEAuthenticationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_acme_authentication_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindRecentACMEAuthenticationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRecentACMEAuthenticationsRequest) ProtoMessage() {}
+
+func (x *FindRecentACMEAuthenticationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_acme_authentication_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRecentACMEAuthenticationsRequest.ProtoReflect.Descriptor instead.
+func (*FindRecentACMEAuthenticationsRequest) Descriptor() ([]byte, []int) {
+	return file_service_acme_authentication_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FindRecentACMEAuthenticationsRequest) GetSinceTime() int64 {
+	if x != nil {
+		return x.SinceTime
+	}
+	return 0
+}
+
+type FindRecentACMEAuthenticationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AcmeAuthentications []*FindRecentACMEAuthenticationsResponse_ACMEAuthentication `protobuf:"bytes,1,rep,name=acmeAuthentications,proto3" json:"acmeAuthentications,omitempty"`
+}
+
+func (x *FindRecentACMEAuthenticationsResponse) Reset() {
+	*x = FindRecentACMEAuthenticationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_acme_authentication_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindRecentACMEAuthenticationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRecentACMEAuthenticationsResponse) ProtoMessage() {}
+
+func (x *FindRecentACMEAuthenticationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_acme_authentication_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRecentACMEAuthenticationsResponse.ProtoReflect.Descriptor instead.
+func (*FindRecentACMEAuthenticationsResponse) Descriptor() ([]byte, []int) {
+	return file_service_acme_authentication_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FindRecentACMEAuthenticationsResponse) GetAcmeAuthentications() []*FindRecentACMEAuthenticationsResponse_ACMEAuthentication {
+	if x != nil {
+		return x.AcmeAuthentications
+	}
+	return nil
+}
+
+type FindRecentACMEAuthenticationsResponse_ACMEAuthentication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain    string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Key       string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	CreatedAt int64  `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *FindRecentACMEAuthenticationsResponse_ACMEAuthentication) Reset() {
+	*x = FindRecentACMEAuthenticationsResponse_ACMEAuthentication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_acme_authentication_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindRecentACMEAuthenticationsResponse_ACMEAuthentication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRecentACMEAuthenticationsResponse_ACMEAuthentication) ProtoMessage() {}
+
+func (x *FindRecentACMEAuthenticationsResponse_ACMEAuthentication) ProtoReflect() protoreflect.Message {
+	mi := &file_service_acme_authentication_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRecentACMEAuthenticationsResponse_ACMEAuthentication.ProtoReflect.Descriptor instead.
+func (*FindRecentACMEAuthenticationsResponse_ACMEAuthentication) Descriptor() ([]byte, []int) {
+	return file_service_acme_authentication_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *FindRecentACMEAuthenticationsResponse_ACMEAuthentication) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *FindRecentACMEAuthenticationsResponse_ACMEAuthentication) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *FindRecentACMEAuthenticationsResponse_ACMEAuthentication) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FindRecentACMEAuthenticationsResponse_ACMEAuthentication) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
 var File_service_acme_authentication_proto protoreflect.FileDescriptor
 
 var file_service_acme_authentication_proto_rawDesc = []byte{
@@ -128,18 +294,47 @@ var file_service_acme_authentication_proto_rawDesc = []byte{
 	0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x32, 0xa1, 0x01, 0x0a, 0x19, 0x41,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x44, 0x0a, 0x24, 0x46, 0x69,
+	0x6e, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x8b, 0x02, 0x0a, 0x25, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41,
 	0x43, 0x4d, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x22, 0x66, 0x69, 0x6e,
-	0x64, 0x41, 0x43, 0x4d, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x2d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x57, 0x69,
-	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
-	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x57, 0x69, 0x74,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06,
-	0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x61, 0x63,
+	0x6d, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x41, 0x43, 0x4d, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x61, 0x63, 0x6d, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x72, 0x0a, 0x12, 0x41, 0x43,
+	0x4d, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0x97,
+	0x02, 0x0a, 0x19, 0x41, 0x43, 0x4d, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x83, 0x01, 0x0a,
+	0x22, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x2d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d,
+	0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b,
+	0x65, 0x79, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65,
+	0x79, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x74, 0x0a, 0x1d, 0x66, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x41, 0x43, 0x4d, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x43, 0x4d,
+	0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -154,19 +349,25 @@ func file_service_acme_authentication_proto_rawDescGZIP() []byte {
 	return file_service_acme_authentication_proto_rawDescData
 }
 
-var file_service_acme_authentication_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_service_acme_authentication_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_service_acme_authentication_proto_goTypes = []interface{}{
-	(*FindACMEAuthenticationKeyWithTokenRequest)(nil),  // 0: pb.FindACMEAuthenticationKeyWithTokenRequest
-	(*FindACMEAuthenticationKeyWithTokenResponse)(nil), // 1: pb.FindACMEAuthenticationKeyWithTokenResponse
+	(*FindACMEAuthenticationKeyWithTokenRequest)(nil),                // 0: pb.FindACMEAuthenticationKeyWithTokenRequest
+	(*FindACMEAuthenticationKeyWithTokenResponse)(nil),               // 1: pb.FindACMEAuthenticationKeyWithTokenResponse
+	(*FindRecentACMEAuthenticationsRequest)(nil),                     // 2: pb.FindRecentACMEAuthenticationsRequest
+	(*FindRecentACMEAuthenticationsResponse)(nil),                    // 3: pb.FindRecentACMEAuthenticationsResponse
+	(*FindRecentACMEAuthenticationsResponse_ACMEAuthentication)(nil), // 4: pb.FindRecentACMEAuthenticationsResponse.ACMEAuthentication
 }
 var file_service_acme_authentication_proto_depIdxs = []int32{
-	0, // 0: pb.ACMEAuthenticationService.findACMEAuthenticationKeyWithToken:input_type -> pb.FindACMEAuthenticationKeyWithTokenRequest
-	1, // 1: pb.ACMEAuthenticationService.findACMEAuthenticationKeyWithToken:output_type -> pb.FindACMEAuthenticationKeyWithTokenResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: pb.FindRecentACMEAuthenticationsResponse.acmeAuthentications:type_name -> pb.FindRecentACMEAuthenticationsResponse.ACMEAuthentication
+	0, // 1: pb.ACMEAuthenticationService.findACMEAuthenticationKeyWithToken:input_type -> pb.FindACMEAuthenticationKeyWithTokenRequest
+	2, // 2: pb.ACMEAuthenticationService.findRecentACMEAuthentications:input_type -> pb.FindRecentACMEAuthenticationsRequest
+	1, // 3: pb.ACMEAuthenticationService.findACMEAuthenticationKeyWithToken:output_type -> pb.FindACMEAuthenticationKeyWithTokenResponse
+	3, // 4: pb.ACMEAuthenticationService.findRecentACMEAuthentications:output_type -> pb.FindRecentACMEAuthenticationsResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_service_acme_authentication_proto_init() }
@@ -199,6 +400,42 @@ func file_service_acme_authentication_proto_init() {
 				return nil
 			}
 		}
+		file_service_acme_authentication_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindRecentACMEAuthenticationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_acme_authentication_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindRecentACMEAuthenticationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_acme_authentication_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindRecentACMEAuthenticationsResponse_ACMEAuthentication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -206,7 +443,7 @@ func file_service_acme_authentication_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_acme_authentication_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	ACMEAuthenticationService_FindACMEAuthenticationKeyWithToken_FullMethodName = "/pb.ACMEAuthenticationService/findACMEAuthenticationKeyWithToken"
+	ACMEAuthenticationService_FindRecentACMEAuthentications_FullMethodName      = "/pb.ACMEAuthenticationService/findRecentACMEAuthentications"
 )
 
 // ACMEAuthenticationServiceClient is the client API for ACMEAuthenticationService service.
@@ -28,6 +29,8 @@ const (
 type ACMEAuthenticationServiceClient interface {
 	// 获取Key
 	FindACMEAuthenticationKeyWithToken(ctx context.Context, in *FindACMEAuthenticationKeyWithTokenRequest, opts ...grpc.CallOption) (*FindACMEAuthenticationKeyWithTokenResponse, error)
+	// 查找最近的认证信息，供边缘节点提前缓存HTTP认证令牌
+	FindRecentACMEAuthentications(ctx context.Context, in *FindRecentACMEAuthenticationsRequest, opts ...grpc.CallOption) (*FindRecentACMEAuthenticationsResponse, error)
 }
 
 type aCMEAuthenticationServiceClient struct {
@@ -47,12 +50,23 @@ func (c *aCMEAuthenticationServiceClient) FindACMEAuthenticationKeyWithToken(ctx
 	return out, nil
 }
 
+func (c *aCMEAuthenticationServiceClient) FindRecentACMEAuthentications(ctx context.Context, in *FindRecentACMEAuthenticationsRequest, opts ...grpc.CallOption) (*FindRecentACMEAuthenticationsResponse, error) {
+	out := new(FindRecentACMEAuthenticationsResponse)
+	err := c.cc.Invoke(ctx, ACMEAuthenticationService_FindRecentACMEAuthentications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ACMEAuthenticationServiceServer is the server API for ACMEAuthenticationService service.
 // All implementations should embed UnimplementedACMEAuthenticationServiceServer
 // for forward compatibility
 type ACMEAuthenticationServiceServer interface {
 	// 获取Key
 	FindACMEAuthenticationKeyWithToken(context.Context, *FindACMEAuthenticationKeyWithTokenRequest) (*FindACMEAuthenticationKeyWithTokenResponse, error)
+	// 查找最近的认证信息，供边缘节点提前缓存HTTP认证令牌
+	FindRecentACMEAuthentications(context.Context, *FindRecentACMEAuthenticationsRequest) (*FindRecentACMEAuthenticationsResponse, error)
 }
 
 // UnimplementedACMEAuthenticationServiceServer should be embedded to have forward compatible implementations.
@@ -62,6 +76,9 @@ type UnimplementedACMEAuthenticationServiceServer struct {
 func (UnimplementedACMEAuthenticationServiceServer) FindACMEAuthenticationKeyWithToken(context.Context, *FindACMEAuthenticationKeyWithTokenRequest) (*FindACMEAuthenticationKeyWithTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindACMEAuthenticationKeyWithToken not implemented")
 }
+func (UnimplementedACMEAuthenticationServiceServer) FindRecentACMEAuthentications(context.Context, *FindRecentACMEAuthenticationsRequest) (*FindRecentACMEAuthenticationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRecentACMEAuthentications not implemented")
+}
 
 // UnsafeACMEAuthenticationServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ACMEAuthenticationServiceServer will
@@ -92,6 +109,24 @@ func _ACMEAuthenticationService_FindACMEAuthenticationKeyWithToken_Handler(srv i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ACMEAuthenticationService_FindRecentACMEAuthentications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRecentACMEAuthenticationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACMEAuthenticationServiceServer).FindRecentACMEAuthentications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ACMEAuthenticationService_FindRecentACMEAuthentications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACMEAuthenticationServiceServer).FindRecentACMEAuthentications(ctx, req.(*FindRecentACMEAuthenticationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ACMEAuthenticationService_ServiceDesc is the grpc.ServiceDesc for ACMEAuthenticationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +138,10 @@ var ACMEAuthenticationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "findACMEAuthenticationKeyWithToken",
 			Handler:    _ACMEAuthenticationService_FindACMEAuthenticationKeyWithToken_Handler,
 		},
+		{
+			MethodName: "findRecentACMEAuthentications",
+			Handler:    _ACMEAuthenticationService_FindRecentACMEAuthentications_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_acme_authentication.proto",
@@ -377,15 +377,22 @@ type CreateACMETaskRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId        int64    `protobuf:"varint,8,opt,name=userId,proto3" json:"userId,omitempty"` // 平台用户ID，只有管理员才有权限指定
-	AcmeUserId    int64    `protobuf:"varint,1,opt,name=acmeUserId,proto3" json:"acmeUserId,omitempty"`
-	DnsProviderId int64    `protobuf:"varint,2,opt,name=dnsProviderId,proto3" json:"dnsProviderId,omitempty"`
-	DnsDomain     string   `protobuf:"bytes,3,opt,name=dnsDomain,proto3" json:"dnsDomain,omitempty"`
-	Domains       []string `protobuf:"bytes,4,rep,name=domains,proto3" json:"domains,omitempty"`
-	AutoRenew     bool     `protobuf:"varint,5,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"`
-	AuthType      string   `protobuf:"bytes,6,opt,name=authType,proto3" json:"authType,omitempty"`
-	AuthURL       string   `protobuf:"bytes,7,opt,name=authURL,proto3" json:"authURL,omitempty"`
-	Async         bool     `protobuf:"varint,100,opt,name=async,proto3" json:"async,omitempty"`
+	UserId              int64    `protobuf:"varint,8,opt,name=userId,proto3" json:"userId,omitempty"` // 平台用户ID，只有管理员才有权限指定
+	AcmeUserId          int64    `protobuf:"varint,1,opt,name=acmeUserId,proto3" json:"acmeUserId,omitempty"`
+	DnsProviderId       int64    `protobuf:"varint,2,opt,name=dnsProviderId,proto3" json:"dnsProviderId,omitempty"`
+	DnsDomain           string   `protobuf:"bytes,3,opt,name=dnsDomain,proto3" json:"dnsDomain,omitempty"`
+	Domains             []string `protobuf:"bytes,4,rep,name=domains,proto3" json:"domains,omitempty"`
+	AutoRenew           bool     `protobuf:"varint,5,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"`
+	AuthType            string   `protobuf:"bytes,6,opt,name=authType,proto3" json:"authType,omitempty"`
+	AuthURL             string   `protobuf:"bytes,7,opt,name=authURL,proto3" json:"authURL,omitempty"`
+	DnsAliasProviderId  int64    `protobuf:"varint,9,opt,name=dnsAliasProviderId,proto3" json:"dnsAliasProviderId,omitempty"`    // DNS别名（CNAME委托）所在域名的服务商ID
+	DnsAliasDomain      string   `protobuf:"bytes,10,opt,name=dnsAliasDomain,proto3" json:"dnsAliasDomain,omitempty"`            // DNS别名（CNAME委托）域名
+	KeyType             string   `protobuf:"bytes,11,opt,name=keyType,proto3" json:"keyType,omitempty"`                          // 私钥类型：ec256、ec384、rsa2048、rsa3072、rsa4096，为空表示rsa2048
+	PreferredChain      string   `protobuf:"bytes,12,opt,name=preferredChain,proto3" json:"preferredChain,omitempty"`            // 首选证书链顶级证书的签发者通用名称，比如 ISRG Root X1，为空表示使用CA的默认证书链
+	DirectoryURL        string   `protobuf:"bytes,13,opt,name=directoryURL,proto3" json:"directoryURL,omitempty"`                // 自定义ACME目录URL，比如服务商的测试环境目录，为空表示使用服务商的正式目录
+	IncludeWildcardApex bool     `protobuf:"varint,14,opt,name=includeWildcardApex,proto3" json:"includeWildcardApex,omitempty"` // 是否为通配符域名自动补充主域名，比如 *.example.com 补充 example.com
+	CsrData             []byte   `protobuf:"bytes,15,opt,name=csrData,proto3" json:"csrData,omitempty"`                          // 用户提供的证书签名请求（CSR），PEM或DER格式，设置后使用CSR中的公钥签发证书，系统不保存私钥
+	Async               bool     `protobuf:"varint,100,opt,name=async,proto3" json:"async,omitempty"`
 }
 
 func (x *CreateACMETaskRequest) Reset() {
@@ -476,6 +483,55 @@ func (x *CreateACMETaskRequest) GetAuthURL() string {
 	return ""
 }
 
+func (x *CreateACMETaskRequest) GetDnsAliasProviderId() int64 {
+	if x != nil {
+		return x.DnsAliasProviderId
+	}
+	return 0
+}
+
+func (x *CreateACMETaskRequest) GetDnsAliasDomain() string {
+	if x != nil {
+		return x.DnsAliasDomain
+	}
+	return ""
+}
+
+func (x *CreateACMETaskRequest) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *CreateACMETaskRequest) GetPreferredChain() string {
+	if x != nil {
+		return x.PreferredChain
+	}
+	return ""
+}
+
+func (x *CreateACMETaskRequest) GetDirectoryURL() string {
+	if x != nil {
+		return x.DirectoryURL
+	}
+	return ""
+}
+
+func (x *CreateACMETaskRequest) GetIncludeWildcardApex() bool {
+	if x != nil {
+		return x.IncludeWildcardApex
+	}
+	return false
+}
+
+func (x *CreateACMETaskRequest) GetCsrData() []byte {
+	if x != nil {
+		return x.CsrData
+	}
+	return nil
+}
+
 func (x *CreateACMETaskRequest) GetAsync() bool {
 	if x != nil {
 		return x.Async
@@ -536,13 +592,21 @@ type UpdateACMETaskRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AcmeTaskId    int64    `protobuf:"varint,1,opt,name=acmeTaskId,proto3" json:"acmeTaskId,omitempty"`
-	AcmeUserId    int64    `protobuf:"varint,2,opt,name=acmeUserId,proto3" json:"acmeUserId,omitempty"`
-	DnsProviderId int64    `protobuf:"varint,3,opt,name=dnsProviderId,proto3" json:"dnsProviderId,omitempty"`
-	DnsDomain     string   `protobuf:"bytes,4,opt,name=dnsDomain,proto3" json:"dnsDomain,omitempty"`
-	Domains       []string `protobuf:"bytes,5,rep,name=domains,proto3" json:"domains,omitempty"`
-	AutoRenew     bool     `protobuf:"varint,6,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"`
-	AuthURL       string   `protobuf:"bytes,7,opt,name=authURL,proto3" json:"authURL,omitempty"`
+	AcmeTaskId          int64    `protobuf:"varint,1,opt,name=acmeTaskId,proto3" json:"acmeTaskId,omitempty"`
+	AcmeUserId          int64    `protobuf:"varint,2,opt,name=acmeUserId,proto3" json:"acmeUserId,omitempty"`
+	DnsProviderId       int64    `protobuf:"varint,3,opt,name=dnsProviderId,proto3" json:"dnsProviderId,omitempty"`
+	DnsDomain           string   `protobuf:"bytes,4,opt,name=dnsDomain,proto3" json:"dnsDomain,omitempty"`
+	Domains             []string `protobuf:"bytes,5,rep,name=domains,proto3" json:"domains,omitempty"`
+	AutoRenew           bool     `protobuf:"varint,6,opt,name=autoRenew,proto3" json:"autoRenew,omitempty"`
+	AuthURL             string   `protobuf:"bytes,7,opt,name=authURL,proto3" json:"authURL,omitempty"`
+	DnsAliasProviderId  int64    `protobuf:"varint,8,opt,name=dnsAliasProviderId,proto3" json:"dnsAliasProviderId,omitempty"`    // DNS别名（CNAME委托）所在域名的服务商ID
+	DnsAliasDomain      string   `protobuf:"bytes,9,opt,name=dnsAliasDomain,proto3" json:"dnsAliasDomain,omitempty"`             // DNS别名（CNAME委托）域名
+	KeyType             string   `protobuf:"bytes,10,opt,name=keyType,proto3" json:"keyType,omitempty"`                          // 私钥类型：ec256、ec384、rsa2048、rsa3072、rsa4096，为空表示rsa2048
+	PreferredChain      string   `protobuf:"bytes,11,opt,name=preferredChain,proto3" json:"preferredChain,omitempty"`            // 首选证书链顶级证书的签发者通用名称，比如 ISRG Root X1，为空表示使用CA的默认证书链
+	DirectoryURL        string   `protobuf:"bytes,12,opt,name=directoryURL,proto3" json:"directoryURL,omitempty"`                // 自定义ACME目录URL，比如服务商的测试环境目录，为空表示使用服务商的正式目录
+	IncludeWildcardApex bool     `protobuf:"varint,13,opt,name=includeWildcardApex,proto3" json:"includeWildcardApex,omitempty"` // 是否为通配符域名自动补充主域名，比如 *.example.com 补充 example.com
+	CsrData             []byte   `protobuf:"bytes,14,opt,name=csrData,proto3" json:"csrData,omitempty"`                          // 用户提供的证书签名请求（CSR），为空表示保持不变
+	RemoveCSR           bool     `protobuf:"varint,15,opt,name=removeCSR,proto3" json:"removeCSR,omitempty"`                     // 是否清除已设置的CSR，清除后重新由系统生成私钥
 }
 
 func (x *UpdateACMETaskRequest) Reset() {
@@ -626,6 +690,62 @@ func (x *UpdateACMETaskRequest) GetAuthURL() string {
 	return ""
 }
 
+func (x *UpdateACMETaskRequest) GetDnsAliasProviderId() int64 {
+	if x != nil {
+		return x.DnsAliasProviderId
+	}
+	return 0
+}
+
+func (x *UpdateACMETaskRequest) GetDnsAliasDomain() string {
+	if x != nil {
+		return x.DnsAliasDomain
+	}
+	return ""
+}
+
+func (x *UpdateACMETaskRequest) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *UpdateACMETaskRequest) GetPreferredChain() string {
+	if x != nil {
+		return x.PreferredChain
+	}
+	return ""
+}
+
+func (x *UpdateACMETaskRequest) GetDirectoryURL() string {
+	if x != nil {
+		return x.DirectoryURL
+	}
+	return ""
+}
+
+func (x *UpdateACMETaskRequest) GetIncludeWildcardApex() bool {
+	if x != nil {
+		return x.IncludeWildcardApex
+	}
+	return false
+}
+
+func (x *UpdateACMETaskRequest) GetCsrData() []byte {
+	if x != nil {
+		return x.CsrData
+	}
+	return nil
+}
+
+func (x *UpdateACMETaskRequest) GetRemoveCSR() bool {
+	if x != nil {
+		return x.RemoveCSR
+	}
+	return false
+}
+
 // 删除任务
 type DeleteACMETaskRequest struct {
 	state         protoimpl.MessageState
@@ -785,6 +905,292 @@ func (x *RunACMETaskResponse) GetSslCertId() int64 {
 	return 0
 }
 
+// 在CA测试环境中试运行任务
+type DryRunACMETaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AcmeTaskId int64 `protobuf:"varint,1,opt,name=acmeTaskId,proto3" json:"acmeTaskId,omitempty"`
+}
+
+func (x *DryRunACMETaskRequest) Reset() {
+	*x = DryRunACMETaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_acme_task_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DryRunACMETaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunACMETaskRequest) ProtoMessage() {}
+
+func (x *DryRunACMETaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_acme_task_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunACMETaskRequest.ProtoReflect.Descriptor instead.
+func (*DryRunACMETaskRequest) Descriptor() ([]byte, []int) {
+	return file_service_acme_task_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DryRunACMETaskRequest) GetAcmeTaskId() int64 {
+	if x != nil {
+		return x.AcmeTaskId
+	}
+	return 0
+}
+
+type DryRunACMETaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsOk          bool                           `protobuf:"varint,1,opt,name=isOk,proto3" json:"isOk,omitempty"`                   // 是否成功
+	Error         string                         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                  // 失败原因
+	DirectoryURL  string                         `protobuf:"bytes,3,opt,name=directoryURL,proto3" json:"directoryURL,omitempty"`    // 使用的测试目录
+	Steps         []*DryRunACMETaskResponse_Step `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`                  // 执行的步骤
+	CertDNSNames  []string                       `protobuf:"bytes,5,rep,name=certDNSNames,proto3" json:"certDNSNames,omitempty"`    // 测试证书包含的域名
+	CertIssuer    string                         `protobuf:"bytes,6,opt,name=certIssuer,proto3" json:"certIssuer,omitempty"`        // 测试证书签发者
+	CertExpiresAt int64                          `protobuf:"varint,7,opt,name=certExpiresAt,proto3" json:"certExpiresAt,omitempty"` // 测试证书过期时间
+}
+
+func (x *DryRunACMETaskResponse) Reset() {
+	*x = DryRunACMETaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_acme_task_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DryRunACMETaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunACMETaskResponse) ProtoMessage() {}
+
+func (x *DryRunACMETaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_acme_task_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunACMETaskResponse.ProtoReflect.Descriptor instead.
+func (*DryRunACMETaskResponse) Descriptor() ([]byte, []int) {
+	return file_service_acme_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DryRunACMETaskResponse) GetIsOk() bool {
+	if x != nil {
+		return x.IsOk
+	}
+	return false
+}
+
+func (x *DryRunACMETaskResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DryRunACMETaskResponse) GetDirectoryURL() string {
+	if x != nil {
+		return x.DirectoryURL
+	}
+	return ""
+}
+
+func (x *DryRunACMETaskResponse) GetSteps() []*DryRunACMETaskResponse_Step {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *DryRunACMETaskResponse) GetCertDNSNames() []string {
+	if x != nil {
+		return x.CertDNSNames
+	}
+	return nil
+}
+
+func (x *DryRunACMETaskResponse) GetCertIssuer() string {
+	if x != nil {
+		return x.CertIssuer
+	}
+	return ""
+}
+
+func (x *DryRunACMETaskResponse) GetCertExpiresAt() int64 {
+	if x != nil {
+		return x.CertExpiresAt
+	}
+	return 0
+}
+
+// 监听任务执行进度
+type WatchACMETaskProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AcmeTaskId int64 `protobuf:"varint,1,opt,name=acmeTaskId,proto3" json:"acmeTaskId,omitempty"`
+}
+
+func (x *WatchACMETaskProgressRequest) Reset() {
+	*x = WatchACMETaskProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_acme_task_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchACMETaskProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchACMETaskProgressRequest) ProtoMessage() {}
+
+func (x *WatchACMETaskProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_acme_task_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchACMETaskProgressRequest.ProtoReflect.Descriptor instead.
+func (*WatchACMETaskProgressRequest) Descriptor() ([]byte, []int) {
+	return file_service_acme_task_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchACMETaskProgressRequest) GetAcmeTaskId() int64 {
+	if x != nil {
+		return x.AcmeTaskId
+	}
+	return 0
+}
+
+type WatchACMETaskProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsSubscribed bool                                `protobuf:"varint,1,opt,name=isSubscribed,proto3" json:"isSubscribed,omitempty"` // 是否为订阅成功的消息
+	Step         *WatchACMETaskProgressResponse_Step `protobuf:"bytes,2,opt,name=step,proto3" json:"step,omitempty"`                  // 完成的步骤
+	IsFinished   bool                                `protobuf:"varint,3,opt,name=isFinished,proto3" json:"isFinished,omitempty"`     // 任务是否已结束
+	IsOk         bool                                `protobuf:"varint,4,opt,name=isOk,proto3" json:"isOk,omitempty"`                 // 任务结束时是否成功
+	Error        string                              `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                // 任务结束时的错误信息
+	SslCertId    int64                               `protobuf:"varint,6,opt,name=sslCertId,proto3" json:"sslCertId,omitempty"`       // 任务成功时生成的证书ID
+	CreatedAt    int64                               `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`       // 事件时间
+}
+
+func (x *WatchACMETaskProgressResponse) Reset() {
+	*x = WatchACMETaskProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_acme_task_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchACMETaskProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchACMETaskProgressResponse) ProtoMessage() {}
+
+func (x *WatchACMETaskProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_acme_task_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchACMETaskProgressResponse.ProtoReflect.Descriptor instead.
+func (*WatchACMETaskProgressResponse) Descriptor() ([]byte, []int) {
+	return file_service_acme_task_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchACMETaskProgressResponse) GetIsSubscribed() bool {
+	if x != nil {
+		return x.IsSubscribed
+	}
+	return false
+}
+
+func (x *WatchACMETaskProgressResponse) GetStep() *WatchACMETaskProgressResponse_Step {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *WatchACMETaskProgressResponse) GetIsFinished() bool {
+	if x != nil {
+		return x.IsFinished
+	}
+	return false
+}
+
+func (x *WatchACMETaskProgressResponse) GetIsOk() bool {
+	if x != nil {
+		return x.IsOk
+	}
+	return false
+}
+
+func (x *WatchACMETaskProgressResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WatchACMETaskProgressResponse) GetSslCertId() int64 {
+	if x != nil {
+		return x.SslCertId
+	}
+	return 0
+}
+
+func (x *WatchACMETaskProgressResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
 // 查找单个任务信息
 type FindEnabledACMETaskRequest struct {
 	state         protoimpl.MessageState
@@ -797,7 +1203,7 @@ type FindEnabledACMETaskRequest struct {
 func (x *FindEnabledACMETaskRequest) Reset() {
 	*x = FindEnabledACMETaskRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_task_proto_msgTypes[11]
+		mi := &file_service_acme_task_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -810,7 +1216,7 @@ func (x *FindEnabledACMETaskRequest) String() string {
 func (*FindEnabledACMETaskRequest) ProtoMessage() {}
 
 func (x *FindEnabledACMETaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_task_proto_msgTypes[11]
+	mi := &file_service_acme_task_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +1229,7 @@ func (x *FindEnabledACMETaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledACMETaskRequest.ProtoReflect.Descriptor instead.
 func (*FindEnabledACMETaskRequest) Descriptor() ([]byte, []int) {
-	return file_service_acme_task_proto_rawDescGZIP(), []int{11}
+	return file_service_acme_task_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *FindEnabledACMETaskRequest) GetAcmeTaskId() int64 {
@@ -844,7 +1250,7 @@ type FindEnabledACMETaskResponse struct {
 func (x *FindEnabledACMETaskResponse) Reset() {
 	*x = FindEnabledACMETaskResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_task_proto_msgTypes[12]
+		mi := &file_service_acme_task_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -857,7 +1263,7 @@ func (x *FindEnabledACMETaskResponse) String() string {
 func (*FindEnabledACMETaskResponse) ProtoMessage() {}
 
 func (x *FindEnabledACMETaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_task_proto_msgTypes[12]
+	mi := &file_service_acme_task_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,7 +1276,7 @@ func (x *FindEnabledACMETaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledACMETaskResponse.ProtoReflect.Descriptor instead.
 func (*FindEnabledACMETaskResponse) Descriptor() ([]byte, []int) {
-	return file_service_acme_task_proto_rawDescGZIP(), []int{12}
+	return file_service_acme_task_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *FindEnabledACMETaskResponse) GetAcmeTask() *ACMETask {
@@ -892,7 +1298,7 @@ type FindACMETaskUserRequest struct {
 func (x *FindACMETaskUserRequest) Reset() {
 	*x = FindACMETaskUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_task_proto_msgTypes[13]
+		mi := &file_service_acme_task_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -905,7 +1311,7 @@ func (x *FindACMETaskUserRequest) String() string {
 func (*FindACMETaskUserRequest) ProtoMessage() {}
 
 func (x *FindACMETaskUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_task_proto_msgTypes[13]
+	mi := &file_service_acme_task_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,7 +1324,7 @@ func (x *FindACMETaskUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindACMETaskUserRequest.ProtoReflect.Descriptor instead.
 func (*FindACMETaskUserRequest) Descriptor() ([]byte, []int) {
-	return file_service_acme_task_proto_rawDescGZIP(), []int{13}
+	return file_service_acme_task_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *FindACMETaskUserRequest) GetAcmeTaskId() int64 {
@@ -939,7 +1345,7 @@ type FindACMETaskUserResponse struct {
 func (x *FindACMETaskUserResponse) Reset() {
 	*x = FindACMETaskUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_task_proto_msgTypes[14]
+		mi := &file_service_acme_task_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -952,7 +1358,7 @@ func (x *FindACMETaskUserResponse) String() string {
 func (*FindACMETaskUserResponse) ProtoMessage() {}
 
 func (x *FindACMETaskUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_task_proto_msgTypes[14]
+	mi := &file_service_acme_task_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1371,7 @@ func (x *FindACMETaskUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindACMETaskUserResponse.ProtoReflect.Descriptor instead.
 func (*FindACMETaskUserResponse) Descriptor() ([]byte, []int) {
-	return file_service_acme_task_proto_rawDescGZIP(), []int{14}
+	return file_service_acme_task_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *FindACMETaskUserResponse) GetUser() *User {
@@ -975,6 +1381,148 @@ func (x *FindACMETaskUserResponse) GetUser() *User {
 	return nil
 }
 
+type DryRunACMETaskResponse_Step struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`       // 步骤名称
+	IsOk    bool   `protobuf:"varint,2,opt,name=isOk,proto3" json:"isOk,omitempty"`      // 是否成功
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // 详细信息或错误信息
+	CostMs  int64  `protobuf:"varint,4,opt,name=costMs,proto3" json:"costMs,omitempty"`  // 耗时（毫秒）
+}
+
+func (x *DryRunACMETaskResponse_Step) Reset() {
+	*x = DryRunACMETaskResponse_Step{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_acme_task_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DryRunACMETaskResponse_Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunACMETaskResponse_Step) ProtoMessage() {}
+
+func (x *DryRunACMETaskResponse_Step) ProtoReflect() protoreflect.Message {
+	mi := &file_service_acme_task_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunACMETaskResponse_Step.ProtoReflect.Descriptor instead.
+func (*DryRunACMETaskResponse_Step) Descriptor() ([]byte, []int) {
+	return file_service_acme_task_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *DryRunACMETaskResponse_Step) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DryRunACMETaskResponse_Step) GetIsOk() bool {
+	if x != nil {
+		return x.IsOk
+	}
+	return false
+}
+
+func (x *DryRunACMETaskResponse_Step) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DryRunACMETaskResponse_Step) GetCostMs() int64 {
+	if x != nil {
+		return x.CostMs
+	}
+	return 0
+}
+
+type WatchACMETaskProgressResponse_Step struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`       // 步骤名称
+	IsOk    bool   `protobuf:"varint,2,opt,name=isOk,proto3" json:"isOk,omitempty"`      // 是否成功
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // 详细信息或错误信息
+	CostMs  int64  `protobuf:"varint,4,opt,name=costMs,proto3" json:"costMs,omitempty"`  // 耗时（毫秒）
+}
+
+func (x *WatchACMETaskProgressResponse_Step) Reset() {
+	*x = WatchACMETaskProgressResponse_Step{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_acme_task_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchACMETaskProgressResponse_Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchACMETaskProgressResponse_Step) ProtoMessage() {}
+
+func (x *WatchACMETaskProgressResponse_Step) ProtoReflect() protoreflect.Message {
+	mi := &file_service_acme_task_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchACMETaskProgressResponse_Step.ProtoReflect.Descriptor instead.
+func (*WatchACMETaskProgressResponse_Step) Descriptor() ([]byte, []int) {
+	return file_service_acme_task_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *WatchACMETaskProgressResponse_Step) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchACMETaskProgressResponse_Step) GetIsOk() bool {
+	if x != nil {
+		return x.IsOk
+	}
+	return false
+}
+
+func (x *WatchACMETaskProgressResponse_Step) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WatchACMETaskProgressResponse_Step) GetCostMs() int64 {
+	if x != nil {
+		return x.CostMs
+	}
+	return 0
+}
+
 var File_service_acme_task_proto protoreflect.FileDescriptor
 
 var file_service_acme_task_proto_rawDesc = []byte{
@@ -1032,7 +1580,7 @@ var file_service_acme_task_proto_rawDesc = []byte{
 	0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x2a, 0x0a, 0x09, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x09, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x97, 0x02, 0x0a, 0x15,
+	0x52, 0x09, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0xa1, 0x04, 0x0a, 0x15,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
 	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a,
@@ -1049,109 +1597,208 @@ var file_service_acme_task_proto_rawDesc = []byte{
 	0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74,
 	0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c,
 	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x61, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x38, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22,
-	0xed, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d,
+	0x2e, 0x0a, 0x12, 0x64, 0x6e, 0x73, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x64, 0x6e, 0x73,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x0e, 0x64, 0x6e, 0x73, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x6e, 0x73, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x30, 0x0a,
+	0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x57, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64,
+	0x41, 0x70, 0x65, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x57, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x41, 0x70, 0x65, 0x78, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x73, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x63, 0x73, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x79,
+	0x6e, 0x63, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x22,
+	0x38, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d,
 	0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61,
-	0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61,
-	0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6e, 0x73,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x64, 0x6e, 0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52,
-	0x65, 0x6e, 0x65, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f,
-	0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c, 0x22,
-	0x37, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73,
+	0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x95, 0x04, 0x0a, 0x15, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6e, 0x73, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6e, 0x73,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x6e,
+	0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c, 0x12, 0x2e, 0x0a, 0x12, 0x64, 0x6e, 0x73,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x64, 0x6e, 0x73, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x6e, 0x73,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x64, 0x6e, 0x73, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x55, 0x52, 0x4c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x30, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x57, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x41, 0x70, 0x65, 0x78, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x57, 0x69, 0x6c,
+	0x64, 0x63, 0x61, 0x72, 0x64, 0x41, 0x70, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x73, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x73, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x53, 0x52,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x53,
+	0x52, 0x22, 0x37, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63,
+	0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x12, 0x52, 0x75,
+	0x6e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x22, 0x5d, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x22,
+	0x37, 0x0a, 0x15, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73,
 	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65,
 	0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63,
-	0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x41,
-	0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x5d,
-	0x0a, 0x13, 0x52, 0x75, 0x6e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x22, 0x3c, 0x0a,
-	0x1a, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
-	0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x1b, 0x46,
-	0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x63,
-	0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x62, 0x2e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x61, 0x63, 0x6d, 0x65,
-	0x54, 0x61, 0x73, 0x6b, 0x22, 0x39, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45,
-	0x54, 0x61, 0x73, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22,
-	0x38, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x32, 0xd3, 0x06, 0x0a, 0x0f, 0x41, 0x43,
-	0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x71, 0x0a,
-	0x26, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x43, 0x4d,
-	0x45, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x71, 0x0a, 0x26, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x4e, 0x53,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x18, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12,
-	0x23, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61,
+	0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0xe9, 0x02, 0x0a, 0x16, 0x44, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a,
+	0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52,
+	0x4c, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x41, 0x43, 0x4d, 0x45,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x65,
+	0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74,
+	0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x65, 0x72, 0x74, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x65, 0x72, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x65, 0x72, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x1a, 0x60, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73,
+	0x4f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x73, 0x74, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f,
+	0x73, 0x74, 0x4d, 0x73, 0x22, 0x3e, 0x0a, 0x1c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x43, 0x4d,
+	0x45, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x49, 0x64, 0x22, 0xe7, 0x02, 0x0a, 0x1d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x43,
+	0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x04, 0x73, 0x74,
+	0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x65, 0x70,
+	0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x60, 0x0a, 0x04,
+	0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x73, 0x22, 0x3c,
+	0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d,
+	0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x1b,
+	0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x61,
+	0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x61, 0x63, 0x6d,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x22, 0x39, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d,
+	0x45, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x22, 0x38, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x32, 0xfc, 0x07, 0x0a, 0x0f, 0x41,
+	0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x71,
+	0x0a, 0x26, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x43,
+	0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d,
+	0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x71, 0x0a, 0x26, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x4e,
+	0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d,
+	0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x18, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61,
 	0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x6c, 0x69,
-	0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73,
-	0x6b, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x43,
-	0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
-	0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b,
-	0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62,
-	0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x0e, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x19, 0x2e,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50,
-	0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x41,
-	0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e,
-	0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x66, 0x69, 0x6e, 0x64,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x12,
-	0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4d, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43,
-	0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54,
-	0x61, 0x73, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d,
+	0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x0e,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x19,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x75, 0x6e,
+	0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75,
+	0x6e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x19, 0x2e, 0x70, 0x62,
+	0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5e, 0x0a, 0x15, 0x77, 0x61, 0x74, 0x63, 0x68, 0x41, 0x43, 0x4d, 0x45, 0x54,
+	0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x56, 0x0a, 0x13, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x66, 0x69,
+	0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1166,7 +1813,7 @@ func file_service_acme_task_proto_rawDescGZIP() []byte {
 	return file_service_acme_task_proto_rawDescData
 }
 
-var file_service_acme_task_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_service_acme_task_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_service_acme_task_proto_goTypes = []interface{}{
 	(*CountAllEnabledACMETasksWithACMEUserIdRequest)(nil), // 0: pb.CountAllEnabledACMETasksWithACMEUserIdRequest
 	(*CountEnabledACMETasksWithDNSProviderIdRequest)(nil), // 1: pb.CountEnabledACMETasksWithDNSProviderIdRequest
@@ -1179,44 +1826,56 @@ var file_service_acme_task_proto_goTypes = []interface{}{
 	(*DeleteACMETaskRequest)(nil),                         // 8: pb.DeleteACMETaskRequest
 	(*RunACMETaskRequest)(nil),                            // 9: pb.RunACMETaskRequest
 	(*RunACMETaskResponse)(nil),                           // 10: pb.RunACMETaskResponse
-	(*FindEnabledACMETaskRequest)(nil),                    // 11: pb.FindEnabledACMETaskRequest
-	(*FindEnabledACMETaskResponse)(nil),                   // 12: pb.FindEnabledACMETaskResponse
-	(*FindACMETaskUserRequest)(nil),                       // 13: pb.FindACMETaskUserRequest
-	(*FindACMETaskUserResponse)(nil),                      // 14: pb.FindACMETaskUserResponse
-	(*ACMETask)(nil),                                      // 15: pb.ACMETask
-	(*User)(nil),                                          // 16: pb.User
-	(*RPCCountResponse)(nil),                              // 17: pb.RPCCountResponse
-	(*RPCSuccess)(nil),                                    // 18: pb.RPCSuccess
+	(*DryRunACMETaskRequest)(nil),                         // 11: pb.DryRunACMETaskRequest
+	(*DryRunACMETaskResponse)(nil),                        // 12: pb.DryRunACMETaskResponse
+	(*WatchACMETaskProgressRequest)(nil),                  // 13: pb.WatchACMETaskProgressRequest
+	(*WatchACMETaskProgressResponse)(nil),                 // 14: pb.WatchACMETaskProgressResponse
+	(*FindEnabledACMETaskRequest)(nil),                    // 15: pb.FindEnabledACMETaskRequest
+	(*FindEnabledACMETaskResponse)(nil),                   // 16: pb.FindEnabledACMETaskResponse
+	(*FindACMETaskUserRequest)(nil),                       // 17: pb.FindACMETaskUserRequest
+	(*FindACMETaskUserResponse)(nil),                      // 18: pb.FindACMETaskUserResponse
+	(*DryRunACMETaskResponse_Step)(nil),                   // 19: pb.DryRunACMETaskResponse.Step
+	(*WatchACMETaskProgressResponse_Step)(nil),            // 20: pb.WatchACMETaskProgressResponse.Step
+	(*ACMETask)(nil),                                      // 21: pb.ACMETask
+	(*User)(nil),                                          // 22: pb.User
+	(*RPCCountResponse)(nil),                              // 23: pb.RPCCountResponse
+	(*RPCSuccess)(nil),                                    // 24: pb.RPCSuccess
 }
 var file_service_acme_task_proto_depIdxs = []int32{
-	15, // 0: pb.ListEnabledACMETasksResponse.acmeTasks:type_name -> pb.ACMETask
-	15, // 1: pb.FindEnabledACMETaskResponse.acmeTask:type_name -> pb.ACMETask
-	16, // 2: pb.FindACMETaskUserResponse.user:type_name -> pb.User
-	0,  // 3: pb.ACMETaskService.countAllEnabledACMETasksWithACMEUserId:input_type -> pb.CountAllEnabledACMETasksWithACMEUserIdRequest
-	1,  // 4: pb.ACMETaskService.countEnabledACMETasksWithDNSProviderId:input_type -> pb.CountEnabledACMETasksWithDNSProviderIdRequest
-	2,  // 5: pb.ACMETaskService.countAllEnabledACMETasks:input_type -> pb.CountAllEnabledACMETasksRequest
-	3,  // 6: pb.ACMETaskService.listEnabledACMETasks:input_type -> pb.ListEnabledACMETasksRequest
-	5,  // 7: pb.ACMETaskService.createACMETask:input_type -> pb.CreateACMETaskRequest
-	7,  // 8: pb.ACMETaskService.updateACMETask:input_type -> pb.UpdateACMETaskRequest
-	8,  // 9: pb.ACMETaskService.deleteACMETask:input_type -> pb.DeleteACMETaskRequest
-	9,  // 10: pb.ACMETaskService.runACMETask:input_type -> pb.RunACMETaskRequest
-	11, // 11: pb.ACMETaskService.findEnabledACMETask:input_type -> pb.FindEnabledACMETaskRequest
-	13, // 12: pb.ACMETaskService.findACMETaskUser:input_type -> pb.FindACMETaskUserRequest
-	17, // 13: pb.ACMETaskService.countAllEnabledACMETasksWithACMEUserId:output_type -> pb.RPCCountResponse
-	17, // 14: pb.ACMETaskService.countEnabledACMETasksWithDNSProviderId:output_type -> pb.RPCCountResponse
-	17, // 15: pb.ACMETaskService.countAllEnabledACMETasks:output_type -> pb.RPCCountResponse
-	4,  // 16: pb.ACMETaskService.listEnabledACMETasks:output_type -> pb.ListEnabledACMETasksResponse
-	6,  // 17: pb.ACMETaskService.createACMETask:output_type -> pb.CreateACMETaskResponse
-	18, // 18: pb.ACMETaskService.updateACMETask:output_type -> pb.RPCSuccess
-	18, // 19: pb.ACMETaskService.deleteACMETask:output_type -> pb.RPCSuccess
-	10, // 20: pb.ACMETaskService.runACMETask:output_type -> pb.RunACMETaskResponse
-	12, // 21: pb.ACMETaskService.findEnabledACMETask:output_type -> pb.FindEnabledACMETaskResponse
-	14, // 22: pb.ACMETaskService.findACMETaskUser:output_type -> pb.FindACMETaskUserResponse
-	13, // [13:23] is the sub-list for method output_type
-	3,  // [3:13] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	21, // 0: pb.ListEnabledACMETasksResponse.acmeTasks:type_name -> pb.ACMETask
+	19, // 1: pb.DryRunACMETaskResponse.steps:type_name -> pb.DryRunACMETaskResponse.Step
+	20, // 2: pb.WatchACMETaskProgressResponse.step:type_name -> pb.WatchACMETaskProgressResponse.Step
+	21, // 3: pb.FindEnabledACMETaskResponse.acmeTask:type_name -> pb.ACMETask
+	22, // 4: pb.FindACMETaskUserResponse.user:type_name -> pb.User
+	0,  // 5: pb.ACMETaskService.countAllEnabledACMETasksWithACMEUserId:input_type -> pb.CountAllEnabledACMETasksWithACMEUserIdRequest
+	1,  // 6: pb.ACMETaskService.countEnabledACMETasksWithDNSProviderId:input_type -> pb.CountEnabledACMETasksWithDNSProviderIdRequest
+	2,  // 7: pb.ACMETaskService.countAllEnabledACMETasks:input_type -> pb.CountAllEnabledACMETasksRequest
+	3,  // 8: pb.ACMETaskService.listEnabledACMETasks:input_type -> pb.ListEnabledACMETasksRequest
+	5,  // 9: pb.ACMETaskService.createACMETask:input_type -> pb.CreateACMETaskRequest
+	7,  // 10: pb.ACMETaskService.updateACMETask:input_type -> pb.UpdateACMETaskRequest
+	8,  // 11: pb.ACMETaskService.deleteACMETask:input_type -> pb.DeleteACMETaskRequest
+	9,  // 12: pb.ACMETaskService.runACMETask:input_type -> pb.RunACMETaskRequest
+	11, // 13: pb.ACMETaskService.dryRunACMETask:input_type -> pb.DryRunACMETaskRequest
+	13, // 14: pb.ACMETaskService.watchACMETaskProgress:input_type -> pb.WatchACMETaskProgressRequest
+	15, // 15: pb.ACMETaskService.findEnabledACMETask:input_type -> pb.FindEnabledACMETaskRequest
+	17, // 16: pb.ACMETaskService.findACMETaskUser:input_type -> pb.FindACMETaskUserRequest
+	23, // 17: pb.ACMETaskService.countAllEnabledACMETasksWithACMEUserId:output_type -> pb.RPCCountResponse
+	23, // 18: pb.ACMETaskService.countEnabledACMETasksWithDNSProviderId:output_type -> pb.RPCCountResponse
+	23, // 19: pb.ACMETaskService.countAllEnabledACMETasks:output_type -> pb.RPCCountResponse
+	4,  // 20: pb.ACMETaskService.listEnabledACMETasks:output_type -> pb.ListEnabledACMETasksResponse
+	6,  // 21: pb.ACMETaskService.createACMETask:output_type -> pb.CreateACMETaskResponse
+	24, // 22: pb.ACMETaskService.updateACMETask:output_type -> pb.RPCSuccess
+	24, // 23: pb.ACMETaskService.deleteACMETask:output_type -> pb.RPCSuccess
+	10, // 24: pb.ACMETaskService.runACMETask:output_type -> pb.RunACMETaskResponse
+	12, // 25: pb.ACMETaskService.dryRunACMETask:output_type -> pb.DryRunACMETaskResponse
+	14, // 26: pb.ACMETaskService.watchACMETaskProgress:output_type -> pb.WatchACMETaskProgressResponse
+	16, // 27: pb.ACMETaskService.findEnabledACMETask:output_type -> pb.FindEnabledACMETaskResponse
+	18, // 28: pb.ACMETaskService.findACMETaskUser:output_type -> pb.FindACMETaskUserResponse
+	17, // [17:29] is the sub-list for method output_type
+	5,  // [5:17] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_service_acme_task_proto_init() }
@@ -1361,7 +2020,7 @@ func file_service_acme_task_proto_init() {
 			}
 		}
 		file_service_acme_task_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEnabledACMETaskRequest); i {
+			switch v := v.(*DryRunACMETaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1373,7 +2032,7 @@ func file_service_acme_task_proto_init() {
 			}
 		}
 		file_service_acme_task_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEnabledACMETaskResponse); i {
+			switch v := v.(*DryRunACMETaskResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1385,7 +2044,7 @@ func file_service_acme_task_proto_init() {
 			}
 		}
 		file_service_acme_task_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindACMETaskUserRequest); i {
+			switch v := v.(*WatchACMETaskProgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1397,6 +2056,54 @@ func file_service_acme_task_proto_init() {
 			}
 		}
 		file_service_acme_task_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchACMETaskProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_acme_task_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEnabledACMETaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_acme_task_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEnabledACMETaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_acme_task_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindACMETaskUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_acme_task_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindACMETaskUserResponse); i {
 			case 0:
 				return &v.state
@@ -1408,6 +2115,30 @@ func file_service_acme_task_proto_init() {
 				return nil
 			}
 		}
+		file_service_acme_task_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DryRunACMETaskResponse_Step); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_acme_task_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchACMETaskProgressResponse_Step); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1415,7 +2146,7 @@ func file_service_acme_task_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_acme_task_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -27,6 +27,8 @@ const (
 	ACMETaskService_UpdateACMETask_FullMethodName                         = "/pb.ACMETaskService/updateACMETask"
 	ACMETaskService_DeleteACMETask_FullMethodName                         = "/pb.ACMETaskService/deleteACMETask"
 	ACMETaskService_RunACMETask_FullMethodName                            = "/pb.ACMETaskService/runACMETask"
+	ACMETaskService_DryRunACMETask_FullMethodName                         = "/pb.ACMETaskService/dryRunACMETask"
+	ACMETaskService_WatchACMETaskProgress_FullMethodName                  = "/pb.ACMETaskService/watchACMETaskProgress"
 	ACMETaskService_FindEnabledACMETask_FullMethodName                    = "/pb.ACMETaskService/findEnabledACMETask"
 	ACMETaskService_FindACMETaskUser_FullMethodName                       = "/pb.ACMETaskService/findACMETaskUser"
 )
@@ -51,6 +53,11 @@ type ACMETaskServiceClient interface {
 	DeleteACMETask(ctx context.Context, in *DeleteACMETaskRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 	// 运行某个任务
 	RunACMETask(ctx context.Context, in *RunACMETaskRequest, opts ...grpc.CallOption) (*RunACMETaskResponse, error)
+	// 在CA测试环境中试运行任务，不保存证书，返回诊断报告
+	DryRunACMETask(ctx context.Context, in *DryRunACMETaskRequest, opts ...grpc.CallOption) (*DryRunACMETaskResponse, error)
+	// 监听任务执行进度
+	// 连接建立后首先返回一个 isSubscribed 为 true 的消息，之后再执行任务可以保证不会丢失进度；任务结束后连接关闭
+	WatchACMETaskProgress(ctx context.Context, in *WatchACMETaskProgressRequest, opts ...grpc.CallOption) (ACMETaskService_WatchACMETaskProgressClient, error)
 	// 查找单个任务信息
 	FindEnabledACMETask(ctx context.Context, in *FindEnabledACMETaskRequest, opts ...grpc.CallOption) (*FindEnabledACMETaskResponse, error)
 	// 查找任务所属用户
@@ -137,6 +144,47 @@ func (c *aCMETaskServiceClient) RunACMETask(ctx context.Context, in *RunACMETask
 	return out, nil
 }
 
+func (c *aCMETaskServiceClient) DryRunACMETask(ctx context.Context, in *DryRunACMETaskRequest, opts ...grpc.CallOption) (*DryRunACMETaskResponse, error) {
+	out := new(DryRunACMETaskResponse)
+	err := c.cc.Invoke(ctx, ACMETaskService_DryRunACMETask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCMETaskServiceClient) WatchACMETaskProgress(ctx context.Context, in *WatchACMETaskProgressRequest, opts ...grpc.CallOption) (ACMETaskService_WatchACMETaskProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ACMETaskService_ServiceDesc.Streams[0], ACMETaskService_WatchACMETaskProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aCMETaskServiceWatchACMETaskProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ACMETaskService_WatchACMETaskProgressClient interface {
+	Recv() (*WatchACMETaskProgressResponse, error)
+	grpc.ClientStream
+}
+
+type aCMETaskServiceWatchACMETaskProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *aCMETaskServiceWatchACMETaskProgressClient) Recv() (*WatchACMETaskProgressResponse, error) {
+	m := new(WatchACMETaskProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aCMETaskServiceClient) FindEnabledACMETask(ctx context.Context, in *FindEnabledACMETaskRequest, opts ...grpc.CallOption) (*FindEnabledACMETaskResponse, error) {
 	out := new(FindEnabledACMETaskResponse)
 	err := c.cc.Invoke(ctx, ACMETaskService_FindEnabledACMETask_FullMethodName, in, out, opts...)
@@ -175,6 +223,11 @@ type ACMETaskServiceServer interface {
 	DeleteACMETask(context.Context, *DeleteACMETaskRequest) (*RPCSuccess, error)
 	// 运行某个任务
 	RunACMETask(context.Context, *RunACMETaskRequest) (*RunACMETaskResponse, error)
+	// 在CA测试环境中试运行任务，不保存证书，返回诊断报告
+	DryRunACMETask(context.Context, *DryRunACMETaskRequest) (*DryRunACMETaskResponse, error)
+	// 监听任务执行进度
+	// 连接建立后首先返回一个 isSubscribed 为 true 的消息，之后再执行任务可以保证不会丢失进度；任务结束后连接关闭
+	WatchACMETaskProgress(*WatchACMETaskProgressRequest, ACMETaskService_WatchACMETaskProgressServer) error
 	// 查找单个任务信息
 	FindEnabledACMETask(context.Context, *FindEnabledACMETaskRequest) (*FindEnabledACMETaskResponse, error)
 	// 查找任务所属用户
@@ -209,6 +262,12 @@ func (UnimplementedACMETaskServiceServer) DeleteACMETask(context.Context, *Delet
 func (UnimplementedACMETaskServiceServer) RunACMETask(context.Context, *RunACMETaskRequest) (*RunACMETaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunACMETask not implemented")
 }
+func (UnimplementedACMETaskServiceServer) DryRunACMETask(context.Context, *DryRunACMETaskRequest) (*DryRunACMETaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunACMETask not implemented")
+}
+func (UnimplementedACMETaskServiceServer) WatchACMETaskProgress(*WatchACMETaskProgressRequest, ACMETaskService_WatchACMETaskProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchACMETaskProgress not implemented")
+}
 func (UnimplementedACMETaskServiceServer) FindEnabledACMETask(context.Context, *FindEnabledACMETaskRequest) (*FindEnabledACMETaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindEnabledACMETask not implemented")
 }
@@ -371,6 +430,45 @@ func _ACMETaskService_RunACMETask_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ACMETaskService_DryRunACMETask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunACMETaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACMETaskServiceServer).DryRunACMETask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ACMETaskService_DryRunACMETask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACMETaskServiceServer).DryRunACMETask(ctx, req.(*DryRunACMETaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACMETaskService_WatchACMETaskProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchACMETaskProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ACMETaskServiceServer).WatchACMETaskProgress(m, &aCMETaskServiceWatchACMETaskProgressServer{stream})
+}
+
+type ACMETaskService_WatchACMETaskProgressServer interface {
+	Send(*WatchACMETaskProgressResponse) error
+	grpc.ServerStream
+}
+
+type aCMETaskServiceWatchACMETaskProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *aCMETaskServiceWatchACMETaskProgressServer) Send(m *WatchACMETaskProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ACMETaskService_FindEnabledACMETask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindEnabledACMETaskRequest)
 	if err := dec(in); err != nil {
@@ -446,6 +544,10 @@ var ACMETaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "runACMETask",
 			Handler:    _ACMETaskService_RunACMETask_Handler,
 		},
+		{
+			MethodName: "dryRunACMETask",
+			Handler:    _ACMETaskService_DryRunACMETask_Handler,
+		},
 		{
 			MethodName: "findEnabledACMETask",
 			Handler:    _ACMETaskService_FindEnabledACMETask_Handler,
@@ -455,6 +557,12 @@ var ACMETaskService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ACMETaskService_FindACMETaskUser_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "watchACMETaskProgress",
+			Handler:       _ACMETaskService_WatchACMETaskProgress_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "service_acme_task.proto",
 }
@@ -147,6 +147,149 @@ func (x *CreateACMEUserResponse) GetAcmeUserId() int64 {
 	return 0
 }
 
+// 导入已有的用户
+type ImportACMEUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId                int64  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`                               // 所属用户，只有管理员才有权限指定
+	Email                 string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                                  // 用户邮箱
+	Description           string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                      // 用户描述
+	AcmeProviderCode      string `protobuf:"bytes,4,opt,name=acmeProviderCode,proto3" json:"acmeProviderCode,omitempty"`            // 服务商代号
+	AcmeProviderAccountId int64  `protobuf:"varint,5,opt,name=acmeProviderAccountId,proto3" json:"acmeProviderAccountId,omitempty"` // 服务商帐号
+	PrivateKeyData        []byte `protobuf:"bytes,6,opt,name=privateKeyData,proto3" json:"privateKeyData,omitempty"`                // 账号私钥（PEM格式），比如certbot的private_key.json需要先转换为PEM格式
+	AccountURL            string `protobuf:"bytes,7,opt,name=accountURL,proto3" json:"accountURL,omitempty"`                        // 账号URL（可选），比如 https://acme-v02.api.letsencrypt.org/acme/acct/123456
+}
+
+func (x *ImportACMEUserRequest) Reset() {
+	*x = ImportACMEUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_acme_user_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportACMEUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportACMEUserRequest) ProtoMessage() {}
+
+func (x *ImportACMEUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_acme_user_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportACMEUserRequest.ProtoReflect.Descriptor instead.
+func (*ImportACMEUserRequest) Descriptor() ([]byte, []int) {
+	return file_service_acme_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportACMEUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportACMEUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ImportACMEUserRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportACMEUserRequest) GetAcmeProviderCode() string {
+	if x != nil {
+		return x.AcmeProviderCode
+	}
+	return ""
+}
+
+func (x *ImportACMEUserRequest) GetAcmeProviderAccountId() int64 {
+	if x != nil {
+		return x.AcmeProviderAccountId
+	}
+	return 0
+}
+
+func (x *ImportACMEUserRequest) GetPrivateKeyData() []byte {
+	if x != nil {
+		return x.PrivateKeyData
+	}
+	return nil
+}
+
+func (x *ImportACMEUserRequest) GetAccountURL() string {
+	if x != nil {
+		return x.AccountURL
+	}
+	return ""
+}
+
+type ImportACMEUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AcmeUserId int64 `protobuf:"varint,1,opt,name=acmeUserId,proto3" json:"acmeUserId,omitempty"`
+}
+
+func (x *ImportACMEUserResponse) Reset() {
+	*x = ImportACMEUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_acme_user_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportACMEUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportACMEUserResponse) ProtoMessage() {}
+
+func (x *ImportACMEUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_acme_user_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportACMEUserResponse.ProtoReflect.Descriptor instead.
+func (*ImportACMEUserResponse) Descriptor() ([]byte, []int) {
+	return file_service_acme_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImportACMEUserResponse) GetAcmeUserId() int64 {
+	if x != nil {
+		return x.AcmeUserId
+	}
+	return 0
+}
+
 // 修改用户
 type UpdateACMEUserRequest struct {
 	state         protoimpl.MessageState
@@ -160,7 +303,7 @@ type UpdateACMEUserRequest struct {
 func (x *UpdateACMEUserRequest) Reset() {
 	*x = UpdateACMEUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_user_proto_msgTypes[2]
+		mi := &file_service_acme_user_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -173,7 +316,7 @@ func (x *UpdateACMEUserRequest) String() string {
 func (*UpdateACMEUserRequest) ProtoMessage() {}
 
 func (x *UpdateACMEUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_user_proto_msgTypes[2]
+	mi := &file_service_acme_user_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +329,7 @@ func (x *UpdateACMEUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateACMEUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateACMEUserRequest) Descriptor() ([]byte, []int) {
-	return file_service_acme_user_proto_rawDescGZIP(), []int{2}
+	return file_service_acme_user_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateACMEUserRequest) GetAcmeUserId() int64 {
@@ -215,7 +358,7 @@ type DeleteACMEUserRequest struct {
 func (x *DeleteACMEUserRequest) Reset() {
 	*x = DeleteACMEUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_user_proto_msgTypes[3]
+		mi := &file_service_acme_user_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -228,7 +371,7 @@ func (x *DeleteACMEUserRequest) String() string {
 func (*DeleteACMEUserRequest) ProtoMessage() {}
 
 func (x *DeleteACMEUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_user_proto_msgTypes[3]
+	mi := &file_service_acme_user_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +384,7 @@ func (x *DeleteACMEUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteACMEUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteACMEUserRequest) Descriptor() ([]byte, []int) {
-	return file_service_acme_user_proto_rawDescGZIP(), []int{3}
+	return file_service_acme_user_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DeleteACMEUserRequest) GetAcmeUserId() int64 {
@@ -265,7 +408,7 @@ type CountAcmeUsersRequest struct {
 func (x *CountAcmeUsersRequest) Reset() {
 	*x = CountAcmeUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_user_proto_msgTypes[4]
+		mi := &file_service_acme_user_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -278,7 +421,7 @@ func (x *CountAcmeUsersRequest) String() string {
 func (*CountAcmeUsersRequest) ProtoMessage() {}
 
 func (x *CountAcmeUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_user_proto_msgTypes[4]
+	mi := &file_service_acme_user_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +434,7 @@ func (x *CountAcmeUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountAcmeUsersRequest.ProtoReflect.Descriptor instead.
 func (*CountAcmeUsersRequest) Descriptor() ([]byte, []int) {
-	return file_service_acme_user_proto_rawDescGZIP(), []int{4}
+	return file_service_acme_user_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CountAcmeUsersRequest) GetAdminId() int64 {
@@ -330,7 +473,7 @@ type ListACMEUsersRequest struct {
 func (x *ListACMEUsersRequest) Reset() {
 	*x = ListACMEUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_user_proto_msgTypes[5]
+		mi := &file_service_acme_user_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -343,7 +486,7 @@ func (x *ListACMEUsersRequest) String() string {
 func (*ListACMEUsersRequest) ProtoMessage() {}
 
 func (x *ListACMEUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_user_proto_msgTypes[5]
+	mi := &file_service_acme_user_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +499,7 @@ func (x *ListACMEUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListACMEUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListACMEUsersRequest) Descriptor() ([]byte, []int) {
-	return file_service_acme_user_proto_rawDescGZIP(), []int{5}
+	return file_service_acme_user_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListACMEUsersRequest) GetAdminId() int64 {
@@ -398,7 +541,7 @@ type ListACMEUsersResponse struct {
 func (x *ListACMEUsersResponse) Reset() {
 	*x = ListACMEUsersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_user_proto_msgTypes[6]
+		mi := &file_service_acme_user_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -411,7 +554,7 @@ func (x *ListACMEUsersResponse) String() string {
 func (*ListACMEUsersResponse) ProtoMessage() {}
 
 func (x *ListACMEUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_user_proto_msgTypes[6]
+	mi := &file_service_acme_user_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,7 +567,7 @@ func (x *ListACMEUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListACMEUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListACMEUsersResponse) Descriptor() ([]byte, []int) {
-	return file_service_acme_user_proto_rawDescGZIP(), []int{6}
+	return file_service_acme_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListACMEUsersResponse) GetAcmeUsers() []*ACMEUser {
@@ -446,7 +589,7 @@ type FindEnabledACMEUserRequest struct {
 func (x *FindEnabledACMEUserRequest) Reset() {
 	*x = FindEnabledACMEUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_user_proto_msgTypes[7]
+		mi := &file_service_acme_user_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -459,7 +602,7 @@ func (x *FindEnabledACMEUserRequest) String() string {
 func (*FindEnabledACMEUserRequest) ProtoMessage() {}
 
 func (x *FindEnabledACMEUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_user_proto_msgTypes[7]
+	mi := &file_service_acme_user_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +615,7 @@ func (x *FindEnabledACMEUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledACMEUserRequest.ProtoReflect.Descriptor instead.
 func (*FindEnabledACMEUserRequest) Descriptor() ([]byte, []int) {
-	return file_service_acme_user_proto_rawDescGZIP(), []int{7}
+	return file_service_acme_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *FindEnabledACMEUserRequest) GetAcmeUserId() int64 {
@@ -493,7 +636,7 @@ type FindEnabledACMEUserResponse struct {
 func (x *FindEnabledACMEUserResponse) Reset() {
 	*x = FindEnabledACMEUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_user_proto_msgTypes[8]
+		mi := &file_service_acme_user_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -506,7 +649,7 @@ func (x *FindEnabledACMEUserResponse) String() string {
 func (*FindEnabledACMEUserResponse) ProtoMessage() {}
 
 func (x *FindEnabledACMEUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_user_proto_msgTypes[8]
+	mi := &file_service_acme_user_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +662,7 @@ func (x *FindEnabledACMEUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledACMEUserResponse.ProtoReflect.Descriptor instead.
 func (*FindEnabledACMEUserResponse) Descriptor() ([]byte, []int) {
-	return file_service_acme_user_proto_rawDescGZIP(), []int{8}
+	return file_service_acme_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *FindEnabledACMEUserResponse) GetAcmeUser() *ACMEUser {
@@ -543,7 +686,7 @@ type FindAllACMEUsersRequest struct {
 func (x *FindAllACMEUsersRequest) Reset() {
 	*x = FindAllACMEUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_user_proto_msgTypes[9]
+		mi := &file_service_acme_user_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -556,7 +699,7 @@ func (x *FindAllACMEUsersRequest) String() string {
 func (*FindAllACMEUsersRequest) ProtoMessage() {}
 
 func (x *FindAllACMEUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_user_proto_msgTypes[9]
+	mi := &file_service_acme_user_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +712,7 @@ func (x *FindAllACMEUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindAllACMEUsersRequest.ProtoReflect.Descriptor instead.
 func (*FindAllACMEUsersRequest) Descriptor() ([]byte, []int) {
-	return file_service_acme_user_proto_rawDescGZIP(), []int{9}
+	return file_service_acme_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *FindAllACMEUsersRequest) GetAdminId() int64 {
@@ -604,7 +747,7 @@ type FindAllACMEUsersResponse struct {
 func (x *FindAllACMEUsersResponse) Reset() {
 	*x = FindAllACMEUsersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_acme_user_proto_msgTypes[10]
+		mi := &file_service_acme_user_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -617,7 +760,7 @@ func (x *FindAllACMEUsersResponse) String() string {
 func (*FindAllACMEUsersResponse) ProtoMessage() {}
 
 func (x *FindAllACMEUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_acme_user_proto_msgTypes[10]
+	mi := &file_service_acme_user_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +773,7 @@ func (x *FindAllACMEUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindAllACMEUsersResponse.ProtoReflect.Descriptor instead.
 func (*FindAllACMEUsersResponse) Descriptor() ([]byte, []int) {
-	return file_service_acme_user_proto_rawDescGZIP(), []int{10}
+	return file_service_acme_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *FindAllACMEUsersResponse) GetAcmeUsers() []*ACMEUser {
@@ -664,90 +807,116 @@ var file_service_acme_user_proto_rawDesc = []byte{
 	0x49, 0x64, 0x22, 0x38, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45,
 	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
 	0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x15,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x7f, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x61,
-	0x63, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x61, 0x63, 0x6d, 0x65,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x22, 0x74, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x43, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2a, 0x0a, 0x09, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x09, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x1a,
-	0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63,
+	0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x91, 0x02, 0x0a,
+	0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x6d, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x61, 0x63, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x63, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x15, 0x61, 0x63, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x52, 0x4c,
+	0x22, 0x38, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63,
 	0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x1b, 0x46, 0x69,
+	0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x15, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7f,
+	0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x63, 0x6d,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x61, 0x63, 0x6d, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x74, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x43, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x43, 0x4d,
+	0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x09, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x09, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x1a, 0x46, 0x69,
 	0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x63, 0x6d,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x08, 0x61, 0x63, 0x6d, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x22, 0x77, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x43,
-	0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x6d, 0x65,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x46, 0x0a, 0x18,
-	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x61, 0x63, 0x6d, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x61, 0x63, 0x6d, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x32, 0x84, 0x04, 0x0a, 0x0f, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x6d, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63,
+	0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x63, 0x6d, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x08, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x22, 0x77, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x43, 0x4d, 0x45,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x10, 0x61, 0x63, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x6d, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x46, 0x0a, 0x18, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x61, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x32, 0xcd, 0x04, 0x0a, 0x0f, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x43, 0x4d,
+	0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
+	0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
-	0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
-	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3b,
-	0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62,
-	0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50,
-	0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
-	0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
-	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x62,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10,
-	0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x43, 0x4d,
-	0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e,
-	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41,
+	0x63, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x43, 0x4d,
+	0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x66,
+	0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x43,
+	0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x41, 0x43, 0x4d, 0x45, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -762,43 +931,47 @@ func file_service_acme_user_proto_rawDescGZIP() []byte {
 	return file_service_acme_user_proto_rawDescData
 }
 
-var file_service_acme_user_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_service_acme_user_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_service_acme_user_proto_goTypes = []interface{}{
 	(*CreateACMEUserRequest)(nil),       // 0: pb.CreateACMEUserRequest
 	(*CreateACMEUserResponse)(nil),      // 1: pb.CreateACMEUserResponse
-	(*UpdateACMEUserRequest)(nil),       // 2: pb.UpdateACMEUserRequest
-	(*DeleteACMEUserRequest)(nil),       // 3: pb.DeleteACMEUserRequest
-	(*CountAcmeUsersRequest)(nil),       // 4: pb.CountAcmeUsersRequest
-	(*ListACMEUsersRequest)(nil),        // 5: pb.ListACMEUsersRequest
-	(*ListACMEUsersResponse)(nil),       // 6: pb.ListACMEUsersResponse
-	(*FindEnabledACMEUserRequest)(nil),  // 7: pb.FindEnabledACMEUserRequest
-	(*FindEnabledACMEUserResponse)(nil), // 8: pb.FindEnabledACMEUserResponse
-	(*FindAllACMEUsersRequest)(nil),     // 9: pb.FindAllACMEUsersRequest
-	(*FindAllACMEUsersResponse)(nil),    // 10: pb.FindAllACMEUsersResponse
-	(*ACMEUser)(nil),                    // 11: pb.ACMEUser
-	(*RPCSuccess)(nil),                  // 12: pb.RPCSuccess
-	(*RPCCountResponse)(nil),            // 13: pb.RPCCountResponse
+	(*ImportACMEUserRequest)(nil),       // 2: pb.ImportACMEUserRequest
+	(*ImportACMEUserResponse)(nil),      // 3: pb.ImportACMEUserResponse
+	(*UpdateACMEUserRequest)(nil),       // 4: pb.UpdateACMEUserRequest
+	(*DeleteACMEUserRequest)(nil),       // 5: pb.DeleteACMEUserRequest
+	(*CountAcmeUsersRequest)(nil),       // 6: pb.CountAcmeUsersRequest
+	(*ListACMEUsersRequest)(nil),        // 7: pb.ListACMEUsersRequest
+	(*ListACMEUsersResponse)(nil),       // 8: pb.ListACMEUsersResponse
+	(*FindEnabledACMEUserRequest)(nil),  // 9: pb.FindEnabledACMEUserRequest
+	(*FindEnabledACMEUserResponse)(nil), // 10: pb.FindEnabledACMEUserResponse
+	(*FindAllACMEUsersRequest)(nil),     // 11: pb.FindAllACMEUsersRequest
+	(*FindAllACMEUsersResponse)(nil),    // 12: pb.FindAllACMEUsersResponse
+	(*ACMEUser)(nil),                    // 13: pb.ACMEUser
+	(*RPCSuccess)(nil),                  // 14: pb.RPCSuccess
+	(*RPCCountResponse)(nil),            // 15: pb.RPCCountResponse
 }
 var file_service_acme_user_proto_depIdxs = []int32{
-	11, // 0: pb.ListACMEUsersResponse.acmeUsers:type_name -> pb.ACMEUser
-	11, // 1: pb.FindEnabledACMEUserResponse.acmeUser:type_name -> pb.ACMEUser
-	11, // 2: pb.FindAllACMEUsersResponse.acmeUsers:type_name -> pb.ACMEUser
+	13, // 0: pb.ListACMEUsersResponse.acmeUsers:type_name -> pb.ACMEUser
+	13, // 1: pb.FindEnabledACMEUserResponse.acmeUser:type_name -> pb.ACMEUser
+	13, // 2: pb.FindAllACMEUsersResponse.acmeUsers:type_name -> pb.ACMEUser
 	0,  // 3: pb.ACMEUserService.createACMEUser:input_type -> pb.CreateACMEUserRequest
-	2,  // 4: pb.ACMEUserService.updateACMEUser:input_type -> pb.UpdateACMEUserRequest
-	3,  // 5: pb.ACMEUserService.deleteACMEUser:input_type -> pb.DeleteACMEUserRequest
-	4,  // 6: pb.ACMEUserService.countACMEUsers:input_type -> pb.CountAcmeUsersRequest
-	5,  // 7: pb.ACMEUserService.listACMEUsers:input_type -> pb.ListACMEUsersRequest
-	7,  // 8: pb.ACMEUserService.findEnabledACMEUser:input_type -> pb.FindEnabledACMEUserRequest
-	9,  // 9: pb.ACMEUserService.findAllACMEUsers:input_type -> pb.FindAllACMEUsersRequest
-	1,  // 10: pb.ACMEUserService.createACMEUser:output_type -> pb.CreateACMEUserResponse
-	12, // 11: pb.ACMEUserService.updateACMEUser:output_type -> pb.RPCSuccess
-	12, // 12: pb.ACMEUserService.deleteACMEUser:output_type -> pb.RPCSuccess
-	13, // 13: pb.ACMEUserService.countACMEUsers:output_type -> pb.RPCCountResponse
-	6,  // 14: pb.ACMEUserService.listACMEUsers:output_type -> pb.ListACMEUsersResponse
-	8,  // 15: pb.ACMEUserService.findEnabledACMEUser:output_type -> pb.FindEnabledACMEUserResponse
-	10, // 16: pb.ACMEUserService.findAllACMEUsers:output_type -> pb.FindAllACMEUsersResponse
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
+	2,  // 4: pb.ACMEUserService.importACMEUser:input_type -> pb.ImportACMEUserRequest
+	4,  // 5: pb.ACMEUserService.updateACMEUser:input_type -> pb.UpdateACMEUserRequest
+	5,  // 6: pb.ACMEUserService.deleteACMEUser:input_type -> pb.DeleteACMEUserRequest
+	6,  // 7: pb.ACMEUserService.countACMEUsers:input_type -> pb.CountAcmeUsersRequest
+	7,  // 8: pb.ACMEUserService.listACMEUsers:input_type -> pb.ListACMEUsersRequest
+	9,  // 9: pb.ACMEUserService.findEnabledACMEUser:input_type -> pb.FindEnabledACMEUserRequest
+	11, // 10: pb.ACMEUserService.findAllACMEUsers:input_type -> pb.FindAllACMEUsersRequest
+	1,  // 11: pb.ACMEUserService.createACMEUser:output_type -> pb.CreateACMEUserResponse
+	3,  // 12: pb.ACMEUserService.importACMEUser:output_type -> pb.ImportACMEUserResponse
+	14, // 13: pb.ACMEUserService.updateACMEUser:output_type -> pb.RPCSuccess
+	14, // 14: pb.ACMEUserService.deleteACMEUser:output_type -> pb.RPCSuccess
+	15, // 15: pb.ACMEUserService.countACMEUsers:output_type -> pb.RPCCountResponse
+	8,  // 16: pb.ACMEUserService.listACMEUsers:output_type -> pb.ListACMEUsersResponse
+	10, // 17: pb.ACMEUserService.findEnabledACMEUser:output_type -> pb.FindEnabledACMEUserResponse
+	12, // 18: pb.ACMEUserService.findAllACMEUsers:output_type -> pb.FindAllACMEUsersResponse
+	11, // [11:19] is the sub-list for method output_type
+	3,  // [3:11] is the sub-list for method input_type
 	3,  // [3:3] is the sub-list for extension type_name
 	3,  // [3:3] is the sub-list for extension extendee
 	0,  // [0:3] is the sub-list for field type_name
@@ -837,7 +1010,7 @@ func file_service_acme_user_proto_init() {
 			}
 		}
 		file_service_acme_user_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateACMEUserRequest); i {
+			switch v := v.(*ImportACMEUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -849,7 +1022,7 @@ func file_service_acme_user_proto_init() {
 			}
 		}
 		file_service_acme_user_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteACMEUserRequest); i {
+			switch v := v.(*ImportACMEUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -861,7 +1034,7 @@ func file_service_acme_user_proto_init() {
 			}
 		}
 		file_service_acme_user_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountAcmeUsersRequest); i {
+			switch v := v.(*UpdateACMEUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -873,7 +1046,7 @@ func file_service_acme_user_proto_init() {
 			}
 		}
 		file_service_acme_user_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListACMEUsersRequest); i {
+			switch v := v.(*DeleteACMEUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -885,7 +1058,7 @@ func file_service_acme_user_proto_init() {
 			}
 		}
 		file_service_acme_user_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListACMEUsersResponse); i {
+			switch v := v.(*CountAcmeUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -897,7 +1070,7 @@ func file_service_acme_user_proto_init() {
 			}
 		}
 		file_service_acme_user_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEnabledACMEUserRequest); i {
+			switch v := v.(*ListACMEUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -909,7 +1082,7 @@ func file_service_acme_user_proto_init() {
 			}
 		}
 		file_service_acme_user_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEnabledACMEUserResponse); i {
+			switch v := v.(*ListACMEUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -921,7 +1094,7 @@ func file_service_acme_user_proto_init() {
 			}
 		}
 		file_service_acme_user_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindAllACMEUsersRequest); i {
+			switch v := v.(*FindEnabledACMEUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -933,6 +1106,30 @@ func file_service_acme_user_proto_init() {
 			}
 		}
 		file_service_acme_user_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEnabledACMEUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_acme_user_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllACMEUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_acme_user_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllACMEUsersResponse); i {
 			case 0:
 				return &v.state
@@ -951,7 +1148,7 @@ func file_service_acme_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_acme_user_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
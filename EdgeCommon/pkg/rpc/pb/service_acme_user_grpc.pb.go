@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	ACMEUserService_CreateACMEUser_FullMethodName      = "/pb.ACMEUserService/createACMEUser"
+	ACMEUserService_ImportACMEUser_FullMethodName      = "/pb.ACMEUserService/importACMEUser"
 	ACMEUserService_UpdateACMEUser_FullMethodName      = "/pb.ACMEUserService/updateACMEUser"
 	ACMEUserService_DeleteACMEUser_FullMethodName      = "/pb.ACMEUserService/deleteACMEUser"
 	ACMEUserService_CountACMEUsers_FullMethodName      = "/pb.ACMEUserService/countACMEUsers"
@@ -34,6 +35,8 @@ const (
 type ACMEUserServiceClient interface {
 	// 创建用户
 	CreateACMEUser(ctx context.Context, in *CreateACMEUserRequest, opts ...grpc.CallOption) (*CreateACMEUserResponse, error)
+	// 导入已有的用户
+	ImportACMEUser(ctx context.Context, in *ImportACMEUserRequest, opts ...grpc.CallOption) (*ImportACMEUserResponse, error)
 	// 修改用户
 	UpdateACMEUser(ctx context.Context, in *UpdateACMEUserRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 	// 删除用户
@@ -65,6 +68,15 @@ func (c *aCMEUserServiceClient) CreateACMEUser(ctx context.Context, in *CreateAC
 	return out, nil
 }
 
+func (c *aCMEUserServiceClient) ImportACMEUser(ctx context.Context, in *ImportACMEUserRequest, opts ...grpc.CallOption) (*ImportACMEUserResponse, error) {
+	out := new(ImportACMEUserResponse)
+	err := c.cc.Invoke(ctx, ACMEUserService_ImportACMEUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aCMEUserServiceClient) UpdateACMEUser(ctx context.Context, in *UpdateACMEUserRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
 	out := new(RPCSuccess)
 	err := c.cc.Invoke(ctx, ACMEUserService_UpdateACMEUser_FullMethodName, in, out, opts...)
@@ -125,6 +137,8 @@ func (c *aCMEUserServiceClient) FindAllACMEUsers(ctx context.Context, in *FindAl
 type ACMEUserServiceServer interface {
 	// 创建用户
 	CreateACMEUser(context.Context, *CreateACMEUserRequest) (*CreateACMEUserResponse, error)
+	// 导入已有的用户
+	ImportACMEUser(context.Context, *ImportACMEUserRequest) (*ImportACMEUserResponse, error)
 	// 修改用户
 	UpdateACMEUser(context.Context, *UpdateACMEUserRequest) (*RPCSuccess, error)
 	// 删除用户
@@ -146,6 +160,9 @@ type UnimplementedACMEUserServiceServer struct {
 func (UnimplementedACMEUserServiceServer) CreateACMEUser(context.Context, *CreateACMEUserRequest) (*CreateACMEUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateACMEUser not implemented")
 }
+func (UnimplementedACMEUserServiceServer) ImportACMEUser(context.Context, *ImportACMEUserRequest) (*ImportACMEUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportACMEUser not implemented")
+}
 func (UnimplementedACMEUserServiceServer) UpdateACMEUser(context.Context, *UpdateACMEUserRequest) (*RPCSuccess, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateACMEUser not implemented")
 }
@@ -194,6 +211,24 @@ func _ACMEUserService_CreateACMEUser_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ACMEUserService_ImportACMEUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportACMEUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACMEUserServiceServer).ImportACMEUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ACMEUserService_ImportACMEUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACMEUserServiceServer).ImportACMEUser(ctx, req.(*ImportACMEUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ACMEUserService_UpdateACMEUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateACMEUserRequest)
 	if err := dec(in); err != nil {
@@ -313,6 +348,10 @@ var ACMEUserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "createACMEUser",
 			Handler:    _ACMEUserService_CreateACMEUser_Handler,
 		},
+		{
+			MethodName: "importACMEUser",
+			Handler:    _ACMEUserService_ImportACMEUser_Handler,
+		},
 		{
 			MethodName: "updateACMEUser",
 			Handler:    _ACMEUserService_UpdateACMEUser_Handler,
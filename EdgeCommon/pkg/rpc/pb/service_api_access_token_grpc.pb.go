@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	APIAccessTokenService_GetAPIAccessToken_FullMethodName = "/pb.APIAccessTokenService/GetAPIAccessToken"
+	APIAccessTokenService_GetAPIAccessToken_FullMethodName             = "/pb.APIAccessTokenService/GetAPIAccessToken"
+	APIAccessTokenService_CreateUserImpersonationToken_FullMethodName  = "/pb.APIAccessTokenService/createUserImpersonationToken"
+	APIAccessTokenService_RevokeUserImpersonationTokens_FullMethodName = "/pb.APIAccessTokenService/revokeUserImpersonationTokens"
 )
 
 // APIAccessTokenServiceClient is the client API for APIAccessTokenService service.
@@ -28,6 +30,10 @@ const (
 type APIAccessTokenServiceClient interface {
 	// 获取AccessToken
 	GetAPIAccessToken(ctx context.Context, in *GetAPIAccessTokenRequest, opts ...grpc.CallOption) (*GetAPIAccessTokenResponse, error)
+	// 管理员获取模拟用户登录的短期AccessToken，用于技术支持人员复现用户遇到的问题，使用此令牌的所有调用都会记录审计日志
+	CreateUserImpersonationToken(ctx context.Context, in *CreateUserImpersonationTokenRequest, opts ...grpc.CallOption) (*CreateUserImpersonationTokenResponse, error)
+	// 撤销当前管理员模拟用户登录的AccessToken
+	RevokeUserImpersonationTokens(ctx context.Context, in *RevokeUserImpersonationTokensRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 }
 
 type aPIAccessTokenServiceClient struct {
@@ -47,12 +53,34 @@ func (c *aPIAccessTokenServiceClient) GetAPIAccessToken(ctx context.Context, in
 	return out, nil
 }
 
+func (c *aPIAccessTokenServiceClient) CreateUserImpersonationToken(ctx context.Context, in *CreateUserImpersonationTokenRequest, opts ...grpc.CallOption) (*CreateUserImpersonationTokenResponse, error) {
+	out := new(CreateUserImpersonationTokenResponse)
+	err := c.cc.Invoke(ctx, APIAccessTokenService_CreateUserImpersonationToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIAccessTokenServiceClient) RevokeUserImpersonationTokens(ctx context.Context, in *RevokeUserImpersonationTokensRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, APIAccessTokenService_RevokeUserImpersonationTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIAccessTokenServiceServer is the server API for APIAccessTokenService service.
 // All implementations should embed UnimplementedAPIAccessTokenServiceServer
 // for forward compatibility
 type APIAccessTokenServiceServer interface {
 	// 获取AccessToken
 	GetAPIAccessToken(context.Context, *GetAPIAccessTokenRequest) (*GetAPIAccessTokenResponse, error)
+	// 管理员获取模拟用户登录的短期AccessToken，用于技术支持人员复现用户遇到的问题，使用此令牌的所有调用都会记录审计日志
+	CreateUserImpersonationToken(context.Context, *CreateUserImpersonationTokenRequest) (*CreateUserImpersonationTokenResponse, error)
+	// 撤销当前管理员模拟用户登录的AccessToken
+	RevokeUserImpersonationTokens(context.Context, *RevokeUserImpersonationTokensRequest) (*RPCSuccess, error)
 }
 
 // UnimplementedAPIAccessTokenServiceServer should be embedded to have forward compatible implementations.
@@ -62,6 +90,12 @@ type UnimplementedAPIAccessTokenServiceServer struct {
 func (UnimplementedAPIAccessTokenServiceServer) GetAPIAccessToken(context.Context, *GetAPIAccessTokenRequest) (*GetAPIAccessTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAPIAccessToken not implemented")
 }
+func (UnimplementedAPIAccessTokenServiceServer) CreateUserImpersonationToken(context.Context, *CreateUserImpersonationTokenRequest) (*CreateUserImpersonationTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserImpersonationToken not implemented")
+}
+func (UnimplementedAPIAccessTokenServiceServer) RevokeUserImpersonationTokens(context.Context, *RevokeUserImpersonationTokensRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserImpersonationTokens not implemented")
+}
 
 // UnsafeAPIAccessTokenServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to APIAccessTokenServiceServer will
@@ -92,6 +126,42 @@ func _APIAccessTokenService_GetAPIAccessToken_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIAccessTokenService_CreateUserImpersonationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserImpersonationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIAccessTokenServiceServer).CreateUserImpersonationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIAccessTokenService_CreateUserImpersonationToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIAccessTokenServiceServer).CreateUserImpersonationToken(ctx, req.(*CreateUserImpersonationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIAccessTokenService_RevokeUserImpersonationTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserImpersonationTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIAccessTokenServiceServer).RevokeUserImpersonationTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIAccessTokenService_RevokeUserImpersonationTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIAccessTokenServiceServer).RevokeUserImpersonationTokens(ctx, req.(*RevokeUserImpersonationTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // APIAccessTokenService_ServiceDesc is the grpc.ServiceDesc for APIAccessTokenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +173,14 @@ var APIAccessTokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAPIAccessToken",
 			Handler:    _APIAccessTokenService_GetAPIAccessToken_Handler,
 		},
+		{
+			MethodName: "createUserImpersonationToken",
+			Handler:    _APIAccessTokenService_CreateUserImpersonationToken_Handler,
+		},
+		{
+			MethodName: "revokeUserImpersonationTokens",
+			Handler:    _APIAccessTokenService_RevokeUserImpersonationTokens_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_api_access_token.proto",
@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_audit_log.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AuditLogService_CountAuditLogs_FullMethodName = "/pb.AuditLogService/countAuditLogs"
+	AuditLogService_ListAuditLogs_FullMethodName  = "/pb.AuditLogService/listAuditLogs"
+	AuditLogService_FindAuditLog_FullMethodName   = "/pb.AuditLogService/findAuditLog"
+)
+
+// AuditLogServiceClient is the client API for AuditLogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuditLogServiceClient interface {
+	// 计算审计日志数量
+	CountAuditLogs(ctx context.Context, in *CountAuditLogsRequest, opts ...grpc.CallOption) (*RPCCountResponse, error)
+	// 列出单页审计日志
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
+	// 查找单条审计日志
+	FindAuditLog(ctx context.Context, in *FindAuditLogRequest, opts ...grpc.CallOption) (*FindAuditLogResponse, error)
+}
+
+type auditLogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditLogServiceClient(cc grpc.ClientConnInterface) AuditLogServiceClient {
+	return &auditLogServiceClient{cc}
+}
+
+func (c *auditLogServiceClient) CountAuditLogs(ctx context.Context, in *CountAuditLogsRequest, opts ...grpc.CallOption) (*RPCCountResponse, error) {
+	out := new(RPCCountResponse)
+	err := c.cc.Invoke(ctx, AuditLogService_CountAuditLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditLogServiceClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, AuditLogService_ListAuditLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditLogServiceClient) FindAuditLog(ctx context.Context, in *FindAuditLogRequest, opts ...grpc.CallOption) (*FindAuditLogResponse, error) {
+	out := new(FindAuditLogResponse)
+	err := c.cc.Invoke(ctx, AuditLogService_FindAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditLogServiceServer is the server API for AuditLogService service.
+// All implementations should embed UnimplementedAuditLogServiceServer
+// for forward compatibility
+type AuditLogServiceServer interface {
+	// 计算审计日志数量
+	CountAuditLogs(context.Context, *CountAuditLogsRequest) (*RPCCountResponse, error)
+	// 列出单页审计日志
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
+	// 查找单条审计日志
+	FindAuditLog(context.Context, *FindAuditLogRequest) (*FindAuditLogResponse, error)
+}
+
+// UnimplementedAuditLogServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedAuditLogServiceServer struct {
+}
+
+func (UnimplementedAuditLogServiceServer) CountAuditLogs(context.Context, *CountAuditLogsRequest) (*RPCCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountAuditLogs not implemented")
+}
+func (UnimplementedAuditLogServiceServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
+func (UnimplementedAuditLogServiceServer) FindAuditLog(context.Context, *FindAuditLogRequest) (*FindAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAuditLog not implemented")
+}
+
+// UnsafeAuditLogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditLogServiceServer will
+// result in compilation errors.
+type UnsafeAuditLogServiceServer interface {
+	mustEmbedUnimplementedAuditLogServiceServer()
+}
+
+func RegisterAuditLogServiceServer(s grpc.ServiceRegistrar, srv AuditLogServiceServer) {
+	s.RegisterService(&AuditLogService_ServiceDesc, srv)
+}
+
+func _AuditLogService_CountAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLogServiceServer).CountAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditLogService_CountAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLogServiceServer).CountAuditLogs(ctx, req.(*CountAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditLogService_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLogServiceServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditLogService_ListAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLogServiceServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditLogService_FindAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLogServiceServer).FindAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditLogService_FindAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLogServiceServer).FindAuditLog(ctx, req.(*FindAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditLogService_ServiceDesc is the grpc.ServiceDesc for AuditLogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditLogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.AuditLogService",
+	HandlerType: (*AuditLogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "countAuditLogs",
+			Handler:    _AuditLogService_CountAuditLogs_Handler,
+		},
+		{
+			MethodName: "listAuditLogs",
+			Handler:    _AuditLogService_ListAuditLogs_Handler,
+		},
+		{
+			MethodName: "findAuditLog",
+			Handler:    _AuditLogService_FindAuditLog_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_audit_log.proto",
+}
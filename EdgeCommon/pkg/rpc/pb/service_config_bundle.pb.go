@@ -0,0 +1,561 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_config_bundle.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 导出配置包
+type ExportConfigBundleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerIds             []int64 `protobuf:"varint,1,rep,packed,name=serverIds,proto3" json:"serverIds,omitempty"`                         // 网站ID，网站引用的证书和WAF策略会自动导出
+	SslCertIds            []int64 `protobuf:"varint,2,rep,packed,name=sslCertIds,proto3" json:"sslCertIds,omitempty"`                       // 证书ID
+	DnsProviderIds        []int64 `protobuf:"varint,3,rep,packed,name=dnsProviderIds,proto3" json:"dnsProviderIds,omitempty"`               // DNS服务商ID
+	HttpCachePolicyIds    []int64 `protobuf:"varint,4,rep,packed,name=httpCachePolicyIds,proto3" json:"httpCachePolicyIds,omitempty"`       // 缓存策略ID
+	HttpFirewallPolicyIds []int64 `protobuf:"varint,5,rep,packed,name=httpFirewallPolicyIds,proto3" json:"httpFirewallPolicyIds,omitempty"` // WAF策略ID
+	Format                string  `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`                                       // 格式：json、yaml，默认为json
+	IncludeSecrets        bool    `protobuf:"varint,7,opt,name=includeSecrets,proto3" json:"includeSecrets,omitempty"`                      // 是否包含证书私钥和DNS服务商API参数等敏感信息
+}
+
+func (x *ExportConfigBundleRequest) Reset() {
+	*x = ExportConfigBundleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_config_bundle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportConfigBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigBundleRequest) ProtoMessage() {}
+
+func (x *ExportConfigBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_config_bundle_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigBundleRequest.ProtoReflect.Descriptor instead.
+func (*ExportConfigBundleRequest) Descriptor() ([]byte, []int) {
+	return file_service_config_bundle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportConfigBundleRequest) GetServerIds() []int64 {
+	if x != nil {
+		return x.ServerIds
+	}
+	return nil
+}
+
+func (x *ExportConfigBundleRequest) GetSslCertIds() []int64 {
+	if x != nil {
+		return x.SslCertIds
+	}
+	return nil
+}
+
+func (x *ExportConfigBundleRequest) GetDnsProviderIds() []int64 {
+	if x != nil {
+		return x.DnsProviderIds
+	}
+	return nil
+}
+
+func (x *ExportConfigBundleRequest) GetHttpCachePolicyIds() []int64 {
+	if x != nil {
+		return x.HttpCachePolicyIds
+	}
+	return nil
+}
+
+func (x *ExportConfigBundleRequest) GetHttpFirewallPolicyIds() []int64 {
+	if x != nil {
+		return x.HttpFirewallPolicyIds
+	}
+	return nil
+}
+
+func (x *ExportConfigBundleRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportConfigBundleRequest) GetIncludeSecrets() bool {
+	if x != nil {
+		return x.IncludeSecrets
+	}
+	return false
+}
+
+type ExportConfigBundleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BundleData []byte `protobuf:"bytes,1,opt,name=bundleData,proto3" json:"bundleData,omitempty"` // 配置包数据
+	Format     string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`         // 格式
+	Version    int32  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`      // 配置包格式版本
+}
+
+func (x *ExportConfigBundleResponse) Reset() {
+	*x = ExportConfigBundleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_config_bundle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportConfigBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigBundleResponse) ProtoMessage() {}
+
+func (x *ExportConfigBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_config_bundle_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigBundleResponse.ProtoReflect.Descriptor instead.
+func (*ExportConfigBundleResponse) Descriptor() ([]byte, []int) {
+	return file_service_config_bundle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportConfigBundleResponse) GetBundleData() []byte {
+	if x != nil {
+		return x.BundleData
+	}
+	return nil
+}
+
+func (x *ExportConfigBundleResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportConfigBundleResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// 导入配置包
+type ImportConfigBundleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BundleData           []byte `protobuf:"bytes,1,opt,name=bundleData,proto3" json:"bundleData,omitempty"`                      // 配置包数据，自动识别JSON和YAML格式
+	NodeClusterId        int64  `protobuf:"varint,2,opt,name=nodeClusterId,proto3" json:"nodeClusterId,omitempty"`               // 网站导入到的集群ID，配置包中有网站时必填
+	UserId               int64  `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`                             // 可选项，导入的网站、证书等所属的用户ID
+	SkipExistServerNames bool   `protobuf:"varint,4,opt,name=skipExistServerNames,proto3" json:"skipExistServerNames,omitempty"` // 是否跳过域名已经存在的网站，为false时遇到已存在的域名将导入失败
+}
+
+func (x *ImportConfigBundleRequest) Reset() {
+	*x = ImportConfigBundleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_config_bundle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportConfigBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigBundleRequest) ProtoMessage() {}
+
+func (x *ImportConfigBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_config_bundle_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigBundleRequest.ProtoReflect.Descriptor instead.
+func (*ImportConfigBundleRequest) Descriptor() ([]byte, []int) {
+	return file_service_config_bundle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportConfigBundleRequest) GetBundleData() []byte {
+	if x != nil {
+		return x.BundleData
+	}
+	return nil
+}
+
+func (x *ImportConfigBundleRequest) GetNodeClusterId() int64 {
+	if x != nil {
+		return x.NodeClusterId
+	}
+	return 0
+}
+
+func (x *ImportConfigBundleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportConfigBundleRequest) GetSkipExistServerNames() bool {
+	if x != nil {
+		return x.SkipExistServerNames
+	}
+	return false
+}
+
+type ImportConfigBundleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdMappings []*ImportConfigBundleResponse_IdMapping `protobuf:"bytes,1,rep,name=idMappings,proto3" json:"idMappings,omitempty"` // 新旧ID映射
+	Warnings   []string                                `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`     // 警告信息，比如缺少私钥的证书
+}
+
+func (x *ImportConfigBundleResponse) Reset() {
+	*x = ImportConfigBundleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_config_bundle_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportConfigBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigBundleResponse) ProtoMessage() {}
+
+func (x *ImportConfigBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_config_bundle_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigBundleResponse.ProtoReflect.Descriptor instead.
+func (*ImportConfigBundleResponse) Descriptor() ([]byte, []int) {
+	return file_service_config_bundle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImportConfigBundleResponse) GetIdMappings() []*ImportConfigBundleResponse_IdMapping {
+	if x != nil {
+		return x.IdMappings
+	}
+	return nil
+}
+
+func (x *ImportConfigBundleResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ImportConfigBundleResponse_IdMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemType string `protobuf:"bytes,1,opt,name=itemType,proto3" json:"itemType,omitempty"` // 对象类型：sslCert、dnsProvider、httpCachePolicy、httpFirewallPolicy、server
+	OldId    int64  `protobuf:"varint,2,opt,name=oldId,proto3" json:"oldId,omitempty"`      // 配置包中的ID
+	NewId    int64  `protobuf:"varint,3,opt,name=newId,proto3" json:"newId,omitempty"`      // 导入后的ID，跳过的对象为0
+}
+
+func (x *ImportConfigBundleResponse_IdMapping) Reset() {
+	*x = ImportConfigBundleResponse_IdMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_config_bundle_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportConfigBundleResponse_IdMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigBundleResponse_IdMapping) ProtoMessage() {}
+
+func (x *ImportConfigBundleResponse_IdMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_service_config_bundle_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigBundleResponse_IdMapping.ProtoReflect.Descriptor instead.
+func (*ImportConfigBundleResponse_IdMapping) Descriptor() ([]byte, []int) {
+	return file_service_config_bundle_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *ImportConfigBundleResponse_IdMapping) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *ImportConfigBundleResponse_IdMapping) GetOldId() int64 {
+	if x != nil {
+		return x.OldId
+	}
+	return 0
+}
+
+func (x *ImportConfigBundleResponse_IdMapping) GetNewId() int64 {
+	if x != nil {
+		return x.NewId
+	}
+	return 0
+}
+
+var File_service_config_bundle_proto protoreflect.FileDescriptor
+
+var file_service_config_bundle_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x22, 0xa7, 0x02, 0x0a, 0x19, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x0a, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x68, 0x74, 0x74, 0x70, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x12, 0x68, 0x74, 0x74, 0x70, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x68, 0x74, 0x74, 0x70, 0x46, 0x69, 0x72,
+	0x65, 0x77, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x15, 0x68, 0x74, 0x74, 0x70, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61,
+	0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0x6e, 0x0a, 0x1a, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xad, 0x01, 0x0a, 0x19,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x73, 0x6b, 0x69, 0x70, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x6b, 0x69, 0x70, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x1a,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x69, 0x64,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49,
+	0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x1a, 0x53, 0x0a, 0x09, 0x49, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x6c, 0x64,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x65, 0x77, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6e, 0x65, 0x77, 0x49, 0x64, 0x32, 0xbf, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a,
+	0x12, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_config_bundle_proto_rawDescOnce sync.Once
+	file_service_config_bundle_proto_rawDescData = file_service_config_bundle_proto_rawDesc
+)
+
+func file_service_config_bundle_proto_rawDescGZIP() []byte {
+	file_service_config_bundle_proto_rawDescOnce.Do(func() {
+		file_service_config_bundle_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_config_bundle_proto_rawDescData)
+	})
+	return file_service_config_bundle_proto_rawDescData
+}
+
+var file_service_config_bundle_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_service_config_bundle_proto_goTypes = []interface{}{
+	(*ExportConfigBundleRequest)(nil),            // 0: pb.ExportConfigBundleRequest
+	(*ExportConfigBundleResponse)(nil),           // 1: pb.ExportConfigBundleResponse
+	(*ImportConfigBundleRequest)(nil),            // 2: pb.ImportConfigBundleRequest
+	(*ImportConfigBundleResponse)(nil),           // 3: pb.ImportConfigBundleResponse
+	(*ImportConfigBundleResponse_IdMapping)(nil), // 4: pb.ImportConfigBundleResponse.IdMapping
+}
+var file_service_config_bundle_proto_depIdxs = []int32{
+	4, // 0: pb.ImportConfigBundleResponse.idMappings:type_name -> pb.ImportConfigBundleResponse.IdMapping
+	0, // 1: pb.ConfigBundleService.exportConfigBundle:input_type -> pb.ExportConfigBundleRequest
+	2, // 2: pb.ConfigBundleService.importConfigBundle:input_type -> pb.ImportConfigBundleRequest
+	1, // 3: pb.ConfigBundleService.exportConfigBundle:output_type -> pb.ExportConfigBundleResponse
+	3, // 4: pb.ConfigBundleService.importConfigBundle:output_type -> pb.ImportConfigBundleResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_service_config_bundle_proto_init() }
+func file_service_config_bundle_proto_init() {
+	if File_service_config_bundle_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_service_config_bundle_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportConfigBundleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_config_bundle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportConfigBundleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_config_bundle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportConfigBundleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_config_bundle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportConfigBundleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_config_bundle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportConfigBundleResponse_IdMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_config_bundle_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_config_bundle_proto_goTypes,
+		DependencyIndexes: file_service_config_bundle_proto_depIdxs,
+		MessageInfos:      file_service_config_bundle_proto_msgTypes,
+	}.Build()
+	File_service_config_bundle_proto = out.File
+	file_service_config_bundle_proto_rawDesc = nil
+	file_service_config_bundle_proto_goTypes = nil
+	file_service_config_bundle_proto_depIdxs = nil
+}
@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_config_bundle.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ConfigBundleService_ExportConfigBundle_FullMethodName = "/pb.ConfigBundleService/exportConfigBundle"
+	ConfigBundleService_ImportConfigBundle_FullMethodName = "/pb.ConfigBundleService/importConfigBundle"
+)
+
+// ConfigBundleServiceClient is the client API for ConfigBundleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConfigBundleServiceClient interface {
+	// 导出配置包
+	ExportConfigBundle(ctx context.Context, in *ExportConfigBundleRequest, opts ...grpc.CallOption) (*ExportConfigBundleResponse, error)
+	// 导入配置包
+	ImportConfigBundle(ctx context.Context, in *ImportConfigBundleRequest, opts ...grpc.CallOption) (*ImportConfigBundleResponse, error)
+}
+
+type configBundleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConfigBundleServiceClient(cc grpc.ClientConnInterface) ConfigBundleServiceClient {
+	return &configBundleServiceClient{cc}
+}
+
+func (c *configBundleServiceClient) ExportConfigBundle(ctx context.Context, in *ExportConfigBundleRequest, opts ...grpc.CallOption) (*ExportConfigBundleResponse, error) {
+	out := new(ExportConfigBundleResponse)
+	err := c.cc.Invoke(ctx, ConfigBundleService_ExportConfigBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configBundleServiceClient) ImportConfigBundle(ctx context.Context, in *ImportConfigBundleRequest, opts ...grpc.CallOption) (*ImportConfigBundleResponse, error) {
+	out := new(ImportConfigBundleResponse)
+	err := c.cc.Invoke(ctx, ConfigBundleService_ImportConfigBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfigBundleServiceServer is the server API for ConfigBundleService service.
+// All implementations should embed UnimplementedConfigBundleServiceServer
+// for forward compatibility
+type ConfigBundleServiceServer interface {
+	// 导出配置包
+	ExportConfigBundle(context.Context, *ExportConfigBundleRequest) (*ExportConfigBundleResponse, error)
+	// 导入配置包
+	ImportConfigBundle(context.Context, *ImportConfigBundleRequest) (*ImportConfigBundleResponse, error)
+}
+
+// UnimplementedConfigBundleServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedConfigBundleServiceServer struct {
+}
+
+func (UnimplementedConfigBundleServiceServer) ExportConfigBundle(context.Context, *ExportConfigBundleRequest) (*ExportConfigBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportConfigBundle not implemented")
+}
+func (UnimplementedConfigBundleServiceServer) ImportConfigBundle(context.Context, *ImportConfigBundleRequest) (*ImportConfigBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportConfigBundle not implemented")
+}
+
+// UnsafeConfigBundleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigBundleServiceServer will
+// result in compilation errors.
+type UnsafeConfigBundleServiceServer interface {
+	mustEmbedUnimplementedConfigBundleServiceServer()
+}
+
+func RegisterConfigBundleServiceServer(s grpc.ServiceRegistrar, srv ConfigBundleServiceServer) {
+	s.RegisterService(&ConfigBundleService_ServiceDesc, srv)
+}
+
+func _ConfigBundleService_ExportConfigBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportConfigBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigBundleServiceServer).ExportConfigBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigBundleService_ExportConfigBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigBundleServiceServer).ExportConfigBundle(ctx, req.(*ExportConfigBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigBundleService_ImportConfigBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportConfigBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigBundleServiceServer).ImportConfigBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigBundleService_ImportConfigBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigBundleServiceServer).ImportConfigBundle(ctx, req.(*ImportConfigBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConfigBundleService_ServiceDesc is the grpc.ServiceDesc for ConfigBundleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConfigBundleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ConfigBundleService",
+	HandlerType: (*ConfigBundleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "exportConfigBundle",
+			Handler:    _ConfigBundleService_ExportConfigBundle_Handler,
+		},
+		{
+			MethodName: "importConfigBundle",
+			Handler:    _ConfigBundleService_ImportConfigBundle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_config_bundle.proto",
+}
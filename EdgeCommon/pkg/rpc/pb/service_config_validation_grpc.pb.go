@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_config_validation.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ConfigValidationService_ValidateConfig_FullMethodName       = "/pb.ConfigValidationService/validateConfig"
+	ConfigValidationService_ValidateConfigStream_FullMethodName = "/pb.ConfigValidationService/validateConfigStream"
+)
+
+// ConfigValidationServiceClient is the client API for ConfigValidationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConfigValidationServiceClient interface {
+	// 校验配置
+	ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error)
+	// 实时校验配置
+	// 控制台在编辑配置时不断发送最新的配置，API节点对比同一个文档上一次的校验结果，返回新增和已解决的问题
+	ValidateConfigStream(ctx context.Context, opts ...grpc.CallOption) (ConfigValidationService_ValidateConfigStreamClient, error)
+}
+
+type configValidationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConfigValidationServiceClient(cc grpc.ClientConnInterface) ConfigValidationServiceClient {
+	return &configValidationServiceClient{cc}
+}
+
+func (c *configValidationServiceClient) ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error) {
+	out := new(ValidateConfigResponse)
+	err := c.cc.Invoke(ctx, ConfigValidationService_ValidateConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configValidationServiceClient) ValidateConfigStream(ctx context.Context, opts ...grpc.CallOption) (ConfigValidationService_ValidateConfigStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConfigValidationService_ServiceDesc.Streams[0], ConfigValidationService_ValidateConfigStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &configValidationServiceValidateConfigStreamClient{stream}
+	return x, nil
+}
+
+type ConfigValidationService_ValidateConfigStreamClient interface {
+	Send(*ValidateConfigStreamRequest) error
+	Recv() (*ValidateConfigStreamResponse, error)
+	grpc.ClientStream
+}
+
+type configValidationServiceValidateConfigStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *configValidationServiceValidateConfigStreamClient) Send(m *ValidateConfigStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *configValidationServiceValidateConfigStreamClient) Recv() (*ValidateConfigStreamResponse, error) {
+	m := new(ValidateConfigStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ConfigValidationServiceServer is the server API for ConfigValidationService service.
+// All implementations should embed UnimplementedConfigValidationServiceServer
+// for forward compatibility
+type ConfigValidationServiceServer interface {
+	// 校验配置
+	ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error)
+	// 实时校验配置
+	// 控制台在编辑配置时不断发送最新的配置，API节点对比同一个文档上一次的校验结果，返回新增和已解决的问题
+	ValidateConfigStream(ConfigValidationService_ValidateConfigStreamServer) error
+}
+
+// UnimplementedConfigValidationServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedConfigValidationServiceServer struct {
+}
+
+func (UnimplementedConfigValidationServiceServer) ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfig not implemented")
+}
+func (UnimplementedConfigValidationServiceServer) ValidateConfigStream(ConfigValidationService_ValidateConfigStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateConfigStream not implemented")
+}
+
+// UnsafeConfigValidationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigValidationServiceServer will
+// result in compilation errors.
+type UnsafeConfigValidationServiceServer interface {
+	mustEmbedUnimplementedConfigValidationServiceServer()
+}
+
+func RegisterConfigValidationServiceServer(s grpc.ServiceRegistrar, srv ConfigValidationServiceServer) {
+	s.RegisterService(&ConfigValidationService_ServiceDesc, srv)
+}
+
+func _ConfigValidationService_ValidateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigValidationServiceServer).ValidateConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigValidationService_ValidateConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigValidationServiceServer).ValidateConfig(ctx, req.(*ValidateConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigValidationService_ValidateConfigStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ConfigValidationServiceServer).ValidateConfigStream(&configValidationServiceValidateConfigStreamServer{stream})
+}
+
+type ConfigValidationService_ValidateConfigStreamServer interface {
+	Send(*ValidateConfigStreamResponse) error
+	Recv() (*ValidateConfigStreamRequest, error)
+	grpc.ServerStream
+}
+
+type configValidationServiceValidateConfigStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *configValidationServiceValidateConfigStreamServer) Send(m *ValidateConfigStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *configValidationServiceValidateConfigStreamServer) Recv() (*ValidateConfigStreamRequest, error) {
+	m := new(ValidateConfigStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ConfigValidationService_ServiceDesc is the grpc.ServiceDesc for ConfigValidationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConfigValidationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ConfigValidationService",
+	HandlerType: (*ConfigValidationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "validateConfig",
+			Handler:    _ConfigValidationService_ValidateConfig_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "validateConfigStream",
+			Handler:       _ConfigValidationService_ValidateConfigStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "service_config_validation.proto",
+}
@@ -202,6 +202,400 @@ func (x *TruncateDBTableRequest) GetDbTable() string {
 	return ""
 }
 
+// 检查常用查询的索引
+type FindDBIndexAdvicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindDBIndexAdvicesRequest) Reset() {
+	*x = FindDBIndexAdvicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_db_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDBIndexAdvicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDBIndexAdvicesRequest) ProtoMessage() {}
+
+func (x *FindDBIndexAdvicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_db_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDBIndexAdvicesRequest.ProtoReflect.Descriptor instead.
+func (*FindDBIndexAdvicesRequest) Descriptor() ([]byte, []int) {
+	return file_service_db_proto_rawDescGZIP(), []int{4}
+}
+
+type FindDBIndexAdvicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DbIndexAdvices []*FindDBIndexAdvicesResponse_DBIndexAdvice `protobuf:"bytes,1,rep,name=dbIndexAdvices,proto3" json:"dbIndexAdvices,omitempty"`
+}
+
+func (x *FindDBIndexAdvicesResponse) Reset() {
+	*x = FindDBIndexAdvicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_db_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDBIndexAdvicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDBIndexAdvicesResponse) ProtoMessage() {}
+
+func (x *FindDBIndexAdvicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_db_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDBIndexAdvicesResponse.ProtoReflect.Descriptor instead.
+func (*FindDBIndexAdvicesResponse) Descriptor() ([]byte, []int) {
+	return file_service_db_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindDBIndexAdvicesResponse) GetDbIndexAdvices() []*FindDBIndexAdvicesResponse_DBIndexAdvice {
+	if x != nil {
+		return x.DbIndexAdvices
+	}
+	return nil
+}
+
+// 检查数据库表结构和当前版本是否一致
+type CheckDBSchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckDBSchemaRequest) Reset() {
+	*x = CheckDBSchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_db_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckDBSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDBSchemaRequest) ProtoMessage() {}
+
+func (x *CheckDBSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_db_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDBSchemaRequest.ProtoReflect.Descriptor instead.
+func (*CheckDBSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_service_db_proto_rawDescGZIP(), []int{6}
+}
+
+type CheckDBSchemaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DbSchemaDrifts []*CheckDBSchemaResponse_DBSchemaDrift `protobuf:"bytes,1,rep,name=dbSchemaDrifts,proto3" json:"dbSchemaDrifts,omitempty"`
+}
+
+func (x *CheckDBSchemaResponse) Reset() {
+	*x = CheckDBSchemaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_db_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckDBSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDBSchemaResponse) ProtoMessage() {}
+
+func (x *CheckDBSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_db_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDBSchemaResponse.ProtoReflect.Descriptor instead.
+func (*CheckDBSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_service_db_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CheckDBSchemaResponse) GetDbSchemaDrifts() []*CheckDBSchemaResponse_DBSchemaDrift {
+	if x != nil {
+		return x.DbSchemaDrifts
+	}
+	return nil
+}
+
+type FindDBIndexAdvicesResponse_DBIndexAdvice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                  // 查询名称
+	Description  string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`    // 查询说明
+	DbTable      string   `protobuf:"bytes,3,opt,name=dbTable,proto3" json:"dbTable,omitempty"`            // 表名
+	Sql          string   `protobuf:"bytes,4,opt,name=sql,proto3" json:"sql,omitempty"`                    // 查询语句
+	Columns      []string `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`            // 需要的索引字段
+	HasIndex     bool     `protobuf:"varint,6,opt,name=hasIndex,proto3" json:"hasIndex,omitempty"`         // 是否已经有对应的索引
+	IsFullScan   bool     `protobuf:"varint,7,opt,name=isFullScan,proto3" json:"isFullScan,omitempty"`     // 是否有全表扫描
+	UsesFilesort bool     `protobuf:"varint,8,opt,name=usesFilesort,proto3" json:"usesFilesort,omitempty"` // 是否需要额外的排序或临时表
+	Keys         []string `protobuf:"bytes,9,rep,name=keys,proto3" json:"keys,omitempty"`                  // 使用到的索引
+	Rows         int64    `protobuf:"varint,10,opt,name=rows,proto3" json:"rows,omitempty"`                // 预计扫描的行数，只有MySQL有此数据
+	Details      []string `protobuf:"bytes,11,rep,name=details,proto3" json:"details,omitempty"`           // 查询计划中的每一步
+	SuggestedSQL string   `protobuf:"bytes,12,opt,name=suggestedSQL,proto3" json:"suggestedSQL,omitempty"` // 建议执行的建索引语句
+	Error        string   `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`               // 检查时的错误
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) Reset() {
+	*x = FindDBIndexAdvicesResponse_DBIndexAdvice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_db_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDBIndexAdvicesResponse_DBIndexAdvice) ProtoMessage() {}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) ProtoReflect() protoreflect.Message {
+	mi := &file_service_db_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDBIndexAdvicesResponse_DBIndexAdvice.ProtoReflect.Descriptor instead.
+func (*FindDBIndexAdvicesResponse_DBIndexAdvice) Descriptor() ([]byte, []int) {
+	return file_service_db_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetDbTable() string {
+	if x != nil {
+		return x.DbTable
+	}
+	return ""
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetHasIndex() bool {
+	if x != nil {
+		return x.HasIndex
+	}
+	return false
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetIsFullScan() bool {
+	if x != nil {
+		return x.IsFullScan
+	}
+	return false
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetUsesFilesort() bool {
+	if x != nil {
+		return x.UsesFilesort
+	}
+	return false
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetDetails() []string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetSuggestedSQL() string {
+	if x != nil {
+		return x.SuggestedSQL
+	}
+	return ""
+}
+
+func (x *FindDBIndexAdvicesResponse_DBIndexAdvice) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CheckDBSchemaResponse_DBSchemaDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`         // 类型：missingTable、missingColumn、modifiedColumn、missingIndex、modifiedIndex
+	DbTable  string `protobuf:"bytes,2,opt,name=dbTable,proto3" json:"dbTable,omitempty"`   // 表名
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`         // 字段名或索引名
+	Expected string `protobuf:"bytes,4,opt,name=expected,proto3" json:"expected,omitempty"` // 期望的定义
+	Actual   string `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`     // 数据库中的定义
+}
+
+func (x *CheckDBSchemaResponse_DBSchemaDrift) Reset() {
+	*x = CheckDBSchemaResponse_DBSchemaDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_db_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckDBSchemaResponse_DBSchemaDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDBSchemaResponse_DBSchemaDrift) ProtoMessage() {}
+
+func (x *CheckDBSchemaResponse_DBSchemaDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_service_db_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDBSchemaResponse_DBSchemaDrift.ProtoReflect.Descriptor instead.
+func (*CheckDBSchemaResponse_DBSchemaDrift) Descriptor() ([]byte, []int) {
+	return file_service_db_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *CheckDBSchemaResponse_DBSchemaDrift) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CheckDBSchemaResponse_DBSchemaDrift) GetDbTable() string {
+	if x != nil {
+		return x.DbTable
+	}
+	return ""
+}
+
+func (x *CheckDBSchemaResponse_DBSchemaDrift) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CheckDBSchemaResponse_DBSchemaDrift) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *CheckDBSchemaResponse_DBSchemaDrift) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
 var File_service_db_proto protoreflect.FileDescriptor
 
 var file_service_db_proto_rawDesc = []byte{
@@ -222,21 +616,79 @@ var file_service_db_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x44, 0x42, 0x54, 0x61, 0x62, 0x6c,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x62, 0x54, 0x61,
 	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x62, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x32, 0xd1, 0x01, 0x0a, 0x09, 0x44, 0x42, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x4a, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x42, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
-	0x44, 0x42, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x42, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x42, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x42, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x74, 0x72, 0x75, 0x6e, 0x63,
-	0x61, 0x74, 0x65, 0x44, 0x42, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
-	0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x44, 0x42, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xdc, 0x03, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
+	0x0a, 0x0e, 0x64, 0x62, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x0e, 0x64, 0x62, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x64, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x1a, 0xe7, 0x02, 0x0a, 0x0d, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x62, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64,
+	0x62, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x61, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x53, 0x63, 0x61, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x22,
+	0x0a, 0x0c, 0x75, 0x73, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x6f,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x53, 0x51, 0x4c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x51, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x16,
+	0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x42, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x44, 0x42, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4f, 0x0a, 0x0e, 0x64, 0x62, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x44, 0x42, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x44, 0x42, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66,
+	0x74, 0x52, 0x0e, 0x64, 0x62, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74,
+	0x73, 0x1a, 0x85, 0x01, 0x0a, 0x0d, 0x44, 0x42, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72,
+	0x69, 0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x62, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x62, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x32, 0xec, 0x02, 0x0a, 0x09, 0x44, 0x42,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x64, 0x41,
+	0x6c, 0x6c, 0x44, 0x42, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x42, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x41, 0x6c, 0x6c, 0x44, 0x42, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x42, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x44, 0x42, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d,
+	0x0a, 0x0f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x44, 0x42, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x44,
+	0x42, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x53, 0x0a,
+	0x12, 0x66, 0x69, 0x6e, 0x64, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x42, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x42, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x42, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x42,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x42, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -251,28 +703,40 @@ func file_service_db_proto_rawDescGZIP() []byte {
 	return file_service_db_proto_rawDescData
 }
 
-var file_service_db_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_service_db_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_service_db_proto_goTypes = []interface{}{
-	(*FindAllDBTablesRequest)(nil),  // 0: pb.FindAllDBTablesRequest
-	(*FindAllDBTablesResponse)(nil), // 1: pb.FindAllDBTablesResponse
-	(*DeleteDBTableRequest)(nil),    // 2: pb.DeleteDBTableRequest
-	(*TruncateDBTableRequest)(nil),  // 3: pb.TruncateDBTableRequest
-	(*DBTable)(nil),                 // 4: pb.DBTable
-	(*RPCSuccess)(nil),              // 5: pb.RPCSuccess
+	(*FindAllDBTablesRequest)(nil),                   // 0: pb.FindAllDBTablesRequest
+	(*FindAllDBTablesResponse)(nil),                  // 1: pb.FindAllDBTablesResponse
+	(*DeleteDBTableRequest)(nil),                     // 2: pb.DeleteDBTableRequest
+	(*TruncateDBTableRequest)(nil),                   // 3: pb.TruncateDBTableRequest
+	(*FindDBIndexAdvicesRequest)(nil),                // 4: pb.FindDBIndexAdvicesRequest
+	(*FindDBIndexAdvicesResponse)(nil),               // 5: pb.FindDBIndexAdvicesResponse
+	(*CheckDBSchemaRequest)(nil),                     // 6: pb.CheckDBSchemaRequest
+	(*CheckDBSchemaResponse)(nil),                    // 7: pb.CheckDBSchemaResponse
+	(*FindDBIndexAdvicesResponse_DBIndexAdvice)(nil), // 8: pb.FindDBIndexAdvicesResponse.DBIndexAdvice
+	(*CheckDBSchemaResponse_DBSchemaDrift)(nil),      // 9: pb.CheckDBSchemaResponse.DBSchemaDrift
+	(*DBTable)(nil),                                  // 10: pb.DBTable
+	(*RPCSuccess)(nil),                               // 11: pb.RPCSuccess
 }
 var file_service_db_proto_depIdxs = []int32{
-	4, // 0: pb.FindAllDBTablesResponse.dbTables:type_name -> pb.DBTable
-	0, // 1: pb.DBService.findAllDBTables:input_type -> pb.FindAllDBTablesRequest
-	2, // 2: pb.DBService.deleteDBTable:input_type -> pb.DeleteDBTableRequest
-	3, // 3: pb.DBService.truncateDBTable:input_type -> pb.TruncateDBTableRequest
-	1, // 4: pb.DBService.findAllDBTables:output_type -> pb.FindAllDBTablesResponse
-	5, // 5: pb.DBService.deleteDBTable:output_type -> pb.RPCSuccess
-	5, // 6: pb.DBService.truncateDBTable:output_type -> pb.RPCSuccess
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	10, // 0: pb.FindAllDBTablesResponse.dbTables:type_name -> pb.DBTable
+	8,  // 1: pb.FindDBIndexAdvicesResponse.dbIndexAdvices:type_name -> pb.FindDBIndexAdvicesResponse.DBIndexAdvice
+	9,  // 2: pb.CheckDBSchemaResponse.dbSchemaDrifts:type_name -> pb.CheckDBSchemaResponse.DBSchemaDrift
+	0,  // 3: pb.DBService.findAllDBTables:input_type -> pb.FindAllDBTablesRequest
+	2,  // 4: pb.DBService.deleteDBTable:input_type -> pb.DeleteDBTableRequest
+	3,  // 5: pb.DBService.truncateDBTable:input_type -> pb.TruncateDBTableRequest
+	4,  // 6: pb.DBService.findDBIndexAdvices:input_type -> pb.FindDBIndexAdvicesRequest
+	6,  // 7: pb.DBService.checkDBSchema:input_type -> pb.CheckDBSchemaRequest
+	1,  // 8: pb.DBService.findAllDBTables:output_type -> pb.FindAllDBTablesResponse
+	11, // 9: pb.DBService.deleteDBTable:output_type -> pb.RPCSuccess
+	11, // 10: pb.DBService.truncateDBTable:output_type -> pb.RPCSuccess
+	5,  // 11: pb.DBService.findDBIndexAdvices:output_type -> pb.FindDBIndexAdvicesResponse
+	7,  // 12: pb.DBService.checkDBSchema:output_type -> pb.CheckDBSchemaResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_service_db_proto_init() }
@@ -331,6 +795,78 @@ func file_service_db_proto_init() {
 				return nil
 			}
 		}
+		file_service_db_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDBIndexAdvicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_db_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDBIndexAdvicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_db_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckDBSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_db_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckDBSchemaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_db_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDBIndexAdvicesResponse_DBIndexAdvice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_db_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckDBSchemaResponse_DBSchemaDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -338,7 +874,7 @@ func file_service_db_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_db_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
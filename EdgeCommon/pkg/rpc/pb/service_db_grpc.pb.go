@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DBService_FindAllDBTables_FullMethodName = "/pb.DBService/findAllDBTables"
-	DBService_DeleteDBTable_FullMethodName   = "/pb.DBService/deleteDBTable"
-	DBService_TruncateDBTable_FullMethodName = "/pb.DBService/truncateDBTable"
+	DBService_FindAllDBTables_FullMethodName    = "/pb.DBService/findAllDBTables"
+	DBService_DeleteDBTable_FullMethodName      = "/pb.DBService/deleteDBTable"
+	DBService_TruncateDBTable_FullMethodName    = "/pb.DBService/truncateDBTable"
+	DBService_FindDBIndexAdvices_FullMethodName = "/pb.DBService/findDBIndexAdvices"
+	DBService_CheckDBSchema_FullMethodName      = "/pb.DBService/checkDBSchema"
 )
 
 // DBServiceClient is the client API for DBService service.
@@ -34,6 +36,10 @@ type DBServiceClient interface {
 	DeleteDBTable(ctx context.Context, in *DeleteDBTableRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 	// 清空表
 	TruncateDBTable(ctx context.Context, in *TruncateDBTableRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 检查常用查询的索引
+	FindDBIndexAdvices(ctx context.Context, in *FindDBIndexAdvicesRequest, opts ...grpc.CallOption) (*FindDBIndexAdvicesResponse, error)
+	// 检查数据库表结构和当前版本是否一致
+	CheckDBSchema(ctx context.Context, in *CheckDBSchemaRequest, opts ...grpc.CallOption) (*CheckDBSchemaResponse, error)
 }
 
 type dBServiceClient struct {
@@ -71,6 +77,24 @@ func (c *dBServiceClient) TruncateDBTable(ctx context.Context, in *TruncateDBTab
 	return out, nil
 }
 
+func (c *dBServiceClient) FindDBIndexAdvices(ctx context.Context, in *FindDBIndexAdvicesRequest, opts ...grpc.CallOption) (*FindDBIndexAdvicesResponse, error) {
+	out := new(FindDBIndexAdvicesResponse)
+	err := c.cc.Invoke(ctx, DBService_FindDBIndexAdvices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBServiceClient) CheckDBSchema(ctx context.Context, in *CheckDBSchemaRequest, opts ...grpc.CallOption) (*CheckDBSchemaResponse, error) {
+	out := new(CheckDBSchemaResponse)
+	err := c.cc.Invoke(ctx, DBService_CheckDBSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DBServiceServer is the server API for DBService service.
 // All implementations should embed UnimplementedDBServiceServer
 // for forward compatibility
@@ -81,6 +105,10 @@ type DBServiceServer interface {
 	DeleteDBTable(context.Context, *DeleteDBTableRequest) (*RPCSuccess, error)
 	// 清空表
 	TruncateDBTable(context.Context, *TruncateDBTableRequest) (*RPCSuccess, error)
+	// 检查常用查询的索引
+	FindDBIndexAdvices(context.Context, *FindDBIndexAdvicesRequest) (*FindDBIndexAdvicesResponse, error)
+	// 检查数据库表结构和当前版本是否一致
+	CheckDBSchema(context.Context, *CheckDBSchemaRequest) (*CheckDBSchemaResponse, error)
 }
 
 // UnimplementedDBServiceServer should be embedded to have forward compatible implementations.
@@ -96,6 +124,12 @@ func (UnimplementedDBServiceServer) DeleteDBTable(context.Context, *DeleteDBTabl
 func (UnimplementedDBServiceServer) TruncateDBTable(context.Context, *TruncateDBTableRequest) (*RPCSuccess, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TruncateDBTable not implemented")
 }
+func (UnimplementedDBServiceServer) FindDBIndexAdvices(context.Context, *FindDBIndexAdvicesRequest) (*FindDBIndexAdvicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDBIndexAdvices not implemented")
+}
+func (UnimplementedDBServiceServer) CheckDBSchema(context.Context, *CheckDBSchemaRequest) (*CheckDBSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDBSchema not implemented")
+}
 
 // UnsafeDBServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DBServiceServer will
@@ -162,6 +196,42 @@ func _DBService_TruncateDBTable_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DBService_FindDBIndexAdvices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDBIndexAdvicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBServiceServer).FindDBIndexAdvices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBService_FindDBIndexAdvices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBServiceServer).FindDBIndexAdvices(ctx, req.(*FindDBIndexAdvicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBService_CheckDBSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDBSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBServiceServer).CheckDBSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBService_CheckDBSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBServiceServer).CheckDBSchema(ctx, req.(*CheckDBSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DBService_ServiceDesc is the grpc.ServiceDesc for DBService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +251,14 @@ var DBService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "truncateDBTable",
 			Handler:    _DBService_TruncateDBTable_Handler,
 		},
+		{
+			MethodName: "findDBIndexAdvices",
+			Handler:    _DBService_FindDBIndexAdvices_Handler,
+		},
+		{
+			MethodName: "checkDBSchema",
+			Handler:    _DBService_CheckDBSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_db.proto",
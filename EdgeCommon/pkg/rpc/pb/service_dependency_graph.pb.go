@@ -0,0 +1,461 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_dependency_graph.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 查找某个对象周围的依赖关系图
+type FindDependencyGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjectType string `protobuf:"bytes,1,opt,name=objectType,proto3" json:"objectType,omitempty"` // 对象类型：server, serverGroup, user, sslPolicy, sslCert, acmeTask, acmeUser, dnsProvider, dnsDomain, nodeCluster, node, httpFirewallPolicy, httpCachePolicy
+	ObjectId   int64  `protobuf:"varint,2,opt,name=objectId,proto3" json:"objectId,omitempty"`    // 对象ID
+	Depth      int32  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`          // 展开深度，默认为2，最大为5
+	MaxNodes   int32  `protobuf:"varint,4,opt,name=maxNodes,proto3" json:"maxNodes,omitempty"`    // 最多返回的对象数量，默认为200，最大为2000
+}
+
+func (x *FindDependencyGraphRequest) Reset() {
+	*x = FindDependencyGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dependency_graph_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDependencyGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDependencyGraphRequest) ProtoMessage() {}
+
+func (x *FindDependencyGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dependency_graph_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDependencyGraphRequest.ProtoReflect.Descriptor instead.
+func (*FindDependencyGraphRequest) Descriptor() ([]byte, []int) {
+	return file_service_dependency_graph_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FindDependencyGraphRequest) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *FindDependencyGraphRequest) GetObjectId() int64 {
+	if x != nil {
+		return x.ObjectId
+	}
+	return 0
+}
+
+func (x *FindDependencyGraphRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *FindDependencyGraphRequest) GetMaxNodes() int32 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+type FindDependencyGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes       []*FindDependencyGraphResponse_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`              // 对象
+	Edges       []*FindDependencyGraphResponse_Edge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`              // 依赖关系
+	IsTruncated bool                                `protobuf:"varint,3,opt,name=isTruncated,proto3" json:"isTruncated,omitempty"` // 是否因为数量限制没有完全展开
+}
+
+func (x *FindDependencyGraphResponse) Reset() {
+	*x = FindDependencyGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dependency_graph_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDependencyGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDependencyGraphResponse) ProtoMessage() {}
+
+func (x *FindDependencyGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dependency_graph_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDependencyGraphResponse.ProtoReflect.Descriptor instead.
+func (*FindDependencyGraphResponse) Descriptor() ([]byte, []int) {
+	return file_service_dependency_graph_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FindDependencyGraphResponse) GetNodes() []*FindDependencyGraphResponse_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *FindDependencyGraphResponse) GetEdges() []*FindDependencyGraphResponse_Edge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *FindDependencyGraphResponse) GetIsTruncated() bool {
+	if x != nil {
+		return x.IsTruncated
+	}
+	return false
+}
+
+type FindDependencyGraphResponse_Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`              // 唯一标识，格式为 类型:ID
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`            // 对象类型
+	Id        int64  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`               // 对象ID
+	Name      string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`            // 对象名称
+	Depth     int32  `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`         // 和查询对象的距离
+	IsMissing bool   `protobuf:"varint,6,opt,name=isMissing,proto3" json:"isMissing,omitempty"` // 对象是否已被删除或不存在
+}
+
+func (x *FindDependencyGraphResponse_Node) Reset() {
+	*x = FindDependencyGraphResponse_Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dependency_graph_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDependencyGraphResponse_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDependencyGraphResponse_Node) ProtoMessage() {}
+
+func (x *FindDependencyGraphResponse_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dependency_graph_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDependencyGraphResponse_Node.ProtoReflect.Descriptor instead.
+func (*FindDependencyGraphResponse_Node) Descriptor() ([]byte, []int) {
+	return file_service_dependency_graph_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *FindDependencyGraphResponse_Node) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FindDependencyGraphResponse_Node) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FindDependencyGraphResponse_Node) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FindDependencyGraphResponse_Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FindDependencyGraphResponse_Node) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *FindDependencyGraphResponse_Node) GetIsMissing() bool {
+	if x != nil {
+		return x.IsMissing
+	}
+	return false
+}
+
+type FindDependencyGraphResponse_Edge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From     string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`         // 依赖方对象标识
+	To       string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`             // 被依赖方对象标识
+	Relation string `protobuf:"bytes,3,opt,name=relation,proto3" json:"relation,omitempty"` // 关系：usesCluster, ownedBy, inGroup, usesSSLPolicy, usesCert, issuedBy, usesACMEUser, usesDNSProvider, usesDNSAliasProvider, usesDNSDomain, belongsToCluster, usesFirewallPolicy, usesCachePolicy
+}
+
+func (x *FindDependencyGraphResponse_Edge) Reset() {
+	*x = FindDependencyGraphResponse_Edge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dependency_graph_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDependencyGraphResponse_Edge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDependencyGraphResponse_Edge) ProtoMessage() {}
+
+func (x *FindDependencyGraphResponse_Edge) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dependency_graph_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDependencyGraphResponse_Edge.ProtoReflect.Descriptor instead.
+func (*FindDependencyGraphResponse_Edge) Descriptor() ([]byte, []int) {
+	return file_service_dependency_graph_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *FindDependencyGraphResponse_Edge) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *FindDependencyGraphResponse_Edge) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *FindDependencyGraphResponse_Edge) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+var File_service_dependency_graph_proto protoreflect.FileDescriptor
+
+var file_service_dependency_graph_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x22, 0x8a, 0x01, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x22, 0x86, 0x03, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3a, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3a, 0x0a,
+	0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70,
+	0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x64,
+	0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x54,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x69, 0x73, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x1a, 0x84, 0x01, 0x0a, 0x04,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x1a, 0x46, 0x0a, 0x04, 0x45, 0x64, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x70, 0x0a, 0x16, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x66, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x1e, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04,
+	0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_dependency_graph_proto_rawDescOnce sync.Once
+	file_service_dependency_graph_proto_rawDescData = file_service_dependency_graph_proto_rawDesc
+)
+
+func file_service_dependency_graph_proto_rawDescGZIP() []byte {
+	file_service_dependency_graph_proto_rawDescOnce.Do(func() {
+		file_service_dependency_graph_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_dependency_graph_proto_rawDescData)
+	})
+	return file_service_dependency_graph_proto_rawDescData
+}
+
+var file_service_dependency_graph_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_service_dependency_graph_proto_goTypes = []interface{}{
+	(*FindDependencyGraphRequest)(nil),       // 0: pb.FindDependencyGraphRequest
+	(*FindDependencyGraphResponse)(nil),      // 1: pb.FindDependencyGraphResponse
+	(*FindDependencyGraphResponse_Node)(nil), // 2: pb.FindDependencyGraphResponse.Node
+	(*FindDependencyGraphResponse_Edge)(nil), // 3: pb.FindDependencyGraphResponse.Edge
+}
+var file_service_dependency_graph_proto_depIdxs = []int32{
+	2, // 0: pb.FindDependencyGraphResponse.nodes:type_name -> pb.FindDependencyGraphResponse.Node
+	3, // 1: pb.FindDependencyGraphResponse.edges:type_name -> pb.FindDependencyGraphResponse.Edge
+	0, // 2: pb.DependencyGraphService.findDependencyGraph:input_type -> pb.FindDependencyGraphRequest
+	1, // 3: pb.DependencyGraphService.findDependencyGraph:output_type -> pb.FindDependencyGraphResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_service_dependency_graph_proto_init() }
+func file_service_dependency_graph_proto_init() {
+	if File_service_dependency_graph_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_service_dependency_graph_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDependencyGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_dependency_graph_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDependencyGraphResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_dependency_graph_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDependencyGraphResponse_Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_dependency_graph_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDependencyGraphResponse_Edge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_dependency_graph_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_dependency_graph_proto_goTypes,
+		DependencyIndexes: file_service_dependency_graph_proto_depIdxs,
+		MessageInfos:      file_service_dependency_graph_proto_msgTypes,
+	}.Build()
+	File_service_dependency_graph_proto = out.File
+	file_service_dependency_graph_proto_rawDesc = nil
+	file_service_dependency_graph_proto_goTypes = nil
+	file_service_dependency_graph_proto_depIdxs = nil
+}
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_dependency_graph.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DependencyGraphService_FindDependencyGraph_FullMethodName = "/pb.DependencyGraphService/findDependencyGraph"
+)
+
+// DependencyGraphServiceClient is the client API for DependencyGraphService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DependencyGraphServiceClient interface {
+	// 查找某个对象周围的依赖关系图
+	FindDependencyGraph(ctx context.Context, in *FindDependencyGraphRequest, opts ...grpc.CallOption) (*FindDependencyGraphResponse, error)
+}
+
+type dependencyGraphServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDependencyGraphServiceClient(cc grpc.ClientConnInterface) DependencyGraphServiceClient {
+	return &dependencyGraphServiceClient{cc}
+}
+
+func (c *dependencyGraphServiceClient) FindDependencyGraph(ctx context.Context, in *FindDependencyGraphRequest, opts ...grpc.CallOption) (*FindDependencyGraphResponse, error) {
+	out := new(FindDependencyGraphResponse)
+	err := c.cc.Invoke(ctx, DependencyGraphService_FindDependencyGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DependencyGraphServiceServer is the server API for DependencyGraphService service.
+// All implementations should embed UnimplementedDependencyGraphServiceServer
+// for forward compatibility
+type DependencyGraphServiceServer interface {
+	// 查找某个对象周围的依赖关系图
+	FindDependencyGraph(context.Context, *FindDependencyGraphRequest) (*FindDependencyGraphResponse, error)
+}
+
+// UnimplementedDependencyGraphServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedDependencyGraphServiceServer struct {
+}
+
+func (UnimplementedDependencyGraphServiceServer) FindDependencyGraph(context.Context, *FindDependencyGraphRequest) (*FindDependencyGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDependencyGraph not implemented")
+}
+
+// UnsafeDependencyGraphServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DependencyGraphServiceServer will
+// result in compilation errors.
+type UnsafeDependencyGraphServiceServer interface {
+	mustEmbedUnimplementedDependencyGraphServiceServer()
+}
+
+func RegisterDependencyGraphServiceServer(s grpc.ServiceRegistrar, srv DependencyGraphServiceServer) {
+	s.RegisterService(&DependencyGraphService_ServiceDesc, srv)
+}
+
+func _DependencyGraphService_FindDependencyGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDependencyGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyGraphServiceServer).FindDependencyGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyGraphService_FindDependencyGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyGraphServiceServer).FindDependencyGraph(ctx, req.(*FindDependencyGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DependencyGraphService_ServiceDesc is the grpc.ServiceDesc for DependencyGraphService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DependencyGraphService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.DependencyGraphService",
+	HandlerType: (*DependencyGraphServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "findDependencyGraph",
+			Handler:    _DependencyGraphService_FindDependencyGraph_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_dependency_graph.proto",
+}
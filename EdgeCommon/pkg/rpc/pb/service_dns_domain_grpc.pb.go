@@ -34,6 +34,8 @@ const (
 	DNSDomainService_ExistAvailableDomains_FullMethodName                   = "/pb.DNSDomainService/existAvailableDomains"
 	DNSDomainService_ExistDNSDomainRecord_FullMethodName                    = "/pb.DNSDomainService/existDNSDomainRecord"
 	DNSDomainService_SyncDNSDomainsFromProvider_FullMethodName              = "/pb.DNSDomainService/syncDNSDomainsFromProvider"
+	DNSDomainService_BatchCreateDNSRecords_FullMethodName                   = "/pb.DNSDomainService/batchCreateDNSRecords"
+	DNSDomainService_BatchDeleteDNSRecords_FullMethodName                   = "/pb.DNSDomainService/batchDeleteDNSRecords"
 )
 
 // DNSDomainServiceClient is the client API for DNSDomainService service.
@@ -70,6 +72,10 @@ type DNSDomainServiceClient interface {
 	ExistDNSDomainRecord(ctx context.Context, in *ExistDNSDomainRecordRequest, opts ...grpc.CallOption) (*ExistDNSDomainRecordResponse, error)
 	// 从服务商同步域名
 	SyncDNSDomainsFromProvider(ctx context.Context, in *SyncDNSDomainsFromProviderRequest, opts ...grpc.CallOption) (*SyncDNSDomainsFromProviderResponse, error)
+	// 批量创建域名解析记录
+	BatchCreateDNSRecords(ctx context.Context, in *BatchCreateDNSRecordsRequest, opts ...grpc.CallOption) (*BatchCreateDNSRecordsResponse, error)
+	// 批量删除域名解析记录
+	BatchDeleteDNSRecords(ctx context.Context, in *BatchDeleteDNSRecordsRequest, opts ...grpc.CallOption) (*BatchDeleteDNSRecordsResponse, error)
 }
 
 type dNSDomainServiceClient struct {
@@ -215,6 +221,24 @@ func (c *dNSDomainServiceClient) SyncDNSDomainsFromProvider(ctx context.Context,
 	return out, nil
 }
 
+func (c *dNSDomainServiceClient) BatchCreateDNSRecords(ctx context.Context, in *BatchCreateDNSRecordsRequest, opts ...grpc.CallOption) (*BatchCreateDNSRecordsResponse, error) {
+	out := new(BatchCreateDNSRecordsResponse)
+	err := c.cc.Invoke(ctx, DNSDomainService_BatchCreateDNSRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dNSDomainServiceClient) BatchDeleteDNSRecords(ctx context.Context, in *BatchDeleteDNSRecordsRequest, opts ...grpc.CallOption) (*BatchDeleteDNSRecordsResponse, error) {
+	out := new(BatchDeleteDNSRecordsResponse)
+	err := c.cc.Invoke(ctx, DNSDomainService_BatchDeleteDNSRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DNSDomainServiceServer is the server API for DNSDomainService service.
 // All implementations should embed UnimplementedDNSDomainServiceServer
 // for forward compatibility
@@ -249,6 +273,10 @@ type DNSDomainServiceServer interface {
 	ExistDNSDomainRecord(context.Context, *ExistDNSDomainRecordRequest) (*ExistDNSDomainRecordResponse, error)
 	// 从服务商同步域名
 	SyncDNSDomainsFromProvider(context.Context, *SyncDNSDomainsFromProviderRequest) (*SyncDNSDomainsFromProviderResponse, error)
+	// 批量创建域名解析记录
+	BatchCreateDNSRecords(context.Context, *BatchCreateDNSRecordsRequest) (*BatchCreateDNSRecordsResponse, error)
+	// 批量删除域名解析记录
+	BatchDeleteDNSRecords(context.Context, *BatchDeleteDNSRecordsRequest) (*BatchDeleteDNSRecordsResponse, error)
 }
 
 // UnimplementedDNSDomainServiceServer should be embedded to have forward compatible implementations.
@@ -300,6 +328,12 @@ func (UnimplementedDNSDomainServiceServer) ExistDNSDomainRecord(context.Context,
 func (UnimplementedDNSDomainServiceServer) SyncDNSDomainsFromProvider(context.Context, *SyncDNSDomainsFromProviderRequest) (*SyncDNSDomainsFromProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncDNSDomainsFromProvider not implemented")
 }
+func (UnimplementedDNSDomainServiceServer) BatchCreateDNSRecords(context.Context, *BatchCreateDNSRecordsRequest) (*BatchCreateDNSRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateDNSRecords not implemented")
+}
+func (UnimplementedDNSDomainServiceServer) BatchDeleteDNSRecords(context.Context, *BatchDeleteDNSRecordsRequest) (*BatchDeleteDNSRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteDNSRecords not implemented")
+}
 
 // UnsafeDNSDomainServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DNSDomainServiceServer will
@@ -582,6 +616,42 @@ func _DNSDomainService_SyncDNSDomainsFromProvider_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DNSDomainService_BatchCreateDNSRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateDNSRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DNSDomainServiceServer).BatchCreateDNSRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DNSDomainService_BatchCreateDNSRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DNSDomainServiceServer).BatchCreateDNSRecords(ctx, req.(*BatchCreateDNSRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DNSDomainService_BatchDeleteDNSRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteDNSRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DNSDomainServiceServer).BatchDeleteDNSRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DNSDomainService_BatchDeleteDNSRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DNSDomainServiceServer).BatchDeleteDNSRecords(ctx, req.(*BatchDeleteDNSRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DNSDomainService_ServiceDesc is the grpc.ServiceDesc for DNSDomainService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -649,6 +719,14 @@ var DNSDomainService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "syncDNSDomainsFromProvider",
 			Handler:    _DNSDomainService_SyncDNSDomainsFromProvider_Handler,
 		},
+		{
+			MethodName: "batchCreateDNSRecords",
+			Handler:    _DNSDomainService_BatchCreateDNSRecords_Handler,
+		},
+		{
+			MethodName: "batchDeleteDNSRecords",
+			Handler:    _DNSDomainService_BatchDeleteDNSRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_dns_domain.proto",
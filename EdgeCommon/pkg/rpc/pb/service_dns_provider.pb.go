@@ -29,7 +29,8 @@ type CreateDNSProviderRequest struct {
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	ApiParamsJSON []byte `protobuf:"bytes,3,opt,name=apiParamsJSON,proto3" json:"apiParamsJSON,omitempty"`
-	MinTTL        int32  `protobuf:"varint,4,opt,name=minTTL,proto3" json:"minTTL,omitempty"` // 最小TTL
+	MinTTL        int32  `protobuf:"varint,4,opt,name=minTTL,proto3" json:"minTTL,omitempty"`        // 最小TTL
+	ExternalId    string `protobuf:"bytes,5,opt,name=externalId,proto3" json:"externalId,omitempty"` // 可选项，外部ID，由调用方指定的稳定标识，同一个外部ID只能被一个服务商使用
 }
 
 func (x *CreateDNSProviderRequest) Reset() {
@@ -92,6 +93,13 @@ func (x *CreateDNSProviderRequest) GetMinTTL() int32 {
 	return 0
 }
 
+func (x *CreateDNSProviderRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
 type CreateDNSProviderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -932,6 +940,490 @@ func (x *FindAllEnabledDNSProvidersWithTypeResponse) GetDnsProviders() []*DNSPro
 	return nil
 }
 
+// 查找服务商API调用统计和配额
+type FindDNSProviderCallStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DnsProviderId int64 `protobuf:"varint,1,opt,name=dnsProviderId,proto3" json:"dnsProviderId,omitempty"`
+	Days          int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // 最近N天，默认为7天
+}
+
+func (x *FindDNSProviderCallStatsRequest) Reset() {
+	*x = FindDNSProviderCallStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dns_provider_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDNSProviderCallStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDNSProviderCallStatsRequest) ProtoMessage() {}
+
+func (x *FindDNSProviderCallStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dns_provider_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDNSProviderCallStatsRequest.ProtoReflect.Descriptor instead.
+func (*FindDNSProviderCallStatsRequest) Descriptor() ([]byte, []int) {
+	return file_service_dns_provider_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FindDNSProviderCallStatsRequest) GetDnsProviderId() int64 {
+	if x != nil {
+		return x.DnsProviderId
+	}
+	return 0
+}
+
+func (x *FindDNSProviderCallStatsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type FindDNSProviderCallStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DnsProviderCallStats []*DNSProviderCallStat `protobuf:"bytes,1,rep,name=dnsProviderCallStats,proto3" json:"dnsProviderCallStats,omitempty"`
+	MaxCallsPerDay       int64                  `protobuf:"varint,2,opt,name=maxCallsPerDay,proto3" json:"maxCallsPerDay,omitempty"`         // 每天最多调用次数，0表示不限制
+	MaxCallsPerSecond    int32                  `protobuf:"varint,3,opt,name=maxCallsPerSecond,proto3" json:"maxCallsPerSecond,omitempty"`   // 每秒最多调用次数，0表示不限制
+	CountTodayCalls      int64                  `protobuf:"varint,4,opt,name=countTodayCalls,proto3" json:"countTodayCalls,omitempty"`       // 今天已调用次数
+	IsApproachingQuota   bool                   `protobuf:"varint,5,opt,name=isApproachingQuota,proto3" json:"isApproachingQuota,omitempty"` // 是否接近配额上限
+}
+
+func (x *FindDNSProviderCallStatsResponse) Reset() {
+	*x = FindDNSProviderCallStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dns_provider_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDNSProviderCallStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDNSProviderCallStatsResponse) ProtoMessage() {}
+
+func (x *FindDNSProviderCallStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dns_provider_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDNSProviderCallStatsResponse.ProtoReflect.Descriptor instead.
+func (*FindDNSProviderCallStatsResponse) Descriptor() ([]byte, []int) {
+	return file_service_dns_provider_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FindDNSProviderCallStatsResponse) GetDnsProviderCallStats() []*DNSProviderCallStat {
+	if x != nil {
+		return x.DnsProviderCallStats
+	}
+	return nil
+}
+
+func (x *FindDNSProviderCallStatsResponse) GetMaxCallsPerDay() int64 {
+	if x != nil {
+		return x.MaxCallsPerDay
+	}
+	return 0
+}
+
+func (x *FindDNSProviderCallStatsResponse) GetMaxCallsPerSecond() int32 {
+	if x != nil {
+		return x.MaxCallsPerSecond
+	}
+	return 0
+}
+
+func (x *FindDNSProviderCallStatsResponse) GetCountTodayCalls() int64 {
+	if x != nil {
+		return x.CountTodayCalls
+	}
+	return 0
+}
+
+func (x *FindDNSProviderCallStatsResponse) GetIsApproachingQuota() bool {
+	if x != nil {
+		return x.IsApproachingQuota
+	}
+	return false
+}
+
+// 查找接近配额上限的服务商
+type FindAllDNSProviderQuotaWarningsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindAllDNSProviderQuotaWarningsRequest) Reset() {
+	*x = FindAllDNSProviderQuotaWarningsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dns_provider_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllDNSProviderQuotaWarningsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllDNSProviderQuotaWarningsRequest) ProtoMessage() {}
+
+func (x *FindAllDNSProviderQuotaWarningsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dns_provider_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllDNSProviderQuotaWarningsRequest.ProtoReflect.Descriptor instead.
+func (*FindAllDNSProviderQuotaWarningsRequest) Descriptor() ([]byte, []int) {
+	return file_service_dns_provider_proto_rawDescGZIP(), []int{18}
+}
+
+type FindAllDNSProviderQuotaWarningsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DnsProviderQuotaWarnings []*FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning `protobuf:"bytes,1,rep,name=dnsProviderQuotaWarnings,proto3" json:"dnsProviderQuotaWarnings,omitempty"`
+}
+
+func (x *FindAllDNSProviderQuotaWarningsResponse) Reset() {
+	*x = FindAllDNSProviderQuotaWarningsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dns_provider_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllDNSProviderQuotaWarningsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllDNSProviderQuotaWarningsResponse) ProtoMessage() {}
+
+func (x *FindAllDNSProviderQuotaWarningsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dns_provider_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllDNSProviderQuotaWarningsResponse.ProtoReflect.Descriptor instead.
+func (*FindAllDNSProviderQuotaWarningsResponse) Descriptor() ([]byte, []int) {
+	return file_service_dns_provider_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FindAllDNSProviderQuotaWarningsResponse) GetDnsProviderQuotaWarnings() []*FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning {
+	if x != nil {
+		return x.DnsProviderQuotaWarnings
+	}
+	return nil
+}
+
+// 查找服务商
+// 数据总是从主库读取，创建或修改后可以立即查到最新的数据
+type LookupDNSProviderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DnsProviderId int64  `protobuf:"varint,1,opt,name=dnsProviderId,proto3" json:"dnsProviderId,omitempty"` // 可选项，服务商ID
+	ExternalId    string `protobuf:"bytes,2,opt,name=externalId,proto3" json:"externalId,omitempty"`        // 可选项，外部ID
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                    // 可选项，服务商名称，有多个同名服务商时返回错误
+}
+
+func (x *LookupDNSProviderRequest) Reset() {
+	*x = LookupDNSProviderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dns_provider_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupDNSProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupDNSProviderRequest) ProtoMessage() {}
+
+func (x *LookupDNSProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dns_provider_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupDNSProviderRequest.ProtoReflect.Descriptor instead.
+func (*LookupDNSProviderRequest) Descriptor() ([]byte, []int) {
+	return file_service_dns_provider_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LookupDNSProviderRequest) GetDnsProviderId() int64 {
+	if x != nil {
+		return x.DnsProviderId
+	}
+	return 0
+}
+
+func (x *LookupDNSProviderRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *LookupDNSProviderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LookupDNSProviderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DnsProviderId int64  `protobuf:"varint,1,opt,name=dnsProviderId,proto3" json:"dnsProviderId,omitempty"` // 服务商ID，找不到时为0
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                    // 服务商名称
+	Type          string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                    // 服务商类型
+	ExternalId    string `protobuf:"bytes,4,opt,name=externalId,proto3" json:"externalId,omitempty"`        // 外部ID
+}
+
+func (x *LookupDNSProviderResponse) Reset() {
+	*x = LookupDNSProviderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dns_provider_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupDNSProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupDNSProviderResponse) ProtoMessage() {}
+
+func (x *LookupDNSProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dns_provider_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupDNSProviderResponse.ProtoReflect.Descriptor instead.
+func (*LookupDNSProviderResponse) Descriptor() ([]byte, []int) {
+	return file_service_dns_provider_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LookupDNSProviderResponse) GetDnsProviderId() int64 {
+	if x != nil {
+		return x.DnsProviderId
+	}
+	return 0
+}
+
+func (x *LookupDNSProviderResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LookupDNSProviderResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LookupDNSProviderResponse) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+// 设置服务商外部ID
+type UpdateDNSProviderExternalIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DnsProviderId int64  `protobuf:"varint,1,opt,name=dnsProviderId,proto3" json:"dnsProviderId,omitempty"` // 服务商ID
+	ExternalId    string `protobuf:"bytes,2,opt,name=externalId,proto3" json:"externalId,omitempty"`        // 外部ID，为空表示清除
+}
+
+func (x *UpdateDNSProviderExternalIdRequest) Reset() {
+	*x = UpdateDNSProviderExternalIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dns_provider_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDNSProviderExternalIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDNSProviderExternalIdRequest) ProtoMessage() {}
+
+func (x *UpdateDNSProviderExternalIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dns_provider_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDNSProviderExternalIdRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDNSProviderExternalIdRequest) Descriptor() ([]byte, []int) {
+	return file_service_dns_provider_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateDNSProviderExternalIdRequest) GetDnsProviderId() int64 {
+	if x != nil {
+		return x.DnsProviderId
+	}
+	return 0
+}
+
+func (x *UpdateDNSProviderExternalIdRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DnsProvider    *DNSProvider `protobuf:"bytes,1,opt,name=dnsProvider,proto3" json:"dnsProvider,omitempty"`
+	CountCalls     int64        `protobuf:"varint,2,opt,name=countCalls,proto3" json:"countCalls,omitempty"`         // 今天已调用次数
+	MaxCallsPerDay int64        `protobuf:"varint,3,opt,name=maxCallsPerDay,proto3" json:"maxCallsPerDay,omitempty"` // 每天最多调用次数
+	Percent        float32      `protobuf:"fixed32,4,opt,name=percent,proto3" json:"percent,omitempty"`              // 已使用百分比
+}
+
+func (x *FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning) Reset() {
+	*x = FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_dns_provider_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning) ProtoMessage() {}
+
+func (x *FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_service_dns_provider_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning.ProtoReflect.Descriptor instead.
+func (*FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning) Descriptor() ([]byte, []int) {
+	return file_service_dns_provider_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning) GetDnsProvider() *DNSProvider {
+	if x != nil {
+		return x.DnsProvider
+	}
+	return nil
+}
+
+func (x *FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning) GetCountCalls() int64 {
+	if x != nil {
+		return x.CountCalls
+	}
+	return 0
+}
+
+func (x *FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning) GetMaxCallsPerDay() int64 {
+	if x != nil {
+		return x.MaxCallsPerDay
+	}
+	return 0
+}
+
+func (x *FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning) GetPercent() float32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
 var File_service_dns_provider_proto protoreflect.FileDescriptor
 
 var file_service_dns_provider_proto_rawDesc = []byte{
@@ -940,110 +1432,186 @@ var file_service_dns_provider_proto_rawDesc = []byte{
 	0x1a, 0x19, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x6d, 0x6f, 0x64,
 	0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x64, 0x6e, 0x73, 0x5f, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a,
-	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x53,
-	0x4f, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x70, 0x69, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x54, 0x54,
-	0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x54, 0x54, 0x4c, 0x22,
-	0x41, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d,
-	0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4e, 0x53,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x69,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0d, 0x61, 0x70, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12,
-	0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x54, 0x54, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x6d, 0x69, 0x6e, 0x54, 0x54, 0x4c, 0x22, 0x9c, 0x01, 0x0a, 0x22, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x29, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x64, 0x6e, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x61, 0x70, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x70, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x53,
+	0x4f, 0x4e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x54, 0x54, 0x4c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x54, 0x54, 0x4c, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x19, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x92, 0x01,
+	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6e,
+	0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x70, 0x69,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69,
+	0x6e, 0x54, 0x54, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x54,
+	0x54, 0x4c, 0x22, 0x9c, 0x01, 0x0a, 0x22, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45,
 	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
 	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d,
 	0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69,
 	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6b,
-	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
 	0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x56, 0x0a,
-	0x1f, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x33, 0x0a, 0x0c, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0xc4, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x56, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74,
 	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x22,
-	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e,
-	0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e,
-	0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x64, 0x6e, 0x73, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x40, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6e, 0x73, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x1d, 0x46, 0x69, 0x6e,
-	0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6e,
-	0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x22, 0x53, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44,
-	0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x20, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
-	0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64, 0x41,
-	0x6c, 0x6c, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x0f, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x29, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
-	0x57, 0x69, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2a, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x61, 0x0a, 0x2a, 0x46,
-	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x64, 0x6e, 0x73,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x52, 0x0c, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x32, 0xe5,
-	0x06, 0x0a, 0x12, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x64,
+	0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x52, 0x0c, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0x55, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x22, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x0c, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x22, 0x40, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x4e, 0x53, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6e,
+	0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d,
+	0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x6d, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x53, 0x0a, 0x1e, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x0b, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x0b, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x22, 0x20, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x4e, 0x53, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x5c, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x4e, 0x53,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x22, 0x5b, 0x0a, 0x0f, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a,
+	0x29, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44,
+	0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x61, 0x0a, 0x2a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x64, 0x6e, 0x73,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x5b, 0x0a, 0x1f, 0x46, 0x69, 0x6e,
+	0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x44,
+	0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x14, 0x64,
+	0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x52, 0x14, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43,
+	0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x43,
+	0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79,
+	0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x78,
+	0x43, 0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x28,
+	0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x43, 0x61, 0x6c, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f,
+	0x64, 0x61, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x73, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x28, 0x0a, 0x26, 0x46, 0x69, 0x6e, 0x64,
+	0x41, 0x6c, 0x6c, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xdb, 0x02, 0x0a, 0x27, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x4e,
+	0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f,
+	0x0a, 0x18, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x43, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x4e, 0x53,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x4e,
+	0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x18, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x1a,
+	0xae, 0x01, 0x0a, 0x17, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x0b, 0x64,
+	0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x0b, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x73,
+	0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x22, 0x74, 0x0a, 0x18, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x19, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6e, 0x73,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x64, 0x22, 0x6a, 0x0a, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4e, 0x53, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6e, 0x73, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x64, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x32, 0xf1,
+	0x09, 0x0a, 0x12, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
 	0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
@@ -1097,8 +1665,33 @@ var file_service_dns_provider_proto_rawDesc = []byte{
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
 	0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f,
 	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x18, 0x66, 0x69, 0x6e, 0x64, 0x44, 0x4e,
+	0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x4e, 0x53, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a,
+	0x1f, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x2a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x4e, 0x53,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70,
+	0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x6c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x1b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x26, 0x2e, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1113,57 +1706,77 @@ func file_service_dns_provider_proto_rawDescGZIP() []byte {
 	return file_service_dns_provider_proto_rawDescData
 }
 
-var file_service_dns_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_service_dns_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_service_dns_provider_proto_goTypes = []interface{}{
-	(*CreateDNSProviderRequest)(nil),                   // 0: pb.CreateDNSProviderRequest
-	(*CreateDNSProviderResponse)(nil),                  // 1: pb.CreateDNSProviderResponse
-	(*UpdateDNSProviderRequest)(nil),                   // 2: pb.UpdateDNSProviderRequest
-	(*CountAllEnabledDNSProvidersRequest)(nil),         // 3: pb.CountAllEnabledDNSProvidersRequest
-	(*ListEnabledDNSProvidersRequest)(nil),             // 4: pb.ListEnabledDNSProvidersRequest
-	(*ListEnabledDNSProvidersResponse)(nil),            // 5: pb.ListEnabledDNSProvidersResponse
-	(*FindAllEnabledDNSProvidersRequest)(nil),          // 6: pb.FindAllEnabledDNSProvidersRequest
-	(*FindAllEnabledDNSProvidersResponse)(nil),         // 7: pb.FindAllEnabledDNSProvidersResponse
-	(*DeleteDNSProviderRequest)(nil),                   // 8: pb.DeleteDNSProviderRequest
-	(*FindEnabledDNSProviderRequest)(nil),              // 9: pb.FindEnabledDNSProviderRequest
-	(*FindEnabledDNSProviderResponse)(nil),             // 10: pb.FindEnabledDNSProviderResponse
-	(*FindAllDNSProviderTypesRequest)(nil),             // 11: pb.FindAllDNSProviderTypesRequest
-	(*FindAllDNSProviderTypesResponse)(nil),            // 12: pb.FindAllDNSProviderTypesResponse
-	(*DNSProviderType)(nil),                            // 13: pb.DNSProviderType
-	(*FindAllEnabledDNSProvidersWithTypeRequest)(nil),  // 14: pb.FindAllEnabledDNSProvidersWithTypeRequest
-	(*FindAllEnabledDNSProvidersWithTypeResponse)(nil), // 15: pb.FindAllEnabledDNSProvidersWithTypeResponse
-	(*DNSProvider)(nil),                                // 16: pb.DNSProvider
-	(*RPCSuccess)(nil),                                 // 17: pb.RPCSuccess
-	(*RPCCountResponse)(nil),                           // 18: pb.RPCCountResponse
+	(*CreateDNSProviderRequest)(nil),                                        // 0: pb.CreateDNSProviderRequest
+	(*CreateDNSProviderResponse)(nil),                                       // 1: pb.CreateDNSProviderResponse
+	(*UpdateDNSProviderRequest)(nil),                                        // 2: pb.UpdateDNSProviderRequest
+	(*CountAllEnabledDNSProvidersRequest)(nil),                              // 3: pb.CountAllEnabledDNSProvidersRequest
+	(*ListEnabledDNSProvidersRequest)(nil),                                  // 4: pb.ListEnabledDNSProvidersRequest
+	(*ListEnabledDNSProvidersResponse)(nil),                                 // 5: pb.ListEnabledDNSProvidersResponse
+	(*FindAllEnabledDNSProvidersRequest)(nil),                               // 6: pb.FindAllEnabledDNSProvidersRequest
+	(*FindAllEnabledDNSProvidersResponse)(nil),                              // 7: pb.FindAllEnabledDNSProvidersResponse
+	(*DeleteDNSProviderRequest)(nil),                                        // 8: pb.DeleteDNSProviderRequest
+	(*FindEnabledDNSProviderRequest)(nil),                                   // 9: pb.FindEnabledDNSProviderRequest
+	(*FindEnabledDNSProviderResponse)(nil),                                  // 10: pb.FindEnabledDNSProviderResponse
+	(*FindAllDNSProviderTypesRequest)(nil),                                  // 11: pb.FindAllDNSProviderTypesRequest
+	(*FindAllDNSProviderTypesResponse)(nil),                                 // 12: pb.FindAllDNSProviderTypesResponse
+	(*DNSProviderType)(nil),                                                 // 13: pb.DNSProviderType
+	(*FindAllEnabledDNSProvidersWithTypeRequest)(nil),                       // 14: pb.FindAllEnabledDNSProvidersWithTypeRequest
+	(*FindAllEnabledDNSProvidersWithTypeResponse)(nil),                      // 15: pb.FindAllEnabledDNSProvidersWithTypeResponse
+	(*FindDNSProviderCallStatsRequest)(nil),                                 // 16: pb.FindDNSProviderCallStatsRequest
+	(*FindDNSProviderCallStatsResponse)(nil),                                // 17: pb.FindDNSProviderCallStatsResponse
+	(*FindAllDNSProviderQuotaWarningsRequest)(nil),                          // 18: pb.FindAllDNSProviderQuotaWarningsRequest
+	(*FindAllDNSProviderQuotaWarningsResponse)(nil),                         // 19: pb.FindAllDNSProviderQuotaWarningsResponse
+	(*LookupDNSProviderRequest)(nil),                                        // 20: pb.LookupDNSProviderRequest
+	(*LookupDNSProviderResponse)(nil),                                       // 21: pb.LookupDNSProviderResponse
+	(*UpdateDNSProviderExternalIdRequest)(nil),                              // 22: pb.UpdateDNSProviderExternalIdRequest
+	(*FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning)(nil), // 23: pb.FindAllDNSProviderQuotaWarningsResponse.DNSProviderQuotaWarning
+	(*DNSProvider)(nil),                                                     // 24: pb.DNSProvider
+	(*DNSProviderCallStat)(nil),                                             // 25: pb.DNSProviderCallStat
+	(*RPCSuccess)(nil),                                                      // 26: pb.RPCSuccess
+	(*RPCCountResponse)(nil),                                                // 27: pb.RPCCountResponse
 }
 var file_service_dns_provider_proto_depIdxs = []int32{
-	16, // 0: pb.ListEnabledDNSProvidersResponse.dnsProviders:type_name -> pb.DNSProvider
-	16, // 1: pb.FindAllEnabledDNSProvidersResponse.dnsProviders:type_name -> pb.DNSProvider
-	16, // 2: pb.FindEnabledDNSProviderResponse.dnsProvider:type_name -> pb.DNSProvider
+	24, // 0: pb.ListEnabledDNSProvidersResponse.dnsProviders:type_name -> pb.DNSProvider
+	24, // 1: pb.FindAllEnabledDNSProvidersResponse.dnsProviders:type_name -> pb.DNSProvider
+	24, // 2: pb.FindEnabledDNSProviderResponse.dnsProvider:type_name -> pb.DNSProvider
 	13, // 3: pb.FindAllDNSProviderTypesResponse.providerTypes:type_name -> pb.DNSProviderType
-	16, // 4: pb.FindAllEnabledDNSProvidersWithTypeResponse.dnsProviders:type_name -> pb.DNSProvider
-	0,  // 5: pb.DNSProviderService.createDNSProvider:input_type -> pb.CreateDNSProviderRequest
-	2,  // 6: pb.DNSProviderService.updateDNSProvider:input_type -> pb.UpdateDNSProviderRequest
-	3,  // 7: pb.DNSProviderService.countAllEnabledDNSProviders:input_type -> pb.CountAllEnabledDNSProvidersRequest
-	4,  // 8: pb.DNSProviderService.listEnabledDNSProviders:input_type -> pb.ListEnabledDNSProvidersRequest
-	6,  // 9: pb.DNSProviderService.findAllEnabledDNSProviders:input_type -> pb.FindAllEnabledDNSProvidersRequest
-	8,  // 10: pb.DNSProviderService.deleteDNSProvider:input_type -> pb.DeleteDNSProviderRequest
-	9,  // 11: pb.DNSProviderService.findEnabledDNSProvider:input_type -> pb.FindEnabledDNSProviderRequest
-	11, // 12: pb.DNSProviderService.findAllDNSProviderTypes:input_type -> pb.FindAllDNSProviderTypesRequest
-	14, // 13: pb.DNSProviderService.findAllEnabledDNSProvidersWithType:input_type -> pb.FindAllEnabledDNSProvidersWithTypeRequest
-	1,  // 14: pb.DNSProviderService.createDNSProvider:output_type -> pb.CreateDNSProviderResponse
-	17, // 15: pb.DNSProviderService.updateDNSProvider:output_type -> pb.RPCSuccess
-	18, // 16: pb.DNSProviderService.countAllEnabledDNSProviders:output_type -> pb.RPCCountResponse
-	5,  // 17: pb.DNSProviderService.listEnabledDNSProviders:output_type -> pb.ListEnabledDNSProvidersResponse
-	7,  // 18: pb.DNSProviderService.findAllEnabledDNSProviders:output_type -> pb.FindAllEnabledDNSProvidersResponse
-	17, // 19: pb.DNSProviderService.deleteDNSProvider:output_type -> pb.RPCSuccess
-	10, // 20: pb.DNSProviderService.findEnabledDNSProvider:output_type -> pb.FindEnabledDNSProviderResponse
-	12, // 21: pb.DNSProviderService.findAllDNSProviderTypes:output_type -> pb.FindAllDNSProviderTypesResponse
-	15, // 22: pb.DNSProviderService.findAllEnabledDNSProvidersWithType:output_type -> pb.FindAllEnabledDNSProvidersWithTypeResponse
-	14, // [14:23] is the sub-list for method output_type
-	5,  // [5:14] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	24, // 4: pb.FindAllEnabledDNSProvidersWithTypeResponse.dnsProviders:type_name -> pb.DNSProvider
+	25, // 5: pb.FindDNSProviderCallStatsResponse.dnsProviderCallStats:type_name -> pb.DNSProviderCallStat
+	23, // 6: pb.FindAllDNSProviderQuotaWarningsResponse.dnsProviderQuotaWarnings:type_name -> pb.FindAllDNSProviderQuotaWarningsResponse.DNSProviderQuotaWarning
+	24, // 7: pb.FindAllDNSProviderQuotaWarningsResponse.DNSProviderQuotaWarning.dnsProvider:type_name -> pb.DNSProvider
+	0,  // 8: pb.DNSProviderService.createDNSProvider:input_type -> pb.CreateDNSProviderRequest
+	2,  // 9: pb.DNSProviderService.updateDNSProvider:input_type -> pb.UpdateDNSProviderRequest
+	3,  // 10: pb.DNSProviderService.countAllEnabledDNSProviders:input_type -> pb.CountAllEnabledDNSProvidersRequest
+	4,  // 11: pb.DNSProviderService.listEnabledDNSProviders:input_type -> pb.ListEnabledDNSProvidersRequest
+	6,  // 12: pb.DNSProviderService.findAllEnabledDNSProviders:input_type -> pb.FindAllEnabledDNSProvidersRequest
+	8,  // 13: pb.DNSProviderService.deleteDNSProvider:input_type -> pb.DeleteDNSProviderRequest
+	9,  // 14: pb.DNSProviderService.findEnabledDNSProvider:input_type -> pb.FindEnabledDNSProviderRequest
+	11, // 15: pb.DNSProviderService.findAllDNSProviderTypes:input_type -> pb.FindAllDNSProviderTypesRequest
+	14, // 16: pb.DNSProviderService.findAllEnabledDNSProvidersWithType:input_type -> pb.FindAllEnabledDNSProvidersWithTypeRequest
+	16, // 17: pb.DNSProviderService.findDNSProviderCallStats:input_type -> pb.FindDNSProviderCallStatsRequest
+	18, // 18: pb.DNSProviderService.findAllDNSProviderQuotaWarnings:input_type -> pb.FindAllDNSProviderQuotaWarningsRequest
+	20, // 19: pb.DNSProviderService.lookupDNSProvider:input_type -> pb.LookupDNSProviderRequest
+	22, // 20: pb.DNSProviderService.updateDNSProviderExternalId:input_type -> pb.UpdateDNSProviderExternalIdRequest
+	1,  // 21: pb.DNSProviderService.createDNSProvider:output_type -> pb.CreateDNSProviderResponse
+	26, // 22: pb.DNSProviderService.updateDNSProvider:output_type -> pb.RPCSuccess
+	27, // 23: pb.DNSProviderService.countAllEnabledDNSProviders:output_type -> pb.RPCCountResponse
+	5,  // 24: pb.DNSProviderService.listEnabledDNSProviders:output_type -> pb.ListEnabledDNSProvidersResponse
+	7,  // 25: pb.DNSProviderService.findAllEnabledDNSProviders:output_type -> pb.FindAllEnabledDNSProvidersResponse
+	26, // 26: pb.DNSProviderService.deleteDNSProvider:output_type -> pb.RPCSuccess
+	10, // 27: pb.DNSProviderService.findEnabledDNSProvider:output_type -> pb.FindEnabledDNSProviderResponse
+	12, // 28: pb.DNSProviderService.findAllDNSProviderTypes:output_type -> pb.FindAllDNSProviderTypesResponse
+	15, // 29: pb.DNSProviderService.findAllEnabledDNSProvidersWithType:output_type -> pb.FindAllEnabledDNSProvidersWithTypeResponse
+	17, // 30: pb.DNSProviderService.findDNSProviderCallStats:output_type -> pb.FindDNSProviderCallStatsResponse
+	19, // 31: pb.DNSProviderService.findAllDNSProviderQuotaWarnings:output_type -> pb.FindAllDNSProviderQuotaWarningsResponse
+	21, // 32: pb.DNSProviderService.lookupDNSProvider:output_type -> pb.LookupDNSProviderResponse
+	26, // 33: pb.DNSProviderService.updateDNSProviderExternalId:output_type -> pb.RPCSuccess
+	21, // [21:34] is the sub-list for method output_type
+	8,  // [8:21] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_service_dns_provider_proto_init() }
@@ -1173,6 +1786,7 @@ func file_service_dns_provider_proto_init() {
 	}
 	file_models_rpc_messages_proto_init()
 	file_models_model_dns_provider_proto_init()
+	file_models_model_dns_provider_call_stat_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_service_dns_provider_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateDNSProviderRequest); i {
@@ -1366,6 +1980,102 @@ func file_service_dns_provider_proto_init() {
 				return nil
 			}
 		}
+		file_service_dns_provider_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDNSProviderCallStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_dns_provider_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDNSProviderCallStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_dns_provider_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllDNSProviderQuotaWarningsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_dns_provider_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllDNSProviderQuotaWarningsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_dns_provider_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupDNSProviderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_dns_provider_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupDNSProviderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_dns_provider_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDNSProviderExternalIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_dns_provider_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllDNSProviderQuotaWarningsResponse_DNSProviderQuotaWarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1373,7 +2083,7 @@ func file_service_dns_provider_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_dns_provider_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
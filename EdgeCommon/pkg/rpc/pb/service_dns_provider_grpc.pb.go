@@ -28,6 +28,10 @@ const (
 	DNSProviderService_FindEnabledDNSProvider_FullMethodName             = "/pb.DNSProviderService/findEnabledDNSProvider"
 	DNSProviderService_FindAllDNSProviderTypes_FullMethodName            = "/pb.DNSProviderService/findAllDNSProviderTypes"
 	DNSProviderService_FindAllEnabledDNSProvidersWithType_FullMethodName = "/pb.DNSProviderService/findAllEnabledDNSProvidersWithType"
+	DNSProviderService_FindDNSProviderCallStats_FullMethodName           = "/pb.DNSProviderService/findDNSProviderCallStats"
+	DNSProviderService_FindAllDNSProviderQuotaWarnings_FullMethodName    = "/pb.DNSProviderService/findAllDNSProviderQuotaWarnings"
+	DNSProviderService_LookupDNSProvider_FullMethodName                  = "/pb.DNSProviderService/lookupDNSProvider"
+	DNSProviderService_UpdateDNSProviderExternalId_FullMethodName        = "/pb.DNSProviderService/updateDNSProviderExternalId"
 )
 
 // DNSProviderServiceClient is the client API for DNSProviderService service.
@@ -52,6 +56,14 @@ type DNSProviderServiceClient interface {
 	FindAllDNSProviderTypes(ctx context.Context, in *FindAllDNSProviderTypesRequest, opts ...grpc.CallOption) (*FindAllDNSProviderTypesResponse, error)
 	// 取得某个类型的所有服务商
 	FindAllEnabledDNSProvidersWithType(ctx context.Context, in *FindAllEnabledDNSProvidersWithTypeRequest, opts ...grpc.CallOption) (*FindAllEnabledDNSProvidersWithTypeResponse, error)
+	// 查找服务商API调用统计和配额
+	FindDNSProviderCallStats(ctx context.Context, in *FindDNSProviderCallStatsRequest, opts ...grpc.CallOption) (*FindDNSProviderCallStatsResponse, error)
+	// 查找接近配额上限的服务商
+	FindAllDNSProviderQuotaWarnings(ctx context.Context, in *FindAllDNSProviderQuotaWarningsRequest, opts ...grpc.CallOption) (*FindAllDNSProviderQuotaWarningsResponse, error)
+	// 根据ID、外部ID或者名称查找服务商，通常用于Terraform等自动化工具导入和读取已有服务商
+	LookupDNSProvider(ctx context.Context, in *LookupDNSProviderRequest, opts ...grpc.CallOption) (*LookupDNSProviderResponse, error)
+	// 设置服务商外部ID
+	UpdateDNSProviderExternalId(ctx context.Context, in *UpdateDNSProviderExternalIdRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 }
 
 type dNSProviderServiceClient struct {
@@ -143,6 +155,42 @@ func (c *dNSProviderServiceClient) FindAllEnabledDNSProvidersWithType(ctx contex
 	return out, nil
 }
 
+func (c *dNSProviderServiceClient) FindDNSProviderCallStats(ctx context.Context, in *FindDNSProviderCallStatsRequest, opts ...grpc.CallOption) (*FindDNSProviderCallStatsResponse, error) {
+	out := new(FindDNSProviderCallStatsResponse)
+	err := c.cc.Invoke(ctx, DNSProviderService_FindDNSProviderCallStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dNSProviderServiceClient) FindAllDNSProviderQuotaWarnings(ctx context.Context, in *FindAllDNSProviderQuotaWarningsRequest, opts ...grpc.CallOption) (*FindAllDNSProviderQuotaWarningsResponse, error) {
+	out := new(FindAllDNSProviderQuotaWarningsResponse)
+	err := c.cc.Invoke(ctx, DNSProviderService_FindAllDNSProviderQuotaWarnings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dNSProviderServiceClient) LookupDNSProvider(ctx context.Context, in *LookupDNSProviderRequest, opts ...grpc.CallOption) (*LookupDNSProviderResponse, error) {
+	out := new(LookupDNSProviderResponse)
+	err := c.cc.Invoke(ctx, DNSProviderService_LookupDNSProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dNSProviderServiceClient) UpdateDNSProviderExternalId(ctx context.Context, in *UpdateDNSProviderExternalIdRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, DNSProviderService_UpdateDNSProviderExternalId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DNSProviderServiceServer is the server API for DNSProviderService service.
 // All implementations should embed UnimplementedDNSProviderServiceServer
 // for forward compatibility
@@ -165,6 +213,14 @@ type DNSProviderServiceServer interface {
 	FindAllDNSProviderTypes(context.Context, *FindAllDNSProviderTypesRequest) (*FindAllDNSProviderTypesResponse, error)
 	// 取得某个类型的所有服务商
 	FindAllEnabledDNSProvidersWithType(context.Context, *FindAllEnabledDNSProvidersWithTypeRequest) (*FindAllEnabledDNSProvidersWithTypeResponse, error)
+	// 查找服务商API调用统计和配额
+	FindDNSProviderCallStats(context.Context, *FindDNSProviderCallStatsRequest) (*FindDNSProviderCallStatsResponse, error)
+	// 查找接近配额上限的服务商
+	FindAllDNSProviderQuotaWarnings(context.Context, *FindAllDNSProviderQuotaWarningsRequest) (*FindAllDNSProviderQuotaWarningsResponse, error)
+	// 根据ID、外部ID或者名称查找服务商，通常用于Terraform等自动化工具导入和读取已有服务商
+	LookupDNSProvider(context.Context, *LookupDNSProviderRequest) (*LookupDNSProviderResponse, error)
+	// 设置服务商外部ID
+	UpdateDNSProviderExternalId(context.Context, *UpdateDNSProviderExternalIdRequest) (*RPCSuccess, error)
 }
 
 // UnimplementedDNSProviderServiceServer should be embedded to have forward compatible implementations.
@@ -198,6 +254,18 @@ func (UnimplementedDNSProviderServiceServer) FindAllDNSProviderTypes(context.Con
 func (UnimplementedDNSProviderServiceServer) FindAllEnabledDNSProvidersWithType(context.Context, *FindAllEnabledDNSProvidersWithTypeRequest) (*FindAllEnabledDNSProvidersWithTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindAllEnabledDNSProvidersWithType not implemented")
 }
+func (UnimplementedDNSProviderServiceServer) FindDNSProviderCallStats(context.Context, *FindDNSProviderCallStatsRequest) (*FindDNSProviderCallStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDNSProviderCallStats not implemented")
+}
+func (UnimplementedDNSProviderServiceServer) FindAllDNSProviderQuotaWarnings(context.Context, *FindAllDNSProviderQuotaWarningsRequest) (*FindAllDNSProviderQuotaWarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllDNSProviderQuotaWarnings not implemented")
+}
+func (UnimplementedDNSProviderServiceServer) LookupDNSProvider(context.Context, *LookupDNSProviderRequest) (*LookupDNSProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupDNSProvider not implemented")
+}
+func (UnimplementedDNSProviderServiceServer) UpdateDNSProviderExternalId(context.Context, *UpdateDNSProviderExternalIdRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDNSProviderExternalId not implemented")
+}
 
 // UnsafeDNSProviderServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DNSProviderServiceServer will
@@ -372,6 +440,78 @@ func _DNSProviderService_FindAllEnabledDNSProvidersWithType_Handler(srv interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DNSProviderService_FindDNSProviderCallStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDNSProviderCallStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DNSProviderServiceServer).FindDNSProviderCallStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DNSProviderService_FindDNSProviderCallStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DNSProviderServiceServer).FindDNSProviderCallStats(ctx, req.(*FindDNSProviderCallStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DNSProviderService_FindAllDNSProviderQuotaWarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllDNSProviderQuotaWarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DNSProviderServiceServer).FindAllDNSProviderQuotaWarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DNSProviderService_FindAllDNSProviderQuotaWarnings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DNSProviderServiceServer).FindAllDNSProviderQuotaWarnings(ctx, req.(*FindAllDNSProviderQuotaWarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DNSProviderService_LookupDNSProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupDNSProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DNSProviderServiceServer).LookupDNSProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DNSProviderService_LookupDNSProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DNSProviderServiceServer).LookupDNSProvider(ctx, req.(*LookupDNSProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DNSProviderService_UpdateDNSProviderExternalId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDNSProviderExternalIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DNSProviderServiceServer).UpdateDNSProviderExternalId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DNSProviderService_UpdateDNSProviderExternalId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DNSProviderServiceServer).UpdateDNSProviderExternalId(ctx, req.(*UpdateDNSProviderExternalIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DNSProviderService_ServiceDesc is the grpc.ServiceDesc for DNSProviderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -415,6 +555,22 @@ var DNSProviderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "findAllEnabledDNSProvidersWithType",
 			Handler:    _DNSProviderService_FindAllEnabledDNSProvidersWithType_Handler,
 		},
+		{
+			MethodName: "findDNSProviderCallStats",
+			Handler:    _DNSProviderService_FindDNSProviderCallStats_Handler,
+		},
+		{
+			MethodName: "findAllDNSProviderQuotaWarnings",
+			Handler:    _DNSProviderService_FindAllDNSProviderQuotaWarnings_Handler,
+		},
+		{
+			MethodName: "lookupDNSProvider",
+			Handler:    _DNSProviderService_LookupDNSProvider_Handler,
+		},
+		{
+			MethodName: "updateDNSProviderExternalId",
+			Handler:    _DNSProviderService_UpdateDNSProviderExternalId_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_dns_provider.proto",
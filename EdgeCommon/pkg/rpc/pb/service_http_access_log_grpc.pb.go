@@ -23,6 +23,7 @@ const (
 	HTTPAccessLogService_ListHTTPAccessLogs_FullMethodName          = "/pb.HTTPAccessLogService/listHTTPAccessLogs"
 	HTTPAccessLogService_FindHTTPAccessLog_FullMethodName           = "/pb.HTTPAccessLogService/findHTTPAccessLog"
 	HTTPAccessLogService_FindHTTPAccessLogPartitions_FullMethodName = "/pb.HTTPAccessLogService/findHTTPAccessLogPartitions"
+	HTTPAccessLogService_TailHTTPAccessLogs_FullMethodName          = "/pb.HTTPAccessLogService/tailHTTPAccessLogs"
 )
 
 // HTTPAccessLogServiceClient is the client API for HTTPAccessLogService service.
@@ -37,6 +38,8 @@ type HTTPAccessLogServiceClient interface {
 	FindHTTPAccessLog(ctx context.Context, in *FindHTTPAccessLogRequest, opts ...grpc.CallOption) (*FindHTTPAccessLogResponse, error)
 	// 查找日志分区
 	FindHTTPAccessLogPartitions(ctx context.Context, in *FindHTTPAccessLogPartitionsRequest, opts ...grpc.CallOption) (*FindHTTPAccessLogPartitionsResponse, error)
+	// 实时查看新的访问日志
+	TailHTTPAccessLogs(ctx context.Context, in *TailHTTPAccessLogsRequest, opts ...grpc.CallOption) (HTTPAccessLogService_TailHTTPAccessLogsClient, error)
 }
 
 type hTTPAccessLogServiceClient struct {
@@ -83,6 +86,38 @@ func (c *hTTPAccessLogServiceClient) FindHTTPAccessLogPartitions(ctx context.Con
 	return out, nil
 }
 
+func (c *hTTPAccessLogServiceClient) TailHTTPAccessLogs(ctx context.Context, in *TailHTTPAccessLogsRequest, opts ...grpc.CallOption) (HTTPAccessLogService_TailHTTPAccessLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &HTTPAccessLogService_ServiceDesc.Streams[0], HTTPAccessLogService_TailHTTPAccessLogs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &hTTPAccessLogServiceTailHTTPAccessLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HTTPAccessLogService_TailHTTPAccessLogsClient interface {
+	Recv() (*TailHTTPAccessLogsResponse, error)
+	grpc.ClientStream
+}
+
+type hTTPAccessLogServiceTailHTTPAccessLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *hTTPAccessLogServiceTailHTTPAccessLogsClient) Recv() (*TailHTTPAccessLogsResponse, error) {
+	m := new(TailHTTPAccessLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // HTTPAccessLogServiceServer is the server API for HTTPAccessLogService service.
 // All implementations should embed UnimplementedHTTPAccessLogServiceServer
 // for forward compatibility
@@ -95,6 +130,8 @@ type HTTPAccessLogServiceServer interface {
 	FindHTTPAccessLog(context.Context, *FindHTTPAccessLogRequest) (*FindHTTPAccessLogResponse, error)
 	// 查找日志分区
 	FindHTTPAccessLogPartitions(context.Context, *FindHTTPAccessLogPartitionsRequest) (*FindHTTPAccessLogPartitionsResponse, error)
+	// 实时查看新的访问日志
+	TailHTTPAccessLogs(*TailHTTPAccessLogsRequest, HTTPAccessLogService_TailHTTPAccessLogsServer) error
 }
 
 // UnimplementedHTTPAccessLogServiceServer should be embedded to have forward compatible implementations.
@@ -113,6 +150,9 @@ func (UnimplementedHTTPAccessLogServiceServer) FindHTTPAccessLog(context.Context
 func (UnimplementedHTTPAccessLogServiceServer) FindHTTPAccessLogPartitions(context.Context, *FindHTTPAccessLogPartitionsRequest) (*FindHTTPAccessLogPartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindHTTPAccessLogPartitions not implemented")
 }
+func (UnimplementedHTTPAccessLogServiceServer) TailHTTPAccessLogs(*TailHTTPAccessLogsRequest, HTTPAccessLogService_TailHTTPAccessLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailHTTPAccessLogs not implemented")
+}
 
 // UnsafeHTTPAccessLogServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to HTTPAccessLogServiceServer will
@@ -197,6 +237,27 @@ func _HTTPAccessLogService_FindHTTPAccessLogPartitions_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HTTPAccessLogService_TailHTTPAccessLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailHTTPAccessLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HTTPAccessLogServiceServer).TailHTTPAccessLogs(m, &hTTPAccessLogServiceTailHTTPAccessLogsServer{stream})
+}
+
+type HTTPAccessLogService_TailHTTPAccessLogsServer interface {
+	Send(*TailHTTPAccessLogsResponse) error
+	grpc.ServerStream
+}
+
+type hTTPAccessLogServiceTailHTTPAccessLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *hTTPAccessLogServiceTailHTTPAccessLogsServer) Send(m *TailHTTPAccessLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // HTTPAccessLogService_ServiceDesc is the grpc.ServiceDesc for HTTPAccessLogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,6 +282,12 @@ var HTTPAccessLogService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _HTTPAccessLogService_FindHTTPAccessLogPartitions_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "tailHTTPAccessLogs",
+			Handler:       _HTTPAccessLogService_TailHTTPAccessLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "service_http_access_log.proto",
 }
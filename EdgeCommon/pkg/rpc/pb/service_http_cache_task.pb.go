@@ -511,6 +511,133 @@ func (x *ResetHTTPCacheTaskRequest) GetHttpCacheTaskId() int64 {
 	return 0
 }
 
+// 查询任务执行进度
+type FindHTTPCacheTaskProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HttpCacheTaskId int64 `protobuf:"varint,1,opt,name=httpCacheTaskId,proto3" json:"httpCacheTaskId,omitempty"` // 任务ID
+}
+
+func (x *FindHTTPCacheTaskProgressRequest) Reset() {
+	*x = FindHTTPCacheTaskProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_http_cache_task_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindHTTPCacheTaskProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindHTTPCacheTaskProgressRequest) ProtoMessage() {}
+
+func (x *FindHTTPCacheTaskProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_http_cache_task_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindHTTPCacheTaskProgressRequest.ProtoReflect.Descriptor instead.
+func (*FindHTTPCacheTaskProgressRequest) Descriptor() ([]byte, []int) {
+	return file_service_http_cache_task_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FindHTTPCacheTaskProgressRequest) GetHttpCacheTaskId() int64 {
+	if x != nil {
+		return x.HttpCacheTaskId
+	}
+	return 0
+}
+
+type FindHTTPCacheTaskProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CountKeys       int64 `protobuf:"varint,1,opt,name=countKeys,proto3" json:"countKeys,omitempty"`             // Key总数量
+	CountDoneKeys   int64 `protobuf:"varint,2,opt,name=countDoneKeys,proto3" json:"countDoneKeys,omitempty"`     // 已完成的Key数量
+	CountFailedKeys int64 `protobuf:"varint,3,opt,name=countFailedKeys,proto3" json:"countFailedKeys,omitempty"` // 有错误的Key数量
+	IsDone          bool  `protobuf:"varint,4,opt,name=isDone,proto3" json:"isDone,omitempty"`                   // 任务是否已完成
+	IsOk            bool  `protobuf:"varint,5,opt,name=isOk,proto3" json:"isOk,omitempty"`                       // 任务是否完全成功
+}
+
+func (x *FindHTTPCacheTaskProgressResponse) Reset() {
+	*x = FindHTTPCacheTaskProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_http_cache_task_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindHTTPCacheTaskProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindHTTPCacheTaskProgressResponse) ProtoMessage() {}
+
+func (x *FindHTTPCacheTaskProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_http_cache_task_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindHTTPCacheTaskProgressResponse.ProtoReflect.Descriptor instead.
+func (*FindHTTPCacheTaskProgressResponse) Descriptor() ([]byte, []int) {
+	return file_service_http_cache_task_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FindHTTPCacheTaskProgressResponse) GetCountKeys() int64 {
+	if x != nil {
+		return x.CountKeys
+	}
+	return 0
+}
+
+func (x *FindHTTPCacheTaskProgressResponse) GetCountDoneKeys() int64 {
+	if x != nil {
+		return x.CountDoneKeys
+	}
+	return 0
+}
+
+func (x *FindHTTPCacheTaskProgressResponse) GetCountFailedKeys() int64 {
+	if x != nil {
+		return x.CountFailedKeys
+	}
+	return 0
+}
+
+func (x *FindHTTPCacheTaskProgressResponse) GetIsDone() bool {
+	if x != nil {
+		return x.IsDone
+	}
+	return false
+}
+
+func (x *FindHTTPCacheTaskProgressResponse) GetIsOk() bool {
+	if x != nil {
+		return x.IsOk
+	}
+	return false
+}
+
 var File_service_http_cache_task_proto protoreflect.FileDescriptor
 
 var file_service_http_cache_task_proto_rawDesc = []byte{
@@ -566,46 +693,69 @@ var file_service_http_cache_task_proto_rawDesc = []byte{
 	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x68,
 	0x74, 0x74, 0x70, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x68, 0x74, 0x74, 0x70, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x49, 0x64, 0x32, 0xda, 0x04, 0x0a, 0x14, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56,
-	0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48,
-	0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1e, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x18, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x69, 0x6e,
-	0x67, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12,
-	0x23, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x48,
-	0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x6c, 0x69,
-	0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x65, 0x0a, 0x18, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x48, 0x54,
-	0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x23, 0x2e, 0x70, 0x62,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x48, 0x54, 0x54, 0x50,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x48, 0x54, 0x54,
+	0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x74, 0x74,
+	0x70, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x68, 0x74, 0x74, 0x70, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x48, 0x54, 0x54, 0x50,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x6f, 0x6e, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x44, 0x6f, 0x6e,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69,
+	0x73, 0x4f, 0x6b, 0x32, 0xc4, 0x05, 0x0a, 0x14, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x13,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48,
+	0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48,
+	0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x54, 0x54,
+	0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x18, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x48,
+	0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x23, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x48, 0x54, 0x54,
+	0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x6c, 0x69, 0x73, 0x74,
+	0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1d,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a,
+	0x18, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x48, 0x54, 0x54, 0x50,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x48,
+	0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x54,
+	0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1e, 0x2e, 0x70, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x12, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x48, 0x54, 0x54, 0x50,
 	0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1e, 0x2e,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
-	0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a,
-	0x12, 0x72, 0x65, 0x73, 0x65, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x48, 0x54,
-	0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x68, 0x0a, 0x19, 0x66, 0x69, 0x6e, 0x64, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x48, 0x54, 0x54,
+	0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -620,25 +770,27 @@ func file_service_http_cache_task_proto_rawDescGZIP() []byte {
 	return file_service_http_cache_task_proto_rawDescData
 }
 
-var file_service_http_cache_task_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_service_http_cache_task_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_service_http_cache_task_proto_goTypes = []interface{}{
-	(*CreateHTTPCacheTaskRequest)(nil),       // 0: pb.CreateHTTPCacheTaskRequest
-	(*CreateHTTPCacheTaskResponse)(nil),      // 1: pb.CreateHTTPCacheTaskResponse
-	(*CountHTTPCacheTasksRequest)(nil),       // 2: pb.CountHTTPCacheTasksRequest
-	(*CountDoingHTTPCacheTasksRequest)(nil),  // 3: pb.CountDoingHTTPCacheTasksRequest
-	(*FindEnabledHTTPCacheTaskRequest)(nil),  // 4: pb.FindEnabledHTTPCacheTaskRequest
-	(*FindEnabledHTTPCacheTaskResponse)(nil), // 5: pb.FindEnabledHTTPCacheTaskResponse
-	(*ListHTTPCacheTasksRequest)(nil),        // 6: pb.ListHTTPCacheTasksRequest
-	(*ListHTTPCacheTasksResponse)(nil),       // 7: pb.ListHTTPCacheTasksResponse
-	(*DeleteHTTPCacheTaskRequest)(nil),       // 8: pb.DeleteHTTPCacheTaskRequest
-	(*ResetHTTPCacheTaskRequest)(nil),        // 9: pb.ResetHTTPCacheTaskRequest
-	(*HTTPCacheTask)(nil),                    // 10: pb.HTTPCacheTask
-	(*RPCCountResponse)(nil),                 // 11: pb.RPCCountResponse
-	(*RPCSuccess)(nil),                       // 12: pb.RPCSuccess
+	(*CreateHTTPCacheTaskRequest)(nil),        // 0: pb.CreateHTTPCacheTaskRequest
+	(*CreateHTTPCacheTaskResponse)(nil),       // 1: pb.CreateHTTPCacheTaskResponse
+	(*CountHTTPCacheTasksRequest)(nil),        // 2: pb.CountHTTPCacheTasksRequest
+	(*CountDoingHTTPCacheTasksRequest)(nil),   // 3: pb.CountDoingHTTPCacheTasksRequest
+	(*FindEnabledHTTPCacheTaskRequest)(nil),   // 4: pb.FindEnabledHTTPCacheTaskRequest
+	(*FindEnabledHTTPCacheTaskResponse)(nil),  // 5: pb.FindEnabledHTTPCacheTaskResponse
+	(*ListHTTPCacheTasksRequest)(nil),         // 6: pb.ListHTTPCacheTasksRequest
+	(*ListHTTPCacheTasksResponse)(nil),        // 7: pb.ListHTTPCacheTasksResponse
+	(*DeleteHTTPCacheTaskRequest)(nil),        // 8: pb.DeleteHTTPCacheTaskRequest
+	(*ResetHTTPCacheTaskRequest)(nil),         // 9: pb.ResetHTTPCacheTaskRequest
+	(*FindHTTPCacheTaskProgressRequest)(nil),  // 10: pb.FindHTTPCacheTaskProgressRequest
+	(*FindHTTPCacheTaskProgressResponse)(nil), // 11: pb.FindHTTPCacheTaskProgressResponse
+	(*HTTPCacheTask)(nil),                     // 12: pb.HTTPCacheTask
+	(*RPCCountResponse)(nil),                  // 13: pb.RPCCountResponse
+	(*RPCSuccess)(nil),                        // 14: pb.RPCSuccess
 }
 var file_service_http_cache_task_proto_depIdxs = []int32{
-	10, // 0: pb.FindEnabledHTTPCacheTaskResponse.httpCacheTask:type_name -> pb.HTTPCacheTask
-	10, // 1: pb.ListHTTPCacheTasksResponse.httpCacheTasks:type_name -> pb.HTTPCacheTask
+	12, // 0: pb.FindEnabledHTTPCacheTaskResponse.httpCacheTask:type_name -> pb.HTTPCacheTask
+	12, // 1: pb.ListHTTPCacheTasksResponse.httpCacheTasks:type_name -> pb.HTTPCacheTask
 	0,  // 2: pb.HTTPCacheTaskService.createHTTPCacheTask:input_type -> pb.CreateHTTPCacheTaskRequest
 	2,  // 3: pb.HTTPCacheTaskService.countHTTPCacheTasks:input_type -> pb.CountHTTPCacheTasksRequest
 	3,  // 4: pb.HTTPCacheTaskService.countDoingHTTPCacheTasks:input_type -> pb.CountDoingHTTPCacheTasksRequest
@@ -646,15 +798,17 @@ var file_service_http_cache_task_proto_depIdxs = []int32{
 	4,  // 6: pb.HTTPCacheTaskService.findEnabledHTTPCacheTask:input_type -> pb.FindEnabledHTTPCacheTaskRequest
 	8,  // 7: pb.HTTPCacheTaskService.deleteHTTPCacheTask:input_type -> pb.DeleteHTTPCacheTaskRequest
 	9,  // 8: pb.HTTPCacheTaskService.resetHTTPCacheTask:input_type -> pb.ResetHTTPCacheTaskRequest
-	1,  // 9: pb.HTTPCacheTaskService.createHTTPCacheTask:output_type -> pb.CreateHTTPCacheTaskResponse
-	11, // 10: pb.HTTPCacheTaskService.countHTTPCacheTasks:output_type -> pb.RPCCountResponse
-	11, // 11: pb.HTTPCacheTaskService.countDoingHTTPCacheTasks:output_type -> pb.RPCCountResponse
-	7,  // 12: pb.HTTPCacheTaskService.listHTTPCacheTasks:output_type -> pb.ListHTTPCacheTasksResponse
-	5,  // 13: pb.HTTPCacheTaskService.findEnabledHTTPCacheTask:output_type -> pb.FindEnabledHTTPCacheTaskResponse
-	12, // 14: pb.HTTPCacheTaskService.deleteHTTPCacheTask:output_type -> pb.RPCSuccess
-	12, // 15: pb.HTTPCacheTaskService.resetHTTPCacheTask:output_type -> pb.RPCSuccess
-	9,  // [9:16] is the sub-list for method output_type
-	2,  // [2:9] is the sub-list for method input_type
+	10, // 9: pb.HTTPCacheTaskService.findHTTPCacheTaskProgress:input_type -> pb.FindHTTPCacheTaskProgressRequest
+	1,  // 10: pb.HTTPCacheTaskService.createHTTPCacheTask:output_type -> pb.CreateHTTPCacheTaskResponse
+	13, // 11: pb.HTTPCacheTaskService.countHTTPCacheTasks:output_type -> pb.RPCCountResponse
+	13, // 12: pb.HTTPCacheTaskService.countDoingHTTPCacheTasks:output_type -> pb.RPCCountResponse
+	7,  // 13: pb.HTTPCacheTaskService.listHTTPCacheTasks:output_type -> pb.ListHTTPCacheTasksResponse
+	5,  // 14: pb.HTTPCacheTaskService.findEnabledHTTPCacheTask:output_type -> pb.FindEnabledHTTPCacheTaskResponse
+	14, // 15: pb.HTTPCacheTaskService.deleteHTTPCacheTask:output_type -> pb.RPCSuccess
+	14, // 16: pb.HTTPCacheTaskService.resetHTTPCacheTask:output_type -> pb.RPCSuccess
+	11, // 17: pb.HTTPCacheTaskService.findHTTPCacheTaskProgress:output_type -> pb.FindHTTPCacheTaskProgressResponse
+	10, // [10:18] is the sub-list for method output_type
+	2,  // [2:10] is the sub-list for method input_type
 	2,  // [2:2] is the sub-list for extension type_name
 	2,  // [2:2] is the sub-list for extension extendee
 	0,  // [0:2] is the sub-list for field type_name
@@ -788,6 +942,30 @@ func file_service_http_cache_task_proto_init() {
 				return nil
 			}
 		}
+		file_service_http_cache_task_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindHTTPCacheTaskProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_http_cache_task_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindHTTPCacheTaskProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -795,7 +973,7 @@ func file_service_http_cache_task_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_http_cache_task_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
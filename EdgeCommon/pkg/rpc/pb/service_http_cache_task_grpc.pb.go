@@ -19,13 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	HTTPCacheTaskService_CreateHTTPCacheTask_FullMethodName      = "/pb.HTTPCacheTaskService/createHTTPCacheTask"
-	HTTPCacheTaskService_CountHTTPCacheTasks_FullMethodName      = "/pb.HTTPCacheTaskService/countHTTPCacheTasks"
-	HTTPCacheTaskService_CountDoingHTTPCacheTasks_FullMethodName = "/pb.HTTPCacheTaskService/countDoingHTTPCacheTasks"
-	HTTPCacheTaskService_ListHTTPCacheTasks_FullMethodName       = "/pb.HTTPCacheTaskService/listHTTPCacheTasks"
-	HTTPCacheTaskService_FindEnabledHTTPCacheTask_FullMethodName = "/pb.HTTPCacheTaskService/findEnabledHTTPCacheTask"
-	HTTPCacheTaskService_DeleteHTTPCacheTask_FullMethodName      = "/pb.HTTPCacheTaskService/deleteHTTPCacheTask"
-	HTTPCacheTaskService_ResetHTTPCacheTask_FullMethodName       = "/pb.HTTPCacheTaskService/resetHTTPCacheTask"
+	HTTPCacheTaskService_CreateHTTPCacheTask_FullMethodName       = "/pb.HTTPCacheTaskService/createHTTPCacheTask"
+	HTTPCacheTaskService_CountHTTPCacheTasks_FullMethodName       = "/pb.HTTPCacheTaskService/countHTTPCacheTasks"
+	HTTPCacheTaskService_CountDoingHTTPCacheTasks_FullMethodName  = "/pb.HTTPCacheTaskService/countDoingHTTPCacheTasks"
+	HTTPCacheTaskService_ListHTTPCacheTasks_FullMethodName        = "/pb.HTTPCacheTaskService/listHTTPCacheTasks"
+	HTTPCacheTaskService_FindEnabledHTTPCacheTask_FullMethodName  = "/pb.HTTPCacheTaskService/findEnabledHTTPCacheTask"
+	HTTPCacheTaskService_DeleteHTTPCacheTask_FullMethodName       = "/pb.HTTPCacheTaskService/deleteHTTPCacheTask"
+	HTTPCacheTaskService_ResetHTTPCacheTask_FullMethodName        = "/pb.HTTPCacheTaskService/resetHTTPCacheTask"
+	HTTPCacheTaskService_FindHTTPCacheTaskProgress_FullMethodName = "/pb.HTTPCacheTaskService/findHTTPCacheTaskProgress"
 )
 
 // HTTPCacheTaskServiceClient is the client API for HTTPCacheTaskService service.
@@ -46,6 +47,8 @@ type HTTPCacheTaskServiceClient interface {
 	DeleteHTTPCacheTask(ctx context.Context, in *DeleteHTTPCacheTaskRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 	// 重置任务状态
 	ResetHTTPCacheTask(ctx context.Context, in *ResetHTTPCacheTaskRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 查询任务执行进度
+	FindHTTPCacheTaskProgress(ctx context.Context, in *FindHTTPCacheTaskProgressRequest, opts ...grpc.CallOption) (*FindHTTPCacheTaskProgressResponse, error)
 }
 
 type hTTPCacheTaskServiceClient struct {
@@ -119,6 +122,15 @@ func (c *hTTPCacheTaskServiceClient) ResetHTTPCacheTask(ctx context.Context, in
 	return out, nil
 }
 
+func (c *hTTPCacheTaskServiceClient) FindHTTPCacheTaskProgress(ctx context.Context, in *FindHTTPCacheTaskProgressRequest, opts ...grpc.CallOption) (*FindHTTPCacheTaskProgressResponse, error) {
+	out := new(FindHTTPCacheTaskProgressResponse)
+	err := c.cc.Invoke(ctx, HTTPCacheTaskService_FindHTTPCacheTaskProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HTTPCacheTaskServiceServer is the server API for HTTPCacheTaskService service.
 // All implementations should embed UnimplementedHTTPCacheTaskServiceServer
 // for forward compatibility
@@ -137,6 +149,8 @@ type HTTPCacheTaskServiceServer interface {
 	DeleteHTTPCacheTask(context.Context, *DeleteHTTPCacheTaskRequest) (*RPCSuccess, error)
 	// 重置任务状态
 	ResetHTTPCacheTask(context.Context, *ResetHTTPCacheTaskRequest) (*RPCSuccess, error)
+	// 查询任务执行进度
+	FindHTTPCacheTaskProgress(context.Context, *FindHTTPCacheTaskProgressRequest) (*FindHTTPCacheTaskProgressResponse, error)
 }
 
 // UnimplementedHTTPCacheTaskServiceServer should be embedded to have forward compatible implementations.
@@ -164,6 +178,9 @@ func (UnimplementedHTTPCacheTaskServiceServer) DeleteHTTPCacheTask(context.Conte
 func (UnimplementedHTTPCacheTaskServiceServer) ResetHTTPCacheTask(context.Context, *ResetHTTPCacheTaskRequest) (*RPCSuccess, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetHTTPCacheTask not implemented")
 }
+func (UnimplementedHTTPCacheTaskServiceServer) FindHTTPCacheTaskProgress(context.Context, *FindHTTPCacheTaskProgressRequest) (*FindHTTPCacheTaskProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindHTTPCacheTaskProgress not implemented")
+}
 
 // UnsafeHTTPCacheTaskServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to HTTPCacheTaskServiceServer will
@@ -302,6 +319,24 @@ func _HTTPCacheTaskService_ResetHTTPCacheTask_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HTTPCacheTaskService_FindHTTPCacheTaskProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindHTTPCacheTaskProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HTTPCacheTaskServiceServer).FindHTTPCacheTaskProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HTTPCacheTaskService_FindHTTPCacheTaskProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HTTPCacheTaskServiceServer).FindHTTPCacheTaskProgress(ctx, req.(*FindHTTPCacheTaskProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HTTPCacheTaskService_ServiceDesc is the grpc.ServiceDesc for HTTPCacheTaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -337,6 +372,10 @@ var HTTPCacheTaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "resetHTTPCacheTask",
 			Handler:    _HTTPCacheTaskService_ResetHTTPCacheTask_Handler,
 		},
+		{
+			MethodName: "findHTTPCacheTaskProgress",
+			Handler:    _HTTPCacheTaskService_FindHTTPCacheTaskProgress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_http_cache_task.proto",
@@ -314,6 +314,125 @@ func (x *CountHTTPCacheTaskKeysWithDayRequest) GetDay() string {
 	return ""
 }
 
+// 列出任务下的单页Key及执行结果
+type ListHTTPCacheTaskKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HttpCacheTaskId int64 `protobuf:"varint,1,opt,name=httpCacheTaskId,proto3" json:"httpCacheTaskId,omitempty"` // 任务ID
+	OnlyFailed      bool  `protobuf:"varint,2,opt,name=onlyFailed,proto3" json:"onlyFailed,omitempty"`           // 是否只列出有错误的Key
+	Offset          int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                   // 查询起始位置
+	Size            int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                       // 查询条数
+}
+
+func (x *ListHTTPCacheTaskKeysRequest) Reset() {
+	*x = ListHTTPCacheTaskKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_http_cache_task_key_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHTTPCacheTaskKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHTTPCacheTaskKeysRequest) ProtoMessage() {}
+
+func (x *ListHTTPCacheTaskKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_http_cache_task_key_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHTTPCacheTaskKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListHTTPCacheTaskKeysRequest) Descriptor() ([]byte, []int) {
+	return file_service_http_cache_task_key_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListHTTPCacheTaskKeysRequest) GetHttpCacheTaskId() int64 {
+	if x != nil {
+		return x.HttpCacheTaskId
+	}
+	return 0
+}
+
+func (x *ListHTTPCacheTaskKeysRequest) GetOnlyFailed() bool {
+	if x != nil {
+		return x.OnlyFailed
+	}
+	return false
+}
+
+func (x *ListHTTPCacheTaskKeysRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListHTTPCacheTaskKeysRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListHTTPCacheTaskKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HttpCacheTaskKeys []*HTTPCacheTaskKey `protobuf:"bytes,1,rep,name=httpCacheTaskKeys,proto3" json:"httpCacheTaskKeys,omitempty"` // 一组Key
+}
+
+func (x *ListHTTPCacheTaskKeysResponse) Reset() {
+	*x = ListHTTPCacheTaskKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_http_cache_task_key_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHTTPCacheTaskKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHTTPCacheTaskKeysResponse) ProtoMessage() {}
+
+func (x *ListHTTPCacheTaskKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_http_cache_task_key_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHTTPCacheTaskKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListHTTPCacheTaskKeysResponse) Descriptor() ([]byte, []int) {
+	return file_service_http_cache_task_key_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListHTTPCacheTaskKeysResponse) GetHttpCacheTaskKeys() []*HTTPCacheTaskKey {
+	if x != nil {
+		return x.HttpCacheTaskKeys
+	}
+	return nil
+}
+
 type ValidateHTTPCacheTaskKeysResponse_FailKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -326,7 +445,7 @@ type ValidateHTTPCacheTaskKeysResponse_FailKey struct {
 func (x *ValidateHTTPCacheTaskKeysResponse_FailKey) Reset() {
 	*x = ValidateHTTPCacheTaskKeysResponse_FailKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_http_cache_task_key_proto_msgTypes[6]
+		mi := &file_service_http_cache_task_key_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -339,7 +458,7 @@ func (x *ValidateHTTPCacheTaskKeysResponse_FailKey) String() string {
 func (*ValidateHTTPCacheTaskKeysResponse_FailKey) ProtoMessage() {}
 
 func (x *ValidateHTTPCacheTaskKeysResponse_FailKey) ProtoReflect() protoreflect.Message {
-	mi := &file_service_http_cache_task_key_proto_msgTypes[6]
+	mi := &file_service_http_cache_task_key_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,7 +501,7 @@ type UpdateHTTPCacheTaskKeysStatusRequest_KeyResult struct {
 func (x *UpdateHTTPCacheTaskKeysStatusRequest_KeyResult) Reset() {
 	*x = UpdateHTTPCacheTaskKeysStatusRequest_KeyResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_http_cache_task_key_proto_msgTypes[7]
+		mi := &file_service_http_cache_task_key_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -395,7 +514,7 @@ func (x *UpdateHTTPCacheTaskKeysStatusRequest_KeyResult) String() string {
 func (*UpdateHTTPCacheTaskKeysStatusRequest_KeyResult) ProtoMessage() {}
 
 func (x *UpdateHTTPCacheTaskKeysStatusRequest_KeyResult) ProtoReflect() protoreflect.Message {
-	mi := &file_service_http_cache_task_key_proto_msgTypes[7]
+	mi := &file_service_http_cache_task_key_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,35 +603,57 @@ var file_service_http_cache_task_key_proto_rawDesc = []byte{
 	0x65, 0x79, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64,
-	0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x32, 0xac, 0x03,
-	0x0a, 0x17, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b,
-	0x65, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x19, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61,
-	0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73,
-	0x6b, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
-	0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x1a, 0x66, 0x69, 0x6e, 0x64, 0x44, 0x6f, 0x69, 0x6e, 0x67,
+	0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x94, 0x01,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x0f, 0x68, 0x74, 0x74, 0x70, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x68, 0x74, 0x74, 0x70, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x6e, 0x6c, 0x79,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x6e,
+	0x6c, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x63, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x11, 0x68, 0x74, 0x74, 0x70, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x52, 0x11, 0x68, 0x74, 0x74, 0x70, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x32, 0x8a, 0x04, 0x0a, 0x17, 0x48, 0x54,
+	0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x19, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6b, 0x0a, 0x1a, 0x66, 0x69, 0x6e, 0x64, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x48, 0x54, 0x54, 0x50,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x25, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x48, 0x54, 0x54, 0x50,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x6f,
+	0x69, 0x6e, 0x67, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x1d,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5f, 0x0a, 0x1d, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79,
-	0x73, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x6f, 0x69, 0x6e, 0x67,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x61, 0x79, 0x12, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x4b, 0x65, 0x79, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x6c, 0x69, 0x73, 0x74,
 	0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x59, 0x0a, 0x1d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54,
-	0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62,
-	0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5f, 0x0a, 0x1d, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73,
-	0x6b, 0x4b, 0x65, 0x79, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x61, 0x79, 0x12, 0x28, 0x2e, 0x70,
-	0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x61, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04,
-	0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x54, 0x54,
+	0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -527,7 +668,7 @@ func file_service_http_cache_task_key_proto_rawDescGZIP() []byte {
 	return file_service_http_cache_task_key_proto_rawDescData
 }
 
-var file_service_http_cache_task_key_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_service_http_cache_task_key_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_service_http_cache_task_key_proto_goTypes = []interface{}{
 	(*ValidateHTTPCacheTaskKeysRequest)(nil),               // 0: pb.ValidateHTTPCacheTaskKeysRequest
 	(*ValidateHTTPCacheTaskKeysResponse)(nil),              // 1: pb.ValidateHTTPCacheTaskKeysResponse
@@ -535,29 +676,34 @@ var file_service_http_cache_task_key_proto_goTypes = []interface{}{
 	(*FindDoingHTTPCacheTaskKeysResponse)(nil),             // 3: pb.FindDoingHTTPCacheTaskKeysResponse
 	(*UpdateHTTPCacheTaskKeysStatusRequest)(nil),           // 4: pb.UpdateHTTPCacheTaskKeysStatusRequest
 	(*CountHTTPCacheTaskKeysWithDayRequest)(nil),           // 5: pb.CountHTTPCacheTaskKeysWithDayRequest
-	(*ValidateHTTPCacheTaskKeysResponse_FailKey)(nil),      // 6: pb.ValidateHTTPCacheTaskKeysResponse.FailKey
-	(*UpdateHTTPCacheTaskKeysStatusRequest_KeyResult)(nil), // 7: pb.UpdateHTTPCacheTaskKeysStatusRequest.KeyResult
-	(*HTTPCacheTaskKey)(nil),                               // 8: pb.HTTPCacheTaskKey
-	(*RPCSuccess)(nil),                                     // 9: pb.RPCSuccess
-	(*RPCCountResponse)(nil),                               // 10: pb.RPCCountResponse
+	(*ListHTTPCacheTaskKeysRequest)(nil),                   // 6: pb.ListHTTPCacheTaskKeysRequest
+	(*ListHTTPCacheTaskKeysResponse)(nil),                  // 7: pb.ListHTTPCacheTaskKeysResponse
+	(*ValidateHTTPCacheTaskKeysResponse_FailKey)(nil),      // 8: pb.ValidateHTTPCacheTaskKeysResponse.FailKey
+	(*UpdateHTTPCacheTaskKeysStatusRequest_KeyResult)(nil), // 9: pb.UpdateHTTPCacheTaskKeysStatusRequest.KeyResult
+	(*HTTPCacheTaskKey)(nil),                               // 10: pb.HTTPCacheTaskKey
+	(*RPCSuccess)(nil),                                     // 11: pb.RPCSuccess
+	(*RPCCountResponse)(nil),                               // 12: pb.RPCCountResponse
 }
 var file_service_http_cache_task_key_proto_depIdxs = []int32{
-	6,  // 0: pb.ValidateHTTPCacheTaskKeysResponse.failKeys:type_name -> pb.ValidateHTTPCacheTaskKeysResponse.FailKey
-	8,  // 1: pb.FindDoingHTTPCacheTaskKeysResponse.httpCacheTaskKeys:type_name -> pb.HTTPCacheTaskKey
-	7,  // 2: pb.UpdateHTTPCacheTaskKeysStatusRequest.keyResults:type_name -> pb.UpdateHTTPCacheTaskKeysStatusRequest.KeyResult
-	0,  // 3: pb.HTTPCacheTaskKeyService.validateHTTPCacheTaskKeys:input_type -> pb.ValidateHTTPCacheTaskKeysRequest
-	2,  // 4: pb.HTTPCacheTaskKeyService.findDoingHTTPCacheTaskKeys:input_type -> pb.FindDoingHTTPCacheTaskKeysRequest
-	4,  // 5: pb.HTTPCacheTaskKeyService.updateHTTPCacheTaskKeysStatus:input_type -> pb.UpdateHTTPCacheTaskKeysStatusRequest
-	5,  // 6: pb.HTTPCacheTaskKeyService.countHTTPCacheTaskKeysWithDay:input_type -> pb.CountHTTPCacheTaskKeysWithDayRequest
-	1,  // 7: pb.HTTPCacheTaskKeyService.validateHTTPCacheTaskKeys:output_type -> pb.ValidateHTTPCacheTaskKeysResponse
-	3,  // 8: pb.HTTPCacheTaskKeyService.findDoingHTTPCacheTaskKeys:output_type -> pb.FindDoingHTTPCacheTaskKeysResponse
-	9,  // 9: pb.HTTPCacheTaskKeyService.updateHTTPCacheTaskKeysStatus:output_type -> pb.RPCSuccess
-	10, // 10: pb.HTTPCacheTaskKeyService.countHTTPCacheTaskKeysWithDay:output_type -> pb.RPCCountResponse
-	7,  // [7:11] is the sub-list for method output_type
-	3,  // [3:7] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	8,  // 0: pb.ValidateHTTPCacheTaskKeysResponse.failKeys:type_name -> pb.ValidateHTTPCacheTaskKeysResponse.FailKey
+	10, // 1: pb.FindDoingHTTPCacheTaskKeysResponse.httpCacheTaskKeys:type_name -> pb.HTTPCacheTaskKey
+	9,  // 2: pb.UpdateHTTPCacheTaskKeysStatusRequest.keyResults:type_name -> pb.UpdateHTTPCacheTaskKeysStatusRequest.KeyResult
+	10, // 3: pb.ListHTTPCacheTaskKeysResponse.httpCacheTaskKeys:type_name -> pb.HTTPCacheTaskKey
+	0,  // 4: pb.HTTPCacheTaskKeyService.validateHTTPCacheTaskKeys:input_type -> pb.ValidateHTTPCacheTaskKeysRequest
+	2,  // 5: pb.HTTPCacheTaskKeyService.findDoingHTTPCacheTaskKeys:input_type -> pb.FindDoingHTTPCacheTaskKeysRequest
+	4,  // 6: pb.HTTPCacheTaskKeyService.updateHTTPCacheTaskKeysStatus:input_type -> pb.UpdateHTTPCacheTaskKeysStatusRequest
+	5,  // 7: pb.HTTPCacheTaskKeyService.countHTTPCacheTaskKeysWithDay:input_type -> pb.CountHTTPCacheTaskKeysWithDayRequest
+	6,  // 8: pb.HTTPCacheTaskKeyService.listHTTPCacheTaskKeys:input_type -> pb.ListHTTPCacheTaskKeysRequest
+	1,  // 9: pb.HTTPCacheTaskKeyService.validateHTTPCacheTaskKeys:output_type -> pb.ValidateHTTPCacheTaskKeysResponse
+	3,  // 10: pb.HTTPCacheTaskKeyService.findDoingHTTPCacheTaskKeys:output_type -> pb.FindDoingHTTPCacheTaskKeysResponse
+	11, // 11: pb.HTTPCacheTaskKeyService.updateHTTPCacheTaskKeysStatus:output_type -> pb.RPCSuccess
+	12, // 12: pb.HTTPCacheTaskKeyService.countHTTPCacheTaskKeysWithDay:output_type -> pb.RPCCountResponse
+	7,  // 13: pb.HTTPCacheTaskKeyService.listHTTPCacheTaskKeys:output_type -> pb.ListHTTPCacheTaskKeysResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_service_http_cache_task_key_proto_init() }
@@ -641,7 +787,7 @@ func file_service_http_cache_task_key_proto_init() {
 			}
 		}
 		file_service_http_cache_task_key_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateHTTPCacheTaskKeysResponse_FailKey); i {
+			switch v := v.(*ListHTTPCacheTaskKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -653,6 +799,30 @@ func file_service_http_cache_task_key_proto_init() {
 			}
 		}
 		file_service_http_cache_task_key_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHTTPCacheTaskKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_http_cache_task_key_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateHTTPCacheTaskKeysResponse_FailKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_http_cache_task_key_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateHTTPCacheTaskKeysStatusRequest_KeyResult); i {
 			case 0:
 				return &v.state
@@ -671,7 +841,7 @@ func file_service_http_cache_task_key_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_http_cache_task_key_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
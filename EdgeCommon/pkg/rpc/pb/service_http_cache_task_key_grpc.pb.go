@@ -23,6 +23,7 @@ const (
 	HTTPCacheTaskKeyService_FindDoingHTTPCacheTaskKeys_FullMethodName    = "/pb.HTTPCacheTaskKeyService/findDoingHTTPCacheTaskKeys"
 	HTTPCacheTaskKeyService_UpdateHTTPCacheTaskKeysStatus_FullMethodName = "/pb.HTTPCacheTaskKeyService/updateHTTPCacheTaskKeysStatus"
 	HTTPCacheTaskKeyService_CountHTTPCacheTaskKeysWithDay_FullMethodName = "/pb.HTTPCacheTaskKeyService/countHTTPCacheTaskKeysWithDay"
+	HTTPCacheTaskKeyService_ListHTTPCacheTaskKeys_FullMethodName         = "/pb.HTTPCacheTaskKeyService/listHTTPCacheTaskKeys"
 )
 
 // HTTPCacheTaskKeyServiceClient is the client API for HTTPCacheTaskKeyService service.
@@ -37,6 +38,8 @@ type HTTPCacheTaskKeyServiceClient interface {
 	UpdateHTTPCacheTaskKeysStatus(ctx context.Context, in *UpdateHTTPCacheTaskKeysStatusRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 	// 计算当天已经清理的Key数量
 	CountHTTPCacheTaskKeysWithDay(ctx context.Context, in *CountHTTPCacheTaskKeysWithDayRequest, opts ...grpc.CallOption) (*RPCCountResponse, error)
+	// 列出任务下的单页Key及执行结果
+	ListHTTPCacheTaskKeys(ctx context.Context, in *ListHTTPCacheTaskKeysRequest, opts ...grpc.CallOption) (*ListHTTPCacheTaskKeysResponse, error)
 }
 
 type hTTPCacheTaskKeyServiceClient struct {
@@ -83,6 +86,15 @@ func (c *hTTPCacheTaskKeyServiceClient) CountHTTPCacheTaskKeysWithDay(ctx contex
 	return out, nil
 }
 
+func (c *hTTPCacheTaskKeyServiceClient) ListHTTPCacheTaskKeys(ctx context.Context, in *ListHTTPCacheTaskKeysRequest, opts ...grpc.CallOption) (*ListHTTPCacheTaskKeysResponse, error) {
+	out := new(ListHTTPCacheTaskKeysResponse)
+	err := c.cc.Invoke(ctx, HTTPCacheTaskKeyService_ListHTTPCacheTaskKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HTTPCacheTaskKeyServiceServer is the server API for HTTPCacheTaskKeyService service.
 // All implementations should embed UnimplementedHTTPCacheTaskKeyServiceServer
 // for forward compatibility
@@ -95,6 +107,8 @@ type HTTPCacheTaskKeyServiceServer interface {
 	UpdateHTTPCacheTaskKeysStatus(context.Context, *UpdateHTTPCacheTaskKeysStatusRequest) (*RPCSuccess, error)
 	// 计算当天已经清理的Key数量
 	CountHTTPCacheTaskKeysWithDay(context.Context, *CountHTTPCacheTaskKeysWithDayRequest) (*RPCCountResponse, error)
+	// 列出任务下的单页Key及执行结果
+	ListHTTPCacheTaskKeys(context.Context, *ListHTTPCacheTaskKeysRequest) (*ListHTTPCacheTaskKeysResponse, error)
 }
 
 // UnimplementedHTTPCacheTaskKeyServiceServer should be embedded to have forward compatible implementations.
@@ -113,6 +127,9 @@ func (UnimplementedHTTPCacheTaskKeyServiceServer) UpdateHTTPCacheTaskKeysStatus(
 func (UnimplementedHTTPCacheTaskKeyServiceServer) CountHTTPCacheTaskKeysWithDay(context.Context, *CountHTTPCacheTaskKeysWithDayRequest) (*RPCCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CountHTTPCacheTaskKeysWithDay not implemented")
 }
+func (UnimplementedHTTPCacheTaskKeyServiceServer) ListHTTPCacheTaskKeys(context.Context, *ListHTTPCacheTaskKeysRequest) (*ListHTTPCacheTaskKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHTTPCacheTaskKeys not implemented")
+}
 
 // UnsafeHTTPCacheTaskKeyServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to HTTPCacheTaskKeyServiceServer will
@@ -197,6 +214,24 @@ func _HTTPCacheTaskKeyService_CountHTTPCacheTaskKeysWithDay_Handler(srv interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HTTPCacheTaskKeyService_ListHTTPCacheTaskKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHTTPCacheTaskKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HTTPCacheTaskKeyServiceServer).ListHTTPCacheTaskKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HTTPCacheTaskKeyService_ListHTTPCacheTaskKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HTTPCacheTaskKeyServiceServer).ListHTTPCacheTaskKeys(ctx, req.(*ListHTTPCacheTaskKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HTTPCacheTaskKeyService_ServiceDesc is the grpc.ServiceDesc for HTTPCacheTaskKeyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -220,6 +255,10 @@ var HTTPCacheTaskKeyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "countHTTPCacheTaskKeysWithDay",
 			Handler:    _HTTPCacheTaskKeyService_CountHTTPCacheTaskKeysWithDay_Handler,
 		},
+		{
+			MethodName: "listHTTPCacheTaskKeys",
+			Handler:    _HTTPCacheTaskKeyService_ListHTTPCacheTaskKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_http_cache_task_key.proto",
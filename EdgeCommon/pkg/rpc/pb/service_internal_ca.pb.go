@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_internal_ca.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 查找当前内部CA信息
+type FindInternalCARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindInternalCARequest) Reset() {
+	*x = FindInternalCARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_internal_ca_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindInternalCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindInternalCARequest) ProtoMessage() {}
+
+func (x *FindInternalCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_internal_ca_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindInternalCARequest.ProtoReflect.Descriptor instead.
+func (*FindInternalCARequest) Descriptor() ([]byte, []int) {
+	return file_service_internal_ca_proto_rawDescGZIP(), []int{0}
+}
+
+type FindInternalCAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaCertData       []byte `protobuf:"bytes,1,opt,name=caCertData,proto3" json:"caCertData,omitempty"`              // 当前CA证书，PEM格式
+	CreatedAt        int64  `protobuf:"varint,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`               // 创建时间
+	ExpiresAt        int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`               // 过期时间
+	CountPreviousCAs int32  `protobuf:"varint,4,opt,name=countPreviousCAs,proto3" json:"countPreviousCAs,omitempty"` // 仍然被信任的旧CA数量
+	MtlsConfigJSON   []byte `protobuf:"bytes,5,opt,name=mtlsConfigJSON,proto3" json:"mtlsConfigJSON,omitempty"`      // 双向TLS认证设置
+}
+
+func (x *FindInternalCAResponse) Reset() {
+	*x = FindInternalCAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_internal_ca_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindInternalCAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindInternalCAResponse) ProtoMessage() {}
+
+func (x *FindInternalCAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_internal_ca_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindInternalCAResponse.ProtoReflect.Descriptor instead.
+func (*FindInternalCAResponse) Descriptor() ([]byte, []int) {
+	return file_service_internal_ca_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FindInternalCAResponse) GetCaCertData() []byte {
+	if x != nil {
+		return x.CaCertData
+	}
+	return nil
+}
+
+func (x *FindInternalCAResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *FindInternalCAResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *FindInternalCAResponse) GetCountPreviousCAs() int32 {
+	if x != nil {
+		return x.CountPreviousCAs
+	}
+	return 0
+}
+
+func (x *FindInternalCAResponse) GetMtlsConfigJSON() []byte {
+	if x != nil {
+		return x.MtlsConfigJSON
+	}
+	return nil
+}
+
+// 轮换内部CA
+type RotateInternalCARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RotateInternalCARequest) Reset() {
+	*x = RotateInternalCARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_internal_ca_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateInternalCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateInternalCARequest) ProtoMessage() {}
+
+func (x *RotateInternalCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_internal_ca_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateInternalCARequest.ProtoReflect.Descriptor instead.
+func (*RotateInternalCARequest) Descriptor() ([]byte, []int) {
+	return file_service_internal_ca_proto_rawDescGZIP(), []int{2}
+}
+
+// 删除轮换之前的CA
+type RevokePreviousInternalCAsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokePreviousInternalCAsRequest) Reset() {
+	*x = RevokePreviousInternalCAsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_internal_ca_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokePreviousInternalCAsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePreviousInternalCAsRequest) ProtoMessage() {}
+
+func (x *RevokePreviousInternalCAsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_internal_ca_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePreviousInternalCAsRequest.ProtoReflect.Descriptor instead.
+func (*RevokePreviousInternalCAsRequest) Descriptor() ([]byte, []int) {
+	return file_service_internal_ca_proto_rawDescGZIP(), []int{3}
+}
+
+// 为当前节点签发客户端证书
+type IssueNodeClientCertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CsrData []byte `protobuf:"bytes,1,opt,name=csrData,proto3" json:"csrData,omitempty"` // 证书请求，PEM格式
+}
+
+func (x *IssueNodeClientCertRequest) Reset() {
+	*x = IssueNodeClientCertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_internal_ca_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueNodeClientCertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueNodeClientCertRequest) ProtoMessage() {}
+
+func (x *IssueNodeClientCertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_internal_ca_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueNodeClientCertRequest.ProtoReflect.Descriptor instead.
+func (*IssueNodeClientCertRequest) Descriptor() ([]byte, []int) {
+	return file_service_internal_ca_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IssueNodeClientCertRequest) GetCsrData() []byte {
+	if x != nil {
+		return x.CsrData
+	}
+	return nil
+}
+
+type IssueNodeClientCertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CertData   []byte `protobuf:"bytes,1,opt,name=certData,proto3" json:"certData,omitempty"`     // 客户端证书，PEM格式
+	CaCertData []byte `protobuf:"bytes,2,opt,name=caCertData,proto3" json:"caCertData,omitempty"` // 所有被信任的CA证书，PEM格式
+	ExpiresAt  int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`  // 客户端证书过期时间
+}
+
+func (x *IssueNodeClientCertResponse) Reset() {
+	*x = IssueNodeClientCertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_internal_ca_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueNodeClientCertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueNodeClientCertResponse) ProtoMessage() {}
+
+func (x *IssueNodeClientCertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_internal_ca_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueNodeClientCertResponse.ProtoReflect.Descriptor instead.
+func (*IssueNodeClientCertResponse) Descriptor() ([]byte, []int) {
+	return file_service_internal_ca_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *IssueNodeClientCertResponse) GetCertData() []byte {
+	if x != nil {
+		return x.CertData
+	}
+	return nil
+}
+
+func (x *IssueNodeClientCertResponse) GetCaCertData() []byte {
+	if x != nil {
+		return x.CaCertData
+	}
+	return nil
+}
+
+func (x *IssueNodeClientCertResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+var File_service_internal_ca_proto protoreflect.FileDescriptor
+
+var file_service_internal_ca_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x63, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a,
+	0x19, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x17, 0x0a, 0x15, 0x46, 0x69,
+	0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x43, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x41, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x43, 0x41, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x74, 0x6c, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x6d, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x19,
+	0x0a, 0x17, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x22, 0x0a, 0x20, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x43, 0x41, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a,
+	0x1a, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x73, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x73,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x77, 0x0a, 0x1b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x32, 0xc8,
+	0x02, 0x0a, 0x11, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x41, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x43, 0x41, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x43, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x10, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43,
+	0x41, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x51,
+	0x0a, 0x19, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x41, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x41, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x56, 0x0a, 0x13, 0x69, 0x73, 0x73, 0x75, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_internal_ca_proto_rawDescOnce sync.Once
+	file_service_internal_ca_proto_rawDescData = file_service_internal_ca_proto_rawDesc
+)
+
+func file_service_internal_ca_proto_rawDescGZIP() []byte {
+	file_service_internal_ca_proto_rawDescOnce.Do(func() {
+		file_service_internal_ca_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_internal_ca_proto_rawDescData)
+	})
+	return file_service_internal_ca_proto_rawDescData
+}
+
+var file_service_internal_ca_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_service_internal_ca_proto_goTypes = []interface{}{
+	(*FindInternalCARequest)(nil),            // 0: pb.FindInternalCARequest
+	(*FindInternalCAResponse)(nil),           // 1: pb.FindInternalCAResponse
+	(*RotateInternalCARequest)(nil),          // 2: pb.RotateInternalCARequest
+	(*RevokePreviousInternalCAsRequest)(nil), // 3: pb.RevokePreviousInternalCAsRequest
+	(*IssueNodeClientCertRequest)(nil),       // 4: pb.IssueNodeClientCertRequest
+	(*IssueNodeClientCertResponse)(nil),      // 5: pb.IssueNodeClientCertResponse
+	(*RPCSuccess)(nil),                       // 6: pb.RPCSuccess
+}
+var file_service_internal_ca_proto_depIdxs = []int32{
+	0, // 0: pb.InternalCAService.findInternalCA:input_type -> pb.FindInternalCARequest
+	2, // 1: pb.InternalCAService.rotateInternalCA:input_type -> pb.RotateInternalCARequest
+	3, // 2: pb.InternalCAService.revokePreviousInternalCAs:input_type -> pb.RevokePreviousInternalCAsRequest
+	4, // 3: pb.InternalCAService.issueNodeClientCert:input_type -> pb.IssueNodeClientCertRequest
+	1, // 4: pb.InternalCAService.findInternalCA:output_type -> pb.FindInternalCAResponse
+	6, // 5: pb.InternalCAService.rotateInternalCA:output_type -> pb.RPCSuccess
+	6, // 6: pb.InternalCAService.revokePreviousInternalCAs:output_type -> pb.RPCSuccess
+	5, // 7: pb.InternalCAService.issueNodeClientCert:output_type -> pb.IssueNodeClientCertResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_service_internal_ca_proto_init() }
+func file_service_internal_ca_proto_init() {
+	if File_service_internal_ca_proto != nil {
+		return
+	}
+	file_models_rpc_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_internal_ca_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindInternalCARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_internal_ca_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindInternalCAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_internal_ca_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateInternalCARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_internal_ca_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokePreviousInternalCAsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_internal_ca_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueNodeClientCertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_internal_ca_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueNodeClientCertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_internal_ca_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_internal_ca_proto_goTypes,
+		DependencyIndexes: file_service_internal_ca_proto_depIdxs,
+		MessageInfos:      file_service_internal_ca_proto_msgTypes,
+	}.Build()
+	File_service_internal_ca_proto = out.File
+	file_service_internal_ca_proto_rawDesc = nil
+	file_service_internal_ca_proto_goTypes = nil
+	file_service_internal_ca_proto_depIdxs = nil
+}
@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_internal_ca.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	InternalCAService_FindInternalCA_FullMethodName            = "/pb.InternalCAService/findInternalCA"
+	InternalCAService_RotateInternalCA_FullMethodName          = "/pb.InternalCAService/rotateInternalCA"
+	InternalCAService_RevokePreviousInternalCAs_FullMethodName = "/pb.InternalCAService/revokePreviousInternalCAs"
+	InternalCAService_IssueNodeClientCert_FullMethodName       = "/pb.InternalCAService/issueNodeClientCert"
+)
+
+// InternalCAServiceClient is the client API for InternalCAService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InternalCAServiceClient interface {
+	// 查找当前内部CA信息
+	FindInternalCA(ctx context.Context, in *FindInternalCARequest, opts ...grpc.CallOption) (*FindInternalCAResponse, error)
+	// 轮换内部CA
+	RotateInternalCA(ctx context.Context, in *RotateInternalCARequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 删除轮换之前的CA，删除后旧CA签发的客户端证书将不能再通过认证
+	RevokePreviousInternalCAs(ctx context.Context, in *RevokePreviousInternalCAsRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 为当前节点签发客户端证书
+	IssueNodeClientCert(ctx context.Context, in *IssueNodeClientCertRequest, opts ...grpc.CallOption) (*IssueNodeClientCertResponse, error)
+}
+
+type internalCAServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInternalCAServiceClient(cc grpc.ClientConnInterface) InternalCAServiceClient {
+	return &internalCAServiceClient{cc}
+}
+
+func (c *internalCAServiceClient) FindInternalCA(ctx context.Context, in *FindInternalCARequest, opts ...grpc.CallOption) (*FindInternalCAResponse, error) {
+	out := new(FindInternalCAResponse)
+	err := c.cc.Invoke(ctx, InternalCAService_FindInternalCA_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalCAServiceClient) RotateInternalCA(ctx context.Context, in *RotateInternalCARequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, InternalCAService_RotateInternalCA_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalCAServiceClient) RevokePreviousInternalCAs(ctx context.Context, in *RevokePreviousInternalCAsRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, InternalCAService_RevokePreviousInternalCAs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalCAServiceClient) IssueNodeClientCert(ctx context.Context, in *IssueNodeClientCertRequest, opts ...grpc.CallOption) (*IssueNodeClientCertResponse, error) {
+	out := new(IssueNodeClientCertResponse)
+	err := c.cc.Invoke(ctx, InternalCAService_IssueNodeClientCert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InternalCAServiceServer is the server API for InternalCAService service.
+// All implementations should embed UnimplementedInternalCAServiceServer
+// for forward compatibility
+type InternalCAServiceServer interface {
+	// 查找当前内部CA信息
+	FindInternalCA(context.Context, *FindInternalCARequest) (*FindInternalCAResponse, error)
+	// 轮换内部CA
+	RotateInternalCA(context.Context, *RotateInternalCARequest) (*RPCSuccess, error)
+	// 删除轮换之前的CA，删除后旧CA签发的客户端证书将不能再通过认证
+	RevokePreviousInternalCAs(context.Context, *RevokePreviousInternalCAsRequest) (*RPCSuccess, error)
+	// 为当前节点签发客户端证书
+	IssueNodeClientCert(context.Context, *IssueNodeClientCertRequest) (*IssueNodeClientCertResponse, error)
+}
+
+// UnimplementedInternalCAServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedInternalCAServiceServer struct {
+}
+
+func (UnimplementedInternalCAServiceServer) FindInternalCA(context.Context, *FindInternalCARequest) (*FindInternalCAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindInternalCA not implemented")
+}
+func (UnimplementedInternalCAServiceServer) RotateInternalCA(context.Context, *RotateInternalCARequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateInternalCA not implemented")
+}
+func (UnimplementedInternalCAServiceServer) RevokePreviousInternalCAs(context.Context, *RevokePreviousInternalCAsRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePreviousInternalCAs not implemented")
+}
+func (UnimplementedInternalCAServiceServer) IssueNodeClientCert(context.Context, *IssueNodeClientCertRequest) (*IssueNodeClientCertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueNodeClientCert not implemented")
+}
+
+// UnsafeInternalCAServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InternalCAServiceServer will
+// result in compilation errors.
+type UnsafeInternalCAServiceServer interface {
+	mustEmbedUnimplementedInternalCAServiceServer()
+}
+
+func RegisterInternalCAServiceServer(s grpc.ServiceRegistrar, srv InternalCAServiceServer) {
+	s.RegisterService(&InternalCAService_ServiceDesc, srv)
+}
+
+func _InternalCAService_FindInternalCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindInternalCARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalCAServiceServer).FindInternalCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalCAService_FindInternalCA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalCAServiceServer).FindInternalCA(ctx, req.(*FindInternalCARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalCAService_RotateInternalCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateInternalCARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalCAServiceServer).RotateInternalCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalCAService_RotateInternalCA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalCAServiceServer).RotateInternalCA(ctx, req.(*RotateInternalCARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalCAService_RevokePreviousInternalCAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePreviousInternalCAsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalCAServiceServer).RevokePreviousInternalCAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalCAService_RevokePreviousInternalCAs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalCAServiceServer).RevokePreviousInternalCAs(ctx, req.(*RevokePreviousInternalCAsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalCAService_IssueNodeClientCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueNodeClientCertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalCAServiceServer).IssueNodeClientCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalCAService_IssueNodeClientCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalCAServiceServer).IssueNodeClientCert(ctx, req.(*IssueNodeClientCertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InternalCAService_ServiceDesc is the grpc.ServiceDesc for InternalCAService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InternalCAService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.InternalCAService",
+	HandlerType: (*InternalCAServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "findInternalCA",
+			Handler:    _InternalCAService_FindInternalCA_Handler,
+		},
+		{
+			MethodName: "rotateInternalCA",
+			Handler:    _InternalCAService_RotateInternalCA_Handler,
+		},
+		{
+			MethodName: "revokePreviousInternalCAs",
+			Handler:    _InternalCAService_RevokePreviousInternalCAs_Handler,
+		},
+		{
+			MethodName: "issueNodeClientCert",
+			Handler:    _InternalCAService_IssueNodeClientCert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_internal_ca.proto",
+}
@@ -25,6 +25,8 @@ const (
 	IPLibraryArtifactService_FindIPLibraryArtifact_FullMethodName           = "/pb.IPLibraryArtifactService/findIPLibraryArtifact"
 	IPLibraryArtifactService_FindPublicIPLibraryArtifact_FullMethodName     = "/pb.IPLibraryArtifactService/findPublicIPLibraryArtifact"
 	IPLibraryArtifactService_DeleteIPLibraryArtifact_FullMethodName         = "/pb.IPLibraryArtifactService/deleteIPLibraryArtifact"
+	IPLibraryArtifactService_DownloadIPLibraryArtifact_FullMethodName       = "/pb.IPLibraryArtifactService/downloadIPLibraryArtifact"
+	IPLibraryArtifactService_CompareIPLibraryArtifacts_FullMethodName       = "/pb.IPLibraryArtifactService/compareIPLibraryArtifacts"
 )
 
 // IPLibraryArtifactServiceClient is the client API for IPLibraryArtifactService service.
@@ -43,6 +45,10 @@ type IPLibraryArtifactServiceClient interface {
 	FindPublicIPLibraryArtifact(ctx context.Context, in *FindPublicIPLibraryArtifactRequest, opts ...grpc.CallOption) (*FindPublicIPLibraryArtifactResponse, error)
 	// 删除制品
 	DeleteIPLibraryArtifact(ctx context.Context, in *DeleteIPLibraryArtifactRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 从第三方IP库下载新的制品
+	DownloadIPLibraryArtifact(ctx context.Context, in *DownloadIPLibraryArtifactRequest, opts ...grpc.CallOption) (*DownloadIPLibraryArtifactResponse, error)
+	// 对比两个制品的查询结果
+	CompareIPLibraryArtifacts(ctx context.Context, in *CompareIPLibraryArtifactsRequest, opts ...grpc.CallOption) (*CompareIPLibraryArtifactsResponse, error)
 }
 
 type iPLibraryArtifactServiceClient struct {
@@ -107,6 +113,24 @@ func (c *iPLibraryArtifactServiceClient) DeleteIPLibraryArtifact(ctx context.Con
 	return out, nil
 }
 
+func (c *iPLibraryArtifactServiceClient) DownloadIPLibraryArtifact(ctx context.Context, in *DownloadIPLibraryArtifactRequest, opts ...grpc.CallOption) (*DownloadIPLibraryArtifactResponse, error) {
+	out := new(DownloadIPLibraryArtifactResponse)
+	err := c.cc.Invoke(ctx, IPLibraryArtifactService_DownloadIPLibraryArtifact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iPLibraryArtifactServiceClient) CompareIPLibraryArtifacts(ctx context.Context, in *CompareIPLibraryArtifactsRequest, opts ...grpc.CallOption) (*CompareIPLibraryArtifactsResponse, error) {
+	out := new(CompareIPLibraryArtifactsResponse)
+	err := c.cc.Invoke(ctx, IPLibraryArtifactService_CompareIPLibraryArtifacts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IPLibraryArtifactServiceServer is the server API for IPLibraryArtifactService service.
 // All implementations should embed UnimplementedIPLibraryArtifactServiceServer
 // for forward compatibility
@@ -123,6 +147,10 @@ type IPLibraryArtifactServiceServer interface {
 	FindPublicIPLibraryArtifact(context.Context, *FindPublicIPLibraryArtifactRequest) (*FindPublicIPLibraryArtifactResponse, error)
 	// 删除制品
 	DeleteIPLibraryArtifact(context.Context, *DeleteIPLibraryArtifactRequest) (*RPCSuccess, error)
+	// 从第三方IP库下载新的制品
+	DownloadIPLibraryArtifact(context.Context, *DownloadIPLibraryArtifactRequest) (*DownloadIPLibraryArtifactResponse, error)
+	// 对比两个制品的查询结果
+	CompareIPLibraryArtifacts(context.Context, *CompareIPLibraryArtifactsRequest) (*CompareIPLibraryArtifactsResponse, error)
 }
 
 // UnimplementedIPLibraryArtifactServiceServer should be embedded to have forward compatible implementations.
@@ -147,6 +175,12 @@ func (UnimplementedIPLibraryArtifactServiceServer) FindPublicIPLibraryArtifact(c
 func (UnimplementedIPLibraryArtifactServiceServer) DeleteIPLibraryArtifact(context.Context, *DeleteIPLibraryArtifactRequest) (*RPCSuccess, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteIPLibraryArtifact not implemented")
 }
+func (UnimplementedIPLibraryArtifactServiceServer) DownloadIPLibraryArtifact(context.Context, *DownloadIPLibraryArtifactRequest) (*DownloadIPLibraryArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadIPLibraryArtifact not implemented")
+}
+func (UnimplementedIPLibraryArtifactServiceServer) CompareIPLibraryArtifacts(context.Context, *CompareIPLibraryArtifactsRequest) (*CompareIPLibraryArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareIPLibraryArtifacts not implemented")
+}
 
 // UnsafeIPLibraryArtifactServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to IPLibraryArtifactServiceServer will
@@ -267,6 +301,42 @@ func _IPLibraryArtifactService_DeleteIPLibraryArtifact_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IPLibraryArtifactService_DownloadIPLibraryArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadIPLibraryArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IPLibraryArtifactServiceServer).DownloadIPLibraryArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IPLibraryArtifactService_DownloadIPLibraryArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IPLibraryArtifactServiceServer).DownloadIPLibraryArtifact(ctx, req.(*DownloadIPLibraryArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IPLibraryArtifactService_CompareIPLibraryArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareIPLibraryArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IPLibraryArtifactServiceServer).CompareIPLibraryArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IPLibraryArtifactService_CompareIPLibraryArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IPLibraryArtifactServiceServer).CompareIPLibraryArtifacts(ctx, req.(*CompareIPLibraryArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IPLibraryArtifactService_ServiceDesc is the grpc.ServiceDesc for IPLibraryArtifactService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -298,6 +368,14 @@ var IPLibraryArtifactService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "deleteIPLibraryArtifact",
 			Handler:    _IPLibraryArtifactService_DeleteIPLibraryArtifact_Handler,
 		},
+		{
+			MethodName: "downloadIPLibraryArtifact",
+			Handler:    _IPLibraryArtifactService_DownloadIPLibraryArtifact_Handler,
+		},
+		{
+			MethodName: "compareIPLibraryArtifacts",
+			Handler:    _IPLibraryArtifactService_CompareIPLibraryArtifacts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_ip_library_artifact.proto",
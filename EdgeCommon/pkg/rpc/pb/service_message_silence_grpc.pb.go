@@ -0,0 +1,304 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_message_silence.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MessageSilenceService_CreateMessageSilence_FullMethodName           = "/pb.MessageSilenceService/createMessageSilence"
+	MessageSilenceService_UpdateMessageSilence_FullMethodName           = "/pb.MessageSilenceService/updateMessageSilence"
+	MessageSilenceService_DeleteMessageSilence_FullMethodName           = "/pb.MessageSilenceService/deleteMessageSilence"
+	MessageSilenceService_CountAllEnabledMessageSilences_FullMethodName = "/pb.MessageSilenceService/countAllEnabledMessageSilences"
+	MessageSilenceService_ListEnabledMessageSilences_FullMethodName     = "/pb.MessageSilenceService/listEnabledMessageSilences"
+	MessageSilenceService_FindEnabledMessageSilence_FullMethodName      = "/pb.MessageSilenceService/findEnabledMessageSilence"
+)
+
+// MessageSilenceServiceClient is the client API for MessageSilenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MessageSilenceServiceClient interface {
+	// 创建静默规则
+	CreateMessageSilence(ctx context.Context, in *CreateMessageSilenceRequest, opts ...grpc.CallOption) (*CreateMessageSilenceResponse, error)
+	// 修改静默规则
+	UpdateMessageSilence(ctx context.Context, in *UpdateMessageSilenceRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 删除静默规则
+	DeleteMessageSilence(ctx context.Context, in *DeleteMessageSilenceRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 计算静默规则数量
+	CountAllEnabledMessageSilences(ctx context.Context, in *CountAllEnabledMessageSilencesRequest, opts ...grpc.CallOption) (*RPCCountResponse, error)
+	// 列出单页静默规则
+	ListEnabledMessageSilences(ctx context.Context, in *ListEnabledMessageSilencesRequest, opts ...grpc.CallOption) (*ListEnabledMessageSilencesResponse, error)
+	// 查找单个静默规则
+	FindEnabledMessageSilence(ctx context.Context, in *FindEnabledMessageSilenceRequest, opts ...grpc.CallOption) (*FindEnabledMessageSilenceResponse, error)
+}
+
+type messageSilenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMessageSilenceServiceClient(cc grpc.ClientConnInterface) MessageSilenceServiceClient {
+	return &messageSilenceServiceClient{cc}
+}
+
+func (c *messageSilenceServiceClient) CreateMessageSilence(ctx context.Context, in *CreateMessageSilenceRequest, opts ...grpc.CallOption) (*CreateMessageSilenceResponse, error) {
+	out := new(CreateMessageSilenceResponse)
+	err := c.cc.Invoke(ctx, MessageSilenceService_CreateMessageSilence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageSilenceServiceClient) UpdateMessageSilence(ctx context.Context, in *UpdateMessageSilenceRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, MessageSilenceService_UpdateMessageSilence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageSilenceServiceClient) DeleteMessageSilence(ctx context.Context, in *DeleteMessageSilenceRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, MessageSilenceService_DeleteMessageSilence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageSilenceServiceClient) CountAllEnabledMessageSilences(ctx context.Context, in *CountAllEnabledMessageSilencesRequest, opts ...grpc.CallOption) (*RPCCountResponse, error) {
+	out := new(RPCCountResponse)
+	err := c.cc.Invoke(ctx, MessageSilenceService_CountAllEnabledMessageSilences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageSilenceServiceClient) ListEnabledMessageSilences(ctx context.Context, in *ListEnabledMessageSilencesRequest, opts ...grpc.CallOption) (*ListEnabledMessageSilencesResponse, error) {
+	out := new(ListEnabledMessageSilencesResponse)
+	err := c.cc.Invoke(ctx, MessageSilenceService_ListEnabledMessageSilences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageSilenceServiceClient) FindEnabledMessageSilence(ctx context.Context, in *FindEnabledMessageSilenceRequest, opts ...grpc.CallOption) (*FindEnabledMessageSilenceResponse, error) {
+	out := new(FindEnabledMessageSilenceResponse)
+	err := c.cc.Invoke(ctx, MessageSilenceService_FindEnabledMessageSilence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MessageSilenceServiceServer is the server API for MessageSilenceService service.
+// All implementations should embed UnimplementedMessageSilenceServiceServer
+// for forward compatibility
+type MessageSilenceServiceServer interface {
+	// 创建静默规则
+	CreateMessageSilence(context.Context, *CreateMessageSilenceRequest) (*CreateMessageSilenceResponse, error)
+	// 修改静默规则
+	UpdateMessageSilence(context.Context, *UpdateMessageSilenceRequest) (*RPCSuccess, error)
+	// 删除静默规则
+	DeleteMessageSilence(context.Context, *DeleteMessageSilenceRequest) (*RPCSuccess, error)
+	// 计算静默规则数量
+	CountAllEnabledMessageSilences(context.Context, *CountAllEnabledMessageSilencesRequest) (*RPCCountResponse, error)
+	// 列出单页静默规则
+	ListEnabledMessageSilences(context.Context, *ListEnabledMessageSilencesRequest) (*ListEnabledMessageSilencesResponse, error)
+	// 查找单个静默规则
+	FindEnabledMessageSilence(context.Context, *FindEnabledMessageSilenceRequest) (*FindEnabledMessageSilenceResponse, error)
+}
+
+// UnimplementedMessageSilenceServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedMessageSilenceServiceServer struct {
+}
+
+func (UnimplementedMessageSilenceServiceServer) CreateMessageSilence(context.Context, *CreateMessageSilenceRequest) (*CreateMessageSilenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMessageSilence not implemented")
+}
+func (UnimplementedMessageSilenceServiceServer) UpdateMessageSilence(context.Context, *UpdateMessageSilenceRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMessageSilence not implemented")
+}
+func (UnimplementedMessageSilenceServiceServer) DeleteMessageSilence(context.Context, *DeleteMessageSilenceRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessageSilence not implemented")
+}
+func (UnimplementedMessageSilenceServiceServer) CountAllEnabledMessageSilences(context.Context, *CountAllEnabledMessageSilencesRequest) (*RPCCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountAllEnabledMessageSilences not implemented")
+}
+func (UnimplementedMessageSilenceServiceServer) ListEnabledMessageSilences(context.Context, *ListEnabledMessageSilencesRequest) (*ListEnabledMessageSilencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnabledMessageSilences not implemented")
+}
+func (UnimplementedMessageSilenceServiceServer) FindEnabledMessageSilence(context.Context, *FindEnabledMessageSilenceRequest) (*FindEnabledMessageSilenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindEnabledMessageSilence not implemented")
+}
+
+// UnsafeMessageSilenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MessageSilenceServiceServer will
+// result in compilation errors.
+type UnsafeMessageSilenceServiceServer interface {
+	mustEmbedUnimplementedMessageSilenceServiceServer()
+}
+
+func RegisterMessageSilenceServiceServer(s grpc.ServiceRegistrar, srv MessageSilenceServiceServer) {
+	s.RegisterService(&MessageSilenceService_ServiceDesc, srv)
+}
+
+func _MessageSilenceService_CreateMessageSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMessageSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageSilenceServiceServer).CreateMessageSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageSilenceService_CreateMessageSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageSilenceServiceServer).CreateMessageSilence(ctx, req.(*CreateMessageSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageSilenceService_UpdateMessageSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMessageSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageSilenceServiceServer).UpdateMessageSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageSilenceService_UpdateMessageSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageSilenceServiceServer).UpdateMessageSilence(ctx, req.(*UpdateMessageSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageSilenceService_DeleteMessageSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageSilenceServiceServer).DeleteMessageSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageSilenceService_DeleteMessageSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageSilenceServiceServer).DeleteMessageSilence(ctx, req.(*DeleteMessageSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageSilenceService_CountAllEnabledMessageSilences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountAllEnabledMessageSilencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageSilenceServiceServer).CountAllEnabledMessageSilences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageSilenceService_CountAllEnabledMessageSilences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageSilenceServiceServer).CountAllEnabledMessageSilences(ctx, req.(*CountAllEnabledMessageSilencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageSilenceService_ListEnabledMessageSilences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnabledMessageSilencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageSilenceServiceServer).ListEnabledMessageSilences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageSilenceService_ListEnabledMessageSilences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageSilenceServiceServer).ListEnabledMessageSilences(ctx, req.(*ListEnabledMessageSilencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageSilenceService_FindEnabledMessageSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindEnabledMessageSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageSilenceServiceServer).FindEnabledMessageSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageSilenceService_FindEnabledMessageSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageSilenceServiceServer).FindEnabledMessageSilence(ctx, req.(*FindEnabledMessageSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MessageSilenceService_ServiceDesc is the grpc.ServiceDesc for MessageSilenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MessageSilenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.MessageSilenceService",
+	HandlerType: (*MessageSilenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "createMessageSilence",
+			Handler:    _MessageSilenceService_CreateMessageSilence_Handler,
+		},
+		{
+			MethodName: "updateMessageSilence",
+			Handler:    _MessageSilenceService_UpdateMessageSilence_Handler,
+		},
+		{
+			MethodName: "deleteMessageSilence",
+			Handler:    _MessageSilenceService_DeleteMessageSilence_Handler,
+		},
+		{
+			MethodName: "countAllEnabledMessageSilences",
+			Handler:    _MessageSilenceService_CountAllEnabledMessageSilences_Handler,
+		},
+		{
+			MethodName: "listEnabledMessageSilences",
+			Handler:    _MessageSilenceService_ListEnabledMessageSilences_Handler,
+		},
+		{
+			MethodName: "findEnabledMessageSilence",
+			Handler:    _MessageSilenceService_FindEnabledMessageSilence_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_message_silence.proto",
+}
@@ -0,0 +1,504 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_node_command.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 查找所有可以远程执行的命令
+type FindAllNodeCommandsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindAllNodeCommandsRequest) Reset() {
+	*x = FindAllNodeCommandsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_node_command_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllNodeCommandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllNodeCommandsRequest) ProtoMessage() {}
+
+func (x *FindAllNodeCommandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_node_command_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllNodeCommandsRequest.ProtoReflect.Descriptor instead.
+func (*FindAllNodeCommandsRequest) Descriptor() ([]byte, []int) {
+	return file_service_node_command_proto_rawDescGZIP(), []int{0}
+}
+
+type FindAllNodeCommandsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeCommands []*FindAllNodeCommandsResponse_NodeCommand `protobuf:"bytes,1,rep,name=nodeCommands,proto3" json:"nodeCommands,omitempty"` // 命令列表
+}
+
+func (x *FindAllNodeCommandsResponse) Reset() {
+	*x = FindAllNodeCommandsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_node_command_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllNodeCommandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllNodeCommandsResponse) ProtoMessage() {}
+
+func (x *FindAllNodeCommandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_node_command_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllNodeCommandsResponse.ProtoReflect.Descriptor instead.
+func (*FindAllNodeCommandsResponse) Descriptor() ([]byte, []int) {
+	return file_service_node_command_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FindAllNodeCommandsResponse) GetNodeCommands() []*FindAllNodeCommandsResponse_NodeCommand {
+	if x != nil {
+		return x.NodeCommands
+	}
+	return nil
+}
+
+// 在一组节点上执行远程命令
+type RunNodeCommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command        string  `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`                // 命令代号：reloadConfig, restartService, clearLocalCache, collectDiagnostics
+	NodeIds        []int64 `protobuf:"varint,2,rep,packed,name=nodeIds,proto3" json:"nodeIds,omitempty"`        // 节点ID列表
+	NodeClusterId  int64   `protobuf:"varint,3,opt,name=nodeClusterId,proto3" json:"nodeClusterId,omitempty"`   // 集群ID，如果指定则在集群所有节点上执行
+	TimeoutSeconds int32   `protobuf:"varint,4,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"` // 每个节点的超时时间，默认30秒
+}
+
+func (x *RunNodeCommandRequest) Reset() {
+	*x = RunNodeCommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_node_command_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunNodeCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNodeCommandRequest) ProtoMessage() {}
+
+func (x *RunNodeCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_node_command_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNodeCommandRequest.ProtoReflect.Descriptor instead.
+func (*RunNodeCommandRequest) Descriptor() ([]byte, []int) {
+	return file_service_node_command_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RunNodeCommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *RunNodeCommandRequest) GetNodeIds() []int64 {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *RunNodeCommandRequest) GetNodeClusterId() int64 {
+	if x != nil {
+		return x.NodeClusterId
+	}
+	return 0
+}
+
+func (x *RunNodeCommandRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type RunNodeCommandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId   int64  `protobuf:"varint,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`    // 节点ID
+	NodeName string `protobuf:"bytes,2,opt,name=nodeName,proto3" json:"nodeName,omitempty"` // 节点名称
+	IsOk     bool   `protobuf:"varint,3,opt,name=isOk,proto3" json:"isOk,omitempty"`        // 是否执行成功
+	Message  string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`   // 执行结果或错误信息
+	DataJSON []byte `protobuf:"bytes,5,opt,name=dataJSON,proto3" json:"dataJSON,omitempty"` // 结果数据，比如收集的诊断信息
+	CostMs   int64  `protobuf:"varint,6,opt,name=costMs,proto3" json:"costMs,omitempty"`    // 耗时（毫秒）
+}
+
+func (x *RunNodeCommandResponse) Reset() {
+	*x = RunNodeCommandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_node_command_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunNodeCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNodeCommandResponse) ProtoMessage() {}
+
+func (x *RunNodeCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_node_command_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNodeCommandResponse.ProtoReflect.Descriptor instead.
+func (*RunNodeCommandResponse) Descriptor() ([]byte, []int) {
+	return file_service_node_command_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RunNodeCommandResponse) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *RunNodeCommandResponse) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *RunNodeCommandResponse) GetIsOk() bool {
+	if x != nil {
+		return x.IsOk
+	}
+	return false
+}
+
+func (x *RunNodeCommandResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RunNodeCommandResponse) GetDataJSON() []byte {
+	if x != nil {
+		return x.DataJSON
+	}
+	return nil
+}
+
+func (x *RunNodeCommandResponse) GetCostMs() int64 {
+	if x != nil {
+		return x.CostMs
+	}
+	return 0
+}
+
+type FindAllNodeCommandsResponse_NodeCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`               // 代号
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // 名称
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // 描述
+}
+
+func (x *FindAllNodeCommandsResponse_NodeCommand) Reset() {
+	*x = FindAllNodeCommandsResponse_NodeCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_node_command_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllNodeCommandsResponse_NodeCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllNodeCommandsResponse_NodeCommand) ProtoMessage() {}
+
+func (x *FindAllNodeCommandsResponse_NodeCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_service_node_command_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllNodeCommandsResponse_NodeCommand.ProtoReflect.Descriptor instead.
+func (*FindAllNodeCommandsResponse_NodeCommand) Descriptor() ([]byte, []int) {
+	return file_service_node_command_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *FindAllNodeCommandsResponse_NodeCommand) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *FindAllNodeCommandsResponse_NodeCommand) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FindAllNodeCommandsResponse_NodeCommand) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_service_node_command_proto protoreflect.FileDescriptor
+
+var file_service_node_command_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62,
+	0x22, 0x1c, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc7,
+	0x01, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x1a,
+	0x57, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x99, 0x01, 0x0a, 0x15, 0x52, 0x75, 0x6e,
+	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e,
+	0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x16, 0x52, 0x75, 0x6e, 0x4e, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63,
+	0x6f, 0x73, 0x74, 0x4d, 0x73, 0x32, 0xb7, 0x01, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x13,
+	0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
+	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
+	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x72, 0x75, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42,
+	0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_node_command_proto_rawDescOnce sync.Once
+	file_service_node_command_proto_rawDescData = file_service_node_command_proto_rawDesc
+)
+
+func file_service_node_command_proto_rawDescGZIP() []byte {
+	file_service_node_command_proto_rawDescOnce.Do(func() {
+		file_service_node_command_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_node_command_proto_rawDescData)
+	})
+	return file_service_node_command_proto_rawDescData
+}
+
+var file_service_node_command_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_service_node_command_proto_goTypes = []interface{}{
+	(*FindAllNodeCommandsRequest)(nil),              // 0: pb.FindAllNodeCommandsRequest
+	(*FindAllNodeCommandsResponse)(nil),             // 1: pb.FindAllNodeCommandsResponse
+	(*RunNodeCommandRequest)(nil),                   // 2: pb.RunNodeCommandRequest
+	(*RunNodeCommandResponse)(nil),                  // 3: pb.RunNodeCommandResponse
+	(*FindAllNodeCommandsResponse_NodeCommand)(nil), // 4: pb.FindAllNodeCommandsResponse.NodeCommand
+}
+var file_service_node_command_proto_depIdxs = []int32{
+	4, // 0: pb.FindAllNodeCommandsResponse.nodeCommands:type_name -> pb.FindAllNodeCommandsResponse.NodeCommand
+	0, // 1: pb.NodeCommandService.findAllNodeCommands:input_type -> pb.FindAllNodeCommandsRequest
+	2, // 2: pb.NodeCommandService.runNodeCommand:input_type -> pb.RunNodeCommandRequest
+	1, // 3: pb.NodeCommandService.findAllNodeCommands:output_type -> pb.FindAllNodeCommandsResponse
+	3, // 4: pb.NodeCommandService.runNodeCommand:output_type -> pb.RunNodeCommandResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_service_node_command_proto_init() }
+func file_service_node_command_proto_init() {
+	if File_service_node_command_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_service_node_command_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllNodeCommandsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_node_command_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllNodeCommandsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_node_command_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunNodeCommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_node_command_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunNodeCommandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_node_command_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllNodeCommandsResponse_NodeCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_node_command_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_node_command_proto_goTypes,
+		DependencyIndexes: file_service_node_command_proto_depIdxs,
+		MessageInfos:      file_service_node_command_proto_msgTypes,
+	}.Build()
+	File_service_node_command_proto = out.File
+	file_service_node_command_proto_rawDesc = nil
+	file_service_node_command_proto_goTypes = nil
+	file_service_node_command_proto_depIdxs = nil
+}
@@ -0,0 +1,176 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_node_command.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	NodeCommandService_FindAllNodeCommands_FullMethodName = "/pb.NodeCommandService/findAllNodeCommands"
+	NodeCommandService_RunNodeCommand_FullMethodName      = "/pb.NodeCommandService/runNodeCommand"
+)
+
+// NodeCommandServiceClient is the client API for NodeCommandService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NodeCommandServiceClient interface {
+	// 查找所有可以远程执行的命令
+	FindAllNodeCommands(ctx context.Context, in *FindAllNodeCommandsRequest, opts ...grpc.CallOption) (*FindAllNodeCommandsResponse, error)
+	// 在一组节点上执行远程命令，并逐个返回执行结果
+	RunNodeCommand(ctx context.Context, in *RunNodeCommandRequest, opts ...grpc.CallOption) (NodeCommandService_RunNodeCommandClient, error)
+}
+
+type nodeCommandServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNodeCommandServiceClient(cc grpc.ClientConnInterface) NodeCommandServiceClient {
+	return &nodeCommandServiceClient{cc}
+}
+
+func (c *nodeCommandServiceClient) FindAllNodeCommands(ctx context.Context, in *FindAllNodeCommandsRequest, opts ...grpc.CallOption) (*FindAllNodeCommandsResponse, error) {
+	out := new(FindAllNodeCommandsResponse)
+	err := c.cc.Invoke(ctx, NodeCommandService_FindAllNodeCommands_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeCommandServiceClient) RunNodeCommand(ctx context.Context, in *RunNodeCommandRequest, opts ...grpc.CallOption) (NodeCommandService_RunNodeCommandClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NodeCommandService_ServiceDesc.Streams[0], NodeCommandService_RunNodeCommand_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeCommandServiceRunNodeCommandClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodeCommandService_RunNodeCommandClient interface {
+	Recv() (*RunNodeCommandResponse, error)
+	grpc.ClientStream
+}
+
+type nodeCommandServiceRunNodeCommandClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeCommandServiceRunNodeCommandClient) Recv() (*RunNodeCommandResponse, error) {
+	m := new(RunNodeCommandResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// NodeCommandServiceServer is the server API for NodeCommandService service.
+// All implementations should embed UnimplementedNodeCommandServiceServer
+// for forward compatibility
+type NodeCommandServiceServer interface {
+	// 查找所有可以远程执行的命令
+	FindAllNodeCommands(context.Context, *FindAllNodeCommandsRequest) (*FindAllNodeCommandsResponse, error)
+	// 在一组节点上执行远程命令，并逐个返回执行结果
+	RunNodeCommand(*RunNodeCommandRequest, NodeCommandService_RunNodeCommandServer) error
+}
+
+// UnimplementedNodeCommandServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedNodeCommandServiceServer struct {
+}
+
+func (UnimplementedNodeCommandServiceServer) FindAllNodeCommands(context.Context, *FindAllNodeCommandsRequest) (*FindAllNodeCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllNodeCommands not implemented")
+}
+func (UnimplementedNodeCommandServiceServer) RunNodeCommand(*RunNodeCommandRequest, NodeCommandService_RunNodeCommandServer) error {
+	return status.Errorf(codes.Unimplemented, "method RunNodeCommand not implemented")
+}
+
+// UnsafeNodeCommandServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NodeCommandServiceServer will
+// result in compilation errors.
+type UnsafeNodeCommandServiceServer interface {
+	mustEmbedUnimplementedNodeCommandServiceServer()
+}
+
+func RegisterNodeCommandServiceServer(s grpc.ServiceRegistrar, srv NodeCommandServiceServer) {
+	s.RegisterService(&NodeCommandService_ServiceDesc, srv)
+}
+
+func _NodeCommandService_FindAllNodeCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllNodeCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeCommandServiceServer).FindAllNodeCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeCommandService_FindAllNodeCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeCommandServiceServer).FindAllNodeCommands(ctx, req.(*FindAllNodeCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeCommandService_RunNodeCommand_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RunNodeCommandRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeCommandServiceServer).RunNodeCommand(m, &nodeCommandServiceRunNodeCommandServer{stream})
+}
+
+type NodeCommandService_RunNodeCommandServer interface {
+	Send(*RunNodeCommandResponse) error
+	grpc.ServerStream
+}
+
+type nodeCommandServiceRunNodeCommandServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeCommandServiceRunNodeCommandServer) Send(m *RunNodeCommandResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// NodeCommandService_ServiceDesc is the grpc.ServiceDesc for NodeCommandService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NodeCommandService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.NodeCommandService",
+	HandlerType: (*NodeCommandServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "findAllNodeCommands",
+			Handler:    _NodeCommandService_FindAllNodeCommands_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "runNodeCommand",
+			Handler:       _NodeCommandService_RunNodeCommand_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service_node_command.proto",
+}
@@ -33,6 +33,7 @@ const (
 	NodeService_FindCurrentNodeConfig_FullMethodName                      = "/pb.NodeService/findCurrentNodeConfig"
 	NodeService_NodeStream_FullMethodName                                 = "/pb.NodeService/nodeStream"
 	NodeService_SendCommandToNode_FullMethodName                          = "/pb.NodeService/sendCommandToNode"
+	NodeService_WatchNodeStates_FullMethodName                            = "/pb.NodeService/watchNodeStates"
 	NodeService_UpdateNodeStatus_FullMethodName                           = "/pb.NodeService/updateNodeStatus"
 	NodeService_UpdateNodeIsInstalled_FullMethodName                      = "/pb.NodeService/updateNodeIsInstalled"
 	NodeService_InstallNode_FullMethodName                                = "/pb.NodeService/installNode"
@@ -87,6 +88,9 @@ const (
 	NodeService_FindNodeWebPPolicies_FullMethodName                       = "/pb.NodeService/findNodeWebPPolicies"
 	NodeService_UpdateNodeIsOn_FullMethodName                             = "/pb.NodeService/updateNodeIsOn"
 	NodeService_UpdateNodeBypassMobile_FullMethodName                     = "/pb.NodeService/updateNodeBypassMobile"
+	NodeService_FindNodeBGPConfig_FullMethodName                          = "/pb.NodeService/findNodeBGPConfig"
+	NodeService_UpdateNodeBGPConfig_FullMethodName                        = "/pb.NodeService/updateNodeBGPConfig"
+	NodeService_UpdateNodeBGPMaintenance_FullMethodName                   = "/pb.NodeService/updateNodeBGPMaintenance"
 )
 
 // NodeServiceClient is the client API for NodeService service.
@@ -121,6 +125,8 @@ type NodeServiceClient interface {
 	NodeStream(ctx context.Context, opts ...grpc.CallOption) (NodeService_NodeStreamClient, error)
 	// 向节点发送命令
 	SendCommandToNode(ctx context.Context, in *NodeStreamMessage, opts ...grpc.CallOption) (*NodeStreamMessage, error)
+	// 监听节点状态变化
+	WatchNodeStates(ctx context.Context, in *WatchNodeStatesRequest, opts ...grpc.CallOption) (NodeService_WatchNodeStatesClient, error)
 	// 更新节点状态
 	UpdateNodeStatus(ctx context.Context, in *UpdateNodeStatusRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 	// 修改节点安装状态
@@ -229,6 +235,12 @@ type NodeServiceClient interface {
 	UpdateNodeIsOn(ctx context.Context, in *UpdateNodeIsOnRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 	// 修改节点是否过移动
 	UpdateNodeBypassMobile(ctx context.Context, in *UpdateNodeBypassMobile, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 读取节点BGP宣告配置和状态
+	FindNodeBGPConfig(ctx context.Context, in *FindNodeBGPConfigRequest, opts ...grpc.CallOption) (*FindNodeBGPConfigResponse, error)
+	// 修改节点BGP宣告配置
+	UpdateNodeBGPConfig(ctx context.Context, in *UpdateNodeBGPConfigRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 修改节点BGP维护状态，维护期间撤回所有宣告
+	UpdateNodeBGPMaintenance(ctx context.Context, in *UpdateNodeBGPMaintenanceRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 }
 
 type nodeServiceClient struct {
@@ -387,6 +399,38 @@ func (c *nodeServiceClient) SendCommandToNode(ctx context.Context, in *NodeStrea
 	return out, nil
 }
 
+func (c *nodeServiceClient) WatchNodeStates(ctx context.Context, in *WatchNodeStatesRequest, opts ...grpc.CallOption) (NodeService_WatchNodeStatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NodeService_ServiceDesc.Streams[1], NodeService_WatchNodeStates_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeServiceWatchNodeStatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodeService_WatchNodeStatesClient interface {
+	Recv() (*WatchNodeStatesResponse, error)
+	grpc.ClientStream
+}
+
+type nodeServiceWatchNodeStatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeServiceWatchNodeStatesClient) Recv() (*WatchNodeStatesResponse, error) {
+	m := new(WatchNodeStatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *nodeServiceClient) UpdateNodeStatus(ctx context.Context, in *UpdateNodeStatusRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
 	out := new(RPCSuccess)
 	err := c.cc.Invoke(ctx, NodeService_UpdateNodeStatus_FullMethodName, in, out, opts...)
@@ -873,6 +917,33 @@ func (c *nodeServiceClient) UpdateNodeBypassMobile(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *nodeServiceClient) FindNodeBGPConfig(ctx context.Context, in *FindNodeBGPConfigRequest, opts ...grpc.CallOption) (*FindNodeBGPConfigResponse, error) {
+	out := new(FindNodeBGPConfigResponse)
+	err := c.cc.Invoke(ctx, NodeService_FindNodeBGPConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) UpdateNodeBGPConfig(ctx context.Context, in *UpdateNodeBGPConfigRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, NodeService_UpdateNodeBGPConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) UpdateNodeBGPMaintenance(ctx context.Context, in *UpdateNodeBGPMaintenanceRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, NodeService_UpdateNodeBGPMaintenance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServiceServer is the server API for NodeService service.
 // All implementations should embed UnimplementedNodeServiceServer
 // for forward compatibility
@@ -905,6 +976,8 @@ type NodeServiceServer interface {
 	NodeStream(NodeService_NodeStreamServer) error
 	// 向节点发送命令
 	SendCommandToNode(context.Context, *NodeStreamMessage) (*NodeStreamMessage, error)
+	// 监听节点状态变化
+	WatchNodeStates(*WatchNodeStatesRequest, NodeService_WatchNodeStatesServer) error
 	// 更新节点状态
 	UpdateNodeStatus(context.Context, *UpdateNodeStatusRequest) (*RPCSuccess, error)
 	// 修改节点安装状态
@@ -1013,6 +1086,12 @@ type NodeServiceServer interface {
 	UpdateNodeIsOn(context.Context, *UpdateNodeIsOnRequest) (*RPCSuccess, error)
 	// 修改节点是否过移动
 	UpdateNodeBypassMobile(context.Context, *UpdateNodeBypassMobile) (*RPCSuccess, error)
+	// 读取节点BGP宣告配置和状态
+	FindNodeBGPConfig(context.Context, *FindNodeBGPConfigRequest) (*FindNodeBGPConfigResponse, error)
+	// 修改节点BGP宣告配置
+	UpdateNodeBGPConfig(context.Context, *UpdateNodeBGPConfigRequest) (*RPCSuccess, error)
+	// 修改节点BGP维护状态，维护期间撤回所有宣告
+	UpdateNodeBGPMaintenance(context.Context, *UpdateNodeBGPMaintenanceRequest) (*RPCSuccess, error)
 }
 
 // UnimplementedNodeServiceServer should be embedded to have forward compatible implementations.
@@ -1061,6 +1140,9 @@ func (UnimplementedNodeServiceServer) NodeStream(NodeService_NodeStreamServer) e
 func (UnimplementedNodeServiceServer) SendCommandToNode(context.Context, *NodeStreamMessage) (*NodeStreamMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendCommandToNode not implemented")
 }
+func (UnimplementedNodeServiceServer) WatchNodeStates(*WatchNodeStatesRequest, NodeService_WatchNodeStatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNodeStates not implemented")
+}
 func (UnimplementedNodeServiceServer) UpdateNodeStatus(context.Context, *UpdateNodeStatusRequest) (*RPCSuccess, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeStatus not implemented")
 }
@@ -1223,6 +1305,15 @@ func (UnimplementedNodeServiceServer) UpdateNodeIsOn(context.Context, *UpdateNod
 func (UnimplementedNodeServiceServer) UpdateNodeBypassMobile(context.Context, *UpdateNodeBypassMobile) (*RPCSuccess, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeBypassMobile not implemented")
 }
+func (UnimplementedNodeServiceServer) FindNodeBGPConfig(context.Context, *FindNodeBGPConfigRequest) (*FindNodeBGPConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNodeBGPConfig not implemented")
+}
+func (UnimplementedNodeServiceServer) UpdateNodeBGPConfig(context.Context, *UpdateNodeBGPConfigRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeBGPConfig not implemented")
+}
+func (UnimplementedNodeServiceServer) UpdateNodeBGPMaintenance(context.Context, *UpdateNodeBGPMaintenanceRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeBGPMaintenance not implemented")
+}
 
 // UnsafeNodeServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to NodeServiceServer will
@@ -1495,6 +1586,27 @@ func _NodeService_SendCommandToNode_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeService_WatchNodeStates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNodeStatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServiceServer).WatchNodeStates(m, &nodeServiceWatchNodeStatesServer{stream})
+}
+
+type NodeService_WatchNodeStatesServer interface {
+	Send(*WatchNodeStatesResponse) error
+	grpc.ServerStream
+}
+
+type nodeServiceWatchNodeStatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeServiceWatchNodeStatesServer) Send(m *WatchNodeStatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _NodeService_UpdateNodeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateNodeStatusRequest)
 	if err := dec(in); err != nil {
@@ -2467,6 +2579,60 @@ func _NodeService_UpdateNodeBypassMobile_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeService_FindNodeBGPConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNodeBGPConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).FindNodeBGPConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_FindNodeBGPConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).FindNodeBGPConfig(ctx, req.(*FindNodeBGPConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_UpdateNodeBGPConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodeBGPConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).UpdateNodeBGPConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_UpdateNodeBGPConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).UpdateNodeBGPConfig(ctx, req.(*UpdateNodeBGPConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_UpdateNodeBGPMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodeBGPMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).UpdateNodeBGPMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_UpdateNodeBGPMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).UpdateNodeBGPMaintenance(ctx, req.(*UpdateNodeBGPMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NodeService_ServiceDesc is the grpc.ServiceDesc for NodeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2742,6 +2908,18 @@ var NodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "updateNodeBypassMobile",
 			Handler:    _NodeService_UpdateNodeBypassMobile_Handler,
 		},
+		{
+			MethodName: "findNodeBGPConfig",
+			Handler:    _NodeService_FindNodeBGPConfig_Handler,
+		},
+		{
+			MethodName: "updateNodeBGPConfig",
+			Handler:    _NodeService_UpdateNodeBGPConfig_Handler,
+		},
+		{
+			MethodName: "updateNodeBGPMaintenance",
+			Handler:    _NodeService_UpdateNodeBGPMaintenance_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2750,6 +2928,11 @@ var NodeService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "watchNodeStates",
+			Handler:       _NodeService_WatchNodeStates_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "service_node.proto",
 }
@@ -24,6 +24,7 @@ const (
 	OriginService_FindEnabledOrigin_FullMethodName       = "/pb.OriginService/findEnabledOrigin"
 	OriginService_FindEnabledOriginConfig_FullMethodName = "/pb.OriginService/findEnabledOriginConfig"
 	OriginService_UpdateOriginIsOn_FullMethodName        = "/pb.OriginService/updateOriginIsOn"
+	OriginService_FindOriginResolution_FullMethodName    = "/pb.OriginService/findOriginResolution"
 )
 
 // OriginServiceClient is the client API for OriginService service.
@@ -40,6 +41,8 @@ type OriginServiceClient interface {
 	FindEnabledOriginConfig(ctx context.Context, in *FindEnabledOriginConfigRequest, opts ...grpc.CallOption) (*FindEnabledOriginConfigResponse, error)
 	// 设置源站是否启用
 	UpdateOriginIsOn(ctx context.Context, in *UpdateOriginIsOnRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 查找源站域名在API节点上的解析结果
+	FindOriginResolution(ctx context.Context, in *FindOriginResolutionRequest, opts ...grpc.CallOption) (*FindOriginResolutionResponse, error)
 }
 
 type originServiceClient struct {
@@ -95,6 +98,15 @@ func (c *originServiceClient) UpdateOriginIsOn(ctx context.Context, in *UpdateOr
 	return out, nil
 }
 
+func (c *originServiceClient) FindOriginResolution(ctx context.Context, in *FindOriginResolutionRequest, opts ...grpc.CallOption) (*FindOriginResolutionResponse, error) {
+	out := new(FindOriginResolutionResponse)
+	err := c.cc.Invoke(ctx, OriginService_FindOriginResolution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OriginServiceServer is the server API for OriginService service.
 // All implementations should embed UnimplementedOriginServiceServer
 // for forward compatibility
@@ -109,6 +121,8 @@ type OriginServiceServer interface {
 	FindEnabledOriginConfig(context.Context, *FindEnabledOriginConfigRequest) (*FindEnabledOriginConfigResponse, error)
 	// 设置源站是否启用
 	UpdateOriginIsOn(context.Context, *UpdateOriginIsOnRequest) (*RPCSuccess, error)
+	// 查找源站域名在API节点上的解析结果
+	FindOriginResolution(context.Context, *FindOriginResolutionRequest) (*FindOriginResolutionResponse, error)
 }
 
 // UnimplementedOriginServiceServer should be embedded to have forward compatible implementations.
@@ -130,6 +144,9 @@ func (UnimplementedOriginServiceServer) FindEnabledOriginConfig(context.Context,
 func (UnimplementedOriginServiceServer) UpdateOriginIsOn(context.Context, *UpdateOriginIsOnRequest) (*RPCSuccess, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOriginIsOn not implemented")
 }
+func (UnimplementedOriginServiceServer) FindOriginResolution(context.Context, *FindOriginResolutionRequest) (*FindOriginResolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindOriginResolution not implemented")
+}
 
 // UnsafeOriginServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OriginServiceServer will
@@ -232,6 +249,24 @@ func _OriginService_UpdateOriginIsOn_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OriginService_FindOriginResolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOriginResolutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OriginServiceServer).FindOriginResolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OriginService_FindOriginResolution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OriginServiceServer).FindOriginResolution(ctx, req.(*FindOriginResolutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OriginService_ServiceDesc is the grpc.ServiceDesc for OriginService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -259,6 +294,10 @@ var OriginService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "updateOriginIsOn",
 			Handler:    _OriginService_UpdateOriginIsOn_Handler,
 		},
+		{
+			MethodName: "findOriginResolution",
+			Handler:    _OriginService_FindOriginResolution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_origin.proto",
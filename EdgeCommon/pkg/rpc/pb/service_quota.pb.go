@@ -0,0 +1,589 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_quota.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 查询用户所有配额使用情况
+type FindUserQuotasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"` // 用户ID，用户调用时可以不填
+}
+
+func (x *FindUserQuotasRequest) Reset() {
+	*x = FindUserQuotasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_quota_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserQuotasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserQuotasRequest) ProtoMessage() {}
+
+func (x *FindUserQuotasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_quota_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserQuotasRequest.ProtoReflect.Descriptor instead.
+func (*FindUserQuotasRequest) Descriptor() ([]byte, []int) {
+	return file_service_quota_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FindUserQuotasRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type FindUserQuotasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserQuotas []*UserQuota `protobuf:"bytes,1,rep,name=userQuotas,proto3" json:"userQuotas,omitempty"` // 配额使用情况
+}
+
+func (x *FindUserQuotasResponse) Reset() {
+	*x = FindUserQuotasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_quota_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserQuotasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserQuotasResponse) ProtoMessage() {}
+
+func (x *FindUserQuotasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_quota_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserQuotasResponse.ProtoReflect.Descriptor instead.
+func (*FindUserQuotasResponse) Descriptor() ([]byte, []int) {
+	return file_service_quota_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FindUserQuotasResponse) GetUserQuotas() []*UserQuota {
+	if x != nil {
+		return x.UserQuotas
+	}
+	return nil
+}
+
+// 检查用户是否还可以创建某类对象
+type CheckUserQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`       // 用户ID，用户调用时可以不填
+	Item      string `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`            // 配额项目：servers, sslCerts, dnsRecords, acmeTasks
+	Increment int64  `protobuf:"varint,3,opt,name=increment,proto3" json:"increment,omitempty"` // 要新增的数量，默认为1
+}
+
+func (x *CheckUserQuotaRequest) Reset() {
+	*x = CheckUserQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_quota_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckUserQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserQuotaRequest) ProtoMessage() {}
+
+func (x *CheckUserQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_quota_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserQuotaRequest.ProtoReflect.Descriptor instead.
+func (*CheckUserQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_service_quota_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CheckUserQuotaRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckUserQuotaRequest) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *CheckUserQuotaRequest) GetIncrement() int64 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+type CheckUserQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsAllowed bool       `protobuf:"varint,1,opt,name=isAllowed,proto3" json:"isAllowed,omitempty"` // 是否允许创建
+	UserQuota *UserQuota `protobuf:"bytes,2,opt,name=userQuota,proto3" json:"userQuota,omitempty"`  // 配额使用情况
+}
+
+func (x *CheckUserQuotaResponse) Reset() {
+	*x = CheckUserQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_quota_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckUserQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserQuotaResponse) ProtoMessage() {}
+
+func (x *CheckUserQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_quota_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserQuotaResponse.ProtoReflect.Descriptor instead.
+func (*CheckUserQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_service_quota_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CheckUserQuotaResponse) GetIsAllowed() bool {
+	if x != nil {
+		return x.IsAllowed
+	}
+	return false
+}
+
+func (x *CheckUserQuotaResponse) GetUserQuota() *UserQuota {
+	if x != nil {
+		return x.UserQuota
+	}
+	return nil
+}
+
+// 查询全局用户配额设置
+type FindGlobalUserQuotaConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindGlobalUserQuotaConfigRequest) Reset() {
+	*x = FindGlobalUserQuotaConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_quota_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindGlobalUserQuotaConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindGlobalUserQuotaConfigRequest) ProtoMessage() {}
+
+func (x *FindGlobalUserQuotaConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_quota_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindGlobalUserQuotaConfigRequest.ProtoReflect.Descriptor instead.
+func (*FindGlobalUserQuotaConfigRequest) Descriptor() ([]byte, []int) {
+	return file_service_quota_proto_rawDescGZIP(), []int{4}
+}
+
+type FindGlobalUserQuotaConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuotaJSON []byte `protobuf:"bytes,1,opt,name=quotaJSON,proto3" json:"quotaJSON,omitempty"` // 用户对象数量限制 @link json:user_quota
+}
+
+func (x *FindGlobalUserQuotaConfigResponse) Reset() {
+	*x = FindGlobalUserQuotaConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_quota_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindGlobalUserQuotaConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindGlobalUserQuotaConfigResponse) ProtoMessage() {}
+
+func (x *FindGlobalUserQuotaConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_quota_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindGlobalUserQuotaConfigResponse.ProtoReflect.Descriptor instead.
+func (*FindGlobalUserQuotaConfigResponse) Descriptor() ([]byte, []int) {
+	return file_service_quota_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindGlobalUserQuotaConfigResponse) GetQuotaJSON() []byte {
+	if x != nil {
+		return x.QuotaJSON
+	}
+	return nil
+}
+
+// 修改全局用户配额设置
+type UpdateGlobalUserQuotaConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuotaJSON []byte `protobuf:"bytes,1,opt,name=quotaJSON,proto3" json:"quotaJSON,omitempty"` // 用户对象数量限制 @link json:user_quota
+}
+
+func (x *UpdateGlobalUserQuotaConfigRequest) Reset() {
+	*x = UpdateGlobalUserQuotaConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_quota_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGlobalUserQuotaConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGlobalUserQuotaConfigRequest) ProtoMessage() {}
+
+func (x *UpdateGlobalUserQuotaConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_quota_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGlobalUserQuotaConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGlobalUserQuotaConfigRequest) Descriptor() ([]byte, []int) {
+	return file_service_quota_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateGlobalUserQuotaConfigRequest) GetQuotaJSON() []byte {
+	if x != nil {
+		return x.QuotaJSON
+	}
+	return nil
+}
+
+var File_service_quota_proto protoreflect.FileDescriptor
+
+var file_service_quota_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1d, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2f, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x2f, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x22, 0x61, 0x0a,
+	0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x63, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x22, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x21, 0x46, 0x69, 0x6e,
+	0x64, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x42, 0x0a, 0x22,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e,
+	0x32, 0xe1, 0x02, 0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x47, 0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x19, 0x66, 0x69, 0x6e, 0x64, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
+	0x1b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_quota_proto_rawDescOnce sync.Once
+	file_service_quota_proto_rawDescData = file_service_quota_proto_rawDesc
+)
+
+func file_service_quota_proto_rawDescGZIP() []byte {
+	file_service_quota_proto_rawDescOnce.Do(func() {
+		file_service_quota_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_quota_proto_rawDescData)
+	})
+	return file_service_quota_proto_rawDescData
+}
+
+var file_service_quota_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_service_quota_proto_goTypes = []interface{}{
+	(*FindUserQuotasRequest)(nil),              // 0: pb.FindUserQuotasRequest
+	(*FindUserQuotasResponse)(nil),             // 1: pb.FindUserQuotasResponse
+	(*CheckUserQuotaRequest)(nil),              // 2: pb.CheckUserQuotaRequest
+	(*CheckUserQuotaResponse)(nil),             // 3: pb.CheckUserQuotaResponse
+	(*FindGlobalUserQuotaConfigRequest)(nil),   // 4: pb.FindGlobalUserQuotaConfigRequest
+	(*FindGlobalUserQuotaConfigResponse)(nil),  // 5: pb.FindGlobalUserQuotaConfigResponse
+	(*UpdateGlobalUserQuotaConfigRequest)(nil), // 6: pb.UpdateGlobalUserQuotaConfigRequest
+	(*UserQuota)(nil),                          // 7: pb.UserQuota
+	(*RPCSuccess)(nil),                         // 8: pb.RPCSuccess
+}
+var file_service_quota_proto_depIdxs = []int32{
+	7, // 0: pb.FindUserQuotasResponse.userQuotas:type_name -> pb.UserQuota
+	7, // 1: pb.CheckUserQuotaResponse.userQuota:type_name -> pb.UserQuota
+	0, // 2: pb.QuotaService.findUserQuotas:input_type -> pb.FindUserQuotasRequest
+	2, // 3: pb.QuotaService.checkUserQuota:input_type -> pb.CheckUserQuotaRequest
+	4, // 4: pb.QuotaService.findGlobalUserQuotaConfig:input_type -> pb.FindGlobalUserQuotaConfigRequest
+	6, // 5: pb.QuotaService.updateGlobalUserQuotaConfig:input_type -> pb.UpdateGlobalUserQuotaConfigRequest
+	1, // 6: pb.QuotaService.findUserQuotas:output_type -> pb.FindUserQuotasResponse
+	3, // 7: pb.QuotaService.checkUserQuota:output_type -> pb.CheckUserQuotaResponse
+	5, // 8: pb.QuotaService.findGlobalUserQuotaConfig:output_type -> pb.FindGlobalUserQuotaConfigResponse
+	8, // 9: pb.QuotaService.updateGlobalUserQuotaConfig:output_type -> pb.RPCSuccess
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_service_quota_proto_init() }
+func file_service_quota_proto_init() {
+	if File_service_quota_proto != nil {
+		return
+	}
+	file_models_model_user_quota_proto_init()
+	file_models_rpc_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_quota_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUserQuotasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_quota_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUserQuotasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_quota_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckUserQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_quota_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckUserQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_quota_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindGlobalUserQuotaConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_quota_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindGlobalUserQuotaConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_quota_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGlobalUserQuotaConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_quota_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_quota_proto_goTypes,
+		DependencyIndexes: file_service_quota_proto_depIdxs,
+		MessageInfos:      file_service_quota_proto_msgTypes,
+	}.Build()
+	File_service_quota_proto = out.File
+	file_service_quota_proto_rawDesc = nil
+	file_service_quota_proto_goTypes = nil
+	file_service_quota_proto_depIdxs = nil
+}
@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_quota.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	QuotaService_FindUserQuotas_FullMethodName              = "/pb.QuotaService/findUserQuotas"
+	QuotaService_CheckUserQuota_FullMethodName              = "/pb.QuotaService/checkUserQuota"
+	QuotaService_FindGlobalUserQuotaConfig_FullMethodName   = "/pb.QuotaService/findGlobalUserQuotaConfig"
+	QuotaService_UpdateGlobalUserQuotaConfig_FullMethodName = "/pb.QuotaService/updateGlobalUserQuotaConfig"
+)
+
+// QuotaServiceClient is the client API for QuotaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuotaServiceClient interface {
+	// 查询用户所有配额使用情况
+	FindUserQuotas(ctx context.Context, in *FindUserQuotasRequest, opts ...grpc.CallOption) (*FindUserQuotasResponse, error)
+	// 检查用户是否还可以创建某类对象
+	CheckUserQuota(ctx context.Context, in *CheckUserQuotaRequest, opts ...grpc.CallOption) (*CheckUserQuotaResponse, error)
+	// 查询全局用户配额设置
+	FindGlobalUserQuotaConfig(ctx context.Context, in *FindGlobalUserQuotaConfigRequest, opts ...grpc.CallOption) (*FindGlobalUserQuotaConfigResponse, error)
+	// 修改全局用户配额设置
+	UpdateGlobalUserQuotaConfig(ctx context.Context, in *UpdateGlobalUserQuotaConfigRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+}
+
+type quotaServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuotaServiceClient(cc grpc.ClientConnInterface) QuotaServiceClient {
+	return &quotaServiceClient{cc}
+}
+
+func (c *quotaServiceClient) FindUserQuotas(ctx context.Context, in *FindUserQuotasRequest, opts ...grpc.CallOption) (*FindUserQuotasResponse, error) {
+	out := new(FindUserQuotasResponse)
+	err := c.cc.Invoke(ctx, QuotaService_FindUserQuotas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaServiceClient) CheckUserQuota(ctx context.Context, in *CheckUserQuotaRequest, opts ...grpc.CallOption) (*CheckUserQuotaResponse, error) {
+	out := new(CheckUserQuotaResponse)
+	err := c.cc.Invoke(ctx, QuotaService_CheckUserQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaServiceClient) FindGlobalUserQuotaConfig(ctx context.Context, in *FindGlobalUserQuotaConfigRequest, opts ...grpc.CallOption) (*FindGlobalUserQuotaConfigResponse, error) {
+	out := new(FindGlobalUserQuotaConfigResponse)
+	err := c.cc.Invoke(ctx, QuotaService_FindGlobalUserQuotaConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaServiceClient) UpdateGlobalUserQuotaConfig(ctx context.Context, in *UpdateGlobalUserQuotaConfigRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, QuotaService_UpdateGlobalUserQuotaConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuotaServiceServer is the server API for QuotaService service.
+// All implementations should embed UnimplementedQuotaServiceServer
+// for forward compatibility
+type QuotaServiceServer interface {
+	// 查询用户所有配额使用情况
+	FindUserQuotas(context.Context, *FindUserQuotasRequest) (*FindUserQuotasResponse, error)
+	// 检查用户是否还可以创建某类对象
+	CheckUserQuota(context.Context, *CheckUserQuotaRequest) (*CheckUserQuotaResponse, error)
+	// 查询全局用户配额设置
+	FindGlobalUserQuotaConfig(context.Context, *FindGlobalUserQuotaConfigRequest) (*FindGlobalUserQuotaConfigResponse, error)
+	// 修改全局用户配额设置
+	UpdateGlobalUserQuotaConfig(context.Context, *UpdateGlobalUserQuotaConfigRequest) (*RPCSuccess, error)
+}
+
+// UnimplementedQuotaServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedQuotaServiceServer struct {
+}
+
+func (UnimplementedQuotaServiceServer) FindUserQuotas(context.Context, *FindUserQuotasRequest) (*FindUserQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUserQuotas not implemented")
+}
+func (UnimplementedQuotaServiceServer) CheckUserQuota(context.Context, *CheckUserQuotaRequest) (*CheckUserQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUserQuota not implemented")
+}
+func (UnimplementedQuotaServiceServer) FindGlobalUserQuotaConfig(context.Context, *FindGlobalUserQuotaConfigRequest) (*FindGlobalUserQuotaConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindGlobalUserQuotaConfig not implemented")
+}
+func (UnimplementedQuotaServiceServer) UpdateGlobalUserQuotaConfig(context.Context, *UpdateGlobalUserQuotaConfigRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGlobalUserQuotaConfig not implemented")
+}
+
+// UnsafeQuotaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuotaServiceServer will
+// result in compilation errors.
+type UnsafeQuotaServiceServer interface {
+	mustEmbedUnimplementedQuotaServiceServer()
+}
+
+func RegisterQuotaServiceServer(s grpc.ServiceRegistrar, srv QuotaServiceServer) {
+	s.RegisterService(&QuotaService_ServiceDesc, srv)
+}
+
+func _QuotaService_FindUserQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServiceServer).FindUserQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuotaService_FindUserQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServiceServer).FindUserQuotas(ctx, req.(*FindUserQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotaService_CheckUserQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUserQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServiceServer).CheckUserQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuotaService_CheckUserQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServiceServer).CheckUserQuota(ctx, req.(*CheckUserQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotaService_FindGlobalUserQuotaConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindGlobalUserQuotaConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServiceServer).FindGlobalUserQuotaConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuotaService_FindGlobalUserQuotaConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServiceServer).FindGlobalUserQuotaConfig(ctx, req.(*FindGlobalUserQuotaConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotaService_UpdateGlobalUserQuotaConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGlobalUserQuotaConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServiceServer).UpdateGlobalUserQuotaConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuotaService_UpdateGlobalUserQuotaConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServiceServer).UpdateGlobalUserQuotaConfig(ctx, req.(*UpdateGlobalUserQuotaConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuotaService_ServiceDesc is the grpc.ServiceDesc for QuotaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuotaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.QuotaService",
+	HandlerType: (*QuotaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "findUserQuotas",
+			Handler:    _QuotaService_FindUserQuotas_Handler,
+		},
+		{
+			MethodName: "checkUserQuota",
+			Handler:    _QuotaService_CheckUserQuota_Handler,
+		},
+		{
+			MethodName: "findGlobalUserQuotaConfig",
+			Handler:    _QuotaService_FindGlobalUserQuotaConfig_Handler,
+		},
+		{
+			MethodName: "updateGlobalUserQuotaConfig",
+			Handler:    _QuotaService_UpdateGlobalUserQuotaConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_quota.proto",
+}
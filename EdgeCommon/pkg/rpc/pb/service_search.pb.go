@@ -0,0 +1,356 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_search.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 在网站、证书、节点和用户中搜索关键词
+type SearchAllRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword string   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"` // 关键词，比如域名、证书CN、节点IP、用户邮箱
+	Types   []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`     // 可选，限制搜索的类型：server, sslCert, node, user
+	Size    int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`      // 最多返回的结果数，默认20，最大100
+}
+
+func (x *SearchAllRequest) Reset() {
+	*x = SearchAllRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_search_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAllRequest) ProtoMessage() {}
+
+func (x *SearchAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_search_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAllRequest.ProtoReflect.Descriptor instead.
+func (*SearchAllRequest) Descriptor() ([]byte, []int) {
+	return file_service_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchAllRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchAllRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchAllRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SearchAllResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SearchResults []*SearchAllResponse_SearchResult `protobuf:"bytes,1,rep,name=searchResults,proto3" json:"searchResults,omitempty"` // 结果列表，按匹配度从高到低排列
+}
+
+func (x *SearchAllResponse) Reset() {
+	*x = SearchAllResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_search_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAllResponse) ProtoMessage() {}
+
+func (x *SearchAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_search_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAllResponse.ProtoReflect.Descriptor instead.
+func (*SearchAllResponse) Descriptor() ([]byte, []int) {
+	return file_service_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchAllResponse) GetSearchResults() []*SearchAllResponse_SearchResult {
+	if x != nil {
+		return x.SearchResults
+	}
+	return nil
+}
+
+type SearchAllResponse_SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                 // 类型：server, sslCert, node, user
+	Id           int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`                    // 对象ID
+	Title        string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`               // 标题
+	Subtitle     string `protobuf:"bytes,4,opt,name=subtitle,proto3" json:"subtitle,omitempty"`         // 副标题
+	MatchedField string `protobuf:"bytes,5,opt,name=matchedField,proto3" json:"matchedField,omitempty"` // 匹配的字段
+	MatchedValue string `protobuf:"bytes,6,opt,name=matchedValue,proto3" json:"matchedValue,omitempty"` // 匹配的值
+	Score        int32  `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`              // 匹配度
+}
+
+func (x *SearchAllResponse_SearchResult) Reset() {
+	*x = SearchAllResponse_SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_search_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAllResponse_SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAllResponse_SearchResult) ProtoMessage() {}
+
+func (x *SearchAllResponse_SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_search_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAllResponse_SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchAllResponse_SearchResult) Descriptor() ([]byte, []int) {
+	return file_service_search_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *SearchAllResponse_SearchResult) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SearchAllResponse_SearchResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchAllResponse_SearchResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchAllResponse_SearchResult) GetSubtitle() string {
+	if x != nil {
+		return x.Subtitle
+	}
+	return ""
+}
+
+func (x *SearchAllResponse_SearchResult) GetMatchedField() string {
+	if x != nil {
+		return x.MatchedField
+	}
+	return ""
+}
+
+func (x *SearchAllResponse_SearchResult) GetMatchedValue() string {
+	if x != nil {
+		return x.MatchedValue
+	}
+	return ""
+}
+
+func (x *SearchAllResponse_SearchResult) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+var File_service_search_proto protoreflect.FileDescriptor
+
+var file_service_search_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x56, 0x0a, 0x10, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x1a, 0xc2, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x32, 0x49, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_service_search_proto_rawDescOnce sync.Once
+	file_service_search_proto_rawDescData = file_service_search_proto_rawDesc
+)
+
+func file_service_search_proto_rawDescGZIP() []byte {
+	file_service_search_proto_rawDescOnce.Do(func() {
+		file_service_search_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_search_proto_rawDescData)
+	})
+	return file_service_search_proto_rawDescData
+}
+
+var file_service_search_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_service_search_proto_goTypes = []interface{}{
+	(*SearchAllRequest)(nil),               // 0: pb.SearchAllRequest
+	(*SearchAllResponse)(nil),              // 1: pb.SearchAllResponse
+	(*SearchAllResponse_SearchResult)(nil), // 2: pb.SearchAllResponse.SearchResult
+}
+var file_service_search_proto_depIdxs = []int32{
+	2, // 0: pb.SearchAllResponse.searchResults:type_name -> pb.SearchAllResponse.SearchResult
+	0, // 1: pb.SearchService.searchAll:input_type -> pb.SearchAllRequest
+	1, // 2: pb.SearchService.searchAll:output_type -> pb.SearchAllResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_service_search_proto_init() }
+func file_service_search_proto_init() {
+	if File_service_search_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_service_search_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_search_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAllResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_search_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAllResponse_SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_search_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_search_proto_goTypes,
+		DependencyIndexes: file_service_search_proto_depIdxs,
+		MessageInfos:      file_service_search_proto_msgTypes,
+	}.Build()
+	File_service_search_proto = out.File
+	file_service_search_proto_rawDesc = nil
+	file_service_search_proto_goTypes = nil
+	file_service_search_proto_depIdxs = nil
+}
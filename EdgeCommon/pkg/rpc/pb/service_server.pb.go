@@ -44,6 +44,7 @@ type CreateServerRequest struct {
 	ReverseProxyJSON []byte  `protobuf:"bytes,16,opt,name=reverseProxyJSON,proto3" json:"reverseProxyJSON,omitempty"`     // 反向代理（包含源站）配置引用，此项可以在创建网站后再设置 @link json:reverse_proxy_ref
 	ServerGroupIds   []int64 `protobuf:"varint,17,rep,packed,name=serverGroupIds,proto3" json:"serverGroupIds,omitempty"` // 可选项，所属网站分组ID列表
 	UserPlanId       int64   `protobuf:"varint,18,opt,name=userPlanId,proto3" json:"userPlanId,omitempty"`                // 可选项，套餐ID
+	ExternalId       string  `protobuf:"bytes,20,opt,name=externalId,proto3" json:"externalId,omitempty"`                 // 可选项，外部ID，由调用方指定的稳定标识，同一个外部ID只能被一个网站使用
 	NodeClusterId    int64   `protobuf:"varint,30,opt,name=nodeClusterId,proto3" json:"nodeClusterId,omitempty"`          // 所部署的集群ID
 	IncludeNodesJSON []byte  `protobuf:"bytes,31,opt,name=includeNodesJSON,proto3" json:"includeNodesJSON,omitempty"`     // 备用参数，不用填写
 	ExcludeNodesJSON []byte  `protobuf:"bytes,32,opt,name=excludeNodesJSON,proto3" json:"excludeNodesJSON,omitempty"`     // 备用参数，不用填写
@@ -194,6 +195,13 @@ func (x *CreateServerRequest) GetUserPlanId() int64 {
 	return 0
 }
 
+func (x *CreateServerRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
 func (x *CreateServerRequest) GetNodeClusterId() int64 {
 	if x != nil {
 		return x.NodeClusterId
@@ -532,6 +540,117 @@ func (x *CreateBasicTCPServerResponse) GetServerId() int64 {
 	return 0
 }
 
+// 复制网站
+type CloneServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId        int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"`              // 要复制的网站ID
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                       // 新网站名称，为空则使用第一个域名
+	ServerNamesJSON []byte `protobuf:"bytes,3,opt,name=serverNamesJSON,proto3" json:"serverNamesJSON,omitempty"` // 新网站的域名列表，[]ServerNameConfig
+}
+
+func (x *CloneServerRequest) Reset() {
+	*x = CloneServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneServerRequest) ProtoMessage() {}
+
+func (x *CloneServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneServerRequest.ProtoReflect.Descriptor instead.
+func (*CloneServerRequest) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CloneServerRequest) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *CloneServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneServerRequest) GetServerNamesJSON() []byte {
+	if x != nil {
+		return x.ServerNamesJSON
+	}
+	return nil
+}
+
+type CloneServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId int64 `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 所创建的网站ID
+}
+
+func (x *CloneServerResponse) Reset() {
+	*x = CloneServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneServerResponse) ProtoMessage() {}
+
+func (x *CloneServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneServerResponse.ProtoReflect.Descriptor instead.
+func (*CloneServerResponse) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CloneServerResponse) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
 // 为网站添加源站
 type AddServerOriginRequest struct {
 	state         protoimpl.MessageState
@@ -546,7 +665,7 @@ type AddServerOriginRequest struct {
 func (x *AddServerOriginRequest) Reset() {
 	*x = AddServerOriginRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[6]
+		mi := &file_service_server_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -559,7 +678,7 @@ func (x *AddServerOriginRequest) String() string {
 func (*AddServerOriginRequest) ProtoMessage() {}
 
 func (x *AddServerOriginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[6]
+	mi := &file_service_server_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +691,7 @@ func (x *AddServerOriginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddServerOriginRequest.ProtoReflect.Descriptor instead.
 func (*AddServerOriginRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{6}
+	return file_service_server_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AddServerOriginRequest) GetServerId() int64 {
@@ -609,7 +728,7 @@ type DeleteServerOriginRequest struct {
 func (x *DeleteServerOriginRequest) Reset() {
 	*x = DeleteServerOriginRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[7]
+		mi := &file_service_server_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -622,7 +741,7 @@ func (x *DeleteServerOriginRequest) String() string {
 func (*DeleteServerOriginRequest) ProtoMessage() {}
 
 func (x *DeleteServerOriginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[7]
+	mi := &file_service_server_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +754,7 @@ func (x *DeleteServerOriginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteServerOriginRequest.ProtoReflect.Descriptor instead.
 func (*DeleteServerOriginRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{7}
+	return file_service_server_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteServerOriginRequest) GetServerId() int64 {
@@ -665,12 +784,13 @@ type UpdateServerBasicRequest struct {
 	KeepOldConfigs bool    `protobuf:"varint,7,opt,name=keepOldConfigs,proto3" json:"keepOldConfigs,omitempty"`        // 可选项，是否在老节点上保留一段时间配置
 	IsOn           bool    `protobuf:"varint,5,opt,name=isOn,proto3" json:"isOn,omitempty"`                            // 是否启用
 	ServerGroupIds []int64 `protobuf:"varint,6,rep,packed,name=serverGroupIds,proto3" json:"serverGroupIds,omitempty"` // 可选项，网站分组ID列表
+	Version        int64   `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`                      // 修改前读取的版本号，用于检测并发修改，为0表示不检查
 }
 
 func (x *UpdateServerBasicRequest) Reset() {
 	*x = UpdateServerBasicRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[8]
+		mi := &file_service_server_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -683,7 +803,7 @@ func (x *UpdateServerBasicRequest) String() string {
 func (*UpdateServerBasicRequest) ProtoMessage() {}
 
 func (x *UpdateServerBasicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[8]
+	mi := &file_service_server_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -696,7 +816,7 @@ func (x *UpdateServerBasicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerBasicRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerBasicRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{8}
+	return file_service_server_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateServerBasicRequest) GetServerId() int64 {
@@ -748,6 +868,13 @@ func (x *UpdateServerBasicRequest) GetServerGroupIds() []int64 {
 	return nil
 }
 
+func (x *UpdateServerBasicRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // 修改网站所在分组
 type UpdateServerGroupIdsRequest struct {
 	state         protoimpl.MessageState
@@ -761,7 +888,7 @@ type UpdateServerGroupIdsRequest struct {
 func (x *UpdateServerGroupIdsRequest) Reset() {
 	*x = UpdateServerGroupIdsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[9]
+		mi := &file_service_server_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -774,7 +901,7 @@ func (x *UpdateServerGroupIdsRequest) String() string {
 func (*UpdateServerGroupIdsRequest) ProtoMessage() {}
 
 func (x *UpdateServerGroupIdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[9]
+	mi := &file_service_server_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,7 +914,7 @@ func (x *UpdateServerGroupIdsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerGroupIdsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerGroupIdsRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{9}
+	return file_service_server_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateServerGroupIdsRequest) GetServerId() int64 {
@@ -817,7 +944,7 @@ type UpdateServerIsOnRequest struct {
 func (x *UpdateServerIsOnRequest) Reset() {
 	*x = UpdateServerIsOnRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[10]
+		mi := &file_service_server_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -830,7 +957,7 @@ func (x *UpdateServerIsOnRequest) String() string {
 func (*UpdateServerIsOnRequest) ProtoMessage() {}
 
 func (x *UpdateServerIsOnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[10]
+	mi := &file_service_server_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +970,7 @@ func (x *UpdateServerIsOnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerIsOnRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerIsOnRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{10}
+	return file_service_server_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateServerIsOnRequest) GetServerId() int64 {
@@ -868,12 +995,13 @@ type UpdateServerHTTPRequest struct {
 
 	ServerId int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID
 	HttpJSON []byte `protobuf:"bytes,2,opt,name=httpJSON,proto3" json:"httpJSON,omitempty"`  // HTTP协议设置，当type为httpProxy或者httpWeb时填写 @link json:http_protocol
+	Version  int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`   // 修改前读取的版本号，用于检测并发修改，为0表示不检查
 }
 
 func (x *UpdateServerHTTPRequest) Reset() {
 	*x = UpdateServerHTTPRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[11]
+		mi := &file_service_server_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -886,7 +1014,7 @@ func (x *UpdateServerHTTPRequest) String() string {
 func (*UpdateServerHTTPRequest) ProtoMessage() {}
 
 func (x *UpdateServerHTTPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[11]
+	mi := &file_service_server_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1027,7 @@ func (x *UpdateServerHTTPRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerHTTPRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerHTTPRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{11}
+	return file_service_server_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateServerHTTPRequest) GetServerId() int64 {
@@ -916,6 +1044,13 @@ func (x *UpdateServerHTTPRequest) GetHttpJSON() []byte {
 	return nil
 }
 
+func (x *UpdateServerHTTPRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateServerHTTPSRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -923,12 +1058,13 @@ type UpdateServerHTTPSRequest struct {
 
 	ServerId  int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"`  // 网站ID
 	HttpsJSON []byte `protobuf:"bytes,2,opt,name=httpsJSON,proto3" json:"httpsJSON,omitempty"` // HTTPS协议设置，当type为httpProxy或者httpWeb时填写 @link json:https_protocol
+	Version   int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`    // 修改前读取的版本号，用于检测并发修改，为0表示不检查
 }
 
 func (x *UpdateServerHTTPSRequest) Reset() {
 	*x = UpdateServerHTTPSRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[12]
+		mi := &file_service_server_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -941,7 +1077,7 @@ func (x *UpdateServerHTTPSRequest) String() string {
 func (*UpdateServerHTTPSRequest) ProtoMessage() {}
 
 func (x *UpdateServerHTTPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[12]
+	mi := &file_service_server_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,7 +1090,7 @@ func (x *UpdateServerHTTPSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerHTTPSRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerHTTPSRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{12}
+	return file_service_server_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateServerHTTPSRequest) GetServerId() int64 {
@@ -971,6 +1107,13 @@ func (x *UpdateServerHTTPSRequest) GetHttpsJSON() []byte {
 	return nil
 }
 
+func (x *UpdateServerHTTPSRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateServerTCPRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -978,12 +1121,13 @@ type UpdateServerTCPRequest struct {
 
 	ServerId int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID
 	TcpJSON  []byte `protobuf:"bytes,2,opt,name=tcpJSON,proto3" json:"tcpJSON,omitempty"`    // TCP协议设置，当type为tcpProxy时填写 @link json:tcp_protocol
+	Version  int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`   // 修改前读取的版本号，用于检测并发修改，为0表示不检查
 }
 
 func (x *UpdateServerTCPRequest) Reset() {
 	*x = UpdateServerTCPRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[13]
+		mi := &file_service_server_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -996,7 +1140,7 @@ func (x *UpdateServerTCPRequest) String() string {
 func (*UpdateServerTCPRequest) ProtoMessage() {}
 
 func (x *UpdateServerTCPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[13]
+	mi := &file_service_server_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,7 +1153,7 @@ func (x *UpdateServerTCPRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerTCPRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerTCPRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{13}
+	return file_service_server_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdateServerTCPRequest) GetServerId() int64 {
@@ -1026,6 +1170,13 @@ func (x *UpdateServerTCPRequest) GetTcpJSON() []byte {
 	return nil
 }
 
+func (x *UpdateServerTCPRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateServerTLSRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1033,12 +1184,13 @@ type UpdateServerTLSRequest struct {
 
 	ServerId int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID
 	TlsJSON  []byte `protobuf:"bytes,2,opt,name=tlsJSON,proto3" json:"tlsJSON,omitempty"`    // TLS协议设置，当type为tcpProxy时填写 @link json:tls_protocol
+	Version  int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`   // 修改前读取的版本号，用于检测并发修改，为0表示不检查
 }
 
 func (x *UpdateServerTLSRequest) Reset() {
 	*x = UpdateServerTLSRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[14]
+		mi := &file_service_server_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1051,7 +1203,7 @@ func (x *UpdateServerTLSRequest) String() string {
 func (*UpdateServerTLSRequest) ProtoMessage() {}
 
 func (x *UpdateServerTLSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[14]
+	mi := &file_service_server_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,7 +1216,7 @@ func (x *UpdateServerTLSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerTLSRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerTLSRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{14}
+	return file_service_server_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateServerTLSRequest) GetServerId() int64 {
@@ -1081,6 +1233,13 @@ func (x *UpdateServerTLSRequest) GetTlsJSON() []byte {
 	return nil
 }
 
+func (x *UpdateServerTLSRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateServerUDPRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1088,12 +1247,13 @@ type UpdateServerUDPRequest struct {
 
 	ServerId int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID
 	UdpJSON  []byte `protobuf:"bytes,2,opt,name=udpJSON,proto3" json:"udpJSON,omitempty"`    // UDP协议设置，当type为udpProxy时填写 @link json:udp_protocol
+	Version  int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`   // 修改前读取的版本号，用于检测并发修改，为0表示不检查
 }
 
 func (x *UpdateServerUDPRequest) Reset() {
 	*x = UpdateServerUDPRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[15]
+		mi := &file_service_server_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1106,7 +1266,7 @@ func (x *UpdateServerUDPRequest) String() string {
 func (*UpdateServerUDPRequest) ProtoMessage() {}
 
 func (x *UpdateServerUDPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[15]
+	mi := &file_service_server_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,7 +1279,7 @@ func (x *UpdateServerUDPRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerUDPRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerUDPRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{15}
+	return file_service_server_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdateServerUDPRequest) GetServerId() int64 {
@@ -1136,6 +1296,13 @@ func (x *UpdateServerUDPRequest) GetUdpJSON() []byte {
 	return nil
 }
 
+func (x *UpdateServerUDPRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateServerWebRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1148,7 +1315,7 @@ type UpdateServerWebRequest struct {
 func (x *UpdateServerWebRequest) Reset() {
 	*x = UpdateServerWebRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[16]
+		mi := &file_service_server_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1161,7 +1328,7 @@ func (x *UpdateServerWebRequest) String() string {
 func (*UpdateServerWebRequest) ProtoMessage() {}
 
 func (x *UpdateServerWebRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[16]
+	mi := &file_service_server_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1174,7 +1341,7 @@ func (x *UpdateServerWebRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerWebRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerWebRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{16}
+	return file_service_server_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateServerWebRequest) GetServerId() int64 {
@@ -1199,12 +1366,13 @@ type UpdateServerReverseProxyRequest struct {
 
 	ServerId         int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"`                // 网站ID
 	ReverseProxyJSON []byte `protobuf:"bytes,2,opt,name=reverseProxyJSON,proto3" json:"reverseProxyJSON,omitempty"` // 反向代理（包含源站）配置引用，此项可以在创建网站后再设置 @link json:reverse_proxy_ref
+	Version          int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                  // 修改前读取的版本号，用于检测并发修改，为0表示不检查
 }
 
 func (x *UpdateServerReverseProxyRequest) Reset() {
 	*x = UpdateServerReverseProxyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[17]
+		mi := &file_service_server_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1217,7 +1385,7 @@ func (x *UpdateServerReverseProxyRequest) String() string {
 func (*UpdateServerReverseProxyRequest) ProtoMessage() {}
 
 func (x *UpdateServerReverseProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[17]
+	mi := &file_service_server_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1230,7 +1398,7 @@ func (x *UpdateServerReverseProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerReverseProxyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerReverseProxyRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{17}
+	return file_service_server_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateServerReverseProxyRequest) GetServerId() int64 {
@@ -1247,6 +1415,13 @@ func (x *UpdateServerReverseProxyRequest) GetReverseProxyJSON() []byte {
 	return nil
 }
 
+func (x *UpdateServerReverseProxyRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // 查找网站的域名设置
 type FindServerNamesRequest struct {
 	state         protoimpl.MessageState
@@ -1259,7 +1434,7 @@ type FindServerNamesRequest struct {
 func (x *FindServerNamesRequest) Reset() {
 	*x = FindServerNamesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[18]
+		mi := &file_service_server_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1272,7 +1447,7 @@ func (x *FindServerNamesRequest) String() string {
 func (*FindServerNamesRequest) ProtoMessage() {}
 
 func (x *FindServerNamesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[18]
+	mi := &file_service_server_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,7 +1460,7 @@ func (x *FindServerNamesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindServerNamesRequest.ProtoReflect.Descriptor instead.
 func (*FindServerNamesRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{18}
+	return file_service_server_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *FindServerNamesRequest) GetServerId() int64 {
@@ -1310,7 +1485,7 @@ type FindServerNamesResponse struct {
 func (x *FindServerNamesResponse) Reset() {
 	*x = FindServerNamesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[19]
+		mi := &file_service_server_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1323,7 +1498,7 @@ func (x *FindServerNamesResponse) String() string {
 func (*FindServerNamesResponse) ProtoMessage() {}
 
 func (x *FindServerNamesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[19]
+	mi := &file_service_server_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1336,7 +1511,7 @@ func (x *FindServerNamesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindServerNamesResponse.ProtoReflect.Descriptor instead.
 func (*FindServerNamesResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{19}
+	return file_service_server_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *FindServerNamesResponse) GetServerNamesJSON() []byte {
@@ -1382,12 +1557,13 @@ type UpdateServerNamesRequest struct {
 
 	ServerId        int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"`              // 网站ID
 	ServerNamesJSON []byte `protobuf:"bytes,2,opt,name=serverNamesJSON,proto3" json:"serverNamesJSON,omitempty"` // 域名列表 @link json:server_names
+	Version         int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                // 修改前读取的版本号，用于检测并发修改，为0表示不检查
 }
 
 func (x *UpdateServerNamesRequest) Reset() {
 	*x = UpdateServerNamesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[20]
+		mi := &file_service_server_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1400,7 +1576,7 @@ func (x *UpdateServerNamesRequest) String() string {
 func (*UpdateServerNamesRequest) ProtoMessage() {}
 
 func (x *UpdateServerNamesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[20]
+	mi := &file_service_server_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,7 +1589,7 @@ func (x *UpdateServerNamesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerNamesRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerNamesRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{20}
+	return file_service_server_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateServerNamesRequest) GetServerId() int64 {
@@ -1430,6 +1606,13 @@ func (x *UpdateServerNamesRequest) GetServerNamesJSON() []byte {
 	return nil
 }
 
+func (x *UpdateServerNamesRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // 审核网站的域名设置
 type UpdateServerNamesAuditingRequest struct {
 	state         protoimpl.MessageState
@@ -1443,7 +1626,7 @@ type UpdateServerNamesAuditingRequest struct {
 func (x *UpdateServerNamesAuditingRequest) Reset() {
 	*x = UpdateServerNamesAuditingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[21]
+		mi := &file_service_server_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1456,7 +1639,7 @@ func (x *UpdateServerNamesAuditingRequest) String() string {
 func (*UpdateServerNamesAuditingRequest) ProtoMessage() {}
 
 func (x *UpdateServerNamesAuditingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[21]
+	mi := &file_service_server_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1469,7 +1652,7 @@ func (x *UpdateServerNamesAuditingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerNamesAuditingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerNamesAuditingRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{21}
+	return file_service_server_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateServerNamesAuditingRequest) GetServerId() int64 {
@@ -1499,7 +1682,7 @@ type UpdateServerDNSRequest struct {
 func (x *UpdateServerDNSRequest) Reset() {
 	*x = UpdateServerDNSRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[22]
+		mi := &file_service_server_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1512,7 +1695,7 @@ func (x *UpdateServerDNSRequest) String() string {
 func (*UpdateServerDNSRequest) ProtoMessage() {}
 
 func (x *UpdateServerDNSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[22]
+	mi := &file_service_server_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1525,7 +1708,7 @@ func (x *UpdateServerDNSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerDNSRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerDNSRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{22}
+	return file_service_server_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UpdateServerDNSRequest) GetServerId() int64 {
@@ -1554,7 +1737,7 @@ type RegenerateServerDNSNameRequest struct {
 func (x *RegenerateServerDNSNameRequest) Reset() {
 	*x = RegenerateServerDNSNameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[23]
+		mi := &file_service_server_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1567,7 +1750,7 @@ func (x *RegenerateServerDNSNameRequest) String() string {
 func (*RegenerateServerDNSNameRequest) ProtoMessage() {}
 
 func (x *RegenerateServerDNSNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[23]
+	mi := &file_service_server_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1580,7 +1763,7 @@ func (x *RegenerateServerDNSNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegenerateServerDNSNameRequest.ProtoReflect.Descriptor instead.
 func (*RegenerateServerDNSNameRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{23}
+	return file_service_server_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RegenerateServerDNSNameRequest) GetServerId() int64 {
@@ -1603,7 +1786,7 @@ type UpdateServerDNSNameRequest struct {
 func (x *UpdateServerDNSNameRequest) Reset() {
 	*x = UpdateServerDNSNameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[24]
+		mi := &file_service_server_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1616,7 +1799,7 @@ func (x *UpdateServerDNSNameRequest) String() string {
 func (*UpdateServerDNSNameRequest) ProtoMessage() {}
 
 func (x *UpdateServerDNSNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[24]
+	mi := &file_service_server_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1629,7 +1812,7 @@ func (x *UpdateServerDNSNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerDNSNameRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerDNSNameRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{24}
+	return file_service_server_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UpdateServerDNSNameRequest) GetServerId() int64 {
@@ -1659,7 +1842,7 @@ type FindServerIdWithDNSNameRequest struct {
 func (x *FindServerIdWithDNSNameRequest) Reset() {
 	*x = FindServerIdWithDNSNameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[25]
+		mi := &file_service_server_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1672,7 +1855,7 @@ func (x *FindServerIdWithDNSNameRequest) String() string {
 func (*FindServerIdWithDNSNameRequest) ProtoMessage() {}
 
 func (x *FindServerIdWithDNSNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[25]
+	mi := &file_service_server_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1685,7 +1868,7 @@ func (x *FindServerIdWithDNSNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindServerIdWithDNSNameRequest.ProtoReflect.Descriptor instead.
 func (*FindServerIdWithDNSNameRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{25}
+	return file_service_server_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *FindServerIdWithDNSNameRequest) GetNodeClusterId() int64 {
@@ -1713,7 +1896,7 @@ type FindServerIdWithDNSNameResponse struct {
 func (x *FindServerIdWithDNSNameResponse) Reset() {
 	*x = FindServerIdWithDNSNameResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[26]
+		mi := &file_service_server_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1726,7 +1909,7 @@ func (x *FindServerIdWithDNSNameResponse) String() string {
 func (*FindServerIdWithDNSNameResponse) ProtoMessage() {}
 
 func (x *FindServerIdWithDNSNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[26]
+	mi := &file_service_server_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1739,7 +1922,7 @@ func (x *FindServerIdWithDNSNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindServerIdWithDNSNameResponse.ProtoReflect.Descriptor instead.
 func (*FindServerIdWithDNSNameResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{26}
+	return file_service_server_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *FindServerIdWithDNSNameResponse) GetServerId() int64 {
@@ -1767,7 +1950,7 @@ type CountAllEnabledServersMatchRequest struct {
 func (x *CountAllEnabledServersMatchRequest) Reset() {
 	*x = CountAllEnabledServersMatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[27]
+		mi := &file_service_server_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1780,7 +1963,7 @@ func (x *CountAllEnabledServersMatchRequest) String() string {
 func (*CountAllEnabledServersMatchRequest) ProtoMessage() {}
 
 func (x *CountAllEnabledServersMatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[27]
+	mi := &file_service_server_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1793,7 +1976,7 @@ func (x *CountAllEnabledServersMatchRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CountAllEnabledServersMatchRequest.ProtoReflect.Descriptor instead.
 func (*CountAllEnabledServersMatchRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{27}
+	return file_service_server_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CountAllEnabledServersMatchRequest) GetServerGroupId() int64 {
@@ -1872,7 +2055,7 @@ type ListEnabledServersMatchRequest struct {
 func (x *ListEnabledServersMatchRequest) Reset() {
 	*x = ListEnabledServersMatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[28]
+		mi := &file_service_server_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1885,7 +2068,7 @@ func (x *ListEnabledServersMatchRequest) String() string {
 func (*ListEnabledServersMatchRequest) ProtoMessage() {}
 
 func (x *ListEnabledServersMatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[28]
+	mi := &file_service_server_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1898,7 +2081,7 @@ func (x *ListEnabledServersMatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEnabledServersMatchRequest.ProtoReflect.Descriptor instead.
 func (*ListEnabledServersMatchRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{28}
+	return file_service_server_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ListEnabledServersMatchRequest) GetOffset() int64 {
@@ -2024,7 +2207,7 @@ type ListEnabledServersMatchResponse struct {
 func (x *ListEnabledServersMatchResponse) Reset() {
 	*x = ListEnabledServersMatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[29]
+		mi := &file_service_server_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2037,7 +2220,7 @@ func (x *ListEnabledServersMatchResponse) String() string {
 func (*ListEnabledServersMatchResponse) ProtoMessage() {}
 
 func (x *ListEnabledServersMatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[29]
+	mi := &file_service_server_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2050,7 +2233,7 @@ func (x *ListEnabledServersMatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEnabledServersMatchResponse.ProtoReflect.Descriptor instead.
 func (*ListEnabledServersMatchResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{29}
+	return file_service_server_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ListEnabledServersMatchResponse) GetServers() []*Server {
@@ -2072,7 +2255,7 @@ type DeleteServerRequest struct {
 func (x *DeleteServerRequest) Reset() {
 	*x = DeleteServerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[30]
+		mi := &file_service_server_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2085,7 +2268,7 @@ func (x *DeleteServerRequest) String() string {
 func (*DeleteServerRequest) ProtoMessage() {}
 
 func (x *DeleteServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[30]
+	mi := &file_service_server_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2098,7 +2281,7 @@ func (x *DeleteServerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteServerRequest.ProtoReflect.Descriptor instead.
 func (*DeleteServerRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{30}
+	return file_service_server_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *DeleteServerRequest) GetServerId() int64 {
@@ -2120,7 +2303,7 @@ type DeleteServersRequest struct {
 func (x *DeleteServersRequest) Reset() {
 	*x = DeleteServersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[31]
+		mi := &file_service_server_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2133,7 +2316,7 @@ func (x *DeleteServersRequest) String() string {
 func (*DeleteServersRequest) ProtoMessage() {}
 
 func (x *DeleteServersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[31]
+	mi := &file_service_server_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2146,7 +2329,7 @@ func (x *DeleteServersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteServersRequest.ProtoReflect.Descriptor instead.
 func (*DeleteServersRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{31}
+	return file_service_server_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DeleteServersRequest) GetServerIds() []int64 {
@@ -2169,7 +2352,7 @@ type FindEnabledServerRequest struct {
 func (x *FindEnabledServerRequest) Reset() {
 	*x = FindEnabledServerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[32]
+		mi := &file_service_server_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2182,7 +2365,7 @@ func (x *FindEnabledServerRequest) String() string {
 func (*FindEnabledServerRequest) ProtoMessage() {}
 
 func (x *FindEnabledServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[32]
+	mi := &file_service_server_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2195,7 +2378,7 @@ func (x *FindEnabledServerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledServerRequest.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{32}
+	return file_service_server_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *FindEnabledServerRequest) GetServerId() int64 {
@@ -2223,7 +2406,7 @@ type FindEnabledServerResponse struct {
 func (x *FindEnabledServerResponse) Reset() {
 	*x = FindEnabledServerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[33]
+		mi := &file_service_server_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2236,7 +2419,7 @@ func (x *FindEnabledServerResponse) String() string {
 func (*FindEnabledServerResponse) ProtoMessage() {}
 
 func (x *FindEnabledServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[33]
+	mi := &file_service_server_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2249,7 +2432,7 @@ func (x *FindEnabledServerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledServerResponse.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{33}
+	return file_service_server_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *FindEnabledServerResponse) GetServer() *Server {
@@ -2271,7 +2454,7 @@ type FindEnabledServerConfigRequest struct {
 func (x *FindEnabledServerConfigRequest) Reset() {
 	*x = FindEnabledServerConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[34]
+		mi := &file_service_server_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2284,7 +2467,7 @@ func (x *FindEnabledServerConfigRequest) String() string {
 func (*FindEnabledServerConfigRequest) ProtoMessage() {}
 
 func (x *FindEnabledServerConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[34]
+	mi := &file_service_server_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2297,7 +2480,7 @@ func (x *FindEnabledServerConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledServerConfigRequest.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerConfigRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{34}
+	return file_service_server_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *FindEnabledServerConfigRequest) GetServerId() int64 {
@@ -2318,7 +2501,7 @@ type FindEnabledServerConfigResponse struct {
 func (x *FindEnabledServerConfigResponse) Reset() {
 	*x = FindEnabledServerConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[35]
+		mi := &file_service_server_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2331,7 +2514,7 @@ func (x *FindEnabledServerConfigResponse) String() string {
 func (*FindEnabledServerConfigResponse) ProtoMessage() {}
 
 func (x *FindEnabledServerConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[35]
+	mi := &file_service_server_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2344,7 +2527,7 @@ func (x *FindEnabledServerConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledServerConfigResponse.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerConfigResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{35}
+	return file_service_server_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *FindEnabledServerConfigResponse) GetServerJSON() []byte {
@@ -2366,7 +2549,7 @@ type FindEnabledServerTypeRequest struct {
 func (x *FindEnabledServerTypeRequest) Reset() {
 	*x = FindEnabledServerTypeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[36]
+		mi := &file_service_server_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2379,7 +2562,7 @@ func (x *FindEnabledServerTypeRequest) String() string {
 func (*FindEnabledServerTypeRequest) ProtoMessage() {}
 
 func (x *FindEnabledServerTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[36]
+	mi := &file_service_server_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2392,7 +2575,7 @@ func (x *FindEnabledServerTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledServerTypeRequest.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerTypeRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{36}
+	return file_service_server_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *FindEnabledServerTypeRequest) GetServerId() int64 {
@@ -2413,7 +2596,7 @@ type FindEnabledServerTypeResponse struct {
 func (x *FindEnabledServerTypeResponse) Reset() {
 	*x = FindEnabledServerTypeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[37]
+		mi := &file_service_server_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2426,7 +2609,7 @@ func (x *FindEnabledServerTypeResponse) String() string {
 func (*FindEnabledServerTypeResponse) ProtoMessage() {}
 
 func (x *FindEnabledServerTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[37]
+	mi := &file_service_server_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2439,7 +2622,7 @@ func (x *FindEnabledServerTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledServerTypeResponse.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerTypeResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{37}
+	return file_service_server_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *FindEnabledServerTypeResponse) GetType() string {
@@ -2461,7 +2644,7 @@ type FindAndInitServerReverseProxyConfigRequest struct {
 func (x *FindAndInitServerReverseProxyConfigRequest) Reset() {
 	*x = FindAndInitServerReverseProxyConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[38]
+		mi := &file_service_server_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2474,7 +2657,7 @@ func (x *FindAndInitServerReverseProxyConfigRequest) String() string {
 func (*FindAndInitServerReverseProxyConfigRequest) ProtoMessage() {}
 
 func (x *FindAndInitServerReverseProxyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[38]
+	mi := &file_service_server_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2487,7 +2670,7 @@ func (x *FindAndInitServerReverseProxyConfigRequest) ProtoReflect() protoreflect
 
 // Deprecated: Use FindAndInitServerReverseProxyConfigRequest.ProtoReflect.Descriptor instead.
 func (*FindAndInitServerReverseProxyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{38}
+	return file_service_server_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *FindAndInitServerReverseProxyConfigRequest) GetServerId() int64 {
@@ -2509,7 +2692,7 @@ type FindAndInitServerReverseProxyConfigResponse struct {
 func (x *FindAndInitServerReverseProxyConfigResponse) Reset() {
 	*x = FindAndInitServerReverseProxyConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[39]
+		mi := &file_service_server_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2522,7 +2705,7 @@ func (x *FindAndInitServerReverseProxyConfigResponse) String() string {
 func (*FindAndInitServerReverseProxyConfigResponse) ProtoMessage() {}
 
 func (x *FindAndInitServerReverseProxyConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[39]
+	mi := &file_service_server_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2535,7 +2718,7 @@ func (x *FindAndInitServerReverseProxyConfigResponse) ProtoReflect() protoreflec
 
 // Deprecated: Use FindAndInitServerReverseProxyConfigResponse.ProtoReflect.Descriptor instead.
 func (*FindAndInitServerReverseProxyConfigResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{39}
+	return file_service_server_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *FindAndInitServerReverseProxyConfigResponse) GetReverseProxyJSON() []byte {
@@ -2564,7 +2747,7 @@ type FindAndInitServerWebConfigRequest struct {
 func (x *FindAndInitServerWebConfigRequest) Reset() {
 	*x = FindAndInitServerWebConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[40]
+		mi := &file_service_server_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2577,7 +2760,7 @@ func (x *FindAndInitServerWebConfigRequest) String() string {
 func (*FindAndInitServerWebConfigRequest) ProtoMessage() {}
 
 func (x *FindAndInitServerWebConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[40]
+	mi := &file_service_server_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,7 +2773,7 @@ func (x *FindAndInitServerWebConfigRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use FindAndInitServerWebConfigRequest.ProtoReflect.Descriptor instead.
 func (*FindAndInitServerWebConfigRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{40}
+	return file_service_server_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *FindAndInitServerWebConfigRequest) GetServerId() int64 {
@@ -2611,7 +2794,7 @@ type FindAndInitServerWebConfigResponse struct {
 func (x *FindAndInitServerWebConfigResponse) Reset() {
 	*x = FindAndInitServerWebConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[41]
+		mi := &file_service_server_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2624,7 +2807,7 @@ func (x *FindAndInitServerWebConfigResponse) String() string {
 func (*FindAndInitServerWebConfigResponse) ProtoMessage() {}
 
 func (x *FindAndInitServerWebConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[41]
+	mi := &file_service_server_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2637,7 +2820,7 @@ func (x *FindAndInitServerWebConfigResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use FindAndInitServerWebConfigResponse.ProtoReflect.Descriptor instead.
 func (*FindAndInitServerWebConfigResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{41}
+	return file_service_server_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *FindAndInitServerWebConfigResponse) GetWebJSON() []byte {
@@ -2659,7 +2842,7 @@ type CountAllEnabledServersWithSSLCertIdRequest struct {
 func (x *CountAllEnabledServersWithSSLCertIdRequest) Reset() {
 	*x = CountAllEnabledServersWithSSLCertIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[42]
+		mi := &file_service_server_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2672,7 +2855,7 @@ func (x *CountAllEnabledServersWithSSLCertIdRequest) String() string {
 func (*CountAllEnabledServersWithSSLCertIdRequest) ProtoMessage() {}
 
 func (x *CountAllEnabledServersWithSSLCertIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[42]
+	mi := &file_service_server_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2685,7 +2868,7 @@ func (x *CountAllEnabledServersWithSSLCertIdRequest) ProtoReflect() protoreflect
 
 // Deprecated: Use CountAllEnabledServersWithSSLCertIdRequest.ProtoReflect.Descriptor instead.
 func (*CountAllEnabledServersWithSSLCertIdRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{42}
+	return file_service_server_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CountAllEnabledServersWithSSLCertIdRequest) GetSslCertId() int64 {
@@ -2707,7 +2890,7 @@ type FindAllEnabledServersWithSSLCertIdRequest struct {
 func (x *FindAllEnabledServersWithSSLCertIdRequest) Reset() {
 	*x = FindAllEnabledServersWithSSLCertIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[43]
+		mi := &file_service_server_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2720,7 +2903,7 @@ func (x *FindAllEnabledServersWithSSLCertIdRequest) String() string {
 func (*FindAllEnabledServersWithSSLCertIdRequest) ProtoMessage() {}
 
 func (x *FindAllEnabledServersWithSSLCertIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[43]
+	mi := &file_service_server_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2733,7 +2916,7 @@ func (x *FindAllEnabledServersWithSSLCertIdRequest) ProtoReflect() protoreflect.
 
 // Deprecated: Use FindAllEnabledServersWithSSLCertIdRequest.ProtoReflect.Descriptor instead.
 func (*FindAllEnabledServersWithSSLCertIdRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{43}
+	return file_service_server_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *FindAllEnabledServersWithSSLCertIdRequest) GetSslCertId() int64 {
@@ -2754,7 +2937,7 @@ type FindAllEnabledServersWithSSLCertIdResponse struct {
 func (x *FindAllEnabledServersWithSSLCertIdResponse) Reset() {
 	*x = FindAllEnabledServersWithSSLCertIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[44]
+		mi := &file_service_server_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2767,7 +2950,7 @@ func (x *FindAllEnabledServersWithSSLCertIdResponse) String() string {
 func (*FindAllEnabledServersWithSSLCertIdResponse) ProtoMessage() {}
 
 func (x *FindAllEnabledServersWithSSLCertIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[44]
+	mi := &file_service_server_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2780,7 +2963,7 @@ func (x *FindAllEnabledServersWithSSLCertIdResponse) ProtoReflect() protoreflect
 
 // Deprecated: Use FindAllEnabledServersWithSSLCertIdResponse.ProtoReflect.Descriptor instead.
 func (*FindAllEnabledServersWithSSLCertIdResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{44}
+	return file_service_server_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *FindAllEnabledServersWithSSLCertIdResponse) GetServers() []*Server {
@@ -2802,7 +2985,7 @@ type CountAllEnabledServersWithNodeClusterIdRequest struct {
 func (x *CountAllEnabledServersWithNodeClusterIdRequest) Reset() {
 	*x = CountAllEnabledServersWithNodeClusterIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[45]
+		mi := &file_service_server_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2815,7 +2998,7 @@ func (x *CountAllEnabledServersWithNodeClusterIdRequest) String() string {
 func (*CountAllEnabledServersWithNodeClusterIdRequest) ProtoMessage() {}
 
 func (x *CountAllEnabledServersWithNodeClusterIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[45]
+	mi := &file_service_server_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2828,7 +3011,7 @@ func (x *CountAllEnabledServersWithNodeClusterIdRequest) ProtoReflect() protoref
 
 // Deprecated: Use CountAllEnabledServersWithNodeClusterIdRequest.ProtoReflect.Descriptor instead.
 func (*CountAllEnabledServersWithNodeClusterIdRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{45}
+	return file_service_server_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *CountAllEnabledServersWithNodeClusterIdRequest) GetNodeClusterId() int64 {
@@ -2850,7 +3033,7 @@ type CountAllEnabledServersWithServerGroupIdRequest struct {
 func (x *CountAllEnabledServersWithServerGroupIdRequest) Reset() {
 	*x = CountAllEnabledServersWithServerGroupIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[46]
+		mi := &file_service_server_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2863,7 +3046,7 @@ func (x *CountAllEnabledServersWithServerGroupIdRequest) String() string {
 func (*CountAllEnabledServersWithServerGroupIdRequest) ProtoMessage() {}
 
 func (x *CountAllEnabledServersWithServerGroupIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[46]
+	mi := &file_service_server_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2876,7 +3059,7 @@ func (x *CountAllEnabledServersWithServerGroupIdRequest) ProtoReflect() protoref
 
 // Deprecated: Use CountAllEnabledServersWithServerGroupIdRequest.ProtoReflect.Descriptor instead.
 func (*CountAllEnabledServersWithServerGroupIdRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{46}
+	return file_service_server_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *CountAllEnabledServersWithServerGroupIdRequest) GetServerGroupId() int64 {
@@ -2896,7 +3079,7 @@ type NotifyServersChangeRequest struct {
 func (x *NotifyServersChangeRequest) Reset() {
 	*x = NotifyServersChangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[47]
+		mi := &file_service_server_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2909,7 +3092,7 @@ func (x *NotifyServersChangeRequest) String() string {
 func (*NotifyServersChangeRequest) ProtoMessage() {}
 
 func (x *NotifyServersChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[47]
+	mi := &file_service_server_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2922,7 +3105,7 @@ func (x *NotifyServersChangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyServersChangeRequest.ProtoReflect.Descriptor instead.
 func (*NotifyServersChangeRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{47}
+	return file_service_server_proto_rawDescGZIP(), []int{49}
 }
 
 type NotifyServersChangeResponse struct {
@@ -2934,7 +3117,7 @@ type NotifyServersChangeResponse struct {
 func (x *NotifyServersChangeResponse) Reset() {
 	*x = NotifyServersChangeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[48]
+		mi := &file_service_server_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2947,7 +3130,7 @@ func (x *NotifyServersChangeResponse) String() string {
 func (*NotifyServersChangeResponse) ProtoMessage() {}
 
 func (x *NotifyServersChangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[48]
+	mi := &file_service_server_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2960,7 +3143,7 @@ func (x *NotifyServersChangeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyServersChangeResponse.ProtoReflect.Descriptor instead.
 func (*NotifyServersChangeResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{48}
+	return file_service_server_proto_rawDescGZIP(), []int{50}
 }
 
 // 取得某个集群下的所有网站相关的DNS
@@ -2975,7 +3158,7 @@ type FindAllEnabledServersDNSWithNodeClusterIdRequest struct {
 func (x *FindAllEnabledServersDNSWithNodeClusterIdRequest) Reset() {
 	*x = FindAllEnabledServersDNSWithNodeClusterIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[49]
+		mi := &file_service_server_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2988,7 +3171,7 @@ func (x *FindAllEnabledServersDNSWithNodeClusterIdRequest) String() string {
 func (*FindAllEnabledServersDNSWithNodeClusterIdRequest) ProtoMessage() {}
 
 func (x *FindAllEnabledServersDNSWithNodeClusterIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[49]
+	mi := &file_service_server_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3001,7 +3184,7 @@ func (x *FindAllEnabledServersDNSWithNodeClusterIdRequest) ProtoReflect() protor
 
 // Deprecated: Use FindAllEnabledServersDNSWithNodeClusterIdRequest.ProtoReflect.Descriptor instead.
 func (*FindAllEnabledServersDNSWithNodeClusterIdRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{49}
+	return file_service_server_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *FindAllEnabledServersDNSWithNodeClusterIdRequest) GetNodeClusterId() int64 {
@@ -3022,7 +3205,7 @@ type FindAllEnabledServersDNSWithNodeClusterIdResponse struct {
 func (x *FindAllEnabledServersDNSWithNodeClusterIdResponse) Reset() {
 	*x = FindAllEnabledServersDNSWithNodeClusterIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[50]
+		mi := &file_service_server_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3035,7 +3218,7 @@ func (x *FindAllEnabledServersDNSWithNodeClusterIdResponse) String() string {
 func (*FindAllEnabledServersDNSWithNodeClusterIdResponse) ProtoMessage() {}
 
 func (x *FindAllEnabledServersDNSWithNodeClusterIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[50]
+	mi := &file_service_server_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3048,7 +3231,7 @@ func (x *FindAllEnabledServersDNSWithNodeClusterIdResponse) ProtoReflect() proto
 
 // Deprecated: Use FindAllEnabledServersDNSWithNodeClusterIdResponse.ProtoReflect.Descriptor instead.
 func (*FindAllEnabledServersDNSWithNodeClusterIdResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{50}
+	return file_service_server_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *FindAllEnabledServersDNSWithNodeClusterIdResponse) GetServers() []*ServerDNSInfo {
@@ -3071,7 +3254,7 @@ type ServerDNSInfo struct {
 func (x *ServerDNSInfo) Reset() {
 	*x = ServerDNSInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[51]
+		mi := &file_service_server_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3084,7 +3267,7 @@ func (x *ServerDNSInfo) String() string {
 func (*ServerDNSInfo) ProtoMessage() {}
 
 func (x *ServerDNSInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[51]
+	mi := &file_service_server_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3097,7 +3280,7 @@ func (x *ServerDNSInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerDNSInfo.ProtoReflect.Descriptor instead.
 func (*ServerDNSInfo) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{51}
+	return file_service_server_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ServerDNSInfo) GetId() int64 {
@@ -3133,7 +3316,7 @@ type FindEnabledServerDNSRequest struct {
 func (x *FindEnabledServerDNSRequest) Reset() {
 	*x = FindEnabledServerDNSRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[52]
+		mi := &file_service_server_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3146,7 +3329,7 @@ func (x *FindEnabledServerDNSRequest) String() string {
 func (*FindEnabledServerDNSRequest) ProtoMessage() {}
 
 func (x *FindEnabledServerDNSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[52]
+	mi := &file_service_server_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3159,7 +3342,7 @@ func (x *FindEnabledServerDNSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledServerDNSRequest.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerDNSRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{52}
+	return file_service_server_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *FindEnabledServerDNSRequest) GetServerId() int64 {
@@ -3182,7 +3365,7 @@ type FindEnabledServerDNSResponse struct {
 func (x *FindEnabledServerDNSResponse) Reset() {
 	*x = FindEnabledServerDNSResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[53]
+		mi := &file_service_server_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3195,7 +3378,7 @@ func (x *FindEnabledServerDNSResponse) String() string {
 func (*FindEnabledServerDNSResponse) ProtoMessage() {}
 
 func (x *FindEnabledServerDNSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[53]
+	mi := &file_service_server_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3208,7 +3391,7 @@ func (x *FindEnabledServerDNSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledServerDNSResponse.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerDNSResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{53}
+	return file_service_server_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *FindEnabledServerDNSResponse) GetDnsName() string {
@@ -3244,7 +3427,7 @@ type CheckUserServerRequest struct {
 func (x *CheckUserServerRequest) Reset() {
 	*x = CheckUserServerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[54]
+		mi := &file_service_server_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3257,7 +3440,7 @@ func (x *CheckUserServerRequest) String() string {
 func (*CheckUserServerRequest) ProtoMessage() {}
 
 func (x *CheckUserServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[54]
+	mi := &file_service_server_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3270,7 +3453,7 @@ func (x *CheckUserServerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckUserServerRequest.ProtoReflect.Descriptor instead.
 func (*CheckUserServerRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{54}
+	return file_service_server_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *CheckUserServerRequest) GetServerId() int64 {
@@ -3292,7 +3475,7 @@ type FindAllEnabledServerNamesWithUserIdRequest struct {
 func (x *FindAllEnabledServerNamesWithUserIdRequest) Reset() {
 	*x = FindAllEnabledServerNamesWithUserIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[55]
+		mi := &file_service_server_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3305,7 +3488,7 @@ func (x *FindAllEnabledServerNamesWithUserIdRequest) String() string {
 func (*FindAllEnabledServerNamesWithUserIdRequest) ProtoMessage() {}
 
 func (x *FindAllEnabledServerNamesWithUserIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[55]
+	mi := &file_service_server_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3318,7 +3501,7 @@ func (x *FindAllEnabledServerNamesWithUserIdRequest) ProtoReflect() protoreflect
 
 // Deprecated: Use FindAllEnabledServerNamesWithUserIdRequest.ProtoReflect.Descriptor instead.
 func (*FindAllEnabledServerNamesWithUserIdRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{55}
+	return file_service_server_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *FindAllEnabledServerNamesWithUserIdRequest) GetUserId() int64 {
@@ -3339,7 +3522,7 @@ type FindAllEnabledServerNamesWithUserIdResponse struct {
 func (x *FindAllEnabledServerNamesWithUserIdResponse) Reset() {
 	*x = FindAllEnabledServerNamesWithUserIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[56]
+		mi := &file_service_server_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3352,7 +3535,7 @@ func (x *FindAllEnabledServerNamesWithUserIdResponse) String() string {
 func (*FindAllEnabledServerNamesWithUserIdResponse) ProtoMessage() {}
 
 func (x *FindAllEnabledServerNamesWithUserIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[56]
+	mi := &file_service_server_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3365,7 +3548,7 @@ func (x *FindAllEnabledServerNamesWithUserIdResponse) ProtoReflect() protoreflec
 
 // Deprecated: Use FindAllEnabledServerNamesWithUserIdResponse.ProtoReflect.Descriptor instead.
 func (*FindAllEnabledServerNamesWithUserIdResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{56}
+	return file_service_server_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *FindAllEnabledServerNamesWithUserIdResponse) GetServerNames() []string {
@@ -3388,7 +3571,7 @@ type CountAllServerNamesWithUserIdRequest struct {
 func (x *CountAllServerNamesWithUserIdRequest) Reset() {
 	*x = CountAllServerNamesWithUserIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[57]
+		mi := &file_service_server_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3401,7 +3584,7 @@ func (x *CountAllServerNamesWithUserIdRequest) String() string {
 func (*CountAllServerNamesWithUserIdRequest) ProtoMessage() {}
 
 func (x *CountAllServerNamesWithUserIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[57]
+	mi := &file_service_server_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3414,7 +3597,7 @@ func (x *CountAllServerNamesWithUserIdRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use CountAllServerNamesWithUserIdRequest.ProtoReflect.Descriptor instead.
 func (*CountAllServerNamesWithUserIdRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{57}
+	return file_service_server_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *CountAllServerNamesWithUserIdRequest) GetUserId() int64 {
@@ -3443,7 +3626,7 @@ type CountServerNamesRequest struct {
 func (x *CountServerNamesRequest) Reset() {
 	*x = CountServerNamesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[58]
+		mi := &file_service_server_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3456,7 +3639,7 @@ func (x *CountServerNamesRequest) String() string {
 func (*CountServerNamesRequest) ProtoMessage() {}
 
 func (x *CountServerNamesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[58]
+	mi := &file_service_server_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3469,7 +3652,7 @@ func (x *CountServerNamesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountServerNamesRequest.ProtoReflect.Descriptor instead.
 func (*CountServerNamesRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{58}
+	return file_service_server_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *CountServerNamesRequest) GetServerId() int64 {
@@ -3491,7 +3674,7 @@ type FindAllUserServersRequest struct {
 func (x *FindAllUserServersRequest) Reset() {
 	*x = FindAllUserServersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[59]
+		mi := &file_service_server_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3504,7 +3687,7 @@ func (x *FindAllUserServersRequest) String() string {
 func (*FindAllUserServersRequest) ProtoMessage() {}
 
 func (x *FindAllUserServersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[59]
+	mi := &file_service_server_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3517,7 +3700,7 @@ func (x *FindAllUserServersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindAllUserServersRequest.ProtoReflect.Descriptor instead.
 func (*FindAllUserServersRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{59}
+	return file_service_server_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *FindAllUserServersRequest) GetUserId() int64 {
@@ -3538,7 +3721,7 @@ type FindAllUserServersResponse struct {
 func (x *FindAllUserServersResponse) Reset() {
 	*x = FindAllUserServersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[60]
+		mi := &file_service_server_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3551,7 +3734,7 @@ func (x *FindAllUserServersResponse) String() string {
 func (*FindAllUserServersResponse) ProtoMessage() {}
 
 func (x *FindAllUserServersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[60]
+	mi := &file_service_server_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3564,7 +3747,7 @@ func (x *FindAllUserServersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindAllUserServersResponse.ProtoReflect.Descriptor instead.
 func (*FindAllUserServersResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{60}
+	return file_service_server_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *FindAllUserServersResponse) GetServers() []*Server {
@@ -3587,7 +3770,7 @@ type CountAllUserServersRequest struct {
 func (x *CountAllUserServersRequest) Reset() {
 	*x = CountAllUserServersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[61]
+		mi := &file_service_server_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3600,7 +3783,7 @@ func (x *CountAllUserServersRequest) String() string {
 func (*CountAllUserServersRequest) ProtoMessage() {}
 
 func (x *CountAllUserServersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[61]
+	mi := &file_service_server_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3613,7 +3796,7 @@ func (x *CountAllUserServersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountAllUserServersRequest.ProtoReflect.Descriptor instead.
 func (*CountAllUserServersRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{61}
+	return file_service_server_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *CountAllUserServersRequest) GetUserId() int64 {
@@ -3642,7 +3825,7 @@ type ComposeAllUserServersConfigRequest struct {
 func (x *ComposeAllUserServersConfigRequest) Reset() {
 	*x = ComposeAllUserServersConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[62]
+		mi := &file_service_server_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3655,7 +3838,7 @@ func (x *ComposeAllUserServersConfigRequest) String() string {
 func (*ComposeAllUserServersConfigRequest) ProtoMessage() {}
 
 func (x *ComposeAllUserServersConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[62]
+	mi := &file_service_server_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3668,7 +3851,7 @@ func (x *ComposeAllUserServersConfigRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ComposeAllUserServersConfigRequest.ProtoReflect.Descriptor instead.
 func (*ComposeAllUserServersConfigRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{62}
+	return file_service_server_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ComposeAllUserServersConfigRequest) GetUserId() int64 {
@@ -3689,7 +3872,7 @@ type ComposeAllUserServersConfigResponse struct {
 func (x *ComposeAllUserServersConfigResponse) Reset() {
 	*x = ComposeAllUserServersConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[63]
+		mi := &file_service_server_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3702,7 +3885,7 @@ func (x *ComposeAllUserServersConfigResponse) String() string {
 func (*ComposeAllUserServersConfigResponse) ProtoMessage() {}
 
 func (x *ComposeAllUserServersConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[63]
+	mi := &file_service_server_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3715,7 +3898,7 @@ func (x *ComposeAllUserServersConfigResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ComposeAllUserServersConfigResponse.ProtoReflect.Descriptor instead.
 func (*ComposeAllUserServersConfigResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{63}
+	return file_service_server_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ComposeAllUserServersConfigResponse) GetServersConfigJSON() []byte {
@@ -3737,7 +3920,7 @@ type FindEnabledUserServerBasicRequest struct {
 func (x *FindEnabledUserServerBasicRequest) Reset() {
 	*x = FindEnabledUserServerBasicRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[64]
+		mi := &file_service_server_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3750,7 +3933,7 @@ func (x *FindEnabledUserServerBasicRequest) String() string {
 func (*FindEnabledUserServerBasicRequest) ProtoMessage() {}
 
 func (x *FindEnabledUserServerBasicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[64]
+	mi := &file_service_server_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3763,7 +3946,7 @@ func (x *FindEnabledUserServerBasicRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use FindEnabledUserServerBasicRequest.ProtoReflect.Descriptor instead.
 func (*FindEnabledUserServerBasicRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{64}
+	return file_service_server_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *FindEnabledUserServerBasicRequest) GetServerId() int64 {
@@ -3784,7 +3967,7 @@ type FindEnabledUserServerBasicResponse struct {
 func (x *FindEnabledUserServerBasicResponse) Reset() {
 	*x = FindEnabledUserServerBasicResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[65]
+		mi := &file_service_server_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3797,7 +3980,7 @@ func (x *FindEnabledUserServerBasicResponse) String() string {
 func (*FindEnabledUserServerBasicResponse) ProtoMessage() {}
 
 func (x *FindEnabledUserServerBasicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[65]
+	mi := &file_service_server_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3810,7 +3993,7 @@ func (x *FindEnabledUserServerBasicResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use FindEnabledUserServerBasicResponse.ProtoReflect.Descriptor instead.
 func (*FindEnabledUserServerBasicResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{65}
+	return file_service_server_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *FindEnabledUserServerBasicResponse) GetServer() *Server {
@@ -3833,7 +4016,7 @@ type UpdateEnabledUserServerBasicRequest struct {
 func (x *UpdateEnabledUserServerBasicRequest) Reset() {
 	*x = UpdateEnabledUserServerBasicRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[66]
+		mi := &file_service_server_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3846,7 +4029,7 @@ func (x *UpdateEnabledUserServerBasicRequest) String() string {
 func (*UpdateEnabledUserServerBasicRequest) ProtoMessage() {}
 
 func (x *UpdateEnabledUserServerBasicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[66]
+	mi := &file_service_server_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3859,7 +4042,7 @@ func (x *UpdateEnabledUserServerBasicRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UpdateEnabledUserServerBasicRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEnabledUserServerBasicRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{66}
+	return file_service_server_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *UpdateEnabledUserServerBasicRequest) GetServerId() int64 {
@@ -3894,7 +4077,7 @@ type UploadServerHTTPRequestStatRequest struct {
 func (x *UploadServerHTTPRequestStatRequest) Reset() {
 	*x = UploadServerHTTPRequestStatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[67]
+		mi := &file_service_server_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3907,7 +4090,7 @@ func (x *UploadServerHTTPRequestStatRequest) String() string {
 func (*UploadServerHTTPRequestStatRequest) ProtoMessage() {}
 
 func (x *UploadServerHTTPRequestStatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[67]
+	mi := &file_service_server_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3920,7 +4103,7 @@ func (x *UploadServerHTTPRequestStatRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UploadServerHTTPRequestStatRequest.ProtoReflect.Descriptor instead.
 func (*UploadServerHTTPRequestStatRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{67}
+	return file_service_server_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *UploadServerHTTPRequestStatRequest) GetMonth() string {
@@ -3987,7 +4170,7 @@ type CheckServerNameDuplicationInNodeClusterRequest struct {
 func (x *CheckServerNameDuplicationInNodeClusterRequest) Reset() {
 	*x = CheckServerNameDuplicationInNodeClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[68]
+		mi := &file_service_server_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4000,7 +4183,7 @@ func (x *CheckServerNameDuplicationInNodeClusterRequest) String() string {
 func (*CheckServerNameDuplicationInNodeClusterRequest) ProtoMessage() {}
 
 func (x *CheckServerNameDuplicationInNodeClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[68]
+	mi := &file_service_server_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4013,7 +4196,7 @@ func (x *CheckServerNameDuplicationInNodeClusterRequest) ProtoReflect() protoref
 
 // Deprecated: Use CheckServerNameDuplicationInNodeClusterRequest.ProtoReflect.Descriptor instead.
 func (*CheckServerNameDuplicationInNodeClusterRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{68}
+	return file_service_server_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *CheckServerNameDuplicationInNodeClusterRequest) GetNodeClusterId() int64 {
@@ -4055,7 +4238,7 @@ type CheckServerNameDuplicationInNodeClusterResponse struct {
 func (x *CheckServerNameDuplicationInNodeClusterResponse) Reset() {
 	*x = CheckServerNameDuplicationInNodeClusterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[69]
+		mi := &file_service_server_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4068,7 +4251,7 @@ func (x *CheckServerNameDuplicationInNodeClusterResponse) String() string {
 func (*CheckServerNameDuplicationInNodeClusterResponse) ProtoMessage() {}
 
 func (x *CheckServerNameDuplicationInNodeClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[69]
+	mi := &file_service_server_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4081,7 +4264,7 @@ func (x *CheckServerNameDuplicationInNodeClusterResponse) ProtoReflect() protore
 
 // Deprecated: Use CheckServerNameDuplicationInNodeClusterResponse.ProtoReflect.Descriptor instead.
 func (*CheckServerNameDuplicationInNodeClusterResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{69}
+	return file_service_server_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *CheckServerNameDuplicationInNodeClusterResponse) GetDuplicatedServerNames() []string {
@@ -4104,7 +4287,7 @@ type CheckServerNameInServerRequest struct {
 func (x *CheckServerNameInServerRequest) Reset() {
 	*x = CheckServerNameInServerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[70]
+		mi := &file_service_server_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4117,7 +4300,7 @@ func (x *CheckServerNameInServerRequest) String() string {
 func (*CheckServerNameInServerRequest) ProtoMessage() {}
 
 func (x *CheckServerNameInServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[70]
+	mi := &file_service_server_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4130,7 +4313,7 @@ func (x *CheckServerNameInServerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckServerNameInServerRequest.ProtoReflect.Descriptor instead.
 func (*CheckServerNameInServerRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{70}
+	return file_service_server_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *CheckServerNameInServerRequest) GetServerId() int64 {
@@ -4158,7 +4341,7 @@ type CheckServerNameInServerResponse struct {
 func (x *CheckServerNameInServerResponse) Reset() {
 	*x = CheckServerNameInServerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[71]
+		mi := &file_service_server_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4171,7 +4354,7 @@ func (x *CheckServerNameInServerResponse) String() string {
 func (*CheckServerNameInServerResponse) ProtoMessage() {}
 
 func (x *CheckServerNameInServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[71]
+	mi := &file_service_server_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4184,7 +4367,7 @@ func (x *CheckServerNameInServerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckServerNameInServerResponse.ProtoReflect.Descriptor instead.
 func (*CheckServerNameInServerResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{71}
+	return file_service_server_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *CheckServerNameInServerResponse) GetExists() bool {
@@ -4206,7 +4389,7 @@ type FindLatestServersRequest struct {
 func (x *FindLatestServersRequest) Reset() {
 	*x = FindLatestServersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[72]
+		mi := &file_service_server_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4219,7 +4402,7 @@ func (x *FindLatestServersRequest) String() string {
 func (*FindLatestServersRequest) ProtoMessage() {}
 
 func (x *FindLatestServersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[72]
+	mi := &file_service_server_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4232,7 +4415,7 @@ func (x *FindLatestServersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindLatestServersRequest.ProtoReflect.Descriptor instead.
 func (*FindLatestServersRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{72}
+	return file_service_server_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *FindLatestServersRequest) GetSize() int64 {
@@ -4253,7 +4436,7 @@ type FindLatestServersResponse struct {
 func (x *FindLatestServersResponse) Reset() {
 	*x = FindLatestServersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[73]
+		mi := &file_service_server_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4266,7 +4449,7 @@ func (x *FindLatestServersResponse) String() string {
 func (*FindLatestServersResponse) ProtoMessage() {}
 
 func (x *FindLatestServersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[73]
+	mi := &file_service_server_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4279,7 +4462,7 @@ func (x *FindLatestServersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindLatestServersResponse.ProtoReflect.Descriptor instead.
 func (*FindLatestServersResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{73}
+	return file_service_server_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *FindLatestServersResponse) GetServers() []*Server {
@@ -4301,7 +4484,7 @@ type FindNearbyServersRequest struct {
 func (x *FindNearbyServersRequest) Reset() {
 	*x = FindNearbyServersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[74]
+		mi := &file_service_server_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4314,7 +4497,7 @@ func (x *FindNearbyServersRequest) String() string {
 func (*FindNearbyServersRequest) ProtoMessage() {}
 
 func (x *FindNearbyServersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[74]
+	mi := &file_service_server_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4327,7 +4510,7 @@ func (x *FindNearbyServersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindNearbyServersRequest.ProtoReflect.Descriptor instead.
 func (*FindNearbyServersRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{74}
+	return file_service_server_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *FindNearbyServersRequest) GetServerId() int64 {
@@ -4349,7 +4532,7 @@ type FindNearbyServersResponse struct {
 func (x *FindNearbyServersResponse) Reset() {
 	*x = FindNearbyServersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[75]
+		mi := &file_service_server_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4362,7 +4545,7 @@ func (x *FindNearbyServersResponse) String() string {
 func (*FindNearbyServersResponse) ProtoMessage() {}
 
 func (x *FindNearbyServersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[75]
+	mi := &file_service_server_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4375,7 +4558,7 @@ func (x *FindNearbyServersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindNearbyServersResponse.ProtoReflect.Descriptor instead.
 func (*FindNearbyServersResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{75}
+	return file_service_server_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *FindNearbyServersResponse) GetScope() string {
@@ -4406,7 +4589,7 @@ type PurgeServerCacheRequest struct {
 func (x *PurgeServerCacheRequest) Reset() {
 	*x = PurgeServerCacheRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[76]
+		mi := &file_service_server_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4419,7 +4602,7 @@ func (x *PurgeServerCacheRequest) String() string {
 func (*PurgeServerCacheRequest) ProtoMessage() {}
 
 func (x *PurgeServerCacheRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[76]
+	mi := &file_service_server_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4432,7 +4615,7 @@ func (x *PurgeServerCacheRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurgeServerCacheRequest.ProtoReflect.Descriptor instead.
 func (*PurgeServerCacheRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{76}
+	return file_service_server_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *PurgeServerCacheRequest) GetKeys() []string {
@@ -4461,14 +4644,15 @@ type PurgeServerCacheResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsOk    bool   `protobuf:"varint,1,opt,name=isOk,proto3" json:"isOk,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	IsOk             bool    `protobuf:"varint,1,opt,name=isOk,proto3" json:"isOk,omitempty"`
+	Message          string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	HttpCacheTaskIds []int64 `protobuf:"varint,3,rep,packed,name=httpCacheTaskIds,proto3" json:"httpCacheTaskIds,omitempty"` // 生成的缓存任务ID，可以用来查询执行进度
 }
 
 func (x *PurgeServerCacheResponse) Reset() {
 	*x = PurgeServerCacheResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[77]
+		mi := &file_service_server_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4481,7 +4665,7 @@ func (x *PurgeServerCacheResponse) String() string {
 func (*PurgeServerCacheResponse) ProtoMessage() {}
 
 func (x *PurgeServerCacheResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[77]
+	mi := &file_service_server_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4494,7 +4678,7 @@ func (x *PurgeServerCacheResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurgeServerCacheResponse.ProtoReflect.Descriptor instead.
 func (*PurgeServerCacheResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{77}
+	return file_service_server_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *PurgeServerCacheResponse) GetIsOk() bool {
@@ -4511,6 +4695,13 @@ func (x *PurgeServerCacheResponse) GetMessage() string {
 	return ""
 }
 
+func (x *PurgeServerCacheResponse) GetHttpCacheTaskIds() []int64 {
+	if x != nil {
+		return x.HttpCacheTaskIds
+	}
+	return nil
+}
+
 // 查找流量限制
 type FindEnabledServerTrafficLimitRequest struct {
 	state         protoimpl.MessageState
@@ -4523,7 +4714,7 @@ type FindEnabledServerTrafficLimitRequest struct {
 func (x *FindEnabledServerTrafficLimitRequest) Reset() {
 	*x = FindEnabledServerTrafficLimitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[78]
+		mi := &file_service_server_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4536,7 +4727,7 @@ func (x *FindEnabledServerTrafficLimitRequest) String() string {
 func (*FindEnabledServerTrafficLimitRequest) ProtoMessage() {}
 
 func (x *FindEnabledServerTrafficLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[78]
+	mi := &file_service_server_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4549,7 +4740,7 @@ func (x *FindEnabledServerTrafficLimitRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use FindEnabledServerTrafficLimitRequest.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerTrafficLimitRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{78}
+	return file_service_server_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *FindEnabledServerTrafficLimitRequest) GetServerId() int64 {
@@ -4570,7 +4761,7 @@ type FindEnabledServerTrafficLimitResponse struct {
 func (x *FindEnabledServerTrafficLimitResponse) Reset() {
 	*x = FindEnabledServerTrafficLimitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[79]
+		mi := &file_service_server_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4583,7 +4774,7 @@ func (x *FindEnabledServerTrafficLimitResponse) String() string {
 func (*FindEnabledServerTrafficLimitResponse) ProtoMessage() {}
 
 func (x *FindEnabledServerTrafficLimitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[79]
+	mi := &file_service_server_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4596,7 +4787,7 @@ func (x *FindEnabledServerTrafficLimitResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use FindEnabledServerTrafficLimitResponse.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerTrafficLimitResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{79}
+	return file_service_server_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *FindEnabledServerTrafficLimitResponse) GetTrafficLimitJSON() []byte {
@@ -4619,7 +4810,7 @@ type UpdateServerTrafficLimitRequest struct {
 func (x *UpdateServerTrafficLimitRequest) Reset() {
 	*x = UpdateServerTrafficLimitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[80]
+		mi := &file_service_server_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4632,7 +4823,7 @@ func (x *UpdateServerTrafficLimitRequest) String() string {
 func (*UpdateServerTrafficLimitRequest) ProtoMessage() {}
 
 func (x *UpdateServerTrafficLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[80]
+	mi := &file_service_server_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4645,7 +4836,7 @@ func (x *UpdateServerTrafficLimitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerTrafficLimitRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerTrafficLimitRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{80}
+	return file_service_server_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *UpdateServerTrafficLimitRequest) GetServerId() int64 {
@@ -4675,7 +4866,7 @@ type UpdateServerUserPlanRequest struct {
 func (x *UpdateServerUserPlanRequest) Reset() {
 	*x = UpdateServerUserPlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[81]
+		mi := &file_service_server_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4688,7 +4879,7 @@ func (x *UpdateServerUserPlanRequest) String() string {
 func (*UpdateServerUserPlanRequest) ProtoMessage() {}
 
 func (x *UpdateServerUserPlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[81]
+	mi := &file_service_server_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4701,7 +4892,7 @@ func (x *UpdateServerUserPlanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerUserPlanRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerUserPlanRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{81}
+	return file_service_server_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *UpdateServerUserPlanRequest) GetServerId() int64 {
@@ -4730,7 +4921,7 @@ type FindServerUserPlanRequest struct {
 func (x *FindServerUserPlanRequest) Reset() {
 	*x = FindServerUserPlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[82]
+		mi := &file_service_server_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4743,7 +4934,7 @@ func (x *FindServerUserPlanRequest) String() string {
 func (*FindServerUserPlanRequest) ProtoMessage() {}
 
 func (x *FindServerUserPlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[82]
+	mi := &file_service_server_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4756,7 +4947,7 @@ func (x *FindServerUserPlanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindServerUserPlanRequest.ProtoReflect.Descriptor instead.
 func (*FindServerUserPlanRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{82}
+	return file_service_server_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *FindServerUserPlanRequest) GetServerId() int64 {
@@ -4777,7 +4968,7 @@ type FindServerUserPlanResponse struct {
 func (x *FindServerUserPlanResponse) Reset() {
 	*x = FindServerUserPlanResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[83]
+		mi := &file_service_server_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4790,7 +4981,7 @@ func (x *FindServerUserPlanResponse) String() string {
 func (*FindServerUserPlanResponse) ProtoMessage() {}
 
 func (x *FindServerUserPlanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[83]
+	mi := &file_service_server_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4803,7 +4994,7 @@ func (x *FindServerUserPlanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindServerUserPlanResponse.ProtoReflect.Descriptor instead.
 func (*FindServerUserPlanResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{83}
+	return file_service_server_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *FindServerUserPlanResponse) GetUserPlan() *UserPlan {
@@ -4819,13 +5010,14 @@ type ComposeServerConfigRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerId int64 `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID
+	ServerId  int64    `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"`  // 网站ID
+	FieldMask []string `protobuf:"bytes,2,rep,name=fieldMask,proto3" json:"fieldMask,omitempty"` // 只返回配置中的这些字段，比如 id、web.root，为空表示返回所有字段
 }
 
 func (x *ComposeServerConfigRequest) Reset() {
 	*x = ComposeServerConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[84]
+		mi := &file_service_server_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4838,7 +5030,7 @@ func (x *ComposeServerConfigRequest) String() string {
 func (*ComposeServerConfigRequest) ProtoMessage() {}
 
 func (x *ComposeServerConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[84]
+	mi := &file_service_server_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4851,7 +5043,7 @@ func (x *ComposeServerConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComposeServerConfigRequest.ProtoReflect.Descriptor instead.
 func (*ComposeServerConfigRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{84}
+	return file_service_server_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ComposeServerConfigRequest) GetServerId() int64 {
@@ -4861,6 +5053,13 @@ func (x *ComposeServerConfigRequest) GetServerId() int64 {
 	return 0
 }
 
+func (x *ComposeServerConfigRequest) GetFieldMask() []string {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 type ComposeServerConfigResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4872,7 +5071,7 @@ type ComposeServerConfigResponse struct {
 func (x *ComposeServerConfigResponse) Reset() {
 	*x = ComposeServerConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[85]
+		mi := &file_service_server_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4885,7 +5084,7 @@ func (x *ComposeServerConfigResponse) String() string {
 func (*ComposeServerConfigResponse) ProtoMessage() {}
 
 func (x *ComposeServerConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[85]
+	mi := &file_service_server_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4898,7 +5097,7 @@ func (x *ComposeServerConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComposeServerConfigResponse.ProtoReflect.Descriptor instead.
 func (*ComposeServerConfigResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{85}
+	return file_service_server_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *ComposeServerConfigResponse) GetServerConfigJSON() []byte {
@@ -4921,7 +5120,7 @@ type UpdateServerUAMRequest struct {
 func (x *UpdateServerUAMRequest) Reset() {
 	*x = UpdateServerUAMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[86]
+		mi := &file_service_server_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4934,7 +5133,7 @@ func (x *UpdateServerUAMRequest) String() string {
 func (*UpdateServerUAMRequest) ProtoMessage() {}
 
 func (x *UpdateServerUAMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[86]
+	mi := &file_service_server_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4947,7 +5146,7 @@ func (x *UpdateServerUAMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerUAMRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerUAMRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{86}
+	return file_service_server_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *UpdateServerUAMRequest) GetServerId() int64 {
@@ -4976,7 +5175,7 @@ type FindEnabledServerUAMRequest struct {
 func (x *FindEnabledServerUAMRequest) Reset() {
 	*x = FindEnabledServerUAMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[87]
+		mi := &file_service_server_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4989,7 +5188,7 @@ func (x *FindEnabledServerUAMRequest) String() string {
 func (*FindEnabledServerUAMRequest) ProtoMessage() {}
 
 func (x *FindEnabledServerUAMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[87]
+	mi := &file_service_server_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5002,7 +5201,7 @@ func (x *FindEnabledServerUAMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledServerUAMRequest.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerUAMRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{87}
+	return file_service_server_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *FindEnabledServerUAMRequest) GetServerId() int64 {
@@ -5023,7 +5222,7 @@ type FindEnabledServerUAMResponse struct {
 func (x *FindEnabledServerUAMResponse) Reset() {
 	*x = FindEnabledServerUAMResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[88]
+		mi := &file_service_server_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5036,7 +5235,7 @@ func (x *FindEnabledServerUAMResponse) String() string {
 func (*FindEnabledServerUAMResponse) ProtoMessage() {}
 
 func (x *FindEnabledServerUAMResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[88]
+	mi := &file_service_server_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5049,7 +5248,7 @@ func (x *FindEnabledServerUAMResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindEnabledServerUAMResponse.ProtoReflect.Descriptor instead.
 func (*FindEnabledServerUAMResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{88}
+	return file_service_server_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *FindEnabledServerUAMResponse) GetUamJSON() []byte {
@@ -5072,7 +5271,7 @@ type UpdateServerUserRequest struct {
 func (x *UpdateServerUserRequest) Reset() {
 	*x = UpdateServerUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[89]
+		mi := &file_service_server_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5085,7 +5284,7 @@ func (x *UpdateServerUserRequest) String() string {
 func (*UpdateServerUserRequest) ProtoMessage() {}
 
 func (x *UpdateServerUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[89]
+	mi := &file_service_server_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5098,7 +5297,7 @@ func (x *UpdateServerUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerUserRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{89}
+	return file_service_server_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *UpdateServerUserRequest) GetServerId() int64 {
@@ -5128,7 +5327,7 @@ type UpdateServerNameRequest struct {
 func (x *UpdateServerNameRequest) Reset() {
 	*x = UpdateServerNameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[90]
+		mi := &file_service_server_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5141,7 +5340,7 @@ func (x *UpdateServerNameRequest) String() string {
 func (*UpdateServerNameRequest) ProtoMessage() {}
 
 func (x *UpdateServerNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[90]
+	mi := &file_service_server_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5154,7 +5353,7 @@ func (x *UpdateServerNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServerNameRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServerNameRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{90}
+	return file_service_server_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *UpdateServerNameRequest) GetServerId() int64 {
@@ -5190,7 +5389,7 @@ type CopyServerConfigRequest struct {
 func (x *CopyServerConfigRequest) Reset() {
 	*x = CopyServerConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[91]
+		mi := &file_service_server_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5203,7 +5402,7 @@ func (x *CopyServerConfigRequest) String() string {
 func (*CopyServerConfigRequest) ProtoMessage() {}
 
 func (x *CopyServerConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[91]
+	mi := &file_service_server_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5216,7 +5415,7 @@ func (x *CopyServerConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CopyServerConfigRequest.ProtoReflect.Descriptor instead.
 func (*CopyServerConfigRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{91}
+	return file_service_server_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *CopyServerConfigRequest) GetServerId() int64 {
@@ -5287,7 +5486,7 @@ type FindServerAuditingPromptRequest struct {
 func (x *FindServerAuditingPromptRequest) Reset() {
 	*x = FindServerAuditingPromptRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[92]
+		mi := &file_service_server_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5300,7 +5499,7 @@ func (x *FindServerAuditingPromptRequest) String() string {
 func (*FindServerAuditingPromptRequest) ProtoMessage() {}
 
 func (x *FindServerAuditingPromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[92]
+	mi := &file_service_server_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5313,7 +5512,7 @@ func (x *FindServerAuditingPromptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindServerAuditingPromptRequest.ProtoReflect.Descriptor instead.
 func (*FindServerAuditingPromptRequest) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{92}
+	return file_service_server_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *FindServerAuditingPromptRequest) GetServerId() int64 {
@@ -5334,7 +5533,7 @@ type FindServerAuditingPromptResponse struct {
 func (x *FindServerAuditingPromptResponse) Reset() {
 	*x = FindServerAuditingPromptResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[93]
+		mi := &file_service_server_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5347,7 +5546,7 @@ func (x *FindServerAuditingPromptResponse) String() string {
 func (*FindServerAuditingPromptResponse) ProtoMessage() {}
 
 func (x *FindServerAuditingPromptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[93]
+	mi := &file_service_server_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5360,7 +5559,7 @@ func (x *FindServerAuditingPromptResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindServerAuditingPromptResponse.ProtoReflect.Descriptor instead.
 func (*FindServerAuditingPromptResponse) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{93}
+	return file_service_server_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *FindServerAuditingPromptResponse) GetPromptText() string {
@@ -5370,38 +5569,32 @@ func (x *FindServerAuditingPromptResponse) GetPromptText() string {
 	return ""
 }
 
-type UploadServerHTTPRequestStatRequest_RegionCity struct {
+// 计算网站变更记录数量
+type CountAllServerChangeLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerId            int64 `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID
-	CountRequests       int64 `protobuf:"varint,5,opt,name=countRequests,proto3" json:"countRequests,omitempty"`
-	Bytes               int64 `protobuf:"varint,6,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	CountAttackRequests int64 `protobuf:"varint,7,opt,name=countAttackRequests,proto3" json:"countAttackRequests,omitempty"`
-	AttackBytes         int64 `protobuf:"varint,8,opt,name=attackBytes,proto3" json:"attackBytes,omitempty"`
-	CountryId           int64 `protobuf:"varint,9,opt,name=countryId,proto3" json:"countryId,omitempty"`
-	ProvinceId          int64 `protobuf:"varint,10,opt,name=provinceId,proto3" json:"provinceId,omitempty"`
-	CityId              int64 `protobuf:"varint,11,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	ServerId int64 `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionCity) Reset() {
-	*x = UploadServerHTTPRequestStatRequest_RegionCity{}
+func (x *CountAllServerChangeLogsRequest) Reset() {
+	*x = CountAllServerChangeLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[94]
+		mi := &file_service_server_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionCity) String() string {
+func (x *CountAllServerChangeLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadServerHTTPRequestStatRequest_RegionCity) ProtoMessage() {}
+func (*CountAllServerChangeLogsRequest) ProtoMessage() {}
 
-func (x *UploadServerHTTPRequestStatRequest_RegionCity) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[94]
+func (x *CountAllServerChangeLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5412,94 +5605,107 @@ func (x *UploadServerHTTPRequestStatRequest_RegionCity) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadServerHTTPRequestStatRequest_RegionCity.ProtoReflect.Descriptor instead.
-func (*UploadServerHTTPRequestStatRequest_RegionCity) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{67, 0}
+// Deprecated: Use CountAllServerChangeLogsRequest.ProtoReflect.Descriptor instead.
+func (*CountAllServerChangeLogsRequest) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetServerId() int64 {
+func (x *CountAllServerChangeLogsRequest) GetServerId() int64 {
 	if x != nil {
 		return x.ServerId
 	}
 	return 0
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetCountRequests() int64 {
-	if x != nil {
-		return x.CountRequests
-	}
-	return 0
+// 列出单页网站变更记录
+type ListServerChangeLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId int64 `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID
+	Offset   int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`     // 读取位置
+	Size     int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`         // 数量，通常不能小于0
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetBytes() int64 {
-	if x != nil {
-		return x.Bytes
+func (x *ListServerChangeLogsRequest) Reset() {
+	*x = ListServerChangeLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetCountAttackRequests() int64 {
-	if x != nil {
-		return x.CountAttackRequests
-	}
-	return 0
+func (x *ListServerChangeLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetAttackBytes() int64 {
-	if x != nil {
-		return x.AttackBytes
+func (*ListServerChangeLogsRequest) ProtoMessage() {}
+
+func (x *ListServerChangeLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetCountryId() int64 {
+// Deprecated: Use ListServerChangeLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListServerChangeLogsRequest) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListServerChangeLogsRequest) GetServerId() int64 {
 	if x != nil {
-		return x.CountryId
+		return x.ServerId
 	}
 	return 0
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetProvinceId() int64 {
+func (x *ListServerChangeLogsRequest) GetOffset() int64 {
 	if x != nil {
-		return x.ProvinceId
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetCityId() int64 {
+func (x *ListServerChangeLogsRequest) GetSize() int64 {
 	if x != nil {
-		return x.CityId
+		return x.Size
 	}
 	return 0
 }
 
-type UploadServerHTTPRequestStatRequest_RegionProvider struct {
+type ListServerChangeLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerId   int64 `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID
-	Count      int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	ProviderId int64 `protobuf:"varint,4,opt,name=providerId,proto3" json:"providerId,omitempty"`
+	ServerChangeLogs []*ServerChangeLog `protobuf:"bytes,1,rep,name=serverChangeLogs,proto3" json:"serverChangeLogs,omitempty"` // 变更记录列表，按时间倒序排列
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionProvider) Reset() {
-	*x = UploadServerHTTPRequestStatRequest_RegionProvider{}
+func (x *ListServerChangeLogsResponse) Reset() {
+	*x = ListServerChangeLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[95]
+		mi := &file_service_server_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionProvider) String() string {
+func (x *ListServerChangeLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadServerHTTPRequestStatRequest_RegionProvider) ProtoMessage() {}
+func (*ListServerChangeLogsResponse) ProtoMessage() {}
 
-func (x *UploadServerHTTPRequestStatRequest_RegionProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[95]
+func (x *ListServerChangeLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5510,20 +5716,499 @@ func (x *UploadServerHTTPRequestStatRequest_RegionProvider) ProtoReflect() proto
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadServerHTTPRequestStatRequest_RegionProvider.ProtoReflect.Descriptor instead.
-func (*UploadServerHTTPRequestStatRequest_RegionProvider) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{67, 1}
+// Deprecated: Use ListServerChangeLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListServerChangeLogsResponse) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionProvider) GetServerId() int64 {
+func (x *ListServerChangeLogsResponse) GetServerChangeLogs() []*ServerChangeLog {
 	if x != nil {
-		return x.ServerId
+		return x.ServerChangeLogs
 	}
-	return 0
+	return nil
 }
 
-func (x *UploadServerHTTPRequestStatRequest_RegionProvider) GetCount() int64 {
-	if x != nil {
+// 搜索网站
+type SearchServersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword       string   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`              // 关键词，比如域名、源站IP等
+	Types         []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`                  // 匹配类型，可选值：name, description, serverName, origin, certDomain, cluster，不填表示全部
+	UserId        int64    `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`               // 用户ID，只有管理员才能指定
+	NodeClusterId int64    `protobuf:"varint,4,opt,name=nodeClusterId,proto3" json:"nodeClusterId,omitempty"` // 集群ID（可选）
+	Size          int64    `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`                   // 最多返回的匹配数量，默认100，最大1000
+}
+
+func (x *SearchServersRequest) Reset() {
+	*x = SearchServersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchServersRequest) ProtoMessage() {}
+
+func (x *SearchServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchServersRequest.ProtoReflect.Descriptor instead.
+func (*SearchServersRequest) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SearchServersRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchServersRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchServersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SearchServersRequest) GetNodeClusterId() int64 {
+	if x != nil {
+		return x.NodeClusterId
+	}
+	return 0
+}
+
+func (x *SearchServersRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SearchServersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matches []*SearchServersResponse_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"` // 匹配列表，完全匹配的排在前面
+}
+
+func (x *SearchServersResponse) Reset() {
+	*x = SearchServersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchServersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchServersResponse) ProtoMessage() {}
+
+func (x *SearchServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchServersResponse.ProtoReflect.Descriptor instead.
+func (*SearchServersResponse) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SearchServersResponse) GetMatches() []*SearchServersResponse_Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// 查找网站
+// 数据总是从主库读取，创建或修改后可以立即查到最新的数据
+type LookupServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId   int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"`    // 可选项，网站ID
+	ExternalId string `protobuf:"bytes,2,opt,name=externalId,proto3" json:"externalId,omitempty"` // 可选项，外部ID
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`             // 可选项，网站名称，有多个同名网站时返回错误
+	UserId     int64  `protobuf:"varint,4,opt,name=userId,proto3" json:"userId,omitempty"`        // 可选项，用户ID，只有管理员才能指定
+}
+
+func (x *LookupServerRequest) Reset() {
+	*x = LookupServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupServerRequest) ProtoMessage() {}
+
+func (x *LookupServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupServerRequest.ProtoReflect.Descriptor instead.
+func (*LookupServerRequest) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *LookupServerRequest) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *LookupServerRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *LookupServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LookupServerRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type LookupServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId   int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"`    // 网站ID，找不到时为0
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`             // 网站名称
+	ExternalId string `protobuf:"bytes,3,opt,name=externalId,proto3" json:"externalId,omitempty"` // 外部ID
+	Version    int64  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`      // 版本号，修改时传回可以检测并发修改
+}
+
+func (x *LookupServerResponse) Reset() {
+	*x = LookupServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupServerResponse) ProtoMessage() {}
+
+func (x *LookupServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupServerResponse.ProtoReflect.Descriptor instead.
+func (*LookupServerResponse) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *LookupServerResponse) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *LookupServerResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LookupServerResponse) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *LookupServerResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// 设置网站外部ID
+type UpdateServerExternalIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId   int64  `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"`    // 网站ID
+	ExternalId string `protobuf:"bytes,2,opt,name=externalId,proto3" json:"externalId,omitempty"` // 外部ID，为空表示清除
+}
+
+func (x *UpdateServerExternalIdRequest) Reset() {
+	*x = UpdateServerExternalIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateServerExternalIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServerExternalIdRequest) ProtoMessage() {}
+
+func (x *UpdateServerExternalIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServerExternalIdRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServerExternalIdRequest) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateServerExternalIdRequest) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *UpdateServerExternalIdRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type UploadServerHTTPRequestStatRequest_RegionCity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId            int64 `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID
+	CountRequests       int64 `protobuf:"varint,5,opt,name=countRequests,proto3" json:"countRequests,omitempty"`
+	Bytes               int64 `protobuf:"varint,6,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	CountAttackRequests int64 `protobuf:"varint,7,opt,name=countAttackRequests,proto3" json:"countAttackRequests,omitempty"`
+	AttackBytes         int64 `protobuf:"varint,8,opt,name=attackBytes,proto3" json:"attackBytes,omitempty"`
+	CountryId           int64 `protobuf:"varint,9,opt,name=countryId,proto3" json:"countryId,omitempty"`
+	ProvinceId          int64 `protobuf:"varint,10,opt,name=provinceId,proto3" json:"provinceId,omitempty"`
+	CityId              int64 `protobuf:"varint,11,opt,name=cityId,proto3" json:"cityId,omitempty"`
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionCity) Reset() {
+	*x = UploadServerHTTPRequestStatRequest_RegionCity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionCity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadServerHTTPRequestStatRequest_RegionCity) ProtoMessage() {}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionCity) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadServerHTTPRequestStatRequest_RegionCity.ProtoReflect.Descriptor instead.
+func (*UploadServerHTTPRequestStatRequest_RegionCity) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{69, 0}
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetCountRequests() int64 {
+	if x != nil {
+		return x.CountRequests
+	}
+	return 0
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetCountAttackRequests() int64 {
+	if x != nil {
+		return x.CountAttackRequests
+	}
+	return 0
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetAttackBytes() int64 {
+	if x != nil {
+		return x.AttackBytes
+	}
+	return 0
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetCountryId() int64 {
+	if x != nil {
+		return x.CountryId
+	}
+	return 0
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetProvinceId() int64 {
+	if x != nil {
+		return x.ProvinceId
+	}
+	return 0
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionCity) GetCityId() int64 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+type UploadServerHTTPRequestStatRequest_RegionProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId   int64 `protobuf:"varint,1,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID
+	Count      int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	ProviderId int64 `protobuf:"varint,4,opt,name=providerId,proto3" json:"providerId,omitempty"`
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionProvider) Reset() {
+	*x = UploadServerHTTPRequestStatRequest_RegionProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadServerHTTPRequestStatRequest_RegionProvider) ProtoMessage() {}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadServerHTTPRequestStatRequest_RegionProvider.ProtoReflect.Descriptor instead.
+func (*UploadServerHTTPRequestStatRequest_RegionProvider) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{69, 1}
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionProvider) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *UploadServerHTTPRequestStatRequest_RegionProvider) GetCount() int64 {
+	if x != nil {
 		return x.Count
 	}
 	return 0
@@ -5550,7 +6235,7 @@ type UploadServerHTTPRequestStatRequest_System struct {
 func (x *UploadServerHTTPRequestStatRequest_System) Reset() {
 	*x = UploadServerHTTPRequestStatRequest_System{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[96]
+		mi := &file_service_server_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5563,7 +6248,7 @@ func (x *UploadServerHTTPRequestStatRequest_System) String() string {
 func (*UploadServerHTTPRequestStatRequest_System) ProtoMessage() {}
 
 func (x *UploadServerHTTPRequestStatRequest_System) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[96]
+	mi := &file_service_server_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5576,7 +6261,7 @@ func (x *UploadServerHTTPRequestStatRequest_System) ProtoReflect() protoreflect.
 
 // Deprecated: Use UploadServerHTTPRequestStatRequest_System.ProtoReflect.Descriptor instead.
 func (*UploadServerHTTPRequestStatRequest_System) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{67, 2}
+	return file_service_server_proto_rawDescGZIP(), []int{69, 2}
 }
 
 func (x *UploadServerHTTPRequestStatRequest_System) GetServerId() int64 {
@@ -5621,7 +6306,7 @@ type UploadServerHTTPRequestStatRequest_Browser struct {
 func (x *UploadServerHTTPRequestStatRequest_Browser) Reset() {
 	*x = UploadServerHTTPRequestStatRequest_Browser{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[97]
+		mi := &file_service_server_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5634,7 +6319,7 @@ func (x *UploadServerHTTPRequestStatRequest_Browser) String() string {
 func (*UploadServerHTTPRequestStatRequest_Browser) ProtoMessage() {}
 
 func (x *UploadServerHTTPRequestStatRequest_Browser) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[97]
+	mi := &file_service_server_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5647,7 +6332,7 @@ func (x *UploadServerHTTPRequestStatRequest_Browser) ProtoReflect() protoreflect
 
 // Deprecated: Use UploadServerHTTPRequestStatRequest_Browser.ProtoReflect.Descriptor instead.
 func (*UploadServerHTTPRequestStatRequest_Browser) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{67, 3}
+	return file_service_server_proto_rawDescGZIP(), []int{69, 3}
 }
 
 func (x *UploadServerHTTPRequestStatRequest_Browser) GetServerId() int64 {
@@ -5692,7 +6377,7 @@ type UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup struct {
 func (x *UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup) Reset() {
 	*x = UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[98]
+		mi := &file_service_server_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5705,7 +6390,7 @@ func (x *UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup) String() stri
 func (*UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup) ProtoMessage() {}
 
 func (x *UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[98]
+	mi := &file_service_server_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5718,7 +6403,7 @@ func (x *UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup) ProtoReflect(
 
 // Deprecated: Use UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup.ProtoReflect.Descriptor instead.
 func (*UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{67, 4}
+	return file_service_server_proto_rawDescGZIP(), []int{69, 4}
 }
 
 func (x *UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup) GetServerId() int64 {
@@ -5761,7 +6446,7 @@ type FindNearbyServersResponse_GroupInfo struct {
 func (x *FindNearbyServersResponse_GroupInfo) Reset() {
 	*x = FindNearbyServersResponse_GroupInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_server_proto_msgTypes[99]
+		mi := &file_service_server_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5774,7 +6459,63 @@ func (x *FindNearbyServersResponse_GroupInfo) String() string {
 func (*FindNearbyServersResponse_GroupInfo) ProtoMessage() {}
 
 func (x *FindNearbyServersResponse_GroupInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_service_server_proto_msgTypes[99]
+	mi := &file_service_server_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearbyServersResponse_GroupInfo.ProtoReflect.Descriptor instead.
+func (*FindNearbyServersResponse_GroupInfo) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{77, 0}
+}
+
+func (x *FindNearbyServersResponse_GroupInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FindNearbyServersResponse_GroupInfo) GetServers() []*Server {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type SearchServersResponse_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`       // 匹配类型
+	Keyword string  `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"` // 匹配到的内容，比如源站地址
+	Server  *Server `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`   // 网站基本信息，只包含id、name、isOn和nodeCluster
+}
+
+func (x *SearchServersResponse_Match) Reset() {
+	*x = SearchServersResponse_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchServersResponse_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchServersResponse_Match) ProtoMessage() {}
+
+func (x *SearchServersResponse_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5785,21 +6526,28 @@ func (x *FindNearbyServersResponse_GroupInfo) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindNearbyServersResponse_GroupInfo.ProtoReflect.Descriptor instead.
-func (*FindNearbyServersResponse_GroupInfo) Descriptor() ([]byte, []int) {
-	return file_service_server_proto_rawDescGZIP(), []int{75, 0}
+// Deprecated: Use SearchServersResponse_Match.ProtoReflect.Descriptor instead.
+func (*SearchServersResponse_Match) Descriptor() ([]byte, []int) {
+	return file_service_server_proto_rawDescGZIP(), []int{100, 0}
 }
 
-func (x *FindNearbyServersResponse_GroupInfo) GetName() string {
+func (x *SearchServersResponse_Match) GetType() string {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
 	return ""
 }
 
-func (x *FindNearbyServersResponse_GroupInfo) GetServers() []*Server {
+func (x *SearchServersResponse_Match) GetKeyword() string {
 	if x != nil {
-		return x.Servers
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchServersResponse_Match) GetServer() *Server {
+	if x != nil {
+		return x.Server
 	}
 	return nil
 }
@@ -5818,161 +6566,187 @@ var file_service_server_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x72, 0x70, 0x63,
 	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x04,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x2a, 0x0a, 0x0e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x4a, 0x4f, 0x4e, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x0c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x4a, 0x4f, 0x4e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x74, 0x74, 0x70, 0x4a,
-	0x53, 0x4f, 0x4e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70, 0x4a,
-	0x53, 0x4f, 0x4e, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x73, 0x4a, 0x53, 0x4f, 0x4e,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x68, 0x74, 0x74, 0x70, 0x73, 0x4a, 0x53, 0x4f,
-	0x4e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x63, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x74, 0x63, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x18, 0x0a, 0x07, 0x74,
-	0x6c, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6c,
-	0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x64, 0x70, 0x4a, 0x53, 0x4f, 0x4e,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x75, 0x64, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x12,
-	0x14, 0x0a, 0x05, 0x77, 0x65, 0x62, 0x49, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x77, 0x65, 0x62, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x50, 0x72, 0x6f, 0x78, 0x79, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4a, 0x53, 0x4f,
-	0x4e, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x64, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65,
-	0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75,
-	0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4a,
-	0x53, 0x4f, 0x4e, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x2a, 0x0a, 0x10, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18,
-	0x20, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x32, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x1c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x48, 0x54, 0x54, 0x50, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d,
-	0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49,
-	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72,
-	0x74, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x64,
-	0x64, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x22, 0x3b, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x48,
-	0x54, 0x54, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd5, 0x01,
-	0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x54, 0x43, 0x50,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
-	0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x63, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x74,
-	0x63, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6c, 0x73, 0x50, 0x6f,
-	0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x74, 0x6c, 0x73, 0x50, 0x6f,
-	0x72, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74,
-	0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x64, 0x64,
-	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x41, 0x64, 0x64, 0x72, 0x73, 0x22, 0x3a, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x61, 0x73, 0x69, 0x63, 0x54, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x97, 0x05, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x4a, 0x53, 0x4f,
+	0x4e, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x4a, 0x4f, 0x4e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x4a, 0x4f, 0x4e, 0x12, 0x1a, 0x0a,
+	0x08, 0x68, 0x74, 0x74, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x68, 0x74, 0x74, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x74, 0x74,
+	0x70, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x68, 0x74,
+	0x74, 0x70, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x63, 0x70, 0x4a, 0x53,
+	0x4f, 0x4e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x63, 0x70, 0x4a, 0x53, 0x4f,
+	0x4e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6c, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x74, 0x6c, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x64, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x75, 0x64,
+	0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x65, 0x62, 0x49, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x65, 0x62, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4a, 0x53, 0x4f, 0x4e, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4a, 0x53, 0x4f,
+	0x4e, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x32, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x6e, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x64, 0x22, 0xe2, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x48, 0x54, 0x54, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x73,
+	0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a,
+	0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x65, 0x62,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x3b, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x69, 0x63, 0x48, 0x54, 0x54, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x73, 0x69, 0x63, 0x54, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x63, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x08, 0x74, 0x63, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x6c, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x08, 0x74, 0x6c, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x73, 0x6c,
+	0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x73,
+	0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x73, 0x22, 0x3a, 0x0a, 0x1c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x54, 0x43, 0x50, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
-	0x79, 0x22, 0x53, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f,
-	0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6b,
-	0x65, 0x65, 0x70, 0x4f, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x6b, 0x65, 0x65, 0x70, 0x4f, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52,
-	0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x22,
-	0x61, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x64, 0x73, 0x22, 0x49, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x73, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
 	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x22, 0x51, 0x0a,
-	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54,
-	0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x74, 0x74, 0x70, 0x4a, 0x53, 0x4f, 0x4e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70, 0x4a, 0x53, 0x4f, 0x4e,
-	0x22, 0x54, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x48, 0x54, 0x54, 0x50, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x4a, 0x53, 0x4f, 0x4e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x31, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x16, 0x41, 0x64,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x53, 0x0a, 0x19, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x22,
+	0x90, 0x02, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
 	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70,
-	0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x68, 0x74, 0x74,
-	0x70, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x4e, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x43, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x74, 0x63, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74,
-	0x63, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x4e, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x4c, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x74, 0x6c, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74,
-	0x6c, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x4e, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x44, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x75, 0x64, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x75,
-	0x64, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x4a, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6b, 0x65, 0x65, 0x70, 0x4f, 0x6c, 0x64, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6b, 0x65,
+	0x65, 0x70, 0x4f, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x73, 0x4f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6e,
+	0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x73, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x77, 0x65, 0x62, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x65, 0x62,
-	0x49, 0x64, 0x22, 0x69, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x73, 0x4f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6e,
+	0x22, 0x6b, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x74, 0x74, 0x70, 0x4a,
+	0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70, 0x4a,
+	0x53, 0x4f, 0x4e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6e, 0x0a,
+	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54,
+	0x50, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x73, 0x4a, 0x53,
+	0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x68, 0x74, 0x74, 0x70, 0x73, 0x4a,
+	0x53, 0x4f, 0x4e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a,
+	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x43, 0x50,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x63, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x63, 0x70, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x4c, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x6c, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x74, 0x6c, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x68, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x55, 0x44, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x64, 0x70, 0x4a, 0x53,
+	0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x75, 0x64, 0x70, 0x4a, 0x53, 0x4f,
+	0x4e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78,
-	0x79, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x34, 0x0a,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x65, 0x62, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x77, 0x65, 0x62, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4a,
+	0x53, 0x4f, 0x4e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a,
 	0x16, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
 	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
@@ -5992,857 +6766,951 @@ var file_service_server_proto_rawDesc = []byte{
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62,
 	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74,
 	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x74,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x60, 0x0a, 0x18, 0x55, 0x70, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x7a, 0x0a, 0x18, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
 	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
 	0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x84, 0x01, 0x0a, 0x20,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x41, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0e,
-	0x61, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x58, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x70, 0x70,
-	0x6f, 0x72, 0x74, 0x43, 0x4e, 0x41, 0x4d, 0x45, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x4e, 0x41, 0x4d, 0x45, 0x22, 0x3c, 0x0a, 0x1e,
-	0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x1a, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x58, 0x0a, 0x16,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x4e, 0x41,
+	0x4d, 0x45, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x4e, 0x41, 0x4d, 0x45, 0x22, 0x3c, 0x0a, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
 	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x60,
-	0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x57, 0x69,
-	0x74, 0x68, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x3d, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64,
-	0x57, 0x69, 0x74, 0x68, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x8e, 0x02, 0x0a, 0x22, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b,
-	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24,
-	0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67,
-	0x46, 0x6c, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69,
-	0x74, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
-	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64,
-	0x22, 0xde, 0x04, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
-	0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
-	0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a,
-	0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61,
-	0x67, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x61, 0x6d,
-	0x69, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x41, 0x73, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x41, 0x73, 0x63, 0x12,
-	0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x44, 0x65, 0x73,
-	0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x4f, 0x75, 0x74, 0x44, 0x65, 0x73, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x41, 0x73, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x41, 0x73, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x44, 0x65, 0x73, 0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x44, 0x65, 0x73, 0x63, 0x12, 0x2c, 0x0a,
-	0x11, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x41,
-	0x73, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x41, 0x73, 0x63, 0x12, 0x2e, 0x0a, 0x12, 0x61,
-	0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x44, 0x65, 0x73,
-	0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x44, 0x65, 0x73, 0x63, 0x12, 0x2c, 0x0a, 0x11, 0x69,
-	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x67, 0x6e,
-	0x6f, 0x72, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74,
-	0x73, 0x22, 0x47, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x31, 0x0a, 0x13, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x34, 0x0a,
-	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x49, 0x64, 0x73, 0x22, 0x5e, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x60, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x4e, 0x53, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f,
+	0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x1f, 0x46, 0x69,
+	0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x4e,
+	0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x8e, 0x02, 0x0a, 0x22, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0xde, 0x04, 0x0a, 0x1e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4f, 0x75,
+	0x74, 0x41, 0x73, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x41, 0x73, 0x63, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x44, 0x65, 0x73, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x44, 0x65, 0x73,
+	0x63, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x41, 0x73, 0x63,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x41, 0x73, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x44,
+	0x65, 0x73, 0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x44, 0x65, 0x73, 0x63, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x41, 0x73, 0x63, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x41, 0x73, 0x63, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x44, 0x65, 0x73, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x44, 0x65, 0x73, 0x63, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x53, 0x4c,
+	0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x73, 0x22, 0x47, 0x0a, 0x1f, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x22, 0x31, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x5e, 0x0a,
+	0x18, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53,
+	0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x73, 0x22, 0x3f, 0x0a,
+	0x19, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x3c,
+	0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x1f,
+	0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4a, 0x53, 0x4f, 0x4e, 0x22,
+	0x3a, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69,
-	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65,
-	0x72, 0x74, 0x73, 0x22, 0x3f, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x22, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x41, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4a,
-	0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x3a, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x33, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x48, 0x0a, 0x2a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6e,
-	0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x8b, 0x01, 0x0a, 0x2b, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79,
-	0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x30, 0x0a, 0x13,
-	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x66, 0x4a,
-	0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x72, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x66, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x3f,
-	0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x3e, 0x0a, 0x22, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x4a, 0x53, 0x4f, 0x4e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x77, 0x65, 0x62, 0x4a, 0x53, 0x4f, 0x4e, 0x22,
-	0x4a, 0x0a, 0x2a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x1d, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x48, 0x0a, 0x2a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x2b, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x66, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x13, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x52, 0x65, 0x66, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x3f, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64,
+	0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x22, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65,
+	0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x77, 0x65, 0x62, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x4a, 0x0a, 0x2a, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65,
+	0x72, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x73, 0x6c, 0x43,
+	0x65, 0x72, 0x74, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x29, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64,
+	0x22, 0x52, 0x0a, 0x2a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c,
 	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4c,
-	0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x29, 0x46,
-	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x73, 0x6c, 0x43,
-	0x65, 0x72, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x73, 0x6c,
-	0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x2a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
-	0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57,
-	0x69, 0x74, 0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x56, 0x0a, 0x2e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d,
-	0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x56, 0x0a, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x30, 0x46, 0x69, 0x6e, 0x64, 0x41,
-	0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x44, 0x4e, 0x53, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e,
-	0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x60, 0x0a, 0x31, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62,
+	0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x22, 0x56, 0x0a, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e,
+	0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x58, 0x0a, 0x30, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62,
 	0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x44, 0x4e, 0x53, 0x57, 0x69, 0x74,
 	0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x73, 0x22, 0x4d, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x39, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x83, 0x01,
-	0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e,
-	0x53, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12,
-	0x22, 0x0a, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x4e, 0x41, 0x4d, 0x45, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x4e,
-	0x41, 0x4d, 0x45, 0x22, 0x34, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x2a, 0x46, 0x69, 0x6e,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f,
+	0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x60, 0x0a, 0x31, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x44, 0x4e, 0x53, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x4d, 0x0a,
+	0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x1b,
+	0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x4e, 0x41, 0x4d, 0x45, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x4e, 0x41, 0x4d, 0x45, 0x22, 0x34, 0x0a,
+	0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x2a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x2b, 0x46, 0x69, 0x6e,
 	0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
 	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x4f, 0x0a, 0x2b, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x22, 0x5e, 0x0a, 0x24, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x24, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x17, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x33, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x54, 0x0a, 0x1a, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
 	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
 	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64,
-	0x22, 0x35, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x41,
-	0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x1a,
-	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x22, 0x54, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x6c,
-	0x61, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72,
-	0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x22, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73,
-	0x65, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x23, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x41,
-	0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x3f, 0x0a, 0x21, 0x46, 0x69, 0x6e,
-	0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x22, 0x46, 0x69,
-	0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x22, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42,
-	0x61, 0x73, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xf7, 0x09, 0x0a, 0x22,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x55, 0x0a, 0x0c, 0x72, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x31, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43,
-	0x69, 0x74, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x12, 0x5f, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x62, 0x2e,
+	0x22, 0x3c, 0x0a, 0x22, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x53,
+	0x0a, 0x23, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a,
+	0x53, 0x4f, 0x4e, 0x22, 0x3f, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x22, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73,
+	0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x55,
+	0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xf7, 0x09, 0x0a, 0x22, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x64, 0x61, 0x79, 0x12, 0x55, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x70, 0x62, 0x2e,
 	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x52, 0x07, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x62,
-	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x73, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x0f, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x07,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
 	0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48,
 	0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x52, 0x08, 0x62,
-	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x73, 0x12, 0x74, 0x0a, 0x16, 0x68, 0x74, 0x74, 0x70, 0x46,
-	0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c,
 	0x6f, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x48, 0x54, 0x54, 0x50, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x16, 0x68, 0x74, 0x74, 0x70, 0x46, 0x69, 0x72, 0x65, 0x77,
-	0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x8e, 0x02,
-	0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x74, 0x74,
-	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x13, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74,
-	0x61, 0x63, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x72, 0x79, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x1a, 0x62,
-	0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x49, 0x64, 0x1a, 0x68, 0x0a, 0x06, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x69, 0x0a, 0x07,
-	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x9b, 0x01, 0x0a, 0x15, 0x48, 0x54, 0x54, 0x50,
-	0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a,
-	0x17, 0x68, 0x74, 0x74, 0x70, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17,
-	0x68, 0x74, 0x74, 0x70, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75,
-	0x70, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x69, 0x6c, 0x64,
-	0x63, 0x61, 0x72, 0x64, 0x22, 0x67, 0x0a, 0x2f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x64, 0x75, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5c, 0x0a,
-	0x1e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x49, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x52, 0x08, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x74, 0x0a, 0x16, 0x68, 0x74, 0x74, 0x70, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c,
+	0x6c, 0x52, 0x75, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x46, 0x69,
+	0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x16, 0x68, 0x74, 0x74, 0x70, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x8e, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x30,
+	0x0a, 0x13, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x1a, 0x62, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x68, 0x0a, 0x06,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x69, 0x0a, 0x07, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x1a, 0x9b, 0x01, 0x0a, 0x15, 0x48, 0x54, 0x54, 0x50, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61,
+	0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x17, 0x68, 0x74, 0x74, 0x70, 0x46,
+	0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x68, 0x74, 0x74, 0x70, 0x46, 0x69,
+	0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xcc, 0x01, 0x0a, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x57,
+	0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x22, 0x67,
+	0x0a, 0x2f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x15, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x15, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x1e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x1f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x22, 0x2e, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x22, 0x41, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x22, 0x36, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x62,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x1f, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x2e, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x4c, 0x61,
-	0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x41, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x4c, 0x61,
-	0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x36, 0x0a, 0x18, 0x46, 0x69, 0x6e,
-	0x64, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0xb9, 0x01, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e,
-	0x65, 0x61, 0x72, 0x62, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x45, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x6b, 0x0a,
-	0x17, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x18, 0x50, 0x75,
-	0x72, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x42, 0x0a, 0x24, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x25, 0x46, 0x69, 0x6e, 0x64,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x69, 0x0a,
-	0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10,
-	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4a, 0x53, 0x4f, 0x4e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x59, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e,
+	0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb9, 0x01, 0x0a, 0x19,
+	0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x3f, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x1a, 0x45, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x6b, 0x0a, 0x17, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x18, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x69, 0x73, 0x4f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a,
+	0x0a, 0x10, 0x68, 0x74, 0x74, 0x70, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x10, 0x68, 0x74, 0x74, 0x70, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x42, 0x0a, 0x24, 0x46, 0x69,
+	0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x53,
+	0x0a, 0x25, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4a,
+	0x53, 0x4f, 0x4e, 0x22, 0x69, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x59,
+	0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65,
+	0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x19, 0x46, 0x69, 0x6e,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x46, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x56, 0x0a, 0x1a, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x22, 0x49, 0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x4e, 0x0a,
+	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41, 0x4d,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
 	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61,
-	0x6e, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x1a,
-	0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c,
-	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x50, 0x6c, 0x61, 0x6e, 0x22, 0x38, 0x0a, 0x1a, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x49,
-	0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
-	0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f,
-	0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x4e, 0x0a, 0x16, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41, 0x4d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x75, 0x61, 0x6d, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x75, 0x61, 0x6d, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x39, 0x0a, 0x1b, 0x46, 0x69, 0x6e,
-	0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41,
-	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x61, 0x6d, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x75, 0x61, 0x6d, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x39, 0x0a,
+	0x1b, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x55, 0x41, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41, 0x4d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x61, 0x6d, 0x4a,
+	0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x75, 0x61, 0x6d, 0x4a, 0x53,
+	0x4f, 0x4e, 0x22, 0x4d, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x49, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc9, 0x02, 0x0a,
+	0x17, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
 	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41, 0x4d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x61, 0x6d, 0x4a, 0x53, 0x4f, 0x4e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x75, 0x61, 0x6d, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x4d,
-	0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x32,
+	0x0a, 0x14, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x14, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x77, 0x61, 0x66, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x61, 0x66, 0x43, 0x6f, 0x70,
+	0x79, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x78, 0x74, 0x22, 0x3d, 0x0a, 0x1f, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x1b, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72,
 	0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x49, 0x0a,
-	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc9, 0x02, 0x0a, 0x17, 0x43, 0x6f, 0x70,
-	0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0x5f, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f,
+	0x67, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xad, 0x01,
+	0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x1a, 0x59, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x7d, 0x0a,
+	0x13, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x14, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x12, 0x28,
-	0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e,
-	0x77, 0x61, 0x66, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1e,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x61, 0x66, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x70,
-	0x74, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
-	0x6d, 0x70, 0x74, 0x54, 0x65, 0x78, 0x74, 0x32, 0xbe, 0x2b, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x48, 0x54, 0x54, 0x50, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x48, 0x54, 0x54, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x48, 0x54, 0x54, 0x50, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x63, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x80, 0x01, 0x0a,
+	0x14, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x5b, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x32, 0x86, 0x2f, 0x0a,
+	0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41,
+	0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x17,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63,
+	0x48, 0x54, 0x54, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x48, 0x54, 0x54, 0x50, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x48, 0x54, 0x54,
+	0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x54, 0x43,
+	0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x54, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x54, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61,
-	0x73, 0x69, 0x63, 0x54, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x61, 0x73, 0x69, 0x63, 0x54, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6c,
+	0x6f, 0x6e, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x61, 0x64,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67,
 	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x11, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12, 0x1c,
+	0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x12, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
+	0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x41,
+	0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61,
+	0x73, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x47, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x73, 0x4f, 0x6e, 0x12, 0x1b,
 	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70,
-	0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x47, 0x0a, 0x14,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x73, 0x4f, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x73, 0x4f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x53, 0x12, 0x1c, 0x2e, 0x70,
-	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54,
-	0x54, 0x50, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x43, 0x50, 0x12, 0x1a, 0x2e,
-	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54,
-	0x43, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x4c, 0x53, 0x12, 0x1a, 0x2e, 0x70,
-	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x4c,
-	0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50,
-	0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x44, 0x50, 0x12, 0x1a, 0x2e, 0x70, 0x62,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x44, 0x50,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x4f, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x73, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x12,
+	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x11,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50,
+	0x53, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x3d, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54,
+	0x43, 0x50, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x54, 0x43, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d,
+	0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x4c,
+	0x53, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x54, 0x4c, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a,
+	0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x44, 0x50,
+	0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x55, 0x44, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x12,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x57, 0x65, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x4f, 0x0a, 0x18, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x4a, 0x0a, 0x0f,
+	0x66, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1c, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x51, 0x0a, 0x19, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d,
+	0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e,
+	0x53, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a,
+	0x17, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e,
+	0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a, 0x13,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x62, 0x0a, 0x17, 0x66, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64,
+	0x57, 0x69, 0x74, 0x68, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x1b, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x22, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x50, 0x0a, 0x11,
+	0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x17, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x86, 0x01, 0x0a, 0x23, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74,
 	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x4a, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x64, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x51, 0x0a, 0x19, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41, 0x75, 0x64, 0x69, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41, 0x75, 0x64, 0x69, 0x74, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x12, 0x1a, 0x2e, 0x70,
-	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e,
-	0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50,
-	0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x17, 0x72, 0x65, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
-	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
-	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x62,
-	0x0a, 0x17, 0x66, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x57, 0x69,
-	0x74, 0x68, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x46,
-	0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44,
-	0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x57,
-	0x69, 0x74, 0x68, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x1b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x12, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x62, 0x0a, 0x17, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70,
-	0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x0d,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x66, 0x69, 0x6e, 0x64, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70,
-	0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x66, 0x69, 0x6e,
-	0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
-	0x15, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x23,
-	0x66, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x2e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64,
-	0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64,
-	0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x1a, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49,
-	0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49,
-	0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x46,
-	0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6b, 0x0a, 0x23, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53,
-	0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50,
-	0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83,
-	0x01, 0x0a, 0x22, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4c, 0x43,
-	0x65, 0x72, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x1a, 0x66, 0x69, 0x6e,
+	0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65,
+	0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65,
+	0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x23, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41,
 	0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
-	0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57,
-	0x69, 0x74, 0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x27, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69,
-	0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x32, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4e,
-	0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x27, 0x63, 0x6f, 0x75,
+	0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4c,
+	0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x22, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x2e, 0x70, 0x62, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x27, 0x63, 0x6f, 0x75,
 	0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x64, 0x12, 0x32, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41,
+	0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41,
 	0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
 	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50,
-	0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
-	0x0a, 0x13, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x98, 0x01, 0x0a, 0x29, 0x66, 0x69, 0x6e, 0x64, 0x41,
-	0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x44, 0x4e, 0x53, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
-	0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x44,
-	0x4e, 0x53, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x70, 0x62, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x73, 0x44, 0x4e, 0x53, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x59, 0x0a, 0x14, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x46,
-	0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x44, 0x4e, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0f,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
-	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62,
-	0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x23,
+	0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73,
+	0x0a, 0x27, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x32, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x98, 0x01, 0x0a, 0x29,
 	0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x2e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x1d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x41, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x57, 0x69,
-	0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12,
-	0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50,
-	0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e,
-	0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
-	0x73, 0x65, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b,
-	0x0a, 0x1a, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12, 0x25, 0x2e, 0x70,
-	0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61,
-	0x73, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x1c, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12, 0x27, 0x2e, 0x70, 0x62,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x55, 0x0a, 0x1b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x12, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62,
-	0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x27,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x44,
-	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x32, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x44, 0x75, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x4e, 0x6f, 0x64,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x62, 0x0a, 0x17, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x49, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x66, 0x69, 0x6e, 0x64, 0x4c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x46,
-	0x69, 0x6e, 0x64, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x66, 0x69, 0x6e, 0x64, 0x4e, 0x65,
-	0x61, 0x72, 0x62, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x44, 0x4e, 0x53, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x44, 0x4e, 0x53, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x35, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x44, 0x4e, 0x53, 0x57, 0x69, 0x74,
+	0x68, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x12, 0x1f,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x86, 0x01, 0x0a, 0x23, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x1d, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41,
+	0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x41, 0x6c,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x41,
+	0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x1a, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x57, 0x0a, 0x1c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x63,
+	0x12, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73,
+	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x55, 0x0a, 0x1b, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x92, 0x01, 0x0a, 0x27, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x32, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x33, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x66, 0x69, 0x6e,
+	0x64, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1c,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70,
+	0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x66,
 	0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x70, 0x75, 0x72, 0x67,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1b, 0x2e, 0x70,
-	0x62, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x50,
-	0x75, 0x72, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x1d, 0x66, 0x69, 0x6e, 0x64, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66,
-	0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
-	0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
-	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x47,
-	0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x53, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x64, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1d, 0x2e,
-	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70,
-	0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x55, 0x41, 0x4d, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41, 0x4d, 0x52, 0x65, 0x71, 0x75,
+	0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
+	0x10, 0x70, 0x75, 0x72, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x1d,
+	0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x23,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x59, 0x0a, 0x14, 0x66, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41, 0x4d, 0x12, 0x1f, 0x2e, 0x70, 0x62,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x55, 0x41, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
-	0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x55, 0x41, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
-	0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x3f, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x3f, 0x0a, 0x10, 0x63, 0x6f, 0x70, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x65, 0x0a, 0x18, 0x66, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41,
-	0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x23, 0x2e,
-	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x75, 0x64,
-	0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x73, 0x73, 0x12, 0x47, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x53, 0x0a, 0x12,
+	0x66, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41, 0x4d, 0x12, 0x1a, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41,
+	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50,
+	0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x59, 0x0a, 0x14, 0x66, 0x69, 0x6e, 0x64,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41, 0x4d,
+	0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x41, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x63, 0x6f, 0x70, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x70, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x65, 0x0a, 0x18, 0x66, 0x69, 0x6e, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76,
 	0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
+	0x18, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x64, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6857,7 +7725,7 @@ func file_service_server_proto_rawDescGZIP() []byte {
 	return file_service_server_proto_rawDescData
 }
 
-var file_service_server_proto_msgTypes = make([]protoimpl.MessageInfo, 100)
+var file_service_server_proto_msgTypes = make([]protoimpl.MessageInfo, 111)
 var file_service_server_proto_goTypes = []interface{}{
 	(*CreateServerRequest)(nil),                                      // 0: pb.CreateServerRequest
 	(*CreateServerResponse)(nil),                                     // 1: pb.CreateServerResponse
@@ -6865,259 +7733,286 @@ var file_service_server_proto_goTypes = []interface{}{
 	(*CreateBasicHTTPServerResponse)(nil),                            // 3: pb.CreateBasicHTTPServerResponse
 	(*CreateBasicTCPServerRequest)(nil),                              // 4: pb.CreateBasicTCPServerRequest
 	(*CreateBasicTCPServerResponse)(nil),                             // 5: pb.CreateBasicTCPServerResponse
-	(*AddServerOriginRequest)(nil),                                   // 6: pb.AddServerOriginRequest
-	(*DeleteServerOriginRequest)(nil),                                // 7: pb.DeleteServerOriginRequest
-	(*UpdateServerBasicRequest)(nil),                                 // 8: pb.UpdateServerBasicRequest
-	(*UpdateServerGroupIdsRequest)(nil),                              // 9: pb.UpdateServerGroupIdsRequest
-	(*UpdateServerIsOnRequest)(nil),                                  // 10: pb.UpdateServerIsOnRequest
-	(*UpdateServerHTTPRequest)(nil),                                  // 11: pb.UpdateServerHTTPRequest
-	(*UpdateServerHTTPSRequest)(nil),                                 // 12: pb.UpdateServerHTTPSRequest
-	(*UpdateServerTCPRequest)(nil),                                   // 13: pb.UpdateServerTCPRequest
-	(*UpdateServerTLSRequest)(nil),                                   // 14: pb.UpdateServerTLSRequest
-	(*UpdateServerUDPRequest)(nil),                                   // 15: pb.UpdateServerUDPRequest
-	(*UpdateServerWebRequest)(nil),                                   // 16: pb.UpdateServerWebRequest
-	(*UpdateServerReverseProxyRequest)(nil),                          // 17: pb.UpdateServerReverseProxyRequest
-	(*FindServerNamesRequest)(nil),                                   // 18: pb.FindServerNamesRequest
-	(*FindServerNamesResponse)(nil),                                  // 19: pb.FindServerNamesResponse
-	(*UpdateServerNamesRequest)(nil),                                 // 20: pb.UpdateServerNamesRequest
-	(*UpdateServerNamesAuditingRequest)(nil),                         // 21: pb.UpdateServerNamesAuditingRequest
-	(*UpdateServerDNSRequest)(nil),                                   // 22: pb.UpdateServerDNSRequest
-	(*RegenerateServerDNSNameRequest)(nil),                           // 23: pb.RegenerateServerDNSNameRequest
-	(*UpdateServerDNSNameRequest)(nil),                               // 24: pb.UpdateServerDNSNameRequest
-	(*FindServerIdWithDNSNameRequest)(nil),                           // 25: pb.FindServerIdWithDNSNameRequest
-	(*FindServerIdWithDNSNameResponse)(nil),                          // 26: pb.FindServerIdWithDNSNameResponse
-	(*CountAllEnabledServersMatchRequest)(nil),                       // 27: pb.CountAllEnabledServersMatchRequest
-	(*ListEnabledServersMatchRequest)(nil),                           // 28: pb.ListEnabledServersMatchRequest
-	(*ListEnabledServersMatchResponse)(nil),                          // 29: pb.ListEnabledServersMatchResponse
-	(*DeleteServerRequest)(nil),                                      // 30: pb.DeleteServerRequest
-	(*DeleteServersRequest)(nil),                                     // 31: pb.DeleteServersRequest
-	(*FindEnabledServerRequest)(nil),                                 // 32: pb.FindEnabledServerRequest
-	(*FindEnabledServerResponse)(nil),                                // 33: pb.FindEnabledServerResponse
-	(*FindEnabledServerConfigRequest)(nil),                           // 34: pb.FindEnabledServerConfigRequest
-	(*FindEnabledServerConfigResponse)(nil),                          // 35: pb.FindEnabledServerConfigResponse
-	(*FindEnabledServerTypeRequest)(nil),                             // 36: pb.FindEnabledServerTypeRequest
-	(*FindEnabledServerTypeResponse)(nil),                            // 37: pb.FindEnabledServerTypeResponse
-	(*FindAndInitServerReverseProxyConfigRequest)(nil),               // 38: pb.FindAndInitServerReverseProxyConfigRequest
-	(*FindAndInitServerReverseProxyConfigResponse)(nil),              // 39: pb.FindAndInitServerReverseProxyConfigResponse
-	(*FindAndInitServerWebConfigRequest)(nil),                        // 40: pb.FindAndInitServerWebConfigRequest
-	(*FindAndInitServerWebConfigResponse)(nil),                       // 41: pb.FindAndInitServerWebConfigResponse
-	(*CountAllEnabledServersWithSSLCertIdRequest)(nil),               // 42: pb.CountAllEnabledServersWithSSLCertIdRequest
-	(*FindAllEnabledServersWithSSLCertIdRequest)(nil),                // 43: pb.FindAllEnabledServersWithSSLCertIdRequest
-	(*FindAllEnabledServersWithSSLCertIdResponse)(nil),               // 44: pb.FindAllEnabledServersWithSSLCertIdResponse
-	(*CountAllEnabledServersWithNodeClusterIdRequest)(nil),           // 45: pb.CountAllEnabledServersWithNodeClusterIdRequest
-	(*CountAllEnabledServersWithServerGroupIdRequest)(nil),           // 46: pb.CountAllEnabledServersWithServerGroupIdRequest
-	(*NotifyServersChangeRequest)(nil),                               // 47: pb.NotifyServersChangeRequest
-	(*NotifyServersChangeResponse)(nil),                              // 48: pb.NotifyServersChangeResponse
-	(*FindAllEnabledServersDNSWithNodeClusterIdRequest)(nil),         // 49: pb.FindAllEnabledServersDNSWithNodeClusterIdRequest
-	(*FindAllEnabledServersDNSWithNodeClusterIdResponse)(nil),        // 50: pb.FindAllEnabledServersDNSWithNodeClusterIdResponse
-	(*ServerDNSInfo)(nil),                                            // 51: pb.ServerDNSInfo
-	(*FindEnabledServerDNSRequest)(nil),                              // 52: pb.FindEnabledServerDNSRequest
-	(*FindEnabledServerDNSResponse)(nil),                             // 53: pb.FindEnabledServerDNSResponse
-	(*CheckUserServerRequest)(nil),                                   // 54: pb.CheckUserServerRequest
-	(*FindAllEnabledServerNamesWithUserIdRequest)(nil),               // 55: pb.FindAllEnabledServerNamesWithUserIdRequest
-	(*FindAllEnabledServerNamesWithUserIdResponse)(nil),              // 56: pb.FindAllEnabledServerNamesWithUserIdResponse
-	(*CountAllServerNamesWithUserIdRequest)(nil),                     // 57: pb.CountAllServerNamesWithUserIdRequest
-	(*CountServerNamesRequest)(nil),                                  // 58: pb.CountServerNamesRequest
-	(*FindAllUserServersRequest)(nil),                                // 59: pb.FindAllUserServersRequest
-	(*FindAllUserServersResponse)(nil),                               // 60: pb.FindAllUserServersResponse
-	(*CountAllUserServersRequest)(nil),                               // 61: pb.CountAllUserServersRequest
-	(*ComposeAllUserServersConfigRequest)(nil),                       // 62: pb.ComposeAllUserServersConfigRequest
-	(*ComposeAllUserServersConfigResponse)(nil),                      // 63: pb.ComposeAllUserServersConfigResponse
-	(*FindEnabledUserServerBasicRequest)(nil),                        // 64: pb.FindEnabledUserServerBasicRequest
-	(*FindEnabledUserServerBasicResponse)(nil),                       // 65: pb.FindEnabledUserServerBasicResponse
-	(*UpdateEnabledUserServerBasicRequest)(nil),                      // 66: pb.UpdateEnabledUserServerBasicRequest
-	(*UploadServerHTTPRequestStatRequest)(nil),                       // 67: pb.UploadServerHTTPRequestStatRequest
-	(*CheckServerNameDuplicationInNodeClusterRequest)(nil),           // 68: pb.CheckServerNameDuplicationInNodeClusterRequest
-	(*CheckServerNameDuplicationInNodeClusterResponse)(nil),          // 69: pb.CheckServerNameDuplicationInNodeClusterResponse
-	(*CheckServerNameInServerRequest)(nil),                           // 70: pb.CheckServerNameInServerRequest
-	(*CheckServerNameInServerResponse)(nil),                          // 71: pb.CheckServerNameInServerResponse
-	(*FindLatestServersRequest)(nil),                                 // 72: pb.FindLatestServersRequest
-	(*FindLatestServersResponse)(nil),                                // 73: pb.FindLatestServersResponse
-	(*FindNearbyServersRequest)(nil),                                 // 74: pb.FindNearbyServersRequest
-	(*FindNearbyServersResponse)(nil),                                // 75: pb.FindNearbyServersResponse
-	(*PurgeServerCacheRequest)(nil),                                  // 76: pb.PurgeServerCacheRequest
-	(*PurgeServerCacheResponse)(nil),                                 // 77: pb.PurgeServerCacheResponse
-	(*FindEnabledServerTrafficLimitRequest)(nil),                     // 78: pb.FindEnabledServerTrafficLimitRequest
-	(*FindEnabledServerTrafficLimitResponse)(nil),                    // 79: pb.FindEnabledServerTrafficLimitResponse
-	(*UpdateServerTrafficLimitRequest)(nil),                          // 80: pb.UpdateServerTrafficLimitRequest
-	(*UpdateServerUserPlanRequest)(nil),                              // 81: pb.UpdateServerUserPlanRequest
-	(*FindServerUserPlanRequest)(nil),                                // 82: pb.FindServerUserPlanRequest
-	(*FindServerUserPlanResponse)(nil),                               // 83: pb.FindServerUserPlanResponse
-	(*ComposeServerConfigRequest)(nil),                               // 84: pb.ComposeServerConfigRequest
-	(*ComposeServerConfigResponse)(nil),                              // 85: pb.ComposeServerConfigResponse
-	(*UpdateServerUAMRequest)(nil),                                   // 86: pb.UpdateServerUAMRequest
-	(*FindEnabledServerUAMRequest)(nil),                              // 87: pb.FindEnabledServerUAMRequest
-	(*FindEnabledServerUAMResponse)(nil),                             // 88: pb.FindEnabledServerUAMResponse
-	(*UpdateServerUserRequest)(nil),                                  // 89: pb.UpdateServerUserRequest
-	(*UpdateServerNameRequest)(nil),                                  // 90: pb.UpdateServerNameRequest
-	(*CopyServerConfigRequest)(nil),                                  // 91: pb.CopyServerConfigRequest
-	(*FindServerAuditingPromptRequest)(nil),                          // 92: pb.FindServerAuditingPromptRequest
-	(*FindServerAuditingPromptResponse)(nil),                         // 93: pb.FindServerAuditingPromptResponse
-	(*UploadServerHTTPRequestStatRequest_RegionCity)(nil),            // 94: pb.UploadServerHTTPRequestStatRequest.RegionCity
-	(*UploadServerHTTPRequestStatRequest_RegionProvider)(nil),        // 95: pb.UploadServerHTTPRequestStatRequest.RegionProvider
-	(*UploadServerHTTPRequestStatRequest_System)(nil),                // 96: pb.UploadServerHTTPRequestStatRequest.System
-	(*UploadServerHTTPRequestStatRequest_Browser)(nil),               // 97: pb.UploadServerHTTPRequestStatRequest.Browser
-	(*UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup)(nil), // 98: pb.UploadServerHTTPRequestStatRequest.HTTPFirewallRuleGroup
-	(*FindNearbyServersResponse_GroupInfo)(nil),                      // 99: pb.FindNearbyServersResponse.GroupInfo
-	(*ServerNameAuditingResult)(nil),                                 // 100: pb.ServerNameAuditingResult
-	(*Server)(nil),                                                   // 101: pb.Server
-	(*DNSDomain)(nil),                                                // 102: pb.DNSDomain
-	(*UserPlan)(nil),                                                 // 103: pb.UserPlan
-	(*RPCSuccess)(nil),                                               // 104: pb.RPCSuccess
-	(*RPCCountResponse)(nil),                                         // 105: pb.RPCCountResponse
+	(*CloneServerRequest)(nil),                                       // 6: pb.CloneServerRequest
+	(*CloneServerResponse)(nil),                                      // 7: pb.CloneServerResponse
+	(*AddServerOriginRequest)(nil),                                   // 8: pb.AddServerOriginRequest
+	(*DeleteServerOriginRequest)(nil),                                // 9: pb.DeleteServerOriginRequest
+	(*UpdateServerBasicRequest)(nil),                                 // 10: pb.UpdateServerBasicRequest
+	(*UpdateServerGroupIdsRequest)(nil),                              // 11: pb.UpdateServerGroupIdsRequest
+	(*UpdateServerIsOnRequest)(nil),                                  // 12: pb.UpdateServerIsOnRequest
+	(*UpdateServerHTTPRequest)(nil),                                  // 13: pb.UpdateServerHTTPRequest
+	(*UpdateServerHTTPSRequest)(nil),                                 // 14: pb.UpdateServerHTTPSRequest
+	(*UpdateServerTCPRequest)(nil),                                   // 15: pb.UpdateServerTCPRequest
+	(*UpdateServerTLSRequest)(nil),                                   // 16: pb.UpdateServerTLSRequest
+	(*UpdateServerUDPRequest)(nil),                                   // 17: pb.UpdateServerUDPRequest
+	(*UpdateServerWebRequest)(nil),                                   // 18: pb.UpdateServerWebRequest
+	(*UpdateServerReverseProxyRequest)(nil),                          // 19: pb.UpdateServerReverseProxyRequest
+	(*FindServerNamesRequest)(nil),                                   // 20: pb.FindServerNamesRequest
+	(*FindServerNamesResponse)(nil),                                  // 21: pb.FindServerNamesResponse
+	(*UpdateServerNamesRequest)(nil),                                 // 22: pb.UpdateServerNamesRequest
+	(*UpdateServerNamesAuditingRequest)(nil),                         // 23: pb.UpdateServerNamesAuditingRequest
+	(*UpdateServerDNSRequest)(nil),                                   // 24: pb.UpdateServerDNSRequest
+	(*RegenerateServerDNSNameRequest)(nil),                           // 25: pb.RegenerateServerDNSNameRequest
+	(*UpdateServerDNSNameRequest)(nil),                               // 26: pb.UpdateServerDNSNameRequest
+	(*FindServerIdWithDNSNameRequest)(nil),                           // 27: pb.FindServerIdWithDNSNameRequest
+	(*FindServerIdWithDNSNameResponse)(nil),                          // 28: pb.FindServerIdWithDNSNameResponse
+	(*CountAllEnabledServersMatchRequest)(nil),                       // 29: pb.CountAllEnabledServersMatchRequest
+	(*ListEnabledServersMatchRequest)(nil),                           // 30: pb.ListEnabledServersMatchRequest
+	(*ListEnabledServersMatchResponse)(nil),                          // 31: pb.ListEnabledServersMatchResponse
+	(*DeleteServerRequest)(nil),                                      // 32: pb.DeleteServerRequest
+	(*DeleteServersRequest)(nil),                                     // 33: pb.DeleteServersRequest
+	(*FindEnabledServerRequest)(nil),                                 // 34: pb.FindEnabledServerRequest
+	(*FindEnabledServerResponse)(nil),                                // 35: pb.FindEnabledServerResponse
+	(*FindEnabledServerConfigRequest)(nil),                           // 36: pb.FindEnabledServerConfigRequest
+	(*FindEnabledServerConfigResponse)(nil),                          // 37: pb.FindEnabledServerConfigResponse
+	(*FindEnabledServerTypeRequest)(nil),                             // 38: pb.FindEnabledServerTypeRequest
+	(*FindEnabledServerTypeResponse)(nil),                            // 39: pb.FindEnabledServerTypeResponse
+	(*FindAndInitServerReverseProxyConfigRequest)(nil),               // 40: pb.FindAndInitServerReverseProxyConfigRequest
+	(*FindAndInitServerReverseProxyConfigResponse)(nil),              // 41: pb.FindAndInitServerReverseProxyConfigResponse
+	(*FindAndInitServerWebConfigRequest)(nil),                        // 42: pb.FindAndInitServerWebConfigRequest
+	(*FindAndInitServerWebConfigResponse)(nil),                       // 43: pb.FindAndInitServerWebConfigResponse
+	(*CountAllEnabledServersWithSSLCertIdRequest)(nil),               // 44: pb.CountAllEnabledServersWithSSLCertIdRequest
+	(*FindAllEnabledServersWithSSLCertIdRequest)(nil),                // 45: pb.FindAllEnabledServersWithSSLCertIdRequest
+	(*FindAllEnabledServersWithSSLCertIdResponse)(nil),               // 46: pb.FindAllEnabledServersWithSSLCertIdResponse
+	(*CountAllEnabledServersWithNodeClusterIdRequest)(nil),           // 47: pb.CountAllEnabledServersWithNodeClusterIdRequest
+	(*CountAllEnabledServersWithServerGroupIdRequest)(nil),           // 48: pb.CountAllEnabledServersWithServerGroupIdRequest
+	(*NotifyServersChangeRequest)(nil),                               // 49: pb.NotifyServersChangeRequest
+	(*NotifyServersChangeResponse)(nil),                              // 50: pb.NotifyServersChangeResponse
+	(*FindAllEnabledServersDNSWithNodeClusterIdRequest)(nil),         // 51: pb.FindAllEnabledServersDNSWithNodeClusterIdRequest
+	(*FindAllEnabledServersDNSWithNodeClusterIdResponse)(nil),        // 52: pb.FindAllEnabledServersDNSWithNodeClusterIdResponse
+	(*ServerDNSInfo)(nil),                                            // 53: pb.ServerDNSInfo
+	(*FindEnabledServerDNSRequest)(nil),                              // 54: pb.FindEnabledServerDNSRequest
+	(*FindEnabledServerDNSResponse)(nil),                             // 55: pb.FindEnabledServerDNSResponse
+	(*CheckUserServerRequest)(nil),                                   // 56: pb.CheckUserServerRequest
+	(*FindAllEnabledServerNamesWithUserIdRequest)(nil),               // 57: pb.FindAllEnabledServerNamesWithUserIdRequest
+	(*FindAllEnabledServerNamesWithUserIdResponse)(nil),              // 58: pb.FindAllEnabledServerNamesWithUserIdResponse
+	(*CountAllServerNamesWithUserIdRequest)(nil),                     // 59: pb.CountAllServerNamesWithUserIdRequest
+	(*CountServerNamesRequest)(nil),                                  // 60: pb.CountServerNamesRequest
+	(*FindAllUserServersRequest)(nil),                                // 61: pb.FindAllUserServersRequest
+	(*FindAllUserServersResponse)(nil),                               // 62: pb.FindAllUserServersResponse
+	(*CountAllUserServersRequest)(nil),                               // 63: pb.CountAllUserServersRequest
+	(*ComposeAllUserServersConfigRequest)(nil),                       // 64: pb.ComposeAllUserServersConfigRequest
+	(*ComposeAllUserServersConfigResponse)(nil),                      // 65: pb.ComposeAllUserServersConfigResponse
+	(*FindEnabledUserServerBasicRequest)(nil),                        // 66: pb.FindEnabledUserServerBasicRequest
+	(*FindEnabledUserServerBasicResponse)(nil),                       // 67: pb.FindEnabledUserServerBasicResponse
+	(*UpdateEnabledUserServerBasicRequest)(nil),                      // 68: pb.UpdateEnabledUserServerBasicRequest
+	(*UploadServerHTTPRequestStatRequest)(nil),                       // 69: pb.UploadServerHTTPRequestStatRequest
+	(*CheckServerNameDuplicationInNodeClusterRequest)(nil),           // 70: pb.CheckServerNameDuplicationInNodeClusterRequest
+	(*CheckServerNameDuplicationInNodeClusterResponse)(nil),          // 71: pb.CheckServerNameDuplicationInNodeClusterResponse
+	(*CheckServerNameInServerRequest)(nil),                           // 72: pb.CheckServerNameInServerRequest
+	(*CheckServerNameInServerResponse)(nil),                          // 73: pb.CheckServerNameInServerResponse
+	(*FindLatestServersRequest)(nil),                                 // 74: pb.FindLatestServersRequest
+	(*FindLatestServersResponse)(nil),                                // 75: pb.FindLatestServersResponse
+	(*FindNearbyServersRequest)(nil),                                 // 76: pb.FindNearbyServersRequest
+	(*FindNearbyServersResponse)(nil),                                // 77: pb.FindNearbyServersResponse
+	(*PurgeServerCacheRequest)(nil),                                  // 78: pb.PurgeServerCacheRequest
+	(*PurgeServerCacheResponse)(nil),                                 // 79: pb.PurgeServerCacheResponse
+	(*FindEnabledServerTrafficLimitRequest)(nil),                     // 80: pb.FindEnabledServerTrafficLimitRequest
+	(*FindEnabledServerTrafficLimitResponse)(nil),                    // 81: pb.FindEnabledServerTrafficLimitResponse
+	(*UpdateServerTrafficLimitRequest)(nil),                          // 82: pb.UpdateServerTrafficLimitRequest
+	(*UpdateServerUserPlanRequest)(nil),                              // 83: pb.UpdateServerUserPlanRequest
+	(*FindServerUserPlanRequest)(nil),                                // 84: pb.FindServerUserPlanRequest
+	(*FindServerUserPlanResponse)(nil),                               // 85: pb.FindServerUserPlanResponse
+	(*ComposeServerConfigRequest)(nil),                               // 86: pb.ComposeServerConfigRequest
+	(*ComposeServerConfigResponse)(nil),                              // 87: pb.ComposeServerConfigResponse
+	(*UpdateServerUAMRequest)(nil),                                   // 88: pb.UpdateServerUAMRequest
+	(*FindEnabledServerUAMRequest)(nil),                              // 89: pb.FindEnabledServerUAMRequest
+	(*FindEnabledServerUAMResponse)(nil),                             // 90: pb.FindEnabledServerUAMResponse
+	(*UpdateServerUserRequest)(nil),                                  // 91: pb.UpdateServerUserRequest
+	(*UpdateServerNameRequest)(nil),                                  // 92: pb.UpdateServerNameRequest
+	(*CopyServerConfigRequest)(nil),                                  // 93: pb.CopyServerConfigRequest
+	(*FindServerAuditingPromptRequest)(nil),                          // 94: pb.FindServerAuditingPromptRequest
+	(*FindServerAuditingPromptResponse)(nil),                         // 95: pb.FindServerAuditingPromptResponse
+	(*CountAllServerChangeLogsRequest)(nil),                          // 96: pb.CountAllServerChangeLogsRequest
+	(*ListServerChangeLogsRequest)(nil),                              // 97: pb.ListServerChangeLogsRequest
+	(*ListServerChangeLogsResponse)(nil),                             // 98: pb.ListServerChangeLogsResponse
+	(*SearchServersRequest)(nil),                                     // 99: pb.SearchServersRequest
+	(*SearchServersResponse)(nil),                                    // 100: pb.SearchServersResponse
+	(*LookupServerRequest)(nil),                                      // 101: pb.LookupServerRequest
+	(*LookupServerResponse)(nil),                                     // 102: pb.LookupServerResponse
+	(*UpdateServerExternalIdRequest)(nil),                            // 103: pb.UpdateServerExternalIdRequest
+	(*UploadServerHTTPRequestStatRequest_RegionCity)(nil),            // 104: pb.UploadServerHTTPRequestStatRequest.RegionCity
+	(*UploadServerHTTPRequestStatRequest_RegionProvider)(nil),        // 105: pb.UploadServerHTTPRequestStatRequest.RegionProvider
+	(*UploadServerHTTPRequestStatRequest_System)(nil),                // 106: pb.UploadServerHTTPRequestStatRequest.System
+	(*UploadServerHTTPRequestStatRequest_Browser)(nil),               // 107: pb.UploadServerHTTPRequestStatRequest.Browser
+	(*UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup)(nil), // 108: pb.UploadServerHTTPRequestStatRequest.HTTPFirewallRuleGroup
+	(*FindNearbyServersResponse_GroupInfo)(nil),                      // 109: pb.FindNearbyServersResponse.GroupInfo
+	(*SearchServersResponse_Match)(nil),                              // 110: pb.SearchServersResponse.Match
+	(*ServerNameAuditingResult)(nil),                                 // 111: pb.ServerNameAuditingResult
+	(*Server)(nil),                                                   // 112: pb.Server
+	(*DNSDomain)(nil),                                                // 113: pb.DNSDomain
+	(*UserPlan)(nil),                                                 // 114: pb.UserPlan
+	(*ServerChangeLog)(nil),                                          // 115: pb.ServerChangeLog
+	(*RPCSuccess)(nil),                                               // 116: pb.RPCSuccess
+	(*RPCCountResponse)(nil),                                         // 117: pb.RPCCountResponse
 }
 var file_service_server_proto_depIdxs = []int32{
-	100, // 0: pb.FindServerNamesResponse.auditingResult:type_name -> pb.ServerNameAuditingResult
-	100, // 1: pb.UpdateServerNamesAuditingRequest.auditingResult:type_name -> pb.ServerNameAuditingResult
-	101, // 2: pb.ListEnabledServersMatchResponse.servers:type_name -> pb.Server
-	101, // 3: pb.FindEnabledServerResponse.server:type_name -> pb.Server
-	101, // 4: pb.FindAllEnabledServersWithSSLCertIdResponse.servers:type_name -> pb.Server
-	51,  // 5: pb.FindAllEnabledServersDNSWithNodeClusterIdResponse.servers:type_name -> pb.ServerDNSInfo
-	102, // 6: pb.FindEnabledServerDNSResponse.domain:type_name -> pb.DNSDomain
-	101, // 7: pb.FindAllUserServersResponse.servers:type_name -> pb.Server
-	101, // 8: pb.FindEnabledUserServerBasicResponse.server:type_name -> pb.Server
-	94,  // 9: pb.UploadServerHTTPRequestStatRequest.regionCities:type_name -> pb.UploadServerHTTPRequestStatRequest.RegionCity
-	95,  // 10: pb.UploadServerHTTPRequestStatRequest.regionProviders:type_name -> pb.UploadServerHTTPRequestStatRequest.RegionProvider
-	96,  // 11: pb.UploadServerHTTPRequestStatRequest.systems:type_name -> pb.UploadServerHTTPRequestStatRequest.System
-	97,  // 12: pb.UploadServerHTTPRequestStatRequest.browsers:type_name -> pb.UploadServerHTTPRequestStatRequest.Browser
-	98,  // 13: pb.UploadServerHTTPRequestStatRequest.httpFirewallRuleGroups:type_name -> pb.UploadServerHTTPRequestStatRequest.HTTPFirewallRuleGroup
-	101, // 14: pb.FindLatestServersResponse.servers:type_name -> pb.Server
-	99,  // 15: pb.FindNearbyServersResponse.groups:type_name -> pb.FindNearbyServersResponse.GroupInfo
-	103, // 16: pb.FindServerUserPlanResponse.userPlan:type_name -> pb.UserPlan
-	101, // 17: pb.FindNearbyServersResponse.GroupInfo.servers:type_name -> pb.Server
-	0,   // 18: pb.ServerService.createServer:input_type -> pb.CreateServerRequest
-	2,   // 19: pb.ServerService.createBasicHTTPServer:input_type -> pb.CreateBasicHTTPServerRequest
-	4,   // 20: pb.ServerService.createBasicTCPServer:input_type -> pb.CreateBasicTCPServerRequest
-	6,   // 21: pb.ServerService.addServerOrigin:input_type -> pb.AddServerOriginRequest
-	7,   // 22: pb.ServerService.deleteServerOrigin:input_type -> pb.DeleteServerOriginRequest
-	8,   // 23: pb.ServerService.updateServerBasic:input_type -> pb.UpdateServerBasicRequest
-	9,   // 24: pb.ServerService.updateServerGroupIds:input_type -> pb.UpdateServerGroupIdsRequest
-	10,  // 25: pb.ServerService.updateServerIsOn:input_type -> pb.UpdateServerIsOnRequest
-	11,  // 26: pb.ServerService.updateServerHTTP:input_type -> pb.UpdateServerHTTPRequest
-	12,  // 27: pb.ServerService.updateServerHTTPS:input_type -> pb.UpdateServerHTTPSRequest
-	13,  // 28: pb.ServerService.updateServerTCP:input_type -> pb.UpdateServerTCPRequest
-	14,  // 29: pb.ServerService.updateServerTLS:input_type -> pb.UpdateServerTLSRequest
-	15,  // 30: pb.ServerService.updateServerUDP:input_type -> pb.UpdateServerUDPRequest
-	16,  // 31: pb.ServerService.updateServerWeb:input_type -> pb.UpdateServerWebRequest
-	17,  // 32: pb.ServerService.updateServerReverseProxy:input_type -> pb.UpdateServerReverseProxyRequest
-	18,  // 33: pb.ServerService.findServerNames:input_type -> pb.FindServerNamesRequest
-	20,  // 34: pb.ServerService.updateServerNames:input_type -> pb.UpdateServerNamesRequest
-	21,  // 35: pb.ServerService.updateServerNamesAuditing:input_type -> pb.UpdateServerNamesAuditingRequest
-	22,  // 36: pb.ServerService.updateServerDNS:input_type -> pb.UpdateServerDNSRequest
-	23,  // 37: pb.ServerService.regenerateServerDNSName:input_type -> pb.RegenerateServerDNSNameRequest
-	24,  // 38: pb.ServerService.updateServerDNSName:input_type -> pb.UpdateServerDNSNameRequest
-	25,  // 39: pb.ServerService.findServerIdWithDNSName:input_type -> pb.FindServerIdWithDNSNameRequest
-	27,  // 40: pb.ServerService.countAllEnabledServersMatch:input_type -> pb.CountAllEnabledServersMatchRequest
-	28,  // 41: pb.ServerService.listEnabledServersMatch:input_type -> pb.ListEnabledServersMatchRequest
-	30,  // 42: pb.ServerService.deleteServer:input_type -> pb.DeleteServerRequest
-	31,  // 43: pb.ServerService.deleteServers:input_type -> pb.DeleteServersRequest
-	32,  // 44: pb.ServerService.findEnabledServer:input_type -> pb.FindEnabledServerRequest
-	34,  // 45: pb.ServerService.findEnabledServerConfig:input_type -> pb.FindEnabledServerConfigRequest
-	36,  // 46: pb.ServerService.findEnabledServerType:input_type -> pb.FindEnabledServerTypeRequest
-	38,  // 47: pb.ServerService.findAndInitServerReverseProxyConfig:input_type -> pb.FindAndInitServerReverseProxyConfigRequest
-	40,  // 48: pb.ServerService.findAndInitServerWebConfig:input_type -> pb.FindAndInitServerWebConfigRequest
-	42,  // 49: pb.ServerService.countAllEnabledServersWithSSLCertId:input_type -> pb.CountAllEnabledServersWithSSLCertIdRequest
-	43,  // 50: pb.ServerService.findAllEnabledServersWithSSLCertId:input_type -> pb.FindAllEnabledServersWithSSLCertIdRequest
-	45,  // 51: pb.ServerService.countAllEnabledServersWithNodeClusterId:input_type -> pb.CountAllEnabledServersWithNodeClusterIdRequest
-	46,  // 52: pb.ServerService.countAllEnabledServersWithServerGroupId:input_type -> pb.CountAllEnabledServersWithServerGroupIdRequest
-	47,  // 53: pb.ServerService.notifyServersChange:input_type -> pb.NotifyServersChangeRequest
-	49,  // 54: pb.ServerService.findAllEnabledServersDNSWithNodeClusterId:input_type -> pb.FindAllEnabledServersDNSWithNodeClusterIdRequest
-	52,  // 55: pb.ServerService.findEnabledServerDNS:input_type -> pb.FindEnabledServerDNSRequest
-	54,  // 56: pb.ServerService.checkUserServer:input_type -> pb.CheckUserServerRequest
-	55,  // 57: pb.ServerService.findAllEnabledServerNamesWithUserId:input_type -> pb.FindAllEnabledServerNamesWithUserIdRequest
-	57,  // 58: pb.ServerService.countAllServerNamesWithUserId:input_type -> pb.CountAllServerNamesWithUserIdRequest
-	58,  // 59: pb.ServerService.countServerNames:input_type -> pb.CountServerNamesRequest
-	59,  // 60: pb.ServerService.findAllUserServers:input_type -> pb.FindAllUserServersRequest
-	61,  // 61: pb.ServerService.countAllUserServers:input_type -> pb.CountAllUserServersRequest
-	62,  // 62: pb.ServerService.composeAllUserServersConfig:input_type -> pb.ComposeAllUserServersConfigRequest
-	64,  // 63: pb.ServerService.findEnabledUserServerBasic:input_type -> pb.FindEnabledUserServerBasicRequest
-	66,  // 64: pb.ServerService.updateEnabledUserServerBasic:input_type -> pb.UpdateEnabledUserServerBasicRequest
-	67,  // 65: pb.ServerService.uploadServerHTTPRequestStat:input_type -> pb.UploadServerHTTPRequestStatRequest
-	68,  // 66: pb.ServerService.checkServerNameDuplicationInNodeCluster:input_type -> pb.CheckServerNameDuplicationInNodeClusterRequest
-	70,  // 67: pb.ServerService.checkServerNameInServer:input_type -> pb.CheckServerNameInServerRequest
-	72,  // 68: pb.ServerService.findLatestServers:input_type -> pb.FindLatestServersRequest
-	74,  // 69: pb.ServerService.findNearbyServers:input_type -> pb.FindNearbyServersRequest
-	76,  // 70: pb.ServerService.purgeServerCache:input_type -> pb.PurgeServerCacheRequest
-	78,  // 71: pb.ServerService.findEnabledServerTrafficLimit:input_type -> pb.FindEnabledServerTrafficLimitRequest
-	80,  // 72: pb.ServerService.updateServerTrafficLimit:input_type -> pb.UpdateServerTrafficLimitRequest
-	81,  // 73: pb.ServerService.updateServerUserPlan:input_type -> pb.UpdateServerUserPlanRequest
-	82,  // 74: pb.ServerService.findServerUserPlan:input_type -> pb.FindServerUserPlanRequest
-	84,  // 75: pb.ServerService.composeServerConfig:input_type -> pb.ComposeServerConfigRequest
-	86,  // 76: pb.ServerService.updateServerUAM:input_type -> pb.UpdateServerUAMRequest
-	87,  // 77: pb.ServerService.findEnabledServerUAM:input_type -> pb.FindEnabledServerUAMRequest
-	89,  // 78: pb.ServerService.updateServerUser:input_type -> pb.UpdateServerUserRequest
-	90,  // 79: pb.ServerService.updateServerName:input_type -> pb.UpdateServerNameRequest
-	91,  // 80: pb.ServerService.copyServerConfig:input_type -> pb.CopyServerConfigRequest
-	92,  // 81: pb.ServerService.findServerAuditingPrompt:input_type -> pb.FindServerAuditingPromptRequest
-	1,   // 82: pb.ServerService.createServer:output_type -> pb.CreateServerResponse
-	3,   // 83: pb.ServerService.createBasicHTTPServer:output_type -> pb.CreateBasicHTTPServerResponse
-	5,   // 84: pb.ServerService.createBasicTCPServer:output_type -> pb.CreateBasicTCPServerResponse
-	104, // 85: pb.ServerService.addServerOrigin:output_type -> pb.RPCSuccess
-	104, // 86: pb.ServerService.deleteServerOrigin:output_type -> pb.RPCSuccess
-	104, // 87: pb.ServerService.updateServerBasic:output_type -> pb.RPCSuccess
-	104, // 88: pb.ServerService.updateServerGroupIds:output_type -> pb.RPCSuccess
-	104, // 89: pb.ServerService.updateServerIsOn:output_type -> pb.RPCSuccess
-	104, // 90: pb.ServerService.updateServerHTTP:output_type -> pb.RPCSuccess
-	104, // 91: pb.ServerService.updateServerHTTPS:output_type -> pb.RPCSuccess
-	104, // 92: pb.ServerService.updateServerTCP:output_type -> pb.RPCSuccess
-	104, // 93: pb.ServerService.updateServerTLS:output_type -> pb.RPCSuccess
-	104, // 94: pb.ServerService.updateServerUDP:output_type -> pb.RPCSuccess
-	104, // 95: pb.ServerService.updateServerWeb:output_type -> pb.RPCSuccess
-	104, // 96: pb.ServerService.updateServerReverseProxy:output_type -> pb.RPCSuccess
-	19,  // 97: pb.ServerService.findServerNames:output_type -> pb.FindServerNamesResponse
-	104, // 98: pb.ServerService.updateServerNames:output_type -> pb.RPCSuccess
-	104, // 99: pb.ServerService.updateServerNamesAuditing:output_type -> pb.RPCSuccess
-	104, // 100: pb.ServerService.updateServerDNS:output_type -> pb.RPCSuccess
-	104, // 101: pb.ServerService.regenerateServerDNSName:output_type -> pb.RPCSuccess
-	104, // 102: pb.ServerService.updateServerDNSName:output_type -> pb.RPCSuccess
-	26,  // 103: pb.ServerService.findServerIdWithDNSName:output_type -> pb.FindServerIdWithDNSNameResponse
-	105, // 104: pb.ServerService.countAllEnabledServersMatch:output_type -> pb.RPCCountResponse
-	29,  // 105: pb.ServerService.listEnabledServersMatch:output_type -> pb.ListEnabledServersMatchResponse
-	104, // 106: pb.ServerService.deleteServer:output_type -> pb.RPCSuccess
-	104, // 107: pb.ServerService.deleteServers:output_type -> pb.RPCSuccess
-	33,  // 108: pb.ServerService.findEnabledServer:output_type -> pb.FindEnabledServerResponse
-	35,  // 109: pb.ServerService.findEnabledServerConfig:output_type -> pb.FindEnabledServerConfigResponse
-	37,  // 110: pb.ServerService.findEnabledServerType:output_type -> pb.FindEnabledServerTypeResponse
-	39,  // 111: pb.ServerService.findAndInitServerReverseProxyConfig:output_type -> pb.FindAndInitServerReverseProxyConfigResponse
-	41,  // 112: pb.ServerService.findAndInitServerWebConfig:output_type -> pb.FindAndInitServerWebConfigResponse
-	105, // 113: pb.ServerService.countAllEnabledServersWithSSLCertId:output_type -> pb.RPCCountResponse
-	44,  // 114: pb.ServerService.findAllEnabledServersWithSSLCertId:output_type -> pb.FindAllEnabledServersWithSSLCertIdResponse
-	105, // 115: pb.ServerService.countAllEnabledServersWithNodeClusterId:output_type -> pb.RPCCountResponse
-	105, // 116: pb.ServerService.countAllEnabledServersWithServerGroupId:output_type -> pb.RPCCountResponse
-	48,  // 117: pb.ServerService.notifyServersChange:output_type -> pb.NotifyServersChangeResponse
-	50,  // 118: pb.ServerService.findAllEnabledServersDNSWithNodeClusterId:output_type -> pb.FindAllEnabledServersDNSWithNodeClusterIdResponse
-	53,  // 119: pb.ServerService.findEnabledServerDNS:output_type -> pb.FindEnabledServerDNSResponse
-	104, // 120: pb.ServerService.checkUserServer:output_type -> pb.RPCSuccess
-	56,  // 121: pb.ServerService.findAllEnabledServerNamesWithUserId:output_type -> pb.FindAllEnabledServerNamesWithUserIdResponse
-	105, // 122: pb.ServerService.countAllServerNamesWithUserId:output_type -> pb.RPCCountResponse
-	105, // 123: pb.ServerService.countServerNames:output_type -> pb.RPCCountResponse
-	60,  // 124: pb.ServerService.findAllUserServers:output_type -> pb.FindAllUserServersResponse
-	105, // 125: pb.ServerService.countAllUserServers:output_type -> pb.RPCCountResponse
-	63,  // 126: pb.ServerService.composeAllUserServersConfig:output_type -> pb.ComposeAllUserServersConfigResponse
-	65,  // 127: pb.ServerService.findEnabledUserServerBasic:output_type -> pb.FindEnabledUserServerBasicResponse
-	104, // 128: pb.ServerService.updateEnabledUserServerBasic:output_type -> pb.RPCSuccess
-	104, // 129: pb.ServerService.uploadServerHTTPRequestStat:output_type -> pb.RPCSuccess
-	69,  // 130: pb.ServerService.checkServerNameDuplicationInNodeCluster:output_type -> pb.CheckServerNameDuplicationInNodeClusterResponse
-	71,  // 131: pb.ServerService.checkServerNameInServer:output_type -> pb.CheckServerNameInServerResponse
-	73,  // 132: pb.ServerService.findLatestServers:output_type -> pb.FindLatestServersResponse
-	75,  // 133: pb.ServerService.findNearbyServers:output_type -> pb.FindNearbyServersResponse
-	77,  // 134: pb.ServerService.purgeServerCache:output_type -> pb.PurgeServerCacheResponse
-	79,  // 135: pb.ServerService.findEnabledServerTrafficLimit:output_type -> pb.FindEnabledServerTrafficLimitResponse
-	104, // 136: pb.ServerService.updateServerTrafficLimit:output_type -> pb.RPCSuccess
-	104, // 137: pb.ServerService.updateServerUserPlan:output_type -> pb.RPCSuccess
-	83,  // 138: pb.ServerService.findServerUserPlan:output_type -> pb.FindServerUserPlanResponse
-	85,  // 139: pb.ServerService.composeServerConfig:output_type -> pb.ComposeServerConfigResponse
-	104, // 140: pb.ServerService.updateServerUAM:output_type -> pb.RPCSuccess
-	88,  // 141: pb.ServerService.findEnabledServerUAM:output_type -> pb.FindEnabledServerUAMResponse
-	104, // 142: pb.ServerService.updateServerUser:output_type -> pb.RPCSuccess
-	104, // 143: pb.ServerService.updateServerName:output_type -> pb.RPCSuccess
-	104, // 144: pb.ServerService.copyServerConfig:output_type -> pb.RPCSuccess
-	93,  // 145: pb.ServerService.findServerAuditingPrompt:output_type -> pb.FindServerAuditingPromptResponse
-	82,  // [82:146] is the sub-list for method output_type
-	18,  // [18:82] is the sub-list for method input_type
-	18,  // [18:18] is the sub-list for extension type_name
-	18,  // [18:18] is the sub-list for extension extendee
-	0,   // [0:18] is the sub-list for field type_name
+	111, // 0: pb.FindServerNamesResponse.auditingResult:type_name -> pb.ServerNameAuditingResult
+	111, // 1: pb.UpdateServerNamesAuditingRequest.auditingResult:type_name -> pb.ServerNameAuditingResult
+	112, // 2: pb.ListEnabledServersMatchResponse.servers:type_name -> pb.Server
+	112, // 3: pb.FindEnabledServerResponse.server:type_name -> pb.Server
+	112, // 4: pb.FindAllEnabledServersWithSSLCertIdResponse.servers:type_name -> pb.Server
+	53,  // 5: pb.FindAllEnabledServersDNSWithNodeClusterIdResponse.servers:type_name -> pb.ServerDNSInfo
+	113, // 6: pb.FindEnabledServerDNSResponse.domain:type_name -> pb.DNSDomain
+	112, // 7: pb.FindAllUserServersResponse.servers:type_name -> pb.Server
+	112, // 8: pb.FindEnabledUserServerBasicResponse.server:type_name -> pb.Server
+	104, // 9: pb.UploadServerHTTPRequestStatRequest.regionCities:type_name -> pb.UploadServerHTTPRequestStatRequest.RegionCity
+	105, // 10: pb.UploadServerHTTPRequestStatRequest.regionProviders:type_name -> pb.UploadServerHTTPRequestStatRequest.RegionProvider
+	106, // 11: pb.UploadServerHTTPRequestStatRequest.systems:type_name -> pb.UploadServerHTTPRequestStatRequest.System
+	107, // 12: pb.UploadServerHTTPRequestStatRequest.browsers:type_name -> pb.UploadServerHTTPRequestStatRequest.Browser
+	108, // 13: pb.UploadServerHTTPRequestStatRequest.httpFirewallRuleGroups:type_name -> pb.UploadServerHTTPRequestStatRequest.HTTPFirewallRuleGroup
+	112, // 14: pb.FindLatestServersResponse.servers:type_name -> pb.Server
+	109, // 15: pb.FindNearbyServersResponse.groups:type_name -> pb.FindNearbyServersResponse.GroupInfo
+	114, // 16: pb.FindServerUserPlanResponse.userPlan:type_name -> pb.UserPlan
+	115, // 17: pb.ListServerChangeLogsResponse.serverChangeLogs:type_name -> pb.ServerChangeLog
+	110, // 18: pb.SearchServersResponse.matches:type_name -> pb.SearchServersResponse.Match
+	112, // 19: pb.FindNearbyServersResponse.GroupInfo.servers:type_name -> pb.Server
+	112, // 20: pb.SearchServersResponse.Match.server:type_name -> pb.Server
+	0,   // 21: pb.ServerService.createServer:input_type -> pb.CreateServerRequest
+	2,   // 22: pb.ServerService.createBasicHTTPServer:input_type -> pb.CreateBasicHTTPServerRequest
+	4,   // 23: pb.ServerService.createBasicTCPServer:input_type -> pb.CreateBasicTCPServerRequest
+	6,   // 24: pb.ServerService.cloneServer:input_type -> pb.CloneServerRequest
+	8,   // 25: pb.ServerService.addServerOrigin:input_type -> pb.AddServerOriginRequest
+	9,   // 26: pb.ServerService.deleteServerOrigin:input_type -> pb.DeleteServerOriginRequest
+	10,  // 27: pb.ServerService.updateServerBasic:input_type -> pb.UpdateServerBasicRequest
+	11,  // 28: pb.ServerService.updateServerGroupIds:input_type -> pb.UpdateServerGroupIdsRequest
+	12,  // 29: pb.ServerService.updateServerIsOn:input_type -> pb.UpdateServerIsOnRequest
+	13,  // 30: pb.ServerService.updateServerHTTP:input_type -> pb.UpdateServerHTTPRequest
+	14,  // 31: pb.ServerService.updateServerHTTPS:input_type -> pb.UpdateServerHTTPSRequest
+	15,  // 32: pb.ServerService.updateServerTCP:input_type -> pb.UpdateServerTCPRequest
+	16,  // 33: pb.ServerService.updateServerTLS:input_type -> pb.UpdateServerTLSRequest
+	17,  // 34: pb.ServerService.updateServerUDP:input_type -> pb.UpdateServerUDPRequest
+	18,  // 35: pb.ServerService.updateServerWeb:input_type -> pb.UpdateServerWebRequest
+	19,  // 36: pb.ServerService.updateServerReverseProxy:input_type -> pb.UpdateServerReverseProxyRequest
+	20,  // 37: pb.ServerService.findServerNames:input_type -> pb.FindServerNamesRequest
+	22,  // 38: pb.ServerService.updateServerNames:input_type -> pb.UpdateServerNamesRequest
+	23,  // 39: pb.ServerService.updateServerNamesAuditing:input_type -> pb.UpdateServerNamesAuditingRequest
+	24,  // 40: pb.ServerService.updateServerDNS:input_type -> pb.UpdateServerDNSRequest
+	25,  // 41: pb.ServerService.regenerateServerDNSName:input_type -> pb.RegenerateServerDNSNameRequest
+	26,  // 42: pb.ServerService.updateServerDNSName:input_type -> pb.UpdateServerDNSNameRequest
+	27,  // 43: pb.ServerService.findServerIdWithDNSName:input_type -> pb.FindServerIdWithDNSNameRequest
+	29,  // 44: pb.ServerService.countAllEnabledServersMatch:input_type -> pb.CountAllEnabledServersMatchRequest
+	30,  // 45: pb.ServerService.listEnabledServersMatch:input_type -> pb.ListEnabledServersMatchRequest
+	32,  // 46: pb.ServerService.deleteServer:input_type -> pb.DeleteServerRequest
+	33,  // 47: pb.ServerService.deleteServers:input_type -> pb.DeleteServersRequest
+	34,  // 48: pb.ServerService.findEnabledServer:input_type -> pb.FindEnabledServerRequest
+	36,  // 49: pb.ServerService.findEnabledServerConfig:input_type -> pb.FindEnabledServerConfigRequest
+	38,  // 50: pb.ServerService.findEnabledServerType:input_type -> pb.FindEnabledServerTypeRequest
+	40,  // 51: pb.ServerService.findAndInitServerReverseProxyConfig:input_type -> pb.FindAndInitServerReverseProxyConfigRequest
+	42,  // 52: pb.ServerService.findAndInitServerWebConfig:input_type -> pb.FindAndInitServerWebConfigRequest
+	44,  // 53: pb.ServerService.countAllEnabledServersWithSSLCertId:input_type -> pb.CountAllEnabledServersWithSSLCertIdRequest
+	45,  // 54: pb.ServerService.findAllEnabledServersWithSSLCertId:input_type -> pb.FindAllEnabledServersWithSSLCertIdRequest
+	47,  // 55: pb.ServerService.countAllEnabledServersWithNodeClusterId:input_type -> pb.CountAllEnabledServersWithNodeClusterIdRequest
+	48,  // 56: pb.ServerService.countAllEnabledServersWithServerGroupId:input_type -> pb.CountAllEnabledServersWithServerGroupIdRequest
+	49,  // 57: pb.ServerService.notifyServersChange:input_type -> pb.NotifyServersChangeRequest
+	51,  // 58: pb.ServerService.findAllEnabledServersDNSWithNodeClusterId:input_type -> pb.FindAllEnabledServersDNSWithNodeClusterIdRequest
+	54,  // 59: pb.ServerService.findEnabledServerDNS:input_type -> pb.FindEnabledServerDNSRequest
+	56,  // 60: pb.ServerService.checkUserServer:input_type -> pb.CheckUserServerRequest
+	57,  // 61: pb.ServerService.findAllEnabledServerNamesWithUserId:input_type -> pb.FindAllEnabledServerNamesWithUserIdRequest
+	59,  // 62: pb.ServerService.countAllServerNamesWithUserId:input_type -> pb.CountAllServerNamesWithUserIdRequest
+	60,  // 63: pb.ServerService.countServerNames:input_type -> pb.CountServerNamesRequest
+	61,  // 64: pb.ServerService.findAllUserServers:input_type -> pb.FindAllUserServersRequest
+	63,  // 65: pb.ServerService.countAllUserServers:input_type -> pb.CountAllUserServersRequest
+	64,  // 66: pb.ServerService.composeAllUserServersConfig:input_type -> pb.ComposeAllUserServersConfigRequest
+	66,  // 67: pb.ServerService.findEnabledUserServerBasic:input_type -> pb.FindEnabledUserServerBasicRequest
+	68,  // 68: pb.ServerService.updateEnabledUserServerBasic:input_type -> pb.UpdateEnabledUserServerBasicRequest
+	69,  // 69: pb.ServerService.uploadServerHTTPRequestStat:input_type -> pb.UploadServerHTTPRequestStatRequest
+	70,  // 70: pb.ServerService.checkServerNameDuplicationInNodeCluster:input_type -> pb.CheckServerNameDuplicationInNodeClusterRequest
+	72,  // 71: pb.ServerService.checkServerNameInServer:input_type -> pb.CheckServerNameInServerRequest
+	74,  // 72: pb.ServerService.findLatestServers:input_type -> pb.FindLatestServersRequest
+	76,  // 73: pb.ServerService.findNearbyServers:input_type -> pb.FindNearbyServersRequest
+	78,  // 74: pb.ServerService.purgeServerCache:input_type -> pb.PurgeServerCacheRequest
+	80,  // 75: pb.ServerService.findEnabledServerTrafficLimit:input_type -> pb.FindEnabledServerTrafficLimitRequest
+	82,  // 76: pb.ServerService.updateServerTrafficLimit:input_type -> pb.UpdateServerTrafficLimitRequest
+	83,  // 77: pb.ServerService.updateServerUserPlan:input_type -> pb.UpdateServerUserPlanRequest
+	84,  // 78: pb.ServerService.findServerUserPlan:input_type -> pb.FindServerUserPlanRequest
+	86,  // 79: pb.ServerService.composeServerConfig:input_type -> pb.ComposeServerConfigRequest
+	88,  // 80: pb.ServerService.updateServerUAM:input_type -> pb.UpdateServerUAMRequest
+	89,  // 81: pb.ServerService.findEnabledServerUAM:input_type -> pb.FindEnabledServerUAMRequest
+	91,  // 82: pb.ServerService.updateServerUser:input_type -> pb.UpdateServerUserRequest
+	92,  // 83: pb.ServerService.updateServerName:input_type -> pb.UpdateServerNameRequest
+	93,  // 84: pb.ServerService.copyServerConfig:input_type -> pb.CopyServerConfigRequest
+	94,  // 85: pb.ServerService.findServerAuditingPrompt:input_type -> pb.FindServerAuditingPromptRequest
+	96,  // 86: pb.ServerService.countAllServerChangeLogs:input_type -> pb.CountAllServerChangeLogsRequest
+	97,  // 87: pb.ServerService.listServerChangeLogs:input_type -> pb.ListServerChangeLogsRequest
+	99,  // 88: pb.ServerService.searchServers:input_type -> pb.SearchServersRequest
+	101, // 89: pb.ServerService.lookupServer:input_type -> pb.LookupServerRequest
+	103, // 90: pb.ServerService.updateServerExternalId:input_type -> pb.UpdateServerExternalIdRequest
+	1,   // 91: pb.ServerService.createServer:output_type -> pb.CreateServerResponse
+	3,   // 92: pb.ServerService.createBasicHTTPServer:output_type -> pb.CreateBasicHTTPServerResponse
+	5,   // 93: pb.ServerService.createBasicTCPServer:output_type -> pb.CreateBasicTCPServerResponse
+	7,   // 94: pb.ServerService.cloneServer:output_type -> pb.CloneServerResponse
+	116, // 95: pb.ServerService.addServerOrigin:output_type -> pb.RPCSuccess
+	116, // 96: pb.ServerService.deleteServerOrigin:output_type -> pb.RPCSuccess
+	116, // 97: pb.ServerService.updateServerBasic:output_type -> pb.RPCSuccess
+	116, // 98: pb.ServerService.updateServerGroupIds:output_type -> pb.RPCSuccess
+	116, // 99: pb.ServerService.updateServerIsOn:output_type -> pb.RPCSuccess
+	116, // 100: pb.ServerService.updateServerHTTP:output_type -> pb.RPCSuccess
+	116, // 101: pb.ServerService.updateServerHTTPS:output_type -> pb.RPCSuccess
+	116, // 102: pb.ServerService.updateServerTCP:output_type -> pb.RPCSuccess
+	116, // 103: pb.ServerService.updateServerTLS:output_type -> pb.RPCSuccess
+	116, // 104: pb.ServerService.updateServerUDP:output_type -> pb.RPCSuccess
+	116, // 105: pb.ServerService.updateServerWeb:output_type -> pb.RPCSuccess
+	116, // 106: pb.ServerService.updateServerReverseProxy:output_type -> pb.RPCSuccess
+	21,  // 107: pb.ServerService.findServerNames:output_type -> pb.FindServerNamesResponse
+	116, // 108: pb.ServerService.updateServerNames:output_type -> pb.RPCSuccess
+	116, // 109: pb.ServerService.updateServerNamesAuditing:output_type -> pb.RPCSuccess
+	116, // 110: pb.ServerService.updateServerDNS:output_type -> pb.RPCSuccess
+	116, // 111: pb.ServerService.regenerateServerDNSName:output_type -> pb.RPCSuccess
+	116, // 112: pb.ServerService.updateServerDNSName:output_type -> pb.RPCSuccess
+	28,  // 113: pb.ServerService.findServerIdWithDNSName:output_type -> pb.FindServerIdWithDNSNameResponse
+	117, // 114: pb.ServerService.countAllEnabledServersMatch:output_type -> pb.RPCCountResponse
+	31,  // 115: pb.ServerService.listEnabledServersMatch:output_type -> pb.ListEnabledServersMatchResponse
+	116, // 116: pb.ServerService.deleteServer:output_type -> pb.RPCSuccess
+	116, // 117: pb.ServerService.deleteServers:output_type -> pb.RPCSuccess
+	35,  // 118: pb.ServerService.findEnabledServer:output_type -> pb.FindEnabledServerResponse
+	37,  // 119: pb.ServerService.findEnabledServerConfig:output_type -> pb.FindEnabledServerConfigResponse
+	39,  // 120: pb.ServerService.findEnabledServerType:output_type -> pb.FindEnabledServerTypeResponse
+	41,  // 121: pb.ServerService.findAndInitServerReverseProxyConfig:output_type -> pb.FindAndInitServerReverseProxyConfigResponse
+	43,  // 122: pb.ServerService.findAndInitServerWebConfig:output_type -> pb.FindAndInitServerWebConfigResponse
+	117, // 123: pb.ServerService.countAllEnabledServersWithSSLCertId:output_type -> pb.RPCCountResponse
+	46,  // 124: pb.ServerService.findAllEnabledServersWithSSLCertId:output_type -> pb.FindAllEnabledServersWithSSLCertIdResponse
+	117, // 125: pb.ServerService.countAllEnabledServersWithNodeClusterId:output_type -> pb.RPCCountResponse
+	117, // 126: pb.ServerService.countAllEnabledServersWithServerGroupId:output_type -> pb.RPCCountResponse
+	50,  // 127: pb.ServerService.notifyServersChange:output_type -> pb.NotifyServersChangeResponse
+	52,  // 128: pb.ServerService.findAllEnabledServersDNSWithNodeClusterId:output_type -> pb.FindAllEnabledServersDNSWithNodeClusterIdResponse
+	55,  // 129: pb.ServerService.findEnabledServerDNS:output_type -> pb.FindEnabledServerDNSResponse
+	116, // 130: pb.ServerService.checkUserServer:output_type -> pb.RPCSuccess
+	58,  // 131: pb.ServerService.findAllEnabledServerNamesWithUserId:output_type -> pb.FindAllEnabledServerNamesWithUserIdResponse
+	117, // 132: pb.ServerService.countAllServerNamesWithUserId:output_type -> pb.RPCCountResponse
+	117, // 133: pb.ServerService.countServerNames:output_type -> pb.RPCCountResponse
+	62,  // 134: pb.ServerService.findAllUserServers:output_type -> pb.FindAllUserServersResponse
+	117, // 135: pb.ServerService.countAllUserServers:output_type -> pb.RPCCountResponse
+	65,  // 136: pb.ServerService.composeAllUserServersConfig:output_type -> pb.ComposeAllUserServersConfigResponse
+	67,  // 137: pb.ServerService.findEnabledUserServerBasic:output_type -> pb.FindEnabledUserServerBasicResponse
+	116, // 138: pb.ServerService.updateEnabledUserServerBasic:output_type -> pb.RPCSuccess
+	116, // 139: pb.ServerService.uploadServerHTTPRequestStat:output_type -> pb.RPCSuccess
+	71,  // 140: pb.ServerService.checkServerNameDuplicationInNodeCluster:output_type -> pb.CheckServerNameDuplicationInNodeClusterResponse
+	73,  // 141: pb.ServerService.checkServerNameInServer:output_type -> pb.CheckServerNameInServerResponse
+	75,  // 142: pb.ServerService.findLatestServers:output_type -> pb.FindLatestServersResponse
+	77,  // 143: pb.ServerService.findNearbyServers:output_type -> pb.FindNearbyServersResponse
+	79,  // 144: pb.ServerService.purgeServerCache:output_type -> pb.PurgeServerCacheResponse
+	81,  // 145: pb.ServerService.findEnabledServerTrafficLimit:output_type -> pb.FindEnabledServerTrafficLimitResponse
+	116, // 146: pb.ServerService.updateServerTrafficLimit:output_type -> pb.RPCSuccess
+	116, // 147: pb.ServerService.updateServerUserPlan:output_type -> pb.RPCSuccess
+	85,  // 148: pb.ServerService.findServerUserPlan:output_type -> pb.FindServerUserPlanResponse
+	87,  // 149: pb.ServerService.composeServerConfig:output_type -> pb.ComposeServerConfigResponse
+	116, // 150: pb.ServerService.updateServerUAM:output_type -> pb.RPCSuccess
+	90,  // 151: pb.ServerService.findEnabledServerUAM:output_type -> pb.FindEnabledServerUAMResponse
+	116, // 152: pb.ServerService.updateServerUser:output_type -> pb.RPCSuccess
+	116, // 153: pb.ServerService.updateServerName:output_type -> pb.RPCSuccess
+	116, // 154: pb.ServerService.copyServerConfig:output_type -> pb.RPCSuccess
+	95,  // 155: pb.ServerService.findServerAuditingPrompt:output_type -> pb.FindServerAuditingPromptResponse
+	117, // 156: pb.ServerService.countAllServerChangeLogs:output_type -> pb.RPCCountResponse
+	98,  // 157: pb.ServerService.listServerChangeLogs:output_type -> pb.ListServerChangeLogsResponse
+	100, // 158: pb.ServerService.searchServers:output_type -> pb.SearchServersResponse
+	102, // 159: pb.ServerService.lookupServer:output_type -> pb.LookupServerResponse
+	116, // 160: pb.ServerService.updateServerExternalId:output_type -> pb.RPCSuccess
+	91,  // [91:161] is the sub-list for method output_type
+	21,  // [21:91] is the sub-list for method input_type
+	21,  // [21:21] is the sub-list for extension type_name
+	21,  // [21:21] is the sub-list for extension extendee
+	0,   // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_service_server_proto_init() }
@@ -7130,6 +8025,7 @@ func file_service_server_proto_init() {
 	file_models_model_server_name_auditing_result_proto_init()
 	file_models_rpc_messages_proto_init()
 	file_models_model_user_plan_proto_init()
+	file_models_model_server_change_log_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_service_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateServerRequest); i {
@@ -7204,7 +8100,7 @@ func file_service_server_proto_init() {
 			}
 		}
 		file_service_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddServerOriginRequest); i {
+			switch v := v.(*CloneServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7216,7 +8112,7 @@ func file_service_server_proto_init() {
 			}
 		}
 		file_service_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteServerOriginRequest); i {
+			switch v := v.(*CloneServerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7228,7 +8124,7 @@ func file_service_server_proto_init() {
 			}
 		}
 		file_service_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateServerBasicRequest); i {
+			switch v := v.(*AddServerOriginRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7240,7 +8136,7 @@ func file_service_server_proto_init() {
 			}
 		}
 		file_service_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateServerGroupIdsRequest); i {
+			switch v := v.(*DeleteServerOriginRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7252,7 +8148,7 @@ func file_service_server_proto_init() {
 			}
 		}
 		file_service_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateServerIsOnRequest); i {
+			switch v := v.(*UpdateServerBasicRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7264,7 +8160,7 @@ func file_service_server_proto_init() {
 			}
 		}
 		file_service_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateServerHTTPRequest); i {
+			switch v := v.(*UpdateServerGroupIdsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7276,7 +8172,7 @@ func file_service_server_proto_init() {
 			}
 		}
 		file_service_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateServerHTTPSRequest); i {
+			switch v := v.(*UpdateServerIsOnRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7288,7 +8184,7 @@ func file_service_server_proto_init() {
 			}
 		}
 		file_service_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateServerTCPRequest); i {
+			switch v := v.(*UpdateServerHTTPRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7300,7 +8196,7 @@ func file_service_server_proto_init() {
 			}
 		}
 		file_service_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateServerTLSRequest); i {
+			switch v := v.(*UpdateServerHTTPSRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7312,6 +8208,30 @@ func file_service_server_proto_init() {
 			}
 		}
 		file_service_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateServerTCPRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateServerTLSRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerUDPRequest); i {
 			case 0:
 				return &v.state
@@ -7323,7 +8243,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerWebRequest); i {
 			case 0:
 				return &v.state
@@ -7335,7 +8255,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerReverseProxyRequest); i {
 			case 0:
 				return &v.state
@@ -7347,7 +8267,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindServerNamesRequest); i {
 			case 0:
 				return &v.state
@@ -7359,7 +8279,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindServerNamesResponse); i {
 			case 0:
 				return &v.state
@@ -7371,7 +8291,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerNamesRequest); i {
 			case 0:
 				return &v.state
@@ -7383,7 +8303,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerNamesAuditingRequest); i {
 			case 0:
 				return &v.state
@@ -7395,7 +8315,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerDNSRequest); i {
 			case 0:
 				return &v.state
@@ -7407,7 +8327,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegenerateServerDNSNameRequest); i {
 			case 0:
 				return &v.state
@@ -7419,7 +8339,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerDNSNameRequest); i {
 			case 0:
 				return &v.state
@@ -7431,7 +8351,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindServerIdWithDNSNameRequest); i {
 			case 0:
 				return &v.state
@@ -7443,7 +8363,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindServerIdWithDNSNameResponse); i {
 			case 0:
 				return &v.state
@@ -7455,7 +8375,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountAllEnabledServersMatchRequest); i {
 			case 0:
 				return &v.state
@@ -7467,7 +8387,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListEnabledServersMatchRequest); i {
 			case 0:
 				return &v.state
@@ -7479,7 +8399,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListEnabledServersMatchResponse); i {
 			case 0:
 				return &v.state
@@ -7491,7 +8411,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteServerRequest); i {
 			case 0:
 				return &v.state
@@ -7503,7 +8423,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteServersRequest); i {
 			case 0:
 				return &v.state
@@ -7515,7 +8435,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerRequest); i {
 			case 0:
 				return &v.state
@@ -7527,7 +8447,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerResponse); i {
 			case 0:
 				return &v.state
@@ -7539,7 +8459,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerConfigRequest); i {
 			case 0:
 				return &v.state
@@ -7551,7 +8471,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerConfigResponse); i {
 			case 0:
 				return &v.state
@@ -7563,7 +8483,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerTypeRequest); i {
 			case 0:
 				return &v.state
@@ -7575,7 +8495,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerTypeResponse); i {
 			case 0:
 				return &v.state
@@ -7587,7 +8507,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAndInitServerReverseProxyConfigRequest); i {
 			case 0:
 				return &v.state
@@ -7599,7 +8519,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAndInitServerReverseProxyConfigResponse); i {
 			case 0:
 				return &v.state
@@ -7611,7 +8531,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAndInitServerWebConfigRequest); i {
 			case 0:
 				return &v.state
@@ -7623,7 +8543,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAndInitServerWebConfigResponse); i {
 			case 0:
 				return &v.state
@@ -7635,7 +8555,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountAllEnabledServersWithSSLCertIdRequest); i {
 			case 0:
 				return &v.state
@@ -7647,7 +8567,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllEnabledServersWithSSLCertIdRequest); i {
 			case 0:
 				return &v.state
@@ -7659,7 +8579,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllEnabledServersWithSSLCertIdResponse); i {
 			case 0:
 				return &v.state
@@ -7671,7 +8591,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountAllEnabledServersWithNodeClusterIdRequest); i {
 			case 0:
 				return &v.state
@@ -7683,7 +8603,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountAllEnabledServersWithServerGroupIdRequest); i {
 			case 0:
 				return &v.state
@@ -7695,7 +8615,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotifyServersChangeRequest); i {
 			case 0:
 				return &v.state
@@ -7707,7 +8627,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotifyServersChangeResponse); i {
 			case 0:
 				return &v.state
@@ -7719,7 +8639,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllEnabledServersDNSWithNodeClusterIdRequest); i {
 			case 0:
 				return &v.state
@@ -7731,7 +8651,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllEnabledServersDNSWithNodeClusterIdResponse); i {
 			case 0:
 				return &v.state
@@ -7743,7 +8663,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerDNSInfo); i {
 			case 0:
 				return &v.state
@@ -7755,7 +8675,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerDNSRequest); i {
 			case 0:
 				return &v.state
@@ -7767,7 +8687,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerDNSResponse); i {
 			case 0:
 				return &v.state
@@ -7779,7 +8699,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckUserServerRequest); i {
 			case 0:
 				return &v.state
@@ -7791,7 +8711,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllEnabledServerNamesWithUserIdRequest); i {
 			case 0:
 				return &v.state
@@ -7803,7 +8723,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllEnabledServerNamesWithUserIdResponse); i {
 			case 0:
 				return &v.state
@@ -7815,7 +8735,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountAllServerNamesWithUserIdRequest); i {
 			case 0:
 				return &v.state
@@ -7827,7 +8747,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountServerNamesRequest); i {
 			case 0:
 				return &v.state
@@ -7839,7 +8759,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllUserServersRequest); i {
 			case 0:
 				return &v.state
@@ -7851,7 +8771,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllUserServersResponse); i {
 			case 0:
 				return &v.state
@@ -7863,7 +8783,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountAllUserServersRequest); i {
 			case 0:
 				return &v.state
@@ -7875,7 +8795,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComposeAllUserServersConfigRequest); i {
 			case 0:
 				return &v.state
@@ -7887,7 +8807,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComposeAllUserServersConfigResponse); i {
 			case 0:
 				return &v.state
@@ -7899,7 +8819,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledUserServerBasicRequest); i {
 			case 0:
 				return &v.state
@@ -7911,7 +8831,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledUserServerBasicResponse); i {
 			case 0:
 				return &v.state
@@ -7923,7 +8843,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateEnabledUserServerBasicRequest); i {
 			case 0:
 				return &v.state
@@ -7935,7 +8855,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadServerHTTPRequestStatRequest); i {
 			case 0:
 				return &v.state
@@ -7947,7 +8867,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckServerNameDuplicationInNodeClusterRequest); i {
 			case 0:
 				return &v.state
@@ -7959,7 +8879,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckServerNameDuplicationInNodeClusterResponse); i {
 			case 0:
 				return &v.state
@@ -7971,7 +8891,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckServerNameInServerRequest); i {
 			case 0:
 				return &v.state
@@ -7983,7 +8903,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckServerNameInServerResponse); i {
 			case 0:
 				return &v.state
@@ -7995,7 +8915,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindLatestServersRequest); i {
 			case 0:
 				return &v.state
@@ -8007,7 +8927,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindLatestServersResponse); i {
 			case 0:
 				return &v.state
@@ -8019,7 +8939,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindNearbyServersRequest); i {
 			case 0:
 				return &v.state
@@ -8031,7 +8951,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindNearbyServersResponse); i {
 			case 0:
 				return &v.state
@@ -8043,7 +8963,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PurgeServerCacheRequest); i {
 			case 0:
 				return &v.state
@@ -8055,7 +8975,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PurgeServerCacheResponse); i {
 			case 0:
 				return &v.state
@@ -8067,7 +8987,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerTrafficLimitRequest); i {
 			case 0:
 				return &v.state
@@ -8079,7 +8999,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerTrafficLimitResponse); i {
 			case 0:
 				return &v.state
@@ -8091,7 +9011,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerTrafficLimitRequest); i {
 			case 0:
 				return &v.state
@@ -8103,7 +9023,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerUserPlanRequest); i {
 			case 0:
 				return &v.state
@@ -8115,7 +9035,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindServerUserPlanRequest); i {
 			case 0:
 				return &v.state
@@ -8127,7 +9047,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindServerUserPlanResponse); i {
 			case 0:
 				return &v.state
@@ -8139,7 +9059,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComposeServerConfigRequest); i {
 			case 0:
 				return &v.state
@@ -8151,7 +9071,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComposeServerConfigResponse); i {
 			case 0:
 				return &v.state
@@ -8163,7 +9083,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerUAMRequest); i {
 			case 0:
 				return &v.state
@@ -8175,7 +9095,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerUAMRequest); i {
 			case 0:
 				return &v.state
@@ -8187,7 +9107,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindEnabledServerUAMResponse); i {
 			case 0:
 				return &v.state
@@ -8199,7 +9119,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerUserRequest); i {
 			case 0:
 				return &v.state
@@ -8211,7 +9131,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateServerNameRequest); i {
 			case 0:
 				return &v.state
@@ -8223,7 +9143,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CopyServerConfigRequest); i {
 			case 0:
 				return &v.state
@@ -8235,7 +9155,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindServerAuditingPromptRequest); i {
 			case 0:
 				return &v.state
@@ -8247,7 +9167,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindServerAuditingPromptResponse); i {
 			case 0:
 				return &v.state
@@ -8259,7 +9179,103 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountAllServerChangeLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServerChangeLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServerChangeLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchServersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchServersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateServerExternalIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadServerHTTPRequestStatRequest_RegionCity); i {
 			case 0:
 				return &v.state
@@ -8271,7 +9287,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadServerHTTPRequestStatRequest_RegionProvider); i {
 			case 0:
 				return &v.state
@@ -8283,7 +9299,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadServerHTTPRequestStatRequest_System); i {
 			case 0:
 				return &v.state
@@ -8295,7 +9311,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadServerHTTPRequestStatRequest_Browser); i {
 			case 0:
 				return &v.state
@@ -8307,7 +9323,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadServerHTTPRequestStatRequest_HTTPFirewallRuleGroup); i {
 			case 0:
 				return &v.state
@@ -8319,7 +9335,7 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
-		file_service_server_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+		file_service_server_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindNearbyServersResponse_GroupInfo); i {
 			case 0:
 				return &v.state
@@ -8331,6 +9347,18 @@ func file_service_server_proto_init() {
 				return nil
 			}
 		}
+		file_service_server_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchServersResponse_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8338,7 +9366,7 @@ func file_service_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   100,
+			NumMessages:   111,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
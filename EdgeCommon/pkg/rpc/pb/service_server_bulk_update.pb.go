@@ -0,0 +1,705 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_server_bulk_update.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 预览批量修改的内容
+type PreviewServerBulkUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerBulkFilter *ServerBulkFilter `protobuf:"bytes,1,opt,name=serverBulkFilter,proto3" json:"serverBulkFilter,omitempty"` // 筛选条件
+	PatchJSON        []byte            `protobuf:"bytes,2,opt,name=patchJSON,proto3" json:"patchJSON,omitempty"`               // 修改内容，参考 models.ServerBulkPatch
+}
+
+func (x *PreviewServerBulkUpdateRequest) Reset() {
+	*x = PreviewServerBulkUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_bulk_update_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewServerBulkUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewServerBulkUpdateRequest) ProtoMessage() {}
+
+func (x *PreviewServerBulkUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_bulk_update_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewServerBulkUpdateRequest.ProtoReflect.Descriptor instead.
+func (*PreviewServerBulkUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_service_server_bulk_update_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreviewServerBulkUpdateRequest) GetServerBulkFilter() *ServerBulkFilter {
+	if x != nil {
+		return x.ServerBulkFilter
+	}
+	return nil
+}
+
+func (x *PreviewServerBulkUpdateRequest) GetPatchJSON() []byte {
+	if x != nil {
+		return x.PatchJSON
+	}
+	return nil
+}
+
+type PreviewServerBulkUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CountServers      int64               `protobuf:"varint,1,opt,name=countServers,proto3" json:"countServers,omitempty"`          // 符合条件的服务数量
+	ServerBulkChanges []*ServerBulkChange `protobuf:"bytes,2,rep,name=serverBulkChanges,proto3" json:"serverBulkChanges,omitempty"` // 将要修改的内容
+}
+
+func (x *PreviewServerBulkUpdateResponse) Reset() {
+	*x = PreviewServerBulkUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_bulk_update_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewServerBulkUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewServerBulkUpdateResponse) ProtoMessage() {}
+
+func (x *PreviewServerBulkUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_bulk_update_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewServerBulkUpdateResponse.ProtoReflect.Descriptor instead.
+func (*PreviewServerBulkUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_service_server_bulk_update_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreviewServerBulkUpdateResponse) GetCountServers() int64 {
+	if x != nil {
+		return x.CountServers
+	}
+	return 0
+}
+
+func (x *PreviewServerBulkUpdateResponse) GetServerBulkChanges() []*ServerBulkChange {
+	if x != nil {
+		return x.ServerBulkChanges
+	}
+	return nil
+}
+
+// 执行批量修改
+type CreateServerBulkUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerBulkFilter *ServerBulkFilter `protobuf:"bytes,1,opt,name=serverBulkFilter,proto3" json:"serverBulkFilter,omitempty"` // 筛选条件
+	PatchJSON        []byte            `protobuf:"bytes,2,opt,name=patchJSON,proto3" json:"patchJSON,omitempty"`               // 修改内容，参考 models.ServerBulkPatch
+}
+
+func (x *CreateServerBulkUpdateRequest) Reset() {
+	*x = CreateServerBulkUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_bulk_update_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateServerBulkUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServerBulkUpdateRequest) ProtoMessage() {}
+
+func (x *CreateServerBulkUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_bulk_update_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServerBulkUpdateRequest.ProtoReflect.Descriptor instead.
+func (*CreateServerBulkUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_service_server_bulk_update_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateServerBulkUpdateRequest) GetServerBulkFilter() *ServerBulkFilter {
+	if x != nil {
+		return x.ServerBulkFilter
+	}
+	return nil
+}
+
+func (x *CreateServerBulkUpdateRequest) GetPatchJSON() []byte {
+	if x != nil {
+		return x.PatchJSON
+	}
+	return nil
+}
+
+type CreateServerBulkUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerBulkUpdateId int64               `protobuf:"varint,1,opt,name=serverBulkUpdateId,proto3" json:"serverBulkUpdateId,omitempty"` // 记录ID，没有任何修改时为0
+	ServerBulkChanges  []*ServerBulkChange `protobuf:"bytes,2,rep,name=serverBulkChanges,proto3" json:"serverBulkChanges,omitempty"`    // 已修改的内容
+}
+
+func (x *CreateServerBulkUpdateResponse) Reset() {
+	*x = CreateServerBulkUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_bulk_update_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateServerBulkUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServerBulkUpdateResponse) ProtoMessage() {}
+
+func (x *CreateServerBulkUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_bulk_update_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServerBulkUpdateResponse.ProtoReflect.Descriptor instead.
+func (*CreateServerBulkUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_service_server_bulk_update_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateServerBulkUpdateResponse) GetServerBulkUpdateId() int64 {
+	if x != nil {
+		return x.ServerBulkUpdateId
+	}
+	return 0
+}
+
+func (x *CreateServerBulkUpdateResponse) GetServerBulkChanges() []*ServerBulkChange {
+	if x != nil {
+		return x.ServerBulkChanges
+	}
+	return nil
+}
+
+// 回滚批量修改
+type RollbackServerBulkUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerBulkUpdateId int64 `protobuf:"varint,1,opt,name=serverBulkUpdateId,proto3" json:"serverBulkUpdateId,omitempty"`
+}
+
+func (x *RollbackServerBulkUpdateRequest) Reset() {
+	*x = RollbackServerBulkUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_bulk_update_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackServerBulkUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackServerBulkUpdateRequest) ProtoMessage() {}
+
+func (x *RollbackServerBulkUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_bulk_update_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackServerBulkUpdateRequest.ProtoReflect.Descriptor instead.
+func (*RollbackServerBulkUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_service_server_bulk_update_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RollbackServerBulkUpdateRequest) GetServerBulkUpdateId() int64 {
+	if x != nil {
+		return x.ServerBulkUpdateId
+	}
+	return 0
+}
+
+// 计算批量修改记录数量
+type CountServerBulkUpdatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CountServerBulkUpdatesRequest) Reset() {
+	*x = CountServerBulkUpdatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_bulk_update_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountServerBulkUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountServerBulkUpdatesRequest) ProtoMessage() {}
+
+func (x *CountServerBulkUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_bulk_update_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountServerBulkUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*CountServerBulkUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_service_server_bulk_update_proto_rawDescGZIP(), []int{5}
+}
+
+// 列出单页批量修改记录
+type ListServerBulkUpdatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Size   int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListServerBulkUpdatesRequest) Reset() {
+	*x = ListServerBulkUpdatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_bulk_update_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServerBulkUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServerBulkUpdatesRequest) ProtoMessage() {}
+
+func (x *ListServerBulkUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_bulk_update_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServerBulkUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*ListServerBulkUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_service_server_bulk_update_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListServerBulkUpdatesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListServerBulkUpdatesRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListServerBulkUpdatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerBulkUpdates []*ServerBulkUpdate `protobuf:"bytes,1,rep,name=serverBulkUpdates,proto3" json:"serverBulkUpdates,omitempty"`
+}
+
+func (x *ListServerBulkUpdatesResponse) Reset() {
+	*x = ListServerBulkUpdatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_server_bulk_update_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServerBulkUpdatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServerBulkUpdatesResponse) ProtoMessage() {}
+
+func (x *ListServerBulkUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_server_bulk_update_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServerBulkUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*ListServerBulkUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_service_server_bulk_update_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListServerBulkUpdatesResponse) GetServerBulkUpdates() []*ServerBulkUpdate {
+	if x != nil {
+		return x.ServerBulkUpdates
+	}
+	return nil
+}
+
+var File_service_server_bulk_update_proto protoreflect.FileDescriptor
+
+var file_service_server_bulk_update_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x19, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x72,
+	0x70, 0x63, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x25, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x1e, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x89, 0x01, 0x0a, 0x1f,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c,
+	0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c,
+	0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x7f, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75,
+	0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x42, 0x75, 0x6c, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x94, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42,
+	0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x11, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x11, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22,
+	0x51, 0x0a, 0x1f, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x63, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c,
+	0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x42, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x32, 0xe0, 0x03, 0x0a, 0x17, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42,
+	0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x62, 0x0a, 0x17, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75,
+	0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x21,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x18, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x51, 0x0a, 0x16, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x6c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_server_bulk_update_proto_rawDescOnce sync.Once
+	file_service_server_bulk_update_proto_rawDescData = file_service_server_bulk_update_proto_rawDesc
+)
+
+func file_service_server_bulk_update_proto_rawDescGZIP() []byte {
+	file_service_server_bulk_update_proto_rawDescOnce.Do(func() {
+		file_service_server_bulk_update_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_server_bulk_update_proto_rawDescData)
+	})
+	return file_service_server_bulk_update_proto_rawDescData
+}
+
+var file_service_server_bulk_update_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_service_server_bulk_update_proto_goTypes = []interface{}{
+	(*PreviewServerBulkUpdateRequest)(nil),  // 0: pb.PreviewServerBulkUpdateRequest
+	(*PreviewServerBulkUpdateResponse)(nil), // 1: pb.PreviewServerBulkUpdateResponse
+	(*CreateServerBulkUpdateRequest)(nil),   // 2: pb.CreateServerBulkUpdateRequest
+	(*CreateServerBulkUpdateResponse)(nil),  // 3: pb.CreateServerBulkUpdateResponse
+	(*RollbackServerBulkUpdateRequest)(nil), // 4: pb.RollbackServerBulkUpdateRequest
+	(*CountServerBulkUpdatesRequest)(nil),   // 5: pb.CountServerBulkUpdatesRequest
+	(*ListServerBulkUpdatesRequest)(nil),    // 6: pb.ListServerBulkUpdatesRequest
+	(*ListServerBulkUpdatesResponse)(nil),   // 7: pb.ListServerBulkUpdatesResponse
+	(*ServerBulkFilter)(nil),                // 8: pb.ServerBulkFilter
+	(*ServerBulkChange)(nil),                // 9: pb.ServerBulkChange
+	(*ServerBulkUpdate)(nil),                // 10: pb.ServerBulkUpdate
+	(*RPCSuccess)(nil),                      // 11: pb.RPCSuccess
+	(*RPCCountResponse)(nil),                // 12: pb.RPCCountResponse
+}
+var file_service_server_bulk_update_proto_depIdxs = []int32{
+	8,  // 0: pb.PreviewServerBulkUpdateRequest.serverBulkFilter:type_name -> pb.ServerBulkFilter
+	9,  // 1: pb.PreviewServerBulkUpdateResponse.serverBulkChanges:type_name -> pb.ServerBulkChange
+	8,  // 2: pb.CreateServerBulkUpdateRequest.serverBulkFilter:type_name -> pb.ServerBulkFilter
+	9,  // 3: pb.CreateServerBulkUpdateResponse.serverBulkChanges:type_name -> pb.ServerBulkChange
+	10, // 4: pb.ListServerBulkUpdatesResponse.serverBulkUpdates:type_name -> pb.ServerBulkUpdate
+	0,  // 5: pb.ServerBulkUpdateService.previewServerBulkUpdate:input_type -> pb.PreviewServerBulkUpdateRequest
+	2,  // 6: pb.ServerBulkUpdateService.createServerBulkUpdate:input_type -> pb.CreateServerBulkUpdateRequest
+	4,  // 7: pb.ServerBulkUpdateService.rollbackServerBulkUpdate:input_type -> pb.RollbackServerBulkUpdateRequest
+	5,  // 8: pb.ServerBulkUpdateService.countServerBulkUpdates:input_type -> pb.CountServerBulkUpdatesRequest
+	6,  // 9: pb.ServerBulkUpdateService.listServerBulkUpdates:input_type -> pb.ListServerBulkUpdatesRequest
+	1,  // 10: pb.ServerBulkUpdateService.previewServerBulkUpdate:output_type -> pb.PreviewServerBulkUpdateResponse
+	3,  // 11: pb.ServerBulkUpdateService.createServerBulkUpdate:output_type -> pb.CreateServerBulkUpdateResponse
+	11, // 12: pb.ServerBulkUpdateService.rollbackServerBulkUpdate:output_type -> pb.RPCSuccess
+	12, // 13: pb.ServerBulkUpdateService.countServerBulkUpdates:output_type -> pb.RPCCountResponse
+	7,  // 14: pb.ServerBulkUpdateService.listServerBulkUpdates:output_type -> pb.ListServerBulkUpdatesResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_service_server_bulk_update_proto_init() }
+func file_service_server_bulk_update_proto_init() {
+	if File_service_server_bulk_update_proto != nil {
+		return
+	}
+	file_models_rpc_messages_proto_init()
+	file_models_model_server_bulk_update_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_server_bulk_update_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewServerBulkUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_bulk_update_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewServerBulkUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_bulk_update_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateServerBulkUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_bulk_update_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateServerBulkUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_bulk_update_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackServerBulkUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_bulk_update_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountServerBulkUpdatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_bulk_update_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServerBulkUpdatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_server_bulk_update_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServerBulkUpdatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_server_bulk_update_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_server_bulk_update_proto_goTypes,
+		DependencyIndexes: file_service_server_bulk_update_proto_depIdxs,
+		MessageInfos:      file_service_server_bulk_update_proto_msgTypes,
+	}.Build()
+	File_service_server_bulk_update_proto = out.File
+	file_service_server_bulk_update_proto_rawDesc = nil
+	file_service_server_bulk_update_proto_goTypes = nil
+	file_service_server_bulk_update_proto_depIdxs = nil
+}
@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_server_bulk_update.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ServerBulkUpdateService_PreviewServerBulkUpdate_FullMethodName  = "/pb.ServerBulkUpdateService/previewServerBulkUpdate"
+	ServerBulkUpdateService_CreateServerBulkUpdate_FullMethodName   = "/pb.ServerBulkUpdateService/createServerBulkUpdate"
+	ServerBulkUpdateService_RollbackServerBulkUpdate_FullMethodName = "/pb.ServerBulkUpdateService/rollbackServerBulkUpdate"
+	ServerBulkUpdateService_CountServerBulkUpdates_FullMethodName   = "/pb.ServerBulkUpdateService/countServerBulkUpdates"
+	ServerBulkUpdateService_ListServerBulkUpdates_FullMethodName    = "/pb.ServerBulkUpdateService/listServerBulkUpdates"
+)
+
+// ServerBulkUpdateServiceClient is the client API for ServerBulkUpdateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServerBulkUpdateServiceClient interface {
+	// 预览批量修改的内容
+	PreviewServerBulkUpdate(ctx context.Context, in *PreviewServerBulkUpdateRequest, opts ...grpc.CallOption) (*PreviewServerBulkUpdateResponse, error)
+	// 执行批量修改
+	CreateServerBulkUpdate(ctx context.Context, in *CreateServerBulkUpdateRequest, opts ...grpc.CallOption) (*CreateServerBulkUpdateResponse, error)
+	// 回滚批量修改
+	RollbackServerBulkUpdate(ctx context.Context, in *RollbackServerBulkUpdateRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 计算批量修改记录数量
+	CountServerBulkUpdates(ctx context.Context, in *CountServerBulkUpdatesRequest, opts ...grpc.CallOption) (*RPCCountResponse, error)
+	// 列出单页批量修改记录
+	ListServerBulkUpdates(ctx context.Context, in *ListServerBulkUpdatesRequest, opts ...grpc.CallOption) (*ListServerBulkUpdatesResponse, error)
+}
+
+type serverBulkUpdateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServerBulkUpdateServiceClient(cc grpc.ClientConnInterface) ServerBulkUpdateServiceClient {
+	return &serverBulkUpdateServiceClient{cc}
+}
+
+func (c *serverBulkUpdateServiceClient) PreviewServerBulkUpdate(ctx context.Context, in *PreviewServerBulkUpdateRequest, opts ...grpc.CallOption) (*PreviewServerBulkUpdateResponse, error) {
+	out := new(PreviewServerBulkUpdateResponse)
+	err := c.cc.Invoke(ctx, ServerBulkUpdateService_PreviewServerBulkUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverBulkUpdateServiceClient) CreateServerBulkUpdate(ctx context.Context, in *CreateServerBulkUpdateRequest, opts ...grpc.CallOption) (*CreateServerBulkUpdateResponse, error) {
+	out := new(CreateServerBulkUpdateResponse)
+	err := c.cc.Invoke(ctx, ServerBulkUpdateService_CreateServerBulkUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverBulkUpdateServiceClient) RollbackServerBulkUpdate(ctx context.Context, in *RollbackServerBulkUpdateRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, ServerBulkUpdateService_RollbackServerBulkUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverBulkUpdateServiceClient) CountServerBulkUpdates(ctx context.Context, in *CountServerBulkUpdatesRequest, opts ...grpc.CallOption) (*RPCCountResponse, error) {
+	out := new(RPCCountResponse)
+	err := c.cc.Invoke(ctx, ServerBulkUpdateService_CountServerBulkUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverBulkUpdateServiceClient) ListServerBulkUpdates(ctx context.Context, in *ListServerBulkUpdatesRequest, opts ...grpc.CallOption) (*ListServerBulkUpdatesResponse, error) {
+	out := new(ListServerBulkUpdatesResponse)
+	err := c.cc.Invoke(ctx, ServerBulkUpdateService_ListServerBulkUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServerBulkUpdateServiceServer is the server API for ServerBulkUpdateService service.
+// All implementations should embed UnimplementedServerBulkUpdateServiceServer
+// for forward compatibility
+type ServerBulkUpdateServiceServer interface {
+	// 预览批量修改的内容
+	PreviewServerBulkUpdate(context.Context, *PreviewServerBulkUpdateRequest) (*PreviewServerBulkUpdateResponse, error)
+	// 执行批量修改
+	CreateServerBulkUpdate(context.Context, *CreateServerBulkUpdateRequest) (*CreateServerBulkUpdateResponse, error)
+	// 回滚批量修改
+	RollbackServerBulkUpdate(context.Context, *RollbackServerBulkUpdateRequest) (*RPCSuccess, error)
+	// 计算批量修改记录数量
+	CountServerBulkUpdates(context.Context, *CountServerBulkUpdatesRequest) (*RPCCountResponse, error)
+	// 列出单页批量修改记录
+	ListServerBulkUpdates(context.Context, *ListServerBulkUpdatesRequest) (*ListServerBulkUpdatesResponse, error)
+}
+
+// UnimplementedServerBulkUpdateServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedServerBulkUpdateServiceServer struct {
+}
+
+func (UnimplementedServerBulkUpdateServiceServer) PreviewServerBulkUpdate(context.Context, *PreviewServerBulkUpdateRequest) (*PreviewServerBulkUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewServerBulkUpdate not implemented")
+}
+func (UnimplementedServerBulkUpdateServiceServer) CreateServerBulkUpdate(context.Context, *CreateServerBulkUpdateRequest) (*CreateServerBulkUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServerBulkUpdate not implemented")
+}
+func (UnimplementedServerBulkUpdateServiceServer) RollbackServerBulkUpdate(context.Context, *RollbackServerBulkUpdateRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackServerBulkUpdate not implemented")
+}
+func (UnimplementedServerBulkUpdateServiceServer) CountServerBulkUpdates(context.Context, *CountServerBulkUpdatesRequest) (*RPCCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountServerBulkUpdates not implemented")
+}
+func (UnimplementedServerBulkUpdateServiceServer) ListServerBulkUpdates(context.Context, *ListServerBulkUpdatesRequest) (*ListServerBulkUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServerBulkUpdates not implemented")
+}
+
+// UnsafeServerBulkUpdateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServerBulkUpdateServiceServer will
+// result in compilation errors.
+type UnsafeServerBulkUpdateServiceServer interface {
+	mustEmbedUnimplementedServerBulkUpdateServiceServer()
+}
+
+func RegisterServerBulkUpdateServiceServer(s grpc.ServiceRegistrar, srv ServerBulkUpdateServiceServer) {
+	s.RegisterService(&ServerBulkUpdateService_ServiceDesc, srv)
+}
+
+func _ServerBulkUpdateService_PreviewServerBulkUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewServerBulkUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerBulkUpdateServiceServer).PreviewServerBulkUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerBulkUpdateService_PreviewServerBulkUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerBulkUpdateServiceServer).PreviewServerBulkUpdate(ctx, req.(*PreviewServerBulkUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerBulkUpdateService_CreateServerBulkUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServerBulkUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerBulkUpdateServiceServer).CreateServerBulkUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerBulkUpdateService_CreateServerBulkUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerBulkUpdateServiceServer).CreateServerBulkUpdate(ctx, req.(*CreateServerBulkUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerBulkUpdateService_RollbackServerBulkUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackServerBulkUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerBulkUpdateServiceServer).RollbackServerBulkUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerBulkUpdateService_RollbackServerBulkUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerBulkUpdateServiceServer).RollbackServerBulkUpdate(ctx, req.(*RollbackServerBulkUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerBulkUpdateService_CountServerBulkUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountServerBulkUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerBulkUpdateServiceServer).CountServerBulkUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerBulkUpdateService_CountServerBulkUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerBulkUpdateServiceServer).CountServerBulkUpdates(ctx, req.(*CountServerBulkUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerBulkUpdateService_ListServerBulkUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServerBulkUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerBulkUpdateServiceServer).ListServerBulkUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerBulkUpdateService_ListServerBulkUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerBulkUpdateServiceServer).ListServerBulkUpdates(ctx, req.(*ListServerBulkUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServerBulkUpdateService_ServiceDesc is the grpc.ServiceDesc for ServerBulkUpdateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServerBulkUpdateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ServerBulkUpdateService",
+	HandlerType: (*ServerBulkUpdateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "previewServerBulkUpdate",
+			Handler:    _ServerBulkUpdateService_PreviewServerBulkUpdate_Handler,
+		},
+		{
+			MethodName: "createServerBulkUpdate",
+			Handler:    _ServerBulkUpdateService_CreateServerBulkUpdate_Handler,
+		},
+		{
+			MethodName: "rollbackServerBulkUpdate",
+			Handler:    _ServerBulkUpdateService_RollbackServerBulkUpdate_Handler,
+		},
+		{
+			MethodName: "countServerBulkUpdates",
+			Handler:    _ServerBulkUpdateService_CountServerBulkUpdates_Handler,
+		},
+		{
+			MethodName: "listServerBulkUpdates",
+			Handler:    _ServerBulkUpdateService_ListServerBulkUpdates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_server_bulk_update.proto",
+}
@@ -22,6 +22,7 @@ const (
 	ServerService_CreateServer_FullMethodName                              = "/pb.ServerService/createServer"
 	ServerService_CreateBasicHTTPServer_FullMethodName                     = "/pb.ServerService/createBasicHTTPServer"
 	ServerService_CreateBasicTCPServer_FullMethodName                      = "/pb.ServerService/createBasicTCPServer"
+	ServerService_CloneServer_FullMethodName                               = "/pb.ServerService/cloneServer"
 	ServerService_AddServerOrigin_FullMethodName                           = "/pb.ServerService/addServerOrigin"
 	ServerService_DeleteServerOrigin_FullMethodName                        = "/pb.ServerService/deleteServerOrigin"
 	ServerService_UpdateServerBasic_FullMethodName                         = "/pb.ServerService/updateServerBasic"
@@ -83,6 +84,11 @@ const (
 	ServerService_UpdateServerName_FullMethodName                          = "/pb.ServerService/updateServerName"
 	ServerService_CopyServerConfig_FullMethodName                          = "/pb.ServerService/copyServerConfig"
 	ServerService_FindServerAuditingPrompt_FullMethodName                  = "/pb.ServerService/findServerAuditingPrompt"
+	ServerService_CountAllServerChangeLogs_FullMethodName                  = "/pb.ServerService/countAllServerChangeLogs"
+	ServerService_ListServerChangeLogs_FullMethodName                      = "/pb.ServerService/listServerChangeLogs"
+	ServerService_SearchServers_FullMethodName                             = "/pb.ServerService/searchServers"
+	ServerService_LookupServer_FullMethodName                              = "/pb.ServerService/lookupServer"
+	ServerService_UpdateServerExternalId_FullMethodName                    = "/pb.ServerService/updateServerExternalId"
 )
 
 // ServerServiceClient is the client API for ServerService service.
@@ -95,6 +101,8 @@ type ServerServiceClient interface {
 	CreateBasicHTTPServer(ctx context.Context, in *CreateBasicHTTPServerRequest, opts ...grpc.CallOption) (*CreateBasicHTTPServerResponse, error)
 	// 快速创建基本的TCP网站
 	CreateBasicTCPServer(ctx context.Context, in *CreateBasicTCPServerRequest, opts ...grpc.CallOption) (*CreateBasicTCPServerResponse, error)
+	// 复制网站，使用新的域名
+	CloneServer(ctx context.Context, in *CloneServerRequest, opts ...grpc.CallOption) (*CloneServerResponse, error)
 	// 为网站添加源站
 	AddServerOrigin(ctx context.Context, in *AddServerOriginRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 	// 从网站中删除某个源站
@@ -217,6 +225,16 @@ type ServerServiceClient interface {
 	CopyServerConfig(ctx context.Context, in *CopyServerConfigRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 	// 获取域名审核时的提示文字
 	FindServerAuditingPrompt(ctx context.Context, in *FindServerAuditingPromptRequest, opts ...grpc.CallOption) (*FindServerAuditingPromptResponse, error)
+	// 计算网站变更记录数量
+	CountAllServerChangeLogs(ctx context.Context, in *CountAllServerChangeLogsRequest, opts ...grpc.CallOption) (*RPCCountResponse, error)
+	// 列出单页网站变更记录
+	ListServerChangeLogs(ctx context.Context, in *ListServerChangeLogsRequest, opts ...grpc.CallOption) (*ListServerChangeLogsResponse, error)
+	// 搜索网站，可以按名称、描述、域名、源站地址、证书域名和集群搜索
+	SearchServers(ctx context.Context, in *SearchServersRequest, opts ...grpc.CallOption) (*SearchServersResponse, error)
+	// 根据ID、外部ID或者名称查找网站，通常用于Terraform等自动化工具导入和读取已有网站
+	LookupServer(ctx context.Context, in *LookupServerRequest, opts ...grpc.CallOption) (*LookupServerResponse, error)
+	// 设置网站外部ID
+	UpdateServerExternalId(ctx context.Context, in *UpdateServerExternalIdRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 }
 
 type serverServiceClient struct {
@@ -254,6 +272,15 @@ func (c *serverServiceClient) CreateBasicTCPServer(ctx context.Context, in *Crea
 	return out, nil
 }
 
+func (c *serverServiceClient) CloneServer(ctx context.Context, in *CloneServerRequest, opts ...grpc.CallOption) (*CloneServerResponse, error) {
+	out := new(CloneServerResponse)
+	err := c.cc.Invoke(ctx, ServerService_CloneServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serverServiceClient) AddServerOrigin(ctx context.Context, in *AddServerOriginRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
 	out := new(RPCSuccess)
 	err := c.cc.Invoke(ctx, ServerService_AddServerOrigin_FullMethodName, in, out, opts...)
@@ -803,6 +830,51 @@ func (c *serverServiceClient) FindServerAuditingPrompt(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *serverServiceClient) CountAllServerChangeLogs(ctx context.Context, in *CountAllServerChangeLogsRequest, opts ...grpc.CallOption) (*RPCCountResponse, error) {
+	out := new(RPCCountResponse)
+	err := c.cc.Invoke(ctx, ServerService_CountAllServerChangeLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ListServerChangeLogs(ctx context.Context, in *ListServerChangeLogsRequest, opts ...grpc.CallOption) (*ListServerChangeLogsResponse, error) {
+	out := new(ListServerChangeLogsResponse)
+	err := c.cc.Invoke(ctx, ServerService_ListServerChangeLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) SearchServers(ctx context.Context, in *SearchServersRequest, opts ...grpc.CallOption) (*SearchServersResponse, error) {
+	out := new(SearchServersResponse)
+	err := c.cc.Invoke(ctx, ServerService_SearchServers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) LookupServer(ctx context.Context, in *LookupServerRequest, opts ...grpc.CallOption) (*LookupServerResponse, error) {
+	out := new(LookupServerResponse)
+	err := c.cc.Invoke(ctx, ServerService_LookupServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) UpdateServerExternalId(ctx context.Context, in *UpdateServerExternalIdRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, ServerService_UpdateServerExternalId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServerServiceServer is the server API for ServerService service.
 // All implementations should embed UnimplementedServerServiceServer
 // for forward compatibility
@@ -813,6 +885,8 @@ type ServerServiceServer interface {
 	CreateBasicHTTPServer(context.Context, *CreateBasicHTTPServerRequest) (*CreateBasicHTTPServerResponse, error)
 	// 快速创建基本的TCP网站
 	CreateBasicTCPServer(context.Context, *CreateBasicTCPServerRequest) (*CreateBasicTCPServerResponse, error)
+	// 复制网站，使用新的域名
+	CloneServer(context.Context, *CloneServerRequest) (*CloneServerResponse, error)
 	// 为网站添加源站
 	AddServerOrigin(context.Context, *AddServerOriginRequest) (*RPCSuccess, error)
 	// 从网站中删除某个源站
@@ -935,6 +1009,16 @@ type ServerServiceServer interface {
 	CopyServerConfig(context.Context, *CopyServerConfigRequest) (*RPCSuccess, error)
 	// 获取域名审核时的提示文字
 	FindServerAuditingPrompt(context.Context, *FindServerAuditingPromptRequest) (*FindServerAuditingPromptResponse, error)
+	// 计算网站变更记录数量
+	CountAllServerChangeLogs(context.Context, *CountAllServerChangeLogsRequest) (*RPCCountResponse, error)
+	// 列出单页网站变更记录
+	ListServerChangeLogs(context.Context, *ListServerChangeLogsRequest) (*ListServerChangeLogsResponse, error)
+	// 搜索网站，可以按名称、描述、域名、源站地址、证书域名和集群搜索
+	SearchServers(context.Context, *SearchServersRequest) (*SearchServersResponse, error)
+	// 根据ID、外部ID或者名称查找网站，通常用于Terraform等自动化工具导入和读取已有网站
+	LookupServer(context.Context, *LookupServerRequest) (*LookupServerResponse, error)
+	// 设置网站外部ID
+	UpdateServerExternalId(context.Context, *UpdateServerExternalIdRequest) (*RPCSuccess, error)
 }
 
 // UnimplementedServerServiceServer should be embedded to have forward compatible implementations.
@@ -950,6 +1034,9 @@ func (UnimplementedServerServiceServer) CreateBasicHTTPServer(context.Context, *
 func (UnimplementedServerServiceServer) CreateBasicTCPServer(context.Context, *CreateBasicTCPServerRequest) (*CreateBasicTCPServerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBasicTCPServer not implemented")
 }
+func (UnimplementedServerServiceServer) CloneServer(context.Context, *CloneServerRequest) (*CloneServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneServer not implemented")
+}
 func (UnimplementedServerServiceServer) AddServerOrigin(context.Context, *AddServerOriginRequest) (*RPCSuccess, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddServerOrigin not implemented")
 }
@@ -1133,6 +1220,21 @@ func (UnimplementedServerServiceServer) CopyServerConfig(context.Context, *CopyS
 func (UnimplementedServerServiceServer) FindServerAuditingPrompt(context.Context, *FindServerAuditingPromptRequest) (*FindServerAuditingPromptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindServerAuditingPrompt not implemented")
 }
+func (UnimplementedServerServiceServer) CountAllServerChangeLogs(context.Context, *CountAllServerChangeLogsRequest) (*RPCCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountAllServerChangeLogs not implemented")
+}
+func (UnimplementedServerServiceServer) ListServerChangeLogs(context.Context, *ListServerChangeLogsRequest) (*ListServerChangeLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServerChangeLogs not implemented")
+}
+func (UnimplementedServerServiceServer) SearchServers(context.Context, *SearchServersRequest) (*SearchServersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchServers not implemented")
+}
+func (UnimplementedServerServiceServer) LookupServer(context.Context, *LookupServerRequest) (*LookupServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupServer not implemented")
+}
+func (UnimplementedServerServiceServer) UpdateServerExternalId(context.Context, *UpdateServerExternalIdRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServerExternalId not implemented")
+}
 
 // UnsafeServerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ServerServiceServer will
@@ -1199,6 +1301,24 @@ func _ServerService_CreateBasicTCPServer_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServerService_CloneServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).CloneServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_CloneServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).CloneServer(ctx, req.(*CloneServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ServerService_AddServerOrigin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddServerOriginRequest)
 	if err := dec(in); err != nil {
@@ -2297,6 +2417,96 @@ func _ServerService_FindServerAuditingPrompt_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServerService_CountAllServerChangeLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountAllServerChangeLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).CountAllServerChangeLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_CountAllServerChangeLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).CountAllServerChangeLogs(ctx, req.(*CountAllServerChangeLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_ListServerChangeLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServerChangeLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).ListServerChangeLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_ListServerChangeLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).ListServerChangeLogs(ctx, req.(*ListServerChangeLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_SearchServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).SearchServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_SearchServers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).SearchServers(ctx, req.(*SearchServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_LookupServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).LookupServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_LookupServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).LookupServer(ctx, req.(*LookupServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_UpdateServerExternalId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServerExternalIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).UpdateServerExternalId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_UpdateServerExternalId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).UpdateServerExternalId(ctx, req.(*UpdateServerExternalIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ServerService_ServiceDesc is the grpc.ServiceDesc for ServerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2316,6 +2526,10 @@ var ServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "createBasicTCPServer",
 			Handler:    _ServerService_CreateBasicTCPServer_Handler,
 		},
+		{
+			MethodName: "cloneServer",
+			Handler:    _ServerService_CloneServer_Handler,
+		},
 		{
 			MethodName: "addServerOrigin",
 			Handler:    _ServerService_AddServerOrigin_Handler,
@@ -2560,6 +2774,26 @@ var ServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "findServerAuditingPrompt",
 			Handler:    _ServerService_FindServerAuditingPrompt_Handler,
 		},
+		{
+			MethodName: "countAllServerChangeLogs",
+			Handler:    _ServerService_CountAllServerChangeLogs_Handler,
+		},
+		{
+			MethodName: "listServerChangeLogs",
+			Handler:    _ServerService_ListServerChangeLogs_Handler,
+		},
+		{
+			MethodName: "searchServers",
+			Handler:    _ServerService_SearchServers_Handler,
+		},
+		{
+			MethodName: "lookupServer",
+			Handler:    _ServerService_LookupServer_Handler,
+		},
+		{
+			MethodName: "updateServerExternalId",
+			Handler:    _ServerService_UpdateServerExternalId_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_server.proto",
@@ -0,0 +1,1095 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_ssl_cert_deploy_target.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 创建部署目标
+type CreateSSLCertDeployTargetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SslCertId  int64  `protobuf:"varint,1,opt,name=sslCertId,proto3" json:"sslCertId,omitempty"`  // 证书ID
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`             // 名称
+	Type       string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`             // 部署目标类型：aliyunCDN, aliyunSLB, tencentCDN, qiniuCDN, sftp, webhook
+	ParamsJSON []byte `protobuf:"bytes,4,opt,name=paramsJSON,proto3" json:"paramsJSON,omitempty"` // 参数
+	IsOn       bool   `protobuf:"varint,5,opt,name=isOn,proto3" json:"isOn,omitempty"`            // 是否启用
+}
+
+func (x *CreateSSLCertDeployTargetRequest) Reset() {
+	*x = CreateSSLCertDeployTargetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSSLCertDeployTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSSLCertDeployTargetRequest) ProtoMessage() {}
+
+func (x *CreateSSLCertDeployTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSSLCertDeployTargetRequest.ProtoReflect.Descriptor instead.
+func (*CreateSSLCertDeployTargetRequest) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateSSLCertDeployTargetRequest) GetSslCertId() int64 {
+	if x != nil {
+		return x.SslCertId
+	}
+	return 0
+}
+
+func (x *CreateSSLCertDeployTargetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSSLCertDeployTargetRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateSSLCertDeployTargetRequest) GetParamsJSON() []byte {
+	if x != nil {
+		return x.ParamsJSON
+	}
+	return nil
+}
+
+func (x *CreateSSLCertDeployTargetRequest) GetIsOn() bool {
+	if x != nil {
+		return x.IsOn
+	}
+	return false
+}
+
+type CreateSSLCertDeployTargetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SslCertDeployTargetId int64 `protobuf:"varint,1,opt,name=sslCertDeployTargetId,proto3" json:"sslCertDeployTargetId,omitempty"`
+}
+
+func (x *CreateSSLCertDeployTargetResponse) Reset() {
+	*x = CreateSSLCertDeployTargetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSSLCertDeployTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSSLCertDeployTargetResponse) ProtoMessage() {}
+
+func (x *CreateSSLCertDeployTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSSLCertDeployTargetResponse.ProtoReflect.Descriptor instead.
+func (*CreateSSLCertDeployTargetResponse) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateSSLCertDeployTargetResponse) GetSslCertDeployTargetId() int64 {
+	if x != nil {
+		return x.SslCertDeployTargetId
+	}
+	return 0
+}
+
+// 修改部署目标
+type UpdateSSLCertDeployTargetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SslCertDeployTargetId int64  `protobuf:"varint,1,opt,name=sslCertDeployTargetId,proto3" json:"sslCertDeployTargetId,omitempty"`
+	Name                  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`             // 名称
+	ParamsJSON            []byte `protobuf:"bytes,3,opt,name=paramsJSON,proto3" json:"paramsJSON,omitempty"` // 参数，掩码后的值表示不修改
+	IsOn                  bool   `protobuf:"varint,4,opt,name=isOn,proto3" json:"isOn,omitempty"`            // 是否启用
+}
+
+func (x *UpdateSSLCertDeployTargetRequest) Reset() {
+	*x = UpdateSSLCertDeployTargetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSSLCertDeployTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSSLCertDeployTargetRequest) ProtoMessage() {}
+
+func (x *UpdateSSLCertDeployTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSSLCertDeployTargetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSSLCertDeployTargetRequest) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateSSLCertDeployTargetRequest) GetSslCertDeployTargetId() int64 {
+	if x != nil {
+		return x.SslCertDeployTargetId
+	}
+	return 0
+}
+
+func (x *UpdateSSLCertDeployTargetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSSLCertDeployTargetRequest) GetParamsJSON() []byte {
+	if x != nil {
+		return x.ParamsJSON
+	}
+	return nil
+}
+
+func (x *UpdateSSLCertDeployTargetRequest) GetIsOn() bool {
+	if x != nil {
+		return x.IsOn
+	}
+	return false
+}
+
+// 删除部署目标
+type DeleteSSLCertDeployTargetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SslCertDeployTargetId int64 `protobuf:"varint,1,opt,name=sslCertDeployTargetId,proto3" json:"sslCertDeployTargetId,omitempty"`
+}
+
+func (x *DeleteSSLCertDeployTargetRequest) Reset() {
+	*x = DeleteSSLCertDeployTargetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSSLCertDeployTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSSLCertDeployTargetRequest) ProtoMessage() {}
+
+func (x *DeleteSSLCertDeployTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSSLCertDeployTargetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSSLCertDeployTargetRequest) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteSSLCertDeployTargetRequest) GetSslCertDeployTargetId() int64 {
+	if x != nil {
+		return x.SslCertDeployTargetId
+	}
+	return 0
+}
+
+// 查找单个部署目标
+type FindSSLCertDeployTargetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SslCertDeployTargetId int64 `protobuf:"varint,1,opt,name=sslCertDeployTargetId,proto3" json:"sslCertDeployTargetId,omitempty"`
+}
+
+func (x *FindSSLCertDeployTargetRequest) Reset() {
+	*x = FindSSLCertDeployTargetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindSSLCertDeployTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSSLCertDeployTargetRequest) ProtoMessage() {}
+
+func (x *FindSSLCertDeployTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSSLCertDeployTargetRequest.ProtoReflect.Descriptor instead.
+func (*FindSSLCertDeployTargetRequest) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FindSSLCertDeployTargetRequest) GetSslCertDeployTargetId() int64 {
+	if x != nil {
+		return x.SslCertDeployTargetId
+	}
+	return 0
+}
+
+type FindSSLCertDeployTargetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SslCertDeployTarget *SSLCertDeployTarget `protobuf:"bytes,1,opt,name=sslCertDeployTarget,proto3" json:"sslCertDeployTarget,omitempty"`
+}
+
+func (x *FindSSLCertDeployTargetResponse) Reset() {
+	*x = FindSSLCertDeployTargetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindSSLCertDeployTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSSLCertDeployTargetResponse) ProtoMessage() {}
+
+func (x *FindSSLCertDeployTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSSLCertDeployTargetResponse.ProtoReflect.Descriptor instead.
+func (*FindSSLCertDeployTargetResponse) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindSSLCertDeployTargetResponse) GetSslCertDeployTarget() *SSLCertDeployTarget {
+	if x != nil {
+		return x.SslCertDeployTarget
+	}
+	return nil
+}
+
+// 查找证书的所有部署目标
+type FindAllSSLCertDeployTargetsWithSSLCertIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SslCertId int64 `protobuf:"varint,1,opt,name=sslCertId,proto3" json:"sslCertId,omitempty"`
+}
+
+func (x *FindAllSSLCertDeployTargetsWithSSLCertIdRequest) Reset() {
+	*x = FindAllSSLCertDeployTargetsWithSSLCertIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSSLCertDeployTargetsWithSSLCertIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSSLCertDeployTargetsWithSSLCertIdRequest) ProtoMessage() {}
+
+func (x *FindAllSSLCertDeployTargetsWithSSLCertIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSSLCertDeployTargetsWithSSLCertIdRequest.ProtoReflect.Descriptor instead.
+func (*FindAllSSLCertDeployTargetsWithSSLCertIdRequest) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FindAllSSLCertDeployTargetsWithSSLCertIdRequest) GetSslCertId() int64 {
+	if x != nil {
+		return x.SslCertId
+	}
+	return 0
+}
+
+type FindAllSSLCertDeployTargetsWithSSLCertIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SslCertDeployTargets []*SSLCertDeployTarget `protobuf:"bytes,1,rep,name=sslCertDeployTargets,proto3" json:"sslCertDeployTargets,omitempty"`
+}
+
+func (x *FindAllSSLCertDeployTargetsWithSSLCertIdResponse) Reset() {
+	*x = FindAllSSLCertDeployTargetsWithSSLCertIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSSLCertDeployTargetsWithSSLCertIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSSLCertDeployTargetsWithSSLCertIdResponse) ProtoMessage() {}
+
+func (x *FindAllSSLCertDeployTargetsWithSSLCertIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSSLCertDeployTargetsWithSSLCertIdResponse.ProtoReflect.Descriptor instead.
+func (*FindAllSSLCertDeployTargetsWithSSLCertIdResponse) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FindAllSSLCertDeployTargetsWithSSLCertIdResponse) GetSslCertDeployTargets() []*SSLCertDeployTarget {
+	if x != nil {
+		return x.SslCertDeployTargets
+	}
+	return nil
+}
+
+// 取得所有部署目标类型
+type FindAllSSLCertDeployTargetTypesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindAllSSLCertDeployTargetTypesRequest) Reset() {
+	*x = FindAllSSLCertDeployTargetTypesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSSLCertDeployTargetTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSSLCertDeployTargetTypesRequest) ProtoMessage() {}
+
+func (x *FindAllSSLCertDeployTargetTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSSLCertDeployTargetTypesRequest.ProtoReflect.Descriptor instead.
+func (*FindAllSSLCertDeployTargetTypesRequest) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{8}
+}
+
+type FindAllSSLCertDeployTargetTypesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Types []*FindAllSSLCertDeployTargetTypesResponse_Type `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (x *FindAllSSLCertDeployTargetTypesResponse) Reset() {
+	*x = FindAllSSLCertDeployTargetTypesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSSLCertDeployTargetTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSSLCertDeployTargetTypesResponse) ProtoMessage() {}
+
+func (x *FindAllSSLCertDeployTargetTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSSLCertDeployTargetTypesResponse.ProtoReflect.Descriptor instead.
+func (*FindAllSSLCertDeployTargetTypesResponse) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FindAllSSLCertDeployTargetTypesResponse) GetTypes() []*FindAllSSLCertDeployTargetTypesResponse_Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// 立即部署证书到某个目标
+type DeploySSLCertDeployTargetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SslCertDeployTargetId int64 `protobuf:"varint,1,opt,name=sslCertDeployTargetId,proto3" json:"sslCertDeployTargetId,omitempty"`
+}
+
+func (x *DeploySSLCertDeployTargetRequest) Reset() {
+	*x = DeploySSLCertDeployTargetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeploySSLCertDeployTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploySSLCertDeployTargetRequest) ProtoMessage() {}
+
+func (x *DeploySSLCertDeployTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploySSLCertDeployTargetRequest.ProtoReflect.Descriptor instead.
+func (*DeploySSLCertDeployTargetRequest) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeploySSLCertDeployTargetRequest) GetSslCertDeployTargetId() int64 {
+	if x != nil {
+		return x.SslCertDeployTargetId
+	}
+	return 0
+}
+
+type DeploySSLCertDeployTargetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsOk  bool   `protobuf:"varint,1,opt,name=isOk,proto3" json:"isOk,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DeploySSLCertDeployTargetResponse) Reset() {
+	*x = DeploySSLCertDeployTargetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeploySSLCertDeployTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploySSLCertDeployTargetResponse) ProtoMessage() {}
+
+func (x *DeploySSLCertDeployTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploySSLCertDeployTargetResponse.ProtoReflect.Descriptor instead.
+func (*DeploySSLCertDeployTargetResponse) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeploySSLCertDeployTargetResponse) GetIsOk() bool {
+	if x != nil {
+		return x.IsOk
+	}
+	return false
+}
+
+func (x *DeploySSLCertDeployTargetResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type FindAllSSLCertDeployTargetTypesResponse_Type struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code        string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *FindAllSSLCertDeployTargetTypesResponse_Type) Reset() {
+	*x = FindAllSSLCertDeployTargetTypesResponse_Type{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSSLCertDeployTargetTypesResponse_Type) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSSLCertDeployTargetTypesResponse_Type) ProtoMessage() {}
+
+func (x *FindAllSSLCertDeployTargetTypesResponse_Type) ProtoReflect() protoreflect.Message {
+	mi := &file_service_ssl_cert_deploy_target_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSSLCertDeployTargetTypesResponse_Type.ProtoReflect.Descriptor instead.
+func (*FindAllSSLCertDeployTargetTypesResponse_Type) Descriptor() ([]byte, []int) {
+	return file_service_ssl_cert_deploy_target_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *FindAllSSLCertDeployTargetTypesResponse_Type) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FindAllSSLCertDeployTargetTypesResponse_Type) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *FindAllSSLCertDeployTargetTypesResponse_Type) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_service_ssl_cert_deploy_target_proto protoreflect.FileDescriptor
+
+var file_service_ssl_cert_deploy_target_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x73, 0x6c, 0x5f, 0x63, 0x65,
+	0x72, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x19, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x29, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x5f, 0x73, 0x73, 0x6c, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x9c, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65,
+	0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72,
+	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x73, 0x4f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x22,
+	0x59, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x15, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x20, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x34, 0x0a, 0x15, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15,
+	0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x22, 0x58, 0x0a,
+	0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x15, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x53,
+	0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x73, 0x6c,
+	0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22,
+	0x6c, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x13, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x13, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x4f, 0x0a,
+	0x2f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x22, 0x7f,
+	0x0a, 0x30, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x14, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x14, 0x73, 0x73, 0x6c, 0x43, 0x65,
+	0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22,
+	0x28, 0x0a, 0x26, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x27, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x50, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x58, 0x0a, 0x20, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x15, 0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x21, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73,
+	0x4f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x8e, 0x06, 0x0a, 0x1a, 0x53, 0x53, 0x4c,
+	0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x51, 0x0a, 0x19, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65,
+	0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x24,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x51, 0x0a, 0x19, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x53,
+	0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x53, 0x4c,
+	0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x62, 0x0a, 0x17, 0x66, 0x69, 0x6e, 0x64, 0x53,
+	0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x53, 0x4c, 0x43,
+	0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x28,
+	0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x12, 0x33, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4c,
+	0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x1f, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x53,
+	0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x41, 0x6c, 0x6c, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53,
+	0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x68, 0x0a, 0x19, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x24, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x53, 0x4c, 0x43, 0x65, 0x72, 0x74, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x53,
+	0x4c, 0x43, 0x65, 0x72, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_ssl_cert_deploy_target_proto_rawDescOnce sync.Once
+	file_service_ssl_cert_deploy_target_proto_rawDescData = file_service_ssl_cert_deploy_target_proto_rawDesc
+)
+
+func file_service_ssl_cert_deploy_target_proto_rawDescGZIP() []byte {
+	file_service_ssl_cert_deploy_target_proto_rawDescOnce.Do(func() {
+		file_service_ssl_cert_deploy_target_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_ssl_cert_deploy_target_proto_rawDescData)
+	})
+	return file_service_ssl_cert_deploy_target_proto_rawDescData
+}
+
+var file_service_ssl_cert_deploy_target_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_service_ssl_cert_deploy_target_proto_goTypes = []interface{}{
+	(*CreateSSLCertDeployTargetRequest)(nil),                 // 0: pb.CreateSSLCertDeployTargetRequest
+	(*CreateSSLCertDeployTargetResponse)(nil),                // 1: pb.CreateSSLCertDeployTargetResponse
+	(*UpdateSSLCertDeployTargetRequest)(nil),                 // 2: pb.UpdateSSLCertDeployTargetRequest
+	(*DeleteSSLCertDeployTargetRequest)(nil),                 // 3: pb.DeleteSSLCertDeployTargetRequest
+	(*FindSSLCertDeployTargetRequest)(nil),                   // 4: pb.FindSSLCertDeployTargetRequest
+	(*FindSSLCertDeployTargetResponse)(nil),                  // 5: pb.FindSSLCertDeployTargetResponse
+	(*FindAllSSLCertDeployTargetsWithSSLCertIdRequest)(nil),  // 6: pb.FindAllSSLCertDeployTargetsWithSSLCertIdRequest
+	(*FindAllSSLCertDeployTargetsWithSSLCertIdResponse)(nil), // 7: pb.FindAllSSLCertDeployTargetsWithSSLCertIdResponse
+	(*FindAllSSLCertDeployTargetTypesRequest)(nil),           // 8: pb.FindAllSSLCertDeployTargetTypesRequest
+	(*FindAllSSLCertDeployTargetTypesResponse)(nil),          // 9: pb.FindAllSSLCertDeployTargetTypesResponse
+	(*DeploySSLCertDeployTargetRequest)(nil),                 // 10: pb.DeploySSLCertDeployTargetRequest
+	(*DeploySSLCertDeployTargetResponse)(nil),                // 11: pb.DeploySSLCertDeployTargetResponse
+	(*FindAllSSLCertDeployTargetTypesResponse_Type)(nil),     // 12: pb.FindAllSSLCertDeployTargetTypesResponse.Type
+	(*SSLCertDeployTarget)(nil),                              // 13: pb.SSLCertDeployTarget
+	(*RPCSuccess)(nil),                                       // 14: pb.RPCSuccess
+}
+var file_service_ssl_cert_deploy_target_proto_depIdxs = []int32{
+	13, // 0: pb.FindSSLCertDeployTargetResponse.sslCertDeployTarget:type_name -> pb.SSLCertDeployTarget
+	13, // 1: pb.FindAllSSLCertDeployTargetsWithSSLCertIdResponse.sslCertDeployTargets:type_name -> pb.SSLCertDeployTarget
+	12, // 2: pb.FindAllSSLCertDeployTargetTypesResponse.types:type_name -> pb.FindAllSSLCertDeployTargetTypesResponse.Type
+	0,  // 3: pb.SSLCertDeployTargetService.createSSLCertDeployTarget:input_type -> pb.CreateSSLCertDeployTargetRequest
+	2,  // 4: pb.SSLCertDeployTargetService.updateSSLCertDeployTarget:input_type -> pb.UpdateSSLCertDeployTargetRequest
+	3,  // 5: pb.SSLCertDeployTargetService.deleteSSLCertDeployTarget:input_type -> pb.DeleteSSLCertDeployTargetRequest
+	4,  // 6: pb.SSLCertDeployTargetService.findSSLCertDeployTarget:input_type -> pb.FindSSLCertDeployTargetRequest
+	6,  // 7: pb.SSLCertDeployTargetService.findAllSSLCertDeployTargetsWithSSLCertId:input_type -> pb.FindAllSSLCertDeployTargetsWithSSLCertIdRequest
+	8,  // 8: pb.SSLCertDeployTargetService.findAllSSLCertDeployTargetTypes:input_type -> pb.FindAllSSLCertDeployTargetTypesRequest
+	10, // 9: pb.SSLCertDeployTargetService.deploySSLCertDeployTarget:input_type -> pb.DeploySSLCertDeployTargetRequest
+	1,  // 10: pb.SSLCertDeployTargetService.createSSLCertDeployTarget:output_type -> pb.CreateSSLCertDeployTargetResponse
+	14, // 11: pb.SSLCertDeployTargetService.updateSSLCertDeployTarget:output_type -> pb.RPCSuccess
+	14, // 12: pb.SSLCertDeployTargetService.deleteSSLCertDeployTarget:output_type -> pb.RPCSuccess
+	5,  // 13: pb.SSLCertDeployTargetService.findSSLCertDeployTarget:output_type -> pb.FindSSLCertDeployTargetResponse
+	7,  // 14: pb.SSLCertDeployTargetService.findAllSSLCertDeployTargetsWithSSLCertId:output_type -> pb.FindAllSSLCertDeployTargetsWithSSLCertIdResponse
+	9,  // 15: pb.SSLCertDeployTargetService.findAllSSLCertDeployTargetTypes:output_type -> pb.FindAllSSLCertDeployTargetTypesResponse
+	11, // 16: pb.SSLCertDeployTargetService.deploySSLCertDeployTarget:output_type -> pb.DeploySSLCertDeployTargetResponse
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_service_ssl_cert_deploy_target_proto_init() }
+func file_service_ssl_cert_deploy_target_proto_init() {
+	if File_service_ssl_cert_deploy_target_proto != nil {
+		return
+	}
+	file_models_rpc_messages_proto_init()
+	file_models_model_ssl_cert_deploy_target_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_ssl_cert_deploy_target_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSSLCertDeployTargetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSSLCertDeployTargetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSSLCertDeployTargetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSSLCertDeployTargetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindSSLCertDeployTargetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindSSLCertDeployTargetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllSSLCertDeployTargetsWithSSLCertIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllSSLCertDeployTargetsWithSSLCertIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllSSLCertDeployTargetTypesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllSSLCertDeployTargetTypesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeploySSLCertDeployTargetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeploySSLCertDeployTargetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_ssl_cert_deploy_target_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllSSLCertDeployTargetTypesResponse_Type); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_ssl_cert_deploy_target_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_ssl_cert_deploy_target_proto_goTypes,
+		DependencyIndexes: file_service_ssl_cert_deploy_target_proto_depIdxs,
+		MessageInfos:      file_service_ssl_cert_deploy_target_proto_msgTypes,
+	}.Build()
+	File_service_ssl_cert_deploy_target_proto = out.File
+	file_service_ssl_cert_deploy_target_proto_rawDesc = nil
+	file_service_ssl_cert_deploy_target_proto_goTypes = nil
+	file_service_ssl_cert_deploy_target_proto_depIdxs = nil
+}
@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_ssl_cert_deploy_target.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SSLCertDeployTargetService_CreateSSLCertDeployTarget_FullMethodName                = "/pb.SSLCertDeployTargetService/createSSLCertDeployTarget"
+	SSLCertDeployTargetService_UpdateSSLCertDeployTarget_FullMethodName                = "/pb.SSLCertDeployTargetService/updateSSLCertDeployTarget"
+	SSLCertDeployTargetService_DeleteSSLCertDeployTarget_FullMethodName                = "/pb.SSLCertDeployTargetService/deleteSSLCertDeployTarget"
+	SSLCertDeployTargetService_FindSSLCertDeployTarget_FullMethodName                  = "/pb.SSLCertDeployTargetService/findSSLCertDeployTarget"
+	SSLCertDeployTargetService_FindAllSSLCertDeployTargetsWithSSLCertId_FullMethodName = "/pb.SSLCertDeployTargetService/findAllSSLCertDeployTargetsWithSSLCertId"
+	SSLCertDeployTargetService_FindAllSSLCertDeployTargetTypes_FullMethodName          = "/pb.SSLCertDeployTargetService/findAllSSLCertDeployTargetTypes"
+	SSLCertDeployTargetService_DeploySSLCertDeployTarget_FullMethodName                = "/pb.SSLCertDeployTargetService/deploySSLCertDeployTarget"
+)
+
+// SSLCertDeployTargetServiceClient is the client API for SSLCertDeployTargetService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SSLCertDeployTargetServiceClient interface {
+	// 创建部署目标
+	CreateSSLCertDeployTarget(ctx context.Context, in *CreateSSLCertDeployTargetRequest, opts ...grpc.CallOption) (*CreateSSLCertDeployTargetResponse, error)
+	// 修改部署目标
+	UpdateSSLCertDeployTarget(ctx context.Context, in *UpdateSSLCertDeployTargetRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 删除部署目标
+	DeleteSSLCertDeployTarget(ctx context.Context, in *DeleteSSLCertDeployTargetRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 查找单个部署目标
+	FindSSLCertDeployTarget(ctx context.Context, in *FindSSLCertDeployTargetRequest, opts ...grpc.CallOption) (*FindSSLCertDeployTargetResponse, error)
+	// 查找证书的所有部署目标
+	FindAllSSLCertDeployTargetsWithSSLCertId(ctx context.Context, in *FindAllSSLCertDeployTargetsWithSSLCertIdRequest, opts ...grpc.CallOption) (*FindAllSSLCertDeployTargetsWithSSLCertIdResponse, error)
+	// 取得所有部署目标类型
+	FindAllSSLCertDeployTargetTypes(ctx context.Context, in *FindAllSSLCertDeployTargetTypesRequest, opts ...grpc.CallOption) (*FindAllSSLCertDeployTargetTypesResponse, error)
+	// 立即部署证书到某个目标
+	DeploySSLCertDeployTarget(ctx context.Context, in *DeploySSLCertDeployTargetRequest, opts ...grpc.CallOption) (*DeploySSLCertDeployTargetResponse, error)
+}
+
+type sSLCertDeployTargetServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSSLCertDeployTargetServiceClient(cc grpc.ClientConnInterface) SSLCertDeployTargetServiceClient {
+	return &sSLCertDeployTargetServiceClient{cc}
+}
+
+func (c *sSLCertDeployTargetServiceClient) CreateSSLCertDeployTarget(ctx context.Context, in *CreateSSLCertDeployTargetRequest, opts ...grpc.CallOption) (*CreateSSLCertDeployTargetResponse, error) {
+	out := new(CreateSSLCertDeployTargetResponse)
+	err := c.cc.Invoke(ctx, SSLCertDeployTargetService_CreateSSLCertDeployTarget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertDeployTargetServiceClient) UpdateSSLCertDeployTarget(ctx context.Context, in *UpdateSSLCertDeployTargetRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, SSLCertDeployTargetService_UpdateSSLCertDeployTarget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertDeployTargetServiceClient) DeleteSSLCertDeployTarget(ctx context.Context, in *DeleteSSLCertDeployTargetRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, SSLCertDeployTargetService_DeleteSSLCertDeployTarget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertDeployTargetServiceClient) FindSSLCertDeployTarget(ctx context.Context, in *FindSSLCertDeployTargetRequest, opts ...grpc.CallOption) (*FindSSLCertDeployTargetResponse, error) {
+	out := new(FindSSLCertDeployTargetResponse)
+	err := c.cc.Invoke(ctx, SSLCertDeployTargetService_FindSSLCertDeployTarget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertDeployTargetServiceClient) FindAllSSLCertDeployTargetsWithSSLCertId(ctx context.Context, in *FindAllSSLCertDeployTargetsWithSSLCertIdRequest, opts ...grpc.CallOption) (*FindAllSSLCertDeployTargetsWithSSLCertIdResponse, error) {
+	out := new(FindAllSSLCertDeployTargetsWithSSLCertIdResponse)
+	err := c.cc.Invoke(ctx, SSLCertDeployTargetService_FindAllSSLCertDeployTargetsWithSSLCertId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertDeployTargetServiceClient) FindAllSSLCertDeployTargetTypes(ctx context.Context, in *FindAllSSLCertDeployTargetTypesRequest, opts ...grpc.CallOption) (*FindAllSSLCertDeployTargetTypesResponse, error) {
+	out := new(FindAllSSLCertDeployTargetTypesResponse)
+	err := c.cc.Invoke(ctx, SSLCertDeployTargetService_FindAllSSLCertDeployTargetTypes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertDeployTargetServiceClient) DeploySSLCertDeployTarget(ctx context.Context, in *DeploySSLCertDeployTargetRequest, opts ...grpc.CallOption) (*DeploySSLCertDeployTargetResponse, error) {
+	out := new(DeploySSLCertDeployTargetResponse)
+	err := c.cc.Invoke(ctx, SSLCertDeployTargetService_DeploySSLCertDeployTarget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SSLCertDeployTargetServiceServer is the server API for SSLCertDeployTargetService service.
+// All implementations should embed UnimplementedSSLCertDeployTargetServiceServer
+// for forward compatibility
+type SSLCertDeployTargetServiceServer interface {
+	// 创建部署目标
+	CreateSSLCertDeployTarget(context.Context, *CreateSSLCertDeployTargetRequest) (*CreateSSLCertDeployTargetResponse, error)
+	// 修改部署目标
+	UpdateSSLCertDeployTarget(context.Context, *UpdateSSLCertDeployTargetRequest) (*RPCSuccess, error)
+	// 删除部署目标
+	DeleteSSLCertDeployTarget(context.Context, *DeleteSSLCertDeployTargetRequest) (*RPCSuccess, error)
+	// 查找单个部署目标
+	FindSSLCertDeployTarget(context.Context, *FindSSLCertDeployTargetRequest) (*FindSSLCertDeployTargetResponse, error)
+	// 查找证书的所有部署目标
+	FindAllSSLCertDeployTargetsWithSSLCertId(context.Context, *FindAllSSLCertDeployTargetsWithSSLCertIdRequest) (*FindAllSSLCertDeployTargetsWithSSLCertIdResponse, error)
+	// 取得所有部署目标类型
+	FindAllSSLCertDeployTargetTypes(context.Context, *FindAllSSLCertDeployTargetTypesRequest) (*FindAllSSLCertDeployTargetTypesResponse, error)
+	// 立即部署证书到某个目标
+	DeploySSLCertDeployTarget(context.Context, *DeploySSLCertDeployTargetRequest) (*DeploySSLCertDeployTargetResponse, error)
+}
+
+// UnimplementedSSLCertDeployTargetServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedSSLCertDeployTargetServiceServer struct {
+}
+
+func (UnimplementedSSLCertDeployTargetServiceServer) CreateSSLCertDeployTarget(context.Context, *CreateSSLCertDeployTargetRequest) (*CreateSSLCertDeployTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSSLCertDeployTarget not implemented")
+}
+func (UnimplementedSSLCertDeployTargetServiceServer) UpdateSSLCertDeployTarget(context.Context, *UpdateSSLCertDeployTargetRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSSLCertDeployTarget not implemented")
+}
+func (UnimplementedSSLCertDeployTargetServiceServer) DeleteSSLCertDeployTarget(context.Context, *DeleteSSLCertDeployTargetRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSSLCertDeployTarget not implemented")
+}
+func (UnimplementedSSLCertDeployTargetServiceServer) FindSSLCertDeployTarget(context.Context, *FindSSLCertDeployTargetRequest) (*FindSSLCertDeployTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSSLCertDeployTarget not implemented")
+}
+func (UnimplementedSSLCertDeployTargetServiceServer) FindAllSSLCertDeployTargetsWithSSLCertId(context.Context, *FindAllSSLCertDeployTargetsWithSSLCertIdRequest) (*FindAllSSLCertDeployTargetsWithSSLCertIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllSSLCertDeployTargetsWithSSLCertId not implemented")
+}
+func (UnimplementedSSLCertDeployTargetServiceServer) FindAllSSLCertDeployTargetTypes(context.Context, *FindAllSSLCertDeployTargetTypesRequest) (*FindAllSSLCertDeployTargetTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllSSLCertDeployTargetTypes not implemented")
+}
+func (UnimplementedSSLCertDeployTargetServiceServer) DeploySSLCertDeployTarget(context.Context, *DeploySSLCertDeployTargetRequest) (*DeploySSLCertDeployTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploySSLCertDeployTarget not implemented")
+}
+
+// UnsafeSSLCertDeployTargetServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SSLCertDeployTargetServiceServer will
+// result in compilation errors.
+type UnsafeSSLCertDeployTargetServiceServer interface {
+	mustEmbedUnimplementedSSLCertDeployTargetServiceServer()
+}
+
+func RegisterSSLCertDeployTargetServiceServer(s grpc.ServiceRegistrar, srv SSLCertDeployTargetServiceServer) {
+	s.RegisterService(&SSLCertDeployTargetService_ServiceDesc, srv)
+}
+
+func _SSLCertDeployTargetService_CreateSSLCertDeployTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSSLCertDeployTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertDeployTargetServiceServer).CreateSSLCertDeployTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertDeployTargetService_CreateSSLCertDeployTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertDeployTargetServiceServer).CreateSSLCertDeployTarget(ctx, req.(*CreateSSLCertDeployTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertDeployTargetService_UpdateSSLCertDeployTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSSLCertDeployTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertDeployTargetServiceServer).UpdateSSLCertDeployTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertDeployTargetService_UpdateSSLCertDeployTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertDeployTargetServiceServer).UpdateSSLCertDeployTarget(ctx, req.(*UpdateSSLCertDeployTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertDeployTargetService_DeleteSSLCertDeployTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSSLCertDeployTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertDeployTargetServiceServer).DeleteSSLCertDeployTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertDeployTargetService_DeleteSSLCertDeployTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertDeployTargetServiceServer).DeleteSSLCertDeployTarget(ctx, req.(*DeleteSSLCertDeployTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertDeployTargetService_FindSSLCertDeployTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSSLCertDeployTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertDeployTargetServiceServer).FindSSLCertDeployTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertDeployTargetService_FindSSLCertDeployTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertDeployTargetServiceServer).FindSSLCertDeployTarget(ctx, req.(*FindSSLCertDeployTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertDeployTargetService_FindAllSSLCertDeployTargetsWithSSLCertId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllSSLCertDeployTargetsWithSSLCertIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertDeployTargetServiceServer).FindAllSSLCertDeployTargetsWithSSLCertId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertDeployTargetService_FindAllSSLCertDeployTargetsWithSSLCertId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertDeployTargetServiceServer).FindAllSSLCertDeployTargetsWithSSLCertId(ctx, req.(*FindAllSSLCertDeployTargetsWithSSLCertIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertDeployTargetService_FindAllSSLCertDeployTargetTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllSSLCertDeployTargetTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertDeployTargetServiceServer).FindAllSSLCertDeployTargetTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertDeployTargetService_FindAllSSLCertDeployTargetTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertDeployTargetServiceServer).FindAllSSLCertDeployTargetTypes(ctx, req.(*FindAllSSLCertDeployTargetTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertDeployTargetService_DeploySSLCertDeployTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploySSLCertDeployTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertDeployTargetServiceServer).DeploySSLCertDeployTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertDeployTargetService_DeploySSLCertDeployTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertDeployTargetServiceServer).DeploySSLCertDeployTarget(ctx, req.(*DeploySSLCertDeployTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SSLCertDeployTargetService_ServiceDesc is the grpc.ServiceDesc for SSLCertDeployTargetService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SSLCertDeployTargetService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.SSLCertDeployTargetService",
+	HandlerType: (*SSLCertDeployTargetServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "createSSLCertDeployTarget",
+			Handler:    _SSLCertDeployTargetService_CreateSSLCertDeployTarget_Handler,
+		},
+		{
+			MethodName: "updateSSLCertDeployTarget",
+			Handler:    _SSLCertDeployTargetService_UpdateSSLCertDeployTarget_Handler,
+		},
+		{
+			MethodName: "deleteSSLCertDeployTarget",
+			Handler:    _SSLCertDeployTargetService_DeleteSSLCertDeployTarget_Handler,
+		},
+		{
+			MethodName: "findSSLCertDeployTarget",
+			Handler:    _SSLCertDeployTargetService_FindSSLCertDeployTarget_Handler,
+		},
+		{
+			MethodName: "findAllSSLCertDeployTargetsWithSSLCertId",
+			Handler:    _SSLCertDeployTargetService_FindAllSSLCertDeployTargetsWithSSLCertId_Handler,
+		},
+		{
+			MethodName: "findAllSSLCertDeployTargetTypes",
+			Handler:    _SSLCertDeployTargetService_FindAllSSLCertDeployTargetTypes_Handler,
+		},
+		{
+			MethodName: "deploySSLCertDeployTarget",
+			Handler:    _SSLCertDeployTargetService_DeploySSLCertDeployTarget_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_ssl_cert_deploy_target.proto",
+}
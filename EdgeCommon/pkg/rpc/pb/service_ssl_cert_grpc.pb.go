@@ -19,20 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SSLCertService_CreateSSLCert_FullMethodName                 = "/pb.SSLCertService/createSSLCert"
-	SSLCertService_CreateSSLCerts_FullMethodName                = "/pb.SSLCertService/createSSLCerts"
-	SSLCertService_UpdateSSLCert_FullMethodName                 = "/pb.SSLCertService/updateSSLCert"
-	SSLCertService_DeleteSSLCert_FullMethodName                 = "/pb.SSLCertService/deleteSSLCert"
-	SSLCertService_FindEnabledSSLCertConfig_FullMethodName      = "/pb.SSLCertService/findEnabledSSLCertConfig"
-	SSLCertService_CountSSLCerts_FullMethodName                 = "/pb.SSLCertService/countSSLCerts"
-	SSLCertService_ListSSLCerts_FullMethodName                  = "/pb.SSLCertService/listSSLCerts"
-	SSLCertService_CountAllSSLCertsWithOCSPError_FullMethodName = "/pb.SSLCertService/countAllSSLCertsWithOCSPError"
-	SSLCertService_ListSSLCertsWithOCSPError_FullMethodName     = "/pb.SSLCertService/listSSLCertsWithOCSPError"
-	SSLCertService_IgnoreSSLCertsWithOCSPError_FullMethodName   = "/pb.SSLCertService/ignoreSSLCertsWithOCSPError"
-	SSLCertService_ResetSSLCertsWithOCSPError_FullMethodName    = "/pb.SSLCertService/resetSSLCertsWithOCSPError"
-	SSLCertService_ResetAllSSLCertsWithOCSPError_FullMethodName = "/pb.SSLCertService/resetAllSSLCertsWithOCSPError"
-	SSLCertService_ListUpdatedSSLCertOCSP_FullMethodName        = "/pb.SSLCertService/listUpdatedSSLCertOCSP"
-	SSLCertService_FindSSLCertUser_FullMethodName               = "/pb.SSLCertService/findSSLCertUser"
+	SSLCertService_CreateSSLCert_FullMethodName                  = "/pb.SSLCertService/createSSLCert"
+	SSLCertService_CreateSSLCerts_FullMethodName                 = "/pb.SSLCertService/createSSLCerts"
+	SSLCertService_UpdateSSLCert_FullMethodName                  = "/pb.SSLCertService/updateSSLCert"
+	SSLCertService_DeleteSSLCert_FullMethodName                  = "/pb.SSLCertService/deleteSSLCert"
+	SSLCertService_FindEnabledSSLCertConfig_FullMethodName       = "/pb.SSLCertService/findEnabledSSLCertConfig"
+	SSLCertService_CountSSLCerts_FullMethodName                  = "/pb.SSLCertService/countSSLCerts"
+	SSLCertService_ListSSLCerts_FullMethodName                   = "/pb.SSLCertService/listSSLCerts"
+	SSLCertService_CountAllSSLCertsWithOCSPError_FullMethodName  = "/pb.SSLCertService/countAllSSLCertsWithOCSPError"
+	SSLCertService_ListSSLCertsWithOCSPError_FullMethodName      = "/pb.SSLCertService/listSSLCertsWithOCSPError"
+	SSLCertService_IgnoreSSLCertsWithOCSPError_FullMethodName    = "/pb.SSLCertService/ignoreSSLCertsWithOCSPError"
+	SSLCertService_ResetSSLCertsWithOCSPError_FullMethodName     = "/pb.SSLCertService/resetSSLCertsWithOCSPError"
+	SSLCertService_ResetAllSSLCertsWithOCSPError_FullMethodName  = "/pb.SSLCertService/resetAllSSLCertsWithOCSPError"
+	SSLCertService_ListUpdatedSSLCertOCSP_FullMethodName         = "/pb.SSLCertService/listUpdatedSSLCertOCSP"
+	SSLCertService_FindSSLCertUser_FullMethodName                = "/pb.SSLCertService/findSSLCertUser"
+	SSLCertService_RevokeSSLCert_FullMethodName                  = "/pb.SSLCertService/revokeSSLCert"
+	SSLCertService_UpdateNodeSSLCertKeyPublicKey_FullMethodName  = "/pb.SSLCertService/updateNodeSSLCertKeyPublicKey"
+	SSLCertService_FindNodeSSLCertKeys_FullMethodName            = "/pb.SSLCertService/findNodeSSLCertKeys"
+	SSLCertService_ConfirmNodeSSLCertKeys_FullMethodName         = "/pb.SSLCertService/confirmNodeSSLCertKeys"
+	SSLCertService_FindAllSSLCertsWithFingerprint_FullMethodName = "/pb.SSLCertService/findAllSSLCertsWithFingerprint"
+	SSLCertService_ExportSSLCert_FullMethodName                  = "/pb.SSLCertService/exportSSLCert"
+	SSLCertService_MergeDuplicatedSSLCerts_FullMethodName        = "/pb.SSLCertService/mergeDuplicatedSSLCerts"
+	SSLCertService_GenerateSSLCert_FullMethodName                = "/pb.SSLCertService/generateSSLCert"
+	SSLCertService_UpdateSSLCertTags_FullMethodName              = "/pb.SSLCertService/updateSSLCertTags"
+	SSLCertService_FindAllSSLCertTags_FullMethodName             = "/pb.SSLCertService/findAllSSLCertTags"
+	SSLCertService_LookupSSLCert_FullMethodName                  = "/pb.SSLCertService/lookupSSLCert"
+	SSLCertService_UpdateSSLCertExternalId_FullMethodName        = "/pb.SSLCertService/updateSSLCertExternalId"
 )
 
 // SSLCertServiceClient is the client API for SSLCertService service.
@@ -67,6 +79,30 @@ type SSLCertServiceClient interface {
 	ListUpdatedSSLCertOCSP(ctx context.Context, in *ListUpdatedSSLCertOCSPRequest, opts ...grpc.CallOption) (*ListUpdatedSSLCertOCSPResponse, error)
 	// 查找证书所属用户
 	FindSSLCertUser(ctx context.Context, in *FindSSLCertUserRequest, opts ...grpc.CallOption) (*FindSSLCertUserResponse, error)
+	// 在证书颁发机构吊销证书
+	RevokeSSLCert(ctx context.Context, in *RevokeSSLCertRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 上报节点用来接收证书私钥的公钥
+	UpdateNodeSSLCertKeyPublicKey(ctx context.Context, in *UpdateNodeSSLCertKeyPublicKeyRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 读取需要保存到当前节点的证书私钥
+	FindNodeSSLCertKeys(ctx context.Context, in *FindNodeSSLCertKeysRequest, opts ...grpc.CallOption) (*FindNodeSSLCertKeysResponse, error)
+	// 确认节点已经保存证书私钥
+	ConfirmNodeSSLCertKeys(ctx context.Context, in *ConfirmNodeSSLCertKeysRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 根据SHA256指纹查找证书
+	FindAllSSLCertsWithFingerprint(ctx context.Context, in *FindAllSSLCertsWithFingerprintRequest, opts ...grpc.CallOption) (*FindAllSSLCertsWithFingerprintResponse, error)
+	// 导出证书为PKCS#12或JKS格式
+	ExportSSLCert(ctx context.Context, in *ExportSSLCertRequest, opts ...grpc.CallOption) (*ExportSSLCertResponse, error)
+	// 合并重复的证书
+	MergeDuplicatedSSLCerts(ctx context.Context, in *MergeDuplicatedSSLCertsRequest, opts ...grpc.CallOption) (*MergeDuplicatedSSLCertsResponse, error)
+	// 生成自签名证书或者使用内部CA签发证书
+	GenerateSSLCert(ctx context.Context, in *GenerateSSLCertRequest, opts ...grpc.CallOption) (*GenerateSSLCertResponse, error)
+	// 修改证书标签
+	UpdateSSLCertTags(ctx context.Context, in *UpdateSSLCertTagsRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 查找所有证书标签
+	FindAllSSLCertTags(ctx context.Context, in *FindAllSSLCertTagsRequest, opts ...grpc.CallOption) (*FindAllSSLCertTagsResponse, error)
+	// 根据ID、外部ID或者名称查找证书，通常用于Terraform等自动化工具导入和读取已有证书
+	LookupSSLCert(ctx context.Context, in *LookupSSLCertRequest, opts ...grpc.CallOption) (*LookupSSLCertResponse, error)
+	// 设置证书外部ID
+	UpdateSSLCertExternalId(ctx context.Context, in *UpdateSSLCertExternalIdRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 }
 
 type sSLCertServiceClient struct {
@@ -203,6 +239,114 @@ func (c *sSLCertServiceClient) FindSSLCertUser(ctx context.Context, in *FindSSLC
 	return out, nil
 }
 
+func (c *sSLCertServiceClient) RevokeSSLCert(ctx context.Context, in *RevokeSSLCertRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, SSLCertService_RevokeSSLCert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertServiceClient) UpdateNodeSSLCertKeyPublicKey(ctx context.Context, in *UpdateNodeSSLCertKeyPublicKeyRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, SSLCertService_UpdateNodeSSLCertKeyPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertServiceClient) FindNodeSSLCertKeys(ctx context.Context, in *FindNodeSSLCertKeysRequest, opts ...grpc.CallOption) (*FindNodeSSLCertKeysResponse, error) {
+	out := new(FindNodeSSLCertKeysResponse)
+	err := c.cc.Invoke(ctx, SSLCertService_FindNodeSSLCertKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertServiceClient) ConfirmNodeSSLCertKeys(ctx context.Context, in *ConfirmNodeSSLCertKeysRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, SSLCertService_ConfirmNodeSSLCertKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertServiceClient) FindAllSSLCertsWithFingerprint(ctx context.Context, in *FindAllSSLCertsWithFingerprintRequest, opts ...grpc.CallOption) (*FindAllSSLCertsWithFingerprintResponse, error) {
+	out := new(FindAllSSLCertsWithFingerprintResponse)
+	err := c.cc.Invoke(ctx, SSLCertService_FindAllSSLCertsWithFingerprint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertServiceClient) ExportSSLCert(ctx context.Context, in *ExportSSLCertRequest, opts ...grpc.CallOption) (*ExportSSLCertResponse, error) {
+	out := new(ExportSSLCertResponse)
+	err := c.cc.Invoke(ctx, SSLCertService_ExportSSLCert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertServiceClient) MergeDuplicatedSSLCerts(ctx context.Context, in *MergeDuplicatedSSLCertsRequest, opts ...grpc.CallOption) (*MergeDuplicatedSSLCertsResponse, error) {
+	out := new(MergeDuplicatedSSLCertsResponse)
+	err := c.cc.Invoke(ctx, SSLCertService_MergeDuplicatedSSLCerts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertServiceClient) GenerateSSLCert(ctx context.Context, in *GenerateSSLCertRequest, opts ...grpc.CallOption) (*GenerateSSLCertResponse, error) {
+	out := new(GenerateSSLCertResponse)
+	err := c.cc.Invoke(ctx, SSLCertService_GenerateSSLCert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertServiceClient) UpdateSSLCertTags(ctx context.Context, in *UpdateSSLCertTagsRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, SSLCertService_UpdateSSLCertTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertServiceClient) FindAllSSLCertTags(ctx context.Context, in *FindAllSSLCertTagsRequest, opts ...grpc.CallOption) (*FindAllSSLCertTagsResponse, error) {
+	out := new(FindAllSSLCertTagsResponse)
+	err := c.cc.Invoke(ctx, SSLCertService_FindAllSSLCertTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertServiceClient) LookupSSLCert(ctx context.Context, in *LookupSSLCertRequest, opts ...grpc.CallOption) (*LookupSSLCertResponse, error) {
+	out := new(LookupSSLCertResponse)
+	err := c.cc.Invoke(ctx, SSLCertService_LookupSSLCert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSLCertServiceClient) UpdateSSLCertExternalId(ctx context.Context, in *UpdateSSLCertExternalIdRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, SSLCertService_UpdateSSLCertExternalId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SSLCertServiceServer is the server API for SSLCertService service.
 // All implementations should embed UnimplementedSSLCertServiceServer
 // for forward compatibility
@@ -235,6 +379,30 @@ type SSLCertServiceServer interface {
 	ListUpdatedSSLCertOCSP(context.Context, *ListUpdatedSSLCertOCSPRequest) (*ListUpdatedSSLCertOCSPResponse, error)
 	// 查找证书所属用户
 	FindSSLCertUser(context.Context, *FindSSLCertUserRequest) (*FindSSLCertUserResponse, error)
+	// 在证书颁发机构吊销证书
+	RevokeSSLCert(context.Context, *RevokeSSLCertRequest) (*RPCSuccess, error)
+	// 上报节点用来接收证书私钥的公钥
+	UpdateNodeSSLCertKeyPublicKey(context.Context, *UpdateNodeSSLCertKeyPublicKeyRequest) (*RPCSuccess, error)
+	// 读取需要保存到当前节点的证书私钥
+	FindNodeSSLCertKeys(context.Context, *FindNodeSSLCertKeysRequest) (*FindNodeSSLCertKeysResponse, error)
+	// 确认节点已经保存证书私钥
+	ConfirmNodeSSLCertKeys(context.Context, *ConfirmNodeSSLCertKeysRequest) (*RPCSuccess, error)
+	// 根据SHA256指纹查找证书
+	FindAllSSLCertsWithFingerprint(context.Context, *FindAllSSLCertsWithFingerprintRequest) (*FindAllSSLCertsWithFingerprintResponse, error)
+	// 导出证书为PKCS#12或JKS格式
+	ExportSSLCert(context.Context, *ExportSSLCertRequest) (*ExportSSLCertResponse, error)
+	// 合并重复的证书
+	MergeDuplicatedSSLCerts(context.Context, *MergeDuplicatedSSLCertsRequest) (*MergeDuplicatedSSLCertsResponse, error)
+	// 生成自签名证书或者使用内部CA签发证书
+	GenerateSSLCert(context.Context, *GenerateSSLCertRequest) (*GenerateSSLCertResponse, error)
+	// 修改证书标签
+	UpdateSSLCertTags(context.Context, *UpdateSSLCertTagsRequest) (*RPCSuccess, error)
+	// 查找所有证书标签
+	FindAllSSLCertTags(context.Context, *FindAllSSLCertTagsRequest) (*FindAllSSLCertTagsResponse, error)
+	// 根据ID、外部ID或者名称查找证书，通常用于Terraform等自动化工具导入和读取已有证书
+	LookupSSLCert(context.Context, *LookupSSLCertRequest) (*LookupSSLCertResponse, error)
+	// 设置证书外部ID
+	UpdateSSLCertExternalId(context.Context, *UpdateSSLCertExternalIdRequest) (*RPCSuccess, error)
 }
 
 // UnimplementedSSLCertServiceServer should be embedded to have forward compatible implementations.
@@ -283,6 +451,42 @@ func (UnimplementedSSLCertServiceServer) ListUpdatedSSLCertOCSP(context.Context,
 func (UnimplementedSSLCertServiceServer) FindSSLCertUser(context.Context, *FindSSLCertUserRequest) (*FindSSLCertUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindSSLCertUser not implemented")
 }
+func (UnimplementedSSLCertServiceServer) RevokeSSLCert(context.Context, *RevokeSSLCertRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSSLCert not implemented")
+}
+func (UnimplementedSSLCertServiceServer) UpdateNodeSSLCertKeyPublicKey(context.Context, *UpdateNodeSSLCertKeyPublicKeyRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeSSLCertKeyPublicKey not implemented")
+}
+func (UnimplementedSSLCertServiceServer) FindNodeSSLCertKeys(context.Context, *FindNodeSSLCertKeysRequest) (*FindNodeSSLCertKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNodeSSLCertKeys not implemented")
+}
+func (UnimplementedSSLCertServiceServer) ConfirmNodeSSLCertKeys(context.Context, *ConfirmNodeSSLCertKeysRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmNodeSSLCertKeys not implemented")
+}
+func (UnimplementedSSLCertServiceServer) FindAllSSLCertsWithFingerprint(context.Context, *FindAllSSLCertsWithFingerprintRequest) (*FindAllSSLCertsWithFingerprintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllSSLCertsWithFingerprint not implemented")
+}
+func (UnimplementedSSLCertServiceServer) ExportSSLCert(context.Context, *ExportSSLCertRequest) (*ExportSSLCertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSSLCert not implemented")
+}
+func (UnimplementedSSLCertServiceServer) MergeDuplicatedSSLCerts(context.Context, *MergeDuplicatedSSLCertsRequest) (*MergeDuplicatedSSLCertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeDuplicatedSSLCerts not implemented")
+}
+func (UnimplementedSSLCertServiceServer) GenerateSSLCert(context.Context, *GenerateSSLCertRequest) (*GenerateSSLCertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateSSLCert not implemented")
+}
+func (UnimplementedSSLCertServiceServer) UpdateSSLCertTags(context.Context, *UpdateSSLCertTagsRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSSLCertTags not implemented")
+}
+func (UnimplementedSSLCertServiceServer) FindAllSSLCertTags(context.Context, *FindAllSSLCertTagsRequest) (*FindAllSSLCertTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllSSLCertTags not implemented")
+}
+func (UnimplementedSSLCertServiceServer) LookupSSLCert(context.Context, *LookupSSLCertRequest) (*LookupSSLCertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupSSLCert not implemented")
+}
+func (UnimplementedSSLCertServiceServer) UpdateSSLCertExternalId(context.Context, *UpdateSSLCertExternalIdRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSSLCertExternalId not implemented")
+}
 
 // UnsafeSSLCertServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to SSLCertServiceServer will
@@ -547,6 +751,222 @@ func _SSLCertService_FindSSLCertUser_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SSLCertService_RevokeSSLCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSSLCertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).RevokeSSLCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_RevokeSSLCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).RevokeSSLCert(ctx, req.(*RevokeSSLCertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertService_UpdateNodeSSLCertKeyPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodeSSLCertKeyPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).UpdateNodeSSLCertKeyPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_UpdateNodeSSLCertKeyPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).UpdateNodeSSLCertKeyPublicKey(ctx, req.(*UpdateNodeSSLCertKeyPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertService_FindNodeSSLCertKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNodeSSLCertKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).FindNodeSSLCertKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_FindNodeSSLCertKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).FindNodeSSLCertKeys(ctx, req.(*FindNodeSSLCertKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertService_ConfirmNodeSSLCertKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmNodeSSLCertKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).ConfirmNodeSSLCertKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_ConfirmNodeSSLCertKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).ConfirmNodeSSLCertKeys(ctx, req.(*ConfirmNodeSSLCertKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertService_FindAllSSLCertsWithFingerprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllSSLCertsWithFingerprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).FindAllSSLCertsWithFingerprint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_FindAllSSLCertsWithFingerprint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).FindAllSSLCertsWithFingerprint(ctx, req.(*FindAllSSLCertsWithFingerprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertService_ExportSSLCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSSLCertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).ExportSSLCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_ExportSSLCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).ExportSSLCert(ctx, req.(*ExportSSLCertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertService_MergeDuplicatedSSLCerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDuplicatedSSLCertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).MergeDuplicatedSSLCerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_MergeDuplicatedSSLCerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).MergeDuplicatedSSLCerts(ctx, req.(*MergeDuplicatedSSLCertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertService_GenerateSSLCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSSLCertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).GenerateSSLCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_GenerateSSLCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).GenerateSSLCert(ctx, req.(*GenerateSSLCertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertService_UpdateSSLCertTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSSLCertTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).UpdateSSLCertTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_UpdateSSLCertTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).UpdateSSLCertTags(ctx, req.(*UpdateSSLCertTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertService_FindAllSSLCertTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllSSLCertTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).FindAllSSLCertTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_FindAllSSLCertTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).FindAllSSLCertTags(ctx, req.(*FindAllSSLCertTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertService_LookupSSLCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupSSLCertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).LookupSSLCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_LookupSSLCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).LookupSSLCert(ctx, req.(*LookupSSLCertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSLCertService_UpdateSSLCertExternalId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSSLCertExternalIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSLCertServiceServer).UpdateSSLCertExternalId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSLCertService_UpdateSSLCertExternalId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSLCertServiceServer).UpdateSSLCertExternalId(ctx, req.(*UpdateSSLCertExternalIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SSLCertService_ServiceDesc is the grpc.ServiceDesc for SSLCertService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -610,6 +1030,54 @@ var SSLCertService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "findSSLCertUser",
 			Handler:    _SSLCertService_FindSSLCertUser_Handler,
 		},
+		{
+			MethodName: "revokeSSLCert",
+			Handler:    _SSLCertService_RevokeSSLCert_Handler,
+		},
+		{
+			MethodName: "updateNodeSSLCertKeyPublicKey",
+			Handler:    _SSLCertService_UpdateNodeSSLCertKeyPublicKey_Handler,
+		},
+		{
+			MethodName: "findNodeSSLCertKeys",
+			Handler:    _SSLCertService_FindNodeSSLCertKeys_Handler,
+		},
+		{
+			MethodName: "confirmNodeSSLCertKeys",
+			Handler:    _SSLCertService_ConfirmNodeSSLCertKeys_Handler,
+		},
+		{
+			MethodName: "findAllSSLCertsWithFingerprint",
+			Handler:    _SSLCertService_FindAllSSLCertsWithFingerprint_Handler,
+		},
+		{
+			MethodName: "exportSSLCert",
+			Handler:    _SSLCertService_ExportSSLCert_Handler,
+		},
+		{
+			MethodName: "mergeDuplicatedSSLCerts",
+			Handler:    _SSLCertService_MergeDuplicatedSSLCerts_Handler,
+		},
+		{
+			MethodName: "generateSSLCert",
+			Handler:    _SSLCertService_GenerateSSLCert_Handler,
+		},
+		{
+			MethodName: "updateSSLCertTags",
+			Handler:    _SSLCertService_UpdateSSLCertTags_Handler,
+		},
+		{
+			MethodName: "findAllSSLCertTags",
+			Handler:    _SSLCertService_FindAllSSLCertTags_Handler,
+		},
+		{
+			MethodName: "lookupSSLCert",
+			Handler:    _SSLCertService_LookupSSLCert_Handler,
+		},
+		{
+			MethodName: "updateSSLCertExternalId",
+			Handler:    _SSLCertService_UpdateSSLCertExternalId_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_ssl_cert.proto",
@@ -0,0 +1,484 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_stat_query.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 查询流量、请求数和带宽统计
+type FindTrafficStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metrics         []string `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`                  // 指标：bytes、cachedBytes、attackBytes、countRequests、countCachedRequests、countAttackRequests、bandwidth（平均带宽，bit/s），默认为 bytes 和 countRequests
+	GroupBy         string   `protobuf:"bytes,2,opt,name=groupBy,proto3" json:"groupBy,omitempty"`                  // 分组：空（不分组）、server、node（仅限管理员）、region、statusClass（仅支持countRequests指标）
+	TimeFrom        int64    `protobuf:"varint,3,opt,name=timeFrom,proto3" json:"timeFrom,omitempty"`               // 开始时间戳，默认为24小时之前
+	TimeTo          int64    `protobuf:"varint,4,opt,name=timeTo,proto3" json:"timeTo,omitempty"`                   // 结束时间戳（不包含），默认为当前时间
+	IntervalSeconds int64    `protobuf:"varint,5,opt,name=intervalSeconds,proto3" json:"intervalSeconds,omitempty"` // 降采样间隔，单位秒，需要是原始数据粒度（5分钟，按节点分组时为1小时）的整数倍；为0表示自动选择
+	ServerIds       []int64  `protobuf:"varint,6,rep,packed,name=serverIds,proto3" json:"serverIds,omitempty"`      // 可选项，网站ID
+	UserId          int64    `protobuf:"varint,7,opt,name=userId,proto3" json:"userId,omitempty"`                   // 可选项，用户ID，用户调用时此项无效
+	NodeClusterId   int64    `protobuf:"varint,8,opt,name=nodeClusterId,proto3" json:"nodeClusterId,omitempty"`     // 可选项，集群ID，仅在按节点分组时有效
+	NodeIds         []int64  `protobuf:"varint,9,rep,packed,name=nodeIds,proto3" json:"nodeIds,omitempty"`          // 可选项，节点ID，仅在按节点分组时有效
+	Size            int32    `protobuf:"varint,10,opt,name=size,proto3" json:"size,omitempty"`                      // 最多返回的分组数量，按第一个指标的总和倒序排列，默认为100
+}
+
+func (x *FindTrafficStatsRequest) Reset() {
+	*x = FindTrafficStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_stat_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindTrafficStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTrafficStatsRequest) ProtoMessage() {}
+
+func (x *FindTrafficStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_stat_query_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindTrafficStatsRequest.ProtoReflect.Descriptor instead.
+func (*FindTrafficStatsRequest) Descriptor() ([]byte, []int) {
+	return file_service_stat_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FindTrafficStatsRequest) GetMetrics() []string {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *FindTrafficStatsRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *FindTrafficStatsRequest) GetTimeFrom() int64 {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return 0
+}
+
+func (x *FindTrafficStatsRequest) GetTimeTo() int64 {
+	if x != nil {
+		return x.TimeTo
+	}
+	return 0
+}
+
+func (x *FindTrafficStatsRequest) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *FindTrafficStatsRequest) GetServerIds() []int64 {
+	if x != nil {
+		return x.ServerIds
+	}
+	return nil
+}
+
+func (x *FindTrafficStatsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FindTrafficStatsRequest) GetNodeClusterId() int64 {
+	if x != nil {
+		return x.NodeClusterId
+	}
+	return 0
+}
+
+func (x *FindTrafficStatsRequest) GetNodeIds() []int64 {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *FindTrafficStatsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type FindTrafficStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IntervalSeconds int64                              `protobuf:"varint,1,opt,name=intervalSeconds,proto3" json:"intervalSeconds,omitempty"` // 实际使用的降采样间隔
+	Metrics         []string                           `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`                  // 指标，和数据点中的值一一对应
+	Series          []*FindTrafficStatsResponse_Series `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"`                    // 各分组数据
+}
+
+func (x *FindTrafficStatsResponse) Reset() {
+	*x = FindTrafficStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_stat_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindTrafficStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTrafficStatsResponse) ProtoMessage() {}
+
+func (x *FindTrafficStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_stat_query_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindTrafficStatsResponse.ProtoReflect.Descriptor instead.
+func (*FindTrafficStatsResponse) Descriptor() ([]byte, []int) {
+	return file_service_stat_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FindTrafficStatsResponse) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *FindTrafficStatsResponse) GetMetrics() []string {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *FindTrafficStatsResponse) GetSeries() []*FindTrafficStatsResponse_Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type FindTrafficStatsResponse_Series struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupKey  string                            `protobuf:"bytes,1,opt,name=groupKey,proto3" json:"groupKey,omitempty"`   // 分组标识，比如网站ID、节点ID、区域ID、状态码分类
+	GroupName string                            `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"` // 分组名称
+	Points    []*FindTrafficStatsResponse_Point `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`       // 数据点
+}
+
+func (x *FindTrafficStatsResponse_Series) Reset() {
+	*x = FindTrafficStatsResponse_Series{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_stat_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindTrafficStatsResponse_Series) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTrafficStatsResponse_Series) ProtoMessage() {}
+
+func (x *FindTrafficStatsResponse_Series) ProtoReflect() protoreflect.Message {
+	mi := &file_service_stat_query_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindTrafficStatsResponse_Series.ProtoReflect.Descriptor instead.
+func (*FindTrafficStatsResponse_Series) Descriptor() ([]byte, []int) {
+	return file_service_stat_query_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *FindTrafficStatsResponse_Series) GetGroupKey() string {
+	if x != nil {
+		return x.GroupKey
+	}
+	return ""
+}
+
+func (x *FindTrafficStatsResponse_Series) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *FindTrafficStatsResponse_Series) GetPoints() []*FindTrafficStatsResponse_Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type FindTrafficStatsResponse_Point struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int64     `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`   // 开始时间戳
+	Values    []float64 `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"` // 和指标一一对应的值
+}
+
+func (x *FindTrafficStatsResponse_Point) Reset() {
+	*x = FindTrafficStatsResponse_Point{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_stat_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindTrafficStatsResponse_Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTrafficStatsResponse_Point) ProtoMessage() {}
+
+func (x *FindTrafficStatsResponse_Point) ProtoReflect() protoreflect.Message {
+	mi := &file_service_stat_query_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindTrafficStatsResponse_Point.ProtoReflect.Descriptor instead.
+func (*FindTrafficStatsResponse_Point) Descriptor() ([]byte, []int) {
+	return file_service_stat_query_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *FindTrafficStatsResponse_Point) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *FindTrafficStatsResponse_Point) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_service_stat_query_proto protoreflect.FileDescriptor
+
+var file_service_stat_query_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xb5,
+	0x02, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69,
+	0x6d, 0x65, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65,
+	0x54, 0x6f, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xda, 0x02, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x06, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x1a, 0x7e, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x1a, 0x3d, 0x0a, 0x05, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x32, 0x61, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x64, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_stat_query_proto_rawDescOnce sync.Once
+	file_service_stat_query_proto_rawDescData = file_service_stat_query_proto_rawDesc
+)
+
+func file_service_stat_query_proto_rawDescGZIP() []byte {
+	file_service_stat_query_proto_rawDescOnce.Do(func() {
+		file_service_stat_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_stat_query_proto_rawDescData)
+	})
+	return file_service_stat_query_proto_rawDescData
+}
+
+var file_service_stat_query_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_service_stat_query_proto_goTypes = []interface{}{
+	(*FindTrafficStatsRequest)(nil),         // 0: pb.FindTrafficStatsRequest
+	(*FindTrafficStatsResponse)(nil),        // 1: pb.FindTrafficStatsResponse
+	(*FindTrafficStatsResponse_Series)(nil), // 2: pb.FindTrafficStatsResponse.Series
+	(*FindTrafficStatsResponse_Point)(nil),  // 3: pb.FindTrafficStatsResponse.Point
+}
+var file_service_stat_query_proto_depIdxs = []int32{
+	2, // 0: pb.FindTrafficStatsResponse.series:type_name -> pb.FindTrafficStatsResponse.Series
+	3, // 1: pb.FindTrafficStatsResponse.Series.points:type_name -> pb.FindTrafficStatsResponse.Point
+	0, // 2: pb.StatQueryService.findTrafficStats:input_type -> pb.FindTrafficStatsRequest
+	1, // 3: pb.StatQueryService.findTrafficStats:output_type -> pb.FindTrafficStatsResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_service_stat_query_proto_init() }
+func file_service_stat_query_proto_init() {
+	if File_service_stat_query_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_service_stat_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindTrafficStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_stat_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindTrafficStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_stat_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindTrafficStatsResponse_Series); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_stat_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindTrafficStatsResponse_Point); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_stat_query_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_stat_query_proto_goTypes,
+		DependencyIndexes: file_service_stat_query_proto_depIdxs,
+		MessageInfos:      file_service_stat_query_proto_msgTypes,
+	}.Build()
+	File_service_stat_query_proto = out.File
+	file_service_stat_query_proto_rawDesc = nil
+	file_service_stat_query_proto_goTypes = nil
+	file_service_stat_query_proto_depIdxs = nil
+}
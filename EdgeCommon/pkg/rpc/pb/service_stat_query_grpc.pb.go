@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_stat_query.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	StatQueryService_FindTrafficStats_FullMethodName = "/pb.StatQueryService/findTrafficStats"
+)
+
+// StatQueryServiceClient is the client API for StatQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StatQueryServiceClient interface {
+	// 查询流量、请求数和带宽统计，支持分组和降采样
+	FindTrafficStats(ctx context.Context, in *FindTrafficStatsRequest, opts ...grpc.CallOption) (*FindTrafficStatsResponse, error)
+}
+
+type statQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStatQueryServiceClient(cc grpc.ClientConnInterface) StatQueryServiceClient {
+	return &statQueryServiceClient{cc}
+}
+
+func (c *statQueryServiceClient) FindTrafficStats(ctx context.Context, in *FindTrafficStatsRequest, opts ...grpc.CallOption) (*FindTrafficStatsResponse, error) {
+	out := new(FindTrafficStatsResponse)
+	err := c.cc.Invoke(ctx, StatQueryService_FindTrafficStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StatQueryServiceServer is the server API for StatQueryService service.
+// All implementations should embed UnimplementedStatQueryServiceServer
+// for forward compatibility
+type StatQueryServiceServer interface {
+	// 查询流量、请求数和带宽统计，支持分组和降采样
+	FindTrafficStats(context.Context, *FindTrafficStatsRequest) (*FindTrafficStatsResponse, error)
+}
+
+// UnimplementedStatQueryServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedStatQueryServiceServer struct {
+}
+
+func (UnimplementedStatQueryServiceServer) FindTrafficStats(context.Context, *FindTrafficStatsRequest) (*FindTrafficStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindTrafficStats not implemented")
+}
+
+// UnsafeStatQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StatQueryServiceServer will
+// result in compilation errors.
+type UnsafeStatQueryServiceServer interface {
+	mustEmbedUnimplementedStatQueryServiceServer()
+}
+
+func RegisterStatQueryServiceServer(s grpc.ServiceRegistrar, srv StatQueryServiceServer) {
+	s.RegisterService(&StatQueryService_ServiceDesc, srv)
+}
+
+func _StatQueryService_FindTrafficStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindTrafficStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatQueryServiceServer).FindTrafficStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatQueryService_FindTrafficStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatQueryServiceServer).FindTrafficStats(ctx, req.(*FindTrafficStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StatQueryService_ServiceDesc is the grpc.ServiceDesc for StatQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StatQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.StatQueryService",
+	HandlerType: (*StatQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "findTrafficStats",
+			Handler:    _StatQueryService_FindTrafficStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_stat_query.proto",
+}
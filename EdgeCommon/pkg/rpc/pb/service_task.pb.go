@@ -0,0 +1,778 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_task.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 查找所有可以手动执行的后台任务
+type FindAllRegisteredTasksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindAllRegisteredTasksRequest) Reset() {
+	*x = FindAllRegisteredTasksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_task_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllRegisteredTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllRegisteredTasksRequest) ProtoMessage() {}
+
+func (x *FindAllRegisteredTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_task_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllRegisteredTasksRequest.ProtoReflect.Descriptor instead.
+func (*FindAllRegisteredTasksRequest) Descriptor() ([]byte, []int) {
+	return file_service_task_proto_rawDescGZIP(), []int{0}
+}
+
+type FindAllRegisteredTasksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RegisteredTasks []*FindAllRegisteredTasksResponse_RegisteredTask `protobuf:"bytes,1,rep,name=registeredTasks,proto3" json:"registeredTasks,omitempty"` // 任务列表
+}
+
+func (x *FindAllRegisteredTasksResponse) Reset() {
+	*x = FindAllRegisteredTasksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_task_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllRegisteredTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllRegisteredTasksResponse) ProtoMessage() {}
+
+func (x *FindAllRegisteredTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_task_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllRegisteredTasksResponse.ProtoReflect.Descriptor instead.
+func (*FindAllRegisteredTasksResponse) Descriptor() ([]byte, []int) {
+	return file_service_task_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FindAllRegisteredTasksResponse) GetRegisteredTasks() []*FindAllRegisteredTasksResponse_RegisteredTask {
+	if x != nil {
+		return x.RegisteredTasks
+	}
+	return nil
+}
+
+// 立即执行某个后台任务
+type RunRegisteredTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`  // 任务代号
+	Wait bool   `protobuf:"varint,2,opt,name=wait,proto3" json:"wait,omitempty"` // 是否等待任务执行结束，如果为false则在后台执行
+}
+
+func (x *RunRegisteredTaskRequest) Reset() {
+	*x = RunRegisteredTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_task_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunRegisteredTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRegisteredTaskRequest) ProtoMessage() {}
+
+func (x *RunRegisteredTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_task_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRegisteredTaskRequest.ProtoReflect.Descriptor instead.
+func (*RunRegisteredTaskRequest) Descriptor() ([]byte, []int) {
+	return file_service_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RunRegisteredTaskRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RunRegisteredTaskRequest) GetWait() bool {
+	if x != nil {
+		return x.Wait
+	}
+	return false
+}
+
+// 修改后台任务的执行计划
+type UpdateRegisteredTaskScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`         // 任务代号
+	CronExpr string `protobuf:"bytes,2,opt,name=cronExpr,proto3" json:"cronExpr,omitempty"` // Cron表达式，格式为：分 时 日 月 周，为空表示恢复默认的间隔
+}
+
+func (x *UpdateRegisteredTaskScheduleRequest) Reset() {
+	*x = UpdateRegisteredTaskScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_task_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRegisteredTaskScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRegisteredTaskScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateRegisteredTaskScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_task_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRegisteredTaskScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRegisteredTaskScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_service_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateRegisteredTaskScheduleRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateRegisteredTaskScheduleRequest) GetCronExpr() string {
+	if x != nil {
+		return x.CronExpr
+	}
+	return ""
+}
+
+// 计算后台任务执行记录数量
+type CountTaskRunsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`            // 任务代号，为空表示所有任务
+	ErrorOnly bool   `protobuf:"varint,2,opt,name=errorOnly,proto3" json:"errorOnly,omitempty"` // 是否只查询失败的记录
+}
+
+func (x *CountTaskRunsRequest) Reset() {
+	*x = CountTaskRunsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_task_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountTaskRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountTaskRunsRequest) ProtoMessage() {}
+
+func (x *CountTaskRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_task_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountTaskRunsRequest.ProtoReflect.Descriptor instead.
+func (*CountTaskRunsRequest) Descriptor() ([]byte, []int) {
+	return file_service_task_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CountTaskRunsRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CountTaskRunsRequest) GetErrorOnly() bool {
+	if x != nil {
+		return x.ErrorOnly
+	}
+	return false
+}
+
+// 列出单页后台任务执行记录
+type ListTaskRunsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`            // 任务代号，为空表示所有任务
+	ErrorOnly bool   `protobuf:"varint,2,opt,name=errorOnly,proto3" json:"errorOnly,omitempty"` // 是否只查询失败的记录
+	Offset    int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Size      int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListTaskRunsRequest) Reset() {
+	*x = ListTaskRunsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_task_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTaskRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskRunsRequest) ProtoMessage() {}
+
+func (x *ListTaskRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_task_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskRunsRequest) Descriptor() ([]byte, []int) {
+	return file_service_task_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTaskRunsRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListTaskRunsRequest) GetErrorOnly() bool {
+	if x != nil {
+		return x.ErrorOnly
+	}
+	return false
+}
+
+func (x *ListTaskRunsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTaskRunsRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListTaskRunsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskRuns []*TaskRun `protobuf:"bytes,1,rep,name=taskRuns,proto3" json:"taskRuns,omitempty"`
+}
+
+func (x *ListTaskRunsResponse) Reset() {
+	*x = ListTaskRunsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_task_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTaskRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskRunsResponse) ProtoMessage() {}
+
+func (x *ListTaskRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_task_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskRunsResponse) Descriptor() ([]byte, []int) {
+	return file_service_task_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTaskRunsResponse) GetTaskRuns() []*TaskRun {
+	if x != nil {
+		return x.TaskRuns
+	}
+	return nil
+}
+
+type FindAllRegisteredTasksResponse_RegisteredTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code            string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                        // 代号
+	Name            string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                        // 名称
+	Description     string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`          // 描述
+	IntervalSeconds int64    `protobuf:"varint,4,opt,name=intervalSeconds,proto3" json:"intervalSeconds,omitempty"` // 定时执行的间隔（秒）
+	IsRunning       bool     `protobuf:"varint,5,opt,name=isRunning,proto3" json:"isRunning,omitempty"`             // 是否正在手动执行
+	LastRunAt       int64    `protobuf:"varint,6,opt,name=lastRunAt,proto3" json:"lastRunAt,omitempty"`             // 上次手动执行的时间
+	LastError       string   `protobuf:"bytes,7,opt,name=lastError,proto3" json:"lastError,omitempty"`              // 上次手动执行的错误信息
+	CronExpr        string   `protobuf:"bytes,8,opt,name=cronExpr,proto3" json:"cronExpr,omitempty"`                // Cron表达式，为空表示按照默认的间隔执行
+	NextRunAt       int64    `protobuf:"varint,9,opt,name=nextRunAt,proto3" json:"nextRunAt,omitempty"`             // 按照Cron表达式下一次执行的时间
+	LatestTaskRun   *TaskRun `protobuf:"bytes,10,opt,name=latestTaskRun,proto3" json:"latestTaskRun,omitempty"`     // 最近一次执行记录，包括定时执行和手动执行
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) Reset() {
+	*x = FindAllRegisteredTasksResponse_RegisteredTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_task_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllRegisteredTasksResponse_RegisteredTask) ProtoMessage() {}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) ProtoReflect() protoreflect.Message {
+	mi := &file_service_task_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllRegisteredTasksResponse_RegisteredTask.ProtoReflect.Descriptor instead.
+func (*FindAllRegisteredTasksResponse_RegisteredTask) Descriptor() ([]byte, []int) {
+	return file_service_task_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) GetIsRunning() bool {
+	if x != nil {
+		return x.IsRunning
+	}
+	return false
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) GetLastRunAt() int64 {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return 0
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) GetCronExpr() string {
+	if x != nil {
+		return x.CronExpr
+	}
+	return ""
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) GetNextRunAt() int64 {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return 0
+}
+
+func (x *FindAllRegisteredTasksResponse_RegisteredTask) GetLatestTaskRun() *TaskRun {
+	if x != nil {
+		return x.LatestTaskRun
+	}
+	return nil
+}
+
+var File_service_task_proto protoreflect.FileDescriptor
+
+var file_service_task_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x19, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2f, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x75, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x1f, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xcb, 0x03, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x1a, 0xcb, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28,
+	0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x52, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x52,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75,
+	0x6e, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x52,
+	0x75, 0x6e, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x0d,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e,
+	0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x22,
+	0x42, 0x0a, 0x18, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77,
+	0x61, 0x69, 0x74, 0x22, 0x55, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x22, 0x48, 0x0a, 0x14, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x73, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3f, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e,
+	0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x32, 0x8e, 0x03, 0x0a, 0x0b, 0x54,
+	0x61, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x16, 0x66, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x11, 0x72,
+	0x75, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x57,
+	0x0a, 0x1c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x27,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_task_proto_rawDescOnce sync.Once
+	file_service_task_proto_rawDescData = file_service_task_proto_rawDesc
+)
+
+func file_service_task_proto_rawDescGZIP() []byte {
+	file_service_task_proto_rawDescOnce.Do(func() {
+		file_service_task_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_task_proto_rawDescData)
+	})
+	return file_service_task_proto_rawDescData
+}
+
+var file_service_task_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_service_task_proto_goTypes = []interface{}{
+	(*FindAllRegisteredTasksRequest)(nil),                 // 0: pb.FindAllRegisteredTasksRequest
+	(*FindAllRegisteredTasksResponse)(nil),                // 1: pb.FindAllRegisteredTasksResponse
+	(*RunRegisteredTaskRequest)(nil),                      // 2: pb.RunRegisteredTaskRequest
+	(*UpdateRegisteredTaskScheduleRequest)(nil),           // 3: pb.UpdateRegisteredTaskScheduleRequest
+	(*CountTaskRunsRequest)(nil),                          // 4: pb.CountTaskRunsRequest
+	(*ListTaskRunsRequest)(nil),                           // 5: pb.ListTaskRunsRequest
+	(*ListTaskRunsResponse)(nil),                          // 6: pb.ListTaskRunsResponse
+	(*FindAllRegisteredTasksResponse_RegisteredTask)(nil), // 7: pb.FindAllRegisteredTasksResponse.RegisteredTask
+	(*TaskRun)(nil),                                       // 8: pb.TaskRun
+	(*RPCSuccess)(nil),                                    // 9: pb.RPCSuccess
+	(*RPCCountResponse)(nil),                              // 10: pb.RPCCountResponse
+}
+var file_service_task_proto_depIdxs = []int32{
+	7,  // 0: pb.FindAllRegisteredTasksResponse.registeredTasks:type_name -> pb.FindAllRegisteredTasksResponse.RegisteredTask
+	8,  // 1: pb.ListTaskRunsResponse.taskRuns:type_name -> pb.TaskRun
+	8,  // 2: pb.FindAllRegisteredTasksResponse.RegisteredTask.latestTaskRun:type_name -> pb.TaskRun
+	0,  // 3: pb.TaskService.findAllRegisteredTasks:input_type -> pb.FindAllRegisteredTasksRequest
+	2,  // 4: pb.TaskService.runRegisteredTask:input_type -> pb.RunRegisteredTaskRequest
+	3,  // 5: pb.TaskService.updateRegisteredTaskSchedule:input_type -> pb.UpdateRegisteredTaskScheduleRequest
+	4,  // 6: pb.TaskService.countTaskRuns:input_type -> pb.CountTaskRunsRequest
+	5,  // 7: pb.TaskService.listTaskRuns:input_type -> pb.ListTaskRunsRequest
+	1,  // 8: pb.TaskService.findAllRegisteredTasks:output_type -> pb.FindAllRegisteredTasksResponse
+	9,  // 9: pb.TaskService.runRegisteredTask:output_type -> pb.RPCSuccess
+	9,  // 10: pb.TaskService.updateRegisteredTaskSchedule:output_type -> pb.RPCSuccess
+	10, // 11: pb.TaskService.countTaskRuns:output_type -> pb.RPCCountResponse
+	6,  // 12: pb.TaskService.listTaskRuns:output_type -> pb.ListTaskRunsResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_service_task_proto_init() }
+func file_service_task_proto_init() {
+	if File_service_task_proto != nil {
+		return
+	}
+	file_models_rpc_messages_proto_init()
+	file_models_model_task_run_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_task_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllRegisteredTasksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_task_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllRegisteredTasksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_task_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunRegisteredTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_task_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRegisteredTaskScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_task_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountTaskRunsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_task_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTaskRunsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_task_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTaskRunsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_task_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllRegisteredTasksResponse_RegisteredTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_task_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_task_proto_goTypes,
+		DependencyIndexes: file_service_task_proto_depIdxs,
+		MessageInfos:      file_service_task_proto_msgTypes,
+	}.Build()
+	File_service_task_proto = out.File
+	file_service_task_proto_rawDesc = nil
+	file_service_task_proto_goTypes = nil
+	file_service_task_proto_depIdxs = nil
+}
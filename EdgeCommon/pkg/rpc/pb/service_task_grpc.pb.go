@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_task.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TaskService_FindAllRegisteredTasks_FullMethodName       = "/pb.TaskService/findAllRegisteredTasks"
+	TaskService_RunRegisteredTask_FullMethodName            = "/pb.TaskService/runRegisteredTask"
+	TaskService_UpdateRegisteredTaskSchedule_FullMethodName = "/pb.TaskService/updateRegisteredTaskSchedule"
+	TaskService_CountTaskRuns_FullMethodName                = "/pb.TaskService/countTaskRuns"
+	TaskService_ListTaskRuns_FullMethodName                 = "/pb.TaskService/listTaskRuns"
+)
+
+// TaskServiceClient is the client API for TaskService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaskServiceClient interface {
+	// 查找所有可以手动执行的后台任务
+	FindAllRegisteredTasks(ctx context.Context, in *FindAllRegisteredTasksRequest, opts ...grpc.CallOption) (*FindAllRegisteredTasksResponse, error)
+	// 立即执行某个后台任务
+	RunRegisteredTask(ctx context.Context, in *RunRegisteredTaskRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 修改后台任务的执行计划
+	UpdateRegisteredTaskSchedule(ctx context.Context, in *UpdateRegisteredTaskScheduleRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 计算后台任务执行记录数量
+	CountTaskRuns(ctx context.Context, in *CountTaskRunsRequest, opts ...grpc.CallOption) (*RPCCountResponse, error)
+	// 列出单页后台任务执行记录
+	ListTaskRuns(ctx context.Context, in *ListTaskRunsRequest, opts ...grpc.CallOption) (*ListTaskRunsResponse, error)
+}
+
+type taskServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskServiceClient(cc grpc.ClientConnInterface) TaskServiceClient {
+	return &taskServiceClient{cc}
+}
+
+func (c *taskServiceClient) FindAllRegisteredTasks(ctx context.Context, in *FindAllRegisteredTasksRequest, opts ...grpc.CallOption) (*FindAllRegisteredTasksResponse, error) {
+	out := new(FindAllRegisteredTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_FindAllRegisteredTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RunRegisteredTask(ctx context.Context, in *RunRegisteredTaskRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, TaskService_RunRegisteredTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateRegisteredTaskSchedule(ctx context.Context, in *UpdateRegisteredTaskScheduleRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, TaskService_UpdateRegisteredTaskSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CountTaskRuns(ctx context.Context, in *CountTaskRunsRequest, opts ...grpc.CallOption) (*RPCCountResponse, error) {
+	out := new(RPCCountResponse)
+	err := c.cc.Invoke(ctx, TaskService_CountTaskRuns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskRuns(ctx context.Context, in *ListTaskRunsRequest, opts ...grpc.CallOption) (*ListTaskRunsResponse, error) {
+	out := new(ListTaskRunsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTaskRuns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskServiceServer is the server API for TaskService service.
+// All implementations should embed UnimplementedTaskServiceServer
+// for forward compatibility
+type TaskServiceServer interface {
+	// 查找所有可以手动执行的后台任务
+	FindAllRegisteredTasks(context.Context, *FindAllRegisteredTasksRequest) (*FindAllRegisteredTasksResponse, error)
+	// 立即执行某个后台任务
+	RunRegisteredTask(context.Context, *RunRegisteredTaskRequest) (*RPCSuccess, error)
+	// 修改后台任务的执行计划
+	UpdateRegisteredTaskSchedule(context.Context, *UpdateRegisteredTaskScheduleRequest) (*RPCSuccess, error)
+	// 计算后台任务执行记录数量
+	CountTaskRuns(context.Context, *CountTaskRunsRequest) (*RPCCountResponse, error)
+	// 列出单页后台任务执行记录
+	ListTaskRuns(context.Context, *ListTaskRunsRequest) (*ListTaskRunsResponse, error)
+}
+
+// UnimplementedTaskServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedTaskServiceServer struct {
+}
+
+func (UnimplementedTaskServiceServer) FindAllRegisteredTasks(context.Context, *FindAllRegisteredTasksRequest) (*FindAllRegisteredTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllRegisteredTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) RunRegisteredTask(context.Context, *RunRegisteredTaskRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunRegisteredTask not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateRegisteredTaskSchedule(context.Context, *UpdateRegisteredTaskScheduleRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRegisteredTaskSchedule not implemented")
+}
+func (UnimplementedTaskServiceServer) CountTaskRuns(context.Context, *CountTaskRunsRequest) (*RPCCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountTaskRuns not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskRuns(context.Context, *ListTaskRunsRequest) (*ListTaskRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskRuns not implemented")
+}
+
+// UnsafeTaskServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskServiceServer will
+// result in compilation errors.
+type UnsafeTaskServiceServer interface {
+	mustEmbedUnimplementedTaskServiceServer()
+}
+
+func RegisterTaskServiceServer(s grpc.ServiceRegistrar, srv TaskServiceServer) {
+	s.RegisterService(&TaskService_ServiceDesc, srv)
+}
+
+func _TaskService_FindAllRegisteredTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllRegisteredTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).FindAllRegisteredTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_FindAllRegisteredTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).FindAllRegisteredTasks(ctx, req.(*FindAllRegisteredTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RunRegisteredTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRegisteredTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RunRegisteredTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RunRegisteredTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RunRegisteredTask(ctx, req.(*RunRegisteredTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateRegisteredTaskSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRegisteredTaskScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateRegisteredTaskSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateRegisteredTaskSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateRegisteredTaskSchedule(ctx, req.(*UpdateRegisteredTaskScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CountTaskRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountTaskRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CountTaskRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CountTaskRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CountTaskRuns(ctx, req.(*CountTaskRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskRuns(ctx, req.(*ListTaskRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.TaskService",
+	HandlerType: (*TaskServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "findAllRegisteredTasks",
+			Handler:    _TaskService_FindAllRegisteredTasks_Handler,
+		},
+		{
+			MethodName: "runRegisteredTask",
+			Handler:    _TaskService_RunRegisteredTask_Handler,
+		},
+		{
+			MethodName: "updateRegisteredTaskSchedule",
+			Handler:    _TaskService_UpdateRegisteredTaskSchedule_Handler,
+		},
+		{
+			MethodName: "countTaskRuns",
+			Handler:    _TaskService_CountTaskRuns_Handler,
+		},
+		{
+			MethodName: "listTaskRuns",
+			Handler:    _TaskService_ListTaskRuns_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_task.proto",
+}
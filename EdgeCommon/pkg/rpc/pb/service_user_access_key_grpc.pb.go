@@ -24,6 +24,8 @@ const (
 	UserAccessKeyService_DeleteUserAccessKey_FullMethodName           = "/pb.UserAccessKeyService/deleteUserAccessKey"
 	UserAccessKeyService_UpdateUserAccessKeyIsOn_FullMethodName       = "/pb.UserAccessKeyService/updateUserAccessKeyIsOn"
 	UserAccessKeyService_CountAllEnabledUserAccessKeys_FullMethodName = "/pb.UserAccessKeyService/countAllEnabledUserAccessKeys"
+	UserAccessKeyService_UpdateUserAccessKeyScopes_FullMethodName     = "/pb.UserAccessKeyService/updateUserAccessKeyScopes"
+	UserAccessKeyService_FindAllUserAccessKeyScopes_FullMethodName    = "/pb.UserAccessKeyService/findAllUserAccessKeyScopes"
 )
 
 // UserAccessKeyServiceClient is the client API for UserAccessKeyService service.
@@ -40,6 +42,10 @@ type UserAccessKeyServiceClient interface {
 	UpdateUserAccessKeyIsOn(ctx context.Context, in *UpdateUserAccessKeyIsOnRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
 	// 计算AccessKey数量
 	CountAllEnabledUserAccessKeys(ctx context.Context, in *CountAllEnabledUserAccessKeysRequest, opts ...grpc.CallOption) (*RPCCountResponse, error)
+	// 修改AccessKey的权限范围
+	UpdateUserAccessKeyScopes(ctx context.Context, in *UpdateUserAccessKeyScopesRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 查找所有可用的权限范围
+	FindAllUserAccessKeyScopes(ctx context.Context, in *FindAllUserAccessKeyScopesRequest, opts ...grpc.CallOption) (*FindAllUserAccessKeyScopesResponse, error)
 }
 
 type userAccessKeyServiceClient struct {
@@ -95,6 +101,24 @@ func (c *userAccessKeyServiceClient) CountAllEnabledUserAccessKeys(ctx context.C
 	return out, nil
 }
 
+func (c *userAccessKeyServiceClient) UpdateUserAccessKeyScopes(ctx context.Context, in *UpdateUserAccessKeyScopesRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, UserAccessKeyService_UpdateUserAccessKeyScopes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAccessKeyServiceClient) FindAllUserAccessKeyScopes(ctx context.Context, in *FindAllUserAccessKeyScopesRequest, opts ...grpc.CallOption) (*FindAllUserAccessKeyScopesResponse, error) {
+	out := new(FindAllUserAccessKeyScopesResponse)
+	err := c.cc.Invoke(ctx, UserAccessKeyService_FindAllUserAccessKeyScopes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserAccessKeyServiceServer is the server API for UserAccessKeyService service.
 // All implementations should embed UnimplementedUserAccessKeyServiceServer
 // for forward compatibility
@@ -109,6 +133,10 @@ type UserAccessKeyServiceServer interface {
 	UpdateUserAccessKeyIsOn(context.Context, *UpdateUserAccessKeyIsOnRequest) (*RPCSuccess, error)
 	// 计算AccessKey数量
 	CountAllEnabledUserAccessKeys(context.Context, *CountAllEnabledUserAccessKeysRequest) (*RPCCountResponse, error)
+	// 修改AccessKey的权限范围
+	UpdateUserAccessKeyScopes(context.Context, *UpdateUserAccessKeyScopesRequest) (*RPCSuccess, error)
+	// 查找所有可用的权限范围
+	FindAllUserAccessKeyScopes(context.Context, *FindAllUserAccessKeyScopesRequest) (*FindAllUserAccessKeyScopesResponse, error)
 }
 
 // UnimplementedUserAccessKeyServiceServer should be embedded to have forward compatible implementations.
@@ -130,6 +158,12 @@ func (UnimplementedUserAccessKeyServiceServer) UpdateUserAccessKeyIsOn(context.C
 func (UnimplementedUserAccessKeyServiceServer) CountAllEnabledUserAccessKeys(context.Context, *CountAllEnabledUserAccessKeysRequest) (*RPCCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CountAllEnabledUserAccessKeys not implemented")
 }
+func (UnimplementedUserAccessKeyServiceServer) UpdateUserAccessKeyScopes(context.Context, *UpdateUserAccessKeyScopesRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserAccessKeyScopes not implemented")
+}
+func (UnimplementedUserAccessKeyServiceServer) FindAllUserAccessKeyScopes(context.Context, *FindAllUserAccessKeyScopesRequest) (*FindAllUserAccessKeyScopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllUserAccessKeyScopes not implemented")
+}
 
 // UnsafeUserAccessKeyServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to UserAccessKeyServiceServer will
@@ -232,6 +266,42 @@ func _UserAccessKeyService_CountAllEnabledUserAccessKeys_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserAccessKeyService_UpdateUserAccessKeyScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserAccessKeyScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAccessKeyServiceServer).UpdateUserAccessKeyScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAccessKeyService_UpdateUserAccessKeyScopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAccessKeyServiceServer).UpdateUserAccessKeyScopes(ctx, req.(*UpdateUserAccessKeyScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAccessKeyService_FindAllUserAccessKeyScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllUserAccessKeyScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAccessKeyServiceServer).FindAllUserAccessKeyScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAccessKeyService_FindAllUserAccessKeyScopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAccessKeyServiceServer).FindAllUserAccessKeyScopes(ctx, req.(*FindAllUserAccessKeyScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserAccessKeyService_ServiceDesc is the grpc.ServiceDesc for UserAccessKeyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -259,6 +329,14 @@ var UserAccessKeyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "countAllEnabledUserAccessKeys",
 			Handler:    _UserAccessKeyService_CountAllEnabledUserAccessKeys_Handler,
 		},
+		{
+			MethodName: "updateUserAccessKeyScopes",
+			Handler:    _UserAccessKeyService_UpdateUserAccessKeyScopes_Handler,
+		},
+		{
+			MethodName: "findAllUserAccessKeyScopes",
+			Handler:    _UserAccessKeyService_FindAllUserAccessKeyScopes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_user_access_key.proto",
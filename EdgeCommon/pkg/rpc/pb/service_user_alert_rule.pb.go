@@ -0,0 +1,774 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.19.4
+// source: service_user_alert_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 创建告警规则
+type CreateUserAlertRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        int64   `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`     // 用户ID，用户平台调用时不需要指定
+	ServerId      int64   `protobuf:"varint,2,opt,name=serverId,proto3" json:"serverId,omitempty"` // 网站ID，为0表示用户的所有网站
+	Item          string  `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	Value         float64 `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	NotifyMinutes int32   `protobuf:"varint,5,opt,name=notifyMinutes,proto3" json:"notifyMinutes,omitempty"`
+	ReceiversJSON []byte  `protobuf:"bytes,6,opt,name=receiversJSON,proto3" json:"receiversJSON,omitempty"`
+	IsOn          bool    `protobuf:"varint,7,opt,name=isOn,proto3" json:"isOn,omitempty"`
+}
+
+func (x *CreateUserAlertRuleRequest) Reset() {
+	*x = CreateUserAlertRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_user_alert_rule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserAlertRuleRequest) ProtoMessage() {}
+
+func (x *CreateUserAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_alert_rule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_alert_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateUserAlertRuleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateUserAlertRuleRequest) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *CreateUserAlertRuleRequest) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *CreateUserAlertRuleRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CreateUserAlertRuleRequest) GetNotifyMinutes() int32 {
+	if x != nil {
+		return x.NotifyMinutes
+	}
+	return 0
+}
+
+func (x *CreateUserAlertRuleRequest) GetReceiversJSON() []byte {
+	if x != nil {
+		return x.ReceiversJSON
+	}
+	return nil
+}
+
+func (x *CreateUserAlertRuleRequest) GetIsOn() bool {
+	if x != nil {
+		return x.IsOn
+	}
+	return false
+}
+
+type CreateUserAlertRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserAlertRuleId int64 `protobuf:"varint,1,opt,name=userAlertRuleId,proto3" json:"userAlertRuleId,omitempty"`
+}
+
+func (x *CreateUserAlertRuleResponse) Reset() {
+	*x = CreateUserAlertRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_user_alert_rule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserAlertRuleResponse) ProtoMessage() {}
+
+func (x *CreateUserAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_alert_rule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_service_user_alert_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateUserAlertRuleResponse) GetUserAlertRuleId() int64 {
+	if x != nil {
+		return x.UserAlertRuleId
+	}
+	return 0
+}
+
+// 修改告警规则
+type UpdateUserAlertRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserAlertRuleId int64   `protobuf:"varint,1,opt,name=userAlertRuleId,proto3" json:"userAlertRuleId,omitempty"`
+	ServerId        int64   `protobuf:"varint,2,opt,name=serverId,proto3" json:"serverId,omitempty"`
+	Item            string  `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	Value           float64 `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	NotifyMinutes   int32   `protobuf:"varint,5,opt,name=notifyMinutes,proto3" json:"notifyMinutes,omitempty"`
+	ReceiversJSON   []byte  `protobuf:"bytes,6,opt,name=receiversJSON,proto3" json:"receiversJSON,omitempty"`
+	IsOn            bool    `protobuf:"varint,7,opt,name=isOn,proto3" json:"isOn,omitempty"`
+}
+
+func (x *UpdateUserAlertRuleRequest) Reset() {
+	*x = UpdateUserAlertRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_user_alert_rule_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserAlertRuleRequest) ProtoMessage() {}
+
+func (x *UpdateUserAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_alert_rule_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_alert_rule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateUserAlertRuleRequest) GetUserAlertRuleId() int64 {
+	if x != nil {
+		return x.UserAlertRuleId
+	}
+	return 0
+}
+
+func (x *UpdateUserAlertRuleRequest) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *UpdateUserAlertRuleRequest) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *UpdateUserAlertRuleRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *UpdateUserAlertRuleRequest) GetNotifyMinutes() int32 {
+	if x != nil {
+		return x.NotifyMinutes
+	}
+	return 0
+}
+
+func (x *UpdateUserAlertRuleRequest) GetReceiversJSON() []byte {
+	if x != nil {
+		return x.ReceiversJSON
+	}
+	return nil
+}
+
+func (x *UpdateUserAlertRuleRequest) GetIsOn() bool {
+	if x != nil {
+		return x.IsOn
+	}
+	return false
+}
+
+// 删除告警规则
+type DeleteUserAlertRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserAlertRuleId int64 `protobuf:"varint,1,opt,name=userAlertRuleId,proto3" json:"userAlertRuleId,omitempty"`
+}
+
+func (x *DeleteUserAlertRuleRequest) Reset() {
+	*x = DeleteUserAlertRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_user_alert_rule_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteUserAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_alert_rule_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_alert_rule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteUserAlertRuleRequest) GetUserAlertRuleId() int64 {
+	if x != nil {
+		return x.UserAlertRuleId
+	}
+	return 0
+}
+
+// 查找单个告警规则
+type FindUserAlertRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserAlertRuleId int64 `protobuf:"varint,1,opt,name=userAlertRuleId,proto3" json:"userAlertRuleId,omitempty"`
+}
+
+func (x *FindUserAlertRuleRequest) Reset() {
+	*x = FindUserAlertRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_user_alert_rule_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserAlertRuleRequest) ProtoMessage() {}
+
+func (x *FindUserAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_alert_rule_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*FindUserAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_alert_rule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FindUserAlertRuleRequest) GetUserAlertRuleId() int64 {
+	if x != nil {
+		return x.UserAlertRuleId
+	}
+	return 0
+}
+
+type FindUserAlertRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserAlertRule *UserAlertRule `protobuf:"bytes,1,opt,name=userAlertRule,proto3" json:"userAlertRule,omitempty"`
+}
+
+func (x *FindUserAlertRuleResponse) Reset() {
+	*x = FindUserAlertRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_user_alert_rule_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserAlertRuleResponse) ProtoMessage() {}
+
+func (x *FindUserAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_alert_rule_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*FindUserAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_service_user_alert_rule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindUserAlertRuleResponse) GetUserAlertRule() *UserAlertRule {
+	if x != nil {
+		return x.UserAlertRule
+	}
+	return nil
+}
+
+// 查找用户的所有告警规则
+type FindAllUserAlertRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`     // 用户ID，用户平台调用时不需要指定
+	ServerId int64 `protobuf:"varint,2,opt,name=serverId,proto3" json:"serverId,omitempty"` // 可选，只查找某个网站的规则
+}
+
+func (x *FindAllUserAlertRulesRequest) Reset() {
+	*x = FindAllUserAlertRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_user_alert_rule_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllUserAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllUserAlertRulesRequest) ProtoMessage() {}
+
+func (x *FindAllUserAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_alert_rule_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllUserAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*FindAllUserAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_alert_rule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FindAllUserAlertRulesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FindAllUserAlertRulesRequest) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+type FindAllUserAlertRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserAlertRules []*UserAlertRule `protobuf:"bytes,1,rep,name=userAlertRules,proto3" json:"userAlertRules,omitempty"`
+}
+
+func (x *FindAllUserAlertRulesResponse) Reset() {
+	*x = FindAllUserAlertRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_user_alert_rule_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllUserAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllUserAlertRulesResponse) ProtoMessage() {}
+
+func (x *FindAllUserAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_alert_rule_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllUserAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*FindAllUserAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_service_user_alert_rule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FindAllUserAlertRulesResponse) GetUserAlertRules() []*UserAlertRule {
+	if x != nil {
+		return x.UserAlertRules
+	}
+	return nil
+}
+
+var File_service_user_alert_rule_proto protoreflect.FileDescriptor
+
+var file_service_user_alert_rule_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x02, 0x70, 0x62, 0x1a, 0x22, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f,
+	0x72, 0x70, 0x63, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xda, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x73, 0x4f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x22,
+	0x47, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0xec, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x4a,
+	0x53, 0x4f, 0x4e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x22, 0x46, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x75, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22,
+	0x44, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x75,
+	0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0d, 0x75, 0x73,
+	0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x52, 0x0a, 0x1c, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x5a, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0e, 0x75, 0x73, 0x65,
+	0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x32, 0xac, 0x03, 0x0a, 0x14,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x13,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x45, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x50, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x66, 0x69,
+	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15,
+	0x66, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_user_alert_rule_proto_rawDescOnce sync.Once
+	file_service_user_alert_rule_proto_rawDescData = file_service_user_alert_rule_proto_rawDesc
+)
+
+func file_service_user_alert_rule_proto_rawDescGZIP() []byte {
+	file_service_user_alert_rule_proto_rawDescOnce.Do(func() {
+		file_service_user_alert_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_user_alert_rule_proto_rawDescData)
+	})
+	return file_service_user_alert_rule_proto_rawDescData
+}
+
+var file_service_user_alert_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_service_user_alert_rule_proto_goTypes = []interface{}{
+	(*CreateUserAlertRuleRequest)(nil),    // 0: pb.CreateUserAlertRuleRequest
+	(*CreateUserAlertRuleResponse)(nil),   // 1: pb.CreateUserAlertRuleResponse
+	(*UpdateUserAlertRuleRequest)(nil),    // 2: pb.UpdateUserAlertRuleRequest
+	(*DeleteUserAlertRuleRequest)(nil),    // 3: pb.DeleteUserAlertRuleRequest
+	(*FindUserAlertRuleRequest)(nil),      // 4: pb.FindUserAlertRuleRequest
+	(*FindUserAlertRuleResponse)(nil),     // 5: pb.FindUserAlertRuleResponse
+	(*FindAllUserAlertRulesRequest)(nil),  // 6: pb.FindAllUserAlertRulesRequest
+	(*FindAllUserAlertRulesResponse)(nil), // 7: pb.FindAllUserAlertRulesResponse
+	(*UserAlertRule)(nil),                 // 8: pb.UserAlertRule
+	(*RPCSuccess)(nil),                    // 9: pb.RPCSuccess
+}
+var file_service_user_alert_rule_proto_depIdxs = []int32{
+	8, // 0: pb.FindUserAlertRuleResponse.userAlertRule:type_name -> pb.UserAlertRule
+	8, // 1: pb.FindAllUserAlertRulesResponse.userAlertRules:type_name -> pb.UserAlertRule
+	0, // 2: pb.UserAlertRuleService.createUserAlertRule:input_type -> pb.CreateUserAlertRuleRequest
+	2, // 3: pb.UserAlertRuleService.updateUserAlertRule:input_type -> pb.UpdateUserAlertRuleRequest
+	3, // 4: pb.UserAlertRuleService.deleteUserAlertRule:input_type -> pb.DeleteUserAlertRuleRequest
+	4, // 5: pb.UserAlertRuleService.findUserAlertRule:input_type -> pb.FindUserAlertRuleRequest
+	6, // 6: pb.UserAlertRuleService.findAllUserAlertRules:input_type -> pb.FindAllUserAlertRulesRequest
+	1, // 7: pb.UserAlertRuleService.createUserAlertRule:output_type -> pb.CreateUserAlertRuleResponse
+	9, // 8: pb.UserAlertRuleService.updateUserAlertRule:output_type -> pb.RPCSuccess
+	9, // 9: pb.UserAlertRuleService.deleteUserAlertRule:output_type -> pb.RPCSuccess
+	5, // 10: pb.UserAlertRuleService.findUserAlertRule:output_type -> pb.FindUserAlertRuleResponse
+	7, // 11: pb.UserAlertRuleService.findAllUserAlertRules:output_type -> pb.FindAllUserAlertRulesResponse
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_service_user_alert_rule_proto_init() }
+func file_service_user_alert_rule_proto_init() {
+	if File_service_user_alert_rule_proto != nil {
+		return
+	}
+	file_models_model_user_alert_rule_proto_init()
+	file_models_rpc_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_user_alert_rule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserAlertRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_user_alert_rule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserAlertRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_user_alert_rule_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserAlertRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_user_alert_rule_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserAlertRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_user_alert_rule_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUserAlertRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_user_alert_rule_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUserAlertRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_user_alert_rule_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllUserAlertRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_user_alert_rule_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllUserAlertRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_user_alert_rule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_user_alert_rule_proto_goTypes,
+		DependencyIndexes: file_service_user_alert_rule_proto_depIdxs,
+		MessageInfos:      file_service_user_alert_rule_proto_msgTypes,
+	}.Build()
+	File_service_user_alert_rule_proto = out.File
+	file_service_user_alert_rule_proto_rawDesc = nil
+	file_service_user_alert_rule_proto_goTypes = nil
+	file_service_user_alert_rule_proto_depIdxs = nil
+}
@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: service_user_alert_rule.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	UserAlertRuleService_CreateUserAlertRule_FullMethodName   = "/pb.UserAlertRuleService/createUserAlertRule"
+	UserAlertRuleService_UpdateUserAlertRule_FullMethodName   = "/pb.UserAlertRuleService/updateUserAlertRule"
+	UserAlertRuleService_DeleteUserAlertRule_FullMethodName   = "/pb.UserAlertRuleService/deleteUserAlertRule"
+	UserAlertRuleService_FindUserAlertRule_FullMethodName     = "/pb.UserAlertRuleService/findUserAlertRule"
+	UserAlertRuleService_FindAllUserAlertRules_FullMethodName = "/pb.UserAlertRuleService/findAllUserAlertRules"
+)
+
+// UserAlertRuleServiceClient is the client API for UserAlertRuleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserAlertRuleServiceClient interface {
+	// 创建告警规则
+	CreateUserAlertRule(ctx context.Context, in *CreateUserAlertRuleRequest, opts ...grpc.CallOption) (*CreateUserAlertRuleResponse, error)
+	// 修改告警规则
+	UpdateUserAlertRule(ctx context.Context, in *UpdateUserAlertRuleRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 删除告警规则
+	DeleteUserAlertRule(ctx context.Context, in *DeleteUserAlertRuleRequest, opts ...grpc.CallOption) (*RPCSuccess, error)
+	// 查找单个告警规则
+	FindUserAlertRule(ctx context.Context, in *FindUserAlertRuleRequest, opts ...grpc.CallOption) (*FindUserAlertRuleResponse, error)
+	// 查找用户的所有告警规则
+	FindAllUserAlertRules(ctx context.Context, in *FindAllUserAlertRulesRequest, opts ...grpc.CallOption) (*FindAllUserAlertRulesResponse, error)
+}
+
+type userAlertRuleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserAlertRuleServiceClient(cc grpc.ClientConnInterface) UserAlertRuleServiceClient {
+	return &userAlertRuleServiceClient{cc}
+}
+
+func (c *userAlertRuleServiceClient) CreateUserAlertRule(ctx context.Context, in *CreateUserAlertRuleRequest, opts ...grpc.CallOption) (*CreateUserAlertRuleResponse, error) {
+	out := new(CreateUserAlertRuleResponse)
+	err := c.cc.Invoke(ctx, UserAlertRuleService_CreateUserAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAlertRuleServiceClient) UpdateUserAlertRule(ctx context.Context, in *UpdateUserAlertRuleRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, UserAlertRuleService_UpdateUserAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAlertRuleServiceClient) DeleteUserAlertRule(ctx context.Context, in *DeleteUserAlertRuleRequest, opts ...grpc.CallOption) (*RPCSuccess, error) {
+	out := new(RPCSuccess)
+	err := c.cc.Invoke(ctx, UserAlertRuleService_DeleteUserAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAlertRuleServiceClient) FindUserAlertRule(ctx context.Context, in *FindUserAlertRuleRequest, opts ...grpc.CallOption) (*FindUserAlertRuleResponse, error) {
+	out := new(FindUserAlertRuleResponse)
+	err := c.cc.Invoke(ctx, UserAlertRuleService_FindUserAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAlertRuleServiceClient) FindAllUserAlertRules(ctx context.Context, in *FindAllUserAlertRulesRequest, opts ...grpc.CallOption) (*FindAllUserAlertRulesResponse, error) {
+	out := new(FindAllUserAlertRulesResponse)
+	err := c.cc.Invoke(ctx, UserAlertRuleService_FindAllUserAlertRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserAlertRuleServiceServer is the server API for UserAlertRuleService service.
+// All implementations should embed UnimplementedUserAlertRuleServiceServer
+// for forward compatibility
+type UserAlertRuleServiceServer interface {
+	// 创建告警规则
+	CreateUserAlertRule(context.Context, *CreateUserAlertRuleRequest) (*CreateUserAlertRuleResponse, error)
+	// 修改告警规则
+	UpdateUserAlertRule(context.Context, *UpdateUserAlertRuleRequest) (*RPCSuccess, error)
+	// 删除告警规则
+	DeleteUserAlertRule(context.Context, *DeleteUserAlertRuleRequest) (*RPCSuccess, error)
+	// 查找单个告警规则
+	FindUserAlertRule(context.Context, *FindUserAlertRuleRequest) (*FindUserAlertRuleResponse, error)
+	// 查找用户的所有告警规则
+	FindAllUserAlertRules(context.Context, *FindAllUserAlertRulesRequest) (*FindAllUserAlertRulesResponse, error)
+}
+
+// UnimplementedUserAlertRuleServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedUserAlertRuleServiceServer struct {
+}
+
+func (UnimplementedUserAlertRuleServiceServer) CreateUserAlertRule(context.Context, *CreateUserAlertRuleRequest) (*CreateUserAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserAlertRule not implemented")
+}
+func (UnimplementedUserAlertRuleServiceServer) UpdateUserAlertRule(context.Context, *UpdateUserAlertRuleRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserAlertRule not implemented")
+}
+func (UnimplementedUserAlertRuleServiceServer) DeleteUserAlertRule(context.Context, *DeleteUserAlertRuleRequest) (*RPCSuccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserAlertRule not implemented")
+}
+func (UnimplementedUserAlertRuleServiceServer) FindUserAlertRule(context.Context, *FindUserAlertRuleRequest) (*FindUserAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUserAlertRule not implemented")
+}
+func (UnimplementedUserAlertRuleServiceServer) FindAllUserAlertRules(context.Context, *FindAllUserAlertRulesRequest) (*FindAllUserAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllUserAlertRules not implemented")
+}
+
+// UnsafeUserAlertRuleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserAlertRuleServiceServer will
+// result in compilation errors.
+type UnsafeUserAlertRuleServiceServer interface {
+	mustEmbedUnimplementedUserAlertRuleServiceServer()
+}
+
+func RegisterUserAlertRuleServiceServer(s grpc.ServiceRegistrar, srv UserAlertRuleServiceServer) {
+	s.RegisterService(&UserAlertRuleService_ServiceDesc, srv)
+}
+
+func _UserAlertRuleService_CreateUserAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAlertRuleServiceServer).CreateUserAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAlertRuleService_CreateUserAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAlertRuleServiceServer).CreateUserAlertRule(ctx, req.(*CreateUserAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAlertRuleService_UpdateUserAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAlertRuleServiceServer).UpdateUserAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAlertRuleService_UpdateUserAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAlertRuleServiceServer).UpdateUserAlertRule(ctx, req.(*UpdateUserAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAlertRuleService_DeleteUserAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAlertRuleServiceServer).DeleteUserAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAlertRuleService_DeleteUserAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAlertRuleServiceServer).DeleteUserAlertRule(ctx, req.(*DeleteUserAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAlertRuleService_FindUserAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAlertRuleServiceServer).FindUserAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAlertRuleService_FindUserAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAlertRuleServiceServer).FindUserAlertRule(ctx, req.(*FindUserAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAlertRuleService_FindAllUserAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllUserAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAlertRuleServiceServer).FindAllUserAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAlertRuleService_FindAllUserAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAlertRuleServiceServer).FindAllUserAlertRules(ctx, req.(*FindAllUserAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserAlertRuleService_ServiceDesc is the grpc.ServiceDesc for UserAlertRuleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserAlertRuleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.UserAlertRuleService",
+	HandlerType: (*UserAlertRuleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "createUserAlertRule",
+			Handler:    _UserAlertRuleService_CreateUserAlertRule_Handler,
+		},
+		{
+			MethodName: "updateUserAlertRule",
+			Handler:    _UserAlertRuleService_UpdateUserAlertRule_Handler,
+		},
+		{
+			MethodName: "deleteUserAlertRule",
+			Handler:    _UserAlertRuleService_DeleteUserAlertRule_Handler,
+		},
+		{
+			MethodName: "findUserAlertRule",
+			Handler:    _UserAlertRuleService_FindUserAlertRule_Handler,
+		},
+		{
+			MethodName: "findAllUserAlertRules",
+			Handler:    _UserAlertRuleService_FindAllUserAlertRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_user_alert_rule.proto",
+}
@@ -1,6 +1,7 @@
 package serverconfigs
 
 import (
+	"net"
 	"regexp"
 	"strconv"
 	"strings"
@@ -21,6 +22,8 @@ type NetworkAddressConfig struct {
 	MinPort int `yaml:"minPort" json:"minPort"` // minPort和maxPort只是用来记录PortRange分解后的结果，不需要用户输入
 	MaxPort int `yaml:"maxPort" json:"maxPort"`
 
+	ResolvedIPs []string `yaml:"resolvedIPs" json:"resolvedIPs"` // API节点解析的IP地址，仅在Host为域名时有效
+
 	hostHasVariables bool
 }
 
@@ -100,6 +103,26 @@ func (this *NetworkAddressConfig) PickAddress() string {
 	return configutils.QuoteIP(this.Host) + ":" + strconv.Itoa(this.MinPort)
 }
 
+// PickResolvedAddress 将地址中的主机名替换为API节点解析的某个IP，以减少节点上的DNS查询
+// 如果地址中的主机名和当前配置不一致或者没有解析结果，则返回原地址
+func (this *NetworkAddressConfig) PickResolvedAddress(addr string) string {
+	var countIPs = len(this.ResolvedIPs)
+	if countIPs == 0 || this.hostHasVariables {
+		return addr
+	}
+
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || host != this.Host {
+		return addr
+	}
+
+	var ip = this.ResolvedIPs[0]
+	if countIPs > 1 {
+		ip = this.ResolvedIPs[rands.Int(0, countIPs-1)]
+	}
+	return net.JoinHostPort(ip, port)
+}
+
 // HostHasVariables 判断Host是否包含变量
 func (this *NetworkAddressConfig) HostHasVariables() bool {
 	return this.hostHasVariables
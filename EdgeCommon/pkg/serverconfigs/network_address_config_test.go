@@ -117,3 +117,30 @@ func TestNetworkAddressConfig_PickAddress(t *testing.T) {
 		t.Log(addr.PickAddress())
 	}
 }
+
+func TestNetworkAddressConfig_PickResolvedAddress(t *testing.T) {
+	var addr = &NetworkAddressConfig{
+		Protocol:  "http",
+		Host:      "example.com",
+		PortRange: "8080",
+	}
+	err := addr.Init()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if addr.PickResolvedAddress("example.com:8080") != "example.com:8080" {
+		t.Fatal("should not be replaced without resolved ips")
+	}
+
+	addr.ResolvedIPs = []string{"192.168.1.100", "::1"}
+	for i := 0; i < 5; i++ {
+		var result = addr.PickResolvedAddress("example.com:8080")
+		if result != "192.168.1.100:8080" && result != "[::1]:8080" {
+			t.Fatal("unexpected address: " + result)
+		}
+	}
+	if addr.PickResolvedAddress("other.com:8080") != "other.com:8080" {
+		t.Fatal("should not replace other hosts")
+	}
+}
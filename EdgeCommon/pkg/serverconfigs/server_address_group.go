@@ -140,6 +140,9 @@ func (this *ServerAddressGroup) FirstTLSServer() *ServerConfig {
 
 // MatchServerName 使用域名查找服务
 func (this *ServerAddressGroup) MatchServerName(serverName string) *ServerConfig {
+	// 客户端可能使用大写字母或者末尾带点（.）的域名
+	serverName = strings.ToLower(strings.TrimSuffix(serverName, "."))
+
 	var prefix = this.domainPrefix(serverName)
 
 	// 试图从缓存中读取
@@ -4,16 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"regexp"
 
 	"github.com/TeaOSLab/EdgeCommon/pkg/configutils"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/firewallconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
-	"golang.org/x/net/idna"
 )
 
-var normalServerNameReg = regexp.MustCompile(`^[a-zA-Z0-9.-]+$`)
-
 type ServerConfig struct {
 	Id               int64               `yaml:"id" json:"id"`                             // ID
 	ClusterId        int64               `yaml:"clusterId" json:"clusterId"`               // 集群ID
@@ -335,50 +331,25 @@ func (this *ServerConfig) IsUDPFamily() bool {
 // AllStrictNames 所有严格域名
 func (this *ServerConfig) AllStrictNames() []string {
 	var result = []string{}
-	for _, name := range this.AliasServerNames {
-		if len(name) > 0 {
-			if !configutils.IsFuzzyDomain(name) {
-				result = append(result, name)
-
-				// unicode domain
-				if !normalServerNameReg.MatchString(name) {
-					asciiName, err := idna.ToASCII(name)
-					if err == nil && len(asciiName) > 0 {
-						result = append(result, asciiName)
-					}
-				}
-			}
+	var add = func(name string) {
+		if len(name) == 0 || configutils.IsFuzzyDomain(name) {
+			return
+		}
+		result = append(result, name)
+
+		// unicode domain、大写字母、末尾带点的域名
+		asciiName, err := configutils.NormalizeDomain(name)
+		if err == nil && len(asciiName) > 0 && asciiName != name {
+			result = append(result, asciiName)
 		}
 	}
+	for _, name := range this.AliasServerNames {
+		add(name)
+	}
 	for _, serverName := range this.ServerNames {
-		var name = serverName.Name
-		if len(name) > 0 {
-			if !configutils.IsFuzzyDomain(name) {
-				result = append(result, name)
-
-				// unicode domain
-				if !normalServerNameReg.MatchString(name) {
-					asciiName, err := idna.ToASCII(name)
-					if err == nil && len(asciiName) > 0 {
-						result = append(result, asciiName)
-					}
-				}
-			}
-		}
+		add(serverName.Name)
 		for _, name := range serverName.SubNames {
-			if len(name) > 0 {
-				if !configutils.IsFuzzyDomain(name) {
-					result = append(result, name)
-
-					// unicode domain
-					if !normalServerNameReg.MatchString(name) {
-						asciiName, err := idna.ToASCII(name)
-						if err == nil && len(asciiName) > 0 {
-							result = append(result, asciiName)
-						}
-					}
-				}
-			}
+			add(name)
 		}
 	}
 	return result
@@ -387,26 +358,25 @@ func (this *ServerConfig) AllStrictNames() []string {
 // AllFuzzyNames 所有模糊域名
 func (this *ServerConfig) AllFuzzyNames() []string {
 	var result = []string{}
-	for _, name := range this.AliasServerNames {
-		if len(name) > 0 {
-			if configutils.IsFuzzyDomain(name) {
-				result = append(result, name)
-			}
+	var add = func(name string) {
+		if len(name) == 0 || !configutils.IsFuzzyDomain(name) {
+			return
+		}
+		result = append(result, name)
+
+		// unicode domain
+		asciiName, err := configutils.NormalizeDomain(name)
+		if err == nil && len(asciiName) > 0 && asciiName != name {
+			result = append(result, asciiName)
 		}
 	}
+	for _, name := range this.AliasServerNames {
+		add(name)
+	}
 	for _, serverName := range this.ServerNames {
-		var name = serverName.Name
-		if len(name) > 0 {
-			if configutils.IsFuzzyDomain(name) {
-				result = append(result, name)
-			}
-		}
+		add(serverName.Name)
 		for _, name := range serverName.SubNames {
-			if len(name) > 0 {
-				if configutils.IsFuzzyDomain(name) {
-					result = append(result, name)
-				}
-			}
+			add(name)
 		}
 	}
 	return result
@@ -27,6 +27,11 @@ type SSLCertConfig struct {
 	ServerName  string `yaml:"serverName" json:"serverName"`   // 证书使用的主机名，在请求TLS服务器时需要
 	IsCA        bool   `yaml:"isCA" json:"isCA"`               // 是否为CA证书
 	IsACME      bool   `yaml:"isACME" json:"isACME"`           // 是否通过ACME协议免费申请
+	RevokedAt   int64  `yaml:"revokedAt" json:"revokedAt"`     // 在证书颁发机构吊销的时间，为0表示未吊销
+
+	KeyStorage SSLCertKeyStorage `yaml:"keyStorage" json:"keyStorage"` // 私钥存储位置
+
+	Tags []string `yaml:"tags" json:"tags"` // 标签，用来分组管理证书
 
 	// 以下是从证书中分析所得
 	TimeBeginAt int64    `yaml:"timeBeginAt" json:"timeBeginAt"`
@@ -39,6 +44,10 @@ type SSLCertConfig struct {
 	OCSPExpiresAt int64  `yaml:"ocspExpiresAt" json:"ocspExpiresAt"`
 	OCSPError     string `yaml:"ocspError" json:"ocspError"`
 
+	// OCSP状态监控
+	OCSPStatus          SSLCertOCSPStatus `yaml:"ocspStatus" json:"ocspStatus"`                   // 证书状态
+	OCSPStatusCheckedAt int64             `yaml:"ocspStatusCheckedAt" json:"ocspStatusCheckedAt"` // 最后检查时间
+
 	cert      *tls.Certificate
 	caCerts   []*x509.Certificate
 	timeBegin time.Time
@@ -122,7 +131,17 @@ func (this *SSLCertConfig) Init(ctx context.Context) error {
 			}
 		}
 	} else { // 证书+私钥
-		cert, err := tls.X509KeyPair(this.CertData, this.KeyData)
+		// 私钥保存在节点上时，不写入到配置中，以免随配置一起被保存到其他地方
+		var keyData = this.KeyData
+		if this.KeyStorage == SSLCertKeyStorageNode && len(keyData) == 0 {
+			nodeKeyData, err := LoadNodeCertKey(this.CertData)
+			if err != nil {
+				return fmt.Errorf("load private key of certificate '%s' from node failed: %w", strconv.FormatInt(this.Id, 10), err)
+			}
+			keyData = nodeKeyData
+		}
+
+		cert, err := tls.X509KeyPair(this.CertData, keyData)
 		if err != nil {
 			return fmt.Errorf("load certificate '%s' failed: %w", strconv.FormatInt(this.Id, 10), err)
 		}
@@ -181,6 +200,20 @@ func (this *SSLCertConfig) MatchDomain(domain string) bool {
 	return configutils.MatchDomains(this.DNSNames, domain)
 }
 
+// IsSupersededBy 判断证书是否可以被新证书替代
+// 新证书需要包含当前证书的所有域名，并且过期时间更晚
+func (this *SSLCertConfig) IsSupersededBy(dnsNames []string, timeEndAt int64) bool {
+	if this.TimeEndAt >= timeEndAt {
+		return false
+	}
+	for _, dnsName := range this.DNSNames {
+		if !lists.ContainsString(dnsNames, dnsName) {
+			return false
+		}
+	}
+	return true
+}
+
 // CertObject 获取证书对象
 func (this *SSLCertConfig) CertObject() *tls.Certificate {
 	return this.cert
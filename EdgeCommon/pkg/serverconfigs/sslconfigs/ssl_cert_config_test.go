@@ -31,3 +31,15 @@ func TestSSLCertConfig_MatchDomain(t *testing.T) {
 
 	t.Log(config.DNSNames)
 }**/
+
+func TestSSLCertConfig_IsSupersededBy(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var cert = &sslconfigs.SSLCertConfig{
+		DNSNames:  []string{"a.com", "b.com"},
+		TimeEndAt: 100,
+	}
+	a.IsTrue(cert.IsSupersededBy([]string{"a.com", "b.com", "c.com"}, 200))
+	a.IsFalse(cert.IsSupersededBy([]string{"a.com", "b.com", "c.com"}, 100))
+	a.IsFalse(cert.IsSupersededBy([]string{"a.com"}, 200))
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package sslconfigs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var certFingerprintReg = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// CertFingerprint 计算证书的SHA256指纹
+// 和 openssl x509 -fingerprint -sha256 一样对第一个证书的DER数据计算，证书链中的中间证书不参与计算
+func CertFingerprint(certData []byte) (string, error) {
+	for {
+		block, rest := pem.Decode(certData)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			var sum = sha256.Sum256(block.Bytes)
+			return hex.EncodeToString(sum[:]), nil
+		}
+		certData = rest
+	}
+	return "", errors.New("no certificate found")
+}
+
+// NormalizeCertFingerprint 规范化证书指纹
+// 支持 AB:CD:... 和 sha256 Fingerprint=AB:CD:... 等常见格式，返回小写的十六进制字符串
+func NormalizeCertFingerprint(fingerprint string) (string, error) {
+	fingerprint = strings.TrimSpace(fingerprint)
+	var index = strings.LastIndex(fingerprint, "=")
+	if index >= 0 {
+		fingerprint = fingerprint[index+1:]
+	}
+	fingerprint = strings.NewReplacer(":", "", " ", "", "-", "").Replace(strings.ToLower(fingerprint))
+	if !certFingerprintReg.MatchString(fingerprint) {
+		return "", errors.New("invalid sha256 fingerprint")
+	}
+	return fingerprint, nil
+}
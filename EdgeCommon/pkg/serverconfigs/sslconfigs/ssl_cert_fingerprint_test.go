@@ -0,0 +1,78 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package sslconfigs_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestCertFingerprint(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certData1, _ := testGenerateCert(t, privateKey)
+	certData2, _ := testGenerateCert(t, privateKey)
+
+	fingerprint1, err := sslconfigs.CertFingerprint(certData1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(certData1)
+	var sum = sha256.Sum256(block.Bytes)
+	a.IsTrue(fingerprint1 == hex.EncodeToString(sum[:]))
+
+	// 同一个私钥签发的不同证书指纹不同
+	fingerprint2, err := sslconfigs.CertFingerprint(certData2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(fingerprint1 != fingerprint2)
+
+	// 证书链只计算第一个证书
+	fingerprint3, err := sslconfigs.CertFingerprint(append(append([]byte{}, certData1...), certData2...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.IsTrue(fingerprint1 == fingerprint3)
+
+	_, err = sslconfigs.CertFingerprint([]byte("abc"))
+	a.IsNotNil(err)
+}
+
+func TestNormalizeCertFingerprint(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var hexString = strings.Repeat("ab", 32)
+	var colonString = strings.TrimSuffix(strings.Repeat("AB:", 32), ":")
+
+	for _, s := range []string{
+		hexString,
+		strings.ToUpper(hexString),
+		colonString,
+		"sha256 Fingerprint=" + colonString,
+		"SHA256 Fingerprint=" + colonString + "\n",
+	} {
+		result, err := sslconfigs.NormalizeCertFingerprint(s)
+		a.IsNil(err)
+		a.IsTrue(result == hexString)
+	}
+
+	for _, s := range []string{"", "abc", strings.Repeat("zz", 32), strings.Repeat("ab", 20)} {
+		_, err := sslconfigs.NormalizeCertFingerprint(s)
+		a.IsNotNil(err)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package sslconfigs
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+)
+
+// 使用节点的X25519公钥加密证书私钥，只有持有对应私钥的节点才能解密
+// 格式：临时公钥(32) + nonce(12) + 密文
+
+// GenerateNodeSealKey 生成节点用来接收证书私钥的密钥对
+func GenerateNodeSealKey() (privateKey []byte, publicKey []byte, err error) {
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key.Bytes(), key.PublicKey().Bytes(), nil
+}
+
+// NodeSealPublicKey 根据节点私钥计算公钥
+func NodeSealPublicKey(nodePrivateKey []byte) ([]byte, error) {
+	key, err := ecdh.X25519().NewPrivateKey(nodePrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return key.PublicKey().Bytes(), nil
+}
+
+// SealNodeCertKey 使用节点公钥加密证书私钥
+func SealNodeCertKey(nodePublicKey []byte, keyData []byte) ([]byte, error) {
+	publicKey, err := ecdh.X25519().NewPublicKey(nodePublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ephemeralKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	sharedSecret, err := ephemeralKey.ECDH(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var ephemeralPublicKey = ephemeralKey.PublicKey().Bytes()
+	aead, err := newSealAEAD(sharedSecret, ephemeralPublicKey, nodePublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce = make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	var result = append([]byte{}, ephemeralPublicKey...)
+	result = append(result, nonce...)
+	return aead.Seal(result, nonce, keyData, ephemeralPublicKey), nil
+}
+
+// OpenNodeCertKey 使用节点私钥解密证书私钥
+func OpenNodeCertKey(nodePrivateKey []byte, sealedKeyData []byte) ([]byte, error) {
+	privateKey, err := ecdh.X25519().NewPrivateKey(nodePrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	const publicKeySize = 32
+	if len(sealedKeyData) < publicKeySize {
+		return nil, errors.New("invalid sealed key data")
+	}
+	var ephemeralPublicKeyBytes = sealedKeyData[:publicKeySize]
+	ephemeralPublicKey, err := ecdh.X25519().NewPublicKey(ephemeralPublicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	sharedSecret, err := privateKey.ECDH(ephemeralPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newSealAEAD(sharedSecret, ephemeralPublicKeyBytes, privateKey.PublicKey().Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	var rest = sealedKeyData[publicKeySize:]
+	if len(rest) < aead.NonceSize() {
+		return nil, errors.New("invalid sealed key data")
+	}
+	return aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], ephemeralPublicKeyBytes)
+}
+
+// 根据共享密钥和双方公钥生成AES-256-GCM
+func newSealAEAD(sharedSecret []byte, ephemeralPublicKey []byte, nodePublicKey []byte) (cipher.AEAD, error) {
+	var hash = sha256.New()
+	hash.Write(sharedSecret)
+	hash.Write(ephemeralPublicKey)
+	hash.Write(nodePublicKey)
+
+	block, err := aes.NewCipher(hash.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
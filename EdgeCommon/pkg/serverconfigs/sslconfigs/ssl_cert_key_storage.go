@@ -0,0 +1,76 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package sslconfigs
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"sync"
+)
+
+// SSLCertKeyStorage 证书私钥存储位置
+type SSLCertKeyStorage = string
+
+const (
+	SSLCertKeyStorageDB   SSLCertKeyStorage = ""     // 保存在数据库中
+	SSLCertKeyStorageNode SSLCertKeyStorage = "node" // 只保存在边缘节点上，数据库中只保存证书
+)
+
+// IsValidSSLCertKeyStorage 检查存储位置是否正确
+func IsValidSSLCertKeyStorage(storage SSLCertKeyStorage) bool {
+	return storage == SSLCertKeyStorageDB || storage == SSLCertKeyStorageNode
+}
+
+// NodeCertKeyLoader 从节点本地读取私钥
+type NodeCertKeyLoader func(fingerprint string) (keyData []byte, err error)
+
+var nodeCertKeyLoader NodeCertKeyLoader
+var nodeCertKeyLoaderLocker = &sync.RWMutex{}
+
+// SetNodeCertKeyLoader 设置节点本地私钥读取函数，由边缘节点在启动时设置
+func SetNodeCertKeyLoader(loader NodeCertKeyLoader) {
+	nodeCertKeyLoaderLocker.Lock()
+	nodeCertKeyLoader = loader
+	nodeCertKeyLoaderLocker.Unlock()
+}
+
+// LoadNodeCertKey 读取证书在节点本地保存的私钥
+func LoadNodeCertKey(certData []byte) ([]byte, error) {
+	nodeCertKeyLoaderLocker.RLock()
+	var loader = nodeCertKeyLoader
+	nodeCertKeyLoaderLocker.RUnlock()
+
+	if loader == nil {
+		return nil, errors.New("node key storage is not available")
+	}
+
+	fingerprint, err := CertKeyFingerprint(certData)
+	if err != nil {
+		return nil, err
+	}
+	return loader(fingerprint)
+}
+
+// CertKeyFingerprint 计算证书公钥的SHA256指纹
+// 同一个私钥签发的证书（比如续期时）指纹相同，因此可以用来在节点上查找对应的私钥
+func CertKeyFingerprint(certData []byte) (string, error) {
+	for {
+		block, rest := pem.Decode(certData)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return "", err
+			}
+			var sum = sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+			return hex.EncodeToString(sum[:]), nil
+		}
+		certData = rest
+	}
+	return "", errors.New("no certificate found")
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package sslconfigs
+
+// SSLCertOCSPStatus 通过OCSP检查的证书状态
+type SSLCertOCSPStatus = string
+
+const (
+	SSLCertOCSPStatusNone    SSLCertOCSPStatus = ""        // 尚未检查或者证书不支持OCSP
+	SSLCertOCSPStatusGood    SSLCertOCSPStatus = "good"    // 正常
+	SSLCertOCSPStatusRevoked SSLCertOCSPStatus = "revoked" // 已被吊销
+	SSLCertOCSPStatusUnknown SSLCertOCSPStatus = "unknown" // 证书颁发机构无法识别此证书
+)
+
+// FindSSLCertOCSPStatusName 获取OCSP状态名称
+func FindSSLCertOCSPStatusName(status SSLCertOCSPStatus) string {
+	switch status {
+	case SSLCertOCSPStatusGood:
+		return "正常"
+	case SSLCertOCSPStatusRevoked:
+		return "已吊销"
+	case SSLCertOCSPStatusUnknown:
+		return "未知"
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package sslconfigs
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MaxSSLCertTags      = 32 // 单个证书最多标签数量
+	MaxSSLCertTagLength = 64 // 单个标签最大长度（字符数）
+)
+
+// NormalizeSSLCertTags 整理证书标签：去除空白和重复的标签，并检查数量和长度
+func NormalizeSSLCertTags(tags []string) ([]string, error) {
+	var result = []string{}
+	var tagMap = map[string]bool{}
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if len(tag) == 0 || tagMap[tag] {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > MaxSSLCertTagLength {
+			return nil, errors.New("tag '" + tag + "' is too long, it should not be longer than " + strconv.Itoa(MaxSSLCertTagLength) + " characters")
+		}
+		tagMap[tag] = true
+		result = append(result, tag)
+	}
+	if len(result) > MaxSSLCertTags {
+		return nil, errors.New("too many tags, it should not be more than " + strconv.Itoa(MaxSSLCertTags))
+	}
+	return result, nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package sslconfigs_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestNormalizeSSLCertTags(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	tags, err := sslconfigs.NormalizeSSLCertTags([]string{" prod ", "", "team-a", "prod", "客户A"})
+	a.IsNil(err)
+	a.IsTrue(strings.Join(tags, ",") == "prod,team-a,客户A")
+
+	tags, err = sslconfigs.NormalizeSSLCertTags(nil)
+	a.IsNil(err)
+	a.IsTrue(len(tags) == 0)
+
+	_, err = sslconfigs.NormalizeSSLCertTags([]string{strings.Repeat("a", sslconfigs.MaxSSLCertTagLength+1)})
+	a.IsNotNil(err)
+
+	var manyTags = []string{}
+	for i := 0; i <= sslconfigs.MaxSSLCertTags; i++ {
+		manyTags = append(manyTags, "tag"+strings.Repeat("x", i))
+	}
+	_, err = sslconfigs.NormalizeSSLCertTags(manyTags)
+	a.IsNotNil(err)
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs
+
+import "errors"
+
+const DefaultAccessLogArchiveDays = 7
+
+// AccessLogArchiveConfig 访问日志归档设置
+// 启用后超过保留天数的访问日志表会先压缩上传到对象存储，然后再从数据库中删除，此时不再使用数据保留设置中的访问日志天数
+type AccessLogArchiveConfig struct {
+	IsOn bool             `yaml:"isOn" json:"isOn"` // 是否启用
+	Days int              `yaml:"days" json:"days"` // 数据库中保留的天数，更早的访问日志会被归档
+	S3   *S3StorageConfig `yaml:"s3" json:"s3"`     // 对象存储设置
+}
+
+func NewAccessLogArchiveConfig() *AccessLogArchiveConfig {
+	return &AccessLogArchiveConfig{
+		Days: DefaultAccessLogArchiveDays,
+	}
+}
+
+// Validate 校验设置
+func (this *AccessLogArchiveConfig) Validate() error {
+	if this.Days < 0 {
+		return errors.New("'days' should not be negative")
+	}
+	if this.IsOn {
+		if this.Days == 0 {
+			return errors.New("'days' should be greater than 0")
+		}
+		if this.S3 == nil {
+			return errors.New("'s3' should not be empty")
+		}
+		return this.S3.Validate()
+	}
+	return nil
+}
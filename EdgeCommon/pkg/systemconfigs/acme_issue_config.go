@@ -0,0 +1,22 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs
+
+// ACMEIssueConfig ACME证书签发并发设置
+type ACMEIssueConfig struct {
+	Concurrency       int `yaml:"concurrency" json:"concurrency"`             // 同时签发的证书数量
+	MaxPerProvider    int `yaml:"maxPerProvider" json:"maxPerProvider"`       // 每个ACME服务商（CA）同时签发的证书数量，0表示不限制
+	MaxPerAccount     int `yaml:"maxPerAccount" json:"maxPerAccount"`         // 每个ACME账号同时签发的证书数量，0表示不限制
+	MaxPerDNSProvider int `yaml:"maxPerDNSProvider" json:"maxPerDNSProvider"` // 每个DNS服务商账号同时签发的证书数量，0表示不限制
+	MaxRetries        int `yaml:"maxRetries" json:"maxRetries"`               // 签发失败后自动重试的最多次数，0表示不自动重试
+}
+
+func NewACMEIssueConfig() *ACMEIssueConfig {
+	return &ACMEIssueConfig{
+		Concurrency:       10,
+		MaxPerProvider:    5,
+		MaxPerAccount:     2,
+		MaxPerDNSProvider: 3,
+		MaxRetries:        5,
+	}
+}
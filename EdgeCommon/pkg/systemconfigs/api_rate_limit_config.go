@@ -0,0 +1,29 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs
+
+// APIRateLimitConfig API节点RPC请求频率限制设置
+// 按API令牌（即RPC请求中的nodeId）分别计算
+type APIRateLimitConfig struct {
+	IsOn  bool                `yaml:"isOn" json:"isOn"`   // 是否启用
+	QPS   float64             `yaml:"qps" json:"qps"`     // 每个令牌默认每秒可以请求的次数
+	Burst int                 `yaml:"burst" json:"burst"` // 每个令牌默认允许的突发请求次数
+	Rules []*APIRateLimitRule `yaml:"rules" json:"rules"` // 针对某个令牌或者某个方法的规则
+}
+
+func NewAPIRateLimitConfig() *APIRateLimitConfig {
+	return &APIRateLimitConfig{
+		IsOn:  false,
+		QPS:   100,
+		Burst: 200,
+	}
+}
+
+// APIRateLimitRule API请求频率限制规则
+// 同时匹配多个规则时，同时指定了令牌和方法的规则优先，其次是只指定了令牌的规则，最后是只指定了方法的规则
+type APIRateLimitRule struct {
+	NodeId string  `yaml:"nodeId" json:"nodeId"` // 令牌ID，为空表示所有令牌
+	Method string  `yaml:"method" json:"method"` // 完整方法名，比如 /pb.ServerService/findEnabledServer，为空表示所有方法；指定方法时，该方法单独计算请求次数
+	QPS    float64 `yaml:"qps" json:"qps"`       // 每秒可以请求的次数，0表示不限制
+	Burst  int     `yaml:"burst" json:"burst"`   // 允许的突发请求次数
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs
+
+import "errors"
+
+const (
+	BackupStorageLocal = "local" // 本地目录
+	BackupStorageS3    = "s3"    // S3兼容的对象存储
+
+	DefaultBackupIntervalHours = 24
+	DefaultBackupKeeps         = 7
+)
+
+// S3StorageConfig S3兼容的对象存储设置
+type S3StorageConfig struct {
+	Endpoint        string `yaml:"endpoint" json:"endpoint"`               // 服务地址，比如 https://s3.us-east-1.amazonaws.com
+	Region          string `yaml:"region" json:"region"`                   // 区域，比如 us-east-1
+	Bucket          string `yaml:"bucket" json:"bucket"`                   // 存储桶
+	Prefix          string `yaml:"prefix" json:"prefix"`                   // 对象前缀，比如 goedge/backups/
+	AccessKeyId     string `yaml:"accessKeyId" json:"accessKeyId"`         // AccessKey ID
+	AccessKeySecret string `yaml:"accessKeySecret" json:"accessKeySecret"` // AccessKey Secret
+	UsePathStyle    bool   `yaml:"usePathStyle" json:"usePathStyle"`       // 是否使用 endpoint/bucket/key 形式的地址，MinIO等服务通常需要开启
+}
+
+// Validate 校验设置
+func (this *S3StorageConfig) Validate() error {
+	if len(this.Endpoint) == 0 {
+		return errors.New("'endpoint' should not be empty")
+	}
+	if len(this.Bucket) == 0 {
+		return errors.New("'bucket' should not be empty")
+	}
+	if len(this.AccessKeyId) == 0 || len(this.AccessKeySecret) == 0 {
+		return errors.New("'accessKeyId' and 'accessKeySecret' should not be empty")
+	}
+	return nil
+}
+
+// BackupConfig 数据库备份设置
+// 备份包括数据库中所有的表和API节点的配置文件
+type BackupConfig struct {
+	IsOn              bool             `yaml:"isOn" json:"isOn"`                           // 是否启用定时备份
+	IntervalHours     int              `yaml:"intervalHours" json:"intervalHours"`         // 备份间隔（小时）
+	Keeps             int              `yaml:"keeps" json:"keeps"`                         // 保留的备份数量，超出后删除最早的备份，为0表示不删除
+	IncludeConfigs    bool             `yaml:"includeConfigs" json:"includeConfigs"`       // 是否同时备份API节点配置文件
+	IncludeAccessLogs bool             `yaml:"includeAccessLogs" json:"includeAccessLogs"` // 是否备份访问日志表，访问日志通常数据量很大
+	StorageType       string           `yaml:"storageType" json:"storageType"`             // 存储类型：local、s3
+	LocalDir          string           `yaml:"localDir" json:"localDir"`                   // 本地目录，为空时使用程序根目录下的 backups/
+	S3                *S3StorageConfig `yaml:"s3" json:"s3"`                               // S3存储设置
+}
+
+func NewBackupConfig() *BackupConfig {
+	return &BackupConfig{
+		IntervalHours:  DefaultBackupIntervalHours,
+		Keeps:          DefaultBackupKeeps,
+		IncludeConfigs: true,
+		StorageType:    BackupStorageLocal,
+	}
+}
+
+// Validate 校验设置
+func (this *BackupConfig) Validate() error {
+	if this.IntervalHours < 0 {
+		return errors.New("'intervalHours' should not be negative")
+	}
+	if this.Keeps < 0 {
+		return errors.New("'keeps' should not be negative")
+	}
+	switch this.StorageType {
+	case "", BackupStorageLocal:
+	case BackupStorageS3:
+		if this.S3 == nil {
+			return errors.New("'s3' should not be empty")
+		}
+		return this.S3.Validate()
+	default:
+		return errors.New("invalid storage type '" + this.StorageType + "'")
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron表达式的简写
+var cronExprAliases = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// CronExpr Cron表达式
+// 格式为：分 时 日 月 周，支持 *、?、列表（1,2）、范围（1-5）、步长（*/10、1-30/5），周日可以用0或7表示；
+// 同时限制了日和周时，满足其中一个即可
+type CronExpr struct {
+	raw string
+
+	minutes uint64
+	hours   uint64
+	days    uint64
+	months  uint64
+	weeks   uint64
+
+	isAnyDay  bool
+	isAnyWeek bool
+}
+
+// ParseCronExpr 解析Cron表达式
+func ParseCronExpr(expr string) (*CronExpr, error) {
+	expr = strings.TrimSpace(expr)
+	if len(expr) == 0 {
+		return nil, errors.New("cron expression should not be empty")
+	}
+
+	var raw = expr
+	alias, ok := cronExprAliases[strings.ToLower(expr)]
+	if ok {
+		expr = alias
+	}
+
+	var fields = strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errors.New("invalid cron expression '" + raw + "': expected 5 fields")
+	}
+
+	var cronExpr = &CronExpr{raw: raw}
+	var err error
+	for index, def := range []struct {
+		name  string
+		min   int
+		max   int
+		value *uint64
+	}{
+		{"minute", 0, 59, &cronExpr.minutes},
+		{"hour", 0, 23, &cronExpr.hours},
+		{"day", 1, 31, &cronExpr.days},
+		{"month", 1, 12, &cronExpr.months},
+		{"week", 0, 7, &cronExpr.weeks},
+	} {
+		*def.value, err = parseCronField(fields[index], def.min, def.max)
+		if err != nil {
+			return nil, errors.New("invalid cron expression '" + raw + "': invalid " + def.name + " field: " + err.Error())
+		}
+	}
+
+	// 周日
+	if cronExpr.weeks&(1<<7) > 0 {
+		cronExpr.weeks |= 1
+	}
+
+	cronExpr.isAnyDay = fields[2] == "*" || fields[2] == "?"
+	cronExpr.isAnyWeek = fields[4] == "*" || fields[4] == "?"
+
+	return cronExpr, nil
+}
+
+// String 原始的表达式
+func (this *CronExpr) String() string {
+	return this.raw
+}
+
+// Next 查找某个时间之后下一次执行的时间，精确到分钟
+// 5年内找不到时返回零值
+func (this *CronExpr) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	var maxYear = t.Year() + 5
+	for t.Year() <= maxYear {
+		if this.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !this.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if this.hours&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if this.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (this *CronExpr) matchDay(t time.Time) bool {
+	var dayMatched = this.days&(1<<uint(t.Day())) > 0
+	var weekMatched = this.weeks&(1<<uint(t.Weekday())) > 0
+	if this.isAnyDay || this.isAnyWeek {
+		return dayMatched && weekMatched
+	}
+	return dayMatched || weekMatched
+}
+
+// 解析单个字段，返回取值的位集合
+func parseCronField(field string, min int, max int) (uint64, error) {
+	var result uint64
+	for _, piece := range strings.Split(field, ",") {
+		var step = 1
+		stepIndex := strings.Index(piece, "/")
+		if stepIndex >= 0 {
+			var err error
+			step, err = strconv.Atoi(piece[stepIndex+1:])
+			if err != nil || step <= 0 {
+				return 0, errors.New("invalid step '" + piece + "'")
+			}
+			piece = piece[:stepIndex]
+		}
+
+		var from, to int
+		if piece == "*" || piece == "?" {
+			from, to = min, max
+		} else {
+			rangeIndex := strings.Index(piece, "-")
+			var err error
+			if rangeIndex >= 0 {
+				from, err = strconv.Atoi(piece[:rangeIndex])
+				if err == nil {
+					to, err = strconv.Atoi(piece[rangeIndex+1:])
+				}
+			} else {
+				from, err = strconv.Atoi(piece)
+				to = from
+				if stepIndex >= 0 {
+					to = max
+				}
+			}
+			if err != nil {
+				return 0, errors.New("invalid value '" + piece + "'")
+			}
+		}
+		if from < min || to > max || from > to {
+			return 0, errors.New("value '" + piece + "' out of range " + strconv.Itoa(min) + "-" + strconv.Itoa(max))
+		}
+
+		for i := from; i <= to; i += step {
+			result |= 1 << uint(i)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestParseCronExpr(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	for _, expr := range []string{"* * * * *", "*/5 * * * *", "0 3 * * *", "0 3 * * 1-5", "0,30 1-5/2 1 1,6 0", "0 0 * * 7", "@daily", "@Hourly"} {
+		_, err := systemconfigs.ParseCronExpr(expr)
+		a.IsNil(err)
+	}
+
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "a * * * *", "5-1 * * * *"} {
+		_, err := systemconfigs.ParseCronExpr(expr)
+		a.IsNotNil(err)
+	}
+}
+
+func TestCronExpr_Next(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var now = time.Date(2024, 5, 10, 3, 20, 30, 0, time.Local) // 星期五
+
+	for _, item := range []struct {
+		expr string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2024, 5, 10, 3, 21, 0, 0, time.Local)},
+		{"*/15 * * * *", time.Date(2024, 5, 10, 3, 30, 0, 0, time.Local)},
+		{"0 3 * * *", time.Date(2024, 5, 11, 3, 0, 0, 0, time.Local)},
+		{"30 4 * * *", time.Date(2024, 5, 10, 4, 30, 0, 0, time.Local)},
+		{"0 2 * * 1", time.Date(2024, 5, 13, 2, 0, 0, 0, time.Local)},
+		{"0 0 * * 0", time.Date(2024, 5, 12, 0, 0, 0, 0, time.Local)},
+		{"0 0 * * 7", time.Date(2024, 5, 12, 0, 0, 0, 0, time.Local)},
+		{"0 0 1 * *", time.Date(2024, 6, 1, 0, 0, 0, 0, time.Local)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.Local)},
+		{"0 0 15 * 1", time.Date(2024, 5, 13, 0, 0, 0, 0, time.Local)}, // 同时限制日和周时满足其中一个即可
+		{"@monthly", time.Date(2024, 6, 1, 0, 0, 0, 0, time.Local)},
+	} {
+		expr, err := systemconfigs.ParseCronExpr(item.expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var next = expr.Next(now)
+		if !next.Equal(item.next) {
+			t.Log(item.expr, "=>", next.String(), "expected:", item.next.String())
+		}
+		a.IsTrue(next.Equal(item.next))
+	}
+}
+
+func TestTaskScheduleConfig(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var config = systemconfigs.NewTaskScheduleConfig()
+	config.SetCronExpr("dbBackup", "0 3 * * *")
+	a.IsNil(config.Validate())
+	a.IsTrue(config.FindCronExpr("dbBackup") == "0 3 * * *")
+
+	config.SetCronExpr("dbBackup", "")
+	a.IsTrue(len(config.FindCronExpr("dbBackup")) == 0)
+
+	config.SetCronExpr("dbBackup", "0 25 * * *")
+	a.IsNotNil(config.Validate())
+}
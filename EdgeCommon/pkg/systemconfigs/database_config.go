@@ -6,6 +6,9 @@ type DatabaseConfig struct {
 		Clean struct {
 			Days int `json:"days"` // 日志保留天数，0表示不限制
 		} `json:"clean"` // 清理相关配置
+		FullTextSearch struct {
+			IsOn bool `json:"isOn"` // 是否在新的日志表中创建全文索引，只对MySQL有效
+		} `json:"fullTextSearch"` // 全文检索相关配置
 	} `json:"serverAccessLog"` // 服务访问日志相关配置
 
 	HTTPCacheTask struct {
@@ -0,0 +1,35 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs
+
+import "github.com/TeaOSLab/EdgeCommon/pkg/iplibrary"
+
+// IPLibraryConfig IP库配置
+type IPLibraryConfig struct {
+	Driver iplibrary.DriverType `yaml:"driver" json:"driver"` // 驱动类型
+
+	AutoUpdate         bool `yaml:"autoUpdate" json:"autoUpdate"`                 // 是否自动更新
+	UpdateIntervalDays int  `yaml:"updateIntervalDays" json:"updateIntervalDays"` // 自动更新间隔天数
+
+	// MaxMind
+	MaxMindLicenseKey string `yaml:"maxMindLicenseKey" json:"maxMindLicenseKey"` // License Key
+	MaxMindEditionId  string `yaml:"maxMindEditionId" json:"maxMindEditionId"`   // 数据库版本，比如 GeoLite2-City
+
+	// ipip.net
+	IPIPDownloadURL string `yaml:"ipipDownloadURL" json:"ipipDownloadURL"` // 数据库下载地址
+
+	LastUpdatedAt int64 `yaml:"lastUpdatedAt" json:"lastUpdatedAt"` // 上次自动更新时间
+}
+
+func NewIPLibraryConfig() *IPLibraryConfig {
+	return &IPLibraryConfig{
+		Driver:             iplibrary.DriverTypeDefault,
+		UpdateIntervalDays: 7,
+		MaxMindEditionId:   "GeoLite2-City",
+	}
+}
+
+// FindAllMaxMindEditions 所有支持的MaxMind数据库版本
+func FindAllMaxMindEditions() []string {
+	return []string{"GeoLite2-City", "GeoIP2-City"}
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs
+
+import "errors"
+
+const (
+	DefaultMTLSClientCertDays = 90
+	MaxMTLSClientCertDays     = 825
+)
+
+// MTLSConfig API节点和其他节点之间的双向TLS认证设置
+// 客户端证书由API节点内置的CA签发，只在HTTPS端口上生效
+type MTLSConfig struct {
+	IsOn           bool     `yaml:"isOn" json:"isOn"`                     // 是否启用，启用后HTTPS端口会要求并校验客户端证书
+	IsEnforced     bool     `yaml:"isEnforced" json:"isEnforced"`         // 是否强制，强制后没有合法客户端证书的请求会被拒绝
+	Roles          []string `yaml:"roles" json:"roles"`                   // 需要客户端证书的节点角色，为空表示 node, admin, user
+	ClientCertDays int      `yaml:"clientCertDays" json:"clientCertDays"` // 客户端证书有效期（天）
+}
+
+func NewMTLSConfig() *MTLSConfig {
+	return &MTLSConfig{
+		ClientCertDays: DefaultMTLSClientCertDays,
+	}
+}
+
+// DefaultMTLSRoles 默认需要客户端证书的节点角色
+func DefaultMTLSRoles() []string {
+	return []string{"node", "admin", "user"}
+}
+
+// Validate 校验设置
+func (this *MTLSConfig) Validate() error {
+	if this.ClientCertDays < 0 || this.ClientCertDays > MaxMTLSClientCertDays {
+		return errors.New("'clientCertDays' should be between 0 and 825")
+	}
+	if this.IsEnforced && !this.IsOn {
+		return errors.New("mTLS should be on before enforced")
+	}
+	return nil
+}
+
+// CertDays 客户端证书有效期
+func (this *MTLSConfig) CertDays() int {
+	if this == nil || this.ClientCertDays <= 0 {
+		return DefaultMTLSClientCertDays
+	}
+	return this.ClientCertDays
+}
+
+// RequireRole 检查某个角色的请求是否必须携带客户端证书
+func (this *MTLSConfig) RequireRole(role string) bool {
+	if this == nil || !this.IsOn || !this.IsEnforced {
+		return false
+	}
+	var roles = this.Roles
+	if len(roles) == 0 {
+		roles = DefaultMTLSRoles()
+	}
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
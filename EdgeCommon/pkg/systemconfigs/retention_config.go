@@ -0,0 +1,79 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs
+
+import "time"
+
+// RetentionPolicy 某类数据的保留策略
+type RetentionPolicy struct {
+	Days      int `yaml:"days" json:"days"`           // 保留天数
+	Hours     int `yaml:"hours" json:"hours"`         // 保留小时数，用于保留时间较短的数据，设置后优先于天数
+	BatchSize int `yaml:"batchSize" json:"batchSize"` // 每批删除的数量，为0时使用默认值
+}
+
+// IsOn 是否需要清理
+func (this *RetentionPolicy) IsOn() bool {
+	return this != nil && (this.Days > 0 || this.Hours > 0)
+}
+
+// ExpiresTime 过期时间，此时间之前的数据可以被删除
+func (this *RetentionPolicy) ExpiresTime(now time.Time) time.Time {
+	if !this.IsOn() {
+		return time.Time{}
+	}
+	if this.Hours > 0 {
+		return now.Add(-time.Duration(this.Hours) * time.Hour)
+	}
+	return now.AddDate(0, 0, -this.Days)
+}
+
+// RetentionConfig 数据保留设置
+// 清理任务会按照此设置分批删除过期的数据
+type RetentionConfig struct {
+	AccessLog  *RetentionPolicy `yaml:"accessLog" json:"accessLog"`   // 访问日志，按天删除日志表
+	NodeValue  *RetentionPolicy `yaml:"nodeValue" json:"nodeValue"`   // 节点监控数据
+	NodeLog    *RetentionPolicy `yaml:"nodeLog" json:"nodeLog"`       // 节点运行日志
+	TaskLog    *RetentionPolicy `yaml:"taskLog" json:"taskLog"`       // 消息发送任务和发送日志
+	Message    *RetentionPolicy `yaml:"message" json:"message"`       // 消息
+	MetricStat *RetentionPolicy `yaml:"metricStat" json:"metricStat"` // 指标统计数据，指标本身设置的保留周期更短时以指标为准
+	TaskRun    *RetentionPolicy `yaml:"taskRun" json:"taskRun"`       // 后台任务执行记录
+}
+
+func NewRetentionConfig() *RetentionConfig {
+	return &RetentionConfig{
+		AccessLog:  &RetentionPolicy{Days: 14},
+		NodeValue:  &RetentionPolicy{Hours: 2},
+		NodeLog:    &RetentionPolicy{Days: 7},
+		TaskLog:    &RetentionPolicy{Days: 30},
+		Message:    &RetentionPolicy{Days: 30},
+		MetricStat: &RetentionPolicy{},
+		TaskRun:    &RetentionPolicy{Days: 7},
+	}
+}
+
+// Init 初始化
+// 补充没有设置的数据类型
+func (this *RetentionConfig) Init() {
+	var defaultConfig = NewRetentionConfig()
+	if this.AccessLog == nil {
+		this.AccessLog = defaultConfig.AccessLog
+	}
+	if this.NodeValue == nil {
+		this.NodeValue = defaultConfig.NodeValue
+	}
+	if this.NodeLog == nil {
+		this.NodeLog = defaultConfig.NodeLog
+	}
+	if this.TaskLog == nil {
+		this.TaskLog = defaultConfig.TaskLog
+	}
+	if this.Message == nil {
+		this.Message = defaultConfig.Message
+	}
+	if this.MetricStat == nil {
+		this.MetricStat = defaultConfig.MetricStat
+	}
+	if this.TaskRun == nil {
+		this.TaskRun = defaultConfig.TaskRun
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/systemconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestRetentionConfig_Init(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var config = &systemconfigs.RetentionConfig{}
+	err := json.Unmarshal([]byte(`{"message":{"days":7}}`), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.Init()
+	a.IsTrue(config.Message.Days == 7)
+	a.IsTrue(config.TaskLog.Days == 30)
+	a.IsTrue(config.NodeValue.Hours == 2)
+	a.IsFalse(config.MetricStat.IsOn())
+	a.IsTrue(config.TaskRun.Days == 7)
+}
+
+func TestRetentionPolicy_ExpiresTime(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var now = time.Date(2024, 5, 10, 12, 0, 0, 0, time.Local)
+	a.IsTrue((&systemconfigs.RetentionPolicy{Days: 3}).ExpiresTime(now).Equal(time.Date(2024, 5, 7, 12, 0, 0, 0, time.Local)))
+	a.IsTrue((&systemconfigs.RetentionPolicy{Days: 3, Hours: 2}).ExpiresTime(now).Equal(time.Date(2024, 5, 10, 10, 0, 0, 0, time.Local)))
+	a.IsTrue((&systemconfigs.RetentionPolicy{}).ExpiresTime(now).IsZero())
+
+	var nilPolicy *systemconfigs.RetentionPolicy
+	a.IsFalse(nilPolicy.IsOn())
+}
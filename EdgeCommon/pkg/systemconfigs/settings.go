@@ -11,10 +11,21 @@ const (
 	SettingCodeDatabaseConfigSetting SettingCode = "databaseConfig"      // 数据库相关配置
 	SettingCodeAccessLogQueue        SettingCode = "accessLogQueue"      // 访问日志队列
 	SettingCodeCheckUpdates          SettingCode = "checkUpdates"        // 检查自动更新配置
+	SettingCodeIPLibraryConfig       SettingCode = "ipLibraryConfig"     // IP库配置
+	SettingCodeACMEIssueConfig       SettingCode = "acmeIssueConfig"     // ACME证书签发设置
+	SettingCodeSSLCertExpireNotice   SettingCode = "sslCertExpireNotice" // SSL证书过期提醒设置
+	SettingCodeAPIRateLimitConfig    SettingCode = "apiRateLimitConfig"  // API请求频率限制
+	SettingCodeMTLSConfig            SettingCode = "mtlsConfig"          // API节点双向TLS认证设置
+	SettingCodeInternalCA            SettingCode = "internalCA"          // 内部CA证书，用来给节点签发客户端证书
+	SettingCodeRetentionConfig       SettingCode = "retentionConfig"     // 数据保留设置
+	SettingCodeBackupConfig          SettingCode = "backupConfig"        // 数据库备份设置
+	SettingCodeAccessLogArchive      SettingCode = "accessLogArchive"    // 访问日志归档设置
+	SettingCodeTaskScheduleConfig    SettingCode = "taskScheduleConfig"  // 后台任务执行计划
 
 	SettingCodeUserServerConfig   SettingCode = "userServerConfig"   // 用户服务设置
 	SettingCodeUserRegisterConfig SettingCode = "userRegisterConfig" // 用户注册配置
 	SettingCodeUserUIConfig       SettingCode = "userUIConfig"       // 用户界面配置
+	SettingCodeUserQuotaConfig    SettingCode = "userQuotaConfig"    // 用户对象数量限制
 
 	SettingCodeStandaloneInstanceInitialized SettingCode = "standaloneInstanceInitialized" // 单体实例是否已经被初始化：0 未被初始化, 1 已经成功初始化
 )
@@ -0,0 +1,60 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs
+
+import "sort"
+
+// SSLCertExpireNoticeConfig SSL证书过期提醒设置
+type SSLCertExpireNoticeConfig struct {
+	IsOn bool  `yaml:"isOn" json:"isOn"` // 是否启用
+	Days []int `yaml:"days" json:"days"` // 在过期前N天提醒，每个证书在每个时间点只提醒一次
+}
+
+func NewSSLCertExpireNoticeConfig() *SSLCertExpireNoticeConfig {
+	return &SSLCertExpireNoticeConfig{
+		IsOn: true,
+		Days: []int{30, 14, 7, 3, 1},
+	}
+}
+
+// Init 初始化
+// 去除重复和无效的天数，并从大到小排序
+func (this *SSLCertExpireNoticeConfig) Init() {
+	var days = []int{}
+	var dayMap = map[int]bool{}
+	for _, day := range this.Days {
+		if day <= 0 || dayMap[day] {
+			continue
+		}
+		dayMap[day] = true
+		days = append(days, day)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(days)))
+	this.Days = days
+}
+
+// MaxDays 最早提醒的天数
+func (this *SSLCertExpireNoticeConfig) MaxDays() int {
+	var maxDays = 0
+	for _, day := range this.Days {
+		if day > maxDays {
+			maxDays = day
+		}
+	}
+	return maxDays
+}
+
+// MatchDays 根据证书剩余的秒数，查找当前所处的提醒时间点
+// 返回大于等于剩余天数的最小时间点，所以错过的较早时间点不会重复提醒
+func (this *SSLCertExpireNoticeConfig) MatchDays(remainingSeconds int64) (days int, ok bool) {
+	if remainingSeconds <= 0 {
+		return 0, false
+	}
+	for _, day := range this.Days {
+		if remainingSeconds <= int64(day)*86400 && (!ok || day < days) {
+			days = day
+			ok = true
+		}
+	}
+	return
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package systemconfigs
+
+// TaskScheduleConfig 后台任务执行计划设置
+// 没有设置执行计划的任务按照默认的间隔定时执行
+type TaskScheduleConfig struct {
+	CronExprs map[string]string `yaml:"cronExprs" json:"cronExprs"` // 任务代号 => Cron表达式
+}
+
+func NewTaskScheduleConfig() *TaskScheduleConfig {
+	return &TaskScheduleConfig{
+		CronExprs: map[string]string{},
+	}
+}
+
+// Validate 校验设置
+func (this *TaskScheduleConfig) Validate() error {
+	for _, expr := range this.CronExprs {
+		_, err := ParseCronExpr(expr)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindCronExpr 查找某个任务的Cron表达式
+func (this *TaskScheduleConfig) FindCronExpr(taskCode string) string {
+	if this.CronExprs == nil {
+		return ""
+	}
+	return this.CronExprs[taskCode]
+}
+
+// SetCronExpr 设置某个任务的Cron表达式，为空表示恢复默认的间隔
+func (this *TaskScheduleConfig) SetCronExpr(taskCode string, expr string) {
+	if this.CronExprs == nil {
+		this.CronExprs = map[string]string{}
+	}
+	if len(expr) == 0 {
+		delete(this.CronExprs, taskCode)
+		return
+	}
+	this.CronExprs[taskCode] = expr
+}
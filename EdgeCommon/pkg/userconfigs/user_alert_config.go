@@ -0,0 +1,95 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package userconfigs
+
+import (
+	"errors"
+	"net/url"
+)
+
+// UserAlertItem 用户告警监控项
+type UserAlertItem = string
+
+const (
+	UserAlertItemDailyTraffic   UserAlertItem = "dailyTraffic"   // 当日流量，单位GiB
+	UserAlertItemBandwidth      UserAlertItem = "bandwidth"      // 最近5分钟带宽峰值，单位Mbps
+	UserAlertItemStatus4xxRatio UserAlertItem = "status4xxRatio" // 最近5分钟4xx响应比例，单位%
+	UserAlertItemStatus5xxRatio UserAlertItem = "status5xxRatio" // 最近5分钟5xx响应比例，单位%
+)
+
+// UserAlertMinRequests 计算状态码比例时最少需要的请求数，避免请求数过少时误报
+const UserAlertMinRequests = 100
+
+// UserAlertItemDefinition 监控项定义
+type UserAlertItemDefinition struct {
+	Name        string `json:"name"`
+	Code        string `json:"code"`
+	Unit        string `json:"unit"`
+	Description string `json:"description"`
+}
+
+// FindAllUserAlertItems 所有监控项
+func FindAllUserAlertItems() []*UserAlertItemDefinition {
+	return []*UserAlertItemDefinition{
+		{
+			Name:        "当日流量",
+			Code:        UserAlertItemDailyTraffic,
+			Unit:        "GiB",
+			Description: "当天累计流量超过阈值时告警。",
+		},
+		{
+			Name:        "带宽",
+			Code:        UserAlertItemBandwidth,
+			Unit:        "Mbps",
+			Description: "最近5分钟带宽峰值超过阈值时告警。",
+		},
+		{
+			Name:        "4xx比例",
+			Code:        UserAlertItemStatus4xxRatio,
+			Unit:        "%",
+			Description: "最近5分钟4xx响应占比超过阈值时告警。",
+		},
+		{
+			Name:        "5xx比例",
+			Code:        UserAlertItemStatus5xxRatio,
+			Unit:        "%",
+			Description: "最近5分钟5xx响应占比超过阈值时告警。",
+		},
+	}
+}
+
+// FindUserAlertItem 查找监控项定义
+func FindUserAlertItem(code UserAlertItem) *UserAlertItemDefinition {
+	for _, item := range FindAllUserAlertItems() {
+		if item.Code == code {
+			return item
+		}
+	}
+	return nil
+}
+
+// UserAlertReceiversConfig 告警接收设置
+type UserAlertReceiversConfig struct {
+	NotifyMessage bool   `yaml:"notifyMessage" json:"notifyMessage"` // 发送站内消息
+	WebhookURL    string `yaml:"webhookURL" json:"webhookURL"`       // Webhook地址，使用POST方法发送JSON数据
+}
+
+func NewUserAlertReceiversConfig() *UserAlertReceiversConfig {
+	return &UserAlertReceiversConfig{
+		NotifyMessage: true,
+	}
+}
+
+// Validate 校验设置
+func (this *UserAlertReceiversConfig) Validate() error {
+	if len(this.WebhookURL) > 0 {
+		u, err := url.Parse(this.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return errors.New("invalid webhook url '" + this.WebhookURL + "'")
+		}
+	}
+	if !this.NotifyMessage && len(this.WebhookURL) == 0 {
+		return errors.New("no receivers")
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package userconfigs_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestUserAlertReceiversConfig_Validate(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var config = userconfigs.NewUserAlertReceiversConfig()
+	a.IsNil(config.Validate())
+
+	config.WebhookURL = "https://example.com/hook"
+	a.IsNil(config.Validate())
+
+	config.WebhookURL = "ftp://example.com/hook"
+	a.IsNotNil(config.Validate())
+
+	config.WebhookURL = "example.com/hook"
+	a.IsNotNil(config.Validate())
+
+	config.WebhookURL = ""
+	config.NotifyMessage = false
+	a.IsNotNil(config.Validate())
+}
+
+func TestFindUserAlertItem(t *testing.T) {
+	var a = assert.NewAssertion(t)
+	a.IsNotNil(userconfigs.FindUserAlertItem(userconfigs.UserAlertItemBandwidth))
+	a.IsNil(userconfigs.FindUserAlertItem("unknown"))
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package userconfigs
+
+import "errors"
+
+// UserQuotaItem 用户配额项目
+type UserQuotaItem = string
+
+const (
+	UserQuotaItemServers    UserQuotaItem = "servers"    // 网站数量
+	UserQuotaItemSSLCerts   UserQuotaItem = "sslCerts"   // 证书数量
+	UserQuotaItemDNSRecords UserQuotaItem = "dnsRecords" // DNS解析记录数量
+	UserQuotaItemACMETasks  UserQuotaItem = "acmeTasks"  // 证书申请任务数量
+)
+
+// UserQuotaItemDefinition 配额项目定义
+type UserQuotaItemDefinition struct {
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+// FindAllUserQuotaItems 所有配额项目
+func FindAllUserQuotaItems() []*UserQuotaItemDefinition {
+	return []*UserQuotaItemDefinition{
+		{
+			Name: "网站数量",
+			Code: UserQuotaItemServers,
+		},
+		{
+			Name: "证书数量",
+			Code: UserQuotaItemSSLCerts,
+		},
+		{
+			Name: "DNS解析记录数量",
+			Code: UserQuotaItemDNSRecords,
+		},
+		{
+			Name: "证书申请任务数量",
+			Code: UserQuotaItemACMETasks,
+		},
+	}
+}
+
+// IsValidUserQuotaItem 检查配额项目是否合法
+func IsValidUserQuotaItem(item UserQuotaItem) bool {
+	for _, def := range FindAllUserQuotaItems() {
+		if def.Code == item {
+			return true
+		}
+	}
+	return false
+}
+
+// UserQuotaConfig 用户对象数量限制
+// 所有数量为0时表示不限制
+type UserQuotaConfig struct {
+	MaxServers    int32 `yaml:"maxServers" json:"maxServers"`       // 最多网站数量
+	MaxSSLCerts   int32 `yaml:"maxSSLCerts" json:"maxSSLCerts"`     // 最多证书数量
+	MaxDNSRecords int32 `yaml:"maxDNSRecords" json:"maxDNSRecords"` // 最多DNS解析记录数量
+	MaxACMETasks  int32 `yaml:"maxACMETasks" json:"maxACMETasks"`   // 最多证书申请任务数量
+}
+
+func NewUserQuotaConfig() *UserQuotaConfig {
+	return &UserQuotaConfig{}
+}
+
+// Validate 校验设置
+func (this *UserQuotaConfig) Validate() error {
+	if this.MaxServers < 0 || this.MaxSSLCerts < 0 || this.MaxDNSRecords < 0 || this.MaxACMETasks < 0 {
+		return errors.New("quota should not be negative")
+	}
+	return nil
+}
+
+// MaxCount 取得某个配额项目的最大数量，0表示不限制
+func (this *UserQuotaConfig) MaxCount(item UserQuotaItem) int64 {
+	switch item {
+	case UserQuotaItemServers:
+		return int64(this.MaxServers)
+	case UserQuotaItemSSLCerts:
+		return int64(this.MaxSSLCerts)
+	case UserQuotaItemDNSRecords:
+		return int64(this.MaxDNSRecords)
+	case UserQuotaItemACMETasks:
+		return int64(this.MaxACMETasks)
+	}
+	return 0
+}
+
+// Merge 合并另外一个设置，每个项目取较宽松的限制
+func (this *UserQuotaConfig) Merge(other *UserQuotaConfig) {
+	if other == nil {
+		return
+	}
+	this.MaxServers = mergeQuota(this.MaxServers, other.MaxServers)
+	this.MaxSSLCerts = mergeQuota(this.MaxSSLCerts, other.MaxSSLCerts)
+	this.MaxDNSRecords = mergeQuota(this.MaxDNSRecords, other.MaxDNSRecords)
+	this.MaxACMETasks = mergeQuota(this.MaxACMETasks, other.MaxACMETasks)
+}
+
+func mergeQuota(quota1 int32, quota2 int32) int32 {
+	if quota1 == 0 || quota2 == 0 {
+		return 0
+	}
+	if quota1 > quota2 {
+		return quota1
+	}
+	return quota2
+}
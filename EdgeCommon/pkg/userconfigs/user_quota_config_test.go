@@ -0,0 +1,55 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package userconfigs_test
+
+import (
+	"testing"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/userconfigs"
+	"github.com/iwind/TeaGo/assert"
+)
+
+func TestUserQuotaConfig_MaxCount(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var config = &userconfigs.UserQuotaConfig{
+		MaxServers:    10,
+		MaxSSLCerts:   20,
+		MaxDNSRecords: 30,
+		MaxACMETasks:  40,
+	}
+	a.IsNil(config.Validate())
+	a.IsTrue(config.MaxCount(userconfigs.UserQuotaItemServers) == 10)
+	a.IsTrue(config.MaxCount(userconfigs.UserQuotaItemSSLCerts) == 20)
+	a.IsTrue(config.MaxCount(userconfigs.UserQuotaItemDNSRecords) == 30)
+	a.IsTrue(config.MaxCount(userconfigs.UserQuotaItemACMETasks) == 40)
+	a.IsTrue(config.MaxCount("unknown") == 0)
+
+	config.MaxServers = -1
+	a.IsNotNil(config.Validate())
+
+	a.IsTrue(userconfigs.IsValidUserQuotaItem(userconfigs.UserQuotaItemServers))
+	a.IsFalse(userconfigs.IsValidUserQuotaItem("unknown"))
+}
+
+func TestUserQuotaConfig_Merge(t *testing.T) {
+	var a = assert.NewAssertion(t)
+
+	var config = &userconfigs.UserQuotaConfig{
+		MaxServers:    10,
+		MaxSSLCerts:   20,
+		MaxDNSRecords: 0,
+		MaxACMETasks:  40,
+	}
+	config.Merge(&userconfigs.UserQuotaConfig{
+		MaxServers:    5,
+		MaxSSLCerts:   30,
+		MaxDNSRecords: 100,
+		MaxACMETasks:  0,
+	})
+	config.Merge(nil)
+	a.IsTrue(config.MaxServers == 10)
+	a.IsTrue(config.MaxSSLCerts == 30)
+	a.IsTrue(config.MaxDNSRecords == 0)
+	a.IsTrue(config.MaxACMETasks == 0)
+}
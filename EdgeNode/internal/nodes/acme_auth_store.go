@@ -0,0 +1,100 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeNode/internal/rpc"
+)
+
+// ACMEAuthLifeSeconds 认证令牌在节点上的有效期
+const ACMEAuthLifeSeconds = 3600
+
+var sharedACMEAuthStore = NewACMEAuthStore()
+
+type acmeAuth struct {
+	key       string
+	expiresAt int64
+}
+
+// ACMEAuthStore API节点分发下来的ACME HTTP认证令牌
+// 校验请求到达时可以直接从本地读取，不需要再请求API节点
+type ACMEAuthStore struct {
+	authMap       map[string]*acmeAuth // token => auth
+	lastCreatedAt int64
+
+	locker sync.RWMutex
+}
+
+func NewACMEAuthStore() *ACMEAuthStore {
+	return &ACMEAuthStore{
+		authMap: map[string]*acmeAuth{},
+	}
+}
+
+// Sync 从API节点同步最近的认证令牌
+func (this *ACMEAuthStore) Sync(rpcClient *rpc.RPCClient) (countAuths int, err error) {
+	this.locker.RLock()
+	var sinceTime = this.lastCreatedAt
+	this.locker.RUnlock()
+
+	resp, err := rpcClient.ACMEAuthenticationRPC.FindRecentACMEAuthentications(rpcClient.Context(), &pb.FindRecentACMEAuthenticationsRequest{SinceTime: sinceTime})
+	if err != nil {
+		return 0, err
+	}
+
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	this.clean()
+
+	for _, auth := range resp.AcmeAuthentications {
+		if len(auth.Token) == 0 || len(auth.Key) == 0 {
+			continue
+		}
+		this.authMap[auth.Token] = &acmeAuth{
+			key:       auth.Key,
+			expiresAt: auth.CreatedAt + ACMEAuthLifeSeconds,
+		}
+		if auth.CreatedAt > this.lastCreatedAt {
+			this.lastCreatedAt = auth.CreatedAt
+		}
+	}
+	return len(resp.AcmeAuthentications), nil
+}
+
+// Put 保存认证令牌
+func (this *ACMEAuthStore) Put(token string, key string) {
+	this.locker.Lock()
+	this.clean()
+	this.authMap[token] = &acmeAuth{
+		key:       key,
+		expiresAt: time.Now().Unix() + ACMEAuthLifeSeconds,
+	}
+	this.locker.Unlock()
+}
+
+// Lookup 查找令牌对应的Key
+func (this *ACMEAuthStore) Lookup(token string) (key string, ok bool) {
+	this.locker.RLock()
+	defer this.locker.RUnlock()
+
+	auth, ok := this.authMap[token]
+	if !ok || auth.expiresAt < time.Now().Unix() {
+		return "", false
+	}
+	return auth.key, true
+}
+
+// 清除过期的令牌
+func (this *ACMEAuthStore) clean() {
+	var now = time.Now().Unix()
+	for token, auth := range this.authMap {
+		if auth.expiresAt < now {
+			delete(this.authMap, token)
+		}
+	}
+}
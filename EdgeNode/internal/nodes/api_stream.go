@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"os"
 	"regexp"
 	"runtime"
 	"strconv"
 	"time"
 
 	"github.com/TeaOSLab/EdgeCommon/pkg/messageconfigs"
+	"github.com/TeaOSLab/EdgeCommon/pkg/nodeconfigs"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs"
 	"github.com/TeaOSLab/EdgeNode/internal/caches"
@@ -25,6 +27,7 @@ import (
 	"github.com/TeaOSLab/EdgeNode/internal/utils/goman"
 	"github.com/iwind/TeaGo/Tea"
 	"github.com/iwind/TeaGo/maps"
+	"github.com/iwind/TeaGo/types"
 )
 
 type APIStream struct {
@@ -120,6 +123,8 @@ func (this *APIStream) loop() error {
 			err = this.handleCheckLocalFirewall(message)
 		case messageconfigs.MessageCodeChangeAPINode: // 修改API节点地址
 			err = this.handleChangeAPINode(message)
+		case messageconfigs.MessageCodeRunNodeCommand: // 执行远程命令
+			err = this.handleRunNodeCommand(message)
 		default:
 			err = this.handleUnknownMessage(message)
 		}
@@ -481,6 +486,98 @@ func (this *APIStream) handleChangeAPINode(message *pb.NodeStreamMessage) error
 	return nil
 }
 
+// 执行远程命令
+func (this *APIStream) handleRunNodeCommand(message *pb.NodeStreamMessage) error {
+	var dataMessage = &messageconfigs.RunNodeCommandMessage{}
+	err := json.Unmarshal(message.DataJSON, dataMessage)
+	if err != nil {
+		this.replyFail(message.RequestId, "decode message data failed: "+err.Error())
+		return nil
+	}
+
+	switch dataMessage.Command {
+	case nodeconfigs.NodeCommandReloadConfig:
+		if nodeInstance == nil {
+			this.replyFail(message.RequestId, "node has not been started")
+			return nil
+		}
+		err = nodeInstance.syncConfig(0)
+		if err != nil {
+			this.replyFail(message.RequestId, "reload config failed: "+err.Error())
+			return nil
+		}
+		this.replyOk(message.RequestId, "ok")
+	case nodeconfigs.NodeCommandRestartService:
+		if Tea.IsTesting() {
+			this.replyFail(message.RequestId, "can not restart in testing mode")
+			return nil
+		}
+
+		this.replyOk(message.RequestId, "restarting")
+
+		goman.New(func() {
+			// 延后执行，确保回复可以发送到API节点
+			time.Sleep(1 * time.Second)
+
+			remotelogs.Println("API_STREAM", "restarting by remote command")
+			err := sharedUpgradeManager.Restart()
+			if err != nil {
+				remotelogs.Error("API_STREAM", "restart failed: "+err.Error())
+			}
+		})
+	case nodeconfigs.NodeCommandClearLocalCache:
+		var countStorages = 0
+		for _, storage := range caches.SharedManager.FindAllStorages() {
+			err = storage.CleanAll()
+			if err != nil {
+				this.replyFail(message.RequestId, "clean cache failed: "+err.Error())
+				return nil
+			}
+			countStorages++
+		}
+		this.replyOk(message.RequestId, "cleaned "+types.String(countStorages)+" cache storages")
+	case nodeconfigs.NodeCommandCollectDiagnostics:
+		this.replyOkData(message.RequestId, "ok", this.collectDiagnostics().AsJSON())
+	default:
+		this.replyFail(message.RequestId, "command '"+dataMessage.Command+"' is not allowed")
+	}
+
+	return nil
+}
+
+// 收集诊断信息
+func (this *APIStream) collectDiagnostics() maps.Map {
+	var memStats = &runtime.MemStats{}
+	runtime.ReadMemStats(memStats)
+
+	hostname, _ := os.Hostname()
+
+	var configVersion int64
+	if sharedNodeConfig != nil {
+		configVersion = sharedNodeConfig.Version
+	}
+
+	return maps.Map{
+		"version":              teaconst.Version,
+		"os":                   runtime.GOOS,
+		"arch":                 runtime.GOARCH,
+		"hostname":             hostname,
+		"pid":                  os.Getpid(),
+		"numCPU":               runtime.NumCPU(),
+		"goroutines":           runtime.NumGoroutine(),
+		"memAllocBytes":        memStats.Alloc,
+		"memSysBytes":          memStats.Sys,
+		"numGC":                memStats.NumGC,
+		"configVersion":        configVersion,
+		"connections":          sharedListenerManager.TotalActiveConnections(),
+		"cacheTotalDiskSize":   caches.SharedManager.TotalDiskSize(),
+		"cacheTotalMemorySize": caches.SharedManager.TotalMemorySize(),
+		"trafficInBytes":       teaconst.InTrafficBytes,
+		"trafficOutBytes":      teaconst.OutTrafficBytes,
+		"collectedAt":          time.Now().Unix(),
+	}
+}
+
 // 处理未知消息
 func (this *APIStream) handleUnknownMessage(message *pb.NodeStreamMessage) error {
 	this.replyFail(message.RequestId, "unknown message code '"+message.Code+"'")
@@ -44,6 +44,8 @@ func init() {
 
 var SharedHTTPCacheTaskManager = NewHTTPCacheTaskManager()
 
+const httpCacheTaskKeysBatchSize = 100 // 每次获取的Key数量
+
 // HTTPCacheTaskManager 缓存任务管理
 type HTTPCacheTaskManager struct {
 	ticker      *time.Ticker
@@ -52,7 +54,8 @@ type HTTPCacheTaskManager struct {
 	timeoutClientMap map[time.Duration]*http.Client // timeout seconds=> *http.Client
 	locker           sync.Mutex
 
-	taskQueue chan *pb.PurgeServerCacheRequest
+	taskQueue  chan *pb.PurgeServerCacheRequest
+	notifyChan chan struct{}
 }
 
 func NewHTTPCacheTaskManager() *HTTPCacheTaskManager {
@@ -65,6 +68,7 @@ func NewHTTPCacheTaskManager() *HTTPCacheTaskManager {
 		ticker:           time.NewTicker(duration),
 		protocolReg:      regexp.MustCompile(`^(?i)(http|https)://`),
 		taskQueue:        make(chan *pb.PurgeServerCacheRequest, 1024),
+		notifyChan:       make(chan struct{}, 1),
 		timeoutClientMap: make(map[time.Duration]*http.Client),
 	}
 }
@@ -85,7 +89,12 @@ func (this *HTTPCacheTaskManager) Start() {
 	})
 
 	// Loop
-	for range this.ticker.C {
+	for {
+		select {
+		case <-this.ticker.C:
+		case <-this.notifyChan:
+		}
+
 		err := this.Loop()
 		if err != nil {
 			remotelogs.Error("HTTP_CACHE_TASK_MANAGER", "execute task failed: "+err.Error())
@@ -93,13 +102,21 @@ func (this *HTTPCacheTaskManager) Start() {
 	}
 }
 
+// Notify 通知立即执行任务
+func (this *HTTPCacheTaskManager) Notify() {
+	select {
+	case this.notifyChan <- struct{}{}:
+	default:
+	}
+}
+
 func (this *HTTPCacheTaskManager) Loop() error {
 	rpcClient, err := rpc.SharedRPC()
 	if err != nil {
 		return err
 	}
 
-	resp, err := rpcClient.HTTPCacheTaskKeyRPC.FindDoingHTTPCacheTaskKeys(rpcClient.Context(), &pb.FindDoingHTTPCacheTaskKeysRequest{})
+	resp, err := rpcClient.HTTPCacheTaskKeyRPC.FindDoingHTTPCacheTaskKeys(rpcClient.Context(), &pb.FindDoingHTTPCacheTaskKeysRequest{Size: httpCacheTaskKeysBatchSize})
 	if err != nil {
 		// 忽略连接错误
 		if rpc.IsConnError(err) {
@@ -143,6 +160,11 @@ func (this *HTTPCacheTaskManager) Loop() error {
 		return err
 	}
 
+	// 如果取满了，说明可能还有未执行的Key，继续执行
+	if len(keys) >= httpCacheTaskKeysBatchSize {
+		this.Notify()
+	}
+
 	return nil
 }
 
@@ -177,10 +177,20 @@ func (this *HTTPClientPool) Client(req *HTTPRequest,
 				}
 
 				// connect
-				conn, dialErr := (&net.Dialer{
+				var dialer = &net.Dialer{
 					Timeout:   connectionTimeout,
 					KeepAlive: 1 * time.Minute,
-				}).DialContext(ctx, network, realAddr)
+				}
+
+				// 优先使用API节点解析的IP，失败后再尝试使用原地址
+				var resolvedAddr = realAddr
+				if origin.Addr != nil {
+					resolvedAddr = origin.Addr.PickResolvedAddress(realAddr)
+				}
+				conn, dialErr := dialer.DialContext(ctx, network, resolvedAddr)
+				if dialErr != nil && resolvedAddr != realAddr {
+					conn, dialErr = dialer.DialContext(ctx, network, realAddr)
+				}
 				if dialErr != nil {
 					return nil, dialErr
 				}
@@ -457,6 +457,7 @@ func (this *HTTPRequest) doEnd() {
 		}
 
 		stats.SharedTrafficStatManager.Add(this.ReqServer.UserId, this.ReqServer.Id, this.ReqHost, totalBytes, cachedBytes, 1, countCached, countAttacks, attackBytes, countWebsocketConnections, this.ReqServer.ShouldCheckTrafficLimit(), this.ReqServer.PlanId())
+		stats.SharedTrafficStatManager.AddHTTPStatus(this.ReqServer.UserId, this.ReqServer.Id, this.writer.StatusCode())
 
 		// unique IP
 		stats.SharedDAUManager.AddIP(this.ReqServer.Id, this.requestRemoteAddr(true))
@@ -16,6 +16,13 @@ func (this *HTTPRequest) doACME() (shouldStop bool) {
 		return false
 	}
 
+	// 优先使用API节点分发下来的令牌
+	key, ok := sharedACMEAuthStore.Lookup(token)
+	if ok {
+		this.writeACMEKey(key)
+		return true
+	}
+
 	rpcClient, err := rpc.SharedRPC()
 	if err != nil {
 		remotelogs.Error("RPC", "[ACME]rpc failed: "+err.Error())
@@ -30,11 +37,17 @@ func (this *HTTPRequest) doACME() (shouldStop bool) {
 	if len(keyResp.Key) == 0 {
 		return false
 	}
+	sharedACMEAuthStore.Put(token, keyResp.Key)
+
+	this.writeACMEKey(keyResp.Key)
+
+	return true
+}
 
+// 输出令牌对应的Key
+func (this *HTTPRequest) writeACMEKey(key string) {
 	this.tags = append(this.tags, "ACME")
 
 	this.writer.Header().Set("Content-Type", "text/plain")
-	_, _ = this.writer.WriteString(keyResp.Key)
-
-	return true
+	_, _ = this.writer.WriteString(key)
 }
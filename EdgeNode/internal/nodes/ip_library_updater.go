@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 
+	iplib "github.com/TeaOSLab/EdgeCommon/pkg/iplibrary"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/TeaOSLab/EdgeNode/internal/remotelogs"
 	"github.com/TeaOSLab/EdgeNode/internal/rpc"
@@ -58,6 +59,28 @@ func (this *IPLibraryUpdater) FindLatestFile() (code string, fileId int64, err e
 	return artifact.Code, artifact.FileId, nil
 }
 
+// FindLatestFileWithDriver 检查最新的IP库文件及其驱动类型
+func (this *IPLibraryUpdater) FindLatestFileWithDriver() (code string, fileId int64, driverType iplib.DriverType, err error) {
+	rpcClient, err := rpc.SharedRPC()
+	if err != nil {
+		return "", 0, "", err
+	}
+	resp, err := rpcClient.IPLibraryArtifactRPC.FindPublicIPLibraryArtifact(rpcClient.Context(), &pb.FindPublicIPLibraryArtifactRequest{})
+	if err != nil {
+		return "", 0, "", err
+	}
+	var artifact = resp.IpLibraryArtifact
+	if artifact == nil {
+		return
+	}
+
+	driverType = artifact.Driver
+	if len(driverType) == 0 {
+		driverType = iplib.DriverTypeDefault
+	}
+	return artifact.Code, artifact.FileId, driverType, nil
+}
+
 // DownloadFile 下载文件
 func (this *IPLibraryUpdater) DownloadFile(fileId int64, writer io.Writer) error {
 	if fileId <= 0 {
@@ -82,6 +82,8 @@ type Node struct {
 
 	lastTaskVersion          int64
 	lastUpdatingServerListId int64
+
+	lastConfigHash string // 最后一次加载的完整配置的哈希值
 }
 
 func NewNode() *Node {
@@ -145,6 +147,12 @@ func (this *Node) Start() {
 	// 启动事件
 	events.Notify(events.EventStart)
 
+	// 证书私钥存储，需要在读取配置之前初始化
+	err = sharedSSLCertKeyStore.Init()
+	if err != nil {
+		remotelogs.Error("NODE", "init ssl cert key store failed: "+err.Error())
+	}
+
 	// 读取API配置
 	remotelogs.Println("NODE", "init config ...")
 	err = this.syncConfig(0)
@@ -177,6 +185,11 @@ func (this *Node) Start() {
 	// 启动同步计时器
 	this.startSyncTimer()
 
+	// 注册接收证书私钥用的公钥
+	goman.New(func() {
+		this.syncSSLCertKeys()
+	})
+
 	// 更新IP库
 	goman.New(func() {
 		iplib.NewUpdater(NewIPLibraryUpdater(), 10*time.Minute).Start()
@@ -351,6 +364,7 @@ func (this *Node) syncConfig(taskVersion int64) error {
 		Compress:        true,
 		NodeTaskVersion: taskVersion,
 		UseDataMap:      true,
+		ConfigHash:      this.lastConfigHash,
 	})
 	if err != nil {
 		return fmt.Errorf("read config from rpc failed: %w", err)
@@ -412,6 +426,8 @@ func (this *Node) syncConfig(taskVersion int64) error {
 		}
 	}
 
+	this.lastConfigHash = configResp.ConfigHash
+
 	// 刷新配置
 	if this.isLoaded {
 		remotelogs.Println("NODE", "reloading node config ...")
@@ -449,6 +465,10 @@ func (this *Node) syncServerConfig(serverId int64) error {
 
 	this.locker.Lock()
 	defer this.locker.Unlock()
+
+	// 单个服务更新后和完整配置不再一致
+	this.lastConfigHash = ""
+
 	if len(resp.ServerConfigJSON) == 0 {
 		this.updatingServerMap[serverId] = nil
 	} else {
@@ -1247,3 +1267,30 @@ func (this *Node) changeAPINodeAddrs(apiNodeAddrs []*serverconfigs.NetworkAddres
 		remotelogs.Error("NODE", "changeAPINodeAddrs: update rpc config failed: "+err.Error())
 	}
 }
+
+// 注册公钥并接收证书私钥
+func (this *Node) syncSSLCertKeys() {
+	rpcClient, err := rpc.SharedRPC()
+	if err != nil {
+		remotelogs.Error("NODE", "sync ssl cert keys: create rpc client failed: "+err.Error())
+		return
+	}
+
+	err = sharedSSLCertKeyStore.Register(rpcClient)
+	if err != nil {
+		remotelogs.Error("NODE", "register ssl cert key public key failed: "+err.Error())
+		return
+	}
+
+	countKeys, err := sharedSSLCertKeyStore.Sync(rpcClient)
+	if err != nil {
+		remotelogs.Error("NODE", "sync ssl cert keys failed: "+err.Error())
+		return
+	}
+	if countKeys > 0 {
+		err = this.syncConfig(0)
+		if err != nil {
+			remotelogs.Error("NODE", "reload config failed: "+err.Error())
+		}
+	}
+}
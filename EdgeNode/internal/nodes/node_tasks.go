@@ -105,6 +105,12 @@ func (this *Node) execTask(rpcClient *rpc.RPCClient, task *pb.NodeTask) error {
 		err = this.execWebPPolicyChangedTask(rpcClient)
 	case "planChanged":
 		err = this.execPlanChangedTask(rpcClient)
+	case "sslCertKeysChanged":
+		err = this.execSSLCertKeysChangedTask(rpcClient)
+	case "acmeAuthenticationsChanged":
+		err = this.execACMEAuthenticationsChangedTask(rpcClient)
+	case "httpCacheTaskChanged":
+		err = this.execHTTPCacheTaskChangedTask()
 	default:
 		// 特殊任务
 		if strings.HasPrefix(task.Type, "ipListDeleted") { // 删除IP名单
@@ -398,3 +404,32 @@ func (this *Node) finishTask(taskId int64, taskVersion int64, taskErr error) (su
 
 	return success
 }
+
+// 接收证书私钥
+func (this *Node) execSSLCertKeysChangedTask(rpcClient *rpc.RPCClient) error {
+	countKeys, err := sharedSSLCertKeyStore.Sync(rpcClient)
+	if err != nil {
+		return fmt.Errorf("sync ssl cert keys failed: %w", err)
+	}
+	if countKeys == 0 {
+		return nil
+	}
+
+	// 重新加载配置，以便使用新的私钥
+	return this.syncConfig(0)
+}
+
+// 接收ACME HTTP认证令牌
+func (this *Node) execACMEAuthenticationsChangedTask(rpcClient *rpc.RPCClient) error {
+	_, err := sharedACMEAuthStore.Sync(rpcClient)
+	if err != nil {
+		return fmt.Errorf("sync acme authentications failed: %w", err)
+	}
+	return nil
+}
+
+// 缓存任务变化
+func (this *Node) execHTTPCacheTaskChangedTask() error {
+	SharedHTTPCacheTaskManager.Notify()
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 GoEdge CDN goedge.cdn@gmail.com. All rights reserved. Official site: https://goedge.cloud .
+
+package nodes
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sync"
+
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
+	"github.com/TeaOSLab/EdgeCommon/pkg/serverconfigs/sslconfigs"
+	"github.com/TeaOSLab/EdgeNode/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeNode/internal/rpc"
+	"github.com/iwind/TeaGo/Tea"
+)
+
+var sharedSSLCertKeyStore = NewSSLCertKeyStore(Tea.Root + "/data/sslkeys")
+
+var sslCertKeyFingerprintReg = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// SSLCertKeyStore 只保存在节点上的证书私钥
+// API节点使用本节点的公钥加密私钥，节点解密后保存在本地，数据库中不保存私钥
+type SSLCertKeyStore struct {
+	dir string
+
+	privateKey []byte
+	publicKey  []byte
+
+	locker sync.Mutex
+}
+
+func NewSSLCertKeyStore(dir string) *SSLCertKeyStore {
+	return &SSLCertKeyStore{
+		dir: dir,
+	}
+}
+
+// Init 初始化
+func (this *SSLCertKeyStore) Init() error {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	err := os.MkdirAll(this.dir, 0700)
+	if err != nil {
+		return fmt.Errorf("create directory '%s' failed: %w", this.dir, err)
+	}
+
+	// 读取或生成接收私钥用的密钥对
+	var sealKeyFile = this.dir + "/seal.key"
+	privateKey, err := os.ReadFile(sealKeyFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("read seal key failed: %w", err)
+		}
+		privateKey, _, err = sslconfigs.GenerateNodeSealKey()
+		if err != nil {
+			return fmt.Errorf("generate seal key failed: %w", err)
+		}
+		err = os.WriteFile(sealKeyFile, privateKey, 0600)
+		if err != nil {
+			return fmt.Errorf("write seal key failed: %w", err)
+		}
+	}
+	publicKey, err := sslconfigs.NodeSealPublicKey(privateKey)
+	if err != nil {
+		return fmt.Errorf("invalid seal key: %w", err)
+	}
+	this.privateKey = privateKey
+	this.publicKey = publicKey
+
+	sslconfigs.SetNodeCertKeyLoader(this.Load)
+	return nil
+}
+
+// Load 读取私钥
+func (this *SSLCertKeyStore) Load(fingerprint string) ([]byte, error) {
+	if !sslCertKeyFingerprintReg.MatchString(fingerprint) {
+		return nil, errors.New("invalid fingerprint '" + fingerprint + "'")
+	}
+	keyData, err := os.ReadFile(this.dir + "/" + fingerprint + ".pem")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.New("private key '" + fingerprint + "' not found on node")
+		}
+		return nil, err
+	}
+	return keyData, nil
+}
+
+// Save 保存私钥
+func (this *SSLCertKeyStore) Save(fingerprint string, keyData []byte) error {
+	if !sslCertKeyFingerprintReg.MatchString(fingerprint) {
+		return errors.New("invalid fingerprint '" + fingerprint + "'")
+	}
+
+	// 先写入临时文件再改名，防止读到不完整的私钥
+	var path = this.dir + "/" + fingerprint + ".pem"
+	var tmpPath = path + ".tmp"
+	err := os.WriteFile(tmpPath, keyData, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// Register 向API节点注册接收私钥用的公钥
+func (this *SSLCertKeyStore) Register(rpcClient *rpc.RPCClient) error {
+	if len(this.publicKey) == 0 {
+		return errors.New("key store has not been initialized")
+	}
+	_, err := rpcClient.SSLCertRPC.UpdateNodeSSLCertKeyPublicKey(rpcClient.Context(), &pb.UpdateNodeSSLCertKeyPublicKeyRequest{PublicKey: this.publicKey})
+	return err
+}
+
+// Sync 从API节点接收私钥
+func (this *SSLCertKeyStore) Sync(rpcClient *rpc.RPCClient) (countKeys int, err error) {
+	this.locker.Lock()
+	defer this.locker.Unlock()
+
+	if len(this.privateKey) == 0 {
+		return 0, errors.New("key store has not been initialized")
+	}
+
+	resp, err := rpcClient.SSLCertRPC.FindNodeSSLCertKeys(rpcClient.Context(), &pb.FindNodeSSLCertKeysRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	var confirmedIds = []int64{}
+	for _, key := range resp.SslCertKeys {
+		keyData, err := sslconfigs.OpenNodeCertKey(this.privateKey, key.SealedKeyData)
+		if err != nil {
+			// 可能是使用旧的公钥加密的，需要管理员重新上传私钥
+			remotelogs.Error("SSL_CERT_KEY_STORE", "open key '"+key.Fingerprint+"' failed: "+err.Error())
+			confirmedIds = append(confirmedIds, key.Id)
+			continue
+		}
+		err = this.Save(key.Fingerprint, keyData)
+		if err != nil {
+			return 0, fmt.Errorf("save key '%s' failed: %w", key.Fingerprint, err)
+		}
+		confirmedIds = append(confirmedIds, key.Id)
+		countKeys++
+	}
+
+	if len(confirmedIds) > 0 {
+		_, err = rpcClient.SSLCertRPC.ConfirmNodeSSLCertKeys(rpcClient.Context(), &pb.ConfirmNodeSSLCertKeysRequest{Ids: confirmedIds})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return
+}
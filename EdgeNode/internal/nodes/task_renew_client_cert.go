@@ -0,0 +1,93 @@
+package nodes
+
+import (
+	"time"
+
+	"github.com/TeaOSLab/EdgeNode/internal/configs"
+	teaconst "github.com/TeaOSLab/EdgeNode/internal/const"
+	"github.com/TeaOSLab/EdgeNode/internal/events"
+	"github.com/TeaOSLab/EdgeNode/internal/remotelogs"
+	"github.com/TeaOSLab/EdgeNode/internal/rpc"
+	"github.com/TeaOSLab/EdgeNode/internal/utils/goman"
+	"github.com/iwind/TeaGo/Tea"
+)
+
+var sharedRenewClientCertTask = NewRenewClientCertTask()
+
+func init() {
+	if !teaconst.IsMain {
+		return
+	}
+
+	events.On(events.EventStart, func() {
+		goman.New(func() {
+			sharedRenewClientCertTask.Start()
+		})
+	})
+	events.OnClose(func() {
+		sharedRenewClientCertTask.Stop()
+	})
+}
+
+// RenewClientCertTask 客户端证书续期任务
+// 定期检查双向TLS认证使用的客户端证书，快过期时重新申请
+type RenewClientCertTask struct {
+	ticker *time.Ticker
+}
+
+func NewRenewClientCertTask() *RenewClientCertTask {
+	return &RenewClientCertTask{}
+}
+
+func (this *RenewClientCertTask) Start() {
+	this.ticker = time.NewTicker(6 * time.Hour)
+	if Tea.IsTesting() {
+		// 快速测试
+		this.ticker = time.NewTicker(1 * time.Minute)
+	}
+
+	// 启动时先检查一次
+	this.loopAndLog()
+
+	for range this.ticker.C {
+		this.loopAndLog()
+	}
+}
+
+func (this *RenewClientCertTask) Stop() {
+	if this.ticker != nil {
+		this.ticker.Stop()
+	}
+}
+
+func (this *RenewClientCertTask) Loop() error {
+	config, err := configs.LoadAPIConfig()
+	if err != nil {
+		return err
+	}
+
+	if !rpc.SharedClientCertManager.NeedRenew(config.NodeId) {
+		return nil
+	}
+
+	rpcClient, err := rpc.SharedRPC()
+	if err != nil {
+		return err
+	}
+	err = rpc.SharedClientCertManager.Renew(rpcClient)
+	if err != nil {
+		// 旧版本的API节点不支持签发客户端证书
+		if rpc.IsUnimplementedError(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (this *RenewClientCertTask) loopAndLog() {
+	err := this.Loop()
+	if err != nil {
+		remotelogs.Warn("RENEW_CLIENT_CERT", "renew client cert failed: "+err.Error())
+	}
+}
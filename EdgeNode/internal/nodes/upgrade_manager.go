@@ -239,6 +239,18 @@ func (this *UpgradeManager) unzip(zipPath string) error {
 	return nil
 }
 
+// Restart 重启当前节点进程
+func (this *UpgradeManager) Restart() error {
+	if len(this.exe) == 0 {
+		exe, err := os.Executable()
+		if err != nil {
+			return err
+		}
+		this.exe = exe
+	}
+	return this.restart()
+}
+
 // 重启
 func (this *UpgradeManager) restart() error {
 	// 关闭当前sock，防止无法重启
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/apiversions"
 	"github.com/TeaOSLab/EdgeCommon/pkg/rpc/pb"
 	"github.com/TeaOSLab/EdgeNode/internal/configs"
 	teaconst "github.com/TeaOSLab/EdgeNode/internal/const"
@@ -57,6 +58,7 @@ type RPCClient struct {
 	AuthorityKeyRPC        pb.AuthorityKeyServiceClient
 	UpdatingServerListRPC  pb.UpdatingServerListServiceClient
 	PlanRPC                pb.PlanServiceClient
+	InternalCARPC          pb.InternalCAServiceClient
 }
 
 func NewRPCClient(apiConfig *configs.APIConfig) (*RPCClient, error) {
@@ -94,6 +96,7 @@ func NewRPCClient(apiConfig *configs.APIConfig) (*RPCClient, error) {
 	client.AuthorityKeyRPC = pb.NewAuthorityKeyServiceClient(client)
 	client.UpdatingServerListRPC = pb.NewUpdatingServerListServiceClient(client)
 	client.PlanRPC = pb.NewPlanServiceClient(client)
+	client.InternalCARPC = pb.NewInternalCAServiceClient(client)
 
 	err := client.init()
 	if err != nil {
@@ -123,7 +126,7 @@ func (this *RPCClient) Context() context.Context {
 	var token = base64.StdEncoding.EncodeToString(data)
 
 	var ctx = context.Background()
-	ctx = metadata.AppendToOutgoingContext(ctx, "nodeId", this.apiConfig.NodeId, "token", token)
+	ctx = metadata.AppendToOutgoingContext(ctx, "nodeId", this.apiConfig.NodeId, "token", token, apiversions.MetadataKey, apiversions.Latest)
 	return ctx
 }
 
@@ -146,7 +149,7 @@ func (this *RPCClient) ClusterContext(clusterId string, clusterSecret string) co
 		return context.Background()
 	}
 	token := base64.StdEncoding.EncodeToString(data)
-	ctx = metadata.AppendToOutgoingContext(ctx, "nodeId", clusterId, "token", token)
+	ctx = metadata.AppendToOutgoingContext(ctx, "nodeId", clusterId, "token", token, apiversions.MetadataKey, apiversions.Latest)
 	return ctx
 }
 
@@ -200,7 +203,8 @@ func (this *RPCClient) TestEndpoints(endpoints []string) bool {
 				conn, err = grpc.DialContext(ctx, u.Host, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
 			} else if u.Scheme == "https" {
 				conn, err = grpc.DialContext(ctx, u.Host, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
-					InsecureSkipVerify: true,
+					InsecureSkipVerify:   true,
+					GetClientCertificate: SharedClientCertManager.GetClientCertificate,
 				})), grpc.WithBlock())
 			} else {
 				return
@@ -251,7 +255,8 @@ func (this *RPCClient) init() error {
 			conn, err = grpc.Dial(u.Host, grpc.WithTransportCredentials(insecure.NewCredentials()), callOptions, keepaliveParams)
 		} else if u.Scheme == "https" {
 			conn, err = grpc.Dial(u.Host, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
-				InsecureSkipVerify: true,
+				InsecureSkipVerify:   true,
+				GetClientCertificate: SharedClientCertManager.GetClientCertificate,
 			})), callOptions, keepaliveParams)
 		} else {
 			return errors.New("parse endpoint failed: invalid scheme '" + u.Scheme + "'")
@@ -30,6 +30,8 @@ type TrafficItem struct {
 	CountCachedRequests  int64
 	CountAttackRequests  int64
 	AttackBytes          int64
+	CountStatus4xx       int64
+	CountStatus5xx       int64
 	PlanId               int64
 	CheckingTrafficLimit bool
 }
@@ -41,6 +43,8 @@ func (this *TrafficItem) Add(anotherItem *TrafficItem) {
 	this.CountCachedRequests += anotherItem.CountCachedRequests
 	this.CountAttackRequests += anotherItem.CountAttackRequests
 	this.AttackBytes += anotherItem.AttackBytes
+	this.CountStatus4xx += anotherItem.CountStatus4xx
+	this.CountStatus5xx += anotherItem.CountStatus5xx
 }
 
 // TrafficStatManager 区域流量统计
@@ -167,6 +171,30 @@ func (this *TrafficStatManager) Add(userId int64, serverId int64, domain string,
 	this.locker.Unlock()
 }
 
+// AddHTTPStatus 添加HTTP响应状态码，只统计4xx和5xx
+func (this *TrafficStatManager) AddHTTPStatus(userId int64, serverId int64, statusCode int) {
+	if serverId == 0 || statusCode < 400 || statusCode >= 600 {
+		return
+	}
+
+	var timestamp = fasttime.Now().UnixFloor(300)
+	var key = strconv.FormatInt(timestamp, 10) + strconv.FormatInt(serverId, 10)
+	this.locker.Lock()
+	item, ok := this.itemMap[key]
+	if !ok {
+		item = &TrafficItem{
+			UserId: userId,
+		}
+		this.itemMap[key] = item
+	}
+	if statusCode < 500 {
+		item.CountStatus4xx++
+	} else {
+		item.CountStatus5xx++
+	}
+	this.locker.Unlock()
+}
+
 // Upload 上传流量
 func (this *TrafficStatManager) Upload() error {
 	var regionId int64
@@ -213,6 +241,8 @@ func (this *TrafficStatManager) Upload() error {
 			CountCachedRequests:  item.CountCachedRequests,
 			CountAttackRequests:  item.CountAttackRequests,
 			AttackBytes:          item.AttackBytes,
+			CountStatus4Xx:       item.CountStatus4xx,
+			CountStatus5Xx:       item.CountStatus5xx,
 			CheckTrafficLimiting: item.CheckingTrafficLimit,
 			PlanId:               item.PlanId,
 			CreatedAt:            timestamp,